@@ -4,30 +4,52 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 
+	"github.com/weaveworks/eksctl/pkg/ctl/apply"
+	"github.com/weaveworks/eksctl/pkg/ctl/clone"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/ctl/completion"
 	"github.com/weaveworks/eksctl/pkg/ctl/create"
 	"github.com/weaveworks/eksctl/pkg/ctl/delete"
 	"github.com/weaveworks/eksctl/pkg/ctl/drain"
 	"github.com/weaveworks/eksctl/pkg/ctl/enable"
+	"github.com/weaveworks/eksctl/pkg/ctl/estimate"
+	"github.com/weaveworks/eksctl/pkg/ctl/export"
 	"github.com/weaveworks/eksctl/pkg/ctl/generate"
 	"github.com/weaveworks/eksctl/pkg/ctl/get"
 	"github.com/weaveworks/eksctl/pkg/ctl/install"
+	"github.com/weaveworks/eksctl/pkg/ctl/pause"
+	"github.com/weaveworks/eksctl/pkg/ctl/register"
+	"github.com/weaveworks/eksctl/pkg/ctl/replace"
+	"github.com/weaveworks/eksctl/pkg/ctl/restore"
+	"github.com/weaveworks/eksctl/pkg/ctl/resume"
 	"github.com/weaveworks/eksctl/pkg/ctl/scale"
 	"github.com/weaveworks/eksctl/pkg/ctl/update"
+	"github.com/weaveworks/eksctl/pkg/ctl/upgrade"
 	"github.com/weaveworks/eksctl/pkg/ctl/utils"
+	"github.com/weaveworks/eksctl/pkg/ctl/validate"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func addCommands(rootCmd *cobra.Command, flagGrouping *cmdutils.FlagGrouping) {
 	rootCmd.AddCommand(create.Command(flagGrouping))
+	rootCmd.AddCommand(clone.Command(flagGrouping))
 	rootCmd.AddCommand(get.Command(flagGrouping))
 	rootCmd.AddCommand(update.Command(flagGrouping))
+	rootCmd.AddCommand(upgrade.Command(flagGrouping))
+	rootCmd.AddCommand(replace.Command(flagGrouping))
 	rootCmd.AddCommand(delete.Command(flagGrouping))
 	rootCmd.AddCommand(scale.Command(flagGrouping))
 	rootCmd.AddCommand(drain.Command(flagGrouping))
+	rootCmd.AddCommand(pause.Command(flagGrouping))
+	rootCmd.AddCommand(resume.Command(flagGrouping))
+	rootCmd.AddCommand(register.Command(flagGrouping))
+	rootCmd.AddCommand(apply.Command(flagGrouping))
+	rootCmd.AddCommand(validate.Command(flagGrouping))
+	rootCmd.AddCommand(estimate.Command(flagGrouping))
+	rootCmd.AddCommand(restore.Command(flagGrouping))
+	rootCmd.AddCommand(export.Command(flagGrouping))
 	if os.Getenv("EKSCTL_EXPERIMENTAL") == "true" {
 		rootCmd.AddCommand(install.Command(flagGrouping))
 		rootCmd.AddCommand(generate.Command(flagGrouping))
@@ -59,6 +81,8 @@ func main() {
 	rootCmd.PersistentFlags().IntVarP(&logger.Level, "verbose", "v", 3, "set log level, use 0 to silence, 4 for debugging and 5 for debugging with AWS debug logging")
 
 	colorValue := rootCmd.PersistentFlags().StringP("color", "C", "true", "toggle colorized logs (valid options: true, false, fabulous)")
+	logFormat := rootCmd.PersistentFlags().String("log-format", logger.FormatText, "format of logs (valid options: text, json)")
+	subsystemLevels := rootCmd.PersistentFlags().StringToString("subsystem-verbose", nil, "set log level of subsystems, overriding --verbose, e.g. cfn=4,kubernetes=1 (subsystems are the packages under pkg/, e.g. cfn, eks, kubernetes)")
 
 	cobra.OnInitialize(func() {
 		// Control colored output
@@ -66,6 +90,10 @@ func main() {
 		logger.Fabulous = *colorValue == "fabulous"
 		// Add timestamps for debugging
 		logger.Timestamps = logger.Level >= 4
+		if err := logger.Configure(*logFormat, *subsystemLevels); err != nil {
+			fmt.Println(err)
+			os.Exit(-1)
+		}
 	})
 
 	rootCmd.SetUsageFunc(flagGrouping.Usage)
@@ -233,6 +233,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 					cmd := eksctlGetCmd.WithArgs(
 						"nodegroup",
 						"--cluster", clusterName,
+						"--yes",
 						testNG,
 					)
 					Expect(cmd).To(RunSuccessfullyWithOutputStringLines(
@@ -498,6 +499,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 							"--cluster", clusterName,
 							"--name", "s3-read-only",
 							"--wait",
+							"--yes",
 						),
 						eksctlDeleteCmd.WithArgs(
 							"iamserviceaccount",
@@ -505,6 +507,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 							"--name", "app-cache-access",
 							"--namespace", "app1",
 							"--wait",
+							"--yes",
 						),
 					}
 					Expect(cmds).To(RunSuccessfully())
@@ -652,6 +655,7 @@ var _ = Describe("(Integration) Create, Get, Scale & Delete", func() {
 						"--cluster", clusterName,
 						"--name", "s3-reader",
 						"--namespace", test.Namespace,
+						"--yes",
 					)
 
 					Expect(deleteCmd).To(RunSuccessfully())
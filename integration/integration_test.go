@@ -73,7 +73,7 @@ func init() {
 		WithTimeout(15 * time.Minute)
 
 	eksctlDeleteClusterCmd = eksctlDeleteCmd.
-		WithArgs("cluster", "--verbose", "4")
+		WithArgs("cluster", "--verbose", "4", "--yes")
 
 	eksctlScaleNodeGroupCmd = eksctlCmd.
 		WithArgs("scale", "nodegroup", "--verbose", "4").
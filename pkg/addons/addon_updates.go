@@ -0,0 +1,93 @@
+package addons
+
+import (
+	"sort"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+)
+
+const (
+	// AddonTypeEKS is the type of addons that are managed via EKS Addons API
+	AddonTypeEKS = "EKS"
+	// AddonTypeSelfManaged is the type of the default addons of clusters that don't manage
+	// them via EKS Addons API
+	AddonTypeSelfManaged = "self-managed"
+)
+
+// AddonUpdateSummary holds the version of an addon along with the versions it can be updated to
+type AddonUpdateSummary struct {
+	Name string
+	Type string
+	// Version is the version the addon runs
+	Version string
+	// DefaultVersion is the version of the addon for the Kubernetes version of the cluster
+	DefaultVersion string
+	// Updates are the versions that are newer than Version, the latest last
+	Updates []string
+}
+
+// GetUpdates returns the default version and the newer versions of each of the addons that
+// are compatible with the given Kubernetes version
+func (m *EKSAddonManager) GetUpdates(summaries []*EKSAddonSummary, kubernetesVersion string) ([]*AddonUpdateSummary, error) {
+	updates := []*AddonUpdateSummary{}
+	for _, summary := range summaries {
+		update := &AddonUpdateSummary{
+			Name:    summary.Name,
+			Type:    AddonTypeEKS,
+			Version: summary.Version,
+			Updates: []string{},
+		}
+		var versions []string
+		input := &eks.DescribeAddonVersionsInput{
+			AddonName:         aws.String(summary.Name),
+			KubernetesVersion: aws.String(kubernetesVersion),
+		}
+		err := m.provider.EKS().DescribeAddonVersionsPages(input, func(output *eks.DescribeAddonVersionsOutput, _ bool) bool {
+			for _, addon := range output.Addons {
+				for _, version := range addon.AddonVersions {
+					versions = append(versions, aws.StringValue(version.AddonVersion))
+					for _, compatibility := range version.Compatibilities {
+						if aws.StringValue(compatibility.ClusterVersion) == kubernetesVersion && aws.BoolValue(compatibility.DefaultVersion) {
+							update.DefaultVersion = aws.StringValue(version.AddonVersion)
+						}
+					}
+				}
+			}
+			return true
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing versions of addon %q", summary.Name)
+		}
+		update.Updates = NewerVersions(summary.Version, versions)
+		updates = append(updates, update)
+	}
+	return updates, nil
+}
+
+// NewerVersions returns the versions that are newer than current, the latest last;
+// versions that aren't semantic versions are left out
+func NewerVersions(current string, versions []string) []string {
+	newer := []string{}
+	currentVersion, err := semver.ParseTolerant(current)
+	if err != nil {
+		return newer
+	}
+	parsed := map[string]semver.Version{}
+	for _, v := range versions {
+		version, err := semver.ParseTolerant(v)
+		if err != nil || !version.GT(currentVersion) {
+			continue
+		}
+		if _, ok := parsed[v]; !ok {
+			parsed[v] = version
+			newer = append(newer, v)
+		}
+	}
+	sort.Slice(newer, func(i, j int) bool {
+		return parsed[newer[i]].LT(parsed[newer[j]])
+	})
+	return newer
+}
@@ -0,0 +1,140 @@
+package addons
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	loadBalancerControllerName      = "aws-load-balancer-controller"
+	loadBalancerControllerChartRepo = "https://aws.github.io/eks-charts"
+)
+
+// loadBalancerControllerChartVersions pins a release of the aws-load-balancer-controller chart for
+// Kubernetes versions starting at minimumVersion, ordered from the oldest Kubernetes version
+var loadBalancerControllerChartVersions = []struct {
+	minimumVersion, chartVersion string
+}{
+	{minimumVersion: api.MinimumVersionForALBIngress, chartVersion: "1.1.6"},
+	{minimumVersion: "1.19", chartVersion: "1.4.8"},
+	{minimumVersion: "1.22", chartVersion: "1.6.2"},
+}
+
+// loadBalancerControllerActions are the actions that the AWS Load Balancer Controller needs
+// on top of the ones of alb-ingress-controller, e.g. for WAFv2, Shield and Cognito integration
+var loadBalancerControllerActions = []string{
+	"ec2:DescribeAvailabilityZones",
+	"ec2:DescribeCoipPools",
+	"ec2:GetCoipPoolUsage",
+	"cognito-idp:DescribeUserPoolClient",
+	"wafv2:GetWebACL",
+	"wafv2:GetWebACLForResource",
+	"wafv2:AssociateWebACL",
+	"wafv2:DisassociateWebACL",
+	"shield:GetSubscriptionState",
+	"shield:DescribeProtection",
+	"shield:CreateProtection",
+	"shield:DeleteProtection",
+	"shield:DescribeSubscription",
+}
+
+// ChartInstaller installs Helm releases to a cluster
+type ChartInstaller interface {
+	Install(release *api.HelmRelease) error
+}
+
+// ALBIngressControllerServiceAccount returns the iamserviceaccount of the AWS Load Balancer Controller,
+// with a policy that allows it to manage load balancers for ingresses and services
+func ALBIngressControllerServiceAccount() *api.ClusterIAMServiceAccount {
+	return &api.ClusterIAMServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      loadBalancerControllerName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		AttachPolicy: api.InlineDocument{
+			"Version": "2012-10-17",
+			"Statement": []interface{}{
+				map[string]interface{}{
+					"Effect":   "Allow",
+					"Resource": "*",
+					"Action":   append(append([]string{}, builder.ALBIngressActions...), loadBalancerControllerActions...),
+				},
+			},
+		},
+	}
+}
+
+// ALBIngressController installs the AWS Load Balancer Controller from its Helm chart, it provisions
+// application load balancers for ingresses of class "alb"
+type ALBIngressController struct {
+	installer           ChartInstaller
+	spec                *api.ClusterConfig
+	controlPlaneVersion string
+	planMode            bool
+}
+
+// NewALBIngressController creates a new ALBIngressController
+func NewALBIngressController(installer ChartInstaller, spec *api.ClusterConfig, controlPlaneVersion string, planMode bool) *ALBIngressController {
+	return &ALBIngressController{
+		installer:           installer,
+		spec:                spec,
+		controlPlaneVersion: controlPlaneVersion,
+		planMode:            planMode,
+	}
+}
+
+// Deploy installs the chart version pinned for the control plane version, its service account
+// is expected to be created beforehand, and the VPC of the cluster to be loaded
+func (a *ALBIngressController) Deploy() error {
+	chartVersion, err := loadBalancerControllerChartVersion(a.controlPlaneVersion)
+	if err != nil {
+		return err
+	}
+	if a.spec.VPC == nil || a.spec.VPC.ID == "" {
+		return fmt.Errorf("VPC of cluster %q is required to deploy %s", a.spec.Metadata.Name, loadBalancerControllerName)
+	}
+
+	release := &api.HelmRelease{
+		Name:      loadBalancerControllerName,
+		Namespace: metav1.NamespaceSystem,
+		Repo:      loadBalancerControllerChartRepo,
+		Chart:     loadBalancerControllerName,
+		Version:   chartVersion,
+		Values: api.InlineDocument{
+			"clusterName": a.spec.Metadata.Name,
+			"region":      a.spec.Metadata.Region,
+			"vpcId":       a.spec.VPC.ID,
+			"serviceAccount": map[string]interface{}{
+				"create": false,
+				"name":   loadBalancerControllerName,
+			},
+		},
+	}
+	if a.planMode {
+		logger.Info("(plan) would install version %s of Helm chart %q from %s", chartVersion, release.Chart, release.Repo)
+		return nil
+	}
+	if err := a.installer.Install(release); err != nil {
+		return errors.Wrapf(err, "deploying %q", loadBalancerControllerName)
+	}
+	return nil
+}
+
+func loadBalancerControllerChartVersion(controlPlaneVersion string) (string, error) {
+	chartVersion := ""
+	for _, v := range loadBalancerControllerChartVersions {
+		if api.IsVersionAtLeast(controlPlaneVersion, v.minimumVersion) {
+			chartVersion = v.chartVersion
+		}
+	}
+	if chartVersion == "" {
+		return "", fmt.Errorf("%s is only supported by Kubernetes %s or later, the control plane runs %s", loadBalancerControllerName, api.MinimumVersionForALBIngress, controlPlaneVersion)
+	}
+	return chartVersion, nil
+}
@@ -0,0 +1,82 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+type fakeChartInstaller struct {
+	releases []*api.HelmRelease
+}
+
+func (f *fakeChartInstaller) Install(release *api.HelmRelease) error {
+	f.releases = append(f.releases, release)
+	return nil
+}
+
+var _ = Describe("AWS Load Balancer Controller", func() {
+	var (
+		installer *fakeChartInstaller
+		cfg       *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		installer = &fakeChartInstaller{}
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.VPC.ID = "vpc-0123"
+	})
+
+	It("installs the chart version pinned for the control plane, configured for the cluster VPC", func() {
+		err := NewALBIngressController(installer, cfg, "1.20", false).Deploy()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(installer.releases).To(HaveLen(1))
+		release := installer.releases[0]
+		Expect(release.Name).To(Equal("aws-load-balancer-controller"))
+		Expect(release.Namespace).To(Equal("kube-system"))
+		Expect(release.Repo).To(Equal("https://aws.github.io/eks-charts"))
+		Expect(release.Chart).To(Equal("aws-load-balancer-controller"))
+		Expect(release.Version).To(Equal("1.4.8"))
+		Expect(release.Values).To(Equal(api.InlineDocument{
+			"clusterName": "test-cluster",
+			"region":      "us-west-2",
+			"vpcId":       "vpc-0123",
+			"serviceAccount": map[string]interface{}{
+				"create": false,
+				"name":   "aws-load-balancer-controller",
+			},
+		}))
+	})
+
+	It("refuses Kubernetes versions older than the oldest release supports", func() {
+		err := NewALBIngressController(installer, cfg, "1.14", false).Deploy()
+		Expect(err).To(MatchError("aws-load-balancer-controller is only supported by Kubernetes 1.15 or later, the control plane runs 1.14"))
+		Expect(installer.releases).To(BeEmpty())
+	})
+
+	It("installs nothing in plan mode", func() {
+		err := NewALBIngressController(installer, cfg, "1.15", true).Deploy()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(installer.releases).To(BeEmpty())
+	})
+
+	It("fails without the VPC of the cluster", func() {
+		cfg.VPC.ID = ""
+		err := NewALBIngressController(installer, cfg, "1.22", false).Deploy()
+		Expect(err).To(MatchError(`VPC of cluster "test-cluster" is required to deploy aws-load-balancer-controller`))
+	})
+
+	It("uses the service account in kube-system with the load balancer policy", func() {
+		sa := ALBIngressControllerServiceAccount()
+		Expect(sa.NameString()).To(Equal("kube-system/aws-load-balancer-controller"))
+		statement := sa.AttachPolicy["Statement"].([]interface{})[0].(map[string]interface{})
+		Expect(statement["Action"]).To(ContainElement("elasticloadbalancing:CreateLoadBalancer"))
+		Expect(statement["Action"]).To(ContainElement("wafv2:AssociateWebACL"))
+	})
+})
@@ -0,0 +1,86 @@
+package addons
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+const archARM64 = "arm64"
+
+var archLabels = []string{"kubernetes.io/arch", "beta.kubernetes.io/arch"}
+
+// IsARMNodeGroup returns true if the nodegroup uses arm64 instance types
+func IsARMNodeGroup(ng *api.NodeGroup) bool {
+	return utils.IsARMInstanceType(ng.InstanceType) || (ng.InstancesDistribution != nil && utils.HasARMInstanceType(ng.InstancesDistribution.InstanceTypes))
+}
+
+// FindDaemonSetsWithoutARM64Support inspects all DaemonSets in the cluster and returns
+// a description of each one that won't run, or may not run, on arm64 nodes
+func FindDaemonSetsWithoutARM64Support(clientSet kubernetes.Interface) ([]string, error) {
+	daemonSets, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing DaemonSets")
+	}
+
+	var issues []string
+	for _, ds := range daemonSets.Items {
+		if reason := arm64SupportIssue(&ds); reason != "" {
+			issues = append(issues, fmt.Sprintf("%s/%s %s", ds.Namespace, ds.Name, reason))
+		}
+	}
+	return issues, nil
+}
+
+func arm64SupportIssue(ds *appsv1.DaemonSet) string {
+	spec := ds.Spec.Template.Spec
+
+	if archs := allowedArchs(&spec); len(archs) > 0 {
+		for _, arch := range archs {
+			if arch == archARM64 {
+				return ""
+			}
+		}
+		return fmt.Sprintf("is restricted to %s nodes", strings.Join(archs, ", "))
+	}
+
+	for _, container := range append(spec.InitContainers, spec.Containers...) {
+		if strings.Contains(container.Image, "amd64") {
+			return fmt.Sprintf("uses image %q, which is built for amd64 only", container.Image)
+		}
+	}
+	return "doesn't declare arm64 support, make sure its images are multi-arch"
+}
+
+// allowedArchs returns architectures the pods are restricted to by
+// node selector or required node affinity, if there are any
+func allowedArchs(spec *corev1.PodSpec) []string {
+	var archs []string
+	for _, label := range archLabels {
+		if arch, ok := spec.NodeSelector[label]; ok {
+			archs = append(archs, arch)
+		}
+	}
+
+	if spec.Affinity == nil || spec.Affinity.NodeAffinity == nil || spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution == nil {
+		return archs
+	}
+	for _, term := range spec.Affinity.NodeAffinity.RequiredDuringSchedulingIgnoredDuringExecution.NodeSelectorTerms {
+		for _, expr := range term.MatchExpressions {
+			for _, label := range archLabels {
+				if expr.Key == label && expr.Operator == corev1.NodeSelectorOpIn {
+					archs = append(archs, expr.Values...)
+				}
+			}
+		}
+	}
+	return archs
+}
@@ -0,0 +1,76 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+func newDaemonSet(name string, podSpec corev1.PodSpec) *appsv1.DaemonSet {
+	return &appsv1.DaemonSet{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Spec: appsv1.DaemonSetSpec{
+			Template: corev1.PodTemplateSpec{Spec: podSpec},
+		},
+	}
+}
+
+var _ = Describe("arm64 support", func() {
+	It("detects arm64 nodegroups", func() {
+		ng := api.NewNodeGroup()
+		ng.InstanceType = "m6g.large"
+		Expect(IsARMNodeGroup(ng)).To(BeTrue())
+
+		ng.InstanceType = "m5.large"
+		Expect(IsARMNodeGroup(ng)).To(BeFalse())
+	})
+
+	It("reports DaemonSets that won't run on arm64 nodes", func() {
+		clientSet := fake.NewSimpleClientset(
+			newDaemonSet("multi-arch", corev1.PodSpec{
+				Affinity: &corev1.Affinity{
+					NodeAffinity: &corev1.NodeAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &corev1.NodeSelector{
+							NodeSelectorTerms: []corev1.NodeSelectorTerm{{
+								MatchExpressions: []corev1.NodeSelectorRequirement{{
+									Key:      "kubernetes.io/arch",
+									Operator: corev1.NodeSelectorOpIn,
+									Values:   []string{"amd64", "arm64"},
+								}},
+							}},
+						},
+					},
+				},
+				Containers: []corev1.Container{{Image: "example/multi-arch:v1"}},
+			}),
+			newDaemonSet("amd64-only", corev1.PodSpec{
+				NodeSelector: map[string]string{"beta.kubernetes.io/arch": "amd64"},
+				Containers:   []corev1.Container{{Image: "example/agent:v1"}},
+			}),
+			newDaemonSet("amd64-image", corev1.PodSpec{
+				Containers: []corev1.Container{{Image: "example/agent-amd64:v1"}},
+			}),
+			newDaemonSet("unknown", corev1.PodSpec{
+				Containers: []corev1.Container{{Image: "example/agent:v1"}},
+			}),
+		)
+
+		issues, err := FindDaemonSetsWithoutARM64Support(clientSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(issues).To(ConsistOf(
+			"kube-system/amd64-only is restricted to amd64 nodes",
+			`kube-system/amd64-image uses image "example/agent-amd64:v1", which is built for amd64 only`,
+			"kube-system/unknown doesn't declare arm64 support, make sure its images are multi-arch",
+		))
+	})
+})
@@ -0,0 +1,350 @@
+// Code generated by go-bindata.
+// sources:
+// assets/cluster-autoscaler.yaml
+// assets/ebs-csi-driver.yaml
+// assets/efa-device-plugin.yaml
+// assets/nvidia-device-plugin.yaml
+// assets/vpc-admission-webhook.yaml
+// assets/vpc-resource-controller.yaml
+// DO NOT EDIT!
+
+package addons
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data []byte, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(bytes.NewBuffer(data))
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+	clErr := gz.Close()
+
+	if err != nil {
+		return nil, fmt.Errorf("Read %q: %v", name, err)
+	}
+	if clErr != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes []byte
+	info  os.FileInfo
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _clusterAutoscalerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x57\xcd\x8e\x1b\x37\x0c\xbe\xfb\x29\x08\xe7\x1a\xd9\x1b\x24\x29\x82\x01\xf6\x90\xa6\x40\x11\xa0\x49\x17\x09\xd0\x4b\xb1\x07\x8e\x44\xdb\x8a\x35\x92\x2a\x72\xbc\xeb\x3e\x7d\x21\xcd\x78\xd7\x3f\x63\xaf\xd7\x0d\xda\xc0\xbe\x8c\x44\x7e\xfc\xc4\x1f\x91\x52\x4a\x8d\x5e\x80\x2c\x08\x98\xd2\xca\x6a\x02\xd4\x3a\xb4\x5e\xc0\x32\xe8\x44\x28\x64\xa0\x5e\x03\x2d\x59\x8b\x03\x74\xc1\xcf\xe1\xce\xca\x02\xac\x30\x7c\x7c\xff\x09\x52\x70\x34\xc2\x68\xff\xa0\xc4\x36\xf8\x0a\x52\x8d\x7a\x82\xad\x2c\x42\xb2\x7f\xa3\xd8\xe0\x27\xcb\x77\x3c\xb1\x61\xba\x7a\x35\x5a\x5a\x6f\x2a\xf8\xe0\x5a\x16\x4a\x5f\xb2\x6a\x43\x82\x06\x05\xab\x11\x80\xc7\x86\x2a\xd0\xdd\xae\xc2\x56\x02\x6b\x74\x94\x46\x00\x0e\x6b\x72\x9c\x85\x00\x96\xef\x58\xa1\x31\xc1\x0f\xc9\x4e\xca\x0e\xf7\x36\x1f\xe5\x63\x1c\x44\x4e\xad\xa3\x02\xab\x00\xa3\xfd\x35\x85\x36\x72\x05\x7f\x8e\xc7\xb7\x45\x35\x11\x87\x36\x69\x2a\x6b\xb4\x22\x2f\x3c\x7e\x09\x63\xf2\x26\x06\x9b\x3f\x3a\xb1\x15\xa5\xba\x88\x74\x2e\xcb\x22\x11\x45\x2f\xc6\xb7\xe7\x21\xc7\x60\x78\x4a\x2b\xab\xb3\xbb\x8e\x60\x3e\x07\x8a\x05\xa5\x3d\x20\xd7\x46\xf3\x0c\xa0\xfd\x33\x6e\x36\x3f\x63\xd3\x91\x3e\xf4\xe6\xbe\xc1\x39\x49\x76\xc5\xf3\xec\xfa\x60\xe8\x80\xfa\x5d\xf1\xe6\x4b\x18\x3b\xcb\x05\xf3\x22\xe8\xec\xe6\xac\xdc\xe7\x7a\x09\x65\xa2\xe8\xac\x2e\x79\xaa\x83\x97\x14\x9c\xa3\x54\x76\x62\xce\x68\x16\xf2\xb2\x0a\xae\x6d\x48\x3b\xb4\xcd\xe0\xce\x79\x74\x07\x48\xd2\xbd\x90\xcf\x65\xc3\x43\x74\x7b\x6a\x4c\x5d\xd2\x19\xa4\x26\xf8\xf2\x75\xa1\xb9\x18\x9c\xd5\xeb\x21\x53\x31\x18\x63\x39\xb5\x31\xfb\xa1\x6e\xcd\xfc\x49\x2b\x03\xf0\x18\x23\x0f\x81\xe7\x6c\xa4\x59\xeb\x36\x07\xf9\xee\xe7\x62\x09\x09\xe7\xd4\xd7\xfc\x30\x85\x22\xa1\x1d\x32\x53\xb1\xaa\xd9\x9e\x9f\x69\x03\x36\xeb\x8d\xdc\xe9\x18\x7e\x0b\x35\x1f\x29\x8b\x8d\x81\x07\x8b\x47\xaf\x0c\x1d\x42\x32\xd6\x6f\x5f\xa6\x43\xb6\x1c\x21\x13\x9f\x7f\x7d\x3c\x0d\xfb\x74\xad\x9f\x41\x60\xbf\x54\x73\xcf\x79\x66\xc3\x78\x4e\xa7\xc8\x4d\x84\x23\x6a\xaa\x60\xd9\xd6\xa4\x78\xcd\x42\xcd\x0f\xd2\x42\x74\xf0\x33\x3b\x6f\x30\x1e\x78\xa9\x0f\xd3\x41\x5e\xdc\x5e\x08\x7c\x46\x08\x55\xdf\x26\x5e\xc2\xd0\x66\x4c\x36\x24\x2b\x6b\x45\xf7\x11\xbd\x39\xbc\xdd\x0d\x39\xea\x18\xef\x46\x78\x8b\xfa\x05\xa1\xde\x9a\x0d\x7e\xb6\xde\x58\x3f\xff\xdf\x47\x84\xe0\xe8\x0b\xcd\x32\xf0\x26\x0c\x27\x4e\x32\x02\x38\x1c\x72\x4e\xf0\xe6\xb6\xfe\x46\x5a\xfa\x04\xea\x54\xbf\x76\xfd\xe9\x7d\x37\x8a\x15\xaf\x9f\x38\xf7\xd1\x94\xbf\xc0\xfb\x17\xb8\xfd\xbf\xaf\xb7\xcb\xe2\xf1\x03\x05\x22\x37\xca\x47\x9f\xff\x42\xd1\x85\x75\x43\x5e\xbe\xab\xcb\x8f\xb9\x8f\x23\xe9\x7c\xc6\x4d\x17\xae\xe0\xd5\x08\x80\xc9\x91\x96\x90\xf2\x0e\x40\x93\xeb\xf7\xb7\x2d\xb0\xe3\x70\x00\x42\x4d\x74\x28\xd4\xab\x6e\x1d\x01\x60\x97\xd2\x69\x9c\xfc\x43\xef\x83\x94\x3e\xb7\xa5\x72\x28\x3d\xc9\x37\x7b\xf2\x24\x94\x33\x66\xca\x38\x23\x25\x41\x95\x09\xba\x82\xf1\x0c\x1d\xd3\xb8\xe8\x6f\x4e\x9b\x7f\xbc\x13\xcd\xcf\xa7\x02\x09\xb0\xb9\xfe\x3e\xe4\x91\xa1\x93\xed\xdc\xac\x36\x2a\x3a\x59\xb1\x1a\xdd\x03\xba\x6e\x8b\x42\xf0\x42\xf7\xf2\x48\xff\x05\xa0\x73\xe1\x8e\xcb\x33\xeb\xd1\x0e\x48\x80\x44\x68\xca\xf2\x1d\xd5\x60\x0d\x79\xb1\xb2\x06\x09\x4b\xf2\x10\x66\xe5\x8d\xb5\xf7\x2e\x7b\x40\x9d\x71\x9f\xf9\x3f\xbd\x7d\xfb\xfa\x4d\xbf\x9c\x27\x9a\xaf\x3b\x91\xcc\xff\x9a\x04\xf7\x3c\x16\xb8\x02\x67\x7d\x7b\xdf\x0b\xe5\xd1\x17\xad\xa7\xb4\xe5\x76\x05\xb6\xc1\x79\xce\xb1\x77\x3c\x99\xeb\x94\x3d\x7d\xd4\x5d\x4f\x96\x06\xc0\x76\x57\xda\x32\x93\xff\xce\x36\x56\xf6\xd6\x00\x74\x6c\x2b\x78\x75\x75\xd5\xec\xad\x37\xd4\x84\xb4\xae\xe0\xf5\xd5\xd5\x27\xbb\xb3\x97\xe8\xaf\x96\xf8\xdf\x23\xe9\xd0\x34\xe8\xcd\x2e\x8c\x82\xc9\xe9\xf3\x67\x11\xa5\x56\xd7\x6f\x0e\xd6\x58\x0c\xa5\x24\x8b\x44\xbc\x08\xce\x5c\x5b\x3f\x0b\x07\x42\xda\x85\xd6\xa8\x98\xc2\xca\x1a\x4a\xd7\x78\xc7\x87\x38\x4b\x1b\x55\x0e\x32\xab\xfc\x08\x57\x2e\x68\x74\xaa\x1f\x6e\xaf\x4b\xda\x3f\xa9\xd3\x67\x71\x7e\x0b\x1d\xd1\xd8\x34\xfc\xeb\x3c\x50\x8a\xba\x43\x96\x43\xa1\x1a\x1d\x7a\x4d\x8a\x6d\x63\x1d\xa6\xc2\x4a\xcd\x73\x4a\x6e\xd3\xee\x5e\x4e\x9f\x72\xbd\xed\x05\x45\xf5\xe9\xc2\xec\x94\xa6\x24\xbb\x87\x05\x68\xb2\xce\x0d\xca\xa2\x82\x29\x89\x9e\x32\xbb\x69\x91\x9b\x6a\x2c\x0a\x76\x96\x9f\x6d\xc4\x13\x9d\x64\x4f\x37\x97\xd5\xef\xde\xad\x2b\x90\xd4\x6e\x53\x2f\x09\x7d\xd3\x3a\x77\x53\x5e\x42\x15\x7c\x9c\x7d\x0e\x72\x93\x88\xe9\xa1\xb4\xfa\x27\x5d\x35\x3a\x87\xe9\x22\x70\x47\x72\x6b\x0d\x20\x1e\xa1\x5d\xb7\xde\x38\x9a\xe8\x24\xa3\x7f\x06\x00\xd4\xff\xb6\xe1\x79\x11\x00\x00")
+
+func clusterAutoscalerYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_clusterAutoscalerYaml,
+		"cluster-autoscaler.yaml",
+	)
+}
+
+func clusterAutoscalerYaml() (*asset, error) {
+	bytes, err := clusterAutoscalerYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "cluster-autoscaler.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _ebsCsiDriverYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5f\x6f\xdb\x36\x10\x7f\xf7\xa7\x38\xb8\x7d\x68\x81\xca\x6e\xda\x65\xc8\x04\xe4\x21\x8d\x83\x2e\xd8\x9a\x06\x71\xd0\x97\x22\x08\x28\xea\x6c\x73\xa6\x48\x8e\x3c\x29\x51\x87\x7d\xf7\x81\x92\x9c\x48\xb2\xfc\x27\xce\x52\x2c\x43\x91\xa0\x35\xc8\xbb\xfb\xdd\xdf\x9f\x4e\x4e\x10\x04\xbd\x17\x40\x33\x04\x8c\x5c\xc0\x9d\x08\xb8\x56\x64\xb5\x94\x68\x03\xc7\xc0\xa1\xcd\x04\x47\x60\x9c\xeb\x54\x11\x08\x07\xdc\x22\x23\x8c\x21\xca\x01\xe7\x8e\x93\x04\x26\xb5\x9a\xc2\x8d\xa0\x19\x08\x72\x70\x7a\xf4\x09\xac\x96\xd8\x63\x46\x7c\x41\xeb\x84\x56\x21\xd8\x88\xf1\x01\x4b\x69\xa6\xad\xf8\xc6\x48\x68\x35\x98\x1f\xb8\x81\xd0\xc3\x6c\xaf\x37\x17\x2a\x0e\xe1\x58\xa6\x8e\xd0\x5e\x78\xd5\x04\x89\xc5\x8c\x58\xd8\x03\x50\x2c\xc1\xb0\xf0\x0f\x6f\x09\xad\x62\x32\x30\x56\x67\xc2\x1b\x46\x1b\x14\x50\x00\x92\x45\x28\x9d\x97\x07\x60\xc6\x0c\xe6\x69\x84\x56\x21\x61\x01\x52\xda\x60\x37\x2e\x58\xc4\x19\x5b\x91\xa1\xed\xd9\x54\x62\xa1\x15\x00\x33\xe2\xa3\xd5\xa9\x71\x21\x7c\xed\xf7\xaf\x0a\x4b\x16\x9d\x4e\x2d\xc7\xe2\xcc\xf8\x60\x1c\xa1\xa2\x4c\xcb\x34\x41\x57\x09\x65\x68\xa3\x42\x60\x8a\xd4\x7f\x03\x7d\x29\x5c\xf1\xff\x0d\x23\x3e\xf3\x1f\xca\x94\xf9\x4f\x31\x4a\x24\xec\x5f\xed\x06\xc8\x25\x13\xc9\xd6\xa8\xa9\x89\x59\x37\x96\x23\x6d\xd9\x14\xab\x0a\x74\x21\x57\x12\x5c\x32\xe7\xb6\x8c\x73\xcb\x98\x30\x43\x45\x4b\x16\xd7\xa4\xac\x0a\xe3\x0d\xf4\xcd\x2a\x1c\xa7\x98\x71\x33\x4d\x83\xcd\x81\x55\x95\xab\x14\x9c\xc7\x6c\x1e\xf9\xfe\xef\xf2\xb0\x1e\xf3\x4e\x29\xe5\x4e\x28\x1d\xff\xbb\xc9\x7c\x94\x41\xae\xb5\x8d\x85\xaa\x0f\x63\x17\x86\x44\xb6\xba\x05\xee\xea\xb5\x40\xab\x1a\xbc\x51\xb7\xaa\x96\x57\x3d\xcf\x36\xbb\x93\xc2\x07\xa1\x62\xa1\xa6\x2b\xb8\xc1\xcf\x74\x9d\x16\xa2\x4a\x7a\x77\x66\xd0\x12\x2f\x70\xe2\xf5\x16\x69\x5b\xe3\x71\x0f\x60\x99\xc5\xb6\xe3\x2e\x97\x46\x7f\x20\xa7\x8a\x85\x4a\x2b\xe3\x92\x77\x8f\x4a\xda\x2d\x8a\xd2\x0c\xb5\x41\xd3\x77\xf7\xce\x30\x8e\x21\xf8\x08\x03\x97\x3b\xc2\xe4\x91\x39\xdf\x44\xc4\x8c\x88\xf1\xd9\xf3\x60\xe1\xaa\x21\xbf\xcb\x68\x39\x31\xd8\x9a\x11\x84\x9a\xe8\x9d\x91\x36\xa3\x94\xf4\x56\x16\x2a\xd9\xc4\x6c\x9d\xf9\x7a\xea\xb1\xbd\x6b\xa2\xff\xec\xcc\x36\xdb\xfc\xfb\x0e\x2c\x33\xc6\xdd\x27\x76\x84\x46\xea\xdc\xd7\x71\x4d\x42\xef\xb1\x7a\x2b\x61\x76\xce\xb1\x33\xc8\xbd\x92\x45\x23\x05\x67\x2e\x84\x77\x3d\x00\x87\x12\x39\x69\xeb\x6f\x00\x12\xdf\x43\xbf\xd7\xec\x17\x08\x2b\x1c\x7c\x20\x3e\x00\x61\x62\x24\x23\xac\xb0\x6a\x69\x00\x68\x86\xb5\x19\xf8\x81\xd0\x00\x8b\xf0\xfd\x8f\x6b\x14\xfd\x6c\x43\xbd\x01\x8c\x15\xda\x0a\xca\x8f\xfd\x5a\x55\x8a\x97\x2c\x1d\xf0\x72\x5e\x02\x6e\x05\x09\xce\xe4\x1d\x00\x4f\x0b\x05\xbf\x94\xdc\xd2\x7d\x50\x2f\x80\x49\xa9\x6f\x5c\xb1\xba\x97\xde\x01\x69\xb0\xc8\xe2\xe2\xe8\x06\x23\x10\x31\x2a\x12\x94\x03\xe9\x39\x2a\xd0\x93\x62\x3b\x6f\x6d\xf4\x77\x16\x27\xae\x1a\x97\x9f\xf7\xf7\xdf\xff\x54\x1d\x7b\x7a\x1a\x37\x2a\xeb\x7f\x23\x24\xd6\x4a\x99\x76\x21\x48\xa1\xd2\xdb\x4a\x88\xb4\x44\x5b\x3c\x5d\x6a\x95\x08\x40\x1b\x7f\xaa\x6d\x08\x27\xb7\xc2\x91\xab\xae\x7c\x3b\x30\xa1\xd0\x36\x84\xef\x7b\xda\xc8\x74\x2a\xd4\xdd\x15\x80\x48\xd8\x14\x43\x60\x09\xfb\xa6\xd5\x70\x45\xa5\xaa\xf2\xda\x69\xcd\xaa\xff\x0d\x20\x08\x50\xc5\x46\x0b\x45\x87\x2f\x5f\x1d\x8f\x4f\xaf\x4f\xce\x46\xe7\x9f\x4f\xcf\x2e\x5f\x2f\x09\x4a\x3d\x25\xed\x28\x46\x5b\x37\x5a\x1a\xc9\x0e\xf7\x6b\x67\xa8\xb2\x36\x4e\x19\x41\x1d\xa0\x21\x00\x90\x31\x99\x62\x08\xa9\x12\xb7\xe1\x70\x38\xcc\x98\x1d\x4a\x11\x0d\xb9\x13\x43\xa7\xf9\x1c\xc9\x0d\xcb\xd8\x8d\xd5\xb7\xb9\x3f\x1f\xf8\xf3\x9a\x95\x92\xdd\x3f\xf9\xfe\x5b\x0a\xb3\x84\xf7\x0a\x48\x41\x2c\x9a\x01\x00\x24\x5e\xe9\x9c\xd1\x2c\x84\x8d\xc8\x35\x55\xa3\xed\x2a\xa8\x19\x32\x49\xb3\x6f\x8d\xbb\x5a\x75\xcf\xb5\xa5\x10\x7e\x39\x78\x7b\xd0\x92\x30\x56\x93\xe6\x5a\x86\x70\x79\x7c\x5e\xbb\x93\x22\x43\x85\xce\x9d\x5b\x1d\x55\xd3\xbe\xf8\x99\x11\x99\x8f\x48\x61\xdb\x52\x19\x4e\xb7\x23\xde\xf3\x6e\x27\x85\x12\x24\x98\x1c\xa1\x64\xf9\x18\xb9\x56\xb1\x0b\x61\xef\x6d\x43\x86\x44\x82\x3a\xa5\xbb\xeb\xf7\x8d\x5b\x83\x56\xe8\x78\x95\xee\x84\x09\x99\x5a\xbc\x9c\x59\x74\x33\x2d\xe3\x10\xf6\x7b\xed\xe4\xb5\x16\xd8\xe5\x6e\xff\x33\x65\xb9\xa7\xa7\xf9\x81\xf3\x0d\xd2\x92\x0f\xb3\xbd\xc1\xfb\x41\x1d\xb6\xbb\xf3\xbd\x1a\x8b\x63\x8b\xce\x1d\xbe\x7c\x75\x34\x1a\x5d\x9c\x8c\xc7\xaf\x37\xf4\x76\x79\x36\x41\x46\xa9\xc5\x60\xca\x08\xdd\xe1\xa5\x36\x5a\xea\x69\x7e\x48\x36\xc5\x25\x59\x54\x2c\x92\x18\x48\x64\x31\xda\xa0\xa0\x10\xa1\xd5\xf2\x78\x35\xef\x03\xca\x0d\x1e\x96\x6f\x1c\xdb\x8c\x56\xe5\x7e\xf7\x54\x3d\x87\x71\x5a\x58\xad\xaf\x41\x5b\x55\x7e\x21\xec\xcb\xfe\xee\x69\xcb\xde\xaa\x61\x77\xbd\x7f\x14\xb2\x2c\xe4\x82\xb2\xfc\x6b\x5e\x84\x1b\x4b\xb9\x10\x2f\xa4\x7d\x2d\xf7\x1e\x58\xcb\x61\xd5\x10\x4f\x14\x3f\x77\xa2\xba\xad\x5e\xb6\xc2\xde\x56\x76\x30\x31\x94\x8f\x84\x0d\xe1\xaf\xbf\x37\x6d\xb4\x0c\x13\xad\xc6\xb8\x6e\xa1\xf5\x7b\xc8\x13\xae\xb2\x0f\x5b\x5e\x2b\x67\xbe\xe7\xda\x5a\x83\x7c\xd4\xc2\xba\x7a\x01\xf5\x08\xed\xed\x73\xa6\x1d\x9d\x21\xdd\x68\x3b\x0f\xa1\x36\xf5\xff\x83\xad\x70\xe5\x62\x5d\x65\x29\x13\x12\xa7\x18\x37\xc2\x7e\x5e\xdb\xe4\xce\xbc\xe0\x5b\x4b\x6e\x4d\x8c\x95\x74\xa7\xa4\xd5\x86\x4d\x8b\x72\x87\xd0\xff\x20\x62\x61\xcb\xa7\x3c\x93\xfd\x4e\xe4\x72\xd5\xdd\x9a\x91\x9a\xca\x31\xfa\x2f\xcc\x36\x28\xc7\x98\xfd\xd8\x64\x9f\x7c\x93\xf5\xfc\x50\x8d\x5c\x60\x71\x2a\x1c\x59\x66\x97\xe7\xb4\x63\xab\xe9\xd4\xdc\xe5\xb1\xb8\x66\xc5\x59\xf4\xf7\xc2\xbe\xef\xc8\xc0\x67\xfb\xf0\xe5\xab\xd1\xc5\xe9\x97\x93\x8b\xeb\x8b\x93\x8f\xd7\xe3\xcf\xc7\xbf\x5d\x9f\x1f\x5d\xfe\xfa\x7a\xc3\xa4\x4a\x31\x41\x9e\x73\xd9\x2a\xab\xb1\x38\x26\x6d\x9a\x87\x00\x78\x8b\xbc\x7d\xe6\x1b\x2b\x49\x98\xff\x7a\xe7\x6b\x7f\x18\x09\x35\x74\xc5\x77\xea\x01\xf7\xff\xda\x04\x02\x3b\x81\x61\xdd\xdf\x21\x46\x6e\xe0\xa7\x9b\xdd\xb8\x01\xd7\x89\x4f\x73\xb1\x01\x40\x63\xee\xfb\x57\xdb\x30\xca\xfa\xdd\xab\x6e\xaf\x53\xbd\x2b\x67\x2b\x6c\xb5\x48\xa3\xda\xa4\x5c\x3b\x9a\xdd\x78\xeb\x51\xec\xd1\x68\x86\xf5\x26\xea\xa2\xbd\xb6\x9d\xe7\xb3\xfe\x3d\x30\x5d\x2b\xd7\xbf\xc5\x38\x35\x0d\xf9\xd5\xa1\xb0\xd1\xeb\xe0\xb4\x75\xcf\x0e\xff\xde\x10\xc2\xa8\x78\x54\x68\x9b\x2f\xc1\x75\xba\xfd\x10\xb4\x95\x4d\xb7\xce\x8d\xcf\xf6\xb8\xf8\x1b\xd6\x92\x3b\x6b\xfa\x66\x17\xa7\xae\x2b\x7b\xf9\x5a\x6f\x96\xbc\xe8\x7c\xf6\xad\xc5\x6f\x3e\x08\x97\x11\xfe\x19\x00\x82\xe9\x9d\xe2\x18\x20\x00\x00")
+
+func ebsCsiDriverYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_ebsCsiDriverYaml,
+		"ebs-csi-driver.yaml",
+	)
+}
+
+func ebsCsiDriverYaml() (*asset, error) {
+	bytes, err := ebsCsiDriverYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "ebs-csi-driver.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _efaDevicePluginYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x6f\xdb\x30\x0c\xbd\xfb\x57\x10\xb9\xcb\xee\xd7\x86\x41\xb7\xa2\xeb\xad\xeb\x8a\x05\xdb\x65\xd8\x81\x91\x99\x44\xb0\x2c\x0a\x22\x9d\x34\xfb\xf5\x83\x12\x27\x73\x0a\x14\x0d\xa4\x43\x42\xf2\xf9\xe9\xbd\x47\x63\x4c\x85\xc9\xff\xa2\x2c\x9e\xa3\x05\x4c\x49\x9a\xcd\x75\xd5\xf9\xd8\x5a\xf8\x8a\xd4\x73\x9c\x93\x56\x3d\x29\xb6\xa8\x68\x2b\x80\x88\x3d\x59\xc0\xad\x18\x5a\xa2\xe9\xbe\x88\x69\x69\xe3\x1d\x99\x14\x86\x95\x8f\xa6\xdd\xa3\x84\x74\x9c\x95\x84\x8e\x2c\x74\xc3\x82\x8c\xec\x44\xa9\xaf\x24\x91\x2b\x9f\x12\x0a\xe4\x94\x73\xf9\x0d\xd0\xa3\xba\xf5\x13\x2e\x28\xc8\xa1\xf0\x21\x57\x05\x30\xa4\x16\x95\xe6\x9a\x51\x69\xb5\x3b\xe0\x74\x97\xc8\xc2\x0f\x0e\xc1\xc7\xd5\xcf\xfd\x40\x05\xa0\xd4\xa7\x80\x4a\x23\xd9\x44\x51\xf9\x8f\x31\xb2\xa2\x7a\x8e\x27\x72\x00\x71\x6b\x6a\x87\x40\xb9\xc6\x90\xd6\x58\x17\x0d\x39\x92\x92\xd4\x9e\x1b\x97\xbd\x7a\x87\xc1\x24\x6e\x2d\xcc\x66\x23\x2c\x9c\x29\xb8\x44\x03\xc0\xd1\x90\x72\x94\x03\xe5\xb7\x2f\x31\xd0\xd1\xce\xc2\xc3\x48\x79\xdf\xb6\x1c\xe5\x7b\x0c\xbb\xd3\x04\x00\xa7\x82\xe3\x6c\xe1\xf1\xd5\x8b\xca\x04\xfc\x6e\x0b\x80\x96\x4b\x72\x6a\xe1\x99\xe7\xa3\xda\xb1\x99\xb2\xe7\xec\x75\xf7\x10\x50\xe4\x79\x1f\xfa\xec\x90\x9f\x89\xdc\x92\x39\xca\x3f\xea\x2e\xc5\xf9\x59\xa0\xe5\x2e\x48\xdf\xfa\xc6\x62\x21\xf8\x38\xbc\x9e\x86\x0e\xee\x52\x27\x4e\x43\x99\x28\x46\x51\xc4\x45\xa0\x62\xac\xe6\x81\x8e\x24\x6b\x16\x7d\x26\xdd\x72\xee\x2c\x94\xc6\x58\x77\x1c\x15\x7d\xa4\x7c\xe6\x99\xef\x71\x45\x16\x3e\x5f\xdd\xdc\x5d\x5d\x5f\xdf\xdd\xde\x7d\xba\xa9\xdb\x2e\xd7\xe4\x72\x3d\x88\xd9\x92\xa8\xb9\xa9\xb1\xc7\xbf\x1c\x71\x2b\xb5\xe3\xbe\xa1\x4e\x9a\x77\xc3\xb2\x9b\xab\xfa\xb6\xbe\x3d\x51\x5c\x96\xee\xb8\x4c\xe4\x86\xbd\x9f\x1c\x95\x5e\xf5\xff\x3b\xcb\xc1\x10\x78\xfb\x92\xfd\xc6\x07\x5a\xd1\xa3\x38\x0c\xfb\x15\xb0\xb0\xc4\x20\x47\x95\xa3\x56\x4c\xb8\xf0\xc1\xab\xa7\x89\xda\xc3\x6d\x33\x27\x0b\xbf\x67\xf7\x4f\x4f\xb3\x3f\x93\xde\x86\xc3\xd0\xd3\x37\x1e\xa2\xbe\xc1\x98\x51\xc2\x7b\xcf\x2e\xa7\x2f\xb8\x17\xd4\xb5\x85\x66\x83\xb9\x09\x7e\xd1\x94\x4c\x03\x69\x73\x86\x3b\x2e\xd6\x81\x6e\xc2\xf4\x11\x4b\xc9\x75\x4f\x30\xa9\x01\xa4\x8b\x28\xff\x0d\x00\x28\xfa\x6d\x76\xc5\x04\x00\x00")
+
+func efaDevicePluginYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_efaDevicePluginYaml,
+		"efa-device-plugin.yaml",
+	)
+}
+
+func efaDevicePluginYaml() (*asset, error) {
+	bytes, err := efaDevicePluginYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "efa-device-plugin.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _nvidiaDevicePluginYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x31\x6f\xdb\x3c\x10\xdd\xf5\x2b\x0e\xde\x69\x25\xc0\x37\x7c\xe0\x16\xa4\xd9\xd2\x34\xa8\xd1\x2e\x45\x87\x33\x79\xb1\x0f\xa6\x78\x04\x79\x72\xad\x7f\x5f\xd0\x92\x5d\xc9\x43\x12\x90\x83\x74\x7c\x8f\xef\xee\x3d\x1a\x63\x1a\x4c\xfc\x93\x72\x61\x89\x16\x30\xa5\xd2\x1e\xef\x9b\x03\x47\x6f\xe1\x0b\x52\x27\x71\x43\xda\x74\xa4\xe8\x51\xd1\x36\x00\x11\x3b\xb2\x10\x8f\xec\x19\x8d\xa7\x23\x3b\x32\x29\xf4\x3b\x8e\xc6\x9f\x09\x85\x74\x82\x95\x84\x8e\x2c\x1c\xfa\x2d\x99\x32\x14\xa5\xae\x29\x89\x5c\xbd\xa5\x50\x20\xa7\x92\xeb\x37\x40\x87\xea\xf6\xcf\xb8\xa5\x50\xc6\xc2\xfb\x32\xa5\x01\xe8\x93\x47\xa5\x8d\x66\x54\xda\x0d\x23\x4b\x87\x44\x16\xbe\x4b\x08\x1c\x77\x3f\xce\x80\x06\x40\xa9\x4b\x01\x95\x26\xa9\xd9\x28\xf5\x1f\x63\x14\x45\x65\x89\x57\x69\x80\xe2\xf6\xe4\xfb\x40\x79\x8d\x21\xed\x71\x5d\x27\xc8\x91\x94\xca\x9a\xa5\x75\x99\x95\x1d\x06\x93\xc4\x5b\x58\xad\x26\x5a\x58\xf4\xff\xf1\x04\x00\x17\x33\xea\x52\x09\x94\x6f\xfb\x30\x70\xa0\xc1\xc2\xe3\x24\xf8\xe0\xbd\xc4\xf2\x2d\x86\xe1\x8a\x00\x90\x54\x79\x92\x2d\x3c\x9d\xb8\x68\xb9\x25\x8f\x0d\xac\x9d\x74\xed\x2e\xf5\x9f\x21\x02\xd0\xdb\x1b\x39\xb5\xf0\x22\x9b\xc9\x89\xe9\x30\x65\x96\xcc\x3a\x3c\x06\x2c\xe5\xe5\xfc\x12\x56\x63\xb2\x26\x8a\x27\x73\xb1\xe6\xe2\x49\x2d\x6e\x16\x51\xd7\xbd\x25\xbd\xf5\x54\x8a\x85\xc0\xb1\x3f\x4d\x20\x27\x51\x91\x23\xe5\x85\x1b\xdc\xe1\xee\xea\x69\x7b\xf8\xbf\x2c\x7d\xb5\xf7\xeb\xbb\xf5\x9d\xa9\xd7\xff\x77\x65\xbd\x1b\x84\xd3\x3c\x03\x16\x72\xfd\x79\x3a\x89\x4a\x27\xfd\xa7\x5c\x17\x86\x20\x7f\x5e\x33\x1f\x39\xd0\x8e\x9e\x8a\xc3\x70\x8e\xcb\xc2\x1b\x86\x42\x0b\xac\xc3\x84\x5b\x0e\xac\x4c\xb3\xfe\xc7\xed\xb3\x24\x0b\xbf\x56\x0f\xcf\xcf\xab\xdf\xb3\xb3\xa3\x84\xbe\xa3\xaf\xd2\x47\xbd\xe1\x98\x69\x82\x45\xeb\x0b\x04\x40\x57\x79\xaf\xa8\x7b\x0b\xed\x11\x73\x1b\x78\xdb\x56\x87\x03\x69\xbb\xe0\x5d\x62\x1e\xe5\x66\x4a\x1f\xa9\xec\xa5\x8c\x02\xb3\x1a\x40\xfa\x94\xe4\xdf\x01\x00\x2f\xcd\xff\x77\x68\x04\x00\x00")
+
+func nvidiaDevicePluginYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_nvidiaDevicePluginYaml,
+		"nvidia-device-plugin.yaml",
+	)
+}
+
+func nvidiaDevicePluginYaml() (*asset, error) {
+	bytes, err := nvidiaDevicePluginYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "nvidia-device-plugin.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vpcAdmissionWebhookYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x55\x4d\x8f\xdb\x36\x10\xbd\xfb\x57\x0c\x7c\xe8\x8d\xd2\xda\xeb\x06\x05\x81\x2d\x10\x6c\xd3\xa2\x68\x93\x35\xb2\x45\x7b\x28\x7a\x18\x53\xb3\x32\x21\x8a\x24\xc8\x91\x5c\xf5\xd7\x17\xa2\xa4\x58\xf6\xda\xde\xdc\x02\x1a\x36\x34\xf3\xde\x13\xdf\xe3\x87\x85\x10\x0b\xf4\xfa\x4f\x0a\x51\x3b\x2b\xa1\x5d\x2d\x2a\x6d\x0b\x09\xcf\x14\x5a\xad\x68\x51\x13\x63\x81\x8c\x72\x01\x60\xb1\x26\x09\xad\x57\x02\x8b\x5a\xc7\x9e\x21\x0e\xb4\xdb\x3b\x57\x8d\xdd\xe8\x51\x91\x84\xaa\xd9\x91\x88\x5d\x64\xaa\x17\x00\x06\x77\x64\x62\x2f\x00\x80\xde\x5f\x53\x88\x9e\x54\x0f\xf2\x2e\xf0\x88\x16\xe9\x41\xc2\x66\x73\x9f\x9e\x01\x18\x43\x49\xbc\x9d\x55\x23\x19\x52\xec\xc2\x9b\xfa\xe7\x56\xd1\xfb\x98\x7f\xf1\xfb\x13\x79\xe3\xba\x9a\x2c\x7f\x03\xcb\x81\xbc\xd1\x0a\xa3\x84\x55\xef\x88\x03\x32\x95\xdd\x40\xe7\xce\x93\x84\xcf\xa4\x02\x21\xd3\x2b\xc3\x35\xb2\xda\xff\x3e\x7b\xdd\xcd\x17\x02\x30\xd5\xde\x20\xd3\xc8\x9e\x59\x05\x38\x9d\xf7\x9b\x52\x00\xd3\xfc\xfb\xa1\x9c\x65\xd4\x96\xc2\x8c\x2e\xde\xc8\x6f\x1a\x18\xca\x19\xab\xff\x08\x10\x6c\xe2\x23\x05\xfe\x59\x1b\x7a\xc8\x89\x55\x3e\xf2\x72\x45\x81\x63\xfa\xce\x7c\xda\x60\xc7\x31\xd0\x7e\xa3\xee\x1a\xab\xa2\xee\x12\xe9\xe9\x39\x45\xf8\x3c\x46\xfb\xd4\x52\x08\xba\xa0\x87\x83\xb6\x85\x3b\xc4\x73\x38\x9a\xe8\x8c\x2b\xd9\x45\x2e\x28\x84\xf3\x76\xfb\xb0\x39\x2b\xad\x7f\xfc\x6e\x35\x2b\xe9\x1a\x4b\x92\xf0\xee\x6e\xbd\xb9\x5b\xad\x36\xf7\x9b\xef\xd7\x59\x51\x85\x8c\x54\xc8\x9a\x28\x0e\x14\x59\xac\x33\xac\xf1\x3f\x67\xf1\x10\x33\xe5\xea\x9c\xaa\x98\x5f\xcc\x51\xb6\x77\xd9\x3a\xbb\x3f\x97\xdf\x36\xc6\x6c\x9d\xd1\xaa\x93\xf0\xde\x1c\xb0\x9b\xbb\x68\x9d\x69\x6a\xfa\xe8\x1a\x3b\x1d\xb5\xf3\x25\x1b\xc5\x45\x8a\xed\x04\x01\x50\xf7\xbc\x2d\xf2\x5e\xc2\xeb\x88\xcf\xb0\x81\xb0\x78\xb2\xa6\x93\xc0\xa1\xa1\xb1\xb9\x77\x91\x3f\x11\x1f\x5c\xa8\x4e\xea\xd6\x15\x34\xad\xc1\x71\x5a\x3b\x62\xcc\xfa\xf3\x15\x2c\x31\xc5\x4c\xbb\xdc\x45\x09\x46\xdb\xe6\xdf\x5b\x20\x0c\x6a\x2f\x01\xeb\xe2\xdd\xb4\x1c\x83\xed\x0b\x1b\xf4\x9a\xdb\xd8\x1f\x3b\x3e\xe2\x8f\xb5\x4f\xd7\x37\xf6\xa8\xf2\xea\xbe\x99\x70\x81\x4a\x9d\xce\xb8\x76\x36\xab\x7e\x48\x86\xda\x55\xef\x72\xba\x8c\x3e\x36\x8c\xac\x6d\xf9\xd7\x20\xf8\xe8\xec\x8b\x2e\x9b\x81\xf1\xb5\xd7\x93\x50\x2f\xe5\xd7\xdf\x44\xe3\x6f\x02\x8a\x5b\xb2\xa7\xbb\x32\xc9\x2a\xa3\xc9\xf2\x30\xc9\x29\xaa\x38\xfc\x7d\x1c\x93\xbb\x21\x79\x82\xb9\x78\x9d\xa6\x2e\xf8\xb4\xe3\x96\x79\xdd\xa7\x43\xcb\x54\x0f\x8d\x39\xae\xa7\x00\xe7\x69\x48\x29\x4a\xf8\x7b\xf9\xf8\xf9\xc3\xfb\x3f\x3e\x2c\xff\xf9\xa2\x80\x5e\xff\x12\x5c\xe3\x53\xf7\xb4\x3e\x2e\x53\xea\xb4\xab\x59\x2f\x50\x74\x4d\x50\x94\x3a\xde\x15\x71\xec\xbd\xa0\x36\x4d\xa0\xe9\x88\xfd\x5a\x5a\x17\x68\xf1\xff\x00\x6a\x72\x0f\xde\x50\x07\x00\x00")
+
+func vpcAdmissionWebhookYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_vpcAdmissionWebhookYaml,
+		"vpc-admission-webhook.yaml",
+	)
+}
+
+func vpcAdmissionWebhookYaml() (*asset, error) {
+	bytes, err := vpcAdmissionWebhookYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vpc-admission-webhook.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+var _vpcResourceControllerYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xbc\x96\xcd\x6e\x23\x37\x0c\xc7\xef\x7e\x0a\x62\xef\x33\xfe\x48\x36\x69\x05\xf4\xb0\xdd\x05\xb6\x87\xa2\x08\x36\x41\xef\xb4\xc4\x78\x54\x6b\x24\x81\xa4\xec\x75\x9e\xbe\x90\x93\x49\xc6\xce\x87\xb3\x6d\x51\x6b\x0e\x43\xfd\x29\xfd\x48\x8a\xb2\xdd\x34\xcd\x04\xb3\xff\x93\x58\x7c\x8a\x06\x78\x89\xb6\xc5\xa2\x5d\x62\x7f\x87\xea\x53\x6c\xd7\x3f\x49\xeb\xd3\x74\x33\x9f\xac\x7d\x74\x06\x3e\x87\x22\x4a\xfc\x2d\x05\x9a\xf4\xa4\xe8\x50\xd1\x4c\x00\x22\xf6\x64\x60\x93\x6d\xc3\x24\xa9\xb0\xa5\xc6\xa6\xa8\x9c\x42\x20\x9e\x70\x09\x24\xd5\xad\x01\xcc\xfe\x2b\xa7\x92\xf7\x26\x40\x9d\xfa\xf0\x61\xff\x3a\x2c\x1c\x29\x31\x39\x92\xbd\xb5\x21\x5e\x8e\x84\x15\xe9\xe3\x7b\xf0\xf2\x64\x6c\x51\x6d\xf7\x0f\x41\x53\x51\xd4\xf2\x22\x2f\x3f\x6c\x5b\xcd\x06\x4a\x76\xa8\xf4\xe3\x94\x9c\xdc\xff\x92\x8d\x4d\xf1\xd6\xaf\x7a\xcc\x2f\xd2\x2c\xd3\x7d\xf4\x75\x34\xe0\x28\x90\xd2\xe9\x58\x5e\x29\xc1\x38\xd0\x7f\xd7\x4f\xbf\xfa\xe8\x7c\x5c\xfd\x48\x5b\xa5\x40\xdf\xe8\xb6\x16\x78\xa8\xcf\x1b\xd0\x09\xc0\xf3\x1e\x3e\x85\x90\xb2\xfc\x8b\xac\x3e\x34\xef\xfd\xfa\x6b\xe2\x8d\xb7\xf4\xc9\xda\x54\xe2\x7d\x81\xde\xde\x64\xf0\x90\x8c\x96\x0c\xac\xcb\x92\x1a\xd9\x89\x52\xff\xac\x64\x8f\x85\x39\x82\xbc\xbf\x28\xef\x46\x61\xce\xf2\x74\x10\x5f\x28\x87\xb4\xeb\xe9\xbf\x61\x49\x26\x5b\x43\x65\xca\xc1\x5b\x14\x03\xf3\x09\x80\x50\x20\xab\x89\xab\x02\xd0\xd7\x86\xfa\x1d\x97\x14\x1e\x9b\x13\x73\x7e\x0b\x56\x87\x7a\x62\x03\x4b\xb4\x6b\x8a\x6e\x98\x63\xb4\x6b\x03\xa2\xb8\xdc\x1f\xa9\x52\x9f\x03\x2a\x3d\x70\x46\xe9\x54\x3b\x1c\x20\xdf\x05\x7d\x19\xfb\x1c\x0c\x30\x24\x5e\x87\x1c\x1c\xe1\x29\x46\x85\xa2\x8f\xc4\xa3\xd0\x1a\xb0\xa9\xef\x31\xba\xa7\xa9\x3a\x1a\x98\x9e\x8a\x17\x00\x79\x35\xda\xa9\x3e\x0d\x34\xa2\x8e\x98\xb5\x63\x92\x2e\x05\xf7\x8b\x8f\xb7\x69\xe4\xe3\x7b\x5c\x91\x81\x8b\xd9\xe2\x7c\x36\x9f\x9f\x9f\x9d\x7f\x5c\xb4\x6e\xcd\x2d\x59\x6e\x8b\x34\x5b\x12\x6d\x16\x2d\xf6\x78\x97\x22\x6e\xa5\xb5\xa9\x9f\xd2\x5a\xa6\x5b\x1f\x5d\xda\x4a\xf3\x4a\x54\x66\x33\x6b\x17\xed\xd9\x31\xe8\xaa\x84\x70\x95\x82\xb7\x3b\x03\x9f\xc2\x16\x77\x32\xf2\x08\x7e\x43\x91\x44\xae\x38\x2d\x1f\x0e\x72\x18\xb7\xe8\x43\x61\xba\x19\xb2\x30\xf0\xf1\x40\xef\x54\xf3\x57\xd2\xc3\x45\x00\x5d\x12\x35\x30\x5f\x5c\xb6\xb3\x76\xd6\xce\x8f\xd4\x8c\xda\x19\x98\x76\x84\x41\xbb\xbb\x63\x31\xb1\x1a\xb8\x98\x5f\x5e\xfe\x7c\xa4\x88\xed\xa8\xde\xc6\xdf\x6e\x6e\xae\x0e\x24\x1f\xbd\x7a\x0c\x5f\x28\xe0\xee\x9a\x6c\x8a\x4e\x0c\x9c\xcd\x0e\x7c\x32\xb1\x4f\xee\x35\x55\x7d\x4f\xa9\xe8\xa3\x3c\x4e\xf2\xd4\xb5\x1c\x3e\x42\xb6\xb0\xd7\xdd\xe7\x14\x95\xbe\x1f\x95\x24\xb3\xdf\xf8\x40\x2b\x72\x06\x94\xcb\xf0\x6d\x5e\xeb\xf4\x07\xe9\x36\xf1\xfa\x60\xbe\xfe\x46\x5e\x1f\xdc\xe0\xfa\x2c\x49\xb1\xad\x57\x9f\x23\x29\xed\xff\x2c\x24\x31\x10\x7c\x2c\xdf\xdf\x72\x42\xb6\x9d\x01\xec\xdd\xc5\xf9\xe4\xef\x01\x00\xcc\x49\x95\xf2\x88\x08\x00\x00")
+
+func vpcResourceControllerYamlBytes() ([]byte, error) {
+	return bindataRead(
+		_vpcResourceControllerYaml,
+		"vpc-resource-controller.yaml",
+	)
+}
+
+func vpcResourceControllerYaml() (*asset, error) {
+	bytes, err := vpcResourceControllerYamlBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "vpc-resource-controller.yaml", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[cannonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"cluster-autoscaler.yaml": clusterAutoscalerYaml,
+	"ebs-csi-driver.yaml": ebsCsiDriverYaml,
+	"efa-device-plugin.yaml": efaDevicePluginYaml,
+	"nvidia-device-plugin.yaml": nvidiaDevicePluginYaml,
+	"vpc-admission-webhook.yaml": vpcAdmissionWebhookYaml,
+	"vpc-resource-controller.yaml": vpcResourceControllerYaml,
+}
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"}
+// AssetDir("data/img") would return []string{"a.png", "b.png"}
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		cannonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(cannonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+var _bintree = &bintree{nil, map[string]*bintree{
+	"cluster-autoscaler.yaml": &bintree{clusterAutoscalerYaml, map[string]*bintree{}},
+	"ebs-csi-driver.yaml": &bintree{ebsCsiDriverYaml, map[string]*bintree{}},
+	"efa-device-plugin.yaml": &bintree{efaDevicePluginYaml, map[string]*bintree{}},
+	"nvidia-device-plugin.yaml": &bintree{nvidiaDevicePluginYaml, map[string]*bintree{}},
+	"vpc-admission-webhook.yaml": &bintree{vpcAdmissionWebhookYaml, map[string]*bintree{}},
+	"vpc-resource-controller.yaml": &bintree{vpcResourceControllerYaml, map[string]*bintree{}},
+}}
+
+// RestoreAsset restores an asset under the given directory
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	err = os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+	if err != nil {
+		return err
+	}
+	return nil
+}
+
+// RestoreAssets restores an asset under the given directory recursively
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	cannonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(cannonicalName, "/")...)...)
+}
+
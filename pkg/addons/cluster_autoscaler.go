@@ -0,0 +1,108 @@
+package addons
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	clusterAutoscalerName  = api.ClusterAutoscalerAddon
+	clusterAutoscalerImage = "k8s.gcr.io/cluster-autoscaler"
+)
+
+// clusterAutoscalerVersions pins a release of cluster-autoscaler for each version
+// of Kubernetes, as the autoscaler has to match the minor version of the control plane
+var clusterAutoscalerVersions = map[string]string{
+	api.Version1_12: "v1.12.8",
+	api.Version1_13: "v1.13.8",
+	api.Version1_14: "v1.14.6",
+}
+
+// ClusterAutoscalerServiceAccount returns the iamserviceaccount of cluster-autoscaler,
+// with a policy that allows it to manage auto scaling groups of the cluster
+func ClusterAutoscalerServiceAccount() *api.ClusterIAMServiceAccount {
+	return &api.ClusterIAMServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterAutoscalerName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		AttachPolicy: api.InlineDocument{
+			"Version": "2012-10-17",
+			"Statement": []interface{}{
+				map[string]interface{}{
+					"Effect":   "Allow",
+					"Resource": "*",
+					"Action": []string{
+						"autoscaling:DescribeAutoScalingGroups",
+						"autoscaling:DescribeAutoScalingInstances",
+						"autoscaling:DescribeLaunchConfigurations",
+						"autoscaling:DescribeTags",
+						"autoscaling:SetDesiredCapacity",
+						"autoscaling:TerminateInstanceInAutoScalingGroup",
+						"ec2:DescribeLaunchTemplateVersions",
+					},
+				},
+			},
+		},
+	}
+}
+
+// ClusterAutoscaler deploys cluster-autoscaler, configured to discover
+// auto scaling groups of the cluster by their tags
+type ClusterAutoscaler struct {
+	rawClient           kubernetes.RawClientInterface
+	spec                *api.ClusterConfig
+	controlPlaneVersion string
+	planMode            bool
+}
+
+// NewClusterAutoscaler creates a new ClusterAutoscaler
+func NewClusterAutoscaler(rawClient kubernetes.RawClientInterface, spec *api.ClusterConfig, controlPlaneVersion string, planMode bool) *ClusterAutoscaler {
+	return &ClusterAutoscaler{
+		rawClient:           rawClient,
+		spec:                spec,
+		controlPlaneVersion: controlPlaneVersion,
+		planMode:            planMode,
+	}
+}
+
+// Deploy deploys cluster-autoscaler to the cluster, its service account
+// is expected to be created beforehand
+func (c *ClusterAutoscaler) Deploy() error {
+	version, ok := clusterAutoscalerVersions[c.controlPlaneVersion]
+	if !ok {
+		return fmt.Errorf("no version of %s is known to support Kubernetes %s", clusterAutoscalerName, c.controlPlaneVersion)
+	}
+
+	list, err := loadAsset(clusterAutoscalerName)
+	if err != nil {
+		return err
+	}
+	for _, rawObj := range list.Items {
+		if d, ok := rawObj.Object.(*appsv1.Deployment); ok {
+			c.configure(&d.Spec.Template.Spec.Containers[0], version)
+		}
+	}
+	if err := applyResources(c.rawClient, list.Items, c.planMode); err != nil {
+		return errors.Wrapf(err, "deploying %q", clusterAutoscalerName)
+	}
+	return nil
+}
+
+func (c *ClusterAutoscaler) configure(container *corev1.Container, version string) {
+	container.Image = clusterAutoscalerImage + ":" + version
+	container.Command = append(container.Command,
+		fmt.Sprintf("--node-group-auto-discovery=asg:tag=%s,%s", api.ClusterAutoscalerEnabledTag, api.ClusterAutoscalerClusterTag(c.spec.Metadata.Name)),
+	)
+	container.Env = append(container.Env, corev1.EnvVar{
+		Name:  "AWS_REGION",
+		Value: c.spec.Metadata.Region,
+	})
+}
@@ -0,0 +1,56 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("cluster-autoscaler", func() {
+	var (
+		rawClient *testutils.FakeRawClient
+		cfg       *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+	})
+
+	It("deploys the version matching the control plane and discovers auto scaling groups of the cluster", func() {
+		err := NewClusterAutoscaler(rawClient, cfg, "1.14", false).Deploy()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(rawClient.Collection.Created()).To(HaveLen(5))
+
+		d, err := rawClient.ClientSet().AppsV1().Deployments(metav1.NamespaceSystem).Get("cluster-autoscaler", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+
+		container := d.Spec.Template.Spec.Containers[0]
+		Expect(container.Image).To(Equal("k8s.gcr.io/cluster-autoscaler:v1.14.6"))
+		Expect(container.Command).To(ContainElement("--node-group-auto-discovery=asg:tag=k8s.io/cluster-autoscaler/enabled,k8s.io/cluster-autoscaler/test-cluster"))
+		Expect(container.Env[0].Name).To(Equal("AWS_REGION"))
+		Expect(container.Env[0].Value).To(Equal("us-west-2"))
+	})
+
+	It("fails for an unknown version of the control plane", func() {
+		err := NewClusterAutoscaler(rawClient, cfg, "1.99", false).Deploy()
+		Expect(err).To(MatchError("no version of cluster-autoscaler is known to support Kubernetes 1.99"))
+		Expect(rawClient.Collection.Created()).To(BeEmpty())
+	})
+
+	It("uses the service account in kube-system", func() {
+		sa := ClusterAutoscalerServiceAccount()
+		Expect(sa.NameString()).To(Equal("kube-system/cluster-autoscaler"))
+		Expect(sa.AttachPolicy).To(HaveKey("Statement"))
+	})
+})
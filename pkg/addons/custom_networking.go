@@ -0,0 +1,149 @@
+package addons
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	awsNodeName = "aws-node"
+
+	customNetworkingEnvVar = "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG"
+	eniConfigLabelEnvVar   = "ENI_CONFIG_LABEL_DEF"
+
+	// ENIConfigs are named after AZs, so that VPC CNI picks the one matching the zone label of each node
+	zoneLabel = "failure-domain.beta.kubernetes.io/zone"
+)
+
+// ENIConfigResource is the custom resource of VPC CNI that defines the subnet
+// and security groups of secondary network interfaces used by pods
+var ENIConfigResource = schema.GroupVersionResource{
+	Group:    "crd.k8s.amazonaws.com",
+	Version:  "v1alpha1",
+	Resource: "eniconfigs",
+}
+
+// CustomNetworking configures VPC CNI to give pods IPs from dedicated subnets
+type CustomNetworking struct {
+	clientSet     kubernetes.Interface
+	dynamicClient dynamic.Interface
+}
+
+// NewCustomNetworking creates a new CustomNetworking
+func NewCustomNetworking(clientSet kubernetes.Interface, dynamicClient dynamic.Interface) *CustomNetworking {
+	return &CustomNetworking{
+		clientSet:     clientSet,
+		dynamicClient: dynamicClient,
+	}
+}
+
+// Deploy creates an ENIConfig for every pod subnet and enables custom networking on aws-node,
+// nodes that are already running only use the pod subnets once they are replaced
+func (n *CustomNetworking) Deploy(cfg *api.ClusterConfig) error {
+	if !cfg.HasPodSubnets() {
+		return nil
+	}
+	securityGroups := cfg.VPC.PodSubnets.SecurityGroups
+	if len(securityGroups) == 0 && cfg.VPC.SharedNodeSecurityGroup != "" {
+		securityGroups = []string{cfg.VPC.SharedNodeSecurityGroup}
+	}
+
+	for az, subnet := range cfg.VPC.PodSubnets.Subnets {
+		if subnet.ID == "" {
+			return fmt.Errorf("ID of pod subnet in %s is unknown", az)
+		}
+		if err := n.createOrUpdateENIConfig(az, subnet.ID, securityGroups); err != nil {
+			return errors.Wrapf(err, "creating ENIConfig for %s", az)
+		}
+	}
+	return errors.Wrapf(n.enableOnAWSNode(), "updating %q daemonset", awsNodeName)
+}
+
+func (n *CustomNetworking) createOrUpdateENIConfig(az, subnetID string, securityGroups []string) error {
+	eniConfig := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": ENIConfigResource.GroupVersion().String(),
+			"kind":       "ENIConfig",
+			"metadata": map[string]interface{}{
+				"name": az,
+			},
+			"spec": map[string]interface{}{
+				"subnet":         subnetID,
+				"securityGroups": toInterfaceSlice(securityGroups),
+			},
+		},
+	}
+
+	client := n.dynamicClient.Resource(ENIConfigResource)
+	existing, err := client.Get(az, metav1.GetOptions{})
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			return err
+		}
+		if _, err := client.Create(eniConfig, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		logger.Info("created ENIConfig %q with subnet %q", az, subnetID)
+		return nil
+	}
+
+	eniConfig.SetResourceVersion(existing.GetResourceVersion())
+	if _, err := client.Update(eniConfig, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+	logger.Info("updated ENIConfig %q with subnet %q", az, subnetID)
+	return nil
+}
+
+func (n *CustomNetworking) enableOnAWSNode() error {
+	daemonSets := n.clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem)
+	awsNode, err := daemonSets.Get(awsNodeName, metav1.GetOptions{})
+	if err != nil {
+		return err
+	}
+
+	for i := range awsNode.Spec.Template.Spec.Containers {
+		container := &awsNode.Spec.Template.Spec.Containers[i]
+		if container.Name != awsNodeName {
+			continue
+		}
+		container.Env = setEnvVar(container.Env, customNetworkingEnvVar, "true")
+		container.Env = setEnvVar(container.Env, eniConfigLabelEnvVar, zoneLabel)
+	}
+
+	if _, err := daemonSets.Update(awsNode); err != nil {
+		return err
+	}
+	logger.Info("enabled custom networking on %q", awsNodeName)
+	return nil
+}
+
+func setEnvVar(env []corev1.EnvVar, name, value string) []corev1.EnvVar {
+	for i := range env {
+		if env[i].Name == name {
+			env[i].Value = value
+			env[i].ValueFrom = nil
+			return env
+		}
+	}
+	return append(env, corev1.EnvVar{Name: name, Value: value})
+}
+
+func toInterfaceSlice(values []string) []interface{} {
+	result := make([]interface{}, len(values))
+	for i, v := range values {
+		result[i] = v
+	}
+	return result
+}
@@ -0,0 +1,68 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("Custom networking", func() {
+	It("creates ENIConfigs for pod subnets and enables custom networking on aws-node", func() {
+		clientSet := fake.NewSimpleClientset(&appsv1.DaemonSet{
+			ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: metav1.NamespaceSystem},
+			Spec: appsv1.DaemonSetSpec{
+				Template: corev1.PodTemplateSpec{
+					Spec: corev1.PodSpec{
+						Containers: []corev1.Container{{
+							Name: "aws-node",
+							Env:  []corev1.EnvVar{{Name: "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG", Value: "false"}},
+						}},
+					},
+				},
+			},
+		})
+		dynamicClient := dynamicfake.NewSimpleDynamicClient(runtime.NewScheme())
+
+		cfg := api.NewClusterConfig()
+		cfg.VPC.SharedNodeSecurityGroup = "sg-shared"
+		cfg.VPC.PodSubnets = &api.ClusterPodSubnets{
+			Subnets: map[string]api.Network{
+				"us-west-2a": {ID: "subnet-a"},
+				"us-west-2b": {ID: "subnet-b"},
+			},
+		}
+
+		Expect(NewCustomNetworking(clientSet, dynamicClient).Deploy(cfg)).To(Succeed())
+
+		eniConfig, err := dynamicClient.Resource(ENIConfigResource).Get("us-west-2a", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		subnet, _, _ := unstructured.NestedString(eniConfig.Object, "spec", "subnet")
+		Expect(subnet).To(Equal("subnet-a"))
+		securityGroups, _, _ := unstructured.NestedSlice(eniConfig.Object, "spec", "securityGroups")
+		Expect(securityGroups).To(Equal([]interface{}{"sg-shared"}))
+
+		awsNode, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get("aws-node", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(awsNode.Spec.Template.Spec.Containers[0].Env).To(ConsistOf(
+			corev1.EnvVar{Name: "AWS_VPC_K8S_CNI_CUSTOM_NETWORK_CFG", Value: "true"},
+			corev1.EnvVar{Name: "ENI_CONFIG_LABEL_DEF", Value: "failure-domain.beta.kubernetes.io/zone"},
+		))
+
+		cfg.VPC.PodSubnets.SecurityGroups = []string{"sg-pods"}
+		Expect(NewCustomNetworking(clientSet, dynamicClient).Deploy(cfg)).To(Succeed())
+		eniConfig, err = dynamicClient.Resource(ENIConfigResource).Get("us-west-2b", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		securityGroups, _, _ = unstructured.NestedSlice(eniConfig.Object, "spec", "securityGroups")
+		Expect(securityGroups).To(Equal([]interface{}{"sg-pods"}))
+	})
+})
@@ -4,9 +4,9 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	appsv1 "k8s.io/api/apps/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -18,8 +18,7 @@ const (
 	// AWSNode is the name of the aws-node addon
 	AWSNode = "aws-node"
 
-	awsNodeImagePrefixPTN = "%s.dkr.ecr."
-	awsNodeImageSuffix    = ".amazonaws.com/amazon-k8s-cni"
+	awsNodeImageSuffix = "/amazon-k8s-cni"
 )
 
 // UpdateAWSNode will update the `aws-node` add-on
@@ -51,9 +50,8 @@ func UpdateAWSNode(rawClient kubernetes.RawClientInterface, region, controlPlane
 			if len(imageParts) != 2 {
 				return false, fmt.Errorf("unexpected image format %q for %q", *image, AWSNode)
 			}
-			awsNodeImagePrefix := fmt.Sprintf(awsNodeImagePrefixPTN, api.EKSResourceAccountID(region))
 			if strings.HasSuffix(imageParts[0], awsNodeImageSuffix) {
-				*image = awsNodeImagePrefix + region + awsNodeImageSuffix + ":" + imageParts[1]
+				*image = api.EKSResourceRegistry(region) + awsNodeImageSuffix + ":" + imageParts[1]
 			}
 		}
 
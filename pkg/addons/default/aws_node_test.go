@@ -88,5 +88,21 @@ var _ = Describe("default addons - aws-node", func() {
 				Equal("602401143452.dkr.ecr.us-east-1.amazonaws.com/amazon-k8s-cni:v1.5.0"),
 			)
 		})
+
+		It("can update 1.12 sample for a region in China", func() {
+			rawClient.ClientSetUseUpdatedObjects = false // must be set for subsequent UpdateAWSNode
+
+			_, err := UpdateAWSNode(rawClient, "cn-north-1", api.DefaultVersion, false)
+			Expect(err).ToNot(HaveOccurred())
+
+			rawClient.ClientSetUseUpdatedObjects = true // for verification of updated objects
+
+			awsNode, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get(AWSNode, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(awsNode.Spec.Template.Spec.Containers).To(HaveLen(1))
+			Expect(awsNode.Spec.Template.Spec.Containers[0].Image).To(
+				Equal("918309763551.dkr.ecr.cn-north-1.amazonaws.com.cn/amazon-k8s-cni:v1.5.0"),
+			)
+		})
 	})
 })
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
@@ -13,6 +12,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -21,8 +21,7 @@ const (
 	// KubeDNS is the name of the kube-dns addon
 	KubeDNS = "kube-dns"
 
-	coreDNSImagePrefixPTN = "%s.dkr.ecr."
-	coreDNSImageSuffix    = ".amazonaws.com/eks/coredns"
+	coreDNSImageSuffix = "/eks/coredns"
 )
 
 // UpdateCoreDNS will update the `coredns` add-on
@@ -65,9 +64,8 @@ func UpdateCoreDNS(rawClient kubernetes.RawClientInterface, region, controlPlane
 				return false, fmt.Errorf("unexpected image format %q for %q", *image, KubeProxy)
 			}
 
-			coreDNSImagePrefix := fmt.Sprintf(coreDNSImagePrefixPTN, api.EKSResourceAccountID(region))
 			if strings.HasSuffix(imageParts[0], coreDNSImageSuffix) {
-				*image = coreDNSImagePrefix + region + coreDNSImageSuffix + ":" + imageParts[1]
+				*image = api.EKSResourceRegistry(region) + coreDNSImageSuffix + ":" + imageParts[1]
 			}
 		case "Service":
 			resource.Info.Object.(*corev1.Service).SetResourceVersion(kubeDNSSevice.GetResourceVersion())
@@ -0,0 +1,110 @@
+package defaultaddons
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+// Version is the version of a default addon that runs in a cluster, along with the version
+// that `eksctl utils update-<addon>` updates it to for the Kubernetes version of the cluster
+type Version struct {
+	Name    string
+	Current string
+	Default string
+}
+
+// UpdateAvailable returns true when the addon doesn't run the default version
+func (v *Version) UpdateAvailable() bool {
+	return v.Current != v.Default
+}
+
+// GetVersions returns the versions of aws-node, kube-proxy and coredns that run in the cluster,
+// the addons that aren't found are left out
+func GetVersions(clientSet kubernetes.Interface, controlPlaneVersion string) ([]*Version, error) {
+	versions := []*Version{}
+
+	if current, err := daemonSetImageTag(clientSet, AWSNode); err != nil {
+		return nil, err
+	} else if current != "" {
+		list, err := LoadAsset(AWSNode, "yaml")
+		if err != nil {
+			return nil, err
+		}
+		defaultVersion, err := assetImageTag(list)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting version of %q", AWSNode)
+		}
+		versions = append(versions, &Version{Name: AWSNode, Current: current, Default: defaultVersion})
+	}
+
+	if current, err := daemonSetImageTag(clientSet, KubeProxy); err != nil {
+		return nil, err
+	} else if current != "" {
+		versions = append(versions, &Version{Name: KubeProxy, Current: current, Default: "v" + controlPlaneVersion})
+	}
+
+	deployment, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get(CoreDNS, metav1.GetOptions{})
+	if err != nil && !apierrs.IsNotFound(err) {
+		return nil, errors.Wrapf(err, "getting %q", CoreDNS)
+	}
+	if err == nil {
+		current, err := imageTag(deployment.Spec.Template.Spec.Containers, CoreDNS)
+		if err != nil {
+			return nil, err
+		}
+		list, err := loadAssetCoreDNS(controlPlaneVersion)
+		if err != nil {
+			return nil, err
+		}
+		defaultVersion, err := assetImageTag(list)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting version of %q", CoreDNS)
+		}
+		versions = append(versions, &Version{Name: CoreDNS, Current: current, Default: defaultVersion})
+	}
+
+	return versions, nil
+}
+
+// daemonSetImageTag returns the image tag of the DaemonSet, or an empty string when it doesn't exist
+func daemonSetImageTag(clientSet kubernetes.Interface, name string) (string, error) {
+	d, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(name, metav1.GetOptions{})
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			return "", nil
+		}
+		return "", errors.Wrapf(err, "getting %q", name)
+	}
+	return imageTag(d.Spec.Template.Spec.Containers, name)
+}
+
+func imageTag(containers []corev1.Container, name string) (string, error) {
+	if len(containers) == 0 {
+		return "", fmt.Errorf("%s has no containers", name)
+	}
+	imageParts := strings.Split(containers[0].Image, ":")
+	if len(imageParts) != 2 {
+		return "", fmt.Errorf("unexpected image format %q for %q", containers[0].Image, name)
+	}
+	return imageParts[1], nil
+}
+
+// assetImageTag returns the image tag of the DaemonSet or the Deployment in an embedded manifest
+func assetImageTag(list *metav1.List) (string, error) {
+	for _, rawObj := range list.Items {
+		switch obj := rawObj.Object.(type) {
+		case *appsv1.DaemonSet:
+			return imageTag(obj.Spec.Template.Spec.Containers, obj.Name)
+		case *appsv1.Deployment:
+			return imageTag(obj.Spec.Template.Spec.Containers, obj.Name)
+		}
+	}
+	return "", errors.New("no DaemonSet or Deployment found in manifest")
+}
@@ -0,0 +1,36 @@
+package defaultaddons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("default addons - versions", func() {
+	It("compares the versions in the cluster with the default versions", func() {
+		clientSet, _ := testutils.NewFakeClientSetWithSamples("testdata/sample-1.12.json")
+
+		versions, err := GetVersions(clientSet, "1.13.7")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(versions).To(Equal([]*Version{
+			{Name: AWSNode, Current: "v1.4.1", Default: "v1.5.0"},
+			{Name: KubeProxy, Current: "v1.12.6", Default: "v1.13.7"},
+			{Name: CoreDNS, Current: "v1.2.2", Default: "v1.2.6"},
+		}))
+		for _, version := range versions {
+			Expect(version.UpdateAvailable()).To(BeTrue())
+		}
+	})
+
+	It("finds no updates when the cluster runs the default versions", func() {
+		clientSet, _ := testutils.NewFakeClientSetWithSamples("testdata/sample-1.12.json")
+
+		versions, err := GetVersions(clientSet, "1.12.6")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(versions).To(HaveLen(3))
+		Expect(versions[1].UpdateAvailable()).To(BeFalse())
+		Expect(versions[2].UpdateAvailable()).To(BeFalse())
+	})
+})
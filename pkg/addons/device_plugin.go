@@ -0,0 +1,67 @@
+package addons
+
+import (
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+const (
+	nvidiaDevicePluginName = "nvidia-device-plugin"
+
+	// NvidiaGPUTaintKey is the taint key set on GPU nodes when the NVIDIA device plugin is installed
+	NvidiaGPUTaintKey = "nvidia.com/gpu"
+	nvidiaGPUTaint    = "true:NoSchedule"
+)
+
+// IsGPUNodeGroup returns true if the nodegroup uses any GPU instance types
+func IsGPUNodeGroup(ng *api.NodeGroup) bool {
+	return utils.IsGPUInstanceType(ng.InstanceType) || (ng.InstancesDistribution != nil && utils.HasGPUInstanceType(ng.InstancesDistribution.InstanceTypes))
+}
+
+// WantsNvidiaDevicePlugin returns true if the NVIDIA device plugin
+// should be installed for the nodegroup
+func WantsNvidiaDevicePlugin(ng *api.NodeGroup) bool {
+	return ng.GPU != nil && api.IsEnabled(ng.GPU.InstallDevicePlugin) && IsGPUNodeGroup(ng)
+}
+
+// SetNvidiaGPUTaint adds a taint to the nodegroup, so that only pods which
+// tolerate it get scheduled onto GPU nodes; an existing taint with the same
+// key is left unchanged
+func SetNvidiaGPUTaint(ng *api.NodeGroup) {
+	if ng.Taints == nil {
+		ng.Taints = map[string]string{}
+	}
+	if _, ok := ng.Taints[NvidiaGPUTaintKey]; !ok {
+		ng.Taints[NvidiaGPUTaintKey] = nvidiaGPUTaint
+	}
+}
+
+// NvidiaDevicePlugin deploys the NVIDIA device plugin DaemonSet, which
+// exposes GPUs to the kubelet as an allocatable resource
+type NvidiaDevicePlugin struct {
+	rawClient kubernetes.RawClientInterface
+	planMode  bool
+}
+
+// NewNvidiaDevicePlugin creates a new NvidiaDevicePlugin
+func NewNvidiaDevicePlugin(rawClient kubernetes.RawClientInterface, planMode bool) *NvidiaDevicePlugin {
+	return &NvidiaDevicePlugin{
+		rawClient: rawClient,
+		planMode:  planMode,
+	}
+}
+
+// Deploy deploys the NVIDIA device plugin to the cluster
+func (n *NvidiaDevicePlugin) Deploy() error {
+	list, err := loadAsset(nvidiaDevicePluginName)
+	if err != nil {
+		return err
+	}
+	if err := applyResources(n.rawClient, list.Items, n.planMode); err != nil {
+		return errors.Wrapf(err, "deploying %q", nvidiaDevicePluginName)
+	}
+	return nil
+}
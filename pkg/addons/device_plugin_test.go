@@ -0,0 +1,75 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("NVIDIA device plugin", func() {
+	var ng *api.NodeGroup
+
+	BeforeEach(func() {
+		ng = api.NewNodeGroup()
+		ng.InstanceType = "p3.2xlarge"
+	})
+
+	It("is only wanted for GPU nodegroups that enable it", func() {
+		Expect(WantsNvidiaDevicePlugin(ng)).To(BeFalse())
+
+		ng.GPU = &api.NodeGroupGPU{InstallDevicePlugin: api.Enabled()}
+		Expect(WantsNvidiaDevicePlugin(ng)).To(BeTrue())
+
+		ng.InstanceType = "m5.large"
+		Expect(WantsNvidiaDevicePlugin(ng)).To(BeFalse())
+	})
+
+	It("taints the nodegroup without overriding an existing taint", func() {
+		SetNvidiaGPUTaint(ng)
+		Expect(ng.Taints).To(HaveKeyWithValue("nvidia.com/gpu", "true:NoSchedule"))
+
+		ng.Taints["nvidia.com/gpu"] = "present:NoExecute"
+		SetNvidiaGPUTaint(ng)
+		Expect(ng.Taints).To(HaveKeyWithValue("nvidia.com/gpu", "present:NoExecute"))
+	})
+
+	It("deploys the DaemonSet", func() {
+		rawClient := testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+
+		Expect(NewNvidiaDevicePlugin(rawClient, false).Deploy()).To(Succeed())
+
+		ds, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get("nvidia-device-plugin-daemonset", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		var tolerationKeys []string
+		for _, t := range ds.Spec.Template.Spec.Tolerations {
+			tolerationKeys = append(tolerationKeys, t.Key)
+		}
+		Expect(tolerationKeys).To(ContainElement("nvidia.com/gpu"))
+	})
+})
+
+var _ = Describe("EFA device plugin", func() {
+	It("labels the nodegroup", func() {
+		ng := api.NewNodeGroup()
+		SetEFALabel(ng)
+		Expect(ng.Labels).To(HaveKeyWithValue("alpha.eksctl.io/efa-enabled", "true"))
+	})
+
+	It("deploys the DaemonSet with the regional image", func() {
+		rawClient := testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+
+		Expect(NewEFADevicePlugin(rawClient, "eu-west-1", false).Deploy()).To(Succeed())
+
+		ds, err := rawClient.ClientSet().AppsV1().DaemonSets(metav1.NamespaceSystem).Get("aws-efa-k8s-device-plugin-daemonset", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(ds.Spec.Template.Spec.Containers[0].Image).To(Equal("602401143452.dkr.ecr.eu-west-1.amazonaws.com/eks/aws-efa-k8s-device-plugin:v0.3.3"))
+		Expect(ds.Spec.Template.Spec.NodeSelector).To(HaveKeyWithValue("alpha.eksctl.io/efa-enabled", "true"))
+	})
+})
@@ -0,0 +1,145 @@
+package addons
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	ebsCSIDriverName  = "ebs-csi-driver"
+	ebsCSIDriverImage = "amazon/aws-ebs-csi-driver"
+
+	// ebsCSIDriverProvisioner is the name of the CSI driver that in-tree volumes are migrated to
+	ebsCSIDriverProvisioner = "ebs.csi.aws.com"
+
+	// csiMigratedToAnnotation marks volumes and claims that are handled by a CSI driver
+	csiMigratedToAnnotation = "pv.kubernetes.io/migrated-to"
+)
+
+// ebsCSIDriverVersions pins a release of EBS CSI driver for each version of Kubernetes
+// that the driver supports, older versions lack CSI features the driver relies on
+var ebsCSIDriverVersions = map[string]string{
+	api.Version1_14: "v0.5.0",
+}
+
+// EBSCSIDriverPolicyARN returns the managed policy with the permissions that EBS CSI driver needs
+// in the partition of region
+func EBSCSIDriverPolicyARN(region string) string {
+	return fmt.Sprintf("arn:%s:iam::aws:policy/service-role/AmazonEBSCSIDriverPolicy", api.Partition(region))
+}
+
+// EBSCSIDriverServiceAccount returns the iamserviceaccount of the controller of EBS CSI driver
+func EBSCSIDriverServiceAccount(region string) *api.ClusterIAMServiceAccount {
+	return &api.ClusterIAMServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      (&api.Addon{Name: api.EBSCSIDriverAddon}).ServiceAccountName(),
+			Namespace: metav1.NamespaceSystem,
+		},
+		AttachPolicyARNs: []string{EBSCSIDriverPolicyARN(region)},
+	}
+}
+
+// EBSCSIDriver deploys a self-managed EBS CSI driver, as an alternative
+// to the EKS addon for clusters that don't support it
+type EBSCSIDriver struct {
+	rawClient           kubernetes.RawClientInterface
+	controlPlaneVersion string
+	planMode            bool
+}
+
+// NewEBSCSIDriver creates a new EBSCSIDriver
+func NewEBSCSIDriver(rawClient kubernetes.RawClientInterface, controlPlaneVersion string, planMode bool) *EBSCSIDriver {
+	return &EBSCSIDriver{
+		rawClient:           rawClient,
+		controlPlaneVersion: controlPlaneVersion,
+		planMode:            planMode,
+	}
+}
+
+// Deploy deploys EBS CSI driver to the cluster, the service account
+// of its controller is expected to be created beforehand
+func (e *EBSCSIDriver) Deploy() error {
+	version, ok := ebsCSIDriverVersions[e.controlPlaneVersion]
+	if !ok {
+		return fmt.Errorf("no version of %s is known to support Kubernetes %s", ebsCSIDriverName, e.controlPlaneVersion)
+	}
+
+	list, err := loadAsset(ebsCSIDriverName)
+	if err != nil {
+		return err
+	}
+	for _, rawObj := range list.Items {
+		var podSpec *corev1.PodSpec
+		switch obj := rawObj.Object.(type) {
+		case *appsv1.Deployment:
+			podSpec = &obj.Spec.Template.Spec
+		case *appsv1.DaemonSet:
+			podSpec = &obj.Spec.Template.Spec
+		default:
+			continue
+		}
+		for i := range podSpec.Containers {
+			if podSpec.Containers[i].Image == ebsCSIDriverImage {
+				podSpec.Containers[i].Image = ebsCSIDriverImage + ":" + version
+			}
+		}
+	}
+	if err := applyResources(e.rawClient, list.Items, e.planMode); err != nil {
+		return errors.Wrapf(err, "deploying %q", ebsCSIDriverName)
+	}
+	return nil
+}
+
+// InTreeEBSVolumes returns persistent volumes that use the in-tree EBS plugin
+// and weren't migrated to the CSI driver yet
+func InTreeEBSVolumes(clientSet kubernetes.Interface) ([]corev1.PersistentVolume, error) {
+	pvs, err := clientSet.CoreV1().PersistentVolumes().List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing persistent volumes")
+	}
+	volumes := []corev1.PersistentVolume{}
+	for _, pv := range pvs.Items {
+		if pv.Spec.AWSElasticBlockStore == nil || pv.Annotations[csiMigratedToAnnotation] == ebsCSIDriverProvisioner {
+			continue
+		}
+		volumes = append(volumes, pv)
+	}
+	return volumes, nil
+}
+
+// AnnotateForCSIMigration marks the given in-tree volumes, and claims bound to them,
+// as handled by EBS CSI driver
+func AnnotateForCSIMigration(clientSet kubernetes.Interface, volumes []corev1.PersistentVolume, plan bool) error {
+	for _, pv := range volumes {
+		if plan {
+			logger.Info("(plan) would annotate persistent volume %q for migration to %s", pv.Name, ebsCSIDriverProvisioner)
+			continue
+		}
+		pv := pv
+		metav1.SetMetaDataAnnotation(&pv.ObjectMeta, csiMigratedToAnnotation, ebsCSIDriverProvisioner)
+		if _, err := clientSet.CoreV1().PersistentVolumes().Update(&pv); err != nil {
+			return errors.Wrapf(err, "annotating persistent volume %q", pv.Name)
+		}
+
+		if ref := pv.Spec.ClaimRef; ref != nil {
+			pvc, err := clientSet.CoreV1().PersistentVolumeClaims(ref.Namespace).Get(ref.Name, metav1.GetOptions{})
+			if err != nil {
+				return errors.Wrapf(err, "getting persistent volume claim \"%s/%s\"", ref.Namespace, ref.Name)
+			}
+			metav1.SetMetaDataAnnotation(&pvc.ObjectMeta, csiMigratedToAnnotation, ebsCSIDriverProvisioner)
+			if _, err := clientSet.CoreV1().PersistentVolumeClaims(ref.Namespace).Update(pvc); err != nil {
+				return errors.Wrapf(err, "annotating persistent volume claim \"%s/%s\"", ref.Namespace, ref.Name)
+			}
+		}
+		logger.Info("annotated persistent volume %q for migration to %s", pv.Name, ebsCSIDriverProvisioner)
+	}
+	return nil
+}
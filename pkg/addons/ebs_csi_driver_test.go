@@ -0,0 +1,88 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("EBS CSI driver", func() {
+	It("deploys the controller and node plugin with the pinned version of the driver", func() {
+		rawClient := testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+
+		err := NewEBSCSIDriver(rawClient, "1.14", false).Deploy()
+		Expect(err).ToNot(HaveOccurred())
+
+		var podSpecs []corev1.PodSpec
+		for _, item := range rawClient.Collection.CreatedItems() {
+			switch obj := item.(type) {
+			case *appsv1.Deployment:
+				podSpecs = append(podSpecs, obj.Spec.Template.Spec)
+			case *appsv1.DaemonSet:
+				podSpecs = append(podSpecs, obj.Spec.Template.Spec)
+			}
+		}
+		Expect(podSpecs).To(HaveLen(2))
+		for _, podSpec := range podSpecs {
+			Expect(podSpec.Containers[0].Name).To(Equal("ebs-plugin"))
+			Expect(podSpec.Containers[0].Image).To(Equal("amazon/aws-ebs-csi-driver:v0.5.0"))
+		}
+	})
+
+	It("fails for a control plane version the driver doesn't support", func() {
+		rawClient := testutils.NewFakeRawClient()
+		err := NewEBSCSIDriver(rawClient, "1.13", false).Deploy()
+		Expect(err).To(MatchError("no version of ebs-csi-driver is known to support Kubernetes 1.13"))
+	})
+
+	It("finds in-tree volumes and annotates them, along with their claims, for migration", func() {
+		inTree := &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-in-tree"},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					AWSElasticBlockStore: &corev1.AWSElasticBlockStoreVolumeSource{VolumeID: "vol-1"},
+				},
+				ClaimRef: &corev1.ObjectReference{Namespace: "default", Name: "data"},
+			},
+		}
+		other := &corev1.PersistentVolume{
+			ObjectMeta: metav1.ObjectMeta{Name: "pv-nfs"},
+			Spec: corev1.PersistentVolumeSpec{
+				PersistentVolumeSource: corev1.PersistentVolumeSource{
+					NFS: &corev1.NFSVolumeSource{Server: "nfs", Path: "/"},
+				},
+			},
+		}
+		claim := &corev1.PersistentVolumeClaim{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "data"},
+		}
+		clientSet := fake.NewSimpleClientset(inTree, other, claim)
+
+		volumes, err := InTreeEBSVolumes(clientSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(volumes).To(HaveLen(1))
+		Expect(volumes[0].Name).To(Equal("pv-in-tree"))
+
+		Expect(AnnotateForCSIMigration(clientSet, volumes, false)).To(Succeed())
+
+		pv, err := clientSet.CoreV1().PersistentVolumes().Get("pv-in-tree", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pv.Annotations).To(HaveKeyWithValue("pv.kubernetes.io/migrated-to", "ebs.csi.aws.com"))
+
+		pvc, err := clientSet.CoreV1().PersistentVolumeClaims("default").Get("data", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pvc.Annotations).To(HaveKeyWithValue("pv.kubernetes.io/migrated-to", "ebs.csi.aws.com"))
+
+		volumes, err = InTreeEBSVolumes(clientSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(volumes).To(BeEmpty())
+	})
+})
@@ -0,0 +1,58 @@
+package addons
+
+import (
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	efaDevicePluginName = "efa-device-plugin"
+
+	// EFALabelKey is the label set on nodes of EFA-enabled nodegroups, the EFA device plugin runs on these nodes only
+	EFALabelKey = "alpha.eksctl.io/efa-enabled"
+)
+
+// SetEFALabel adds the label that the EFA device plugin selects nodes by to the nodegroup
+func SetEFALabel(ng *api.NodeGroup) {
+	if ng.Labels == nil {
+		ng.Labels = map[string]string{}
+	}
+	ng.Labels[EFALabelKey] = "true"
+}
+
+// EFADevicePlugin deploys the EFA device plugin DaemonSet, which exposes
+// Elastic Fabric Adapters to the kubelet as an allocatable resource
+type EFADevicePlugin struct {
+	rawClient kubernetes.RawClientInterface
+	region    string
+	planMode  bool
+}
+
+// NewEFADevicePlugin creates a new EFADevicePlugin
+func NewEFADevicePlugin(rawClient kubernetes.RawClientInterface, region string, planMode bool) *EFADevicePlugin {
+	return &EFADevicePlugin{
+		rawClient: rawClient,
+		region:    region,
+		planMode:  planMode,
+	}
+}
+
+// Deploy deploys the EFA device plugin to the cluster
+func (e *EFADevicePlugin) Deploy() error {
+	list, err := loadAsset(efaDevicePluginName)
+	if err != nil {
+		return err
+	}
+	for _, rawObj := range list.Items {
+		if ds, ok := rawObj.Object.(*appsv1.DaemonSet); ok {
+			useRegionalImage(&ds.Spec.Template, e.region)
+		}
+	}
+	if err := applyResources(e.rawClient, list.Items, e.planMode); err != nil {
+		return errors.Wrapf(err, "deploying %q", efaDevicePluginName)
+	}
+	return nil
+}
@@ -0,0 +1,315 @@
+package addons
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const eksAddonPollInterval = 10 * time.Second
+
+// EKSAddonSummary holds the state of an EKS addon
+type EKSAddonSummary struct {
+	Name                  string
+	Version               string
+	Status                string
+	ServiceAccountRoleARN string
+	Issues                []string
+}
+
+// EKSAddonManager manages lifecycle of addons via EKS Addons API,
+// along with IAM roles of their service accounts
+type EKSAddonManager struct {
+	provider     api.ClusterProvider
+	stackManager *manager.StackCollection
+	oidc         *iamoidc.OpenIDConnectManager
+	clusterName  string
+}
+
+// NewEKSAddonManager creates a new EKSAddonManager, oidc is only needed
+// to create IAM roles for addons with attachPolicyARNs and may be nil otherwise
+func NewEKSAddonManager(provider api.ClusterProvider, stackManager *manager.StackCollection, oidc *iamoidc.OpenIDConnectManager, clusterName string) *EKSAddonManager {
+	return &EKSAddonManager{
+		provider:     provider,
+		stackManager: stackManager,
+		oidc:         oidc,
+		clusterName:  clusterName,
+	}
+}
+
+// Create creates the addon and waits for it to become active
+func (m *EKSAddonManager) Create(addon *api.Addon) error {
+	roleARN, err := m.serviceAccountRoleARN(addon)
+	if err != nil {
+		return err
+	}
+
+	input := &eks.CreateAddonInput{
+		ClusterName: aws.String(m.clusterName),
+		AddonName:   aws.String(addon.Name),
+	}
+	if addon.Version != "" {
+		input.AddonVersion = aws.String(addon.Version)
+	}
+	if roleARN != "" {
+		input.ServiceAccountRoleArn = aws.String(roleARN)
+	}
+	if addon.ResolveConflicts != "" {
+		input.ResolveConflicts = aws.String(strings.ToUpper(addon.ResolveConflicts))
+	}
+
+	logger.Info("creating addon %q", addon.Name)
+	if _, err := m.provider.EKS().CreateAddon(input); err != nil {
+		return errors.Wrapf(err, "creating addon %q", addon.Name)
+	}
+
+	logger.Info("waiting for addon %q to become active", addon.Name)
+	if err := m.provider.EKS().WaitUntilAddonActiveWithContext(aws.BackgroundContext(), m.describeAddonInput(addon.Name), m.waiterOptions()...); err != nil {
+		return errors.Wrapf(err, "waiting for addon %q to become active", addon.Name)
+	}
+	logger.Success("created addon %q", addon.Name)
+	return nil
+}
+
+// Get returns the summary of the addon with the given name, or of all addons of the cluster when name is empty
+func (m *EKSAddonManager) Get(name string) ([]*EKSAddonSummary, error) {
+	names := []string{name}
+	if name == "" {
+		var err error
+		if names, err = m.list(); err != nil {
+			return nil, err
+		}
+	}
+
+	summaries := []*EKSAddonSummary{}
+	for _, name := range names {
+		addon, err := m.describe(name)
+		if err != nil {
+			return nil, err
+		}
+		if addon == nil {
+			return nil, fmt.Errorf("addon %q not found", name)
+		}
+		summary := &EKSAddonSummary{
+			Name:                  aws.StringValue(addon.AddonName),
+			Version:               aws.StringValue(addon.AddonVersion),
+			Status:                aws.StringValue(addon.Status),
+			ServiceAccountRoleARN: aws.StringValue(addon.ServiceAccountRoleArn),
+			Issues:                []string{},
+		}
+		if addon.Health != nil {
+			for _, issue := range addon.Health.Issues {
+				summary.Issues = append(summary.Issues, fmt.Sprintf("%s: %s", aws.StringValue(issue.Code), aws.StringValue(issue.Message)))
+			}
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
+
+// Exists returns true if the addon with the given name is installed
+func (m *EKSAddonManager) Exists(name string) (bool, error) {
+	addon, err := m.describe(name)
+	if err != nil {
+		return false, err
+	}
+	return addon != nil, nil
+}
+
+// Update updates version, service account role and conflict resolution of an existing addon
+func (m *EKSAddonManager) Update(addon *api.Addon) error {
+	current, err := m.describe(addon.Name)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("addon %q not found", addon.Name)
+	}
+
+	roleARN, err := m.serviceAccountRoleARN(addon)
+	if err != nil {
+		return err
+	}
+
+	input := &eks.UpdateAddonInput{
+		ClusterName: aws.String(m.clusterName),
+		AddonName:   aws.String(addon.Name),
+	}
+	if addon.Version != "" {
+		input.AddonVersion = aws.String(addon.Version)
+	}
+	if roleARN != "" {
+		input.ServiceAccountRoleArn = aws.String(roleARN)
+	}
+	if addon.ResolveConflicts != "" {
+		input.ResolveConflicts = aws.String(strings.ToUpper(addon.ResolveConflicts))
+	}
+
+	logger.Info("updating addon %q", addon.Name)
+	output, err := m.provider.EKS().UpdateAddon(input)
+	if err != nil {
+		return errors.Wrapf(err, "updating addon %q", addon.Name)
+	}
+	if err := m.waitForUpdate(addon.Name, output.Update); err != nil {
+		return err
+	}
+	logger.Success("updated addon %q", addon.Name)
+	return nil
+}
+
+// Delete deletes the addon, along with the IAM role of its service account if it was created by eksctl
+func (m *EKSAddonManager) Delete(name string) error {
+	logger.Info("deleting addon %q", name)
+	if _, err := m.provider.EKS().DeleteAddon(&eks.DeleteAddonInput{
+		ClusterName: aws.String(m.clusterName),
+		AddonName:   aws.String(name),
+	}); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException {
+			return fmt.Errorf("addon %q not found", name)
+		}
+		return errors.Wrapf(err, "deleting addon %q", name)
+	}
+	if err := m.provider.EKS().WaitUntilAddonDeletedWithContext(aws.BackgroundContext(), m.describeAddonInput(name), m.waiterOptions()...); err != nil {
+		return errors.Wrapf(err, "waiting for addon %q to be deleted", name)
+	}
+
+	serviceAccount := (&api.Addon{Name: name}).ServiceAccountName()
+	if serviceAccount != "" {
+		stacks, err := m.stackManager.DescribeIAMServiceAccountStacks()
+		if err != nil {
+			return err
+		}
+		for _, s := range stacks {
+			if m.stackManager.GetIAMServiceAccountName(s) == metav1.NamespaceSystem+"/"+serviceAccount {
+				if _, err := m.stackManager.DeleteStackBySpec(s); err != nil {
+					return errors.Wrapf(err, "deleting IAM role of addon %q", name)
+				}
+			}
+		}
+	}
+	logger.Success("deleted addon %q", name)
+	return nil
+}
+
+// serviceAccountRoleARN returns the ARN of the role for the service account of the addon,
+// creating the role when policies are given and it doesn't exist yet
+func (m *EKSAddonManager) serviceAccountRoleARN(addon *api.Addon) (string, error) {
+	if addon.ServiceAccountRoleARN != "" || (len(addon.AttachPolicyARNs) == 0 && addon.AttachPolicy == nil) {
+		return addon.ServiceAccountRoleARN, nil
+	}
+
+	serviceAccount := &api.ClusterIAMServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      addon.ServiceAccountName(),
+			Namespace: metav1.NamespaceSystem,
+		},
+		AttachPolicyARNs: addon.AttachPolicyARNs,
+		AttachPolicy:     addon.AttachPolicy,
+	}
+
+	existing, err := m.stackManager.GetIAMServiceAccounts()
+	if err != nil {
+		return "", errors.Wrap(err, "getting iamserviceaccounts")
+	}
+	for _, sa := range existing {
+		if sa.NameString() == serviceAccount.NameString() {
+			logger.Info("using existing IAM role of serviceaccount %q for addon %q", sa.NameString(), addon.Name)
+			return *sa.Status.RoleARN, nil
+		}
+	}
+
+	if m.oidc == nil {
+		return "", fmt.Errorf("IAM OIDC provider is required to create IAM role for addon %q", addon.Name)
+	}
+	if err := m.stackManager.CreateIAMServiceAccountRole(serviceAccount, m.oidc); err != nil {
+		return "", errors.Wrapf(err, "creating IAM role for addon %q", addon.Name)
+	}
+	return *serviceAccount.Status.RoleARN, nil
+}
+
+// waitForUpdate polls the update until it's no longer in progress
+func (m *EKSAddonManager) waitForUpdate(name string, update *eks.Update) error {
+	logger.Info("waiting for update %q of addon %q to complete", aws.StringValue(update.Id), name)
+	timer := time.After(m.provider.WaitTimeout())
+	for {
+		switch status := aws.StringValue(update.Status); status {
+		case eks.UpdateStatusSuccessful:
+			return nil
+		case eks.UpdateStatusFailed, eks.UpdateStatusCancelled:
+			messages := []string{}
+			for _, e := range update.Errors {
+				messages = append(messages, aws.StringValue(e.ErrorMessage))
+			}
+			return fmt.Errorf("update %q of addon %q is in %q state: %v", aws.StringValue(update.Id), name, status, messages)
+		}
+
+		select {
+		case <-timer:
+			return fmt.Errorf("timed out (after %s) waiting for update %q of addon %q", m.provider.WaitTimeout(), aws.StringValue(update.Id), name)
+		case <-time.After(eksAddonPollInterval):
+		}
+
+		output, err := m.provider.EKS().DescribeUpdate(&eks.DescribeUpdateInput{
+			Name:      aws.String(m.clusterName),
+			AddonName: aws.String(name),
+			UpdateId:  update.Id,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "describing update %q of addon %q", aws.StringValue(update.Id), name)
+		}
+		update = output.Update
+	}
+}
+
+// describe returns the addon with the given name, or nil if there is no such addon
+func (m *EKSAddonManager) describe(name string) (*eks.Addon, error) {
+	output, err := m.provider.EKS().DescribeAddon(m.describeAddonInput(name))
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "describing addon %q", name)
+	}
+	return output.Addon, nil
+}
+
+func (m *EKSAddonManager) list() ([]string, error) {
+	names := []string{}
+	input := &eks.ListAddonsInput{
+		ClusterName: aws.String(m.clusterName),
+	}
+	err := m.provider.EKS().ListAddonsPages(input, func(output *eks.ListAddonsOutput, _ bool) bool {
+		names = append(names, aws.StringValueSlice(output.Addons)...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing addons of cluster %q", m.clusterName)
+	}
+	return names, nil
+}
+
+func (m *EKSAddonManager) describeAddonInput(name string) *eks.DescribeAddonInput {
+	return &eks.DescribeAddonInput{
+		ClusterName: aws.String(m.clusterName),
+		AddonName:   aws.String(name),
+	}
+}
+
+func (m *EKSAddonManager) waiterOptions() []request.WaiterOption {
+	return []request.WaiterOption{
+		request.WithWaiterDelay(request.ConstantWaiterDelay(eksAddonPollInterval)),
+		request.WithWaiterMaxAttempts(int(m.provider.WaitTimeout()/eksAddonPollInterval) + 1),
+	}
+}
@@ -0,0 +1,179 @@
+package addons_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("EKS addons", func() {
+	var (
+		p            *mockprovider.MockProvider
+		addonManager *EKSAddonManager
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		addonManager = NewEKSAddonManager(p, manager.NewStackCollection(p, cfg), nil, "test-cluster")
+	})
+
+	It("creates an addon with the given version and conflict resolution", func() {
+		p.MockEKS().On("CreateAddon", mock.Anything).Return(&eks.CreateAddonOutput{}, nil)
+		p.MockEKS().On("WaitUntilAddonActiveWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		err := addonManager.Create(&api.Addon{
+			Name:                  api.VPCCNIAddon,
+			Version:               "v1.7.5-eksbuild.1",
+			ServiceAccountRoleARN: "arn:aws:iam::123:role/cni",
+			ResolveConflicts:      api.AddonResolveConflictsOverwrite,
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		input := p.MockEKS().Calls[0].Arguments[0].(*eks.CreateAddonInput)
+		Expect(*input.ClusterName).To(Equal("test-cluster"))
+		Expect(*input.AddonName).To(Equal("vpc-cni"))
+		Expect(*input.AddonVersion).To(Equal("v1.7.5-eksbuild.1"))
+		Expect(*input.ServiceAccountRoleArn).To(Equal("arn:aws:iam::123:role/cni"))
+		Expect(*input.ResolveConflicts).To(Equal(eks.ResolveConflictsOverwrite))
+	})
+
+	It("uses an existing IAM role of the addon's service account", func() {
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{
+					{
+						StackName:   aws.String("eksctl-test-cluster-addon-iamserviceaccount-kube-system-aws-node"),
+						StackStatus: aws.String(cfn.StackStatusCreateComplete),
+						Tags: []*cfn.Tag{
+							{
+								Key:   aws.String(api.IAMServiceAccountNameTag),
+								Value: aws.String("kube-system/aws-node"),
+							},
+						},
+						Outputs: []*cfn.Output{
+							{
+								OutputKey:   aws.String("Role1"),
+								OutputValue: aws.String("arn:aws:iam::123:role/eksctl-aws-node"),
+							},
+						},
+					},
+				},
+			}, true)
+		}).Return(nil)
+		p.MockEKS().On("CreateAddon", mock.Anything).Return(&eks.CreateAddonOutput{}, nil)
+		p.MockEKS().On("WaitUntilAddonActiveWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		err := addonManager.Create(&api.Addon{
+			Name:             api.VPCCNIAddon,
+			AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		input := p.MockEKS().Calls[0].Arguments[0].(*eks.CreateAddonInput)
+		Expect(*input.ServiceAccountRoleArn).To(Equal("arn:aws:iam::123:role/eksctl-aws-node"))
+		Expect(input.AddonVersion).To(BeNil())
+		Expect(input.ResolveConflicts).To(BeNil())
+	})
+
+	It("gets summaries of all addons", func() {
+		p.MockEKS().On("ListAddonsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*eks.ListAddonsOutput, bool) bool)
+			consume(&eks.ListAddonsOutput{Addons: aws.StringSlice([]string{"coredns", "kube-proxy"})}, true)
+		}).Return(nil)
+		p.MockEKS().On("DescribeAddon", mock.Anything).Return(func(input *eks.DescribeAddonInput) *eks.DescribeAddonOutput {
+			addon := &eks.Addon{
+				AddonName:    input.AddonName,
+				AddonVersion: aws.String("v1.0.0-eksbuild.1"),
+				Status:       aws.String(eks.AddonStatusActive),
+			}
+			if *input.AddonName == "kube-proxy" {
+				addon.Status = aws.String(eks.AddonStatusDegraded)
+				addon.Health = &eks.AddonHealth{
+					Issues: []*eks.AddonIssue{{Code: aws.String("ConfigurationConflict"), Message: aws.String("conflicts found")}},
+				}
+			}
+			return &eks.DescribeAddonOutput{Addon: addon}
+		}, nil)
+
+		summaries, err := addonManager.Get("")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(summaries).To(HaveLen(2))
+		Expect(summaries[0].Name).To(Equal("coredns"))
+		Expect(summaries[0].Status).To(Equal("ACTIVE"))
+		Expect(summaries[0].Issues).To(BeEmpty())
+		Expect(summaries[1].Name).To(Equal("kube-proxy"))
+		Expect(summaries[1].Issues).To(Equal([]string{"ConfigurationConflict: conflicts found"}))
+	})
+
+	It("updates an existing addon and waits for the update", func() {
+		p.MockEKS().On("DescribeAddon", mock.Anything).Return(&eks.DescribeAddonOutput{Addon: &eks.Addon{AddonName: aws.String("coredns")}}, nil)
+		p.MockEKS().On("UpdateAddon", mock.Anything).Return(&eks.UpdateAddonOutput{
+			Update: &eks.Update{Id: aws.String("update-1"), Status: aws.String(eks.UpdateStatusSuccessful)},
+		}, nil)
+
+		err := addonManager.Update(&api.Addon{Name: api.CoreDNSAddon, Version: "v1.8.0-eksbuild.1", ResolveConflicts: api.AddonResolveConflictsPreserve})
+		Expect(err).ToNot(HaveOccurred())
+
+		input := p.MockEKS().Calls[1].Arguments[0].(*eks.UpdateAddonInput)
+		Expect(*input.AddonVersion).To(Equal("v1.8.0-eksbuild.1"))
+		Expect(*input.ResolveConflicts).To(Equal(eks.ResolveConflictsPreserve))
+	})
+
+	It("fails to update or delete an addon that doesn't exist", func() {
+		notFound := awserr.New(eks.ErrCodeResourceNotFoundException, "not found", nil)
+		p.MockEKS().On("DescribeAddon", mock.Anything).Return(nil, notFound)
+		p.MockEKS().On("DeleteAddon", mock.Anything).Return(nil, notFound)
+
+		Expect(addonManager.Update(&api.Addon{Name: api.CoreDNSAddon})).To(MatchError(`addon "coredns" not found`))
+		Expect(addonManager.Delete(api.KubeProxyAddon)).To(MatchError(`addon "kube-proxy" not found`))
+	})
+
+	It("lists the default version and the newer versions of addons", func() {
+		p.MockEKS().On("DescribeAddonVersionsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			Expect(*args[0].(*eks.DescribeAddonVersionsInput).KubernetesVersion).To(Equal("1.18"))
+			consume := args[1].(func(p *eks.DescribeAddonVersionsOutput, last bool) (shouldContinue bool))
+			consume(&eks.DescribeAddonVersionsOutput{
+				Addons: []*eks.AddonInfo{
+					{
+						AddonName: aws.String("vpc-cni"),
+						AddonVersions: []*eks.AddonVersionInfo{
+							{AddonVersion: aws.String("v1.7.10-eksbuild.1")},
+							{
+								AddonVersion: aws.String("v1.7.5-eksbuild.2"),
+								Compatibilities: []*eks.Compatibility{
+									{ClusterVersion: aws.String("1.18"), DefaultVersion: aws.Bool(true)},
+								},
+							},
+							{AddonVersion: aws.String("v1.6.3-eksbuild.1")},
+						},
+					},
+				},
+			}, true)
+		}).Return(nil)
+
+		updates, err := addonManager.GetUpdates([]*EKSAddonSummary{{Name: "vpc-cni", Version: "v1.7.5-eksbuild.1"}}, "1.18")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(updates).To(Equal([]*AddonUpdateSummary{{
+			Name:           "vpc-cni",
+			Type:           AddonTypeEKS,
+			Version:        "v1.7.5-eksbuild.1",
+			DefaultVersion: "v1.7.5-eksbuild.2",
+			Updates:        []string{"v1.7.5-eksbuild.2", "v1.7.10-eksbuild.1"},
+		}}))
+	})
+})
@@ -0,0 +1,53 @@
+package addons
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func loadAsset(name string) (*metav1.List, error) {
+	data, err := Asset(name + ".yaml")
+	if err != nil {
+		return nil, errors.Wrapf(err, "decoding embedded manifest for %q", name)
+	}
+	list, err := kubernetes.NewList(data)
+	if err != nil {
+		return nil, errors.Wrapf(err, "loading individual resources from manifest for %q", name)
+	}
+	return list, nil
+}
+
+func applyResources(rawClient kubernetes.RawClientInterface, items []runtime.RawExtension, plan bool) error {
+	for _, rawObj := range items {
+		resource, err := rawClient.NewRawResource(rawObj)
+		if err != nil {
+			return err
+		}
+		status, err := resource.CreateOrReplace(plan)
+		if err != nil {
+			return err
+		}
+		logger.Info(status)
+	}
+	return nil
+}
+
+// useRegionalImage replaces the registry host of the images, as the
+// manifests refer to the registry in us-west-2
+func useRegionalImage(spec *corev1.PodTemplateSpec, region string) {
+	registry := api.EKSResourceRegistry(region)
+	for i := range spec.Spec.Containers {
+		image := &spec.Spec.Containers[i].Image
+		if parts := strings.SplitN(*image, "/", 2); len(parts) == 2 {
+			*image = registry + "/" + parts[1]
+		}
+	}
+}
@@ -0,0 +1,81 @@
+package addons
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	karpenterName      = "karpenter"
+	karpenterNamespace = "karpenter"
+	karpenterChartRepo = "oci://public.ecr.aws/karpenter"
+)
+
+// KarpenterServiceAccount returns the iamserviceaccount of Karpenter, with the policy
+// of the controller created in the Karpenter stack
+func KarpenterServiceAccount(controllerPolicyARN string) *api.ClusterIAMServiceAccount {
+	return &api.ClusterIAMServiceAccount{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      karpenterName,
+			Namespace: karpenterNamespace,
+		},
+		AttachPolicyARNs: []string{controllerPolicyARN},
+	}
+}
+
+// Karpenter installs the Helm chart of Karpenter, configured with the instance profile of its nodes
+// and the queue of interruption events from the Karpenter stack
+type Karpenter struct {
+	installer ChartInstaller
+	spec      *api.ClusterConfig
+	resources *builder.KarpenterResources
+	planMode  bool
+}
+
+// NewKarpenter creates a new Karpenter
+func NewKarpenter(installer ChartInstaller, spec *api.ClusterConfig, resources *builder.KarpenterResources, planMode bool) *Karpenter {
+	return &Karpenter{
+		installer: installer,
+		spec:      spec,
+		resources: resources,
+		planMode:  planMode,
+	}
+}
+
+// Deploy installs the chart of the release of Karpenter set in the config, its service account
+// is expected to be created beforehand
+func (k *Karpenter) Deploy() error {
+	release := &api.HelmRelease{
+		Name:      karpenterName,
+		Namespace: karpenterNamespace,
+		Repo:      karpenterChartRepo,
+		Chart:     karpenterName,
+		Version:   k.spec.Karpenter.Version,
+		Values: api.InlineDocument{
+			"serviceAccount": map[string]interface{}{
+				"create": false,
+				"name":   karpenterName,
+			},
+			"settings": map[string]interface{}{
+				"aws": map[string]interface{}{
+					"clusterName":            k.spec.Metadata.Name,
+					"clusterEndpoint":        k.spec.Status.Endpoint,
+					"defaultInstanceProfile": k.resources.InstanceProfileName,
+					"interruptionQueueName":  k.resources.InterruptionQueueName,
+				},
+			},
+		},
+	}
+	if k.planMode {
+		logger.Info("(plan) would install version %s of Helm chart %q", release.Version, release.ChartReference())
+		return nil
+	}
+	if err := k.installer.Install(release); err != nil {
+		return errors.Wrapf(err, "deploying %q", karpenterName)
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("Karpenter", func() {
+	var (
+		installer *fakeChartInstaller
+		cfg       *api.ClusterConfig
+		resources *builder.KarpenterResources
+	)
+
+	BeforeEach(func() {
+		installer = &fakeChartInstaller{}
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Status = &api.ClusterStatus{Endpoint: "https://test-cluster.example.com"}
+		cfg.Karpenter = &api.Karpenter{Version: "v0.19.3"}
+
+		resources = &builder.KarpenterResources{
+			InstanceProfileName:   "eksctl-test-cluster-addon-karpenter-KarpenterNodeInstanceProfile",
+			InterruptionQueueName: "eksctl-test-cluster-addon-karpenter-KarpenterInterruptionQueue",
+		}
+	})
+
+	It("installs the chart of the given version configured with the resources of the Karpenter stack", func() {
+		err := NewKarpenter(installer, cfg, resources, false).Deploy()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(installer.releases).To(HaveLen(1))
+		release := installer.releases[0]
+		Expect(release.ChartReference()).To(Equal("oci://public.ecr.aws/karpenter/karpenter"))
+		Expect(release.Version).To(Equal("v0.19.3"))
+		Expect(release.Namespace).To(Equal("karpenter"))
+		Expect(release.Values).To(Equal(api.InlineDocument{
+			"serviceAccount": map[string]interface{}{
+				"create": false,
+				"name":   "karpenter",
+			},
+			"settings": map[string]interface{}{
+				"aws": map[string]interface{}{
+					"clusterName":            "test-cluster",
+					"clusterEndpoint":        "https://test-cluster.example.com",
+					"defaultInstanceProfile": resources.InstanceProfileName,
+					"interruptionQueueName":  resources.InterruptionQueueName,
+				},
+			},
+		}))
+	})
+
+	It("installs nothing in plan mode", func() {
+		err := NewKarpenter(installer, cfg, resources, true).Deploy()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(installer.releases).To(BeEmpty())
+	})
+
+	It("uses the service account in its own namespace with the controller policy", func() {
+		sa := KarpenterServiceAccount("arn:aws:iam::123456789012:policy/karpenter-controller")
+		Expect(sa.NameString()).To(Equal("karpenter/karpenter"))
+		Expect(sa.AttachPolicyARNs).To(ConsistOf("arn:aws:iam::123456789012:policy/karpenter-controller"))
+	})
+})
@@ -0,0 +1,246 @@
+package addons
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/pkg/errors"
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+//go:generate ${GOBIN}/go-bindata -pkg ${GOPACKAGE} -prefix assets -nometadata -o assets.go assets
+
+const (
+	vpcControllerNamespace = metav1.NamespaceSystem
+
+	vpcResourceControllerName = "vpc-resource-controller"
+	vpcAdmissionWebhookName   = "vpc-admission-webhook"
+
+	webhookCertsSecretName = "vpc-admission-webhook-certs"
+
+	// the webhook has no way of rotating its certificate, so it is made long-lived
+	webhookCertValidity = 10 * 365 * 24 * time.Hour
+	// certificates that expire sooner than this are replaced
+	webhookCertMinValidity = 30 * 24 * time.Hour
+
+	// webhookCertsChecksumAnnotation restarts the webhook whenever its certificate changes,
+	// as it only reads the certificate when it starts
+	webhookCertsChecksumAnnotation = "eksctl.io/webhook-certs-checksum"
+)
+
+// VPCController deploys the VPC resource controller and the VPC admission webhook,
+// which are required for Windows nodes to receive pod IP addresses
+type VPCController struct {
+	rawClient kubernetes.RawClientInterface
+	region    string
+	planMode  bool
+}
+
+// NewVPCController creates a new VPCController
+func NewVPCController(rawClient kubernetes.RawClientInterface, region string, planMode bool) *VPCController {
+	return &VPCController{
+		rawClient: rawClient,
+		region:    region,
+		planMode:  planMode,
+	}
+}
+
+// Deploy deploys the VPC controllers to the cluster
+func (v *VPCController) Deploy() error {
+	if err := v.deployVPCResourceController(); err != nil {
+		return errors.Wrapf(err, "deploying %q", vpcResourceControllerName)
+	}
+	if err := v.deployVPCAdmissionWebhook(); err != nil {
+		return errors.Wrapf(err, "deploying %q", vpcAdmissionWebhookName)
+	}
+	return nil
+}
+
+func (v *VPCController) deployVPCResourceController() error {
+	list, err := loadAsset(vpcResourceControllerName)
+	if err != nil {
+		return err
+	}
+	for _, rawObj := range list.Items {
+		if d, ok := rawObj.Object.(*appsv1.Deployment); ok {
+			useRegionalImage(&d.Spec.Template, v.region)
+		}
+	}
+	return applyResources(v.rawClient, list.Items, v.planMode)
+}
+
+func (v *VPCController) deployVPCAdmissionWebhook() error {
+	serviceName := vpcAdmissionWebhookName
+	caCert, cert, key, err := v.existingWebhookCertificates(serviceName, vpcControllerNamespace)
+	if err != nil {
+		return err
+	}
+	if caCert == nil {
+		logger.Info("generating certificates of %q", vpcAdmissionWebhookName)
+		if caCert, cert, key, err = generateWebhookCertificates(serviceName, vpcControllerNamespace); err != nil {
+			return errors.Wrap(err, "generating certificates")
+		}
+	}
+	checksum := sha256.Sum256(append(append([]byte{}, caCert...), cert...))
+
+	secret := &corev1.Secret{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "Secret",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      webhookCertsSecretName,
+			Namespace: vpcControllerNamespace,
+		},
+		Data: map[string][]byte{
+			"ca.pem":   caCert,
+			"cert.pem": cert,
+			"key.pem":  key,
+		},
+	}
+
+	list, err := loadAsset(vpcAdmissionWebhookName)
+	if err != nil {
+		return err
+	}
+	for _, rawObj := range list.Items {
+		switch obj := rawObj.Object.(type) {
+		case *appsv1.Deployment:
+			useRegionalImage(&obj.Spec.Template, v.region)
+			if obj.Spec.Template.Annotations == nil {
+				obj.Spec.Template.Annotations = map[string]string{}
+			}
+			obj.Spec.Template.Annotations[webhookCertsChecksumAnnotation] = hex.EncodeToString(checksum[:])
+		case *admissionregistration.MutatingWebhookConfiguration:
+			for i := range obj.Webhooks {
+				obj.Webhooks[i].ClientConfig.CABundle = caCert
+			}
+		}
+	}
+
+	return applyResources(v.rawClient, append([]runtime.RawExtension{{Object: secret}}, list.Items...), v.planMode)
+}
+
+// existingWebhookCertificates returns the certificates of the webhook that are stored in its secret, so that
+// the webhook keeps serving the certificate that its CA bundle trusts; none are returned when the secret
+// doesn't exist, or when its certificates are invalid or about to expire
+func (v *VPCController) existingWebhookCertificates(serviceName, namespace string) ([]byte, []byte, []byte, error) {
+	secret, err := v.rawClient.ClientSet().CoreV1().Secrets(namespace).Get(webhookCertsSecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil, nil, nil, nil
+	}
+	if err != nil {
+		return nil, nil, nil, errors.Wrapf(err, "getting secret %q", webhookCertsSecretName)
+	}
+	caCert, cert, key := secret.Data["ca.pem"], secret.Data["cert.pem"], secret.Data["key.pem"]
+	if err := validateWebhookCertificates(caCert, cert, key, fmt.Sprintf("%s.%s.svc", serviceName, namespace)); err != nil {
+		logger.Info("replacing the certificates of %q: %s", vpcAdmissionWebhookName, err.Error())
+		return nil, nil, nil, nil
+	}
+	return caCert, cert, key, nil
+}
+
+// validateWebhookCertificates checks that the serving certificate matches its key, is issued by the CA
+// for the given name, and doesn't expire soon
+func validateWebhookCertificates(caCert, cert, key []byte, dnsName string) error {
+	if len(caCert) == 0 {
+		return errors.New("the CA certificate is missing")
+	}
+	if _, err := tls.X509KeyPair(cert, key); err != nil {
+		return err
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caCert) {
+		return errors.New("the CA certificate can't be parsed")
+	}
+	block, _ := pem.Decode(cert)
+	if block == nil {
+		return errors.New("the serving certificate can't be parsed")
+	}
+	parsed, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return err
+	}
+	_, err = parsed.Verify(x509.VerifyOptions{
+		DNSName:     dnsName,
+		Roots:       roots,
+		CurrentTime: time.Now().Add(webhookCertMinValidity),
+		KeyUsages:   []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	})
+	return err
+}
+
+// generateWebhookCertificates creates a self-signed CA and uses it to
+// issue a serving certificate for the given service, it returns PEM-encoded
+// CA certificate, serving certificate and its private key
+func generateWebhookCertificates(serviceName, namespace string) ([]byte, []byte, []byte, error) {
+	notBefore := time.Now()
+	notAfter := notBefore.Add(webhookCertValidity)
+
+	caKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	caTemplate := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: serviceName + "-ca"},
+		NotBefore:             notBefore,
+		NotAfter:              notAfter,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTemplate, caTemplate, &caKey.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	commonName := fmt.Sprintf("%s.%s.svc", serviceName, namespace)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		DNSNames: []string{
+			serviceName,
+			fmt.Sprintf("%s.%s", serviceName, namespace),
+			commonName,
+		},
+		NotBefore:   notBefore,
+		NotAfter:    notAfter,
+		KeyUsage:    x509.KeyUsageDigitalSignature | x509.KeyUsageKeyEncipherment,
+		ExtKeyUsage: []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, caTemplate, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	encode := func(blockType string, data []byte) []byte {
+		buf := &bytes.Buffer{}
+		_ = pem.Encode(buf, &pem.Block{Type: blockType, Bytes: data})
+		return buf.Bytes()
+	}
+
+	return encode("CERTIFICATE", caDER), encode("CERTIFICATE", certDER), encode("RSA PRIVATE KEY", x509.MarshalPKCS1PrivateKey(key)), nil
+}
@@ -0,0 +1,88 @@
+package addons_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	admissionregistration "k8s.io/api/admissionregistration/v1beta1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	. "github.com/weaveworks/eksctl/pkg/addons"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+)
+
+var _ = Describe("VPC controller", func() {
+	var rawClient *testutils.FakeRawClient
+
+	BeforeEach(func() {
+		rawClient = testutils.NewFakeRawClient()
+		rawClient.AssumeObjectsMissing = true
+	})
+
+	It("creates all resources with regional images and webhook certificates", func() {
+		err := NewVPCController(rawClient, "eu-west-1", false).Deploy()
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(rawClient.Collection.Created()).To(HaveLen(8))
+
+		clientSet := rawClient.ClientSet()
+
+		for _, name := range []string{"vpc-resource-controller", "vpc-admission-webhook"} {
+			d, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get(name, metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(d.Spec.Template.Spec.Containers[0].Image).To(HavePrefix("602401143452.dkr.ecr.eu-west-1.amazonaws.com/eks/"))
+		}
+
+		secret, err := clientSet.CoreV1().Secrets(metav1.NamespaceSystem).Get("vpc-admission-webhook-certs", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(secret.Data).To(HaveKey("cert.pem"))
+		Expect(secret.Data).To(HaveKey("key.pem"))
+
+		var webhookConfig *admissionregistration.MutatingWebhookConfiguration
+		for _, item := range rawClient.Collection.CreatedItems() {
+			if obj, ok := item.(*admissionregistration.MutatingWebhookConfiguration); ok {
+				webhookConfig = obj
+			}
+		}
+		Expect(webhookConfig).ToNot(BeNil())
+		Expect(string(webhookConfig.Webhooks[0].ClientConfig.CABundle)).To(HavePrefix("-----BEGIN CERTIFICATE-----"))
+		Expect(secret.Data["ca.pem"]).To(Equal(webhookConfig.Webhooks[0].ClientConfig.CABundle))
+	})
+
+	It("reuses the certificates of the webhook when they are valid, so that it doesn't restart", func() {
+		Expect(NewVPCController(rawClient, "eu-west-1", false).Deploy()).To(Succeed())
+		clientSet := rawClient.ClientSet()
+		secret, err := clientSet.CoreV1().Secrets(metav1.NamespaceSystem).Get("vpc-admission-webhook-certs", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		webhook, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get("vpc-admission-webhook", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(webhook.Spec.Template.Annotations).To(HaveKey("eksctl.io/webhook-certs-checksum"))
+
+		rawClient.AssumeObjectsMissing = false
+		Expect(NewVPCController(rawClient, "eu-west-1", false).Deploy()).To(Succeed())
+
+		var (
+			updatedSecret  *corev1.Secret
+			updatedWebhook *appsv1.Deployment
+			webhookConfig  *admissionregistration.MutatingWebhookConfiguration
+		)
+		for _, item := range rawClient.Collection.UpdatedItems() {
+			switch obj := item.(type) {
+			case *corev1.Secret:
+				updatedSecret = obj
+			case *appsv1.Deployment:
+				if obj.Name == "vpc-admission-webhook" {
+					updatedWebhook = obj
+				}
+			case *admissionregistration.MutatingWebhookConfiguration:
+				webhookConfig = obj
+			}
+		}
+		Expect(updatedSecret.Data).To(Equal(secret.Data))
+		Expect(webhookConfig.Webhooks[0].ClientConfig.CABundle).To(Equal(secret.Data["ca.pem"]))
+		Expect(updatedWebhook.Spec.Template.Annotations).To(Equal(webhook.Spec.Template.Annotations))
+	})
+
+})
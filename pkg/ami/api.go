@@ -12,6 +12,7 @@ import (
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
 const (
@@ -21,6 +22,12 @@ const (
 	// ImageFamilyUbuntu1804 represents Ubuntu 18.04 family
 	ImageFamilyUbuntu1804 = api.NodeImageFamilyUbuntu1804 // Owner 099720109477
 
+	// ImageFamilyWindowsServer2019CoreContainer represents Windows 2019 core container family
+	ImageFamilyWindowsServer2019CoreContainer = api.NodeImageFamilyWindowsServer2019CoreContainer // Owner 801119661308
+
+	// ImageFamilyWindowsServer2019FullContainer represents Windows 2019 full container family
+	ImageFamilyWindowsServer2019FullContainer = api.NodeImageFamilyWindowsServer2019FullContainer // Owner 801119661308
+
 	// ResolverStatic is used to indicate that the static (i.e. compiled into eksctl) AMIs should be used
 	ResolverStatic = api.NodeImageResolverStatic
 	// ResolverAuto is used to indicate that the latest EKS AMIs should be used for the nodes. This implies
@@ -32,33 +39,63 @@ const (
 const (
 	ImageClassGeneral = iota
 	ImageClassGPU
+	ImageClassARM
 )
 
 // ImageClasses is a list of image class names
 var ImageClasses = []string{
 	"ImageClassGeneral",
 	"ImageClassGPU",
+	"ImageClassARM",
+}
+
+// imageClassFor returns the image class the instance type needs; the architecture is checked first,
+// as the GPU AMIs are x86_64 only, so that arm64 GPU instance types, e.g. g5g, get the arm64 AMI
+func imageClassFor(instanceType string) int {
+	switch {
+	case utils.IsARMInstanceType(instanceType):
+		return ImageClassARM
+	case utils.IsGPUInstanceType(instanceType):
+		return ImageClassGPU
+	default:
+		return ImageClassGeneral
+	}
 }
 
 // Use checks if a given AMI ID is available in AWS EC2 as well as checking and populating RootDevice information
 func Use(ec2api ec2iface.EC2API, ng *api.NodeGroup) error {
+	_, err := useImage(ec2api, ng)
+	return err
+}
+
+// UseCustom does what Use does for a custom AMI, i.e. one that the nodegroup sets rather than one that was resolved,
+// and also checks that the nodes can be bootstrapped the way the nodegroup does it
+func UseCustom(ec2api ec2iface.EC2API, ng *api.NodeGroup) error {
+	image, err := useImage(ec2api, ng)
+	if err != nil {
+		return err
+	}
+	return checkCustomImageBootstrap(image, ng)
+}
+
+func useImage(ec2api ec2iface.EC2API, ng *api.NodeGroup) (*ec2.Image, error) {
 	input := &ec2.DescribeImagesInput{
 		ImageIds: []*string{&ng.AMI},
 	}
 
 	output, err := ec2api.DescribeImages(input)
 	if err != nil {
-		return errors.Wrapf(err, "unable to find image %q", ng.AMI)
+		return nil, errors.Wrapf(err, "unable to find image %q", ng.AMI)
 	}
 
 	// This will never return more than one as we are looking up a single ami id
 	if len(output.Images) < 1 {
-		return NewErrNotFound(ng.AMI)
+		return nil, NewErrNotFound(ng.AMI)
 	}
 
 	// Instance-store AMIs cannot have their root volume size managed
 	if *output.Images[0].RootDeviceType == "instance-store" {
-		return fmt.Errorf("%q is an instance-store AMI and EBS block device mappings not supported for instance-store AMIs", ng.AMI)
+		return nil, fmt.Errorf("%q is an instance-store AMI and EBS block device mappings not supported for instance-store AMIs", ng.AMI)
 	}
 
 	if *output.Images[0].RootDeviceType == "ebs" {
@@ -72,12 +109,12 @@ func Use(ec2api ec2iface.EC2API, ng *api.NodeGroup) error {
 		} else {
 			// VolumeEncrypted cannot be false if the AMI being used is already encrypted.
 			if api.IsDisabled(ng.VolumeEncrypted) && api.IsEnabled(amiEncrypted) {
-				return fmt.Errorf("%q is an encrypted AMI and volumeEncrypted has been set to false", ng.AMI)
+				return nil, fmt.Errorf("%q is an encrypted AMI and volumeEncrypted has been set to false", ng.AMI)
 			}
 		}
 	}
 
-	return nil
+	return output.Images[0], nil
 }
 
 // FindImage will get the AMI to use for the EKS nodes by querying AWS EC2 API.
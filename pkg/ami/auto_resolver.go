@@ -4,10 +4,9 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
-	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // MakeImageSearchPatterns creates a map of image search patterns by image OS family and class
@@ -16,18 +15,42 @@ func MakeImageSearchPatterns(version string) map[string]map[int]string {
 		ImageFamilyAmazonLinux2: {
 			ImageClassGeneral: fmt.Sprintf("amazon-eks-node-%s-v*", version),
 			ImageClassGPU:     fmt.Sprintf("amazon-eks-gpu-node-%s-*", version),
+			ImageClassARM:     fmt.Sprintf("amazon-eks-arm64-node-%s-v*", version),
 		},
 		ImageFamilyUbuntu1804: {
 			ImageClassGeneral: fmt.Sprintf("ubuntu-eks/k8s_%s/images/*", version),
 		},
+		ImageFamilyWindowsServer2019CoreContainer: {
+			ImageClassGeneral: fmt.Sprintf("Windows_Server-2019-English-Core-EKS_Optimized-%s-*", version),
+		},
+		ImageFamilyWindowsServer2019FullContainer: {
+			ImageClassGeneral: fmt.Sprintf("Windows_Server-2019-English-Full-EKS_Optimized-%s-*", version),
+		},
 	}
 }
 
+// accounts that own the Ubuntu and Windows images in each partition, the Amazon Linux
+// images are owned by the account of EKS in the region
+var (
+	ubuntuOwnerAccountIDs = map[string]string{
+		api.PartitionAWS:   "099720109477",
+		api.PartitionChina: "837727238323",
+		api.PartitionUSGov: "513442679011",
+	}
+	windowsOwnerAccountIDs = map[string]string{
+		api.PartitionAWS:   "801119661308",
+		api.PartitionChina: "016951021795",
+		api.PartitionUSGov: "077303321853",
+	}
+)
+
 // OwnerAccountID returns the AWS account ID that owns worker AMI.
 func OwnerAccountID(imageFamily, region string) (string, error) {
 	switch imageFamily {
 	case ImageFamilyUbuntu1804:
-		return "099720109477", nil
+		return ubuntuOwnerAccountIDs[api.Partition(region)], nil
+	case ImageFamilyWindowsServer2019CoreContainer, ImageFamilyWindowsServer2019FullContainer:
+		return windowsOwnerAccountIDs[api.Partition(region)], nil
 	case ImageFamilyAmazonLinux2:
 		return api.EKSResourceAccountID(region), nil
 	default:
@@ -48,7 +71,15 @@ func (r *AutoResolver) Resolve(region, version, instanceType, imageFamily string
 
 	imageClasses := MakeImageSearchPatterns(version)[imageFamily]
 	namePattern := imageClasses[ImageClassGeneral]
-	if utils.IsGPUInstanceType(instanceType) {
+	switch imageClassFor(instanceType) {
+	case ImageClassARM:
+		var ok bool
+		namePattern, ok = imageClasses[ImageClassARM]
+		if !ok {
+			logger.Critical("image family %s doesn't support ARM image class", imageFamily)
+			return "", NewErrFailedResolution(region, version, instanceType, imageFamily)
+		}
+	case ImageClassGPU:
 		var ok bool
 		namePattern, ok = imageClasses[ImageClassGPU]
 		if !ok {
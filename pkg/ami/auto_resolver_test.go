@@ -57,6 +57,26 @@ var _ = Describe("AMI Auto Resolution", func() {
 				Expect(ownerAccount).To(BeEquivalentTo("099720109477"))
 				Expect(err).NotTo(HaveOccurred())
 			})
+
+			It("should return the Windows Account ID for Windows images", func() {
+				ownerAccount, err := OwnerAccountID(ImageFamilyWindowsServer2019FullContainer, region)
+				Expect(ownerAccount).To(BeEquivalentTo("801119661308"))
+				Expect(err).NotTo(HaveOccurred())
+			})
+
+			It("should return the AWS Account IDs of the partition in China and GovCloud", func() {
+				ownerAccount, err := OwnerAccountID(ImageFamilyAmazonLinux2, "cn-north-1")
+				Expect(ownerAccount).To(BeEquivalentTo("918309763551"))
+				Expect(err).NotTo(HaveOccurred())
+
+				ownerAccount, err = OwnerAccountID(ImageFamilyUbuntu1804, "cn-northwest-1")
+				Expect(ownerAccount).To(BeEquivalentTo("837727238323"))
+				Expect(err).NotTo(HaveOccurred())
+
+				ownerAccount, err = OwnerAccountID(ImageFamilyWindowsServer2019FullContainer, "us-gov-west-1")
+				Expect(ownerAccount).To(BeEquivalentTo("077303321853"))
+				Expect(err).NotTo(HaveOccurred())
+			})
 		})
 
 		Context("with a valid region and N instance type", func() {
@@ -120,6 +140,28 @@ var _ = Describe("AMI Auto Resolution", func() {
 					})
 				})
 
+				Context("and Windows ami is available", func() {
+					BeforeEach(func() {
+						imageState = "available"
+						imageFamily = "WindowsServer2019FullContainer"
+						version = "1.14"
+
+						_, p = createProviders()
+						addMockDescribeImages(p, "Windows_Server-2019-English-Full-EKS_Optimized-1.14-*", expectedAmi, imageState, "2019-10-08T23:25:53.000Z", ImageFamilyWindowsServer2019FullContainer)
+
+						resolver := NewAutoResolver(p.MockEC2())
+						resolvedAmi, err = resolver.Resolve(region, version, instanceType, imageFamily)
+					})
+
+					It("should not error", func() {
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should have returned an ami id", func() {
+						Expect(resolvedAmi).To(BeEquivalentTo(expectedAmi))
+					})
+				})
+
 				Context("and ami is NOT available", func() {
 					BeforeEach(func() {
 						imageState = "pending"
@@ -211,6 +253,64 @@ var _ = Describe("AMI Auto Resolution", func() {
 					})
 				})
 			})
+
+			Context("and arm64 instance type", func() {
+				BeforeEach(func() {
+					instanceType = "m6g.large"
+				})
+
+				Context("and ami is available", func() {
+					BeforeEach(func() {
+						imageState = "available"
+						imageFamily = "AmazonLinux2"
+
+						_, p = createProviders()
+						addMockDescribeImages(p, "amazon-eks-arm64-node-1.12-v*", expectedAmi, imageState, "2019-12-20T23:25:53.000Z", ImageFamilyAmazonLinux2)
+						resolver := NewAutoResolver(p.MockEC2())
+						resolvedAmi, err = resolver.Resolve(region, version, instanceType, imageFamily)
+					})
+
+					It("should not error", func() {
+						Expect(err).NotTo(HaveOccurred())
+					})
+
+					It("should have returned an ami id", func() {
+						Expect(resolvedAmi).To(BeEquivalentTo(expectedAmi))
+					})
+				})
+
+				Context("and arm64 GPU instance type", func() {
+					BeforeEach(func() {
+						instanceType = "g5g.xlarge"
+						imageState = "available"
+						imageFamily = "AmazonLinux2"
+
+						_, p = createProviders()
+						addMockDescribeImages(p, "amazon-eks-arm64-node-1.12-v*", expectedAmi, imageState, "2019-12-20T23:25:53.000Z", ImageFamilyAmazonLinux2)
+						resolver := NewAutoResolver(p.MockEC2())
+						resolvedAmi, err = resolver.Resolve(region, version, instanceType, imageFamily)
+					})
+
+					It("should have returned the arm64 ami id", func() {
+						Expect(err).NotTo(HaveOccurred())
+						Expect(resolvedAmi).To(BeEquivalentTo(expectedAmi))
+					})
+				})
+
+				Context("and image family has no arm64 ami", func() {
+					BeforeEach(func() {
+						imageFamily = "Ubuntu1804"
+
+						_, p = createProviders()
+						resolver := NewAutoResolver(p.MockEC2())
+						resolvedAmi, err = resolver.Resolve(region, version, instanceType, imageFamily)
+					})
+
+					It("should return an error", func() {
+						Expect(err).To(HaveOccurred())
+					})
+				})
+			})
 		})
 	})
 })
@@ -0,0 +1,75 @@
+package ami
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// platformDetailsLinux is the platform of Linux images other than those of RHEL and SUSE, which bring their own
+// platform details as they are licensed
+const platformDetailsLinux = "Linux/UNIX"
+
+// DetectImageFamily returns the image family of an EKS-optimized image from its name, or an empty string for
+// other images, e.g. custom ones built from them
+func DetectImageFamily(image *ec2.Image) string {
+	name := aws.StringValue(image.Name)
+	patterns := MakeImageSearchPatterns("*")
+	families := make([]string, 0, len(patterns))
+	for family := range patterns {
+		families = append(families, family)
+	}
+	sort.Strings(families)
+	for _, family := range families {
+		for _, pattern := range patterns[family] {
+			if matchImageNamePattern(pattern, name) {
+				return family
+			}
+		}
+	}
+	return ""
+}
+
+// matchImageNamePattern matches a name with a pattern of the name filter of DescribeImages, in which * matches
+// any characters, including slashes
+func matchImageNamePattern(pattern, name string) bool {
+	expr := "^" + strings.Replace(regexp.QuoteMeta(pattern), `\*`, ".*", -1) + "$"
+	return regexp.MustCompile(expr).MatchString(name)
+}
+
+// checkCustomImageBootstrap checks that the nodes of a custom AMI can be bootstrapped the way the nodegroup does
+// it, amiFamily selects the bootstrap script unless overrideBootstrapCommand or bootstrapScriptTemplate is set
+func checkCustomImageBootstrap(image *ec2.Image, ng *api.NodeGroup) error {
+	customBootstrap := ng.OverrideBootstrapCommand != nil || ng.BootstrapScriptTemplate != nil
+	windowsImage := strings.EqualFold(aws.StringValue(image.Platform), ec2.PlatformValuesWindows)
+	platformDetails := aws.StringValue(image.PlatformDetails)
+
+	if family := DetectImageFamily(image); family != "" {
+		if family != ng.AMIFamily {
+			return fmt.Errorf("%q is an EKS-optimized %s image, while nodegroup %q has AMI family %s; set amiFamily (or --node-ami-family) to %s", ng.AMI, family, ng.Name, ng.AMIFamily, family)
+		}
+		return nil
+	}
+
+	switch {
+	case windowsImage && !api.IsWindowsImage(ng.AMIFamily):
+		return fmt.Errorf("%q is a Windows image, while nodegroup %q has AMI family %s; set amiFamily (or --node-ami-family) to one of the Windows image families", ng.AMI, ng.Name, ng.AMIFamily)
+	case !windowsImage && api.IsWindowsImage(ng.AMIFamily):
+		return fmt.Errorf("%q is not a Windows image, while nodegroup %q has AMI family %s; set amiFamily (or --node-ami-family) to a Linux image family", ng.AMI, ng.Name, ng.AMIFamily)
+	case customBootstrap:
+		return nil
+	case !windowsImage && platformDetails != "" && platformDetails != platformDetailsLinux:
+		// the bootstrap scripts of eksctl need the kubelet that the EKS-optimized images set up
+		return fmt.Errorf("%q is a %s image, which eksctl can't bootstrap nodes of; set overrideBootstrapCommand or bootstrapScriptTemplate of nodegroup %q", ng.AMI, platformDetails, ng.Name)
+	default:
+		logger.Warning("%q isn't an EKS-optimized image, the nodes of nodegroup %q will be bootstrapped as those of %s images; if they don't join the cluster, set overrideBootstrapCommand or bootstrapScriptTemplate", ng.AMI, ng.Name, ng.AMIFamily)
+		return nil
+	}
+}
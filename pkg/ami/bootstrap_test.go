@@ -0,0 +1,91 @@
+package ami_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/weaveworks/eksctl/pkg/ami"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Custom AMI bootstrap", func() {
+	var (
+		p     *mockprovider.MockProvider
+		ng    *api.NodeGroup
+		image *ec2.Image
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		ng = api.NewClusterConfig().NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.AMI = "ami-0123456789abcdef0"
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+		image = &ec2.Image{
+			ImageId:         aws.String(ng.AMI),
+			Name:            aws.String("golden-eks-node-2020-03-01"),
+			PlatformDetails: aws.String("Linux/UNIX"),
+			RootDeviceType:  aws.String("ebs"),
+			BlockDeviceMappings: []*ec2.BlockDeviceMapping{
+				{Ebs: &ec2.EbsBlockDevice{Encrypted: aws.Bool(false)}},
+			},
+		}
+		p.MockEC2().On("DescribeImages", mock.Anything).Return(func(*ec2.DescribeImagesInput) *ec2.DescribeImagesOutput {
+			return &ec2.DescribeImagesOutput{Images: []*ec2.Image{image}}
+		}, nil)
+	})
+
+	It("detects the image family of EKS-optimized images", func() {
+		for name, family := range map[string]string{
+			"amazon-eks-node-1.15-v20200228":                               api.NodeImageFamilyAmazonLinux2,
+			"amazon-eks-gpu-node-1.15-v20200228":                           api.NodeImageFamilyAmazonLinux2,
+			"amazon-eks-arm64-node-1.15-v20200228":                         api.NodeImageFamilyAmazonLinux2,
+			"ubuntu-eks/k8s_1.15/images/hvm-ssd/ubuntu-bionic-18.04-amd64": api.NodeImageFamilyUbuntu1804,
+			"Windows_Server-2019-English-Core-EKS_Optimized-1.15-2020.02":  api.NodeImageFamilyWindowsServer2019CoreContainer,
+			"Windows_Server-2019-English-Full-EKS_Optimized-1.15-2020.02":  api.NodeImageFamilyWindowsServer2019FullContainer,
+			"golden-eks-node-2020-03-01":                                   "",
+		} {
+			Expect(DetectImageFamily(&ec2.Image{Name: aws.String(name)})).To(Equal(family), name)
+		}
+	})
+
+	It("accepts EKS-optimized images of the AMI family", func() {
+		image.Name = aws.String("amazon-eks-node-1.15-v20200228")
+
+		Expect(UseCustom(p.MockEC2(), ng)).To(Succeed())
+		Expect(ng.VolumeEncrypted).To(Equal(aws.Bool(false)))
+	})
+
+	It("rejects EKS-optimized images of another AMI family", func() {
+		image.Name = aws.String("ubuntu-eks/k8s_1.15/images/hvm-ssd/ubuntu-bionic-18.04-amd64")
+
+		err := UseCustom(p.MockEC2(), ng)
+		Expect(err).To(MatchError(`"ami-0123456789abcdef0" is an EKS-optimized Ubuntu1804 image, while nodegroup "ng-1" has AMI family AmazonLinux2; set amiFamily (or --node-ami-family) to Ubuntu1804`))
+	})
+
+	It("rejects Windows images of Linux AMI families and the other way around", func() {
+		image.Platform = aws.String("windows")
+		image.PlatformDetails = aws.String("Windows")
+		Expect(UseCustom(p.MockEC2(), ng)).To(MatchError(ContainSubstring("is a Windows image, while nodegroup \"ng-1\" has AMI family AmazonLinux2")))
+
+		image.Platform, image.PlatformDetails = nil, aws.String("Linux/UNIX")
+		ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+		Expect(UseCustom(p.MockEC2(), ng)).To(MatchError(ContainSubstring("is not a Windows image, while nodegroup \"ng-1\" has AMI family WindowsServer2019CoreContainer")))
+	})
+
+	It("requires a custom bootstrap for images of other Linux distributions", func() {
+		image.PlatformDetails = aws.String("Red Hat Enterprise Linux")
+		Expect(UseCustom(p.MockEC2(), ng)).To(MatchError(`"ami-0123456789abcdef0" is a Red Hat Enterprise Linux image, which eksctl can't bootstrap nodes of; set overrideBootstrapCommand or bootstrapScriptTemplate of nodegroup "ng-1"`))
+
+		ng.BootstrapScriptTemplate = aws.String("#!/bin/bash\n/opt/bootstrap.sh {{.ClusterName}}\n")
+		Expect(UseCustom(p.MockEC2(), ng)).To(Succeed())
+	})
+
+	It("bootstraps other custom Linux images as those of the AMI family", func() {
+		Expect(UseCustom(p.MockEC2(), ng)).To(Succeed())
+	})
+})
@@ -0,0 +1,112 @@
+package ami
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	"github.com/weaveworks/eksctl/pkg/utils/file"
+)
+
+// StaticManifest maps versions, image families, image classes and regions to AMIs, the same
+// way as StaticImages does, but with the image classes named general, gpu and arm
+type StaticManifest map[string]map[string]map[string]map[string]string
+
+// staticManifestImageClasses are the image classes by their name in a StaticManifest
+var staticManifestImageClasses = map[string]int{
+	"general": ImageClassGeneral,
+	"gpu":     ImageClassGPU,
+	"arm":     ImageClassARM,
+}
+
+var amiIDPattern = regexp.MustCompile(`^ami-[0-9a-f]+$`)
+
+// staticManifestImages are the AMIs of the loaded manifest, they take precedence over StaticImages
+var staticManifestImages map[string]map[string]map[int]map[string]string
+
+// LoadStaticManifest loads a manifest of AMIs in YAML or JSON, whose AMIs take precedence over
+// those compiled into eksctl for the same version, image family, image class and region; this
+// lets the static resolvers resolve AMIs released after eksctl, or those of other partitions,
+// without calling AWS APIs. An empty path unloads the manifest
+func LoadStaticManifest(path string) error {
+	staticManifestImages = nil
+	if path == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(file.ExpandPath(path))
+	if err != nil {
+		return errors.Wrapf(err, "reading AMI manifest %q", path)
+	}
+	manifest := StaticManifest{}
+	if err := yaml.UnmarshalStrict(data, &manifest); err != nil {
+		return errors.Wrapf(err, "parsing AMI manifest %q", path)
+	}
+	images, err := manifest.images()
+	if err != nil {
+		return errors.Wrapf(err, "invalid AMI manifest %q", path)
+	}
+	staticManifestImages = images
+	return nil
+}
+
+// images converts the manifest to the form of StaticImages, checking its image classes and AMIs
+func (m StaticManifest) images() (map[string]map[string]map[int]map[string]string, error) {
+	images := map[string]map[string]map[int]map[string]string{}
+	for version, families := range m {
+		images[version] = map[string]map[int]map[string]string{}
+		for family, classes := range families {
+			images[version][family] = map[int]map[string]string{}
+			for className, regionalAMIs := range classes {
+				imageClass, ok := staticManifestImageClasses[className]
+				if !ok {
+					return nil, fmt.Errorf("%s.%s: unknown image class %q, valid image classes are %v", version, family, className, staticManifestImageClassNames())
+				}
+				for region, id := range regionalAMIs {
+					if !amiIDPattern.MatchString(id) {
+						return nil, fmt.Errorf("%s.%s.%s.%s: %q is not an AMI ID", version, family, className, region, id)
+					}
+				}
+				images[version][family][imageClass] = regionalAMIs
+			}
+		}
+	}
+	return images, nil
+}
+
+func staticManifestImageClassNames() []string {
+	names := make([]string, 0, len(staticManifestImageClasses))
+	for name := range staticManifestImageClasses {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// staticRegionalImages returns the AMIs of each region for the version, image family and image class,
+// those of the loaded manifest override those of StaticImages; ok is false if neither has the image class
+func staticRegionalImages(version, imageFamily string, imageClass int) (regionalAMIs map[string]string, ok bool) {
+	compiled, compiledOK := StaticImages[version][imageFamily][imageClass]
+	loaded, loadedOK := staticManifestImages[version][imageFamily][imageClass]
+	if !loadedOK {
+		return compiled, compiledOK
+	}
+	regionalAMIs = map[string]string{}
+	for region, id := range compiled {
+		regionalAMIs[region] = id
+	}
+	for region, id := range loaded {
+		regionalAMIs[region] = id
+	}
+	return regionalAMIs, true
+}
+
+// HasStaticImage returns true if the static resolvers have an AMI of the image class that the
+// instance type needs, so that they resolve it without falling back to another image class
+func HasStaticImage(region, version, instanceType, imageFamily string) bool {
+	regionalAMIs, _ := staticRegionalImages(version, imageFamily, imageClassFor(instanceType))
+	return regionalAMIs[region] != ""
+}
@@ -0,0 +1,65 @@
+package ami_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/ami"
+)
+
+var _ = Describe("AMI manifest", func() {
+	BeforeEach(func() {
+		ami.DefaultResolvers = []ami.Resolver{&ami.StaticGPUResolver{}, &ami.StaticDefaultResolver{}}
+	})
+
+	AfterEach(func() {
+		Expect(ami.LoadStaticManifest("")).To(Succeed())
+	})
+
+	It("takes precedence over the static AMIs", func() {
+		Expect(ami.LoadStaticManifest("testdata/manifest.yaml")).To(Succeed())
+
+		Expect(ami.Resolve("us-west-2", "1.12", "t2.medium", "AmazonLinux2")).To(Equal("ami-0123456789abcdef0"))
+		Expect(ami.Resolve("cn-north-1", "1.12", "t2.medium", "AmazonLinux2")).To(Equal("ami-0fedcba9876543210"))
+		Expect(ami.Resolve("us-west-2", "1.12", "a1.large", "AmazonLinux2")).To(Equal("ami-0a1b2c3d4e5f60718"))
+		Expect(ami.Resolve("us-west-2", "1.12", "g5g.xlarge", "AmazonLinux2")).To(Equal("ami-0a1b2c3d4e5f60718"))
+		Expect(ami.HasStaticImage("us-west-2", "1.12", "g5g.xlarge", "AmazonLinux2")).To(BeTrue())
+		Expect(ami.HasStaticImage("cn-north-1", "1.12", "t2.medium", "AmazonLinux2")).To(BeTrue())
+	})
+
+	It("keeps the static AMIs of the regions it doesn't have", func() {
+		Expect(ami.LoadStaticManifest("testdata/manifest.yaml")).To(Succeed())
+
+		Expect(ami.Resolve("us-east-1", "1.12", "t2.medium", "AmazonLinux2")).To(Equal("ami-01e370f796735b244"))
+		Expect(ami.Resolve("us-west-2", "1.12", "p2.xlarge", "AmazonLinux2")).To(Equal("ami-0c9156d7fcd3c2948"))
+	})
+
+	It("is unloaded by an empty path", func() {
+		Expect(ami.LoadStaticManifest("testdata/manifest.yaml")).To(Succeed())
+		Expect(ami.LoadStaticManifest("")).To(Succeed())
+
+		Expect(ami.Resolve("us-west-2", "1.12", "t2.medium", "AmazonLinux2")).To(Equal("ami-0b520e822d42998c1"))
+		Expect(ami.HasStaticImage("cn-north-1", "1.12", "t2.medium", "AmazonLinux2")).To(BeFalse())
+	})
+
+	It("only has the GPU AMIs of GPU instance types", func() {
+		Expect(ami.HasStaticImage("us-west-2", "1.12", "p2.xlarge", "AmazonLinux2")).To(BeTrue())
+		Expect(ami.HasStaticImage("us-west-2", "1.12", "p2.xlarge", "Ubuntu1804")).To(BeFalse())
+	})
+
+	It("rejects unknown image classes", func() {
+		err := ami.LoadStaticManifest("testdata/bad-class.yaml")
+		Expect(err).To(MatchError(`invalid AMI manifest "testdata/bad-class.yaml": 1.12.AmazonLinux2: unknown image class "inferentia", valid image classes are [arm general gpu]`))
+	})
+
+	It("rejects values that aren't AMI IDs", func() {
+		err := ami.LoadStaticManifest("testdata/bad-ami.yaml")
+		Expect(err).To(MatchError(`invalid AMI manifest "testdata/bad-ami.yaml": 1.12.AmazonLinux2.general.us-west-2: "ubuntu-18.04" is not an AMI ID`))
+	})
+
+	It("fails when the manifest can't be read", func() {
+		err := ami.LoadStaticManifest("testdata/nothing.yaml")
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix(`reading AMI manifest "testdata/nothing.yaml"`))
+	})
+})
@@ -1,8 +1,7 @@
 package ami
 
 import (
-	"github.com/kris-nova/logger"
-	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 //go:generate go run ./static_resolver_ami_generate.go
@@ -12,11 +11,16 @@ type StaticDefaultResolver struct {
 }
 
 // Resolve will return an AMI to use based on the default AMI for each region
-// currently source of truth for these is here
+// currently source of truth for these is here, unless a manifest was loaded
 func (r *StaticDefaultResolver) Resolve(region, version, instanceType, imageFamily string) (string, error) {
 	logger.Debug("resolving AMI using StaticDefaultResolver for region %s, version %s, instanceType %s and imageFamily %s", region, version, instanceType, imageFamily)
 
-	regionalAMIs := StaticImages[version][imageFamily][ImageClassGeneral]
+	imageClass := ImageClassGeneral
+	if imageClassFor(instanceType) == ImageClassARM {
+		imageClass = ImageClassARM
+	}
+
+	regionalAMIs, _ := staticRegionalImages(version, imageFamily, imageClass)
 	return regionalAMIs[region], nil
 }
 
@@ -28,12 +32,12 @@ type StaticGPUResolver struct {
 func (r *StaticGPUResolver) Resolve(region, version, instanceType, imageFamily string) (string, error) {
 	logger.Debug("resolving AMI using StaticGPUResolver for region %s, instanceType %s and imageFamily %s", region, instanceType, imageFamily)
 
-	if !utils.IsGPUInstanceType(instanceType) {
-		logger.Debug("can't resolve AMI using StaticGPUResolver as instance type %s is non-GPU", instanceType)
+	if imageClassFor(instanceType) != ImageClassGPU {
+		logger.Debug("can't resolve AMI using StaticGPUResolver as instance type %s is non-GPU or arm64", instanceType)
 		return "", nil
 	}
 
-	regionalAMIs, ok := StaticImages[version][imageFamily][ImageClassGPU]
+	regionalAMIs, ok := staticRegionalImages(version, imageFamily, ImageClassGPU)
 	if !ok {
 		logger.Critical("image family %s doesn't support GPU image class", imageFamily)
 		return "", NewErrFailedResolution(region, version, instanceType, imageFamily)
@@ -38,7 +38,7 @@ func main() {
 			imageClasses := imageFamilies[family]
 			for class := range imageClasses {
 				classImages := Dict{}
-				for _, region := range api.SupportedRegions() {
+				for _, region := range awsPartitionRegions() {
 					namePattern := imageClasses[class]
 					ownerAccount, err := ami.OwnerAccountID(family, region)
 					if err != nil {
@@ -84,9 +84,21 @@ func newSession(region string) *session.Session {
 	return session.Must(session.NewSessionWithOptions(opts))
 }
 
+// awsPartitionRegions returns the supported regions of the aws partition, the images of GovCloud
+// and China can't be looked up with the same credentials, so nodes there use the auto resolver
+func awsPartitionRegions() []string {
+	regions := []string{}
+	for _, region := range api.SupportedRegions() {
+		if api.Partition(region) == api.PartitionAWS {
+			regions = append(regions, region)
+		}
+	}
+	return regions
+}
+
 func newMultiRegionClient() map[string]*ec2.EC2 {
 	clients := make(map[string]*ec2.EC2)
-	for _, region := range api.SupportedRegions() {
+	for _, region := range awsPartitionRegions() {
 		clients[region] = ec2.New(newSession(region))
 	}
 	return clients
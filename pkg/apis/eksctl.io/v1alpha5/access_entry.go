@@ -0,0 +1,81 @@
+package v1alpha5
+
+const (
+	// AuthenticationModeConfigMap only uses the aws-auth ConfigMap to authenticate IAM principals
+	AuthenticationModeConfigMap = "CONFIG_MAP"
+	// AuthenticationModeAPIAndConfigMap uses access entries, as well as the aws-auth ConfigMap
+	AuthenticationModeAPIAndConfigMap = "API_AND_CONFIG_MAP"
+	// AuthenticationModeAPI only uses access entries
+	AuthenticationModeAPI = "API"
+
+	// AccessScopeCluster grants the permissions of an access policy in all namespaces
+	AccessScopeCluster = "cluster"
+	// AccessScopeNamespace grants the permissions of an access policy in the given namespaces
+	AccessScopeNamespace = "namespace"
+)
+
+// SupportedAuthenticationModes are the authentication modes of EKS clusters, in the order
+// they can be switched to, EKS doesn't allow going back to a previous mode
+func SupportedAuthenticationModes() []string {
+	return []string{AuthenticationModeConfigMap, AuthenticationModeAPIAndConfigMap, AuthenticationModeAPI}
+}
+
+// SupportedAccessEntryTypes are the types of access entries
+func SupportedAccessEntryTypes() []string {
+	return []string{"STANDARD", "EC2_LINUX", "EC2_WINDOWS", "FARGATE_LINUX"}
+}
+
+// AccessConfig defines how IAM principals authenticate with the cluster
+type AccessConfig struct {
+	// AuthenticationMode is one of CONFIG_MAP, API_AND_CONFIG_MAP or API,
+	// the mode of a cluster can only be changed in that order
+	// +optional
+	AuthenticationMode string `json:"authenticationMode,omitempty"`
+}
+
+// AccessEntry grants an IAM principal access to the cluster through the EKS API,
+// as an alternative to mapping it in the aws-auth ConfigMap
+type AccessEntry struct {
+	// PrincipalARN of the IAM role or user
+	PrincipalARN string `json:"principalARN"`
+
+	// Type is one of STANDARD, EC2_LINUX, EC2_WINDOWS or FARGATE_LINUX,
+	// defaults to STANDARD
+	// +optional
+	Type string `json:"type,omitempty"`
+
+	// +optional
+	KubernetesUsername string `json:"kubernetesUsername,omitempty"`
+
+	// KubernetesGroups are used in RBAC bindings, groups starting
+	// with "system:" are not allowed
+	// +optional
+	KubernetesGroups []string `json:"kubernetesGroups,omitempty"`
+
+	// AccessPolicies are associated with the principal, e.g.
+	// arn:aws:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy
+	// +optional
+	AccessPolicies []AccessPolicy `json:"accessPolicies,omitempty"`
+}
+
+// AccessPolicy is an EKS access policy associated with an access entry
+type AccessPolicy struct {
+	PolicyARN string `json:"policyARN"`
+
+	AccessScope AccessScope `json:"accessScope"`
+}
+
+// AccessScope limits the permissions of an access policy
+type AccessScope struct {
+	// Type is either cluster or namespace
+	Type string `json:"type"`
+
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+}
+
+// UsesAccessEntries checks if the cluster authenticates IAM principals with access entries
+func (c *ClusterConfig) UsesAccessEntries() bool {
+	return c.AccessConfig != nil && (c.AccessConfig.AuthenticationMode == AuthenticationModeAPIAndConfigMap ||
+		c.AccessConfig.AuthenticationMode == AuthenticationModeAPI)
+}
@@ -0,0 +1,166 @@
+package v1alpha5
+
+import (
+	"bytes"
+	"encoding/json"
+)
+
+// Addons that can be installed by eksctl
+const (
+	// ClusterAutoscalerAddon installs cluster-autoscaler with an IAM role for its service account
+	ClusterAutoscalerAddon = "cluster-autoscaler"
+
+	// ALBIngressAddon installs the AWS Load Balancer Controller with an IAM role for its service account
+	ALBIngressAddon = "alb-ingress"
+
+	// VPCCNIAddon is the Amazon VPC CNI plugin, managed via EKS Addons API
+	VPCCNIAddon = "vpc-cni"
+
+	// CoreDNSAddon is CoreDNS, managed via EKS Addons API
+	CoreDNSAddon = "coredns"
+
+	// KubeProxyAddon is kube-proxy, managed via EKS Addons API
+	KubeProxyAddon = "kube-proxy"
+
+	// EBSCSIDriverAddon is the Amazon EBS CSI driver, managed via EKS Addons API
+	EBSCSIDriverAddon = "aws-ebs-csi-driver"
+
+	// PodIdentityAgentAddon is the EKS Pod Identity agent, managed via EKS Addons API, which
+	// is needed by pods whose service account has a pod identity association
+	PodIdentityAgentAddon = "eks-pod-identity-agent"
+)
+
+// MinimumVersionForALBIngress is the oldest Kubernetes version that a release of the AWS Load Balancer Controller supports
+const MinimumVersionForALBIngress = "1.15"
+
+// Values for Addon.ResolveConflicts
+const (
+	// AddonResolveConflictsNone makes EKS fail to create or update the addon when
+	// fields it manages were changed in the cluster
+	AddonResolveConflictsNone = "none"
+
+	// AddonResolveConflictsOverwrite makes EKS overwrite fields it manages that were changed in the cluster
+	AddonResolveConflictsOverwrite = "overwrite"
+
+	// AddonResolveConflictsPreserve makes EKS keep fields it manages that were changed in the cluster
+	AddonResolveConflictsPreserve = "preserve"
+)
+
+// eksAddonServiceAccounts holds names of the service accounts in kube-system
+// used by EKS addons that need AWS permissions
+var eksAddonServiceAccounts = map[string]string{
+	VPCCNIAddon:       "aws-node",
+	EBSCSIDriverAddon: "ebs-csi-controller-sa",
+}
+
+// ClusterAutoscalerEnabledTag is the tag by which cluster-autoscaler discovers auto scaling groups,
+// along with the tag returned by ClusterAutoscalerClusterTag
+const ClusterAutoscalerEnabledTag = "k8s.io/cluster-autoscaler/enabled"
+
+// ClusterAutoscalerClusterTag returns the tag that marks auto scaling groups as part of the cluster for cluster-autoscaler
+func ClusterAutoscalerClusterTag(clusterName string) string {
+	return "k8s.io/cluster-autoscaler/" + clusterName
+}
+
+// Addon holds the configuration of an addon installed by eksctl
+type Addon struct {
+	Name string `json:"name"`
+
+	// Version of an EKS addon, EKS picks the default version for
+	// the cluster when it's not set
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// ServiceAccountRoleARN of an existing IAM role to be used by the
+	// service account of an EKS addon
+	// +optional
+	ServiceAccountRoleARN string `json:"serviceAccountRoleARN,omitempty"`
+
+	// AttachPolicyARNs of policies to attach to an IAM role that eksctl
+	// creates for the service account of an EKS addon
+	// +optional
+	AttachPolicyARNs []string `json:"attachPolicyARNs,omitempty"`
+
+	// AttachPolicy holds a policy document to attach to an IAM role that
+	// eksctl creates for the service account of an EKS addon
+	// +optional
+	AttachPolicy InlineDocument `json:"attachPolicy,omitempty"`
+
+	// ResolveConflicts defines how EKS handles fields of an EKS addon that
+	// were changed in the cluster, valid variants are `"none"` (default),
+	// `"overwrite"` and `"preserve"`
+	// +optional
+	ResolveConflicts string `json:"resolveConflicts,omitempty"`
+}
+
+// UnmarshalJSON allows addons without any configuration to be given by name,
+// e.g. `addons: [cluster-autoscaler]`
+func (a *Addon) UnmarshalJSON(data []byte) error {
+	var name string
+	if err := json.Unmarshal(data, &name); err == nil {
+		a.Name = name
+		return nil
+	}
+
+	type addon Addon
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+	return decoder.Decode((*addon)(a))
+}
+
+// SupportedAddons returns names of all addons that can be installed by eksctl
+func SupportedAddons() []string {
+	return []string{ClusterAutoscalerAddon, ALBIngressAddon, VPCCNIAddon, CoreDNSAddon, KubeProxyAddon, EBSCSIDriverAddon, PodIdentityAgentAddon}
+}
+
+// IsEKSAddon returns true if the addon is managed via EKS Addons API,
+// rather than deployed by eksctl
+func (a *Addon) IsEKSAddon() bool {
+	return a.Name != ClusterAutoscalerAddon && a.Name != ALBIngressAddon
+}
+
+// ServiceAccountName returns the name of the service account in kube-system used
+// by the addon, or an empty string if the addon doesn't need AWS permissions
+func (a *Addon) ServiceAccountName() string {
+	return eksAddonServiceAccounts[a.Name]
+}
+
+// EKSAddons returns the addons managed via EKS Addons API
+func (c *ClusterConfig) EKSAddons() []*Addon {
+	addons := []*Addon{}
+	for _, addon := range c.Addons {
+		if addon.IsEKSAddon() {
+			addons = append(addons, addon)
+		}
+	}
+	return addons
+}
+
+// HasAddon returns true if the addon with the given name is enabled
+func (c *ClusterConfig) HasAddon(name string) bool {
+	for _, addon := range c.Addons {
+		if addon.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// needsIAMServiceAccount returns true if the addon gets its permissions via an iamserviceaccount
+func (a *Addon) needsIAMServiceAccount() bool {
+	return !a.IsEKSAddon() || a.hasPolicies()
+}
+
+// hasPolicies returns true if policies are given for the IAM role of the service account of the addon
+func (a *Addon) hasPolicies() bool {
+	return len(a.AttachPolicyARNs) > 0 || a.AttachPolicy != nil
+}
+
+func (c *ClusterConfig) hasAddonWithIAMServiceAccount() bool {
+	for _, addon := range c.Addons {
+		if addon.needsIAMServiceAccount() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,52 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+)
+
+// AWSConfig holds the options of the AWS clients that eksctl uses
+type AWSConfig struct {
+	// UseFIPSEndpoints makes AWS APIs be called at their FIPS 140-2 validated endpoints,
+	// e.g. for FedRAMP workloads; services that have no FIPS endpoint in the region
+	// are called at their standard endpoint
+	// +optional
+	UseFIPSEndpoints *bool `json:"useFIPSEndpoints,omitempty"`
+}
+
+// fipsRegions are the regions of the aws partition that have FIPS endpoints, all
+// regions of GovCloud have them and those of China don't
+var fipsRegions = []string{
+	RegionUSEast1,
+	RegionUSEast2,
+	RegionUSWest2,
+	RegionCACentral1,
+}
+
+// UseFIPSEndpoints returns true if the config makes AWS APIs be called at their FIPS endpoints
+func (c *ClusterConfig) UseFIPSEndpoints() bool {
+	return c.AWSConfig != nil && IsEnabled(c.AWSConfig.UseFIPSEndpoints)
+}
+
+// ValidateFIPSEndpoints checks that AWS APIs can be called at FIPS endpoints in region, and that
+// none of the endpoints that override them would bypass TLS
+func ValidateFIPSEndpoints(region string, endpoints *AWSEndpoints) error {
+	if Partition(region) != PartitionUSGov && !isOneOf(region, fipsRegions) {
+		return fmt.Errorf("FIPS endpoints are not available in region %s, they are in GovCloud and in %v", region, fipsRegions)
+	}
+	if endpoints == nil {
+		return nil
+	}
+	services := make([]string, 0, len(endpoints.Services))
+	for service := range endpoints.Services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		if u, err := url.Parse(endpoints.Services[service]); err == nil && u.Scheme != "https" {
+			return fmt.Errorf("awsEndpoints.services.%s must be an https URL when FIPS endpoints are used", service)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// supportedServiceEndpoints are the names of the AWS services whose endpoints can be overridden
+var supportedServiceEndpoints = []string{
+	"autoscaling",
+	"cloudformation",
+	"cloudtrail",
+	"cloudwatchlogs",
+	"ec2",
+	"eks",
+	"elb",
+	"elbv2",
+	"eventbridge",
+	"iam",
+	"pricing",
+	"s3",
+	"servicequotas",
+	"ssm",
+	"sts",
+}
+
+// AWSEndpoints overrides the endpoints of the AWS APIs that eksctl calls
+type AWSEndpoints struct {
+	// STSRegional makes STS be called at the endpoint of the region instead of the global endpoint,
+	// e.g. when only regional endpoints can be reached from the network eksctl runs in
+	// +optional
+	STSRegional *bool `json:"stsRegional,omitempty"`
+
+	// Services maps the names of services, e.g. eks, cloudformation, ec2, sts and iam, to the URLs
+	// of their endpoints, e.g. those of VPC interface endpoints or of localstack; the
+	// AWS_<SERVICE>_ENDPOINT environment variables take precedence
+	// +optional
+	Services map[string]string `json:"services,omitempty"`
+}
+
+// SupportedServiceEndpoints returns the names of the services whose endpoints can be overridden
+func SupportedServiceEndpoints() []string {
+	return supportedServiceEndpoints
+}
+
+func validateAWSEndpoints(e *AWSEndpoints) error {
+	if e == nil {
+		return nil
+	}
+	services := make([]string, 0, len(e.Services))
+	for service := range e.Services {
+		services = append(services, service)
+	}
+	sort.Strings(services)
+	for _, service := range services {
+		path := fmt.Sprintf("awsEndpoints.services.%s", service)
+		if !isSupportedServiceEndpoint(service) {
+			return fmt.Errorf("%s is not supported, the endpoints of %s can be overridden", path, strings.Join(supportedServiceEndpoints, ", "))
+		}
+		u, err := url.Parse(e.Services[service])
+		if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+			return fmt.Errorf("%s must be an http or https URL", path)
+		}
+	}
+	return nil
+}
+
+func isSupportedServiceEndpoint(service string) bool {
+	for _, s := range supportedServiceEndpoints {
+		if s == service {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,18 @@
+package v1alpha5
+
+// ClusterBackup holds the S3 location of snapshots of the cluster, which are taken before each
+// command that changes it; a snapshot has the templates, parameters and tags of all stacks of the
+// cluster, the config that the command was run with and the aws-auth ConfigMap
+type ClusterBackup struct {
+	// S3Bucket is the name of the bucket that snapshots are written to, each one under a key
+	// with the time it was taken, e.g. `<prefix>/us-west-2/cluster-1/20200102T150405Z/`
+	S3Bucket string `json:"s3Bucket"`
+	// S3Prefix of the keys of snapshots
+	// +optional
+	S3Prefix string `json:"s3Prefix,omitempty"`
+}
+
+// HasBackup checks if snapshots of the cluster are written to S3
+func (c *ClusterConfig) HasBackup() bool {
+	return c.Backup != nil && c.Backup.S3Bucket != ""
+}
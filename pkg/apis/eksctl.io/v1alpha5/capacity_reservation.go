@@ -0,0 +1,33 @@
+package v1alpha5
+
+// Values for the preference of capacity reservations
+const (
+	CapacityReservationPreferenceOpen = "open"
+	CapacityReservationPreferenceNone = "none"
+)
+
+// NodeGroupCapacityReservation configures the capacity reservation that the nodes are launched into,
+// either a specific capacity reservation or capacity block is targeted by its ID, or any open
+// capacity reservation is used depending on the preference
+type NodeGroupCapacityReservation struct {
+	// ID of the capacity reservation or capacity block to launch the nodes into
+	// +optional
+	ID string `json:"id,omitempty"`
+
+	// Preference for capacity reservations when no ID is set, either "open" or "none"
+	// +optional
+	Preference string `json:"preference,omitempty"`
+
+	// CapacityBlock has to be set when the ID refers to a capacity block for ML,
+	// the nodes are then launched with the capacity block market type
+	// +optional
+	CapacityBlock *bool `json:"capacityBlock,omitempty"`
+}
+
+// SupportedCapacityReservationPreferences are the preferences for capacity reservations of nodegroups
+func SupportedCapacityReservationPreferences() []string {
+	return []string{
+		CapacityReservationPreferenceOpen,
+		CapacityReservationPreferenceNone,
+	}
+}
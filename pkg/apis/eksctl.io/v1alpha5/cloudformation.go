@@ -0,0 +1,88 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// CloudFormation configures the CloudFormation stacks that eksctl creates
+type CloudFormation struct {
+	// StackTags are added to every stack that eksctl creates for the cluster, CloudFormation
+	// propagates them to the resources of the stacks that support tags; only valid for the cluster
+	// +optional
+	StackTags map[string]string `json:"stackTags,omitempty"`
+
+	// StackPolicy is set as the stack policy of every stack that eksctl creates for the cluster,
+	// e.g. to deny updates that replace IAM resources; only valid for the cluster
+	// +optional
+	StackPolicy InlineDocument `json:"stackPolicy,omitempty"`
+
+	// ExtraResources are added to the generated template as they are, keyed by their logical ID,
+	// e.g. extra security group rules or SSM parameters; they can refer to the generated resources
+	// with Ref and Fn::GetAtt, and their logical IDs must not be the same as those of generated resources
+	// +optional
+	ExtraResources map[string]InlineDocument `json:"extraResources,omitempty"`
+}
+
+// cfnLogicalIDPattern matches the logical IDs that CloudFormation accepts
+var cfnLogicalIDPattern = regexp.MustCompile(`^[A-Za-z0-9]{1,255}$`)
+
+// cfnResourceAttributes are the keys that a resource in a template can have
+var cfnResourceAttributes = map[string]bool{
+	"Type":                true,
+	"Properties":          true,
+	"DependsOn":           true,
+	"Condition":           true,
+	"DeletionPolicy":      true,
+	"UpdateReplacePolicy": true,
+	"UpdatePolicy":        true,
+	"CreationPolicy":      true,
+	"Metadata":            true,
+}
+
+func validateCloudFormation(path string, c *CloudFormation) error {
+	if c == nil {
+		return nil
+	}
+	for key := range c.StackTags {
+		if key == "" {
+			return fmt.Errorf("%s.stackTags cannot have an empty key", path)
+		}
+		if strings.HasPrefix(strings.ToLower(key), "aws:") {
+			return fmt.Errorf("%s.stackTags.%s is invalid, the aws: prefix is reserved", path, key)
+		}
+	}
+	if len(c.StackPolicy) > 0 {
+		if _, ok := c.StackPolicy["Statement"]; !ok {
+			return fmt.Errorf("%s.stackPolicy must have a Statement", path)
+		}
+	}
+	logicalIDs := []string{}
+	for logicalID := range c.ExtraResources {
+		logicalIDs = append(logicalIDs, logicalID)
+	}
+	sort.Strings(logicalIDs)
+	for _, logicalID := range logicalIDs {
+		resourcePath := fmt.Sprintf("%s.extraResources.%s", path, logicalID)
+		if !cfnLogicalIDPattern.MatchString(logicalID) {
+			return fmt.Errorf("%s is invalid, logical IDs must be alphanumeric", resourcePath)
+		}
+		resource := c.ExtraResources[logicalID]
+		if resourceType, ok := resource["Type"].(string); !ok || resourceType == "" {
+			return fmt.Errorf("%s.Type must be set", resourcePath)
+		}
+		if properties, ok := resource["Properties"]; ok {
+			if _, ok := properties.(map[string]interface{}); !ok {
+				return fmt.Errorf("%s.Properties must be an object", resourcePath)
+			}
+		}
+		for key := range resource {
+			if !cfnResourceAttributes[key] {
+				return fmt.Errorf("%s.%s is not a valid resource attribute", resourcePath, key)
+			}
+		}
+	}
+	return nil
+}
@@ -10,8 +10,30 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		cfg.IAM = &ClusterIAM{}
 	}
 
+	if cfg.IPv6Enabled() {
+		setIPv6Defaults(cfg)
+	}
+
+	if cfg.IsPrivateCluster() {
+		setPrivateClusterDefaults(cfg)
+	}
+
+	if cfg.VPC != nil {
+		setSecurityGroupRulesDefaults(cfg.VPC.ControlPlaneSecurityGroupRules)
+		setSecurityGroupRulesDefaults(cfg.VPC.SharedNodeSecurityGroupRules)
+	}
+
+	if cfg.VPC != nil && cfg.VPC.SubnetDiscovery != nil {
+		setSubnetDiscoveryDefaults(cfg.VPC.SubnetDiscovery)
+	}
+
 	if cfg.IAM.WithOIDC == nil {
-		cfg.IAM.WithOIDC = Disabled()
+		// addons deployed by eksctl, EKS addons with policies and Karpenter get their permissions via iamserviceaccounts
+		if cfg.hasAddonWithIAMServiceAccount() || cfg.HasKarpenter() {
+			cfg.IAM.WithOIDC = Enabled()
+		} else {
+			cfg.IAM.WithOIDC = Disabled()
+		}
 	}
 
 	for _, sa := range cfg.IAM.ServiceAccounts {
@@ -20,6 +42,25 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 		}
 	}
 
+	setPermissionsBoundaryDefaults(cfg)
+
+	// pods only get the credentials of their associations when the agent is running
+	if len(cfg.IAM.PodIdentityAssociations) > 0 && !cfg.HasAddon(PodIdentityAgentAddon) {
+		cfg.Addons = append(cfg.Addons, &Addon{Name: PodIdentityAgentAddon})
+	}
+
+	if cfg.HasKarpenter() && cfg.Karpenter.Version == "" {
+		cfg.Karpenter.Version = DefaultKarpenterVersion
+	}
+
+	SetGitOpsDefaults(cfg)
+
+	if cfg.HasHelmReleases() {
+		for _, r := range cfg.PostCreate.HelmReleases {
+			setHelmReleaseDefaults(r)
+		}
+	}
+
 	if cfg.HasClusterCloudWatchLogging() && len(cfg.CloudWatch.ClusterLogging.EnableTypes) == 1 {
 		switch cfg.CloudWatch.ClusterLogging.EnableTypes[0] {
 		case "all", "*":
@@ -28,6 +69,57 @@ func SetClusterConfigDefaults(cfg *ClusterConfig) {
 	}
 }
 
+// setIPv6Defaults allocates IPv6 CIDRs to subnets created by eksctl and makes sure VPC CNI
+// gets the permissions to assign IPv6 addresses to pods
+func setIPv6Defaults(cfg *ClusterConfig) {
+	if cfg.VPC != nil && cfg.VPC.ID == "" {
+		cfg.VPC.AutoAllocateIPv6 = Enabled()
+	}
+
+	var vpcCNI *Addon
+	for _, addon := range cfg.Addons {
+		if addon.Name == VPCCNIAddon {
+			vpcCNI = addon
+		}
+	}
+	if vpcCNI == nil {
+		vpcCNI = &Addon{Name: VPCCNIAddon}
+		cfg.Addons = append(cfg.Addons, vpcCNI)
+	}
+	if vpcCNI.ServiceAccountRoleARN == "" && !vpcCNI.hasPolicies() {
+		vpcCNI.AttachPolicy = vpcCNIIPv6Policy(cfg.Metadata.Region)
+	}
+
+	// VPC CNI gets its permissions via IRSA even when the role is given
+	if cfg.IAM.WithOIDC == nil {
+		cfg.IAM.WithOIDC = Enabled()
+	}
+}
+
+// setPrivateClusterDefaults makes sure no NAT gateway is created, as the VPC has no public subnets;
+// nodegroups have to set privateNetworking themselves
+func setPrivateClusterDefaults(cfg *ClusterConfig) {
+	if cfg.VPC != nil && cfg.VPC.NAT != nil {
+		gateway := ClusterDisableNAT
+		cfg.VPC.NAT.Gateway = &gateway
+	}
+}
+
+// setSubnetDiscoveryDefaults makes subnets get discovered by the tags that load balancer
+// controllers use, which the subnets of a cluster are meant to have anyway
+func setSubnetDiscoveryDefaults(discovery *ClusterSubnetDiscovery) {
+	if discovery.Private == nil && discovery.Public == nil {
+		discovery.Private = &SubnetSelector{}
+		discovery.Public = &SubnetSelector{}
+	}
+	if discovery.Private != nil && len(discovery.Private.Tags) == 0 {
+		discovery.Private.Tags = map[string]string{PrivateSubnetRoleTag: "1"}
+	}
+	if discovery.Public != nil && len(discovery.Public.Tags) == 0 {
+		discovery.Public.Tags = map[string]string{PublicSubnetRoleTag: "1"}
+	}
+}
+
 // SetNodeGroupDefaults will set defaults for a given nodegroup
 func SetNodeGroupDefaults(_ int, ng *NodeGroup) {
 	if ng.InstanceType == "" {
@@ -61,25 +153,16 @@ func SetNodeGroupDefaults(_ int, ng *NodeGroup) {
 			Allow: Disabled(),
 		}
 	}
-
-	numSSHFlagsEnabled := countEnabledFields(
-		ng.SSH.PublicKeyName,
-		ng.SSH.PublicKeyPath,
-		ng.SSH.PublicKey)
-
-	if numSSHFlagsEnabled > 0 {
-		ng.SSH.Allow = Enabled()
-	} else {
-		if IsEnabled(ng.SSH.Allow) {
-			ng.SSH.PublicKeyPath = &DefaultNodeSSHPublicKeyPath
-		} else {
-			ng.SSH.Allow = Disabled()
-		}
-	}
+	setNodeGroupSSHDefaults(ng.SSH)
 
 	if !IsSetAndNonEmptyString(ng.VolumeType) {
 		ng.VolumeType = &DefaultNodeVolumeType
 	}
+	for _, v := range ng.AdditionalVolumes {
+		if !IsSetAndNonEmptyString(v.VolumeType) {
+			v.VolumeType = &DefaultNodeVolumeType
+		}
+	}
 
 	if ng.IAM == nil {
 		ng.IAM = &NodeGroupIAM{}
@@ -116,6 +199,41 @@ func SetNodeGroupDefaults(_ int, ng *NodeGroup) {
 	}
 }
 
+// SetManagedNodeGroupDefaults will set defaults for a given managed nodegroup
+func SetManagedNodeGroupDefaults(_ int, ng *ManagedNodeGroup) {
+	if ng.InstanceType == "" {
+		ng.InstanceType = DefaultNodeType
+	}
+
+	if ng.SSH == nil {
+		ng.SSH = &NodeGroupSSH{
+			Allow: Disabled(),
+		}
+	}
+	setNodeGroupSSHDefaults(ng.SSH)
+
+	if ng.IAM == nil {
+		ng.IAM = &ManagedNodeGroupIAM{}
+	}
+}
+
+func setNodeGroupSSHDefaults(ssh *NodeGroupSSH) {
+	numSSHFlagsEnabled := countEnabledFields(
+		ssh.PublicKeyName,
+		ssh.PublicKeyPath,
+		ssh.PublicKey)
+
+	if numSSHFlagsEnabled > 0 {
+		ssh.Allow = Enabled()
+	} else {
+		if IsEnabled(ssh.Allow) {
+			ssh.PublicKeyPath = &DefaultNodeSSHPublicKeyPath
+		} else {
+			ssh.Allow = Disabled()
+		}
+	}
+}
+
 // DefaultClusterNAT will set the default value for Cluster NAT mode
 func DefaultClusterNAT() *ClusterNAT {
 	single := ClusterSingleNAT
@@ -123,3 +241,56 @@ func DefaultClusterNAT() *ClusterNAT {
 		Gateway: &single,
 	}
 }
+
+// SetGitOpsDefaults sets the defaults of the repository that Flux is bootstrapped in
+func SetGitOpsDefaults(cfg *ClusterConfig) {
+	if !cfg.HasGitOps() {
+		return
+	}
+	flux := cfg.GitOps.Flux
+	if flux.Branch == "" {
+		flux.Branch = DefaultFluxBranch
+	}
+	if flux.Path == "" {
+		flux.Path = "clusters/" + cfg.Metadata.Name
+	}
+	if flux.AuthorName == "" {
+		flux.AuthorName = DefaultFluxAuthorName
+	}
+}
+
+// setPermissionsBoundaryDefaults sets the permissions boundary of the cluster as the boundary of the
+// other roles that eksctl creates, unless they have their own or use an existing role
+func setPermissionsBoundaryDefaults(cfg *ClusterConfig) {
+	if !IsSetAndNonEmptyString(cfg.IAM.ServiceRolePermissionsBoundary) {
+		return
+	}
+	boundary := *cfg.IAM.ServiceRolePermissionsBoundary
+
+	for _, ng := range cfg.NodeGroups {
+		if ng.IAM == nil {
+			ng.IAM = &NodeGroupIAM{}
+		}
+		if ng.IAM.InstanceRolePermissionsBoundary == "" && ng.IAM.InstanceRoleARN == "" && ng.IAM.InstanceProfileARN == "" {
+			ng.IAM.InstanceRolePermissionsBoundary = boundary
+		}
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		if ng.IAM == nil {
+			ng.IAM = &ManagedNodeGroupIAM{}
+		}
+		if ng.IAM.InstanceRolePermissionsBoundary == "" && ng.IAM.InstanceRoleARN == "" {
+			ng.IAM.InstanceRolePermissionsBoundary = boundary
+		}
+	}
+	for _, sa := range cfg.IAM.ServiceAccounts {
+		if sa.PermissionsBoundary == "" {
+			sa.PermissionsBoundary = boundary
+		}
+	}
+	for _, association := range cfg.IAM.PodIdentityAssociations {
+		if association.PermissionsBoundary == "" && association.RoleARN == "" {
+			association.PermissionsBoundary = boundary
+		}
+	}
+}
@@ -3,6 +3,7 @@ package v1alpha5
 import (
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 var _ = Describe("ClusterConfig validation", func() {
@@ -97,4 +98,53 @@ var _ = Describe("ClusterConfig validation", func() {
 
 	})
 
+	Context("Pod identity associations", func() {
+
+		It("adds the pod identity agent addon once", func() {
+			cfg := NewClusterConfig()
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.HasAddon(PodIdentityAgentAddon)).To(BeFalse())
+
+			cfg.IAM.PodIdentityAssociations = []*PodIdentityAssociation{{Namespace: "default", ServiceAccountName: "sa-1"}}
+			SetClusterConfigDefaults(cfg)
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.Addons).To(HaveLen(1))
+			Expect(cfg.Addons[0].Name).To(Equal(PodIdentityAgentAddon))
+		})
+
+	})
+
+	Context("Permissions boundary", func() {
+
+		It("sets the boundary of the cluster on the roles eksctl creates", func() {
+			const (
+				clusterBoundary = "arn:aws:iam::123456789012:policy/eks-boundary"
+				ownBoundary     = "arn:aws:iam::123456789012:policy/irsa-boundary"
+			)
+			cfg := NewClusterConfig()
+			cfg.IAM.ServiceRolePermissionsBoundary = &clusterBoundary
+
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			existingRole := cfg.NewNodeGroup()
+			existingRole.Name = "ng-2"
+			existingRole.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/nodes"
+			mng := NewManagedNodeGroup()
+			mng.Name = "mng-1"
+			cfg.ManagedNodeGroups = []*ManagedNodeGroup{mng}
+			cfg.IAM.ServiceAccounts = []*ClusterIAMServiceAccount{
+				{ObjectMeta: metav1.ObjectMeta{Name: "sa-1"}},
+				{ObjectMeta: metav1.ObjectMeta{Name: "sa-2"}, PermissionsBoundary: ownBoundary},
+			}
+
+			SetClusterConfigDefaults(cfg)
+			Expect(ng.IAM.InstanceRolePermissionsBoundary).To(Equal(clusterBoundary))
+			Expect(existingRole.IAM.InstanceRolePermissionsBoundary).To(BeEmpty())
+			Expect(mng.IAM.InstanceRolePermissionsBoundary).To(Equal(clusterBoundary))
+			Expect(cfg.IAM.ServiceAccounts[0].PermissionsBoundary).To(Equal(clusterBoundary))
+			Expect(cfg.IAM.ServiceAccounts[1].PermissionsBoundary).To(Equal(ownBoundary))
+		})
+
+	})
+
 })
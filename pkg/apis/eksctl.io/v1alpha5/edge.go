@@ -0,0 +1,72 @@
+package v1alpha5
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Values for the type of edge locations of nodegroups
+const (
+	EdgeTypeLocalZone      = "local-zone"
+	EdgeTypeWavelengthZone = "wavelength-zone"
+	EdgeTypeOutpost        = "outpost"
+)
+
+// NodeGroupEdge launches the nodes into existing subnets of a Local Zone,
+// a Wavelength Zone or an Outpost, instead of the subnets of the cluster
+type NodeGroupEdge struct {
+	// Type of the edge location, either "local-zone", "wavelength-zone" or "outpost"
+	Type string `json:"type"`
+
+	// Subnets are the IDs of the subnets to launch the nodes into
+	Subnets []string `json:"subnets"`
+
+	// OutpostARN of the Outpost that the subnets are on, only for type "outpost"
+	// +optional
+	OutpostARN string `json:"outpostARN,omitempty"`
+}
+
+// Outpost holds the configuration of local clusters, where the Kubernetes control plane
+// runs on an Outpost rather than in the region
+type Outpost struct {
+	// ControlPlaneOutpostARN is the ARN of the Outpost that the control plane runs on
+	ControlPlaneOutpostARN string `json:"controlPlaneOutpostARN"`
+
+	// ControlPlaneInstanceType is the instance type of the control plane instances
+	ControlPlaneInstanceType string `json:"controlPlaneInstanceType"`
+}
+
+// SupportedEdgeTypes are the types of edge locations that nodegroups can be launched into
+func SupportedEdgeTypes() []string {
+	return []string{
+		EdgeTypeLocalZone,
+		EdgeTypeWavelengthZone,
+		EdgeTypeOutpost,
+	}
+}
+
+var outpostARNPattern = regexp.MustCompile(`^arn:aws(-us-gov)?:outposts:[a-z0-9-]+:\d{12}:outpost/op-[0-9a-f]+$`)
+
+// wavelengthInstanceTypes are the only instance types available in Wavelength Zones
+var wavelengthInstanceTypes = []string{"t3.medium", "t3.xlarge", "r5.2xlarge", "g4dn.2xlarge"}
+
+// outpostInstanceFamilies are the instance families that Outposts can be configured with,
+// Local Zones offer different instance types depending on the zone, so they aren't checked
+var outpostInstanceFamilies = []string{"c5", "c5d", "m5", "m5d", "r5", "r5d", "g4dn", "i3en"}
+
+// IsLocalCluster checks if the control plane of the cluster runs on an Outpost
+func (c *ClusterConfig) IsLocalCluster() bool {
+	return c.Outpost != nil
+}
+
+// isSupportedEdgeInstanceType checks if the instance type can be used in the given type of edge location
+func isSupportedEdgeInstanceType(edgeType, instanceType string) bool {
+	switch edgeType {
+	case EdgeTypeWavelengthZone:
+		return isOneOf(instanceType, wavelengthInstanceTypes)
+	case EdgeTypeOutpost:
+		return isOneOf(strings.Split(instanceType, ".")[0], outpostInstanceFamilies)
+	default:
+		return true
+	}
+}
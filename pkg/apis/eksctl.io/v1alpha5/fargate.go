@@ -0,0 +1,86 @@
+package v1alpha5
+
+import (
+	"strings"
+)
+
+const (
+	// MaxFargateProfileSelectors is the maximum number of selectors EKS accepts in a Fargate profile
+	MaxFargateProfileSelectors = 5
+
+	// reservedFargateProfileNamePrefix is the prefix of Fargate profile names that are reserved by EKS
+	reservedFargateProfileNamePrefix = "eks-"
+)
+
+// FargateProfile defines the settings used to schedule workload onto Fargate
+type FargateProfile struct {
+	// Name of the Fargate profile
+	Name string `json:"name"`
+
+	// PodExecutionRoleARN of an existing role used by pods scheduled onto Fargate,
+	// the role created along with the cluster is used when it's not set
+	// +optional
+	PodExecutionRoleARN string `json:"podExecutionRoleARN,omitempty"`
+
+	// Selectors define the rules to select workload to schedule onto Fargate
+	Selectors []FargateProfileSelector `json:"selectors"`
+
+	// Subnets which Fargate should use to do network placement of the selected workload,
+	// private subnets of the cluster are used when it's not set
+	// +optional
+	Subnets []string `json:"subnets,omitempty"`
+
+	// Tags are applied to the Fargate profile
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+}
+
+// FargateProfileSelector defines rules to select workload to schedule onto Fargate
+type FargateProfileSelector struct {
+	// Namespace of the workload
+	Namespace string `json:"namespace"`
+
+	// Labels the workload must have, in addition to being in the namespace
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+}
+
+// Matches checks if the selector selects pods in the given namespace with the given labels
+func (s *FargateProfileSelector) Matches(namespace string, labels map[string]string) bool {
+	if s.Namespace != namespace {
+		return false
+	}
+	for k, v := range s.Labels {
+		if labels[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// HasFargateProfiles checks if the cluster has any Fargate profiles
+func (c *ClusterConfig) HasFargateProfiles() bool {
+	return len(c.FargateProfiles) > 0
+}
+
+// HasNodeGroups checks if the cluster has any nodegroups, managed or not
+func (c *ClusterConfig) HasNodeGroups() bool {
+	return len(c.NodeGroups) > 0 || len(c.ManagedNodeGroups) > 0
+}
+
+// IsSchedulableOnFargate checks if any of the Fargate profiles of the cluster
+// selects pods in the given namespace with the given labels
+func (c *ClusterConfig) IsSchedulableOnFargate(namespace string, labels map[string]string) bool {
+	for _, profile := range c.FargateProfiles {
+		for i := range profile.Selectors {
+			if profile.Selectors[i].Matches(namespace, labels) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func isReservedFargateProfileName(name string) bool {
+	return strings.HasPrefix(name, reservedFargateProfileNamePrefix)
+}
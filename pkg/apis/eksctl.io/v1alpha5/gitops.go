@@ -0,0 +1,80 @@
+package v1alpha5
+
+// Git providers that Flux can be bootstrapped with
+const (
+	FluxGitProviderGitHub = "github"
+	FluxGitProviderGitLab = "gitlab"
+)
+
+// DefaultFluxBranch is the branch of the repository that Flux is bootstrapped in
+const DefaultFluxBranch = "main"
+
+// DefaultFluxAuthorName is the name of the author of the commits made when bootstrapping Flux
+const DefaultFluxAuthorName = "Flux"
+
+// SupportedFluxGitProviders are the Git providers that Flux can be bootstrapped with
+func SupportedFluxGitProviders() []string {
+	return []string{FluxGitProviderGitHub, FluxGitProviderGitLab}
+}
+
+// GitOps holds the configuration of the GitOps operator that the cluster is wired to
+type GitOps struct {
+	// +optional
+	Flux *Flux `json:"flux,omitempty"`
+}
+
+// Flux holds the configuration of the repository that Flux v2 is bootstrapped in,
+// the token to access it is taken from GITHUB_TOKEN or GITLAB_TOKEN
+type Flux struct {
+	// Valid variants are `"github"` and `"gitlab"`
+	GitProvider string `json:"gitProvider"`
+	// Owner of the repository, a user or an organization (or a group on GitLab)
+	Owner string `json:"owner"`
+	// Repository is created if it doesn't exist
+	Repository string `json:"repository"`
+	// Branch of the repository, defaults to `"main"`
+	// +optional
+	Branch string `json:"branch,omitempty"`
+	// Path in the repository that Flux syncs the cluster from,
+	// defaults to `"clusters/<cluster name>"`
+	// +optional
+	Path string `json:"path,omitempty"`
+	// Personal must be enabled when the owner is a user
+	// +optional
+	Personal *bool `json:"personal,omitempty"`
+	// AuthorName of the commits, defaults to `"Flux"`
+	// +optional
+	AuthorName string `json:"authorName,omitempty"`
+	// AuthorEmail of the commits
+	AuthorEmail string `json:"authorEmail"`
+}
+
+// HasGitOps checks if the cluster is wired to a GitOps operator
+func (c *ClusterConfig) HasGitOps() bool {
+	return c.GitOps != nil && c.GitOps.Flux != nil
+}
+
+// TokenEnvVar returns the environment variable that the token of the Git provider is taken from
+func (f *Flux) TokenEnvVar() string {
+	if f.GitProvider == FluxGitProviderGitLab {
+		return "GITLAB_TOKEN"
+	}
+	return "GITHUB_TOKEN"
+}
+
+// RepositoryURL returns the HTTPS URL of the repository, which can be accessed with the token
+func (f *Flux) RepositoryURL() string {
+	host := "github.com"
+	if f.GitProvider == FluxGitProviderGitLab {
+		host = "gitlab.com"
+	}
+	return "https://" + host + "/" + f.Owner + "/" + f.Repository + ".git"
+}
+
+// TokenUsername returns the username that the token is used with over HTTPS
+func (f *Flux) TokenUsername() string {
+	if f.GitProvider == FluxGitProviderGitLab {
+		return "oauth2"
+	}
+	return "x-access-token"
+}
@@ -0,0 +1,62 @@
+package v1alpha5
+
+import (
+	"path"
+	"strings"
+)
+
+// PostCreate holds what is installed to the cluster once its nodegroups are ready
+type PostCreate struct {
+	// Helm charts that are installed in the given order, the [helm CLI](https://helm.sh/docs/intro/install/)
+	// v3 must be installed
+	// +optional
+	HelmReleases []*HelmRelease `json:"helmReleases,omitempty"`
+}
+
+// HelmRelease holds the configuration of a Helm chart to install
+type HelmRelease struct {
+	// Name of the release, defaults to the name of the chart
+	// +optional
+	Name string `json:"name,omitempty"`
+	// Namespace of the release, it's created if it doesn't exist, defaults to `"default"`
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	// Repo is the URL of the chart repository, either HTTP(S) or an OCI registry (`oci://`)
+	Repo string `json:"repo"`
+	// Chart is the name of the chart in the repository
+	Chart string `json:"chart"`
+	// Version of the chart, defaults to the latest one
+	// +optional
+	Version string `json:"version,omitempty"`
+	// Values that override the default values of the chart
+	// +optional
+	Values InlineDocument `json:"values,omitempty"`
+}
+
+// HasHelmReleases checks if any Helm charts are installed after the cluster is created
+func (c *ClusterConfig) HasHelmReleases() bool {
+	return c.PostCreate != nil && len(c.PostCreate.HelmReleases) > 0
+}
+
+// IsOCI checks if the chart is stored in an OCI registry
+func (r *HelmRelease) IsOCI() bool {
+	return strings.HasPrefix(r.Repo, "oci://")
+}
+
+// ChartReference returns the chart as it's given to helm, i.e. the full reference in an OCI
+// registry, or the name of the chart that is looked up in the repository
+func (r *HelmRelease) ChartReference() string {
+	if r.IsOCI() {
+		return strings.TrimSuffix(r.Repo, "/") + "/" + r.Chart
+	}
+	return r.Chart
+}
+
+func setHelmReleaseDefaults(r *HelmRelease) {
+	if r.Name == "" {
+		r.Name = path.Base(r.Chart)
+	}
+	if r.Namespace == "" {
+		r.Namespace = "default"
+	}
+}
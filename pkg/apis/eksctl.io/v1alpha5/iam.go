@@ -16,10 +16,20 @@ const (
 type ClusterIAM struct {
 	// +optional
 	ServiceRoleARN *string `json:"serviceRoleARN,omitempty"`
+	// ServiceRolePermissionsBoundary is the ARN of the managed policy that sets the permissions boundary
+	// of the roles that eksctl creates for the cluster, i.e. its service role, the Fargate pod execution role
+	// and the Karpenter node role; it's also the default boundary of the roles of nodegroups,
+	// iamserviceaccounts and pod identity associations
+	// +optional
+	ServiceRolePermissionsBoundary *string `json:"serviceRolePermissionsBoundary,omitempty"`
+	// +optional
+	FargatePodExecutionRoleARN *string `json:"fargatePodExecutionRoleARN,omitempty"`
 	// +optional
 	WithOIDC *bool `json:"withOIDC,omitempty"`
 	// +optional
 	ServiceAccounts []*ClusterIAMServiceAccount `json:"serviceAccounts,omitempty"`
+	// +optional
+	PodIdentityAssociations []*PodIdentityAssociation `json:"podIdentityAssociations,omitempty"`
 }
 
 // ClusterIAMServiceAccount holds an iamserviceaccount metadata and configuration
@@ -29,6 +39,23 @@ type ClusterIAMServiceAccount struct {
 	AttachPolicyARNs []string `json:"attachPolicyARNs,omitempty"`
 	// +optional
 	AttachPolicy InlineDocument `json:"attachPolicy,omitempty"`
+	// RoleName is the name of the IAM role, it's generated by CloudFormation when not set
+	// +optional
+	RoleName string `json:"roleName,omitempty"`
+	// RolePath is the path of the IAM role, it must begin and end with "/"
+	// +optional
+	RolePath string `json:"rolePath,omitempty"`
+	// PermissionsBoundary is the ARN of the managed policy that sets the permissions boundary of the IAM role
+	// +optional
+	PermissionsBoundary string `json:"permissionsBoundary,omitempty"`
+	// OIDCIssuerURL of the tokens the IAM role trusts, it defaults to the issuer of the cluster and is
+	// only needed when the role is assumed by service accounts of a cluster in another account
+	// +optional
+	OIDCIssuerURL string `json:"oidcIssuerURL,omitempty"`
+	// TrustedAccounts are the IDs of the accounts whose IAM OIDC provider of the issuer the role trusts,
+	// it defaults to the account of the cluster; each provider must exist already
+	// +optional
+	TrustedAccounts []string `json:"trustedAccounts,omitempty"`
 	// +optional
 	Status *ClusterIAMServiceAccountStatus `json:"status,omitempty"`
 }
@@ -44,6 +71,12 @@ func (sa *ClusterIAMServiceAccount) NameString() string {
 	return sa.Namespace + "/" + sa.Name
 }
 
+// HasTrustPolicyOverrides returns true if the trust policy of the role refers to IAM OIDC
+// providers other than the one of the cluster
+func (sa *ClusterIAMServiceAccount) HasTrustPolicyOverrides() bool {
+	return sa.OIDCIssuerURL != "" || len(sa.TrustedAccounts) > 0
+}
+
 // ClusterIAMServiceAccountNameStringToObjectMeta constructs metav1.ObjectMeta from <ns>/<name> string
 func ClusterIAMServiceAccountNameStringToObjectMeta(name string) (*metav1.ObjectMeta, error) {
 	nameParts := strings.Split(name, "/")
@@ -67,3 +100,25 @@ func (sa *ClusterIAMServiceAccount) SetAnnotations() {
 		sa.Annotations[AnnotationEKSRoleARN] = *sa.Status.RoleARN
 	}
 }
+
+// IAMIdentityMapping maps an IAM role or user to a Kubernetes username and groups
+// in the auth ConfigMap (aws-auth)
+type IAMIdentityMapping struct {
+	// ARN of the IAM role or user
+	ARN string `json:"arn"`
+	// +optional
+	Username string `json:"username,omitempty"`
+	// +optional
+	Groups []string `json:"groups,omitempty"`
+}
+
+// allowedSystemGroups are the groups with the reserved "system:" prefix
+// that IAM identities can be mapped to
+var allowedSystemGroups = []string{"system:masters", "system:bootstrappers", "system:nodes"}
+
+// IsReservedKubernetesGroup checks if a group is reserved by Kubernetes and cannot be
+// granted to IAM identities, i.e. it has the "system:" prefix and isn't one of the
+// groups used for administrators and nodes
+func IsReservedKubernetesGroup(group string) bool {
+	return strings.HasPrefix(group, "system:") && !isOneOf(group, allowedSystemGroups)
+}
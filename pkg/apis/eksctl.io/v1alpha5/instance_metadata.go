@@ -0,0 +1,19 @@
+package v1alpha5
+
+import (
+	"fmt"
+)
+
+// EnforceIMDSv2 requires IMDSv2 on the nodes of the nodegroup, it fails if IMDSv1
+// is explicitly allowed for the nodegroup
+func EnforceIMDSv2(ng *NodeGroup) error {
+	if ng.MetadataOptions == nil {
+		ng.MetadataOptions = &NodeGroupMetadataOptions{}
+	}
+	if tokens := ng.MetadataOptions.HTTPTokens; tokens != nil && *tokens != IMDSHTTPTokensRequired {
+		return fmt.Errorf("IMDSv2 cannot be enforced, as nodegroup %q sets metadataOptions.httpTokens to %q", ng.Name, *tokens)
+	}
+	required := IMDSHTTPTokensRequired
+	ng.MetadataOptions.HTTPTokens = &required
+	return nil
+}
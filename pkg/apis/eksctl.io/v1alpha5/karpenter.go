@@ -0,0 +1,27 @@
+package v1alpha5
+
+// DefaultKarpenterVersion is the release of Karpenter that eksctl installs by default
+const DefaultKarpenterVersion = "v0.20.0"
+
+// KarpenterDiscoveryTag is the tag by which Karpenter discovers the subnets and security groups
+// to launch nodes with, its value is the name of the cluster
+const KarpenterDiscoveryTag = "karpenter.sh/discovery"
+
+// MinimumVersionForKarpenter is the oldest Kubernetes version that the supported releases of Karpenter run on
+const MinimumVersionForKarpenter = "1.19"
+
+// supportedKarpenterVersions are the releases of Karpenter whose Helm chart takes the values that eksctl sets
+var supportedKarpenterVersions = []string{"v0.19.3", DefaultKarpenterVersion}
+
+// Karpenter holds the configuration of Karpenter, which eksctl installs along with
+// the IAM roles, the interruption queue and the EventBridge rules it needs
+type Karpenter struct {
+	// Version of Karpenter, defaults to the release that eksctl was tested with
+	// +optional
+	Version string `json:"version,omitempty"`
+}
+
+// HasKarpenter checks if Karpenter is installed to the cluster
+func (c *ClusterConfig) HasKarpenter() bool {
+	return c.Karpenter != nil
+}
@@ -0,0 +1,94 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Values for KubernetesNetworkConfig.IPFamily
+const (
+	// IPV4Family makes pods and services of the cluster get IPv4 addresses, it's the default
+	IPV4Family = "IPv4"
+
+	// IPV6Family makes pods and services of the cluster get IPv6 addresses
+	IPV6Family = "IPv6"
+)
+
+const (
+	// minimumVersionForIPv6 is the oldest Kubernetes version of EKS clusters with IPv6 family
+	minimumVersionForIPv6 = "1.21"
+
+	// minimumVPCCNIVersionForIPv6 is the oldest version of VPC CNI that assigns IPv6 addresses to pods
+	minimumVPCCNIVersionForIPv6 = "1.10.0"
+)
+
+// KubernetesNetworkConfig holds the network configuration of Kubernetes objects of the cluster
+type KubernetesNetworkConfig struct {
+	// IPFamily of pods and services of the cluster, valid variants are `"IPv4"` (default) and `"IPv6"`
+	// +optional
+	IPFamily string `json:"ipFamily,omitempty"`
+}
+
+// IPv6Enabled checks if pods and services of the cluster get IPv6 addresses
+func (c *ClusterConfig) IPv6Enabled() bool {
+	return c.KubernetesNetworkConfig != nil && c.KubernetesNetworkConfig.IPFamily == IPV6Family
+}
+
+// vpcCNIIPv6Policy returns the policy that VPC CNI needs to assign IPv6 addresses to pods,
+// AmazonEKS_CNI_Policy only covers IPv4
+func vpcCNIIPv6Policy(region string) InlineDocument {
+	return InlineDocument{
+		"Version": "2012-10-17",
+		"Statement": []interface{}{
+			map[string]interface{}{
+				"Effect": "Allow",
+				"Action": []string{
+					"ec2:AssignIpv6Addresses",
+					"ec2:DescribeInstances",
+					"ec2:DescribeTags",
+					"ec2:DescribeNetworkInterfaces",
+					"ec2:DescribeInstanceTypes",
+				},
+				"Resource": "*",
+			},
+			map[string]interface{}{
+				"Effect":   "Allow",
+				"Action":   []string{"ec2:CreateTags"},
+				"Resource": fmt.Sprintf("arn:%s:ec2:*:*:network-interface/*", Partition(region)),
+			},
+		},
+	}
+}
+
+// IsVersionAtLeast compares dot-separated numeric versions, ignoring a leading "v"
+// and anything after "-", e.g. "v1.10.1-eksbuild.1" is at least "1.10.0"
+func IsVersionAtLeast(version, minimum string) bool {
+	parse := func(v string) []int {
+		v = strings.SplitN(strings.TrimPrefix(v, "v"), "-", 2)[0]
+		parts := []int{}
+		for _, p := range strings.Split(v, ".") {
+			n, err := strconv.Atoi(p)
+			if err != nil {
+				return nil
+			}
+			parts = append(parts, n)
+		}
+		return parts
+	}
+
+	actual, required := parse(version), parse(minimum)
+	if actual == nil {
+		return false
+	}
+	for i, r := range required {
+		a := 0
+		if i < len(actual) {
+			a = actual[i]
+		}
+		if a != r {
+			return a > r
+		}
+	}
+	return true
+}
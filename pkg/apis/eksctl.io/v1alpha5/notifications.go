@@ -0,0 +1,10 @@
+package v1alpha5
+
+// Notifications configures where the progress of long-running operations on the cluster is reported
+type Notifications struct {
+	// WebhookURL is posted a JSON payload when an operation starts running its tasks, and when it
+	// finishes or fails; payloads are valid Slack messages, so this can be the URL of a Slack
+	// incoming webhook. The `--notify-url` flag takes precedence over it
+	// +optional
+	WebhookURL string `json:"webhookURL,omitempty"`
+}
@@ -0,0 +1,131 @@
+package v1alpha5
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Partitions of AWS, each with its own regions, endpoints and accounts
+const (
+	PartitionAWS   = "aws"
+	PartitionChina = "aws-cn"
+	PartitionUSGov = "aws-us-gov"
+)
+
+// Partition returns the partition that region belongs to
+func Partition(region string) string {
+	switch {
+	case strings.HasPrefix(region, "cn-"):
+		return PartitionChina
+	case strings.HasPrefix(region, "us-gov-"):
+		return PartitionUSGov
+	default:
+		return PartitionAWS
+	}
+}
+
+// partitionEdgeTypes are the types of edge locations that each partition has, Local Zones and
+// Wavelength Zones are only in the aws partition and there are no Outposts in China
+var partitionEdgeTypes = map[string][]string{
+	PartitionAWS:   {EdgeTypeLocalZone, EdgeTypeWavelengthZone, EdgeTypeOutpost},
+	PartitionUSGov: {EdgeTypeOutpost},
+	PartitionChina: {},
+}
+
+// PartitionDNSSuffix returns the domain of the endpoints of services in region
+func PartitionDNSSuffix(region string) string {
+	if Partition(region) == PartitionChina {
+		return "amazonaws.com.cn"
+	}
+	return "amazonaws.com"
+}
+
+// EKSResourceRegistry returns the ECR registry of the images that EKS provides in region
+func EKSResourceRegistry(region string) string {
+	return fmt.Sprintf("%s.dkr.ecr.%s.%s", EKSResourceAccountID(region), region, PartitionDNSSuffix(region))
+}
+
+// ARNPartition returns the partition of an ARN, or an empty string if it's not an ARN
+func ARNPartition(arn string) string {
+	parts := strings.SplitN(arn, ":", 3)
+	if len(parts) < 3 || parts[0] != "arn" {
+		return ""
+	}
+	return parts[1]
+}
+
+// validatePartition checks that the features in the config are available in the partition of the region
+// of the cluster, and that its ARNs belong to that partition, as roles and policies of one partition can't
+// be used in another
+func validatePartition(cfg *ClusterConfig) error {
+	if cfg.Metadata == nil || cfg.Metadata.Region == "" {
+		return nil
+	}
+	partition := Partition(cfg.Metadata.Region)
+
+	edgeTypes := partitionEdgeTypes[partition]
+	if cfg.Outpost != nil && !isOneOf(EdgeTypeOutpost, edgeTypes) {
+		return fmt.Errorf("outpost is not supported in region %s of partition %s", cfg.Metadata.Region, partition)
+	}
+	for i, ng := range cfg.NodeGroups {
+		// unknown types are left to the validation of the nodegroup
+		if ng.Edge != nil && isOneOf(ng.Edge.Type, SupportedEdgeTypes()) && !isOneOf(ng.Edge.Type, edgeTypes) {
+			return fmt.Errorf("nodeGroups[%d].edge.type %s is not supported in region %s of partition %s", i, ng.Edge.Type, cfg.Metadata.Region, partition)
+		}
+	}
+
+	arns := map[string]string{}
+	if cfg.IAM != nil {
+		if IsSetAndNonEmptyString(cfg.IAM.ServiceRoleARN) {
+			arns["iam.serviceRoleARN"] = *cfg.IAM.ServiceRoleARN
+		}
+		if IsSetAndNonEmptyString(cfg.IAM.ServiceRolePermissionsBoundary) {
+			arns["iam.serviceRolePermissionsBoundary"] = *cfg.IAM.ServiceRolePermissionsBoundary
+		}
+		for i, sa := range cfg.IAM.ServiceAccounts {
+			for j, arn := range sa.AttachPolicyARNs {
+				arns[fmt.Sprintf("iam.serviceAccounts[%d].attachPolicyARNs[%d]", i, j)] = arn
+			}
+		}
+	}
+	for i, ng := range cfg.NodeGroups {
+		if ng.IAM == nil {
+			continue
+		}
+		path := fmt.Sprintf("nodeGroups[%d].iam", i)
+		arns[path+".instanceRoleARN"] = ng.IAM.InstanceRoleARN
+		arns[path+".instanceProfileARN"] = ng.IAM.InstanceProfileARN
+		for j, arn := range ng.IAM.AttachPolicyARNs {
+			arns[fmt.Sprintf("%s.attachPolicyARNs[%d]", path, j)] = arn
+		}
+	}
+	for i, ng := range cfg.ManagedNodeGroups {
+		if ng.IAM == nil {
+			continue
+		}
+		path := fmt.Sprintf("managedNodeGroups[%d].iam", i)
+		arns[path+".instanceRoleARN"] = ng.IAM.InstanceRoleARN
+		for j, arn := range ng.IAM.AttachPolicyARNs {
+			arns[fmt.Sprintf("%s.attachPolicyARNs[%d]", path, j)] = arn
+		}
+	}
+	if cfg.Outpost != nil {
+		arns["outpost.controlPlaneOutpostARN"] = cfg.Outpost.ControlPlaneOutpostARN
+	}
+	if cfg.SecretsEncryption != nil {
+		arns["secretsEncryption.keyARN"] = cfg.SecretsEncryption.KeyARN
+	}
+
+	paths := make([]string, 0, len(arns))
+	for path := range arns {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		if p := ARNPartition(arns[path]); p != "" && p != partition {
+			return fmt.Errorf("%s %q is in partition %s, but region %s is in partition %s", path, arns[path], p, cfg.Metadata.Region, partition)
+		}
+	}
+	return nil
+}
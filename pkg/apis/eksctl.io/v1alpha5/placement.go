@@ -0,0 +1,45 @@
+package v1alpha5
+
+// Values for the strategy of placement groups
+const (
+	PlacementStrategyCluster   = "cluster"
+	PlacementStrategySpread    = "spread"
+	PlacementStrategyPartition = "partition"
+)
+
+// Values for the tenancy of nodes
+const (
+	TenancyDefault   = "default"
+	TenancyDedicated = "dedicated"
+	TenancyHost      = "host"
+)
+
+// NodeGroupPlacement configures the placement group of the nodes, either an existing
+// one is referenced by name, or one gets created for the nodegroup with the given strategy
+type NodeGroupPlacement struct {
+	// GroupName of an existing placement group
+	// +optional
+	GroupName string `json:"groupName,omitempty"`
+
+	// Strategy of the placement group to create, either "cluster", "spread" or "partition"
+	// +optional
+	Strategy string `json:"strategy,omitempty"`
+}
+
+// SupportedPlacementStrategies are the strategies of placement groups that can be created for nodegroups
+func SupportedPlacementStrategies() []string {
+	return []string{
+		PlacementStrategyCluster,
+		PlacementStrategySpread,
+		PlacementStrategyPartition,
+	}
+}
+
+// SupportedTenancies are the tenancies that can be used for nodes
+func SupportedTenancies() []string {
+	return []string{
+		TenancyDefault,
+		TenancyDedicated,
+		TenancyHost,
+	}
+}
@@ -0,0 +1,84 @@
+package v1alpha5
+
+import (
+	"fmt"
+)
+
+// PodIdentityAssociation grants the pods that use a service account the permissions of an IAM role
+// via EKS Pod Identity, as an alternative to IAM roles for service accounts that doesn't need an
+// IAM OIDC provider; eksctl creates the role unless roleARN is given
+type PodIdentityAssociation struct {
+	Namespace string `json:"namespace"`
+
+	ServiceAccountName string `json:"serviceAccountName"`
+
+	// RoleARN of an existing IAM role, which must trust the pods.eks.amazonaws.com service principal
+	// +optional
+	RoleARN string `json:"roleARN,omitempty"`
+
+	// RoleName of the IAM role that eksctl creates, it's generated by CloudFormation when not set
+	// +optional
+	RoleName string `json:"roleName,omitempty"`
+
+	// PermissionsBoundary is the ARN of the managed policy that sets the permissions boundary
+	// of the IAM role that eksctl creates
+	// +optional
+	PermissionsBoundary string `json:"permissionsBoundary,omitempty"`
+
+	// AttachPolicyARNs of policies to attach to the IAM role that eksctl creates
+	// +optional
+	AttachPolicyARNs []string `json:"attachPolicyARNs,omitempty"`
+
+	// AttachPolicy holds a policy document to attach to the IAM role that eksctl creates
+	// +optional
+	AttachPolicy InlineDocument `json:"attachPolicy,omitempty"`
+}
+
+// NameString returns the <namespace>/<serviceAccountName> of the association
+func (p *PodIdentityAssociation) NameString() string {
+	return p.Namespace + "/" + p.ServiceAccountName
+}
+
+// HasPolicies returns true if policies are given for the IAM role that eksctl creates
+func (p *PodIdentityAssociation) HasPolicies() bool {
+	return len(p.AttachPolicyARNs) > 0 || len(p.AttachPolicy) > 0
+}
+
+func validatePodIdentityAssociations(cfg *ClusterConfig) error {
+	names := nameSet{}
+	roleNames := nameSet{}
+	for i, association := range cfg.IAM.PodIdentityAssociations {
+		path := fmt.Sprintf("iam.podIdentityAssociations[%d]", i)
+		if association.Namespace == "" {
+			return fmt.Errorf("%s.namespace must be set", path)
+		}
+		if association.ServiceAccountName == "" {
+			return fmt.Errorf("%s.serviceAccountName must be set", path)
+		}
+		if ok, err := names.checkUnique("<namespace>/<serviceAccountName> of "+path, association.NameString()); !ok {
+			return err
+		}
+
+		if association.RoleARN != "" {
+			if association.HasPolicies() || association.RoleName != "" || association.PermissionsBoundary != "" {
+				return fmt.Errorf("%s.roleARN cannot be set along with attachPolicyARNs, attachPolicy, roleName or permissionsBoundary", path)
+			}
+			if !iamIdentityARNPattern.MatchString(association.RoleARN) {
+				return fmt.Errorf("%s.roleARN %q is invalid, must be the ARN of an IAM role", path, association.RoleARN)
+			}
+			continue
+		}
+		if !association.HasPolicies() {
+			return fmt.Errorf("%s.roleARN, %s.attachPolicyARNs or %s.attachPolicy must be set", path, path, path)
+		}
+		if association.RoleName != "" {
+			if ok, err := roleNames.checkUnique(path+".roleName", association.RoleName); !ok {
+				return err
+			}
+		}
+		if association.PermissionsBoundary != "" && !iamPolicyARNPattern.MatchString(association.PermissionsBoundary) {
+			return fmt.Errorf("%s.permissionsBoundary %q is invalid, must be the ARN of an IAM policy", path, association.PermissionsBoundary)
+		}
+	}
+	return nil
+}
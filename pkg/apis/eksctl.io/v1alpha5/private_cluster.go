@@ -0,0 +1,76 @@
+package v1alpha5
+
+import (
+	"fmt"
+)
+
+// S3EndpointService is the only AWS service that nodes need which is reached through
+// a gateway VPC endpoint, all other services get interface VPC endpoints
+const S3EndpointService = "s3"
+
+// PrivateCluster holds the configuration of fully-private clusters, which have no
+// access to the internet
+type PrivateCluster struct {
+	// Enabled creates the cluster without internet and NAT gateways, with VPC endpoints
+	// for the AWS services that nodes need, and with the Kubernetes API endpoint only
+	// accessible from within the VPC
+	// +optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// AdditionalEndpointServices are AWS services to create VPC endpoints for in addition
+	// to the ones nodes need, e.g. `"cloudformation"` or `"ssm"`
+	// +optional
+	AdditionalEndpointServices []string `json:"additionalEndpointServices,omitempty"`
+}
+
+// IsPrivateCluster checks if the cluster is fully-private
+func (c *ClusterConfig) IsPrivateCluster() bool {
+	return c.PrivateCluster != nil && IsEnabled(c.PrivateCluster.Enabled)
+}
+
+// EndpointServices returns the AWS services that nodes of a fully-private cluster
+// reach through VPC endpoints, with S3EndpointService first
+func (c *ClusterConfig) EndpointServices() []string {
+	services := []string{S3EndpointService, "ec2", "ecr.api", "ecr.dkr", "sts", "logs"}
+	if c.HasAddon(ClusterAutoscalerAddon) {
+		services = append(services, "autoscaling")
+	}
+	if c.HasAddon(ALBIngressAddon) {
+		services = append(services, "elasticloadbalancing")
+	}
+	if c.hasNodeGroupWithSSM() {
+		services = append(services, "ssm", "ssmmessages", "ec2messages")
+	}
+	if c.PrivateCluster != nil {
+		services = append(services, c.PrivateCluster.AdditionalEndpointServices...)
+	}
+
+	seen := map[string]bool{}
+	unique := []string{}
+	for _, service := range services {
+		if !seen[service] {
+			seen[service] = true
+			unique = append(unique, service)
+		}
+	}
+	return unique
+}
+
+// EndpointServiceName returns the full name of the VPC endpoint service of an AWS service
+func (c *ClusterConfig) EndpointServiceName(service string) string {
+	return fmt.Sprintf("com.amazonaws.%s.%s", c.Metadata.Region, service)
+}
+
+func (c *ClusterConfig) hasNodeGroupWithSSM() bool {
+	for _, ng := range c.NodeGroups {
+		if ng.SSH != nil && IsEnabled(ng.SSH.EnableSSM) {
+			return true
+		}
+	}
+	for _, ng := range c.ManagedNodeGroups {
+		if ng.SSH != nil && IsEnabled(ng.SSH.EnableSSM) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+package v1alpha5
+
+import (
+	"reflect"
+	"strings"
+)
+
+// Redacted replaces secret values of the config when it's output
+const Redacted = "REDACTED"
+
+// Redacted returns a copy of the config that can be output, e.g. logged, committed or uploaded: the
+// secret values of the config are replaced wherever they're used, and so is the webhook URL, which
+// often embeds a secret
+func (c *ClusterConfig) Redacted() *ClusterConfig {
+	out := c.DeepCopy()
+	if len(c.SecretValues) > 0 {
+		redactStrings(reflect.ValueOf(out).Elem(), c.SecretValues)
+		out.SecretValues = append([]string{}, c.SecretValues...)
+	}
+	if out.Notifications != nil && out.Notifications.WebhookURL != "" {
+		out.Notifications.WebhookURL = Redacted
+	}
+	return out
+}
+
+// redactStrings replaces the secrets in all strings that v holds, that can be set
+func redactStrings(v reflect.Value, secrets []string) {
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			redactStrings(v.Elem(), secrets)
+		}
+	case reflect.Interface:
+		if v.IsNil() || !v.CanSet() {
+			return
+		}
+		// values of interfaces can't be set, so a copy is redacted
+		elem := reflect.New(v.Elem().Type()).Elem()
+		elem.Set(v.Elem())
+		redactStrings(elem, secrets)
+		v.Set(elem)
+	case reflect.Struct:
+		for i := 0; i < v.NumField(); i++ {
+			if v.Field(i).CanSet() {
+				redactStrings(v.Field(i), secrets)
+			}
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			redactStrings(v.Index(i), secrets)
+		}
+	case reflect.Map:
+		for _, key := range v.MapKeys() {
+			value := reflect.New(v.Type().Elem()).Elem()
+			value.Set(v.MapIndex(key))
+			redactStrings(value, secrets)
+			v.SetMapIndex(key, value)
+		}
+	case reflect.String:
+		if v.CanSet() {
+			s := v.String()
+			for _, secret := range secrets {
+				if secret != "" {
+					s = strings.ReplaceAll(s, secret, Redacted)
+				}
+			}
+			v.SetString(s)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package v1alpha5
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("ClusterConfig redaction", func() {
+	var cfg *ClusterConfig
+
+	BeforeEach(func() {
+		cfg = NewClusterConfig()
+		cfg.Metadata.Name = "test"
+		cfg.Metadata.Tags = map[string]string{"token": "s3cr3t"}
+		cfg.IAM.ServiceAccounts = []*ClusterIAMServiceAccount{{
+			ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+			AttachPolicy: InlineDocument{
+				"Statement": []interface{}{
+					map[string]interface{}{"Condition": "key-s3cr3t"},
+				},
+			},
+		}}
+		cfg.Notifications = &Notifications{WebhookURL: "https://hooks.slack.com/services/T0/B0/abc"}
+		cfg.SecretValues = []string{"s3cr3t"}
+	})
+
+	It("replaces secret values wherever they're used, and the webhook URL", func() {
+		out := cfg.Redacted()
+		Expect(out.Metadata.Tags).To(Equal(map[string]string{"token": "REDACTED"}))
+		statement := out.IAM.ServiceAccounts[0].AttachPolicy["Statement"].([]interface{})[0].(map[string]interface{})
+		Expect(statement["Condition"]).To(Equal("key-REDACTED"))
+		Expect(out.Notifications.WebhookURL).To(Equal("REDACTED"))
+		Expect(out.Metadata.Name).To(Equal("test"))
+	})
+
+	It("leaves the config alone", func() {
+		cfg.Redacted()
+		Expect(cfg.Metadata.Tags).To(Equal(map[string]string{"token": "s3cr3t"}))
+		statement := cfg.IAM.ServiceAccounts[0].AttachPolicy["Statement"].([]interface{})[0].(map[string]interface{})
+		Expect(statement["Condition"]).To(Equal("key-s3cr3t"))
+		Expect(cfg.Notifications.WebhookURL).To(Equal("https://hooks.slack.com/services/T0/B0/abc"))
+	})
+})
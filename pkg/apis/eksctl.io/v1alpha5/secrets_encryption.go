@@ -0,0 +1,30 @@
+package v1alpha5
+
+// SecretsEncryption holds the configuration of the envelope encryption of Kubernetes
+// secrets with a KMS key
+type SecretsEncryption struct {
+	// KeyARN is the ARN of an existing KMS key to encrypt secrets with
+	// +optional
+	KeyARN string `json:"keyARN,omitempty"`
+
+	// CreateKey creates a dedicated KMS key with an alias of `alias/eksctl/<cluster>`
+	// in the cluster stack, along with a key policy that allows the account and the
+	// cluster role to use it; cannot be combined with `keyARN`
+	// +optional
+	CreateKey *bool `json:"createKey,omitempty"`
+}
+
+// HasSecretsEncryption checks if Kubernetes secrets of the cluster are encrypted with a KMS key
+func (c *ClusterConfig) HasSecretsEncryption() bool {
+	return c.SecretsEncryption != nil && (c.SecretsEncryption.KeyARN != "" || IsEnabled(c.SecretsEncryption.CreateKey))
+}
+
+// CreatesSecretsEncryptionKey checks if eksctl creates the KMS key for secrets encryption
+func (c *ClusterConfig) CreatesSecretsEncryptionKey() bool {
+	return c.SecretsEncryption != nil && IsEnabled(c.SecretsEncryption.CreateKey)
+}
+
+// SecretsEncryptionKeyAlias returns the alias of the KMS key that eksctl creates for secrets encryption
+func (c *ClusterConfig) SecretsEncryptionKeyAlias() string {
+	return "alias/eksctl/" + c.Metadata.Name
+}
@@ -0,0 +1,124 @@
+package v1alpha5
+
+import (
+	"fmt"
+
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
+)
+
+// Values for SecurityGroupRule.Protocol
+const (
+	// SecurityGroupProtocolTCP is the default protocol of rules
+	SecurityGroupProtocolTCP    = "tcp"
+	SecurityGroupProtocolUDP    = "udp"
+	SecurityGroupProtocolICMP   = "icmp"
+	SecurityGroupProtocolICMPv6 = "icmpv6"
+	// SecurityGroupProtocolAll matches all protocols and ports
+	SecurityGroupProtocolAll = "-1"
+)
+
+type (
+	// SecurityGroupRules holds rules that are added to a security group created by eksctl,
+	// in addition to the rules eksctl adds itself
+	SecurityGroupRules struct {
+		// +optional
+		Ingress []SecurityGroupRule `json:"ingress,omitempty"`
+		// +optional
+		Egress []SecurityGroupRule `json:"egress,omitempty"`
+	}
+	// SecurityGroupRule allows traffic from (ingress) or to (egress) either a CIDR or a security group
+	SecurityGroupRule struct {
+		// +optional
+		Description string `json:"description,omitempty"`
+		// Protocol is one of `"tcp"` (default), `"udp"`, `"icmp"`, `"icmpv6"` or `"-1"` for all traffic
+		// +optional
+		Protocol string `json:"protocol,omitempty"`
+		// FromPort is the first port of the range, or the ICMP type
+		// +optional
+		FromPort *int `json:"fromPort,omitempty"`
+		// ToPort is the last port of the range, or the ICMP code, it defaults to FromPort
+		// +optional
+		ToPort *int `json:"toPort,omitempty"`
+		// CIDR of either IPv4 or IPv6 addresses
+		// +optional
+		CIDR *ipnet.IPNet `json:"cidr,omitempty"`
+		// SecurityGroupID of the source or destination
+		// +optional
+		SecurityGroupID string `json:"securityGroupID,omitempty"`
+	}
+)
+
+// IsIPv6 checks if the rule applies to IPv6 addresses
+func (r *SecurityGroupRule) IsIPv6() bool {
+	return r.CIDR != nil && r.CIDR.IP.To4() == nil
+}
+
+func setSecurityGroupRulesDefaults(rules *SecurityGroupRules) {
+	if rules == nil {
+		return
+	}
+	for _, ruleSet := range [][]SecurityGroupRule{rules.Ingress, rules.Egress} {
+		for i := range ruleSet {
+			rule := &ruleSet[i]
+			if rule.Protocol == "" {
+				rule.Protocol = SecurityGroupProtocolTCP
+			}
+			if rule.ToPort == nil && rule.FromPort != nil {
+				toPort := *rule.FromPort
+				rule.ToPort = &toPort
+			}
+		}
+	}
+}
+
+func validateSecurityGroupRules(path string, rules *SecurityGroupRules, existingSecurityGroup string) error {
+	if rules == nil {
+		return nil
+	}
+	if existingSecurityGroup != "" {
+		return fmt.Errorf("%s cannot be used with an existing security group", path)
+	}
+	for i, rule := range rules.Ingress {
+		if err := validateSecurityGroupRule(fmt.Sprintf("%s.ingress[%d]", path, i), rule); err != nil {
+			return err
+		}
+	}
+	for i, rule := range rules.Egress {
+		if err := validateSecurityGroupRule(fmt.Sprintf("%s.egress[%d]", path, i), rule); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateSecurityGroupRule(path string, rule SecurityGroupRule) error {
+	if (rule.CIDR == nil) == (rule.SecurityGroupID == "") {
+		return fmt.Errorf("%s must have either cidr or securityGroupID set", path)
+	}
+
+	switch rule.Protocol {
+	case SecurityGroupProtocolAll:
+		if rule.FromPort != nil || rule.ToPort != nil {
+			return fmt.Errorf("%s.fromPort and %[1]s.toPort cannot be set when %[1]s.protocol is %q", path, SecurityGroupProtocolAll)
+		}
+	case SecurityGroupProtocolICMP, SecurityGroupProtocolICMPv6:
+		if rule.FromPort == nil {
+			return fmt.Errorf("%s.fromPort must be set to the ICMP type, or -1 for all types", path)
+		}
+	case "", SecurityGroupProtocolTCP, SecurityGroupProtocolUDP:
+		if rule.FromPort == nil {
+			return fmt.Errorf("%s.fromPort must be set", path)
+		}
+		toPort := *rule.FromPort
+		if rule.ToPort != nil {
+			toPort = *rule.ToPort
+		}
+		if *rule.FromPort < 0 || toPort > 65535 || toPort < *rule.FromPort {
+			return fmt.Errorf("%s has invalid port range %d-%d", path, *rule.FromPort, toPort)
+		}
+	default:
+		return fmt.Errorf("%s.protocol must be one of %q, %q, %q, %q or %q, got %q", path,
+			SecurityGroupProtocolTCP, SecurityGroupProtocolUDP, SecurityGroupProtocolICMP, SecurityGroupProtocolICMPv6, SecurityGroupProtocolAll, rule.Protocol)
+	}
+	return nil
+}
@@ -2,15 +2,23 @@ package v1alpha5
 
 import (
 	"fmt"
+	"math"
 	"time"
 
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/pricing/pricingiface"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -68,6 +76,18 @@ const (
 	// RegionMESouth1 represents the Middle East Region Bahrain
 	RegionMESouth1 = "me-south-1"
 
+	// RegionCNNorth1 represents the China Region Beijing
+	RegionCNNorth1 = "cn-north-1"
+
+	// RegionCNNorthwest1 represents the China Region Ningxia
+	RegionCNNorthwest1 = "cn-northwest-1"
+
+	// RegionUSGovWest1 represents the AWS GovCloud (US-West) Region
+	RegionUSGovWest1 = "us-gov-west-1"
+
+	// RegionUSGovEast1 represents the AWS GovCloud (US-East) Region
+	RegionUSGovEast1 = "us-gov-east-1"
+
 	// DefaultRegion defines the default region, where to deploy the EKS cluster
 	DefaultRegion = RegionUSWest2
 
@@ -100,6 +120,8 @@ const (
 
 	// NodeVolumeTypeGP2 is General Purpose SSD
 	NodeVolumeTypeGP2 = "gp2"
+	// NodeVolumeTypeGP3 is General Purpose SSD with configurable IOPS and throughput
+	NodeVolumeTypeGP3 = "gp3"
 	// NodeVolumeTypeIO1 is Provisioned IOPS SSD
 	NodeVolumeTypeIO1 = "io1"
 	// NodeVolumeTypeSC1 is Throughput Optimized HDD
@@ -109,10 +131,26 @@ const (
 
 	// DefaultNodeImageFamily defines the default image family for the worker nodes
 	DefaultNodeImageFamily = NodeImageFamilyAmazonLinux2
+	// IMDSHTTPTokensOptional allows both IMDSv1 and IMDSv2 on the nodes
+	IMDSHTTPTokensOptional = "optional"
+	// IMDSHTTPTokensRequired allows only IMDSv2 on the nodes
+	IMDSHTTPTokensRequired = "required"
+
 	// NodeImageFamilyAmazonLinux2 represents Amazon Linux 2 family
 	NodeImageFamilyAmazonLinux2 = "AmazonLinux2"
 	// NodeImageFamilyUbuntu1804 represents Ubuntu 18.04 family
 	NodeImageFamilyUbuntu1804 = "Ubuntu1804"
+	// NodeImageFamilyWindowsServer2019CoreContainer represents Windows 2019 core container family
+	NodeImageFamilyWindowsServer2019CoreContainer = "WindowsServer2019CoreContainer"
+	// NodeImageFamilyWindowsServer2019FullContainer represents Windows 2019 full container family
+	NodeImageFamilyWindowsServer2019FullContainer = "WindowsServer2019FullContainer"
+	// ManagedNodeGroupAMITypeAmazonLinux2 represents the Amazon Linux 2 AMI of managed nodegroups
+	ManagedNodeGroupAMITypeAmazonLinux2 = "AL2_x86_64"
+	// ManagedNodeGroupAMITypeAmazonLinux2GPU represents the Amazon Linux 2 GPU AMI of managed nodegroups
+	ManagedNodeGroupAMITypeAmazonLinux2GPU = "AL2_x86_64_GPU"
+	// ManagedNodeGroupAMITypeAmazonLinux2ARM represents the Amazon Linux 2 arm64 AMI of managed nodegroups
+	ManagedNodeGroupAMITypeAmazonLinux2ARM = "AL2_ARM_64"
+
 	// NodeImageResolverStatic represents static AMI resolver (see ami package)
 	NodeImageResolverStatic = "static"
 	// NodeImageResolverAuto represents auto AMI resolver (see ami package)
@@ -136,6 +174,12 @@ const (
 	// IAMServiceAccountNameTag defines the tag of the iamserviceaccount name
 	IAMServiceAccountNameTag = "alpha.eksctl.io/iamserviceaccount-name"
 
+	// PodIdentityAssociationNameTag defines the tag of the <namespace>/<serviceAccountName> of a pod identity association
+	PodIdentityAssociationNameTag = "alpha.eksctl.io/podidentityassociation-name"
+
+	// ManagedNodeGroupNameTag defines the tag of the managed nodegroup name
+	ManagedNodeGroupNameTag = "alpha.eksctl.io/managed-nodegroup-name"
+
 	// ClusterNameLabel defines the tag of the cluster name
 	ClusterNameLabel = "alpha.eksctl.io/cluster-name"
 
@@ -160,12 +204,27 @@ const (
 
 	// eksResourceAccountMESouth1 defines the AWS EKS account ID that provides node resources in me-south-1 region
 	eksResourceAccountMESouth1 = "558608220178"
+
+	// eksResourceAccountCNNorth1 defines the AWS EKS account ID that provides node resources in cn-north-1 region
+	eksResourceAccountCNNorth1 = "918309763551"
+
+	// eksResourceAccountCNNorthwest1 defines the AWS EKS account ID that provides node resources in cn-northwest-1 region
+	eksResourceAccountCNNorthwest1 = "961992271922"
+
+	// eksResourceAccountUSGovWest1 defines the AWS EKS account ID that provides node resources in us-gov-west-1 region
+	eksResourceAccountUSGovWest1 = "013241004608"
+
+	// eksResourceAccountUSGovEast1 defines the AWS EKS account ID that provides node resources in us-gov-east-1 region
+	eksResourceAccountUSGovEast1 = "151742754352"
 )
 
 var (
 	// DefaultWaitTimeout defines the default wait timeout
 	DefaultWaitTimeout = 25 * time.Minute
 
+	// DefaultMaxRetries is the default number of times AWS API calls are retried
+	DefaultMaxRetries = 13
+
 	// DefaultNodeSSHPublicKeyPath is the default path to SSH public key
 	DefaultNodeSSHPublicKeyPath = "~/.ssh/id_rsa.pub"
 
@@ -218,6 +277,10 @@ func SupportedRegions() []string {
 		RegionAPSouth1,
 		RegionAPEast1,
 		RegionMESouth1,
+		RegionCNNorth1,
+		RegionCNNorthwest1,
+		RegionUSGovWest1,
+		RegionUSGovEast1,
 	}
 }
 
@@ -244,12 +307,32 @@ func SupportedVersions() []string {
 func SupportedNodeVolumeTypes() []string {
 	return []string{
 		NodeVolumeTypeGP2,
+		NodeVolumeTypeGP3,
 		NodeVolumeTypeIO1,
 		NodeVolumeTypeSC1,
 		NodeVolumeTypeST1,
 	}
 }
 
+// SupportedNodeImageFamilies are the image families that can be used for nodegroups
+func SupportedNodeImageFamilies() []string {
+	return []string{
+		NodeImageFamilyAmazonLinux2,
+		NodeImageFamilyUbuntu1804,
+		NodeImageFamilyWindowsServer2019CoreContainer,
+		NodeImageFamilyWindowsServer2019FullContainer,
+	}
+}
+
+// SupportedManagedNodeGroupAMITypes are the AMI types that can be used for managed nodegroups
+func SupportedManagedNodeGroupAMITypes() []string {
+	return []string{
+		ManagedNodeGroupAMITypeAmazonLinux2,
+		ManagedNodeGroupAMITypeAmazonLinux2GPU,
+		ManagedNodeGroupAMITypeAmazonLinux2ARM,
+	}
+}
+
 // EKSResourceAccountID provides worker node resources(ami/ecr image) in different aws account
 // for different aws partitions & opt-in regions.
 func EKSResourceAccountID(region string) string {
@@ -258,6 +341,14 @@ func EKSResourceAccountID(region string) string {
 		return eksResourceAccountAPEast1
 	case RegionMESouth1:
 		return eksResourceAccountMESouth1
+	case RegionCNNorth1:
+		return eksResourceAccountCNNorth1
+	case RegionCNNorthwest1:
+		return eksResourceAccountCNNorthwest1
+	case RegionUSGovWest1:
+		return eksResourceAccountUSGovWest1
+	case RegionUSGovEast1:
+		return eksResourceAccountUSGovEast1
 	default:
 		return eksResourceAccountStandard
 	}
@@ -271,6 +362,11 @@ type ClusterMeta struct {
 	Version string `json:"version,omitempty"`
 	// +optional
 	Tags map[string]string `json:"tags,omitempty"`
+	// DeletionProtection enables termination protection of the cluster stack,
+	// `eksctl delete cluster` refuses to delete the cluster unless
+	// `--disable-protection` is passed
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
 }
 
 // ClusterStatus hold read-only attributes of a cluster
@@ -299,9 +395,16 @@ type ClusterProvider interface {
 	EC2() ec2iface.EC2API
 	ELB() elbiface.ELBAPI
 	ELBV2() elbv2iface.ELBV2API
+	ASG() autoscalingiface.AutoScalingAPI
 	STS() stsiface.STSAPI
 	IAM() iamiface.IAMAPI
 	CloudTrail() cloudtrailiface.CloudTrailAPI
+	SSM() ssmiface.SSMAPI
+	ServiceQuotas() servicequotasiface.ServiceQuotasAPI
+	Pricing() pricingiface.PricingAPI
+	S3() s3iface.S3API
+	CloudWatchLogs() cloudwatchlogsiface.CloudWatchLogsAPI
+	EventBridge() eventbridgeiface.EventBridgeAPI
 	Region() string
 	Profile() string
 	WaitTimeout() time.Duration
@@ -314,8 +417,50 @@ type ProviderConfig struct {
 	Region      string
 	Profile     string
 	WaitTimeout time.Duration
+
+	// AssumeRoleARNs are roles that are assumed one after another, each with the
+	// credentials of the previous one, the last one is used by all AWS clients
+	AssumeRoleARNs       []string
+	AssumeRoleExternalID string
+	// AssumeRoleSessionTags are passed when the first role is assumed, and are
+	// transitive, so that they are kept for the other roles
+	AssumeRoleSessionTags map[string]string
+	// MFASerial is the MFA device used when the first role is assumed
+	MFASerial string
+
+	// NoCache disables caching of descriptions of CloudFormation stacks within a command
+	NoCache bool
+
+	// MaxRetries is the number of times AWS API calls are retried, DefaultMaxRetries when it's unset,
+	// 0 disables retries
+	MaxRetries *int
+	// RetryMode is either RetryModeStandard or RetryModeAdaptive, the latter also slows down
+	// calls to an AWS service after it throttled any of them
+	RetryMode string
+
+	// Endpoints maps the names of services to the URLs of their endpoints, as set by awsEndpoints.services
+	Endpoints map[string]string
+	// STSRegionalEndpoint makes STS be called at the endpoint of the region
+	STSRegionalEndpoint bool
+	// UseFIPSEndpoints makes AWS APIs be called at their FIPS endpoints where they have one
+	UseFIPSEndpoints bool
+	// CABundle is the path of a PEM bundle of CAs that all HTTP clients trust along with those
+	// of the system, e.g. the CA of a TLS-intercepting proxy
+	CABundle string
+
+	// AMIResolver is the resolver of the AMIs of all nodegroups whose AMI is static or auto, when
+	// it's set the static resolver never falls back to the auto resolver
+	AMIResolver string
+	// AMIManifest is the path of a manifest of AMIs that take precedence over the static AMIs
+	AMIManifest string
 }
 
+// Values for RetryMode
+const (
+	RetryModeStandard = "standard"
+	RetryModeAdaptive = "adaptive"
+)
+
 // +genclient
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
@@ -328,19 +473,77 @@ type ClusterConfig struct {
 	// +optional
 	IAM *ClusterIAM `json:"iam,omitempty"`
 
+	// +optional
+	IAMIdentityMappings []*IAMIdentityMapping `json:"iamIdentityMappings,omitempty"`
+
+	// +optional
+	AccessConfig *AccessConfig `json:"accessConfig,omitempty"`
+
+	// +optional
+	AccessEntries []*AccessEntry `json:"accessEntries,omitempty"`
+
 	// +optional
 	VPC *ClusterVPC `json:"vpc,omitempty"`
 
+	// +optional
+	KubernetesNetworkConfig *KubernetesNetworkConfig `json:"kubernetesNetworkConfig,omitempty"`
+
+	// +optional
+	PrivateCluster *PrivateCluster `json:"privateCluster,omitempty"`
+
+	// +optional
+	SecretsEncryption *SecretsEncryption `json:"secretsEncryption,omitempty"`
+
+	// +optional
+	Outpost *Outpost `json:"outpost,omitempty"`
+
+	// +optional
+	Karpenter *Karpenter `json:"karpenter,omitempty"`
+
+	// +optional
+	GitOps *GitOps `json:"gitops,omitempty"`
+
+	// +optional
+	PostCreate *PostCreate `json:"postCreate,omitempty"`
+
+	// +optional
+	Backup *ClusterBackup `json:"backup,omitempty"`
+
+	// +optional
+	Notifications *Notifications `json:"notifications,omitempty"`
+
+	// +optional
+	CloudFormation *CloudFormation `json:"cloudFormation,omitempty"`
+
+	// +optional
+	AWSEndpoints *AWSEndpoints `json:"awsEndpoints,omitempty"`
+
+	// +optional
+	AWSConfig *AWSConfig `json:"awsConfig,omitempty"`
+
 	// +optional
 	NodeGroups []*NodeGroup `json:"nodeGroups,omitempty"`
 
+	// +optional
+	ManagedNodeGroups []*ManagedNodeGroup `json:"managedNodeGroups,omitempty"`
+
 	// +optional
 	AvailabilityZones []string `json:"availabilityZones,omitempty"`
 
 	// +optional
 	CloudWatch *ClusterCloudWatch `json:"cloudWatch,omitempty"`
 
+	// +optional
+	Addons []*Addon `json:"addons,omitempty"`
+
+	// +optional
+	FargateProfiles []*FargateProfile `json:"fargateProfiles,omitempty"`
+
 	Status *ClusterStatus `json:"status,omitempty"`
+
+	// SecretValues are values of the config that came from secrets, e.g. SecureString SSM parameters
+	// that placeholders of the config file resolved to; they're redacted when the config is output
+	SecretValues []string `json:"-"`
 }
 
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -493,6 +696,31 @@ type NodeGroup struct {
 	// +optional
 	VolumeIOPS *int `json:"volumeIOPS"`
 
+	// AdditionalVolumes are EBS volumes that get attached to the nodes in addition to the root volume
+	// +optional
+	AdditionalVolumes []*VolumeMapping `json:"additionalVolumes,omitempty"`
+
+	// +optional
+	InstanceStore *NodeGroupInstanceStore `json:"instanceStore,omitempty"`
+
+	// +optional
+	Placement *NodeGroupPlacement `json:"placement,omitempty"`
+
+	// Tenancy of the nodes, either "default", "dedicated" or "host"
+	// +optional
+	Tenancy string `json:"tenancy,omitempty"`
+
+	// EFAEnabled attaches an Elastic Fabric Adapter to the nodes, installs its driver
+	// and deploys the EFA device plugin
+	// +optional
+	EFAEnabled *bool `json:"efaEnabled,omitempty"`
+
+	// +optional
+	CapacityReservation *NodeGroupCapacityReservation `json:"capacityReservation,omitempty"`
+
+	// +optional
+	Edge *NodeGroupEdge `json:"edge,omitempty"`
+
 	// +optional
 	MaxPodsPerNode int `json:"maxPodsPerNode,omitempty"`
 
@@ -513,14 +741,35 @@ type NodeGroup struct {
 	// +optional
 	PreBootstrapCommands []string `json:"preBootstrapCommands,omitempty"`
 
+	// +optional
+	PostBootstrapCommands []string `json:"postBootstrapCommands,omitempty"`
+
 	// +optional
 	OverrideBootstrapCommand *string `json:"overrideBootstrapCommand,omitempty"`
 
+	// BootstrapScriptTemplate replaces the bootstrap script of eksctl, like overrideBootstrapCommand,
+	// with a Go template that is rendered with the cluster variables, e.g. {{.APIServerEndpoint}},
+	// {{.Base64ClusterCA}} and {{.ClusterDNS}}; it's meant for custom AMIs
+	// +optional
+	BootstrapScriptTemplate *string `json:"bootstrapScriptTemplate,omitempty"`
+
 	// +optional
 	ClusterDNS string `json:"clusterDNS,omitempty"`
 
 	// +optional
 	KubeletExtraConfig *InlineDocument `json:"kubeletExtraConfig,omitempty"`
+
+	// +optional
+	GPU *NodeGroupGPU `json:"gpu,omitempty"`
+
+	// +optional
+	LaunchTemplate *NodeGroupLaunchTemplate `json:"launchTemplate,omitempty"`
+
+	// +optional
+	MetadataOptions *NodeGroupMetadataOptions `json:"metadataOptions,omitempty"`
+
+	// +optional
+	CloudFormation *CloudFormation `json:"cloudFormation,omitempty"`
 }
 
 // ListOptions returns metav1.ListOptions with label selector for the nodegroup
@@ -535,6 +784,88 @@ func (n *NodeGroup) NameString() string {
 	return n.Name
 }
 
+// NewManagedNodeGroup creates new managed nodegroup, and returns pointer to it
+func NewManagedNodeGroup() *ManagedNodeGroup {
+	return &ManagedNodeGroup{
+		InstanceType: DefaultNodeType,
+		IAM:          &ManagedNodeGroupIAM{},
+		SSH: &NodeGroupSSH{
+			Allow:         Disabled(),
+			PublicKeyPath: &DefaultNodeSSHPublicKeyPath,
+		},
+	}
+}
+
+// ManagedNodeGroup holds configuration attributes of a nodegroup
+// whose instances are provisioned and managed by EKS
+type ManagedNodeGroup struct {
+	Name string `json:"name"`
+
+	// AMIType is one of AL2_x86_64, AL2_x86_64_GPU or AL2_ARM_64,
+	// it's chosen based on the instance type if it's not set
+	// +optional
+	AMIType string `json:"amiType,omitempty"`
+
+	// +optional
+	InstanceType string `json:"instanceType,omitempty"`
+
+	// +optional
+	AvailabilityZones []string `json:"availabilityZones,omitempty"`
+
+	// +optional
+	PrivateNetworking bool `json:"privateNetworking"`
+
+	// +optional
+	DesiredCapacity *int `json:"desiredCapacity,omitempty"`
+	// +optional
+	MinSize *int `json:"minSize,omitempty"`
+	// +optional
+	MaxSize *int `json:"maxSize,omitempty"`
+
+	// +optional
+	VolumeSize *int `json:"volumeSize,omitempty"`
+
+	// +optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// +optional
+	Taints map[string]string `json:"taints,omitempty"`
+
+	// +optional
+	Tags map[string]string `json:"tags,omitempty"`
+
+	// +optional
+	SSH *NodeGroupSSH `json:"ssh,omitempty"`
+
+	// +optional
+	IAM *ManagedNodeGroupIAM `json:"iam,omitempty"`
+}
+
+// ListOptions returns metav1.ListOptions with label selector for the managed nodegroup
+func (n *ManagedNodeGroup) ListOptions() metav1.ListOptions {
+	return metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", NodeGroupNameLabel, n.Name),
+	}
+}
+
+// NameString returns common name string
+func (n *ManagedNodeGroup) NameString() string {
+	return n.Name
+}
+
+// ManagedNodeGroupIAM holds all IAM attributes of a managed nodegroup
+type ManagedNodeGroupIAM struct {
+	// +optional
+	AttachPolicyARNs []string `json:"attachPolicyARNs,omitempty"`
+	// InstanceRoleARN of an existing role, no role gets created if it's set
+	// +optional
+	InstanceRoleARN string `json:"instanceRoleARN,omitempty"`
+	// InstanceRolePermissionsBoundary is the ARN of the managed policy that sets the permissions boundary
+	// of the role that eksctl creates for the nodes
+	// +optional
+	InstanceRolePermissionsBoundary string `json:"instanceRolePermissionsBoundary,omitempty"`
+}
+
 type (
 	// NodeGroupSGs holds all SG attributes of a NodeGroup
 	NodeGroupSGs struct {
@@ -555,6 +886,10 @@ type (
 		InstanceRoleARN string `json:"instanceRoleARN,omitempty"`
 		// +optional
 		InstanceRoleName string `json:"instanceRoleName,omitempty"`
+		// InstanceRolePermissionsBoundary is the ARN of the managed policy that sets the permissions
+		// boundary of the role that eksctl creates for the nodes
+		// +optional
+		InstanceRolePermissionsBoundary string `json:"instanceRolePermissionsBoundary,omitempty"`
 		// +optional
 		WithAddonPolicies NodeGroupIAMAddonPolicies `json:"withAddonPolicies,omitempty"`
 	}
@@ -594,6 +929,71 @@ type (
 		PublicKey *string `json:"publicKey,omitempty"`
 		// +optional
 		PublicKeyName *string `json:"publicKeyName,omitempty"`
+		// EnableSSM attaches the policy required by SSM agent to the nodes, so that
+		// sessions can be started via SSM Session Manager instead of SSH, and port 22
+		// doesn't get opened in the security group of the nodegroup
+		// +optional
+		EnableSSM *bool `json:"enableSSM,omitempty"`
+	}
+
+	// NodeGroupGPU holds the configuration for GPU instance types
+	NodeGroupGPU struct {
+		// InstallDevicePlugin deploys the NVIDIA device plugin DaemonSet
+		// and taints the nodes, so that only GPU workloads get scheduled there
+		// +optional
+		InstallDevicePlugin *bool `json:"installDevicePlugin,omitempty"`
+	}
+
+	// NodeGroupLaunchTemplate references an existing EC2 launch template,
+	// which is used as the base for the launch template of the nodegroup
+	NodeGroupLaunchTemplate struct {
+		ID string `json:"id"`
+		// Version of the launch template, the default version is used if it's not set
+		// +optional
+		Version *string `json:"version,omitempty"`
+	}
+
+	// VolumeMapping describes an EBS volume of the nodes
+	VolumeMapping struct {
+		// VolumeName is the device name of the volume, e.g. `/dev/xvdb`
+		VolumeName string `json:"volumeName"`
+		// VolumeSize in GiB
+		VolumeSize *int `json:"volumeSize"`
+		// +optional
+		VolumeType *string `json:"volumeType,omitempty"`
+		// VolumeIOPS is required for io1 volumes, and optional for gp3 volumes
+		// +optional
+		VolumeIOPS *int `json:"volumeIOPS,omitempty"`
+		// VolumeThroughput in MiB/s, only for gp3 volumes
+		// +optional
+		VolumeThroughput *int `json:"volumeThroughput,omitempty"`
+		// +optional
+		VolumeEncrypted *bool `json:"volumeEncrypted,omitempty"`
+		// +optional
+		VolumeKmsKeyID *string `json:"volumeKmsKeyID,omitempty"`
+		// MountPath is where the volume gets mounted, it's formatted with ext4 on first boot;
+		// the volume is left unformatted if it's not set
+		// +optional
+		MountPath string `json:"mountPath,omitempty"`
+	}
+
+	// NodeGroupInstanceStore configures the NVMe instance store volumes of the nodes,
+	// which are combined into a RAID 0 array if there is more than one
+	NodeGroupInstanceStore struct {
+		// MountPath is where the instance store gets mounted, it's formatted with ext4 on boot
+		MountPath string `json:"mountPath"`
+	}
+
+	// NodeGroupMetadataOptions configures the instance metadata service (IMDS) of the nodes
+	NodeGroupMetadataOptions struct {
+		// HTTPTokens is either "optional", which allows IMDSv1 and IMDSv2,
+		// or "required", which allows only IMDSv2
+		// +optional
+		HTTPTokens *string `json:"httpTokens,omitempty"`
+		// HTTPPutResponseHopLimit is the number of network hops IMDSv2 tokens can travel,
+		// between 1 (only the node itself) and 64
+		// +optional
+		HTTPPutResponseHopLimit *int `json:"httpPutResponseHopLimit,omitempty"`
 	}
 
 	// NodeGroupInstancesDistribution holds the configuration for spot instances
@@ -624,7 +1024,60 @@ func (in *InlineDocument) DeepCopy() *InlineDocument {
 	return out
 }
 
+// IsWindowsImage returns whether the image family is Windows-based
+func IsWindowsImage(imageFamily string) bool {
+	switch imageFamily {
+	case NodeImageFamilyWindowsServer2019CoreContainer, NodeImageFamilyWindowsServer2019FullContainer:
+		return true
+	default:
+		return false
+	}
+}
+
+// HasWindowsNodeGroup returns true if any of the nodegroups uses a Windows image family
+func (c *ClusterConfig) HasWindowsNodeGroup() bool {
+	for _, ng := range c.NodeGroups {
+		if IsWindowsImage(ng.AMIFamily) {
+			return true
+		}
+	}
+	return false
+}
+
 // HasMixedInstances checks if a nodegroup has mixed instances option declared
 func HasMixedInstances(ng *NodeGroup) bool {
 	return ng.InstancesDistribution != nil && ng.InstancesDistribution.InstanceTypes != nil && len(ng.InstancesDistribution.InstanceTypes) != 0
 }
+
+// InstanceCapacity returns how many of the desired instances of a nodegroup are on-demand
+// and how many are spot instances
+func InstanceCapacity(ng *NodeGroup) (int, int) {
+	capacity := DesiredCapacityOrDefault(ng.DesiredCapacity, ng.MinSize)
+	if !HasMixedInstances(ng) {
+		return capacity, 0
+	}
+	base, percentage := 0, 100
+	if d := ng.InstancesDistribution; d.OnDemandBaseCapacity != nil {
+		base = *d.OnDemandBaseCapacity
+	}
+	if d := ng.InstancesDistribution; d.OnDemandPercentageAboveBaseCapacity != nil {
+		percentage = *d.OnDemandPercentageAboveBaseCapacity
+	}
+	if base >= capacity {
+		return capacity, 0
+	}
+	onDemand := base + int(math.Ceil(float64((capacity-base)*percentage)/100))
+	return onDemand, capacity - onDemand
+}
+
+// DesiredCapacityOrDefault returns the desired capacity of a nodegroup, its minimum size when
+// it's not set, or the default node count
+func DesiredCapacityOrDefault(desired, minSize *int) int {
+	if desired != nil {
+		return *desired
+	}
+	if minSize != nil {
+		return *minSize
+	}
+	return DefaultNodeCount
+}
@@ -2,7 +2,10 @@ package v1alpha5
 
 import (
 	"fmt"
+	"net/url"
+	"regexp"
 	"strings"
+	"text/template"
 
 	"k8s.io/apimachinery/pkg/util/validation"
 )
@@ -24,7 +27,11 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		return fmt.Errorf("iam.withOIDC must be enabled explicitly for iam.serviceAccounts to be created")
 	}
 
-	saNames := nameSet{}
+	if boundary := cfg.IAM.ServiceRolePermissionsBoundary; IsSetAndNonEmptyString(boundary) && !iamPolicyARNPattern.MatchString(*boundary) {
+		return fmt.Errorf("iam.serviceRolePermissionsBoundary %q is invalid, must be the ARN of an IAM policy", *boundary)
+	}
+
+	saNames, roleNames := nameSet{}, nameSet{}
 	for i, sa := range cfg.IAM.ServiceAccounts {
 		path := fmt.Sprintf("iam.serviceAccounts[%d]", i)
 		if sa.Name == "" {
@@ -36,6 +43,41 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 		if len(sa.AttachPolicyARNs) == 0 && sa.AttachPolicy == nil {
 			return fmt.Errorf("%s.attachPolicyARNs or %s.attachPolicy must be set", path, path)
 		}
+		if sa.RoleName != "" {
+			if ok, err := roleNames.checkUnique(path+".roleName", sa.RoleName); !ok {
+				return err
+			}
+		}
+		if sa.RolePath != "" && !iamRolePathPattern.MatchString(sa.RolePath) {
+			return fmt.Errorf("%s.rolePath %q is invalid, must begin and end with \"/\"", path, sa.RolePath)
+		}
+		if sa.PermissionsBoundary != "" && !iamPolicyARNPattern.MatchString(sa.PermissionsBoundary) {
+			return fmt.Errorf("%s.permissionsBoundary %q is invalid, must be the ARN of an IAM policy", path, sa.PermissionsBoundary)
+		}
+		if sa.OIDCIssuerURL != "" && !strings.HasPrefix(sa.OIDCIssuerURL, "https://") {
+			return fmt.Errorf("%s.oidcIssuerURL %q is invalid, must be an https:// URL", path, sa.OIDCIssuerURL)
+		}
+		accountIDs := nameSet{}
+		for _, accountID := range sa.TrustedAccounts {
+			if !accountIDPattern.MatchString(accountID) {
+				return fmt.Errorf("%s.trustedAccounts %q is invalid, must be a 12-digit account ID", path, accountID)
+			}
+			if ok, err := accountIDs.checkUnique(path+".trustedAccounts", accountID); !ok {
+				return err
+			}
+		}
+	}
+
+	if err := validatePodIdentityAssociations(cfg); err != nil {
+		return err
+	}
+
+	if err := validateIAMIdentityMappings(cfg); err != nil {
+		return err
+	}
+
+	if err := validateAccessEntries(cfg); err != nil {
+		return err
 	}
 
 	ngNames := nameSet{}
@@ -48,6 +90,109 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 			return err
 		}
 	}
+	for i, ng := range cfg.ManagedNodeGroups {
+		path := fmt.Sprintf("managedNodeGroups[%d]", i)
+		if ng.Name == "" {
+			return fmt.Errorf("%s.name must be set", path)
+		}
+		if ok, err := ngNames.checkUnique(path+".name", ng.NameString()); !ok {
+			return err
+		}
+	}
+
+	if len(cfg.ManagedNodeGroups) > 0 {
+		switch version := cfg.Metadata.Version; version {
+		case Version1_10, Version1_11, Version1_12, Version1_13:
+			return fmt.Errorf("managed nodegroups are only supported by Kubernetes %s or later, got %s", Version1_14, version)
+		}
+	}
+
+	if cfg.HasWindowsNodeGroup() {
+		if err := validateWindowsSupport(cfg); err != nil {
+			return err
+		}
+	}
+
+	if err := validateAddons(cfg); err != nil {
+		return err
+	}
+
+	if err := validateFargateProfiles(cfg); err != nil {
+		return err
+	}
+
+	if err := validateKubernetesNetworkConfig(cfg); err != nil {
+		return err
+	}
+
+	if err := validatePrivateCluster(cfg); err != nil {
+		return err
+	}
+
+	if err := validateSecretsEncryption(cfg); err != nil {
+		return err
+	}
+
+	if err := validatePartition(cfg); err != nil {
+		return err
+	}
+
+	if err := validateOutpost(cfg); err != nil {
+		return err
+	}
+
+	if err := validateKarpenter(cfg); err != nil {
+		return err
+	}
+
+	if err := ValidateGitOps(cfg); err != nil {
+		return err
+	}
+
+	if err := validateHelmReleases(cfg); err != nil {
+		return err
+	}
+
+	if cfg.Backup != nil && cfg.Backup.S3Bucket == "" {
+		return fmt.Errorf("backup.s3Bucket must be set")
+	}
+
+	if cfg.Notifications != nil && cfg.Notifications.WebhookURL != "" {
+		if err := ValidateWebhookURL("notifications.webhookURL", cfg.Notifications.WebhookURL); err != nil {
+			return err
+		}
+	}
+
+	if err := validateCloudFormation("cloudFormation", cfg.CloudFormation); err != nil {
+		return err
+	}
+
+	if err := validateAWSEndpoints(cfg.AWSEndpoints); err != nil {
+		return err
+	}
+
+	if cfg.UseFIPSEndpoints() && cfg.Metadata.Region != "" {
+		if err := ValidateFIPSEndpoints(cfg.Metadata.Region, cfg.AWSEndpoints); err != nil {
+			return err
+		}
+	}
+
+	if err := validatePodSubnets(cfg); err != nil {
+		return err
+	}
+
+	if err := validateSubnetDiscovery(cfg); err != nil {
+		return err
+	}
+
+	if cfg.VPC != nil {
+		if err := validateSecurityGroupRules("vpc.controlPlaneSecurityGroupRules", cfg.VPC.ControlPlaneSecurityGroupRules, cfg.VPC.SecurityGroup); err != nil {
+			return err
+		}
+		if err := validateSecurityGroupRules("vpc.sharedNodeSecurityGroupRules", cfg.VPC.SharedNodeSecurityGroupRules, cfg.VPC.SharedNodeSecurityGroup); err != nil {
+			return err
+		}
+	}
 
 	if cfg.HasClusterCloudWatchLogging() {
 		for i, logType := range cfg.CloudWatch.ClusterLogging.EnableTypes {
@@ -66,6 +211,530 @@ func ValidateClusterConfig(cfg *ClusterConfig) error {
 	return nil
 }
 
+// ValidateClusterConfigFile runs all checks that can be done without contacting AWS, it sets
+// defaults the same way as commands that use the config do, and returns every error found
+// rather than only the first one
+func ValidateClusterConfigFile(cfg *ClusterConfig) []error {
+	errs := []error{}
+
+	if cfg.Metadata.Region != "" && !isOneOf(cfg.Metadata.Region, SupportedRegions()) {
+		errs = append(errs, fmt.Errorf("metadata.region %q is not supported, must be one of %v", cfg.Metadata.Region, SupportedRegions()))
+	}
+	switch version := cfg.Metadata.Version; version {
+	case "", "auto", "default", "latest":
+		break
+	default:
+		if !isOneOf(version, SupportedVersions()) {
+			errs = append(errs, fmt.Errorf("metadata.version %q is not supported, must be one of %v", version, SupportedVersions()))
+		}
+	}
+
+	SetClusterConfigDefaults(cfg)
+	if err := ValidateClusterConfig(cfg); err != nil {
+		errs = append(errs, err)
+	}
+
+	for i, ng := range cfg.NodeGroups {
+		path := fmt.Sprintf("nodeGroups[%d]", i)
+		if ng.AMIFamily != "" && !isOneOf(ng.AMIFamily, SupportedNodeImageFamilies()) {
+			errs = append(errs, fmt.Errorf("%s.amiFamily %q is not supported, must be one of %v", path, ng.AMIFamily, SupportedNodeImageFamilies()))
+		}
+		if ng.VolumeType != nil && !isOneOf(*ng.VolumeType, SupportedNodeVolumeTypes()) {
+			errs = append(errs, fmt.Errorf("%s.volumeType %q is not supported, must be one of %v", path, *ng.VolumeType, SupportedNodeVolumeTypes()))
+		}
+		if err := ValidateNodeGroup(i, ng); err != nil {
+			errs = append(errs, err)
+		}
+		SetNodeGroupDefaults(i, ng)
+	}
+
+	for i, ng := range cfg.ManagedNodeGroups {
+		if err := ValidateManagedNodeGroup(i, ng); err != nil {
+			errs = append(errs, err)
+		}
+		SetManagedNodeGroupDefaults(i, ng)
+	}
+
+	return errs
+}
+
+func isOneOf(value string, values []string) bool {
+	for _, v := range values {
+		if value == v {
+			return true
+		}
+	}
+	return false
+}
+
+func validateAddons(cfg *ClusterConfig) error {
+	addonNames := nameSet{}
+	for i, addon := range cfg.Addons {
+		path := fmt.Sprintf("addons[%d]", i)
+		isUnknown := true
+		for _, name := range SupportedAddons() {
+			if addon.Name == name {
+				isUnknown = false
+			}
+		}
+		if isUnknown {
+			return fmt.Errorf("%s.name %q is not supported, must be one of %v", path, addon.Name, SupportedAddons())
+		}
+		if ok, err := addonNames.checkUnique(path+".name", addon.Name); !ok {
+			return err
+		}
+		if err := ValidateAddon(path, addon); err != nil {
+			return err
+		}
+		if addon.needsIAMServiceAccount() && IsDisabled(cfg.IAM.WithOIDC) {
+			if addon.IsEKSAddon() {
+				if addon.AttachPolicy != nil {
+					return fmt.Errorf("iam.withOIDC must be enabled for %s.attachPolicy", path)
+				}
+				return fmt.Errorf("iam.withOIDC must be enabled for %s.attachPolicyARNs", path)
+			}
+			return fmt.Errorf("iam.withOIDC must be enabled for addon %q", addon.Name)
+		}
+		if addon.Name == ALBIngressAddon {
+			if version := cfg.Metadata.Version; version != "" && !IsVersionAtLeast(version, MinimumVersionForALBIngress) {
+				return fmt.Errorf("addon %q is only supported by Kubernetes %s or later, got %s", addon.Name, MinimumVersionForALBIngress, version)
+			}
+		}
+	}
+	return nil
+}
+
+var (
+	iamIdentityARNPattern = regexp.MustCompile(`^arn:aws(-cn|-us-gov)?:iam::\d{12}:(role|user)/.+$`)
+	iamPolicyARNPattern   = regexp.MustCompile(`^arn:aws(-cn|-us-gov)?:iam::(\d{12}|aws):policy/.+$`)
+	iamRolePathPattern    = regexp.MustCompile(`^/([\x21-\x7E]+/)?$`)
+	accountIDPattern      = regexp.MustCompile(`^\d{12}$`)
+)
+
+func validateIAMIdentityMappings(cfg *ClusterConfig) error {
+	arns := nameSet{}
+	for i, mapping := range cfg.IAMIdentityMappings {
+		path := fmt.Sprintf("iamIdentityMappings[%d]", i)
+		if !iamIdentityARNPattern.MatchString(mapping.ARN) {
+			return fmt.Errorf("%s.arn %q is invalid, must be the ARN of an IAM role or user", path, mapping.ARN)
+		}
+		if ok, err := arns.checkUnique(path+".arn", mapping.ARN); !ok {
+			return err
+		}
+		if mapping.Username == "" && len(mapping.Groups) == 0 {
+			return fmt.Errorf("%s.username or %s.groups must be set", path, path)
+		}
+		for _, group := range mapping.Groups {
+			if IsReservedKubernetesGroup(group) {
+				return fmt.Errorf("%s.groups cannot include %q, groups starting with \"system:\" are reserved", path, group)
+			}
+		}
+	}
+	return nil
+}
+
+var accessPolicyARNPattern = regexp.MustCompile(`^arn:aws(-cn|-us-gov)?:eks::aws:cluster-access-policy/.+$`)
+
+func validateAccessEntries(cfg *ClusterConfig) error {
+	if cfg.AccessConfig != nil && cfg.AccessConfig.AuthenticationMode != "" &&
+		!isOneOf(cfg.AccessConfig.AuthenticationMode, SupportedAuthenticationModes()) {
+		return fmt.Errorf("accessConfig.authenticationMode %q is not supported, must be one of %v", cfg.AccessConfig.AuthenticationMode, SupportedAuthenticationModes())
+	}
+	if len(cfg.AccessEntries) > 0 && !cfg.UsesAccessEntries() {
+		return fmt.Errorf("accessEntries can only be used when accessConfig.authenticationMode is %s or %s", AuthenticationModeAPIAndConfigMap, AuthenticationModeAPI)
+	}
+	if len(cfg.IAMIdentityMappings) > 0 && cfg.AccessConfig != nil && cfg.AccessConfig.AuthenticationMode == AuthenticationModeAPI {
+		return fmt.Errorf("iamIdentityMappings cannot be used when accessConfig.authenticationMode is %s, as the aws-auth ConfigMap is ignored", AuthenticationModeAPI)
+	}
+	if len(cfg.NodeGroups) > 0 && cfg.AccessConfig != nil && cfg.AccessConfig.AuthenticationMode == AuthenticationModeAPI {
+		return fmt.Errorf("nodeGroups cannot be used when accessConfig.authenticationMode is %s, as their nodes join the cluster through the aws-auth ConfigMap", AuthenticationModeAPI)
+	}
+
+	principals := nameSet{}
+	for i, entry := range cfg.AccessEntries {
+		path := fmt.Sprintf("accessEntries[%d]", i)
+		if !iamIdentityARNPattern.MatchString(entry.PrincipalARN) {
+			return fmt.Errorf("%s.principalARN %q is invalid, must be the ARN of an IAM role or user", path, entry.PrincipalARN)
+		}
+		if ok, err := principals.checkUnique(path+".principalARN", entry.PrincipalARN); !ok {
+			return err
+		}
+		if entry.Type != "" && !isOneOf(entry.Type, SupportedAccessEntryTypes()) {
+			return fmt.Errorf("%s.type %q is not supported, must be one of %v", path, entry.Type, SupportedAccessEntryTypes())
+		}
+		if entry.Type != "" && entry.Type != "STANDARD" && (entry.KubernetesUsername != "" || len(entry.KubernetesGroups) > 0 || len(entry.AccessPolicies) > 0) {
+			return fmt.Errorf("%s cannot set kubernetesUsername, kubernetesGroups or accessPolicies for type %s", path, entry.Type)
+		}
+		for _, group := range entry.KubernetesGroups {
+			if strings.HasPrefix(group, "system:") {
+				return fmt.Errorf("%s.kubernetesGroups cannot include %q, groups starting with \"system:\" are reserved, use an access policy instead", path, group)
+			}
+		}
+		for j, policy := range entry.AccessPolicies {
+			policyPath := fmt.Sprintf("%s.accessPolicies[%d]", path, j)
+			if !accessPolicyARNPattern.MatchString(policy.PolicyARN) {
+				return fmt.Errorf("%s.policyARN %q is invalid, must be the ARN of an EKS access policy", policyPath, policy.PolicyARN)
+			}
+			switch policy.AccessScope.Type {
+			case AccessScopeCluster:
+				if len(policy.AccessScope.Namespaces) > 0 {
+					return fmt.Errorf("%s.accessScope.namespaces cannot be set for scope %q", policyPath, AccessScopeCluster)
+				}
+			case AccessScopeNamespace:
+				if len(policy.AccessScope.Namespaces) == 0 {
+					return fmt.Errorf("%s.accessScope.namespaces must be set for scope %q", policyPath, AccessScopeNamespace)
+				}
+			default:
+				return fmt.Errorf("%s.accessScope.type must be %q or %q", policyPath, AccessScopeCluster, AccessScopeNamespace)
+			}
+		}
+	}
+	return nil
+}
+
+func validateFargateProfiles(cfg *ClusterConfig) error {
+	if !cfg.HasFargateProfiles() {
+		return nil
+	}
+	switch version := cfg.Metadata.Version; version {
+	case Version1_10, Version1_11, Version1_12, Version1_13:
+		return fmt.Errorf("Fargate profiles are only supported by Kubernetes %s or later, got %s", Version1_14, version)
+	}
+
+	profileNames := nameSet{}
+	for i, profile := range cfg.FargateProfiles {
+		path := fmt.Sprintf("fargateProfiles[%d]", i)
+		if err := ValidateFargateProfile(path, profile); err != nil {
+			return err
+		}
+		if ok, err := profileNames.checkUnique(path+".name", profile.Name); !ok {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateFargateProfile checks the configuration of a Fargate profile given at the path
+func ValidateFargateProfile(path string, profile *FargateProfile) error {
+	if profile.Name == "" {
+		return fmt.Errorf("%s.name must be set", path)
+	}
+	if isReservedFargateProfileName(profile.Name) {
+		return fmt.Errorf("%s.name %q is invalid, names starting with %q are reserved", path, profile.Name, reservedFargateProfileNamePrefix)
+	}
+	if len(profile.Selectors) == 0 {
+		return fmt.Errorf("%s.selectors must be set", path)
+	}
+	if len(profile.Selectors) > MaxFargateProfileSelectors {
+		return fmt.Errorf("%s.selectors cannot have more than %d selectors, got %d", path, MaxFargateProfileSelectors, len(profile.Selectors))
+	}
+	for i, selector := range profile.Selectors {
+		if selector.Namespace == "" {
+			return fmt.Errorf("%s.selectors[%d].namespace must be set", path, i)
+		}
+	}
+	return nil
+}
+
+func validateKubernetesNetworkConfig(cfg *ClusterConfig) error {
+	if cfg.KubernetesNetworkConfig == nil {
+		return nil
+	}
+	switch cfg.KubernetesNetworkConfig.IPFamily {
+	case "", IPV4Family:
+		return nil
+	case IPV6Family:
+	default:
+		return fmt.Errorf("kubernetesNetworkConfig.ipFamily must be either %q or %q, got %q", IPV4Family, IPV6Family, cfg.KubernetesNetworkConfig.IPFamily)
+	}
+
+	if version := cfg.Metadata.Version; version != "" && !IsVersionAtLeast(version, minimumVersionForIPv6) {
+		return fmt.Errorf("IPv6 is only supported by Kubernetes %s or later, got %s", minimumVersionForIPv6, version)
+	}
+	if IsDisabled(cfg.IAM.WithOIDC) {
+		return fmt.Errorf("iam.withOIDC must be enabled for IPv6")
+	}
+	if len(cfg.NodeGroups) > 0 {
+		return fmt.Errorf("nodeGroups are not supported with IPv6, use managedNodeGroups or fargateProfiles instead")
+	}
+	for i, addon := range cfg.Addons {
+		if addon.Name == VPCCNIAddon && addon.Version != "" && !IsVersionAtLeast(addon.Version, minimumVPCCNIVersionForIPv6) {
+			return fmt.Errorf("addons[%d].version must be %s or later for IPv6, got %s", i, minimumVPCCNIVersionForIPv6, addon.Version)
+		}
+	}
+	return nil
+}
+
+func validatePrivateCluster(cfg *ClusterConfig) error {
+	if !cfg.IsPrivateCluster() {
+		return nil
+	}
+	if cfg.IPv6Enabled() {
+		return fmt.Errorf("privateCluster is not supported with IPv6")
+	}
+	for i, service := range cfg.PrivateCluster.AdditionalEndpointServices {
+		if service == "" {
+			return fmt.Errorf("privateCluster.additionalEndpointServices[%d] must be set", i)
+		}
+	}
+	// nodes of a private cluster can't have public IPs, as its VPC has no public subnets
+	for i, ng := range cfg.NodeGroups {
+		if !ng.PrivateNetworking {
+			return fmt.Errorf("nodeGroups[%d].privateNetworking must be enabled in a private cluster", i)
+		}
+	}
+	for i, ng := range cfg.ManagedNodeGroups {
+		if !ng.PrivateNetworking {
+			return fmt.Errorf("managedNodeGroups[%d].privateNetworking must be enabled in a private cluster", i)
+		}
+	}
+	return nil
+}
+
+func validateOutpost(cfg *ClusterConfig) error {
+	if !cfg.IsLocalCluster() {
+		return nil
+	}
+	if !outpostARNPattern.MatchString(cfg.Outpost.ControlPlaneOutpostARN) {
+		return fmt.Errorf("outpost.controlPlaneOutpostARN must be the ARN of an Outpost, got %q", cfg.Outpost.ControlPlaneOutpostARN)
+	}
+	if cfg.Outpost.ControlPlaneInstanceType == "" {
+		return fmt.Errorf("outpost.controlPlaneInstanceType must be set")
+	}
+	if !isSupportedEdgeInstanceType(EdgeTypeOutpost, cfg.Outpost.ControlPlaneInstanceType) {
+		return fmt.Errorf("outpost.controlPlaneInstanceType %q is not available on Outposts, must be one of the instance families %v", cfg.Outpost.ControlPlaneInstanceType, outpostInstanceFamilies)
+	}
+	// the subnets of the control plane have to be on the Outpost, which eksctl can't create
+	if cfg.VPC == nil || cfg.VPC.ID == "" || !cfg.HasAnySubnets() {
+		return fmt.Errorf("vpc.id and vpc.subnets of the Outpost must be set for a local cluster on an Outpost")
+	}
+	switch {
+	case len(cfg.ManagedNodeGroups) > 0:
+		return fmt.Errorf("managed nodegroups are not supported by local clusters on Outposts")
+	case len(cfg.FargateProfiles) > 0:
+		return fmt.Errorf("Fargate profiles are not supported by local clusters on Outposts")
+	case cfg.IPv6Enabled():
+		return fmt.Errorf("IPv6 is not supported by local clusters on Outposts")
+	case IsEnabled(cfg.IAM.WithOIDC):
+		return fmt.Errorf("iam.withOIDC is not supported by local clusters on Outposts")
+	}
+	return nil
+}
+
+func validateKarpenter(cfg *ClusterConfig) error {
+	if !cfg.HasKarpenter() {
+		return nil
+	}
+	if IsDisabled(cfg.IAM.WithOIDC) {
+		return fmt.Errorf("iam.withOIDC must be enabled for karpenter")
+	}
+	if cfg.IsLocalCluster() {
+		return fmt.Errorf("karpenter is not supported by local clusters on Outposts")
+	}
+	if version := cfg.Metadata.Version; version != "" && !IsVersionAtLeast(version, MinimumVersionForKarpenter) {
+		return fmt.Errorf("karpenter is only supported by Kubernetes %s or later, got %s", MinimumVersionForKarpenter, version)
+	}
+	if version := cfg.Karpenter.Version; version != "" {
+		return ValidateKarpenterVersion(version)
+	}
+	return nil
+}
+
+// ValidateKarpenterVersion checks that eksctl knows how to configure the Helm chart of the given release of Karpenter
+func ValidateKarpenterVersion(version string) error {
+	if !isOneOf(version, supportedKarpenterVersions) {
+		return fmt.Errorf("karpenter.version %q is not supported, must be one of %v", version, supportedKarpenterVersions)
+	}
+	return nil
+}
+
+// ValidateGitOps checks the configuration of the repository that Flux is bootstrapped in
+func ValidateGitOps(cfg *ClusterConfig) error {
+	if cfg.GitOps == nil {
+		return nil
+	}
+	flux := cfg.GitOps.Flux
+	if flux == nil {
+		return fmt.Errorf("gitops.flux must be set")
+	}
+	if !isOneOf(flux.GitProvider, SupportedFluxGitProviders()) {
+		return fmt.Errorf("gitops.flux.gitProvider must be one of %v, got %q", SupportedFluxGitProviders(), flux.GitProvider)
+	}
+	if flux.Owner == "" {
+		return fmt.Errorf("gitops.flux.owner must be set")
+	}
+	if flux.Repository == "" {
+		return fmt.Errorf("gitops.flux.repository must be set")
+	}
+	if flux.AuthorEmail == "" {
+		return fmt.Errorf("gitops.flux.authorEmail must be set")
+	}
+	// the config file of the cluster is committed outside of the path, as Flux cannot apply it
+	switch strings.Trim(flux.Path, "./") {
+	case "", "eksctl":
+		return fmt.Errorf("gitops.flux.path cannot be the root of the repository or %q", "eksctl")
+	}
+	return nil
+}
+
+// ValidateWebhookURL checks that the URL that notifications are posted to is an HTTP(S) URL; the URL
+// isn't part of the error, as webhook URLs often embed a secret
+func ValidateWebhookURL(path, webhookURL string) error {
+	u, err := url.Parse(webhookURL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fmt.Errorf("%s must be an http or https URL", path)
+	}
+	return nil
+}
+
+func validateHelmReleases(cfg *ClusterConfig) error {
+	if !cfg.HasHelmReleases() {
+		return nil
+	}
+	names := map[string]bool{}
+	for i, r := range cfg.PostCreate.HelmReleases {
+		path := fmt.Sprintf("postCreate.helmReleases[%d]", i)
+		if r.Chart == "" {
+			return fmt.Errorf("%s.chart must be set", path)
+		}
+		if !strings.HasPrefix(r.Repo, "https://") && !strings.HasPrefix(r.Repo, "http://") && !r.IsOCI() {
+			return fmt.Errorf("%s.repo must be the URL of a chart repository or an OCI registry, got %q", path, r.Repo)
+		}
+		// releases that are not defaulted yet are checked as they will be named
+		defaulted := *r
+		setHelmReleaseDefaults(&defaulted)
+		name := defaulted.Name
+		key := defaulted.Namespace + "/" + name
+		if names[key] {
+			return fmt.Errorf("%s.name %q must be unique in its namespace", path, name)
+		}
+		names[key] = true
+	}
+	return nil
+}
+
+var kmsKeyARNPattern = regexp.MustCompile(`^arn:aws(-cn|-us-gov)?:kms:[a-z0-9-]+:\d{12}:(key|alias)/.+$`)
+
+func validateSecretsEncryption(cfg *ClusterConfig) error {
+	if cfg.SecretsEncryption == nil {
+		return nil
+	}
+	keyARN := cfg.SecretsEncryption.KeyARN
+	if IsEnabled(cfg.SecretsEncryption.CreateKey) {
+		if keyARN != "" {
+			return fmt.Errorf("secretsEncryption.keyARN and secretsEncryption.createKey cannot be used together")
+		}
+		return nil
+	}
+	if keyARN == "" {
+		return fmt.Errorf("either secretsEncryption.keyARN or secretsEncryption.createKey must be set")
+	}
+	if !kmsKeyARNPattern.MatchString(keyARN) {
+		return fmt.Errorf("secretsEncryption.keyARN must be the ARN of a KMS key, got %q", keyARN)
+	}
+	return nil
+}
+
+func validateSubnetDiscovery(cfg *ClusterConfig) error {
+	if cfg.VPC == nil || cfg.VPC.SubnetDiscovery == nil {
+		return nil
+	}
+	if cfg.VPC.ID == "" {
+		return fmt.Errorf("vpc.id must be set for vpc.subnetDiscovery")
+	}
+	if cfg.HasAnySubnets() {
+		return fmt.Errorf("vpc.subnetDiscovery and vpc.subnets cannot be used together")
+	}
+	return nil
+}
+
+func validatePodSubnets(cfg *ClusterConfig) error {
+	if !cfg.HasPodSubnets() {
+		return nil
+	}
+	if cfg.IPv6Enabled() {
+		return fmt.Errorf("vpc.podSubnets cannot be used with IPv6")
+	}
+
+	podSubnets := cfg.VPC.PodSubnets
+	if cfg.VPC.ID != "" {
+		if len(podSubnets.Subnets) == 0 {
+			return fmt.Errorf("vpc.podSubnets.subnets must be set when using an existing VPC")
+		}
+		for az, subnet := range podSubnets.Subnets {
+			if subnet.ID == "" {
+				return fmt.Errorf("vpc.podSubnets.subnets[%q].id must be set when using an existing VPC", az)
+			}
+		}
+		return nil
+	}
+
+	if podSubnets.CIDR == nil {
+		return fmt.Errorf("vpc.podSubnets.cidr must be set")
+	}
+	if cfg.VPC.CIDR != nil && (cfg.VPC.CIDR.Contains(podSubnets.CIDR.IP) || podSubnets.CIDR.Contains(cfg.VPC.CIDR.IP)) {
+		return fmt.Errorf("vpc.podSubnets.cidr (%s) must not overlap with vpc.cidr (%s)", podSubnets.CIDR, cfg.VPC.CIDR)
+	}
+	for az, subnet := range podSubnets.Subnets {
+		if subnet.ID != "" {
+			return fmt.Errorf("vpc.podSubnets.subnets[%q].id cannot be set when eksctl creates the VPC", az)
+		}
+		if subnet.CIDR == nil || !podSubnets.CIDR.Contains(subnet.CIDR.IP) {
+			return fmt.Errorf("vpc.podSubnets.subnets[%q].cidr must be within vpc.podSubnets.cidr (%s)", az, podSubnets.CIDR)
+		}
+	}
+	return nil
+}
+
+// ValidateAddon checks the configuration of an addon given at the path
+func ValidateAddon(path string, addon *Addon) error {
+	if !addon.IsEKSAddon() {
+		if addon.Version != "" || addon.ServiceAccountRoleARN != "" || addon.hasPolicies() || addon.ResolveConflicts != "" {
+			return fmt.Errorf("%s: addon %q doesn't accept any configuration", path, addon.Name)
+		}
+		return nil
+	}
+
+	if addon.ServiceAccountRoleARN != "" || addon.hasPolicies() {
+		if addon.ServiceAccountName() == "" {
+			return fmt.Errorf("%s: addon %q doesn't use a service account with an IAM role", path, addon.Name)
+		}
+		if addon.ServiceAccountRoleARN != "" && len(addon.AttachPolicyARNs) > 0 {
+			return fmt.Errorf("%s.serviceAccountRoleARN and %[1]s.attachPolicyARNs cannot be used together", path)
+		}
+		if addon.ServiceAccountRoleARN != "" && addon.AttachPolicy != nil {
+			return fmt.Errorf("%s.serviceAccountRoleARN and %[1]s.attachPolicy cannot be used together", path)
+		}
+	}
+
+	switch addon.ResolveConflicts {
+	case "", AddonResolveConflictsNone, AddonResolveConflictsOverwrite, AddonResolveConflictsPreserve:
+	default:
+		return fmt.Errorf("%s.resolveConflicts must be one of %q, %q or %q, got %q", path,
+			AddonResolveConflictsNone, AddonResolveConflictsOverwrite, AddonResolveConflictsPreserve, addon.ResolveConflicts)
+	}
+	return nil
+}
+
+// validateWindowsSupport makes sure that Windows nodegroups are only
+// used with a control plane version that supports them, and along with
+// a Linux nodegroup that the VPC controller and webhook can run on
+func validateWindowsSupport(cfg *ClusterConfig) error {
+	switch version := cfg.Metadata.Version; version {
+	case Version1_10, Version1_11, Version1_12, Version1_13:
+		return fmt.Errorf("nodegroups with Windows AMI family are only supported by Kubernetes %s or later, got %s", Version1_14, version)
+	}
+	if len(cfg.ManagedNodeGroups) > 0 {
+		return nil
+	}
+	for _, ng := range cfg.NodeGroups {
+		if !IsWindowsImage(ng.AMIFamily) {
+			return nil
+		}
+	}
+	return fmt.Errorf("nodegroups with Windows AMI family require at least one Linux nodegroup, to run the VPC resource controller and admission webhook")
+}
+
 // ValidateNodeGroup checks compatible fields of a given nodegroup
 func ValidateNodeGroup(i int, ng *NodeGroup) error {
 	path := fmt.Sprintf("nodeGroups[%d]", i)
@@ -102,6 +771,10 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		}
 	}
 
+	if err := validateNodeGroupVolumes(path, ng); err != nil {
+		return err
+	}
+
 	if ng.IAM != nil {
 		if err := validateNodeGroupIAM(i, ng, ng.IAM.InstanceProfileARN, "instanceProfileARN", path); err != nil {
 			return err
@@ -109,6 +782,9 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		if err := validateNodeGroupIAM(i, ng, ng.IAM.InstanceRoleARN, "instanceRoleARN", path); err != nil {
 			return err
 		}
+		if boundary := ng.IAM.InstanceRolePermissionsBoundary; boundary != "" && !iamPolicyARNPattern.MatchString(boundary) {
+			return fmt.Errorf("%s.iam.instanceRolePermissionsBoundary %q is invalid, must be the ARN of an IAM policy", path, boundary)
+		}
 
 		if err := ValidateNodeGroupLabels(ng); err != nil {
 			return err
@@ -119,21 +795,175 @@ func ValidateNodeGroup(i int, ng *NodeGroup) error {
 		}
 	}
 
+	if err := validateNodeTaints(path, ng.Taints); err != nil {
+		return err
+	}
+
 	if err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig); err != nil {
 		return err
 	}
 
+	if IsWindowsImage(ng.AMIFamily) && ng.KubeletExtraConfig != nil {
+		return fmt.Errorf("%s.kubeletExtraConfig is not supported for %s nodegroups", path, ng.AMIFamily)
+	}
+
+	if err := validateBootstrapScriptTemplate(path, ng); err != nil {
+		return err
+	}
+
 	if err := validateInstancesDistribution(ng); err != nil {
 		return err
 	}
 
+	if ng.LaunchTemplate != nil {
+		if ng.LaunchTemplate.ID == "" {
+			return fmt.Errorf("%s.launchTemplate.id must be set", path)
+		}
+		if ng.LaunchTemplate.Version != nil && *ng.LaunchTemplate.Version == "" {
+			return fmt.Errorf("%s.launchTemplate.version cannot be empty", path)
+		}
+		if IsWindowsImage(ng.AMIFamily) {
+			return fmt.Errorf("%s.launchTemplate is not supported for %s nodegroups", path, ng.AMIFamily)
+		}
+	}
+
+	if err := validateNodeGroupPlacement(path, ng); err != nil {
+		return err
+	}
+
+	if IsEnabled(ng.EFAEnabled) {
+		if IsWindowsImage(ng.AMIFamily) {
+			return fmt.Errorf("%s.efaEnabled is not supported for %s nodegroups", path, ng.AMIFamily)
+		}
+		// EFA traffic doesn't leave the subnet
+		if len(ng.AvailabilityZones) != 1 {
+			return fmt.Errorf("%s.availabilityZones must have a single availability zone when %s.efaEnabled is set", path, path)
+		}
+	}
+
+	if err := validateNodeGroupCapacityReservation(path, ng); err != nil {
+		return err
+	}
+
+	if err := validateNodeGroupEdge(path, ng); err != nil {
+		return err
+	}
+
+	if mo := ng.MetadataOptions; mo != nil {
+		if mo.HTTPTokens != nil && *mo.HTTPTokens != IMDSHTTPTokensOptional && *mo.HTTPTokens != IMDSHTTPTokensRequired {
+			return fmt.Errorf("%s.metadataOptions.httpTokens must be either %q or %q", path, IMDSHTTPTokensOptional, IMDSHTTPTokensRequired)
+		}
+		if mo.HTTPPutResponseHopLimit != nil && (*mo.HTTPPutResponseHopLimit < 1 || *mo.HTTPPutResponseHopLimit > 64) {
+			return fmt.Errorf("%s.metadataOptions.httpPutResponseHopLimit must be between 1 and 64", path)
+		}
+	}
+
+	if err := validateCloudFormation(path+".cloudFormation", ng.CloudFormation); err != nil {
+		return err
+	}
+	if cfn := ng.CloudFormation; cfn != nil && (len(cfn.StackTags) > 0 || len(cfn.StackPolicy) > 0) {
+		return fmt.Errorf("%s.cloudFormation.stackTags and %s.cloudFormation.stackPolicy cannot be set, set cloudFormation.stackTags and cloudFormation.stackPolicy of the cluster instead", path, path)
+	}
+
 	return nil
 }
 
+// ValidateManagedNodeGroup checks compatible fields of a given managed nodegroup
+func ValidateManagedNodeGroup(i int, ng *ManagedNodeGroup) error {
+	path := fmt.Sprintf("managedNodeGroups[%d]", i)
+
+	if ng.AMIType != "" {
+		isUnknown := true
+		for _, amiType := range SupportedManagedNodeGroupAMITypes() {
+			if ng.AMIType == amiType {
+				isUnknown = false
+			}
+		}
+		if isUnknown {
+			return fmt.Errorf("%s.amiType %q is not supported, must be one of %v", path, ng.AMIType, SupportedManagedNodeGroupAMITypes())
+		}
+	}
+
+	if ng.MinSize != nil && ng.MaxSize != nil && *ng.MinSize > *ng.MaxSize {
+		return fmt.Errorf("%s.minSize cannot be greater than %s.maxSize", path, path)
+	}
+	if ng.DesiredCapacity != nil {
+		if ng.MinSize != nil && *ng.DesiredCapacity < *ng.MinSize {
+			return fmt.Errorf("%s.desiredCapacity cannot be less than %s.minSize", path, path)
+		}
+		if ng.MaxSize != nil && *ng.DesiredCapacity > *ng.MaxSize {
+			return fmt.Errorf("%s.desiredCapacity cannot be greater than %s.maxSize", path, path)
+		}
+	}
+
+	if err := validateNodeLabels(ng.Labels); err != nil {
+		return err
+	}
+
+	if err := validateNodeTaints(path, ng.Taints); err != nil {
+		return err
+	}
+
+	if err := validateNodeGroupSSH(ng.SSH); err != nil {
+		return err
+	}
+
+	if ng.IAM != nil && ng.IAM.InstanceRolePermissionsBoundary != "" {
+		if ng.IAM.InstanceRoleARN != "" {
+			return fmt.Errorf("%s.iam.instanceRoleARN and %s.iam.instanceRolePermissionsBoundary cannot be set at the same time", path, path)
+		}
+		if !iamPolicyARNPattern.MatchString(ng.IAM.InstanceRolePermissionsBoundary) {
+			return fmt.Errorf("%s.iam.instanceRolePermissionsBoundary %q is invalid, must be the ARN of an IAM policy", path, ng.IAM.InstanceRolePermissionsBoundary)
+		}
+	}
+
+	return nil
+}
+
+// validateNodeTaints makes sure taints can be passed to kubelet
+// via --register-with-taints as well as to the EKS API
+func validateNodeTaints(path string, taints map[string]string) error {
+	for key, value := range taints {
+		if errs := validation.IsQualifiedName(key); len(errs) > 0 {
+			return fmt.Errorf("%s.taints has invalid key %q - %v", path, key, errs)
+		}
+		if _, _, err := ParseTaint(value); err != nil {
+			return fmt.Errorf("%s.taints[%q] is invalid - %v", path, key, err)
+		}
+	}
+	return nil
+}
+
+// ParseTaint splits a taint of the form "<value>:<effect>", as used in the
+// taints map of nodegroups, into the value and the effect
+func ParseTaint(taint string) (string, string, error) {
+	var value, effect string
+	if i := strings.LastIndex(taint, ":"); i >= 0 {
+		value, effect = taint[:i], taint[i+1:]
+	} else {
+		effect = taint
+	}
+	switch effect {
+	case "NoSchedule", "PreferNoSchedule", "NoExecute":
+	default:
+		return "", "", fmt.Errorf("taint effect must be one of NoSchedule, PreferNoSchedule or NoExecute, got %q", effect)
+	}
+	if value != "" {
+		if errs := validation.IsValidLabelValue(value); len(errs) > 0 {
+			return "", "", fmt.Errorf("taint value %q is invalid - %v", value, errs)
+		}
+	}
+	return value, effect, nil
+}
+
 // ValidateNodeGroupLabels uses proper Kubernetes label validation,
 // it's designed to make sure users don't pass weird labels to the
 // nodes, which would prevent kubelets to startup properly
 func ValidateNodeGroupLabels(ng *NodeGroup) error {
+	return validateNodeLabels(ng.Labels)
+}
+
+func validateNodeLabels(labels map[string]string) error {
 	// compact version based on:
 	// - https://github.com/kubernetes/kubernetes/blob/v1.13.2/cmd/kubelet/app/options/options.go#L257-L267
 	// - https://github.com/kubernetes/kubernetes/blob/v1.13.2/pkg/kubelet/apis/well_known_labels.go
@@ -141,7 +971,7 @@ func ValidateNodeGroupLabels(ng *NodeGroup) error {
 
 	unknownKubernetesLabels := []string{}
 
-	for l := range ng.Labels {
+	for l := range labels {
 		labelParts := strings.Split(l, "/")
 
 		if len(labelParts) > 2 {
@@ -151,8 +981,8 @@ func ValidateNodeGroupLabels(ng *NodeGroup) error {
 		if errs := validation.IsQualifiedName(l); len(errs) > 0 {
 			return fmt.Errorf("label %q is invalid - %v", l, errs)
 		}
-		if errs := validation.IsValidLabelValue(ng.Labels[l]); len(errs) > 0 {
-			return fmt.Errorf("label %q has invalid value %q - %v", l, ng.Labels[l], errs)
+		if errs := validation.IsValidLabelValue(labels[l]); len(errs) > 0 {
+			return fmt.Errorf("label %q has invalid value %q - %v", l, labels[l], errs)
 		}
 
 		isKubernetesLabel := false
@@ -208,6 +1038,9 @@ func validateNodeGroupIAM(i int, ng *NodeGroup, value, fieldName, path string) e
 		if ng.IAM.InstanceRoleName != "" {
 			return fmtFieldConflictErr("instanceRoleName")
 		}
+		if ng.IAM.InstanceRolePermissionsBoundary != "" {
+			return fmtFieldConflictErr("instanceRolePermissionsBoundary")
+		}
 		if len(ng.IAM.AttachPolicyARNs) != 0 {
 			return fmtFieldConflictErr("attachPolicyARNs")
 		}
@@ -249,6 +1082,154 @@ func validateNodeGroupIAM(i int, ng *NodeGroup, value, fieldName, path string) e
 	return nil
 }
 
+func validateNodeGroupVolumes(path string, ng *NodeGroup) error {
+	deviceNames := map[string]bool{}
+	if IsSetAndNonEmptyString(ng.VolumeName) {
+		deviceNames[*ng.VolumeName] = true
+	}
+	mountPaths := map[string]bool{}
+	validateMountPath := func(fieldPath, mountPath string) error {
+		if !strings.HasPrefix(mountPath, "/") || mountPath == "/" {
+			return fmt.Errorf("%s must be an absolute path other than /", fieldPath)
+		}
+		if mountPaths[mountPath] {
+			return fmt.Errorf("%s %q is used by more than one volume", fieldPath, mountPath)
+		}
+		mountPaths[mountPath] = true
+		if IsWindowsImage(ng.AMIFamily) {
+			return fmt.Errorf("%s is not supported for %s nodegroups", fieldPath, ng.AMIFamily)
+		}
+		return nil
+	}
+
+	for i, v := range ng.AdditionalVolumes {
+		volumePath := fmt.Sprintf("%s.additionalVolumes[%d]", path, i)
+		if v.VolumeName == "" {
+			return fmt.Errorf("%s.volumeName must be set", volumePath)
+		}
+		if deviceNames[v.VolumeName] {
+			return fmt.Errorf("%s.volumeName %q is used by more than one volume", volumePath, v.VolumeName)
+		}
+		deviceNames[v.VolumeName] = true
+		if v.VolumeSize == nil || *v.VolumeSize <= 0 {
+			return fmt.Errorf("%s.volumeSize must be set to a positive number", volumePath)
+		}
+
+		volumeType := DefaultNodeVolumeType
+		if v.VolumeType != nil {
+			volumeType = *v.VolumeType
+		}
+		if !isOneOf(volumeType, SupportedNodeVolumeTypes()) {
+			return fmt.Errorf("%s.volumeType %q is not supported, must be one of %v", volumePath, volumeType, SupportedNodeVolumeTypes())
+		}
+		switch {
+		case volumeType == NodeVolumeTypeIO1 && v.VolumeIOPS == nil:
+			return fmt.Errorf("%s.volumeIOPS is required for %s volume type", volumePath, NodeVolumeTypeIO1)
+		case volumeType != NodeVolumeTypeIO1 && volumeType != NodeVolumeTypeGP3 && v.VolumeIOPS != nil:
+			return fmt.Errorf("%s.volumeIOPS is only supported for %s and %s volume types", volumePath, NodeVolumeTypeIO1, NodeVolumeTypeGP3)
+		case volumeType != NodeVolumeTypeGP3 && v.VolumeThroughput != nil:
+			return fmt.Errorf("%s.volumeThroughput is only supported for %s volume type", volumePath, NodeVolumeTypeGP3)
+		}
+
+		if !IsEnabled(v.VolumeEncrypted) && IsSetAndNonEmptyString(v.VolumeKmsKeyID) {
+			return fmt.Errorf("%s.volumeKmsKeyID can not be set without %s.volumeEncrypted enabled explicitly", volumePath, volumePath)
+		}
+
+		if v.MountPath != "" {
+			if err := validateMountPath(volumePath+".mountPath", v.MountPath); err != nil {
+				return err
+			}
+		}
+	}
+
+	if ng.InstanceStore != nil {
+		if err := validateMountPath(path+".instanceStore.mountPath", ng.InstanceStore.MountPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateNodeGroupCapacityReservation(path string, ng *NodeGroup) error {
+	cr := ng.CapacityReservation
+	if cr == nil {
+		return nil
+	}
+	switch {
+	case cr.ID == "" && cr.Preference == "":
+		return fmt.Errorf("either %s.capacityReservation.id or %s.capacityReservation.preference must be set", path, path)
+	case cr.ID != "" && cr.Preference != "":
+		return fmt.Errorf("%s.capacityReservation.id and %s.capacityReservation.preference cannot be set at the same time", path, path)
+	case cr.Preference != "" && !isOneOf(cr.Preference, SupportedCapacityReservationPreferences()):
+		return fmt.Errorf("%s.capacityReservation.preference %q is not supported, must be one of %v", path, cr.Preference, SupportedCapacityReservationPreferences())
+	case IsEnabled(cr.CapacityBlock) && cr.ID == "":
+		return fmt.Errorf("%s.capacityReservation.id must be set when %s.capacityReservation.capacityBlock is enabled", path, path)
+	case IsEnabled(cr.CapacityBlock) && ng.InstancesDistribution != nil:
+		return fmt.Errorf("%s.capacityReservation.capacityBlock cannot be used with %s.instancesDistribution", path, path)
+	}
+	return nil
+}
+
+func validateNodeGroupEdge(path string, ng *NodeGroup) error {
+	edge := ng.Edge
+	if edge == nil {
+		return nil
+	}
+	if !isOneOf(edge.Type, SupportedEdgeTypes()) {
+		return fmt.Errorf("%s.edge.type %q is not supported, must be one of %v", path, edge.Type, SupportedEdgeTypes())
+	}
+	if len(edge.Subnets) == 0 {
+		return fmt.Errorf("%s.edge.subnets must be set", path)
+	}
+	if len(ng.AvailabilityZones) > 0 {
+		return fmt.Errorf("%s.availabilityZones and %s.edge cannot be used together", path, path)
+	}
+	if edge.Type == EdgeTypeOutpost {
+		if !outpostARNPattern.MatchString(edge.OutpostARN) {
+			return fmt.Errorf("%s.edge.outpostARN must be the ARN of an Outpost, got %q", path, edge.OutpostARN)
+		}
+	} else if edge.OutpostARN != "" {
+		return fmt.Errorf("%s.edge.outpostARN can only be set for edge type %q", path, EdgeTypeOutpost)
+	}
+	if IsWindowsImage(ng.AMIFamily) {
+		return fmt.Errorf("%s.edge is not supported for %s nodegroups", path, ng.AMIFamily)
+	}
+	instanceTypes := []string{ng.InstanceType}
+	if ng.InstancesDistribution != nil {
+		instanceTypes = ng.InstancesDistribution.InstanceTypes
+	}
+	for _, instanceType := range instanceTypes {
+		if !isSupportedEdgeInstanceType(edge.Type, instanceType) {
+			return fmt.Errorf("%s instance type %q is not available in edge locations of type %q", path, instanceType, edge.Type)
+		}
+	}
+	if edge.Type != EdgeTypeLocalZone && ng.InstancesDistribution != nil {
+		// there is no Spot capacity in Wavelength Zones and on Outposts
+		return fmt.Errorf("%s.instancesDistribution is not supported in edge locations of type %q", path, edge.Type)
+	}
+	return nil
+}
+
+func validateNodeGroupPlacement(path string, ng *NodeGroup) error {
+	if p := ng.Placement; p != nil {
+		switch {
+		case p.GroupName == "" && p.Strategy == "":
+			return fmt.Errorf("either %s.placement.groupName or %s.placement.strategy must be set", path, path)
+		case p.GroupName != "" && p.Strategy != "":
+			return fmt.Errorf("%s.placement.groupName and %s.placement.strategy cannot be set at the same time", path, path)
+		case p.Strategy != "" && !isOneOf(p.Strategy, SupportedPlacementStrategies()):
+			return fmt.Errorf("%s.placement.strategy %q is not supported, must be one of %v", path, p.Strategy, SupportedPlacementStrategies())
+		case p.Strategy == PlacementStrategyCluster && len(ng.AvailabilityZones) != 1:
+			// instances of cluster placement groups have to be in a single availability zone
+			return fmt.Errorf("%s.availabilityZones must have a single availability zone for placement strategy %q", path, PlacementStrategyCluster)
+		}
+	}
+	if ng.Tenancy != "" && !isOneOf(ng.Tenancy, SupportedTenancies()) {
+		return fmt.Errorf("%s.tenancy %q is not supported, must be one of %v", path, ng.Tenancy, SupportedTenancies())
+	}
+	return nil
+}
+
 func validateInstancesDistribution(ng *NodeGroup) error {
 	if ng.InstancesDistribution == nil {
 		return nil
@@ -334,3 +1315,18 @@ func validateNodeGroupKubeletExtraConfig(kubeletConfig *InlineDocument) error {
 	}
 	return nil
 }
+
+// validateBootstrapScriptTemplate checks that bootstrapScriptTemplate is a valid template, which replaces the
+// bootstrap script just like overrideBootstrapCommand does, so they can't be used together
+func validateBootstrapScriptTemplate(path string, ng *NodeGroup) error {
+	if ng.BootstrapScriptTemplate == nil {
+		return nil
+	}
+	if ng.OverrideBootstrapCommand != nil {
+		return fmt.Errorf("%s.bootstrapScriptTemplate and %s.overrideBootstrapCommand cannot be used together", path, path)
+	}
+	if _, err := template.New("bootstrapScriptTemplate").Parse(*ng.BootstrapScriptTemplate); err != nil {
+		return fmt.Errorf("%s.bootstrapScriptTemplate is invalid: %s", path, err)
+	}
+	return nil
+}
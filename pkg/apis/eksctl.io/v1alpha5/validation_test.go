@@ -1,8 +1,14 @@
 package v1alpha5
 
 import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 )
 
 var _ = Describe("ClusterConfig validation", func() {
@@ -203,6 +209,53 @@ var _ = Describe("ClusterConfig validation", func() {
 			Expect(err).NotTo(HaveOccurred())
 		})
 
+		It("should validate role names, paths and permissions boundaries of iam.serviceAccounts", func() {
+			cfg.IAM.WithOIDC = Enabled()
+
+			cfg.IAM.ServiceAccounts = []*ClusterIAMServiceAccount{{}, {}}
+			for i, sa := range cfg.IAM.ServiceAccounts {
+				sa.Name = fmt.Sprintf("sa-%d", i)
+				sa.AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}
+			}
+			cfg.IAM.ServiceAccounts[0].RoleName = "s3-reader"
+			cfg.IAM.ServiceAccounts[0].RolePath = "/irsa/readers/"
+			cfg.IAM.ServiceAccounts[0].PermissionsBoundary = "arn:aws:iam::123456789012:policy/boundary"
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			cfg.IAM.ServiceAccounts[1].RoleName = "s3-reader"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`iam.serviceAccounts[1].roleName "s3-reader" is not unique`))
+
+			cfg.IAM.ServiceAccounts[1].RoleName = ""
+			cfg.IAM.ServiceAccounts[1].RolePath = "irsa"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`iam.serviceAccounts[1].rolePath "irsa" is invalid`)))
+
+			cfg.IAM.ServiceAccounts[1].RolePath = ""
+			cfg.IAM.ServiceAccounts[1].PermissionsBoundary = "arn:aws:iam::123456789012:role/boundary"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("permissionsBoundary")))
+		})
+
+		It("should validate trust policy overrides of iam.serviceAccounts", func() {
+			cfg.IAM.WithOIDC = Enabled()
+
+			cfg.IAM.ServiceAccounts = []*ClusterIAMServiceAccount{{
+				AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				OIDCIssuerURL:    "https://oidc.eks.us-west-2.amazonaws.com/id/HUB",
+				TrustedAccounts:  []string{"111122223333", "444455556666"},
+			}}
+			cfg.IAM.ServiceAccounts[0].Name = "sa-1"
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			cfg.IAM.ServiceAccounts[0].OIDCIssuerURL = "oidc.eks.us-west-2.amazonaws.com/id/HUB"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("must be an https:// URL")))
+
+			cfg.IAM.ServiceAccounts[0].OIDCIssuerURL = ""
+			cfg.IAM.ServiceAccounts[0].TrustedAccounts = []string{"1111-2222-3333"}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("must be a 12-digit account ID")))
+
+			cfg.IAM.ServiceAccounts[0].TrustedAccounts = []string{"111122223333", "111122223333"}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("is not unique")))
+		})
+
 		It("should fail when unnamed iam.serviceAccounts[1] is given", func() {
 			cfg.IAM.WithOIDC = Enabled()
 
@@ -291,221 +344,1572 @@ var _ = Describe("ClusterConfig validation", func() {
 		})
 	})
 
-	Describe("ssh flags", func() {
-		var (
-			testKeyPath = "some/path/to/file.pub"
-			testKeyName = "id_rsa.pub"
-			testKey     = "THIS IS A KEY"
-		)
+	Describe("iamIdentityMappings", func() {
+		var cfg *ClusterConfig
 
-		It("fails when a key path and a key name are specified", func() {
-			SSHConfig := &NodeGroupSSH{
-				Allow:         Enabled(),
-				PublicKeyPath: &testKeyPath,
-				PublicKeyName: &testKeyName,
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+		})
+
+		It("should accept mappings of roles and users", func() {
+			cfg.IAMIdentityMappings = []*IAMIdentityMapping{
+				{ARN: "arn:aws:iam::123456789012:role/admin", Groups: []string{"system:masters"}},
+				{ARN: "arn:aws-cn:iam::123456789012:user/ops/alice", Username: "alice"},
 			}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
 
-			checkItDetectsError(SSHConfig)
+		It("should reject invalid mappings", func() {
+			cfg.IAMIdentityMappings = []*IAMIdentityMapping{{ARN: "arn:aws:iam::123456789012:policy/admin", Username: "admin"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("must be the ARN of an IAM role or user")))
+
+			cfg.IAMIdentityMappings = []*IAMIdentityMapping{{ARN: "arn:aws:iam::123456789012:role/admin"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("iamIdentityMappings[0].username or iamIdentityMappings[0].groups must be set"))
+
+			cfg.IAMIdentityMappings = []*IAMIdentityMapping{{ARN: "arn:aws:iam::123456789012:role/admin", Groups: []string{"system:kube-scheduler"}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`cannot include "system:kube-scheduler"`)))
+
+			cfg.IAMIdentityMappings = []*IAMIdentityMapping{
+				{ARN: "arn:aws:iam::123456789012:role/admin", Username: "a"},
+				{ARN: "arn:aws:iam::123456789012:role/admin", Username: "b"},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("is not unique")))
 		})
+	})
 
-		It("fails when a key path and a key are specified", func() {
-			SSHConfig := &NodeGroupSSH{
-				Allow:         Enabled(),
-				PublicKeyPath: &testKeyPath,
-				PublicKey:     &testKey,
+	Describe("podIdentityAssociations", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+		})
+
+		It("should accept associations with existing or new roles", func() {
+			cfg.IAM.PodIdentityAssociations = []*PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "sa-1", RoleARN: "arn:aws:iam::123456789012:role/sa-1"},
+				{
+					Namespace:           "default",
+					ServiceAccountName:  "sa-2",
+					RoleName:            "sa-2",
+					PermissionsBoundary: "arn:aws:iam::123456789012:policy/boundary",
+					AttachPolicyARNs:    []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+				},
 			}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
 
-			checkItDetectsError(SSHConfig)
+		It("should reject invalid associations", func() {
+			cfg.IAM.PodIdentityAssociations = []*PodIdentityAssociation{{ServiceAccountName: "sa-1", RoleARN: "arn:aws:iam::123456789012:role/sa-1"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("iam.podIdentityAssociations[0].namespace must be set")))
+
+			cfg.IAM.PodIdentityAssociations = []*PodIdentityAssociation{{Namespace: "default", ServiceAccountName: "sa-1"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("attachPolicyARNs or iam.podIdentityAssociations[0].attachPolicy must be set")))
+
+			cfg.IAM.PodIdentityAssociations = []*PodIdentityAssociation{{
+				Namespace:          "default",
+				ServiceAccountName: "sa-1",
+				RoleARN:            "arn:aws:iam::123456789012:role/sa-1",
+				AttachPolicyARNs:   []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+			}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("roleARN cannot be set along with")))
+
+			cfg.IAM.PodIdentityAssociations = []*PodIdentityAssociation{{Namespace: "default", ServiceAccountName: "sa-1", RoleARN: "arn:aws:iam::123456789012:policy/sa-1"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("must be the ARN of an IAM role")))
+
+			cfg.IAM.PodIdentityAssociations = []*PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "sa-1", RoleARN: "arn:aws:iam::123456789012:role/sa-1"},
+				{Namespace: "default", ServiceAccountName: "sa-1", RoleARN: "arn:aws:iam::123456789012:role/sa-2"},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("is not unique")))
 		})
+	})
 
-		It("fails when a key name and a key are specified", func() {
-			SSHConfig := &NodeGroupSSH{
-				Allow:         Enabled(),
-				PublicKeyName: &testKeyName,
-				PublicKey:     &testKey,
+	Describe("accessEntries", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.AccessConfig = &AccessConfig{AuthenticationMode: AuthenticationModeAPI}
+		})
+
+		It("should accept access entries with policies", func() {
+			cfg.AccessEntries = []*AccessEntry{
+				{
+					PrincipalARN:     "arn:aws:iam::123456789012:role/dev",
+					KubernetesGroups: []string{"devs"},
+					AccessPolicies: []AccessPolicy{{
+						PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy",
+						AccessScope: AccessScope{Type: AccessScopeNamespace, Namespaces: []string{"dev"}},
+					}},
+				},
+				{PrincipalARN: "arn:aws:iam::123456789012:role/nodes", Type: "EC2_LINUX"},
 			}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
 
-			checkItDetectsError(SSHConfig)
+		It("should reject an unsupported authentication mode", func() {
+			cfg.AccessConfig.AuthenticationMode = "IAM"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`accessConfig.authenticationMode "IAM" is not supported`)))
 		})
 
-		It("It doesn't panic when SSH is not enabled", func() {
-			err := validateNodeGroupSSH(nil)
-			Expect(err).ToNot(HaveOccurred())
+		It("should require an authentication mode that uses access entries", func() {
+			cfg.AccessConfig = nil
+			cfg.AccessEntries = []*AccessEntry{{PrincipalARN: "arn:aws:iam::123456789012:role/dev"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("accessEntries can only be used when")))
 		})
 
-		Context("Instances distribution", func() {
+		It("should reject the aws-auth ConfigMap with authentication mode API", func() {
+			cfg.IAMIdentityMappings = []*IAMIdentityMapping{{ARN: "arn:aws:iam::123456789012:role/admin", Username: "admin"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("iamIdentityMappings cannot be used")))
 
-			var ng *NodeGroup
-			BeforeEach(func() {
-				ng = &NodeGroup{
-					InstancesDistribution: &NodeGroupInstancesDistribution{
-						InstanceTypes: []string{"t3.medium", "t3.large"},
-					},
-				}
-			})
+			cfg.IAMIdentityMappings = nil
+			cfg.NewNodeGroup()
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("nodeGroups cannot be used")))
+		})
 
-			It("It doesn't panic when instance distribution is not enabled", func() {
-				ng.InstancesDistribution = nil
-				err := validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+		It("should reject invalid access entries", func() {
+			cfg.AccessEntries = []*AccessEntry{{PrincipalARN: "arn:aws:iam::123456789012:group/devs"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("must be the ARN of an IAM role or user")))
 
-			It("It fails when instance distribution is enabled and instanceType is not empty or \"mixed\"", func() {
-				err := validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
+			cfg.AccessEntries = []*AccessEntry{{PrincipalARN: "arn:aws:iam::123456789012:role/nodes", Type: "EC2_LINUX", KubernetesGroups: []string{"nodes"}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("cannot set kubernetesUsername, kubernetesGroups or accessPolicies for type EC2_LINUX")))
 
-				ng.InstanceType = "t3.small"
+			cfg.AccessEntries = []*AccessEntry{{PrincipalARN: "arn:aws:iam::123456789012:role/admin", KubernetesGroups: []string{"system:masters"}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring(`cannot include "system:masters"`)))
 
-				err = validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
-			})
+			cfg.AccessEntries = []*AccessEntry{
+				{PrincipalARN: "arn:aws:iam::123456789012:role/dev"},
+				{PrincipalARN: "arn:aws:iam::123456789012:role/dev"},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("is not unique")))
+		})
 
-			It("It fails when the instance distribution doesn't have at least 2 different instance types", func() {
-				ng.InstanceType = "mixed"
-				ng.InstancesDistribution.InstanceTypes = []string{"t3.medium", "t3.medium"}
+		It("should reject invalid access policies", func() {
+			entry := &AccessEntry{PrincipalARN: "arn:aws:iam::123456789012:role/dev"}
+			cfg.AccessEntries = []*AccessEntry{entry}
 
-				err := validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+			entry.AccessPolicies = []AccessPolicy{{PolicyARN: "arn:aws:iam::aws:policy/AdministratorAccess", AccessScope: AccessScope{Type: AccessScopeCluster}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("must be the ARN of an EKS access policy")))
 
-				ng.InstanceType = "mixed"
-				ng.InstancesDistribution.InstanceTypes = []string{"t3.medium", "t3.small"}
+			entry.AccessPolicies = []AccessPolicy{{PolicyARN: "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy", AccessScope: AccessScope{Type: AccessScopeNamespace}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("accessScope.namespaces must be set")))
 
-				err = validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+			entry.AccessPolicies = []AccessPolicy{{PolicyARN: "arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy", AccessScope: AccessScope{Type: AccessScopeCluster, Namespaces: []string{"dev"}}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("accessScope.namespaces cannot be set")))
+		})
+	})
 
-			It("It fails when the onDemandBaseCapacity is not above 0", func() {
-				ng.InstancesDistribution.OnDemandBaseCapacity = newInt(-1)
+	Describe("addons", func() {
+		var cfg *ClusterConfig
 
-				err := validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+		})
 
-				ng.InstancesDistribution.OnDemandBaseCapacity = newInt(1)
+		It("should accept addons given by name", func() {
+			Expect(json.Unmarshal([]byte(`{"addons": ["cluster-autoscaler"]}`), cfg)).To(Succeed())
+			Expect(cfg.Addons).To(Equal([]*Addon{{Name: "cluster-autoscaler"}}))
 
-				err = validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+			Expect(json.Unmarshal([]byte(`{"addons": [{"name": "cluster-autoscaler"}]}`), cfg)).To(Succeed())
+			Expect(cfg.Addons).To(Equal([]*Addon{{Name: "cluster-autoscaler"}}))
 
-			It("It fails when the spotInstancePools is not between 1 and 20", func() {
-				ng.InstancesDistribution.SpotInstancePools = newInt(0)
+			Expect(json.Unmarshal([]byte(`{"addons": [{"nmae": "cluster-autoscaler"}]}`), cfg)).ToNot(Succeed())
+		})
 
-				err := validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+		It("should enable iam.withOIDC for cluster-autoscaler by default", func() {
+			cfg.Addons = []*Addon{{Name: ClusterAutoscalerAddon}}
+			SetClusterConfigDefaults(cfg)
+			Expect(IsEnabled(cfg.IAM.WithOIDC)).To(BeTrue())
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
 
-				ng.InstancesDistribution.SpotInstancePools = newInt(21)
-				err = validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+		It("should fail when iam.withOIDC is disabled for cluster-autoscaler", func() {
+			cfg.Addons = []*Addon{{Name: ClusterAutoscalerAddon}}
+			cfg.IAM.WithOIDC = Disabled()
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`iam.withOIDC must be enabled for addon "cluster-autoscaler"`))
+		})
 
-				ng.InstancesDistribution.SpotInstancePools = newInt(2)
-				err = validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+		It("should enable iam.withOIDC for alb-ingress by default", func() {
+			cfg.Metadata.Version = "1.19"
+			cfg.Addons = []*Addon{{Name: ALBIngressAddon}}
+			SetClusterConfigDefaults(cfg)
+			Expect(IsEnabled(cfg.IAM.WithOIDC)).To(BeTrue())
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.EKSAddons()).To(BeEmpty())
 
-			It("It fails when the onDemandPercentageAboveBaseCapacity is not between 0 and 100", func() {
-				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(-1)
+			cfg.IAM.WithOIDC = Disabled()
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`iam.withOIDC must be enabled for addon "alb-ingress"`))
+		})
 
-				err := validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+		It("should reject alb-ingress for Kubernetes versions the AWS Load Balancer Controller doesn't support", func() {
+			cfg.Metadata.Version = Version1_14
+			cfg.Addons = []*Addon{{Name: ALBIngressAddon}}
+			SetClusterConfigDefaults(cfg)
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`addon "alb-ingress" is only supported by Kubernetes 1.15 or later, got 1.14`))
+		})
 
-				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(101)
-				err = validateInstancesDistribution(ng)
-				Expect(err).To(HaveOccurred())
+		It("should reject unknown and duplicate addons", func() {
+			cfg.IAM.WithOIDC = Enabled()
 
-				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(50)
-				err = validateInstancesDistribution(ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+			cfg.Addons = []*Addon{{Name: "dashboard"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`addons[0].name "dashboard" is not supported, must be one of [cluster-autoscaler alb-ingress vpc-cni coredns kube-proxy aws-ebs-csi-driver eks-pod-identity-agent]`))
+
+			cfg.Addons = []*Addon{{Name: ClusterAutoscalerAddon}, {Name: ClusterAutoscalerAddon}}
+			Expect(ValidateClusterConfig(cfg)).To(HaveOccurred())
 		})
-	})
 
-	Describe("kubelet extra config", func() {
-		Context("Instances distribution", func() {
+		It("should accept configuration of EKS addons", func() {
+			cfg.Addons = []*Addon{
+				{Name: VPCCNIAddon, Version: "v1.7.5-eksbuild.1", AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"}},
+				{Name: CoreDNSAddon, ResolveConflicts: AddonResolveConflictsOverwrite},
+				{Name: KubeProxyAddon},
+			}
+			SetClusterConfigDefaults(cfg)
+			Expect(IsEnabled(cfg.IAM.WithOIDC)).To(BeTrue())
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.EKSAddons()).To(HaveLen(3))
+		})
 
-			var ng *NodeGroup
-			BeforeEach(func() {
-				ng = &NodeGroup{}
-			})
+		It("should reject invalid configuration of addons", func() {
+			cfg.IAM.WithOIDC = Enabled()
 
-			It("Forbids overriding basic fields", func() {
-				testKeys := []string{"kind", "apiVersion", "address", "clusterDomain", "authentication",
-					"authorization", "serverTLSBootstrap"}
+			cfg.Addons = []*Addon{{Name: ClusterAutoscalerAddon, Version: "v1.14.6"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`addons[0]: addon "cluster-autoscaler" doesn't accept any configuration`))
 
-				for _, key := range testKeys {
-					ng.KubeletExtraConfig = &InlineDocument{
-						key: "should-not-be-allowed",
-					}
-					err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
-					Expect(err).To(HaveOccurred())
-				}
-			})
+			cfg.Addons = []*Addon{{Name: CoreDNSAddon, AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`addons[0]: addon "coredns" doesn't use a service account with an IAM role`))
 
-			It("Allows other kubelet options", func() {
-				ng.KubeletExtraConfig = &InlineDocument{
-					"kubeReserved": map[string]string{
-						"cpu":               "300m",
-						"memory":            "300Mi",
-						"ephemeral-storage": "1Gi",
-					},
-					"kubeReservedCgroup": "/kube-reserved",
-					"cgroupDriver":       "systemd",
-					"featureGates": map[string]bool{
-						"VolumeScheduling":         false,
-						"VolumeSnapshotDataSource": true,
-					},
-				}
-				err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
-				Expect(err).ToNot(HaveOccurred())
-			})
+			cfg.Addons = []*Addon{{Name: VPCCNIAddon, ServiceAccountRoleARN: "arn:aws:iam::123:role/cni", AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`addons[0].serviceAccountRoleARN and addons[0].attachPolicyARNs cannot be used together`))
+
+			cfg.Addons = []*Addon{{Name: KubeProxyAddon, ResolveConflicts: "ignore"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`addons[0].resolveConflicts must be one of "none", "overwrite" or "preserve", got "ignore"`))
+		})
 
+		It("should fail when iam.withOIDC is disabled for addons with policies", func() {
+			cfg.IAM.WithOIDC = Disabled()
+			cfg.Addons = []*Addon{{Name: VPCCNIAddon, AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`iam.withOIDC must be enabled for addons[0].attachPolicyARNs`))
 		})
 	})
 
-	Describe("ebs encryption", func() {
-		var (
-			nodegroup = "ng1"
-			volSize   = 50
-			kmsKeyID  = "36c0b54e-64ed-4f2d-a1c7-96558764311e"
-			disabled  = false
-			enabled   = true
-		)
+	Describe("fargateProfiles", func() {
+		var cfg *ClusterConfig
 
-		Context("Encrypted workers", func() {
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Version = Version1_14
+		})
 
-			var ng *NodeGroup
-			BeforeEach(func() {
-				ng = &NodeGroup{}
-			})
+		It("should accept profiles with selectors", func() {
+			cfg.FargateProfiles = []*FargateProfile{
+				{Name: "default", Selectors: []FargateProfileSelector{{Namespace: "default"}, {Namespace: "kube-system"}}},
+				{Name: "dev", Selectors: []FargateProfileSelector{{Namespace: "dev", Labels: map[string]string{"env": "dev"}}}},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
 
-			It("Forbids setting volumeKmsKeyID without volumeEncrypted", func() {
-				ng.Name = nodegroup
-				ng.VolumeSize = &volSize
-				ng.VolumeEncrypted = nil
-				ng.VolumeKmsKeyID = &kmsKeyID
-				err := ValidateNodeGroup(0, ng)
-				Expect(err).To(HaveOccurred())
-			})
+		It("should reject invalid profiles", func() {
+			cfg.FargateProfiles = []*FargateProfile{{Selectors: []FargateProfileSelector{{Namespace: "default"}}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`fargateProfiles[0].name must be set`))
 
-			It("Forbids setting volumeKmsKeyID with volumeEncrypted: false", func() {
-				ng.Name = nodegroup
-				ng.VolumeSize = &volSize
-				ng.VolumeEncrypted = &disabled
-				ng.VolumeKmsKeyID = &kmsKeyID
-				err := ValidateNodeGroup(0, ng)
-				Expect(err).To(HaveOccurred())
-			})
+			cfg.FargateProfiles = []*FargateProfile{{Name: "eks-default", Selectors: []FargateProfileSelector{{Namespace: "default"}}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`fargateProfiles[0].name "eks-default" is invalid, names starting with "eks-" are reserved`))
 
-			It("Allows setting volumeKmsKeyID with volumeEncrypted: true", func() {
-				ng.Name = nodegroup
-				ng.VolumeSize = &volSize
-				ng.VolumeEncrypted = &enabled
-				ng.VolumeKmsKeyID = &kmsKeyID
-				err := ValidateNodeGroup(0, ng)
-				Expect(err).ToNot(HaveOccurred())
-			})
+			cfg.FargateProfiles = []*FargateProfile{{Name: "default"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`fargateProfiles[0].selectors must be set`))
+
+			cfg.FargateProfiles = []*FargateProfile{{Name: "default", Selectors: make([]FargateProfileSelector, 6)}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`fargateProfiles[0].selectors cannot have more than 5 selectors, got 6`))
+
+			cfg.FargateProfiles = []*FargateProfile{{Name: "default", Selectors: []FargateProfileSelector{{Namespace: "default"}, {Labels: map[string]string{"app": "web"}}}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`fargateProfiles[0].selectors[1].namespace must be set`))
+
+			cfg.FargateProfiles = []*FargateProfile{
+				{Name: "default", Selectors: []FargateProfileSelector{{Namespace: "default"}}},
+				{Name: "default", Selectors: []FargateProfileSelector{{Namespace: "kube-system"}}},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`fargateProfiles[1].name "default" is not unique`))
+		})
 
+		It("should reject profiles on Kubernetes versions older than 1.14", func() {
+			cfg.Metadata.Version = Version1_13
+			cfg.FargateProfiles = []*FargateProfile{{Name: "default", Selectors: []FargateProfileSelector{{Namespace: "default"}}}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`Fargate profiles are only supported by Kubernetes 1.14 or later, got 1.13`))
+		})
+
+		It("should check if pods are schedulable onto Fargate", func() {
+			cfg.FargateProfiles = []*FargateProfile{
+				{Name: "system", Selectors: []FargateProfileSelector{{Namespace: "kube-system", Labels: map[string]string{"k8s-app": "kube-dns"}}}},
+			}
+			Expect(cfg.IsSchedulableOnFargate("kube-system", map[string]string{"k8s-app": "kube-dns", "eks.amazonaws.com/component": "coredns"})).To(BeTrue())
+			Expect(cfg.IsSchedulableOnFargate("kube-system", map[string]string{"k8s-app": "kube-proxy"})).To(BeFalse())
+			Expect(cfg.IsSchedulableOnFargate("default", map[string]string{"k8s-app": "kube-dns"})).To(BeFalse())
 		})
 	})
 
+	Describe("vpc security group rules", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+		})
+
+		It("should default protocol and port range", func() {
+			cfg.VPC.ControlPlaneSecurityGroupRules = &SecurityGroupRules{
+				Ingress: []SecurityGroupRule{{FromPort: aws.Int(443), CIDR: ipnet.MustParseCIDR("10.0.0.0/8")}},
+			}
+			SetClusterConfigDefaults(cfg)
+			rule := cfg.VPC.ControlPlaneSecurityGroupRules.Ingress[0]
+			Expect(rule.Protocol).To(Equal(SecurityGroupProtocolTCP))
+			Expect(*rule.ToPort).To(Equal(443))
+			Expect(rule.IsIPv6()).To(BeFalse())
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should reject invalid rules", func() {
+			cfg.VPC.SharedNodeSecurityGroupRules = &SecurityGroupRules{
+				Egress: []SecurityGroupRule{{FromPort: aws.Int(443)}},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("vpc.sharedNodeSecurityGroupRules.egress[0] must have either cidr or securityGroupID set"))
+
+			cfg.VPC.SharedNodeSecurityGroupRules.Egress[0].SecurityGroupID = "sg-1"
+			cfg.VPC.SharedNodeSecurityGroupRules.Egress[0].ToPort = aws.Int(80)
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("vpc.sharedNodeSecurityGroupRules.egress[0] has invalid port range 443-80"))
+
+			cfg.VPC.SharedNodeSecurityGroupRules.Egress[0].Protocol = "-1"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.sharedNodeSecurityGroupRules.egress[0].fromPort and vpc.sharedNodeSecurityGroupRules.egress[0].toPort cannot be set when vpc.sharedNodeSecurityGroupRules.egress[0].protocol is "-1"`))
+
+			cfg.VPC.SharedNodeSecurityGroupRules.Egress[0].Protocol = "sctp"
+			Expect(ValidateClusterConfig(cfg).Error()).To(HaveSuffix(`got "sctp"`))
+		})
+
+		It("should reject rules for existing security groups", func() {
+			cfg.VPC.SecurityGroup = "sg-cp"
+			cfg.VPC.ControlPlaneSecurityGroupRules = &SecurityGroupRules{}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("vpc.controlPlaneSecurityGroupRules cannot be used with an existing security group"))
+		})
+	})
+
+	Describe("privateCluster", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Region = "eu-west-1"
+			cfg.Metadata.Version = Version1_14
+			cfg.PrivateCluster = &PrivateCluster{Enabled: Enabled()}
+		})
+
+		It("should disable NAT and require private networking of nodes", func() {
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.PrivateNetworking = true
+			mng := &ManagedNodeGroup{Name: "mng-1", PrivateNetworking: true}
+			cfg.ManagedNodeGroups = []*ManagedNodeGroup{mng}
+
+			SetClusterConfigDefaults(cfg)
+			Expect(*cfg.VPC.NAT.Gateway).To(Equal(ClusterDisableNAT))
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			mng.PrivateNetworking = false
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("managedNodeGroups[0].privateNetworking must be enabled in a private cluster"))
+			Expect(mng.PrivateNetworking).To(BeFalse())
+
+			ng.PrivateNetworking = false
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("nodeGroups[0].privateNetworking must be enabled in a private cluster"))
+		})
+
+		It("should list endpoint services needed by addons and nodes", func() {
+			Expect(cfg.EndpointServices()).To(Equal([]string{"s3", "ec2", "ecr.api", "ecr.dkr", "sts", "logs"}))
+
+			cfg.Addons = []*Addon{{Name: ClusterAutoscalerAddon}}
+			cfg.PrivateCluster.AdditionalEndpointServices = []string{"cloudformation", "sts"}
+			Expect(cfg.EndpointServices()).To(Equal([]string{"s3", "ec2", "ecr.api", "ecr.dkr", "sts", "logs", "autoscaling", "cloudformation"}))
+			Expect(cfg.EndpointServiceName("ecr.api")).To(Equal("com.amazonaws.eu-west-1.ecr.api"))
+		})
+
+		It("should reject invalid configuration", func() {
+			cfg.PrivateCluster.AdditionalEndpointServices = []string{""}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("privateCluster.additionalEndpointServices[0] must be set"))
+
+			cfg.PrivateCluster.AdditionalEndpointServices = nil
+			cfg.Metadata.Version = "1.21"
+			cfg.KubernetesNetworkConfig = &KubernetesNetworkConfig{IPFamily: IPV6Family}
+			SetClusterConfigDefaults(cfg)
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("privateCluster is not supported with IPv6"))
+		})
+	})
+
+	Describe("secretsEncryption", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Name = "test"
+		})
+
+		It("should accept a key ARN or key creation", func() {
+			cfg.SecretsEncryption = &SecretsEncryption{KeyARN: "arn:aws:kms:us-west-2:123456789012:key/11111111-2222-3333-4444-555555555555"}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.HasSecretsEncryption()).To(BeTrue())
+			Expect(cfg.CreatesSecretsEncryptionKey()).To(BeFalse())
+
+			cfg.SecretsEncryption = &SecretsEncryption{CreateKey: Enabled()}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.CreatesSecretsEncryptionKey()).To(BeTrue())
+			Expect(cfg.SecretsEncryptionKeyAlias()).To(Equal("alias/eksctl/test"))
+		})
+
+		It("should reject invalid configuration", func() {
+			cfg.SecretsEncryption = &SecretsEncryption{}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("either secretsEncryption.keyARN or secretsEncryption.createKey must be set"))
+
+			cfg.SecretsEncryption = &SecretsEncryption{KeyARN: "arn:aws:iam::123456789012:role/foo"}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`secretsEncryption.keyARN must be the ARN of a KMS key, got "arn:aws:iam::123456789012:role/foo"`))
+
+			cfg.SecretsEncryption = &SecretsEncryption{
+				KeyARN:    "arn:aws:kms:us-west-2:123456789012:alias/foo",
+				CreateKey: Enabled(),
+			}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("secretsEncryption.keyARN and secretsEncryption.createKey cannot be used together"))
+		})
+	})
+
+	Describe("outpost", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Outpost = &Outpost{
+				ControlPlaneOutpostARN:   "arn:aws:outposts:us-west-2:123456789012:outpost/op-0123456789abcdef0",
+				ControlPlaneInstanceType: "m5d.large",
+			}
+			cfg.VPC.ID = "vpc-1"
+			cfg.VPC.Subnets = &ClusterSubnets{Private: map[string]Network{"us-west-2a": {ID: "subnet-1"}}}
+		})
+
+		It("should accept a local cluster in an existing VPC", func() {
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.IsLocalCluster()).To(BeTrue())
+		})
+
+		It("should reject invalid configuration", func() {
+			cfg.Outpost.ControlPlaneOutpostARN = "op-0123456789abcdef0"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`outpost.controlPlaneOutpostARN must be the ARN of an Outpost, got "op-0123456789abcdef0"`))
+
+			cfg.Outpost.ControlPlaneOutpostARN = "arn:aws:outposts:us-west-2:123456789012:outpost/op-0123456789abcdef0"
+			cfg.Outpost.ControlPlaneInstanceType = "t3.large"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`outpost.controlPlaneInstanceType "t3.large" is not available on Outposts, must be one of the instance families [c5 c5d m5 m5d r5 r5d g4dn i3en]`))
+
+			cfg.Outpost.ControlPlaneInstanceType = "m5d.large"
+			cfg.VPC.Subnets = nil
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("vpc.id and vpc.subnets of the Outpost must be set for a local cluster on an Outpost"))
+		})
+
+		It("should not allow managed nodegroups", func() {
+			cfg.Metadata.Version = Version1_14
+			ng := NewManagedNodeGroup()
+			ng.Name = "mng-1"
+			cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, ng)
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("managed nodegroups are not supported by local clusters on Outposts"))
+		})
+	})
+
+	Describe("karpenter", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Version = "1.21"
+			cfg.Karpenter = &Karpenter{}
+		})
+
+		It("should enable IAM OIDC provider and default to the tested version", func() {
+			SetClusterConfigDefaults(cfg)
+			Expect(IsEnabled(cfg.IAM.WithOIDC)).To(BeTrue())
+			Expect(cfg.Karpenter.Version).To(Equal(DefaultKarpenterVersion))
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should require IAM OIDC provider", func() {
+			cfg.IAM.WithOIDC = Disabled()
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("iam.withOIDC must be enabled for karpenter"))
+		})
+
+		It("should reject Kubernetes versions that Karpenter doesn't run on", func() {
+			cfg.Metadata.Version = Version1_14
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("karpenter is only supported by Kubernetes 1.19 or later, got 1.14"))
+		})
+
+		It("should reject versions whose chart eksctl doesn't know how to configure", func() {
+			cfg.Karpenter.Version = "v0.6.0"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`karpenter.version "v0.6.0" is not supported, must be one of [v0.19.3 v0.20.0]`))
+		})
+	})
+
+	Describe("gitops", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Name = "cluster-1"
+			cfg.GitOps = &GitOps{
+				Flux: &Flux{
+					GitProvider: FluxGitProviderGitHub,
+					Owner:       "org",
+					Repository:  "fleet",
+					AuthorEmail: "flux@example.com",
+				},
+			}
+		})
+
+		It("should default to the main branch and a path of the cluster", func() {
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.GitOps.Flux.Branch).To(Equal("main"))
+			Expect(cfg.GitOps.Flux.Path).To(Equal("clusters/cluster-1"))
+			Expect(cfg.GitOps.Flux.AuthorName).To(Equal("Flux"))
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(cfg.GitOps.Flux.RepositoryURL()).To(Equal("https://github.com/org/fleet.git"))
+		})
+
+		It("should reject unknown Git providers and missing repositories", func() {
+			cfg.GitOps.Flux.GitProvider = "bitbucket"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`gitops.flux.gitProvider must be one of [github gitlab], got "bitbucket"`))
+
+			cfg.GitOps.Flux.GitProvider = FluxGitProviderGitLab
+			cfg.GitOps.Flux.Repository = ""
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("gitops.flux.repository must be set"))
+		})
+
+		It("should not allow Flux to sync the config file of the cluster", func() {
+			for _, path := range []string{".", "./", "eksctl"} {
+				cfg.GitOps.Flux.Path = path
+				Expect(ValidateClusterConfig(cfg)).To(MatchError(`gitops.flux.path cannot be the root of the repository or "eksctl"`))
+			}
+		})
+	})
+
+	Describe("postCreate Helm releases", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.PostCreate = &PostCreate{
+				HelmReleases: []*HelmRelease{
+					{Repo: "https://kubernetes-sigs.github.io/metrics-server", Chart: "metrics-server"},
+					{Name: "ingress", Namespace: "ingress-nginx", Repo: "https://kubernetes.github.io/ingress-nginx", Chart: "ingress-nginx"},
+				},
+			}
+		})
+
+		It("should name releases after their charts", func() {
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.PostCreate.HelmReleases[0].Name).To(Equal("metrics-server"))
+			Expect(cfg.PostCreate.HelmReleases[0].Namespace).To(Equal("default"))
+			Expect(cfg.PostCreate.HelmReleases[1].Name).To(Equal("ingress"))
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should require a chart from a repository", func() {
+			cfg.PostCreate.HelmReleases[1].Repo = "ingress-nginx"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`postCreate.helmReleases[1].repo must be the URL of a chart repository or an OCI registry, got "ingress-nginx"`))
+
+			cfg.PostCreate.HelmReleases[1].Repo = "oci://registry.example.com/charts"
+			cfg.PostCreate.HelmReleases[1].Chart = ""
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("postCreate.helmReleases[1].chart must be set"))
+		})
+
+		It("should not allow releases with the same name in a namespace", func() {
+			cfg.PostCreate.HelmReleases[1].Name = "metrics-server"
+			cfg.PostCreate.HelmReleases[1].Namespace = ""
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`postCreate.helmReleases[1].name "metrics-server" must be unique in its namespace`))
+		})
+	})
+
+	Describe("kubernetesNetworkConfig", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Version = "1.21"
+			cfg.KubernetesNetworkConfig = &KubernetesNetworkConfig{IPFamily: IPV6Family}
+		})
+
+		It("should set up VPC and VPC CNI for IPv6 by default", func() {
+			SetClusterConfigDefaults(cfg)
+			Expect(IsEnabled(cfg.VPC.AutoAllocateIPv6)).To(BeTrue())
+			Expect(IsEnabled(cfg.IAM.WithOIDC)).To(BeTrue())
+			Expect(cfg.Addons).To(HaveLen(1))
+			Expect(cfg.Addons[0].Name).To(Equal(VPCCNIAddon))
+			Expect(cfg.Addons[0].AttachPolicy).ToNot(BeNil())
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should keep the IAM configuration of VPC CNI", func() {
+			cfg.Addons = []*Addon{{Name: VPCCNIAddon, Version: "v1.10.1-eksbuild.1", ServiceAccountRoleARN: "arn:aws:iam::123:role/cni"}}
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.Addons).To(HaveLen(1))
+			Expect(cfg.Addons[0].AttachPolicy).To(BeNil())
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should reject invalid IPv6 configuration", func() {
+			SetClusterConfigDefaults(cfg)
+
+			cfg.KubernetesNetworkConfig.IPFamily = "ipv5"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`kubernetesNetworkConfig.ipFamily must be either "IPv4" or "IPv6", got "ipv5"`))
+			cfg.KubernetesNetworkConfig.IPFamily = IPV6Family
+
+			cfg.Metadata.Version = Version1_14
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`IPv6 is only supported by Kubernetes 1.21 or later, got 1.14`))
+			cfg.Metadata.Version = "1.21"
+
+			cfg.Addons[0].Version = "v1.9.3-eksbuild.1"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`addons[0].version must be 1.10.0 or later for IPv6, got v1.9.3-eksbuild.1`))
+			cfg.Addons[0].Version = ""
+
+			cfg.NodeGroups = []*NodeGroup{{Name: "ng-1"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`nodeGroups are not supported with IPv6, use managedNodeGroups or fargateProfiles instead`))
+			cfg.NodeGroups = nil
+
+			cfg.IAM.WithOIDC = Disabled()
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`iam.withOIDC must be enabled for addons[0].attachPolicy`))
+
+			cfg.Addons[0].AttachPolicy = nil
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`iam.withOIDC must be enabled for IPv6`))
+		})
+	})
+
+	Describe("vpc.subnetDiscovery", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.VPC.SubnetDiscovery = &ClusterSubnetDiscovery{}
+		})
+
+		It("should discover subnets by role tags by default", func() {
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.VPC.SubnetDiscovery.Private.Tags).To(Equal(map[string]string{"kubernetes.io/role/internal-elb": "1"}))
+			Expect(cfg.VPC.SubnetDiscovery.Public.Tags).To(Equal(map[string]string{"kubernetes.io/role/elb": "1"}))
+
+			cfg.VPC.SubnetDiscovery = &ClusterSubnetDiscovery{Private: &SubnetSelector{Tags: map[string]string{"tier": "private"}}}
+			SetClusterConfigDefaults(cfg)
+			Expect(cfg.VPC.SubnetDiscovery.Private.Tags).To(Equal(map[string]string{"tier": "private"}))
+			Expect(cfg.VPC.SubnetDiscovery.Public).To(BeNil())
+		})
+
+		It("should require an existing VPC without subnets", func() {
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.id must be set for vpc.subnetDiscovery`))
+
+			cfg.VPC.ID = "vpc-1"
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			Expect(cfg.ImportSubnet(SubnetTopologyPrivate, "us-west-2a", "subnet-1", "10.0.1.0/24")).To(Succeed())
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.subnetDiscovery and vpc.subnets cannot be used together`))
+		})
+	})
+
+	Describe("vpc.podSubnets", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.VPC.PodSubnets = &ClusterPodSubnets{}
+		})
+
+		It("should accept a secondary CIDR with a dedicated VPC", func() {
+			cfg.VPC.PodSubnets.CIDR, _ = ipnet.ParseCIDR("100.64.0.0/16")
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			subnetCIDR, _ := ipnet.ParseCIDR("100.64.32.0/19")
+			cfg.VPC.PodSubnets.Subnets = map[string]Network{"us-west-2a": {CIDR: subnetCIDR}}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should reject invalid pod subnets with a dedicated VPC", func() {
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.podSubnets.cidr must be set`))
+
+			cfg.VPC.PodSubnets.CIDR, _ = ipnet.ParseCIDR("192.168.128.0/17")
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.podSubnets.cidr (192.168.128.0/17) must not overlap with vpc.cidr (192.168.0.0/16)`))
+
+			cfg.VPC.PodSubnets.CIDR, _ = ipnet.ParseCIDR("100.64.0.0/16")
+			subnetCIDR, _ := ipnet.ParseCIDR("100.65.0.0/19")
+			cfg.VPC.PodSubnets.Subnets = map[string]Network{"us-west-2a": {CIDR: subnetCIDR}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.podSubnets.subnets["us-west-2a"].cidr must be within vpc.podSubnets.cidr (100.64.0.0/16)`))
+
+			cfg.VPC.PodSubnets.Subnets = map[string]Network{"us-west-2a": {ID: "subnet-1"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.podSubnets.subnets["us-west-2a"].id cannot be set when eksctl creates the VPC`))
+
+			cfg.VPC.PodSubnets.Subnets = nil
+			cfg.KubernetesNetworkConfig = &KubernetesNetworkConfig{IPFamily: IPV6Family}
+			cfg.Metadata.Version = ""
+			cfg.Addons = []*Addon{{Name: VPCCNIAddon}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.podSubnets cannot be used with IPv6`))
+		})
+
+		It("should require subnet IDs with an existing VPC", func() {
+			cfg.VPC.ID = "vpc-1"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.podSubnets.subnets must be set when using an existing VPC`))
+
+			cfg.VPC.PodSubnets.Subnets = map[string]Network{"us-west-2a": {}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`vpc.podSubnets.subnets["us-west-2a"].id must be set when using an existing VPC`))
+
+			cfg.VPC.PodSubnets.Subnets = map[string]Network{"us-west-2a": {ID: "subnet-1"}}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+	})
+
+	Describe("ssh flags", func() {
+		var (
+			testKeyPath = "some/path/to/file.pub"
+			testKeyName = "id_rsa.pub"
+			testKey     = "THIS IS A KEY"
+		)
+
+		It("fails when a key path and a key name are specified", func() {
+			SSHConfig := &NodeGroupSSH{
+				Allow:         Enabled(),
+				PublicKeyPath: &testKeyPath,
+				PublicKeyName: &testKeyName,
+			}
+
+			checkItDetectsError(SSHConfig)
+		})
+
+		It("fails when a key path and a key are specified", func() {
+			SSHConfig := &NodeGroupSSH{
+				Allow:         Enabled(),
+				PublicKeyPath: &testKeyPath,
+				PublicKey:     &testKey,
+			}
+
+			checkItDetectsError(SSHConfig)
+		})
+
+		It("fails when a key name and a key are specified", func() {
+			SSHConfig := &NodeGroupSSH{
+				Allow:         Enabled(),
+				PublicKeyName: &testKeyName,
+				PublicKey:     &testKey,
+			}
+
+			checkItDetectsError(SSHConfig)
+		})
+
+		It("It doesn't panic when SSH is not enabled", func() {
+			err := validateNodeGroupSSH(nil)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		Context("Instances distribution", func() {
+
+			var ng *NodeGroup
+			BeforeEach(func() {
+				ng = &NodeGroup{
+					InstancesDistribution: &NodeGroupInstancesDistribution{
+						InstanceTypes: []string{"t3.medium", "t3.large"},
+					},
+				}
+			})
+
+			It("It doesn't panic when instance distribution is not enabled", func() {
+				ng.InstancesDistribution = nil
+				err := validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when instance distribution is enabled and instanceType is not empty or \"mixed\"", func() {
+				err := validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+
+				ng.InstanceType = "t3.small"
+
+				err = validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("It fails when the instance distribution doesn't have at least 2 different instance types", func() {
+				ng.InstanceType = "mixed"
+				ng.InstancesDistribution.InstanceTypes = []string{"t3.medium", "t3.medium"}
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstanceType = "mixed"
+				ng.InstancesDistribution.InstanceTypes = []string{"t3.medium", "t3.small"}
+
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when the onDemandBaseCapacity is not above 0", func() {
+				ng.InstancesDistribution.OnDemandBaseCapacity = newInt(-1)
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.OnDemandBaseCapacity = newInt(1)
+
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when the spotInstancePools is not between 1 and 20", func() {
+				ng.InstancesDistribution.SpotInstancePools = newInt(0)
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.SpotInstancePools = newInt(21)
+				err = validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.SpotInstancePools = newInt(2)
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("It fails when the onDemandPercentageAboveBaseCapacity is not between 0 and 100", func() {
+				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(-1)
+
+				err := validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(101)
+				err = validateInstancesDistribution(ng)
+				Expect(err).To(HaveOccurred())
+
+				ng.InstancesDistribution.OnDemandPercentageAboveBaseCapacity = newInt(50)
+				err = validateInstancesDistribution(ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("kubelet extra config", func() {
+		Context("Instances distribution", func() {
+
+			var ng *NodeGroup
+			BeforeEach(func() {
+				ng = &NodeGroup{}
+			})
+
+			It("Forbids overriding basic fields", func() {
+				testKeys := []string{"kind", "apiVersion", "address", "clusterDomain", "authentication",
+					"authorization", "serverTLSBootstrap"}
+
+				for _, key := range testKeys {
+					ng.KubeletExtraConfig = &InlineDocument{
+						key: "should-not-be-allowed",
+					}
+					err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("Allows other kubelet options", func() {
+				ng.KubeletExtraConfig = &InlineDocument{
+					"kubeReserved": map[string]string{
+						"cpu":               "300m",
+						"memory":            "300Mi",
+						"ephemeral-storage": "1Gi",
+					},
+					"kubeReservedCgroup": "/kube-reserved",
+					"cgroupDriver":       "systemd",
+					"featureGates": map[string]bool{
+						"VolumeScheduling":         false,
+						"VolumeSnapshotDataSource": true,
+					},
+				}
+				err := validateNodeGroupKubeletExtraConfig(ng.KubeletExtraConfig)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+		})
+	})
+
+	Describe("ebs encryption", func() {
+		var (
+			nodegroup = "ng1"
+			volSize   = 50
+			kmsKeyID  = "36c0b54e-64ed-4f2d-a1c7-96558764311e"
+			disabled  = false
+			enabled   = true
+		)
+
+		Context("Encrypted workers", func() {
+
+			var ng *NodeGroup
+			BeforeEach(func() {
+				ng = &NodeGroup{}
+			})
+
+			It("Forbids setting volumeKmsKeyID without volumeEncrypted", func() {
+				ng.Name = nodegroup
+				ng.VolumeSize = &volSize
+				ng.VolumeEncrypted = nil
+				ng.VolumeKmsKeyID = &kmsKeyID
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("Forbids setting volumeKmsKeyID with volumeEncrypted: false", func() {
+				ng.Name = nodegroup
+				ng.VolumeSize = &volSize
+				ng.VolumeEncrypted = &disabled
+				ng.VolumeKmsKeyID = &kmsKeyID
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("Allows setting volumeKmsKeyID with volumeEncrypted: true", func() {
+				ng.Name = nodegroup
+				ng.VolumeSize = &volSize
+				ng.VolumeEncrypted = &enabled
+				ng.VolumeKmsKeyID = &kmsKeyID
+				err := ValidateNodeGroup(0, ng)
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+		})
+	})
+
+	Describe("Windows nodegroups", func() {
+		var (
+			cfg *ClusterConfig
+			ng  *NodeGroup
+		)
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			linuxNG := cfg.NewNodeGroup()
+			linuxNG.Name = "linux"
+			ng = cfg.NewNodeGroup()
+			ng.Name = "windows"
+			ng.AMIFamily = NodeImageFamilyWindowsServer2019FullContainer
+		})
+
+		It("should pass with Kubernetes 1.14", func() {
+			cfg.Metadata.Version = Version1_14
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(ValidateNodeGroup(1, ng)).To(Succeed())
+		})
+
+		It("should fail with Kubernetes versions older than 1.14", func() {
+			cfg.Metadata.Version = Version1_13
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(ContainSubstring("only supported by Kubernetes 1.14 or later")))
+		})
+
+		It("should fail without a Linux nodegroup", func() {
+			cfg.Metadata.Version = Version1_14
+			cfg.NodeGroups = cfg.NodeGroups[1:]
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("nodegroups with Windows AMI family require at least one Linux nodegroup, to run the VPC resource controller and admission webhook"))
+		})
+
+		It("should pass with a managed nodegroup", func() {
+			cfg.Metadata.Version = Version1_14
+			cfg.NodeGroups = cfg.NodeGroups[1:]
+			cfg.ManagedNodeGroups = []*ManagedNodeGroup{{Name: "linux"}}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should not allow kubeletExtraConfig", func() {
+			ng.KubeletExtraConfig = &InlineDocument{"maxPods": 20}
+			Expect(ValidateNodeGroup(1, ng)).To(MatchError("nodeGroups[1].kubeletExtraConfig is not supported for WindowsServer2019FullContainer nodegroups"))
+		})
+	})
+
+	Describe("nodegroup launch template", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
+
+		It("should pass with an id and version", func() {
+			version := "3"
+			ng.LaunchTemplate = &NodeGroupLaunchTemplate{ID: "lt-0123456789abcdef0", Version: &version}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should require an id", func() {
+			ng.LaunchTemplate = &NodeGroupLaunchTemplate{}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].launchTemplate.id must be set"))
+		})
+
+		It("should not allow Windows nodegroups", func() {
+			ng.AMIFamily = NodeImageFamilyWindowsServer2019CoreContainer
+			ng.LaunchTemplate = &NodeGroupLaunchTemplate{ID: "lt-0123456789abcdef0"}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].launchTemplate is not supported for WindowsServer2019CoreContainer nodegroups"))
+		})
+	})
+
+	Describe("nodegroup bootstrap script template", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
+
+		It("should pass with a valid template", func() {
+			ng.BootstrapScriptTemplate = aws.String("#!/bin/bash\n/etc/eks/bootstrap.sh {{.ClusterName}} --dns-cluster-ip {{.ClusterDNS}}\n")
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should not allow overrideBootstrapCommand", func() {
+			ng.BootstrapScriptTemplate = aws.String("/etc/eks/bootstrap.sh {{.ClusterName}}")
+			ng.OverrideBootstrapCommand = aws.String("/etc/eks/bootstrap.sh golden")
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].bootstrapScriptTemplate and nodeGroups[0].overrideBootstrapCommand cannot be used together"))
+		})
+
+		It("should reject invalid templates", func() {
+			ng.BootstrapScriptTemplate = aws.String("/etc/eks/bootstrap.sh {{.ClusterName")
+			err := ValidateNodeGroup(0, ng)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(HavePrefix("nodeGroups[0].bootstrapScriptTemplate is invalid: "))
+		})
+	})
+
+	Describe("nodegroup volumes", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
+
+		newVolume := func(volumeType string) *VolumeMapping {
+			volumeSize := 100
+			return &VolumeMapping{VolumeName: "/dev/xvdb", VolumeSize: &volumeSize, VolumeType: &volumeType}
+		}
+
+		It("should pass with gp3 volumes and an instance store", func() {
+			iops, throughput := 4000, 250
+			v := newVolume(NodeVolumeTypeGP3)
+			v.VolumeIOPS, v.VolumeThroughput, v.MountPath = &iops, &throughput, "/data"
+			ng.AdditionalVolumes = []*VolumeMapping{v}
+			ng.InstanceStore = &NodeGroupInstanceStore{MountPath: "/mnt/scratch"}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should require a size", func() {
+			ng.AdditionalVolumes = []*VolumeMapping{{VolumeName: "/dev/xvdb"}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].additionalVolumes[0].volumeSize must be set to a positive number"))
+		})
+
+		It("should not allow the same device name twice", func() {
+			ng.AdditionalVolumes = []*VolumeMapping{newVolume(NodeVolumeTypeGP2), newVolume(NodeVolumeTypeST1)}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].additionalVolumes[1].volumeName "/dev/xvdb" is used by more than one volume`))
+		})
+
+		It("should allow throughput only for gp3 volumes", func() {
+			throughput := 250
+			v := newVolume(NodeVolumeTypeGP2)
+			v.VolumeThroughput = &throughput
+			ng.AdditionalVolumes = []*VolumeMapping{v}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].additionalVolumes[0].volumeThroughput is only supported for gp3 volume type"))
+		})
+
+		It("should not allow the same mount path twice", func() {
+			v := newVolume(NodeVolumeTypeGP2)
+			v.MountPath = "/data"
+			ng.AdditionalVolumes = []*VolumeMapping{v}
+			ng.InstanceStore = &NodeGroupInstanceStore{MountPath: "/data"}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].instanceStore.mountPath "/data" is used by more than one volume`))
+		})
+
+		It("should require an absolute mount path for the instance store", func() {
+			ng.InstanceStore = &NodeGroupInstanceStore{}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].instanceStore.mountPath must be an absolute path other than /"))
+		})
+	})
+
+	Describe("nodegroup placement", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
+
+		It("should pass with an existing placement group and dedicated tenancy", func() {
+			ng.Placement = &NodeGroupPlacement{GroupName: "hpc"}
+			ng.Tenancy = TenancyDedicated
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should not allow a group name and a strategy at the same time", func() {
+			ng.Placement = &NodeGroupPlacement{GroupName: "hpc", Strategy: PlacementStrategySpread}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].placement.groupName and nodeGroups[0].placement.strategy cannot be set at the same time"))
+		})
+
+		It("should require a single availability zone for cluster placement groups", func() {
+			ng.Placement = &NodeGroupPlacement{Strategy: PlacementStrategyCluster}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].availabilityZones must have a single availability zone for placement strategy "cluster"`))
+
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should require a single availability zone for EFA", func() {
+			ng.EFAEnabled = Enabled()
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].availabilityZones must have a single availability zone when nodeGroups[0].efaEnabled is set"))
+
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should not allow unknown tenancies", func() {
+			ng.Tenancy = "shared"
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].tenancy "shared" is not supported, must be one of [default dedicated host]`))
+		})
+	})
+
+	Describe("nodegroup edge locations", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
+
+		It("should pass with Local Zone and Outpost subnets", func() {
+			ng.Edge = &NodeGroupEdge{Type: EdgeTypeLocalZone, Subnets: []string{"subnet-1"}}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+
+			ng.InstanceType = "c5d.2xlarge"
+			ng.Edge = &NodeGroupEdge{
+				Type:       EdgeTypeOutpost,
+				Subnets:    []string{"subnet-1"},
+				OutpostARN: "arn:aws:outposts:us-west-2:123456789012:outpost/op-0123456789abcdef0",
+			}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should require subnets and not allow availability zones", func() {
+			ng.Edge = &NodeGroupEdge{Type: EdgeTypeLocalZone}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].edge.subnets must be set"))
+
+			ng.Edge.Subnets = []string{"subnet-1"}
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].availabilityZones and nodeGroups[0].edge cannot be used together"))
+		})
+
+		It("should require the ARN of the Outpost only for type outpost", func() {
+			ng.InstanceType = "m5.large"
+			ng.Edge = &NodeGroupEdge{Type: EdgeTypeOutpost, Subnets: []string{"subnet-1"}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].edge.outpostARN must be the ARN of an Outpost, got ""`))
+
+			ng.Edge = &NodeGroupEdge{
+				Type:       EdgeTypeWavelengthZone,
+				Subnets:    []string{"subnet-1"},
+				OutpostARN: "arn:aws:outposts:us-west-2:123456789012:outpost/op-0123456789abcdef0",
+			}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].edge.outpostARN can only be set for edge type "outpost"`))
+		})
+
+		It("should only allow instance types available in Wavelength Zones", func() {
+			ng.InstanceType = "m5.large"
+			ng.Edge = &NodeGroupEdge{Type: EdgeTypeWavelengthZone, Subnets: []string{"subnet-1"}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0] instance type "m5.large" is not available in edge locations of type "wavelength-zone"`))
+
+			ng.InstanceType = "t3.xlarge"
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should not allow unknown types", func() {
+			ng.Edge = &NodeGroupEdge{Type: "region", Subnets: []string{"subnet-1"}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].edge.type "region" is not supported, must be one of [local-zone wavelength-zone outpost]`))
+		})
+	})
+
+	Describe("nodegroup capacity reservation", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
+
+		It("should pass with a capacity block", func() {
+			ng.CapacityReservation = &NodeGroupCapacityReservation{ID: "cr-1234567890abcdef0", CapacityBlock: Enabled()}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should not allow an ID and a preference at the same time", func() {
+			ng.CapacityReservation = &NodeGroupCapacityReservation{ID: "cr-1234567890abcdef0", Preference: CapacityReservationPreferenceOpen}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].capacityReservation.id and nodeGroups[0].capacityReservation.preference cannot be set at the same time"))
+		})
+
+		It("should not allow unknown preferences", func() {
+			ng.CapacityReservation = &NodeGroupCapacityReservation{Preference: "targeted"}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].capacityReservation.preference "targeted" is not supported, must be one of [open none]`))
+		})
+
+		It("should require an ID for capacity blocks", func() {
+			ng.CapacityReservation = &NodeGroupCapacityReservation{CapacityBlock: Enabled()}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("either nodeGroups[0].capacityReservation.id or nodeGroups[0].capacityReservation.preference must be set"))
+
+			ng.CapacityReservation.Preference = CapacityReservationPreferenceOpen
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].capacityReservation.id must be set when nodeGroups[0].capacityReservation.capacityBlock is enabled"))
+		})
+	})
+
+	Describe("nodegroup metadata options", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+			ng.Name = "ng-1"
+		})
+
+		It("should pass with required tokens and a hop limit", func() {
+			tokens, hopLimit := IMDSHTTPTokensRequired, 2
+			ng.MetadataOptions = &NodeGroupMetadataOptions{HTTPTokens: &tokens, HTTPPutResponseHopLimit: &hopLimit}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should not allow unknown httpTokens", func() {
+			tokens := "v2"
+			ng.MetadataOptions = &NodeGroupMetadataOptions{HTTPTokens: &tokens}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].metadataOptions.httpTokens must be either "optional" or "required"`))
+		})
+
+		It("should not allow a hop limit out of range", func() {
+			hopLimit := 65
+			ng.MetadataOptions = &NodeGroupMetadataOptions{HTTPPutResponseHopLimit: &hopLimit}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].metadataOptions.httpPutResponseHopLimit must be between 1 and 64"))
+		})
+
+		It("should enforce IMDSv2 keeping the hop limit", func() {
+			hopLimit := 2
+			ng.MetadataOptions = &NodeGroupMetadataOptions{HTTPPutResponseHopLimit: &hopLimit}
+			Expect(EnforceIMDSv2(ng)).To(Succeed())
+			Expect(*ng.MetadataOptions.HTTPTokens).To(Equal(IMDSHTTPTokensRequired))
+			Expect(*ng.MetadataOptions.HTTPPutResponseHopLimit).To(Equal(2))
+		})
+
+		It("should not enforce IMDSv2 when IMDSv1 is explicitly allowed", func() {
+			tokens := IMDSHTTPTokensOptional
+			ng.MetadataOptions = &NodeGroupMetadataOptions{HTTPTokens: &tokens}
+			Expect(EnforceIMDSv2(ng)).To(MatchError(`IMDSv2 cannot be enforced, as nodegroup "ng-1" sets metadataOptions.httpTokens to "optional"`))
+		})
+	})
+
+	Describe("nodegroup taints", func() {
+		var ng *NodeGroup
+
+		BeforeEach(func() {
+			ng = NewClusterConfig().NewNodeGroup()
+		})
+
+		It("should allow taints with and without values", func() {
+			ng.Taints = map[string]string{
+				"dedicated":            "ci:NoSchedule",
+				"example.com/draining": "PreferNoSchedule",
+			}
+			Expect(ValidateNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should reject invalid keys", func() {
+			ng.Taints = map[string]string{"not a key": "NoSchedule"}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring(`nodeGroups[0].taints has invalid key "not a key"`)))
+		})
+
+		It("should reject invalid values", func() {
+			ng.Taints = map[string]string{"dedicated": "c/i:NoSchedule"}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(ContainSubstring(`nodeGroups[0].taints["dedicated"] is invalid - taint value "c/i" is invalid`)))
+		})
+
+		It("should reject missing or unknown effects", func() {
+			ng.Taints = map[string]string{"dedicated": "ci"}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError(`nodeGroups[0].taints["dedicated"] is invalid - taint effect must be one of NoSchedule, PreferNoSchedule or NoExecute, got "ci"`))
+		})
+	})
+
+	Describe("Managed nodegroups", func() {
+		var (
+			cfg *ClusterConfig
+			ng  *ManagedNodeGroup
+		)
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Version = Version1_14
+			ng = NewManagedNodeGroup()
+			ng.Name = "managed-ng"
+			cfg.ManagedNodeGroups = []*ManagedNodeGroup{ng}
+		})
+
+		It("should pass with defaults", func() {
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+			Expect(ValidateManagedNodeGroup(0, ng)).To(Succeed())
+		})
+
+		It("should require a name", func() {
+			ng.Name = ""
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("managedNodeGroups[0].name must be set"))
+		})
+
+		It("should not allow names of other nodegroups", func() {
+			cfg.NewNodeGroup().Name = "managed-ng"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`managedNodeGroups[0].name "managed-ng" is not unique`))
+		})
+
+		It("should not allow versions before 1.14", func() {
+			cfg.Metadata.Version = Version1_13
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("managed nodegroups are only supported by Kubernetes 1.14 or later, got 1.13"))
+		})
+
+		It("should reject unknown AMI types", func() {
+			ng.AMIType = "Ubuntu1804"
+			Expect(ValidateManagedNodeGroup(0, ng)).To(MatchError(`managedNodeGroups[0].amiType "Ubuntu1804" is not supported, must be one of [AL2_x86_64 AL2_x86_64_GPU AL2_ARM_64]`))
+		})
+
+		It("should check sizes", func() {
+			ng.MinSize, ng.MaxSize = newInt(3), newInt(2)
+			Expect(ValidateManagedNodeGroup(0, ng)).To(MatchError("managedNodeGroups[0].minSize cannot be greater than managedNodeGroups[0].maxSize"))
+
+			ng.MinSize, ng.MaxSize, ng.DesiredCapacity = newInt(1), newInt(2), newInt(3)
+			Expect(ValidateManagedNodeGroup(0, ng)).To(MatchError("managedNodeGroups[0].desiredCapacity cannot be greater than managedNodeGroups[0].maxSize"))
+
+			ng.DesiredCapacity = newInt(0)
+			Expect(ValidateManagedNodeGroup(0, ng)).To(MatchError("managedNodeGroups[0].desiredCapacity cannot be less than managedNodeGroups[0].minSize"))
+		})
+
+		It("should check taints", func() {
+			ng.Taints = map[string]string{
+				"dedicated":     "gpu:NoSchedule",
+				"without-value": "NoExecute",
+			}
+			Expect(ValidateManagedNodeGroup(0, ng)).To(Succeed())
+
+			ng.Taints["dedicated"] = "gpu:Sometimes"
+			Expect(ValidateManagedNodeGroup(0, ng)).To(MatchError(`managedNodeGroups[0].taints["dedicated"] is invalid - taint effect must be one of NoSchedule, PreferNoSchedule or NoExecute, got "Sometimes"`))
+		})
+
+		It("should check labels", func() {
+			ng.Labels = map[string]string{"kubernetes.io/role": "worker"}
+			Expect(ValidateManagedNodeGroup(0, ng)).To(HaveOccurred())
+		})
+	})
+
+	Describe("notifications", func() {
+		It("should accept HTTP(S) webhook URLs only", func() {
+			cfg := NewClusterConfig()
+			cfg.Notifications = &Notifications{WebhookURL: "https://hooks.slack.com/services/T00/B00/XXX"}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			cfg.Notifications.WebhookURL = "hooks.slack.com/services/T00/B00/XXX"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("notifications.webhookURL must be an http or https URL"))
+		})
+	})
+
+	Describe("extra CloudFormation resources", func() {
+		It("should accept resources with a type", func() {
+			cfg := NewClusterConfig()
+			cfg.CloudFormation = &CloudFormation{
+				ExtraResources: map[string]InlineDocument{
+					"ClusterNameParameter": {
+						"Type":       "AWS::SSM::Parameter",
+						"Properties": map[string]interface{}{"Type": "String", "Value": "cluster-1"},
+					},
+				},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should not allow invalid logical IDs", func() {
+			cfg := NewClusterConfig()
+			cfg.CloudFormation = &CloudFormation{
+				ExtraResources: map[string]InlineDocument{
+					"cluster-name": {"Type": "AWS::SSM::Parameter"},
+				},
+			}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("cloudFormation.extraResources.cluster-name is invalid, logical IDs must be alphanumeric"))
+		})
+
+		It("should not allow resources without a type or with unknown attributes", func() {
+			ng := NewClusterConfig().NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.CloudFormation = &CloudFormation{
+				ExtraResources: map[string]InlineDocument{
+					"Rule": {"Properties": map[string]interface{}{}},
+				},
+			}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].cloudFormation.extraResources.Rule.Type must be set"))
+
+			ng.CloudFormation.ExtraResources["Rule"] = InlineDocument{"Type": "AWS::EC2::SecurityGroupIngress", "Propertes": map[string]interface{}{}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].cloudFormation.extraResources.Rule.Propertes is not a valid resource attribute"))
+		})
+	})
+
+	Describe("stack tags and stack policy", func() {
+		It("should not allow reserved tag keys or a policy without statements", func() {
+			cfg := NewClusterConfig()
+			cfg.CloudFormation = &CloudFormation{StackTags: map[string]string{"aws:cloudformation:stack-name": "x"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("cloudFormation.stackTags.aws:cloudformation:stack-name is invalid, the aws: prefix is reserved"))
+
+			cfg.CloudFormation = &CloudFormation{StackPolicy: InlineDocument{"Effect": "Deny"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("cloudFormation.stackPolicy must have a Statement"))
+		})
+
+		It("should only allow them for the cluster", func() {
+			ng := NewClusterConfig().NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.CloudFormation = &CloudFormation{StackTags: map[string]string{"cost-center": "platform"}}
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].cloudFormation.stackTags and nodeGroups[0].cloudFormation.stackPolicy cannot be set, set cloudFormation.stackTags and cloudFormation.stackPolicy of the cluster instead"))
+		})
+	})
+
+	Describe("AWS endpoints", func() {
+		It("should only allow URLs of supported services", func() {
+			cfg := NewClusterConfig()
+			cfg.AWSEndpoints = &AWSEndpoints{Services: map[string]string{"eks": "https://eks.vpce.example.com", "sts": "http://localhost:4566"}}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			cfg.AWSEndpoints.Services["eks"] = "vpce.example.com"
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("awsEndpoints.services.eks must be an http or https URL"))
+
+			cfg.AWSEndpoints.Services = map[string]string{"lambda": "https://lambda.vpce.example.com"}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(HavePrefix("awsEndpoints.services.lambda is not supported, the endpoints of autoscaling, cloudformation")))
+		})
+	})
+
+	Describe("FIPS endpoints", func() {
+		It("should only allow regions with FIPS endpoints", func() {
+			cfg := NewClusterConfig()
+			cfg.Metadata.Region = RegionUSEast1
+			cfg.AWSConfig = &AWSConfig{UseFIPSEndpoints: Enabled()}
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			cfg.Metadata.Region = RegionUSGovEast1
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			cfg.Metadata.Region = RegionEUWest1
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(HavePrefix("FIPS endpoints are not available in region eu-west-1")))
+		})
+
+		It("should only allow https endpoints", func() {
+			cfg := NewClusterConfig()
+			cfg.Metadata.Region = RegionUSWest2
+			cfg.AWSConfig = &AWSConfig{UseFIPSEndpoints: Enabled()}
+			cfg.AWSEndpoints = &AWSEndpoints{Services: map[string]string{"sts": "http://localhost:4566"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("awsEndpoints.services.sts must be an https URL when FIPS endpoints are used"))
+		})
+	})
+
+	Describe("partitions", func() {
+		It("should only allow ARNs of the partition of the region", func() {
+			cfg := NewClusterConfig()
+			cfg.Metadata.Region = RegionCNNorth1
+			cfg.IAM.ServiceRoleARN = aws.String("arn:aws-cn:iam::123456789012:role/eks")
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.IAM.AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy"}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`nodeGroups[0].iam.attachPolicyARNs[0] "arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy" is in partition aws, but region cn-north-1 is in partition aws-cn`))
+
+			cfg.Metadata.Region = RegionUSGovWest1
+			ng.IAM.AttachPolicyARNs = []string{"arn:aws-us-gov:iam::aws:policy/AmazonEKSWorkerNodePolicy"}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`iam.serviceRoleARN "arn:aws-cn:iam::123456789012:role/eks" is in partition aws-cn, but region us-gov-west-1 is in partition aws-us-gov`))
+		})
+
+		It("should not allow an Outpost in China", func() {
+			cfg := NewClusterConfig()
+			cfg.Metadata.Region = RegionCNNorthwest1
+			cfg.Outpost = &Outpost{ControlPlaneOutpostARN: "arn:aws-cn:outposts:cn-northwest-1:123456789012:outpost/op-0123456789abcdef0"}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("outpost is not supported in region cn-northwest-1 of partition aws-cn"))
+		})
+
+		It("should only allow the edge locations of the partition", func() {
+			cfg := NewClusterConfig()
+			cfg.Metadata.Region = RegionUSGovWest1
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.Edge = &NodeGroupEdge{Type: EdgeTypeWavelengthZone, Subnets: []string{"subnet-1"}}
+			Expect(ValidateClusterConfig(cfg)).To(MatchError("nodeGroups[0].edge.type wavelength-zone is not supported in region us-gov-west-1 of partition aws-us-gov"))
+		})
+
+		It("should derive the partition and domain from the region", func() {
+			Expect(Partition(RegionUSWest2)).To(Equal(PartitionAWS))
+			Expect(Partition(RegionCNNorth1)).To(Equal(PartitionChina))
+			Expect(Partition(RegionUSGovEast1)).To(Equal(PartitionUSGov))
+			Expect(EKSResourceRegistry(RegionCNNorthwest1)).To(Equal("961992271922.dkr.ecr.cn-northwest-1.amazonaws.com.cn"))
+			Expect(EKSResourceRegistry(RegionUSGovWest1)).To(Equal("013241004608.dkr.ecr.us-gov-west-1.amazonaws.com"))
+		})
+	})
+
+	Describe("permissions boundary", func() {
+		It("should only allow policy ARNs", func() {
+			cfg := NewClusterConfig()
+			cfg.IAM.ServiceRolePermissionsBoundary = aws.String("eks-boundary")
+			Expect(ValidateClusterConfig(cfg)).To(MatchError(`iam.serviceRolePermissionsBoundary "eks-boundary" is invalid, must be the ARN of an IAM policy`))
+
+			cfg.IAM.ServiceRolePermissionsBoundary = aws.String("arn:aws:iam::123456789012:policy/eks-boundary")
+			Expect(ValidateClusterConfig(cfg)).To(Succeed())
+		})
+
+		It("should not allow a boundary for existing roles of nodegroups", func() {
+			ng := NewClusterConfig().NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/nodes"
+			ng.IAM.InstanceRolePermissionsBoundary = "arn:aws:iam::123456789012:policy/eks-boundary"
+			Expect(ValidateNodeGroup(0, ng)).To(MatchError("nodeGroups[0].iam.instanceRoleARN and nodeGroups[0].iam.instanceRolePermissionsBoundary cannot be set at the same time"))
+
+			mng := NewManagedNodeGroup()
+			mng.Name = "mng-1"
+			mng.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/nodes"
+			mng.IAM.InstanceRolePermissionsBoundary = "arn:aws:iam::123456789012:policy/eks-boundary"
+			Expect(ValidateManagedNodeGroup(0, mng)).To(MatchError("managedNodeGroups[0].iam.instanceRoleARN and managedNodeGroups[0].iam.instanceRolePermissionsBoundary cannot be set at the same time"))
+		})
+	})
+
+	Describe("config file validation", func() {
+		var cfg *ClusterConfig
+
+		BeforeEach(func() {
+			cfg = NewClusterConfig()
+			cfg.Metadata.Name = "cluster-1"
+			cfg.Metadata.Region = RegionUSWest2
+		})
+
+		It("should accept a valid config", func() {
+			cfg.Metadata.Version = Version1_14
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			mng := NewManagedNodeGroup()
+			mng.Name = "mng-1"
+			cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+
+			Expect(ValidateClusterConfigFile(cfg)).To(BeEmpty())
+			Expect(ng.AMIFamily).To(Equal(DefaultNodeImageFamily))
+		})
+
+		It("should return all errors rather than only the first one", func() {
+			cfg.Metadata.Region = "xx-west-1"
+			cfg.Metadata.Version = "1.99"
+
+			ng := cfg.NewNodeGroup()
+			ng.Name = "ng-1"
+			ng.AMIFamily = "Foo"
+			ng.VolumeSize = newInt(20)
+			ng.VolumeType = aws.String("xyz")
+
+			mng := NewManagedNodeGroup()
+			mng.Name = "mng-1"
+			mng.MinSize = newInt(3)
+			mng.MaxSize = newInt(1)
+			cfg.ManagedNodeGroups = append(cfg.ManagedNodeGroups, mng)
+
+			errs := ValidateClusterConfigFile(cfg)
+			Expect(errs).To(HaveLen(5))
+			Expect(errs[0]).To(MatchError(ContainSubstring(`metadata.region "xx-west-1" is not supported`)))
+			Expect(errs[1]).To(MatchError(ContainSubstring(`metadata.version "1.99" is not supported`)))
+			Expect(errs[2]).To(MatchError(ContainSubstring(`nodeGroups[0].amiFamily "Foo" is not supported`)))
+			Expect(errs[3]).To(MatchError(ContainSubstring(`nodeGroups[0].volumeType "xyz" is not supported`)))
+			Expect(errs[4]).To(MatchError("managedNodeGroups[0].minSize cannot be greater than managedNodeGroups[0].maxSize"))
+		})
+	})
 })
 
 func checkItDetectsError(SSHConfig *NodeGroupSSH) {
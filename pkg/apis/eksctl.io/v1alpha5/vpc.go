@@ -18,22 +18,51 @@ type (
 		// these are keyed by AZ for convenience
 		// +optional
 		Subnets *ClusterSubnets `json:"subnets,omitempty"`
+		// discover subnets of an existing VPC by their tags, instead of
+		// listing each of them in subnets
+		// +optional
+		SubnetDiscovery *ClusterSubnetDiscovery `json:"subnetDiscovery,omitempty"`
 		// for additional CIDR associations, e.g. to use with separate CIDR for
 		// private subnets or any ad-hoc subnets
 		// +optional
 		ExtraCIDRs []*ipnet.IPNet `json:"extraCIDRs,omitempty"`
 		// for pre-defined shared node SG
 		SharedNodeSecurityGroup string `json:"sharedNodeSecurityGroup,omitempty"`
+		// rules added to the control plane security group created by eksctl
+		// +optional
+		ControlPlaneSecurityGroupRules *SecurityGroupRules `json:"controlPlaneSecurityGroupRules,omitempty"`
+		// rules added to the shared node security group created by eksctl
+		// +optional
+		SharedNodeSecurityGroupRules *SecurityGroupRules `json:"sharedNodeSecurityGroupRules,omitempty"`
 		// +optional
 		AutoAllocateIPv6 *bool `json:"autoAllocateIPv6,omitempty"`
 		// +optional
 		NAT *ClusterNAT `json:"nat,omitempty"`
+		// subnets for pods, separate from the subnets of nodes
+		// +optional
+		PodSubnets *ClusterPodSubnets `json:"podSubnets,omitempty"`
 	}
 	// ClusterSubnets holds private and public subnets
 	ClusterSubnets struct {
 		Private map[string]Network `json:"private,omitempty"`
 		Public  map[string]Network `json:"public,omitempty"`
 	}
+	// ClusterSubnetDiscovery holds the selectors of private and public subnets,
+	// when neither is given both are discovered by the load balancer role tags
+	ClusterSubnetDiscovery struct {
+		// +optional
+		Private *SubnetSelector `json:"private,omitempty"`
+		// +optional
+		Public *SubnetSelector `json:"public,omitempty"`
+	}
+	// SubnetSelector selects subnets by tags
+	SubnetSelector struct {
+		// tags that subnets must have, a tag with an empty value
+		// matches any value; defaults to `kubernetes.io/role/internal-elb: "1"`
+		// for private subnets and `kubernetes.io/role/elb: "1"` for public subnets
+		// +optional
+		Tags map[string]string `json:"tags,omitempty"`
+	}
 	// SubnetTopology can be SubnetTopologyPrivate or SubnetTopologyPublic
 	SubnetTopology string
 	// Network holds ID and CIDR
@@ -43,6 +72,21 @@ type (
 		// +optional
 		CIDR *ipnet.IPNet `json:"cidr,omitempty"`
 	}
+	// ClusterPodSubnets holds the configuration of VPC CNI custom networking,
+	// where pods get their IPs from dedicated subnets
+	ClusterPodSubnets struct {
+		// secondary CIDR that is associated with the VPC, pod subnets
+		// are allocated from it when they are not given
+		// +optional
+		CIDR *ipnet.IPNet `json:"cidr,omitempty"`
+		// pod subnets keyed by AZ, with an existing VPC these must be given by ID
+		// +optional
+		Subnets map[string]Network `json:"subnets,omitempty"`
+		// security groups of the network interfaces of pods, default to the
+		// shared node security group
+		// +optional
+		SecurityGroups []string `json:"securityGroups,omitempty"`
+	}
 	// ClusterNAT holds NAT gateway configuration options
 	ClusterNAT struct {
 		Gateway *string `json:"gateway,omitempty"`
@@ -60,6 +104,25 @@ const (
 	SubnetTopologyPublic SubnetTopology = "Public"
 )
 
+// Tags by which load balancer controllers discover subnets
+const (
+	PrivateSubnetRoleTag = "kubernetes.io/role/internal-elb"
+	PublicSubnetRoleTag  = "kubernetes.io/role/elb"
+)
+
+// SubnetSelectors returns the selectors of subnet discovery by topology,
+// topologies without a selector are not discovered
+func (d *ClusterSubnetDiscovery) SubnetSelectors() map[SubnetTopology]*SubnetSelector {
+	selectors := map[SubnetTopology]*SubnetSelector{}
+	if d.Private != nil {
+		selectors[SubnetTopologyPrivate] = d.Private
+	}
+	if d.Public != nil {
+		selectors[SubnetTopologyPublic] = d.Public
+	}
+	return selectors
+}
+
 // SubnetTopologies returns a list of topologies
 func SubnetTopologies() []SubnetTopology {
 	return []SubnetTopology{
@@ -100,6 +163,33 @@ func (c *ClusterConfig) PublicSubnetIDs() []string {
 	return subnets
 }
 
+// HasPodSubnets checks if pods get their IPs from dedicated subnets
+func (c *ClusterConfig) HasPodSubnets() bool {
+	return c.VPC != nil && c.VPC.PodSubnets != nil
+}
+
+// PodSubnetIDs returns list of pod subnets
+func (c *ClusterConfig) PodSubnetIDs() []string {
+	subnets := []string{}
+	if c.HasPodSubnets() {
+		for _, s := range c.VPC.PodSubnets.Subnets {
+			subnets = append(subnets, s.ID)
+		}
+	}
+	return subnets
+}
+
+// ImportPodSubnet loads a given pod subnet into cluster config
+func (c *ClusterConfig) ImportPodSubnet(az, subnetID, cidr string) error {
+	if c.VPC.PodSubnets == nil {
+		c.VPC.PodSubnets = &ClusterPodSubnets{}
+	}
+	if c.VPC.PodSubnets.Subnets == nil {
+		c.VPC.PodSubnets.Subnets = make(map[string]Network)
+	}
+	return doImportSubnet(c.VPC.PodSubnets.Subnets, az, subnetID, cidr)
+}
+
 // ImportSubnet loads a given subnet into cluster config
 func (c *ClusterConfig) ImportSubnet(topology SubnetTopology, az, subnetID, cidr string) error {
 	if c.VPC.Subnets == nil {
@@ -148,6 +238,24 @@ func (c *ClusterConfig) HasAnySubnets() bool {
 	return c.VPC.Subnets != nil && len(c.VPC.Subnets.Private)+len(c.VPC.Subnets.Public) != 0
 }
 
+// AvailabilityZonesWithoutSubnets returns the availability zones that have
+// neither private nor public subnets, e.g. zones that are added to an existing cluster
+func (c *ClusterConfig) AvailabilityZonesWithoutSubnets() []string {
+	var zones []string
+	for _, az := range c.AvailabilityZones {
+		if c.VPC.Subnets != nil {
+			if _, ok := c.VPC.Subnets.Private[az]; ok {
+				continue
+			}
+			if _, ok := c.VPC.Subnets.Public[az]; ok {
+				continue
+			}
+		}
+		zones = append(zones, az)
+	}
+	return zones
+}
+
 // HasSufficientPrivateSubnets validates if there is a sufficient
 // number of private subnets available to create a cluster
 func (c *ClusterConfig) HasSufficientPrivateSubnets() bool {
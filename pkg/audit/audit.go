@@ -0,0 +1,227 @@
+package audit
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/user"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/diagnostics"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+const (
+	// FileEnv is the environment variable that sets the file entries are appended to
+	FileEnv = "EKSCTL_AUDIT_LOG"
+	// LogGroupEnv is the environment variable that sets the CloudWatch Logs group entries are sent to
+	LogGroupEnv = "EKSCTL_AUDIT_LOG_GROUP"
+
+	defaultLogStream = "eksctl"
+)
+
+// Entry records a command that was run, as a line of JSON
+type Entry struct {
+	Time     time.Time `json:"time"`
+	Command  string    `json:"command"`
+	Args     []string  `json:"args,omitempty"`
+	User     string    `json:"user,omitempty"`
+	Identity string    `json:"identity,omitempty"`
+	Cluster  string    `json:"cluster,omitempty"`
+	Region   string    `json:"region,omitempty"`
+	// ConfigHash is the SHA-256 of the resolved ClusterConfig, it tells apart
+	// commands that were run with different configs
+	ConfigHash      string      `json:"configHash,omitempty"`
+	Tasks           []TaskEntry `json:"tasks,omitempty"`
+	DurationSeconds float64     `json:"durationSeconds"`
+	Error           string      `json:"error,omitempty"`
+	Version         string      `json:"version"`
+
+	mutex sync.Mutex
+}
+
+// TaskEntry records a task of a command
+type TaskEntry struct {
+	Description     string    `json:"description"`
+	Started         time.Time `json:"started"`
+	DurationSeconds float64   `json:"durationSeconds"`
+	Error           string    `json:"error,omitempty"`
+}
+
+// NewEntry starts the entry of a command, the values of flags that may hold credentials, e.g. the URL
+// of --notify-url, aren't recorded
+func NewEntry(command string, args []string, now time.Time) *Entry {
+	e := &Entry{
+		Time:    now.UTC(),
+		Command: command,
+		Args:    diagnostics.RedactArgs(args),
+		Version: version.Get().GitTag,
+	}
+	if u, err := user.Current(); err == nil {
+		e.User = u.Username
+	} else {
+		e.User = os.Getenv("USER")
+	}
+	return e
+}
+
+// AddTask records the result of a task, it's safe to call from tasks that run in parallel
+func (e *Entry) AddTask(result manager.TaskResult) {
+	task := TaskEntry{
+		Description:     result.Description,
+		Started:         result.Started.UTC(),
+		DurationSeconds: result.Duration.Seconds(),
+	}
+	if result.Err != nil {
+		task.Error = result.Err.Error()
+	}
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+	e.Tasks = append(e.Tasks, task)
+}
+
+// Finish records the outcome of the command and the config it ran with, cfg may be nil
+func (e *Entry) Finish(cfg *api.ClusterConfig, err error, now time.Time) error {
+	e.DurationSeconds = now.Sub(e.Time).Seconds()
+	if err != nil {
+		e.Error = err.Error()
+	}
+	if cfg == nil {
+		return nil
+	}
+	e.Cluster = cfg.Metadata.Name
+	e.Region = cfg.Metadata.Region
+
+	resolved := cfg.DeepCopy()
+	resolved.Status = nil
+	data, jsonErr := json.Marshal(resolved)
+	if jsonErr != nil {
+		return errors.Wrap(jsonErr, "hashing config")
+	}
+	sum := sha256.Sum256(data)
+	e.ConfigHash = hex.EncodeToString(sum[:])
+	return nil
+}
+
+// Journal writes entries to a local file, and to CloudWatch Logs when a log group is set
+type Journal struct {
+	File     string
+	LogGroup string
+}
+
+// NewJournalFromEnv returns the journal that the environment configures,
+// which is nil when neither a file nor a log group is set
+func NewJournalFromEnv() *Journal {
+	j := &Journal{
+		File:     os.Getenv(FileEnv),
+		LogGroup: os.Getenv(LogGroupEnv),
+	}
+	if j.File == "" && j.LogGroup == "" {
+		return nil
+	}
+	return j
+}
+
+// Write appends the entry to the file and sends it to the log group; the identity of the
+// caller is looked up with provider, which may be nil when the command didn't get to use AWS
+func (j *Journal) Write(provider api.ClusterProvider, e *Entry) error {
+	if provider != nil && e.Identity == "" {
+		if output, err := provider.STS().GetCallerIdentity(&sts.GetCallerIdentityInput{}); err == nil {
+			e.Identity = aws.StringValue(output.Arn)
+		}
+	}
+
+	data, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	if j.File != "" {
+		if err := appendLine(j.File, data); err != nil {
+			return err
+		}
+	}
+	if j.LogGroup != "" {
+		if provider == nil {
+			return fmt.Errorf("cannot send audit log entry to log group %q without AWS credentials", j.LogGroup)
+		}
+		if err := j.send(provider, e, data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadClusterEntries returns the lines of an audit log file that record commands run on the cluster,
+// lines that aren't entries are skipped
+func ReadClusterEntries(path, cluster string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading audit log %q", path)
+	}
+	entries := &bytes.Buffer{}
+	for _, line := range bytes.Split(data, []byte("\n")) {
+		var e struct {
+			Cluster string `json:"cluster"`
+		}
+		if json.Unmarshal(line, &e) == nil && e.Cluster == cluster {
+			entries.Write(line)
+			entries.WriteByte('\n')
+		}
+	}
+	return entries.Bytes(), nil
+}
+
+func appendLine(path string, data []byte) error {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "opening audit log %q", path)
+	}
+	defer f.Close()
+	if _, err := f.Write(append(data, '\n')); err != nil {
+		return errors.Wrapf(err, "writing audit log %q", path)
+	}
+	return nil
+}
+
+// send puts the entry in the log stream of the cluster, or the "eksctl" stream for
+// commands that don't operate on a cluster
+func (j *Journal) send(provider api.ClusterProvider, e *Entry, data []byte) error {
+	stream := defaultLogStream
+	if e.Cluster != "" {
+		stream = e.Cluster
+	}
+
+	_, err := provider.CloudWatchLogs().CreateLogStream(&cloudwatchlogs.CreateLogStreamInput{
+		LogGroupName:  aws.String(j.LogGroup),
+		LogStreamName: aws.String(stream),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != cloudwatchlogs.ErrCodeResourceAlreadyExistsException {
+			return errors.Wrapf(err, "creating log stream %q in log group %q", stream, j.LogGroup)
+		}
+	}
+
+	_, err = provider.CloudWatchLogs().PutLogEvents(&cloudwatchlogs.PutLogEventsInput{
+		LogGroupName:  aws.String(j.LogGroup),
+		LogStreamName: aws.String(stream),
+		LogEvents: []*cloudwatchlogs.InputLogEvent{{
+			Message:   aws.String(string(data)),
+			Timestamp: aws.Int64(e.Time.UnixNano() / int64(time.Millisecond)),
+		}},
+	})
+	return errors.Wrapf(err, "sending audit log entry to log group %q", j.LogGroup)
+}
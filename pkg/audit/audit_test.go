@@ -0,0 +1,125 @@
+package audit_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/sts"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/audit"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("audit log", func() {
+	var (
+		p     *mockprovider.MockProvider
+		cfg   *api.ClusterConfig
+		entry *Entry
+		dir   string
+	)
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "audit")
+		Expect(err).ToNot(HaveOccurred())
+
+		p = mockprovider.NewMockProvider()
+		p.MockSTS().On("GetCallerIdentity", mock.Anything).Return(&sts.GetCallerIdentityOutput{
+			Arn: aws.String("arn:aws:iam::123456789012:user/alice"),
+		}, nil)
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		started := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+		entry = NewEntry("eksctl create nodegroup", []string{"create", "nodegroup", "-f", "cluster.yaml"}, started)
+		entry.AddTask(manager.TaskResult{Description: "create nodegroup \"ng-1\"", Started: started, Duration: 3 * time.Minute})
+		entry.AddTask(manager.TaskResult{Description: "create nodegroup \"ng-2\"", Started: started, Duration: time.Minute, Err: fmt.Errorf("stack failed")})
+		Expect(entry.Finish(cfg, fmt.Errorf("failed to create nodegroups"), started.Add(4*time.Minute))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	It("appends entries to a file as lines of JSON", func() {
+		journal := &Journal{File: filepath.Join(dir, "audit.log")}
+		Expect(journal.Write(p, entry)).To(Succeed())
+		Expect(journal.Write(p, entry)).To(Succeed())
+
+		data, err := ioutil.ReadFile(journal.File)
+		Expect(err).ToNot(HaveOccurred())
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		Expect(lines).To(HaveLen(2))
+
+		var written map[string]interface{}
+		Expect(json.Unmarshal([]byte(lines[0]), &written)).To(Succeed())
+		Expect(written).To(HaveKeyWithValue("command", "eksctl create nodegroup"))
+		Expect(written).To(HaveKeyWithValue("identity", "arn:aws:iam::123456789012:user/alice"))
+		Expect(written).To(HaveKeyWithValue("cluster", "test-cluster"))
+		Expect(written).To(HaveKeyWithValue("region", "us-west-2"))
+		Expect(written).To(HaveKeyWithValue("durationSeconds", 240.0))
+		Expect(written).To(HaveKeyWithValue("error", "failed to create nodegroups"))
+		Expect(written["configHash"]).To(HaveLen(64))
+		Expect(written["tasks"]).To(HaveLen(2))
+	})
+
+	It("reads the entries of a cluster", func() {
+		journal := &Journal{File: filepath.Join(dir, "audit.log")}
+		Expect(journal.Write(p, entry)).To(Succeed())
+		other := NewEntry("eksctl get clusters", nil, time.Now())
+		Expect(other.Finish(nil, nil, time.Now())).To(Succeed())
+		Expect(journal.Write(p, other)).To(Succeed())
+
+		data, err := ReadClusterEntries(journal.File, "test-cluster")
+		Expect(err).ToNot(HaveOccurred())
+		lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+		Expect(lines).To(HaveLen(1))
+		Expect(lines[0]).To(ContainSubstring(`"command":"eksctl create nodegroup"`))
+	})
+
+	It("doesn't record the values of flags that may hold credentials", func() {
+		other := NewEntry("eksctl create cluster", []string{"create", "cluster", "--notify-url", "https://hooks.slack.com/services/T0/B0/abc", "--name", "test"}, time.Now())
+		Expect(other.Args).To(Equal([]string{"create", "cluster", "--notify-url", "REDACTED", "--name", "test"}))
+	})
+
+	It("hashes configs, so that commands run with different configs can be told apart", func() {
+		other := NewEntry("eksctl create nodegroup", nil, time.Now())
+		cfg.Metadata.Tags = map[string]string{"team": "a"}
+		Expect(other.Finish(cfg, nil, time.Now())).To(Succeed())
+		Expect(other.ConfigHash).ToNot(Equal(entry.ConfigHash))
+	})
+
+	It("sends entries to the log stream of the cluster", func() {
+		p.MockCloudWatchLogs().On("CreateLogStream", &cloudwatchlogs.CreateLogStreamInput{
+			LogGroupName:  aws.String("eksctl-audit"),
+			LogStreamName: aws.String("test-cluster"),
+		}).Return(nil, awserr.New(cloudwatchlogs.ErrCodeResourceAlreadyExistsException, "exists", nil))
+		var input *cloudwatchlogs.PutLogEventsInput
+		p.MockCloudWatchLogs().On("PutLogEvents", mock.Anything).Run(func(args mock.Arguments) {
+			input = args[0].(*cloudwatchlogs.PutLogEventsInput)
+		}).Return(&cloudwatchlogs.PutLogEventsOutput{}, nil)
+
+		journal := &Journal{LogGroup: "eksctl-audit"}
+		Expect(journal.Write(p, entry)).To(Succeed())
+
+		Expect(*input.LogStreamName).To(Equal("test-cluster"))
+		Expect(input.LogEvents).To(HaveLen(1))
+		Expect(*input.LogEvents[0].Timestamp).To(Equal(int64(1583298367000)))
+		Expect(*input.LogEvents[0].Message).To(ContainSubstring(`"command":"eksctl create nodegroup"`))
+	})
+})
@@ -10,7 +10,6 @@ import (
 	"fmt"
 
 	"github.com/aws/aws-sdk-go/aws/awsutil"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	kerr "k8s.io/apimachinery/pkg/api/errors"
@@ -18,10 +17,12 @@ import (
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/yaml"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -49,6 +50,10 @@ const (
 // with the cluster, required for the instance role ARNs of nodegroups.
 var RoleNodeGroupGroups = []string{"system:bootstrappers", "system:nodes"}
 
+// RoleNodeGroupGroupsWindows are the groups required for the instance
+// role ARNs of Windows nodegroups, so that kube-proxy can run on them.
+var RoleNodeGroupGroupsWindows = []string{"system:bootstrappers", "system:nodes", "eks:kube-proxy-windows"}
+
 // AuthConfigMap allows modifying the auth ConfigMap.
 type AuthConfigMap struct {
 	client v1.ConfigMapInterface
@@ -142,6 +147,12 @@ func (a *AuthConfigMap) setAccounts(accounts []string) error {
 // role or user with given groups. If you are calling
 // this as part of node creation you should use DefaultNodeGroups.
 func (a *AuthConfigMap) AddIdentity(identity iam.Identity) error {
+	for _, group := range identity.Groups() {
+		if api.IsReservedKubernetesGroup(group) {
+			return fmt.Errorf("cannot map identity %q to group %q, groups starting with \"system:\" are reserved", identity.ARN(), group)
+		}
+	}
+
 	identities, err := a.Identities()
 	if err != nil {
 		return err
@@ -258,42 +269,176 @@ func ObjectMeta() metav1.ObjectMeta {
 	}
 }
 
+// Update fetches the auth ConfigMap, modifies it with the given function and saves it; when
+// the ConfigMap was changed by someone else in the meantime, this is retried with the latest
+// version, so that concurrent changes don't overwrite each other
+func Update(clientSet kubernetes.Interface, modify func(*AuthConfigMap) error) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		acm, err := NewFromClientSet(clientSet)
+		if err != nil {
+			return err
+		}
+		if err := modify(acm); err != nil {
+			return err
+		}
+		if err := acm.Save(); err != nil {
+			if kerr.IsConflict(err) {
+				logger.Debug("auth ConfigMap was modified concurrently, retrying")
+			}
+			return err
+		}
+		return nil
+	})
+}
+
 // AddNodeGroup creates or adds a nodegroup IAM role in the auth
 // ConfigMap for the given nodegroup.
 func AddNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
-	acm, err := NewFromClientSet(clientSet)
+	_, err := AddNodeGroups(clientSet, []*api.NodeGroup{ng})
+	return err
+}
+
+// AddNodeGroups adds the IAM roles of the given nodegroups that are missing from the auth ConfigMap
+// in a single update, which is retried on conflicts; roles that are already mapped are left as they
+// are, even when their username or groups differ, as they may have been changed deliberately. The
+// ARNs of the roles that were added are returned
+func AddNodeGroups(clientSet kubernetes.Interface, nodeGroups []*api.NodeGroup) ([]string, error) {
+	var added []string
+	err := Update(clientSet, func(acm *AuthConfigMap) error {
+		added = nil
+		identities, err := acm.Identities()
+		if err != nil {
+			return err
+		}
+		for _, ng := range MissingNodeGroupRoles(identities, nodeGroups) {
+			groups := RoleNodeGroupGroups
+			if api.IsWindowsImage(ng.AMIFamily) {
+				groups = RoleNodeGroupGroupsWindows
+			}
+			identity, err := iam.NewIdentity(ng.IAM.InstanceRoleARN, RoleNodeGroupUsername, groups)
+			if err != nil {
+				return err
+			}
+			if err := acm.AddIdentity(identity); err != nil {
+				return errors.Wrap(err, "adding nodegroup to auth ConfigMap")
+			}
+			added = append(added, ng.IAM.InstanceRoleARN)
+		}
+		return nil
+	})
 	if err != nil {
-		return err
+		return nil, errors.Wrap(err, "saving auth ConfigMap")
+	}
+	logger.Debug("saved auth ConfigMap for %d nodegroup(s)", len(nodeGroups))
+	return added, nil
+}
+
+// MissingNodeGroupRoles returns the nodegroups whose IAM role isn't mapped by any of the identities,
+// nodegroups that share a role are only returned once
+func MissingNodeGroupRoles(identities []iam.Identity, nodeGroups []*api.NodeGroup) []*api.NodeGroup {
+	mapped := map[string]bool{}
+	for _, identity := range identities {
+		mapped[identity.ARN()] = true
+	}
+	var missing []*api.NodeGroup
+	for _, ng := range nodeGroups {
+		if roleARN := ng.IAM.InstanceRoleARN; !mapped[roleARN] {
+			mapped[roleARN] = true
+			missing = append(missing, ng)
+		} else {
+			logger.Debug("role %q of nodegroup %q is already in auth ConfigMap", roleARN, ng.Name)
+		}
 	}
+	return missing
+}
 
-	identity, err := iam.NewIdentity(ng.IAM.InstanceRoleARN, RoleNodeGroupUsername, RoleNodeGroupGroups)
+// AddNodeRole adds the IAM role of Linux nodes that aren't part of any nodegroup, e.g.
+// nodes launched by Karpenter, to the auth ConfigMap
+func AddNodeRole(clientSet kubernetes.Interface, roleARN string) error {
+	identity, err := iam.NewIdentity(roleARN, RoleNodeGroupUsername, RoleNodeGroupGroups)
 	if err != nil {
 		return err
 	}
 
-	if err := acm.AddIdentity(identity); err != nil {
-		return errors.Wrap(err, "adding nodegroup to auth ConfigMap")
-	}
-	if err := acm.Save(); err != nil {
+	err = Update(clientSet, func(acm *AuthConfigMap) error {
+		identities, err := acm.Identities()
+		if err != nil {
+			return err
+		}
+		for _, existing := range identities {
+			if existing.ARN() == roleARN {
+				logger.Info("node role %q is already in auth ConfigMap", roleARN)
+				return nil
+			}
+		}
+		if err := acm.AddIdentity(identity); err != nil {
+			return errors.Wrap(err, "adding node role to auth ConfigMap")
+		}
+		return nil
+	})
+	if err != nil {
 		return errors.Wrap(err, "saving auth ConfigMap")
 	}
-	logger.Debug("saved auth ConfigMap for %q", ng.Name)
+	logger.Debug("saved auth ConfigMap for node role %q", roleARN)
 	return nil
 }
 
 // RemoveNodeGroup removes a nodegroup from the ConfigMap and
 // does a client update.
 func RemoveNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
-	acm, err := NewFromClientSet(clientSet)
+	err := Update(clientSet, func(acm *AuthConfigMap) error {
+		if err := acm.RemoveIdentity(ng.IAM.InstanceRoleARN, false); err != nil {
+			return errors.Wrap(err, "removing nodegroup from auth ConfigMap")
+		}
+		return nil
+	})
 	if err != nil {
-		return err
-	}
-	if err := acm.RemoveIdentity(ng.IAM.InstanceRoleARN, false); err != nil {
-		return errors.Wrap(err, "removing nodegroup from auth ConfigMap")
-	}
-	if err := acm.Save(); err != nil {
 		return errors.Wrap(err, "updating auth ConfigMap after removing role")
 	}
 	logger.Debug("updated auth ConfigMap for %s", ng.Name)
 	return nil
 }
+
+// MissingIdentityMappings returns the mappings that are not in effect, aws-iam-authenticator
+// only considers the last entry for any given ARN, so that is the one that has to match
+func MissingIdentityMappings(identities []iam.Identity, mappings []*api.IAMIdentityMapping) []*api.IAMIdentityMapping {
+	current := map[string]iam.Identity{}
+	for _, identity := range identities {
+		current[identity.ARN()] = identity
+	}
+
+	missing := []*api.IAMIdentityMapping{}
+	for _, mapping := range mappings {
+		identity, ok := current[mapping.ARN]
+		if !ok || identity.Username() != mapping.Username ||
+			!sets.NewString(identity.Groups()...).Equal(sets.NewString(mapping.Groups...)) {
+			missing = append(missing, mapping)
+		}
+	}
+	return missing
+}
+
+// AddIdentityMappings adds the given mappings to the auth ConfigMap, any existing mapping
+// for the same ARN is replaced
+func AddIdentityMappings(clientSet kubernetes.Interface, mappings []*api.IAMIdentityMapping) error {
+	identities := []iam.Identity{}
+	for _, mapping := range mappings {
+		identity, err := iam.NewIdentity(mapping.ARN, mapping.Username, mapping.Groups)
+		if err != nil {
+			return errors.Wrapf(err, "mapping %q", mapping.ARN)
+		}
+		identities = append(identities, identity)
+	}
+
+	return Update(clientSet, func(acm *AuthConfigMap) error {
+		for _, identity := range identities {
+			if err := acm.RemoveIdentity(identity.ARN(), true); err != nil {
+				return err
+			}
+			if err := acm.AddIdentity(identity); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
@@ -5,11 +5,18 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/typed/core/v1"
+	k8stest "k8s.io/client-go/testing"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	. "github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/iam"
 )
@@ -503,4 +510,106 @@ var _ = Describe("AuthConfigMap{}", func() {
 			Expect(err).To(HaveOccurred())
 		})
 	})
+
+	Describe("AddIdentity() with reserved groups", func() {
+		It("should reject groups starting with system: other than the ones for admins and nodes", func() {
+			acm := New(&mockClient{}, nil)
+			identity, err := iam.NewIdentity(userA, userAUsername, []string{"system:kube-controller-manager"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acm.AddIdentity(identity)).To(MatchError(ContainSubstring(`groups starting with "system:" are reserved`)))
+
+			identity, err = iam.NewIdentity(userA, userAUsername, []string{GroupMasters})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(acm.AddIdentity(identity)).To(Succeed())
+		})
+	})
+
+	Describe("MissingIdentityMappings()", func() {
+		It("should only consider the last mapping of an ARN", func() {
+			identities := []iam.Identity{
+				iam.UserIdentity{UserARN: userA, KubernetesIdentity: iam.KubernetesIdentity{KubernetesUsername: userAUsername, KubernetesGroups: userAGroups}},
+				iam.UserIdentity{UserARN: userB, KubernetesIdentity: iam.KubernetesIdentity{KubernetesUsername: userBUsername, KubernetesGroups: userBGroups}},
+				iam.UserIdentity{UserARN: userB, KubernetesIdentity: iam.KubernetesIdentity{KubernetesUsername: "eve"}},
+			}
+			mappings := []*api.IAMIdentityMapping{
+				{ARN: userA, Username: userAUsername, Groups: []string{"tin-foil-hat-wearers", "cryptographers"}},
+				{ARN: userB, Username: userBUsername, Groups: userBGroups},
+				{ARN: roleA, Groups: []string{GroupMasters}},
+			}
+			Expect(MissingIdentityMappings(identities, mappings)).To(Equal(mappings[1:]))
+		})
+	})
+
+	Describe("AddIdentityMappings()", func() {
+		It("should replace existing mappings and retry on conflicts", func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: ObjectMeta(),
+				Data:       map[string]string{"mapUsers": makeExpectedUser(userA, "mallory", "cryptographers")},
+			}
+			existing.UID = "123456"
+			clientSet := fake.NewSimpleClientset(existing)
+
+			conflicts := 1
+			clientSet.PrependReactor("update", "configmaps", func(action k8stest.Action) (bool, runtime.Object, error) {
+				if conflicts == 0 {
+					return false, nil, nil
+				}
+				conflicts--
+				return true, nil, kerr.NewConflict(schema.GroupResource{Resource: "configmaps"}, ObjectName, fmt.Errorf("modified concurrently"))
+			})
+
+			err := AddIdentityMappings(clientSet, []*api.IAMIdentityMapping{
+				{ARN: userA, Username: userAUsername, Groups: userAGroups},
+				{ARN: roleB, Groups: []string{groupB}},
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(conflicts).To(Equal(0))
+
+			cm, err := clientSet.CoreV1().ConfigMaps(ObjectNamespace).Get(ObjectName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cm.Data["mapUsers"]).To(MatchYAML(expectedUserA))
+			Expect(cm.Data["mapRoles"]).To(MatchYAML(fmt.Sprintf("- rolearn: %s\n  groups:\n  - %s\n", roleB, groupB)))
+		})
+	})
+	Describe("AddNodeGroups()", func() {
+		newNodeGroup := func(name, roleARN string) *api.NodeGroup {
+			ng := api.NewNodeGroup()
+			ng.Name = name
+			ng.IAM.InstanceRoleARN = roleARN
+			return ng
+		}
+
+		It("should only add missing roles and keep existing mappings", func() {
+			existing := &corev1.ConfigMap{
+				ObjectMeta: ObjectMeta(),
+				Data:       map[string]string{"mapRoles": makeExpectedRole(roleA, []string{"system:nodes", groupB})},
+			}
+			existing.UID = "123456"
+			clientSet := fake.NewSimpleClientset(existing)
+
+			added, err := AddNodeGroups(clientSet, []*api.NodeGroup{
+				newNodeGroup("ng-1", roleA),
+				newNodeGroup("ng-2", roleB),
+				newNodeGroup("ng-3", roleB),
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(added).To(Equal([]string{roleB}))
+
+			cm, err := clientSet.CoreV1().ConfigMaps(ObjectNamespace).Get(ObjectName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cm.Data["mapRoles"]).To(MatchYAML(makeExpectedRole(roleA, []string{"system:nodes", groupB}) + makeExpectedRole(roleB, RoleNodeGroupGroups)))
+		})
+
+		It("should map roles of Windows nodegroups with the groups they need", func() {
+			clientSet := fake.NewSimpleClientset()
+			ng := newNodeGroup("ng-1", roleA)
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019FullContainer
+
+			Expect(AddNodeGroup(clientSet, ng)).To(Succeed())
+
+			cm, err := clientSet.CoreV1().ConfigMaps(ObjectNamespace).Get(ObjectName, metav1.GetOptions{})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(cm.Data["mapRoles"]).To(MatchYAML(makeExpectedRole(roleA, RoleNodeGroupGroupsWindows)))
+		})
+	})
 })
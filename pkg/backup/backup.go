@@ -0,0 +1,302 @@
+package backup
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+const (
+	// BucketEnv is the environment variable that sets the S3 bucket snapshots are written to,
+	// for clusters whose config doesn't set backup
+	BucketEnv = "EKSCTL_BACKUP_S3_BUCKET"
+	// PrefixEnv is the environment variable that sets the key prefix of snapshots in the bucket
+	PrefixEnv = "EKSCTL_BACKUP_S3_PREFIX"
+
+	// TimeFormat is the format of the time that identifies a snapshot
+	TimeFormat = "20060102T150405Z"
+
+	configKey  = "cluster.yaml"
+	awsAuthKey = "aws-auth.yaml"
+	stacksKey  = "stacks"
+)
+
+// Location is where the snapshots of a cluster are kept
+type Location struct {
+	Bucket string
+	// Prefix is the key prefix of the snapshots of the cluster, i.e. <prefix>/<region>/<cluster>
+	Prefix string
+}
+
+// LocationFor returns the location of the snapshots of the cluster, which is nil
+// when neither the config nor the environment sets a bucket
+func LocationFor(spec *api.ClusterConfig) *Location {
+	bucket, prefix := os.Getenv(BucketEnv), os.Getenv(PrefixEnv)
+	if spec.HasBackup() {
+		bucket, prefix = spec.Backup.S3Bucket, spec.Backup.S3Prefix
+	}
+	if bucket == "" {
+		return nil
+	}
+	return &Location{
+		Bucket: bucket,
+		Prefix: path.Join(prefix, spec.Metadata.Region, spec.Metadata.Name),
+	}
+}
+
+// Snapshot is the state of a cluster at some time
+type Snapshot struct {
+	// Time identifies the snapshot, it's formatted with TimeFormat
+	Time string
+	// Config is the resolved ClusterConfig as YAML
+	Config []byte
+	// AWSAuth is the aws-auth ConfigMap as YAML, it's empty when it couldn't be read
+	AWSAuth []byte
+	Stacks  []*manager.StackSnapshot
+}
+
+// Take writes a snapshot of the stacks, the config and the aws-auth ConfigMap of the cluster
+// to S3 and returns its time; nothing is written when the cluster has no stacks yet. The
+// aws-auth ConfigMap is left out with a warning when the cluster can't be reached
+func Take(provider api.ClusterProvider, stackManager *manager.StackCollection, spec *api.ClusterConfig, newClientSet func() (kubernetes.Interface, error), now time.Time) (string, error) {
+	location := LocationFor(spec)
+	if location == nil {
+		return "", nil
+	}
+
+	stacks, err := stackManager.SnapshotStacks()
+	if err != nil {
+		return "", errors.Wrap(err, "taking snapshot of stacks")
+	}
+	if len(stacks) == 0 {
+		logger.Debug("cluster %q has no stacks, not taking a snapshot", spec.Metadata.Name)
+		return "", nil
+	}
+
+	snapshot := &Snapshot{
+		Time:   now.UTC().Format(TimeFormat),
+		Stacks: stacks,
+	}
+
+	config := spec.Redacted()
+	config.Status = nil
+	buf := &bytes.Buffer{}
+	if err := printers.NewYAMLPrinter().PrintObj(config, buf); err != nil {
+		return "", err
+	}
+	snapshot.Config = buf.Bytes()
+
+	if newClientSet != nil {
+		awsAuth, err := readAWSAuth(newClientSet)
+		if err != nil {
+			logger.Warning("the snapshot won't include the aws-auth ConfigMap: %s", err.Error())
+		}
+		snapshot.AWSAuth = awsAuth
+	}
+
+	if err := write(provider, location, snapshot); err != nil {
+		return "", err
+	}
+	logger.Info("wrote snapshot %q of cluster %q to s3://%s/%s", snapshot.Time, spec.Metadata.Name, location.Bucket, location.Prefix)
+	return snapshot.Time, nil
+}
+
+func readAWSAuth(newClientSet func() (kubernetes.Interface, error)) ([]byte, error) {
+	clientSet, err := newClientSet()
+	if err != nil {
+		return nil, err
+	}
+	cm, err := clientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace).Get(authconfigmap.ObjectName, metav1.GetOptions{})
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, errors.Wrap(err, "getting auth ConfigMap")
+	}
+	return yaml.Marshal(&corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: authconfigmap.ObjectMeta(),
+		Data:       cm.Data,
+	})
+}
+
+func write(provider api.ClusterProvider, location *Location, snapshot *Snapshot) error {
+	objects := map[string][]byte{
+		configKey: snapshot.Config,
+	}
+	if len(snapshot.AWSAuth) > 0 {
+		objects[awsAuthKey] = snapshot.AWSAuth
+	}
+	for _, stack := range snapshot.Stacks {
+		data, err := json.Marshal(stack)
+		if err != nil {
+			return err
+		}
+		objects[path.Join(stacksKey, stack.Name+".json")] = data
+	}
+
+	for name, data := range objects {
+		key := path.Join(location.Prefix, snapshot.Time, name)
+		_, err := provider.S3().PutObject(&s3.PutObjectInput{
+			Bucket: aws.String(location.Bucket),
+			Key:    aws.String(key),
+			Body:   bytes.NewReader(data),
+			// snapshots hold the config and the aws-auth ConfigMap, so they're encrypted whatever the
+			// default encryption of the bucket is
+			ServerSideEncryption: aws.String(s3.ServerSideEncryptionAes256),
+		})
+		if err != nil {
+			return errors.Wrapf(err, "writing s3://%s/%s", location.Bucket, key)
+		}
+	}
+	return nil
+}
+
+// List returns the times of the snapshots of the cluster, the latest last
+func List(provider api.ClusterProvider, spec *api.ClusterConfig) ([]string, error) {
+	location := LocationFor(spec)
+	if location == nil {
+		return nil, fmt.Errorf("no S3 bucket is set for snapshots of cluster %q, set backup.s3Bucket or %s", spec.Metadata.Name, BucketEnv)
+	}
+
+	times := []string{}
+	input := &s3.ListObjectsV2Input{
+		Bucket:    aws.String(location.Bucket),
+		Prefix:    aws.String(location.Prefix + "/"),
+		Delimiter: aws.String("/"),
+	}
+	err := provider.S3().ListObjectsV2Pages(input, func(p *s3.ListObjectsV2Output, _ bool) bool {
+		for _, prefix := range p.CommonPrefixes {
+			times = append(times, path.Base(aws.StringValue(prefix.Prefix)))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing snapshots in s3://%s/%s", location.Bucket, location.Prefix)
+	}
+	sort.Strings(times)
+	return times, nil
+}
+
+// Load reads the snapshot of the cluster taken at the given time, or the latest one
+// when snapshotTime is empty
+func Load(provider api.ClusterProvider, spec *api.ClusterConfig, snapshotTime string) (*Snapshot, error) {
+	times, err := List(provider, spec)
+	if err != nil {
+		return nil, err
+	}
+	if len(times) == 0 {
+		return nil, fmt.Errorf("no snapshots found for cluster %q", spec.Metadata.Name)
+	}
+	if snapshotTime == "" {
+		snapshotTime = times[len(times)-1]
+	} else if i := sort.SearchStrings(times, snapshotTime); i == len(times) || times[i] != snapshotTime {
+		return nil, fmt.Errorf("snapshot %q of cluster %q not found", snapshotTime, spec.Metadata.Name)
+	}
+
+	location := LocationFor(spec)
+	root := path.Join(location.Prefix, snapshotTime) + "/"
+	snapshot := &Snapshot{Time: snapshotTime}
+
+	var keys []string
+	input := &s3.ListObjectsV2Input{
+		Bucket: aws.String(location.Bucket),
+		Prefix: aws.String(root),
+	}
+	err = provider.S3().ListObjectsV2Pages(input, func(p *s3.ListObjectsV2Output, _ bool) bool {
+		for _, object := range p.Contents {
+			keys = append(keys, aws.StringValue(object.Key))
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing snapshot %q", snapshotTime)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		data, err := read(provider, location.Bucket, key)
+		if err != nil {
+			return nil, err
+		}
+		switch name := strings.TrimPrefix(key, root); {
+		case name == configKey:
+			snapshot.Config = data
+		case name == awsAuthKey:
+			snapshot.AWSAuth = data
+		case strings.HasPrefix(name, stacksKey+"/"):
+			stack := &manager.StackSnapshot{}
+			if err := json.Unmarshal(data, stack); err != nil {
+				return nil, errors.Wrapf(err, "reading s3://%s/%s", location.Bucket, key)
+			}
+			snapshot.Stacks = append(snapshot.Stacks, stack)
+		}
+	}
+	if len(snapshot.Stacks) == 0 {
+		return nil, fmt.Errorf("snapshot %q of cluster %q has no stacks", snapshotTime, spec.Metadata.Name)
+	}
+	return snapshot, nil
+}
+
+func read(provider api.ClusterProvider, bucket, key string) ([]byte, error) {
+	output, err := provider.S3().GetObject(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading s3://%s/%s", bucket, key)
+	}
+	defer output.Body.Close()
+	return ioutil.ReadAll(output.Body)
+}
+
+// RestoreAWSAuth replaces the data of the aws-auth ConfigMap with that of the snapshot
+func RestoreAWSAuth(clientSet kubernetes.Interface, snapshot *Snapshot) error {
+	if len(snapshot.AWSAuth) == 0 {
+		return fmt.Errorf("snapshot %q doesn't include the aws-auth ConfigMap", snapshot.Time)
+	}
+	saved := &corev1.ConfigMap{}
+	if err := yaml.Unmarshal(snapshot.AWSAuth, saved); err != nil {
+		return errors.Wrap(err, "reading aws-auth ConfigMap of snapshot")
+	}
+
+	client := clientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace)
+	cm, err := client.Get(authconfigmap.ObjectName, metav1.GetOptions{})
+	if err != nil {
+		if !kerr.IsNotFound(err) {
+			return errors.Wrap(err, "getting auth ConfigMap")
+		}
+		_, err = client.Create(&corev1.ConfigMap{
+			ObjectMeta: authconfigmap.ObjectMeta(),
+			Data:       saved.Data,
+		})
+		return errors.Wrap(err, "creating auth ConfigMap")
+	}
+	cm.Data = saved.Data
+	_, err = client.Update(cm)
+	return errors.Wrap(err, "updating auth ConfigMap")
+}
@@ -0,0 +1,147 @@
+package backup_test
+
+import (
+	"bytes"
+	"io/ioutil"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/s3"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	. "github.com/weaveworks/eksctl/pkg/backup"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("cluster snapshots", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	awsAuth := func(roles string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: authconfigmap.ObjectMeta(),
+			Data:       map[string]string{"mapRoles": roles},
+		}
+	}
+
+	mockStacks := func(names ...string) {
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			out := &cfn.DescribeStacksOutput{}
+			for _, name := range names {
+				out.Stacks = append(out.Stacks, &cfn.Stack{StackName: aws.String(name), StackStatus: aws.String(cfn.StackStatusCreateComplete)})
+			}
+			consume(out, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(`{"Resources": {}}`)}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Backup = &api.ClusterBackup{S3Bucket: "backups", S3Prefix: "eksctl"}
+	})
+
+	It("writes the stacks, the config and the aws-auth ConfigMap to S3", func() {
+		mockStacks("eksctl-test-cluster-cluster", "eksctl-test-cluster-nodegroup-ng-1")
+		objects := map[string]string{}
+		p.MockS3().On("PutObject", mock.Anything).Run(func(args mock.Arguments) {
+			input := args[0].(*s3.PutObjectInput)
+			Expect(*input.Bucket).To(Equal("backups"))
+			Expect(input.ServerSideEncryption).To(Equal(aws.String(s3.ServerSideEncryptionAes256)))
+			data, err := ioutil.ReadAll(input.Body)
+			Expect(err).ToNot(HaveOccurred())
+			objects[*input.Key] = string(data)
+		}).Return(&s3.PutObjectOutput{}, nil)
+
+		clientSet := fake.NewSimpleClientset(awsAuth("- rolearn: arn:aws:iam::123456789012:role/admin"))
+		now := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+		snapshotTime, err := Take(p, manager.NewStackCollection(p, cfg), cfg, func() (kubernetes.Interface, error) {
+			return clientSet, nil
+		}, now)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshotTime).To(Equal("20200304T050607Z"))
+
+		root := "eksctl/us-west-2/test-cluster/20200304T050607Z/"
+		Expect(objects).To(HaveLen(4))
+		Expect(objects).To(HaveKey(root + "stacks/eksctl-test-cluster-cluster.json"))
+		Expect(objects).To(HaveKey(root + "stacks/eksctl-test-cluster-nodegroup-ng-1.json"))
+		Expect(objects[root+"cluster.yaml"]).To(ContainSubstring("name: test-cluster"))
+		Expect(objects[root+"aws-auth.yaml"]).To(ContainSubstring("arn:aws:iam::123456789012:role/admin"))
+	})
+
+	It("doesn't take a snapshot of a cluster without stacks", func() {
+		mockStacks()
+
+		snapshotTime, err := Take(p, manager.NewStackCollection(p, cfg), cfg, nil, time.Now())
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshotTime).To(BeEmpty())
+		p.MockS3().AssertNotCalled(GinkgoT(), "PutObject", mock.Anything)
+	})
+
+	It("loads the latest snapshot and restores its aws-auth ConfigMap", func() {
+		root := "eksctl/us-west-2/test-cluster/20200304T050607Z/"
+		objects := map[string]string{
+			root + "cluster.yaml":                            "metadata:\n  name: test-cluster\n",
+			root + "aws-auth.yaml":                           "data:\n  mapRoles: restored\n",
+			root + "stacks/eksctl-test-cluster-cluster.json": `{"name": "eksctl-test-cluster-cluster", "template": "{}"}`,
+		}
+		p.MockS3().On("ListObjectsV2Pages", mock.MatchedBy(func(input *s3.ListObjectsV2Input) bool {
+			return input.Delimiter != nil
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			Expect(*args[0].(*s3.ListObjectsV2Input).Prefix).To(Equal("eksctl/us-west-2/test-cluster/"))
+			consume := args[1].(func(*s3.ListObjectsV2Output, bool) bool)
+			consume(&s3.ListObjectsV2Output{CommonPrefixes: []*s3.CommonPrefix{
+				{Prefix: aws.String(root)},
+				{Prefix: aws.String("eksctl/us-west-2/test-cluster/20200101T000000Z/")},
+			}}, true)
+		}).Return(nil)
+		p.MockS3().On("ListObjectsV2Pages", mock.MatchedBy(func(input *s3.ListObjectsV2Input) bool {
+			return input.Delimiter == nil && *input.Prefix == root
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*s3.ListObjectsV2Output, bool) bool)
+			out := &s3.ListObjectsV2Output{}
+			for key := range objects {
+				out.Contents = append(out.Contents, &s3.Object{Key: aws.String(key)})
+			}
+			consume(out, true)
+		}).Return(nil)
+		for key, data := range objects {
+			p.MockS3().On("GetObject", &s3.GetObjectInput{Bucket: aws.String("backups"), Key: aws.String(key)}).
+				Return(&s3.GetObjectOutput{Body: ioutil.NopCloser(bytes.NewBufferString(data))}, nil)
+		}
+
+		snapshot, err := Load(p, cfg, "")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshot.Time).To(Equal("20200304T050607Z"))
+		Expect(string(snapshot.Config)).To(Equal(objects[root+"cluster.yaml"]))
+		Expect(snapshot.Stacks).To(Equal([]*manager.StackSnapshot{{Name: "eksctl-test-cluster-cluster", Template: "{}"}}))
+
+		clientSet := fake.NewSimpleClientset(awsAuth("changed"))
+		Expect(RestoreAWSAuth(clientSet, snapshot)).To(Succeed())
+		cm, err := clientSet.CoreV1().ConfigMaps(authconfigmap.ObjectNamespace).Get(authconfigmap.ObjectName, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(cm.Data).To(Equal(map[string]string{"mapRoles": "restored"}))
+	})
+
+	It("fails when the snapshot doesn't exist", func() {
+		p.MockS3().On("ListObjectsV2Pages", mock.Anything, mock.Anything).Return(nil)
+
+		_, err := Load(p, cfg, "20200304T050607Z")
+		Expect(err).To(MatchError(`no snapshots found for cluster "test-cluster"`))
+	})
+})
@@ -3,16 +3,20 @@ package builder
 import (
 	"fmt"
 	"reflect"
+	"sort"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	gfn "github.com/awslabs/goformation/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 )
 
 const (
-	clusterTemplateDescription   = "EKS cluster"
-	nodeGroupTemplateDescription = "EKS nodes"
-	templateDescriptionSuffix    = "[created and managed by eksctl]"
+	clusterTemplateDescription      = "EKS cluster"
+	registrationTemplateDescription = "EKS cluster registration"
+	nodeGroupTemplateDescription    = "EKS nodes"
+	templateDescriptionSuffix       = "[created and managed by eksctl]"
 )
 
 type awsCloudFormationResource struct {
@@ -96,6 +100,26 @@ func (r *resourceSet) newResource(name string, resource interface{}) *gfn.Value
 	return gfn.MakeRef(name)
 }
 
+// addExtraResources adds the extra resources given in the config to the template, after all generated
+// resources have been added, so that a logical ID that is already in use is an error
+func (r *resourceSet) addExtraResources(owner string, config *api.CloudFormation) error {
+	if config == nil {
+		return nil
+	}
+	logicalIDs := []string{}
+	for logicalID := range config.ExtraResources {
+		logicalIDs = append(logicalIDs, logicalID)
+	}
+	sort.Strings(logicalIDs)
+	for _, logicalID := range logicalIDs {
+		if _, exists := r.template.Resources[logicalID]; exists {
+			return fmt.Errorf("extra resource %q of %s has the same logical ID as a resource generated by eksctl", logicalID, owner)
+		}
+		r.template.Resources[logicalID] = config.ExtraResources[logicalID]
+	}
+	return nil
+}
+
 // renderJSON renders template as JSON
 func (r *resourceSet) renderJSON() ([]byte, error) {
 	return r.template.JSON()
@@ -21,6 +21,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	"github.com/weaveworks/eksctl/pkg/cloudconfig"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
@@ -72,13 +73,19 @@ type Properties struct {
 
 	CidrIp, CidrIpv6, IpProtocol string
 	FromPort, ToPort             int
+	Description                  string
+
+	GroupId, SourceSecurityGroupId, DestinationSecurityGroupId interface{}
 
 	VpcId, SubnetId                            interface{}
 	RouteTableId, AllocationId                 interface{}
 	GatewayId, InternetGatewayId, NatGatewayId interface{}
+	EgressOnlyInternetGatewayId                interface{}
 	DestinationCidrBlock                       interface{}
+	DestinationIpv6CidrBlock                   interface{}
 
-	Ipv6CidrBlock map[string][]interface{}
+	Ipv6CidrBlock               map[string][]interface{}
+	AssignIpv6AddressOnCreation bool
 
 	AmazonProvidedIpv6CidrBlock         bool
 	AvailabilityZone, Domain, CidrBlock string
@@ -86,9 +93,37 @@ type Properties struct {
 	Name, Version      string
 	RoleArn            interface{}
 	ResourcesVpcConfig struct {
-		SecurityGroupIds []interface{}
-		SubnetIds        []interface{}
+		SecurityGroupIds      []interface{}
+		SubnetIds             []interface{}
+		EndpointPrivateAccess bool
+		EndpointPublicAccess  bool
+	}
+
+	ServiceName, VpcEndpointType string
+	PrivateDnsEnabled            bool
+	RouteTableIds, SubnetIds     []interface{}
+	SecurityGroupIds             []interface{}
+	KubernetesNetworkConfig      struct {
+		IpFamily string
 	}
+	EncryptionConfig []struct {
+		Resources []string
+		Provider  struct {
+			KeyArn interface{}
+		}
+	}
+
+	EnableKeyRotation bool
+	KeyPolicy         struct {
+		Statement []struct {
+			Sid       string
+			Principal map[string]interface{}
+			Action    []string
+		}
+	}
+	AliasName   string
+	TargetKeyId interface{}
+
 	MixedInstancesPolicy *struct {
 		LaunchTemplate struct {
 			LaunchTemplateSpecification struct {
@@ -128,7 +163,10 @@ type LaunchTemplateData struct {
 
 type Template struct {
 	Description string
-	Resources   map[string]struct{ Properties Properties }
+	Resources   map[string]struct {
+		Properties Properties
+		DependsOn  []string
+	}
 }
 
 func kubeconfigBody(authenticator string) string {
@@ -573,6 +611,41 @@ var _ = Describe("CloudFormation template builder API", func() {
 		})
 	})
 
+	Context("NodeGroupTags with labels and taints", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.Name = "ng-abcd1234"
+		ng.Labels = map[string]string{"role": "ci", "example.com/tier": "build"}
+		ng.Taints = map[string]string{"dedicated": "ci:NoSchedule"}
+
+		build(cfg, "eksctl-test-123-cluster", ng)
+
+		roundtrip()
+
+		It("should have node template tags for cluster-autoscaler", func() {
+			ngProps := getNodeGroupProperties(ngTemplate)
+
+			Expect(ngProps.Tags).To(HaveLen(5))
+			Expect(ngProps.Tags[2:]).To(Equal([]Tag{
+				{
+					Key:               "k8s.io/cluster-autoscaler/node-template/label/example.com/tier",
+					Value:             "build",
+					PropagateAtLaunch: "false",
+				},
+				{
+					Key:               "k8s.io/cluster-autoscaler/node-template/label/role",
+					Value:             "ci",
+					PropagateAtLaunch: "false",
+				},
+				{
+					Key:               "k8s.io/cluster-autoscaler/node-template/taint/dedicated",
+					Value:             "ci:NoSchedule",
+					PropagateAtLaunch: "false",
+				},
+			}))
+		})
+	})
+
 	Context("NodeGroup DesiredCapacity=nil MaxSize=nil MinSize=nil", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
@@ -1116,6 +1189,340 @@ var _ = Describe("CloudFormation template builder API", func() {
 		})
 	})
 
+	Context("ClusterWithIPv6", func() {
+		cfg, ng := newClusterConfigAndNodegroup(false)
+
+		cfg.KubernetesNetworkConfig = &api.KubernetesNetworkConfig{IPFamily: api.IPV6Family}
+		cfg.VPC.AutoAllocateIPv6 = api.Enabled()
+
+		setSubnets(cfg)
+
+		build(cfg, "eksctl-test-ipv6-cluster", ng)
+
+		roundtrip()
+
+		It("should create dual-stack subnets", func() {
+			Expect(clusterTemplate.Resources).To(HaveKey("AutoAllocatedCIDRv6"))
+
+			for _, suffix1 := range []string{"PrivateUSWEST2", "PublicUSWEST2"} {
+				for _, suffix2 := range []string{"A", "B", "C"} {
+					suffix := suffix1 + suffix2
+					Expect(clusterTemplate.Resources).ToNot(HaveKey(suffix + "CIDRv6"))
+
+					subnet := clusterTemplate.Resources["Subnet"+suffix]
+					Expect(subnet.DependsOn).To(Equal([]string{"AutoAllocatedCIDRv6"}))
+					Expect(subnet.Properties.AssignIpv6AddressOnCreation).To(BeTrue())
+					Expect(subnet.Properties.Ipv6CidrBlock["Fn::Select"]).To(HaveLen(2))
+					Expect(subnet.Properties.AvailabilityZone).ToNot(BeEmpty())
+					isRefTo(subnet.Properties.VpcId, "VPC")
+				}
+			}
+		})
+
+		It("should route IPv6 traffic to the internet", func() {
+			route := clusterTemplate.Resources["PublicSubnetIPv6DefaultRoute"].Properties
+			Expect(route.DestinationIpv6CidrBlock).To(Equal("::/0"))
+			isRefTo(route.GatewayId, "InternetGateway")
+
+			Expect(clusterTemplate.Resources).To(HaveKey("EgressOnlyInternetGateway"))
+			for _, az := range []string{"A", "B", "C"} {
+				route := clusterTemplate.Resources["PrivateSubnetIPv6DefaultRouteUSWEST2"+az].Properties
+				Expect(route.DestinationIpv6CidrBlock).To(Equal("::/0"))
+				isRefTo(route.EgressOnlyInternetGatewayId, "EgressOnlyInternetGateway")
+				isRefTo(route.RouteTableId, "PrivateRouteTableUSWEST2"+az)
+			}
+		})
+
+		It("should set the IP family of the control plane", func() {
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+			Expect(cp.Name).To(Equal(cfg.Metadata.Name))
+			Expect(cp.KubernetesNetworkConfig.IpFamily).To(Equal("ipv6"))
+			Expect(cp.ResourcesVpcConfig.SubnetIds).To(HaveLen(6))
+		})
+	})
+
+	Context("ClusterWithPodSubnets", func() {
+		cfg, ng := newClusterConfigAndNodegroup(false)
+
+		cfg.VPC.PodSubnets = &api.ClusterPodSubnets{}
+		cfg.VPC.PodSubnets.CIDR, _ = ipnet.ParseCIDR("100.64.0.0/16")
+
+		setSubnets(cfg)
+
+		It("should split the secondary CIDR into pod subnets", func() {
+			Expect(cfg.VPC.PodSubnets.Subnets).To(HaveLen(3))
+			Expect(cfg.VPC.PodSubnets.Subnets["us-west-2a"].CIDR.String()).To(HavePrefix("100.64."))
+		})
+
+		build(cfg, "eksctl-test-pod-subnets-cluster", ng)
+
+		roundtrip()
+
+		It("should associate the secondary CIDR and create pod subnets", func() {
+			Expect(clusterTemplate.Resources).To(HaveKey("PodCIDR"))
+			podCIDR := clusterTemplate.Resources["PodCIDR"].Properties
+			isRefTo(podCIDR.VpcId, "VPC")
+			Expect(podCIDR.CidrBlock).To(Equal("100.64.0.0/16"))
+
+			for _, az := range []string{"A", "B", "C"} {
+				subnet := clusterTemplate.Resources["SubnetPodUSWEST2"+az]
+				Expect(subnet.DependsOn).To(Equal([]string{"PodCIDR"}))
+				Expect(subnet.Properties.CidrBlock).To(HavePrefix("100.64."))
+				Expect(subnet.Properties.Ipv6CidrBlock).To(BeNil())
+				isRefTo(subnet.Properties.VpcId, "VPC")
+
+				association := clusterTemplate.Resources["RouteTableAssociationPodUSWEST2"+az].Properties
+				isRefTo(association.SubnetId, "SubnetPodUSWEST2"+az)
+				isRefTo(association.RouteTableId, "PrivateRouteTableUSWEST2"+az)
+			}
+		})
+
+		It("should not pass pod subnets to the control plane", func() {
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+			Expect(cp.ResourcesVpcConfig.SubnetIds).To(HaveLen(6))
+		})
+	})
+
+	Context("ClusterWithPrivateCluster", func() {
+		cfg, ng := newClusterConfigAndNodegroup(false)
+
+		cfg.PrivateCluster = &api.PrivateCluster{
+			Enabled:                    api.Enabled(),
+			AdditionalEndpointServices: []string{"cloudformation"},
+		}
+		ng.PrivateNetworking = true
+		api.SetClusterConfigDefaults(cfg)
+
+		It("should only create private subnets", func() {
+			Expect(vpc.SetSubnets(cfg)).To(Succeed())
+			Expect(cfg.VPC.Subnets.Private).To(HaveLen(3))
+			Expect(cfg.VPC.Subnets.Public).To(BeEmpty())
+		})
+
+		build(cfg, "eksctl-test-private-cluster", ng)
+
+		roundtrip()
+
+		It("should not have any route to the internet", func() {
+			for _, name := range []string{"InternetGateway", "VPCGatewayAttachment", "PublicRouteTable", "PublicSubnetRoute", "NATGateway", "NATIP"} {
+				Expect(clusterTemplate.Resources).ToNot(HaveKey(name))
+			}
+			for _, az := range []string{"A", "B", "C"} {
+				Expect(clusterTemplate.Resources).ToNot(HaveKey("SubnetPublicUSWEST2" + az))
+				Expect(clusterTemplate.Resources).ToNot(HaveKey("NATPrivateSubnetRouteUSWEST2" + az))
+				Expect(clusterTemplate.Resources).To(HaveKey("PrivateRouteTableUSWEST2" + az))
+			}
+		})
+
+		It("should have a gateway endpoint for S3", func() {
+			endpoint := clusterTemplate.Resources["VPCEndpointS3"].Properties
+			Expect(endpoint.ServiceName).To(Equal("com.amazonaws.us-west-2.s3"))
+			Expect(endpoint.VpcEndpointType).To(Equal("Gateway"))
+			isRefTo(endpoint.VpcId, "VPC")
+			Expect(endpoint.RouteTableIds).To(HaveLen(3))
+			Expect(endpoint.SubnetIds).To(BeEmpty())
+		})
+
+		It("should have interface endpoints for other services", func() {
+			for name, service := range map[string]string{
+				"EC2":            "ec2",
+				"ECRAPI":         "ecr.api",
+				"ECRDKR":         "ecr.dkr",
+				"STS":            "sts",
+				"LOGS":           "logs",
+				"CLOUDFORMATION": "cloudformation",
+			} {
+				endpoint := clusterTemplate.Resources["VPCEndpoint"+name].Properties
+				Expect(endpoint.ServiceName).To(Equal("com.amazonaws.us-west-2." + service))
+				Expect(endpoint.VpcEndpointType).To(Equal("Interface"))
+				Expect(endpoint.PrivateDnsEnabled).To(BeTrue())
+				Expect(endpoint.SubnetIds).To(HaveLen(3))
+				Expect(endpoint.SecurityGroupIds).To(HaveLen(1))
+				isRefTo(endpoint.SecurityGroupIds[0], "VPCEndpointSecurityGroup")
+			}
+
+			ingress := clusterTemplate.Resources["IngressVPCEndpoints"].Properties
+			Expect(ingress.CidrIp).To(Equal("192.168.0.0/16"))
+			Expect(ingress.FromPort).To(Equal(443))
+		})
+
+		It("should only enable private access to the API endpoint", func() {
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+			Expect(cp.ResourcesVpcConfig.EndpointPrivateAccess).To(BeTrue())
+			Expect(cp.ResourcesVpcConfig.EndpointPublicAccess).To(BeFalse())
+			Expect(cp.ResourcesVpcConfig.SubnetIds).To(HaveLen(3))
+			Expect(cp.ResourcesVpcConfig.SecurityGroupIds).To(HaveLen(1))
+		})
+	})
+
+	Context("ClusterWithSecretsEncryptionKey", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.SecretsEncryption = &api.SecretsEncryption{
+			CreateKey: api.Enabled(),
+		}
+
+		build(cfg, "eksctl-test-secrets-encryption-key", ng)
+
+		roundtrip()
+
+		It("should create a KMS key with an alias", func() {
+			key := clusterTemplate.Resources["SecretsEncryptionKey"].Properties
+			Expect(key.EnableKeyRotation).To(BeTrue())
+			Expect(key.KeyPolicy.Statement).To(HaveLen(2))
+			Expect(key.KeyPolicy.Statement[0].Action).To(Equal([]string{"kms:*"}))
+			Expect(key.KeyPolicy.Statement[1].Sid).To(Equal("AllowClusterRole"))
+			isFnGetAttOf(key.KeyPolicy.Statement[1].Principal["AWS"], "ServiceRole.Arn")
+
+			alias := clusterTemplate.Resources["SecretsEncryptionKeyAlias"].Properties
+			Expect(alias.AliasName).To(Equal("alias/eksctl/" + clusterName))
+			isRefTo(alias.TargetKeyId, "SecretsEncryptionKey")
+		})
+
+		It("should encrypt secrets with the key", func() {
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+			Expect(cp.EncryptionConfig).To(HaveLen(1))
+			Expect(cp.EncryptionConfig[0].Resources).To(Equal([]string{"secrets"}))
+			isFnGetAttOf(cp.EncryptionConfig[0].Provider.KeyArn, "SecretsEncryptionKey.Arn")
+			Expect(crs.Template().Outputs).To(HaveKey(outputs.ClusterSecretsEncryptionKeyARN))
+		})
+	})
+
+	Context("ClusterWithSecretsEncryptionKeyARN", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		keyARN := "arn:aws:kms:us-west-2:123456789012:key/11111111-2222-3333-4444-555555555555"
+		cfg.SecretsEncryption = &api.SecretsEncryption{
+			KeyARN: keyARN,
+		}
+
+		build(cfg, "eksctl-test-secrets-encryption-key-arn", ng)
+
+		roundtrip()
+
+		It("should encrypt secrets with the given key", func() {
+			Expect(clusterTemplate.Resources).ToNot(HaveKey("SecretsEncryptionKey"))
+			cp := clusterTemplate.Resources["ControlPlane"].Properties
+			Expect(cp.EncryptionConfig).To(HaveLen(1))
+			Expect(cp.EncryptionConfig[0].Provider.KeyArn).To(Equal(keyARN))
+		})
+	})
+
+	Context("ClusterWithSecurityGroupRules", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.VPC.SecurityGroup = ""
+		cfg.VPC.SharedNodeSecurityGroup = ""
+		cfg.VPC.ControlPlaneSecurityGroupRules = &api.SecurityGroupRules{
+			Ingress: []api.SecurityGroupRule{
+				{Description: "corporate network", FromPort: aws.Int(443), CIDR: ipnet.MustParseCIDR("10.100.0.0/16")},
+				{FromPort: aws.Int(443), CIDR: ipnet.MustParseCIDR("2001:db8::/32")},
+			},
+		}
+		cfg.VPC.SharedNodeSecurityGroupRules = &api.SecurityGroupRules{
+			Ingress: []api.SecurityGroupRule{
+				{Protocol: "udp", FromPort: aws.Int(8472), SecurityGroupID: "sg-cni"},
+			},
+			Egress: []api.SecurityGroupRule{
+				{Protocol: "-1", CIDR: ipnet.MustParseCIDR("172.16.0.0/12")},
+			},
+		}
+		api.SetClusterConfigDefaults(cfg)
+
+		build(cfg, "eksctl-test-sg-rules-cluster", ng)
+
+		roundtrip()
+
+		It("should add ingress rules to the control plane security group", func() {
+			rule := clusterTemplate.Resources["IngressControlPlaneRule0"].Properties
+			isRefTo(rule.GroupId, "ControlPlaneSecurityGroup")
+			Expect(rule.Description).To(Equal("corporate network"))
+			Expect(rule.IpProtocol).To(Equal("tcp"))
+			Expect(rule.FromPort).To(Equal(443))
+			Expect(rule.ToPort).To(Equal(443))
+			Expect(rule.CidrIp).To(Equal("10.100.0.0/16"))
+
+			rule = clusterTemplate.Resources["IngressControlPlaneRule1"].Properties
+			Expect(rule.CidrIp).To(BeEmpty())
+			Expect(rule.CidrIpv6).To(Equal("2001:db8::/32"))
+		})
+
+		It("should add rules to the shared node security group", func() {
+			rule := clusterTemplate.Resources["IngressSharedNodeRule0"].Properties
+			isRefTo(rule.GroupId, "ClusterSharedNodeSecurityGroup")
+			Expect(rule.IpProtocol).To(Equal("udp"))
+			Expect(rule.FromPort).To(Equal(8472))
+			Expect(rule.SourceSecurityGroupId).To(Equal("sg-cni"))
+
+			rule = clusterTemplate.Resources["EgressSharedNodeRule0"].Properties
+			isRefTo(rule.GroupId, "ClusterSharedNodeSecurityGroup")
+			Expect(rule.IpProtocol).To(Equal("-1"))
+			Expect(rule.CidrIp).To(Equal("172.16.0.0/12"))
+		})
+	})
+
+	Context("ClusterWithFargateProfiles", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		cfg.FargateProfiles = []*api.FargateProfile{
+			{Name: "default", Selectors: []api.FargateProfileSelector{{Namespace: "default"}}},
+		}
+
+		build(cfg, "eksctl-test-fargate-cluster", ng)
+
+		roundtrip()
+
+		It("should have a pod execution role", func() {
+			Expect(clusterTemplate.Resources).To(HaveKey("FargatePodExecutionRole"))
+
+			role := clusterTemplate.Resources["FargatePodExecutionRole"].Properties
+
+			Expect(role.ManagedPolicyArns).To(Equal([]interface{}{"arn:aws:iam::aws:policy/AmazonEKSFargatePodExecutionRolePolicy"}))
+			checkARPD("eks-fargate-pods.amazonaws.com", role.AssumeRolePolicyDocument)
+		})
+
+		It("should use an existing pod execution role", func() {
+			cfg.IAM.FargatePodExecutionRoleARN = aws.String("arn:aws:iam::123:role/fargate")
+			defer func() { cfg.IAM.FargatePodExecutionRoleARN = nil }()
+
+			crs := NewClusterResourceSet(p, cfg)
+			Expect(crs.AddAllResources()).To(Succeed())
+			Expect(crs.Template().Resources).ToNot(HaveKey("FargatePodExecutionRole"))
+			Expect(crs.Template().Outputs).To(HaveKey("FargatePodExecutionRoleARN"))
+		})
+	})
+
+	Context("NodeGroup{SSH.EnableSSM=true}", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.SSH.Allow = api.Enabled()
+		ng.SSH.EnableSSM = api.Enabled()
+		ng.PrivateNetworking = false
+
+		build(cfg, "eksctl-test-ssm-cluster", ng)
+
+		roundtrip()
+
+		extractCloudConfig()
+
+		It("should attach the SSM policy", func() {
+			role := ngTemplate.Resources["NodeInstanceRole"].Properties
+
+			Expect(role.ManagedPolicyArns).To(HaveLen(4))
+			Expect(role.ManagedPolicyArns[3]).To(Equal("arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore"))
+		})
+
+		It("should not allow SSH access in the security group", func() {
+			Expect(ngTemplate.Resources).ToNot(HaveKey("SSHIPv4"))
+			Expect(ngTemplate.Resources).ToNot(HaveKey("SSHIPv6"))
+		})
+
+		It("should install SSM agent before bootstrapping", func() {
+			Expect(cc.Commands).To(HaveLen(2))
+			Expect(cc.Commands[0].([]interface{})[2]).To(ContainSubstring("yum install -y amazon-ssm-agent"))
+		})
+	})
+
 	Context("NodeGroupEBS", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
@@ -1744,6 +2151,29 @@ var _ = Describe("CloudFormation template builder API", func() {
 		})
 	})
 
+	Context("UserData - AmazonLinux2 (custom pre-bootstrap and post-bootstrap)", func() {
+		cfg, ng := newClusterConfigAndNodegroup(true)
+
+		ng.PreBootstrapCommands = []string{"touch /tmp/test"}
+		ng.PostBootstrapCommands = []string{"rm /tmp/test", "systemctl start custom-agent"}
+
+		build(cfg, "eksctl-test-123-cluster", ng)
+
+		roundtrip()
+
+		extractCloudConfig()
+
+		It("should run post-bootstrap commands after the bootstrap script", func() {
+			checkScript(cc, "/var/lib/cloud/scripts/per-instance/bootstrap.al2.sh", true)
+
+			Expect(cc.Commands).To(HaveLen(4))
+			Expect(cc.Commands[0].([]interface{})).To(Equal([]interface{}{"/bin/bash", "-c", "touch /tmp/test"}))
+			Expect(cc.Commands[1].([]interface{})).To(Equal([]interface{}{"/var/lib/cloud/scripts/per-instance/bootstrap.al2.sh"}))
+			Expect(cc.Commands[2].([]interface{})).To(Equal([]interface{}{"/bin/bash", "-c", "rm /tmp/test"}))
+			Expect(cc.Commands[3].([]interface{})).To(Equal([]interface{}{"/bin/bash", "-c", "systemctl start custom-agent"}))
+		})
+	})
+
 	Context("UserData - AmazonLinux2 (custom bootstrap)", func() {
 		cfg, ng := newClusterConfigAndNodegroup(true)
 
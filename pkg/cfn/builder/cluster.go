@@ -3,6 +3,7 @@ package builder
 import (
 	"encoding/base64"
 	"fmt"
+	"strings"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	gfn "github.com/awslabs/goformation/cloudformation"
@@ -10,6 +11,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
 )
 
 // ClusterResourceSet stores the resource information of the cluster
@@ -19,6 +21,7 @@ type ClusterResourceSet struct {
 	provider       api.ClusterProvider
 	vpc            *gfn.Value
 	subnets        map[api.SubnetTopology][]*gfn.Value
+	podSubnets     []*gfn.Value
 	securityGroups []*gfn.Value
 }
 
@@ -50,6 +53,8 @@ func (c *ClusterResourceSet) AddAllResources() error {
 
 	c.addResourcesForSecurityGroups()
 	c.addResourcesForIAM()
+	c.addResourcesForFargate()
+	c.addResourcesForSecretsEncryption()
 	c.addResourcesForControlPlane()
 
 	c.rs.defineOutput(outputs.ClusterStackName, gfn.RefStackName, false, func(v string) error {
@@ -66,6 +71,42 @@ func (c *ClusterResourceSet) AddAllResources() error {
 		dedicatedVPC, c.rs.withIAM,
 		templateDescriptionSuffix)
 
+	return c.rs.addExtraResources(fmt.Sprintf("cluster %q", c.spec.Metadata.Name), c.spec.CloudFormation)
+}
+
+// AddResourcesForRegistration adds the outputs of an existing cluster that wasn't created
+// by eksctl, so that nodegroup and other stacks can import them as with any other cluster;
+// the control plane itself is not part of the stack, spec.VPC and spec.Status must be set
+func (c *ClusterResourceSet) AddResourcesForRegistration() error {
+	if c.spec.Status == nil || c.spec.Status.Endpoint == "" {
+		return fmt.Errorf("status of cluster %q must be loaded before it can be registered", c.spec.Metadata.Name)
+	}
+
+	c.importResourcesForVPC()
+	c.addOutputsForVPC()
+
+	// a stack must have at least one resource
+	c.newResource("ClusterRegistration", &awsCloudFormationResource{
+		Type:       "AWS::CloudFormation::WaitConditionHandle",
+		Properties: map[string]interface{}{},
+	})
+
+	c.rs.defineOutputWithoutCollector(outputs.ClusterSecurityGroup, c.spec.VPC.SecurityGroup, true)
+	c.rs.defineOutputWithoutCollector(outputs.ClusterSharedNodeSecurityGroup, c.spec.VPC.SharedNodeSecurityGroup, true)
+	c.rs.defineOutputWithoutCollector(outputs.ClusterCertificateAuthorityData, base64.StdEncoding.EncodeToString(c.spec.Status.CertificateAuthorityData), false)
+	c.rs.defineOutputWithoutCollector(outputs.ClusterEndpoint, c.spec.Status.Endpoint, true)
+	c.rs.defineOutputWithoutCollector(outputs.ClusterARN, c.spec.Status.ARN, true)
+	c.rs.defineOutputWithoutCollector(outputs.ClusterFeatureRegistered, true, false)
+	if api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRoleARN) {
+		c.rs.defineOutputWithoutCollector(outputs.ClusterServiceRoleARN, c.spec.IAM.ServiceRoleARN, true)
+	}
+	c.rs.defineOutput(outputs.ClusterStackName, gfn.RefStackName, false, func(v string) error {
+		c.spec.Status.StackName = v
+		return nil
+	})
+
+	c.rs.template.Description = fmt.Sprintf("%s %s", registrationTemplateDescription, templateDescriptionSuffix)
+
 	return nil
 }
 
@@ -83,6 +124,13 @@ func (c *ClusterResourceSet) newResource(name string, resource interface{}) *gfn
 	return c.rs.newResource(name, resource)
 }
 
+func (c *ClusterResourceSet) serviceRoleARN() *gfn.Value {
+	if api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRoleARN) {
+		return gfn.NewString(*c.spec.IAM.ServiceRoleARN)
+	}
+	return gfn.MakeFnGetAttString("ServiceRole.Arn")
+}
+
 func (c *ClusterResourceSet) addResourcesForControlPlane() {
 	clusterVPC := &gfn.AWSEKSCluster_ResourcesVpcConfig{
 		SecurityGroupIds: c.securityGroups,
@@ -91,17 +139,58 @@ func (c *ClusterResourceSet) addResourcesForControlPlane() {
 		clusterVPC.SubnetIds = append(clusterVPC.SubnetIds, c.subnets[topology]...)
 	}
 
-	serviceRoleARN := gfn.MakeFnGetAttString("ServiceRole.Arn")
-	if api.IsSetAndNonEmptyString(c.spec.IAM.ServiceRoleARN) {
-		serviceRoleARN = gfn.NewString(*c.spec.IAM.ServiceRoleARN)
-	}
-
-	c.newResource("ControlPlane", &gfn.AWSEKSCluster{
+	cluster := &gfn.AWSEKSCluster{
 		Name:               gfn.NewString(c.spec.Metadata.Name),
-		RoleArn:            serviceRoleARN,
+		RoleArn:            c.serviceRoleARN(),
 		Version:            gfn.NewString(c.spec.Metadata.Version),
 		ResourcesVpcConfig: clusterVPC,
-	})
+	}
+	if c.spec.IPv6Enabled() || c.spec.IsPrivateCluster() || c.spec.HasSecretsEncryption() || c.spec.IsLocalCluster() {
+		// goformation doesn't know KubernetesNetworkConfig, EncryptionConfig, OutpostConfig and endpoint access yet
+		properties := map[string]interface{}{
+			"Name":               cluster.Name,
+			"RoleArn":            cluster.RoleArn,
+			"Version":            cluster.Version,
+			"ResourcesVpcConfig": cluster.ResourcesVpcConfig,
+		}
+		if c.spec.IPv6Enabled() {
+			properties["KubernetesNetworkConfig"] = map[string]string{
+				"IpFamily": strings.ToLower(api.IPV6Family),
+			}
+		}
+		if c.spec.IsLocalCluster() {
+			properties["OutpostConfig"] = map[string]interface{}{
+				"OutpostArns":              []string{c.spec.Outpost.ControlPlaneOutpostARN},
+				"ControlPlaneInstanceType": c.spec.Outpost.ControlPlaneInstanceType,
+			}
+		}
+		if c.spec.IsPrivateCluster() || c.spec.IsLocalCluster() {
+			// the API endpoint of private and local clusters is only accessible from within the VPC,
+			// it's never public, not even while eksctl sets the cluster up
+			properties["ResourcesVpcConfig"] = map[string]interface{}{
+				"SecurityGroupIds":      clusterVPC.SecurityGroupIds,
+				"SubnetIds":             clusterVPC.SubnetIds,
+				"EndpointPrivateAccess": true,
+				"EndpointPublicAccess":  false,
+			}
+		}
+		if c.spec.HasSecretsEncryption() {
+			properties["EncryptionConfig"] = []interface{}{
+				map[string]interface{}{
+					"Resources": []string{"secrets"},
+					"Provider": map[string]interface{}{
+						"KeyArn": c.secretsEncryptionKeyARN(),
+					},
+				},
+			}
+		}
+		c.newResource("ControlPlane", &awsCloudFormationResource{
+			Type:       cluster.AWSCloudFormationType(),
+			Properties: properties,
+		})
+	} else {
+		c.newResource("ControlPlane", cluster)
+	}
 
 	if c.spec.Status == nil {
 		c.spec.Status = &api.ClusterStatus{}
@@ -125,6 +214,62 @@ func (c *ClusterResourceSet) addResourcesForControlPlane() {
 	})
 }
 
+// addResourcesForSecretsEncryption adds a KMS key with an alias for the encryption of Kubernetes
+// secrets; the account keeps full access, so that IAM policies can grant access to admins, and
+// the cluster role may use the key
+func (c *ClusterResourceSet) addResourcesForSecretsEncryption() {
+	if !c.spec.CreatesSecretsEncryptionKey() {
+		return
+	}
+
+	refKey := c.newResource("SecretsEncryptionKey", &gfn.AWSKMSKey{
+		Description:       gfn.NewString(fmt.Sprintf("Encryption of Kubernetes secrets of EKS cluster %q", c.spec.Metadata.Name)),
+		EnableKeyRotation: gfn.True(),
+		KeyPolicy: cft.MakePolicyDocument(
+			cft.MapOfInterfaces{
+				"Sid":    "AllowAccount",
+				"Effect": "Allow",
+				"Principal": map[string]interface{}{
+					"AWS": gfn.MakeFnSubString(fmt.Sprintf("arn:${%s}:iam::${%s}:root", gfn.Partition, gfn.AccountID)),
+				},
+				"Action":   []string{"kms:*"},
+				"Resource": "*",
+			},
+			cft.MapOfInterfaces{
+				"Sid":    "AllowClusterRole",
+				"Effect": "Allow",
+				"Principal": map[string]interface{}{
+					"AWS": c.serviceRoleARN(),
+				},
+				"Action": []string{
+					"kms:Encrypt",
+					"kms:Decrypt",
+					"kms:ReEncrypt*",
+					"kms:GenerateDataKey*",
+					"kms:DescribeKey",
+					"kms:CreateGrant",
+				},
+				"Resource": "*",
+			},
+		),
+	})
+	c.newResource("SecretsEncryptionKeyAlias", &gfn.AWSKMSAlias{
+		AliasName:   gfn.NewString(c.spec.SecretsEncryptionKeyAlias()),
+		TargetKeyId: refKey,
+	})
+	c.rs.defineOutputFromAtt(outputs.ClusterSecretsEncryptionKeyARN, "SecretsEncryptionKey.Arn", false, func(v string) error {
+		c.spec.SecretsEncryption.KeyARN = v
+		return nil
+	})
+}
+
+func (c *ClusterResourceSet) secretsEncryptionKeyARN() *gfn.Value {
+	if c.spec.CreatesSecretsEncryptionKey() {
+		return gfn.MakeFnGetAttString("SecretsEncryptionKey.Arn")
+	}
+	return gfn.NewString(c.spec.SecretsEncryption.KeyARN)
+}
+
 // GetAllOutputs collects all outputs of the cluster
 func (c *ClusterResourceSet) GetAllOutputs(stack cfn.Stack) error {
 	return c.rs.GetAllOutputs(stack)
@@ -0,0 +1,79 @@
+package builder_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("extra CloudFormation resources", func() {
+	var (
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		caCertData, err := base64.StdEncoding.DecodeString(caCert)
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Status = &api.ClusterStatus{
+			CertificateAuthorityData: caCertData,
+			Endpoint:                 endpoint,
+		}
+
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-extra"
+		ng.InstanceType = "m5.large"
+		ng.AMI = "ami-eksctl"
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+	})
+
+	It("adds the extra resources of a nodegroup to its template", func() {
+		ng.CloudFormation = &api.CloudFormation{
+			ExtraResources: map[string]api.InlineDocument{
+				"NodeGroupName": {
+					"Type": "AWS::SSM::Parameter",
+					"Properties": map[string]interface{}{
+						"Type":  "String",
+						"Value": map[string]interface{}{"Ref": "NodeGroup"},
+					},
+				},
+			},
+		}
+
+		ngrs := NewNodeGroupResourceSet(mockprovider.NewMockProvider(), cfg, "eksctl-test-cluster", ng)
+		Expect(ngrs.AddAllResources()).To(Succeed())
+
+		templateBody, err := ngrs.RenderJSON()
+		Expect(err).ToNot(HaveOccurred())
+		template := struct {
+			Resources map[string]json.RawMessage
+		}{}
+		Expect(json.Unmarshal(templateBody, &template)).To(Succeed())
+		Expect(template.Resources).To(HaveKey("NodeGroup"))
+		Expect(template.Resources["NodeGroupName"]).To(MatchJSON(`{
+			"Type": "AWS::SSM::Parameter",
+			"Properties": {"Type": "String", "Value": {"Ref": "NodeGroup"}}
+		}`))
+	})
+
+	It("fails when an extra resource has the logical ID of a generated resource", func() {
+		ng.CloudFormation = &api.CloudFormation{
+			ExtraResources: map[string]api.InlineDocument{
+				"NodeGroup": {"Type": "AWS::SSM::Parameter"},
+			},
+		}
+
+		ngrs := NewNodeGroupResourceSet(mockprovider.NewMockProvider(), cfg, "eksctl-test-cluster", ng)
+		Expect(ngrs.AddAllResources()).To(MatchError(`extra resource "NodeGroup" of nodegroup "ng-extra" has the same logical ID as a resource generated by eksctl`))
+	})
+})
@@ -13,23 +13,107 @@ import (
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 )
 
+// names of the AWS managed policies that are attached to the roles that eksctl creates
 const (
-	iamPolicyAmazonEKSServicePolicyARN = "arn:aws:iam::aws:policy/AmazonEKSServicePolicy"
-	iamPolicyAmazonEKSClusterPolicyARN = "arn:aws:iam::aws:policy/AmazonEKSClusterPolicy"
-
-	iamPolicyAmazonEKSWorkerNodePolicyARN           = "arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy"
-	iamPolicyAmazonEKSCNIPolicyARN                  = "arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy"
-	iamPolicyAmazonEC2ContainerRegistryPowerUserARN = "arn:aws:iam::aws:policy/AmazonEC2ContainerRegistryPowerUser"
-	iamPolicyAmazonEC2ContainerRegistryReadOnlyARN  = "arn:aws:iam::aws:policy/AmazonEC2ContainerRegistryReadOnly"
-	iamPolicyCloudWatchAgentServerPolicyARN         = "arn:aws:iam::aws:policy/CloudWatchAgentServerPolicy"
+	iamPolicyAmazonEKSServicePolicy = "AmazonEKSServicePolicy"
+	iamPolicyAmazonEKSClusterPolicy = "AmazonEKSClusterPolicy"
+
+	iamPolicyAmazonEKSFargatePodExecutionRolePolicy = "AmazonEKSFargatePodExecutionRolePolicy"
+
+	iamPolicyAmazonEKSWorkerNodePolicy           = "AmazonEKSWorkerNodePolicy"
+	iamPolicyAmazonEKSCNIPolicy                  = "AmazonEKS_CNI_Policy"
+	iamPolicyAmazonEC2ContainerRegistryPowerUser = "AmazonEC2ContainerRegistryPowerUser"
+	iamPolicyAmazonEC2ContainerRegistryReadOnly  = "AmazonEC2ContainerRegistryReadOnly"
+	iamPolicyCloudWatchAgentServerPolicy         = "CloudWatchAgentServerPolicy"
+	iamPolicyAmazonSSMManagedInstanceCore        = "AmazonSSMManagedInstanceCore"
 )
 
-var (
-	iamDefaultNodePolicyARNs = []string{
-		iamPolicyAmazonEKSWorkerNodePolicyARN,
-		iamPolicyAmazonEKSCNIPolicyARN,
+// awsManagedPolicyARN returns the ARN of an AWS managed policy in the partition of region
+func awsManagedPolicyARN(region, policy string) string {
+	return fmt.Sprintf("arn:%s:iam::aws:policy/%s", api.Partition(region), policy)
+}
+
+// defaultNodePolicyARNs are the policies that are attached to the roles of nodes unless attachPolicyARNs is set
+func defaultNodePolicyARNs(region string) []string {
+	return []string{
+		awsManagedPolicyARN(region, iamPolicyAmazonEKSWorkerNodePolicy),
+		awsManagedPolicyARN(region, iamPolicyAmazonEKSCNIPolicy),
 	}
-)
+}
+
+// ec2ServicePrincipal returns the service principal of EC2 in the partition of region, e.g. it's
+// ec2.amazonaws.com.cn in China
+func ec2ServicePrincipal(region string) string {
+	return "ec2." + api.PartitionDNSSuffix(region)
+}
+
+// ALBIngressActions are the actions that alb-ingress-controller needs to be allowed
+var ALBIngressActions = []string{
+	"acm:DescribeCertificate",
+	"acm:ListCertificates",
+	"acm:GetCertificate",
+	"ec2:AuthorizeSecurityGroupIngress",
+	"ec2:CreateSecurityGroup",
+	"ec2:CreateTags",
+	"ec2:DeleteTags",
+	"ec2:DeleteSecurityGroup",
+	"ec2:DescribeAccountAttributes",
+	"ec2:DescribeAddresses",
+	"ec2:DescribeInstances",
+	"ec2:DescribeInstanceStatus",
+	"ec2:DescribeInternetGateways",
+	"ec2:DescribeNetworkInterfaces",
+	"ec2:DescribeSecurityGroups",
+	"ec2:DescribeSubnets",
+	"ec2:DescribeTags",
+	"ec2:DescribeVpcs",
+	"ec2:ModifyInstanceAttribute",
+	"ec2:ModifyNetworkInterfaceAttribute",
+	"ec2:RevokeSecurityGroupIngress",
+	"elasticloadbalancing:AddListenerCertificates",
+	"elasticloadbalancing:AddTags",
+	"elasticloadbalancing:CreateListener",
+	"elasticloadbalancing:CreateLoadBalancer",
+	"elasticloadbalancing:CreateRule",
+	"elasticloadbalancing:CreateTargetGroup",
+	"elasticloadbalancing:DeleteListener",
+	"elasticloadbalancing:DeleteLoadBalancer",
+	"elasticloadbalancing:DeleteRule",
+	"elasticloadbalancing:DeleteTargetGroup",
+	"elasticloadbalancing:DeregisterTargets",
+	"elasticloadbalancing:DescribeListenerCertificates",
+	"elasticloadbalancing:DescribeListeners",
+	"elasticloadbalancing:DescribeLoadBalancers",
+	"elasticloadbalancing:DescribeLoadBalancerAttributes",
+	"elasticloadbalancing:DescribeRules",
+	"elasticloadbalancing:DescribeSSLPolicies",
+	"elasticloadbalancing:DescribeTags",
+	"elasticloadbalancing:DescribeTargetGroups",
+	"elasticloadbalancing:DescribeTargetGroupAttributes",
+	"elasticloadbalancing:DescribeTargetHealth",
+	"elasticloadbalancing:ModifyListener",
+	"elasticloadbalancing:ModifyLoadBalancerAttributes",
+	"elasticloadbalancing:ModifyRule",
+	"elasticloadbalancing:ModifyTargetGroup",
+	"elasticloadbalancing:ModifyTargetGroupAttributes",
+	"elasticloadbalancing:RegisterTargets",
+	"elasticloadbalancing:RemoveListenerCertificates",
+	"elasticloadbalancing:RemoveTags",
+	"elasticloadbalancing:SetIpAddressType",
+	"elasticloadbalancing:SetSecurityGroups",
+	"elasticloadbalancing:SetSubnets",
+	"elasticloadbalancing:SetWebACL",
+	"iam:CreateServiceLinkedRole",
+	"iam:GetServerCertificate",
+	"iam:ListServerCertificates",
+	"waf-regional:GetWebACLForResource",
+	"waf-regional:GetWebACL",
+	"waf-regional:AssociateWebACL",
+	"waf-regional:DisassociateWebACL",
+	"tag:GetResources",
+	"tag:TagResources",
+	"waf:GetWebACL",
+}
 
 func (c *resourceSet) attachAllowPolicy(name string, refRole *gfn.Value, resources interface{}, actions []string) {
 	c.newResource(name, &gfn.AWSIAMPolicy{
@@ -53,6 +137,14 @@ func (c *ClusterResourceSet) WithNamedIAM() bool {
 	return c.rs.withNamedIAM
 }
 
+// makePermissionsBoundary returns the permissions boundary of a role, if one is set
+func makePermissionsBoundary(boundary *string) *gfn.Value {
+	if !api.IsSetAndNonEmptyString(boundary) {
+		return nil
+	}
+	return gfn.NewString(*boundary)
+}
+
 func (c *ClusterResourceSet) addResourcesForIAM() {
 	c.rs.withNamedIAM = false
 
@@ -67,9 +159,10 @@ func (c *ClusterResourceSet) addResourcesForIAM() {
 	refSR := c.newResource("ServiceRole", &gfn.AWSIAMRole{
 		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices("eks.amazonaws.com"),
 		ManagedPolicyArns: makeStringSlice(
-			iamPolicyAmazonEKSServicePolicyARN,
-			iamPolicyAmazonEKSClusterPolicyARN,
+			awsManagedPolicyARN(c.spec.Metadata.Region, iamPolicyAmazonEKSServicePolicy),
+			awsManagedPolicyARN(c.spec.Metadata.Region, iamPolicyAmazonEKSClusterPolicy),
 		),
+		PermissionsBoundary: makePermissionsBoundary(c.spec.IAM.ServiceRolePermissionsBoundary),
 	})
 	c.rs.attachAllowPolicy("PolicyNLB", refSR, "*", []string{
 		"elasticloadbalancing:*",
@@ -85,6 +178,31 @@ func (c *ClusterResourceSet) addResourcesForIAM() {
 	})
 }
 
+func (c *ClusterResourceSet) addResourcesForFargate() {
+	if !c.spec.HasFargateProfiles() {
+		return
+	}
+
+	if api.IsSetAndNonEmptyString(c.spec.IAM.FargatePodExecutionRoleARN) {
+		c.rs.defineOutputWithoutCollector(outputs.ClusterFargatePodExecutionRoleARN, c.spec.IAM.FargatePodExecutionRoleARN, true)
+		return
+	}
+
+	c.rs.withIAM = true
+
+	c.newResource("FargatePodExecutionRole", &gfn.AWSIAMRole{
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices("eks-fargate-pods.amazonaws.com"),
+		ManagedPolicyArns: makeStringSlice(
+			awsManagedPolicyARN(c.spec.Metadata.Region, iamPolicyAmazonEKSFargatePodExecutionRolePolicy),
+		),
+		PermissionsBoundary: makePermissionsBoundary(c.spec.IAM.ServiceRolePermissionsBoundary),
+	})
+	c.rs.defineOutputFromAtt(outputs.ClusterFargatePodExecutionRoleARN, "FargatePodExecutionRole.Arn", true, func(v string) error {
+		c.spec.IAM.FargatePodExecutionRoleARN = &v
+		return nil
+	})
+}
+
 // WithIAM states, if IAM roles will be created or not
 func (n *NodeGroupResourceSet) WithIAM() bool {
 	return n.rs.withIAM
@@ -144,22 +262,28 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() {
 		n.rs.withNamedIAM = true
 	}
 
+	region := n.clusterSpec.Metadata.Region
+	partition := api.Partition(region)
 	if len(n.spec.IAM.AttachPolicyARNs) == 0 {
-		n.spec.IAM.AttachPolicyARNs = iamDefaultNodePolicyARNs
+		n.spec.IAM.AttachPolicyARNs = defaultNodePolicyARNs(region)
 	}
 	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.ImageBuilder) {
-		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, iamPolicyAmazonEC2ContainerRegistryPowerUserARN)
+		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, awsManagedPolicyARN(region, iamPolicyAmazonEC2ContainerRegistryPowerUser))
 	} else {
-		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, iamPolicyAmazonEC2ContainerRegistryReadOnlyARN)
+		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, awsManagedPolicyARN(region, iamPolicyAmazonEC2ContainerRegistryReadOnly))
 	}
 
 	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.CloudWatch) {
-		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, iamPolicyCloudWatchAgentServerPolicyARN)
+		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, awsManagedPolicyARN(region, iamPolicyCloudWatchAgentServerPolicy))
+	}
+
+	if n.spec.SSH != nil && api.IsEnabled(n.spec.SSH.EnableSSM) {
+		n.spec.IAM.AttachPolicyARNs = append(n.spec.IAM.AttachPolicyARNs, awsManagedPolicyARN(region, iamPolicyAmazonSSMManagedInstanceCore))
 	}
 
 	role := gfn.AWSIAMRole{
 		Path: gfn.NewString("/"),
-		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices("ec2.amazonaws.com"),
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(ec2ServicePrincipal(region)),
 		ManagedPolicyArns:        makeStringSlice(n.spec.IAM.AttachPolicyARNs...),
 	}
 
@@ -167,6 +291,10 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() {
 		role.RoleName = gfn.NewString(n.spec.IAM.InstanceRoleName)
 	}
 
+	if n.spec.IAM.InstanceRolePermissionsBoundary != "" {
+		role.PermissionsBoundary = gfn.NewString(n.spec.IAM.InstanceRolePermissionsBoundary)
+	}
+
 	refIR := n.newResource("NodeInstanceRole", &role)
 
 	n.newResource("NodeInstanceProfile", &gfn.AWSIAMInstanceProfile{
@@ -190,7 +318,7 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() {
 	}
 
 	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.CertManager) {
-		n.rs.attachAllowPolicy("PolicyCertManagerChangeSet", refIR, "arn:aws:route53:::hostedzone/*",
+		n.rs.attachAllowPolicy("PolicyCertManagerChangeSet", refIR, fmt.Sprintf("arn:%s:route53:::hostedzone/*", partition),
 			[]string{
 				"route53:ChangeResourceRecordSets",
 			},
@@ -202,13 +330,13 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() {
 				"route53:ListHostedZonesByName",
 			},
 		)
-		n.rs.attachAllowPolicy("PolicyCertManagerGetChange", refIR, "arn:aws:route53:::change/*",
+		n.rs.attachAllowPolicy("PolicyCertManagerGetChange", refIR, fmt.Sprintf("arn:%s:route53:::change/*", partition),
 			[]string{
 				"route53:GetChange",
 			},
 		)
 	} else if api.IsEnabled(n.spec.IAM.WithAddonPolicies.ExternalDNS) {
-		n.rs.attachAllowPolicy("PolicyExternalDNSChangeSet", refIR, "arn:aws:route53:::hostedzone/*",
+		n.rs.attachAllowPolicy("PolicyExternalDNSChangeSet", refIR, fmt.Sprintf("arn:%s:route53:::hostedzone/*", partition),
 			[]string{
 				"route53:ChangeResourceRecordSets",
 			},
@@ -254,7 +382,7 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() {
 				"fsx:*",
 			},
 		)
-		n.rs.attachAllowPolicy("PolicyServiceLinkRole", refIR, "arn:aws:iam::*:role/aws-service-role/*",
+		n.rs.attachAllowPolicy("PolicyServiceLinkRole", refIR, fmt.Sprintf("arn:%s:iam::*:role/aws-service-role/*", partition),
 			[]string{
 				"iam:CreateServiceLinkedRole",
 				"iam:AttachRolePolicy",
@@ -282,74 +410,7 @@ func (n *NodeGroupResourceSet) addResourcesForIAM() {
 	}
 
 	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.ALBIngress) {
-		n.rs.attachAllowPolicy("PolicyALBIngress", refIR, "*",
-			[]string{
-				"acm:DescribeCertificate",
-				"acm:ListCertificates",
-				"acm:GetCertificate",
-				"ec2:AuthorizeSecurityGroupIngress",
-				"ec2:CreateSecurityGroup",
-				"ec2:CreateTags",
-				"ec2:DeleteTags",
-				"ec2:DeleteSecurityGroup",
-				"ec2:DescribeAccountAttributes",
-				"ec2:DescribeAddresses",
-				"ec2:DescribeInstances",
-				"ec2:DescribeInstanceStatus",
-				"ec2:DescribeInternetGateways",
-				"ec2:DescribeNetworkInterfaces",
-				"ec2:DescribeSecurityGroups",
-				"ec2:DescribeSubnets",
-				"ec2:DescribeTags",
-				"ec2:DescribeVpcs",
-				"ec2:ModifyInstanceAttribute",
-				"ec2:ModifyNetworkInterfaceAttribute",
-				"ec2:RevokeSecurityGroupIngress",
-				"elasticloadbalancing:AddListenerCertificates",
-				"elasticloadbalancing:AddTags",
-				"elasticloadbalancing:CreateListener",
-				"elasticloadbalancing:CreateLoadBalancer",
-				"elasticloadbalancing:CreateRule",
-				"elasticloadbalancing:CreateTargetGroup",
-				"elasticloadbalancing:DeleteListener",
-				"elasticloadbalancing:DeleteLoadBalancer",
-				"elasticloadbalancing:DeleteRule",
-				"elasticloadbalancing:DeleteTargetGroup",
-				"elasticloadbalancing:DeregisterTargets",
-				"elasticloadbalancing:DescribeListenerCertificates",
-				"elasticloadbalancing:DescribeListeners",
-				"elasticloadbalancing:DescribeLoadBalancers",
-				"elasticloadbalancing:DescribeLoadBalancerAttributes",
-				"elasticloadbalancing:DescribeRules",
-				"elasticloadbalancing:DescribeSSLPolicies",
-				"elasticloadbalancing:DescribeTags",
-				"elasticloadbalancing:DescribeTargetGroups",
-				"elasticloadbalancing:DescribeTargetGroupAttributes",
-				"elasticloadbalancing:DescribeTargetHealth",
-				"elasticloadbalancing:ModifyListener",
-				"elasticloadbalancing:ModifyLoadBalancerAttributes",
-				"elasticloadbalancing:ModifyRule",
-				"elasticloadbalancing:ModifyTargetGroup",
-				"elasticloadbalancing:ModifyTargetGroupAttributes",
-				"elasticloadbalancing:RegisterTargets",
-				"elasticloadbalancing:RemoveListenerCertificates",
-				"elasticloadbalancing:RemoveTags",
-				"elasticloadbalancing:SetIpAddressType",
-				"elasticloadbalancing:SetSecurityGroups",
-				"elasticloadbalancing:SetSubnets",
-				"elasticloadbalancing:SetWebACL",
-				"iam:CreateServiceLinkedRole",
-				"iam:GetServerCertificate",
-				"iam:ListServerCertificates",
-				"waf-regional:GetWebACLForResource",
-				"waf-regional:GetWebACL",
-				"waf-regional:AssociateWebACL",
-				"waf-regional:DisassociateWebACL",
-				"tag:GetResources",
-				"tag:TagResources",
-				"waf:GetWebACL",
-			},
-		)
+		n.rs.attachAllowPolicy("PolicyALBIngress", refIR, "*", ALBIngressActions)
 	}
 
 	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.XRay) {
@@ -394,8 +455,8 @@ func NewIAMServiceAccountResourceSet(spec *api.ClusterIAMServiceAccount, oidc *i
 // WithIAM returns true
 func (*IAMServiceAccountResourceSet) WithIAM() bool { return true }
 
-// WithNamedIAM returns false
-func (*IAMServiceAccountResourceSet) WithNamedIAM() bool { return false }
+// WithNamedIAM returns true when the role name is set
+func (rs *IAMServiceAccountResourceSet) WithNamedIAM() bool { return rs.spec.RoleName != "" }
 
 // AddAllResources adds all resources for the stack
 func (rs *IAMServiceAccountResourceSet) AddAllResources() error {
@@ -409,8 +470,15 @@ func (rs *IAMServiceAccountResourceSet) AddAllResources() error {
 	// so will need to give them unique names
 	// we will need to consider using a large stack for all the roles, but that needs some
 	// testing and potentially a better stack mutation strategy
+	assumeRolePolicyDocument, err := rs.makeAssumeRolePolicyDocument()
+	if err != nil {
+		return err
+	}
 	role := &cft.IAMRole{
-		AssumeRolePolicyDocument: rs.oidc.MakeAssumeRolePolicyDocument(rs.spec.Namespace, rs.spec.Name),
+		RoleName:                 rs.spec.RoleName,
+		Path:                     rs.spec.RolePath,
+		AssumeRolePolicyDocument: assumeRolePolicyDocument,
+		PermissionsBoundary:      rs.spec.PermissionsBoundary,
 	}
 	role.ManagedPolicyArns = append(role.ManagedPolicyArns, rs.spec.AttachPolicyARNs...)
 
@@ -436,6 +504,31 @@ func (rs *IAMServiceAccountResourceSet) AddAllResources() error {
 	return nil
 }
 
+// makeAssumeRolePolicyDocument constructs the trust policy of the role; without overrides it only trusts the
+// provider of the cluster, in the same form as before overrides were supported, so existing stacks are unchanged;
+// overrides are also kept in the template metadata, as the issuer can't be told apart from the cluster's otherwise
+func (rs *IAMServiceAccountResourceSet) makeAssumeRolePolicyDocument() (cft.MapOfInterfaces, error) {
+	if !rs.spec.HasTrustPolicyOverrides() {
+		return rs.oidc.MakeAssumeRolePolicyDocument(rs.spec.Namespace, rs.spec.Name), nil
+	}
+
+	oidc := rs.oidc
+	trustPolicy := map[string]interface{}{}
+	if rs.spec.OIDCIssuerURL != "" {
+		var err error
+		if oidc, err = rs.oidc.WithIssuer(rs.spec.OIDCIssuerURL); err != nil {
+			return nil, err
+		}
+		trustPolicy["OIDCIssuerURL"] = rs.spec.OIDCIssuerURL
+	}
+	if len(rs.spec.TrustedAccounts) > 0 {
+		trustPolicy["TrustedAccounts"] = rs.spec.TrustedAccounts
+	}
+	rs.template.Metadata = map[string]interface{}{"TrustPolicy": trustPolicy}
+
+	return oidc.MakeCrossAccountAssumeRolePolicyDocument(rs.spec.TrustedAccounts, rs.spec.Namespace, rs.spec.Name), nil
+}
+
 // RenderJSON will render iamserviceaccount stack as JSON
 func (rs *IAMServiceAccountResourceSet) RenderJSON() ([]byte, error) {
 	return rs.template.RenderJSON()
@@ -184,6 +184,84 @@ var _ = Describe("template builder for IAM", func() {
 		Expect(t).To(HaveOutputWithValue("Role1", `{ "Fn::GetAtt": "Role1.Arn" }`))
 	})
 
+	It("can constuct an iamserviceaccount addon template with a custom role name, path and permissions boundary", func() {
+		serviceAccount := &api.ClusterIAMServiceAccount{}
+
+		serviceAccount.Name = "sa-1"
+		serviceAccount.AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}
+		serviceAccount.RoleName = "s3-reader"
+		serviceAccount.RolePath = "/irsa/"
+		serviceAccount.PermissionsBoundary = "arn:aws:iam::123456789012:policy/boundary"
+
+		appendServiceAccountToClusterConfig(cfg, serviceAccount)
+
+		rs := NewIAMServiceAccountResourceSet(serviceAccount, oidc)
+		Expect(rs.WithNamedIAM()).To(BeTrue())
+
+		templateBody := []byte{}
+
+		Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+		t := cft.NewTemplate()
+
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+
+		Expect(t.Resources).To(HaveLen(1))
+
+		Expect(t).To(HaveResourceWithPropertyValue("Role1", "RoleName", `"s3-reader"`))
+		Expect(t).To(HaveResourceWithPropertyValue("Role1", "Path", `"/irsa/"`))
+		Expect(t).To(HaveResourceWithPropertyValue("Role1", "PermissionsBoundary", `"arn:aws:iam::123456789012:policy/boundary"`))
+	})
+
+	It("can constuct an iamserviceaccount addon template that trusts the OIDC providers of other accounts", func() {
+		serviceAccount := &api.ClusterIAMServiceAccount{}
+
+		serviceAccount.Name = "sa-1"
+		serviceAccount.AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}
+		serviceAccount.OIDCIssuerURL = "https://oidc.eks.us-west-2.amazonaws.com/id/HUB"
+		serviceAccount.TrustedAccounts = []string{"111122223333"}
+
+		appendServiceAccountToClusterConfig(cfg, serviceAccount)
+
+		rs := NewIAMServiceAccountResourceSet(serviceAccount, oidc)
+
+		templateBody := []byte{}
+
+		Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+		t := cft.NewTemplate()
+
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+
+		Expect(t.Metadata).To(Equal(map[string]interface{}{
+			"TrustPolicy": map[string]interface{}{
+				"OIDCIssuerURL":   "https://oidc.eks.us-west-2.amazonaws.com/id/HUB",
+				"TrustedAccounts": []interface{}{"111122223333"},
+			},
+		}))
+
+		Expect(t).To(HaveResourceWithPropertyValue("Role1", "AssumeRolePolicyDocument", `{
+			"Statement": [
+			  {
+				"Action": [
+				  "sts:AssumeRoleWithWebIdentity"
+				],
+				"Condition": {
+				  "StringEquals": {
+					"oidc.eks.us-west-2.amazonaws.com/id/HUB:aud": "sts.amazonaws.com",
+					"oidc.eks.us-west-2.amazonaws.com/id/HUB:sub": "system:serviceaccount:default:sa-1"
+				  }
+				},
+				"Effect": "Allow",
+				"Principal": {
+				  "Federated": "arn:aws:iam::111122223333:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/HUB"
+				}
+			  }
+			],
+			"Version": "2012-10-17"
+		}`))
+	})
+
 	It("can parse an iamserviceaccount addon template", func() {
 		t := cft.NewTemplate()
 
@@ -0,0 +1,211 @@
+package builder
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	gfn "github.com/awslabs/goformation/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+const karpenterTemplateDescription = "Karpenter IAM roles and interruption handling"
+
+// karpenterInterruptionEvents are the events that Karpenter handles by draining
+// and replacing the affected nodes ahead of time, keyed by the name of their rule
+var karpenterInterruptionEvents = map[string]map[string]interface{}{
+	"ScheduledChangeRule": {
+		"source":      []string{"aws.health"},
+		"detail-type": []string{"AWS Health Event"},
+	},
+	"SpotInterruptionRule": {
+		"source":      []string{"aws.ec2"},
+		"detail-type": []string{"EC2 Spot Instance Interruption Warning"},
+	},
+	"RebalanceRule": {
+		"source":      []string{"aws.ec2"},
+		"detail-type": []string{"EC2 Instance Rebalance Recommendation"},
+	},
+	"InstanceStateChangeRule": {
+		"source":      []string{"aws.ec2"},
+		"detail-type": []string{"EC2 Instance State-change Notification"},
+	},
+}
+
+// KarpenterResources are the resources of the Karpenter stack that Karpenter is configured with
+type KarpenterResources struct {
+	NodeRoleARN           string
+	InstanceProfileName   string
+	InterruptionQueueName string
+	ControllerPolicyARN   string
+}
+
+// KarpenterResourceSet holds the stack of the resources that Karpenter needs: the role and
+// instance profile of the nodes it launches, the policy of its controller, and the queue
+// that EventBridge sends interruption events to
+type KarpenterResourceSet struct {
+	rs        *resourceSet
+	spec      *api.ClusterConfig
+	Resources KarpenterResources
+}
+
+// NewKarpenterResourceSet builds the stack of the resources that Karpenter needs
+func NewKarpenterResourceSet(spec *api.ClusterConfig) *KarpenterResourceSet {
+	return &KarpenterResourceSet{
+		rs:   newResourceSet(),
+		spec: spec,
+	}
+}
+
+// AddAllResources adds all resources for the stack
+func (k *KarpenterResourceSet) AddAllResources() error {
+	k.rs.template.Description = fmt.Sprintf("%s %s", karpenterTemplateDescription, templateDescriptionSuffix)
+	k.rs.withIAM = true
+
+	region := k.spec.Metadata.Region
+	refNodeRole := k.rs.newResource("KarpenterNodeRole", &gfn.AWSIAMRole{
+		Path:                     gfn.NewString("/"),
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(ec2ServicePrincipal(region)),
+		ManagedPolicyArns: makeStringSlice(
+			awsManagedPolicyARN(region, iamPolicyAmazonEKSWorkerNodePolicy),
+			awsManagedPolicyARN(region, iamPolicyAmazonEKSCNIPolicy),
+			awsManagedPolicyARN(region, iamPolicyAmazonEC2ContainerRegistryReadOnly),
+			awsManagedPolicyARN(region, iamPolicyAmazonSSMManagedInstanceCore),
+		),
+		PermissionsBoundary: makePermissionsBoundary(k.spec.IAM.ServiceRolePermissionsBoundary),
+	})
+	refInstanceProfile := k.rs.newResource("KarpenterNodeInstanceProfile", &gfn.AWSIAMInstanceProfile{
+		Path:  gfn.NewString("/"),
+		Roles: makeSlice(refNodeRole),
+	})
+
+	refQueue := k.rs.newResource("KarpenterInterruptionQueue", &gfn.AWSSQSQueue{
+		MessageRetentionPeriod: gfn.NewInteger(300),
+	})
+	queueARN := gfn.MakeFnGetAttString("KarpenterInterruptionQueue.Arn")
+	k.rs.newResource("KarpenterInterruptionQueuePolicy", &gfn.AWSSQSQueuePolicy{
+		Queues: makeSlice(refQueue),
+		PolicyDocument: cft.MakePolicyDocument(cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Principal": map[string][]string{
+				"Service": {"events.amazonaws.com", "sqs.amazonaws.com"},
+			},
+			"Action":   "sqs:SendMessage",
+			"Resource": queueARN,
+		}),
+	})
+	for name, pattern := range karpenterInterruptionEvents {
+		k.rs.newResource(name, &gfn.AWSEventsRule{
+			EventPattern: pattern,
+			Targets: []gfn.AWSEventsRule_Target{{
+				Id:  gfn.NewString("KarpenterInterruptionQueueTarget"),
+				Arn: queueARN,
+			}},
+		})
+	}
+
+	refControllerPolicy := k.rs.newResource("KarpenterControllerPolicy", &gfn.AWSIAMManagedPolicy{
+		PolicyDocument: k.controllerPolicyDocument(queueARN),
+	})
+
+	k.rs.defineOutputFromAtt(outputs.KarpenterNodeRoleARN, "KarpenterNodeRole.Arn", false, func(v string) error {
+		k.Resources.NodeRoleARN = v
+		return nil
+	})
+	k.rs.defineOutput(outputs.KarpenterInstanceProfileName, refInstanceProfile, false, func(v string) error {
+		k.Resources.InstanceProfileName = v
+		return nil
+	})
+	k.rs.defineOutputFromAtt(outputs.KarpenterInterruptionQueueName, "KarpenterInterruptionQueue.QueueName", false, func(v string) error {
+		k.Resources.InterruptionQueueName = v
+		return nil
+	})
+	k.rs.defineOutput(outputs.KarpenterControllerPolicyARN, refControllerPolicy, false, func(v string) error {
+		k.Resources.ControllerPolicyARN = v
+		return nil
+	})
+	return nil
+}
+
+// controllerPolicyDocument allows the controller to launch and terminate nodes of the cluster,
+// and to receive interruption events from the queue
+func (k *KarpenterResourceSet) controllerPolicyDocument(queueARN *gfn.Value) cft.MapOfInterfaces {
+	clusterARN := gfn.MakeFnSubString(fmt.Sprintf("arn:${%s}:eks:${%s}:${%s}:cluster/%s", gfn.Partition, gfn.Region, gfn.AccountID, k.spec.Metadata.Name))
+	return cft.MakePolicyDocument(
+		cft.MapOfInterfaces{
+			"Effect":   "Allow",
+			"Resource": "*",
+			"Action": []string{
+				"ec2:CreateFleet",
+				"ec2:CreateLaunchTemplate",
+				"ec2:CreateTags",
+				"ec2:DeleteLaunchTemplate",
+				"ec2:DescribeAvailabilityZones",
+				"ec2:DescribeImages",
+				"ec2:DescribeInstances",
+				"ec2:DescribeInstanceTypeOfferings",
+				"ec2:DescribeInstanceTypes",
+				"ec2:DescribeLaunchTemplates",
+				"ec2:DescribeSecurityGroups",
+				"ec2:DescribeSpotPriceHistory",
+				"ec2:DescribeSubnets",
+				"ec2:RunInstances",
+				"pricing:GetProducts",
+				"ssm:GetParameter",
+			},
+		},
+		cft.MapOfInterfaces{
+			// only nodes launched by Karpenter can be terminated by it
+			"Effect":   "Allow",
+			"Resource": "*",
+			"Action":   []string{"ec2:TerminateInstances"},
+			"Condition": map[string]interface{}{
+				"StringLike": map[string]string{
+					"ec2:ResourceTag/karpenter.sh/provisioner-name": "*",
+				},
+			},
+		},
+		cft.MapOfInterfaces{
+			"Effect":   "Allow",
+			"Resource": gfn.MakeFnGetAttString("KarpenterNodeRole.Arn"),
+			"Action":   []string{"iam:PassRole"},
+		},
+		cft.MapOfInterfaces{
+			"Effect":   "Allow",
+			"Resource": clusterARN,
+			"Action":   []string{"eks:DescribeCluster"},
+		},
+		cft.MapOfInterfaces{
+			"Effect":   "Allow",
+			"Resource": queueARN,
+			"Action": []string{
+				"sqs:DeleteMessage",
+				"sqs:GetQueueAttributes",
+				"sqs:GetQueueUrl",
+				"sqs:ReceiveMessage",
+			},
+		},
+	)
+}
+
+// WithIAM returns true, as the stack creates IAM roles
+func (k *KarpenterResourceSet) WithIAM() bool {
+	return k.rs.withIAM
+}
+
+// WithNamedIAM returns false, as the names of the IAM roles are generated
+func (k *KarpenterResourceSet) WithNamedIAM() bool {
+	return k.rs.withNamedIAM
+}
+
+// RenderJSON returns the rendered JSON
+func (k *KarpenterResourceSet) RenderJSON() ([]byte, error) {
+	return k.rs.renderJSON()
+}
+
+// GetAllOutputs collects the outputs of the stack into Resources
+func (k *KarpenterResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	return k.rs.GetAllOutputs(stack)
+}
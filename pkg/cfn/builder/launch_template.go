@@ -0,0 +1,204 @@
+package builder
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	gfn "github.com/awslabs/goformation/cloudformation"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const defaultLaunchTemplateVersion = "$Default"
+
+// describeLaunchTemplateData fetches the data of the launch template version referenced by the nodegroup
+func describeLaunchTemplateData(provider api.ClusterProvider, lt *api.NodeGroupLaunchTemplate) (*ec2.ResponseLaunchTemplateData, error) {
+	version := defaultLaunchTemplateVersion
+	if lt.Version != nil {
+		version = *lt.Version
+	}
+
+	output, err := provider.EC2().DescribeLaunchTemplateVersions(&ec2.DescribeLaunchTemplateVersionsInput{
+		LaunchTemplateId: aws.String(lt.ID),
+		Versions:         aws.StringSlice([]string{version}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing launch template %q (version %q)", lt.ID, version)
+	}
+	if len(output.LaunchTemplateVersions) != 1 || output.LaunchTemplateVersions[0].LaunchTemplateData == nil {
+		return nil, fmt.Errorf("launch template %q (version %q) not found", lt.ID, version)
+	}
+	return output.LaunchTemplateVersions[0].LaunchTemplateData, nil
+}
+
+// mergeLaunchTemplateData overlays the data of the launch template referenced by the nodegroup
+// on top of the data generated by eksctl; IAM instance profile, network interfaces, instance type
+// and user data are always set by eksctl (user data of the template is merged into the latter),
+// security groups of both get attached, while other supported settings of the template take precedence
+func mergeLaunchTemplateData(data *gfn.AWSEC2LaunchTemplate_LaunchTemplateData, template *ec2.ResponseLaunchTemplateData) {
+	if template.ImageId != nil {
+		data.ImageId = gfn.NewString(*template.ImageId)
+	}
+	if template.KeyName != nil {
+		data.KeyName = gfn.NewString(*template.KeyName)
+	}
+	if template.EbsOptimized != nil {
+		data.EbsOptimized = gfn.NewBoolean(*template.EbsOptimized)
+	}
+	if template.Monitoring != nil && template.Monitoring.Enabled != nil {
+		data.Monitoring = &gfn.AWSEC2LaunchTemplate_Monitoring{
+			Enabled: gfn.NewBoolean(*template.Monitoring.Enabled),
+		}
+	}
+	if template.CreditSpecification != nil && template.CreditSpecification.CpuCredits != nil {
+		data.CreditSpecification = &gfn.AWSEC2LaunchTemplate_CreditSpecification{
+			CpuCredits: gfn.NewString(*template.CreditSpecification.CpuCredits),
+		}
+	}
+	if len(template.BlockDeviceMappings) > 0 {
+		data.BlockDeviceMappings = makeBlockDeviceMappings(template.BlockDeviceMappings)
+	}
+	for _, spec := range template.TagSpecifications {
+		data.TagSpecifications = append(data.TagSpecifications, makeTagSpecification(spec))
+	}
+	for _, id := range template.SecurityGroupIds {
+		data.NetworkInterfaces[0].Groups = append(data.NetworkInterfaces[0].Groups, gfn.NewString(*id))
+	}
+}
+
+// launchTemplateMetadataOptions returns the instance metadata options for the launch template of the nodegroup,
+// those of the nodegroup take precedence over the ones of the launch template referenced by the nodegroup
+func launchTemplateMetadataOptions(ng *api.NodeGroup, template *ec2.ResponseLaunchTemplateData) map[string]interface{} {
+	options := map[string]interface{}{}
+	if template != nil && template.MetadataOptions != nil {
+		if endpoint := template.MetadataOptions.HttpEndpoint; endpoint != nil {
+			options["HttpEndpoint"] = *endpoint
+		}
+		if tokens := template.MetadataOptions.HttpTokens; tokens != nil {
+			options["HttpTokens"] = *tokens
+		}
+		if hopLimit := template.MetadataOptions.HttpPutResponseHopLimit; hopLimit != nil {
+			options["HttpPutResponseHopLimit"] = *hopLimit
+		}
+	}
+	if mo := ng.MetadataOptions; mo != nil {
+		if mo.HTTPTokens != nil {
+			options["HttpTokens"] = *mo.HTTPTokens
+		}
+		if mo.HTTPPutResponseHopLimit != nil {
+			options["HttpPutResponseHopLimit"] = *mo.HTTPPutResponseHopLimit
+		}
+	}
+	return options
+}
+
+// launchTemplateDataProperties converts the launch template data into properties, so that
+// fields goformation doesn't know yet can be added, e.g. MetadataOptions or the throughput of volumes
+func launchTemplateDataProperties(data *gfn.AWSEC2LaunchTemplate_LaunchTemplateData) (map[string]interface{}, error) {
+	dataJSON, err := json.Marshal(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "serialising launch template data")
+	}
+	properties := map[string]interface{}{}
+	if err := json.Unmarshal(dataJSON, &properties); err != nil {
+		return nil, errors.Wrap(err, "deserialising launch template data")
+	}
+	return properties, nil
+}
+
+// makeAdditionalVolumeMapping returns the block device mapping of an additional volume of the nodegroup
+func makeAdditionalVolumeMapping(v *api.VolumeMapping) map[string]interface{} {
+	ebs := map[string]interface{}{
+		"VolumeSize": *v.VolumeSize,
+		"Encrypted":  api.IsEnabled(v.VolumeEncrypted),
+	}
+	if v.VolumeType != nil {
+		ebs["VolumeType"] = *v.VolumeType
+	}
+	if v.VolumeIOPS != nil {
+		ebs["Iops"] = *v.VolumeIOPS
+	}
+	if v.VolumeThroughput != nil {
+		ebs["Throughput"] = *v.VolumeThroughput
+	}
+	if api.IsSetAndNonEmptyString(v.VolumeKmsKeyID) {
+		ebs["KmsKeyId"] = *v.VolumeKmsKeyID
+	}
+	return map[string]interface{}{
+		"DeviceName": v.VolumeName,
+		"Ebs":        ebs,
+	}
+}
+
+func makeCapacityReservationSpecification(cr *api.NodeGroupCapacityReservation) map[string]interface{} {
+	if cr.ID != "" {
+		return map[string]interface{}{
+			"CapacityReservationTarget": map[string]interface{}{
+				"CapacityReservationId": cr.ID,
+			},
+		}
+	}
+	return map[string]interface{}{
+		"CapacityReservationPreference": cr.Preference,
+	}
+}
+
+func makeBlockDeviceMappings(mappings []*ec2.LaunchTemplateBlockDeviceMapping) []gfn.AWSEC2LaunchTemplate_BlockDeviceMapping {
+	result := make([]gfn.AWSEC2LaunchTemplate_BlockDeviceMapping, len(mappings))
+	for i, m := range mappings {
+		result[i] = gfn.AWSEC2LaunchTemplate_BlockDeviceMapping{
+			DeviceName:  newStringIfSet(m.DeviceName),
+			NoDevice:    newStringIfSet(m.NoDevice),
+			VirtualName: newStringIfSet(m.VirtualName),
+		}
+		if ebs := m.Ebs; ebs != nil {
+			result[i].Ebs = &gfn.AWSEC2LaunchTemplate_Ebs{
+				DeleteOnTermination: newBooleanIfSet(ebs.DeleteOnTermination),
+				Encrypted:           newBooleanIfSet(ebs.Encrypted),
+				Iops:                newIntegerIfSet(ebs.Iops),
+				KmsKeyId:            newStringIfSet(ebs.KmsKeyId),
+				SnapshotId:          newStringIfSet(ebs.SnapshotId),
+				VolumeSize:          newIntegerIfSet(ebs.VolumeSize),
+				VolumeType:          newStringIfSet(ebs.VolumeType),
+			}
+		}
+	}
+	return result
+}
+
+func makeTagSpecification(spec *ec2.LaunchTemplateTagSpecification) gfn.AWSEC2LaunchTemplate_TagSpecification {
+	result := gfn.AWSEC2LaunchTemplate_TagSpecification{
+		ResourceType: newStringIfSet(spec.ResourceType),
+	}
+	for _, tag := range spec.Tags {
+		result.Tags = append(result.Tags, gfn.Tag{
+			Key:   newStringIfSet(tag.Key),
+			Value: newStringIfSet(tag.Value),
+		})
+	}
+	return result
+}
+
+func newStringIfSet(s *string) *gfn.Value {
+	if s == nil {
+		return nil
+	}
+	return gfn.NewString(*s)
+}
+
+func newBooleanIfSet(b *bool) *gfn.Value {
+	if b == nil {
+		return nil
+	}
+	return gfn.NewBoolean(*b)
+}
+
+func newIntegerIfSet(i *int64) *gfn.Value {
+	if i == nil {
+		return nil
+	}
+	return gfn.NewInteger(int(*i))
+}
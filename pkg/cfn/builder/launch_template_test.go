@@ -0,0 +1,134 @@
+package builder_test
+
+import (
+	"encoding/base64"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	gfn "github.com/awslabs/goformation/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("nodegroup with a custom launch template", func() {
+	var (
+		p            *mockprovider.MockProvider
+		cfg          *api.ClusterConfig
+		ng           *api.NodeGroup
+		templateData *ec2.ResponseLaunchTemplateData
+	)
+
+	BeforeEach(func() {
+		caCertData, err := base64.StdEncoding.DecodeString(caCert)
+		Expect(err).ToNot(HaveOccurred())
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = clusterName
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Status = &api.ClusterStatus{
+			CertificateAuthorityData: caCertData,
+			Endpoint:                 endpoint,
+		}
+
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-lt"
+		ng.InstanceType = "m5.large"
+		ng.AMI = "ami-eksctl"
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+		ng.LaunchTemplate = &api.NodeGroupLaunchTemplate{
+			ID:      "lt-0123456789abcdef0",
+			Version: aws.String("3"),
+		}
+
+		templateData = &ec2.ResponseLaunchTemplateData{
+			ImageId:          aws.String("ami-cis"),
+			SecurityGroupIds: aws.StringSlice([]string{"sg-org"}),
+			UserData:         aws.String(base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\necho hardening\n"))),
+			BlockDeviceMappings: []*ec2.LaunchTemplateBlockDeviceMapping{{
+				DeviceName: aws.String("/dev/xvda"),
+				Ebs: &ec2.LaunchTemplateEbsBlockDevice{
+					VolumeSize: aws.Int64(100),
+					Encrypted:  aws.Bool(true),
+				},
+			}},
+		}
+
+		p = mockprovider.NewMockProvider()
+		p.MockEC2().On("DescribeLaunchTemplateVersions", mock.MatchedBy(func(input *ec2.DescribeLaunchTemplateVersionsInput) bool {
+			return *input.LaunchTemplateId == "lt-0123456789abcdef0" && *input.Versions[0] == "3"
+		})).Return(&ec2.DescribeLaunchTemplateVersionsOutput{
+			LaunchTemplateVersions: []*ec2.LaunchTemplateVersion{{
+				LaunchTemplateData: templateData,
+			}},
+		}, nil)
+	})
+
+	It("uses the launch template as a base", func() {
+		ngrs := NewNodeGroupResourceSet(p, cfg, "eksctl-test-cluster", ng)
+		Expect(ngrs.AddAllResources()).To(Succeed())
+
+		t := ngrs.Template()
+		Expect(t.Resources).To(HaveKey("NodeGroupLaunchTemplate"))
+		data := t.Resources["NodeGroupLaunchTemplate"].(*gfn.AWSEC2LaunchTemplate).LaunchTemplateData
+
+		Expect(data.ImageId).To(Equal(gfn.NewString("ami-cis")))
+		Expect(data.InstanceType).To(Equal(gfn.NewString("m5.large")))
+		Expect(data.IamInstanceProfile).ToNot(BeNil())
+		Expect(data.NetworkInterfaces[0].Groups).To(ContainElement(gfn.NewString("sg-org")))
+		Expect(data.BlockDeviceMappings).To(HaveLen(1))
+		Expect(data.BlockDeviceMappings[0].Ebs.VolumeSize).To(Equal(gfn.NewInteger(100)))
+		Expect(data.UserData).ToNot(BeNil())
+
+		p.MockEC2().AssertNumberOfCalls(GinkgoT(), "DescribeLaunchTemplateVersions", 1)
+	})
+
+	It("sets metadata options of the nodegroup over those of the launch template", func() {
+		templateData.MetadataOptions = &ec2.LaunchTemplateInstanceMetadataOptions{
+			HttpEndpoint:            aws.String("enabled"),
+			HttpTokens:              aws.String(api.IMDSHTTPTokensOptional),
+			HttpPutResponseHopLimit: aws.Int64(1),
+		}
+		hopLimit := 2
+		ng.MetadataOptions = &api.NodeGroupMetadataOptions{HTTPPutResponseHopLimit: &hopLimit}
+		Expect(api.EnforceIMDSv2(ng)).To(Succeed())
+
+		ngrs := NewNodeGroupResourceSet(p, cfg, "eksctl-test-cluster", ng)
+		Expect(ngrs.AddAllResources()).To(Succeed())
+
+		templateBody, err := ngrs.RenderJSON()
+		Expect(err).ToNot(HaveOccurred())
+		var template struct {
+			Resources map[string]struct {
+				Properties struct {
+					LaunchTemplateData struct {
+						ImageId         string
+						MetadataOptions map[string]interface{}
+					}
+				}
+			}
+		}
+		Expect(json.Unmarshal(templateBody, &template)).To(Succeed())
+
+		data := template.Resources["NodeGroupLaunchTemplate"].Properties.LaunchTemplateData
+		Expect(data.ImageId).To(Equal("ami-cis"))
+		Expect(data.MetadataOptions).To(Equal(map[string]interface{}{
+			"HttpEndpoint":            "enabled",
+			"HttpTokens":              "required",
+			"HttpPutResponseHopLimit": 2.0,
+		}))
+	})
+
+	It("fails when the launch template cannot be found", func() {
+		ng.LaunchTemplate.Version = aws.String("4")
+		p.MockEC2().On("DescribeLaunchTemplateVersions", mock.Anything).Return(&ec2.DescribeLaunchTemplateVersionsOutput{}, nil)
+
+		ngrs := NewNodeGroupResourceSet(p, cfg, "eksctl-test-cluster", ng)
+		Expect(ngrs.AddAllResources()).To(MatchError(`launch template "lt-0123456789abcdef0" (version "4") not found`))
+	})
+})
@@ -0,0 +1,93 @@
+package builder
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+// ManagedNodeGroupResourceSet holds build-time information of the stack of a managed nodegroup,
+// which only contains the IAM role of the nodes, as the nodegroup itself is created via EKS API
+type ManagedNodeGroupResourceSet struct {
+	template *cft.Template
+	spec     *api.ManagedNodeGroup
+	outputs  *outputs.CollectorSet
+	// region of the cluster, which the partition of the ARNs of the role depends on
+	region string
+}
+
+// NewManagedNodeGroupResourceSet builds managed nodegroup stack from the given spec
+func NewManagedNodeGroupResourceSet(spec *api.ManagedNodeGroup) *ManagedNodeGroupResourceSet {
+	return &ManagedNodeGroupResourceSet{
+		template: cft.NewTemplate(),
+		spec:     spec,
+	}
+}
+
+// WithRegion sets the region of the cluster, the role refers to AWS managed policies
+// of its partition
+func (rs *ManagedNodeGroupResourceSet) WithRegion(region string) *ManagedNodeGroupResourceSet {
+	rs.region = region
+	return rs
+}
+
+// WithIAM returns true
+func (*ManagedNodeGroupResourceSet) WithIAM() bool { return true }
+
+// WithNamedIAM returns false
+func (*ManagedNodeGroupResourceSet) WithNamedIAM() bool { return false }
+
+// AddAllResources adds all resources for the stack
+func (rs *ManagedNodeGroupResourceSet) AddAllResources() error {
+	rs.template.Description = fmt.Sprintf(
+		"IAM role for managed nodegroup %q %s",
+		rs.spec.NameString(),
+		templateDescriptionSuffix,
+	)
+
+	if rs.spec.IAM == nil {
+		rs.spec.IAM = &api.ManagedNodeGroupIAM{}
+	}
+
+	policyARNs := append([]string{}, rs.spec.IAM.AttachPolicyARNs...)
+	if len(policyARNs) == 0 {
+		policyARNs = append(policyARNs, defaultNodePolicyARNs(rs.region)...)
+	}
+	policyARNs = append(policyARNs, awsManagedPolicyARN(rs.region, iamPolicyAmazonEC2ContainerRegistryReadOnly))
+	if rs.spec.SSH != nil && api.IsEnabled(rs.spec.SSH.EnableSSM) {
+		policyARNs = append(policyARNs, awsManagedPolicyARN(rs.region, iamPolicyAmazonSSMManagedInstanceCore))
+	}
+
+	rs.template.NewResource("NodeInstanceRole", &cft.IAMRole{
+		Path:                     "/",
+		AssumeRolePolicyDocument: cft.MakeAssumeRolePolicyDocumentForServices(ec2ServicePrincipal(rs.region)),
+		ManagedPolicyArns:        policyARNs,
+		PermissionsBoundary:      rs.spec.IAM.InstanceRolePermissionsBoundary,
+	})
+
+	rs.template.Outputs[outputs.NodeGroupInstanceRoleARN] = cft.Output{
+		Value: cft.MakeFnGetAttString("NodeInstanceRole.Arn"),
+	}
+	rs.outputs = outputs.NewCollectorSet(map[string]outputs.Collector{
+		outputs.NodeGroupInstanceRoleARN: func(v string) error {
+			rs.spec.IAM.InstanceRoleARN = v
+			return nil
+		},
+	})
+
+	return nil
+}
+
+// RenderJSON will render managed nodegroup stack as JSON
+func (rs *ManagedNodeGroupResourceSet) RenderJSON() ([]byte, error) {
+	return rs.template.RenderJSON()
+}
+
+// GetAllOutputs will get all outputs from managed nodegroup stack
+func (rs *ManagedNodeGroupResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	return rs.outputs.MustCollect(stack)
+}
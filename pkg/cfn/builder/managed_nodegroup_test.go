@@ -0,0 +1,108 @@
+package builder_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/template/matchers"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("template builder for managed nodegroups", func() {
+	var (
+		ng     *api.ManagedNodeGroup
+		region string
+	)
+
+	BeforeEach(func() {
+		ng = api.NewManagedNodeGroup()
+		ng.Name = "managed-ng"
+		region = api.DefaultRegion
+	})
+
+	render := func() *cft.Template {
+		rs := NewManagedNodeGroupResourceSet(ng).WithRegion(region)
+		Expect(rs.AddAllResources()).To(Succeed())
+
+		templateBody := []byte{}
+		Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+		t := cft.NewTemplate()
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+		return t
+	}
+
+	It("can construct a template with the default node policies", func() {
+		t := render()
+
+		Expect(t.Description).To(Equal("IAM role for managed nodegroup \"managed-ng\" [created and managed by eksctl]"))
+
+		Expect(t.Resources).To(HaveLen(1))
+		Expect(t).To(HaveResource("NodeInstanceRole", "AWS::IAM::Role"))
+		Expect(t).To(HaveResourceWithPropertyValue("NodeInstanceRole", "ManagedPolicyArns", `[
+			"arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy",
+			"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy",
+			"arn:aws:iam::aws:policy/AmazonEC2ContainerRegistryReadOnly"
+		]`))
+
+		Expect(t).To(HaveOutputWithValue("InstanceRoleARN", `{ "Fn::GetAtt": "NodeInstanceRole.Arn" }`))
+	})
+
+	It("can construct a template with custom policies", func() {
+		ng.IAM.AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy", "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}
+
+		t := render()
+
+		Expect(t).To(HaveResourceWithPropertyValue("NodeInstanceRole", "ManagedPolicyArns", `[
+			"arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy",
+			"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess",
+			"arn:aws:iam::aws:policy/AmazonEC2ContainerRegistryReadOnly"
+		]`))
+		Expect(ng.IAM.AttachPolicyARNs).To(HaveLen(2))
+	})
+
+	It("attaches the SSM policy when enabled", func() {
+		ng.SSH.EnableSSM = api.Enabled()
+
+		t := render()
+
+		Expect(t).To(HaveResourceWithPropertyValue("NodeInstanceRole", "ManagedPolicyArns", `[
+			"arn:aws:iam::aws:policy/AmazonEKSWorkerNodePolicy",
+			"arn:aws:iam::aws:policy/AmazonEKS_CNI_Policy",
+			"arn:aws:iam::aws:policy/AmazonEC2ContainerRegistryReadOnly",
+			"arn:aws:iam::aws:policy/AmazonSSMManagedInstanceCore"
+		]`))
+	})
+
+	It("sets the permissions boundary of the role", func() {
+		ng.IAM.InstanceRolePermissionsBoundary = "arn:aws:iam::123456789012:policy/eks-boundary"
+
+		t := render()
+
+		Expect(t).To(HaveResourceWithPropertyValue("NodeInstanceRole", "PermissionsBoundary", `"arn:aws:iam::123456789012:policy/eks-boundary"`))
+	})
+
+	It("refers to the policies and EC2 principal of the partition of the region", func() {
+		region = api.RegionCNNorth1
+
+		t := render()
+
+		Expect(t).To(HaveResourceWithPropertyValue("NodeInstanceRole", "ManagedPolicyArns", `[
+			"arn:aws-cn:iam::aws:policy/AmazonEKSWorkerNodePolicy",
+			"arn:aws-cn:iam::aws:policy/AmazonEKS_CNI_Policy",
+			"arn:aws-cn:iam::aws:policy/AmazonEC2ContainerRegistryReadOnly"
+		]`))
+		Expect(t).To(HaveResourceWithPropertyValue("NodeInstanceRole", "AssumeRolePolicyDocument", `{
+			"Version": "2012-10-17",
+			"Statement": [{
+				"Effect": "Allow",
+				"Action": ["sts:AssumeRole"],
+				"Principal": {"Service": ["ec2.amazonaws.com.cn"]}
+			}]
+		}`))
+	})
+})
@@ -2,14 +2,15 @@ package builder
 
 import (
 	"fmt"
-
-	"github.com/kris-nova/logger"
+	"sort"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
 	gfn "github.com/awslabs/goformation/cloudformation"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/nodebootstrap"
 )
 
@@ -25,6 +26,7 @@ type NodeGroupResourceSet struct {
 	securityGroups     []*gfn.Value
 	vpc                *gfn.Value
 	userData           *gfn.Value
+	baseLaunchTemplate *ec2.ResponseLaunchTemplateData
 }
 
 // NewNodeGroupResourceSet returns a resource set for a nodegroup embedded in a cluster config
@@ -57,6 +59,18 @@ func (n *NodeGroupResourceSet) AddAllResources() error {
 	if err != nil {
 		return err
 	}
+
+	if n.spec.LaunchTemplate != nil {
+		if n.baseLaunchTemplate, err = describeLaunchTemplateData(n.provider, n.spec.LaunchTemplate); err != nil {
+			return err
+		}
+		logger.Info("nodegroup %q will use launch template %q as a base", n.nodeGroupName, n.spec.LaunchTemplate.ID)
+		if templateUserData := n.baseLaunchTemplate.UserData; templateUserData != nil && *templateUserData != "" {
+			if userData, err = nodebootstrap.MergeUserData(*templateUserData, userData); err != nil {
+				return err
+			}
+		}
+	}
 	n.userData = gfn.NewString(userData)
 
 	// Ensure MinSize is set, as it is required by the ASG cfn resource
@@ -89,7 +103,11 @@ func (n *NodeGroupResourceSet) AddAllResources() error {
 	n.addResourcesForIAM()
 	n.addResourcesForSecurityGroups()
 
-	return n.addResourcesForNodeGroup()
+	if err := n.addResourcesForNodeGroup(); err != nil {
+		return err
+	}
+
+	return n.rs.addExtraResources(fmt.Sprintf("nodegroup %q", n.nodeGroupName), n.spec.CloudFormation)
 }
 
 // RenderJSON returns the rendered JSON
@@ -114,6 +132,28 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 		launchTemplateData.KeyName = gfn.NewString(*n.spec.SSH.PublicKeyName)
 	}
 
+	if n.spec.Placement != nil || n.spec.Tenancy != "" {
+		launchTemplateData.Placement = &gfn.AWSEC2LaunchTemplate_Placement{}
+		if n.spec.Tenancy != "" {
+			launchTemplateData.Placement.Tenancy = gfn.NewString(n.spec.Tenancy)
+		}
+		if p := n.spec.Placement; p != nil {
+			if p.GroupName != "" {
+				launchTemplateData.Placement.GroupName = gfn.NewString(p.GroupName)
+			} else {
+				launchTemplateData.Placement.GroupName = n.newResource("NodeGroupPlacementGroup", &gfn.AWSEC2PlacementGroup{
+					Strategy: gfn.NewString(p.Strategy),
+				})
+			}
+		}
+	}
+
+	if cr := n.spec.CapacityReservation; cr != nil && api.IsEnabled(cr.CapacityBlock) {
+		launchTemplateData.InstanceMarketOptions = &gfn.AWSEC2LaunchTemplate_InstanceMarketOptions{
+			MarketType: gfn.NewString("capacity-block"),
+		}
+	}
+
 	if volumeSize := n.spec.VolumeSize; volumeSize != nil && *volumeSize > 0 {
 		var (
 			kmsKeyID   *gfn.Value
@@ -139,15 +179,68 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 		}}
 	}
 
-	n.newResource("NodeGroupLaunchTemplate", &gfn.AWSEC2LaunchTemplate{
+	if n.baseLaunchTemplate != nil {
+		mergeLaunchTemplateData(launchTemplateData, n.baseLaunchTemplate)
+	}
+
+	launchTemplate := &gfn.AWSEC2LaunchTemplate{
 		LaunchTemplateName: launchTemplateName,
 		LaunchTemplateData: launchTemplateData,
-	})
+	}
+	metadataOptions := launchTemplateMetadataOptions(n.spec, n.baseLaunchTemplate)
+	efaEnabled := api.IsEnabled(n.spec.EFAEnabled)
+	capacityReservation := n.spec.CapacityReservation
+	// nodes in Wavelength Zones get a carrier IP address instead of a public one
+	carrierIP := n.spec.Edge != nil && n.spec.Edge.Type == api.EdgeTypeWavelengthZone && !n.spec.PrivateNetworking
+	if len(metadataOptions) > 0 || len(n.spec.AdditionalVolumes) > 0 || efaEnabled || capacityReservation != nil || carrierIP {
+		// goformation doesn't know MetadataOptions, the throughput of volumes, the type of network interfaces,
+		// the preference of capacity reservations and carrier IP addresses yet
+		data, err := launchTemplateDataProperties(launchTemplateData)
+		if err != nil {
+			return err
+		}
+		networkInterface := data["NetworkInterfaces"].([]interface{})[0].(map[string]interface{})
+		if efaEnabled {
+			networkInterface["InterfaceType"] = "efa"
+		}
+		if carrierIP {
+			delete(networkInterface, "AssociatePublicIpAddress")
+			networkInterface["AssociateCarrierIpAddress"] = true
+		}
+		if len(metadataOptions) > 0 {
+			data["MetadataOptions"] = metadataOptions
+		}
+		if capacityReservation != nil {
+			data["CapacityReservationSpecification"] = makeCapacityReservationSpecification(capacityReservation)
+		}
+		if len(n.spec.AdditionalVolumes) > 0 {
+			mappings, _ := data["BlockDeviceMappings"].([]interface{})
+			for _, v := range n.spec.AdditionalVolumes {
+				mappings = append(mappings, makeAdditionalVolumeMapping(v))
+			}
+			data["BlockDeviceMappings"] = mappings
+		}
+		n.newResource("NodeGroupLaunchTemplate", &awsCloudFormationResource{
+			Type: launchTemplate.AWSCloudFormationType(),
+			Properties: map[string]interface{}{
+				"LaunchTemplateName": launchTemplate.LaunchTemplateName,
+				"LaunchTemplateData": data,
+			},
+		})
+	} else {
+		n.newResource("NodeGroupLaunchTemplate", launchTemplate)
+	}
 
 	// currently goformation type system doesn't allow specifying `VPCZoneIdentifier: { "Fn::ImportValue": ... }`,
 	// and tags don't have `PropagateAtLaunch` field, so we have a custom method here until this gets resolved
 	var vpcZoneIdentifier interface{}
-	if numNodeGroupsAZs := len(n.spec.AvailabilityZones); numNodeGroupsAZs > 0 {
+	if n.spec.Edge != nil {
+		subnets := make([]interface{}, len(n.spec.Edge.Subnets))
+		for i, subnet := range n.spec.Edge.Subnets {
+			subnets[i] = subnet
+		}
+		vpcZoneIdentifier = subnets
+	} else if numNodeGroupsAZs := len(n.spec.AvailabilityZones); numNodeGroupsAZs > 0 {
 		subnets := n.clusterSpec.VPC.Subnets.Private
 		if !n.spec.PrivateNetworking {
 			subnets = n.clusterSpec.VPC.Subnets.Public
@@ -185,27 +278,53 @@ func (n *NodeGroupResourceSet) addResourcesForNodeGroup() error {
 			"PropagateAtLaunch": "true",
 		},
 	}
-	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) {
+	if api.IsEnabled(n.spec.IAM.WithAddonPolicies.AutoScaler) || n.clusterSpec.HasAddon(api.ClusterAutoscalerAddon) {
 		tags = append(tags,
 			map[string]interface{}{
-				"Key":               "k8s.io/cluster-autoscaler/enabled",
+				"Key":               api.ClusterAutoscalerEnabledTag,
 				"Value":             "true",
 				"PropagateAtLaunch": "true",
 			},
 			map[string]interface{}{
-				"Key":               "k8s.io/cluster-autoscaler/" + n.clusterSpec.Metadata.Name,
+				"Key":               api.ClusterAutoscalerClusterTag(n.clusterSpec.Metadata.Name),
 				"Value":             "owned",
 				"PropagateAtLaunch": "true",
 			},
 		)
 	}
 
+	tags = append(tags, nodeTemplateTags(n.spec)...)
+
 	asg := nodeGroupResource(launchTemplateName, &vpcZoneIdentifier, tags, n.spec)
 	n.newResource("NodeGroup", asg)
 
 	return nil
 }
 
+// nodeTemplateTags describes labels and taints of the nodes to cluster-autoscaler,
+// which needs them to scale up a nodegroup that has no nodes yet; these tags only
+// apply to the ASG, and are not propagated to the instances
+func nodeTemplateTags(ng *api.NodeGroup) []map[string]interface{} {
+	tags := []map[string]interface{}{}
+	addTags := func(prefix string, kvs map[string]string) {
+		keys := []string{}
+		for k := range kvs {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			tags = append(tags, map[string]interface{}{
+				"Key":               prefix + k,
+				"Value":             kvs[k],
+				"PropagateAtLaunch": "false",
+			})
+		}
+	}
+	addTags("k8s.io/cluster-autoscaler/node-template/label/", ng.Labels)
+	addTags("k8s.io/cluster-autoscaler/node-template/taint/", ng.Taints)
+	return tags
+}
+
 // GetAllOutputs collects all outputs of the nodegroup
 func (n *NodeGroupResourceSet) GetAllOutputs(stack cfn.Stack) error {
 	return n.rs.GetAllOutputs(stack)
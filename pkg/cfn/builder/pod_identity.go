@@ -0,0 +1,84 @@
+package builder
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+)
+
+// podIdentityServicePrincipal is the service that assumes roles on behalf of pods with a pod identity association
+const podIdentityServicePrincipal = "pods.eks.amazonaws.com"
+
+// PodIdentityRoleResourceSet holds the stack of the IAM role of a pod identity association
+type PodIdentityRoleResourceSet struct {
+	template *cft.Template
+	spec     *api.PodIdentityAssociation
+	outputs  *outputs.CollectorSet
+}
+
+// NewPodIdentityRoleResourceSet builds the stack of the IAM role of a pod identity association
+func NewPodIdentityRoleResourceSet(spec *api.PodIdentityAssociation) *PodIdentityRoleResourceSet {
+	return &PodIdentityRoleResourceSet{
+		template: cft.NewTemplate(),
+		spec:     spec,
+	}
+}
+
+// WithIAM returns true
+func (*PodIdentityRoleResourceSet) WithIAM() bool { return true }
+
+// WithNamedIAM returns true when the role name is set
+func (rs *PodIdentityRoleResourceSet) WithNamedIAM() bool { return rs.spec.RoleName != "" }
+
+// AddAllResources adds all resources for the stack
+func (rs *PodIdentityRoleResourceSet) AddAllResources() error {
+	rs.template.Description = fmt.Sprintf(
+		"IAM role for pod identity association %q %s",
+		rs.spec.NameString(),
+		templateDescriptionSuffix,
+	)
+
+	// pod identity also needs sts:TagSession, as the agent tags sessions with the attributes of the pod
+	role := &cft.IAMRole{
+		RoleName: rs.spec.RoleName,
+		AssumeRolePolicyDocument: cft.MakePolicyDocument(cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": []string{"sts:AssumeRole", "sts:TagSession"},
+			"Principal": map[string][]string{
+				"Service": {podIdentityServicePrincipal},
+			},
+		}),
+		ManagedPolicyArns:   rs.spec.AttachPolicyARNs,
+		PermissionsBoundary: rs.spec.PermissionsBoundary,
+	}
+	roleRef := rs.template.NewResource("Role1", role)
+
+	rs.template.Outputs["Role1"] = cft.Output{
+		Value: cft.MakeFnGetAttString("Role1.Arn"),
+	}
+	rs.outputs = outputs.NewCollectorSet(map[string]outputs.Collector{
+		"Role1": func(v string) error {
+			rs.spec.RoleARN = v
+			return nil
+		},
+	})
+
+	if len(rs.spec.AttachPolicy) != 0 {
+		rs.template.AttachPolicy("Policy1", roleRef, cft.MapOfInterfaces(rs.spec.AttachPolicy))
+	}
+	return nil
+}
+
+// RenderJSON will render the stack as JSON
+func (rs *PodIdentityRoleResourceSet) RenderJSON() ([]byte, error) {
+	return rs.template.RenderJSON()
+}
+
+// GetAllOutputs will get all outputs from the stack, setting the role ARN of the association
+func (rs *PodIdentityRoleResourceSet) GetAllOutputs(stack cfn.Stack) error {
+	return rs.outputs.MustCollect(stack)
+}
@@ -0,0 +1,53 @@
+package builder_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/template/matchers"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("template builder for pod identity associations", func() {
+	It("can construct a role that can be assumed by EKS Pod Identity", func() {
+		association := &api.PodIdentityAssociation{
+			Namespace:          "backend",
+			ServiceAccountName: "s3-reader",
+			AttachPolicyARNs:   []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+			AttachPolicy: cft.MakePolicyDocument(cft.MapOfInterfaces{
+				"Effect":   "Allow",
+				"Action":   []string{"sqs:ReceiveMessage"},
+				"Resource": "*",
+			}),
+		}
+
+		rs := NewPodIdentityRoleResourceSet(association)
+		Expect(rs.WithNamedIAM()).To(BeFalse())
+
+		templateBody := []byte{}
+		Expect(rs).To(RenderWithoutErrors(&templateBody))
+
+		t := cft.NewTemplate()
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+
+		Expect(t.Description).To(Equal("IAM role for pod identity association \"backend/s3-reader\" [created and managed by eksctl]"))
+		Expect(t.Resources).To(HaveLen(2))
+		Expect(t).To(HaveResourceWithPropertyValue("Role1", "AssumeRolePolicyDocument", `{
+			"Version": "2012-10-17",
+			"Statement": [
+				{
+					"Effect": "Allow",
+					"Action": ["sts:AssumeRole", "sts:TagSession"],
+					"Principal": {"Service": ["pods.eks.amazonaws.com"]}
+				}
+			]
+		}`))
+		Expect(t).To(HaveResourceWithPropertyValue("Role1", "ManagedPolicyArns", `["arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"]`))
+		Expect(t).To(HaveResource("Policy1", "AWS::IAM::Policy"))
+		Expect(t).To(HaveOutputWithValue("Role1", `{ "Fn::GetAtt": "Role1.Arn" }`))
+	})
+})
@@ -0,0 +1,72 @@
+package builder_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/template/matchers"
+
+	. "github.com/weaveworks/eksctl/pkg/cfn/builder"
+)
+
+var _ = Describe("template builder for cluster registration", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "external"
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.SecurityGroup = "sg-control-plane"
+		cfg.VPC.SharedNodeSecurityGroup = "sg-cluster"
+		Expect(cfg.ImportSubnet(api.SubnetTopologyPrivate, "us-west-2a", "subnet-p1", "10.0.1.0/24")).To(Succeed())
+		Expect(cfg.ImportSubnet(api.SubnetTopologyPublic, "us-west-2a", "subnet-a", "10.0.0.0/24")).To(Succeed())
+		cfg.Status = &api.ClusterStatus{
+			Endpoint:                 "https://external.eks.amazonaws.com",
+			CertificateAuthorityData: []byte("ca"),
+			ARN:                      "arn:aws:eks:us-west-2:111122223333:cluster/external",
+		}
+	})
+
+	render := func() *cft.Template {
+		rs := NewClusterResourceSet(mockprovider.NewMockProvider(), cfg)
+		Expect(rs.AddResourcesForRegistration()).To(Succeed())
+
+		templateBody, err := rs.RenderJSON()
+		Expect(err).ToNot(HaveOccurred())
+
+		t := cft.NewTemplate()
+		Expect(t).To(LoadBytesWithoutErrors(templateBody))
+		return t
+	}
+
+	It("only defines outputs of the existing cluster", func() {
+		t := render()
+
+		Expect(t.Description).To(Equal("EKS cluster registration [created and managed by eksctl]"))
+
+		Expect(t.Resources).To(HaveLen(1))
+		Expect(t).To(HaveResource("ClusterRegistration", "AWS::CloudFormation::WaitConditionHandle"))
+
+		Expect(t).To(HaveOutputWithValue("VPC", `"vpc-1"`))
+		Expect(t).To(HaveOutputWithValue("SubnetsPrivate", `{ "Fn::Join": [ ",", [ "subnet-p1" ] ] }`))
+		Expect(t).To(HaveOutputWithValue("SubnetsPublic", `{ "Fn::Join": [ ",", [ "subnet-a" ] ] }`))
+		Expect(t).To(HaveOutputWithValue("SecurityGroup", `"sg-control-plane"`))
+		Expect(t).To(HaveOutputWithValue("SharedNodeSecurityGroup", `"sg-cluster"`))
+		Expect(t).To(HaveOutputWithValue("CertificateAuthorityData", `"Y2E="`))
+		Expect(t).To(HaveOutputWithValue("Endpoint", `"https://external.eks.amazonaws.com"`))
+		Expect(t).To(HaveOutputWithValue("ARN", `"arn:aws:eks:us-west-2:111122223333:cluster/external"`))
+		Expect(t).To(HaveOutputWithValue("FeatureRegistered", `true`))
+		Expect(t).To(HaveOutputWithValue("ClusterStackName", `{ "Ref": "AWS::StackName" }`))
+	})
+
+	It("requires the status of the cluster", func() {
+		cfg.Status = nil
+
+		rs := NewClusterResourceSet(mockprovider.NewMockProvider(), cfg)
+		Expect(rs.AddResourcesForRegistration()).To(MatchError(ContainSubstring("status of cluster \"external\" must be loaded")))
+	})
+})
@@ -11,7 +11,10 @@ import (
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
-var internetCIDR = gfn.NewString("0.0.0.0/0")
+var (
+	internetCIDR     = gfn.NewString("0.0.0.0/0")
+	internetCIDRIPv6 = gfn.NewString("::/0")
+)
 
 func (c *ClusterResourceSet) addSubnets(refRT *gfn.Value, topology api.SubnetTopology, subnets map[string]api.Network) {
 	var subnetIndexForIPv6 int
@@ -38,36 +41,36 @@ func (c *ClusterResourceSet) addSubnets(refRT *gfn.Value, topology api.SubnetTop
 			// Choose the appropriate route table for private subnets
 			refRT = gfn.MakeRef("PrivateRouteTable" + strings.ToUpper(strings.Join(strings.Split(az, "-"), "")))
 			subnet.Tags = []gfn.Tag{{
-				Key:   gfn.NewString("kubernetes.io/role/internal-elb"),
+				Key:   gfn.NewString(api.PrivateSubnetRoleTag),
 				Value: gfn.NewString("1"),
 			}}
 		case api.SubnetTopologyPublic:
 			subnet.Tags = []gfn.Tag{{
-				Key:   gfn.NewString("kubernetes.io/role/elb"),
+				Key:   gfn.NewString(api.PublicSubnetRoleTag),
 				Value: gfn.NewString("1"),
 			}}
 		}
-		refSubnet := c.newResource("Subnet"+alias, subnet)
+
+		var refSubnet *gfn.Value
+		if c.spec.IPv6Enabled() {
+			// pods get IPv6 addresses from the subnet, so the block must be
+			// assigned on creation and not by a separate resource
+			subnet.Ipv6CidrBlock = subnetCIDRv6(subnetIndexForIPv6)
+			subnet.AssignIpv6AddressOnCreation = gfn.True()
+			subnetIndexForIPv6++
+			refSubnet = c.newSubnetDependingOn("Subnet"+alias, subnet, "AutoAllocatedCIDRv6")
+		} else {
+			refSubnet = c.newResource("Subnet"+alias, subnet)
+		}
 		c.newResource("RouteTableAssociation"+alias, &gfn.AWSEC2SubnetRouteTableAssociation{
 			SubnetId:     refSubnet,
 			RouteTableId: refRT,
 		})
 
-		if api.IsEnabled(c.spec.VPC.AutoAllocateIPv6) {
-			// get 8 of /64 subnets from the auto-allocated IPv6 block,
-			// and pick one block based on subnetIndexForIPv6 counter;
-			// NOTE: this is done inside of CloudFormation using Fn::Cidr,
-			// we don't slice it here, just construct the JSON expression
-			// that does slicing at runtime.
-			refAutoAllocateCIDRv6 := gfn.MakeFnSelect(
-				0, gfn.MakeFnGetAttString("VPC.Ipv6CidrBlocks"),
-			)
-			refSubnetSlices := gfn.MakeFnCIDR(
-				refAutoAllocateCIDRv6, 8, 64,
-			)
+		if api.IsEnabled(c.spec.VPC.AutoAllocateIPv6) && !c.spec.IPv6Enabled() {
 			c.newResource(alias+"CIDRv6", &gfn.AWSEC2SubnetCidrBlock{
 				SubnetId:      refSubnet,
-				Ipv6CidrBlock: gfn.MakeFnSelect(subnetIndexForIPv6, refSubnetSlices),
+				Ipv6CidrBlock: subnetCIDRv6(subnetIndexForIPv6),
 			})
 			subnetIndexForIPv6++
 		}
@@ -76,6 +79,67 @@ func (c *ClusterResourceSet) addSubnets(refRT *gfn.Value, topology api.SubnetTop
 	}
 }
 
+// subnetCIDRv6 gets 8 of /64 subnets from the auto-allocated IPv6 block,
+// and picks one block based on the index;
+// NOTE: this is done inside of CloudFormation using Fn::Cidr,
+// we don't slice it here, just construct the JSON expression
+// that does slicing at runtime.
+func subnetCIDRv6(index int) *gfn.Value {
+	refAutoAllocateCIDRv6 := gfn.MakeFnSelect(
+		0, gfn.MakeFnGetAttString("VPC.Ipv6CidrBlocks"),
+	)
+	refSubnetSlices := gfn.MakeFnCIDR(
+		refAutoAllocateCIDRv6, 8, 64,
+	)
+	return gfn.MakeFnSelect(index, refSubnetSlices)
+}
+
+// newSubnetDependingOn adds a subnet that can only be created once the given CIDR block
+// is associated with the VPC, goformation resources cannot express DependsOn
+func (c *ClusterResourceSet) newSubnetDependingOn(name string, subnet *gfn.AWSEC2Subnet, cidrBlockResource string) *gfn.Value {
+	maybeSetNameTag(name, subnet)
+	properties := map[string]interface{}{
+		"AvailabilityZone": subnet.AvailabilityZone,
+		"CidrBlock":        subnet.CidrBlock,
+		"VpcId":            subnet.VpcId,
+		"Tags":             subnet.Tags,
+	}
+	if subnet.Ipv6CidrBlock != nil {
+		properties["Ipv6CidrBlock"] = subnet.Ipv6CidrBlock
+		properties["AssignIpv6AddressOnCreation"] = subnet.AssignIpv6AddressOnCreation
+	}
+	return c.rs.newResource(name, &awsCloudFormationResource{
+		Type:       subnet.AWSCloudFormationType(),
+		Properties: properties,
+		DependsOn:  []string{cidrBlockResource},
+	})
+}
+
+// addPodSubnets associates the secondary CIDR with the VPC and creates the subnets
+// that VPC CNI custom networking places pods in, they are routed like private subnets
+func (c *ClusterResourceSet) addPodSubnets() {
+	podSubnets := c.spec.VPC.PodSubnets
+	c.newResource("PodCIDR", &gfn.AWSEC2VPCCidrBlock{
+		VpcId:     c.vpc,
+		CidrBlock: gfn.NewString(podSubnets.CIDR.String()),
+	})
+
+	for az, subnet := range podSubnets.Subnets {
+		alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+
+		refSubnet := c.newSubnetDependingOn("SubnetPod"+alphanumericUpperAZ, &gfn.AWSEC2Subnet{
+			AvailabilityZone: gfn.NewString(az),
+			CidrBlock:        gfn.NewString(subnet.CIDR.String()),
+			VpcId:            c.vpc,
+		}, "PodCIDR")
+		c.newResource("RouteTableAssociationPod"+alphanumericUpperAZ, &gfn.AWSEC2SubnetRouteTableAssociation{
+			SubnetId:     refSubnet,
+			RouteTableId: gfn.MakeRef("PrivateRouteTable" + alphanumericUpperAZ),
+		})
+		c.podSubnets = append(c.podSubnets, refSubnet)
+	}
+}
+
 //nolint:interfacer
 func (c *ClusterResourceSet) addResourcesForVPC() error {
 
@@ -94,6 +158,32 @@ func (c *ClusterResourceSet) addResourcesForVPC() error {
 
 	c.subnets = make(map[api.SubnetTopology][]*gfn.Value)
 
+	if !c.spec.IsPrivateCluster() {
+		c.addPublicSubnets()
+	}
+
+	if err := c.addNATGateways(); err != nil {
+		return err
+	}
+
+	if c.spec.IPv6Enabled() {
+		c.addEgressOnlyInternetGateway()
+	}
+
+	c.addSubnets(nil, api.SubnetTopologyPrivate, c.spec.VPC.Subnets.Private)
+
+	if c.spec.IsPrivateCluster() {
+		c.addVPCEndpoints()
+	}
+
+	if c.spec.HasPodSubnets() {
+		c.addPodSubnets()
+	}
+	return nil
+}
+
+// addPublicSubnets adds the public subnets along with the internet gateway they route through
+func (c *ClusterResourceSet) addPublicSubnets() {
 	refIG := c.newResource("InternetGateway", &gfn.AWSEC2InternetGateway{})
 	c.newResource("VPCGatewayAttachment", &gfn.AWSEC2VPCGatewayAttachment{
 		InternetGatewayId: refIG,
@@ -110,14 +200,73 @@ func (c *ClusterResourceSet) addResourcesForVPC() error {
 		GatewayId:            refIG,
 	})
 
+	if c.spec.IPv6Enabled() {
+		c.newResource("PublicSubnetIPv6DefaultRoute", &gfn.AWSEC2Route{
+			RouteTableId:             refPublicRT,
+			DestinationIpv6CidrBlock: internetCIDRIPv6,
+			GatewayId:                refIG,
+		})
+	}
+
 	c.addSubnets(refPublicRT, api.SubnetTopologyPublic, c.spec.VPC.Subnets.Public)
+}
 
-	if err := c.addNATGateways(); err != nil {
-		return err
+// addVPCEndpoints lets nodes of a fully-private cluster reach the AWS services they need,
+// S3 is reached through a gateway endpoint, the others through interface endpoints
+func (c *ClusterResourceSet) addVPCEndpoints() {
+	refEndpointSG := c.newResource("VPCEndpointSecurityGroup", &gfn.AWSEC2SecurityGroup{
+		GroupDescription: gfn.NewString("Communication between the VPC and interface VPC endpoints"),
+		VpcId:            c.vpc,
+	})
+	c.newResource("IngressVPCEndpoints", &gfn.AWSEC2SecurityGroupIngress{
+		GroupId:     refEndpointSG,
+		CidrIp:      gfn.NewString(c.spec.VPC.CIDR.String()),
+		Description: gfn.NewString("Allow the VPC to reach interface VPC endpoints"),
+		IpProtocol:  sgProtoTCP,
+		FromPort:    sgPortHTTPS,
+		ToPort:      sgPortHTTPS,
+	})
+
+	var refPrivateRTs []*gfn.Value
+	for _, az := range c.spec.AvailabilityZones {
+		alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+		refPrivateRTs = append(refPrivateRTs, gfn.MakeRef("PrivateRouteTable"+alphanumericUpperAZ))
 	}
 
-	c.addSubnets(nil, api.SubnetTopologyPrivate, c.spec.VPC.Subnets.Private)
-	return nil
+	for _, service := range c.spec.EndpointServices() {
+		endpoint := &gfn.AWSEC2VPCEndpoint{
+			ServiceName: gfn.NewString(c.spec.EndpointServiceName(service)),
+			VpcId:       c.vpc,
+		}
+		if service == api.S3EndpointService {
+			endpoint.VpcEndpointType = gfn.NewString("Gateway")
+			endpoint.RouteTableIds = refPrivateRTs
+		} else {
+			endpoint.VpcEndpointType = gfn.NewString("Interface")
+			endpoint.PrivateDnsEnabled = gfn.True()
+			endpoint.SubnetIds = c.subnets[api.SubnetTopologyPrivate]
+			endpoint.SecurityGroupIds = []*gfn.Value{refEndpointSG}
+		}
+		c.newResource("VPCEndpoint"+strings.ToUpper(strings.Replace(service, ".", "", -1)), endpoint)
+	}
+}
+
+// addEgressOnlyInternetGateway routes outbound IPv6 traffic of private subnets,
+// NAT gateways only handle IPv4
+func (c *ClusterResourceSet) addEgressOnlyInternetGateway() {
+	refEIGW := c.newResource("EgressOnlyInternetGateway", &gfn.AWSEC2EgressOnlyInternetGateway{
+		VpcId: c.vpc,
+	})
+
+	for _, az := range c.spec.AvailabilityZones {
+		alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+
+		c.newResource("PrivateSubnetIPv6DefaultRoute"+alphanumericUpperAZ, &gfn.AWSEC2Route{
+			RouteTableId:                gfn.MakeRef("PrivateRouteTable" + alphanumericUpperAZ),
+			DestinationIpv6CidrBlock:    internetCIDRIPv6,
+			EgressOnlyInternetGatewayId: refEIGW,
+		})
+	}
 }
 
 func (c *ClusterResourceSet) addNATGateways() error {
@@ -168,10 +317,35 @@ func (c *ClusterResourceSet) addOutputsForVPC() {
 			return vpc.ImportSubnetsFromList(c.provider, c.spec, api.SubnetTopologyPublic, strings.Split(v, ","))
 		})
 	}
+	if len(c.podSubnets) > 0 {
+		c.rs.defineJoinedOutput(outputs.ClusterSubnetsPod, c.podSubnets, true, func(v string) error {
+			return vpc.ImportPodSubnetsFromList(c.provider, c.spec, strings.Split(v, ","))
+		})
+	}
+}
+
+// AddOutputsForZones adds the outputs of the subnets in availability zones that are added to
+// an existing cluster, AddAllResources must be called first
+func (c *ClusterResourceSet) AddOutputsForZones(zones []string) {
+	for _, az := range zones {
+		alphanumericUpperAZ := strings.ToUpper(strings.Join(strings.Split(az, "-"), ""))
+		for _, topology := range api.SubnetTopologies() {
+			subnets := c.spec.VPC.Subnets.Private
+			if topology == api.SubnetTopologyPublic {
+				subnets = c.spec.VPC.Subnets.Public
+			}
+			if _, ok := subnets[az]; !ok {
+				continue
+			}
+			refSubnet := gfn.MakeRef("Subnet" + string(topology) + alphanumericUpperAZ)
+			c.rs.defineOutputWithoutCollector(outputs.ClusterSubnetsInZone(topology, az), refSubnet, false)
+		}
+	}
 }
 
 var (
 	sgProtoTCP           = gfn.NewString("tcp")
+	sgProtoAll           = gfn.NewString("-1")
 	sgSourceAnywhereIPv4 = gfn.NewString("0.0.0.0/0")
 	sgSourceAnywhereIPv6 = gfn.NewString("::/0")
 
@@ -191,6 +365,7 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups() {
 			GroupDescription: gfn.NewString("Communication between the control plane and worker nodegroups"),
 			VpcId:            c.vpc,
 		})
+		c.addSecurityGroupRules("ControlPlane", refControlPlaneSG, c.spec.VPC.ControlPlaneSecurityGroupRules)
 	} else {
 		refControlPlaneSG = gfn.NewString(c.spec.VPC.SecurityGroup)
 	}
@@ -209,6 +384,7 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups() {
 			FromPort:              sgPortZero,
 			ToPort:                sgMaxNodePort,
 		})
+		c.addSecurityGroupRules("SharedNode", refClusterSharedNodeSG, c.spec.VPC.SharedNodeSecurityGroupRules)
 	} else {
 		refClusterSharedNodeSG = gfn.NewString(c.spec.VPC.SharedNodeSecurityGroup)
 	}
@@ -226,6 +402,65 @@ func (c *ClusterResourceSet) addResourcesForSecurityGroups() {
 	})
 }
 
+// addSecurityGroupRules adds the rules given in the config to a security group created by eksctl
+func (c *ClusterResourceSet) addSecurityGroupRules(name string, refSG *gfn.Value, rules *api.SecurityGroupRules) {
+	if rules == nil {
+		return
+	}
+
+	for i, rule := range rules.Ingress {
+		ingress := &gfn.AWSEC2SecurityGroupIngress{
+			GroupId:     refSG,
+			Description: sgRuleDescription(rule),
+			IpProtocol:  gfn.NewString(rule.Protocol),
+			FromPort:    sgRulePort(rule.FromPort),
+			ToPort:      sgRulePort(rule.ToPort),
+		}
+		switch {
+		case rule.CIDR == nil:
+			ingress.SourceSecurityGroupId = gfn.NewString(rule.SecurityGroupID)
+		case rule.IsIPv6():
+			ingress.CidrIpv6 = gfn.NewString(rule.CIDR.String())
+		default:
+			ingress.CidrIp = gfn.NewString(rule.CIDR.String())
+		}
+		c.newResource(fmt.Sprintf("Ingress%sRule%d", name, i), ingress)
+	}
+
+	for i, rule := range rules.Egress {
+		egress := &gfn.AWSEC2SecurityGroupEgress{
+			GroupId:     refSG,
+			Description: sgRuleDescription(rule),
+			IpProtocol:  gfn.NewString(rule.Protocol),
+			FromPort:    sgRulePort(rule.FromPort),
+			ToPort:      sgRulePort(rule.ToPort),
+		}
+		switch {
+		case rule.CIDR == nil:
+			egress.DestinationSecurityGroupId = gfn.NewString(rule.SecurityGroupID)
+		case rule.IsIPv6():
+			egress.CidrIpv6 = gfn.NewString(rule.CIDR.String())
+		default:
+			egress.CidrIp = gfn.NewString(rule.CIDR.String())
+		}
+		c.newResource(fmt.Sprintf("Egress%sRule%d", name, i), egress)
+	}
+}
+
+func sgRuleDescription(rule api.SecurityGroupRule) *gfn.Value {
+	if rule.Description == "" {
+		return nil
+	}
+	return gfn.NewString(rule.Description)
+}
+
+func sgRulePort(port *int) *gfn.Value {
+	if port == nil {
+		return nil
+	}
+	return gfn.NewInteger(*port)
+}
+
 func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 	for _, id := range n.spec.SecurityGroups.AttachIDs {
 		n.securityGroups = append(n.securityGroups, gfn.NewString(id))
@@ -236,6 +471,10 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		n.securityGroups = append(n.securityGroups, refClusterSharedNodeSG)
 	}
 
+	if api.IsEnabled(n.spec.EFAEnabled) {
+		n.addResourcesForEFASecurityGroup()
+	}
+
 	if api.IsDisabled(n.spec.SecurityGroups.WithLocal) {
 		return
 	}
@@ -297,7 +536,7 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 		FromPort:              sgPortHTTPS,
 		ToPort:                sgPortHTTPS,
 	})
-	if *n.spec.SSH.Allow {
+	if *n.spec.SSH.Allow && !api.IsEnabled(n.spec.SSH.EnableSSM) {
 		if n.spec.PrivateNetworking {
 			n.newResource("SSHIPv4", &gfn.AWSEC2SecurityGroupIngress{
 				GroupId:     refNodeGroupLocalSG,
@@ -328,6 +567,36 @@ func (n *NodeGroupResourceSet) addResourcesForSecurityGroups() {
 	}
 }
 
+// addResourcesForEFASecurityGroup adds the security group that EFA requires,
+// which allows all traffic from and to itself
+func (n *NodeGroupResourceSet) addResourcesForEFASecurityGroup() {
+	desc := "EFA-enabled worker nodes in group " + n.nodeGroupName
+
+	refEFASG := n.newResource("EFASG", &gfn.AWSEC2SecurityGroup{
+		VpcId:            makeImportValue(n.clusterStackName, outputs.ClusterVPC),
+		GroupDescription: gfn.NewString("Communication between " + desc),
+		Tags: []gfn.Tag{{
+			Key:   gfn.NewString("kubernetes.io/cluster/" + n.clusterSpec.Metadata.Name),
+			Value: gfn.NewString("owned"),
+		}},
+	})
+
+	n.securityGroups = append(n.securityGroups, refEFASG)
+
+	n.newResource("EFAIngressSelf", &gfn.AWSEC2SecurityGroupIngress{
+		GroupId:               refEFASG,
+		SourceSecurityGroupId: refEFASG,
+		Description:           gfn.NewString("Allow " + desc + " to receive all traffic from each other"),
+		IpProtocol:            sgProtoAll,
+	})
+	n.newResource("EFAEgressSelf", &gfn.AWSEC2SecurityGroupEgress{
+		GroupId:                    refEFASG,
+		DestinationSecurityGroupId: refEFASG,
+		Description:                gfn.NewString("Allow " + desc + " to send all traffic to each other"),
+		IpProtocol:                 sgProtoAll,
+	})
+}
+
 func (c *ClusterResourceSet) haNAT() {
 
 	for _, az := range c.spec.AvailabilityZones {
@@ -1,17 +1,20 @@
 package manager
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"regexp"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -42,6 +45,9 @@ type StackCollection struct {
 	provider   api.ClusterProvider
 	spec       *api.ClusterConfig
 	sharedTags []*cloudformation.Tag
+	ctx        context.Context
+	cache      *StackCache
+	poller     *StackPoller
 }
 
 func newTag(key, value string) *cloudformation.Tag {
@@ -57,6 +63,11 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) *
 	for key, value := range spec.Metadata.Tags {
 		tags = append(tags, newTag(key, value))
 	}
+	if spec.CloudFormation != nil {
+		for key, value := range spec.CloudFormation.StackTags {
+			tags = append(tags, newTag(key, value))
+		}
+	}
 	return &StackCollection{
 		provider:   provider,
 		spec:       spec,
@@ -64,10 +75,43 @@ func NewStackCollection(provider api.ClusterProvider, spec *api.ClusterConfig) *
 	}
 }
 
+// WithContext returns a copy of the stack collection whose waits stop once ctx is done,
+// e.g. when an embedding program or the user cancels the operation
+func (c *StackCollection) WithContext(ctx context.Context) *StackCollection {
+	copy := *c
+	copy.ctx = ctx
+	return &copy
+}
+
+// Context returns the context of the stack collection, which is never done unless one was set
+func (c *StackCollection) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
+}
+
+// WithCache returns a copy of the stack collection that looks up stacks in the given cache,
+// which it shares with the other stack collections that use it
+func (c *StackCollection) WithCache(cache *StackCache) *StackCollection {
+	copy := *c
+	copy.cache = cache
+	return &copy
+}
+
+// WithPoller returns a copy of the stack collection that waits for stacks by polling them with
+// the given poller, along with the stacks other stack collections that use it wait for
+func (c *StackCollection) WithPoller(poller *StackPoller) *StackCollection {
+	copy := *c
+	copy.poller = poller
+	return &copy
+}
+
 // DoCreateStackRequest requests the creation of a CloudFormation stack
 func (c *StackCollection) DoCreateStackRequest(i *Stack, templateBody []byte, tags, parameters map[string]string, withIAM bool, withNamedIAM bool) error {
 	input := &cloudformation.CreateStackInput{
-		StackName: i.StackName,
+		StackName:                   i.StackName,
+		EnableTerminationProtection: i.EnableTerminationProtection,
 	}
 
 	for _, t := range c.sharedTags {
@@ -91,6 +135,14 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateBody []byte, ta
 		input = input.SetRoleARN(cfnRole)
 	}
 
+	if cfg := c.spec.CloudFormation; cfg != nil && len(cfg.StackPolicy) > 0 {
+		stackPolicy, err := json.Marshal(cfg.StackPolicy)
+		if err != nil {
+			return errors.Wrap(err, "encoding stack policy")
+		}
+		input.SetStackPolicyBody(string(stackPolicy))
+	}
+
 	for k, v := range parameters {
 		p := &cloudformation.Parameter{
 			ParameterKey:   aws.String(k),
@@ -101,6 +153,7 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateBody []byte, ta
 
 	logger.Debug("CreateStackInput = %#v", input)
 	s, err := c.provider.CloudFormation().CreateStack(input)
+	c.cache.Invalidate()
 	if err != nil {
 		return errors.Wrapf(err, "creating CloudFormation stack %q", *i.StackName)
 	}
@@ -113,7 +166,12 @@ func (c *StackCollection) DoCreateStackRequest(i *Stack, templateBody []byte, ta
 // assume completion, do not expect more then one error value on the
 // channel, it's closed immediately after it is written to
 func (c *StackCollection) CreateStack(name string, stack builder.ResourceSet, tags, parameters map[string]string, errs chan error) error {
-	i := &Stack{StackName: &name}
+	return c.createStack(&Stack{StackName: &name}, stack, tags, parameters, errs)
+}
+
+// createStack creates the stack described by i, which may set stack options like
+// termination protection
+func (c *StackCollection) createStack(i *Stack, stack builder.ResourceSet, tags, parameters map[string]string, errs chan error) error {
 	templateBody, err := stack.RenderJSON()
 	if err != nil {
 		return errors.Wrapf(err, "rendering template for %q stack", *i.StackName)
@@ -123,28 +181,41 @@ func (c *StackCollection) CreateStack(name string, stack builder.ResourceSet, ta
 		return err
 	}
 
-	logger.Info("deploying stack %q", name)
+	logger.Info("deploying stack %q", *i.StackName)
 
 	go c.waitUntilStackIsCreated(i, stack, errs)
 
 	return nil
 }
 
-// UpdateStack will update a CloudFormation stack by creating and executing a ChangeSet
-func (c *StackCollection) UpdateStack(stackName string, changeSetName string, description string, template []byte, parameters map[string]string) error {
+// UpdateStack will update a CloudFormation stack by creating and executing a ChangeSet,
+// the changes are always logged, and in plan mode the ChangeSet is deleted instead of executed
+func (c *StackCollection) UpdateStack(stackName string, changeSetName string, description string, template []byte, parameters map[string]string, plan bool) error {
 	logger.Info(description)
 	i := &Stack{StackName: &stackName}
 	if err := c.doCreateChangeSetRequest(i, changeSetName, description, template, parameters, true); err != nil {
 		return err
 	}
-	if err := c.doWaitUntilChangeSetIsCreated(i, changeSetName); err != nil {
-		return err
-	}
+	waitErr := c.doWaitUntilChangeSetIsCreated(i, changeSetName)
 	changeSet, err := c.DescribeStackChangeSet(i, changeSetName)
 	if err != nil {
 		return err
 	}
+	if waitErr != nil {
+		if !isEmptyChangeSet(changeSet) {
+			return waitErr
+		}
+		logger.Info("no changes to stack %q", stackName)
+		return c.doDeleteChangeSet(stackName, changeSetName)
+	}
 	logger.Debug("changes = %#v", changeSet.Changes)
+	for _, line := range formatChanges(changeSet.Changes) {
+		logger.Info("%s", line)
+	}
+	if plan {
+		logger.Info("(plan) not executing ChangeSet %q for stack %q", changeSetName, stackName)
+		return c.doDeleteChangeSet(stackName, changeSetName)
+	}
 	if err := c.doExecuteChangeSet(stackName, changeSetName); err != nil {
 		logger.Warning("error executing Cloudformation changeSet %s in stack %s. Check the Cloudformation console for further details", changeSetName, stackName)
 		return err
@@ -167,22 +238,73 @@ func (c *StackCollection) DescribeStack(i *Stack) (*Stack, error) {
 	return resp.Stacks[0], nil
 }
 
-// ListStacks gets all of CloudFormation stacks
+// ListStacks gets all of CloudFormation stacks whose names match nameRegex and that are in one
+// of the given states, or in any state but DELETE_COMPLETE when no states are given; stacks that
+// aren't deleted are described by paging through all stacks once, rather than describing each
+// stack separately, so that accounts with thousands of stacks don't need thousands of calls
 func (c *StackCollection) ListStacks(nameRegex string, statusFilters ...string) ([]*Stack, error) {
+	re, err := regexp.Compile(nameRegex)
+	if err != nil {
+		return nil, errors.Wrap(err, "cannot list stacks")
+	}
+	if len(statusFilters) == 0 {
+		statusFilters = allNonDeletedStackStatuses()
+	}
+	if includesDeletedStacks(statusFilters) {
+		return c.listStacksIncludingDeleted(re, statusFilters)
+	}
+	return c.describeStacksMatching(re, statusFilters)
+}
+
+func includesDeletedStacks(statusFilters []string) bool {
+	for _, status := range statusFilters {
+		if status == cloudformation.StackStatusDeleteComplete {
+			return true
+		}
+	}
+	return false
+}
+
+// describeStacksMatching pages through all stacks that aren't deleted, DescribeStacks
+// returns them with all their details, unlike ListStacks; they are looked up in the
+// cache first, if there is one
+func (c *StackCollection) describeStacksMatching(re *regexp.Regexp, statusFilters []string) ([]*Stack, error) {
+	allStacks, generation, ok := c.cache.get()
+	if !ok {
+		allStacks = []*Stack{}
+		pager := func(p *cloudformation.DescribeStacksOutput, _ bool) bool {
+			allStacks = append(allStacks, p.Stacks...)
+			return true
+		}
+		if err := c.provider.CloudFormation().DescribeStacksPages(&cloudformation.DescribeStacksInput{}, pager); err != nil {
+			return nil, err
+		}
+		c.cache.set(allStacks, generation)
+	}
+
+	wanted := map[string]bool{}
+	for _, status := range statusFilters {
+		wanted[status] = true
+	}
+	stacks := []*Stack{}
+	for _, s := range allStacks {
+		if re.MatchString(*s.StackName) && wanted[*s.StackStatus] {
+			stacks = append(stacks, s)
+		}
+	}
+	return stacks, nil
+}
+
+// listStacksIncludingDeleted lists stacks in the given states and describes each of them,
+// as DescribeStacks only returns deleted stacks when asked for them by ID
+func (c *StackCollection) listStacksIncludingDeleted(re *regexp.Regexp, statusFilters []string) ([]*Stack, error) {
 	var (
 		subErr error
 		stack  *Stack
 	)
 
-	re, err := regexp.Compile(nameRegex)
-	if err != nil {
-		return nil, errors.Wrap(err, "cannot list stacks")
-	}
 	input := &cloudformation.ListStacksInput{
-		StackStatusFilter: defaultStackStatusFilter(),
-	}
-	if len(statusFilters) > 0 {
-		input.StackStatusFilter = aws.StringSlice(statusFilters)
+		StackStatusFilter: aws.StringSlice(statusFilters),
 	}
 	stacks := []*Stack{}
 
@@ -274,10 +396,6 @@ func allNonDeletedStackStatuses() []string {
 	}
 }
 
-func defaultStackStatusFilter() []*string {
-	return aws.StringSlice(allNonDeletedStackStatuses())
-}
-
 // DeleteStackByName sends a request to delete the stack
 func (c *StackCollection) DeleteStackByName(name string) (*Stack, error) {
 	i := &Stack{StackName: &name}
@@ -317,17 +435,28 @@ func (c *StackCollection) DeleteStackByNameSync(name string, errs chan error) er
 
 // DeleteStackBySpec sends a request to delete the stack
 func (c *StackCollection) DeleteStackBySpec(s *Stack) (*Stack, error) {
+	return c.deleteStackBySpec(s, nil)
+}
+
+// deleteStackBySpec sends a request to delete the stack, the resources to retain can only
+// be given when the stack is in DELETE_FAILED status
+func (c *StackCollection) deleteStackBySpec(s *Stack, retainResources []string) (*Stack, error) {
 	for _, tag := range s.Tags {
 		if matchesClusterName(*tag.Key, *tag.Value, c.spec.Metadata.Name) {
 			input := &cloudformation.DeleteStackInput{
 				StackName: s.StackId,
 			}
+			if len(retainResources) > 0 {
+				input.RetainResources = aws.StringSlice(retainResources)
+			}
 
 			if cfnRole := c.provider.CloudFormationRoleARN(); cfnRole != "" {
 				input = input.SetRoleARN(cfnRole)
 			}
 
-			if _, err := c.provider.CloudFormation().DeleteStack(input); err != nil {
+			_, err := c.provider.CloudFormation().DeleteStack(input)
+			c.cache.Invalidate()
+			if err != nil {
 				return nil, errors.Wrapf(err, "not able to delete stack %q", *s.StackName)
 			}
 			logger.Info("will delete stack %q", *s.StackName)
@@ -367,8 +496,92 @@ func (c *StackCollection) DeleteStackBySpecSync(s *Stack, errs chan error) error
 	return nil
 }
 
+// DeleteStackBySpecSyncRetainingResources is like DeleteStackBySpecSync, but when the deletion fails
+// because some resources can't be deleted, e.g. security groups that are still in use, it retries the
+// deletion retaining these resources, and logs them, as they have to be deleted manually
+func (c *StackCollection) DeleteStackBySpecSyncRetainingResources(s *Stack, errs chan error) error {
+	i, err := c.DeleteStackBySpec(s)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("waiting for stack %q to get deleted", *i.StackName)
+
+	go func() {
+		defer close(errs)
+
+		waitErr := c.doWaitUntilStackIsDeleted(i)
+		if waitErr == nil {
+			errs <- nil
+			return
+		}
+		failed, err := c.describeFailedDeletions(i)
+		if err != nil {
+			logger.Debug("not retrying deletion of stack %q: %s", *i.StackName, err.Error())
+		}
+		if len(failed) == 0 {
+			errs <- waitErr
+			return
+		}
+
+		retained := make([]string, 0, len(failed))
+		descriptions := make([]string, 0, len(failed))
+		for _, e := range failed {
+			retained = append(retained, *e.LogicalResourceId)
+			descriptions = append(descriptions, fmt.Sprintf("%s %q", aws.StringValue(e.ResourceType), aws.StringValue(e.PhysicalResourceId)))
+		}
+		logger.Warning("retrying deletion of stack %q, retaining resource(s) that could not be deleted: %s", *i.StackName, strings.Join(retained, ", "))
+		if _, err := c.deleteStackBySpec(i, retained); err != nil {
+			errs <- err
+			return
+		}
+		if err := c.doWaitUntilStackIsDeleted(i); err != nil {
+			errs <- err
+			return
+		}
+		logger.Warning("deleted stack %q, but retained %s, which have to be deleted manually", *i.StackName, strings.Join(descriptions, ", "))
+		errs <- nil
+	}()
+
+	return nil
+}
+
+// describeFailedDeletions returns the events of resources that failed to be deleted in the last
+// deletion of the stack, if the stack is in DELETE_FAILED status
+func (c *StackCollection) describeFailedDeletions(i *Stack) ([]*cloudformation.StackEvent, error) {
+	s, err := c.DescribeStack(i)
+	if err != nil {
+		return nil, err
+	}
+	if *s.StackStatus != cloudformation.StackStatusDeleteFailed {
+		return nil, nil
+	}
+	events, err := c.DescribeStackEvents(i)
+	if err != nil {
+		return nil, err
+	}
+
+	failed := []*cloudformation.StackEvent{}
+	seen := map[string]bool{}
+	// events are returned most recent first, so the ones of the last deletion come before the stack's DELETE_IN_PROGRESS event
+	for _, e := range events {
+		if aws.StringValue(e.LogicalResourceId) == *s.StackName {
+			if aws.StringValue(e.ResourceStatus) == cloudformation.ResourceStatusDeleteInProgress {
+				break
+			}
+			continue
+		}
+		if aws.StringValue(e.ResourceStatus) != cloudformation.ResourceStatusDeleteFailed || seen[*e.LogicalResourceId] {
+			continue
+		}
+		seen[*e.LogicalResourceId] = true
+		failed = append(failed, e)
+	}
+	return failed, nil
+}
+
 func fmtStacksRegexForCluster(name string) string {
-	const ourStackRegexFmt = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|addon-.+)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
+	const ourStackRegexFmt = "^(eksctl|EKS)-%s-((cluster|nodegroup-.+|managed-nodegroup-.+|addon-.+)|(VPC|ServiceRole|ControlPlane|DefaultNodeGroup))$"
 	return fmt.Sprintf(ourStackRegexFmt, name)
 }
 
@@ -388,6 +601,34 @@ func (c *StackCollection) DescribeStacks() ([]*Stack, error) {
 	return stacks, nil
 }
 
+// DescribeStacksAndResources calls DescribeStacks and fetches the template and resources of each stack
+func (c *StackCollection) DescribeStacksAndResources() ([]StackInfo, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	infos := []StackInfo{}
+	for _, s := range stacks {
+		template, err := c.GetStackTemplate(*s.StackName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting template for %q stack", *s.StackName)
+		}
+		resources, err := c.provider.CloudFormation().DescribeStackResources(&cloudformation.DescribeStackResourcesInput{
+			StackName: s.StackName,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting all resources for %q stack", *s.StackName)
+		}
+		infos = append(infos, StackInfo{
+			Stack:     s,
+			Resources: resources.StackResources,
+			Template:  &template,
+		})
+	}
+	return infos, nil
+}
+
 // DescribeStackEvents describes the events that have occurred on the stack
 func (c *StackCollection) DescribeStackEvents(i *Stack) ([]*cloudformation.StackEvent, error) {
 	input := &cloudformation.DescribeStackEventsInput{
@@ -445,7 +686,9 @@ func (c *StackCollection) doCreateChangeSetRequest(i *Stack, changeSetName strin
 	input.SetTemplateBody(string(templateBody))
 
 	if withIAM {
-		input.SetCapabilities(stackCapabilitiesIAM)
+		// templates with named IAM resources, e.g. roles of nodegroups or iamserviceaccounts,
+		// can only be updated with this capability, which also covers unnamed resources
+		input.SetCapabilities(stackCapabilitiesNamedIAM)
 	}
 
 	if cfnRole := c.provider.CloudFormationRoleARN(); cfnRole != "" {
@@ -477,13 +720,75 @@ func (c *StackCollection) doExecuteChangeSet(stackName string, changeSetName str
 
 	logger.Debug("executing changeSet, input = %#v", input)
 
-	if _, err := c.provider.CloudFormation().ExecuteChangeSet(input); err != nil {
+	_, err := c.provider.CloudFormation().ExecuteChangeSet(input)
+	c.cache.Invalidate()
+	if err != nil {
 		return errors.Wrapf(err, "executing CloudFormation ChangeSet %q for stack %q", changeSetName, stackName)
 	}
 	return nil
 }
 
-// DescribeStackChangeSet describes a ChangeSet by name
+func (c *StackCollection) doDeleteChangeSet(stackName string, changeSetName string) error {
+	input := &cloudformation.DeleteChangeSetInput{
+		ChangeSetName: &changeSetName,
+		StackName:     &stackName,
+	}
+
+	logger.Debug("deleting changeSet, input = %#v", input)
+
+	if _, err := c.provider.CloudFormation().DeleteChangeSet(input); err != nil {
+		return errors.Wrapf(err, "deleting CloudFormation ChangeSet %q for stack %q", changeSetName, stackName)
+	}
+	return nil
+}
+
+// isEmptyChangeSet checks if the ChangeSet failed only because the template is unchanged
+func isEmptyChangeSet(changeSet *ChangeSet) bool {
+	if aws.StringValue(changeSet.Status) != cloudformation.ChangeSetStatusFailed {
+		return false
+	}
+	reason := aws.StringValue(changeSet.StatusReason)
+	return strings.Contains(reason, "didn't contain changes") || strings.Contains(reason, "No updates are to be performed")
+}
+
+// formatChanges describes each resource change of a ChangeSet, followed by the changed attributes
+func formatChanges(changes []*cloudformation.Change) []string {
+	symbols := map[string]string{
+		cloudformation.ChangeActionAdd:    "+",
+		cloudformation.ChangeActionModify: "~",
+		cloudformation.ChangeActionRemove: "-",
+	}
+	lines := []string{}
+	for _, change := range changes {
+		rc := change.ResourceChange
+		if rc == nil {
+			continue
+		}
+		action := aws.StringValue(rc.Action)
+		line := fmt.Sprintf("%s %s %s %s", symbols[action], action, aws.StringValue(rc.ResourceType), aws.StringValue(rc.LogicalResourceId))
+		if rc.PhysicalResourceId != nil {
+			line += fmt.Sprintf(" (%s)", *rc.PhysicalResourceId)
+		}
+		if rc.Replacement != nil {
+			line += fmt.Sprintf(", replacement: %s", *rc.Replacement)
+		}
+		lines = append(lines, line)
+		for _, detail := range rc.Details {
+			if detail.Target == nil {
+				continue
+			}
+			target := aws.StringValue(detail.Target.Attribute)
+			if detail.Target.Name != nil {
+				target += "." + *detail.Target.Name
+			}
+			lines = append(lines, fmt.Sprintf("    %s, requires recreation: %s", target, aws.StringValue(detail.Target.RequiresRecreation)))
+		}
+	}
+	return lines
+}
+
+// DescribeStackChangeSet describes a ChangeSet by name, along with all of its changes,
+// which may take more than one page
 func (c *StackCollection) DescribeStackChangeSet(i *Stack, changeSetName string) (*ChangeSet, error) {
 	input := &cloudformation.DescribeChangeSetInput{
 		StackName:     i.StackName,
@@ -496,5 +801,15 @@ func (c *StackCollection) DescribeStackChangeSet(i *Stack, changeSetName string)
 	if err != nil {
 		return nil, errors.Wrapf(err, "describing CloudFormation ChangeSet %s for stack %s", changeSetName, *i.StackName)
 	}
+	for next := resp.NextToken; next != nil; {
+		input.NextToken = next
+		page, err := c.provider.CloudFormation().DescribeChangeSet(input)
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing CloudFormation ChangeSet %s for stack %s", changeSetName, *i.StackName)
+		}
+		resp.Changes = append(resp.Changes, page.Changes...)
+		next = page.NextToken
+	}
+	resp.NextToken = nil
 	return resp, nil
 }
@@ -0,0 +1,276 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection stack deletion", func() {
+	const stackName = "eksctl-test-cluster-nodegroup-ng-1"
+
+	var (
+		p     *mockprovider.MockProvider
+		sc    *StackCollection
+		stack *Stack
+	)
+
+	newEvent := func(logicalID, status string) *cfn.StackEvent {
+		return &cfn.StackEvent{
+			LogicalResourceId:  aws.String(logicalID),
+			PhysicalResourceId: aws.String("physical-" + logicalID),
+			ResourceType:       aws.String("AWS::EC2::SecurityGroup"),
+			ResourceStatus:     aws.String(status),
+		}
+	}
+
+	mockStack := func(status string, events ...*cfn.StackEvent) {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+			StackName:   aws.String(stackName),
+			StackStatus: aws.String(status),
+		}}}, nil)
+		p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackEventsOutput, last bool) bool)
+			consume(&cfn.DescribeStackEventsOutput{StackEvents: events}, true)
+		}).Return(nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+
+		stack = &Stack{
+			StackName: aws.String(stackName),
+			StackId:   aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/" + stackName + "/1"),
+			Tags:      []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}},
+		}
+	})
+
+	It("finds the resources that failed to be deleted in the last deletion", func() {
+		mockStack(cfn.StackStatusDeleteFailed,
+			newEvent(stackName, cfn.ResourceStatusDeleteFailed),
+			newEvent("SG", cfn.ResourceStatusDeleteFailed),
+			newEvent("EgressInterCluster", cfn.ResourceStatusDeleteComplete),
+			newEvent("SG", cfn.ResourceStatusDeleteFailed),
+			newEvent(stackName, cfn.ResourceStatusDeleteInProgress),
+			newEvent("NodeInstanceRole", cfn.ResourceStatusDeleteFailed),
+		)
+
+		failed, err := sc.describeFailedDeletions(stack)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failed).To(HaveLen(1))
+		Expect(*failed[0].LogicalResourceId).To(Equal("SG"))
+	})
+
+	It("doesn't retain resources of stacks that didn't fail to be deleted", func() {
+		mockStack(cfn.StackStatusDeleteInProgress, newEvent("SG", cfn.ResourceStatusDeleteFailed))
+
+		failed, err := sc.describeFailedDeletions(stack)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(failed).To(BeEmpty())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStackEventsPages", mock.Anything, mock.Anything)
+	})
+
+	It("retains the given resources", func() {
+		p.MockCloudFormation().On("DeleteStack", mock.Anything).Return(&cfn.DeleteStackOutput{}, nil)
+
+		_, err := sc.deleteStackBySpec(stack, []string{"SG"})
+		Expect(err).NotTo(HaveOccurred())
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "DeleteStack", &cfn.DeleteStackInput{
+			StackName:       stack.StackId,
+			RetainResources: aws.StringSlice([]string{"SG"}),
+		})
+	})
+})
+
+var _ = Describe("StackCollection stack creation", func() {
+	var (
+		p     *mockprovider.MockProvider
+		cfg   *api.ClusterConfig
+		input *cfn.CreateStackInput
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+
+		input = nil
+		p.MockCloudFormation().On("CreateStack", mock.Anything).Run(func(args mock.Arguments) {
+			input = args[0].(*cfn.CreateStackInput)
+		}).Return(&cfn.CreateStackOutput{StackId: aws.String("stack-id")}, nil)
+	})
+
+	It("sets the stack tags and the stack policy of the config", func() {
+		cfg.CloudFormation = &api.CloudFormation{
+			StackTags: map[string]string{"cost-center": "platform"},
+			StackPolicy: api.InlineDocument{
+				"Statement": []interface{}{
+					map[string]interface{}{
+						"Effect":    "Deny",
+						"Action":    "Update:Replace",
+						"Principal": "*",
+						"Resource":  "*",
+					},
+				},
+			},
+		}
+
+		stack := &Stack{StackName: aws.String("eksctl-test-cluster-cluster")}
+		Expect(NewStackCollection(p, cfg).DoCreateStackRequest(stack, []byte("{}"), nil, nil, false, false)).To(Succeed())
+		Expect(*stack.StackId).To(Equal("stack-id"))
+
+		Expect(input.Tags).To(ContainElement(&cfn.Tag{Key: aws.String("cost-center"), Value: aws.String("platform")}))
+		Expect(input.Tags).To(ContainElement(&cfn.Tag{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}))
+		Expect(*input.StackPolicyBody).To(MatchJSON(`{"Statement": [{"Effect": "Deny", "Action": "Update:Replace", "Principal": "*", "Resource": "*"}]}`))
+	})
+
+	It("doesn't set a stack policy by default", func() {
+		stack := &Stack{StackName: aws.String("eksctl-test-cluster-cluster")}
+		Expect(NewStackCollection(p, cfg).DoCreateStackRequest(stack, []byte("{}"), nil, nil, false, false)).To(Succeed())
+		Expect(input.StackPolicyBody).To(BeNil())
+	})
+})
+
+var _ = Describe("StackCollection stack listing", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("describes stacks that aren't deleted across all pages in a single pass", func() {
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{
+				{StackName: aws.String("eksctl-test-cluster-cluster"), StackStatus: aws.String(cfn.StackStatusCreateComplete)},
+				{StackName: aws.String("eksctl-other-cluster-cluster"), StackStatus: aws.String(cfn.StackStatusCreateComplete)},
+			}}, false)
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{
+				{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"), StackStatus: aws.String(cfn.StackStatusUpdateInProgress)},
+				{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-2"), StackStatus: aws.String(cfn.StackStatusCreateComplete)},
+			}}, true)
+		}).Return(nil)
+
+		stacks, err := sc.DescribeStacks()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stacks).To(HaveLen(3))
+
+		stacks, err = sc.ListStacks("^eksctl-test-cluster-", cfn.StackStatusCreateComplete)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stacks).To(HaveLen(2))
+		Expect(*stacks[0].StackName).To(Equal("eksctl-test-cluster-cluster"))
+		Expect(*stacks[1].StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-2"))
+
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "ListStacksPages", mock.Anything, mock.Anything)
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacks", mock.Anything)
+	})
+
+	It("describes stacks once until they change when there is a cache", func() {
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{
+				{StackName: aws.String("eksctl-test-cluster-cluster"), StackStatus: aws.String(cfn.StackStatusCreateComplete)},
+			}}, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("UpdateTerminationProtection", mock.Anything).Return(&cfn.UpdateTerminationProtectionOutput{}, nil)
+
+		sc = sc.WithCache(NewStackCache())
+		other := NewStackCollection(p, api.NewClusterConfig()).WithCache(sc.cache)
+
+		for i := 0; i < 3; i++ {
+			_, err := sc.DescribeStacks()
+			Expect(err).NotTo(HaveOccurred())
+		}
+		_, err := other.ListStacks("^eksctl-")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacksPages", 1)).To(BeTrue())
+
+		Expect(sc.SetDeletionProtection(true)).To(Succeed())
+		_, err = sc.DescribeStacks()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacksPages", 2)).To(BeTrue())
+	})
+
+	It("doesn't cache descriptions that were requested before the cache was invalidated", func() {
+		started, proceed := make(chan struct{}), make(chan struct{})
+		calls := 0
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			calls++
+			if calls == 1 {
+				close(started)
+				<-proceed
+			}
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{
+				{StackName: aws.String("eksctl-test-cluster-cluster"), StackStatus: aws.String(cfn.StackStatusCreateComplete)},
+			}}, true)
+		}).Return(nil)
+
+		sc = sc.WithCache(NewStackCache())
+		described := make(chan error)
+		go func() {
+			_, err := sc.DescribeStacks()
+			described <- err
+		}()
+
+		// a stack changes while the stacks are being described
+		<-started
+		sc.cache.Invalidate()
+		close(proceed)
+		Expect(<-described).To(Succeed())
+
+		_, err := sc.DescribeStacks()
+		Expect(err).NotTo(HaveOccurred())
+		_, err = sc.DescribeStacks()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacksPages", 2)).To(BeTrue())
+	})
+
+	It("describes deleted stacks by their ID", func() {
+		p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.ListStacksOutput{StackSummaries: []*cfn.StackSummary{
+				{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"), StackId: aws.String("stack-id-1")},
+			}}, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: aws.String("stack-id-1")}).Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{
+			{StackName: aws.String("eksctl-test-cluster-nodegroup-ng-1"), StackStatus: aws.String(cfn.StackStatusDeleteComplete)},
+		}}, nil)
+
+		stacks, err := sc.ListStacks("^eksctl-test-cluster-nodegroup-ng-1$", cfn.StackStatusDeleteComplete)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stacks).To(HaveLen(1))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacksPages", mock.Anything, mock.Anything)
+	})
+
+	It("describes all pages of changes of a ChangeSet", func() {
+		change := func(logicalID string) *cfn.Change {
+			return &cfn.Change{ResourceChange: &cfn.ResourceChange{LogicalResourceId: aws.String(logicalID)}}
+		}
+		p.MockCloudFormation().On("DescribeChangeSet", mock.MatchedBy(func(input *cfn.DescribeChangeSetInput) bool {
+			return input.NextToken == nil
+		})).Return(&cfn.DescribeChangeSetOutput{Changes: []*cfn.Change{change("A")}, NextToken: aws.String("page-2")}, nil).Once()
+		p.MockCloudFormation().On("DescribeChangeSet", mock.MatchedBy(func(input *cfn.DescribeChangeSetInput) bool {
+			return aws.StringValue(input.NextToken) == "page-2"
+		})).Return(&cfn.DescribeChangeSetOutput{Changes: []*cfn.Change{change("B")}}, nil).Once()
+
+		changeSet, err := sc.DescribeStackChangeSet(&Stack{StackName: aws.String("eksctl-test-cluster-cluster")}, "update-1")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changeSet.Changes).To(Equal([]*cfn.Change{change("A"), change("B")}))
+		Expect(changeSet.NextToken).To(BeNil())
+	})
+})
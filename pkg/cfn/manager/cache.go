@@ -0,0 +1,57 @@
+package manager
+
+import (
+	"sync"
+)
+
+// StackCache holds the descriptions of all stacks that aren't deleted, so that a command
+// that looks up stacks repeatedly pages through them once; StackCollection invalidates it
+// whenever it changes a stack or finishes waiting for one, a nil cache caches nothing
+type StackCache struct {
+	mutex  sync.Mutex
+	stacks []*Stack
+	valid  bool
+	// generation counts invalidations, so that descriptions that were requested before
+	// an invalidation aren't cached once they're received
+	generation uint64
+}
+
+// NewStackCache returns an empty cache
+func NewStackCache() *StackCache {
+	return &StackCache{}
+}
+
+// Invalidate drops the cached descriptions, e.g. after a stack was changed by other means
+func (c *StackCache) Invalidate() {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	c.stacks, c.valid = nil, false
+	c.generation++
+}
+
+// get returns the cached descriptions, if any, along with the generation to set new descriptions with
+func (c *StackCache) get() ([]*Stack, uint64, bool) {
+	if c == nil {
+		return nil, 0, false
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	return c.stacks, c.generation, c.valid
+}
+
+// set caches the descriptions that were requested at the given generation, unless the cache
+// was invalidated since, as they may predate the change
+func (c *StackCache) set(stacks []*Stack, generation uint64) {
+	if c == nil {
+		return
+	}
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+	if generation != c.generation {
+		return
+	}
+	c.stacks, c.valid = stacks, true
+}
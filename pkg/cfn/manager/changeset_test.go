@@ -0,0 +1,69 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StackCollection ChangeSets", func() {
+	It("formats resource changes with their details", func() {
+		changes := []*cfn.Change{
+			{
+				ResourceChange: &cfn.ResourceChange{
+					Action:            aws.String(cfn.ChangeActionAdd),
+					ResourceType:      aws.String("AWS::EC2::SecurityGroupIngress"),
+					LogicalResourceId: aws.String("IngressSharedNodeRule0"),
+				},
+			},
+			{
+				ResourceChange: &cfn.ResourceChange{
+					Action:             aws.String(cfn.ChangeActionModify),
+					ResourceType:       aws.String("AWS::AutoScaling::AutoScalingGroup"),
+					LogicalResourceId:  aws.String("NodeGroup"),
+					PhysicalResourceId: aws.String("eksctl-test-nodegroup-ng-1-NodeGroup-ABC"),
+					Replacement:        aws.String(cfn.ReplacementFalse),
+					Details: []*cfn.ResourceChangeDetail{
+						{
+							Target: &cfn.ResourceTargetDefinition{
+								Attribute:          aws.String(cfn.ResourceAttributeProperties),
+								Name:               aws.String("DesiredCapacity"),
+								RequiresRecreation: aws.String(cfn.RequiresRecreationNever),
+							},
+						},
+					},
+				},
+			},
+			{
+				ResourceChange: &cfn.ResourceChange{
+					Action:             aws.String(cfn.ChangeActionRemove),
+					ResourceType:       aws.String("AWS::EC2::Route"),
+					LogicalResourceId:  aws.String("PublicSubnetRoute"),
+					PhysicalResourceId: aws.String("rtb-1"),
+				},
+			},
+		}
+
+		Expect(formatChanges(changes)).To(Equal([]string{
+			"+ Add AWS::EC2::SecurityGroupIngress IngressSharedNodeRule0",
+			"~ Modify AWS::AutoScaling::AutoScalingGroup NodeGroup (eksctl-test-nodegroup-ng-1-NodeGroup-ABC), replacement: False",
+			"    Properties.DesiredCapacity, requires recreation: Never",
+			"- Remove AWS::EC2::Route PublicSubnetRoute (rtb-1)",
+		}))
+	})
+
+	It("recognises ChangeSets without changes", func() {
+		Expect(isEmptyChangeSet(&ChangeSet{
+			Status:       aws.String(cfn.ChangeSetStatusFailed),
+			StatusReason: aws.String("The submitted information didn't contain changes. Submit different information to create a change set."),
+		})).To(BeTrue())
+		Expect(isEmptyChangeSet(&ChangeSet{
+			Status:       aws.String(cfn.ChangeSetStatusFailed),
+			StatusReason: aws.String("Template format error"),
+		})).To(BeFalse())
+		Expect(isEmptyChangeSet(&ChangeSet{
+			Status: aws.String(cfn.ChangeSetStatusCreateComplete),
+		})).To(BeFalse())
+	})
+})
@@ -2,18 +2,22 @@ package manager
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 
+	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
 // MakeChangeSetName builds a consistent name for a changeset.
@@ -35,9 +39,38 @@ func (c *StackCollection) createClusterTask(errs chan error) error {
 	}
 
 	// Unlike with `createNodeGroupTask`, all tags are already set for the cluster stack
+	i := &Stack{StackName: &name}
+	if api.IsEnabled(c.spec.Metadata.DeletionProtection) {
+		i.EnableTerminationProtection = aws.Bool(true)
+	}
+	return c.createStack(i, stack, nil, nil, errs)
+}
+
+// NewTaskToRegisterCluster defines the task to create the stack of a cluster that
+// wasn't created by eksctl, see builder.AddResourcesForRegistration
+func (c *StackCollection) NewTaskToRegisterCluster() Task {
+	return &taskWithoutParams{
+		info: fmt.Sprintf("create registration stack for cluster %q", c.spec.Metadata.Name),
+		call: c.registerClusterTask,
+	}
+}
+
+func (c *StackCollection) registerClusterTask(errs chan error) error {
+	name := c.makeClusterStackName()
+	logger.Info("building registration stack %q", name)
+	stack := builder.NewClusterResourceSet(c.provider, c.spec)
+	if err := stack.AddResourcesForRegistration(); err != nil {
+		return err
+	}
 	return c.CreateStack(name, stack, nil, nil, errs)
 }
 
+// IsRegisteredClusterStack checks if the cluster stack was created by `eksctl register cluster`,
+// i.e. the control plane is not part of it
+func IsRegisteredClusterStack(s *Stack) bool {
+	return outputs.Exists(*s, outputs.ClusterFeatureRegistered)
+}
+
 // DescribeClusterStack calls DescribeStacks and filters out cluster stack
 func (c *StackCollection) DescribeClusterStack() (*Stack, error) {
 	stacks, err := c.DescribeStacks()
@@ -56,6 +89,65 @@ func (c *StackCollection) DescribeClusterStack() (*Stack, error) {
 	return nil, c.errStackNotFound()
 }
 
+// ListClusterNames lists the names of clusters that have eksctl-managed cluster stacks in the region
+func (c *StackCollection) ListClusterNames() ([]string, error) {
+	stacks, err := c.ListStacks("^(eksctl-.+-cluster|EKS-.+-ControlPlane)$")
+	if err != nil {
+		return nil, errors.Wrap(err, "listing cluster stacks")
+	}
+	names := []string{}
+	for _, s := range stacks {
+		if name := getClusterName(s); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names, nil
+}
+
+// IsDeletionProtected checks if termination protection is enabled on the cluster stack
+func IsDeletionProtected(s *Stack) bool {
+	return aws.BoolValue(s.EnableTerminationProtection)
+}
+
+// CheckDeletionProtection returns an error if termination protection is enabled on the
+// cluster stack, unless it's going to be disabled
+func (c *StackCollection) CheckDeletionProtection(disable bool) error {
+	clusterStack, err := c.DescribeClusterStack()
+	if err != nil || disable || !IsDeletionProtected(clusterStack) {
+		// a missing cluster stack is handled when the deletion tasks are defined
+		return nil
+	}
+	return fmt.Errorf("cluster %q has deletion protection enabled, use --disable-protection to delete it", c.spec.Metadata.Name)
+}
+
+// DisableDeletionProtection disables termination protection of the cluster stack if it's enabled
+func (c *StackCollection) DisableDeletionProtection() error {
+	clusterStack, err := c.DescribeClusterStack()
+	if err != nil || !IsDeletionProtected(clusterStack) {
+		return nil
+	}
+	return c.SetDeletionProtection(false)
+}
+
+// SetDeletionProtection enables or disables termination protection of the cluster stack
+func (c *StackCollection) SetDeletionProtection(enabled bool) error {
+	name := c.makeClusterStackName()
+	_, err := c.provider.CloudFormation().UpdateTerminationProtection(&cfn.UpdateTerminationProtectionInput{
+		StackName:                   &name,
+		EnableTerminationProtection: &enabled,
+	})
+	c.cache.Invalidate()
+	if err != nil {
+		return errors.Wrapf(err, "updating termination protection of stack %q", name)
+	}
+	if enabled {
+		logger.Info("enabled deletion protection of cluster %q", c.spec.Metadata.Name)
+	} else {
+		logger.Info("disabled deletion protection of cluster %q", c.spec.Metadata.Name)
+	}
+	return nil
+}
+
 // AppendNewClusterStackResource will update cluster
 // stack with new resources in append-only way
 func (c *StackCollection) AppendNewClusterStackResource(plan bool) (bool, error) {
@@ -67,15 +159,13 @@ func (c *StackCollection) AppendNewClusterStackResource(plan bool) (bool, error)
 	//   is managed as part of the stack;
 	// - CloudFormation cannot yet upgrade EKS control plane itself;
 
-	currentTemplate, err := c.GetStackTemplate(name)
+	currentTemplate, err := c.getClusterStackTemplate(name)
 	if err != nil {
-		return false, errors.Wrapf(err, "error getting stack template %s", name)
+		return false, err
 	}
-
-	currentResources := gjson.Get(currentTemplate, resourcesRootPath)
-	currentOutputs := gjson.Get(currentTemplate, outputsRootPath)
-	if !currentResources.IsObject() || !currentOutputs.IsObject() {
-		return false, fmt.Errorf("unexpected template format of the current stack ")
+	if gjson.Get(currentTemplate, outputsRootPath).Get(outputs.ClusterFeatureRegistered).Exists() {
+		logger.Info("stack %q only registers cluster %q, no resources can be added to it", name, c.spec.Metadata.Name)
+		return false, nil
 	}
 
 	logger.Info("re-building cluster stack %q", name)
@@ -84,6 +174,111 @@ func (c *StackCollection) AppendNewClusterStackResource(plan bool) (bool, error)
 		return false, err
 	}
 
+	return c.appendToClusterStack(name, currentTemplate, newStack, plan)
+}
+
+// AppendAvailabilityZonesToClusterStack will update cluster stack with subnets in the given
+// availability zones, routed in the same way as the subnets the cluster was created with;
+// the subnets are not added to the control plane, they are meant for nodegroups
+func (c *StackCollection) AppendAvailabilityZonesToClusterStack(zones []string, plan bool) (bool, error) {
+	name := c.makeClusterStackName()
+
+	currentTemplate, err := c.getClusterStackTemplate(name)
+	if err != nil {
+		return false, err
+	}
+	currentResources := gjson.Get(currentTemplate, resourcesRootPath)
+	if !currentResources.Get("VPC").Exists() {
+		return false, fmt.Errorf("VPC of cluster %q was not created by eksctl, subnets in availability zones %v must be added to it directly", c.spec.Metadata.Name, zones)
+	}
+	if currentResources.Get("AutoAllocatedCIDRv6").Exists() {
+		return false, fmt.Errorf("availability zones cannot be added to cluster %q, as its subnets have IPv6 CIDRs", c.spec.Metadata.Name)
+	}
+	if !currentResources.Get("InternetGateway").Exists() {
+		// the S3 gateway endpoint would have to be associated with the new route tables
+		return false, fmt.Errorf("availability zones cannot be added to fully-private cluster %q", c.spec.Metadata.Name)
+	}
+
+	// the VPC is re-built as if the cluster was created with the new zones,
+	// the resources of the existing zones are already in the stack
+	spec := c.spec.DeepCopy()
+	spec.VPC.ID = ""
+	gateway := natModeOf(currentResources)
+	spec.VPC.NAT = &api.ClusterNAT{Gateway: &gateway}
+	spec.VPC.AutoAllocateIPv6 = api.Disabled()
+	spec.PrivateCluster = nil
+	spec.AvailabilityZones = nil
+	for _, subnets := range []map[string]api.Network{spec.VPC.Subnets.Public, spec.VPC.Subnets.Private} {
+		for az := range subnets {
+			spec.AppendAvailabilityZone(az)
+		}
+	}
+	sort.Strings(spec.AvailabilityZones)
+	if err := vpc.SetSubnetsForZones(spec, zones); err != nil {
+		return false, err
+	}
+	if spec.HasPodSubnets() {
+		logger.Warning("no pod subnets are added in availability zones %v", zones)
+	}
+
+	logger.Info("adding availability zones %v to cluster stack %q", zones, name)
+	newStack := builder.NewClusterResourceSet(c.provider, spec)
+	if err := newStack.AddAllResources(); err != nil {
+		return false, err
+	}
+	newStack.AddOutputsForZones(zones)
+
+	return c.appendToClusterStack(name, currentTemplate, newStack, plan)
+}
+
+// NewTasksToAddAvailabilityZones defines tasks required to add subnets in the given
+// availability zones to the cluster stack
+func (c *StackCollection) NewTasksToAddAvailabilityZones(zones []string, plan bool) *TaskTree {
+	tasks := &TaskTree{PlanMode: plan}
+	tasks.Append(&asyncTaskWithoutParams{
+		info: fmt.Sprintf("add subnets in availability zones %v to cluster %q", zones, c.spec.Metadata.Name),
+		call: func() error {
+			_, err := c.AppendAvailabilityZonesToClusterStack(zones, plan)
+			return err
+		},
+	})
+	return tasks
+}
+
+// natModeOf tells the NAT gateway mode from the resources of a cluster stack, as stacks of
+// older clusters don't have the output for it
+func natModeOf(resources gjson.Result) string {
+	if resources.Get("NATGateway").Exists() {
+		return api.ClusterSingleNAT
+	}
+	mode := api.ClusterDisableNAT
+	resources.ForEach(func(k, _ gjson.Result) bool {
+		if strings.HasPrefix(k.String(), "NATGateway") {
+			mode = api.ClusterHighlyAvailableNAT
+			return false
+		}
+		return true
+	})
+	return mode
+}
+
+func (c *StackCollection) getClusterStackTemplate(name string) (string, error) {
+	currentTemplate, err := c.GetStackTemplate(name)
+	if err != nil {
+		return "", errors.Wrapf(err, "error getting stack template %s", name)
+	}
+	if !gjson.Get(currentTemplate, resourcesRootPath).IsObject() || !gjson.Get(currentTemplate, outputsRootPath).IsObject() {
+		return "", fmt.Errorf("unexpected template format of the current stack ")
+	}
+	return currentTemplate, nil
+}
+
+// appendToClusterStack updates the cluster stack with the resources and outputs of newStack
+// that are not in the current template, the existing ones are left as they are
+func (c *StackCollection) appendToClusterStack(name, currentTemplate string, newStack *builder.ClusterResourceSet, plan bool) (bool, error) {
+	currentResources := gjson.Get(currentTemplate, resourcesRootPath)
+	currentOutputs := gjson.Get(currentTemplate, outputsRootPath)
+
 	newTemplate, err := newStack.RenderJSON()
 	if err != nil {
 		return false, errors.Wrapf(err, "rendering template for %q stack", name)
@@ -136,11 +331,7 @@ func (c *StackCollection) AppendNewClusterStackResource(plan bool) (bool, error)
 	logger.Debug("currentTemplate = %s", currentTemplate)
 
 	describeUpdate := fmt.Sprintf("updating stack to add new resources %v and ouputs %v", addResources, addOutputs)
-	if plan {
-		logger.Info("(plan) %s", describeUpdate)
-		return false, nil
-	}
-	return true, c.UpdateStack(name, c.MakeChangeSetName("update-cluster"), describeUpdate, []byte(currentTemplate), nil)
+	return true, c.UpdateStack(name, c.MakeChangeSetName("update-cluster"), describeUpdate, []byte(currentTemplate), nil, plan)
 }
 
 func getClusterName(s *Stack) string {
@@ -0,0 +1,96 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection deletion protection", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	mockClusterStack := func(protected bool) {
+		stackName := "eksctl-test-cluster-cluster"
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:                   aws.String(stackName),
+				StackStatus:                 aws.String(cfn.StackStatusCreateComplete),
+				EnableTerminationProtection: aws.Bool(protected),
+				Tags:                        []*cfn.Tag{{Key: aws.String(api.ClusterNameTag), Value: aws.String("test-cluster")}},
+			}}}, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("UpdateTerminationProtection", mock.Anything).Return(&cfn.UpdateTerminationProtectionOutput{}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("allows deletion of unprotected clusters", func() {
+		mockClusterStack(false)
+		Expect(sc.CheckDeletionProtection(false)).To(Succeed())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "UpdateTerminationProtection", mock.Anything)
+	})
+
+	It("refuses to delete protected clusters", func() {
+		mockClusterStack(true)
+		Expect(sc.CheckDeletionProtection(false)).To(MatchError(`cluster "test-cluster" has deletion protection enabled, use --disable-protection to delete it`))
+	})
+
+	It("disables the protection when asked to", func() {
+		mockClusterStack(true)
+		Expect(sc.CheckDeletionProtection(true)).To(Succeed())
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "UpdateTerminationProtection", mock.Anything)
+
+		Expect(sc.DisableDeletionProtection()).To(Succeed())
+		p.MockCloudFormation().AssertCalled(GinkgoT(), "UpdateTerminationProtection", &cfn.UpdateTerminationProtectionInput{
+			StackName:                   aws.String("eksctl-test-cluster-cluster"),
+			EnableTerminationProtection: aws.Bool(false),
+		})
+	})
+})
+
+var _ = Describe("StackCollection availability zones", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	mockClusterTemplate := func(template string) {
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+			TemplateBody: aws.String(template),
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("refuses to add zones to a VPC that wasn't created by eksctl", func() {
+		mockClusterTemplate(`{"Resources": {"ControlPlane": {}}, "Outputs": {}}`)
+		_, err := sc.AppendAvailabilityZonesToClusterStack([]string{"us-west-2d"}, false)
+		Expect(err).To(MatchError(`VPC of cluster "test-cluster" was not created by eksctl, subnets in availability zones [us-west-2d] must be added to it directly`))
+	})
+
+	It("refuses to add zones to a fully-private cluster", func() {
+		mockClusterTemplate(`{"Resources": {"VPC": {}, "ControlPlane": {}}, "Outputs": {}}`)
+		_, err := sc.AppendAvailabilityZonesToClusterStack([]string{"us-west-2d"}, false)
+		Expect(err).To(MatchError(`availability zones cannot be added to fully-private cluster "test-cluster"`))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateChangeSet", mock.Anything)
+	})
+})
@@ -9,8 +9,9 @@ import (
 )
 
 // NewTasksToCreateClusterWithNodeGroups defines all tasks required to create a cluster along
-// with some nodegroups; see CreateAllNodeGroups for how onlyNodeGroupSubset works
-func (c *StackCollection) NewTasksToCreateClusterWithNodeGroups(nodeGroups []*api.NodeGroup) *TaskTree {
+// with some nodegroups and managed nodegroups; see CreateAllNodeGroups for how onlyNodeGroupSubset works;
+// postClusterCreationTasks run after the control plane is created and before any nodegroups
+func (c *StackCollection) NewTasksToCreateClusterWithNodeGroups(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup, postClusterCreationTasks ...Task) *TaskTree {
 	tasks := &TaskTree{Parallel: false}
 
 	tasks.Append(
@@ -19,8 +20,10 @@ func (c *StackCollection) NewTasksToCreateClusterWithNodeGroups(nodeGroups []*ap
 			call: c.createClusterTask,
 		},
 	)
+	tasks.Append(postClusterCreationTasks...)
 
 	nodeGroupTasks := c.NewTasksToCreateNodeGroups(nodeGroups)
+	nodeGroupTasks.Append(c.NewTasksToCreateManagedNodeGroups(managedNodeGroups).tasks...)
 	if nodeGroupTasks.Len() > 0 {
 		nodeGroupTasks.IsSubTask = true
 		tasks.Append(nodeGroupTasks)
@@ -37,6 +40,7 @@ func (c *StackCollection) NewTasksToCreateNodeGroups(nodeGroups []*api.NodeGroup
 		tasks.Append(&taskWithNodeGroupSpec{
 			info:      fmt.Sprintf("create nodegroup %q", ng.NameString()),
 			nodeGroup: ng,
+			stack:     c.makeNodeGroupStackName(ng.Name),
 			call:      c.createNodeGroupTask,
 		})
 		// TODO: move authconfigmap tasks here using kubernetesTask and kubernetes.CallbackClientSet
@@ -45,6 +49,39 @@ func (c *StackCollection) NewTasksToCreateNodeGroups(nodeGroups []*api.NodeGroup
 	return tasks
 }
 
+// NewTasksToCreateManagedNodeGroups defines tasks required to create all of the managed nodegroups
+func (c *StackCollection) NewTasksToCreateManagedNodeGroups(nodeGroups []*api.ManagedNodeGroup) *TaskTree {
+	tasks := &TaskTree{Parallel: true}
+
+	for _, ng := range nodeGroups {
+		tasks.Append(&taskWithManagedNodeGroupSpec{
+			info:      fmt.Sprintf("create managed nodegroup %q", ng.NameString()),
+			nodeGroup: ng,
+			stack:     c.makeManagedNodeGroupStackName(ng.Name),
+			call:      c.createManagedNodeGroupTask,
+		})
+	}
+
+	return tasks
+}
+
+// NewTasksToUpdateIAMServiceAccounts defines tasks required to update the IAM roles of existing IAM ServiceAccounts
+func (c *StackCollection) NewTasksToUpdateIAMServiceAccounts(serviceAccounts []*api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) *TaskTree {
+	tasks := &TaskTree{Parallel: true}
+
+	for i := range serviceAccounts {
+		sa := serviceAccounts[i]
+		tasks.Append(&taskWithClusterIAMServiceAccountSpec{
+			info:           fmt.Sprintf("update IAM role for serviceaccount %q", sa.NameString()),
+			serviceAccount: sa,
+			oidc:           oidc,
+			call:           c.updateIAMServiceAccountTask,
+		})
+	}
+
+	return tasks
+}
+
 // NewTasksToCreateIAMServiceAccounts defines tasks required to create all of the IAM ServiceAccounts
 func (c *StackCollection) NewTasksToCreateIAMServiceAccounts(serviceAccounts []*api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) *TaskTree {
 	tasks := &TaskTree{Parallel: true}
@@ -0,0 +1,154 @@
+package manager
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+)
+
+// stackImports maps names of stacks to the names of the stacks that import their exports
+type stackImports map[string][]string
+
+// describeStackImports finds the stacks that import exports of the given stacks
+func (c *StackCollection) describeStackImports(stacks []*Stack) (stackImports, error) {
+	imports := stackImports{}
+	for _, s := range stacks {
+		for _, o := range s.Outputs {
+			if o.ExportName == nil {
+				continue
+			}
+			importers, err := c.listImports(*o.ExportName)
+			if err != nil {
+				return nil, err
+			}
+			imports[*s.StackName] = append(imports[*s.StackName], importers...)
+		}
+	}
+	return imports, nil
+}
+
+func (c *StackCollection) listImports(exportName string) ([]string, error) {
+	input := &cloudformation.ListImportsInput{
+		ExportName: &exportName,
+	}
+	importers := []string{}
+	pager := func(p *cloudformation.ListImportsOutput, _ bool) bool {
+		importers = append(importers, aws.StringValueSlice(p.Imports)...)
+		return true
+	}
+	if err := c.provider.CloudFormation().ListImportsPages(input, pager); err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && strings.Contains(awsErr.Message(), "is not imported by any stack") {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "listing imports of export %q", exportName)
+	}
+	return importers, nil
+}
+
+// checkExternalImports returns an error if stacks other than the given ones import exports
+// of the given stacks, as these can't be deleted until the importing stacks are gone
+func (imports stackImports) checkExternalImports(stacks []*Stack) error {
+	names := map[string]bool{}
+	for _, s := range stacks {
+		names[*s.StackName] = true
+	}
+	external := []string{}
+	for _, s := range stacks {
+		for _, importer := range imports[*s.StackName] {
+			if !names[importer] {
+				external = append(external, fmt.Sprintf("%q imports exports of %q", importer, *s.StackName))
+			}
+		}
+	}
+	if len(external) > 0 {
+		sort.Strings(external)
+		return fmt.Errorf("stacks that are not managed by eksctl depend on the cluster, they have to be deleted first: %s", strings.Join(external, ", "))
+	}
+	return nil
+}
+
+// orderForDeletion sorts the names of stacks into groups that can be deleted in parallel,
+// so that stacks are deleted after the stacks that import their exports
+func (imports stackImports) orderForDeletion(names []string) ([][]string, error) {
+	remaining := map[string]bool{}
+	for _, name := range names {
+		remaining[name] = true
+	}
+
+	groups := [][]string{}
+	for len(remaining) > 0 {
+		group := []string{}
+		for _, name := range names {
+			if !remaining[name] {
+				continue
+			}
+			imported := false
+			for _, importer := range imports[name] {
+				if importer != name && remaining[importer] {
+					imported = true
+					break
+				}
+			}
+			if !imported {
+				group = append(group, name)
+			}
+		}
+		if len(group) == 0 {
+			cycle := []string{}
+			for name := range remaining {
+				cycle = append(cycle, name)
+			}
+			sort.Strings(cycle)
+			return nil, fmt.Errorf("stacks %s import exports of each other, they cannot be deleted", strings.Join(cycle, ", "))
+		}
+		for _, name := range group {
+			delete(remaining, name)
+		}
+		groups = append(groups, group)
+	}
+	return groups, nil
+}
+
+// orderNodeGroupDeletions splits the parallel tasks that delete nodegroups into sequential groups of parallel tasks,
+// when stacks of nodegroups import exports of other nodegroups; tasks that don't delete stacks run in the first group
+func (imports stackImports) orderNodeGroupDeletions(tasks *TaskTree) (*TaskTree, error) {
+	names := []string{}
+	for _, t := range tasks.tasks {
+		if st, ok := t.(stackTask); ok {
+			names = append(names, st.stackName())
+		}
+	}
+	groups, err := imports.orderForDeletion(names)
+	if err != nil {
+		return nil, err
+	}
+	if len(groups) <= 1 {
+		return tasks, nil
+	}
+
+	groupOf := map[string]int{}
+	for i, group := range groups {
+		for _, name := range group {
+			groupOf[name] = i
+		}
+	}
+	ordered := &TaskTree{Parallel: false, IsSubTask: tasks.IsSubTask}
+	subTrees := make([]*TaskTree, len(groups))
+	for i := range subTrees {
+		subTrees[i] = &TaskTree{Parallel: true, IsSubTask: true}
+		ordered.Append(subTrees[i])
+	}
+	for _, t := range tasks.tasks {
+		i := 0
+		if st, ok := t.(stackTask); ok {
+			i = groupOf[st.stackName()]
+		}
+		subTrees[i].Append(t)
+	}
+	return ordered, nil
+}
@@ -0,0 +1,83 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection deletion order", func() {
+	newStack := func(name string, exports ...string) *Stack {
+		s := &Stack{StackName: aws.String(name)}
+		for _, e := range exports {
+			s.Outputs = append(s.Outputs, &cfn.Output{OutputKey: aws.String(e), ExportName: aws.String(name + "::" + e)})
+		}
+		return s
+	}
+
+	It("lists the stacks that import exports", func() {
+		p := mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc := NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("ListImportsPages", &cfn.ListImportsInput{ExportName: aws.String("eksctl-test-cluster-cluster::VPC")}, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.ListImportsOutput, last bool) bool)
+			consume(&cfn.ListImportsOutput{Imports: aws.StringSlice([]string{"eksctl-test-cluster-nodegroup-ng-1", "other"})}, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("ListImportsPages", &cfn.ListImportsInput{ExportName: aws.String("eksctl-test-cluster-cluster::ARN")}, mock.Anything).
+			Return(awserr.New("ValidationError", "Export 'eksctl-test-cluster-cluster::ARN' is not imported by any stack.", nil))
+
+		stacks := []*Stack{newStack("eksctl-test-cluster-cluster", "VPC", "ARN"), newStack("eksctl-test-cluster-nodegroup-ng-1")}
+		imports, err := sc.describeStackImports(stacks)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(imports).To(Equal(stackImports{"eksctl-test-cluster-cluster": {"eksctl-test-cluster-nodegroup-ng-1", "other"}}))
+
+		Expect(imports.checkExternalImports(stacks)).To(MatchError(`stacks that are not managed by eksctl depend on the cluster, they have to be deleted first: "other" imports exports of "eksctl-test-cluster-cluster"`))
+	})
+
+	It("orders stacks after the stacks that import their exports", func() {
+		imports := stackImports{
+			"ng-shared": {"ng-1", "ng-2"},
+			"ng-1":      {"ng-3"},
+		}
+		Expect(imports.orderForDeletion([]string{"ng-shared", "ng-1", "ng-2", "ng-3"})).To(Equal([][]string{
+			{"ng-2", "ng-3"},
+			{"ng-1"},
+			{"ng-shared"},
+		}))
+	})
+
+	It("refuses to order stacks that import exports of each other", func() {
+		imports := stackImports{
+			"ng-1": {"ng-2"},
+			"ng-2": {"ng-1"},
+		}
+		_, err := imports.orderForDeletion([]string{"ng-1", "ng-2", "ng-3"})
+		Expect(err).To(MatchError("stacks ng-1, ng-2 import exports of each other, they cannot be deleted"))
+	})
+
+	It("splits nodegroup deletions only when they depend on each other", func() {
+		noop := func(*Stack, chan error) error { return nil }
+		tasks := &TaskTree{Parallel: true}
+		tasks.Append(
+			&taskWithStackSpec{info: `delete nodegroup "ng-1"`, stack: newStack("ng-1"), call: noop},
+			&taskWithStackSpec{info: `delete nodegroup "ng-2"`, stack: newStack("ng-2"), call: noop},
+			&taskToDeleteManagedNodeGroup{info: `delete managed nodegroup "mng-1"`, name: "mng-1"},
+		)
+
+		ordered, err := stackImports{"ng-1": {"eksctl-test-cluster-cluster"}}.orderNodeGroupDeletions(tasks)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ordered).To(BeIdenticalTo(tasks))
+
+		ordered, err = stackImports{"ng-1": {"ng-2"}}.orderNodeGroupDeletions(tasks)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(ordered.Describe()).To(Equal(`2 sequential tasks: { 2 parallel sub-tasks: { delete nodegroup "ng-2", delete managed nodegroup "mng-1" }, delete nodegroup "ng-1" }`))
+	})
+})
@@ -2,22 +2,70 @@ package manager
 
 import (
 	"fmt"
+	"strings"
 
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func deleteAll(_ string) bool { return true }
 
-// NewTasksToDeleteClusterWithNodeGroups defines tasks required to delete the given cluster along with all of its resources
-func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider bool, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, wait bool, cleanup func(chan error, string) error) (*TaskTree, error) {
-	tasks := &TaskTree{Parallel: false}
+// Classes of resources that NewTasksToDeleteClusterResources deletes on their own
+const (
+	ClusterResourcesNodeGroups         = "nodegroups"
+	ClusterResourcesIAMServiceAccounts = "iamserviceaccounts"
+	ClusterResourcesFargate            = "fargate"
+)
+
+// ClusterResourceClasses returns the classes of resources that can be deleted on their own,
+// in the order they are deleted
+func ClusterResourceClasses() []string {
+	return []string{ClusterResourcesNodeGroups, ClusterResourcesIAMServiceAccounts, ClusterResourcesFargate}
+}
+
+// ValidateClusterResourceClasses returns an error if any of the given classes is unknown
+func ValidateClusterResourceClasses(classes []string) error {
+	for _, class := range classes {
+		known := false
+		for _, c := range ClusterResourceClasses() {
+			known = known || class == c
+		}
+		if !known {
+			return fmt.Errorf("unknown class of resources %q, must be one of %s", class, strings.Join(ClusterResourceClasses(), ", "))
+		}
+	}
+	return nil
+}
+
+func (c *StackCollection) deleteStackBySpecSyncFunc(force bool) func(*Stack, chan error) error {
+	if force {
+		return c.DeleteStackBySpecSyncRetainingResources
+	}
+	return c.DeleteStackBySpecSync
+}
+
+// NewTasksToDeleteClusterWithNodeGroups defines tasks required to delete the given cluster along with all of its resources;
+// with force, deletions of nodegroup and cluster stacks that fail because of resources that can't be deleted are retried
+// retaining these resources, and the deletion of the cluster stack is always waited for
+func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider bool, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, wait, force bool, cleanup func(chan error, string) error) (*TaskTree, error) {
+	clusterStack, err := c.DescribeClusterStack()
+	if err != nil {
+		return nil, err
+	}
+	if IsRegisteredClusterStack(clusterStack) {
+		logger.Warning("cluster %q was registered, not created, by eksctl; only the stacks that eksctl created will be deleted", c.spec.Metadata.Name)
+		return c.newTasksToDeleteRegisteredCluster(clusterStack, clientSetGetter, wait, force, cleanup)
+	}
 
-	nodeGroupTasks, err := c.NewTasksToDeleteNodeGroups(deleteAll, true, cleanup)
+	tasks := &TaskTree{Parallel: false}
 
+	// managed nodegroups have to be gone before the control plane can be deleted,
+	// so these are always deleted synchronously
+	nodeGroupTasks, err := c.newTasksToDeleteNodeGroupsInOrder(deleteAll, force, cleanup)
 	if err != nil {
 		return nil, err
 	}
@@ -38,17 +86,111 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvid
 		}
 	}
 
-	clusterStack, err := c.DescribeClusterStack()
+	if err := c.appendTasksToDeleteAddonStacks(tasks); err != nil {
+		return nil, err
+	}
+	c.appendTaskToDeleteClusterStack(tasks, clusterStack, fmt.Sprintf("delete cluster control plane %q", c.spec.Metadata.Name), wait, force)
+	return tasks, nil
+}
+
+// newTasksToDeleteRegisteredCluster defines tasks that delete what eksctl created for a cluster that it registered,
+// but didn't create: the stacks of nodegroups, of managed nodegroups that eksctl created, of IAM service accounts,
+// pod identity roles and Karpenter, and the registration stack; the control plane, managed nodegroups that have no
+// stack and the IAM OIDC provider are left intact
+func (c *StackCollection) newTasksToDeleteRegisteredCluster(clusterStack *Stack, clientSetGetter kubernetes.ClientSetGetter, wait, force bool, cleanup func(chan error, string) error) (*TaskTree, error) {
+	tasks := &TaskTree{Parallel: false}
+
+	managedNodeGroupStacks, err := c.DescribeManagedNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	ownedManagedNodeGroups := map[string]bool{}
+	for _, s := range managedNodeGroupStacks {
+		ownedManagedNodeGroups[c.GetManagedNodeGroupName(s)] = true
+	}
+	nodeGroupTasks, err := c.newTasksToDeleteNodeGroupsInOrder(func(name string) bool {
+		return ownedManagedNodeGroups[name]
+	}, force, cleanup)
+	if err != nil {
+		return nil, err
+	}
+	if nodeGroupTasks.Len() > 0 {
+		nodeGroupTasks.IsSubTask = true
+		tasks.Append(nodeGroupTasks)
+	}
+
+	serviceAccountTasks, err := c.NewTasksToDeleteIAMServiceAccounts(deleteAll, nil, clientSetGetter, true)
+	if err != nil {
+		return nil, err
+	}
+	if serviceAccountTasks.Len() > 0 {
+		serviceAccountTasks.IsSubTask = true
+		tasks.Append(serviceAccountTasks)
+	}
+
+	if err := c.appendTasksToDeleteAddonStacks(tasks); err != nil {
+		return nil, err
+	}
+	c.appendTaskToDeleteClusterStack(tasks, clusterStack, fmt.Sprintf("delete registration stack of cluster %q", c.spec.Metadata.Name), wait, force)
+	return tasks, nil
+}
+
+// newTasksToDeleteNodeGroupsInOrder defines tasks that delete all nodegroups, and the managed nodegroups that
+// shouldDeleteManaged matches; stacks can only be deleted once the stacks that import their exports are gone
+func (c *StackCollection) newTasksToDeleteNodeGroupsInOrder(shouldDeleteManaged func(string) bool, force bool, cleanup func(chan error, string) error) (*TaskTree, error) {
+	nodeGroupTasks, err := c.newTasksToDeleteNodeGroups(deleteAll, true, force, cleanup)
+	if err != nil {
+		return nil, err
+	}
+	managedNodeGroupTasks, err := c.NewTasksToDeleteManagedNodeGroups(shouldDeleteManaged)
+	if err != nil {
+		return nil, err
+	}
+	nodeGroupTasks.Append(managedNodeGroupTasks.tasks...)
+
+	stacks, err := c.DescribeStacks()
 	if err != nil {
 		return nil, err
 	}
+	imports, err := c.describeStackImports(stacks)
+	if err != nil {
+		return nil, err
+	}
+	if err := imports.checkExternalImports(stacks); err != nil {
+		return nil, err
+	}
+	return imports.orderNodeGroupDeletions(nodeGroupTasks)
+}
+
+// appendTasksToDeleteAddonStacks appends the tasks that delete the stacks of pod identity roles and of Karpenter
+func (c *StackCollection) appendTasksToDeleteAddonStacks(tasks *TaskTree) error {
+	podIdentityRoleTasks, err := c.NewTasksToDeletePodIdentityRoles()
+	if err != nil {
+		return err
+	}
+	if podIdentityRoleTasks.Len() > 0 {
+		podIdentityRoleTasks.IsSubTask = true
+		tasks.Append(podIdentityRoleTasks)
+	}
+
+	// the policy of the Karpenter controller can only be deleted once its iamserviceaccount is gone
+	karpenterTasks, err := c.NewTasksToDeleteKarpenter()
+	if err != nil {
+		return err
+	}
+	if karpenterTasks.Len() > 0 {
+		karpenterTasks.IsSubTask = true
+		tasks.Append(karpenterTasks)
+	}
+	return nil
+}
 
-	info := fmt.Sprintf("delete cluster control plane %q", c.spec.Metadata.Name)
-	if wait {
+func (c *StackCollection) appendTaskToDeleteClusterStack(tasks *TaskTree, clusterStack *Stack, info string, wait, force bool) {
+	if wait || force {
 		tasks.Append(&taskWithStackSpec{
 			info:  info,
 			stack: clusterStack,
-			call:  c.DeleteStackBySpecSync,
+			call:  c.deleteStackBySpecSyncFunc(force),
 		})
 	} else {
 		tasks.Append(&asyncTaskWithStackSpec{
@@ -57,12 +199,79 @@ func (c *StackCollection) NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvid
 			call:  c.DeleteStackBySpec,
 		})
 	}
+}
+
+// NewTasksToDeleteClusterResources defines tasks required to delete the given classes of resources of the cluster,
+// leaving the control plane, its network and all other resources intact, e.g. to remove all compute from the cluster;
+// Fargate profiles aren't managed by stacks, so deleteFargateProfiles is called to delete them
+func (c *StackCollection) NewTasksToDeleteClusterResources(classes []string, oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter, force bool, cleanup func(chan error, string) error, deleteFargateProfiles func() error) (*TaskTree, error) {
+	if err := ValidateClusterResourceClasses(classes); err != nil {
+		return nil, err
+	}
+	selected := map[string]bool{}
+	for _, class := range classes {
+		selected[class] = true
+	}
+
+	tasks := &TaskTree{Parallel: false}
+
+	if selected[ClusterResourcesNodeGroups] {
+		nodeGroupTasks, err := c.newTasksToDeleteNodeGroups(deleteAll, true, force, cleanup)
+		if err != nil {
+			return nil, err
+		}
+		managedNodeGroupTasks, err := c.NewTasksToDeleteManagedNodeGroups(deleteAll)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupTasks.Append(managedNodeGroupTasks.tasks...)
+
+		// nodegroups can only be deleted once the nodegroups that import their exports are gone
+		stacks, err := c.DescribeStacks()
+		if err != nil {
+			return nil, err
+		}
+		imports, err := c.describeStackImports(stacks)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupTasks, err = imports.orderNodeGroupDeletions(nodeGroupTasks)
+		if err != nil {
+			return nil, err
+		}
+		if nodeGroupTasks.Len() > 0 {
+			nodeGroupTasks.IsSubTask = true
+			tasks.Append(nodeGroupTasks)
+		}
+	}
+
+	if selected[ClusterResourcesIAMServiceAccounts] {
+		saTasks, err := c.NewTasksToDeleteIAMServiceAccounts(deleteAll, oidc, clientSetGetter, true)
+		if err != nil {
+			return nil, err
+		}
+		if saTasks.Len() > 0 {
+			saTasks.IsSubTask = true
+			tasks.Append(saTasks)
+		}
+	}
+
+	if selected[ClusterResourcesFargate] {
+		tasks.Append(&asyncTaskWithoutParams{
+			info: fmt.Sprintf("delete Fargate profiles of cluster %q", c.spec.Metadata.Name),
+			call: deleteFargateProfiles,
+		})
+	}
 
 	return tasks, nil
 }
 
 // NewTasksToDeleteNodeGroups defines tasks required to delete all of the nodegroups
 func (c *StackCollection) NewTasksToDeleteNodeGroups(shouldDelete func(string) bool, wait bool, cleanup func(chan error, string) error) (*TaskTree, error) {
+	return c.newTasksToDeleteNodeGroups(shouldDelete, wait, false, cleanup)
+}
+
+func (c *StackCollection) newTasksToDeleteNodeGroups(shouldDelete func(string) bool, wait, force bool, cleanup func(chan error, string) error) (*TaskTree, error) {
 	nodeGroupStacks, err := c.DescribeNodeGroupStacks()
 	if err != nil {
 		return nil, err
@@ -87,7 +296,7 @@ func (c *StackCollection) NewTasksToDeleteNodeGroups(shouldDelete func(string) b
 			tasks.Append(&taskWithStackSpec{
 				info:  info,
 				stack: s,
-				call:  c.DeleteStackBySpecSync,
+				call:  c.deleteStackBySpecSyncFunc(force),
 			})
 		} else {
 			tasks.Append(&asyncTaskWithStackSpec{
@@ -101,6 +310,48 @@ func (c *StackCollection) NewTasksToDeleteNodeGroups(shouldDelete func(string) b
 	return tasks, nil
 }
 
+// NewTasksToDeleteManagedNodeGroups defines tasks required to delete all of the managed nodegroups,
+// including stacks of the ones that were deleted part way
+func (c *StackCollection) NewTasksToDeleteManagedNodeGroups(shouldDelete func(string) bool) (*TaskTree, error) {
+	names, err := c.ListManagedNodeGroups()
+	if err != nil {
+		return nil, err
+	}
+	stacks, err := c.DescribeManagedNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := &TaskTree{Parallel: true}
+
+	existing := map[string]bool{}
+	for _, name := range names {
+		existing[name] = true
+		if !shouldDelete(name) {
+			continue
+		}
+		tasks.Append(&taskToDeleteManagedNodeGroup{
+			info: fmt.Sprintf("delete managed nodegroup %q", name),
+			name: name,
+			call: c.deleteManagedNodeGroupTask,
+		})
+	}
+
+	for _, s := range stacks {
+		name := c.GetManagedNodeGroupName(s)
+		if existing[name] || !shouldDelete(name) {
+			continue
+		}
+		tasks.Append(&taskWithStackSpec{
+			info:  fmt.Sprintf("delete IAM role of managed nodegroup %q", name),
+			stack: s,
+			call:  c.DeleteStackBySpecSync,
+		})
+	}
+
+	return tasks, nil
+}
+
 // NewTasksToDeleteOIDCProviderWithIAMServiceAccounts defines tasks required to delete all of the iamserviceaccounts
 // along with associated IAM ODIC provider
 func (c *StackCollection) NewTasksToDeleteOIDCProviderWithIAMServiceAccounts(oidc *iamoidc.OpenIDConnectManager, clientSetGetter kubernetes.ClientSetGetter) (*TaskTree, error) {
@@ -0,0 +1,103 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection partial deletion", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	newStack := func(name, tagKey, tagValue string) *cfn.Stack {
+		return &cfn.Stack{
+			StackName:   aws.String(name),
+			StackStatus: aws.String(cfn.StackStatusCreateComplete),
+			Tags:        []*cfn.Tag{{Key: aws.String(tagKey), Value: aws.String(tagValue)}},
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{
+				newStack("eksctl-test-cluster-cluster", api.ClusterNameTag, "test-cluster"),
+				newStack("eksctl-test-cluster-nodegroup-ng-1", api.NodeGroupNameTag, "ng-1"),
+				newStack("eksctl-test-cluster-addon-iamserviceaccount-default-sa-1", api.IAMServiceAccountNameTag, "default/sa-1"),
+			}}, true)
+		}).Return(nil)
+		p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *eks.ListNodegroupsOutput, last bool) bool)
+			consume(&eks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"mng-1"})}, true)
+		}).Return(nil)
+	})
+
+	It("deletes only the selected classes of resources", func() {
+		tasks, err := sc.NewTasksToDeleteClusterResources([]string{ClusterResourcesFargate, ClusterResourcesNodeGroups}, nil, nil, false, nil, func() error { return nil })
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { 2 parallel sub-tasks: { delete nodegroup "ng-1", delete managed nodegroup "mng-1" }, delete Fargate profiles of cluster "test-cluster" }`))
+
+		tasks, err = sc.NewTasksToDeleteClusterResources([]string{ClusterResourcesIAMServiceAccounts}, nil, nil, false, nil, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`1 task: { 2 sequential sub-tasks: { delete IAM role for serviceaccount "default/sa-1", delete serviceaccount "default/sa-1" } }`))
+	})
+
+	It("deletes only the stacks that eksctl created for a registered cluster", func() {
+		registrationStack := newStack("eksctl-test-cluster-cluster", api.ClusterNameTag, "test-cluster")
+		registrationStack.Outputs = []*cfn.Output{{OutputKey: aws.String(outputs.ClusterFeatureRegistered), OutputValue: aws.String("true")}}
+
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{
+				registrationStack,
+				newStack("eksctl-test-cluster-nodegroup-ng-1", api.NodeGroupNameTag, "ng-1"),
+				newStack("eksctl-test-cluster-nodegroup-mng-1", api.ManagedNodeGroupNameTag, "mng-1"),
+				newStack("eksctl-test-cluster-addon-iamserviceaccount-default-sa-1", api.IAMServiceAccountNameTag, "default/sa-1"),
+			}}, true)
+		}).Return(nil)
+		p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *eks.ListNodegroupsOutput, last bool) bool)
+			consume(&eks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"mng-1", "mng-console"})}, true)
+		}).Return(nil)
+
+		tasks, err := sc.NewTasksToDeleteClusterWithNodeGroups(true, nil, nil, true, false, nil)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`3 sequential tasks: { ` +
+			`2 parallel sub-tasks: { delete nodegroup "ng-1", delete managed nodegroup "mng-1" }, ` +
+			`1 parallel sub-task: { 2 sequential sub-tasks: { delete IAM role for serviceaccount "default/sa-1", delete serviceaccount "default/sa-1" } }, ` +
+			`delete registration stack of cluster "test-cluster" }`))
+		Expect(tasks.Describe()).NotTo(ContainSubstring("mng-console"))
+		Expect(tasks.Describe()).NotTo(ContainSubstring("OIDC"))
+
+		// nothing is deleted while the tasks are defined, and nothing outside of the stacks of eksctl ever is
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DeleteNodegroup", mock.Anything)
+		p.MockEKS().AssertNotCalled(GinkgoT(), "DeleteFargateProfile", mock.Anything)
+		p.MockEKS().AssertNotCalled(GinkgoT(), "ListFargateProfiles", mock.Anything)
+		p.MockIAM().AssertNotCalled(GinkgoT(), "DeleteOpenIDConnectProvider", mock.Anything)
+		p.MockIAM().AssertNotCalled(GinkgoT(), "ListOpenIDConnectProviders", mock.Anything)
+	})
+
+	It("rejects unknown classes of resources", func() {
+		_, err := sc.NewTasksToDeleteClusterResources([]string{"cluster"}, nil, nil, false, nil, nil)
+		Expect(err).To(MatchError(`unknown class of resources "cluster", must be one of nodegroups, iamserviceaccounts, fargate`))
+	})
+})
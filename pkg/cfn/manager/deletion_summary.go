@@ -0,0 +1,135 @@
+package manager
+
+import (
+	"fmt"
+	"io"
+	"text/tabwriter"
+	"time"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// rough durations of deletions, used to estimate how long a TaskTree takes
+const (
+	clusterDeletionEstimate          = 10 * time.Minute
+	nodeGroupDeletionEstimate        = 5 * time.Minute
+	managedNodeGroupDeletionEstimate = 10 * time.Minute
+	stackDeletionEstimate            = time.Minute
+)
+
+// DeletedNodeGroup is a nodegroup that a TaskTree deletes
+type DeletedNodeGroup struct {
+	Name    string
+	Managed bool
+	// Nodes is the desired capacity of the nodegroup, or -1 if it's unknown
+	Nodes int
+}
+
+// DeletionSummary lists the resources that a TaskTree deletes
+type DeletionSummary struct {
+	Stacks             []string
+	NodeGroups         []*DeletedNodeGroup
+	IAMServiceAccounts []string
+	EstimatedDuration  time.Duration
+
+	nodeGroupSizes        map[string]int
+	managedNodeGroupSizes map[string]int
+}
+
+// NewDeletionSummary walks the tasks to find the stacks, nodegroups and iamserviceaccounts
+// they delete; node counts are best-effort, as the nodegroups may be broken already
+func (c *StackCollection) NewDeletionSummary(tasks *TaskTree) *DeletionSummary {
+	summary := &DeletionSummary{
+		nodeGroupSizes:        map[string]int{},
+		managedNodeGroupSizes: map[string]int{},
+	}
+	if nodeGroups, err := c.GetNodeGroupSummaries(""); err != nil {
+		logger.Debug("not counting nodes of nodegroups: %s", err.Error())
+	} else {
+		for _, ng := range nodeGroups {
+			summary.nodeGroupSizes[ng.StackName] = ng.DesiredCapacity
+		}
+	}
+	if managedNodeGroups, err := c.GetManagedNodeGroupSummaries(""); err != nil {
+		logger.Debug("not counting nodes of managed nodegroups: %s", err.Error())
+	} else {
+		for _, ng := range managedNodeGroups {
+			summary.managedNodeGroupSizes[ng.Name] = ng.DesiredCapacity
+		}
+	}
+	summary.EstimatedDuration = c.summarizeTask(summary, tasks)
+	return summary
+}
+
+// summarizeTask adds what the task deletes to the summary and returns its estimated duration
+func (c *StackCollection) summarizeTask(summary *DeletionSummary, task Task) time.Duration {
+	switch t := task.(type) {
+	case *TaskTree:
+		var total time.Duration
+		for _, subTask := range t.tasks {
+			d := c.summarizeTask(summary, subTask)
+			if !t.Parallel {
+				total += d
+			} else if d > total {
+				total = d
+			}
+		}
+		return total
+	case *taskWithStackSpec:
+		return c.summarizeStack(summary, t.stack)
+	case *asyncTaskWithStackSpec:
+		// async deletions are not waited for
+		c.summarizeStack(summary, t.stack)
+		return 0
+	case *taskToDeleteManagedNodeGroup:
+		nodes, ok := summary.managedNodeGroupSizes[t.name]
+		if !ok {
+			nodes = -1
+		}
+		summary.NodeGroups = append(summary.NodeGroups, &DeletedNodeGroup{Name: t.name, Managed: true, Nodes: nodes})
+		return managedNodeGroupDeletionEstimate
+	default:
+		return 0
+	}
+}
+
+func (c *StackCollection) summarizeStack(summary *DeletionSummary, s *Stack) time.Duration {
+	summary.Stacks = append(summary.Stacks, *s.StackName)
+	if nodes, ok := summary.nodeGroupSizes[*s.StackName]; ok {
+		summary.NodeGroups = append(summary.NodeGroups, &DeletedNodeGroup{Name: c.GetNodeGroupName(s), Nodes: nodes})
+		return nodeGroupDeletionEstimate
+	}
+	if name := c.GetIAMServiceAccountName(s); name != "" {
+		summary.IAMServiceAccounts = append(summary.IAMServiceAccounts, name)
+		return stackDeletionEstimate
+	}
+	if getClusterName(s) != "" && !IsRegisteredClusterStack(s) {
+		return clusterDeletionEstimate
+	}
+	return stackDeletionEstimate
+}
+
+// Write prints the summary as a table
+func (s *DeletionSummary) Write(out io.Writer) error {
+	w := tabwriter.NewWriter(out, 0, 8, 2, ' ', 0)
+	fmt.Fprintln(w, "RESOURCE\tNAME\tDETAILS")
+	for _, ng := range s.NodeGroups {
+		kind := "nodegroup"
+		if ng.Managed {
+			kind = "managed nodegroup"
+		}
+		nodes := "unknown number of nodes"
+		if ng.Nodes >= 0 {
+			nodes = fmt.Sprintf("%d node(s)", ng.Nodes)
+		}
+		fmt.Fprintf(w, "%s\t%s\t%s\n", kind, ng.Name, nodes)
+	}
+	for _, name := range s.IAMServiceAccounts {
+		fmt.Fprintf(w, "iamserviceaccount\t%s\tIAM role and service account\n", name)
+	}
+	for _, name := range s.Stacks {
+		fmt.Fprintf(w, "stack\t%s\t\n", name)
+	}
+	fmt.Fprintf(w, "\nestimated duration: %s\n", s.EstimatedDuration)
+	return w.Flush()
+}
@@ -0,0 +1,96 @@
+package manager
+
+import (
+	"bytes"
+	"errors"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var errNotConfirmed = errors.New("not confirmed")
+
+var _ = Describe("StackCollection deletion summary", func() {
+	newStack := func(name, tagKey, tagValue string) *Stack {
+		return &Stack{
+			StackName: aws.String(name),
+			Tags:      []*cfn.Tag{{Key: aws.String(tagKey), Value: aws.String(tagValue)}},
+		}
+	}
+
+	It("lists what the tasks delete and estimates their duration", func() {
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc := NewStackCollection(mockprovider.NewMockProvider(), cfg)
+
+		noop := func(*Stack, chan error) error { return nil }
+		nodeGroups := &TaskTree{Parallel: true, IsSubTask: true}
+		nodeGroups.Append(
+			&taskWithStackSpec{stack: newStack("eksctl-test-cluster-nodegroup-ng-1", api.NodeGroupNameTag, "ng-1"), call: noop},
+			&taskToDeleteManagedNodeGroup{name: "mng-1"},
+		)
+		serviceAccounts := &TaskTree{Parallel: true, IsSubTask: true}
+		serviceAccounts.Append(
+			&taskWithStackSpec{stack: newStack("eksctl-test-cluster-addon-iamserviceaccount-default-sa-1", api.IAMServiceAccountNameTag, "default/sa-1"), call: noop},
+		)
+		tasks := &TaskTree{}
+		tasks.Append(
+			nodeGroups,
+			serviceAccounts,
+			&taskWithStackSpec{stack: newStack("eksctl-test-cluster-cluster", api.ClusterNameTag, "test-cluster"), call: noop},
+		)
+
+		summary := &DeletionSummary{
+			nodeGroupSizes:        map[string]int{"eksctl-test-cluster-nodegroup-ng-1": 3},
+			managedNodeGroupSizes: map[string]int{},
+		}
+		summary.EstimatedDuration = sc.summarizeTask(summary, tasks)
+
+		Expect(summary.NodeGroups).To(Equal([]*DeletedNodeGroup{
+			{Name: "ng-1", Nodes: 3},
+			{Name: "mng-1", Managed: true, Nodes: -1},
+		}))
+		Expect(summary.IAMServiceAccounts).To(Equal([]string{"default/sa-1"}))
+		Expect(summary.Stacks).To(HaveLen(3))
+		// the longest parallel nodegroup deletion, followed by the service accounts and the control plane
+		Expect(summary.EstimatedDuration).To(Equal(21 * time.Minute))
+
+		out := &bytes.Buffer{}
+		Expect(summary.Write(out)).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("managed nodegroup  mng-1"))
+		Expect(out.String()).To(ContainSubstring("3 node(s)"))
+		Expect(out.String()).To(ContainSubstring("estimated duration: 21m0s"))
+	})
+
+	It("runs the confirmation once before any task", func() {
+		ran := false
+		confirmations := 0
+		tasks := &TaskTree{}
+		tasks.Append(&taskWithoutParams{call: func(errs chan error) error {
+			ran = true
+			close(errs)
+			return nil
+		}})
+		tasks.Confirm = func() error {
+			confirmations++
+			if confirmations == 1 {
+				return errNotConfirmed
+			}
+			return nil
+		}
+
+		Expect(tasks.DoAllSync()).To(ConsistOf(errNotConfirmed))
+		Expect(ran).To(BeFalse())
+
+		Expect(tasks.Confirmed()).To(Succeed())
+		Expect(tasks.DoAllSync()).To(BeEmpty())
+		Expect(ran).To(BeTrue())
+		Expect(confirmations).To(Equal(2))
+	})
+})
@@ -68,7 +68,7 @@ func (c *StackCollection) DeleteTasksForDeprecatedStacks() (*TaskTree, error) {
 				},
 			)
 
-			return waiters.Wait(c.spec.Metadata.Name, msg, acceptors, newRequest, c.provider.WaitTimeout(), nil)
+			return waiters.Wait(c.Context(), c.spec.Metadata.Name, msg, acceptors, newRequest, c.provider.WaitTimeout(), nil)
 		},
 	}
 
@@ -0,0 +1,133 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/waiters"
+)
+
+// StackDrift holds the result of drift detection of a stack
+type StackDrift struct {
+	StackName string
+	// DriftStatus is one of DRIFTED, IN_SYNC or NOT_CHECKED
+	DriftStatus string
+	// DriftedResources were modified or deleted outside of CloudFormation
+	DriftedResources []*cloudformation.StackResourceDrift
+}
+
+// canDetectDrift checks if the stack is in one of the states that CloudFormation
+// can detect drift in
+func canDetectDrift(s *Stack) bool {
+	switch *s.StackStatus {
+	case cloudformation.StackStatusCreateComplete,
+		cloudformation.StackStatusUpdateComplete,
+		cloudformation.StackStatusUpdateRollbackComplete,
+		cloudformation.StackStatusUpdateRollbackFailed:
+		return true
+	}
+	return false
+}
+
+// DetectStacksDrift detects drift of all stacks of the cluster, detection is started
+// for all stacks before waiting for any of them as it may take a while
+func (c *StackCollection) DetectStacksDrift() ([]*StackDrift, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	detectionIDs := map[string]*string{}
+	checkedStacks := []*Stack{}
+	for _, s := range stacks {
+		if !canDetectDrift(s) {
+			logger.Warning("skipping stack %q, drift cannot be detected in %q state", *s.StackName, *s.StackStatus)
+			continue
+		}
+		output, err := c.provider.CloudFormation().DetectStackDrift(&cloudformation.DetectStackDriftInput{
+			StackName: s.StackName,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "starting drift detection of stack %q", *s.StackName)
+		}
+		detectionIDs[*s.StackName] = output.StackDriftDetectionId
+		checkedStacks = append(checkedStacks, s)
+	}
+
+	drifts := []*StackDrift{}
+	for _, s := range checkedStacks {
+		drift, err := c.waitForStackDrift(*s.StackName, detectionIDs[*s.StackName])
+		if err != nil {
+			return nil, err
+		}
+		if drift.DriftStatus == cloudformation.StackDriftStatusDrifted {
+			if drift.DriftedResources, err = c.DescribeDriftedResources(drift.StackName); err != nil {
+				return nil, err
+			}
+		}
+		drifts = append(drifts, drift)
+	}
+	return drifts, nil
+}
+
+func (c *StackCollection) waitForStackDrift(stackName string, detectionID *string) (*StackDrift, error) {
+	input := &cloudformation.DescribeStackDriftDetectionStatusInput{
+		StackDriftDetectionId: detectionID,
+	}
+
+	newRequest := func() *request.Request {
+		req, _ := c.provider.CloudFormation().DescribeStackDriftDetectionStatusRequest(input)
+		return req
+	}
+
+	acceptors := waiters.MakeAcceptors(
+		"DetectionStatus",
+		cloudformation.StackDriftDetectionStatusDetectionComplete,
+		[]string{
+			cloudformation.StackDriftDetectionStatusDetectionFailed,
+		},
+	)
+
+	msg := fmt.Sprintf("waiting for drift detection of CloudFormation stack %q", stackName)
+
+	waitErr := waiters.Wait(c.Context(), stackName, msg, acceptors, newRequest, c.provider.WaitTimeout(), nil)
+
+	output, err := c.provider.CloudFormation().DescribeStackDriftDetectionStatus(input)
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing drift detection of stack %q", stackName)
+	}
+	if waitErr != nil {
+		return nil, errors.Wrapf(waitErr, "detecting drift of stack %q: %s", stackName, aws.StringValue(output.DetectionStatusReason))
+	}
+	return &StackDrift{
+		StackName:   stackName,
+		DriftStatus: aws.StringValue(output.StackDriftStatus),
+	}, nil
+}
+
+// DescribeDriftedResources describes the resources of the stack that were found to be modified
+// or deleted by the last drift detection
+func (c *StackCollection) DescribeDriftedResources(stackName string) ([]*cloudformation.StackResourceDrift, error) {
+	input := &cloudformation.DescribeStackResourceDriftsInput{
+		StackName: &stackName,
+		StackResourceDriftStatusFilters: aws.StringSlice([]string{
+			cloudformation.StackResourceDriftStatusModified,
+			cloudformation.StackResourceDriftStatusDeleted,
+		}),
+	}
+
+	drifts := []*cloudformation.StackResourceDrift{}
+	pager := func(p *cloudformation.DescribeStackResourceDriftsOutput, _ bool) bool {
+		drifts = append(drifts, p.StackResourceDrifts...)
+		return true
+	}
+	if err := c.provider.CloudFormation().DescribeStackResourceDriftsPages(input, pager); err != nil {
+		return nil, errors.Wrapf(err, "describing drifted resources of stack %q", stackName)
+	}
+	return drifts, nil
+}
@@ -0,0 +1,56 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection drift detection", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("only detects drift of stacks in stable states", func() {
+		Expect(canDetectDrift(&Stack{StackStatus: aws.String(cfn.StackStatusCreateComplete)})).To(BeTrue())
+		Expect(canDetectDrift(&Stack{StackStatus: aws.String(cfn.StackStatusUpdateRollbackComplete)})).To(BeTrue())
+		Expect(canDetectDrift(&Stack{StackStatus: aws.String(cfn.StackStatusCreateInProgress)})).To(BeFalse())
+		Expect(canDetectDrift(&Stack{StackStatus: aws.String(cfn.StackStatusRollbackComplete)})).To(BeFalse())
+	})
+
+	It("describes modified and deleted resources across pages", func() {
+		p.MockCloudFormation().On("DescribeStackResourceDriftsPages", mock.MatchedBy(func(input *cfn.DescribeStackResourceDriftsInput) bool {
+			return *input.StackName == "eksctl-test-cluster-cluster" &&
+				len(input.StackResourceDriftStatusFilters) == 2 &&
+				*input.StackResourceDriftStatusFilters[0] == cfn.StackResourceDriftStatusModified &&
+				*input.StackResourceDriftStatusFilters[1] == cfn.StackResourceDriftStatusDeleted
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackResourceDriftsOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStackResourceDriftsOutput{
+				StackResourceDrifts: []*cfn.StackResourceDrift{{LogicalResourceId: aws.String("ControlPlaneSecurityGroup")}},
+			}, false)
+			consume(&cfn.DescribeStackResourceDriftsOutput{
+				StackResourceDrifts: []*cfn.StackResourceDrift{{LogicalResourceId: aws.String("NATGateway")}},
+			}, true)
+		}).Return(nil)
+
+		drifts, err := sc.DescribeDriftedResources("eksctl-test-cluster-cluster")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(drifts).To(HaveLen(2))
+		Expect(*drifts[0].LogicalResourceId).To(Equal("ControlPlaneSecurityGroup"))
+		Expect(*drifts[1].LogicalResourceId).To(Equal("NATGateway"))
+	})
+})
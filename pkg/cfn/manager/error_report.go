@@ -0,0 +1,134 @@
+package manager
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// taskPathSeparator joins the segments of the path of a task
+const taskPathSeparator = " / "
+
+// ErrorReport groups the errors of a TaskTree by the tasks that failed, so that failures
+// of parallel tasks can be told apart
+type ErrorReport struct {
+	Failures []*TaskFailure `json:"failures"`
+}
+
+// TaskFailure holds the errors of a task, along with the events of its stack
+// if it operates on one
+type TaskFailure struct {
+	Path        string              `json:"path"`
+	Errors      []string            `json:"errors"`
+	StackName   string              `json:"stackName,omitempty"`
+	StackEvents []*FailedStackEvent `json:"stackEvents,omitempty"`
+}
+
+// FailedStackEvent is a stack event of a resource that failed
+type FailedStackEvent struct {
+	Timestamp            time.Time `json:"timestamp"`
+	LogicalResourceID    string    `json:"logicalResourceId"`
+	ResourceType         string    `json:"resourceType"`
+	ResourceStatus       string    `json:"resourceStatus"`
+	ResourceStatusReason string    `json:"resourceStatusReason,omitempty"`
+}
+
+// NewErrorReport groups the errors returned by TaskTree.DoAllSync, and fetches the events
+// of resources that failed in the stacks of failed tasks
+func (c *StackCollection) NewErrorReport(errs []error) *ErrorReport {
+	report := &ErrorReport{Failures: []*TaskFailure{}}
+	failures := map[string]*TaskFailure{}
+	for _, err := range errs {
+		path, stackName := "", ""
+		if taskErr, ok := err.(*TaskError); ok {
+			path, stackName = strings.Join(taskErr.Path, taskPathSeparator), taskErr.StackName
+		}
+		failure, ok := failures[path]
+		if !ok {
+			failure = &TaskFailure{Path: path, StackName: stackName}
+			failures[path] = failure
+			report.Failures = append(report.Failures, failure)
+		}
+		failure.Errors = append(failure.Errors, err.Error())
+	}
+	sort.SliceStable(report.Failures, func(i, j int) bool {
+		return report.Failures[i].Path < report.Failures[j].Path
+	})
+
+	for _, failure := range report.Failures {
+		if failure.StackName == "" {
+			continue
+		}
+		events, err := c.DescribeFailedStackEvents(failure.StackName)
+		if err != nil {
+			logger.Debug("not reporting events of stack %q: %s", failure.StackName, err.Error())
+			continue
+		}
+		failure.StackEvents = events
+	}
+	return report
+}
+
+// DescribeFailedStackEvents returns the events of resources that failed in the stack, most recent first
+func (c *StackCollection) DescribeFailedStackEvents(stackName string) ([]*FailedStackEvent, error) {
+	events, err := c.DescribeStackEvents(&Stack{StackName: &stackName})
+	if err != nil {
+		return nil, err
+	}
+	failed := []*FailedStackEvent{}
+	for _, e := range events {
+		if !strings.HasSuffix(aws.StringValue(e.ResourceStatus), "_FAILED") {
+			continue
+		}
+		failed = append(failed, &FailedStackEvent{
+			Timestamp:            aws.TimeValue(e.Timestamp),
+			LogicalResourceID:    aws.StringValue(e.LogicalResourceId),
+			ResourceType:         aws.StringValue(e.ResourceType),
+			ResourceStatus:       aws.StringValue(e.ResourceStatus),
+			ResourceStatusReason: aws.StringValue(e.ResourceStatusReason),
+		})
+	}
+	return failed, nil
+}
+
+// WriteText writes the report for humans, one block per failed task
+func (r *ErrorReport) WriteText(out io.Writer) error {
+	for _, failure := range r.Failures {
+		path := failure.Path
+		if path == "" {
+			path = "(no task)"
+		}
+		if _, err := fmt.Fprintf(out, "%s:\n", path); err != nil {
+			return err
+		}
+		for _, msg := range failure.Errors {
+			if _, err := fmt.Fprintf(out, "  error: %s\n", msg); err != nil {
+				return err
+			}
+		}
+		for _, e := range failure.StackEvents {
+			line := fmt.Sprintf("  %s %s/%s: %s", failure.StackName, e.ResourceType, e.LogicalResourceID, e.ResourceStatus)
+			if e.ResourceStatusReason != "" {
+				line += " – " + e.ResourceStatusReason
+			}
+			if _, err := fmt.Fprintln(out, line); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// WriteJSON writes the report as JSON
+func (r *ErrorReport) WriteJSON(out io.Writer) error {
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(r)
+}
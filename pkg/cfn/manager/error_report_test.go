@@ -0,0 +1,113 @@
+package manager
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection error report", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStackEventsOutput, last bool) bool)
+			consume(&cfn.DescribeStackEventsOutput{StackEvents: []*cfn.StackEvent{
+				{
+					LogicalResourceId: aws.String("eksctl-test-cluster-nodegroup-ng-2"),
+					ResourceType:      aws.String("AWS::CloudFormation::Stack"),
+					ResourceStatus:    aws.String(cfn.ResourceStatusRollbackInProgress),
+				},
+				{
+					LogicalResourceId:    aws.String("NodeGroup"),
+					ResourceType:         aws.String("AWS::AutoScaling::AutoScalingGroup"),
+					ResourceStatus:       aws.String(cfn.ResourceStatusCreateFailed),
+					ResourceStatusReason: aws.String("instance type not supported"),
+				},
+			}}, true)
+		}).Return(nil)
+	})
+
+	newTasks := func() *TaskTree {
+		newTask := func(name string, fail bool) Task {
+			return &taskWithStackSpec{
+				info:  fmt.Sprintf("create nodegroup %q", name),
+				stack: &Stack{StackName: aws.String("eksctl-test-cluster-nodegroup-" + name)},
+				call: func(_ *Stack, errs chan error) error {
+					go func() {
+						if fail {
+							errs <- fmt.Errorf("failed to create nodegroup %q", name)
+						}
+						close(errs)
+					}()
+					return nil
+				},
+			}
+		}
+		nodeGroups := &TaskTree{Parallel: true, IsSubTask: true}
+		nodeGroups.Append(newTask("ng-1", false), newTask("ng-2", true), newTask("ng-3", true))
+		tasks := &TaskTree{}
+		tasks.Append(&taskWithoutParams{info: "create cluster", call: func(errs chan error) error {
+			close(errs)
+			return nil
+		}}, nodeGroups)
+		return tasks
+	}
+
+	It("records the path of failed tasks", func() {
+		errs := newTasks().DoAllSync()
+		Expect(errs).To(HaveLen(2))
+		for _, err := range errs {
+			taskErr, ok := err.(*TaskError)
+			Expect(ok).To(BeTrue())
+			Expect(taskErr.Path).To(HaveLen(2))
+			Expect(taskErr.Path[0]).To(Equal("3 parallel sub-tasks"))
+			Expect(taskErr.StackName).To(HavePrefix("eksctl-test-cluster-nodegroup-ng-"))
+		}
+	})
+
+	It("groups errors by task and includes failed stack events", func() {
+		errs := newTasks().DoAllSync()
+		errs = append(errs, fmt.Errorf("unrelated error"))
+
+		report := sc.NewErrorReport(errs)
+		Expect(report.Failures).To(HaveLen(3))
+		Expect(report.Failures[0].Path).To(Equal(""))
+		Expect(report.Failures[0].Errors).To(Equal([]string{"unrelated error"}))
+		Expect(report.Failures[1].Path).To(Equal(`3 parallel sub-tasks / create nodegroup "ng-2"`))
+		Expect(report.Failures[1].Errors).To(Equal([]string{`failed to create nodegroup "ng-2"`}))
+		Expect(report.Failures[1].StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-2"))
+		Expect(report.Failures[1].StackEvents).To(HaveLen(1))
+		Expect(report.Failures[1].StackEvents[0].LogicalResourceID).To(Equal("NodeGroup"))
+		Expect(report.Failures[1].StackEvents[0].ResourceStatusReason).To(Equal("instance type not supported"))
+
+		text := &bytes.Buffer{}
+		Expect(report.WriteText(text)).To(Succeed())
+		Expect(text.String()).To(ContainSubstring("(no task):\n  error: unrelated error\n"))
+		Expect(text.String()).To(ContainSubstring(`  eksctl-test-cluster-nodegroup-ng-3 AWS::AutoScaling::AutoScalingGroup/NodeGroup: CREATE_FAILED – instance type not supported`))
+
+		data := &bytes.Buffer{}
+		Expect(report.WriteJSON(data)).To(Succeed())
+		decoded := &ErrorReport{}
+		Expect(json.Unmarshal(data.Bytes(), decoded)).To(Succeed())
+		Expect(decoded.Failures).To(HaveLen(3))
+		Expect(decoded.Failures[2].StackName).To(Equal("eksctl-test-cluster-nodegroup-ng-3"))
+	})
+})
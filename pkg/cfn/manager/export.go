@@ -0,0 +1,263 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+)
+
+const (
+	launchTemplateDataPath = resourcesRootPath + ".NodeGroupLaunchTemplate.Properties.LaunchTemplateData"
+	keyNamePath            = launchTemplateDataPath + ".KeyName"
+	volumeSizePath         = launchTemplateDataPath + ".BlockDeviceMappings.0.Ebs.VolumeSize"
+	volumeTypePath         = launchTemplateDataPath + ".BlockDeviceMappings.0.Ebs.VolumeType"
+	nodeGroupTagsPath      = resourcesRootPath + ".NodeGroup.Properties.Tags"
+
+	// nodeTemplateLabelTagPrefix prefixes the tags that describe the labels of nodes to cluster-autoscaler
+	nodeTemplateLabelTagPrefix = "k8s.io/cluster-autoscaler/node-template/label/"
+
+	serviceAccountRolePath                = resourcesRootPath + ".Role1.Properties"
+	serviceAccountPolicyARNsPath          = serviceAccountRolePath + ".ManagedPolicyArns"
+	serviceAccountRoleNamePath            = serviceAccountRolePath + ".RoleName"
+	serviceAccountRolePathPath            = serviceAccountRolePath + ".Path"
+	serviceAccountPermissionsBoundaryPath = serviceAccountRolePath + ".PermissionsBoundary"
+	serviceAccountPolicyPath              = resourcesRootPath + ".Policy1.Properties.PolicyDocument"
+	serviceAccountTrustPolicyPath         = "Metadata.TrustPolicy"
+	serviceAccountOIDCIssuerURLPath       = serviceAccountTrustPolicyPath + ".OIDCIssuerURL"
+	serviceAccountTrustedAccountsPath     = serviceAccountTrustPolicyPath + ".TrustedAccounts"
+)
+
+// ExportNodeGroups reconstructs the config of all nodegroups from their stacks,
+// the AMI is set to the image that the nodegroup currently uses
+func (c *StackCollection) ExportNodeGroups() ([]*api.NodeGroup, error) {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stacks")
+	}
+
+	nodeGroups := []*api.NodeGroup{}
+	for _, s := range stacks {
+		summary, err := c.mapStackToNodeGroupSummary(s)
+		if err != nil {
+			return nil, errors.Wrap(err, "mapping stack to nodegroup summary")
+		}
+		template, err := c.GetStackTemplate(*s.StackName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting Cloudformation template for stack %s", *s.StackName)
+		}
+
+		ng := api.NewNodeGroup()
+		ng.Name = summary.Name
+		ng.InstanceType = summary.InstanceType
+		ng.AMI = summary.ImageID
+		ng.MinSize = &summary.MinSize
+		ng.MaxSize = &summary.MaxSize
+		ng.DesiredCapacity = &summary.DesiredCapacity
+
+		if volumeSize := gjson.Get(template, volumeSizePath); volumeSize.Exists() {
+			size := int(volumeSize.Int())
+			ng.VolumeSize = &size
+		}
+		if volumeType := gjson.Get(template, volumeTypePath); volumeType.Exists() {
+			ng.VolumeType = aws.String(volumeType.String())
+		}
+		if keyName := gjson.Get(template, keyNamePath); keyName.Exists() {
+			ng.SSH.Allow = api.Enabled()
+			ng.SSH.PublicKeyPath = nil
+			ng.SSH.PublicKeyName = aws.String(keyName.String())
+		}
+
+		err = outputs.Collect(*s, nil, map[string]outputs.Collector{
+			outputs.NodeGroupFeaturePrivateNetworking: func(v string) error {
+				ng.PrivateNetworking = v == "true"
+				return nil
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		nodeGroups = append(nodeGroups, ng)
+	}
+	return nodeGroups, nil
+}
+
+// GetNodeGroupLabels returns the labels of the nodes of the given nodegroups and managed nodegroups; labels of
+// nodegroups are read from the tags of their ASGs that describe them to cluster-autoscaler, nodegroups whose ASGs
+// don't have these tags, as they were created before eksctl added them, are left out, as their labels are unknown
+func (c *StackCollection) GetNodeGroupLabels(nodeGroups, managedNodeGroups []string) (map[string]map[string]string, error) {
+	nodeGroupLabels := map[string]map[string]string{}
+	if len(nodeGroups) > 0 {
+		stacks, err := c.DescribeNodeGroupStacks()
+		if err != nil {
+			return nil, errors.Wrap(err, "getting nodegroup stacks")
+		}
+		names := sets.NewString(nodeGroups...)
+		for _, s := range stacks {
+			name := c.GetNodeGroupName(s)
+			if !names.Has(name) {
+				continue
+			}
+			template, err := c.GetStackTemplate(*s.StackName)
+			if err != nil {
+				return nil, errors.Wrapf(err, "error getting Cloudformation template for stack %s", *s.StackName)
+			}
+			if labels, ok := nodeGroupLabelsFromTemplate(template); ok {
+				nodeGroupLabels[name] = labels
+			}
+		}
+	}
+	for _, name := range managedNodeGroups {
+		nodeGroup, err := c.DescribeManagedNodeGroup(name)
+		if err != nil {
+			return nil, err
+		}
+		if nodeGroup != nil {
+			nodeGroupLabels[name] = aws.StringValueMap(nodeGroup.Labels)
+		}
+	}
+	return nodeGroupLabels, nil
+}
+
+// nodeGroupLabelsFromTemplate reads the labels of a nodegroup from the tags of its ASG, they're known when the
+// nodegroup name label, which eksctl always sets, is among them
+func nodeGroupLabelsFromTemplate(template string) (map[string]string, bool) {
+	labels := map[string]string{}
+	for _, tag := range gjson.Get(template, nodeGroupTagsPath).Array() {
+		if key := tag.Get("Key").String(); strings.HasPrefix(key, nodeTemplateLabelTagPrefix) {
+			labels[strings.TrimPrefix(key, nodeTemplateLabelTagPrefix)] = tag.Get("Value").String()
+		}
+	}
+	_, ok := labels[api.NodeGroupNameLabel]
+	return labels, ok
+}
+
+// ExportManagedNodeGroups reconstructs the config of all managed nodegroups from the EKS API
+func (c *StackCollection) ExportManagedNodeGroups() ([]*api.ManagedNodeGroup, error) {
+	names, err := c.ListManagedNodeGroups()
+	if err != nil {
+		return nil, err
+	}
+
+	nodeGroups := []*api.ManagedNodeGroup{}
+	for _, name := range names {
+		nodeGroup, err := c.DescribeManagedNodeGroup(name)
+		if err != nil {
+			return nil, err
+		}
+		if nodeGroup == nil {
+			continue
+		}
+		nodeGroups = append(nodeGroups, managedNodeGroupFromEKS(nodeGroup))
+	}
+	return nodeGroups, nil
+}
+
+func managedNodeGroupFromEKS(nodeGroup *eks.Nodegroup) *api.ManagedNodeGroup {
+	ng := api.NewManagedNodeGroup()
+	ng.Name = aws.StringValue(nodeGroup.NodegroupName)
+	ng.AMIType = aws.StringValue(nodeGroup.AmiType)
+	if len(nodeGroup.InstanceTypes) > 0 {
+		ng.InstanceType = *nodeGroup.InstanceTypes[0]
+	}
+	if nodeGroup.DiskSize != nil {
+		size := int(*nodeGroup.DiskSize)
+		ng.VolumeSize = &size
+	}
+	if scaling := nodeGroup.ScalingConfig; scaling != nil {
+		minSize, maxSize, desired := int(aws.Int64Value(scaling.MinSize)), int(aws.Int64Value(scaling.MaxSize)), int(aws.Int64Value(scaling.DesiredSize))
+		ng.MinSize, ng.MaxSize, ng.DesiredCapacity = &minSize, &maxSize, &desired
+	}
+
+	for key, value := range nodeGroup.Labels {
+		// these are always set by eksctl
+		if key == api.ClusterNameLabel || key == api.NodeGroupNameLabel {
+			continue
+		}
+		if ng.Labels == nil {
+			ng.Labels = map[string]string{}
+		}
+		ng.Labels[key] = aws.StringValue(value)
+	}
+
+	for _, taint := range nodeGroup.Taints {
+		effect := aws.StringValue(taint.Effect)
+		for k, v := range taintEffectsToEKS {
+			if v == effect {
+				effect = k
+			}
+		}
+		if ng.Taints == nil {
+			ng.Taints = map[string]string{}
+		}
+		if taint.Value != nil {
+			effect = fmt.Sprintf("%s:%s", *taint.Value, effect)
+		}
+		ng.Taints[aws.StringValue(taint.Key)] = effect
+	}
+
+	for key, value := range nodeGroup.Tags {
+		if key == api.ClusterNameTag || key == api.NodeGroupNameTag {
+			continue
+		}
+		if ng.Tags == nil {
+			ng.Tags = map[string]string{}
+		}
+		ng.Tags[key] = aws.StringValue(value)
+	}
+
+	if nodeGroup.RemoteAccess != nil && nodeGroup.RemoteAccess.Ec2SshKey != nil {
+		ng.SSH.Allow = api.Enabled()
+		ng.SSH.PublicKeyPath = nil
+		ng.SSH.PublicKeyName = nodeGroup.RemoteAccess.Ec2SshKey
+	}
+	return ng
+}
+
+// ExportIAMServiceAccounts reconstructs the config of all IAM service accounts, including
+// the policies attached to their roles, from their stacks
+func (c *StackCollection) ExportIAMServiceAccounts() ([]*api.ClusterIAMServiceAccount, error) {
+	stacks, err := c.DescribeIAMServiceAccountStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	serviceAccounts := []*api.ClusterIAMServiceAccount{}
+	for _, s := range stacks {
+		meta, err := api.ClusterIAMServiceAccountNameStringToObjectMeta(c.GetIAMServiceAccountName(s))
+		if err != nil {
+			return nil, err
+		}
+		template, err := c.GetStackTemplate(*s.StackName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "error getting Cloudformation template for stack %s", *s.StackName)
+		}
+
+		serviceAccount := &api.ClusterIAMServiceAccount{
+			ObjectMeta:          *meta,
+			RoleName:            gjson.Get(template, serviceAccountRoleNamePath).String(),
+			RolePath:            gjson.Get(template, serviceAccountRolePathPath).String(),
+			PermissionsBoundary: gjson.Get(template, serviceAccountPermissionsBoundaryPath).String(),
+		}
+		serviceAccount.OIDCIssuerURL = gjson.Get(template, serviceAccountOIDCIssuerURLPath).String()
+		for _, accountID := range gjson.Get(template, serviceAccountTrustedAccountsPath).Array() {
+			serviceAccount.TrustedAccounts = append(serviceAccount.TrustedAccounts, accountID.String())
+		}
+		for _, arn := range gjson.Get(template, serviceAccountPolicyARNsPath).Array() {
+			serviceAccount.AttachPolicyARNs = append(serviceAccount.AttachPolicyARNs, arn.String())
+		}
+		if policy, ok := gjson.Get(template, serviceAccountPolicyPath).Value().(map[string]interface{}); ok {
+			serviceAccount.AttachPolicy = policy
+		}
+		serviceAccounts = append(serviceAccounts, serviceAccount)
+	}
+	return serviceAccounts, nil
+}
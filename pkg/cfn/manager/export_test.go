@@ -0,0 +1,146 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection config export", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	mockStack := func(stackName, template string, tags map[string]string, stackOutputs map[string]string) {
+		stack := &cfn.Stack{
+			StackName:   aws.String(stackName),
+			StackStatus: aws.String(cfn.StackStatusCreateComplete),
+		}
+		for k, v := range tags {
+			stack.Tags = append(stack.Tags, &cfn.Tag{Key: aws.String(k), Value: aws.String(v)})
+		}
+		for k, v := range stackOutputs {
+			stack.Outputs = append(stack.Outputs, &cfn.Output{OutputKey: aws.String(k), OutputValue: aws.String(v)})
+		}
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{stack}}, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(template)}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("reconstructs nodegroups from their stacks", func() {
+		mockStack("eksctl-test-cluster-nodegroup-ng-1", `{
+			"Resources": {
+				"NodeGroup": {"Properties": {"DesiredCapacity": "3", "MinSize": "1", "MaxSize": "5"}},
+				"NodeGroupLaunchTemplate": {"Properties": {"LaunchTemplateData": {
+					"InstanceType": "m5.large",
+					"ImageId": "ami-123",
+					"KeyName": "my-key",
+					"BlockDeviceMappings": [{"Ebs": {"VolumeSize": 100, "VolumeType": "io1"}}]
+				}}}
+			}
+		}`, map[string]string{api.NodeGroupNameTag: "ng-1"}, map[string]string{"FeaturePrivateNetworking": "true"})
+
+		nodeGroups, err := sc.ExportNodeGroups()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(nodeGroups).To(HaveLen(1))
+
+		ng := nodeGroups[0]
+		Expect(ng.Name).To(Equal("ng-1"))
+		Expect(ng.InstanceType).To(Equal("m5.large"))
+		Expect(ng.AMI).To(Equal("ami-123"))
+		Expect(*ng.MinSize).To(Equal(1))
+		Expect(*ng.MaxSize).To(Equal(5))
+		Expect(*ng.DesiredCapacity).To(Equal(3))
+		Expect(*ng.VolumeSize).To(Equal(100))
+		Expect(*ng.VolumeType).To(Equal("io1"))
+		Expect(api.IsEnabled(ng.SSH.Allow)).To(BeTrue())
+		Expect(*ng.SSH.PublicKeyName).To(Equal("my-key"))
+		Expect(ng.PrivateNetworking).To(BeTrue())
+	})
+
+	It("reconstructs IAM service accounts with their policies", func() {
+		mockStack("eksctl-test-cluster-addon-iamserviceaccount-kube-system-s3-reader", `{
+			"Metadata": {"TrustPolicy": {"OIDCIssuerURL": "https://oidc.eks.us-west-2.amazonaws.com/id/HUB", "TrustedAccounts": ["111122223333"]}},
+			"Resources": {
+				"Role1": {"Properties": {"ManagedPolicyArns": ["arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"], "RoleName": "s3-reader", "PermissionsBoundary": "arn:aws:iam::123456789012:policy/boundary"}},
+				"Policy1": {"Properties": {"PolicyDocument": {"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": ["sqs:*"], "Resource": "*"}]}}}
+			}
+		}`, map[string]string{api.IAMServiceAccountNameTag: "kube-system/s3-reader"}, nil)
+
+		serviceAccounts, err := sc.ExportIAMServiceAccounts()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(serviceAccounts).To(HaveLen(1))
+
+		sa := serviceAccounts[0]
+		Expect(sa.Namespace).To(Equal("kube-system"))
+		Expect(sa.Name).To(Equal("s3-reader"))
+		Expect(sa.AttachPolicyARNs).To(ConsistOf("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"))
+		Expect(sa.RoleName).To(Equal("s3-reader"))
+		Expect(sa.RolePath).To(BeEmpty())
+		Expect(sa.PermissionsBoundary).To(Equal("arn:aws:iam::123456789012:policy/boundary"))
+		Expect(sa.OIDCIssuerURL).To(Equal("https://oidc.eks.us-west-2.amazonaws.com/id/HUB"))
+		Expect(sa.TrustedAccounts).To(Equal([]string{"111122223333"}))
+		Expect(sa.AttachPolicy).To(HaveKeyWithValue("Version", "2012-10-17"))
+		Expect(sa.Status).To(BeNil())
+	})
+
+	It("reconstructs managed nodegroups from the EKS API", func() {
+		ng := managedNodeGroupFromEKS(&eks.Nodegroup{
+			NodegroupName: aws.String("managed-1"),
+			AmiType:       aws.String(eks.AMITypesAl2X8664),
+			InstanceTypes: aws.StringSlice([]string{"m5.xlarge"}),
+			DiskSize:      aws.Int64(50),
+			ScalingConfig: &eks.NodegroupScalingConfig{MinSize: aws.Int64(2), MaxSize: aws.Int64(4), DesiredSize: aws.Int64(3)},
+			Labels: aws.StringMap(map[string]string{
+				api.ClusterNameLabel:   "test-cluster",
+				api.NodeGroupNameLabel: "managed-1",
+				"role":                 "workers",
+			}),
+			Taints: []*eks.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("gpu"), Effect: aws.String(eks.TaintEffectNoSchedule)},
+				{Key: aws.String("spot"), Effect: aws.String(eks.TaintEffectPreferNoSchedule)},
+			},
+			Tags: aws.StringMap(map[string]string{
+				api.ClusterNameTag: "test-cluster",
+				"team":             "data",
+			}),
+			RemoteAccess: &eks.RemoteAccessConfig{Ec2SshKey: aws.String("my-key")},
+		})
+
+		Expect(ng.Name).To(Equal("managed-1"))
+		Expect(ng.AMIType).To(Equal(api.ManagedNodeGroupAMITypeAmazonLinux2))
+		Expect(ng.InstanceType).To(Equal("m5.xlarge"))
+		Expect(*ng.VolumeSize).To(Equal(50))
+		Expect(*ng.MinSize).To(Equal(2))
+		Expect(*ng.MaxSize).To(Equal(4))
+		Expect(*ng.DesiredCapacity).To(Equal(3))
+		Expect(ng.Labels).To(Equal(map[string]string{"role": "workers"}))
+		Expect(ng.Taints).To(Equal(map[string]string{"dedicated": "gpu:NoSchedule", "spot": "PreferNoSchedule"}))
+		Expect(ng.Tags).To(Equal(map[string]string{"team": "data"}))
+		Expect(*ng.SSH.PublicKeyName).To(Equal("my-key"))
+	})
+})
+
+var _ = Describe("ManagedNodeGroupAMIType", func() {
+	It("picks the arm64 AMI type for Graviton GPU instance types", func() {
+		Expect(ManagedNodeGroupAMIType("g5g.xlarge")).To(Equal(api.ManagedNodeGroupAMITypeAmazonLinux2ARM))
+		Expect(ManagedNodeGroupAMIType("g5.xlarge")).To(Equal(api.ManagedNodeGroupAMITypeAmazonLinux2GPU))
+		Expect(ManagedNodeGroupAMIType("m5.large")).To(Equal(api.ManagedNodeGroupAMITypeAmazonLinux2))
+	})
+})
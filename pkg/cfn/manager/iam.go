@@ -1,15 +1,20 @@
 package manager
 
 import (
+	"encoding/json"
 	"fmt"
+	"reflect"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
+	"github.com/tidwall/gjson"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // makeIAMServiceAccountStackName generates the name of the iamserviceaccount stack identified by its name, isolated by the cluster this StackCollection operates on and 'addon' suffix
@@ -21,6 +26,9 @@ func (c *StackCollection) makeIAMServiceAccountStackName(namespace, name string)
 func (c *StackCollection) createIAMServiceAccountTask(errs chan error, spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) error {
 	name := c.makeIAMServiceAccountStackName(spec.Namespace, spec.Name)
 	logger.Info("building iamserviceaccount stack %q", name)
+	if err := checkTrustedOIDCProviders(spec, oidc); err != nil {
+		return err
+	}
 	stack := builder.NewIAMServiceAccountResourceSet(spec, oidc)
 	if err := stack.AddAllResources(); err != nil {
 		return err
@@ -31,6 +39,137 @@ func (c *StackCollection) createIAMServiceAccountTask(errs chan error, spec *api
 	return c.CreateStack(name, stack, tags, nil, errs)
 }
 
+// CreateIAMServiceAccountRole creates the IAM role of the iamserviceaccount and waits for it, setting
+// the role ARN in its status; the service account itself is not created, as this is used for
+// service accounts that are managed by something else, e.g. EKS addons
+func (c *StackCollection) CreateIAMServiceAccountRole(spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) error {
+	errs := make(chan error)
+	if err := c.createIAMServiceAccountTask(errs, spec, oidc); err != nil {
+		return err
+	}
+	return <-errs
+}
+
+// IAMServiceAccountStackChanged checks whether the template of the iamserviceaccount stack differs
+// from the one built from its spec, e.g. when policies, the permissions boundary or the role name were changed
+func (c *StackCollection) IAMServiceAccountStackChanged(spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) (bool, error) {
+	currentTemplate, err := c.GetStackTemplate(c.makeIAMServiceAccountStackName(spec.Namespace, spec.Name))
+	if err != nil {
+		return false, err
+	}
+	desiredTemplate, err := renderIAMServiceAccountTemplate(spec, oidc)
+	if err != nil {
+		return false, err
+	}
+
+	var current, desired interface{}
+	if err := json.Unmarshal([]byte(currentTemplate), &current); err != nil {
+		return false, errors.Wrapf(err, "parsing template of iamserviceaccount %q", spec.NameString())
+	}
+	if err := json.Unmarshal(desiredTemplate, &desired); err != nil {
+		return false, err
+	}
+	return !reflect.DeepEqual(current, desired), nil
+}
+
+// IAMServiceAccountPolicyChanges compares the policies of the role in the iamserviceaccount stack with
+// the ones in its spec, and describes what needs to change, one line for each change
+func (c *StackCollection) IAMServiceAccountPolicyChanges(spec *api.ClusterIAMServiceAccount) ([]string, error) {
+	template, err := c.GetStackTemplate(c.makeIAMServiceAccountStackName(spec.Namespace, spec.Name))
+	if err != nil {
+		return nil, err
+	}
+	changes := []string{}
+
+	current := sets.NewString()
+	for _, arn := range gjson.Get(template, serviceAccountPolicyARNsPath).Array() {
+		current.Insert(arn.String())
+	}
+	desired := sets.NewString(spec.AttachPolicyARNs...)
+	for _, arn := range desired.Difference(current).List() {
+		changes = append(changes, fmt.Sprintf("+ attach policy %q", arn))
+	}
+	for _, arn := range current.Difference(desired).List() {
+		changes = append(changes, fmt.Sprintf("- detach policy %q", arn))
+	}
+
+	currentPolicy := gjson.Get(template, serviceAccountPolicyPath)
+	switch {
+	case !currentPolicy.Exists() && len(spec.AttachPolicy) > 0:
+		changes = append(changes, "+ add inline policy")
+	case currentPolicy.Exists() && len(spec.AttachPolicy) == 0:
+		changes = append(changes, "- remove inline policy")
+	case currentPolicy.Exists():
+		desiredPolicy, err := json.Marshal(spec.AttachPolicy)
+		if err != nil {
+			return nil, err
+		}
+		var currentDoc, desiredDoc interface{}
+		if err := json.Unmarshal([]byte(currentPolicy.Raw), &currentDoc); err != nil {
+			return nil, err
+		}
+		if err := json.Unmarshal(desiredPolicy, &desiredDoc); err != nil {
+			return nil, err
+		}
+		if !reflect.DeepEqual(currentDoc, desiredDoc) {
+			changes = append(changes, "~ update inline policy")
+		}
+	}
+
+	if boundary := gjson.Get(template, serviceAccountPermissionsBoundaryPath).String(); boundary != spec.PermissionsBoundary {
+		if spec.PermissionsBoundary == "" {
+			changes = append(changes, "- remove permissions boundary")
+		} else {
+			changes = append(changes, fmt.Sprintf("~ set permissions boundary to %q", spec.PermissionsBoundary))
+		}
+	}
+	return changes, nil
+}
+
+// UpdateIAMServiceAccountStack updates the stack of the iamserviceaccount with the template built from
+// its spec, only the IAM role is changed and the serviceaccount itself is left as it is
+func (c *StackCollection) UpdateIAMServiceAccountStack(spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager, plan bool) error {
+	if err := checkTrustedOIDCProviders(spec, oidc); err != nil {
+		return err
+	}
+	template, err := renderIAMServiceAccountTemplate(spec, oidc)
+	if err != nil {
+		return err
+	}
+	name := c.makeIAMServiceAccountStackName(spec.Namespace, spec.Name)
+	description := fmt.Sprintf("updating IAM role of iamserviceaccount %q", spec.NameString())
+	return c.UpdateStack(name, c.MakeChangeSetName("update-iamserviceaccount"), description, template, nil, plan)
+}
+
+func (c *StackCollection) updateIAMServiceAccountTask(errs chan error, spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) error {
+	err := c.UpdateIAMServiceAccountStack(spec, oidc, false)
+	close(errs)
+	return err
+}
+
+// checkTrustedOIDCProviders checks that the IAM OIDC providers the role of the iamserviceaccount trusts
+// exist, when they are not the one of the cluster
+func checkTrustedOIDCProviders(spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) error {
+	if !spec.HasTrustPolicyOverrides() {
+		return nil
+	}
+	if spec.OIDCIssuerURL != "" {
+		var err error
+		if oidc, err = oidc.WithIssuer(spec.OIDCIssuerURL); err != nil {
+			return err
+		}
+	}
+	return errors.Wrapf(oidc.CheckCrossAccountProvidersExist(spec.TrustedAccounts), "iamserviceaccount %q", spec.NameString())
+}
+
+func renderIAMServiceAccountTemplate(spec *api.ClusterIAMServiceAccount, oidc *iamoidc.OpenIDConnectManager) ([]byte, error) {
+	stack := builder.NewIAMServiceAccountResourceSet(spec, oidc)
+	if err := stack.AddAllResources(); err != nil {
+		return nil, err
+	}
+	return stack.RenderJSON()
+}
+
 // DescribeIAMServiceAccountStacks calls DescribeStacks and filters out iamserviceaccounts
 func (c *StackCollection) DescribeIAMServiceAccountStacks() ([]*Stack, error) {
 	stacks, err := c.DescribeStacks()
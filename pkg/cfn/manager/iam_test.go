@@ -0,0 +1,93 @@
+package manager
+
+import (
+	"bytes"
+	"encoding/json"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection IAM service accounts", func() {
+	var (
+		p    *mockprovider.MockProvider
+		sc   *StackCollection
+		oidc *iamoidc.OpenIDConnectManager
+		sa   *api.ClusterIAMServiceAccount
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		sc = NewStackCollection(p, cfg)
+
+		var err error
+		oidc, err = iamoidc.NewOpenIDConnectManager(nil, "123456789012", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E")
+		Expect(err).NotTo(HaveOccurred())
+		oidc.ProviderARN = "arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E"
+
+		sa = &api.ClusterIAMServiceAccount{AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}}
+		sa.Name = "s3-reader"
+		sa.Namespace = "default"
+
+		// the template as it was deployed, formatted differently than it's rendered
+		deployed, err := renderIAMServiceAccountTemplate(sa, oidc)
+		Expect(err).NotTo(HaveOccurred())
+		indented := &bytes.Buffer{}
+		Expect(json.Indent(indented, deployed, "", "    ")).To(Succeed())
+
+		p.MockCloudFormation().On("GetTemplate", mock.MatchedBy(func(input *cfn.GetTemplateInput) bool {
+			return *input.StackName == "eksctl-test-cluster-addon-iamserviceaccount-default-s3-reader"
+		})).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(indented.String())}, nil)
+	})
+
+	It("detects that the stack of an iamserviceaccount is up to date", func() {
+		changed, err := sc.IAMServiceAccountStackChanged(sa, oidc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeFalse())
+	})
+
+	It("detects changes to the policies and the permissions boundary", func() {
+		sa.AttachPolicyARNs = append(sa.AttachPolicyARNs, "arn:aws:iam::aws:policy/AmazonDynamoDBReadOnlyAccess")
+		changed, err := sc.IAMServiceAccountStackChanged(sa, oidc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+
+		sa.AttachPolicyARNs = sa.AttachPolicyARNs[:1]
+		sa.PermissionsBoundary = "arn:aws:iam::123456789012:policy/boundary"
+		changed, err = sc.IAMServiceAccountStackChanged(sa, oidc)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changed).To(BeTrue())
+	})
+
+	It("describes the changes to the policies of the role", func() {
+		changes, err := sc.IAMServiceAccountPolicyChanges(sa)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(BeEmpty())
+
+		sa.AttachPolicyARNs = []string{"arn:aws:iam::aws:policy/AmazonDynamoDBReadOnlyAccess"}
+		sa.AttachPolicy = api.InlineDocument{
+			"Version":   "2012-10-17",
+			"Statement": []interface{}{map[string]interface{}{"Effect": "Allow", "Action": "s3:Get*", "Resource": "*"}},
+		}
+		sa.PermissionsBoundary = "arn:aws:iam::123456789012:policy/boundary"
+
+		changes, err = sc.IAMServiceAccountPolicyChanges(sa)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(changes).To(Equal([]string{
+			`+ attach policy "arn:aws:iam::aws:policy/AmazonDynamoDBReadOnlyAccess"`,
+			`- detach policy "arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"`,
+			"+ add inline policy",
+			`~ set permissions boundary to "arn:aws:iam::123456789012:policy/boundary"`,
+		}))
+	})
+})
@@ -0,0 +1,87 @@
+package manager
+
+import (
+	"errors"
+	"sort"
+	"sync"
+	"time"
+)
+
+// ErrInterrupted is the error of tasks that were not started, as the operation was interrupted
+var ErrInterrupted = errors.New("not started, as the operation was interrupted")
+
+// InFlightTask is a task that is running
+type InFlightTask struct {
+	Description string
+	// StackName is set if the task operates on a stack
+	StackName string
+	Started   time.Time
+}
+
+var interruption = struct {
+	sync.Mutex
+	interrupted bool
+	nextID      int
+	running     map[int]InFlightTask
+	// inFlight holds the tasks that were running when Interrupt was called
+	inFlight []InFlightTask
+}{running: map[int]InFlightTask{}}
+
+// Interrupt stops tasks from starting, tasks that are running are left to complete, as
+// the CloudFormation operations that most of them wait for can't be cancelled; it returns
+// the tasks that are running, the oldest first
+func Interrupt() []InFlightTask {
+	interruption.Lock()
+	defer interruption.Unlock()
+	if !interruption.interrupted {
+		interruption.interrupted = true
+		for _, task := range interruption.running {
+			interruption.inFlight = append(interruption.inFlight, task)
+		}
+		sort.Slice(interruption.inFlight, func(i, j int) bool {
+			return interruption.inFlight[i].Started.Before(interruption.inFlight[j].Started)
+		})
+	}
+	return interruption.inFlight
+}
+
+// Interrupted returns the tasks that were running when Interrupt was called, and whether it was
+func Interrupted() ([]InFlightTask, bool) {
+	interruption.Lock()
+	defer interruption.Unlock()
+	return interruption.inFlight, interruption.interrupted
+}
+
+// ResetInterrupt allows tasks to start again after Interrupt was called
+func ResetInterrupt() {
+	interruption.Lock()
+	defer interruption.Unlock()
+	interruption.interrupted = false
+	interruption.inFlight = nil
+}
+
+// startTask records the task as running, unless tasks were interrupted, in which case
+// ok is false; sets of tasks are not recorded, their id is 0
+func startTask(task Task, started time.Time) (id int, ok bool) {
+	interruption.Lock()
+	defer interruption.Unlock()
+	if interruption.interrupted {
+		return 0, false
+	}
+	if _, isTree := task.(*TaskTree); isTree {
+		return 0, true
+	}
+	interruption.nextID++
+	inFlight := InFlightTask{Description: task.Describe(), Started: started}
+	if st, isStackTask := task.(stackTask); isStackTask {
+		inFlight.StackName = st.stackName()
+	}
+	interruption.running[interruption.nextID] = inFlight
+	return interruption.nextID, true
+}
+
+func finishTask(id int) {
+	interruption.Lock()
+	defer interruption.Unlock()
+	delete(interruption.running, id)
+}
@@ -0,0 +1,84 @@
+package manager
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// makeKarpenterStackName generates the name of the stack of the resources that Karpenter needs,
+// isolated by the cluster this StackCollection operates on and 'addon' suffix
+func (c *StackCollection) makeKarpenterStackName() string {
+	return fmt.Sprintf("eksctl-%s-addon-karpenter", c.spec.Metadata.Name)
+}
+
+// DescribeKarpenterStack returns the stack of the resources that Karpenter needs, or nil if it doesn't exist
+func (c *StackCollection) DescribeKarpenterStack() (*Stack, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stacks {
+		if *s.StackStatus != cfn.StackStatusDeleteComplete && *s.StackName == c.makeKarpenterStackName() {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// EnsureKarpenterStack creates the stack of the resources that Karpenter needs and waits for it,
+// unless the stack exists already, and returns the resources; in plan mode nothing is created
+// and nil is returned when the stack doesn't exist
+func (c *StackCollection) EnsureKarpenterStack(plan bool) (*builder.KarpenterResources, error) {
+	name := c.makeKarpenterStackName()
+	stack := builder.NewKarpenterResourceSet(c.spec)
+	if err := stack.AddAllResources(); err != nil {
+		return nil, err
+	}
+
+	existing, err := c.DescribeKarpenterStack()
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		logger.Info("stack %q already exists", name)
+		if err := stack.GetAllOutputs(*existing); err != nil {
+			return nil, err
+		}
+		return &stack.Resources, nil
+	}
+	if plan {
+		logger.Info("(plan) would create stack %q", name)
+		return nil, nil
+	}
+
+	logger.Info("building Karpenter stack %q", name)
+	errs := make(chan error)
+	if err := c.CreateStack(name, stack, nil, nil, errs); err != nil {
+		return nil, err
+	}
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return &stack.Resources, nil
+}
+
+// NewTasksToDeleteKarpenter defines the task to delete the stack of the resources that Karpenter needs, if it exists
+func (c *StackCollection) NewTasksToDeleteKarpenter() (*TaskTree, error) {
+	tasks := &TaskTree{Parallel: false}
+	s, err := c.DescribeKarpenterStack()
+	if err != nil {
+		return nil, err
+	}
+	if s != nil {
+		tasks.Append(&taskWithStackSpec{
+			info:  fmt.Sprintf("delete Karpenter stack %q", *s.StackName),
+			stack: s,
+			call:  c.DeleteStackBySpecSync,
+		})
+	}
+	return tasks, nil
+}
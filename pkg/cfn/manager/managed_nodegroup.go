@@ -0,0 +1,636 @@
+package manager
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+const managedNodeGroupPollInterval = 20 * time.Second
+
+// taint effects use different names in EKS API and in Kubernetes
+var taintEffectsToEKS = map[string]string{
+	"NoSchedule":       eks.TaintEffectNoSchedule,
+	"PreferNoSchedule": eks.TaintEffectPreferNoSchedule,
+	"NoExecute":        eks.TaintEffectNoExecute,
+}
+
+// makeManagedNodeGroupStackName generates the name of the stack that holds the IAM role of a managed nodegroup
+func (c *StackCollection) makeManagedNodeGroupStackName(name string) string {
+	return fmt.Sprintf("eksctl-%s-managed-nodegroup-%s", c.spec.Metadata.Name, name)
+}
+
+// createManagedNodeGroupTask creates the IAM role of the managed nodegroup (unless an existing
+// one is given), then the nodegroup itself, and waits for it to become active
+func (c *StackCollection) createManagedNodeGroupTask(errs chan error, ng *api.ManagedNodeGroup) error {
+	if ng.IAM == nil {
+		ng.IAM = &api.ManagedNodeGroupIAM{}
+	}
+
+	input, err := c.makeCreateNodegroupInput(ng)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		defer close(errs)
+
+		if ng.IAM.InstanceRoleARN == "" {
+			name := c.makeManagedNodeGroupStackName(ng.Name)
+			logger.Info("building managed nodegroup stack %q", name)
+			stack := builder.NewManagedNodeGroupResourceSet(ng).WithRegion(c.spec.Metadata.Region)
+			if err := stack.AddAllResources(); err != nil {
+				errs <- err
+				return
+			}
+			stackErrs := make(chan error)
+			tags := map[string]string{api.ManagedNodeGroupNameTag: ng.Name}
+			if err := c.CreateStack(name, stack, tags, nil, stackErrs); err != nil {
+				errs <- err
+				return
+			}
+			if err := <-stackErrs; err != nil {
+				errs <- err
+				return
+			}
+		}
+		input.NodeRole = aws.String(ng.IAM.InstanceRoleARN)
+
+		logger.Info("creating managed nodegroup %q", ng.Name)
+		if _, err := c.provider.EKS().CreateNodegroup(input); err != nil {
+			errs <- errors.Wrapf(err, "creating managed nodegroup %q", ng.Name)
+			return
+		}
+		logger.Info("waiting for managed nodegroup %q to become active", ng.Name)
+		if err := c.provider.EKS().WaitUntilNodegroupActiveWithContext(c.Context(), c.describeNodegroupInput(ng.Name), c.managedNodeGroupWaiterOptions()...); err != nil {
+			errs <- errors.Wrapf(err, "waiting for managed nodegroup %q to become active", ng.Name)
+			return
+		}
+		errs <- nil
+	}()
+
+	return nil
+}
+
+func (c *StackCollection) makeCreateNodegroupInput(ng *api.ManagedNodeGroup) (*eks.CreateNodegroupInput, error) {
+	subnets, err := c.managedNodeGroupSubnets(ng)
+	if err != nil {
+		return nil, err
+	}
+
+	amiType := ng.AMIType
+	if amiType == "" {
+		amiType = ManagedNodeGroupAMIType(ng.InstanceType)
+	}
+
+	tags := map[string]string{
+		api.ClusterNameTag:   c.spec.Metadata.Name,
+		api.NodeGroupNameTag: ng.Name,
+	}
+	for k, v := range c.spec.Metadata.Tags {
+		tags[k] = v
+	}
+	for k, v := range ng.Tags {
+		tags[k] = v
+	}
+
+	input := &eks.CreateNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(ng.Name),
+		AmiType:       aws.String(amiType),
+		InstanceTypes: aws.StringSlice([]string{ng.InstanceType}),
+		Labels:        aws.StringMap(c.managedNodeGroupLabels(ng)),
+		Taints:        makeTaints(ng.Taints),
+		Tags:          aws.StringMap(tags),
+		ScalingConfig: makeScalingConfig(ng, nil),
+		Subnets:       aws.StringSlice(subnets),
+	}
+	if ng.VolumeSize != nil {
+		input.DiskSize = aws.Int64(int64(*ng.VolumeSize))
+	}
+	if ng.SSH != nil && api.IsEnabled(ng.SSH.Allow) {
+		input.RemoteAccess = &eks.RemoteAccessConfig{
+			Ec2SshKey: ng.SSH.PublicKeyName,
+		}
+	}
+	return input, nil
+}
+
+// ManagedNodeGroupAMIType returns the AMI type that suits the instance type, the architecture
+// goes first, as the GPU AMI type is x86_64 only
+func ManagedNodeGroupAMIType(instanceType string) string {
+	switch {
+	case utils.IsARMInstanceType(instanceType):
+		return api.ManagedNodeGroupAMITypeAmazonLinux2ARM
+	case utils.IsGPUInstanceType(instanceType):
+		return api.ManagedNodeGroupAMITypeAmazonLinux2GPU
+	default:
+		return api.ManagedNodeGroupAMITypeAmazonLinux2
+	}
+}
+
+// managedNodeGroupSubnets returns public or private subnets of the cluster VPC,
+// limited to the availability zones of the nodegroup if any are set
+func (c *StackCollection) managedNodeGroupSubnets(ng *api.ManagedNodeGroup) ([]string, error) {
+	if c.spec.VPC == nil || c.spec.VPC.Subnets == nil {
+		return nil, fmt.Errorf("subnets of cluster %q are unknown", c.spec.Metadata.Name)
+	}
+	topology := "public"
+	subnets := c.spec.VPC.Subnets.Public
+	if ng.PrivateNetworking {
+		topology = "private"
+		subnets = c.spec.VPC.Subnets.Private
+	}
+
+	ids := []string{}
+	if len(ng.AvailabilityZones) == 0 {
+		for _, subnet := range subnets {
+			ids = append(ids, subnet.ID)
+		}
+		sort.Strings(ids)
+	} else {
+		for _, az := range ng.AvailabilityZones {
+			subnet, ok := subnets[az]
+			if !ok {
+				return nil, fmt.Errorf("VPC doesn't have %s subnets in %s", topology, az)
+			}
+			ids = append(ids, subnet.ID)
+		}
+	}
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("VPC doesn't have %s subnets for managed nodegroup %q", topology, ng.Name)
+	}
+	return ids, nil
+}
+
+// managedNodeGroupLabels returns labels of the nodegroup along with the ones
+// that eksctl uses to identify nodes of the nodegroup
+func (c *StackCollection) managedNodeGroupLabels(ng *api.ManagedNodeGroup) map[string]string {
+	labels := map[string]string{
+		api.ClusterNameLabel:   c.spec.Metadata.Name,
+		api.NodeGroupNameLabel: ng.Name,
+	}
+	for k, v := range ng.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+func makeTaints(taints map[string]string) []*eks.Taint {
+	keys := []string{}
+	for key := range taints {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	result := []*eks.Taint{}
+	for _, key := range keys {
+		// taints have been validated already
+		value, effect, _ := api.ParseTaint(taints[key])
+		taint := &eks.Taint{
+			Key:    aws.String(key),
+			Effect: aws.String(taintEffectsToEKS[effect]),
+		}
+		if value != "" {
+			taint.Value = aws.String(value)
+		}
+		result = append(result, taint)
+	}
+	return result
+}
+
+// makeScalingConfig fills the sizes that are not set in the nodegroup with
+// the current ones, or with defaults when the nodegroup doesn't exist yet
+func makeScalingConfig(ng *api.ManagedNodeGroup, current *eks.NodegroupScalingConfig) *eks.NodegroupScalingConfig {
+	if current == nil {
+		desired := api.DefaultNodeCount
+		switch {
+		case ng.DesiredCapacity != nil:
+			desired = *ng.DesiredCapacity
+		case ng.MinSize != nil && *ng.MinSize > desired:
+			desired = *ng.MinSize
+		case ng.MaxSize != nil && *ng.MaxSize < desired:
+			desired = *ng.MaxSize
+		}
+		current = &eks.NodegroupScalingConfig{
+			DesiredSize: aws.Int64(int64(desired)),
+			MinSize:     aws.Int64(int64(desired)),
+			MaxSize:     aws.Int64(int64(desired)),
+		}
+	}
+
+	config := &eks.NodegroupScalingConfig{
+		DesiredSize: current.DesiredSize,
+		MinSize:     current.MinSize,
+		MaxSize:     current.MaxSize,
+	}
+	if ng.DesiredCapacity != nil {
+		config.DesiredSize = aws.Int64(int64(*ng.DesiredCapacity))
+	}
+	if ng.MinSize != nil {
+		config.MinSize = aws.Int64(int64(*ng.MinSize))
+	}
+	if ng.MaxSize != nil {
+		config.MaxSize = aws.Int64(int64(*ng.MaxSize))
+	}
+	return config
+}
+
+func (c *StackCollection) describeNodegroupInput(name string) *eks.DescribeNodegroupInput {
+	return &eks.DescribeNodegroupInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(name),
+	}
+}
+
+func (c *StackCollection) managedNodeGroupWaiterOptions() []request.WaiterOption {
+	return []request.WaiterOption{
+		request.WithWaiterDelay(request.ConstantWaiterDelay(managedNodeGroupPollInterval)),
+		request.WithWaiterMaxAttempts(int(c.provider.WaitTimeout()/managedNodeGroupPollInterval) + 1),
+	}
+}
+
+// DescribeManagedNodeGroup returns the managed nodegroup with the given name,
+// or nil if there is no such managed nodegroup
+func (c *StackCollection) DescribeManagedNodeGroup(name string) (*eks.Nodegroup, error) {
+	output, err := c.provider.EKS().DescribeNodegroup(c.describeNodegroupInput(name))
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, errors.Wrapf(err, "describing managed nodegroup %q", name)
+	}
+	return output.Nodegroup, nil
+}
+
+// ListManagedNodeGroups returns names of all managed nodegroups of the cluster, or none if the
+// cluster doesn't exist, e.g. when deleting a cluster whose control plane is gone already
+func (c *StackCollection) ListManagedNodeGroups() ([]string, error) {
+	names := []string{}
+	input := &eks.ListNodegroupsInput{
+		ClusterName: aws.String(c.spec.Metadata.Name),
+	}
+	err := c.provider.EKS().ListNodegroupsPages(input, func(output *eks.ListNodegroupsOutput, _ bool) bool {
+		names = append(names, aws.StringValueSlice(output.Nodegroups)...)
+		return true
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException {
+			return []string{}, nil
+		}
+		return nil, errors.Wrapf(err, "listing managed nodegroups of cluster %q", c.spec.Metadata.Name)
+	}
+	return names, nil
+}
+
+// UpdateManagedNodeGroupConfig brings labels, taints and scaling config of an existing
+// managed nodegroup in line with the given spec; it returns false if there was nothing to update
+func (c *StackCollection) UpdateManagedNodeGroupConfig(ng *api.ManagedNodeGroup) (bool, error) {
+	current, err := c.DescribeManagedNodeGroup(ng.Name)
+	if err != nil {
+		return false, err
+	}
+	if current == nil {
+		return false, fmt.Errorf("managed nodegroup %q not found", ng.Name)
+	}
+
+	input := &eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(ng.Name),
+		Labels:        makeUpdateLabelsPayload(current.Labels, c.managedNodeGroupLabels(ng)),
+		Taints:        makeUpdateTaintsPayload(current.Taints, makeTaints(ng.Taints)),
+	}
+	if scalingConfig := makeScalingConfig(ng, current.ScalingConfig); !scalingConfigEqual(scalingConfig, current.ScalingConfig) {
+		input.ScalingConfig = scalingConfig
+	}
+
+	if input.Labels == nil && input.Taints == nil && input.ScalingConfig == nil {
+		return false, nil
+	}
+
+	logger.Info("updating configuration of managed nodegroup %q", ng.Name)
+	output, err := c.provider.EKS().UpdateNodegroupConfig(input)
+	if err != nil {
+		return false, errors.Wrapf(err, "updating configuration of managed nodegroup %q", ng.Name)
+	}
+	return true, c.waitForManagedNodeGroupUpdate(ng.Name, output.Update)
+}
+
+// ScaleManagedNodeGroup sets desired capacity of a managed nodegroup, adjusting
+// its min or max size when the desired capacity is outside of the current range
+func (c *StackCollection) ScaleManagedNodeGroup(name string, desiredCapacity int, plan bool) error {
+	return c.ScaleManagedNodeGroupToSpec(&api.ManagedNodeGroup{
+		Name:            name,
+		DesiredCapacity: &desiredCapacity,
+	}, plan)
+}
+
+// ScaleManagedNodeGroupToSpec sets the desired capacity, min and max size of a managed nodegroup that
+// are set in the spec, adjusting its min or max size when the desired capacity is outside of the range;
+// with plan, the new sizes are only logged
+func (c *StackCollection) ScaleManagedNodeGroupToSpec(ng *api.ManagedNodeGroup, plan bool) error {
+	current, err := c.DescribeManagedNodeGroup(ng.Name)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return fmt.Errorf("managed nodegroup %q not found", ng.Name)
+	}
+
+	scalingConfig := makeScalingConfig(&api.ManagedNodeGroup{
+		DesiredCapacity: ng.DesiredCapacity,
+		MinSize:         ng.MinSize,
+		MaxSize:         ng.MaxSize,
+	}, current.ScalingConfig)
+	desiredCapacity := aws.Int64Value(scalingConfig.DesiredSize)
+	if desiredCapacity < aws.Int64Value(scalingConfig.MinSize) {
+		scalingConfig.MinSize = scalingConfig.DesiredSize
+	}
+	if desiredCapacity > aws.Int64Value(scalingConfig.MaxSize) {
+		scalingConfig.MaxSize = scalingConfig.DesiredSize
+	}
+	if scalingConfigEqual(scalingConfig, current.ScalingConfig) {
+		logger.Info("size of managed nodegroup %q is already as requested (desired capacity %d)", ng.Name, desiredCapacity)
+		return nil
+	}
+
+	if plan {
+		logger.Info("(plan) would scale managed nodegroup %q to %d node(s) (min size %d, max size %d)",
+			ng.Name, desiredCapacity, aws.Int64Value(scalingConfig.MinSize), aws.Int64Value(scalingConfig.MaxSize))
+		return nil
+	}
+	logger.Info("scaling managed nodegroup %q to %d node(s)", ng.Name, desiredCapacity)
+	output, err := c.provider.EKS().UpdateNodegroupConfig(&eks.UpdateNodegroupConfigInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(ng.Name),
+		ScalingConfig: scalingConfig,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "scaling managed nodegroup %q", ng.Name)
+	}
+	return c.waitForManagedNodeGroupUpdate(ng.Name, output.Update)
+}
+
+// GetManagedNodeGroupSize returns the desired capacity, min and max size of the managed nodegroup
+func (c *StackCollection) GetManagedNodeGroupSize(name string) (*NodeGroupSize, error) {
+	current, err := c.DescribeManagedNodeGroup(name)
+	if err != nil {
+		return nil, err
+	}
+	if current == nil {
+		return nil, fmt.Errorf("managed nodegroup %q not found", name)
+	}
+	return &NodeGroupSize{
+		DesiredCapacity: int(aws.Int64Value(current.ScalingConfig.DesiredSize)),
+		MinSize:         int(aws.Int64Value(current.ScalingConfig.MinSize)),
+		MaxSize:         int(aws.Int64Value(current.ScalingConfig.MaxSize)),
+	}, nil
+}
+
+// UpgradeManagedNodeGroup updates the Kubernetes version of a managed nodegroup,
+// EKS replaces its nodes with ones based on the latest AMI release for that version
+func (c *StackCollection) UpgradeManagedNodeGroup(name, version string) error {
+	logger.Info("upgrading managed nodegroup %q to Kubernetes %s", name, version)
+	output, err := c.provider.EKS().UpdateNodegroupVersion(&eks.UpdateNodegroupVersionInput{
+		ClusterName:   aws.String(c.spec.Metadata.Name),
+		NodegroupName: aws.String(name),
+		Version:       aws.String(version),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "upgrading managed nodegroup %q", name)
+	}
+	return c.waitForManagedNodeGroupUpdate(name, output.Update)
+}
+
+// waitForManagedNodeGroupUpdate polls the update until it's no longer in progress
+func (c *StackCollection) waitForManagedNodeGroupUpdate(name string, update *eks.Update) error {
+	logger.Info("waiting for update %q of managed nodegroup %q to complete", aws.StringValue(update.Id), name)
+	timer := time.After(c.provider.WaitTimeout())
+	for {
+		switch status := aws.StringValue(update.Status); status {
+		case eks.UpdateStatusSuccessful:
+			return nil
+		case eks.UpdateStatusFailed, eks.UpdateStatusCancelled:
+			messages := []string{}
+			for _, e := range update.Errors {
+				messages = append(messages, aws.StringValue(e.ErrorMessage))
+			}
+			return fmt.Errorf("update %q of managed nodegroup %q is in %q state: %v", aws.StringValue(update.Id), name, status, messages)
+		}
+
+		select {
+		case <-timer:
+			return fmt.Errorf("timed out (after %s) waiting for update %q of managed nodegroup %q", c.provider.WaitTimeout(), aws.StringValue(update.Id), name)
+		case <-c.Context().Done():
+			return errors.Wrapf(c.Context().Err(), "waiting for update %q of managed nodegroup %q", aws.StringValue(update.Id), name)
+		case <-time.After(managedNodeGroupPollInterval):
+		}
+
+		output, err := c.provider.EKS().DescribeUpdate(&eks.DescribeUpdateInput{
+			Name:          aws.String(c.spec.Metadata.Name),
+			NodegroupName: aws.String(name),
+			UpdateId:      update.Id,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "describing update %q of managed nodegroup %q", aws.StringValue(update.Id), name)
+		}
+		update = output.Update
+	}
+}
+
+func makeUpdateLabelsPayload(current map[string]*string, desired map[string]string) *eks.UpdateLabelsPayload {
+	payload := &eks.UpdateLabelsPayload{}
+	for k, v := range desired {
+		if value, ok := current[k]; !ok || aws.StringValue(value) != v {
+			if payload.AddOrUpdateLabels == nil {
+				payload.AddOrUpdateLabels = map[string]*string{}
+			}
+			payload.AddOrUpdateLabels[k] = aws.String(v)
+		}
+	}
+	for k := range current {
+		if _, ok := desired[k]; !ok {
+			payload.RemoveLabels = append(payload.RemoveLabels, aws.String(k))
+		}
+	}
+	if len(payload.AddOrUpdateLabels) == 0 && len(payload.RemoveLabels) == 0 {
+		return nil
+	}
+	sort.Slice(payload.RemoveLabels, func(i, j int) bool {
+		return *payload.RemoveLabels[i] < *payload.RemoveLabels[j]
+	})
+	return payload
+}
+
+// makeUpdateTaintsPayload identifies taints by key and effect, as Kubernetes does
+func makeUpdateTaintsPayload(current, desired []*eks.Taint) *eks.UpdateTaintsPayload {
+	taintID := func(t *eks.Taint) string {
+		return aws.StringValue(t.Key) + ":" + aws.StringValue(t.Effect)
+	}
+	currentByID := map[string]*eks.Taint{}
+	for _, t := range current {
+		currentByID[taintID(t)] = t
+	}
+	desiredByID := map[string]*eks.Taint{}
+	for _, t := range desired {
+		desiredByID[taintID(t)] = t
+	}
+
+	payload := &eks.UpdateTaintsPayload{}
+	for _, t := range desired {
+		if existing, ok := currentByID[taintID(t)]; !ok || aws.StringValue(existing.Value) != aws.StringValue(t.Value) {
+			payload.AddOrUpdateTaints = append(payload.AddOrUpdateTaints, t)
+		}
+	}
+	for _, t := range current {
+		if _, ok := desiredByID[taintID(t)]; !ok {
+			payload.RemoveTaints = append(payload.RemoveTaints, t)
+		}
+	}
+	if len(payload.AddOrUpdateTaints) == 0 && len(payload.RemoveTaints) == 0 {
+		return nil
+	}
+	return payload
+}
+
+func scalingConfigEqual(a, b *eks.NodegroupScalingConfig) bool {
+	return aws.Int64Value(a.DesiredSize) == aws.Int64Value(b.DesiredSize) &&
+		aws.Int64Value(a.MinSize) == aws.Int64Value(b.MinSize) &&
+		aws.Int64Value(a.MaxSize) == aws.Int64Value(b.MaxSize)
+}
+
+// deleteManagedNodeGroupTask deletes the managed nodegroup, waits for it to be gone,
+// then deletes the stack with its IAM role
+func (c *StackCollection) deleteManagedNodeGroupTask(errs chan error, name string) error {
+	go func() {
+		defer close(errs)
+
+		logger.Info("deleting managed nodegroup %q", name)
+		_, err := c.provider.EKS().DeleteNodegroup(&eks.DeleteNodegroupInput{
+			ClusterName:   aws.String(c.spec.Metadata.Name),
+			NodegroupName: aws.String(name),
+		})
+		if err != nil {
+			errs <- errors.Wrapf(err, "deleting managed nodegroup %q", name)
+			return
+		}
+		if err := c.provider.EKS().WaitUntilNodegroupDeletedWithContext(c.Context(), c.describeNodegroupInput(name), c.managedNodeGroupWaiterOptions()...); err != nil {
+			errs <- errors.Wrapf(err, "waiting for managed nodegroup %q to be deleted", name)
+			return
+		}
+
+		stack, err := c.describeManagedNodeGroupStack(name)
+		if err != nil {
+			errs <- err
+			return
+		}
+		if stack == nil {
+			errs <- nil
+			return
+		}
+		stackErrs := make(chan error)
+		if err := c.DeleteStackBySpecSync(stack, stackErrs); err != nil {
+			errs <- err
+			return
+		}
+		errs <- <-stackErrs
+	}()
+	return nil
+}
+
+// DescribeManagedNodeGroupStacks calls DescribeStacks and filters out stacks of managed nodegroups
+func (c *StackCollection) DescribeManagedNodeGroupStacks() ([]*Stack, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	managedNodeGroupStacks := []*Stack{}
+	for _, s := range stacks {
+		if *s.StackStatus == cfn.StackStatusDeleteComplete {
+			continue
+		}
+		if c.GetManagedNodeGroupName(s) != "" {
+			managedNodeGroupStacks = append(managedNodeGroupStacks, s)
+		}
+	}
+	return managedNodeGroupStacks, nil
+}
+
+func (c *StackCollection) describeManagedNodeGroupStack(name string) (*Stack, error) {
+	stacks, err := c.DescribeManagedNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	for _, s := range stacks {
+		if c.GetManagedNodeGroupName(s) == name {
+			return s, nil
+		}
+	}
+	return nil, nil
+}
+
+// GetManagedNodeGroupName will return managed nodegroup name based on tags
+func (*StackCollection) GetManagedNodeGroupName(s *Stack) string {
+	for _, tag := range s.Tags {
+		if *tag.Key == api.ManagedNodeGroupNameTag {
+			return *tag.Value
+		}
+	}
+	return ""
+}
+
+// GetManagedNodeGroupSummaries returns a list of summaries for the managed nodegroups of a cluster
+func (c *StackCollection) GetManagedNodeGroupSummaries(name string) ([]*NodeGroupSummary, error) {
+	names := []string{name}
+	if name == "" {
+		var err error
+		if names, err = c.ListManagedNodeGroups(); err != nil {
+			return nil, err
+		}
+	}
+
+	summaries := []*NodeGroupSummary{}
+	for _, name := range names {
+		ng, err := c.DescribeManagedNodeGroup(name)
+		if err != nil {
+			return nil, err
+		}
+		if ng == nil {
+			continue
+		}
+		summary := &NodeGroupSummary{
+			StackName:    c.makeManagedNodeGroupStackName(name),
+			Cluster:      aws.StringValue(ng.ClusterName),
+			Name:         name,
+			ImageID:      aws.StringValue(ng.AmiType),
+			CreationTime: ng.CreatedAt,
+			Status:       aws.StringValue(ng.Status),
+		}
+		if ng.Resources != nil && len(ng.Resources.AutoScalingGroups) > 0 {
+			summary.AutoScalingGroupName = aws.StringValue(ng.Resources.AutoScalingGroups[0].Name)
+		}
+		if len(ng.InstanceTypes) > 0 {
+			summary.InstanceType = aws.StringValue(ng.InstanceTypes[0])
+		}
+		if ng.ScalingConfig != nil {
+			summary.MinSize = int(aws.Int64Value(ng.ScalingConfig.MinSize))
+			summary.MaxSize = int(aws.Int64Value(ng.ScalingConfig.MaxSize))
+			summary.DesiredCapacity = int(aws.Int64Value(ng.ScalingConfig.DesiredSize))
+		}
+		summaries = append(summaries, summary)
+	}
+	return summaries, nil
+}
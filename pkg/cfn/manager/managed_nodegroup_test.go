@@ -0,0 +1,262 @@
+package manager
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection ManagedNodeGroup", func() {
+	var (
+		cfg *api.ClusterConfig
+		sc  *StackCollection
+		p   *mockprovider.MockProvider
+		ng  *api.ManagedNodeGroup
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Region = "us-west-2"
+		cfg.Metadata.Name = "test-cluster"
+		Expect(cfg.ImportSubnet(api.SubnetTopologyPublic, "us-west-2a", "subnet-public-a", "192.168.0.0/19")).To(Succeed())
+		Expect(cfg.ImportSubnet(api.SubnetTopologyPublic, "us-west-2b", "subnet-public-b", "192.168.32.0/19")).To(Succeed())
+		Expect(cfg.ImportSubnet(api.SubnetTopologyPrivate, "us-west-2a", "subnet-private-a", "192.168.64.0/19")).To(Succeed())
+
+		ng = api.NewManagedNodeGroup()
+		ng.Name = "managed-ng"
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{ng}
+
+		sc = NewStackCollection(p, cfg)
+	})
+
+	Describe("makeCreateNodegroupInput", func() {
+		It("should use defaults and all public subnets", func() {
+			input, err := sc.makeCreateNodegroupInput(ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*input.AmiType).To(Equal("AL2_x86_64"))
+			Expect(aws.StringValueSlice(input.InstanceTypes)).To(Equal([]string{"m5.large"}))
+			Expect(aws.StringValueSlice(input.Subnets)).To(Equal([]string{"subnet-public-a", "subnet-public-b"}))
+			Expect(aws.StringValueMap(input.Labels)).To(Equal(map[string]string{
+				"alpha.eksctl.io/cluster-name":   "test-cluster",
+				"alpha.eksctl.io/nodegroup-name": "managed-ng",
+			}))
+			Expect(*input.ScalingConfig).To(Equal(eks.NodegroupScalingConfig{
+				DesiredSize: aws.Int64(2),
+				MinSize:     aws.Int64(2),
+				MaxSize:     aws.Int64(2),
+			}))
+			Expect(input.RemoteAccess).To(BeNil())
+		})
+
+		It("should use subnets of the given AZs and set taints", func() {
+			ng.PrivateNetworking = true
+			ng.AvailabilityZones = []string{"us-west-2a"}
+			ng.InstanceType = "p2.xlarge"
+			ng.Taints = map[string]string{
+				"nvidia.com/gpu": "true:NoSchedule",
+				"dedicated":      "PreferNoSchedule",
+			}
+
+			input, err := sc.makeCreateNodegroupInput(ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(*input.AmiType).To(Equal("AL2_x86_64_GPU"))
+			Expect(aws.StringValueSlice(input.Subnets)).To(Equal([]string{"subnet-private-a"}))
+			Expect(input.Taints).To(Equal([]*eks.Taint{
+				{Key: aws.String("dedicated"), Effect: aws.String("PREFER_NO_SCHEDULE")},
+				{Key: aws.String("nvidia.com/gpu"), Value: aws.String("true"), Effect: aws.String("NO_SCHEDULE")},
+			}))
+		})
+
+		It("should fail when there are no subnets in the AZ", func() {
+			ng.PrivateNetworking = true
+			ng.AvailabilityZones = []string{"us-west-2b"}
+
+			_, err := sc.makeCreateNodegroupInput(ng)
+			Expect(err).To(MatchError("VPC doesn't have private subnets in us-west-2b"))
+		})
+	})
+
+	Describe("makeScalingConfig", func() {
+		It("should fill unset sizes from the current config", func() {
+			ng.MaxSize = aws.Int(5)
+			current := &eks.NodegroupScalingConfig{
+				DesiredSize: aws.Int64(3),
+				MinSize:     aws.Int64(1),
+				MaxSize:     aws.Int64(3),
+			}
+			Expect(*makeScalingConfig(ng, current)).To(Equal(eks.NodegroupScalingConfig{
+				DesiredSize: aws.Int64(3),
+				MinSize:     aws.Int64(1),
+				MaxSize:     aws.Int64(5),
+			}))
+		})
+
+		It("should derive desired capacity from min size for new nodegroups", func() {
+			ng.MinSize = aws.Int(4)
+			ng.MaxSize = aws.Int(6)
+			Expect(*makeScalingConfig(ng, nil)).To(Equal(eks.NodegroupScalingConfig{
+				DesiredSize: aws.Int64(4),
+				MinSize:     aws.Int64(4),
+				MaxSize:     aws.Int64(6),
+			}))
+		})
+	})
+
+	Describe("UpdateManagedNodeGroupConfig", func() {
+		var current *eks.Nodegroup
+
+		BeforeEach(func() {
+			current = &eks.Nodegroup{
+				NodegroupName: aws.String("managed-ng"),
+				Labels: aws.StringMap(map[string]string{
+					"alpha.eksctl.io/cluster-name":   "test-cluster",
+					"alpha.eksctl.io/nodegroup-name": "managed-ng",
+					"role":                           "old",
+				}),
+				Taints: []*eks.Taint{
+					{Key: aws.String("dedicated"), Value: aws.String("old"), Effect: aws.String("NO_SCHEDULE")},
+				},
+				ScalingConfig: &eks.NodegroupScalingConfig{
+					DesiredSize: aws.Int64(2),
+					MinSize:     aws.Int64(1),
+					MaxSize:     aws.Int64(3),
+				},
+			}
+			p.MockEKS().On("DescribeNodegroup", mock.MatchedBy(func(input *eks.DescribeNodegroupInput) bool {
+				return *input.ClusterName == "test-cluster" && *input.NodegroupName == "managed-ng"
+			})).Return(&eks.DescribeNodegroupOutput{Nodegroup: current}, nil)
+		})
+
+		It("should be a no-op when the nodegroup is up-to-date", func() {
+			ng.Labels = map[string]string{"role": "old"}
+			ng.Taints = map[string]string{"dedicated": "old:NoSchedule"}
+
+			updated, err := sc.UpdateManagedNodeGroupConfig(ng)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeFalse())
+			p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything)
+		})
+
+		It("should update labels, taints and scaling config", func() {
+			ng.Labels = map[string]string{"tier": "web"}
+			ng.Taints = map[string]string{"dedicated": "new:NoExecute"}
+			ng.DesiredCapacity = aws.Int(3)
+
+			var input *eks.UpdateNodegroupConfigInput
+			p.MockEKS().On("UpdateNodegroupConfig", mock.MatchedBy(func(i *eks.UpdateNodegroupConfigInput) bool {
+				input = i
+				return true
+			})).Return(&eks.UpdateNodegroupConfigOutput{
+				Update: &eks.Update{Id: aws.String("update-1"), Status: aws.String(eks.UpdateStatusSuccessful)},
+			}, nil)
+
+			updated, err := sc.UpdateManagedNodeGroupConfig(ng)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			Expect(aws.StringValueMap(input.Labels.AddOrUpdateLabels)).To(Equal(map[string]string{"tier": "web"}))
+			Expect(aws.StringValueSlice(input.Labels.RemoveLabels)).To(Equal([]string{"role"}))
+			Expect(input.Taints.AddOrUpdateTaints).To(Equal([]*eks.Taint{
+				{Key: aws.String("dedicated"), Value: aws.String("new"), Effect: aws.String("NO_EXECUTE")},
+			}))
+			Expect(input.Taints.RemoveTaints).To(Equal(current.Taints))
+			Expect(*input.ScalingConfig).To(Equal(eks.NodegroupScalingConfig{
+				DesiredSize: aws.Int64(3),
+				MinSize:     aws.Int64(1),
+				MaxSize:     aws.Int64(3),
+			}))
+		})
+
+		It("should stop waiting for the update once the context is cancelled", func() {
+			ng.DesiredCapacity = aws.Int(3)
+			p.MockEKS().On("UpdateNodegroupConfig", mock.Anything).Return(&eks.UpdateNodegroupConfigOutput{
+				Update: &eks.Update{Id: aws.String("update-1"), Status: aws.String(eks.UpdateStatusInProgress)},
+			}, nil)
+
+			ctx, cancel := context.WithCancel(context.Background())
+			cancel()
+
+			_, err := sc.WithContext(ctx).UpdateManagedNodeGroupConfig(ng)
+			Expect(err).To(MatchError(ContainSubstring(context.Canceled.Error())))
+			p.MockEKS().AssertNotCalled(GinkgoT(), "DescribeUpdate", mock.Anything)
+		})
+	})
+
+	Describe("ScaleManagedNodeGroupToSpec", func() {
+		BeforeEach(func() {
+			p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&eks.DescribeNodegroupOutput{
+				Nodegroup: &eks.Nodegroup{
+					NodegroupName: aws.String("managed-ng"),
+					ScalingConfig: &eks.NodegroupScalingConfig{
+						DesiredSize: aws.Int64(2),
+						MinSize:     aws.Int64(1),
+						MaxSize:     aws.Int64(3),
+					},
+				},
+			}, nil)
+		})
+
+		It("should only preview the new sizes with plan", func() {
+			ng.DesiredCapacity = aws.Int(5)
+
+			Expect(sc.ScaleManagedNodeGroupToSpec(ng, true)).To(Succeed())
+			p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateNodegroupConfig", mock.Anything)
+		})
+
+		It("should scale the nodegroup without plan", func() {
+			ng.DesiredCapacity = aws.Int(5)
+			p.MockEKS().On("UpdateNodegroupConfig", mock.Anything).Return(&eks.UpdateNodegroupConfigOutput{
+				Update: &eks.Update{Id: aws.String("update-1"), Status: aws.String(eks.UpdateStatusSuccessful)},
+			}, nil)
+
+			Expect(sc.ScaleManagedNodeGroupToSpec(ng, false)).To(Succeed())
+			p.MockEKS().AssertCalled(GinkgoT(), "UpdateNodegroupConfig", &eks.UpdateNodegroupConfigInput{
+				ClusterName:   aws.String("test-cluster"),
+				NodegroupName: aws.String("managed-ng"),
+				ScalingConfig: &eks.NodegroupScalingConfig{
+					DesiredSize: aws.Int64(5),
+					MinSize:     aws.Int64(1),
+					MaxSize:     aws.Int64(5),
+				},
+			})
+		})
+	})
+
+	Describe("ListManagedNodeGroups", func() {
+		It("should list the managed nodegroups of the cluster", func() {
+			p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				fn := args.Get(1).(func(*eks.ListNodegroupsOutput, bool) bool)
+				fn(&eks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"managed-ng"})}, true)
+			}).Return(nil)
+			Expect(sc.ListManagedNodeGroups()).To(Equal([]string{"managed-ng"}))
+		})
+
+		It("should list none when the cluster is gone", func() {
+			p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).
+				Return(awserr.New(eks.ErrCodeResourceNotFoundException, "No cluster found for name: test-cluster.", nil))
+			Expect(sc.ListManagedNodeGroups()).To(BeEmpty())
+		})
+	})
+
+	It("should identify stacks of managed nodegroups by tag", func() {
+		stack := &Stack{
+			StackName: aws.String("eksctl-test-cluster-managed-nodegroup-managed-ng"),
+			Tags:      []*cfn.Tag{newTag(api.ManagedNodeGroupNameTag, "managed-ng")},
+		}
+		Expect(sc.GetManagedNodeGroupName(stack)).To(Equal("managed-ng"))
+		Expect(sc.GetNodeGroupName(stack)).To(BeEmpty())
+	})
+})
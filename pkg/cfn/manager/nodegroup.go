@@ -1,20 +1,21 @@
 package manager
 
 import (
-	"bytes"
 	"fmt"
 	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
@@ -36,6 +37,16 @@ type NodeGroupSummary struct {
 	InstanceType    string
 	ImageID         string
 	CreationTime    *time.Time
+	// Status is the status of the stack of a nodegroup, or of a managed nodegroup
+	Status string
+
+	// AutoScalingGroupName is set for managed nodegroups, and for other nodegroups along with
+	// their live state below, which is set by eks.ClusterProvider.SetNodeGroupLiveStatus
+	AutoScalingGroupName string `json:",omitempty"`
+	InServiceInstances   int
+	Nodes                int
+	ReadyNodes           int
+	KubeletVersions      []string `json:",omitempty"`
 }
 
 // makeNodeGroupStackName generates the name of the nodegroup stack identified by its name, isolated by the cluster this StackCollection operates on
@@ -127,8 +138,58 @@ func (c *StackCollection) DescribeNodeGroupStacksAndResources() (map[string]Stac
 	return allResources, nil
 }
 
-// ScaleNodeGroup will scale an existing nodegroup
-func (c *StackCollection) ScaleNodeGroup(ng *api.NodeGroup) error {
+// GetNodeGroupAutoScalingGroupName returns the name of the ASG of the given nodegroup
+func (c *StackCollection) GetNodeGroupAutoScalingGroupName(name string) (string, error) {
+	stackName := c.makeNodeGroupStackName(name)
+	output, err := c.provider.CloudFormation().DescribeStackResource(&cfn.DescribeStackResourceInput{
+		StackName:         &stackName,
+		LogicalResourceId: aws.String("NodeGroup"),
+	})
+	if err != nil {
+		return "", errors.Wrapf(err, "describing ASG of nodegroup %q", name)
+	}
+	return *output.StackResourceDetail.PhysicalResourceId, nil
+}
+
+// TagNodeGroupsForClusterAutoscaler adds the tags by which cluster-autoscaler discovers
+// auto scaling groups to all nodegroups, as these are only set for nodegroups created
+// with the autoscaler IAM policy or after the cluster-autoscaler addon was enabled
+func (c *StackCollection) TagNodeGroupsForClusterAutoscaler() error {
+	stacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return err
+	}
+	for _, s := range stacks {
+		name := c.GetNodeGroupName(s)
+		asgName, err := c.GetNodeGroupAutoScalingGroupName(name)
+		if err != nil {
+			return err
+		}
+		tag := func(key, value string) *autoscaling.Tag {
+			return &autoscaling.Tag{
+				ResourceId:        aws.String(asgName),
+				ResourceType:      aws.String("auto-scaling-group"),
+				Key:               aws.String(key),
+				Value:             aws.String(value),
+				PropagateAtLaunch: aws.Bool(true),
+			}
+		}
+		tags := []*autoscaling.Tag{
+			tag(api.ClusterAutoscalerEnabledTag, "true"),
+			tag(api.ClusterAutoscalerClusterTag(c.spec.Metadata.Name), "owned"),
+		}
+		if _, err := c.provider.ASG().CreateOrUpdateTags(&autoscaling.CreateOrUpdateTagsInput{Tags: tags}); err != nil {
+			return errors.Wrapf(err, "tagging ASG of nodegroup %q", name)
+		}
+		logger.Info("tagged ASG %q of nodegroup %q for cluster-autoscaler", asgName, name)
+	}
+	return nil
+}
+
+// ScaleNodeGroup will scale an existing nodegroup to the desired capacity, min and max size that are set
+// in the spec, min or max size is adjusted when the desired capacity is outside of the range; with plan,
+// the changes are only previewed
+func (c *StackCollection) ScaleNodeGroup(ng *api.NodeGroup, plan bool) error {
 	clusterName := c.makeClusterStackName()
 	c.spec.Status = &api.ClusterStatus{StackName: clusterName}
 	name := c.makeNodeGroupStackName(ng.Name)
@@ -144,48 +205,75 @@ func (c *StackCollection) ScaleNodeGroup(ng *api.NodeGroup) error {
 	//TODO: In the future we might want to use Goformation for strongly typed
 	//manipulation of the template.
 
-	var descriptionBuffer bytes.Buffer
-	descriptionBuffer.WriteString("scaling nodegroup, ")
-
 	// Get the current values
 	currentCapacity := gjson.Get(template, desiredCapacityPath)
 	currentMaxSize := gjson.Get(template, maxSizePath)
 	currentMinSize := gjson.Get(template, minSizePath)
 
-	if ng.DesiredCapacity != nil && int64(*ng.DesiredCapacity) == currentCapacity.Int() {
-		logger.Info("desired capacity of nodegroup %q in cluster %q is already %d", ng.Name, clusterName, *ng.DesiredCapacity)
+	unchanged := func(size *int, current gjson.Result) bool {
+		return size == nil || int64(*size) == current.Int()
+	}
+	if unchanged(ng.DesiredCapacity, currentCapacity) && unchanged(ng.MinSize, currentMinSize) && unchanged(ng.MaxSize, currentMaxSize) {
+		logger.Info("size of nodegroup %q in cluster %q is already as requested (desired capacity %d)", ng.Name, clusterName, currentCapacity.Int())
 		return nil
 	}
 
 	// Set the new values
-	newCapacity := fmt.Sprintf("%d", *ng.DesiredCapacity)
-	template, err = sjson.Set(template, desiredCapacityPath, newCapacity)
-	if err != nil {
-		return errors.Wrap(err, "setting desired capacity")
+	desiredCapacity, minSize, maxSize := currentCapacity.Int(), currentMinSize.Int(), currentMaxSize.Int()
+	if ng.DesiredCapacity != nil {
+		desiredCapacity = int64(*ng.DesiredCapacity)
+	}
+	if ng.MinSize != nil {
+		minSize = int64(*ng.MinSize)
+	}
+	if ng.MaxSize != nil {
+		maxSize = int64(*ng.MaxSize)
+	}
+	// If the desired number of nodes is outside of the range then update the min or the max
+	if desiredCapacity < minSize {
+		minSize = desiredCapacity
+	}
+	if desiredCapacity > maxSize {
+		maxSize = desiredCapacity
 	}
-	descriptionBuffer.WriteString(fmt.Sprintf("desired capacity from %s to %d", currentCapacity.Str, *ng.DesiredCapacity))
 
-	// If the desired number of nodes is less than the min then update the min
-	if int64(*ng.DesiredCapacity) < currentMinSize.Int() {
-		newMinSize := fmt.Sprintf("%d", *ng.DesiredCapacity)
-		template, err = sjson.Set(template, minSizePath, newMinSize)
-		if err != nil {
-			return errors.Wrap(err, "setting min size")
+	var descriptions []string
+	for _, size := range []struct {
+		desc    string
+		path    string
+		current gjson.Result
+		value   int64
+	}{
+		{"desired capacity", desiredCapacityPath, currentCapacity, desiredCapacity},
+		{"min size", minSizePath, currentMinSize, minSize},
+		{"max size", maxSizePath, currentMaxSize, maxSize},
+	} {
+		if size.value == size.current.Int() {
+			continue
 		}
-		descriptionBuffer.WriteString(fmt.Sprintf(", min size from %s to %d", currentMinSize.Str, *ng.DesiredCapacity))
-	}
-	// If the desired number of nodes is greater than the max then update the max
-	if int64(*ng.DesiredCapacity) > currentMaxSize.Int() {
-		newMaxSize := fmt.Sprintf("%d", *ng.DesiredCapacity)
-		template, err = sjson.Set(template, maxSizePath, newMaxSize)
+		template, err = sjson.Set(template, size.path, fmt.Sprintf("%d", size.value))
 		if err != nil {
-			return errors.Wrap(err, "setting max size")
+			return errors.Wrapf(err, "setting %s", size.desc)
 		}
-		descriptionBuffer.WriteString(fmt.Sprintf(", max size from %s to %d", currentMaxSize.Str, *ng.DesiredCapacity))
+		descriptions = append(descriptions, fmt.Sprintf("%s from %d to %d", size.desc, size.current.Int(), size.value))
 	}
 	logger.Debug("stack template (post-scale change): %s", template)
 
-	return c.UpdateStack(name, c.MakeChangeSetName("scale-nodegroup"), descriptionBuffer.String(), []byte(template), nil)
+	description := "scaling nodegroup, " + strings.Join(descriptions, ", ")
+	return c.UpdateStack(name, c.MakeChangeSetName("scale-nodegroup"), description, []byte(template), nil, plan)
+}
+
+// GetNodeGroupSize returns the desired capacity, min and max size of the nodegroup as set in its stack
+func (c *StackCollection) GetNodeGroupSize(name string) (*NodeGroupSize, error) {
+	template, err := c.GetStackTemplate(c.makeNodeGroupStackName(name))
+	if err != nil {
+		return nil, errors.Wrapf(err, "error getting stack template of nodegroup %q", name)
+	}
+	return &NodeGroupSize{
+		DesiredCapacity: int(gjson.Get(template, desiredCapacityPath).Int()),
+		MinSize:         int(gjson.Get(template, minSizePath).Int()),
+		MaxSize:         int(gjson.Get(template, maxSizePath).Int()),
+	}, nil
 }
 
 // GetNodeGroupSummaries returns a list of summaries for the nodegroups of a cluster
@@ -236,11 +324,19 @@ func (c *StackCollection) mapStackToNodeGroupSummary(stack *Stack) (*NodeGroupSu
 		InstanceType:    instanceType.String(),
 		ImageID:         imageID.String(),
 		CreationTime:    stack.CreationTime,
+		Status:          aws.StringValue(stack.StackStatus),
 	}
 
 	return summary, nil
 }
 
+// NodeGroupSize is the scaling config of a nodegroup or of a managed nodegroup
+type NodeGroupSize struct {
+	DesiredCapacity int
+	MinSize         int
+	MaxSize         int
+}
+
 // GetNodeGroupName will return nodegroup name based on tags
 func (*StackCollection) GetNodeGroupName(s *Stack) string {
 	for _, tag := range s.Tags {
@@ -3,6 +3,7 @@ package manager
 import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -78,11 +79,55 @@ var _ = Describe("StackCollection NodeGroup", func() {
 				cap := 2
 				ng.DesiredCapacity = &cap
 
-				err := sc.ScaleNodeGroup(ng)
+				err := sc.ScaleNodeGroup(ng, false)
 
 				Expect(err).NotTo(HaveOccurred())
 			})
 		})
+
+		Context("With sizes from the config", func() {
+			JustBeforeEach(func() {
+				cc = newClusterConfig("test-cluster")
+				ng = newNodeGroup(cc)
+				ng.Name = "12345"
+				sc = NewStackCollection(p, cc)
+
+				p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+					TemplateBody: aws.String(`{
+						"Resources": {
+							"NodeGroup": {
+								"Properties": {
+									"DesiredCapacity": "2",
+									"MinSize": "1",
+									"MaxSize": "3"
+								}
+							}
+						}
+					}`),
+				}, nil)
+			})
+
+			It("should read the size of the nodegroup from its stack", func() {
+				size, err := sc.GetNodeGroupSize(ng.Name)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(*size).To(Equal(NodeGroupSize{DesiredCapacity: 2, MinSize: 1, MaxSize: 3}))
+			})
+
+			It("should be a no-op if the sizes in the spec are the existing ones", func() {
+				minSize, maxSize := 1, 3
+				ng.MinSize, ng.MaxSize = &minSize, &maxSize
+
+				err := sc.ScaleNodeGroup(ng, false)
+
+				Expect(err).NotTo(HaveOccurred())
+				Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "CreateChangeSet", 0)).To(BeTrue())
+			})
+
+			It("should scale all nodegroups in parallel", func() {
+				tasks := sc.NewTasksToScaleNodeGroups([]*api.NodeGroup{ng}, []*api.ManagedNodeGroup{{Name: "mng-1"}}, false)
+				Expect(tasks.Describe()).To(Equal(`2 parallel tasks: { scale nodegroup "12345", scale managed nodegroup "mng-1" }`))
+			})
+		})
 	})
 
 	Describe("GetNodeGroupSummaries", func() {
@@ -110,39 +155,29 @@ var _ = Describe("StackCollection NodeGroup", func() {
 
 				p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(nil, fmt.Errorf("GetTemplate failed"))
 
-				p.MockCloudFormation().On("ListStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
-					consume := args[1].(func(p *cfn.ListStacksOutput, last bool) (shouldContinue bool))
-					out := &cfn.ListStacksOutput{
-						StackSummaries: []*cfn.StackSummary{
+				p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+					out := &cfn.DescribeStacksOutput{
+						Stacks: []*cfn.Stack{
 							{
-								StackName: aws.String("eksctl-test-cluster-nodegroup-12345"),
+								StackName:   aws.String("eksctl-test-cluster-nodegroup-12345"),
+								StackId:     aws.String("eksctl-test-cluster-nodegroup-12345-id"),
+								StackStatus: aws.String("CREATE_COMPLETE"),
+								Tags: []*cfn.Tag{
+									&cfn.Tag{
+										Key:   aws.String(api.NodeGroupNameTag),
+										Value: aws.String("12345"),
+									},
+								},
 							},
 						},
 					}
 					cont := consume(out, true)
 					if !cont {
-						panic("unexpected return value from the paging function: shouldContinue was false. It becomes false only when subsequent DescribeStacks call(s) fail, which isn't expected in this test scenario")
+						panic("unexpected return value from the paging function: shouldContinue was false, which isn't expected in this test scenario")
 					}
 				}).Return(nil)
 
-				p.MockCloudFormation().On("DescribeStacks", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
-					return input.StackName != nil && *input.StackName == "eksctl-test-cluster-nodegroup-12345"
-				})).Return(&cfn.DescribeStacksOutput{
-					Stacks: []*cfn.Stack{
-						{
-							StackName:   aws.String("eksctl-test-cluster-nodegroup-12345"),
-							StackId:     aws.String("eksctl-test-cluster-nodegroup-12345-id"),
-							StackStatus: aws.String("CREATE_COMPLETE"),
-							Tags: []*cfn.Tag{
-								&cfn.Tag{
-									Key:   aws.String(api.NodeGroupNameTag),
-									Value: aws.String("12345"),
-								},
-							},
-						},
-					},
-				}, nil)
-
 				p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(nil, fmt.Errorf("DescribeStacks failed"))
 			})
 
@@ -186,8 +221,9 @@ var _ = Describe("StackCollection NodeGroup", func() {
 					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "GetTemplate", 1)).To(BeTrue())
 				})
 
-				It("should have called AWS CloudFormation DescribeStacks once", func() {
-					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 1)).To(BeTrue())
+				It("should have described all stacks in a single pass", func() {
+					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacksPages", 1)).To(BeTrue())
+					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacks", 0)).To(BeTrue())
 				})
 
 				It("the output should equal the expectation", func() {
@@ -197,4 +233,54 @@ var _ = Describe("StackCollection NodeGroup", func() {
 			})
 		})
 	})
+
+	Describe("TagNodeGroupsForClusterAutoscaler", func() {
+		var created []*autoscaling.Tag
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			cc = newClusterConfig("test-cluster")
+			sc = NewStackCollection(p, cc)
+			created = nil
+
+			p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+				consume(&cfn.DescribeStacksOutput{
+					Stacks: []*cfn.Stack{
+						{
+							StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+							StackStatus: aws.String("CREATE_COMPLETE"),
+							Tags: []*cfn.Tag{
+								{
+									Key:   aws.String(api.NodeGroupNameTag),
+									Value: aws.String("ng-1"),
+								},
+							},
+						},
+					},
+				}, true)
+			}).Return(nil)
+
+			p.MockCloudFormation().On("DescribeStackResource", mock.MatchedBy(func(input *cfn.DescribeStackResourceInput) bool {
+				return *input.StackName == "eksctl-test-cluster-nodegroup-ng-1" && *input.LogicalResourceId == "NodeGroup"
+			})).Return(&cfn.DescribeStackResourceOutput{
+				StackResourceDetail: &cfn.StackResourceDetail{
+					PhysicalResourceId: aws.String("asg-ng-1"),
+				},
+			}, nil)
+
+			p.MockASG().On("CreateOrUpdateTags", mock.Anything).Run(func(args mock.Arguments) {
+				created = append(created, args[0].(*autoscaling.CreateOrUpdateTagsInput).Tags...)
+			}).Return(&autoscaling.CreateOrUpdateTagsOutput{}, nil)
+		})
+
+		It("adds auto-discovery tags to the ASG of each nodegroup", func() {
+			Expect(sc.TagNodeGroupsForClusterAutoscaler()).To(Succeed())
+
+			Expect(created).To(HaveLen(2))
+			Expect(*created[0].ResourceId).To(Equal("asg-ng-1"))
+			Expect(*created[0].Key).To(Equal("k8s.io/cluster-autoscaler/enabled"))
+			Expect(*created[1].Key).To(Equal("k8s.io/cluster-autoscaler/test-cluster"))
+		})
+	})
 })
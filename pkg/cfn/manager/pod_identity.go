@@ -0,0 +1,103 @@
+package manager
+
+import (
+	"fmt"
+
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// makePodIdentityRoleStackName generates the name of the stack of the IAM role of a pod identity association,
+// isolated by the cluster this StackCollection operates on and 'addon' suffix
+func (c *StackCollection) makePodIdentityRoleStackName(namespace, serviceAccountName string) string {
+	return fmt.Sprintf("eksctl-%s-addon-podidentityrole-%s-%s", c.spec.Metadata.Name, namespace, serviceAccountName)
+}
+
+// CreatePodIdentityRole creates the IAM role of the pod identity association and waits for it,
+// setting the role ARN of the association
+func (c *StackCollection) CreatePodIdentityRole(spec *api.PodIdentityAssociation) error {
+	name := c.makePodIdentityRoleStackName(spec.Namespace, spec.ServiceAccountName)
+	logger.Info("building IAM role stack %q", name)
+	stack := builder.NewPodIdentityRoleResourceSet(spec)
+	if err := stack.AddAllResources(); err != nil {
+		return err
+	}
+
+	tags := map[string]string{api.PodIdentityAssociationNameTag: spec.NameString()}
+
+	errs := make(chan error)
+	if err := c.CreateStack(name, stack, tags, nil, errs); err != nil {
+		return err
+	}
+	return <-errs
+}
+
+// DescribePodIdentityRoleStacks calls DescribeStacks and filters out the stacks of IAM roles of pod identity associations
+func (c *StackCollection) DescribePodIdentityRoleStacks() ([]*Stack, error) {
+	stacks, err := c.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	roleStacks := []*Stack{}
+	for _, s := range stacks {
+		if *s.StackStatus == cfn.StackStatusDeleteComplete {
+			continue
+		}
+		if c.GetPodIdentityAssociationName(s) != "" {
+			roleStacks = append(roleStacks, s)
+		}
+	}
+	return roleStacks, nil
+}
+
+// DeletePodIdentityRole deletes the stack of the IAM role of the pod identity association, if eksctl created one
+func (c *StackCollection) DeletePodIdentityRole(namespace, serviceAccountName string) error {
+	stacks, err := c.DescribePodIdentityRoleStacks()
+	if err != nil {
+		return err
+	}
+	for _, s := range stacks {
+		if c.GetPodIdentityAssociationName(s) == namespace+"/"+serviceAccountName {
+			errs := make(chan error)
+			if err := c.DeleteStackBySpecSync(s, errs); err != nil {
+				return err
+			}
+			return <-errs
+		}
+	}
+	return nil
+}
+
+// GetPodIdentityAssociationName will return the <namespace>/<serviceAccountName> of the pod identity
+// association based on tags
+func (*StackCollection) GetPodIdentityAssociationName(s *Stack) string {
+	for _, tag := range s.Tags {
+		if *tag.Key == api.PodIdentityAssociationNameTag {
+			return *tag.Value
+		}
+	}
+	return ""
+}
+
+// NewTasksToDeletePodIdentityRoles defines tasks required to delete the IAM roles of all pod identity associations,
+// the associations themselves are deleted along with the cluster
+func (c *StackCollection) NewTasksToDeletePodIdentityRoles() (*TaskTree, error) {
+	stacks, err := c.DescribePodIdentityRoleStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	tasks := &TaskTree{Parallel: true}
+	for _, s := range stacks {
+		tasks.Append(&taskWithStackSpec{
+			info:  fmt.Sprintf("delete IAM role for pod identity association %q", c.GetPodIdentityAssociationName(s)),
+			stack: s,
+			call:  c.DeleteStackBySpecSync,
+		})
+	}
+	return tasks, nil
+}
@@ -0,0 +1,236 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	// stackPollInterval is the interval between polls of the stacks that are waited for
+	stackPollInterval = 15 * time.Second
+	// stackPollListThreshold is the number of stacks that are waited for from which all stacks of the region are
+	// listed, rather than described one by one, as a paginated listing costs more calls in accounts with many stacks
+	stackPollListThreshold = 10
+)
+
+// StackPoller batches the polls of stacks that are waited for concurrently, e.g. the stacks
+// of IAM service accounts that are created in parallel: a stack that several tasks wait for
+// is polled once per interval, and many stacks are polled with a single paginated DescribeStacks
+// call rather than a call per stack; with a nil poller, each wait polls its stack on its own
+type StackPoller struct {
+	provider      api.ClusterProvider
+	interval      time.Duration
+	listThreshold int
+
+	mutex   sync.Mutex
+	waiting map[*stackWaiter]struct{}
+	// stop is closed once no stacks are waited for anymore
+	stop chan struct{}
+}
+
+type stackWaiter struct {
+	stack   *Stack
+	updates chan stackPoll
+}
+
+// stackPoll is the outcome of polling a stack, err is a ValidationError when it doesn't exist,
+// as it is when describing a stack on its own
+type stackPoll struct {
+	stack *Stack
+	err   error
+}
+
+// NewStackPoller returns a poller that describes stacks with the CloudFormation API of the provider
+func NewStackPoller(provider api.ClusterProvider) *StackPoller {
+	return &StackPoller{
+		provider:      provider,
+		interval:      stackPollInterval,
+		listThreshold: stackPollListThreshold,
+		waiting:       map[*stackWaiter]struct{}{},
+	}
+}
+
+func (p *StackPoller) add(stack *Stack) *stackWaiter {
+	w := &stackWaiter{stack: stack, updates: make(chan stackPoll, 1)}
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	p.waiting[w] = struct{}{}
+	if p.stop == nil {
+		p.stop = make(chan struct{})
+		go p.run(p.stop)
+	}
+	return w
+}
+
+func (p *StackPoller) remove(w *stackWaiter) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+	delete(p.waiting, w)
+	if len(p.waiting) == 0 && p.stop != nil {
+		close(p.stop)
+		p.stop = nil
+	}
+}
+
+// run polls the stacks every interval until stop is closed, when no stack is waited for anymore
+func (p *StackPoller) run(stop chan struct{}) {
+	ticker := time.NewTicker(p.interval)
+	defer ticker.Stop()
+	for {
+		p.poll()
+		select {
+		case <-stop:
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+func (p *StackPoller) poll() {
+	p.mutex.Lock()
+	waiting := make([]*stackWaiter, 0, len(p.waiting))
+	for w := range p.waiting {
+		waiting = append(waiting, w)
+	}
+	p.mutex.Unlock()
+	if len(waiting) == 0 {
+		return
+	}
+
+	keys := map[string]bool{}
+	for _, w := range waiting {
+		keys[stackKey(w.stack)] = true
+	}
+	logger.Debug("polling the status of %d CloudFormation stack(s)", len(keys))
+
+	byID, byName := map[string]*Stack{}, map[string]*Stack{}
+	var err error
+	if len(keys) >= p.listThreshold {
+		err = p.provider.CloudFormation().DescribeStacksPages(&cfn.DescribeStacksInput{}, func(out *cfn.DescribeStacksOutput, _ bool) bool {
+			for _, s := range out.Stacks {
+				byID[aws.StringValue(s.StackId)] = s
+				byName[aws.StringValue(s.StackName)] = s
+			}
+			return true
+		})
+	}
+
+	// few stacks, and deleted stacks, which aren't listed, are described on their own, once per stack
+	described := map[string]stackPoll{}
+	for _, w := range waiting {
+		key := stackKey(w.stack)
+		found, ok := byID[key]
+		if !ok {
+			found, ok = byName[key]
+		}
+
+		var result stackPoll
+		switch {
+		case err != nil:
+			result = stackPoll{err: err}
+		case ok:
+			result = stackPoll{stack: found}
+		default:
+			if result, ok = described[key]; !ok {
+				result = p.describe(key)
+				described[key] = result
+			}
+		}
+
+		select {
+		case w.updates <- result:
+		default:
+			// the waiter hasn't consumed the previous poll yet
+		}
+	}
+}
+
+// stackKey returns the ID of the stack, or its name when the ID isn't known
+func stackKey(s *Stack) string {
+	if id := aws.StringValue(s.StackId); id != "" {
+		return id
+	}
+	return aws.StringValue(s.StackName)
+}
+
+func (p *StackPoller) describe(nameOrID string) stackPoll {
+	out, err := p.provider.CloudFormation().DescribeStacks(&cfn.DescribeStacksInput{StackName: &nameOrID})
+	if err != nil {
+		return stackPoll{err: err}
+	}
+	if len(out.Stacks) == 0 {
+		return stackPoll{err: awserr.New("ValidationError", fmt.Sprintf("Stack with id %s does not exist", nameOrID), nil)}
+	}
+	return stackPoll{stack: out.Stacks[0]}
+}
+
+// wait is the equivalent of waiters.Wait for the stack, it understands the acceptors that
+// match the status of the stack or the code of the error of describing it
+func (p *StackPoller) wait(ctx context.Context, i *Stack, msg string, acceptors []request.WaiterAcceptor, waitTimeout time.Duration, troubleshoot func(string)) error {
+	desiredStatus := fmt.Sprintf("%v", acceptors[0].Expected)
+	msg = fmt.Sprintf("%s to reach %q status", msg, desiredStatus)
+
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
+	defer cancel()
+	startTime := time.Now()
+	w := p.add(i)
+	defer p.remove(w)
+	logger.Debug("start %s", msg)
+
+	for {
+		var waitErr error
+		select {
+		case <-ctx.Done():
+			waitErr = awserr.New(request.CanceledErrorCode, "waiter context canceled", ctx.Err())
+			if ctx.Err() == context.Canceled {
+				return errors.Wrap(waitErr, msg)
+			}
+		case result := <-w.updates:
+			logger.Debug(msg)
+			state, matched := matchStackAcceptors(acceptors, result)
+			switch {
+			case matched && state == request.SuccessWaiterState:
+				logger.Debug("done after %s of %s", time.Since(startTime), msg)
+				return nil
+			case matched && state == request.FailureWaiterState:
+				waitErr = awserr.New(request.WaiterResourceNotReadyErrorCode, "failed waiting for successful resource state", nil)
+			case result.err != nil:
+				waitErr = result.err
+			default:
+				continue
+			}
+		}
+		if troubleshoot != nil {
+			troubleshoot(desiredStatus)
+		}
+		return errors.Wrap(waitErr, msg)
+	}
+}
+
+// matchStackAcceptors returns the state of the first acceptor that matches the poll
+func matchStackAcceptors(acceptors []request.WaiterAcceptor, result stackPoll) (request.WaiterState, bool) {
+	for _, a := range acceptors {
+		switch a.Matcher {
+		case request.PathAllWaiterMatch, request.PathAnyWaiterMatch:
+			if result.stack != nil && a.Argument == stackStatus && aws.StringValue(result.stack.StackStatus) == a.Expected {
+				return a.State, true
+			}
+		case request.ErrorWaiterMatch:
+			if awsErr, ok := result.err.(awserr.Error); ok && awsErr.Code() == a.Expected {
+				return a.State, true
+			}
+		}
+	}
+	return request.RetryWaiterState, false
+}
@@ -0,0 +1,137 @@
+package manager
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackPoller", func() {
+	var (
+		p      *mockprovider.MockProvider
+		poller *StackPoller
+		sc     *StackCollection
+		polls  int
+		stacks []*Stack
+	)
+
+	newStack := func(name string) *Stack {
+		return &Stack{
+			StackName: aws.String(name),
+			StackId:   aws.String("arn:aws:cloudformation:us-west-2:123456789012:stack/" + name + "/1"),
+		}
+	}
+
+	withStatus := func(s *Stack, status string) *Stack {
+		return &Stack{StackName: s.StackName, StackId: s.StackId, StackStatus: aws.String(status)}
+	}
+
+	waitInParallel := func(wait func(*Stack) error) []error {
+		errs := make(chan error)
+		for _, s := range stacks {
+			go func(s *Stack) { errs <- wait(s) }(s)
+		}
+		var all []error
+		for range stacks {
+			all = append(all, <-errs)
+		}
+		return all
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		poller = NewStackPoller(p)
+		poller.interval = 10 * time.Millisecond
+		sc = NewStackCollection(p, cfg).WithPoller(poller)
+
+		polls = 0
+		stacks = []*Stack{
+			newStack("eksctl-test-cluster-addon-iamserviceaccount-default-sa-1"),
+			newStack("eksctl-test-cluster-addon-iamserviceaccount-default-sa-2"),
+			newStack("eksctl-test-cluster-addon-iamserviceaccount-default-sa-3"),
+		}
+	})
+
+	It("describes each stack that is waited for on its own when there are few of them", func() {
+		for _, s := range stacks {
+			p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: s.StackId}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{withStatus(s, cfn.StackStatusCreateComplete)},
+			}, nil)
+		}
+
+		Expect(waitInParallel(sc.DoWaitUntilStackIsCreated)).To(ConsistOf(BeNil(), BeNil(), BeNil()))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacksPages", mock.Anything, mock.Anything)
+	})
+
+	It("stops polling once no stack is waited for", func() {
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{withStatus(stacks[0], cfn.StackStatusCreateComplete)},
+		}, nil)
+
+		Expect(sc.DoWaitUntilStackIsCreated(stacks[0])).To(Succeed())
+		// let a poll that was already due finish
+		time.Sleep(2 * poller.interval)
+		calls := len(p.MockCloudFormation().Calls)
+		Consistently(func() int { return len(p.MockCloudFormation().Calls) }, 10*poller.interval).Should(Equal(calls))
+	})
+
+	It("polls many stacks that are waited for in a single call per interval", func() {
+		poller.listThreshold = 2
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			polls++
+			status := cfn.StackStatusCreateInProgress
+			if polls > 2 {
+				status = cfn.StackStatusCreateComplete
+			}
+			out := &cfn.DescribeStacksOutput{}
+			for _, s := range stacks {
+				out.Stacks = append(out.Stacks, withStatus(s, status))
+			}
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(out, true)
+		}).Return(nil)
+
+		Expect(waitInParallel(sc.DoWaitUntilStackIsCreated)).To(ConsistOf(BeNil(), BeNil(), BeNil()))
+		Expect(polls).To(BeNumerically("<", 3*len(stacks)))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "DescribeStacksRequest", mock.Anything)
+	})
+
+	It("describes stacks that are no longer listed on their own once deleted", func() {
+		poller.listThreshold = 2
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Return(nil)
+		for _, s := range stacks {
+			p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: s.StackId}).Return(&cfn.DescribeStacksOutput{
+				Stacks: []*cfn.Stack{withStatus(s, cfn.StackStatusDeleteComplete)},
+			}, nil)
+		}
+
+		Expect(waitInParallel(sc.doWaitUntilStackIsDeleted)).To(ConsistOf(BeNil(), BeNil(), BeNil()))
+	})
+
+	It("fails the waits of stacks that reach an unexpected status", func() {
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			out := &cfn.DescribeStacksOutput{}
+			for _, s := range stacks {
+				out.Stacks = append(out.Stacks, withStatus(s, cfn.StackStatusRollbackComplete))
+			}
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(out, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("DescribeStacks", mock.Anything).Return(&cfn.DescribeStacksOutput{
+			Stacks: []*cfn.Stack{withStatus(stacks[0], cfn.StackStatusRollbackComplete)},
+		}, nil)
+		p.MockCloudFormation().On("DescribeStackEventsPages", mock.Anything, mock.Anything).Return(nil)
+
+		err := sc.DoWaitUntilStackIsCreated(stacks[0])
+		Expect(err).To(MatchError(ContainSubstring("failed waiting for successful resource state")))
+	})
+})
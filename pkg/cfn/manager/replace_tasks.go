@@ -0,0 +1,100 @@
+package manager
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// NodeGroupReplacementSteps holds the Kubernetes side of replacing a nodegroup
+type NodeGroupReplacementSteps struct {
+	// WaitForNodes authorises nodes of the new nodegroup to join and waits for them to become ready
+	WaitForNodes func(kubernetes.Interface, *api.NodeGroup) error
+	// Drain cordons nodes of the old nodegroup and evicts their pods
+	Drain func(kubernetes.Interface, *api.NodeGroup) error
+	// Cleanup is called for the old nodegroup after it was drained and before its stack gets deleted
+	Cleanup func(kubernetes.Interface, *api.NodeGroup) error
+}
+
+// NewTasksToReplaceNodeGroup defines tasks required to replace oldNodeGroup with newNodeGroup;
+// the tasks are based on the current state of both stacks, so that a replacement that has failed
+// part way can be resumed by calling this again with the same nodegroups
+func (c *StackCollection) NewTasksToReplaceNodeGroup(oldNodeGroup, newNodeGroup *api.NodeGroup, clientSetGetter kubernetes.ClientSetGetter, steps NodeGroupReplacementSteps) (*TaskTree, error) {
+	nodeGroupStacks, err := c.DescribeNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+
+	var oldStack, newStack *Stack
+	for _, s := range nodeGroupStacks {
+		switch c.GetNodeGroupName(s) {
+		case oldNodeGroup.Name:
+			oldStack = s
+		case newNodeGroup.Name:
+			newStack = s
+		}
+	}
+
+	if oldStack == nil && newStack == nil {
+		return nil, fmt.Errorf("stack not found for nodegroup %q", oldNodeGroup.Name)
+	}
+
+	tasks := &TaskTree{Parallel: false}
+
+	if newStack == nil {
+		tasks.Append(&taskWithNodeGroupSpec{
+			info:      fmt.Sprintf("create nodegroup %q", newNodeGroup.NameString()),
+			nodeGroup: newNodeGroup,
+			stack:     c.makeNodeGroupStackName(newNodeGroup.Name),
+			call:      c.createNodeGroupTask,
+		})
+	} else {
+		if !c.StackStatusIsNotTransitional(newStack) || *newStack.StackStatus == cloudformation.StackStatusRollbackComplete {
+			return nil, fmt.Errorf("stack of nodegroup %q is in %q state, it has to be deleted before the replacement can be retried", newNodeGroup.Name, *newStack.StackStatus)
+		}
+		logger.Info("nodegroup %q already exists, will not create it again", newNodeGroup.Name)
+	}
+
+	tasks.Append(&kubernetesTask{
+		info:       fmt.Sprintf("wait for nodes of nodegroup %q to become ready", newNodeGroup.NameString()),
+		kubernetes: clientSetGetter,
+		call: func(clientSet kubernetes.Interface) error {
+			return steps.WaitForNodes(clientSet, newNodeGroup)
+		},
+	})
+
+	if oldStack == nil {
+		logger.Info("nodegroup %q has already been deleted", oldNodeGroup.Name)
+		return tasks, nil
+	}
+
+	tasks.Append(&kubernetesTask{
+		info:       fmt.Sprintf("drain nodegroup %q", oldNodeGroup.NameString()),
+		kubernetes: clientSetGetter,
+		call: func(clientSet kubernetes.Interface) error {
+			return steps.Drain(clientSet, oldNodeGroup)
+		},
+	})
+
+	if steps.Cleanup != nil {
+		tasks.Append(&kubernetesTask{
+			info:       fmt.Sprintf("cleanup for nodegroup %q", oldNodeGroup.NameString()),
+			kubernetes: clientSetGetter,
+			call: func(clientSet kubernetes.Interface) error {
+				return steps.Cleanup(clientSet, oldNodeGroup)
+			},
+		})
+	}
+
+	tasks.Append(&taskWithStackSpec{
+		info:  fmt.Sprintf("delete nodegroup %q", oldNodeGroup.NameString()),
+		stack: oldStack,
+		call:  c.DeleteStackBySpecSync,
+	})
+
+	return tasks, nil
+}
@@ -0,0 +1,39 @@
+package manager
+
+import (
+	"fmt"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// NewTasksToScaleNodeGroups defines tasks that scale the nodegroups and managed nodegroups in parallel
+// to the desired capacity, min and max size set in their specs; with plan, the changes are only previewed
+func (c *StackCollection) NewTasksToScaleNodeGroups(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup, plan bool) *TaskTree {
+	tasks := &TaskTree{Parallel: true}
+
+	for _, ng := range nodeGroups {
+		tasks.Append(&taskWithNodeGroupSpec{
+			info:      fmt.Sprintf("scale nodegroup %q", ng.NameString()),
+			nodeGroup: ng,
+			stack:     c.makeNodeGroupStackName(ng.Name),
+			call: func(errs chan error, ng *api.NodeGroup) error {
+				defer close(errs)
+				return c.ScaleNodeGroup(ng, plan)
+			},
+		})
+	}
+
+	for _, ng := range managedNodeGroups {
+		tasks.Append(&taskWithManagedNodeGroupSpec{
+			info:      fmt.Sprintf("scale managed nodegroup %q", ng.NameString()),
+			nodeGroup: ng,
+			stack:     c.makeManagedNodeGroupStackName(ng.Name),
+			call: func(errs chan error, ng *api.ManagedNodeGroup) error {
+				defer close(errs)
+				return c.ScaleManagedNodeGroupToSpec(ng, plan)
+			},
+		})
+	}
+
+	return tasks
+}
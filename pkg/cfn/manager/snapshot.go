@@ -0,0 +1,142 @@
+package manager
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// StackSnapshot holds what is needed to create a stack again
+type StackSnapshot struct {
+	Name                  string            `json:"name"`
+	Template              string            `json:"template"`
+	Parameters            map[string]string `json:"parameters,omitempty"`
+	Tags                  map[string]string `json:"tags,omitempty"`
+	Capabilities          []string          `json:"capabilities,omitempty"`
+	TerminationProtection bool              `json:"terminationProtection,omitempty"`
+}
+
+// SnapshotStacks returns snapshots of all stacks of the cluster, there are none
+// when the cluster has no stacks yet
+func (c *StackCollection) SnapshotStacks() ([]*StackSnapshot, error) {
+	stacks, err := c.ListStacks(fmtStacksRegexForCluster(c.spec.Metadata.Name))
+	if err != nil {
+		return nil, err
+	}
+
+	snapshots := []*StackSnapshot{}
+	for _, s := range stacks {
+		template, err := c.GetStackTemplate(*s.StackName)
+		if err != nil {
+			return nil, errors.Wrapf(err, "getting template of stack %q", *s.StackName)
+		}
+		snapshot := &StackSnapshot{
+			Name:                  *s.StackName,
+			Template:              template,
+			Parameters:            map[string]string{},
+			Tags:                  map[string]string{},
+			Capabilities:          aws.StringValueSlice(s.Capabilities),
+			TerminationProtection: aws.BoolValue(s.EnableTerminationProtection),
+		}
+		for _, p := range s.Parameters {
+			snapshot.Parameters[*p.ParameterKey] = aws.StringValue(p.ParameterValue)
+		}
+		for _, t := range s.Tags {
+			snapshot.Tags[*t.Key] = aws.StringValue(t.Value)
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return snapshots, nil
+}
+
+// NewTasksToRestoreStacks defines tasks to create the stacks of the snapshots that don't
+// exist anymore; the cluster stack is created first, as the other stacks import its outputs
+func (c *StackCollection) NewTasksToRestoreStacks(snapshots []*StackSnapshot, plan bool) (*TaskTree, error) {
+	stacks, err := c.ListStacks(fmtStacksRegexForCluster(c.spec.Metadata.Name))
+	if err != nil {
+		return nil, err
+	}
+	existing := map[string]bool{}
+	for _, s := range stacks {
+		existing[*s.StackName] = true
+	}
+
+	tasks := &TaskTree{Parallel: false, PlanMode: plan}
+	otherTasks := &TaskTree{Parallel: true, IsSubTask: true}
+	for _, snapshot := range snapshots {
+		if existing[snapshot.Name] {
+			logger.Info("stack %q exists already, it will not be restored", snapshot.Name)
+			continue
+		}
+		s := snapshot
+		task := &taskWithoutParams{
+			info: fmt.Sprintf("restore stack %q", s.Name),
+			call: func(errs chan error) error {
+				return c.restoreStack(s, errs)
+			},
+		}
+		if s.Name == c.makeClusterStackName() {
+			tasks.Append(task)
+		} else {
+			otherTasks.Append(task)
+		}
+	}
+	if otherTasks.Len() > 0 {
+		tasks.Append(otherTasks)
+	}
+	return tasks, nil
+}
+
+func (c *StackCollection) restoreStack(snapshot *StackSnapshot, errs chan error) error {
+	i, err := c.doRestoreStackRequest(snapshot)
+	if err != nil {
+		return err
+	}
+
+	logger.Info("deploying stack %q", snapshot.Name)
+
+	go func() {
+		defer close(errs)
+		errs <- c.DoWaitUntilStackIsCreated(i)
+	}()
+	return nil
+}
+
+func (c *StackCollection) doRestoreStackRequest(snapshot *StackSnapshot) (*Stack, error) {
+	// the shared tags are added to every stack, and AWS reserves tags prefixed with "aws:"
+	sharedTags := map[string]bool{}
+	for _, t := range c.sharedTags {
+		sharedTags[*t.Key] = true
+	}
+	tags := map[string]string{}
+	for k, v := range snapshot.Tags {
+		if sharedTags[k] || strings.HasPrefix(k, "aws:") {
+			continue
+		}
+		tags[k] = v
+	}
+
+	var withIAM, withNamedIAM bool
+	for _, capability := range snapshot.Capabilities {
+		switch capability {
+		case cloudformation.CapabilityCapabilityIam:
+			withIAM = true
+		case cloudformation.CapabilityCapabilityNamedIam:
+			withNamedIAM = true
+		}
+	}
+
+	i := &Stack{
+		StackName:                   aws.String(snapshot.Name),
+		EnableTerminationProtection: aws.Bool(snapshot.TerminationProtection),
+	}
+	if err := c.DoCreateStackRequest(i, []byte(snapshot.Template), tags, snapshot.Parameters, withIAM, withNamedIAM); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
@@ -0,0 +1,107 @@
+package manager
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("StackCollection snapshots", func() {
+	var (
+		p  *mockprovider.MockProvider
+		sc *StackCollection
+	)
+
+	mockStacks := func(stacks ...*cfn.Stack) {
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: stacks}, true)
+		}).Return(nil)
+		for _, s := range stacks {
+			p.MockCloudFormation().On("DescribeStacks", &cfn.DescribeStacksInput{StackName: s.StackName}).
+				Return(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{s}}, nil)
+		}
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		sc = NewStackCollection(p, cfg)
+	})
+
+	It("takes snapshots of the templates, parameters, tags and options of the stacks", func() {
+		mockStacks(&cfn.Stack{
+			StackName:                   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			StackStatus:                 aws.String(cfn.StackStatusCreateComplete),
+			Capabilities:                aws.StringSlice([]string{cfn.CapabilityCapabilityIam}),
+			EnableTerminationProtection: aws.Bool(true),
+			Parameters:                  []*cfn.Parameter{{ParameterKey: aws.String("Key"), ParameterValue: aws.String("value")}},
+			Tags:                        []*cfn.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String("ng-1")}},
+		})
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(`{"Resources": {}}`)}, nil)
+
+		snapshots, err := sc.SnapshotStacks()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(snapshots).To(Equal([]*StackSnapshot{{
+			Name:                  "eksctl-test-cluster-nodegroup-ng-1",
+			Template:              `{"Resources": {}}`,
+			Parameters:            map[string]string{"Key": "value"},
+			Tags:                  map[string]string{api.NodeGroupNameTag: "ng-1"},
+			Capabilities:          []string{cfn.CapabilityCapabilityIam},
+			TerminationProtection: true,
+		}}))
+	})
+
+	It("restores the cluster stack before the other stacks that don't exist", func() {
+		mockStacks(&cfn.Stack{
+			StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			StackStatus: aws.String(cfn.StackStatusCreateComplete),
+		})
+
+		tasks, err := sc.NewTasksToRestoreStacks([]*StackSnapshot{
+			{Name: "eksctl-test-cluster-nodegroup-ng-1"},
+			{Name: "eksctl-test-cluster-nodegroup-ng-2"},
+			{Name: "eksctl-test-cluster-cluster"},
+		}, true)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`(plan) 2 sequential tasks: { restore stack "eksctl-test-cluster-cluster", restore stack "eksctl-test-cluster-nodegroup-ng-2" }`))
+	})
+
+	It("creates stacks without the tags that are added to every stack", func() {
+		var input *cfn.CreateStackInput
+		p.MockCloudFormation().On("CreateStack", mock.Anything).Run(func(args mock.Arguments) {
+			input = args[0].(*cfn.CreateStackInput)
+		}).Return(&cfn.CreateStackOutput{StackId: aws.String("id")}, nil)
+
+		stack, err := sc.doRestoreStackRequest(&StackSnapshot{
+			Name:                  "eksctl-test-cluster-cluster",
+			Template:              `{"Resources": {}}`,
+			Parameters:            map[string]string{"Key": "value"},
+			Tags:                  map[string]string{api.ClusterNameTag: "test-cluster", "aws:cloudformation:stack-name": "eksctl-test-cluster-cluster", "team": "a"},
+			Capabilities:          []string{cfn.CapabilityCapabilityIam},
+			TerminationProtection: true,
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*stack.StackId).To(Equal("id"))
+
+		Expect(*input.TemplateBody).To(Equal(`{"Resources": {}}`))
+		Expect(*input.EnableTerminationProtection).To(BeTrue())
+		Expect(aws.StringValueSlice(input.Capabilities)).To(Equal([]string{cfn.CapabilityCapabilityIam}))
+		Expect(input.Parameters).To(HaveLen(1))
+		tags := map[string]string{}
+		for _, t := range input.Tags {
+			Expect(tags).ToNot(HaveKey(*t.Key))
+			tags[*t.Key] = *t.Value
+		}
+		Expect(tags).To(HaveKeyWithValue(api.ClusterNameTag, "test-cluster"))
+		Expect(tags).To(HaveKeyWithValue("team", "a"))
+		Expect(tags).ToNot(HaveKey("aws:cloudformation:stack-name"))
+	})
+})
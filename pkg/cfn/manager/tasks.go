@@ -4,13 +4,15 @@ import (
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
-	"github.com/kris-nova/logger"
 	"k8s.io/client-go/kubernetes"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	kubewrapper "github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/metrics"
 )
 
 // Task is a common interface for the stack manager tasks
@@ -19,12 +21,91 @@ type Task interface {
 	Do(chan error) error
 }
 
+// TaskResult is the outcome of a task that ran
+type TaskResult struct {
+	Description string
+	Started     time.Time
+	Duration    time.Duration
+	Err         error
+}
+
+// TaskResultHandler is called with the result of every task that runs, except for sets
+// of tasks, e.g. to record them in a journal of operations; it's called concurrently
+// by tasks that run in parallel
+var TaskResultHandler func(TaskResult)
+
+// TaskTreeObserver is notified when DoAllSync starts running a tree of tasks, and once all
+// of its tasks are done, e.g. to report the progress of an operation
+type TaskTreeObserver interface {
+	TaskTreeStarted(tree *TaskTree)
+	TaskTreeFinished(tree *TaskTree, errs []error)
+}
+
+var (
+	// taskObserver is notified of every tree of tasks that DoAllSync runs; trees in plan mode,
+	// and trees that aren't confirmed, don't run and aren't observed
+	taskObserver      TaskTreeObserver
+	taskObserverMutex sync.RWMutex
+)
+
+// SetTaskObserver sets the observer of the trees of tasks that run, nil removes it; it's safe to
+// call while trees of tasks run, e.g. in parallel
+func SetTaskObserver(observer TaskTreeObserver) {
+	taskObserverMutex.Lock()
+	defer taskObserverMutex.Unlock()
+	taskObserver = observer
+}
+
+func currentTaskObserver() TaskTreeObserver {
+	taskObserverMutex.RLock()
+	defer taskObserverMutex.RUnlock()
+	return taskObserver
+}
+
+func reportTaskStart(task Task) {
+	if _, ok := task.(*TaskTree); ok {
+		return
+	}
+	metrics.TaskStarted()
+}
+
+func reportTaskResult(task Task, started time.Time, err error) {
+	if _, ok := task.(*TaskTree); ok {
+		return
+	}
+	metrics.TaskFinished(err)
+	if TaskResultHandler == nil {
+		return
+	}
+	TaskResultHandler(TaskResult{
+		Description: task.Describe(),
+		Started:     started,
+		Duration:    time.Since(started),
+		Err:         err,
+	})
+}
+
 // TaskTree wraps a set of tasks
 type TaskTree struct {
 	tasks     []Task
 	Parallel  bool
 	PlanMode  bool
 	IsSubTask bool
+	// Confirm is called once before any task runs, the tasks are not run
+	// when it returns an error
+	Confirm func() error
+}
+
+// Confirmed calls Confirm unless it was called successfully already
+func (t *TaskTree) Confirmed() error {
+	if t.Confirm == nil {
+		return nil
+	}
+	if err := t.Confirm(); err != nil {
+		return err
+	}
+	t.Confirm = nil
+	return nil
 }
 
 // Append new tasks to the set
@@ -40,6 +121,19 @@ func (t *TaskTree) Len() int {
 	return len(t.tasks)
 }
 
+// describeMode describes the set by the number of its tasks, e.g. "3 parallel sub-tasks"
+func (t *TaskTree) describeMode() string {
+	mode := "sequential"
+	if t.Parallel {
+		mode = "parallel"
+	}
+	noun := "tasks"
+	if t.IsSubTask {
+		noun = "sub-tasks"
+	}
+	return fmt.Sprintf("%d %s %s", t.Len(), mode, noun)
+}
+
 // Describe the set
 func (t *TaskTree) Describe() string {
 	descriptions := []string{}
@@ -83,6 +177,9 @@ func (t *TaskTree) Do(allErrs chan error) error {
 		close(allErrs)
 		return nil
 	}
+	if err := t.Confirmed(); err != nil {
+		return err
+	}
 
 	errs := make(chan error)
 
@@ -109,6 +206,13 @@ func (t *TaskTree) DoAllSync() []error {
 		logger.Debug("no actual tasks")
 		return nil
 	}
+	if err := t.Confirmed(); err != nil {
+		return []error{err}
+	}
+	observer := currentTaskObserver()
+	if observer != nil {
+		observer.TaskTreeStarted(t)
+	}
 
 	errs := make(chan error)
 
@@ -122,6 +226,9 @@ func (t *TaskTree) DoAllSync() []error {
 	for err := range errs {
 		allErrs = append(allErrs, err)
 	}
+	if observer != nil {
+		observer.TaskTreeFinished(t, allErrs)
+	}
 	return allErrs
 }
 
@@ -142,10 +249,23 @@ type taskWithNameParam struct {
 func (t *taskWithNameParam) Describe() string         { return t.info }
 func (t *taskWithNameParam) Do(errs chan error) error { return t.call(errs, t.name) }
 
+// taskToDeleteManagedNodeGroup is distinct from taskWithNameParam, so that
+// deletion summaries can tell managed nodegroups apart
+type taskToDeleteManagedNodeGroup struct {
+	info string
+	name string
+	call func(chan error, string) error
+}
+
+func (t *taskToDeleteManagedNodeGroup) Describe() string         { return t.info }
+func (t *taskToDeleteManagedNodeGroup) Do(errs chan error) error { return t.call(errs, t.name) }
+
 type taskWithNodeGroupSpec struct {
 	info      string
 	nodeGroup *api.NodeGroup
-	call      func(chan error, *api.NodeGroup) error
+	// stack is the name of the stack of the nodegroup
+	stack string
+	call  func(chan error, *api.NodeGroup) error
 }
 
 func (t *taskWithNodeGroupSpec) Describe() string { return t.info }
@@ -153,6 +273,19 @@ func (t *taskWithNodeGroupSpec) Do(errs chan error) error {
 	return t.call(errs, t.nodeGroup)
 }
 
+type taskWithManagedNodeGroupSpec struct {
+	info      string
+	nodeGroup *api.ManagedNodeGroup
+	// stack is the name of the stack of the nodegroup
+	stack string
+	call  func(chan error, *api.ManagedNodeGroup) error
+}
+
+func (t *taskWithManagedNodeGroupSpec) Describe() string { return t.info }
+func (t *taskWithManagedNodeGroupSpec) Do(errs chan error) error {
+	return t.call(errs, t.nodeGroup)
+}
+
 type taskWithClusterIAMServiceAccountSpec struct {
 	info           string
 	serviceAccount *api.ClusterIAMServiceAccount
@@ -221,18 +354,86 @@ func (t *kubernetesTask) Do(errs chan error) error {
 	return err
 }
 
+// TaskError is the error of a task, along with the path of the task in the TaskTree,
+// its message is the one of the underlying error
+type TaskError struct {
+	// Path holds the descriptions of the task trees the task is part of, followed by
+	// the description of the task itself
+	Path []string
+	// StackName is set if the task operates on a stack
+	StackName string
+	Err       error
+}
+
+func (e *TaskError) Error() string { return e.Err.Error() }
+
+// stackTask is implemented by tasks that operate on a stack
+type stackTask interface {
+	stackName() string
+}
+
+func (t *taskWithStackSpec) stackName() string            { return *t.stack.StackName }
+func (t *asyncTaskWithStackSpec) stackName() string       { return *t.stack.StackName }
+func (t *taskWithNodeGroupSpec) stackName() string        { return t.stack }
+func (t *taskWithManagedNodeGroupSpec) stackName() string { return t.stack }
+
+// newTaskError adds the task to the path of the error
+func newTaskError(task Task, err error) error {
+	segment := task.Describe()
+	if tree, ok := task.(*TaskTree); ok {
+		if tree.Len() == 1 {
+			if _, ok := err.(*TaskError); ok {
+				// the single sub-task describes the tree already
+				return err
+			}
+		}
+		segment = tree.describeMode()
+	}
+	if taskErr, ok := err.(*TaskError); ok {
+		taskErr.Path = append([]string{segment}, taskErr.Path...)
+		return taskErr
+	}
+	taskErr := &TaskError{Path: []string{segment}, Err: err}
+	if st, ok := task.(stackTask); ok {
+		taskErr.StackName = st.stackName()
+	}
+	return taskErr
+}
+
 func doSingleTask(allErrs chan error, task Task) bool {
 	desc := task.Describe()
+	started := time.Now()
+	id, ok := startTask(task, started)
+	if !ok {
+		logger.Debug("skipped task: %s (interrupted)", desc)
+		allErrs <- newTaskError(task, ErrInterrupted)
+		return false
+	}
+	defer finishTask(id)
 	logger.Debug("started task: %s", desc)
+	reportTaskStart(task)
 	errs := make(chan error)
 	if err := task.Do(errs); err != nil {
-		allErrs <- err
+		reportTaskResult(task, started, err)
+		allErrs <- newTaskError(task, err)
 		return false
 	}
-	if err := <-errs; err != nil {
-		allErrs <- err
+	if _, ok := task.(*TaskTree); ok {
+		// a sub-tree sends an error per failed task, and closes the channel once all are done
+		failed := false
+		for err := range errs {
+			allErrs <- newTaskError(task, err)
+			failed = true
+		}
+		if failed {
+			return false
+		}
+	} else if err := <-errs; err != nil {
+		reportTaskResult(task, started, err)
+		allErrs <- newTaskError(task, err)
 		return false
 	}
+	reportTaskResult(task, started, nil)
 	logger.Debug("completed task: %s", desc)
 	return true
 }
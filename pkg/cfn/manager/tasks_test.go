@@ -6,9 +6,13 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
 
@@ -89,6 +93,121 @@ var _ = Describe("StackCollection Tasks", func() {
 				}
 			})
 
+			It("should report the results of tasks", func() {
+				var (
+					results []TaskResult
+					mutex   sync.Mutex
+				)
+				TaskResultHandler = func(result TaskResult) {
+					mutex.Lock()
+					defer mutex.Unlock()
+					results = append(results, result)
+				}
+				defer func() { TaskResultHandler = nil }()
+
+				newTask := func(info string, err error) Task {
+					return &taskWithoutParams{
+						info: info,
+						call: func(errs chan error) error {
+							go func() {
+								errs <- err
+								close(errs)
+							}()
+							return nil
+						},
+					}
+				}
+				tasks := &TaskTree{Parallel: false}
+				subTask := &TaskTree{Parallel: true, IsSubTask: true}
+				subTask.Append(newTask("t1.1", nil), newTask("t1.2", fmt.Errorf("t1.2 fails")))
+				tasks.Append(subTask)
+
+				Expect(tasks.DoAllSync()).To(HaveLen(1))
+				Expect(results).To(HaveLen(2))
+				for _, result := range results {
+					Expect(result.Started).ToNot(BeZero())
+					if result.Description == "t1.2" {
+						Expect(result.Err).To(MatchError("t1.2 fails"))
+					} else {
+						Expect(result.Description).To(Equal("t1.1"))
+						Expect(result.Err).ToNot(HaveOccurred())
+					}
+				}
+			})
+
+			It("should notify the observer of trees of tasks that run", func() {
+				observer := &recordingObserver{}
+				SetTaskObserver(observer)
+				defer SetTaskObserver(nil)
+
+				tasks := &TaskTree{Parallel: true}
+				tasks.Append(&taskWithoutParams{
+					info: "t1",
+					call: func(errs chan error) error {
+						go func() {
+							errs <- fmt.Errorf("t1 fails")
+							close(errs)
+						}()
+						return nil
+					},
+				})
+				Expect(tasks.DoAllSync()).To(HaveLen(1))
+				Expect(observer.started).To(Equal([]string{"1 task: { t1 }"}))
+				Expect(observer.errs).To(HaveLen(1))
+
+				tasks.PlanMode = true
+				Expect(tasks.DoAllSync()).To(BeEmpty())
+				Expect(observer.started).To(HaveLen(1))
+			})
+
+			It("should not start tasks once interrupted", func() {
+				defer ResetInterrupt()
+
+				started := make(chan struct{})
+				release := make(chan struct{})
+				blockingTask := &taskWithoutParams{
+					info: "t1.1",
+					call: func(errs chan error) error {
+						go func() {
+							close(started)
+							<-release
+							close(errs)
+						}()
+						return nil
+					},
+				}
+				var ranAfterInterrupt bool
+				nextTask := &taskWithoutParams{
+					info: "t1.2",
+					call: func(errs chan error) error {
+						ranAfterInterrupt = true
+						close(errs)
+						return nil
+					},
+				}
+				tasks := &TaskTree{Parallel: false}
+				tasks.Append(blockingTask, nextTask)
+
+				result := make(chan []error)
+				go func() { result <- tasks.DoAllSync() }()
+
+				<-started
+				inFlight := Interrupt()
+				Expect(inFlight).To(HaveLen(1))
+				Expect(inFlight[0].Description).To(Equal("t1.1"))
+				close(release)
+
+				errs := <-result
+				Expect(errs).To(HaveLen(1))
+				Expect(errs[0]).To(BeAssignableToTypeOf(&TaskError{}))
+				Expect(errs[0].(*TaskError).Err).To(Equal(ErrInterrupted))
+				Expect(ranAfterInterrupt).To(BeFalse())
+
+				reported, interrupted := Interrupted()
+				Expect(interrupted).To(BeTrue())
+				Expect(reported).To(Equal(inFlight))
+			})
+
 			It("should execute orderly", func() {
 				{
 					var status struct {
@@ -645,6 +764,15 @@ var _ = Describe("StackCollection Tasks", func() {
 					}
 					return nodeGroups
 				}
+				makeManagedNodeGroups := func(names ...string) []*api.ManagedNodeGroup {
+					var nodeGroups []*api.ManagedNodeGroup
+					for _, name := range names {
+						ng := api.NewManagedNodeGroup()
+						ng.Name = name
+						nodeGroups = append(nodeGroups, ng)
+					}
+					return nodeGroups
+				}
 
 				{
 					tasks := stackManager.NewTasksToCreateNodeGroups(makeNodeGroups("bar", "foo"))
@@ -663,19 +791,139 @@ var _ = Describe("StackCollection Tasks", func() {
 					Expect(tasks.Describe()).To(Equal(`no tasks`))
 				}
 				{
-					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar", "foo"))
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar", "foo"), nil)
 					Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { create cluster control plane "test-cluster", 2 parallel sub-tasks: { create nodegroup "bar", create nodegroup "foo" } }`))
 				}
 				{
-					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar"))
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar"), nil)
 					Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { create cluster control plane "test-cluster", create nodegroup "bar" }`))
 				}
 				{
-					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(nil)
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(nil, nil)
 					Expect(tasks.Describe()).To(Equal(`1 task: { create cluster control plane "test-cluster" }`))
 				}
+				{
+					tasks := stackManager.NewTasksToCreateManagedNodeGroups(makeManagedNodeGroups("baz"))
+					Expect(tasks.Describe()).To(Equal(`1 task: { create managed nodegroup "baz" }`))
+				}
+				{
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar"), makeManagedNodeGroups("baz"))
+					Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { create cluster control plane "test-cluster", 2 parallel sub-tasks: { create nodegroup "bar", create managed nodegroup "baz" } }`))
+				}
+				{
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(nil, makeManagedNodeGroups("baz"))
+					Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { create cluster control plane "test-cluster", create managed nodegroup "baz" }`))
+				}
+				{
+					postClusterCreationTask := &taskWithoutParams{info: "configure custom networking for pods"}
+					tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(makeNodeGroups("bar"), nil, postClusterCreationTask)
+					Expect(tasks.Describe()).To(Equal(`3 sequential tasks: { create cluster control plane "test-cluster", configure custom networking for pods, create nodegroup "bar" }`))
+				}
+			})
+		})
+
+		Context("With nodegroup replacement tasks", func() {
+			var (
+				oldNG, newNG *api.NodeGroup
+				steps        NodeGroupReplacementSteps
+			)
+
+			mockNodeGroupStacks := func(statuses map[string]string) {
+				stacks := []*cfn.Stack{}
+				for name, status := range statuses {
+					stacks = append(stacks, &cfn.Stack{
+						StackName:   aws.String("eksctl-test-cluster-nodegroup-" + name),
+						StackStatus: aws.String(status),
+						Tags: []*cfn.Tag{
+							{
+								Key:   aws.String(api.NodeGroupNameTag),
+								Value: aws.String(name),
+							},
+						},
+					})
+				}
+				p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+					consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+					consume(&cfn.DescribeStacksOutput{Stacks: stacks}, true)
+				}).Return(nil)
+			}
+
+			BeforeEach(func() {
+				p = mockprovider.NewMockProvider()
+
+				cfg = newClusterConfig("test-cluster")
+
+				stackManager = NewStackCollection(p, cfg)
+
+				oldNG = cfg.NodeGroups[0]
+				newNG = oldNG.DeepCopy()
+				newNG.Name = "bar-v2"
+
+				steps = NodeGroupReplacementSteps{
+					WaitForNodes: func(_ kubernetes.Interface, _ *api.NodeGroup) error { return nil },
+					Drain:        func(_ kubernetes.Interface, _ *api.NodeGroup) error { return nil },
+				}
+			})
+
+			It("should replace the nodegroup from scratch", func() {
+				mockNodeGroupStacks(map[string]string{"bar": cfn.StackStatusCreateComplete})
+
+				steps.Cleanup = func(_ kubernetes.Interface, _ *api.NodeGroup) error { return nil }
+
+				tasks, err := stackManager.NewTasksToReplaceNodeGroup(oldNG, newNG, nil, steps)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks.Describe()).To(Equal(`5 sequential tasks: { create nodegroup "bar-v2", wait for nodes of nodegroup "bar-v2" to become ready, drain nodegroup "bar", cleanup for nodegroup "bar", delete nodegroup "bar" }`))
+			})
+
+			It("should resume when the new nodegroup exists already", func() {
+				mockNodeGroupStacks(map[string]string{
+					"bar":    cfn.StackStatusCreateComplete,
+					"bar-v2": cfn.StackStatusCreateComplete,
+				})
+
+				tasks, err := stackManager.NewTasksToReplaceNodeGroup(oldNG, newNG, nil, steps)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks.Describe()).To(Equal(`3 sequential tasks: { wait for nodes of nodegroup "bar-v2" to become ready, drain nodegroup "bar", delete nodegroup "bar" }`))
+			})
+
+			It("should resume when the old nodegroup has been deleted already", func() {
+				mockNodeGroupStacks(map[string]string{"bar-v2": cfn.StackStatusCreateComplete})
+
+				tasks, err := stackManager.NewTasksToReplaceNodeGroup(oldNG, newNG, nil, steps)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(tasks.Describe()).To(Equal(`1 task: { wait for nodes of nodegroup "bar-v2" to become ready }`))
+			})
+
+			It("should fail when the new nodegroup failed to be created", func() {
+				mockNodeGroupStacks(map[string]string{
+					"bar":    cfn.StackStatusCreateComplete,
+					"bar-v2": cfn.StackStatusRollbackComplete,
+				})
+
+				_, err := stackManager.NewTasksToReplaceNodeGroup(oldNG, newNG, nil, steps)
+				Expect(err).To(MatchError(`stack of nodegroup "bar-v2" is in "ROLLBACK_COMPLETE" state, it has to be deleted before the replacement can be retried`))
+			})
+
+			It("should fail when neither nodegroup exists", func() {
+				mockNodeGroupStacks(map[string]string{"foo": cfn.StackStatusCreateComplete})
+
+				_, err := stackManager.NewTasksToReplaceNodeGroup(oldNG, newNG, nil, steps)
+				Expect(err).To(MatchError(`stack not found for nodegroup "bar"`))
 			})
 		})
 
 	})
 })
+
+type recordingObserver struct {
+	started []string
+	errs    []error
+}
+
+func (o *recordingObserver) TaskTreeStarted(tree *TaskTree) {
+	o.started = append(o.started, tree.Describe())
+}
+
+func (o *recordingObserver) TaskTreeFinished(_ *TaskTree, errs []error) {
+	o.errs = append(o.errs, errs...)
+}
@@ -3,8 +3,19 @@ package manager
 import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 )
 
+// StackTemplate is the rendered template of a stack
+type StackTemplate struct {
+	StackName string
+	Template  []byte
+}
+
 // GetStackTemplate gets the Cloudformation template for a stack
 func (c *StackCollection) GetStackTemplate(stackName string) (string, error) {
 	input := &cloudformation.GetTemplateInput{
@@ -18,3 +29,49 @@ func (c *StackCollection) GetStackTemplate(stackName string) (string, error) {
 
 	return *output.TemplateBody, nil
 }
+
+// RenderStackTemplates renders the templates of the stacks that are created for the cluster, the given
+// nodegroups, the managed nodegroups and the iamserviceaccounts, without creating them; the templates
+// of iamserviceaccounts are only rendered when oidc is set
+func (c *StackCollection) RenderStackTemplates(nodeGroups []*api.NodeGroup, oidc *iamoidc.OpenIDConnectManager) ([]StackTemplate, error) {
+	templates := []StackTemplate{}
+	add := func(name string, stack builder.ResourceSet) error {
+		if err := stack.AddAllResources(); err != nil {
+			return errors.Wrapf(err, "building %q stack", name)
+		}
+		template, err := stack.RenderJSON()
+		if err != nil {
+			return errors.Wrapf(err, "rendering template for %q stack", name)
+		}
+		templates = append(templates, StackTemplate{StackName: name, Template: template})
+		return nil
+	}
+
+	if err := add(c.makeClusterStackName(), builder.NewClusterResourceSet(c.provider, c.spec)); err != nil {
+		return nil, err
+	}
+	for _, ng := range nodeGroups {
+		stack := builder.NewNodeGroupResourceSet(c.provider, c.spec, c.makeClusterStackName(), ng)
+		if err := add(c.makeNodeGroupStackName(ng.Name), stack); err != nil {
+			return nil, err
+		}
+	}
+	for _, ng := range c.spec.ManagedNodeGroups {
+		// managed nodegroups only have a stack for their instance role
+		if ng.IAM != nil && ng.IAM.InstanceRoleARN != "" {
+			continue
+		}
+		if err := add(c.makeManagedNodeGroupStackName(ng.Name), builder.NewManagedNodeGroupResourceSet(ng).WithRegion(c.spec.Metadata.Region)); err != nil {
+			return nil, err
+		}
+	}
+	if oidc != nil && c.spec.IAM != nil {
+		for _, sa := range c.spec.IAM.ServiceAccounts {
+			stack := builder.NewIAMServiceAccountResourceSet(sa, oidc)
+			if err := add(c.makeIAMServiceAccountStackName(sa.Namespace, sa.Name), stack); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return templates, nil
+}
@@ -9,7 +9,9 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
 var _ = Describe("StackCollection Template", func() {
@@ -96,4 +98,51 @@ var _ = Describe("StackCollection Template", func() {
 			})
 		})
 	})
+
+	Describe("RenderStackTemplates", func() {
+		var oidc *iamoidc.OpenIDConnectManager
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+
+			cc = newClusterConfig("test-cluster")
+			cc.Metadata.Version = api.DefaultVersion
+			Expect(vpc.SetSubnets(cc)).To(Succeed())
+
+			withRole := api.NewManagedNodeGroup()
+			withRole.Name = "with-role"
+			withRole.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/nodes"
+			cc.ManagedNodeGroups = []*api.ManagedNodeGroup{withRole}
+
+			sa := &api.ClusterIAMServiceAccount{AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"}}
+			sa.Name = "s3-reader"
+			sa.Namespace = "default"
+			cc.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{sa}
+
+			sc = NewStackCollection(p, cc)
+
+			var err error
+			oidc, err = iamoidc.NewOpenIDConnectManager(nil, "123456789012", "https://oidc.eks.us-west-2.amazonaws.com/id/A39A2842863C47208955D753DE205E6E")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("renders the templates of the cluster and its iamserviceaccounts without creating stacks", func() {
+			templates, err := sc.RenderStackTemplates(nil, oidc)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(templates).To(HaveLen(2))
+			Expect(templates[0].StackName).To(Equal("eksctl-test-cluster-cluster"))
+			Expect(string(templates[0].Template)).To(ContainSubstring(`"AWS::EKS::Cluster"`))
+			Expect(templates[1].StackName).To(Equal("eksctl-test-cluster-addon-iamserviceaccount-default-s3-reader"))
+			Expect(string(templates[1].Template)).To(ContainSubstring("AmazonS3ReadOnlyAccess"))
+
+			Expect(p.MockCloudFormation().Calls).To(BeEmpty())
+		})
+
+		It("doesn't render the templates of iamserviceaccounts without an OIDC manager", func() {
+			templates, err := sc.RenderStackTemplates(nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(templates).To(HaveLen(1))
+		})
+	})
 })
@@ -2,14 +2,16 @@ package manager
 
 import (
 	"fmt"
+	"strings"
 
+	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/request"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/builder"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/waiters"
 )
 
@@ -42,11 +44,17 @@ func (c *StackCollection) waitWithAcceptors(i *Stack, acceptors []request.Waiter
 			logger.Debug("describeErr=%v", err)
 		} else {
 			logger.Critical("unexpected status %q while %s", *s.StackStatus, msg)
-			c.troubleshootStackFailureCause(i, desiredStatus)
 		}
+		// events of stacks that were deleted by a rollback can still be fetched
+		c.troubleshootStackFailureCause(i, desiredStatus)
 	}
 
-	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.provider.WaitTimeout(), troubleshoot)
+	// the status of the stack has changed by the time waiting is over, whatever the outcome
+	defer c.cache.Invalidate()
+	if c.poller != nil {
+		return c.poller.wait(c.Context(), i, msg, acceptors, c.provider.WaitTimeout(), troubleshoot)
+	}
+	return waiters.Wait(c.Context(), *i.StackName, msg, acceptors, newRequest, c.provider.WaitTimeout(), troubleshoot)
 }
 
 func (c *StackCollection) waitWithAcceptorsChangeSet(i *Stack, changesetName string, acceptors []request.WaiterAcceptor) error {
@@ -70,9 +78,13 @@ func (c *StackCollection) waitWithAcceptorsChangeSet(i *Stack, changesetName str
 		}
 	}
 
-	return waiters.Wait(*i.StackName, msg, acceptors, newRequest, c.provider.WaitTimeout(), troubleshoot)
+	return waiters.Wait(c.Context(), *i.StackName, msg, acceptors, newRequest, c.provider.WaitTimeout(), troubleshoot)
 }
 
+// maxReportedFailedStackEvents is the number of most recent failed events that are logged
+// when a stack doesn't reach the desired status, older ones are only logged in verbose mode
+const maxReportedFailedStackEvents = 5
+
 func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus string) {
 	logger.Info("fetching stack events in attempt to troubleshoot the root cause of the failure")
 	events, err := c.DescribeStackEvents(i)
@@ -80,34 +92,72 @@ func (c *StackCollection) troubleshootStackFailureCause(i *Stack, desiredStatus
 		logger.Critical("cannot fetch stack events: %v", err)
 		return
 	}
+	reported, omitted := 0, 0
 	for _, e := range events {
-		msg := fmt.Sprintf("%s/%s: %s", *e.ResourceType, *e.LogicalResourceId, *e.ResourceStatus)
+		msg := fmt.Sprintf("%s/%s: %s", aws.StringValue(e.ResourceType), aws.StringValue(e.LogicalResourceId), aws.StringValue(e.ResourceStatus))
 		if e.ResourceStatusReason != nil {
-			msg = fmt.Sprintf("%s – %#v", msg, *e.ResourceStatusReason)
+			msg = fmt.Sprintf("%s – %#v", msg, *e.ResourceStatusReason)
 		}
-		switch desiredStatus {
-		case cfn.StackStatusCreateComplete:
-			switch *e.ResourceStatus {
-			case cfn.ResourceStatusCreateFailed:
-				logger.Critical(msg)
-			case cfn.ResourceStatusDeleteInProgress:
-				logger.Warning(msg)
-			default:
-				logger.Debug(msg) // only output this when verbose logging is enabled
-			}
-		case cfn.StackStatusDeleteComplete:
-			switch *e.ResourceStatus {
-			case cfn.ResourceStatusDeleteFailed:
+		switch classifyStackEvent(desiredStatus, aws.StringValue(e.ResourceStatus)) {
+		case stackEventFailed:
+			// events are returned most recent first
+			if reported < maxReportedFailedStackEvents {
 				logger.Critical(msg)
-			case cfn.ResourceStatusDeleteSkipped:
-				logger.Warning(msg)
-			default:
-				logger.Debug(msg) // only output this when verbose logging is enabled
+				reported++
+			} else {
+				logger.Debug(msg)
+				omitted++
 			}
+		case stackEventSuspicious:
+			logger.Warning(msg)
 		default:
-			logger.Info(msg)
+			logger.Debug(msg) // only output this when verbose logging is enabled
+		}
+	}
+	switch {
+	case reported == 0:
+		logger.Info("no failed resources found in events of stack %q", *i.StackName)
+	case omitted > 0:
+		logger.Info("%d older failed event(s) of stack %q were omitted, they are logged with -v 4", omitted, *i.StackName)
+	}
+}
+
+type stackEventClass int
+
+const (
+	stackEventOther stackEventClass = iota
+	stackEventFailed
+	stackEventSuspicious
+)
+
+// classifyStackEvent tells whether the status of a resource explains why its stack
+// did not reach the desired status
+func classifyStackEvent(desiredStatus, resourceStatus string) stackEventClass {
+	switch desiredStatus {
+	case cfn.StackStatusCreateComplete:
+		switch resourceStatus {
+		case cfn.ResourceStatusCreateFailed:
+			return stackEventFailed
+		case cfn.ResourceStatusDeleteInProgress:
+			return stackEventSuspicious
+		}
+	case cfn.StackStatusDeleteComplete:
+		switch resourceStatus {
+		case cfn.ResourceStatusDeleteFailed:
+			return stackEventFailed
+		case cfn.ResourceStatusDeleteSkipped:
+			return stackEventSuspicious
+		}
+	case cfn.StackStatusUpdateComplete:
+		if resourceStatus == cfn.ResourceStatusUpdateFailed {
+			return stackEventFailed
+		}
+	default:
+		if strings.HasSuffix(resourceStatus, "_FAILED") {
+			return stackEventFailed
 		}
 	}
+	return stackEventOther
 }
 
 // DoWaitUntilStackIsCreated blocks until the given stack's
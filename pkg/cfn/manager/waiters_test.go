@@ -0,0 +1,24 @@
+package manager
+
+import (
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/ginkgo/extensions/table"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("StackCollection waiters", func() {
+	DescribeTable("classifies stack events by the desired status of the stack",
+		func(desiredStatus, resourceStatus string, expected stackEventClass) {
+			Expect(classifyStackEvent(desiredStatus, resourceStatus)).To(Equal(expected))
+		},
+		Entry("failed creation", cfn.StackStatusCreateComplete, cfn.ResourceStatusCreateFailed, stackEventFailed),
+		Entry("rollback of creation", cfn.StackStatusCreateComplete, cfn.ResourceStatusDeleteInProgress, stackEventSuspicious),
+		Entry("completed creation", cfn.StackStatusCreateComplete, cfn.ResourceStatusCreateComplete, stackEventOther),
+		Entry("failed deletion", cfn.StackStatusDeleteComplete, cfn.ResourceStatusDeleteFailed, stackEventFailed),
+		Entry("skipped deletion", cfn.StackStatusDeleteComplete, cfn.ResourceStatusDeleteSkipped, stackEventSuspicious),
+		Entry("creation failure of an older operation", cfn.StackStatusDeleteComplete, cfn.ResourceStatusCreateFailed, stackEventOther),
+		Entry("failed update", cfn.StackStatusUpdateComplete, cfn.ResourceStatusUpdateFailed, stackEventFailed),
+		Entry("unknown desired status", "IMPORT_COMPLETE", "IMPORT_FAILED", stackEventFailed),
+	)
+})
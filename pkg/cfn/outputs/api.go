@@ -2,6 +2,7 @@ package outputs
 
 import (
 	"fmt"
+	"strings"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	gfn "github.com/awslabs/goformation/cloudformation"
@@ -16,21 +17,31 @@ const (
 	ClusterSecurityGroup  = "SecurityGroup"
 	ClusterSubnetsPrivate = string("Subnets" + api.SubnetTopologyPrivate)
 	ClusterSubnetsPublic  = string("Subnets" + api.SubnetTopologyPublic)
+	ClusterSubnetsPod     = "SubnetsPod"
 
 	ClusterSubnetsPublicLegacy = "Subnets"
 
-	ClusterCertificateAuthorityData = "CertificateAuthorityData"
-	ClusterEndpoint                 = "Endpoint"
-	ClusterARN                      = "ARN"
-	ClusterStackName                = "ClusterStackName"
-	ClusterSharedNodeSecurityGroup  = "SharedNodeSecurityGroup"
-	ClusterServiceRoleARN           = "ServiceRoleARN"
-	ClusterFeatureNATMode           = "FeatureNATMode"
+	ClusterCertificateAuthorityData   = "CertificateAuthorityData"
+	ClusterEndpoint                   = "Endpoint"
+	ClusterARN                        = "ARN"
+	ClusterStackName                  = "ClusterStackName"
+	ClusterSharedNodeSecurityGroup    = "SharedNodeSecurityGroup"
+	ClusterServiceRoleARN             = "ServiceRoleARN"
+	ClusterFargatePodExecutionRoleARN = "FargatePodExecutionRoleARN"
+	ClusterSecretsEncryptionKeyARN    = "SecretsEncryptionKeyARN"
+	ClusterFeatureNATMode             = "FeatureNATMode"
+	ClusterFeatureRegistered          = "FeatureRegistered"
 
 	// outputs from nodegroup stack
 	NodeGroupInstanceRoleARN    = "InstanceRoleARN"
 	NodeGroupInstanceProfileARN = "InstanceProfileARN"
 
+	// outputs from karpenter stack
+	KarpenterNodeRoleARN           = "KarpenterNodeRoleARN"
+	KarpenterInstanceProfileName   = "KarpenterInstanceProfileName"
+	KarpenterInterruptionQueueName = "KarpenterInterruptionQueueName"
+	KarpenterControllerPolicyARN   = "KarpenterControllerPolicyARN"
+
 	// outputs to indicate configuration attributes that may have critical effect
 	// on critical effect on forward-compatibility with respect to overal functionality
 	// and integrity, e.g. networking
@@ -39,6 +50,26 @@ const (
 	NodeGroupFeatureLocalSecurityGroup  = "FeatureLocalSecurityGroup"
 )
 
+// ClusterSubnetsInZone returns the name of the output of the subnet of the given topology in an
+// availability zone that was added to the cluster after it was created, the outputs that list
+// all subnets cannot be updated as nodegroup stacks import them
+func ClusterSubnetsInZone(topology api.SubnetTopology, az string) string {
+	return "Subnets" + string(topology) + strings.ToUpper(strings.Replace(az, "-", "", -1))
+}
+
+// SubnetsInZones returns the IDs of the subnets of the given topology in availability zones
+// that were added to the cluster, see ClusterSubnetsInZone
+func SubnetsInZones(stack cfn.Stack, topology api.SubnetTopology) []string {
+	prefix := "Subnets" + string(topology)
+	var subnetIDs []string
+	for _, x := range stack.Outputs {
+		if key := *x.OutputKey; strings.HasPrefix(key, prefix) && key != prefix {
+			subnetIDs = append(subnetIDs, *x.OutputValue)
+		}
+	}
+	return subnetIDs
+}
+
 type (
 	// Collector is a callback function that takes an output value
 	// and may return an error
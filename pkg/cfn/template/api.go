@@ -21,8 +21,10 @@ type Template struct {
 	AWSTemplateFormatVersion string
 
 	Description string
-	Resources   map[string]AnyResource `json:",omitempty"`
-	Outputs     map[string]Output      `json:",omitempty"`
+	// Metadata holds details of how the template was built that can't be derived from its resources
+	Metadata  map[string]interface{} `json:",omitempty"`
+	Resources map[string]AnyResource `json:",omitempty"`
+	Outputs   map[string]Output      `json:",omitempty"`
 }
 
 // AnyResource represents a generic CloudFormation resource
@@ -0,0 +1,433 @@
+package cost
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+const (
+	// HoursPerMonth is the number of hours that hourly prices are multiplied by
+	HoursPerMonth = 730
+
+	// Currency is the currency of all prices
+	Currency = "USD"
+
+	// UnitHours is the unit of hourly prices
+	UnitHours = "Hrs"
+	// UnitGBMonths is the unit of monthly prices of storage
+	UnitGBMonths = "GB-Mo"
+
+	// defaultRootVolumeSize is the size of root volumes of nodes when it isn't set, as in the EKS AMIs
+	defaultRootVolumeSize = 20
+	// defaultWindowsRootVolumeSize is the size of root volumes of Windows nodes when it isn't set
+	defaultWindowsRootVolumeSize = 50
+)
+
+// LineItem is the estimated cost of a resource described by a config
+type LineItem struct {
+	Resource    string  `json:"resource"`
+	Description string  `json:"description"`
+	Quantity    float64 `json:"quantity"`
+	UnitPrice   float64 `json:"unitPrice"`
+	Unit        string  `json:"unit"`
+	MonthlyCost float64 `json:"monthlyCost"`
+}
+
+// Estimate is the estimated monthly cost of a cluster
+type Estimate struct {
+	ClusterName string     `json:"clusterName"`
+	Region      string     `json:"region"`
+	Currency    string     `json:"currency"`
+	Items       []LineItem `json:"items"`
+	MonthlyCost float64    `json:"monthlyCost"`
+}
+
+// Estimator estimates the monthly cost of clusters using prices of the Price List API,
+// and recent prices of spot instances
+type Estimator struct {
+	provider api.ClusterProvider
+	prices   map[string]float64
+}
+
+// NewEstimator creates an Estimator
+func NewEstimator(provider api.ClusterProvider) *Estimator {
+	return &Estimator{
+		provider: provider,
+		prices:   map[string]float64{},
+	}
+}
+
+// Estimate returns the estimated monthly cost of the control plane, the instances and volumes of the
+// nodegroups, and the NAT gateways described by spec, at their desired capacity; the cost of data
+// transfer and of load balancers or volumes that are created by Kubernetes isn't included
+func (e *Estimator) Estimate(spec *api.ClusterConfig) (*Estimate, error) {
+	estimate := &Estimate{
+		ClusterName: spec.Metadata.Name,
+		Region:      e.provider.Region(),
+		Currency:    Currency,
+	}
+
+	controlPlanePrice, err := e.controlPlanePrice()
+	if err != nil {
+		return nil, err
+	}
+	estimate.add("control plane", "EKS cluster", 1, controlPlanePrice, UnitHours)
+
+	for _, ng := range spec.NodeGroups {
+		if err := e.addNodeGroup(estimate, ng); err != nil {
+			return nil, err
+		}
+	}
+	for _, ng := range spec.ManagedNodeGroups {
+		if err := e.addManagedNodeGroup(estimate, ng); err != nil {
+			return nil, err
+		}
+	}
+
+	if natGateways := natGatewayCount(spec); natGateways > 0 {
+		natGatewayPrice, err := e.natGatewayPrice()
+		if err != nil {
+			return nil, err
+		}
+		estimate.add("vpc", "NAT gateways", float64(natGateways), natGatewayPrice, UnitHours)
+	}
+
+	return estimate, nil
+}
+
+func (e *Estimate) add(resource, description string, quantity, unitPrice float64, unit string) {
+	monthlyCost := quantity * unitPrice
+	if unit == UnitHours {
+		monthlyCost *= HoursPerMonth
+	}
+	monthlyCost = math.Round(monthlyCost*100) / 100
+	e.Items = append(e.Items, LineItem{
+		Resource:    resource,
+		Description: description,
+		Quantity:    quantity,
+		UnitPrice:   unitPrice,
+		Unit:        unit,
+		MonthlyCost: monthlyCost,
+	})
+	e.MonthlyCost = math.Round((e.MonthlyCost+monthlyCost)*100) / 100
+}
+
+func (e *Estimator) addNodeGroup(estimate *Estimate, ng *api.NodeGroup) error {
+	resource := fmt.Sprintf("nodegroup %q", ng.Name)
+	windows := api.IsWindowsImage(ng.AMIFamily)
+	onDemand, spot := api.InstanceCapacity(ng)
+
+	instanceType := ng.InstanceType
+	instanceTypes := []string{ng.InstanceType}
+	if api.HasMixedInstances(ng) {
+		// the first instance type has the highest priority for on-demand instances
+		instanceType = ng.InstancesDistribution.InstanceTypes[0]
+		instanceTypes = ng.InstancesDistribution.InstanceTypes
+	}
+
+	if onDemand > 0 {
+		price, err := e.onDemandPrice(instanceType, windows)
+		if err != nil {
+			return err
+		}
+		estimate.add(resource, fmt.Sprintf("%s on-demand instances", instanceType), float64(onDemand), price, UnitHours)
+	}
+	if spot > 0 {
+		spotInstanceType, price, err := e.spotPrice(instanceTypes, windows)
+		if err != nil {
+			return err
+		}
+		estimate.add(resource, fmt.Sprintf("%s spot instances", spotInstanceType), float64(spot), price, UnitHours)
+	}
+
+	nodes := onDemand + spot
+	if nodes == 0 {
+		return nil
+	}
+	volumeSize := defaultRootVolumeSize
+	if windows {
+		volumeSize = defaultWindowsRootVolumeSize
+	}
+	if ng.VolumeSize != nil && *ng.VolumeSize > 0 {
+		volumeSize = *ng.VolumeSize
+	}
+	volumes := map[string]int{
+		volumeType(ng.VolumeType): volumeSize,
+	}
+	for _, v := range ng.AdditionalVolumes {
+		if v.VolumeSize != nil {
+			volumes[volumeType(v.VolumeType)] += *v.VolumeSize
+		}
+	}
+	return e.addVolumes(estimate, resource, nodes, volumes)
+}
+
+func (e *Estimator) addManagedNodeGroup(estimate *Estimate, ng *api.ManagedNodeGroup) error {
+	resource := fmt.Sprintf("managed nodegroup %q", ng.Name)
+	nodes := api.DesiredCapacityOrDefault(ng.DesiredCapacity, ng.MinSize)
+	if nodes == 0 {
+		return nil
+	}
+	price, err := e.onDemandPrice(ng.InstanceType, false)
+	if err != nil {
+		return err
+	}
+	estimate.add(resource, fmt.Sprintf("%s on-demand instances", ng.InstanceType), float64(nodes), price, UnitHours)
+
+	volumeSize := defaultRootVolumeSize
+	if ng.VolumeSize != nil && *ng.VolumeSize > 0 {
+		volumeSize = *ng.VolumeSize
+	}
+	return e.addVolumes(estimate, resource, nodes, map[string]int{api.NodeVolumeTypeGP2: volumeSize})
+}
+
+func (e *Estimator) addVolumes(estimate *Estimate, resource string, nodes int, volumes map[string]int) error {
+	volumeTypes := []string{}
+	for volumeType := range volumes {
+		volumeTypes = append(volumeTypes, volumeType)
+	}
+	sort.Strings(volumeTypes)
+	for _, volumeType := range volumeTypes {
+		price, err := e.volumePrice(volumeType)
+		if err != nil {
+			return err
+		}
+		estimate.add(resource, fmt.Sprintf("%s volumes", volumeType), float64(nodes*volumes[volumeType]), price, UnitGBMonths)
+	}
+	return nil
+}
+
+func (e *Estimator) controlPlanePrice() (float64, error) {
+	return e.price("AmazonEKS", map[string]string{}, UnitHours, func(usageType string) bool {
+		return strings.HasSuffix(usageType, "AmazonEKS-Hours:perCluster")
+	})
+}
+
+func (e *Estimator) onDemandPrice(instanceType string, windows bool) (float64, error) {
+	operatingSystem := "Linux"
+	if windows {
+		operatingSystem = "Windows"
+	}
+	return e.price("AmazonEC2", map[string]string{
+		"instanceType":    instanceType,
+		"operatingSystem": operatingSystem,
+		"tenancy":         "Shared",
+		"preInstalledSw":  "NA",
+		"capacitystatus":  "Used",
+	}, UnitHours, nil)
+}
+
+func (e *Estimator) volumePrice(volumeType string) (float64, error) {
+	return e.price("AmazonEC2", map[string]string{
+		"productFamily": "Storage",
+		"volumeApiName": volumeType,
+	}, UnitGBMonths, nil)
+}
+
+func (e *Estimator) natGatewayPrice() (float64, error) {
+	return e.price("AmazonEC2", map[string]string{
+		"productFamily": "NAT Gateway",
+	}, UnitHours, nil)
+}
+
+// price returns the on-demand price in the region of the first product of the service that matches
+// the attributes, has a price in the unit and whose usage type is accepted; prices are cached
+func (e *Estimator) price(serviceCode string, attributes map[string]string, unit string, acceptUsageType func(string) bool) (float64, error) {
+	attributes["regionCode"] = e.provider.Region()
+
+	keys := []string{}
+	for key := range attributes {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	filters := []*pricing.Filter{}
+	description := []string{}
+	for _, key := range keys {
+		filters = append(filters, &pricing.Filter{
+			Type:  aws.String(pricing.FilterTypeTermMatch),
+			Field: aws.String(key),
+			Value: aws.String(attributes[key]),
+		})
+		description = append(description, fmt.Sprintf("%s=%s", key, attributes[key]))
+	}
+	cacheKey := serviceCode + ":" + strings.Join(description, ",")
+	if price, ok := e.prices[cacheKey]; ok {
+		return price, nil
+	}
+
+	var (
+		price float64
+		found bool
+		err   error
+	)
+	input := &pricing.GetProductsInput{
+		ServiceCode: aws.String(serviceCode),
+		Filters:     filters,
+	}
+	pagesErr := e.provider.Pricing().GetProductsPages(input, func(output *pricing.GetProductsOutput, _ bool) bool {
+		for _, product := range output.PriceList {
+			if acceptUsageType != nil && !acceptUsageType(productAttribute(product, "usagetype")) {
+				continue
+			}
+			price, found, err = onDemandPriceOf(product, unit)
+			if err != nil || found {
+				return false
+			}
+		}
+		return true
+	})
+	if pagesErr != nil {
+		return 0, errors.Wrapf(pagesErr, "getting prices of %s products with %s", serviceCode, strings.Join(description, ", "))
+	}
+	if err != nil {
+		return 0, errors.Wrapf(err, "parsing prices of %s products with %s", serviceCode, strings.Join(description, ", "))
+	}
+	if !found {
+		return 0, fmt.Errorf("no price found for %s products with %s", serviceCode, strings.Join(description, ", "))
+	}
+
+	e.prices[cacheKey] = price
+	return price, nil
+}
+
+// spotPrice returns the cheapest of the instance types by their current spot price, averaged
+// over the availability zones that they are offered in
+func (e *Estimator) spotPrice(instanceTypes []string, windows bool) (string, float64, error) {
+	productDescription := "Linux/UNIX"
+	if windows {
+		productDescription = "Windows"
+	}
+	input := &ec2.DescribeSpotPriceHistoryInput{
+		InstanceTypes:       aws.StringSlice(instanceTypes),
+		ProductDescriptions: aws.StringSlice([]string{productDescription}),
+		StartTime:           aws.Time(time.Now()),
+	}
+
+	type zonePrices struct {
+		total float64
+		zones map[string]bool
+	}
+	prices := map[string]*zonePrices{}
+	var err error
+	pagesErr := e.provider.EC2().DescribeSpotPriceHistoryPages(input, func(output *ec2.DescribeSpotPriceHistoryOutput, _ bool) bool {
+		for _, spotPrice := range output.SpotPriceHistory {
+			instanceType, az := aws.StringValue(spotPrice.InstanceType), aws.StringValue(spotPrice.AvailabilityZone)
+			var price float64
+			if price, err = strconv.ParseFloat(aws.StringValue(spotPrice.SpotPrice), 64); err != nil {
+				return false
+			}
+			if prices[instanceType] == nil {
+				prices[instanceType] = &zonePrices{zones: map[string]bool{}}
+			}
+			// only the latest price of each zone is used, they are returned first
+			if p := prices[instanceType]; !p.zones[az] {
+				p.zones[az] = true
+				p.total += price
+			}
+		}
+		return true
+	})
+	if pagesErr != nil {
+		return "", 0, errors.Wrapf(pagesErr, "describing spot prices of instance types %v", instanceTypes)
+	}
+	if err != nil {
+		return "", 0, errors.Wrapf(err, "parsing spot prices of instance types %v", instanceTypes)
+	}
+
+	cheapest, cheapestPrice := "", 0.0
+	for _, instanceType := range instanceTypes {
+		p, ok := prices[instanceType]
+		if !ok {
+			continue
+		}
+		price := p.total / float64(len(p.zones))
+		if cheapest == "" || price < cheapestPrice {
+			cheapest, cheapestPrice = instanceType, price
+		}
+	}
+	if cheapest == "" {
+		return "", 0, fmt.Errorf("no spot price found for instance types %v", instanceTypes)
+	}
+	return cheapest, cheapestPrice, nil
+}
+
+// onDemandPriceOf returns the price in USD of a product of the price list in the unit, if any;
+// products are documents like
+//
+//	{"product": {"attributes": {...}}, "terms": {"OnDemand": {"<term>": {"priceDimensions": {"<rate>": {"unit": "Hrs", "pricePerUnit": {"USD": "0.10"}}}}}}}
+func onDemandPriceOf(product aws.JSONValue, unit string) (float64, bool, error) {
+	terms, _ := product["terms"].(map[string]interface{})
+	onDemand, _ := terms["OnDemand"].(map[string]interface{})
+	for _, term := range onDemand {
+		term, _ := term.(map[string]interface{})
+		dimensions, _ := term["priceDimensions"].(map[string]interface{})
+		for _, dimension := range dimensions {
+			dimension, _ := dimension.(map[string]interface{})
+			if dimension["unit"] != unit {
+				continue
+			}
+			pricePerUnit, _ := dimension["pricePerUnit"].(map[string]interface{})
+			value, ok := pricePerUnit[Currency].(string)
+			if !ok {
+				continue
+			}
+			price, err := strconv.ParseFloat(value, 64)
+			if err != nil {
+				return 0, false, err
+			}
+			if price == 0 {
+				// free tiers of tiered prices
+				continue
+			}
+			return price, true, nil
+		}
+	}
+	return 0, false, nil
+}
+
+func productAttribute(product aws.JSONValue, name string) string {
+	p, _ := product["product"].(map[string]interface{})
+	attributes, _ := p["attributes"].(map[string]interface{})
+	value, _ := attributes[name].(string)
+	return value
+}
+
+// natGatewayCount returns how many NAT gateways are created along with the VPC of the cluster
+func natGatewayCount(spec *api.ClusterConfig) int {
+	if spec.VPC == nil || spec.VPC.ID != "" || spec.IsPrivateCluster() {
+		return 0
+	}
+	nat := spec.VPC.NAT
+	if nat == nil {
+		nat = api.DefaultClusterNAT()
+	}
+	switch aws.StringValue(nat.Gateway) {
+	case api.ClusterSingleNAT:
+		return 1
+	case api.ClusterHighlyAvailableNAT:
+		if len(spec.AvailabilityZones) == 0 {
+			// zones are picked on creation
+			return api.RecommendedSubnets
+		}
+		return len(spec.AvailabilityZones)
+	}
+	return 0
+}
+
+func volumeType(t *string) string {
+	if t == nil || *t == "" {
+		return api.DefaultNodeVolumeType
+	}
+	return *t
+}
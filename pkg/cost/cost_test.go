@@ -0,0 +1,159 @@
+package cost_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/cost"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+// product returns a product of the price list with an on-demand price in the unit
+func product(usageType, unit, price string) aws.JSONValue {
+	return aws.JSONValue{
+		"product": map[string]interface{}{
+			"attributes": map[string]interface{}{"usagetype": usageType},
+		},
+		"terms": map[string]interface{}{
+			"OnDemand": map[string]interface{}{
+				"term-1": map[string]interface{}{
+					"priceDimensions": map[string]interface{}{
+						"rate-1": map[string]interface{}{
+							"unit":         unit,
+							"pricePerUnit": map[string]interface{}{"USD": price},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+var _ = Describe("cost estimates", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	mockProducts := func(serviceCode, field, value string, products ...aws.JSONValue) {
+		p.MockPricing().On("GetProductsPages", mock.MatchedBy(func(input *pricing.GetProductsInput) bool {
+			if *input.ServiceCode != serviceCode {
+				return false
+			}
+			for _, filter := range input.Filters {
+				if *filter.Field == field && *filter.Value == value {
+					return true
+				}
+			}
+			return false
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*pricing.GetProductsOutput, bool) bool)
+			consume(&pricing.GetProductsOutput{PriceList: products}, true)
+		}).Return(nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		mockProducts("AmazonEKS", "regionCode", "us-west-2",
+			product("USW2-AmazonEKS-Hours:extendedSupport", "Hrs", "0.60"),
+			product("USW2-AmazonEKS-Hours:perCluster", "Hrs", "0.10"),
+		)
+		mockProducts("AmazonEC2", "instanceType", "m5.large", product("USW2-BoxUsage:m5.large", "Hrs", "0.0960000000"))
+		mockProducts("AmazonEC2", "volumeApiName", "gp2", product("USW2-EBS:VolumeUsage.gp2", "GB-Mo", "0.10"))
+		mockProducts("AmazonEC2", "volumeApiName", "gp3", product("USW2-EBS:VolumeUsage.gp3", "GB-Mo", "0.08"))
+		mockProducts("AmazonEC2", "productFamily", "NAT Gateway",
+			product("USW2-NatGateway-Bytes", "GB", "0.045"),
+			product("USW2-NatGateway-Hours", "Hrs", "0.045"),
+		)
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b", "us-west-2c"}
+	})
+
+	It("estimates the cost of the control plane, nodegroups and NAT gateways", func() {
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.large"
+		ng.DesiredCapacity = aws.Int(3)
+		ng.VolumeSize = aws.Int(80)
+		ng.VolumeType = aws.String(api.NodeVolumeTypeGP3)
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng-1"
+		mng.InstanceType = "m5.large"
+		mng.DesiredCapacity = aws.Int(2)
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+
+		estimate, err := NewEstimator(p).Estimate(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(estimate.Region).To(Equal("us-west-2"))
+		Expect(estimate.Items).To(Equal([]LineItem{
+			{Resource: "control plane", Description: "EKS cluster", Quantity: 1, UnitPrice: 0.10, Unit: UnitHours, MonthlyCost: 73},
+			{Resource: `nodegroup "ng-1"`, Description: "m5.large on-demand instances", Quantity: 3, UnitPrice: 0.096, Unit: UnitHours, MonthlyCost: 210.24},
+			{Resource: `nodegroup "ng-1"`, Description: "gp3 volumes", Quantity: 240, UnitPrice: 0.08, Unit: UnitGBMonths, MonthlyCost: 19.2},
+			{Resource: `managed nodegroup "mng-1"`, Description: "m5.large on-demand instances", Quantity: 2, UnitPrice: 0.096, Unit: UnitHours, MonthlyCost: 140.16},
+			{Resource: `managed nodegroup "mng-1"`, Description: "gp2 volumes", Quantity: 40, UnitPrice: 0.10, Unit: UnitGBMonths, MonthlyCost: 4},
+			{Resource: "vpc", Description: "NAT gateways", Quantity: 1, UnitPrice: 0.045, Unit: UnitHours, MonthlyCost: 32.85},
+		}))
+		Expect(estimate.MonthlyCost).To(Equal(479.45))
+	})
+
+	It("estimates spot instances with the cheapest of the instance types", func() {
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.DesiredCapacity = aws.Int(4)
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes:                       []string{"m5.large", "m5a.large"},
+			OnDemandBaseCapacity:                aws.Int(1),
+			OnDemandPercentageAboveBaseCapacity: aws.Int(0),
+		}
+		p.MockEC2().On("DescribeSpotPriceHistoryPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeSpotPriceHistoryOutput, bool) bool)
+			consume(&ec2.DescribeSpotPriceHistoryOutput{SpotPriceHistory: []*ec2.SpotPrice{
+				{InstanceType: aws.String("m5.large"), AvailabilityZone: aws.String("us-west-2a"), SpotPrice: aws.String("0.04")},
+				{InstanceType: aws.String("m5.large"), AvailabilityZone: aws.String("us-west-2b"), SpotPrice: aws.String("0.02")},
+				{InstanceType: aws.String("m5.large"), AvailabilityZone: aws.String("us-west-2a"), SpotPrice: aws.String("0.09")},
+				{InstanceType: aws.String("m5a.large"), AvailabilityZone: aws.String("us-west-2a"), SpotPrice: aws.String("0.035")},
+			}}, true)
+		}).Return(nil)
+		disable := api.ClusterDisableNAT
+		cfg.VPC.NAT = &api.ClusterNAT{Gateway: &disable}
+
+		estimate, err := NewEstimator(p).Estimate(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(estimate.Items).To(HaveLen(4))
+		Expect(estimate.Items[1]).To(Equal(LineItem{Resource: `nodegroup "ng-1"`, Description: "m5.large on-demand instances", Quantity: 1, UnitPrice: 0.096, Unit: UnitHours, MonthlyCost: 70.08}))
+		Expect(estimate.Items[2]).To(Equal(LineItem{Resource: `nodegroup "ng-1"`, Description: "m5.large spot instances", Quantity: 3, UnitPrice: 0.03, Unit: UnitHours, MonthlyCost: 65.7}))
+		Expect(estimate.Items[3].Description).To(Equal("gp2 volumes"))
+		Expect(estimate.Items[3].Quantity).To(Equal(80.0))
+	})
+
+	It("counts a NAT gateway per availability zone of highly available NAT, and none for existing VPCs", func() {
+		ha := api.ClusterHighlyAvailableNAT
+		cfg.VPC.NAT = &api.ClusterNAT{Gateway: &ha}
+
+		estimate, err := NewEstimator(p).Estimate(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(estimate.Items[1].Quantity).To(Equal(3.0))
+
+		cfg.VPC.ID = "vpc-1"
+		estimate, err = NewEstimator(p).Estimate(cfg)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(estimate.Items).To(HaveLen(1))
+	})
+
+	It("fails when there is no price", func() {
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.xlarge"
+		mockProducts("AmazonEC2", "instanceType", "m5.xlarge")
+
+		_, err := NewEstimator(p).Estimate(cfg)
+		Expect(err).To(MatchError("no price found for AmazonEC2 products with capacitystatus=Used, instanceType=m5.xlarge, operatingSystem=Linux, preInstalledSw=NA, regionCode=us-west-2, tenancy=Shared"))
+	})
+})
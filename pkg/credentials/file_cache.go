@@ -0,0 +1,276 @@
+// Package credentials caches temporary AWS credentials on disk, so that roles are not
+// assumed again, and MFA codes not prompted for again, by subsequent eksctl commands
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/ssocreds"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	// EnableCacheEnvVar enables the cache when set to "true"
+	EnableCacheEnvVar = "EKSCTL_ENABLE_CREDENTIAL_CACHE"
+	// CacheDirEnvVar overrides the directory of the cache
+	CacheDirEnvVar = "EKSCTL_CREDENTIAL_CACHE_DIR"
+	// KeyFileEnvVar overrides the file of the key that the cache is encrypted with
+	KeyFileEnvVar = "EKSCTL_CREDENTIAL_CACHE_KEY_FILE"
+
+	// expiryWindow makes cached credentials expire early, so that they
+	// don't expire while a command runs
+	expiryWindow = 5 * time.Minute
+)
+
+// IsCacheEnabled tells whether the cache is enabled by the environment
+func IsCacheEnabled() bool {
+	return os.Getenv(EnableCacheEnvVar) == "true"
+}
+
+// DefaultCacheDir returns the directory of the cache, there is none when the home directory of the user
+// is unknown, as credentials are never cached in shared locations
+func DefaultCacheDir() (string, error) {
+	if dir := os.Getenv(CacheDirEnvVar); dir != "" {
+		return dir, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.Wrap(err, "finding the directory of the credentials cache")
+	}
+	return filepath.Join(home, ".eksctl", "cache", "credentials"), nil
+}
+
+// DefaultKeyFile returns the file of the key that the cache is encrypted with, it's kept outside of
+// the directory of the cache
+func DefaultKeyFile() (string, error) {
+	if keyFile := os.Getenv(KeyFileEnvVar); keyFile != "" {
+		return keyFile, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", errors.Wrap(err, "finding the key file of the credentials cache")
+	}
+	return filepath.Join(home, ".eksctl", "credential-cache.key"), nil
+}
+
+// FileCacheProvider retrieves credentials from the cache, and only asks the underlying credentials for new
+// ones once the cached ones expire; only temporary credentials of assumed roles and SSO are cached, encrypted
+// with AES-GCM with a key in a file that only the user can read, in a directory that only the user can access
+type FileCacheProvider struct {
+	dir       string
+	keyFile   string
+	cacheKey  string
+	creds     *credentials.Credentials
+	expiresAt time.Time
+}
+
+// NewFileCacheProvider creates a provider that caches credentials in dir, encrypted with the key in keyFile,
+// under a key that identifies the credentials, e.g. the profile and the assumed roles
+func NewFileCacheProvider(dir, keyFile, cacheKey string, creds *credentials.Credentials) *FileCacheProvider {
+	sum := sha256.Sum256([]byte(cacheKey))
+	return &FileCacheProvider{
+		dir:      dir,
+		keyFile:  keyFile,
+		cacheKey: hex.EncodeToString(sum[:]),
+		creds:    creds,
+	}
+}
+
+type cachedCredentials struct {
+	Value     credentials.Value `json:"value"`
+	ExpiresAt time.Time         `json:"expiresAt"`
+}
+
+// Retrieve returns cached credentials that are still valid, or new credentials
+func (p *FileCacheProvider) Retrieve() (credentials.Value, error) {
+	cached, err := p.load()
+	if err != nil {
+		logger.Debug("not using cached credentials: %s", err.Error())
+	} else if cached != nil && time.Now().Before(cached.ExpiresAt.Add(-expiryWindow)) {
+		logger.Debug("using cached credentials that expire at %s", cached.ExpiresAt)
+		p.expiresAt = cached.ExpiresAt
+		return cached.Value, nil
+	}
+
+	value, err := p.creds.Get()
+	if err != nil {
+		return value, err
+	}
+	p.expiresAt = time.Time{}
+	if value.ProviderName != stscreds.ProviderName && value.ProviderName != ssocreds.ProviderName {
+		// long-term credentials are never written to disk
+		return value, nil
+	}
+	expiresAt, err := p.creds.ExpiresAt()
+	if err != nil {
+		return value, nil
+	}
+	p.expiresAt = expiresAt
+	if err := p.save(&cachedCredentials{Value: value, ExpiresAt: expiresAt}); err != nil {
+		logger.Warning("failed to cache credentials: %s", err.Error())
+	}
+	return value, nil
+}
+
+// IsExpired tells whether the credentials have to be retrieved again
+func (p *FileCacheProvider) IsExpired() bool {
+	if p.expiresAt.IsZero() {
+		return p.creds.IsExpired()
+	}
+	return time.Now().After(p.expiresAt.Add(-expiryWindow))
+}
+
+// ExpiresAt returns when the credentials expire
+func (p *FileCacheProvider) ExpiresAt() time.Time {
+	return p.expiresAt
+}
+
+func (p *FileCacheProvider) path() string {
+	return filepath.Join(p.dir, p.cacheKey)
+}
+
+func (p *FileCacheProvider) load() (*cachedCredentials, error) {
+	data, err := ioutil.ReadFile(p.path())
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := ensurePrivateDir(p.dir); err != nil {
+		return nil, err
+	}
+	aead, err := p.cipher(false)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < aead.NonceSize() {
+		return nil, fmt.Errorf("cached credentials in %q are corrupt", p.path())
+	}
+	plaintext, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], []byte(p.cacheKey))
+	if err != nil {
+		return nil, errors.Wrapf(err, "decrypting cached credentials in %q", p.path())
+	}
+	cached := &cachedCredentials{}
+	if err := json.Unmarshal(plaintext, cached); err != nil {
+		return nil, errors.Wrapf(err, "reading cached credentials in %q", p.path())
+	}
+	return cached, nil
+}
+
+// save encrypts the credentials to a new file that only the user can read, and moves it in place,
+// so that files of the cache are never partially written, nor readable by others
+func (p *FileCacheProvider) save(cached *cachedCredentials) error {
+	if err := ensurePrivateDir(p.dir); err != nil {
+		return err
+	}
+	aead, err := p.cipher(true)
+	if err != nil {
+		return err
+	}
+	plaintext, err := json.Marshal(cached)
+	if err != nil {
+		return err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return err
+	}
+	data := aead.Seal(nonce, nonce, plaintext, []byte(p.cacheKey))
+	f, err := ioutil.TempFile(p.dir, p.cacheKey+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(f.Name())
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		return err
+	}
+	if err := f.Close(); err != nil {
+		return err
+	}
+	return os.Rename(f.Name(), p.path())
+}
+
+// cipher returns the AES-GCM cipher of the cache, creating its key if asked to; a key that
+// others can read isn't used
+func (p *FileCacheProvider) cipher(create bool) (cipher.AEAD, error) {
+	key, err := ioutil.ReadFile(p.keyFile)
+	switch {
+	case os.IsNotExist(err) && create:
+		if key, err = p.createKey(); err != nil {
+			return nil, errors.Wrapf(err, "creating key file %q", p.keyFile)
+		}
+	case err != nil:
+		return nil, err
+	default:
+		info, err := os.Stat(p.keyFile)
+		if err != nil {
+			return nil, err
+		}
+		if info.Mode().Perm()&0077 != 0 {
+			return nil, fmt.Errorf("key file %q must only be accessible by the user, but has mode %v", p.keyFile, info.Mode().Perm())
+		}
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, errors.Wrapf(err, "using key in %q", p.keyFile)
+	}
+	return cipher.NewGCM(block)
+}
+
+func (p *FileCacheProvider) createKey() ([]byte, error) {
+	if err := ensurePrivateDir(filepath.Dir(p.keyFile)); err != nil {
+		return nil, err
+	}
+	key := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, key); err != nil {
+		return nil, err
+	}
+	f, err := os.OpenFile(p.keyFile, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := f.Write(key); err != nil {
+		f.Close()
+		return nil, err
+	}
+	return key, f.Close()
+}
+
+// ensurePrivateDir creates dir, or makes an existing dir, only accessible by the user, and fails
+// when it can't
+func ensurePrivateDir(dir string) error {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return err
+	}
+	info, err := os.Stat(dir)
+	if err != nil {
+		return err
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%q is not a directory", dir)
+	}
+	if info.Mode().Perm() == 0700 {
+		return nil
+	}
+	if err := os.Chmod(dir, 0700); err != nil {
+		return errors.Wrapf(err, "making directory %q only accessible by the user", dir)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package credentials_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/credentials"
+)
+
+type fakeProvider struct {
+	name      string
+	expiresAt time.Time
+	calls     int
+}
+
+func (p *fakeProvider) Retrieve() (credentials.Value, error) {
+	p.calls++
+	return credentials.Value{AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "token", ProviderName: p.name}, nil
+}
+
+func (p *fakeProvider) IsExpired() bool { return p.calls == 0 }
+
+func (p *fakeProvider) ExpiresAt() time.Time { return p.expiresAt }
+
+var _ = Describe("credentials file cache", func() {
+	var dir, keyFile string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "eksctl-credentials")
+		Expect(err).NotTo(HaveOccurred())
+		keyDir, err := ioutil.TempDir("", "eksctl-credentials-key")
+		Expect(err).NotTo(HaveOccurred())
+		keyFile = filepath.Join(keyDir, "key")
+	})
+
+	AfterEach(func() {
+		Expect(os.RemoveAll(dir)).To(Succeed())
+		Expect(os.RemoveAll(filepath.Dir(keyFile))).To(Succeed())
+	})
+
+	get := func(cacheKey string, p *fakeProvider) credentials.Value {
+		value, err := credentials.NewCredentials(NewFileCacheProvider(dir, keyFile, cacheKey, credentials.NewCredentials(p))).Get()
+		Expect(err).NotTo(HaveOccurred())
+		return value
+	}
+
+	It("reuses cached credentials of assumed roles across providers", func() {
+		p := &fakeProvider{name: stscreds.ProviderName, expiresAt: time.Now().Add(time.Hour)}
+		Expect(get("profile\nrole", p).AccessKeyID).To(Equal("AKID"))
+		Expect(get("profile\nrole", p).SessionToken).To(Equal("token"))
+		Expect(p.calls).To(Equal(1))
+
+		Expect(get("profile\nother-role", p).AccessKeyID).To(Equal("AKID"))
+		Expect(p.calls).To(Equal(2))
+
+		files, err := filepath.Glob(filepath.Join(dir, "*"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(2))
+		for _, f := range files {
+			info, err := os.Stat(f)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+			data, err := ioutil.ReadFile(f)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(data)).NotTo(ContainSubstring("secret"))
+			Expect(string(data)).NotTo(ContainSubstring("token"))
+		}
+
+		info, err := os.Stat(keyFile)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0600)))
+	})
+
+	It("keeps the cache in a directory that only the user can access", func() {
+		cacheDir := filepath.Join(dir, "cache")
+		p := &fakeProvider{name: stscreds.ProviderName, expiresAt: time.Now().Add(time.Hour)}
+		_, err := credentials.NewCredentials(NewFileCacheProvider(cacheDir, keyFile, "profile\nrole", credentials.NewCredentials(p))).Get()
+		Expect(err).NotTo(HaveOccurred())
+
+		info, err := os.Stat(cacheDir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0700)))
+	})
+
+	It("makes an existing directory of the cache only accessible by the user", func() {
+		Expect(os.Chmod(dir, 0755)).To(Succeed())
+		p := &fakeProvider{name: stscreds.ProviderName, expiresAt: time.Now().Add(time.Hour)}
+		get("profile\nrole", p)
+
+		info, err := os.Stat(dir)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(info.Mode().Perm()).To(Equal(os.FileMode(0700)))
+	})
+
+	It("doesn't use a key that others can read", func() {
+		p := &fakeProvider{name: stscreds.ProviderName, expiresAt: time.Now().Add(time.Hour)}
+		get("profile\nrole", p)
+		Expect(os.Chmod(keyFile, 0644)).To(Succeed())
+		get("profile\nrole", p)
+		Expect(p.calls).To(Equal(2))
+	})
+
+	It("doesn't reuse credentials that were encrypted with another key", func() {
+		p := &fakeProvider{name: stscreds.ProviderName, expiresAt: time.Now().Add(time.Hour)}
+		get("profile\nrole", p)
+		Expect(ioutil.WriteFile(keyFile, make([]byte, 32), 0600)).To(Succeed())
+		get("profile\nrole", p)
+		Expect(p.calls).To(Equal(2))
+	})
+
+	It("doesn't reuse credentials that are about to expire", func() {
+		p := &fakeProvider{name: stscreds.ProviderName, expiresAt: time.Now().Add(time.Minute)}
+		get("profile\nrole", p)
+		get("profile\nrole", p)
+		Expect(p.calls).To(Equal(2))
+	})
+
+	It("never caches long-term credentials", func() {
+		p := &fakeProvider{name: credentials.StaticProviderName}
+		get("profile", p)
+		get("profile", p)
+		Expect(p.calls).To(Equal(2))
+		files, err := filepath.Glob(filepath.Join(dir, "*"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(BeEmpty())
+	})
+
+	It("ignores cached credentials that can't be read", func() {
+		p := &fakeProvider{name: stscreds.ProviderName, expiresAt: time.Now().Add(time.Hour)}
+		get("profile\nrole", p)
+		files, err := filepath.Glob(filepath.Join(dir, "*"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(files).To(HaveLen(1))
+		Expect(ioutil.WriteFile(files[0], []byte("corrupt"), 0600)).To(Succeed())
+		get("profile\nrole", p)
+		Expect(p.calls).To(Equal(2))
+	})
+})
@@ -0,0 +1,182 @@
+package apply
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/ssh"
+)
+
+// Command will create the `apply` command
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	return cmdutils.NewCmd(flagGrouping, applyCmd)
+}
+
+func applyCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	var prune bool
+
+	cmd.SetDescription("apply", "Reconcile a cluster with its config file",
+		"Compares the config file with the live cluster and creates the nodegroups and IAM service accounts that are missing, "+
+			"updates CloudWatch logging and cluster tags; resources that are not in the config file are only deleted, and log types only disabled, with --prune")
+
+	cmd.SetRunFunc(func() error {
+		return doApply(cmd, prune)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddApproveFlag(fs, cmd)
+		fs.BoolVar(&prune, "prune", false, "delete nodegroups and IAM service accounts that eksctl created and that are not in the config file, and disable log types that aren't enabled in it")
+		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude, &cmd.LabelSelector)
+		cmdutils.AddNotifyURLFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddAMIResolverFlags(fs, cmd.ProviderConfig)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doApply(cmd *cmdutils.Cmd, prune bool) error {
+	if err := cmdutils.NewApplyLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	if err := ctl.LoadClusterVPC(cfg); err != nil {
+		return errors.Wrapf(err, "getting VPC configuration for cluster %q", meta.Name)
+	}
+
+	logger.Info("comparing the given config (%q) against the state of cluster %q", cmd.ClusterConfigFile, meta.Name)
+	diff, err := ctl.DiffClusterConfig(cfg)
+	if err != nil {
+		return err
+	}
+	stackManager := ctl.NewStackManager(cfg)
+	if cmdutils.HasNodeGroupFilters(cmd) {
+		// only the nodegroups that the filters select are created or deleted
+		names := append([]string{}, diff.NodeGroupsToDelete...)
+		names = append(names, diff.ManagedNodeGroupsToDelete...)
+		for _, ng := range cfg.NodeGroups {
+			names = append(names, ng.Name)
+		}
+		for _, ng := range cfg.ManagedNodeGroups {
+			names = append(names, ng.Name)
+		}
+		ngFilter, err := cmdutils.NewNodeGroupFilterFromFlags(cmd, names)
+		if err != nil {
+			return err
+		}
+		knownLabels, err := ngFilter.ExistingNodeGroupLabels(stackManager, diff.NodeGroupsToDelete, append(append([]string{}, diff.ManagedNodeGroupsToDelete...), diff.UnownedManagedNodeGroups...))
+		if err != nil {
+			return err
+		}
+		diff.FilterNodeGroups(ngFilter.MatchNodeGroup, func(name string) bool {
+			return ngFilter.MatchExistingNodeGroup(name, knownLabels)
+		})
+	}
+	for _, line := range diff.Describe(prune) {
+		logger.Info(line)
+	}
+	if !diff.HasChanges(prune) {
+		logger.Info("cluster %q is up to date with the given config", meta.Name)
+		return nil
+	}
+
+	for _, ng := range diff.NodeGroupsToCreate {
+		// new nodegroups always follow the version of the control plane
+		if err := ctl.EnsureAMI(ctl.ControlPlaneVersion(), ng); err != nil {
+			return err
+		}
+		if err := ctl.SetNodeLabels(ng, meta); err != nil {
+			return err
+		}
+		if err := ssh.LoadKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
+			return err
+		}
+	}
+	for _, ng := range diff.ManagedNodeGroupsToCreate {
+		if err := ssh.LoadKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
+			return err
+		}
+	}
+
+	tasks, err := ctl.NewTasksToApplyClusterConfig(cfg, diff, prune)
+	if err != nil {
+		return err
+	}
+	tasks.PlanMode = cmd.Plan
+
+	if prune && len(diff.NodeGroupsToDelete) > 0 {
+		cmdutils.LogIntendedAction(cmd.Plan, "remove %d nodegroups from auth ConfigMap and drain them", len(diff.NodeGroupsToDelete))
+		if !cmd.Plan {
+			clientSet, err := ctl.NewStdClientSet(cfg)
+			if err != nil {
+				return err
+			}
+			for _, name := range diff.NodeGroupsToDelete {
+				ng := &api.NodeGroup{Name: name}
+				if err := ctl.GetNodeGroupIAM(stackManager, cfg, ng); err != nil {
+					logger.Warning("error getting instance role ARN for nodegroup %q", ng.Name)
+				} else if err := authconfigmap.RemoveNodeGroup(clientSet, ng); err != nil {
+					logger.Warning(err.Error())
+				}
+				if err := drain.NodeGroup(clientSet, ng, ctl.Provider.WaitTimeout(), false); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while applying the config, you may wish to check CloudFormation console", len(errs))
+		cmdutils.LogTaskErrors(cmd, stackManager, errs)
+		return fmt.Errorf("failed to apply the config to cluster %q", meta.Name)
+	}
+
+	if !cmd.Plan && len(diff.NodeGroupsToCreate) > 0 {
+		clientSet, err := ctl.NewStdClientSet(cfg)
+		if err != nil {
+			return err
+		}
+		for _, ng := range diff.NodeGroupsToCreate {
+			if err := authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
+				return err
+			}
+			if err := ctl.WaitForNodes(clientSet, ng); err != nil {
+				return err
+			}
+		}
+	}
+
+	cmdutils.LogCompletedAction(cmd.Plan, "applied the config to cluster %q", meta.Name)
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
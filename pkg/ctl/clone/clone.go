@@ -0,0 +1,17 @@
+package clone
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/create"
+)
+
+// Command will create the `clone` commands
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("clone", "Clone resource(s)", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, create.CloneClusterCmd)
+
+	return verbCmd
+}
@@ -0,0 +1,53 @@
+package cmdutils
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// AccessEntryOptions holds the flags of access entry commands
+type AccessEntryOptions struct {
+	PrincipalARN       string
+	Type               string
+	KubernetesUsername string
+	KubernetesGroups   []string
+	AccessPolicyARNs   []string
+	Namespaces         []string
+}
+
+// AddAccessEntryFlags adds flags that configure an access entry, for 'eksctl create accessentry'
+func AddAccessEntryFlags(fs *pflag.FlagSet, options *AccessEntryOptions) {
+	AddAccessEntryPrincipalFlag(fs, options)
+	fs.StringVar(&options.Type, "type", "", "type of the access entry, one of STANDARD, EC2_LINUX, EC2_WINDOWS or FARGATE_LINUX (default STANDARD)")
+	fs.StringVar(&options.KubernetesUsername, "kubernetes-username", "", "username within Kubernetes")
+	fs.StringSliceVar(&options.KubernetesGroups, "kubernetes-groups", nil, "groups within Kubernetes, used in RBAC bindings")
+	fs.StringSliceVar(&options.AccessPolicyARNs, "access-policy-arns", nil, "ARNs of EKS access policies to associate, e.g. arn:aws:eks::aws:cluster-access-policy/AmazonEKSViewPolicy")
+	fs.StringSliceVar(&options.Namespaces, "namespaces", nil, "limit the access policies to the given namespaces, instead of the whole cluster")
+}
+
+// AddAccessEntryPrincipalFlag adds the --principal-arn flag
+func AddAccessEntryPrincipalFlag(fs *pflag.FlagSet, options *AccessEntryOptions) {
+	fs.StringVar(&options.PrincipalARN, "principal-arn", "", "ARN of the IAM role or user")
+}
+
+// ToAccessEntry creates an access entry from the options
+func (o *AccessEntryOptions) ToAccessEntry() *api.AccessEntry {
+	entry := &api.AccessEntry{
+		PrincipalARN:       o.PrincipalARN,
+		Type:               o.Type,
+		KubernetesUsername: o.KubernetesUsername,
+		KubernetesGroups:   o.KubernetesGroups,
+	}
+	scope := api.AccessScope{Type: api.AccessScopeCluster}
+	if len(o.Namespaces) > 0 {
+		scope = api.AccessScope{Type: api.AccessScopeNamespace, Namespaces: o.Namespaces}
+	}
+	for _, policyARN := range o.AccessPolicyARNs {
+		entry.AccessPolicies = append(entry.AccessPolicies, api.AccessPolicy{
+			PolicyARN:   policyARN,
+			AccessScope: scope,
+		})
+	}
+	return entry
+}
@@ -0,0 +1,16 @@
+package cmdutils
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// AddEKSAddonFlags adds flags that configure an EKS addon, for 'eksctl create addon' and 'eksctl update addon'
+func AddEKSAddonFlags(fs *pflag.FlagSet, addon *api.Addon) {
+	fs.StringVar(&addon.Name, "name", "", "name of the addon, e.g. vpc-cni, coredns or kube-proxy")
+	fs.StringVar(&addon.Version, "version", "", "version of the addon, defaults to the version picked by EKS for the cluster")
+	fs.StringVar(&addon.ServiceAccountRoleARN, "service-account-role-arn", "", "ARN of an existing IAM role for the service account of the addon")
+	fs.StringSliceVar(&addon.AttachPolicyARNs, "attach-policy-arn", []string{}, "ARN of the policy to attach to an IAM role created for the service account of the addon")
+	fs.StringVar(&addon.ResolveConflicts, "resolve-conflicts", "", "how to handle fields of the addon that were changed in the cluster, one of: none, overwrite, preserve")
+}
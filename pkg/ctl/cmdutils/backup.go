@@ -0,0 +1,85 @@
+package cmdutils
+
+import (
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/backup"
+	"github.com/weaveworks/eksctl/pkg/eks"
+)
+
+// mutatingVerbs are the commands that change the stacks or the aws-auth ConfigMap of a cluster,
+// a snapshot of the cluster is taken before they run
+var mutatingVerbs = sets.NewString(
+	"apply",
+	"create",
+	"delete",
+	"enable",
+	"install",
+	"register",
+	"replace",
+	"scale",
+	"update",
+	"upgrade",
+)
+
+// mutatingUtilsCommands are the `eksctl utils` commands that change the stacks, the add-ons or the
+// aws-auth ConfigMap of a cluster
+var mutatingUtilsCommands = sets.NewString(
+	"associate-iam-oidc-provider",
+	"install-vpc-controllers",
+	"migrate-to-access-entry",
+	"migrate-to-pod-identity",
+	"repair-aws-auth",
+	"update-aws-node",
+	"update-cluster-logging",
+	"update-coredns",
+	"update-kube-proxy",
+)
+
+// verb returns the name of the verb command, e.g. "create" for `eksctl create nodegroup`
+// and "apply" for `eksctl apply`
+func (c *Cmd) verb() string {
+	parent := c.CobraCommand.Parent()
+	if parent == nil || !parent.HasParent() {
+		return c.CobraCommand.Name()
+	}
+	return parent.Name()
+}
+
+// isMutating checks if the command changes the cluster
+func (c *Cmd) isMutating() bool {
+	if c.verb() == "utils" {
+		return mutatingUtilsCommands.Has(c.CobraCommand.Name())
+	}
+	return mutatingVerbs.Has(c.verb())
+}
+
+// takeSnapshot writes a snapshot of the cluster to S3 before a mutating command changes it,
+// when the config or the environment sets a bucket for snapshots
+func (c *Cmd) takeSnapshot(ctl *eks.ClusterProvider) error {
+	cfg := c.ClusterConfig
+	if !c.isMutating() || cfg.Metadata.Name == "" || backup.LocationFor(cfg) == nil {
+		return nil
+	}
+	if c.CobraCommand.Flag("approve") != nil && c.Plan {
+		// nothing is changed in plan mode
+		return nil
+	}
+
+	newClientSet := func() (kubernetes.Interface, error) {
+		// the status is refreshed on a copy, commands expect to find the config as it was given
+		spec := cfg.DeepCopy()
+		if err := ctl.RefreshClusterStatus(spec); err != nil {
+			return nil, err
+		}
+		return ctl.NewStdClientSet(spec)
+	}
+	if _, err := backup.Take(ctl.Provider, ctl.NewStackManager(cfg), cfg, newClientSet, time.Now()); err != nil {
+		return errors.Wrapf(err, "taking snapshot of cluster %q before changing it, unset backup.s3Bucket and %s to skip snapshots", cfg.Metadata.Name, backup.BucketEnv)
+	}
+	return nil
+}
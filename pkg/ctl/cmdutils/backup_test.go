@@ -0,0 +1,32 @@
+package cmdutils
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+)
+
+var _ = Describe("snapshots before commands", func() {
+	newCmd := func(verb, name string) *Cmd {
+		root := &cobra.Command{Use: "eksctl"}
+		verbCmd := &cobra.Command{Use: verb}
+		root.AddCommand(verbCmd)
+		cmd := &Cmd{CobraCommand: &cobra.Command{Use: name}}
+		verbCmd.AddCommand(cmd.CobraCommand)
+		return cmd
+	}
+
+	It("takes snapshots before commands that change the cluster", func() {
+		Expect(newCmd("create", "nodegroup").isMutating()).To(BeTrue())
+		Expect(newCmd("scale", "nodegroup").isMutating()).To(BeTrue())
+		Expect(newCmd("get", "nodegroup").isMutating()).To(BeFalse())
+	})
+
+	It("takes snapshots before utils commands that change the cluster", func() {
+		Expect(newCmd("utils", "update-coredns").isMutating()).To(BeTrue())
+		Expect(newCmd("utils", "repair-aws-auth").isMutating()).To(BeTrue())
+		Expect(newCmd("utils", "migrate-to-access-entry").isMutating()).To(BeTrue())
+		Expect(newCmd("utils", "write-kubeconfig").isMutating()).To(BeFalse())
+		Expect(newCmd("utils", "describe-stacks").isMutating()).To(BeFalse())
+	})
+})
@@ -1,13 +1,21 @@
 package cmdutils
 
 import (
+	"context"
 	"os"
+	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 
+	"github.com/weaveworks/eksctl/pkg/ami"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/audit"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/metrics"
+	"github.com/weaveworks/eksctl/pkg/notify"
+	"github.com/weaveworks/eksctl/pkg/utils/httpclient"
 )
 
 // Cmd holds attributes that are common between commands;
@@ -17,15 +25,32 @@ type Cmd struct {
 	FlagSetGroup *NamedFlagSetGroup
 
 	Plan, Wait, Validate bool
+	// Yes skips the confirmation of destructive operations
+	Yes bool
 
 	NameArg string
 
-	ClusterConfigFile string
+	ClusterConfigFile    string
+	LenientInterpolation bool
+	ConfigOverrides      []string
+	// ClusterConfigDocument is set when the config file defines multiple clusters,
+	// and the command runs for one of them
+	ClusterConfigDocument []byte
 
 	ProviderConfig *api.ProviderConfig
 	ClusterConfig  *api.ClusterConfig
 
 	Include, Exclude []string
+	// LabelSelector selects nodegroups by their labels, along with Include and Exclude
+	LabelSelector string
+
+	// NotifyURL is posted to when the command starts running its tasks, and when it finishes or fails
+	NotifyURL string
+
+	// ctl is the last provider that NewCtl returned, the audit log looks up the caller with it
+	ctl *eks.ClusterProvider
+	// ctx is cancelled when the command is interrupted twice, the providers that NewCtl returns use it
+	ctx context.Context
 }
 
 // NewCtl performs common defaulting and validation and constructs a new
@@ -53,17 +78,67 @@ func (c *Cmd) NewCtl() (*eks.ClusterProvider, error) {
 		api.SetNodeGroupDefaults(i, ng)
 	}
 
-	ctl := eks.New(c.ProviderConfig, c.ClusterConfig)
+	for i, ng := range c.ClusterConfig.ManagedNodeGroups {
+		if err := api.ValidateManagedNodeGroup(i, ng); err != nil {
+			if c.Validate {
+				return nil, err
+			}
+			logger.Warning("ignoring validation error: %s", err.Error())
+		}
+		api.SetManagedNodeGroupDefaults(i, ng)
+	}
+
+	if e := c.ClusterConfig.AWSEndpoints; e != nil {
+		c.ProviderConfig.Endpoints = e.Services
+		c.ProviderConfig.STSRegionalEndpoint = api.IsEnabled(e.STSRegional)
+	}
+	if c.ClusterConfig.UseFIPSEndpoints() {
+		c.ProviderConfig.UseFIPSEndpoints = true
+	}
+
+	if err := validateAssumeRoleFlags(c.ProviderConfig); err != nil {
+		return nil, err
+	}
+	if err := validateRetryFlags(c.ProviderConfig); err != nil {
+		return nil, err
+	}
+	if err := httpclient.LoadCABundle(c.ProviderConfig.CABundle); err != nil {
+		return nil, err
+	}
+	if err := validateAMIResolverFlag(c.ProviderConfig); err != nil {
+		return nil, err
+	}
+	if err := ami.LoadStaticManifest(c.ProviderConfig.AMIManifest); err != nil {
+		return nil, err
+	}
+
+	ctl := eks.New(c.ProviderConfig, c.ClusterConfig).WithContext(c.ctx).WithAMIResolver(c.ProviderConfig.AMIResolver)
 
 	if !ctl.IsSupportedRegion() {
 		return nil, ErrUnsupportedRegion(c.ProviderConfig)
 	}
 
+	if c.ProviderConfig.UseFIPSEndpoints {
+		if err := api.ValidateFIPSEndpoints(ctl.Provider.Region(), c.ClusterConfig.AWSEndpoints); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := c.takeSnapshot(ctl); err != nil {
+		return nil, err
+	}
+
+	c.ctl = ctl
 	return ctl, nil
 }
 
 // AddResourceCmd create a registers a new command under the given verb command
 func AddResourceCmd(flagGrouping *FlagGrouping, parentVerbCmd *cobra.Command, newCmd func(*Cmd)) {
+	parentVerbCmd.AddCommand(NewCmd(flagGrouping, newCmd))
+}
+
+// NewCmd creates a command that operates without a resource, e.g. `eksctl apply`
+func NewCmd(flagGrouping *FlagGrouping, newCmd func(*Cmd)) *cobra.Command {
 	c := &Cmd{
 		CobraCommand:   &cobra.Command{},
 		ProviderConfig: &api.ProviderConfig{},
@@ -75,7 +150,7 @@ func AddResourceCmd(flagGrouping *FlagGrouping, parentVerbCmd *cobra.Command, ne
 	c.FlagSetGroup = flagGrouping.New(c.CobraCommand)
 	newCmd(c)
 	c.FlagSetGroup.AddTo(c.CobraCommand)
-	parentVerbCmd.AddCommand(c.CobraCommand)
+	return c.CobraCommand
 }
 
 // SetDescription sets usage along with short and long descriptions as well as aliases
@@ -89,7 +164,7 @@ func (c *Cmd) SetDescription(use, short, long string, aliases ...string) {
 // SetRunFunc registers a command function
 func (c *Cmd) SetRunFunc(cmd func() error) {
 	c.CobraCommand.Run = func(_ *cobra.Command, _ []string) {
-		run(cmd)
+		c.run(cmd)
 	}
 }
 
@@ -97,12 +172,94 @@ func (c *Cmd) SetRunFunc(cmd func() error) {
 func (c *Cmd) SetRunFuncWithNameArg(cmd func() error) {
 	c.CobraCommand.Run = func(_ *cobra.Command, args []string) {
 		c.NameArg = GetNameArg(args)
-		run(cmd)
+		c.run(cmd)
+	}
+}
+
+// run runs the command and records it in the audit log, when the environment configures one,
+// metrics are served while it runs when the environment configures an address for them, and an
+// event is sent when a major operation finishes if the environment configures an event bus;
+// when it's interrupted, no new tasks are started and a summary of how to resume is logged;
+// the progress of the tasks it runs is posted to the webhook it's configured with, if any
+func (c *Cmd) run(cmd func() error) {
+	if c.NotifyURL != "" {
+		exitOnError(api.ValidateWebhookURL("--notify-url", c.NotifyURL))
+	}
+	stopHandlingInterrupts := c.handleInterrupts()
+	defer stopHandlingInterrupts()
+	webhook := notify.NewWebhook(c.CobraCommand.CommandPath(), c.webhookTarget)
+	runCmd := cmd
+	cmd = func() error {
+		manager.SetTaskObserver(webhook)
+		err := runCmd()
+		manager.SetTaskObserver(nil)
+		c.logResumeSummary()
+		if notifyErr := webhook.Finish(err); notifyErr != nil {
+			logger.Warning("posting to webhook: %s", notifyErr.Error())
+		}
+		return err
+	}
+
+	if server := metrics.NewServerFromEnv(); server != nil {
+		if err := server.Start(); err != nil {
+			logger.Warning("serving metrics: %s", err.Error())
+		} else {
+			defer server.Stop()
+		}
+	}
+
+	journal := audit.NewJournalFromEnv()
+	notifier := notify.NewNotifierFromEnv(c.CobraCommand.CommandPath())
+	if journal == nil && notifier == nil {
+		exitOnError(cmd())
+		return
+	}
+
+	entry := audit.NewEntry(c.CobraCommand.CommandPath(), os.Args[1:], time.Now())
+	manager.TaskResultHandler = entry.AddTask
+	err := cmd()
+	manager.TaskResultHandler = nil
+
+	if hashErr := entry.Finish(c.ClusterConfig, err, time.Now()); hashErr != nil {
+		logger.Warning("recording config in audit log: %s", hashErr.Error())
+	}
+	if entry.Region == "" {
+		entry.Region = c.ProviderConfig.Region
+	}
+	var provider api.ClusterProvider
+	if c.ctl != nil {
+		provider = c.ctl.Provider
+	}
+	if journal != nil {
+		if writeErr := journal.Write(provider, entry); writeErr != nil {
+			logger.Warning("writing audit log: %s", writeErr.Error())
+		}
+	}
+	// nothing was changed when the command only planned its changes
+	planned := c.CobraCommand.Flags().Lookup("approve") != nil && c.Plan
+	if notifier != nil && !planned {
+		if sendErr := notifier.Send(provider, entry); sendErr != nil {
+			logger.Warning("sending notification: %s", sendErr.Error())
+		}
+	}
+	exitOnError(err)
+}
+
+// webhookTarget returns the URL that the progress of the command is posted to, --notify-url takes
+// precedence over the config, and the config of the cluster
+func (c *Cmd) webhookTarget() (string, *api.ClusterConfig) {
+	cfg := c.ClusterConfig
+	if c.NotifyURL != "" {
+		return c.NotifyURL, cfg
+	}
+	if cfg != nil && cfg.Notifications != nil {
+		return cfg.Notifications.WebhookURL, cfg
 	}
+	return "", cfg
 }
 
-func run(cmd func() error) {
-	if err := cmd(); err != nil {
+func exitOnError(err error) {
+	if err != nil {
 		logger.Critical("%s\n", err.Error())
 		os.Exit(1)
 	}
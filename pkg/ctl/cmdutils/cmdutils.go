@@ -6,10 +6,10 @@ import (
 	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 )
 
@@ -77,6 +77,11 @@ func AddApproveFlag(fs *pflag.FlagSet, cmd *Cmd) {
 	})
 }
 
+// AddNotifyURLFlag adds common `--notify-url` flag
+func AddNotifyURLFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.StringVar(&cmd.NotifyURL, "notify-url", "", "URL of a webhook, e.g. a Slack incoming webhook, that is posted to when the operation starts, finishes or fails; overrides notifications.webhookURL")
+}
+
 // GetNameArg tests to ensure there is only 1 name argument
 func GetNameArg(args []string) string {
 	if len(args) > 1 {
@@ -102,9 +107,48 @@ func AddCommonFlagsForAWS(group *NamedFlagSetGroup, p *api.ProviderConfig, cfnRo
 		if cfnRole {
 			fs.StringVar(&p.CloudFormationRoleARN, "cfn-role-arn", "", "IAM role used by CloudFormation to call AWS API on your behalf")
 		}
+		fs.StringSliceVar(&p.AssumeRoleARNs, "assume-role-arn", nil, "IAM role to assume for all AWS API calls; repeat to chain roles, each is assumed with the credentials of the previous one")
+		fs.StringVar(&p.AssumeRoleExternalID, "assume-role-external-id", "", "external ID to pass when assuming roles")
+		fs.StringToStringVar(&p.AssumeRoleSessionTags, "assume-role-session-tags", nil, "session tags to pass when assuming the first role, e.g. team=platform; they are transitive, so they are kept for chained roles")
+		fs.StringVar(&p.MFASerial, "mfa-serial", "", "serial number or ARN of the MFA device used to assume the first role, the code is prompted for")
+		fs.BoolVar(&p.NoCache, "no-cache", false, "describe CloudFormation stacks every time they are looked up, rather than once until they change")
+		p.MaxRetries = new(int)
+		fs.IntVar(p.MaxRetries, "aws-max-retries", api.DefaultMaxRetries, "maximum number of times AWS API calls are retried, 0 disables retries")
+		fs.StringVar(&p.RetryMode, "aws-retry-mode", api.RetryModeStandard, fmt.Sprintf("how AWS API calls are retried, %q or %q to also slow down calls to a service that throttles them", api.RetryModeStandard, api.RetryModeAdaptive))
+		fs.StringVar(&p.CABundle, "ca-bundle", "", "PEM bundle of CAs to trust along with those of the system, e.g. of a TLS-intercepting proxy (defaults to AWS_CA_BUNDLE)")
+		fs.BoolVar(&p.UseFIPSEndpoints, "fips", false, "call AWS APIs at their FIPS endpoints where they have one, e.g. for FedRAMP workloads")
 	})
 }
 
+// validateAssumeRoleFlags checks that the options of assumed roles are only given along with roles
+func validateAssumeRoleFlags(p *api.ProviderConfig) error {
+	if len(p.AssumeRoleARNs) > 0 {
+		return nil
+	}
+	switch {
+	case p.AssumeRoleExternalID != "":
+		return fmt.Errorf("--assume-role-external-id can only be used with --assume-role-arn")
+	case len(p.AssumeRoleSessionTags) > 0:
+		return fmt.Errorf("--assume-role-session-tags can only be used with --assume-role-arn")
+	case p.MFASerial != "":
+		return fmt.Errorf("--mfa-serial can only be used with --assume-role-arn")
+	}
+	return nil
+}
+
+// validateRetryFlags checks the retry options of AWS API calls
+func validateRetryFlags(p *api.ProviderConfig) error {
+	switch p.RetryMode {
+	case "", api.RetryModeStandard, api.RetryModeAdaptive:
+	default:
+		return fmt.Errorf("--aws-retry-mode must be %q or %q, not %q", api.RetryModeStandard, api.RetryModeAdaptive, p.RetryMode)
+	}
+	if p.MaxRetries != nil && *p.MaxRetries < 0 {
+		return fmt.Errorf("--aws-max-retries must not be negative")
+	}
+	return nil
+}
+
 // AddTimeoutFlagWithValue configures the timeout flag with the provided value.
 func AddTimeoutFlagWithValue(fs *pflag.FlagSet, p *time.Duration, value time.Duration) {
 	fs.DurationVar(p, "timeout", value, "Maximum waiting time for any long-running operation")
@@ -144,10 +188,26 @@ func AddUpdateAuthConfigMap(fs *pflag.FlagSet, updateAuthConfigMap *bool, descri
 	fs.BoolVar(updateAuthConfigMap, "update-auth-configmap", true, description)
 }
 
+// AddRequestQuotaIncreasesFlag adds the `--request-quota-increases` flag, which requests increases
+// of the service quotas that are too low to create a cluster or nodegroups
+func AddRequestQuotaIncreasesFlag(fs *pflag.FlagSet, requestQuotaIncreases *bool) {
+	fs.BoolVar(requestQuotaIncreases, "request-quota-increases", false, "request increases of service quotas that are too low, instead of only reporting them")
+}
+
+// AddWaitNodesFlag adds the `--wait-nodes` flag, which waits for the nodes of new nodegroups
+// to join the cluster and become ready
+func AddWaitNodesFlag(fs *pflag.FlagSet, waitNodes *bool) {
+	fs.BoolVar(waitNodes, "wait-nodes", true, "wait for the nodes of each nodegroup to join the cluster and become ready, and report why they haven't when timing out")
+}
+
 // AddCommonFlagsForKubeconfig adds common flags for controlling how output kubeconfig is written
-func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath, authenticatorRoleARN *string, setContext, autoPath *bool, exampleName string) {
+func AddCommonFlagsForKubeconfig(fs *pflag.FlagSet, outputPath *string, execOpts *kubeconfig.ExecOptions, setContext, autoPath *bool, exampleName string) {
 	fs.StringVar(outputPath, "kubeconfig", kubeconfig.DefaultPath, "path to write kubeconfig (incompatible with --auto-kubeconfig)")
-	fs.StringVar(authenticatorRoleARN, "authenticator-role-arn", "", "AWS IAM role to assume for authenticator")
+	fs.StringVar(&execOpts.Authenticator, "authenticator", "", fmt.Sprintf("command that kubectl gets tokens with, one of %v (default is the first one found in PATH)", kubeconfig.AuthenticatorCommands()))
+	fs.StringVar(&execOpts.RoleARN, "authenticator-role-arn", "", "AWS IAM role to assume for authenticator")
+	fs.StringVar(&execOpts.SessionName, "authenticator-session-name", "", "session name of the role assumed by authenticator (only supported by aws-iam-authenticator)")
+	fs.StringSliceVar(&execOpts.Env, "authenticator-env", nil, "environment variables to set for authenticator, as NAME=VALUE or NAME to pass the current value through")
+	fs.StringVar(&execOpts.ContextName, "kubeconfig-context-name", "", "template of the name of the context, with {{.ClusterName}}, {{.Region}} and {{.Username}} (default is \"<username>@<cluster>.<region>.eksctl.io\")")
 	fs.BoolVar(setContext, "set-kubeconfig-context", true, "if true then current-context will be set in kubeconfig; if a context is already set then it will be overwritten")
 	fs.BoolVar(autoPath, "auto-kubeconfig", false, fmt.Sprintf("save kubeconfig file by cluster name, e.g. %q", kubeconfig.AutoPath(exampleName)))
 }
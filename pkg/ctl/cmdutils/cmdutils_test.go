@@ -0,0 +1,38 @@
+package cmdutils
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("AWS client flags", func() {
+	It("requires roles for options of assumed roles", func() {
+		Expect(validateAssumeRoleFlags(&api.ProviderConfig{})).To(Succeed())
+		Expect(validateAssumeRoleFlags(&api.ProviderConfig{AssumeRoleExternalID: "id"})).To(MatchError("--assume-role-external-id can only be used with --assume-role-arn"))
+		Expect(validateAssumeRoleFlags(&api.ProviderConfig{AssumeRoleSessionTags: map[string]string{"team": "platform"}})).To(MatchError("--assume-role-session-tags can only be used with --assume-role-arn"))
+		Expect(validateAssumeRoleFlags(&api.ProviderConfig{MFASerial: "arn:aws:iam::123456789012:mfa/user"})).To(MatchError("--mfa-serial can only be used with --assume-role-arn"))
+
+		Expect(validateAssumeRoleFlags(&api.ProviderConfig{
+			AssumeRoleARNs:       []string{"arn:aws:iam::123456789012:role/a", "arn:aws:iam::210987654321:role/b"},
+			AssumeRoleExternalID: "id",
+			MFASerial:            "arn:aws:iam::123456789012:mfa/user",
+		})).To(Succeed())
+	})
+
+	It("validates retry options", func() {
+		Expect(validateRetryFlags(&api.ProviderConfig{})).To(Succeed())
+		Expect(validateRetryFlags(&api.ProviderConfig{RetryMode: api.RetryModeAdaptive, MaxRetries: aws.Int(20)})).To(Succeed())
+		Expect(validateRetryFlags(&api.ProviderConfig{MaxRetries: aws.Int(0)})).To(Succeed())
+		Expect(validateRetryFlags(&api.ProviderConfig{RetryMode: "legacy"})).To(MatchError(`--aws-retry-mode must be "standard" or "adaptive", not "legacy"`))
+		Expect(validateRetryFlags(&api.ProviderConfig{MaxRetries: aws.Int(-1)})).To(MatchError("--aws-max-retries must not be negative"))
+	})
+
+	It("validates the AMI resolver", func() {
+		Expect(validateAMIResolverFlag(&api.ProviderConfig{})).To(Succeed())
+		Expect(validateAMIResolverFlag(&api.ProviderConfig{AMIResolver: "static"})).To(Succeed())
+		Expect(validateAMIResolverFlag(&api.ProviderConfig{AMIResolver: "ssm"})).To(MatchError(`--ami-resolver must be "static" or "auto", not "ssm"`))
+	})
+})
@@ -1,18 +1,93 @@
 package cmdutils
 
 import (
+	"bytes"
 	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
 
+	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"sigs.k8s.io/yaml"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
-// AddConfigFileFlag adds common --config-file flag
-func AddConfigFileFlag(fs *pflag.FlagSet, path *string) {
-	fs.StringVarP(path, "config-file", "f", "", "load configuration from a file (or stdin if set to '-')")
+// AddConfigFileFlag adds common --config-file flag, along with --lenient-interpolation and --set
+func AddConfigFileFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.StringVarP(&cmd.ClusterConfigFile, "config-file", "f", "", "load configuration from a file (or stdin if set to '-')")
+	fs.BoolVar(&cmd.LenientInterpolation, "lenient-interpolation", false, "leave ${env:VAR} and ${ssm:/path} placeholders in the config file that cannot be resolved in place, instead of failing")
+	fs.StringArrayVar(&cmd.ConfigOverrides, "set", nil, "override a field of the config file, e.g. --set=metadata.region=us-east-1 or --set=nodeGroups[ng-1].desiredCapacity=5 (can be repeated)")
+}
+
+// AddOutputFileFlag adds common --output-file flag for commands that generate a config file
+func AddOutputFileFlag(fs *pflag.FlagSet, path *string) {
+	fs.StringVar(path, "output-file", "", "write the generated ClusterConfig to the given file instead of stdout")
+}
+
+// WriteClusterConfig writes the config as YAML to the given file, or stdout when it's empty,
+// the status of the cluster is omitted and secret values are redacted
+func WriteClusterConfig(cfg *api.ClusterConfig, outputFile string) error {
+	out := cfg.Redacted()
+	out.Status = nil
+
+	var w io.Writer = os.Stdout
+	if outputFile != "" {
+		f, err := os.Create(outputFile)
+		if err != nil {
+			return errors.Wrapf(err, "creating %q", outputFile)
+		}
+		defer f.Close()
+		w = f
+	}
+
+	if err := printers.NewYAMLPrinter().PrintObj(out, w); err != nil {
+		return err
+	}
+	if outputFile != "" {
+		logger.Info("wrote ClusterConfig to %q", outputFile)
+	}
+	return nil
+}
+
+// ClusterConfigFileContent returns the config file as it was given, e.g. to be committed to a repository,
+// so that placeholders are kept; when it was read from stdin, or when it holds the webhook URL as a literal
+// rather than a placeholder, the config is written as YAML instead, with secret values redacted
+func ClusterConfigFileContent(cmd *Cmd) ([]byte, error) {
+	var content []byte
+	switch {
+	case cmd.ClusterConfigDocument != nil:
+		content = cmd.ClusterConfigDocument
+	case cmd.ClusterConfigFile != "-":
+		var err error
+		if content, err = ioutil.ReadFile(cmd.ClusterConfigFile); err != nil {
+			return nil, err
+		}
+	}
+	if content != nil {
+		var given struct {
+			Notifications *api.Notifications `json:"notifications"`
+		}
+		if err := yaml.Unmarshal(content, &given); err != nil {
+			return nil, errors.Wrapf(err, "parsing config file %q", cmd.ClusterConfigFile)
+		}
+		if given.Notifications == nil || given.Notifications.WebhookURL == "" || eks.IsPlaceholder(given.Notifications.WebhookURL) {
+			return content, nil
+		}
+		logger.Warning("notifications.webhookURL is given as a literal in %q, the config is used with the URL redacted and placeholders resolved instead; use a placeholder, e.g. ${ssm:/path/to/url}, to keep the config file as it is", cmd.ClusterConfigFile)
+	}
+	out := cmd.ClusterConfig.Redacted()
+	out.Status = nil
+	buf := &bytes.Buffer{}
+	if err := printers.NewYAMLPrinter().PrintObj(out, buf); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
 }
 
 // ClusterConfigLoader is an inteface that loaders should implement
@@ -47,7 +122,9 @@ func newCommonClusterConfigLoader(cmd *Cmd) *commonClusterConfigLoader {
 			"only",
 			"include",
 			"exclude",
+			"label-selector",
 			"only-missing",
+			"set",
 		),
 	}
 }
@@ -72,7 +149,19 @@ func (l *commonClusterConfigLoader) Load() error {
 	// The reference to ClusterConfig should only be reassigned if ClusterConfigFile is specified
 	// because other parts of the code store the pointer locally and access it directly instead of via
 	// the Cmd reference
-	if l.ClusterConfig, err = eks.LoadConfigFromFile(l.ClusterConfigFile); err != nil {
+	interpolation := &eks.ConfigInterpolation{
+		Lenient:        l.LenientInterpolation,
+		ProviderConfig: l.ProviderConfig,
+	}
+	if l.ClusterConfigDocument != nil {
+		l.ClusterConfig, err = eks.LoadConfigFromDocument(l.ClusterConfigFile, l.ClusterConfigDocument, interpolation)
+	} else {
+		l.ClusterConfig, err = eks.LoadInterpolatedConfigFromFile(l.ClusterConfigFile, interpolation)
+	}
+	if err != nil {
+		return err
+	}
+	if err := eks.ApplyConfigOverrides(l.ClusterConfig, l.ConfigOverrides); err != nil {
 		return err
 	}
 	meta := l.ClusterConfig.Metadata
@@ -147,6 +236,213 @@ func NewEnableProfileLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewEnableGitOpsLoader handles loading of clusterConfigFile for 'eksctl enable gitops',
+// the repository to bootstrap Flux in can only be given in the config file
+func NewEnableGitOpsLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithConfigFile = func() error {
+		if !l.ClusterConfig.HasGitOps() {
+			return fmt.Errorf("gitops.flux is not defined in %s", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	return l
+}
+
+// NewEnableAddonLoader handles loading of clusterConfigFile vs using flags for commands that install addons, e.g. 'eksctl enable cluster-autoscaler'
+func NewEnableAddonLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+		return nil
+	}
+
+	return l
+}
+
+// NewAddonLoader handles loading of clusterConfigFile vs using flags for 'eksctl create/get/update/delete addon',
+// when a config file is given all EKS addons defined in it are used
+func NewAddonLoader(cmd *Cmd, addon *api.Addon, nameRequired bool) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"service-account-role-arn",
+		"attach-policy-arn",
+		"resolve-conflicts",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.EKSAddons()) == 0 {
+			return fmt.Errorf("no EKS addons are defined in %s", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if addon.Name != "" && l.NameArg != "" {
+			return ErrNameFlagAndArg(addon.Name, l.NameArg)
+		}
+		if l.NameArg != "" {
+			addon.Name = l.NameArg
+		}
+
+		if addon.Name == "" {
+			if nameRequired {
+				return ErrMustBeSet("--name")
+			}
+			return nil
+		}
+
+		l.ClusterConfig.Addons = []*api.Addon{addon}
+		if !addon.IsEKSAddon() {
+			return fmt.Errorf("addon %q is not managed via EKS Addons API", addon.Name)
+		}
+		return nil
+	}
+
+	return l
+}
+
+// NewFargateProfileLoader handles loading of clusterConfigFile vs using flags for fargateprofile commands,
+// the profile given via flags is added to the config only when it's being created
+func NewFargateProfileLoader(cmd *Cmd, options *FargateProfileOptions, forCreate bool) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"namespace",
+		"labels",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if !l.ClusterConfig.HasFargateProfiles() {
+			return fmt.Errorf("no Fargate profiles are defined in %s", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if options.ProfileName != "" && l.NameArg != "" {
+			return ErrNameFlagAndArg(options.ProfileName, l.NameArg)
+		}
+		if l.NameArg != "" {
+			options.ProfileName = l.NameArg
+		}
+
+		if !forCreate {
+			return nil
+		}
+		if options.ProfileName == "" {
+			return ErrMustBeSet("--name")
+		}
+		if options.Namespace == "" {
+			return ErrMustBeSet("--namespace")
+		}
+		l.ClusterConfig.FargateProfiles = []*api.FargateProfile{options.ToFargateProfile()}
+		return nil
+	}
+
+	return l
+}
+
+// NewAccessEntryLoader handles loading of clusterConfigFile vs using flags for access entry commands
+func NewAccessEntryLoader(cmd *Cmd, options *AccessEntryOptions, forCreate bool) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"principal-arn",
+		"type",
+		"kubernetes-username",
+		"kubernetes-groups",
+		"access-policy-arns",
+		"namespaces",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if forCreate && len(l.ClusterConfig.AccessEntries) == 0 {
+			return fmt.Errorf("no access entries are defined in %s", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+		if !forCreate {
+			return nil
+		}
+		if options.PrincipalARN == "" {
+			return ErrMustBeSet("--principal-arn")
+		}
+		// the cluster must already use access entries, this doesn't change its authentication mode
+		l.ClusterConfig.AccessConfig = &api.AccessConfig{AuthenticationMode: api.AuthenticationModeAPIAndConfigMap}
+		l.ClusterConfig.AccessEntries = []*api.AccessEntry{options.ToAccessEntry()}
+		return nil
+	}
+
+	return l
+}
+
+// NewPodIdentityAssociationLoader handles loading of clusterConfigFile vs using flags for pod identity association commands
+func NewPodIdentityAssociationLoader(cmd *Cmd, options *PodIdentityAssociationOptions, forCreate bool) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"namespace",
+		"service-account-name",
+		"role-arn",
+		"role-name",
+		"permissions-boundary",
+		"attach-policy-arn",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if forCreate && len(l.ClusterConfig.IAM.PodIdentityAssociations) == 0 {
+			return fmt.Errorf("no iam.podIdentityAssociations are defined in %s", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+		if !forCreate {
+			return nil
+		}
+		if options.ServiceAccountName == "" {
+			return ErrMustBeSet("--service-account-name")
+		}
+		if options.RoleARN == "" && len(options.AttachPolicyARNs) == 0 {
+			return fmt.Errorf("--role-arn or --attach-policy-arn must be set")
+		}
+		if options.RoleARN != "" && (len(options.AttachPolicyARNs) > 0 || options.RoleName != "" || options.PermissionsBoundary != "") {
+			return fmt.Errorf("--role-arn cannot be used with --attach-policy-arn, --role-name or --permissions-boundary")
+		}
+		l.ClusterConfig.IAM.PodIdentityAssociations = []*api.PodIdentityAssociation{options.ToPodIdentityAssociation()}
+		return nil
+	}
+
+	return l
+}
+
 // NewInstallFluxLoader handles loading of clusterConfigFile vs using flags for install commands
 func NewInstallFluxLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
@@ -162,6 +458,27 @@ func NewInstallFluxLoader(cmd *Cmd) ClusterConfigLoader {
 	return l
 }
 
+// NewSSMSessionLoader handles loading of clusterConfigFile vs using flags for 'eksctl utils ssm-session'
+func NewSSMSessionLoader(cmd *Cmd, ng *api.NodeGroup) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithConfigFile = func() error {
+		if ng.Name == "" {
+			return ErrMustBeSet("--nodegroup")
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+		return l.validateWithConfigFile()
+	}
+
+	return l
+}
+
 // NewCreateClusterLoader will load config or use flags for 'eksctl create cluster'
 func NewCreateClusterLoader(cmd *Cmd, ngFilter *NodeGroupFilter, ng *api.NodeGroup, withoutNodeGroup bool) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
@@ -271,7 +588,10 @@ func NewCreateNodeGroupLoader(cmd *Cmd, ngFilter *NodeGroupFilter) ClusterConfig
 	)
 
 	l.validateWithConfigFile = func() error {
-		return ngFilter.AppendGlobs(l.Include, l.Exclude, l.ClusterConfig.NodeGroups)
+		if err := ngFilter.AppendGlobs(l.Include, l.Exclude, l.ClusterConfig.NodeGroups); err != nil {
+			return err
+		}
+		return ngFilter.SetLabelSelector(l.LabelSelector)
 	}
 
 	l.validateWithoutConfigFile = func() error {
@@ -293,6 +613,28 @@ func NewCreateNodeGroupLoader(cmd *Cmd, ngFilter *NodeGroupFilter) ClusterConfig
 	return l
 }
 
+// NewApplyLoader will load config for 'eksctl apply', which only works with a config file
+func NewApplyLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	return l
+}
+
+// NewValidateLoader will load config for 'eksctl validate', which only works with a config file
+func NewValidateLoader(cmd *Cmd) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	return l
+}
+
 func normalizeNodeGroup(ng *api.NodeGroup, l *commonClusterConfigLoader) error {
 	if flag := l.CobraCommand.Flag("ssh-public-key"); flag != nil && flag.Changed {
 		if *ng.SSH.PublicKeyPath == "" {
@@ -315,7 +657,10 @@ func NewDeleteNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *NodeGroupFi
 	l := newCommonClusterConfigLoader(cmd)
 
 	l.validateWithConfigFile = func() error {
-		return ngFilter.AppendGlobs(l.Include, l.Exclude, l.ClusterConfig.NodeGroups)
+		if err := ngFilter.AppendGlobs(l.Include, l.Exclude, l.ClusterConfig.NodeGroups); err != nil {
+			return err
+		}
+		return ngFilter.SetLabelSelector(l.LabelSelector)
 	}
 
 	l.flagsIncompatibleWithoutConfigFile.Insert(
@@ -349,6 +694,109 @@ func NewDeleteNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup, ngFilter *NodeGroupFi
 	return l
 }
 
+// NewReplaceNodeGroupLoader will load config for 'eksctl replace nodegroup'; a config file is required,
+// as the definition of the nodegroup to replace is used as the basis for the new one
+func NewReplaceNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Delete("name")
+
+	l.validateWithConfigFile = func() error {
+		if ng.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+		for _, existing := range l.ClusterConfig.NodeGroups {
+			if existing.Name == ng.Name {
+				return nil
+			}
+		}
+		return fmt.Errorf("nodegroup %q is not defined in %q", ng.Name, l.ClusterConfigFile)
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	return l
+}
+
+// NewUpdateNodeGroupLoader will load config for 'eksctl update nodegroup'; a config file with
+// managed nodegroups is required, --name can be used to update only one of them
+func NewUpdateNodeGroupLoader(cmd *Cmd, ng *api.ManagedNodeGroup) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Delete("name")
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.ManagedNodeGroups) == 0 {
+			return fmt.Errorf("no managed nodegroups are defined in %q", l.ClusterConfigFile)
+		}
+		if ng.Name == "" {
+			return nil
+		}
+		for _, existing := range l.ClusterConfig.ManagedNodeGroups {
+			if existing.Name == ng.Name {
+				return nil
+			}
+		}
+		return fmt.Errorf("managed nodegroup %q is not defined in %q", ng.Name, l.ClusterConfigFile)
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		return ErrMustBeSet("--config-file")
+	}
+
+	return l
+}
+
+// NewScaleNodeGroupLoader will load config or use flags for 'eksctl scale nodegroup'; with a config file,
+// nodegroups are scaled to the sizes that are set in it, without one, the nodegroup given by name or the
+// nodegroups of the cluster that match the filters are scaled to --nodes
+func NewScaleNodeGroupLoader(cmd *Cmd, ng *api.NodeGroup) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert("nodes")
+	// filters select nodegroups of the cluster without a config file
+	l.flagsIncompatibleWithoutConfigFile.Delete("include", "exclude", "label-selector")
+
+	l.validateWithConfigFile = func() error {
+		if len(l.ClusterConfig.NodeGroups)+len(l.ClusterConfig.ManagedNodeGroups) == 0 {
+			return fmt.Errorf("no nodegroups are defined in %q", l.ClusterConfigFile)
+		}
+		return nil
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if ng.Name != "" && l.NameArg != "" {
+			return ErrNameFlagAndArg(ng.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			ng.Name = l.NameArg
+		}
+
+		bulk := HasNodeGroupFilters(l.Cmd)
+		if bulk && ng.Name != "" {
+			return fmt.Errorf("--name cannot be used with --include, --exclude or --label-selector")
+		}
+		if !bulk && ng.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+
+		if ng.DesiredCapacity == nil || *ng.DesiredCapacity < 0 {
+			return fmt.Errorf("number of nodes must be 0 or greater. Use the --nodes/-N flag")
+		}
+
+		return nil
+	}
+
+	return l
+}
+
 // NewUtilsEnableLoggingLoader will load config or use flags for 'eksctl utils update-cluster-logging'
 func NewUtilsEnableLoggingLoader(cmd *Cmd) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
@@ -388,6 +836,12 @@ func NewCreateIAMServiceAccountLoader(cmd *Cmd, saFilter *IAMServiceAccountFilte
 
 	l.flagsIncompatibleWithConfigFile.Insert(
 		"policy-arn",
+		"attach-policy-arn",
+		"role-name",
+		"role-path",
+		"permissions-boundary",
+		"oidc-issuer-url",
+		"trusted-accounts",
 	)
 
 	l.validateWithConfigFile = func() error {
@@ -419,6 +873,45 @@ func NewCreateIAMServiceAccountLoader(cmd *Cmd, saFilter *IAMServiceAccountFilte
 	return l
 }
 
+// NewUpdateIAMServiceAccountLoader will load config or use flags for 'eksctl update iamserviceaccount'
+func NewUpdateIAMServiceAccountLoader(cmd *Cmd, sa *api.ClusterIAMServiceAccount, saFilter *IAMServiceAccountFilter) ClusterConfigLoader {
+	l := newCommonClusterConfigLoader(cmd)
+
+	l.flagsIncompatibleWithConfigFile.Insert(
+		"attach-policy-arn",
+		"permissions-boundary",
+	)
+
+	l.validateWithConfigFile = func() error {
+		if api.IsDisabled(l.ClusterConfig.IAM.WithOIDC) {
+			return fmt.Errorf("'iam.withOIDC' is not enabled in %q", l.ClusterConfigFile)
+		}
+		return saFilter.AppendGlobs(l.Include, l.Exclude, l.ClusterConfig.IAM.ServiceAccounts)
+	}
+
+	l.validateWithoutConfigFile = func() error {
+		if l.ClusterConfig.Metadata.Name == "" {
+			return ErrMustBeSet("--cluster")
+		}
+
+		if sa.Name != "" && l.NameArg != "" {
+			return ErrNameFlagAndArg(sa.Name, l.NameArg)
+		}
+
+		if l.NameArg != "" {
+			sa.Name = l.NameArg
+		}
+
+		if sa.Name == "" {
+			return ErrMustBeSet("--name")
+		}
+
+		return nil
+	}
+
+	return l
+}
+
 // NewGetIAMServiceAccountLoader will load config or use flags for 'eksctl get iamserviceaccount'
 func NewGetIAMServiceAccountLoader(cmd *Cmd, sa *api.ClusterIAMServiceAccount) ClusterConfigLoader {
 	l := newCommonClusterConfigLoader(cmd)
@@ -1,7 +1,10 @@
 package cmdutils_test
 
 import (
+	"io/ioutil"
+	"os"
 	"path/filepath"
+	"sync"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -81,7 +84,7 @@ var _ = Describe("cmdutils configfile", func() {
 			examples, err := filepath.Glob(examplesDir + "*.yaml")
 			Expect(err).ToNot(HaveOccurred())
 
-			Expect(examples).To(HaveLen(13))
+			Expect(examples).To(HaveLen(18))
 			for _, example := range examples {
 				cmd := &Cmd{
 					CobraCommand:      newCmd(),
@@ -214,5 +217,157 @@ var _ = Describe("cmdutils configfile", func() {
 			}
 
 		})
+
+		It("replace nodegroup loader should require the nodegroup to be defined in config file", func() {
+			newReplaceCmd := func(configFile string) *Cmd {
+				return &Cmd{
+					CobraCommand:      newCmd(),
+					ClusterConfigFile: configFile,
+					ClusterConfig:     api.NewClusterConfig(),
+					ProviderConfig:    &api.ProviderConfig{},
+				}
+			}
+
+			ng := api.NewNodeGroup()
+
+			err := NewReplaceNodeGroupLoader(newReplaceCmd(""), ng).Load()
+			Expect(err).To(MatchError("--config-file must be set"))
+
+			configFile := filepath.Join(examplesDir, "03-two-nodegroups.yaml")
+
+			err = NewReplaceNodeGroupLoader(newReplaceCmd(configFile), ng).Load()
+			Expect(err).To(MatchError("--name must be set"))
+
+			ng.Name = "ng3"
+			err = NewReplaceNodeGroupLoader(newReplaceCmd(configFile), ng).Load()
+			Expect(err).To(MatchError(`nodegroup "ng3" is not defined in "../../../examples/03-two-nodegroups.yaml"`))
+
+			ng.Name = "ng2-private"
+			cmd := newReplaceCmd(configFile)
+			Expect(NewReplaceNodeGroupLoader(cmd, ng).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.Metadata.Name).To(Equal("cluster-3"))
+		})
+
+		It("should derive names of replacement nodegroups", func() {
+			Expect(ReplacementNodeGroupName("ng-1")).To(Equal("ng-1-v2"))
+			Expect(ReplacementNodeGroupName("ng-1-v2")).To(Equal("ng-1-v3"))
+			Expect(ReplacementNodeGroupName("ng-1-v19")).To(Equal("ng-1-v20"))
+			Expect(ReplacementNodeGroupName("ng-v")).To(Equal("ng-v-v2"))
+		})
+
+		It("fargateprofile loader should load profiles from flags or config file", func() {
+			newFargateCmd := func(configFile string) *Cmd {
+				return &Cmd{
+					CobraCommand:      newCmd(),
+					ClusterConfigFile: configFile,
+					ClusterConfig:     api.NewClusterConfig(),
+					ProviderConfig:    &api.ProviderConfig{},
+				}
+			}
+
+			options := &FargateProfileOptions{}
+			cmd := newFargateCmd("")
+			Expect(NewFargateProfileLoader(cmd, options, true).Load()).To(MatchError("--cluster must be set"))
+
+			cmd.ClusterConfig.Metadata.Name = "cluster-1"
+			Expect(NewFargateProfileLoader(cmd, options, true).Load()).To(MatchError("--name must be set"))
+
+			options.ProfileName = "dev"
+			Expect(NewFargateProfileLoader(cmd, options, true).Load()).To(MatchError("--namespace must be set"))
+
+			options.Namespace = "dev"
+			options.Labels = map[string]string{"env": "dev"}
+			Expect(NewFargateProfileLoader(cmd, options, true).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.FargateProfiles).To(Equal([]*api.FargateProfile{
+				{Name: "dev", Selectors: []api.FargateProfileSelector{{Namespace: "dev", Labels: map[string]string{"env": "dev"}}}},
+			}))
+
+			cmd = newFargateCmd(filepath.Join(examplesDir, "16-fargate-profiles.yaml"))
+			Expect(NewFargateProfileLoader(cmd, &FargateProfileOptions{}, true).Load()).To(Succeed())
+			Expect(cmd.ClusterConfig.FargateProfiles).To(HaveLen(2))
+
+			cmd = newFargateCmd(filepath.Join(examplesDir, "01-simple-cluster.yaml"))
+			Expect(NewFargateProfileLoader(cmd, &FargateProfileOptions{}, false).Load()).To(MatchError("no Fargate profiles are defined in ../../../examples/01-simple-cluster.yaml"))
+		})
+
+		It("should run for each cluster defined in a config file", func() {
+			configFile, err := ioutil.TempFile("", "clusters-*.yaml")
+			Expect(err).ToNot(HaveOccurred())
+			defer os.Remove(configFile.Name())
+
+			_, err = configFile.WriteString(`# fleet of clusters
+---
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: cluster-1
+  region: us-west-2
+---
+apiVersion: eksctl.io/v1alpha5
+kind: ClusterConfig
+metadata:
+  name: cluster-2
+  region: eu-north-1
+`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(configFile.Close()).To(Succeed())
+
+			newClustersCmd := func() *Cmd {
+				return &Cmd{
+					ClusterConfig:     api.NewClusterConfig(),
+					CobraCommand:      newCmd(),
+					ClusterConfigFile: configFile.Name(),
+					ProviderConfig:    &api.ProviderConfig{},
+				}
+			}
+
+			for _, parallel := range []bool{false, true} {
+				var mutex sync.Mutex
+				loaded := map[string]string{}
+				err := ForEachClusterConfig(newClustersCmd(), parallel, func(cmd *Cmd) error {
+					if err := NewMetadataLoader(cmd).Load(); err != nil {
+						return err
+					}
+					mutex.Lock()
+					defer mutex.Unlock()
+					loaded[cmd.ClusterConfig.Metadata.Name] = cmd.ProviderConfig.Region
+					return nil
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(loaded).To(Equal(map[string]string{"cluster-1": "us-west-2", "cluster-2": "eu-north-1"}))
+			}
+
+			err = NewMetadataLoader(newClustersCmd()).Load()
+			Expect(err).To(MatchError(ContainSubstring("defines 2 clusters, but this command only works with one cluster")))
+		})
+	})
+
+	Context("config file content", func() {
+		const webhookURL = "https://hooks.slack.com/services/T0/B0/abc"
+
+		newContentCmd := func(document string) *Cmd {
+			cfg := api.NewClusterConfig()
+			cfg.Metadata.Name = "test"
+			cfg.Notifications = &api.Notifications{WebhookURL: webhookURL}
+			return &Cmd{
+				ClusterConfig:         cfg,
+				ClusterConfigFile:     "cluster.yaml",
+				ClusterConfigDocument: []byte(document),
+			}
+		}
+
+		It("keeps the config file as it was given when the webhook URL is a placeholder", func() {
+			document := "metadata:\n  name: test\nnotifications:\n  webhookURL: ${ssm:/slack/webhook}\n"
+			content, err := ClusterConfigFileContent(newContentCmd(document))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).To(Equal(document))
+		})
+
+		It("redacts the webhook URL when it's given as a literal", func() {
+			content, err := ClusterConfigFileContent(newContentCmd("metadata:\n  name: test\nnotifications:\n  webhookURL: " + webhookURL + "\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(content)).ToNot(ContainSubstring(webhookURL))
+			Expect(string(content)).To(ContainSubstring("webhookURL: REDACTED"))
+		})
 	})
 })
@@ -0,0 +1,87 @@
+package cmdutils
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"sync"
+
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+// confirmationIn and confirmationOut are variables, so that tests can replace them; all prompts share
+// one reader, as a reader of its own would buffer input that later prompts need
+var (
+	confirmationIn  *bufio.Reader = bufio.NewReader(os.Stdin)
+	confirmationOut io.Writer     = os.Stdout
+)
+
+// confirmationMutex keeps a summary and its prompt together
+var confirmationMutex sync.Mutex
+
+// ErrParallelConfirmation is returned when deletions would have to be confirmed in parallel
+func ErrParallelConfirmation() error {
+	return fmt.Errorf("--parallel requires --yes, as deletions cannot be confirmed in parallel")
+}
+
+// AddYesFlag adds common `--yes` flag
+func AddYesFlag(fs *pflag.FlagSet, cmd *Cmd) {
+	fs.BoolVarP(&cmd.Yes, "yes", "y", false, "Delete without printing a summary and asking for confirmation")
+}
+
+// ConfirmDeletion makes the tasks print a summary of what they delete, and require the name
+// of the cluster to be typed, before they run; unless `--yes` was given
+func ConfirmDeletion(cmd *Cmd, stackManager *manager.StackCollection, tasks *manager.TaskTree) {
+	if cmd.Yes {
+		return
+	}
+	clusterName := cmd.ClusterConfig.Metadata.Name
+	ctx := cmd.ctx
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	tasks.Confirm = func() error {
+		confirmationMutex.Lock()
+		defer confirmationMutex.Unlock()
+		fmt.Fprintf(confirmationOut, "\nThe following resources of cluster %q will be deleted:\n\n", clusterName)
+		if err := stackManager.NewDeletionSummary(tasks).Write(confirmationOut); err != nil {
+			return err
+		}
+		return confirmClusterName(ctx, clusterName)
+	}
+}
+
+// confirmClusterName prompts for the name of the cluster, and gives up waiting for the answer
+// once ctx is cancelled, i.e. when the command is interrupted twice
+func confirmClusterName(ctx context.Context, clusterName string) error {
+	fmt.Fprintf(confirmationOut, "\nType the name of the cluster to confirm: ")
+	type result struct {
+		answer string
+		err    error
+	}
+	in, read := confirmationIn, make(chan result, 1)
+	go func() {
+		answer, err := in.ReadString('\n')
+		read <- result{answer, err}
+	}()
+
+	var answer string
+	select {
+	case <-ctx.Done():
+		return fmt.Errorf("deletion of cluster %q was not confirmed: %w", clusterName, ctx.Err())
+	case r := <-read:
+		if r.err != nil && r.err != io.EOF {
+			return r.err
+		}
+		answer = r.answer
+	}
+	if strings.TrimSpace(answer) != clusterName {
+		return fmt.Errorf("deletion of cluster %q was not confirmed, type its name or use --yes", clusterName)
+	}
+	return nil
+}
@@ -0,0 +1,60 @@
+package cmdutils
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"io"
+	"os"
+	"strings"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("deletion confirmation", func() {
+	var out *bytes.Buffer
+
+	BeforeEach(func() {
+		out = &bytes.Buffer{}
+		confirmationOut = out
+	})
+
+	AfterEach(func() {
+		confirmationIn, confirmationOut = bufio.NewReader(os.Stdin), io.Writer(os.Stdout)
+	})
+
+	It("accepts the name of the cluster", func() {
+		confirmationIn = bufio.NewReader(strings.NewReader("test-cluster\n"))
+		Expect(confirmClusterName(context.Background(), "test-cluster")).To(Succeed())
+		Expect(out.String()).To(ContainSubstring("Type the name of the cluster to confirm"))
+	})
+
+	It("rejects anything else", func() {
+		confirmationIn = bufio.NewReader(strings.NewReader("yes\n"))
+		Expect(confirmClusterName(context.Background(), "test-cluster")).To(MatchError(`deletion of cluster "test-cluster" was not confirmed, type its name or use --yes`))
+
+		confirmationIn = bufio.NewReader(strings.NewReader(""))
+		Expect(confirmClusterName(context.Background(), "test-cluster")).To(HaveOccurred())
+	})
+
+	It("stops waiting for an answer once the context is cancelled", func() {
+		r, w := io.Pipe()
+		defer w.Close()
+		confirmationIn = bufio.NewReader(r)
+
+		ctx, cancel := context.WithCancel(context.Background())
+		errs := make(chan error, 1)
+		go func() { errs <- confirmClusterName(ctx, "test-cluster") }()
+		Consistently(errs).ShouldNot(Receive())
+
+		cancel()
+		Eventually(errs).Should(Receive(MatchError(ContainSubstring("context canceled"))))
+	})
+
+	It("reads the answers of consecutive prompts from the same input", func() {
+		confirmationIn = bufio.NewReader(strings.NewReader("cluster-1\ncluster-2\n"))
+		Expect(confirmClusterName(context.Background(), "cluster-1")).To(Succeed())
+		Expect(confirmClusterName(context.Background(), "cluster-2")).To(Succeed())
+	})
+})
@@ -0,0 +1,62 @@
+package cmdutils
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// errorReportDir is a variable, so that tests can replace it
+var errorReportDir = os.TempDir()
+
+// LogTaskErrors logs the errors of a TaskTree grouped by the tasks that failed, along with
+// the failed events of their stacks, and saves the same report as JSON for tooling
+func LogTaskErrors(cmd *Cmd, stackManager *manager.StackCollection, errs []error) {
+	report := stackManager.NewErrorReport(errs)
+
+	text := &bytes.Buffer{}
+	if err := report.WriteText(text); err != nil {
+		logger.Warning("failed to write error report: %s", err.Error())
+	}
+	for _, line := range strings.Split(strings.TrimRight(text.String(), "\n"), "\n") {
+		logger.Critical("%s\n", line)
+	}
+
+	path := filepath.Join(errorReportDir, fmt.Sprintf("eksctl-error-report-%s-%d.json", cmd.ClusterConfig.Metadata.Name, time.Now().Unix()))
+	data := &bytes.Buffer{}
+	if err := report.WriteJSON(data); err != nil {
+		logger.Warning("failed to write error report: %s", err.Error())
+		return
+	}
+	if err := ioutil.WriteFile(path, data.Bytes(), 0600); err != nil {
+		logger.Warning("failed to save error report: %s", err.Error())
+		return
+	}
+	logger.Info("saved error report as JSON to %q", path)
+}
+
+// ErrorReportFiles returns the paths of the error reports that LogTaskErrors saved for the cluster
+func ErrorReportFiles(clusterName string) ([]string, error) {
+	prefix := fmt.Sprintf("eksctl-error-report-%s-", clusterName)
+	paths, err := filepath.Glob(filepath.Join(errorReportDir, prefix+"*.json"))
+	if err != nil {
+		return nil, err
+	}
+	files := []string{}
+	for _, path := range paths {
+		// the glob also matches the reports of clusters whose names start with the name followed by a dash
+		timestamp := strings.TrimSuffix(strings.TrimPrefix(filepath.Base(path), prefix), ".json")
+		if _, err := strconv.ParseInt(timestamp, 10, 64); err == nil {
+			files = append(files, path)
+		}
+	}
+	return files, nil
+}
@@ -0,0 +1,34 @@
+package cmdutils
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// FargateProfileOptions holds the options of a Fargate profile given via flags
+type FargateProfileOptions struct {
+	ProfileName string
+	Namespace   string
+	Labels      map[string]string
+}
+
+// AddFargateProfileFlags adds flags that configure a Fargate profile, for 'eksctl create fargateprofile'
+func AddFargateProfileFlags(fs *pflag.FlagSet, options *FargateProfileOptions) {
+	fs.StringVar(&options.ProfileName, "name", "", "name of the Fargate profile")
+	fs.StringVar(&options.Namespace, "namespace", "", "namespace of the workload to schedule onto Fargate")
+	fs.StringToStringVar(&options.Labels, "labels", nil, `labels the workload must have to be scheduled onto Fargate, e.g. "env=dev,app=web"`)
+}
+
+// ToFargateProfile creates a Fargate profile with a single selector from the options
+func (o *FargateProfileOptions) ToFargateProfile() *api.FargateProfile {
+	return &api.FargateProfile{
+		Name: o.ProfileName,
+		Selectors: []api.FargateProfileSelector{
+			{
+				Namespace: o.Namespace,
+				Labels:    o.Labels,
+			},
+		},
+	}
+}
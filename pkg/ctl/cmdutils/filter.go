@@ -5,10 +5,11 @@ import (
 	"strings"
 
 	"github.com/gobwas/glob"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // Filter holds filter configuration
@@ -0,0 +1,22 @@
+package cmdutils
+
+import (
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/gitops/flux"
+)
+
+// BootstrapFlux bootstraps Flux v2 in the repository given in gitops.flux of the config
+// and commits the config file to it
+func BootstrapFlux(cmd *Cmd, ctl *eks.ClusterProvider) error {
+	cfg := cmd.ClusterConfig
+
+	client, err := ctl.NewClient(cfg)
+	if err != nil {
+		return err
+	}
+	configFile, err := ClusterConfigFileContent(cmd)
+	if err != nil {
+		return err
+	}
+	return flux.NewBootstrapper(cfg, client.Config, configFile).Run()
+}
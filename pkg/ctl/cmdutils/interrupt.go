@@ -0,0 +1,75 @@
+package cmdutils
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// handleInterrupts makes the first SIGINT stop tasks from starting, while the tasks that are
+// running are left to complete, as CloudFormation can't cancel the operations they wait for;
+// a second SIGINT cancels the context of the command, so that it stops waiting for them, and
+// a third one terminates the process; the returned function stops handling interrupts
+func (c *Cmd) handleInterrupts() func() {
+	ctx, cancel := context.WithCancel(context.Background())
+	c.ctx = ctx
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt)
+	done := make(chan struct{})
+
+	go func() {
+		select {
+		case <-signals:
+		case <-done:
+			return
+		}
+		inFlight := manager.Interrupt()
+		logger.Warning("interrupted, no new tasks will be started; waiting for %d task(s) in progress to complete, interrupt again to stop waiting", len(inFlight))
+		for _, task := range inFlight {
+			logger.Warning("in progress for %s: %s", time.Since(task.Started).Round(time.Second), task.Description)
+		}
+
+		select {
+		case <-signals:
+		case <-done:
+			return
+		}
+		logger.Warning("interrupted again, no longer waiting for tasks in progress; interrupt again to exit immediately")
+		cancel()
+		// restore the default behaviour, so that a third SIGINT terminates the process
+		signal.Stop(signals)
+	}()
+
+	return func() {
+		signal.Stop(signals)
+		close(done)
+		cancel()
+		manager.ResetInterrupt()
+	}
+}
+
+// logResumeSummary tells which tasks were in progress when the command was interrupted,
+// and how to resume once CloudFormation has completed the operations on their stacks
+func (c *Cmd) logResumeSummary() {
+	inFlight, interrupted := manager.Interrupted()
+	if !interrupted {
+		return
+	}
+	logger.Warning("the command was interrupted, tasks that had not started were skipped")
+	for _, task := range inFlight {
+		if task.StackName != "" {
+			logger.Warning("stack %q was mid-operation (%s), CloudFormation continues the operation regardless", task.StackName, task.Description)
+		} else {
+			logger.Warning("task was in progress: %s", task.Description)
+		}
+	}
+	if meta := c.ClusterConfig; meta != nil && meta.Metadata.Name != "" {
+		logger.Info("to check the state of the stacks, run 'eksctl utils describe-stacks --region=%s --cluster=%s'", meta.Metadata.Region, meta.Metadata.Name)
+	}
+	logger.Info("to resume, run the same command again once no stack operations are in progress")
+}
@@ -0,0 +1,141 @@
+package cmdutils
+
+import (
+	"fmt"
+	"sync"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// AddParallelFlag adds common --parallel flag for commands that work with multiple clusters
+func AddParallelFlag(fs *pflag.FlagSet, parallel *bool) {
+	fs.BoolVar(parallel, "parallel", false, "when the config file defines multiple clusters, operate on all of them in parallel")
+}
+
+// ForEachClusterConfig calls run for each of the clusters defined in the config file, one after
+// another or in parallel, each call gets its own copy of cmd; when the config file defines
+// only one cluster, or no config file is given, run is just called with cmd
+func ForEachClusterConfig(cmd *Cmd, parallel bool, run func(*Cmd) error) error {
+	if cmd.ClusterConfigFile == "" {
+		return run(cmd)
+	}
+
+	documents, err := eks.ReadConfigDocuments(cmd.ClusterConfigFile)
+	if err != nil {
+		return err
+	}
+	switch len(documents) {
+	case 0:
+		return run(cmd)
+	case 1:
+		// the file is not read again, as it may be stdin
+		cmd.ClusterConfigDocument = documents[0]
+		return run(cmd)
+	}
+
+	clusterCmd := func(document []byte) *Cmd {
+		c := *cmd
+		providerConfig := *cmd.ProviderConfig
+		c.ProviderConfig = &providerConfig
+		c.ClusterConfig = api.NewClusterConfig()
+		c.ClusterConfigDocument = document
+		return &c
+	}
+
+	cmds := []*Cmd{}
+	for _, document := range documents {
+		cmds = append(cmds, clusterCmd(document))
+	}
+	if !parallel {
+		logger.Info("config file %q defines %d clusters, will operate on each of them in turn", cmd.ClusterConfigFile, len(documents))
+	} else {
+		logger.Info("config file %q defines %d clusters, will operate on all of them in parallel", cmd.ClusterConfigFile, len(documents))
+	}
+	failed, err := runEach(cmds, parallel, run)
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed for %d of %d clusters defined in config file %q", failed, len(documents), cmd.ClusterConfigFile)
+	}
+	return nil
+}
+
+// runEach calls run with each of the cmds; one after another, returning the first error, or in parallel,
+// logging the errors and returning how many of the calls failed
+func runEach(cmds []*Cmd, parallel bool, run func(*Cmd) error) (int, error) {
+	if !parallel {
+		for _, c := range cmds {
+			if err := run(c); err != nil {
+				return 0, err
+			}
+		}
+		return 0, nil
+	}
+
+	var (
+		wg     sync.WaitGroup
+		mutex  sync.Mutex
+		failed int
+	)
+	for _, c := range cmds {
+		wg.Add(1)
+		go func(c *Cmd) {
+			defer wg.Done()
+			if err := run(c); err != nil {
+				logger.Critical("%s\n", err.Error())
+				mutex.Lock()
+				failed++
+				mutex.Unlock()
+			}
+		}(c)
+	}
+	wg.Wait()
+	return failed, nil
+}
+
+// AllRegions can be given as `--region all` to operate on all regions that are enabled in the account
+const AllRegions = "all"
+
+// AddAllRegionsFlag adds common --all-regions flag
+func AddAllRegionsFlag(fs *pflag.FlagSet, allRegions *bool, description string) {
+	fs.BoolVarP(allRegions, "all-regions", "A", false, description)
+}
+
+// CheckAllRegions tells whether all regions were selected, with `--all-regions` or `--region all`;
+// in the latter case the region is reset, so that AWS clients are created for the default region
+func CheckAllRegions(cmd *Cmd, allRegions bool) bool {
+	if cmd.ProviderConfig.Region == AllRegions {
+		cmd.ProviderConfig.Region = ""
+		return true
+	}
+	return allRegions
+}
+
+// ForEachCluster calls run for each of the clusters, one after another or in parallel, each call
+// gets its own copy of cmd, with the name and region of the cluster
+func ForEachCluster(cmd *Cmd, clusters []*api.ClusterMeta, parallel bool, run func(*Cmd) error) error {
+	cmds := []*Cmd{}
+	for _, meta := range clusters {
+		c := *cmd
+		providerConfig := *cmd.ProviderConfig
+		providerConfig.Region = meta.Region
+		c.ProviderConfig = &providerConfig
+		c.ClusterConfig = api.NewClusterConfig()
+		c.ClusterConfig.Metadata.Name = meta.Name
+		c.NameArg = ""
+		cmds = append(cmds, &c)
+	}
+	failed, err := runEach(cmds, parallel, run)
+	if err != nil {
+		return err
+	}
+	if failed > 0 {
+		return fmt.Errorf("failed for %d of %d clusters", failed, len(clusters))
+	}
+	return nil
+}
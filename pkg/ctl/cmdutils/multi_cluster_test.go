@@ -0,0 +1,79 @@
+package cmdutils
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("operations on multiple clusters", func() {
+	newCmd := func(region string) *Cmd {
+		return &Cmd{
+			ProviderConfig: &api.ProviderConfig{Region: region},
+			ClusterConfig:  api.NewClusterConfig(),
+		}
+	}
+
+	clusters := []*api.ClusterMeta{
+		{Name: "a", Region: "us-west-2"},
+		{Name: "b", Region: "eu-west-1"},
+	}
+
+	It("selects all regions with --region all", func() {
+		cmd := newCmd(AllRegions)
+		Expect(CheckAllRegions(cmd, false)).To(BeTrue())
+		Expect(cmd.ProviderConfig.Region).To(BeEmpty())
+
+		cmd = newCmd("us-west-2")
+		Expect(CheckAllRegions(cmd, false)).To(BeFalse())
+		Expect(CheckAllRegions(cmd, true)).To(BeTrue())
+		Expect(cmd.ProviderConfig.Region).To(Equal("us-west-2"))
+	})
+
+	It("runs for each cluster in its region", func() {
+		cmd := newCmd("")
+		cmd.NameArg = "ignored"
+		visited := []string{}
+		Expect(ForEachCluster(cmd, clusters, false, func(c *Cmd) error {
+			Expect(c.NameArg).To(BeEmpty())
+			visited = append(visited, c.ClusterConfig.Metadata.Name+"@"+c.ProviderConfig.Region)
+			return nil
+		})).To(Succeed())
+		Expect(visited).To(Equal([]string{"a@us-west-2", "b@eu-west-1"}))
+		Expect(cmd.ProviderConfig.Region).To(BeEmpty())
+	})
+
+	It("stops at the first error when running one cluster after another", func() {
+		calls := 0
+		err := ForEachCluster(newCmd(""), clusters, false, func(c *Cmd) error {
+			calls++
+			return fmt.Errorf("failed for %s", c.ClusterConfig.Metadata.Name)
+		})
+		Expect(err).To(MatchError("failed for a"))
+		Expect(calls).To(Equal(1))
+	})
+
+	It("counts failures when running in parallel", func() {
+		var (
+			mutex   sync.Mutex
+			visited []string
+		)
+		err := ForEachCluster(newCmd(""), clusters, true, func(c *Cmd) error {
+			mutex.Lock()
+			visited = append(visited, c.ClusterConfig.Metadata.Name)
+			mutex.Unlock()
+			if c.ClusterConfig.Metadata.Name == "b" {
+				return fmt.Errorf("failed")
+			}
+			return nil
+		})
+		Expect(err).To(MatchError("failed for 1 of 2 clusters"))
+		sort.Strings(visited)
+		Expect(visited).To(Equal([]string{"a", "b"}))
+	})
+})
@@ -3,6 +3,8 @@ package cmdutils
 import (
 	"fmt"
 	"math/rand"
+	"regexp"
+	"strconv"
 	"time"
 
 	"github.com/kubicorn/kubicorn/pkg/namer"
@@ -15,6 +17,8 @@ const (
 
 var r = rand.New(rand.NewSource(time.Now().UnixNano()))
 
+var nodeGroupGenerationSuffix = regexp.MustCompile(`^(.+)-v([0-9]+)$`)
+
 // NodeGroupName generates a name string when a and b are empty strings.
 // If either a or b are non-empty, it returns whichever is non-empty.
 // If neither a nor b are empty, it returns empty name, to indicate
@@ -55,3 +59,16 @@ func ClusterName(a, b string) string {
 		return fmt.Sprintf("%s-%d", namer.RandomName(), time.Now().Unix())
 	})
 }
+
+// ReplacementNodeGroupName derives the name of a nodegroup that replaces
+// the given one by adding or incrementing a "-v<N>" suffix, e.g. "ng-1"
+// becomes "ng-1-v2" and "ng-1-v2" becomes "ng-1-v3"; the result is always
+// the same, so that an interrupted replacement can be resumed
+func ReplacementNodeGroupName(name string) string {
+	if m := nodeGroupGenerationSuffix.FindStringSubmatch(name); m != nil {
+		if generation, err := strconv.Atoi(m[2]); err == nil {
+			return fmt.Sprintf("%s-v%d", m[1], generation+1)
+		}
+	}
+	return name + "-v2"
+}
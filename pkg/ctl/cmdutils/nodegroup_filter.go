@@ -1,16 +1,24 @@
 package cmdutils
 
 import (
-	"github.com/kris-nova/logger"
+	"fmt"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // NodeGroupFilter holds filter configuration
 type NodeGroupFilter struct {
 	*Filter
+
+	// labelSelector is matched against the labels of nodegroups, on top of the include and exclude rules
+	labelSelector    labels.Selector
+	rawLabelSelector string
 }
 
 // NewNodeGroupFilter create new NodeGroupFilter instance
@@ -37,6 +45,53 @@ func (f *NodeGroupFilter) AppendIncludeGlobs(nodeGroups []*api.NodeGroup, globEx
 	return f.doAppendIncludeGlobs(f.collectNames(nodeGroups), "nodegroup", globExprs...)
 }
 
+// SetLabelSelector makes the filter include only the nodegroups whose labels match the given selector,
+// e.g. 'lifecycle=spot,team!=critical'; an empty selector matches all nodegroups
+func (f *NodeGroupFilter) SetLabelSelector(selector string) error {
+	if selector == "" {
+		return nil
+	}
+	parsed, err := labels.Parse(selector)
+	if err != nil {
+		return errors.Wrapf(err, "parsing label selector %q", selector)
+	}
+	f.labelSelector, f.rawLabelSelector = parsed, selector
+	return nil
+}
+
+// MatchNodeGroup matches the name and labels of a nodegroup against the filter
+func (f *NodeGroupFilter) MatchNodeGroup(name string, nodeGroupLabels map[string]string) bool {
+	if !f.Match(name) {
+		return false
+	}
+	return f.labelSelector == nil || f.labelSelector.Matches(labels.Set(nodeGroupLabels))
+}
+
+// MatchExistingNodeGroup matches an existing nodegroup against the filter, with its labels in knownLabels; a
+// nodegroup whose labels are unknown never matches a label selector, so that e.g. 'role!=gpu' doesn't select it
+func (f *NodeGroupFilter) MatchExistingNodeGroup(name string, knownLabels map[string]map[string]string) bool {
+	if f.labelSelector == nil {
+		return f.Match(name)
+	}
+	nodeGroupLabels, ok := knownLabels[name]
+	if !ok {
+		if f.Match(name) {
+			logger.Warning("labels of nodegroup %q are unknown, so it's excluded by label selector %q", name, f.rawLabelSelector)
+		}
+		return false
+	}
+	return f.MatchNodeGroup(name, nodeGroupLabels)
+}
+
+// ExistingNodeGroupLabels returns the labels of the given existing nodegroups and managed nodegroups, they're only
+// looked up when the filter has a label selector
+func (f *NodeGroupFilter) ExistingNodeGroupLabels(stackManager *manager.StackCollection, nodeGroups, managedNodeGroups []string) (map[string]map[string]string, error) {
+	if f.labelSelector == nil {
+		return nil, nil
+	}
+	return stackManager.GetNodeGroupLabels(nodeGroups, managedNodeGroups)
+}
+
 // SetExcludeExistingFilter uses stackManager to list existing nodegroup stacks and configures
 // the filter accordingly
 func (f *NodeGroupFilter) SetExcludeExistingFilter(stackManager *manager.StackCollection) error {
@@ -62,6 +117,12 @@ func (f *NodeGroupFilter) SetIncludeOrExcludeMissingFilter(stackManager *manager
 
 	remote := sets.NewString(existing...)
 	local := sets.NewString()
+	var remoteLabels map[string]map[string]string
+	if includeOnlyMissing {
+		if remoteLabels, err = f.ExistingNodeGroupLabels(stackManager, existing, nil); err != nil {
+			return err
+		}
+	}
 
 	for _, localNodeGroup := range *nodeGroups {
 		local.Insert(localNodeGroup.Name)
@@ -78,10 +139,13 @@ func (f *NodeGroupFilter) SetIncludeOrExcludeMissingFilter(stackManager *manager
 			logger.Info("nodegroup %q present in the cluster, but missing from the given config", remoteNodeGroupName)
 			if includeOnlyMissing {
 				// append it to the config object, so that `ngFilter.ForEach` knows about it
-				*nodeGroups = append(*nodeGroups, &api.NodeGroup{Name: remoteNodeGroupName})
-				// make sure it passes it through the filter, so that one can use `--only-missing` along with `--exclude`
-				if f.Match(remoteNodeGroupName) {
+				*nodeGroups = append(*nodeGroups, &api.NodeGroup{Name: remoteNodeGroupName, Labels: remoteLabels[remoteNodeGroupName]})
+				// make sure it passes it through the filter, so that one can use `--only-missing` along with `--exclude`,
+				// a nodegroup whose labels are unknown can't be matched against a label selector
+				if f.MatchExistingNodeGroup(remoteNodeGroupName, remoteLabels) {
 					f.AppendIncludeNames(remoteNodeGroupName)
+				} else if f.Match(remoteNodeGroupName) {
+					f.AppendExcludeNames(remoteNodeGroupName)
 				}
 			}
 		}
@@ -93,28 +157,116 @@ func (f *NodeGroupFilter) SetIncludeOrExcludeMissingFilter(stackManager *manager
 // LogInfo prints out a user-friendly message about how filter was applied
 func (f *NodeGroupFilter) LogInfo(nodeGroups []*api.NodeGroup) {
 	f.doLogInfo("nodegroup", f.collectNames(nodeGroups))
+	if f.labelSelector != nil {
+		included, excluded := f.MatchAll(nodeGroups)
+		logger.Info("label selector %q included %d and excluded %d nodegroup(s)", f.rawLabelSelector, included.Len(), excluded.Len())
+	}
 }
 
 // MatchAll all names against the filter and return two sets of names - included and excluded
 func (f *NodeGroupFilter) MatchAll(nodeGroups []*api.NodeGroup) (sets.String, sets.String) {
-	return f.doMatchAll(f.collectNames(nodeGroups))
+	if f.labelSelector == nil {
+		return f.doMatchAll(f.collectNames(nodeGroups))
+	}
+	included, excluded := sets.NewString(), sets.NewString()
+	for _, ng := range nodeGroups {
+		if f.MatchNodeGroup(ng.NameString(), ng.Labels) {
+			included.Insert(ng.NameString())
+		} else {
+			excluded.Insert(ng.NameString())
+		}
+	}
+	return included, excluded
 }
 
 // FilterMatching matches names against the filter and returns all included node groups
 func (f *NodeGroupFilter) FilterMatching(nodeGroups []*api.NodeGroup) []*api.NodeGroup {
 	var match []*api.NodeGroup
 	for _, ng := range nodeGroups {
-		if f.Match(ng.NameString()) {
+		if f.MatchNodeGroup(ng.NameString(), ng.Labels) {
 			match = append(match, ng)
 		}
 	}
 	return match
 }
 
+// FilterMatchingManaged returns the managed nodegroups that are included by the filter
+func (f *NodeGroupFilter) FilterMatchingManaged(nodeGroups []*api.ManagedNodeGroup) []*api.ManagedNodeGroup {
+	var match []*api.ManagedNodeGroup
+	for _, ng := range nodeGroups {
+		if f.MatchNodeGroup(ng.Name, ng.Labels) {
+			match = append(match, ng)
+		}
+	}
+	return match
+}
+
+// HasNodeGroupFilters tells whether any of the flags added by AddNodeGroupFilterFlags were set
+func HasNodeGroupFilters(cmd *Cmd) bool {
+	return len(cmd.Include) > 0 || len(cmd.Exclude) > 0 || cmd.LabelSelector != ""
+}
+
+// NewNodeGroupFilterFromFlags returns a filter with the rules of the flags added by AddNodeGroupFilterFlags,
+// the include globs have to match some of the given names of nodegroups
+func NewNodeGroupFilterFromFlags(cmd *Cmd, names []string) (*NodeGroupFilter, error) {
+	f := NewNodeGroupFilter()
+	if err := f.doAppendIncludeGlobs(names, "nodegroup", cmd.Include...); err != nil {
+		return nil, err
+	}
+	if err := f.AppendExcludeGlobs(cmd.Exclude...); err != nil {
+		return nil, err
+	}
+	if err := f.SetLabelSelector(cmd.LabelSelector); err != nil {
+		return nil, err
+	}
+	return f, nil
+}
+
+// SelectExistingNodeGroups returns the names of the nodegroups and managed nodegroups of the cluster that the
+// flags added by AddNodeGroupFilterFlags select, for commands that operate on many nodegroups without a config
+// file; nodegroups whose labels are unknown are never selected by a label selector
+func SelectExistingNodeGroups(cmd *Cmd, stackManager *manager.StackCollection) ([]string, []string, error) {
+	existing, err := stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return nil, nil, err
+	}
+	existingManaged, err := stackManager.ListManagedNodeGroups()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	f, err := NewNodeGroupFilterFromFlags(cmd, append(append([]string{}, existing...), existingManaged...))
+	if err != nil {
+		return nil, nil, err
+	}
+	knownLabels, err := f.ExistingNodeGroupLabels(stackManager, existing, existingManaged)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	nodeGroups := []string{}
+	for _, name := range existing {
+		if f.MatchExistingNodeGroup(name, knownLabels) {
+			nodeGroups = append(nodeGroups, name)
+		}
+	}
+	managedNodeGroups := []string{}
+	for _, name := range existingManaged {
+		if f.MatchExistingNodeGroup(name, knownLabels) {
+			managedNodeGroups = append(managedNodeGroups, name)
+		}
+	}
+	if len(nodeGroups)+len(managedNodeGroups) == 0 {
+		return nil, nil, fmt.Errorf("no nodegroups in cluster %q match the given filters", cmd.ClusterConfig.Metadata.Name)
+	}
+	logger.Info("selected %d nodegroup(s) and %d managed nodegroup(s)", len(nodeGroups), len(managedNodeGroups))
+	return nodeGroups, managedNodeGroups, nil
+}
+
 // ForEach iterates over each nodegroup that is included by the filter and calls iterFn
 func (f *NodeGroupFilter) ForEach(nodeGroups []*api.NodeGroup, iterFn func(i int, ng *api.NodeGroup) error) error {
 	for i, ng := range nodeGroups {
-		if f.Match(ng.NameString()) {
+		if f.MatchNodeGroup(ng.NameString(), ng.Labels) {
 			if err := iterFn(i, ng); err != nil {
 				return err
 			}
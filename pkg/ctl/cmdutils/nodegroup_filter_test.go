@@ -2,12 +2,18 @@ package cmdutils_test
 
 import (
 	"bytes"
+	"encoding/json"
 
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 
 	. "github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 )
@@ -125,6 +131,49 @@ var _ = Describe("nodegroup filter", func() {
 			Expect(excluded).To(HaveLen(1))
 			Expect(excluded.HasAll("test-ng1b")).To(BeTrue())
 		})
+
+		It("should match label selector", func() {
+			err := filter.SetLabelSelector("group=b,seq!=2")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(filter.MatchNodeGroup("test-ng1b", map[string]string{"group": "b", "seq": "1"})).To(BeTrue())
+			Expect(filter.MatchNodeGroup("test-ng1a", map[string]string{"group": "a", "seq": "1"})).To(BeFalse())
+			Expect(filter.MatchNodeGroup("test-ng1b", nil)).To(BeFalse())
+
+			included, excluded := filter.MatchAll(cfg.NodeGroups)
+			Expect(included).To(HaveLen(3))
+			Expect(included.HasAll("test-ng1b", "test-ng2b", "test-ng3b")).To(BeTrue())
+			Expect(excluded).To(HaveLen(3))
+			Expect(excluded.HasAll("test-ng1a", "test-ng2a", "test-ng3a")).To(BeTrue())
+		})
+
+		It("should match label selector and globs together", func() {
+			err := filter.AppendIncludeGlobs(cfg.NodeGroups, "test-ng?a", "test-ng1b")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = filter.SetLabelSelector("seq in (1,2)")
+			Expect(err).ToNot(HaveOccurred())
+
+			included, excluded := filter.MatchAll(cfg.NodeGroups)
+			Expect(included).To(HaveLen(3))
+			Expect(included.HasAll("test-ng1a", "test-ng2a", "test-ng1b")).To(BeTrue())
+			Expect(excluded).To(HaveLen(3))
+			Expect(excluded.HasAll("test-ng3a", "test-ng2b", "test-ng3b")).To(BeTrue())
+
+			managed := []*api.ManagedNodeGroup{
+				{Name: "test-ng1a", Labels: map[string]string{"seq": "1"}},
+				{Name: "test-ng3a", Labels: map[string]string{"seq": "3"}},
+				{Name: "test-ng1b"},
+			}
+			matching := filter.FilterMatchingManaged(managed)
+			Expect(matching).To(HaveLen(1))
+			Expect(matching[0].Name).To(Equal("test-ng1a"))
+		})
+
+		It("should reject an invalid label selector", func() {
+			err := filter.SetLabelSelector("group in b")
+			Expect(err).To(HaveOccurred())
+		})
 	})
 
 	Context("ForEach", func() {
@@ -571,3 +620,78 @@ const expected = `
 		]
   }
 `
+
+var _ = Describe("SelectExistingNodeGroups", func() {
+	var (
+		p            *mockprovider.MockProvider
+		cmd          *Cmd
+		stackManager *manager.StackCollection
+	)
+
+	nodeGroupStack := func(name string) *cfn.Stack {
+		return &cfn.Stack{
+			StackName:   aws.String("eksctl-test-cluster-nodegroup-" + name),
+			StackStatus: aws.String(cfn.StackStatusCreateComplete),
+			Tags:        []*cfn.Tag{{Key: aws.String(api.NodeGroupNameTag), Value: aws.String(name)}},
+		}
+	}
+
+	nodeGroupTemplate := func(labels map[string]string) string {
+		tags := []map[string]string{{"Key": "Name", "Value": "test-cluster-ng-Node"}}
+		for k, v := range labels {
+			tags = append(tags, map[string]string{"Key": "k8s.io/cluster-autoscaler/node-template/label/" + k, "Value": v})
+		}
+		template, err := json.Marshal(map[string]interface{}{
+			"Resources": map[string]interface{}{
+				"NodeGroup": map[string]interface{}{"Properties": map[string]interface{}{"Tags": tags}},
+			},
+		})
+		Expect(err).NotTo(HaveOccurred())
+		return string(template)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cmd = &Cmd{ClusterConfig: cfg}
+		stackManager = manager.NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) (shouldContinue bool))
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{
+				nodeGroupStack("ng-gpu"),
+				nodeGroupStack("ng-cpu"),
+				nodeGroupStack("ng-unknown"),
+			}}, true)
+		}).Return(nil)
+		templates := map[string]string{
+			"eksctl-test-cluster-nodegroup-ng-gpu": nodeGroupTemplate(map[string]string{api.NodeGroupNameLabel: "ng-gpu", "role": "gpu"}),
+			"eksctl-test-cluster-nodegroup-ng-cpu": nodeGroupTemplate(map[string]string{api.NodeGroupNameLabel: "ng-cpu", "role": "cpu"}),
+			// created before the labels were described by the tags of the ASG
+			"eksctl-test-cluster-nodegroup-ng-unknown": nodeGroupTemplate(nil),
+		}
+		for name, template := range templates {
+			stackName := name
+			p.MockCloudFormation().On("GetTemplate", mock.MatchedBy(func(input *cfn.GetTemplateInput) bool {
+				return *input.StackName == stackName
+			})).Return(&cfn.GetTemplateOutput{TemplateBody: aws.String(template)}, nil)
+		}
+		p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Return(nil)
+	})
+
+	It("doesn't select nodegroups whose labels are unknown with a negative selector", func() {
+		cmd.LabelSelector = "role!=gpu"
+		nodeGroups, managedNodeGroups, err := SelectExistingNodeGroups(cmd, stackManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodeGroups).To(ConsistOf("ng-cpu"))
+		Expect(managedNodeGroups).To(BeEmpty())
+	})
+
+	It("selects all nodegroups without a label selector, without reading their labels", func() {
+		nodeGroups, _, err := SelectExistingNodeGroups(cmd, stackManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(nodeGroups).To(ConsistOf("ng-gpu", "ng-cpu", "ng-unknown"))
+		p.MockCloudFormation().AssertNotCalled(GinkgoT(), "GetTemplate", mock.Anything)
+	})
+})
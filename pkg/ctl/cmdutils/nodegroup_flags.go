@@ -40,7 +40,7 @@ func AddCommonCreateNodeGroupFlags(fs *pflag.FlagSet, cmd *Cmd, ng *api.NodeGrou
 	ng.SSH.PublicKeyPath = fs.String("ssh-public-key", "", "SSH public key to use for nodes (import from local path, or use existing EC2 key pair)")
 
 	fs.StringVar(&ng.AMI, "node-ami", ami.ResolverStatic, "Advanced use cases only. If 'static' is supplied (default) then eksctl will use static AMIs; if 'auto' is supplied then eksctl will automatically set the AMI based on version/region/instance type; if any other value is supplied it will override the AMI to use for the nodes. Use with extreme care.")
-	fs.StringVar(&ng.AMIFamily, "node-ami-family", api.DefaultNodeImageFamily, "Advanced use cases only. If 'AmazonLinux2' is supplied (default), then eksctl will use the official AWS EKS AMIs (Amazon Linux 2); if 'Ubuntu1804' is supplied, then eksctl will use the official Canonical EKS AMIs (Ubuntu 18.04).")
+	fs.StringVar(&ng.AMIFamily, "node-ami-family", api.DefaultNodeImageFamily, "Advanced use cases only. If 'AmazonLinux2' is supplied (default), then eksctl will use the official AWS EKS AMIs (Amazon Linux 2); if 'Ubuntu1804' is supplied, then eksctl will use the official Canonical EKS AMIs (Ubuntu 18.04); if 'WindowsServer2019FullContainer' or 'WindowsServer2019CoreContainer' is supplied, then eksctl will use the official AWS EKS Windows AMIs.")
 
 	fs.BoolVarP(&ng.PrivateNetworking, "node-private-networking", "P", false, "whether to make nodegroup networking private")
 
@@ -50,6 +50,28 @@ func AddCommonCreateNodeGroupFlags(fs *pflag.FlagSet, cmd *Cmd, ng *api.NodeGrou
 	fs.StringSliceVar(&ng.AvailabilityZones, "node-zones", nil, "(inherited from the cluster if unspecified)")
 }
 
+// AddAMIResolverFlags adds the `--ami-resolver` and `--ami-manifest` flags, which let the AMIs of
+// nodegroups be resolved without calling AWS APIs, e.g. in air-gapped environments
+func AddAMIResolverFlags(fs *pflag.FlagSet, p *api.ProviderConfig) {
+	fs.StringVar(&p.AMIResolver, "ami-resolver", "", fmt.Sprintf("resolver of the AMIs of all nodegroups whose AMI is %q or %q; with %q AMIs are only resolved from the static AMIs and those of --ami-manifest, never by calling EC2", ami.ResolverStatic, ami.ResolverAuto, ami.ResolverStatic))
+	fs.StringVar(&p.AMIManifest, "ami-manifest", "", "YAML or JSON manifest of AMIs by version, image family, image class and region that take precedence over the static AMIs")
+}
+
+// validateAMIResolverFlag checks that --ami-resolver names a resolver
+func validateAMIResolverFlag(p *api.ProviderConfig) error {
+	switch p.AMIResolver {
+	case "", ami.ResolverStatic, ami.ResolverAuto:
+		return nil
+	default:
+		return fmt.Errorf("--ami-resolver must be %q or %q, not %q", ami.ResolverStatic, ami.ResolverAuto, p.AMIResolver)
+	}
+}
+
+// AddEnforceIMDSv2Flag adds the `--enforce-imdsv2` flag, which requires IMDSv2 on the nodes of all nodegroups
+func AddEnforceIMDSv2Flag(fs *pflag.FlagSet, enforceIMDSv2 *bool) {
+	fs.BoolVar(enforceIMDSv2, "enforce-imdsv2", false, "require IMDSv2 on the nodes of all nodegroups, fails for nodegroups that allow IMDSv1")
+}
+
 // AddCommonCreateNodeGroupIAMAddonsFlags adds flags to set ng.IAM.WithAddonPolicies
 func AddCommonCreateNodeGroupIAMAddonsFlags(fs *pflag.FlagSet, ng *api.NodeGroup) {
 	ng.IAM.WithAddonPolicies.AutoScaler = new(bool)
@@ -66,8 +88,8 @@ func AddCommonCreateNodeGroupIAMAddonsFlags(fs *pflag.FlagSet, ng *api.NodeGroup
 	fs.BoolVar(ng.IAM.WithAddonPolicies.ALBIngress, "alb-ingress-access", false, "enable full access for alb-ingress-controller")
 }
 
-// AddNodeGroupFilterFlags add common `--include` and `--exclude` flags for filtering nodegroups
-func AddNodeGroupFilterFlags(fs *pflag.FlagSet, includeGlobs, excludeGlobs *[]string) {
+// AddNodeGroupFilterFlags add common `--include`, `--exclude` and `--label-selector` flags for filtering nodegroups
+func AddNodeGroupFilterFlags(fs *pflag.FlagSet, includeGlobs, excludeGlobs *[]string, labelSelector *string) {
 	fs.StringSliceVar(includeGlobs, "only", nil, "")
 	_ = fs.MarkDeprecated("only", "use --include")
 
@@ -76,4 +98,7 @@ func AddNodeGroupFilterFlags(fs *pflag.FlagSet, includeGlobs, excludeGlobs *[]st
 
 	fs.StringSliceVar(excludeGlobs, "exclude", nil,
 		"nodegroups to exclude (list of globs), e.g.: 'ng-team-?,prod-*'")
+
+	fs.StringVarP(labelSelector, "label-selector", "l", "",
+		"nodegroups to include by their labels, e.g.: 'lifecycle=spot,team!=critical'")
 }
@@ -0,0 +1,44 @@
+package cmdutils
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// PodIdentityAssociationOptions holds the flags of pod identity association commands
+type PodIdentityAssociationOptions struct {
+	Namespace           string
+	ServiceAccountName  string
+	RoleARN             string
+	RoleName            string
+	PermissionsBoundary string
+	AttachPolicyARNs    []string
+}
+
+// AddPodIdentityAssociationFlags adds flags that configure a pod identity association, for 'eksctl create podidentityassociation'
+func AddPodIdentityAssociationFlags(fs *pflag.FlagSet, options *PodIdentityAssociationOptions) {
+	AddPodIdentityAssociationNameFlags(fs, options)
+	fs.StringVar(&options.RoleARN, "role-arn", "", "ARN of an existing IAM role, which must trust pods.eks.amazonaws.com")
+	fs.StringVar(&options.RoleName, "role-name", "", "name of the IAM role that eksctl creates")
+	fs.StringVar(&options.PermissionsBoundary, "permissions-boundary", "", "ARN of the policy that sets the permissions boundary of the IAM role that eksctl creates")
+	fs.StringSliceVar(&options.AttachPolicyARNs, "attach-policy-arn", nil, "ARN of the policy to attach to the IAM role that eksctl creates")
+}
+
+// AddPodIdentityAssociationNameFlags adds the --namespace and --service-account-name flags
+func AddPodIdentityAssociationNameFlags(fs *pflag.FlagSet, options *PodIdentityAssociationOptions) {
+	fs.StringVar(&options.Namespace, "namespace", "default", "namespace of the service account")
+	fs.StringVar(&options.ServiceAccountName, "service-account-name", "", "name of the service account")
+}
+
+// ToPodIdentityAssociation creates a pod identity association from the options
+func (o *PodIdentityAssociationOptions) ToPodIdentityAssociation() *api.PodIdentityAssociation {
+	return &api.PodIdentityAssociation{
+		Namespace:           o.Namespace,
+		ServiceAccountName:  o.ServiceAccountName,
+		RoleARN:             o.RoleARN,
+		RoleName:            o.RoleName,
+		PermissionsBoundary: o.PermissionsBoundary,
+		AttachPolicyARNs:    o.AttachPolicyARNs,
+	}
+}
@@ -3,8 +3,9 @@ package completion
 import (
 	"os"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // Command will create the `completion` commands
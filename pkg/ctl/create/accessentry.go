@@ -0,0 +1,78 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func createAccessEntryCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.AccessEntryOptions{}
+
+	cmd.SetDescription("accessentry", "Create access entries",
+		"Grants IAM roles or users access to the cluster through the EKS API instead of the aws-auth ConfigMap; "+
+			"with a config file, all accessEntries are created and the cluster is switched to accessConfig.authenticationMode")
+
+	cmd.SetRunFunc(func() error {
+		return doCreateAccessEntry(cmd, options)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddAccessEntryFlags(fs, options)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doCreateAccessEntry(cmd *cmdutils.Cmd, options *cmdutils.AccessEntryOptions) error {
+	if err := cmdutils.NewAccessEntryLoader(cmd, options, true).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	if cmd.ClusterConfigFile != "" {
+		if !cfg.UsesAccessEntries() {
+			return fmt.Errorf("accessConfig.authenticationMode must be %s or %s to create access entries", api.AuthenticationModeAPIAndConfigMap, api.AuthenticationModeAPI)
+		}
+		if err := ctl.UpdateAuthenticationMode(cfg); err != nil {
+			return err
+		}
+	} else {
+		mode, err := ctl.AuthenticationMode(cfg)
+		if err != nil {
+			return err
+		}
+		if mode == api.AuthenticationModeConfigMap {
+			return fmt.Errorf("cluster %q only uses the aws-auth ConfigMap, change its authentication mode with a config file or run 'eksctl utils migrate-to-access-entry' first", meta.Name)
+		}
+	}
+
+	return ctl.CreateAccessEntries(cfg)
+}
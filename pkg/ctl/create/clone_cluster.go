@@ -0,0 +1,121 @@
+package create
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+type cloneClusterCmdParams struct {
+	createClusterCmdParams
+
+	from           string
+	name           string
+	version        string
+	nodeGroupScale float64
+	dryRun         bool
+}
+
+// CloneClusterCmd creates the `clone cluster` command, which creates a cluster from the
+// exported config of another one
+func CloneClusterCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	params := &cloneClusterCmdParams{}
+	params.subnets = map[api.SubnetTopology]*[]string{
+		api.SubnetTopologyPrivate: {},
+		api.SubnetTopologyPublic:  {},
+	}
+
+	cmd.SetDescription("cluster", "Create a cluster from the config of an existing one",
+		"Exports the config of a live cluster, as 'eksctl utils write-config' does, and creates a new cluster with its own VPC from it, e.g. to reproduce an environment for testing upgrades")
+
+	cmd.SetRunFunc(func() error {
+		return doCloneCluster(cmd, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&params.from, "from", "", "name of the cluster to clone")
+		fs.StringVarP(&params.name, "name", "n", "", "name of the new cluster")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		fs.StringVar(&params.version, "version", "", "Kubernetes version of the new cluster (defaults to the version of the cloned cluster), nodegroups use the latest AMIs of the version then")
+		fs.Float64Var(&params.nodeGroupScale, "nodegroup-scale", 1, "multiply the minimum, maximum and desired sizes of nodegroups by the given factor, e.g. 0.5 for half the nodes")
+		fs.StringArrayVar(&cmd.ConfigOverrides, "set", nil, "override a field of the config of the new cluster, e.g. --set=vpc.cidr=10.10.0.0/16 or --set=nodeGroups[ng-1].instanceType=m5.large (can be repeated)")
+		fs.BoolVar(&params.dryRun, "dry-run", false, "write the config of the new cluster to stdout instead of creating it")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddRequestQuotaIncreasesFlag(fs, &params.requestQuotaIncreases)
+		cmdutils.AddWaitNodesFlag(fs, &params.waitNodes)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+
+	cmd.FlagSetGroup.InFlagSet("Output kubeconfig", func(fs *pflag.FlagSet) {
+		cmdutils.AddCommonFlagsForKubeconfig(fs, &params.kubeconfigPath, &params.execOpts, &params.setContext, &params.autoKubeconfigPath, cmdutils.ClusterName("", ""))
+		fs.BoolVar(&params.writeKubeconfig, "write-kubeconfig", true, "toggle writing of kubeconfig")
+	})
+}
+
+func doCloneCluster(cmd *cmdutils.Cmd, params *cloneClusterCmdParams) error {
+	if params.from == "" {
+		return cmdutils.ErrMustBeSet("--from")
+	}
+	if params.name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+	if params.name == params.from {
+		return fmt.Errorf("--name and --from must be different")
+	}
+	if params.nodeGroupScale <= 0 {
+		return fmt.Errorf("--nodegroup-scale must be greater than 0")
+	}
+
+	source := cmd.ClusterConfig
+	source.Metadata.Name = params.from
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", source.Metadata.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if err := ctl.ExportClusterConfig(source); err != nil {
+		return errors.Wrapf(err, "exporting configuration of cluster %q", params.from)
+	}
+	logger.Info("cloning %d nodegroup(s), %d managed nodegroup(s), %d Fargate profile(s) and %d addon(s) of cluster %q",
+		len(source.NodeGroups), len(source.ManagedNodeGroups), len(source.FargateProfiles), len(source.Addons), params.from)
+
+	cfg := eks.CloneClusterConfig(source, params.name)
+	if params.version != "" && params.version != cfg.Metadata.Version {
+		cfg.Metadata.Version = params.version
+		for _, ng := range cfg.NodeGroups {
+			// the AMI that the cloned nodegroup runs is for the previous version
+			ng.AMI = api.NodeImageResolverAuto
+		}
+	}
+	if params.nodeGroupScale != 1 {
+		eks.ScaleNodeGroups(cfg, params.nodeGroupScale)
+	}
+	if err := eks.ApplyConfigOverrides(cfg, cmd.ConfigOverrides); err != nil {
+		return err
+	}
+
+	if params.dryRun {
+		return cmdutils.WriteClusterConfig(cfg, "")
+	}
+
+	if cfg.VPC.NAT == nil || !api.IsSetAndNonEmptyString(cfg.VPC.NAT.Gateway) {
+		cfg.VPC.NAT = api.DefaultClusterNAT()
+	}
+	cmd.ClusterConfig = cfg
+	return createCluster(cmd, cmdutils.NewNodeGroupFilter(), &params.createClusterCmdParams)
+}
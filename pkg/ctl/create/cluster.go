@@ -4,31 +4,38 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/iam"
 	"github.com/weaveworks/eksctl/pkg/kops"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
+	"github.com/weaveworks/eksctl/pkg/quotas"
+	"github.com/weaveworks/eksctl/pkg/ssh"
 	"github.com/weaveworks/eksctl/pkg/utils"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
 type createClusterCmdParams struct {
-	writeKubeconfig      bool
-	kubeconfigPath       string
-	autoKubeconfigPath   bool
-	authenticatorRoleARN string
-	setContext           bool
-	availabilityZones    []string
+	writeKubeconfig    bool
+	kubeconfigPath     string
+	autoKubeconfigPath bool
+	execOpts           kubeconfig.ExecOptions
+	setContext         bool
+	availabilityZones  []string
 
 	kopsClusterNameForVPC string
 	subnets               map[api.SubnetTopology]*[]string
 	withoutNodeGroup      bool
+	enforceIMDSv2         bool
+	requestQuotaIncreases bool
+	waitNodes             bool
 }
 
 func createClusterCmd(cmd *cmdutils.Cmd) {
@@ -37,11 +44,16 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 	cmd.ClusterConfig = cfg
 
 	params := &createClusterCmdParams{}
+	var parallel bool
 
 	cmd.SetDescription("cluster", "Create a cluster", "")
 
 	cmd.SetRunFuncWithNameArg(func() error {
-		return doCreateCluster(cmd, ng, params)
+		return cmdutils.ForEachClusterConfig(cmd, parallel, func(cmd *cmdutils.Cmd) error {
+			// kubeconfig path is set for each cluster
+			clusterParams := *params
+			return doCreateCluster(cmd, ng, &clusterParams)
+		})
 	})
 
 	exampleClusterName := cmdutils.ClusterName("", "")
@@ -53,14 +65,20 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
 		fs.StringSliceVar(&params.availabilityZones, "zones", nil, "(auto-select if unspecified)")
 		cmdutils.AddVersionFlag(fs, cfg.Metadata, "")
-		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddParallelFlag(fs, &parallel)
+		cmdutils.AddNotifyURLFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddRequestQuotaIncreasesFlag(fs, &params.requestQuotaIncreases)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("Initial nodegroup", func(fs *pflag.FlagSet) {
 		fs.StringVar(&ng.Name, "nodegroup-name", "", fmt.Sprintf("name of the nodegroup (generated if unspecified, e.g. %q)", exampleNodeGroupName))
 		fs.BoolVar(&params.withoutNodeGroup, "without-nodegroup", false, "if set, initial nodegroup will not be created")
 		cmdutils.AddCommonCreateNodeGroupFlags(fs, cmd, ng)
+		cmdutils.AddEnforceIMDSv2Flag(fs, &params.enforceIMDSv2)
+		cmdutils.AddAMIResolverFlags(fs, cmd.ProviderConfig)
+		cmdutils.AddWaitNodesFlag(fs, &params.waitNodes)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("Cluster and nodegroup add-ons", func(fs *pflag.FlagSet) {
@@ -80,7 +98,7 @@ func createClusterCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
 
 	cmd.FlagSetGroup.InFlagSet("Output kubeconfig", func(fs *pflag.FlagSet) {
-		cmdutils.AddCommonFlagsForKubeconfig(fs, &params.kubeconfigPath, &params.authenticatorRoleARN, &params.setContext, &params.autoKubeconfigPath, exampleClusterName)
+		cmdutils.AddCommonFlagsForKubeconfig(fs, &params.kubeconfigPath, &params.execOpts, &params.setContext, &params.autoKubeconfigPath, exampleClusterName)
 		fs.BoolVar(&params.writeKubeconfig, "write-kubeconfig", true, "toggle writing of kubeconfig")
 	})
 }
@@ -92,6 +110,19 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		return err
 	}
 
+	if params.enforceIMDSv2 {
+		for _, ng := range cmd.ClusterConfig.NodeGroups {
+			if err := api.EnforceIMDSv2(ng); err != nil {
+				return err
+			}
+		}
+	}
+
+	return createCluster(cmd, ngFilter, params)
+}
+
+// createCluster creates the cluster of the loaded config, along with its nodegroups that match ngFilter
+func createCluster(cmd *cmdutils.Cmd, ngFilter *cmdutils.NodeGroupFilter, params *createClusterCmdParams) error {
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
@@ -105,6 +136,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 
 	if cfg.Metadata.Version == "" {
 		cfg.Metadata.Version = api.DefaultVersion
+		if cfg.HasWindowsNodeGroup() {
+			cfg.Metadata.Version = api.Version1_14
+			logger.Info("will use version %s, as it's required for Windows nodegroups", cfg.Metadata.Version)
+		}
 	}
 	if cfg.Metadata.Version != api.DefaultVersion {
 		if !isValidVersion(cfg.Metadata.Version) {
@@ -119,6 +154,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		return err
 	}
 
+	if err := params.execOpts.Validate(); err != nil {
+		return err
+	}
+
 	if params.autoKubeconfigPath {
 		if params.kubeconfigPath != kubeconfig.DefaultPath {
 			return fmt.Errorf("--kubeconfig and --auto-kubeconfig %s", cmdutils.IncompatibleFlags)
@@ -138,6 +177,11 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 			}
 		}
 	}
+	if cfg.VPC.SubnetDiscovery != nil {
+		if err := vpc.DiscoverSubnets(ctl.Provider, cfg); err != nil {
+			return err
+		}
+	}
 	filteredNodeGroups := ngFilter.FilterMatching(cfg.NodeGroups)
 	subnetsGiven := cfg.HasAnySubnets() // this will be false when neither flags nor config has any subnets
 
@@ -196,6 +240,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 				}
 			}
 
+			if err := vpc.Preflight(ctl.Provider, cfg, ctl.AccountID()); err != nil {
+				return err
+			}
+
 			logger.Success("using %s from kops cluster %q", subnetInfo(), params.kopsClusterNameForVPC)
 			logger.Warning(customNetworkingNotice)
 			return nil
@@ -225,6 +273,10 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 			}
 		}
 
+		if err := vpc.Preflight(ctl.Provider, cfg, ctl.AccountID()); err != nil {
+			return err
+		}
+
 		logger.Success("using existing %s", subnetInfo())
 		logger.Warning(customNetworkingNotice)
 		return nil
@@ -235,13 +287,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 	}
 
 	for _, ng := range filteredNodeGroups {
-		// resolve AMI
-		if err := ctl.EnsureAMI(meta.Version, ng); err != nil {
-			return err
-		}
-		logger.Info("nodegroup %q will use %q [%s/%s]", ng.Name, ng.AMI, ng.AMIFamily, cfg.Metadata.Version)
-
-		if err := ctl.SetNodeLabels(ng, meta); err != nil {
+		if err := PrepareNodeGroup(ctl, meta, ng); err != nil {
 			return err
 		}
 
@@ -249,11 +295,29 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		// fingerprint, so if unique keys provided, each will get
 		// loaded and used as intended and there is no need to have
 		// nodegroup name in the key name
-		if err := loadSSHKey(ng, meta.Name, ctl.Provider); err != nil {
+		if err := ssh.LoadKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
 			return err
 		}
 	}
 
+	for _, ng := range cfg.ManagedNodeGroups {
+		if err := ssh.LoadKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
+			return err
+		}
+	}
+
+	if err := ctl.CheckInstanceTypeOfferings(cfg, filteredNodeGroups, cfg.ManagedNodeGroups); err != nil {
+		return err
+	}
+
+	if err := iam.CheckNodeGroupRoles(ctl.Provider, filteredNodeGroups, cfg.ManagedNodeGroups); err != nil {
+		return err
+	}
+
+	if err := quotas.Preflight(ctl.Provider, cfg, filteredNodeGroups, cfg.ManagedNodeGroups, true, params.requestQuotaIncreases); err != nil {
+		return err
+	}
+
 	logger.Info("using Kubernetes version %s", meta.Version)
 	logger.Info("creating %s", meta.LogString())
 
@@ -261,7 +325,7 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 	// we should also make a call to resolve the AMI and write the result, similarly
 	// the body of the SSH key can be read
 
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
@@ -273,17 +337,22 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 			ngFilter.LogInfo(cfg.NodeGroups)
 			logger.Info("will create a CloudFormation stack for cluster itself and %d nodegroup stack(s)", len(filteredNodeGroups))
 		}
+		if len(cfg.ManagedNodeGroups) > 0 {
+			logger.Info("will create %d managed nodegroup(s)", len(cfg.ManagedNodeGroups))
+		}
 		logger.Info("if you encounter any issues, check CloudFormation console or try 'eksctl utils describe-stacks --region=%s --name=%s'", meta.Region, meta.Name)
-		tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(filteredNodeGroups)
+		var postClusterCreationTasks []manager.Task
+		if cfg.HasPodSubnets() {
+			postClusterCreationTasks = append(postClusterCreationTasks, ctl.NewTaskToConfigureCustomNetworking(cfg))
+		}
+		tasks := stackManager.NewTasksToCreateClusterWithNodeGroups(filteredNodeGroups, cfg.ManagedNodeGroups, postClusterCreationTasks...)
 		ctl.AppendExtraClusterConfigTasks(cfg, tasks)
 
 		logger.Info(tasks.Describe())
 		if errs := tasks.DoAllSync(); len(errs) > 0 {
 			logger.Info("%d error(s) occurred and cluster hasn't been created properly, you may wish to check CloudFormation console", len(errs))
 			logger.Info("to cleanup resources, run 'eksctl delete cluster --region=%s --name=%s'", meta.Region, meta.Name)
-			for _, err := range errs {
-				logger.Critical("%s\n", err.Error())
-			}
+			cmdutils.LogTaskErrors(cmd, stackManager, errs)
 			return fmt.Errorf("failed to create cluster %q", meta.Name)
 		}
 	}
@@ -296,7 +365,11 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		var kubeconfigContextName string
 
 		if params.writeKubeconfig {
-			kubectlConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), params.authenticatorRoleARN, ctl.Provider.Profile())
+			params.execOpts.Profile = ctl.Provider.Profile()
+			kubectlConfig, err := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), params.execOpts)
+			if err != nil {
+				return errors.Wrap(err, "generating kubeconfig")
+			}
 			kubeconfigContextName = kubectlConfig.CurrentContext
 
 			params.kubeconfigPath, err = kubeconfig.Write(params.kubeconfigPath, *kubectlConfig, params.setContext)
@@ -318,23 +391,43 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 			return err
 		}
 
-		for _, ng := range filteredNodeGroups {
-			// authorise nodes to join
-			if err = authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
-				return err
+		if len(cfg.IAMIdentityMappings) > 0 {
+			if err := authconfigmap.AddIdentityMappings(clientSet, cfg.IAMIdentityMappings); err != nil {
+				return errors.Wrap(err, "adding IAM identity mappings to auth ConfigMap")
 			}
+		}
+
+		// authorise nodes to join
+		if _, err = authconfigmap.AddNodeGroups(clientSet, filteredNodeGroups); err != nil {
+			return err
+		}
 
+		if params.waitNodes {
 			// wait for nodes to join
-			if err = ctl.WaitForNodes(clientSet, ng); err != nil {
+			if err := waitForNodes(cmd, ctl, clientSet, filteredNodeGroups); err != nil {
 				return err
 			}
+		}
 
-			// if GPU instance type, give instructions
-			if utils.IsGPUInstanceType(ng.InstanceType) || (ng.InstancesDistribution != nil && utils.HasGPUInstanceType(ng.InstancesDistribution.InstanceTypes)) {
-				logger.Info("as you are using a GPU optimized instance type you will need to install NVIDIA Kubernetes device plugin.")
-				logger.Info("\t see the following page for instructions: https://github.com/NVIDIA/k8s-device-plugin")
-			}
+		if err := setupGPUNodeGroups(ctl, cfg, filteredNodeGroups); err != nil {
+			return err
+		}
 
+		if err := setupEFANodeGroups(ctl, cfg, filteredNodeGroups); err != nil {
+			return err
+		}
+
+		if err := checkARM64Support(clientSet, filteredNodeGroups); err != nil {
+			return err
+		}
+
+		if cfg.HasHelmReleases() {
+			tasks := ctl.NewTasksToInstallHelmReleases(cfg)
+			logger.Info(tasks.Describe())
+			if errs := tasks.DoAllSync(); len(errs) > 0 {
+				cmdutils.LogTaskErrors(cmd, ctl.NewStackManager(cfg), errs)
+				return fmt.Errorf("failed to install Helm releases to cluster %q", meta.Name)
+			}
 		}
 
 		// check kubectl version, and offer install instructions if missing or old
@@ -350,9 +443,15 @@ func doCreateCluster(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *createCluster
 		}
 	}
 
+	if cfg.HasGitOps() {
+		if err := cmdutils.BootstrapFlux(cmd, ctl); err != nil {
+			return errors.Wrap(err, "bootstrapping Flux")
+		}
+	}
+
 	logger.Success("%s is ready", meta.LogString())
 
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
@@ -14,6 +14,10 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createNodeGroupCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createIAMServiceAccountCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createIAMIdentityMappingCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createAccessEntryCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createPodIdentityAssociationCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createAddonCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, createFargateProfileCmd)
 
 	return verbCmd
 }
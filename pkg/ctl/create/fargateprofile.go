@@ -0,0 +1,83 @@
+package create
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func createFargateProfileCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	// version of the control plane is only known once the cluster is found
+	cfg.Metadata.Version = ""
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.FargateProfileOptions{}
+
+	cmd.SetDescription("fargateprofile", "Create a Fargate profile", "")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doCreateFargateProfile(cmd, options)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to add the Fargate profile to")
+
+		cmdutils.AddFargateProfileFlags(fs, options)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doCreateFargateProfile(cmd *cmdutils.Cmd, options *cmdutils.FargateProfileOptions) error {
+	if err := cmdutils.NewFargateProfileLoader(cmd, options, true).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	meta.Version = ctl.ControlPlaneVersion()
+	if err := api.ValidateClusterConfig(cfg); err != nil {
+		return err
+	}
+
+	for _, profile := range cfg.FargateProfiles {
+		if profile.PodExecutionRoleARN == "" {
+			if err := ctl.EnsureFargatePodExecutionRole(cfg); err != nil {
+				return err
+			}
+			break
+		}
+	}
+
+	client := ctl.NewFargateClient(cfg)
+	for _, profile := range cfg.FargateProfiles {
+		if profile.PodExecutionRoleARN == "" {
+			profile.PodExecutionRoleARN = *cfg.IAM.FargatePodExecutionRoleARN
+		}
+		if err := client.CreateProfile(profile); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,7 +1,6 @@
 package create
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/lithammer/dedent"
 	"github.com/spf13/pflag"
 
@@ -9,6 +8,7 @@ import (
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
@@ -21,6 +21,9 @@ func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 			Note aws-iam-authenticator only considers the last entry for any given
 			role. If you create a duplicate entry it will shadow all the previous
 			username and groups mapping.
+
+			When --arn is not given, the mappings in iamIdentityMappings of
+			the config file are created instead.
 		`),
 	)
 
@@ -38,7 +41,7 @@ func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddIAMIdentityMappingARNFlags(fs, cmd, &arn)
 		cmdutils.AddNameFlag(fs, cfg.Metadata)
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
-		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddConfigFileFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
@@ -46,17 +49,21 @@ func createIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 }
 
 func doCreateIAMIdentityMapping(cmd *cmdutils.Cmd, arn string, username string, groups []string) error {
-	id, err := iam.NewIdentity(arn, username, groups)
-	if err != nil {
-		return err
-	}
-
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
 
 	cfg := cmd.ClusterConfig
 
+	fromConfigFile := arn == "" && len(cfg.IAMIdentityMappings) > 0
+	var id iam.Identity
+	if !fromConfigFile {
+		var err error
+		if id, err = iam.NewIdentity(arn, username, groups); err != nil {
+			return err
+		}
+	}
+
 	ctl, err := cmd.NewCtl()
 	if err != nil {
 		return err
@@ -78,29 +85,29 @@ func doCreateIAMIdentityMapping(cmd *cmdutils.Cmd, arn string, username string,
 	if err != nil {
 		return err
 	}
-	acm, err := authconfigmap.NewFromClientSet(clientSet)
-	if err != nil {
-		return err
-	}
 
-	// Check whether role already exists.
-	identities, err := acm.Identities()
-	if err != nil {
-		return err
+	if fromConfigFile {
+		logger.Info("creating %d IAM identity mapping(s) defined in the config file", len(cfg.IAMIdentityMappings))
+		return authconfigmap.AddIdentityMappings(clientSet, cfg.IAMIdentityMappings)
 	}
 
-	createdArn := id.ARN() // The call to Valid above makes sure this cannot error
-	for _, identity := range identities {
-		arn := identity.ARN()
+	return authconfigmap.Update(clientSet, func(acm *authconfigmap.AuthConfigMap) error {
+		// Check whether role already exists.
+		identities, err := acm.Identities()
+		if err != nil {
+			return err
+		}
+
+		createdArn := id.ARN() // The call to Valid above makes sure this cannot error
+		for _, identity := range identities {
+			arn := identity.ARN()
 
-		if createdArn == arn {
-			logger.Warning("found existing mappings with same arn %q (which will be shadowed by your new mapping)", createdArn)
-			break
+			if createdArn == arn {
+				logger.Warning("found existing mappings with same arn %q (which will be shadowed by your new mapping)", createdArn)
+				break
+			}
 		}
-	}
 
-	if err := acm.AddIdentity(id); err != nil {
-		return err
-	}
-	return acm.Save()
+		return acm.AddIdentity(id)
+	})
 }
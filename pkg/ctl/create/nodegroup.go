@@ -3,15 +3,17 @@ package create
 import (
 	"fmt"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
-	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/quotas"
+	"github.com/weaveworks/eksctl/pkg/ssh"
 )
 
 func createNodeGroupCmd(cmd *cmdutils.Cmd) {
@@ -19,14 +21,19 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 	ng := cfg.NewNodeGroup()
 	cmd.ClusterConfig = cfg
 
-	var updateAuthConfigMap bool
+	var (
+		updateAuthConfigMap   bool
+		enforceIMDSv2         bool
+		requestQuotaIncreases bool
+		waitNodes             bool
+	)
 
 	cfg.Metadata.Version = "auto"
 
 	cmd.SetDescription("nodegroup", "Create a nodegroup", "", "ng")
 
 	cmd.SetRunFuncWithNameArg(func() error {
-		return doCreateNodeGroups(cmd, updateAuthConfigMap)
+		return doCreateNodeGroups(cmd, updateAuthConfigMap, enforceIMDSv2, requestQuotaIncreases, waitNodes)
 	})
 
 	exampleNodeGroupName := cmdutils.NodeGroupName("", "")
@@ -35,15 +42,20 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to add the nodegroup to")
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
 		cmdutils.AddVersionFlag(fs, cfg.Metadata, `for nodegroups "auto" and "latest" can be used to automatically inherit version from the control plane or force latest`)
-		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
-		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude, &cmd.LabelSelector)
 		cmdutils.AddUpdateAuthConfigMap(fs, &updateAuthConfigMap, "Remove nodegroup IAM role from aws-auth configmap")
+		cmdutils.AddNotifyURLFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddRequestQuotaIncreasesFlag(fs, &requestQuotaIncreases)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("New nodegroup", func(fs *pflag.FlagSet) {
 		fs.StringVarP(&ng.Name, "name", "n", "", fmt.Sprintf("name of the new nodegroup (generated if unspecified, e.g. %q)", exampleNodeGroupName))
 		cmdutils.AddCommonCreateNodeGroupFlags(fs, cmd, ng)
+		cmdutils.AddEnforceIMDSv2Flag(fs, &enforceIMDSv2)
+		cmdutils.AddAMIResolverFlags(fs, cmd.ProviderConfig)
+		cmdutils.AddWaitNodesFlag(fs, &waitNodes)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("IAM addons", func(fs *pflag.FlagSet) {
@@ -53,13 +65,21 @@ func createNodeGroupCmd(cmd *cmdutils.Cmd) {
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
 }
 
-func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
+func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap, enforceIMDSv2, requestQuotaIncreases, waitNodes bool) error {
 	ngFilter := cmdutils.NewNodeGroupFilter()
 
 	if err := cmdutils.NewCreateNodeGroupLoader(cmd, ngFilter).Load(); err != nil {
 		return err
 	}
 
+	if enforceIMDSv2 {
+		for _, ng := range cmd.ClusterConfig.NodeGroups {
+			if err := api.EnforceIMDSv2(ng); err != nil {
+				return err
+			}
+		}
+	}
+
 	cfg := cmd.ClusterConfig
 	meta := cmd.ClusterConfig.Metadata
 
@@ -96,13 +116,7 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 	filteredNodeGroups := ngFilter.FilterMatching(cfg.NodeGroups)
 
 	for _, ng := range filteredNodeGroups {
-		// resolve AMI
-		if err := ctl.EnsureAMI(meta.Version, ng); err != nil {
-			return err
-		}
-		logger.Info("nodegroup %q will use %q [%s/%s]", ng.Name, ng.AMI, ng.AMIFamily, cfg.Metadata.Version)
-
-		if err := ctl.SetNodeLabels(ng, meta); err != nil {
+		if err := PrepareNodeGroup(ctl, meta, ng); err != nil {
 			return err
 		}
 
@@ -110,12 +124,40 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 		// fingerprint, so if unique keys provided, each will get
 		// loaded and used as intended and there is no need to have
 		// nodegroup name in the key name
-		if err := loadSSHKey(ng, meta.Name, ctl.Provider); err != nil {
+		if err := ssh.LoadKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
+			return err
+		}
+	}
+
+	managedNodeGroups := []*api.ManagedNodeGroup{}
+	for _, ng := range ngFilter.FilterMatchingManaged(cfg.ManagedNodeGroups) {
+		existing, err := stackManager.DescribeManagedNodeGroup(ng.Name)
+		if err != nil {
 			return err
 		}
+		if existing != nil {
+			logger.Info("managed nodegroup %q already exists, will not create it again", ng.Name)
+			continue
+		}
+		if err := ssh.LoadKey(ng.SSH, meta.Name, ng.Name, ctl.Provider); err != nil {
+			return err
+		}
+		managedNodeGroups = append(managedNodeGroups, ng)
+	}
+
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
+		return err
+	}
+
+	if err := ctl.CheckInstanceTypeOfferings(cfg, filteredNodeGroups, managedNodeGroups); err != nil {
+		return err
+	}
+
+	if err := iam.CheckNodeGroupRoles(ctl.Provider, filteredNodeGroups, managedNodeGroups); err != nil {
+		return err
 	}
 
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := quotas.Preflight(ctl.Provider, cfg, filteredNodeGroups, managedNodeGroups, false, requestQuotaIncreases); err != nil {
 		return err
 	}
 
@@ -129,17 +171,21 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 			logger.Info("will create a CloudFormation stack for each of %d nodegroups in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
 		}
 
+		if len(managedNodeGroups) > 0 {
+			logger.Info("will create %d managed nodegroup(s) in cluster %q", len(managedNodeGroups), cfg.Metadata.Name)
+		}
+
 		tasks := stackManager.NewTasksToCreateNodeGroups(filteredNodeGroups)
+		if managedNodeGroupTasks := stackManager.NewTasksToCreateManagedNodeGroups(managedNodeGroups); managedNodeGroupTasks.Len() > 0 {
+			managedNodeGroupTasks.IsSubTask = true
+			tasks.Append(managedNodeGroupTasks)
+		}
 		logger.Info(tasks.Describe())
 		errs := tasks.DoAllSync()
 		if len(errs) > 0 {
 			logger.Info("%d error(s) occurred and nodegroups haven't been created properly, you may wish to check CloudFormation console", len(errs))
 			logger.Info("to cleanup resources, run 'eksctl delete nodegroup --region=%s --cluster=%s --name=<name>' for each of the failed nodegroup", cfg.Metadata.Region, cfg.Metadata.Name)
-			for _, err := range errs {
-				if err != nil {
-					logger.Critical("%s\n", err.Error())
-				}
-			}
+			cmdutils.LogTaskErrors(cmd, stackManager, errs)
 			return fmt.Errorf("failed to create nodegroups for cluster %q", cfg.Metadata.Name)
 		}
 	}
@@ -150,27 +196,45 @@ func doCreateNodeGroups(cmd *cmdutils.Cmd, updateAuthConfigMap bool) error {
 			return err
 		}
 
-		for _, ng := range filteredNodeGroups {
-			if updateAuthConfigMap {
-				// authorise nodes to join
-				if err = authconfigmap.AddNodeGroup(clientSet, ng); err != nil {
-					return err
-				}
+		if updateAuthConfigMap {
+			// authorise nodes to join
+			if _, err = authconfigmap.AddNodeGroups(clientSet, filteredNodeGroups); err != nil {
+				return err
+			}
 
+			if waitNodes {
 				// wait for nodes to join
-				if err = ctl.WaitForNodes(clientSet, ng); err != nil {
+				if err := waitForNodes(cmd, ctl, clientSet, filteredNodeGroups); err != nil {
 					return err
 				}
 			}
+		}
 
-			// if GPU instance type, give instructions
-			if utils.IsGPUInstanceType(ng.InstanceType) || (ng.InstancesDistribution != nil && utils.HasGPUInstanceType(ng.InstancesDistribution.InstanceTypes)) {
-				logger.Info("as you are using a GPU optimized instance type you will need to install NVIDIA Kubernetes device plugin.")
-				logger.Info("\t see the following page for instructions: https://github.com/NVIDIA/k8s-device-plugin")
+		if err := setupGPUNodeGroups(ctl, cfg, filteredNodeGroups); err != nil {
+			return err
+		}
+
+		if err := setupEFANodeGroups(ctl, cfg, filteredNodeGroups); err != nil {
+			return err
+		}
+
+		if err := checkARM64Support(clientSet, filteredNodeGroups); err != nil {
+			return err
+		}
+
+		for _, ng := range filteredNodeGroups {
+			if api.IsWindowsImage(ng.AMIFamily) {
+				if err := ctl.InstallVPCControllers(cfg); err != nil {
+					return err
+				}
+				break
 			}
 		}
 
 		logger.Success("created %d nodegroup(s) in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
+		if len(managedNodeGroups) > 0 {
+			logger.Success("created %d managed nodegroup(s) in cluster %q", len(managedNodeGroups), cfg.Metadata.Name)
+		}
 	}
 
 	if err := ctl.ValidateExistingNodeGroupsForCompatibility(cfg, stackManager); err != nil {
@@ -0,0 +1,63 @@
+package create
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func createPodIdentityAssociationCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.PodIdentityAssociationOptions{}
+
+	cmd.SetDescription("podidentityassociation", "Create pod identity associations",
+		"Grants the pods that use a service account the permissions of an IAM role via EKS Pod Identity, "+
+			"installing the eks-pod-identity-agent addon if needed; eksctl creates the role unless --role-arn is given")
+
+	cmd.SetRunFunc(func() error {
+		return doCreatePodIdentityAssociation(cmd, options)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddPodIdentityAssociationFlags(fs, options)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doCreatePodIdentityAssociation(cmd *cmdutils.Cmd, options *cmdutils.PodIdentityAssociationOptions) error {
+	if err := cmdutils.NewPodIdentityAssociationLoader(cmd, options, true).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	if err := ctl.EnsurePodIdentityAgent(cfg); err != nil {
+		return err
+	}
+
+	return ctl.CreatePodIdentityAssociations(cfg)
+}
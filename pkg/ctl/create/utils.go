@@ -4,13 +4,14 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
+	"k8s.io/client-go/kubernetes"
 
+	"github.com/weaveworks/eksctl/pkg/addons"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/eks"
-	"github.com/weaveworks/eksctl/pkg/ssh"
-	"github.com/weaveworks/eksctl/pkg/utils/file"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils"
 )
 
 func checkSubnetsGivenAsFlags(params *createClusterCmdParams) bool {
@@ -72,51 +73,139 @@ func isDeprecatedVersion(version string) bool {
 	return false
 }
 
-// loadSSHKey loads the ssh public key specified in the NodeGroup. The key should be specified
-// in only one way: by name (for a key existing in EC2), by path (for a key in a local file)
-// or by its contents (in the config-file). It also assumes that if ssh is enabled (SSH.Allow
-// == true) then one key was specified
-func loadSSHKey(ng *api.NodeGroup, clusterName string, provider api.ClusterProvider) error {
-	sshConfig := ng.SSH
-	if sshConfig.Allow == nil || *sshConfig.Allow == false {
-		return nil
+// PrepareNodeGroup resolves the AMI of a nodegroup and sets the labels, taints and placement that
+// eksctl adds to it, this has to be done before its stack is created
+func PrepareNodeGroup(ctl *eks.ClusterProvider, meta *api.ClusterMeta, ng *api.NodeGroup) error {
+	if err := ctl.EnsureAMI(meta.Version, ng); err != nil {
+		return err
+	}
+	logger.Info("nodegroup %q will use %q [%s/%s]", ng.Name, ng.AMI, ng.AMIFamily, meta.Version)
+
+	if err := ctl.SetNodeLabels(ng, meta); err != nil {
+		return err
 	}
 
-	switch {
+	prepareGPUNodeGroup(ng)
+	prepareEFANodeGroup(ng)
+	return nil
+}
+
+// prepareGPUNodeGroup taints GPU nodes that will get the NVIDIA device plugin,
+// this has to be done before the nodegroup is created
+func prepareGPUNodeGroup(ng *api.NodeGroup) {
+	if ng.GPU == nil || !api.IsEnabled(ng.GPU.InstallDevicePlugin) {
+		return
+	}
+	if !addons.IsGPUNodeGroup(ng) {
+		logger.Warning("gpu.installDevicePlugin will be ignored for nodegroup %q, as it doesn't use GPU instance types", ng.Name)
+		return
+	}
+	addons.SetNvidiaGPUTaint(ng)
+	logger.Info("nodegroup %q will be tainted with %q, pods that don't tolerate it won't be scheduled on GPU nodes", ng.Name, addons.NvidiaGPUTaintKey)
+}
 
-	// Load Key by content
-	case sshConfig.PublicKey != nil:
-		keyName, err := ssh.LoadKeyByContent(sshConfig.PublicKey, clusterName, ng.Name, provider)
-		if err != nil {
-			return err
+// setupGPUNodeGroups installs the NVIDIA device plugin if any of the
+// nodegroups ask for it, otherwise it prints instructions for GPU nodegroups
+func setupGPUNodeGroups(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, nodeGroups []*api.NodeGroup) error {
+	installDevicePlugin := false
+	for _, ng := range nodeGroups {
+		if !addons.IsGPUNodeGroup(ng) {
+			continue
+		}
+		if addons.WantsNvidiaDevicePlugin(ng) {
+			installDevicePlugin = true
+			continue
 		}
-		sshConfig.PublicKeyName = &keyName
+		logger.Info("as you are using a GPU optimized instance type you will need to install NVIDIA Kubernetes device plugin.")
+		logger.Info("\t see the following page for instructions: https://github.com/NVIDIA/k8s-device-plugin")
+		logger.Info("\t or set gpu.installDevicePlugin for nodegroup %q in the config file to let eksctl install it", ng.Name)
+	}
 
-	// Use key by name in EC2
-	case sshConfig.PublicKeyName != nil && *sshConfig.PublicKeyName != "":
-		if err := ssh.CheckKeyExistsInEC2(*sshConfig.PublicKeyName, provider); err != nil {
-			return err
+	if !installDevicePlugin {
+		return nil
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	return addons.NewNvidiaDevicePlugin(rawClient, false).Deploy()
+}
+
+// prepareEFANodeGroup labels nodes of EFA-enabled nodegroups for the EFA device plugin,
+// and launches them into a cluster placement group unless a placement group is configured;
+// this has to be done before the nodegroup is created
+func prepareEFANodeGroup(ng *api.NodeGroup) {
+	if !api.IsEnabled(ng.EFAEnabled) {
+		return
+	}
+	instanceTypes := []string{ng.InstanceType}
+	if api.HasMixedInstances(ng) {
+		instanceTypes = ng.InstancesDistribution.InstanceTypes
+	}
+	for _, instanceType := range instanceTypes {
+		if !utils.IsEFAInstanceType(instanceType) {
+			logger.Warning("instance type %q of nodegroup %q may not support EFA, nodes will fail to launch if it doesn't", instanceType, ng.Name)
 		}
-		logger.Info("using EC2 key pair %q", *sshConfig.PublicKeyName)
+	}
+	addons.SetEFALabel(ng)
+	if ng.Placement == nil {
+		ng.Placement = &api.NodeGroupPlacement{Strategy: api.PlacementStrategyCluster}
+		logger.Info("nodegroup %q will be launched into a cluster placement group, for the lowest latency between EFA-enabled nodes", ng.Name)
+	}
+}
 
-	// Local ssh key file
-	case file.Exists(*sshConfig.PublicKeyPath):
-		keyName, err := ssh.LoadKeyFromFile(*sshConfig.PublicKeyPath, clusterName, ng.Name, provider)
-		if err != nil {
-			return err
+// setupEFANodeGroups installs the EFA device plugin if any of the nodegroups enable EFA
+func setupEFANodeGroups(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, nodeGroups []*api.NodeGroup) error {
+	for _, ng := range nodeGroups {
+		if api.IsEnabled(ng.EFAEnabled) {
+			rawClient, err := ctl.NewRawClient(cfg)
+			if err != nil {
+				return err
+			}
+			return addons.NewEFADevicePlugin(rawClient, cfg.Metadata.Region, false).Deploy()
 		}
-		sshConfig.PublicKeyName = &keyName
+	}
+	return nil
+}
 
-	// A keyPath, when specified as a flag, can mean a local key (checked above) or a key name in EC2
-	default:
-		err := ssh.CheckKeyExistsInEC2(*sshConfig.PublicKeyPath, provider)
-		if err != nil {
-			return err
+// checkARM64Support warns about DaemonSets that may not run on arm64 nodes,
+// if any of the nodegroups use arm64 instance types
+func checkARM64Support(clientSet kubernetes.Interface, nodeGroups []*api.NodeGroup) error {
+	hasARMNodeGroup := false
+	for _, ng := range nodeGroups {
+		if addons.IsARMNodeGroup(ng) {
+			hasARMNodeGroup = true
+			break
 		}
-		sshConfig.PublicKeyName = sshConfig.PublicKeyPath
-		sshConfig.PublicKeyPath = nil
-		logger.Info("using EC2 key pair %q", *ng.SSH.PublicKeyName)
+	}
+	if !hasARMNodeGroup {
+		return nil
 	}
 
+	issues, err := addons.FindDaemonSetsWithoutARM64Support(clientSet)
+	if err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		logger.Warning("DaemonSet %s, pods may fail to start on arm64 nodes", issue)
+	}
+	return nil
+}
+
+// waitForNodes waits for the nodes of the nodegroups to join the cluster and become ready,
+// the nodegroups are waited for in parallel
+func waitForNodes(cmd *cmdutils.Cmd, ctl *eks.ClusterProvider, clientSet kubernetes.Interface, nodeGroups []*api.NodeGroup) error {
+	tasks := ctl.NewTasksToWaitForNodes(cmd.ClusterConfig, clientSet, nodeGroups)
+	if tasks.Len() == 0 {
+		return nil
+	}
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("nodes of %d nodegroup(s) haven't become ready in cluster %q", len(errs), cmd.ClusterConfig.Metadata.Name)
+	}
 	return nil
 }
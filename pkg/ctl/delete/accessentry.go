@@ -0,0 +1,74 @@
+package delete
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func deleteAccessEntryCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.AccessEntryOptions{}
+
+	cmd.SetDescription("accessentry", "Delete access entries",
+		"Deletes the access entry of an IAM role or user, or all accessEntries of a config file, along with their access policies")
+
+	cmd.SetRunFunc(func() error {
+		return doDeleteAccessEntry(cmd, options)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddAccessEntryPrincipalFlag(fs, options)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doDeleteAccessEntry(cmd *cmdutils.Cmd, options *cmdutils.AccessEntryOptions) error {
+	if err := cmdutils.NewAccessEntryLoader(cmd, options, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	principals := []string{}
+	if cmd.ClusterConfigFile != "" {
+		for _, entry := range cfg.AccessEntries {
+			principals = append(principals, entry.PrincipalARN)
+		}
+	} else if options.PrincipalARN == "" {
+		return cmdutils.ErrMustBeSet("--principal-arn")
+	} else {
+		principals = append(principals, options.PrincipalARN)
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	for _, principalARN := range principals {
+		if err := ctl.DeleteAccessEntry(cfg, principalARN); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package delete
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func deleteAddonCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	addon := &api.Addon{}
+
+	cmd.SetDescription("addon", "Delete an addon managed via EKS Addons API", "")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doDeleteAddon(cmd, addon)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to delete the addon from")
+		fs.StringVar(&addon.Name, "name", "", "name of the addon to delete")
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doDeleteAddon(cmd *cmdutils.Cmd, addon *api.Addon) error {
+	if err := cmdutils.NewAddonLoader(cmd, addon, true).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	addonManager, err := ctl.NewEKSAddonManager(cfg)
+	if err != nil {
+		return err
+	}
+
+	for _, addon := range cfg.EKSAddons() {
+		if err := addonManager.Delete(addon.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -3,16 +3,20 @@ package delete
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/drain"
 	"github.com/weaveworks/eksctl/pkg/elb"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
@@ -26,10 +30,35 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
+	var (
+		parallel          bool
+		disableProtection bool
+		force             bool
+		allRegions        bool
+		only              []string
+	)
+
 	cmd.SetDescription("cluster", "Delete a cluster", "")
 
 	cmd.SetRunFuncWithNameArg(func() error {
-		return doDeleteCluster(cmd)
+		if len(only) > 0 {
+			if err := manager.ValidateClusterResourceClasses(only); err != nil {
+				return errors.Wrap(err, "--only")
+			}
+			if disableProtection {
+				return fmt.Errorf("--disable-protection cannot be used with --only, as the cluster isn't deleted")
+			}
+		}
+		if parallel && !cmd.Yes {
+			return cmdutils.ErrParallelConfirmation()
+		}
+		run := func(cmd *cmdutils.Cmd) error {
+			return doDeleteCluster(cmd, disableProtection, force, only)
+		}
+		if cmdutils.CheckAllRegions(cmd, allRegions) {
+			return doDeleteClustersInAllRegions(cmd, parallel, run)
+		}
+		return cmdutils.ForEachClusterConfig(cmd, parallel, run)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
@@ -39,30 +68,83 @@ func deleteClusterCmd(cmd *cmdutils.Cmd) {
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
 
-		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddParallelFlag(fs, &parallel)
+		cmdutils.AddAllRegionsFlag(fs, &allRegions, fmt.Sprintf("delete the clusters created by eksctl in all regions that are enabled in the account, or the ones with the given name, same as --region=%s", cmdutils.AllRegions))
+		cmdutils.AddYesFlag(fs, cmd)
+		fs.BoolVar(&disableProtection, "disable-protection", false, "disable deletion protection of the cluster, if it's enabled, and delete it")
+		fs.BoolVar(&force, "force", false, "retry deletions of stacks that fail because some of their resources can't be deleted, retaining these resources")
+		fs.StringSliceVar(&only, "only", nil, fmt.Sprintf("delete only the given classes of resources, leaving the control plane, its network and other resources intact, any of %s", strings.Join(manager.ClusterResourceClasses(), ", ")))
+		cmdutils.AddNotifyURLFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
 }
 
-func handleErrors(errs []error, subject string) error {
-	logger.Info("%d error(s) occurred while deleting %s", len(errs), subject)
-	for _, err := range errs {
-		logger.Critical("%s\n", err.Error())
+// doDeleteClustersInAllRegions finds the clusters that have eksctl-managed stacks in all enabled regions,
+// and deletes them, or the ones with the given name
+func doDeleteClustersInAllRegions(cmd *cmdutils.Cmd, parallel bool, run func(*cmdutils.Cmd) error) error {
+	if cmd.ClusterConfigFile != "" {
+		return cmdutils.ErrCannotUseWithConfigFile("--all-regions")
+	}
+	name := cmd.ClusterConfig.Metadata.Name
+	if name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrNameFlagAndArg(name, cmd.NameArg)
+	}
+	if cmd.NameArg != "" {
+		name = cmd.NameArg
+	}
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	regions := ctl.EnabledRegions()
+	logger.Info("looking for clusters in %d regions", len(regions))
+	clusters, errs := ctl.ListEksctlClusters(regions)
+	for region, err := range errs {
+		logger.Critical("error listing clusters in %q region: %s", region, err.Error())
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to list clusters in %d of %d regions", len(errs), len(regions))
+	}
+
+	matching := []*api.ClusterMeta{}
+	for _, meta := range clusters {
+		if name == "" || meta.Name == name {
+			matching = append(matching, meta)
+		}
+	}
+	if len(matching) == 0 {
+		logger.Info("no clusters created by eksctl were found")
+		return nil
 	}
+	for _, meta := range matching {
+		logger.Info("will delete cluster %q in region %q", meta.Name, meta.Region)
+	}
+	return cmdutils.ForEachCluster(cmd, matching, parallel, run)
+}
+
+func handleErrors(cmd *cmdutils.Cmd, stackManager *manager.StackCollection, errs []error, subject string) error {
+	logger.Info("%d error(s) occurred while deleting %s", len(errs), subject)
+	cmdutils.LogTaskErrors(cmd, stackManager, errs)
 	return fmt.Errorf("failed to delete %s", subject)
 }
 
-func deleteDeprecatedStacks(stackManager *manager.StackCollection) (bool, error) {
+func deleteDeprecatedStacks(cmd *cmdutils.Cmd, stackManager *manager.StackCollection) (bool, error) {
 	tasks, err := stackManager.DeleteTasksForDeprecatedStacks()
 	if err != nil {
 		return true, err
 	}
 	if count := tasks.Len(); count > 0 {
+		cmdutils.ConfirmDeletion(cmd, stackManager, tasks)
 		logger.Info(tasks.Describe())
 		if errs := tasks.DoAllSync(); len(errs) > 0 {
-			return true, handleErrors(errs, "deprecated stacks")
+			return true, handleErrors(cmd, stackManager, errs, "deprecated stacks")
 		}
 		logger.Success("deleted all %s deperecated stacks", count)
 		return true, nil
@@ -70,7 +152,29 @@ func deleteDeprecatedStacks(stackManager *manager.StackCollection) (bool, error)
 	return false, nil
 }
 
-func doDeleteCluster(cmd *cmdutils.Cmd) error {
+// deleteLocalResources deletes the SSH keys that eksctl imported and the kubeconfig context of the cluster
+func deleteLocalResources(ctl *eks.ClusterProvider, meta *api.ClusterMeta) {
+	ssh.DeleteKeys(meta.Name, ctl.Provider)
+	kubeconfig.MaybeDeleteConfig(meta)
+}
+
+// cleanupNetworkInterfaces returns the task to cleanup dangling network interfaces of nodegroups that failed to be deleted
+func cleanupNetworkInterfaces(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) func(chan error, string) error {
+	return func(errs chan error, _ string) error {
+		logger.Info("trying to cleanup dangling network interfaces")
+		if err := ctl.LoadClusterVPC(cfg); err != nil {
+			return errors.Wrapf(err, "getting VPC configuration for cluster %q", cfg.Metadata.Name)
+		}
+
+		go func() {
+			errs <- vpc.CleanupNetworkInterfaces(ctl.Provider.EC2(), cfg)
+			close(errs)
+		}()
+		return nil
+	}
+}
+
+func doDeleteCluster(cmd *cmdutils.Cmd, disableProtection, force bool, only []string) error {
 	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
 		return err
 	}
@@ -91,7 +195,7 @@ func doDeleteCluster(cmd *cmdutils.Cmd) error {
 	}
 
 	logger.Info("deleting EKS cluster %q", meta.Name)
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
@@ -99,6 +203,15 @@ func doDeleteCluster(cmd *cmdutils.Cmd) error {
 		return err
 	}
 
+	stackManager := ctl.NewStackManager(cfg)
+
+	// this has to be checked before the deletion tasks are defined
+	if len(only) == 0 {
+		if err := stackManager.CheckDeletionProtection(disableProtection); err != nil {
+			return err
+		}
+	}
+
 	var (
 		clientSet kubernetes.Interface
 		oidc      *iamoidc.OpenIDConnectManager
@@ -121,22 +234,60 @@ func doDeleteCluster(cmd *cmdutils.Cmd) error {
 		}
 	}
 
-	stackManager := ctl.NewStackManager(cfg)
-
-	ssh.DeleteKeys(meta.Name, ctl.Provider)
-
-	kubeconfig.MaybeDeleteConfig(meta)
+	if len(only) > 0 {
+		return doDeleteClusterResources(cmd, ctl, stackManager, only, clusterOperable, oidc, clientSet, force)
+	}
 
-	if hasDeprecatedStacks, err := deleteDeprecatedStacks(stackManager); hasDeprecatedStacks {
+	if hasDeprecatedStacks, err := deleteDeprecatedStacks(cmd, stackManager); hasDeprecatedStacks {
 		if err != nil {
 			return err
 		}
+		deleteLocalResources(ctl, meta)
 		return nil
 	}
 
 	{
+		// only the stacks of registered clusters are deleted, everything else was created outside of eksctl
+		registered := false
+		if clusterStack, err := stackManager.DescribeClusterStack(); err == nil {
+			registered = manager.IsRegisteredClusterStack(clusterStack)
+		}
+
+		deleteOIDCProvider := clusterOperable && oidcSupported
+		tasks, err := stackManager.NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider, oidc, kubernetes.NewCachedClientSet(clientSet), cmd.Wait, force, cleanupNetworkInterfaces(ctl, cfg))
+
+		if err != nil {
+			return err
+		}
+
+		if tasks.Len() == 0 {
+			logger.Warning("no cluster resources were found for %q", meta.Name)
+			deleteLocalResources(ctl, meta)
+			return nil
+		}
+
+		// local resources, load balancers and Fargate profiles are deleted
+		// before the tasks run, so the deletion is confirmed first
+		cmdutils.ConfirmDeletion(cmd, stackManager, tasks)
+		if err := tasks.Confirmed(); err != nil {
+			return err
+		}
+
+		if disableProtection {
+			if err := stackManager.DisableDeletionProtection(); err != nil {
+				return err
+			}
+		}
+
+		if registered {
+			// the cluster remains, and so does its kubeconfig context
+			ssh.DeleteKeys(meta.Name, ctl.Provider)
+		} else {
+			deleteLocalResources(ctl, meta)
+		}
+
 		// only need to cleanup ELBs if the cluster has already been created.
-		if clusterOperable {
+		if clusterOperable && !registered {
 			ctx, cleanup := context.WithTimeout(context.Background(), 10*time.Minute)
 			defer cleanup()
 
@@ -144,38 +295,140 @@ func doDeleteCluster(cmd *cmdutils.Cmd) error {
 			if err := elb.Cleanup(ctx, ctl.Provider.EC2(), ctl.Provider.ELB(), ctl.Provider.ELBV2(), clientSet, cfg); err != nil {
 				return err
 			}
-		}
 
-		deleteOIDCProvider := clusterOperable && oidcSupported
-		tasks, err := stackManager.NewTasksToDeleteClusterWithNodeGroups(deleteOIDCProvider, oidc, kubernetes.NewCachedClientSet(clientSet), cmd.Wait, func(errs chan error, _ string) error {
-			logger.Info("trying to cleanup dangling network interfaces")
-			if err := ctl.LoadClusterVPC(cfg); err != nil {
-				return errors.Wrapf(err, "getting VPC configuration for cluster %q", cfg.Metadata.Name)
+			if err := ctl.DeleteFargateProfiles(cfg); err != nil {
+				return err
 			}
+		}
+
+		logger.Info(tasks.Describe())
+		if errs := tasks.DoAllSync(); len(errs) > 0 {
+			return handleErrors(cmd, stackManager, errs, "cluster with nodegroup(s)")
+		}
 
-			go func() {
-				errs <- vpc.CleanupNetworkInterfaces(ctl.Provider.EC2(), cfg)
-				close(errs)
-			}()
+		if registered {
+			logger.Success("all resources that eksctl created for cluster %q were deleted, the cluster itself was left intact", meta.Name)
 			return nil
-		})
+		}
+		logger.Success("all cluster resources were deleted")
+	}
 
-		if err != nil {
+	return nil
+}
+
+// doDeleteClusterResources deletes the given classes of resources of the cluster, leaving the rest of it intact
+func doDeleteClusterResources(cmd *cmdutils.Cmd, ctl *eks.ClusterProvider, stackManager *manager.StackCollection, only []string, clusterOperable bool, oidc *iamoidc.OpenIDConnectManager, clientSet kubernetes.Interface, force bool) error {
+	cfg := cmd.ClusterConfig
+	subject := fmt.Sprintf("%s of cluster %q", strings.Join(only, ", "), cfg.Metadata.Name)
+	if !clusterOperable {
+		return fmt.Errorf("cannot delete %s, as the cluster is not operable", subject)
+	}
+
+	tasks, err := stackManager.NewTasksToDeleteClusterResources(only, oidc, kubernetes.NewCachedClientSet(clientSet), force, cleanupNetworkInterfaces(ctl, cfg), func() error {
+		return ctl.DeleteFargateProfiles(cfg)
+	})
+	if err != nil {
+		return err
+	}
+	if tasks.Len() == 0 {
+		logger.Warning("no %s were found", subject)
+		return nil
+	}
+
+	// the nodes are drained and removed from the aws-auth ConfigMap before the tasks run,
+	// so the deletion is confirmed first
+	cmdutils.ConfirmDeletion(cmd, stackManager, tasks)
+	if err := tasks.Confirmed(); err != nil {
+		return err
+	}
+
+	var nodeRoleARNs []string
+	if sets.NewString(only...).Has(manager.ClusterResourcesNodeGroups) {
+		if nodeRoleARNs, err = prepareNodeGroupsForDeletion(ctl, stackManager, cfg, clientSet); err != nil {
 			return err
 		}
+	}
 
-		if tasks.Len() == 0 {
-			logger.Warning("no cluster resources were found for %q", meta.Name)
-			return nil
-		}
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		return handleErrors(cmd, stackManager, errs, subject)
+	}
 
-		logger.Info(tasks.Describe())
-		if errs := tasks.DoAllSync(); len(errs) > 0 {
-			return handleErrors(errs, "cluster with nodegroup(s)")
+	if err := deleteNodeAccessEntries(ctl, cfg, nodeRoleARNs); err != nil {
+		return err
+	}
+
+	logger.Success("deleted %s, the rest of the cluster was left intact", subject)
+	return nil
+}
+
+// prepareNodeGroupsForDeletion removes the nodegroups of a cluster that remains from the aws-auth ConfigMap and
+// drains their nodes, like delete nodegroup does; it returns the roles of the nodes of all nodegroups, including
+// managed ones, whose access entries are deleted once the nodegroups are gone
+func prepareNodeGroupsForDeletion(ctl *eks.ClusterProvider, stackManager *manager.StackCollection, cfg *api.ClusterConfig, clientSet kubernetes.Interface) ([]string, error) {
+	names, err := stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	nodeRoleARNs := sets.NewString()
+	for _, name := range names {
+		ng := api.NewNodeGroup()
+		ng.Name = name
+		if err := ctl.GetNodeGroupIAM(stackManager, cfg, ng); err != nil {
+			logger.Warning("error getting instance role ARN for nodegroup %q: %s", name, err.Error())
+		} else {
+			if err := authconfigmap.RemoveNodeGroup(clientSet, ng); err != nil {
+				logger.Warning(err.Error())
+			}
+			nodeRoleARNs.Insert(ng.IAM.InstanceRoleARN)
 		}
+		if err := drain.NodeGroup(clientSet, ng, ctl.Provider.WaitTimeout(), false); err != nil {
+			return nil, err
+		}
+	}
 
-		logger.Success("all cluster resources were deleted")
+	// managed nodegroups are drained by EKS
+	managedNames, err := stackManager.ListManagedNodeGroups()
+	if err != nil {
+		return nil, err
 	}
+	for _, name := range managedNames {
+		nodeGroup, err := stackManager.DescribeManagedNodeGroup(name)
+		if err != nil {
+			return nil, err
+		}
+		if nodeGroup != nil && nodeGroup.NodeRole != nil {
+			nodeRoleARNs.Insert(*nodeGroup.NodeRole)
+		}
+	}
+	return nodeRoleARNs.List(), nil
+}
 
+// deleteNodeAccessEntries deletes the access entries of the roles of deleted nodegroups, when the cluster uses
+// access entries
+func deleteNodeAccessEntries(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, nodeRoleARNs []string) error {
+	if len(nodeRoleARNs) == 0 {
+		return nil
+	}
+	mode, err := ctl.AuthenticationMode(cfg)
+	if err != nil {
+		return err
+	}
+	if mode == api.AuthenticationModeConfigMap {
+		return nil
+	}
+	existing, err := ctl.ListAccessEntries(cfg)
+	if err != nil {
+		return err
+	}
+	existingEntries := sets.NewString(existing...)
+	for _, roleARN := range nodeRoleARNs {
+		if !existingEntries.Has(roleARN) {
+			continue
+		}
+		if err := ctl.DeleteAccessEntry(cfg, roleARN); err != nil {
+			return err
+		}
+	}
 	return nil
 }
@@ -14,6 +14,10 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteNodeGroupCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteIAMServiceAccountCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteIAMIdentityMappingCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteAccessEntryCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deletePodIdentityAssociationCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteAddonCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, deleteFargateProfileCmd)
 
 	return verbCmd
 }
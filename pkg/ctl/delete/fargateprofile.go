@@ -0,0 +1,74 @@
+package delete
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func deleteFargateProfileCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.FargateProfileOptions{}
+
+	cmd.SetDescription("fargateprofile", "Delete a Fargate profile", "")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doDeleteFargateProfile(cmd, options)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to delete the Fargate profile from")
+		fs.StringVar(&options.ProfileName, "name", "", "name of the Fargate profile to delete")
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doDeleteFargateProfile(cmd *cmdutils.Cmd, options *cmdutils.FargateProfileOptions) error {
+	if err := cmdutils.NewFargateProfileLoader(cmd, options, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	names := []string{options.ProfileName}
+	if cmd.ClusterConfigFile != "" {
+		names = []string{}
+		for _, profile := range cfg.FargateProfiles {
+			names = append(names, profile.Name)
+		}
+	} else if options.ProfileName == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	client := ctl.NewFargateClient(cfg)
+	for _, name := range names {
+		if err := client.DeleteProfile(name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -1,12 +1,12 @@
 package delete
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func deleteIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
@@ -29,7 +29,7 @@ func deleteIAMIdentityMappingCmd(cmd *cmdutils.Cmd) {
 		cmdutils.AddIAMIdentityMappingARNFlags(fs, cmd, &arn)
 		cmdutils.AddNameFlag(fs, cfg.Metadata)
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
-		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddConfigFileFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
@@ -67,32 +67,30 @@ func doDeleteIAMIdentityMapping(cmd *cmdutils.Cmd, arn string, all bool) error {
 	if err != nil {
 		return err
 	}
-	acm, err := authconfigmap.NewFromClientSet(clientSet)
-	if err != nil {
-		return err
-	}
+	duplicates := 0
+	err = authconfigmap.Update(clientSet, func(acm *authconfigmap.AuthConfigMap) error {
+		if err := acm.RemoveIdentity(arn, all); err != nil {
+			return err
+		}
 
-	if err := acm.RemoveIdentity(arn, all); err != nil {
-		return err
-	}
-	if err := acm.Save(); err != nil {
-		return err
-	}
+		// Check whether we have more roles that match
+		identities, err := acm.Identities()
+		if err != nil {
+			return err
+		}
 
-	// Check whether we have more roles that match
-	identities, err := acm.Identities()
+		duplicates = 0
+		for _, identity := range identities {
+			if arn == identity.ARN() {
+				duplicates++
+			}
+		}
+		return nil
+	})
 	if err != nil {
 		return err
 	}
 
-	duplicates := 0
-	for _, identity := range identities {
-
-		if arn == identity.ARN() {
-			duplicates++
-		}
-	}
-
 	if duplicates > 0 {
 		logger.Warning("there are %d mappings left with same arn %q (use --all to delete them at once)", duplicates, arn)
 	}
@@ -1,13 +1,14 @@
 package delete
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
 	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func deleteNodeGroupCmd(cmd *cmdutils.Cmd) {
@@ -27,15 +28,17 @@ func deleteNodeGroupCmd(cmd *cmdutils.Cmd) {
 		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
 		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to delete")
-		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddConfigFileFlag(fs, cmd)
 		cmdutils.AddApproveFlag(fs, cmd)
-		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude)
+		cmdutils.AddYesFlag(fs, cmd)
+		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude, &cmd.LabelSelector)
 		fs.BoolVar(&onlyMissing, "only-missing", false, "Only delete nodegroups that are not defined in the given config file")
 		cmdutils.AddUpdateAuthConfigMap(fs, &updateAuthConfigMap, "Remove nodegroup IAM role from aws-auth configmap")
 		fs.BoolVar(&deleteNodeGroupDrain, "drain", true, "Drain and cordon all nodes in the nodegroup before deletion")
 
 		cmd.Wait = false
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "deletion of all resources")
+		cmdutils.AddNotifyURLFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
@@ -72,6 +75,42 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 
 	stackManager := ctl.NewStackManager(cfg)
 
+	managedNodeGroups, err := stackManager.ListManagedNodeGroups()
+	if err != nil {
+		return err
+	}
+	existingManagedNodeGroups := sets.NewString(managedNodeGroups...)
+	managedNodeGroupsToDelete := sets.NewString()
+	switch {
+	case cmd.ClusterConfigFile == "":
+		if existingManagedNodeGroups.Has(ng.Name) {
+			managedNodeGroupsToDelete.Insert(ng.Name)
+			cfg.NodeGroups = nil
+		}
+	case onlyMissing:
+		desiredManagedNodeGroups := sets.NewString()
+		for _, ng := range cfg.ManagedNodeGroups {
+			desiredManagedNodeGroups.Insert(ng.Name)
+		}
+		missing := existingManagedNodeGroups.Difference(desiredManagedNodeGroups).List()
+		// labels of nodegroups that are missing from the config are read from the cluster
+		knownLabels, err := ngFilter.ExistingNodeGroupLabels(stackManager, nil, missing)
+		if err != nil {
+			return err
+		}
+		for _, name := range missing {
+			if ngFilter.MatchExistingNodeGroup(name, knownLabels) {
+				managedNodeGroupsToDelete.Insert(name)
+			}
+		}
+	default:
+		for _, ng := range ngFilter.FilterMatchingManaged(cfg.ManagedNodeGroups) {
+			if existingManagedNodeGroups.Has(ng.Name) {
+				managedNodeGroupsToDelete.Insert(ng.Name)
+			}
+		}
+	}
+
 	if cmd.ClusterConfigFile != "" {
 		logger.Info("comparing %d nodegroups defined in the given config (%q) against remote state", len(cfg.NodeGroups), cmd.ClusterConfigFile)
 		if err := ngFilter.SetIncludeOrExcludeMissingFilter(stackManager, onlyMissing, &cfg.NodeGroups); err != nil {
@@ -83,6 +122,32 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 
 	ngFilter.LogInfo(cfg.NodeGroups)
 
+	ngSubset, _ := ngFilter.MatchAll(cfg.NodeGroups)
+	tasks, err := stackManager.NewTasksToDeleteNodeGroups(ngSubset.Has, cmd.Wait, nil)
+	if err != nil {
+		return err
+	}
+	if managedNodeGroupsToDelete.Len() > 0 {
+		managedNodeGroupTasks, err := stackManager.NewTasksToDeleteManagedNodeGroups(managedNodeGroupsToDelete.Has)
+		if err != nil {
+			return err
+		}
+		if managedNodeGroupTasks.Len() > 0 {
+			managedNodeGroupTasks.IsSubTask = true
+			tasks.Append(managedNodeGroupTasks)
+		}
+	}
+	tasks.PlanMode = cmd.Plan
+
+	// nodes are removed from the auth ConfigMap and drained before the tasks run,
+	// so the deletion is confirmed first
+	cmdutils.ConfirmDeletion(cmd, stackManager, tasks)
+	if !cmd.Plan && tasks.Len() > 0 {
+		if err := tasks.Confirmed(); err != nil {
+			return err
+		}
+	}
+
 	if updateAuthConfigMap {
 		cmdutils.LogIntendedAction(cmd.Plan, "delete %d nodegroups from auth ConfigMap in cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
 		if !cmd.Plan {
@@ -112,22 +177,19 @@ func doDeleteNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, updateAuthConfigMap
 	}
 
 	cmdutils.LogIntendedAction(cmd.Plan, "delete %d nodegroups from cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
+	if managedNodeGroupsToDelete.Len() > 0 {
+		cmdutils.LogIntendedAction(cmd.Plan, "delete %d managed nodegroups from cluster %q", managedNodeGroupsToDelete.Len(), cfg.Metadata.Name)
+	}
 
 	{
-		ngSubset, _ := ngFilter.MatchAll(cfg.NodeGroups)
-		tasks, err := stackManager.NewTasksToDeleteNodeGroups(ngSubset.Has, cmd.Wait, nil)
-		if err != nil {
-			return err
-		}
-		tasks.PlanMode = cmd.Plan
 		logger.Info(tasks.Describe())
 		if errs := tasks.DoAllSync(); len(errs) > 0 {
-			return handleErrors(errs, "nodegroup(s)")
+			return handleErrors(cmd, stackManager, errs, "nodegroup(s)")
 		}
 		cmdutils.LogCompletedAction(cmd.Plan, "deleted %d nodegroups from cluster %q", len(filteredNodeGroups), cfg.Metadata.Name)
 	}
 
-	cmdutils.LogPlanModeWarning(cmd.Plan && len(filteredNodeGroups) > 0)
+	cmdutils.LogPlanModeWarning(cmd.Plan && (len(filteredNodeGroups) > 0 || managedNodeGroupsToDelete.Len() > 0))
 
 	return nil
 }
@@ -0,0 +1,73 @@
+package delete
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func deletePodIdentityAssociationCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.PodIdentityAssociationOptions{}
+
+	cmd.SetDescription("podidentityassociation", "Delete pod identity associations",
+		"Deletes the pod identity association of a service account, or all iam.podIdentityAssociations of a config file, "+
+			"along with the IAM roles that eksctl created for them")
+
+	cmd.SetRunFunc(func() error {
+		return doDeletePodIdentityAssociation(cmd, options)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddPodIdentityAssociationNameFlags(fs, options)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doDeletePodIdentityAssociation(cmd *cmdutils.Cmd, options *cmdutils.PodIdentityAssociationOptions) error {
+	if err := cmdutils.NewPodIdentityAssociationLoader(cmd, options, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	associations := []*api.PodIdentityAssociation{}
+	if cmd.ClusterConfigFile != "" {
+		associations = cfg.IAM.PodIdentityAssociations
+	} else if options.ServiceAccountName == "" {
+		return cmdutils.ErrMustBeSet("--service-account-name")
+	} else {
+		associations = append(associations, options.ToPodIdentityAssociation())
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	for _, association := range associations {
+		if err := ctl.DeletePodIdentityAssociation(cfg, association.Namespace, association.ServiceAccountName); err != nil {
+			return err
+		}
+	}
+	return nil
+}
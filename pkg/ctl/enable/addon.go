@@ -0,0 +1,89 @@
+package enable
+
+import (
+	"fmt"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// newAddonCtl returns a ClusterProvider for a cluster that an addon deployed by eksctl
+// can be installed to, i.e. one that is operable and has IAM OIDC provider
+func newAddonCtl(cmd *cmdutils.Cmd, addonName string) (*eks.ClusterProvider, *iamoidc.OpenIDConnectManager, error) {
+	if err := cmdutils.NewEnableAddonLoader(cmd).Load(); err != nil {
+		return nil, nil, err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return nil, nil, err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return nil, nil, err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return nil, nil, err
+	}
+
+	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	providerExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !providerExists {
+		logger.Warning("no IAM OIDC provider associated with cluster, try 'eksctl utils associate-iam-oidc-provider --region=%s --name=%s'", meta.Region, meta.Name)
+		return nil, nil, fmt.Errorf("unable to install %s without IAM OIDC provider enabled", addonName)
+	}
+
+	return ctl, oidc, nil
+}
+
+// createAddonIAMServiceAccount creates the iamserviceaccount of an addon, unless its IAM role already exists
+func createAddonIAMServiceAccount(cmd *cmdutils.Cmd, ctl *eks.ClusterProvider, oidc *iamoidc.OpenIDConnectManager, addonName string, serviceAccount *api.ClusterIAMServiceAccount) error {
+	cfg := cmd.ClusterConfig
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	existingServiceAccounts, err := stackManager.ListIAMServiceAccountStacks()
+	if err != nil {
+		return err
+	}
+
+	serviceAccounts := []*api.ClusterIAMServiceAccount{serviceAccount}
+	for _, name := range existingServiceAccounts {
+		if name == serviceAccount.NameString() {
+			logger.Info("IAM role for serviceaccount %q already exists", name)
+			serviceAccounts = nil
+		}
+	}
+
+	tasks := stackManager.NewTasksToCreateIAMServiceAccounts(serviceAccounts, oidc, kubernetes.NewCachedClientSet(clientSet))
+	tasks.PlanMode = cmd.Plan
+
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		cmdutils.LogTaskErrors(cmd, stackManager, errs)
+		return fmt.Errorf("failed to create IAM role for %s", addonName)
+	}
+	return nil
+}
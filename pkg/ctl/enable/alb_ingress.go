@@ -0,0 +1,77 @@
+package enable
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/helm"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+func enableALBIngressCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("alb-ingress", "Install the AWS Load Balancer Controller with an IAM role for its service account and tag subnets for load balancers", "")
+
+	cmd.SetRunFunc(func() error {
+		return doEnableALBIngress(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to install the AWS Load Balancer Controller to")
+
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doEnableALBIngress(cmd *cmdutils.Cmd) error {
+	ctl, oidc, err := newAddonCtl(cmd, api.ALBIngressAddon)
+	if err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	// refuse before the IAM role is created and subnets are tagged, as no release of the chart would install
+	if version := ctl.ControlPlaneVersion(); !api.IsVersionAtLeast(version, api.MinimumVersionForALBIngress) {
+		return fmt.Errorf("the AWS Load Balancer Controller is only supported by Kubernetes %s or later, cluster %q runs %s", api.MinimumVersionForALBIngress, cfg.Metadata.Name, version)
+	}
+
+	if err := ctl.LoadClusterVPC(cfg); err != nil {
+		return err
+	}
+
+	if err := createAddonIAMServiceAccount(cmd, ctl, oidc, api.ALBIngressAddon, addons.ALBIngressControllerServiceAccount()); err != nil {
+		return err
+	}
+
+	if !cmd.Plan {
+		if err := vpc.TagSubnetsForLoadBalancers(ctl.Provider, cfg); err != nil {
+			return err
+		}
+	}
+
+	client, err := ctl.NewClient(cfg)
+	if err != nil {
+		return err
+	}
+	installer := helm.NewInstaller(client.Config, ctl.Provider.WaitTimeout())
+
+	if err := addons.NewALBIngressController(installer, cfg, ctl.ControlPlaneVersion(), cmd.Plan).Deploy(); err != nil {
+		return err
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
@@ -0,0 +1,63 @@
+package enable
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func enableClusterAutoscalerCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("cluster-autoscaler", "Install cluster-autoscaler with an IAM role for its service account", "")
+
+	cmd.SetRunFunc(func() error {
+		return doEnableClusterAutoscaler(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to install cluster-autoscaler to")
+
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doEnableClusterAutoscaler(cmd *cmdutils.Cmd) error {
+	ctl, oidc, err := newAddonCtl(cmd, api.ClusterAutoscalerAddon)
+	if err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	if err := createAddonIAMServiceAccount(cmd, ctl, oidc, api.ClusterAutoscalerAddon, addons.ClusterAutoscalerServiceAccount()); err != nil {
+		return err
+	}
+
+	if !cmd.Plan {
+		if err := ctl.NewStackManager(cfg).TagNodeGroupsForClusterAutoscaler(); err != nil {
+			return err
+		}
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := addons.NewClusterAutoscaler(rawClient, cfg, ctl.ControlPlaneVersion(), cmd.Plan).Deploy(); err != nil {
+		return err
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
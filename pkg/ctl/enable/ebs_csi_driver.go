@@ -0,0 +1,109 @@
+package enable
+
+import (
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+type ebsCSIDriverOptions struct {
+	useEKSAddon    bool
+	migrateVolumes bool
+}
+
+func enableEBSCSIDriverCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var opts ebsCSIDriverOptions
+
+	cmd.SetDescription("ebs-csi-driver", "Install EBS CSI driver with an IAM role for its service account and migrate in-tree EBS volumes to it", "")
+
+	cmd.SetRunFunc(func() error {
+		return doEnableEBSCSIDriver(cmd, opts)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to install EBS CSI driver to")
+		fs.BoolVar(&opts.useEKSAddon, "use-eks-addon", false, "install the driver as an addon managed via EKS Addons API, instead of deploying it with eksctl")
+		fs.BoolVar(&opts.migrateVolumes, "migrate-volumes", false, "annotate persistent volumes of the in-tree EBS plugin, and claims bound to them, as migrated to the driver")
+
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doEnableEBSCSIDriver(cmd *cmdutils.Cmd, opts ebsCSIDriverOptions) error {
+	ctl, oidc, err := newAddonCtl(cmd, api.EBSCSIDriverAddon)
+	if err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	if opts.useEKSAddon {
+		if cmd.Plan {
+			logger.Info("(plan) would create addon %q with an IAM role for its service account", api.EBSCSIDriverAddon)
+		} else {
+			addonManager, err := ctl.NewEKSAddonManager(cfg)
+			if err != nil {
+				return err
+			}
+			addon := &api.Addon{
+				Name:             api.EBSCSIDriverAddon,
+				AttachPolicyARNs: []string{addons.EBSCSIDriverPolicyARN(cfg.Metadata.Region)},
+			}
+			if err := addonManager.Create(addon); err != nil {
+				return err
+			}
+		}
+	} else {
+		if err := createAddonIAMServiceAccount(cmd, ctl, oidc, api.EBSCSIDriverAddon, addons.EBSCSIDriverServiceAccount(cfg.Metadata.Region)); err != nil {
+			return err
+		}
+
+		rawClient, err := ctl.NewRawClient(cfg)
+		if err != nil {
+			return err
+		}
+
+		if err := addons.NewEBSCSIDriver(rawClient, ctl.ControlPlaneVersion(), cmd.Plan).Deploy(); err != nil {
+			return err
+		}
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	volumes, err := addons.InTreeEBSVolumes(clientSet)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case len(volumes) == 0:
+		logger.Info("no persistent volumes of the in-tree EBS plugin found")
+	case opts.migrateVolumes:
+		if err := addons.AnnotateForCSIMigration(clientSet, volumes, cmd.Plan); err != nil {
+			return err
+		}
+	default:
+		for _, pv := range volumes {
+			logger.Info("persistent volume %q uses the in-tree EBS plugin", pv.Name)
+		}
+		logger.Warning("%d persistent volume(s) use the in-tree EBS plugin, use --migrate-volumes to mark them as migrated to EBS CSI driver", len(volumes))
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
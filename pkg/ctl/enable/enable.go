@@ -11,6 +11,11 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 	verbCmd := cmdutils.NewVerbCmd("enable", "Enable features in a cluster", "")
 
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableProfileCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableClusterAutoscalerCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableALBIngressCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableEBSCSIDriverCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableKarpenterCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableGitOpsCmd)
 
 	return verbCmd
 }
@@ -0,0 +1,50 @@
+package enable
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func enableGitOpsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("gitops", "Bootstrap Flux v2 in the repository given in gitops.flux and commit the config file to it", "")
+
+	cmd.SetRunFunc(func() error {
+		return doEnableGitOps(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, cmd)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doEnableGitOps(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewEnableGitOpsLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	api.SetGitOpsDefaults(cfg)
+	if err := api.ValidateGitOps(cfg); err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	return cmdutils.BootstrapFlux(cmd, ctl)
+}
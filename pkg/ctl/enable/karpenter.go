@@ -0,0 +1,62 @@
+package enable
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func enableKarpenterCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var version string
+
+	cmd.SetDescription("karpenter", "Install Karpenter along with its IAM roles, interruption queue and discovery tags", "")
+
+	cmd.SetRunFunc(func() error {
+		return doEnableKarpenter(cmd, version)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster to install Karpenter to")
+		fs.StringVar(&version, "version", "", "version of Karpenter, defaults to "+api.DefaultKarpenterVersion)
+
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doEnableKarpenter(cmd *cmdutils.Cmd, version string) error {
+	ctl, _, err := newAddonCtl(cmd, "karpenter")
+	if err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	if cfg.Karpenter == nil {
+		cfg.Karpenter = &api.Karpenter{}
+	}
+	if version != "" {
+		cfg.Karpenter.Version = version
+	}
+	if cfg.Karpenter.Version == "" {
+		cfg.Karpenter.Version = api.DefaultKarpenterVersion
+	}
+	if err := api.ValidateKarpenterVersion(cfg.Karpenter.Version); err != nil {
+		return err
+	}
+
+	if err := ctl.InstallKarpenter(cfg, cmd.Plan); err != nil {
+		return err
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
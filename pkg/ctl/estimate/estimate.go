@@ -0,0 +1,106 @@
+package estimate
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cost"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+// Command will create the `estimate` command
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	return cmdutils.NewCmd(flagGrouping, estimateCmd)
+}
+
+func estimateCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	var output string
+
+	cmd.SetDescription("estimate", "Estimate the monthly cost of a cluster described by a config file",
+		"Uses the AWS Price List API to estimate the monthly cost of the control plane, the instances and volumes of nodegroups at their desired capacity, and the NAT gateways described by a config file; data transfer and resources created by Kubernetes aren't included")
+
+	cmd.SetRunFunc(func() error {
+		return doEstimate(cmd, output)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVarP(&output, "output", "o", "table", "specifies the output format (valid option: table, json, yaml)")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doEstimate(cmd *cmdutils.Cmd, output string) error {
+	if err := cmdutils.NewValidateLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	estimate, err := cost.NewEstimator(ctl.Provider).Estimate(cfg)
+	if err != nil {
+		return err
+	}
+
+	if output != "table" {
+		return printer.PrintObj(estimate, os.Stdout)
+	}
+
+	addLineItemTableColumns(printer.(*printers.TablePrinter))
+	items := append(estimate.Items, cost.LineItem{
+		Resource:    "total",
+		MonthlyCost: estimate.MonthlyCost,
+	})
+	if err := printer.PrintObjWithKind("items", items, os.Stdout); err != nil {
+		return err
+	}
+	logger.Info("estimated with on-demand prices and current spot prices in %s, without data transfer, load balancers and volumes created by Kubernetes", estimate.Region)
+	return nil
+}
+
+func addLineItemTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("RESOURCE", func(i cost.LineItem) string {
+		return i.Resource
+	})
+	printer.AddColumn("ITEM", func(i cost.LineItem) string {
+		return i.Description
+	})
+	printer.AddColumn("QUANTITY", func(i cost.LineItem) string {
+		if i.Unit == "" {
+			return ""
+		}
+		return fmt.Sprintf("%g", i.Quantity)
+	})
+	printer.AddColumn("UNIT PRICE", func(i cost.LineItem) string {
+		if i.Unit == "" {
+			return ""
+		}
+		return fmt.Sprintf("$%g/%s", i.UnitPrice, i.Unit)
+	})
+	printer.AddColumn("MONTHLY COST", func(i cost.LineItem) string {
+		return fmt.Sprintf("$%.2f", i.MonthlyCost)
+	})
+}
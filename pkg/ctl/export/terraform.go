@@ -0,0 +1,102 @@
+package export
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/terraform"
+)
+
+const (
+	formatHCL    = "hcl"
+	formatImport = "import"
+)
+
+type exportTerraformCmdParams struct {
+	format     string
+	outputFile string
+}
+
+func exportTerraformCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	params := &exportTerraformCmdParams{}
+
+	cmd.SetDescription("terraform", "Export the resources of a cluster as Terraform configuration",
+		"Translates the resources of the CloudFormation stacks of a cluster, i.e. the VPC, the cluster, nodegroups and IAM roles, "+
+			"to Terraform HCL, or to import blocks that bring the existing resources under Terraform management")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doExportTerraform(cmd, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVar(&params.format, "format", formatHCL, fmt.Sprintf("%q for the configuration of the resources, %q for import blocks of the existing resources", formatHCL, formatImport))
+		fs.StringVar(&params.outputFile, "output-file", "", "write the Terraform configuration to the given file instead of stdout")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doExportTerraform(cmd *cmdutils.Cmd, params *exportTerraformCmdParams) error {
+	if params.format != formatHCL && params.format != formatImport {
+		return fmt.Errorf("--format must be %q or %q", formatHCL, formatImport)
+	}
+
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	stacks, err := ctl.NewStackManager(cfg).DescribeStacksAndResources()
+	if err != nil {
+		return err
+	}
+	translator, err := terraform.NewTranslator(meta.Name, stacks)
+	if err != nil {
+		return err
+	}
+
+	config := translator.HCL()
+	if params.format == formatImport {
+		config = translator.Imports()
+	}
+
+	if params.outputFile == "" {
+		fmt.Fprint(os.Stdout, config)
+		return nil
+	}
+	f, err := os.Create(params.outputFile)
+	if err != nil {
+		return errors.Wrapf(err, "creating %q", params.outputFile)
+	}
+	defer f.Close()
+	if _, err := f.WriteString(config); err != nil {
+		return errors.Wrapf(err, "writing %q", params.outputFile)
+	}
+	logger.Info("wrote Terraform configuration of %d stack(s) of cluster %q to %q", len(stacks), meta.Name, params.outputFile)
+	return nil
+}
@@ -0,0 +1,120 @@
+package get
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getAccessEntryCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.AccessEntryOptions{}
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("accessentry", "Get access entries", "", "accessentries")
+
+	cmd.SetRunFunc(func() error {
+		return doGetAccessEntry(cmd, options, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddAccessEntryPrincipalFlag(fs, options)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doGetAccessEntry(cmd *cmdutils.Cmd, options *cmdutils.AccessEntryOptions, params *getCmdParams) error {
+	if err := cmdutils.NewAccessEntryLoader(cmd, options, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	var principals []string
+	switch {
+	case cmd.ClusterConfigFile != "":
+		for _, entry := range cfg.AccessEntries {
+			principals = append(principals, entry.PrincipalARN)
+		}
+	case options.PrincipalARN != "":
+		principals = []string{options.PrincipalARN}
+	default:
+		if principals, err = ctl.ListAccessEntries(cfg); err != nil {
+			return err
+		}
+	}
+
+	entries := []*api.AccessEntry{}
+	for _, principalARN := range principals {
+		entry, err := ctl.DescribeAccessEntry(cfg, principalARN)
+		if err != nil {
+			return err
+		}
+		entries = append(entries, entry)
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == "table" {
+		addAccessEntryTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("accessentries", entries, os.Stdout)
+}
+
+func addAccessEntryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("PRINCIPAL ARN", func(e *api.AccessEntry) string {
+		return e.PrincipalARN
+	})
+	printer.AddColumn("TYPE", func(e *api.AccessEntry) string {
+		return e.Type
+	})
+	printer.AddColumn("USERNAME", func(e *api.AccessEntry) string {
+		return e.KubernetesUsername
+	})
+	printer.AddColumn("GROUPS", func(e *api.AccessEntry) string {
+		return strings.Join(e.KubernetesGroups, ",")
+	})
+	printer.AddColumn("ACCESS POLICIES", func(e *api.AccessEntry) string {
+		policies := []string{}
+		for _, p := range e.AccessPolicies {
+			name := p.PolicyARN[strings.LastIndex(p.PolicyARN, "/")+1:]
+			if p.AccessScope.Type == api.AccessScopeNamespace {
+				name = fmt.Sprintf("%s(%s)", name, strings.Join(p.AccessScope.Namespaces, ","))
+			}
+			policies = append(policies, name)
+		}
+		return strings.Join(policies, ", ")
+	})
+}
@@ -0,0 +1,249 @@
+package get
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getAddonCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	addon := &api.Addon{}
+
+	params := &getCmdParams{}
+	var showUpdates bool
+
+	cmd.SetDescription("addon", "Get addon(s) managed via EKS Addons API",
+		"With --show-updates, lists the default version and the available updates of each addon for the Kubernetes version of the cluster, including aws-node, kube-proxy and coredns when they aren't managed via EKS Addons API", "addons")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		if showUpdates {
+			return doGetAddonUpdates(cmd, addon, params)
+		}
+		return doGetAddon(cmd, addon, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVar(&addon.Name, "name", "", "name of the addon")
+		fs.BoolVar(&showUpdates, "show-updates", false, "list the default version and the available updates of the addons, e.g. before upgrading the cluster")
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doGetAddon(cmd *cmdutils.Cmd, addon *api.Addon, params *getCmdParams) error {
+	if err := cmdutils.NewAddonLoader(cmd, addon, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	addonManager, err := ctl.NewEKSAddonManager(cfg)
+	if err != nil {
+		return err
+	}
+
+	var summaries []*addons.EKSAddonSummary
+	if cmd.ClusterConfigFile == "" {
+		if summaries, err = addonManager.Get(addon.Name); err != nil {
+			return err
+		}
+	} else {
+		for _, addon := range cfg.EKSAddons() {
+			summary, err := addonManager.Get(addon.Name)
+			if err != nil {
+				return err
+			}
+			summaries = append(summaries, summary...)
+		}
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == "table" {
+		addAddonSummaryTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("addons", summaries, os.Stdout)
+}
+
+func addAddonSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(s *addons.EKSAddonSummary) string {
+		return s.Name
+	})
+	printer.AddColumn("VERSION", func(s *addons.EKSAddonSummary) string {
+		return s.Version
+	})
+	printer.AddColumn("STATUS", func(s *addons.EKSAddonSummary) string {
+		return s.Status
+	})
+	printer.AddColumn("ISSUES", func(s *addons.EKSAddonSummary) string {
+		return strings.Join(s.Issues, ", ")
+	})
+	printer.AddColumn("IAM ROLE", func(s *addons.EKSAddonSummary) string {
+		return s.ServiceAccountRoleARN
+	})
+}
+
+func doGetAddonUpdates(cmd *cmdutils.Cmd, addon *api.Addon, params *getCmdParams) error {
+	if err := cmdutils.NewAddonLoader(cmd, addon, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	addonManager, err := ctl.NewEKSAddonManager(cfg)
+	if err != nil {
+		return err
+	}
+
+	// the addons are filtered by name afterwards, as the default addons aren't known to EKS
+	summaries, err := addonManager.Get("")
+	if err != nil {
+		return err
+	}
+	updates, err := addonManager.GetUpdates(summaries, ctl.ControlPlaneVersion())
+	if err != nil {
+		return err
+	}
+
+	rawClient, err := ctl.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	kubernetesVersion, err := rawClient.ServerVersion()
+	if err != nil {
+		return err
+	}
+	defaultVersions, err := defaultaddons.GetVersions(rawClient.ClientSet(), kubernetesVersion)
+	if err != nil {
+		return err
+	}
+	for _, version := range defaultVersions {
+		if isManagedByEKS(version.Name, summaries) {
+			continue
+		}
+		update := &addons.AddonUpdateSummary{
+			Name:           version.Name,
+			Type:           addons.AddonTypeSelfManaged,
+			Version:        version.Current,
+			DefaultVersion: version.Default,
+			Updates:        []string{},
+		}
+		if version.UpdateAvailable() {
+			update.Updates = append(update.Updates, version.Default)
+		}
+		updates = append(updates, update)
+	}
+
+	if addon.Name != "" {
+		var found []*addons.AddonUpdateSummary
+		for _, update := range updates {
+			if update.Name == addon.Name {
+				found = append(found, update)
+			}
+		}
+		if len(found) == 0 {
+			return fmt.Errorf("addon %q not found", addon.Name)
+		}
+		updates = found
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == "table" {
+		addAddonUpdateSummaryTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	if err := printer.PrintObjWithKind("addons", updates, os.Stdout); err != nil {
+		return err
+	}
+	for _, update := range updates {
+		if update.Type == addons.AddonTypeSelfManaged && len(update.Updates) > 0 {
+			logger.Info("to update %q, run 'eksctl utils update-%s --cluster=%s --approve'", update.Name, update.Name, cfg.Metadata.Name)
+		}
+	}
+	return nil
+}
+
+// isManagedByEKS returns true when the default addon is installed via EKS Addons API,
+// under the name that EKS uses for it
+func isManagedByEKS(name string, summaries []*addons.EKSAddonSummary) bool {
+	eksName := name
+	if name == defaultaddons.AWSNode {
+		eksName = "vpc-cni"
+	}
+	for _, summary := range summaries {
+		if summary.Name == eksName {
+			return true
+		}
+	}
+	return false
+}
+
+func addAddonUpdateSummaryTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(s *addons.AddonUpdateSummary) string {
+		return s.Name
+	})
+	printer.AddColumn("TYPE", func(s *addons.AddonUpdateSummary) string {
+		return s.Type
+	})
+	printer.AddColumn("VERSION", func(s *addons.AddonUpdateSummary) string {
+		return s.Version
+	})
+	printer.AddColumn("DEFAULT VERSION", func(s *addons.AddonUpdateSummary) string {
+		return s.DefaultVersion
+	})
+	printer.AddColumn("UPDATES", func(s *addons.AddonUpdateSummary) string {
+		return strings.Join(s.Updates, ", ")
+	})
+}
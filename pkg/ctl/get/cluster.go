@@ -3,10 +3,10 @@ package get
 import (
 	"fmt"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/pflag"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func getClusterCmd(cmd *cmdutils.Cmd) {
@@ -25,7 +25,7 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		cmdutils.AddNameFlag(fs, cfg.Metadata)
-		fs.BoolVarP(&listAllRegions, "all-regions", "A", false, "List clusters across all supported regions")
+		cmdutils.AddAllRegionsFlag(fs, &listAllRegions, fmt.Sprintf("List clusters across all regions that are enabled in the account, same as --region=%s", cmdutils.AllRegions))
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
 		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
@@ -36,7 +36,8 @@ func getClusterCmd(cmd *cmdutils.Cmd) {
 
 func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool) error {
 	cfg := cmd.ClusterConfig
-	regionGiven := cfg.Metadata.Region != "" // eks.New resets this field, so we need to check if it was set in the fist place
+	listAllRegions = cmdutils.CheckAllRegions(cmd, listAllRegions)
+	regionGiven := cmd.ProviderConfig.Region != "" // eks.New resets this field, so we need to check if it was set in the fist place
 
 	ctl, err := cmd.NewCtl()
 	if err != nil {
@@ -44,7 +45,7 @@ func doGetCluster(cmd *cmdutils.Cmd, params *getCmdParams, listAllRegions bool)
 	}
 
 	if regionGiven && listAllRegions {
-		logger.Warning("--region=%s is ignored, as --all-regions is given", cfg.Metadata.Region)
+		logger.Warning("--region=%s is ignored, as --all-regions is given", cmd.ProviderConfig.Region)
 	}
 
 	if cfg.Metadata.Name != "" && cmd.NameArg != "" {
@@ -0,0 +1,128 @@
+package get
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getFargateProfileCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.FargateProfileOptions{}
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("fargateprofile", "Get Fargate profile(s)", "", "fargateprofiles")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doGetFargateProfile(cmd, options, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVar(&options.ProfileName, "name", "", "name of the Fargate profile")
+
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doGetFargateProfile(cmd *cmdutils.Cmd, options *cmdutils.FargateProfileOptions, params *getCmdParams) error {
+	if err := cmdutils.NewFargateProfileLoader(cmd, options, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	client := ctl.NewFargateClient(cfg)
+
+	var profiles []*api.FargateProfile
+	switch {
+	case cmd.ClusterConfigFile != "":
+		for _, p := range cfg.FargateProfiles {
+			profile, err := client.ReadProfile(p.Name)
+			if err != nil {
+				return err
+			}
+			profiles = append(profiles, profile)
+		}
+	case options.ProfileName != "":
+		profile, err := client.ReadProfile(options.ProfileName)
+		if err != nil {
+			return err
+		}
+		profiles = append(profiles, profile)
+	default:
+		if profiles, err = client.ReadProfiles(); err != nil {
+			return err
+		}
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == "table" {
+		addFargateProfileTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("fargateprofiles", profiles, os.Stdout)
+}
+
+func addFargateProfileTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("NAME", func(p *api.FargateProfile) string {
+		return p.Name
+	})
+	printer.AddColumn("SELECTORS", func(p *api.FargateProfile) string {
+		selectors := []string{}
+		for _, s := range p.Selectors {
+			selectors = append(selectors, formatFargateProfileSelector(s))
+		}
+		return strings.Join(selectors, ", ")
+	})
+	printer.AddColumn("POD EXECUTION ROLE", func(p *api.FargateProfile) string {
+		return p.PodExecutionRoleARN
+	})
+	printer.AddColumn("SUBNETS", func(p *api.FargateProfile) string {
+		return strings.Join(p.Subnets, ",")
+	})
+}
+
+func formatFargateProfileSelector(s api.FargateProfileSelector) string {
+	if len(s.Labels) == 0 {
+		return s.Namespace
+	}
+	labels := []string{}
+	for k, v := range s.Labels {
+		labels = append(labels, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(labels)
+	return fmt.Sprintf("%s{%s}", s.Namespace, strings.Join(labels, ","))
+}
@@ -1,16 +1,20 @@
 package get
 
 import (
+	"fmt"
 	"os"
 	"strconv"
+	"strings"
 	"time"
 
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
+	"k8s.io/client-go/kubernetes"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
@@ -21,7 +25,8 @@ func getNodeGroupCmd(cmd *cmdutils.Cmd) {
 
 	params := &getCmdParams{}
 
-	cmd.SetDescription("nodegroup", "Get nodegroup(s)", "", "ng", "nodegroups")
+	cmd.SetDescription("nodegroup", "Get nodegroup(s)",
+		"Shows the sizes and instances in service of the ASGs of nodegroups, and how many of their nodes are ready in the cluster", "ng", "nodegroups")
 
 	cmd.SetRunFuncWithNameArg(func() error {
 		return doGetNodeGroup(cmd, ng, params)
@@ -73,6 +78,25 @@ func doGetNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *getCmdParams)
 	if err != nil {
 		return errors.Wrap(err, "getting nodegroup stack summaries")
 	}
+	managedSummaries, err := manager.GetManagedNodeGroupSummaries(ng.Name)
+	if err != nil {
+		return errors.Wrap(err, "getting managed nodegroup summaries")
+	}
+	summaries = append(summaries, managedSummaries...)
+
+	var clientSet kubernetes.Interface
+	if err := ctl.RefreshClusterStatus(cfg); err != nil {
+		logger.Warning("cannot get nodes of cluster %q: %s", cfg.Metadata.Name, err.Error())
+	} else if clientSet, err = ctl.NewStdClientSet(cfg); err != nil {
+		logger.Warning("cannot get nodes of cluster %q: %s", cfg.Metadata.Name, err.Error())
+		clientSet = nil
+	}
+	if err := ctl.SetNodeGroupLiveStatus(manager, summaries, clientSet); err != nil {
+		logger.Warning("cannot get nodes of cluster %q: %s", cfg.Metadata.Name, err.Error())
+		if err := ctl.SetNodeGroupLiveStatus(manager, summaries, nil); err != nil {
+			return err
+		}
+	}
 
 	printer, err := printers.NewPrinter(params.output)
 	if err != nil {
@@ -97,6 +121,9 @@ func addSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("NODEGROUP", func(s *manager.NodeGroupSummary) string {
 		return s.Name
 	})
+	printer.AddColumn("STATUS", func(s *manager.NodeGroupSummary) string {
+		return s.Status
+	})
 	printer.AddColumn("CREATED", func(s *manager.NodeGroupSummary) string {
 		return s.CreationTime.Format(time.RFC3339)
 	})
@@ -109,10 +136,19 @@ func addSummaryTableColumns(printer *printers.TablePrinter) {
 	printer.AddColumn("DESIRED CAPACITY", func(s *manager.NodeGroupSummary) string {
 		return strconv.Itoa(s.DesiredCapacity)
 	})
+	printer.AddColumn("IN SERVICE", func(s *manager.NodeGroupSummary) string {
+		return strconv.Itoa(s.InServiceInstances)
+	})
+	printer.AddColumn("NODES READY", func(s *manager.NodeGroupSummary) string {
+		return fmt.Sprintf("%d/%d", s.ReadyNodes, s.Nodes)
+	})
 	printer.AddColumn("INSTANCE TYPE", func(s *manager.NodeGroupSummary) string {
 		return s.InstanceType
 	})
 	printer.AddColumn("IMAGE ID", func(s *manager.NodeGroupSummary) string {
 		return s.ImageID
 	})
+	printer.AddColumn("KUBELET VERSION", func(s *manager.NodeGroupSummary) string {
+		return strings.Join(s.KubeletVersions, ",")
+	})
 }
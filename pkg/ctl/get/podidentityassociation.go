@@ -0,0 +1,99 @@
+package get
+
+import (
+	"os"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func getPodIdentityAssociationCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	options := &cmdutils.PodIdentityAssociationOptions{}
+
+	params := &getCmdParams{}
+
+	cmd.SetDescription("podidentityassociation", "Get pod identity associations", "", "podidentityassociations")
+
+	cmd.SetRunFunc(func() error {
+		return doGetPodIdentityAssociation(cmd, options, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVar(&options.Namespace, "namespace", "", "only show associations of service accounts in this namespace")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddCommonFlagsForGetCmd(fs, &params.chunkSize, &params.output)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doGetPodIdentityAssociation(cmd *cmdutils.Cmd, options *cmdutils.PodIdentityAssociationOptions, params *getCmdParams) error {
+	if err := cmdutils.NewPodIdentityAssociationLoader(cmd, options, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	associations, err := ctl.DescribePodIdentityAssociations(cfg)
+	if err != nil {
+		return err
+	}
+	if options.Namespace != "" {
+		filtered := []*eks.PodIdentityAssociationSummary{}
+		for _, association := range associations {
+			if association.Namespace == options.Namespace {
+				filtered = append(filtered, association)
+			}
+		}
+		associations = filtered
+	}
+
+	printer, err := printers.NewPrinter(params.output)
+	if err != nil {
+		return err
+	}
+
+	if params.output == "table" {
+		addPodIdentityAssociationTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	return printer.PrintObjWithKind("podidentityassociations", associations, os.Stdout)
+}
+
+func addPodIdentityAssociationTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("ASSOCIATION ID", func(a *eks.PodIdentityAssociationSummary) string {
+		return a.AssociationID
+	})
+	printer.AddColumn("NAMESPACE", func(a *eks.PodIdentityAssociationSummary) string {
+		return a.Namespace
+	})
+	printer.AddColumn("SERVICE ACCOUNT", func(a *eks.PodIdentityAssociationSummary) string {
+		return a.ServiceAccountName
+	})
+	printer.AddColumn("ROLE ARN", func(a *eks.PodIdentityAssociationSummary) string {
+		return a.RoleARN
+	})
+}
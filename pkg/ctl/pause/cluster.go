@@ -0,0 +1,87 @@
+package pause
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func pauseClusterCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("cluster", "Pause a cluster",
+		"Scales the Deployments and StatefulSets outside kube-system that don't run on Fargate and all nodegroups of a cluster to zero, "+
+			"after recording their replicas and sizes in the "+eks.PausedNodeGroupsConfigMapName+" ConfigMap in kube-system, "+
+			"so that they can be restored with 'eksctl resume cluster'")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doPauseCluster(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddNotifyURLFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doPauseCluster(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if err := ctl.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	tasks, err := ctl.NewTasksToPauseCluster(cfg, clientSet, stackManager)
+	if err != nil {
+		return err
+	}
+	tasks.PlanMode = cmd.Plan
+
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while pausing the cluster, run the same command again to pause the remaining nodegroups", len(errs))
+		cmdutils.LogTaskErrors(cmd, stackManager, errs)
+		return fmt.Errorf("failed to pause cluster %q", meta.Name)
+	}
+
+	cmdutils.LogCompletedAction(cmd.Plan, "paused cluster %q", meta.Name)
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	if !cmd.Plan {
+		logger.Info("to scale the nodegroups and workloads back up, run 'eksctl resume cluster --name=%s --region=%s --approve'", meta.Name, meta.Region)
+	}
+
+	return nil
+}
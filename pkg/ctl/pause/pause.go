@@ -0,0 +1,16 @@
+package pause
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// Command will create the `pause` commands
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("pause", "Pause resource(s)", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, pauseClusterCmd)
+
+	return verbCmd
+}
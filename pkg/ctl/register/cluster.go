@@ -0,0 +1,95 @@
+package register
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func registerClusterCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var (
+		createStack bool
+		outputFile  string
+	)
+
+	cmd.SetDescription("cluster", "Register a cluster that wasn't created by eksctl",
+		"Generates a ClusterConfig from the EKS API and the VPC of the cluster, and optionally creates a stack that lets other eksctl commands manage the cluster")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doRegisterCluster(cmd, createStack, outputFile)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		fs.BoolVar(&createStack, "create-stack", false, "create a stack with the outputs of the cluster, so that nodegroups, IAM service accounts etc. can be created with eksctl")
+		cmdutils.AddOutputFileFlag(fs, &outputFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doRegisterCluster(cmd *cmdutils.Cmd, createStack bool, outputFile string) error {
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	if meta.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrNameFlagAndArg(meta.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		meta.Name = cmd.NameArg
+	}
+
+	if meta.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if stack, err := ctl.NewStackManager(cfg).DescribeClusterStack(); err == nil {
+		if !manager.IsRegisteredClusterStack(stack) {
+			return fmt.Errorf("cluster %q was created by eksctl, there is no need to register it", meta.Name)
+		}
+		if createStack {
+			return fmt.Errorf("cluster %q is already registered with stack %q", meta.Name, *stack.StackName)
+		}
+	}
+
+	if err := ctl.LoadConfigFromControlPlane(cfg); err != nil {
+		return errors.Wrapf(err, "loading configuration of cluster %q", meta.Name)
+	}
+	logger.Info("found cluster %q with Kubernetes version %s in VPC %q", meta.Name, meta.Version, cfg.VPC.ID)
+
+	if createStack {
+		stackManager := ctl.NewStackManager(cfg)
+		tasks := &manager.TaskTree{}
+		tasks.Append(stackManager.NewTaskToRegisterCluster())
+		logger.Info(tasks.Describe())
+		if errs := tasks.DoAllSync(); len(errs) > 0 {
+			cmdutils.LogTaskErrors(cmd, stackManager, errs)
+			return fmt.Errorf("failed to register cluster %q", meta.Name)
+		}
+		logger.Success("registered cluster %q, it can now be managed with eksctl", meta.Name)
+	}
+
+	return cmdutils.WriteClusterConfig(cfg, outputFile)
+}
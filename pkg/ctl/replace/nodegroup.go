@@ -0,0 +1,268 @@
+package replace
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/create"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/quotas"
+	"github.com/weaveworks/eksctl/pkg/ssh"
+)
+
+type replaceNodeGroupParams struct {
+	newName             string
+	instanceType        string
+	ami                 string
+	updateAuthConfigMap bool
+}
+
+func replaceNodeGroupCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	params := &replaceNodeGroupParams{}
+
+	cmd.SetDescription("nodegroup", "Replace a nodegroup with a new one",
+		"Creates a copy of a nodegroup defined in the config file, waits for its nodes to become ready, then drains and deletes the original nodegroup; "+
+			"if anything fails, re-running the same command resumes the replacement", "ng")
+
+	cmd.SetRunFunc(func() error {
+		return doReplaceNodeGroup(cmd, ng, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVarP(&ng.Name, "name", "n", "", "name of the nodegroup to replace")
+		fs.StringVar(&params.newName, "new-name", "", `name of the new nodegroup (derived from the original one if unspecified, e.g. "ng-1" is replaced by "ng-1-v2")`)
+		cmdutils.AddUpdateAuthConfigMap(fs, &params.updateAuthConfigMap, "Add the new nodegroup IAM role to aws-auth configmap, and remove the original one")
+		cmdutils.AddNotifyURLFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("New nodegroup", func(fs *pflag.FlagSet) {
+		fs.StringVarP(&params.instanceType, "node-type", "t", "", "node instance type (defaults to the one of the original nodegroup)")
+		fs.StringVar(&params.ami, "node-ami", "", "'auto', 'static' or an AMI ID (defaults to the one of the original nodegroup)")
+		cmdutils.AddAMIResolverFlags(fs, cmd.ProviderConfig)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doReplaceNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, params *replaceNodeGroupParams) error {
+	if err := cmdutils.NewReplaceNodeGroupLoader(cmd, ng).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cfg.Metadata
+
+	var oldNG *api.NodeGroup
+	for _, existing := range cfg.NodeGroups {
+		if existing.Name == ng.Name {
+			oldNG = existing
+		}
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	// the copy is made once the original nodegroup has been defaulted, and is then
+	// validated and defaulted itself, as flags may override some of its fields
+	newNG, err := newReplacementNodeGroup(oldNG, len(cfg.NodeGroups), params)
+	if err != nil {
+		return err
+	}
+	cfg.NodeGroups = append(cfg.NodeGroups, newNG)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	if meta.Version = ctl.ControlPlaneVersion(); meta.Version == "" {
+		return fmt.Errorf("unable to get control plane version")
+	}
+
+	if err := ctl.LoadClusterVPC(cfg); err != nil {
+		return errors.Wrapf(err, "getting VPC configuration for cluster %q", meta.Name)
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	if err := create.PrepareNodeGroup(ctl, meta, newNG); err != nil {
+		return err
+	}
+
+	if err := ssh.LoadKey(newNG.SSH, meta.Name, newNG.Name, ctl.Provider); err != nil {
+		return err
+	}
+
+	newNodeGroups := []*api.NodeGroup{newNG}
+	if err := ctl.CheckInstanceTypeOfferings(cfg, newNodeGroups, nil); err != nil {
+		return err
+	}
+
+	if err := iam.CheckNodeGroupRoles(ctl.Provider, newNodeGroups, nil); err != nil {
+		return err
+	}
+
+	if err := quotas.Preflight(ctl.Provider, cfg, newNodeGroups, nil, false, false); err != nil {
+		return err
+	}
+
+	if err := ctl.ValidateClusterForCompatibility(cfg, stackManager); err != nil {
+		return errors.Wrap(err, "cluster compatibility check failed")
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	steps := manager.NodeGroupReplacementSteps{
+		WaitForNodes: func(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
+			if params.updateAuthConfigMap {
+				if err := authorizeNodeGroup(ctl, stackManager, cfg, clientSet, ng); err != nil {
+					return err
+				}
+			}
+			return ctl.WaitForNodes(clientSet, ng)
+		},
+		Drain: func(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
+			return drain.NodeGroup(clientSet, ng, ctl.Provider.WaitTimeout(), false)
+		},
+	}
+	if params.updateAuthConfigMap {
+		steps.Cleanup = func(clientSet kubernetes.Interface, ng *api.NodeGroup) error {
+			return revokeNodeGroup(ctl, stackManager, cfg, clientSet, ng, newNG)
+		}
+	}
+
+	tasks, err := stackManager.NewTasksToReplaceNodeGroup(oldNG, newNG, kubernetes.NewCachedClientSet(clientSet), steps)
+	if err != nil {
+		return err
+	}
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while replacing nodegroup %q", len(errs), oldNG.Name)
+		cmdutils.LogTaskErrors(cmd, stackManager, errs)
+		logger.Info("to resume the replacement, run the same command again")
+		return fmt.Errorf("failed to replace nodegroup %q with %q", oldNG.Name, newNG.Name)
+	}
+
+	if addons.WantsNvidiaDevicePlugin(newNG) {
+		rawClient, err := ctl.NewRawClient(cfg)
+		if err != nil {
+			return err
+		}
+		if err := addons.NewNvidiaDevicePlugin(rawClient, false).Deploy(); err != nil {
+			return err
+		}
+	}
+
+	logger.Success("replaced nodegroup %q with %q in cluster %q", oldNG.Name, newNG.Name, meta.Name)
+	logger.Info("nodegroup %q should now be renamed to %q in %q", oldNG.Name, newNG.Name, cmd.ClusterConfigFile)
+	return nil
+}
+
+// newReplacementNodeGroup makes a copy of the nodegroup with a new name, applies instance type
+// and AMI overrides given as flags, and validates and defaults it as the i-th nodegroup
+func newReplacementNodeGroup(oldNG *api.NodeGroup, i int, params *replaceNodeGroupParams) (*api.NodeGroup, error) {
+	newNG := oldNG.DeepCopy()
+
+	newNG.Name = params.newName
+	if newNG.Name == "" {
+		newNG.Name = cmdutils.ReplacementNodeGroupName(oldNG.Name)
+	}
+	if newNG.Name == oldNG.Name {
+		return nil, fmt.Errorf("--new-name must be different from the name of the nodegroup to replace")
+	}
+
+	if newNG.IAM != nil && newNG.IAM.InstanceRoleName != "" {
+		return nil, fmt.Errorf("nodegroup %q sets iam.instanceRoleName, which cannot be used by two nodegroups at the same time; use iam.instanceRoleARN instead", oldNG.Name)
+	}
+
+	if params.instanceType != "" {
+		if newNG.InstancesDistribution != nil {
+			return nil, fmt.Errorf("--node-type cannot be used for nodegroup %q, as it sets instancesDistribution", oldNG.Name)
+		}
+		newNG.InstanceType = params.instanceType
+	}
+
+	if params.ami != "" {
+		newNG.AMI = params.ami
+	}
+
+	if err := api.ValidateNodeGroup(i, newNG); err != nil {
+		return nil, err
+	}
+	api.SetNodeGroupDefaults(i, newNG)
+
+	return newNG, nil
+}
+
+// authorizeNodeGroup adds the nodegroup to the auth ConfigMap, unless its role is present already,
+// which is the case when a replacement is resumed
+func authorizeNodeGroup(ctl *eks.ClusterProvider, stackManager *manager.StackCollection, cfg *api.ClusterConfig, clientSet kubernetes.Interface, ng *api.NodeGroup) error {
+	if ng.IAM == nil || ng.IAM.InstanceRoleARN == "" {
+		if err := ctl.GetNodeGroupIAM(stackManager, cfg, ng); err != nil {
+			return err
+		}
+	}
+
+	acm, err := authconfigmap.NewFromClientSet(clientSet)
+	if err != nil {
+		return err
+	}
+	identities, err := acm.Identities()
+	if err != nil {
+		return err
+	}
+	for _, identity := range identities {
+		if identity.ARN() == ng.IAM.InstanceRoleARN {
+			logger.Debug("nodegroup %q is already present in auth ConfigMap", ng.Name)
+			return nil
+		}
+	}
+
+	return authconfigmap.AddNodeGroup(clientSet, ng)
+}
+
+// revokeNodeGroup removes the original nodegroup from the auth ConfigMap,
+// unless its role is shared with the new nodegroup
+func revokeNodeGroup(ctl *eks.ClusterProvider, stackManager *manager.StackCollection, cfg *api.ClusterConfig, clientSet kubernetes.Interface, oldNG, newNG *api.NodeGroup) error {
+	if oldNG.IAM == nil || oldNG.IAM.InstanceRoleARN == "" {
+		if err := ctl.GetNodeGroupIAM(stackManager, cfg, oldNG); err != nil {
+			return err
+		}
+	}
+
+	if oldNG.IAM.InstanceRoleARN == newNG.IAM.InstanceRoleARN {
+		logger.Info("nodegroup %q shares its instance role with %q, will not remove it from auth ConfigMap", oldNG.Name, newNG.Name)
+		return nil
+	}
+
+	if err := authconfigmap.RemoveNodeGroup(clientSet, oldNG); err != nil {
+		logger.Warning(err.Error())
+	}
+	return nil
+}
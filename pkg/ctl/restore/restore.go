@@ -0,0 +1,161 @@
+package restore
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/backup"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+type restoreCmdParams struct {
+	bucket, prefix string
+	snapshot       string
+	list           bool
+	awsAuth        bool
+	configFile     string
+}
+
+// Command will create the `restore` command
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	return cmdutils.NewCmd(flagGrouping, restoreCmd)
+}
+
+func restoreCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	params := &restoreCmdParams{}
+
+	cmd.SetDescription("restore", "Re-create the stacks of a cluster from a snapshot in S3",
+		"Creates the stacks of a snapshot that don't exist anymore, e.g. after they were deleted by mistake; snapshots are taken before each command that changes a cluster, when backup.s3Bucket or "+backup.BucketEnv+" is set")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doRestore(cmd, params)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVar(&params.bucket, "s3-bucket", "", "S3 bucket of the snapshots (defaults to backup.s3Bucket of the config file or "+backup.BucketEnv+")")
+		fs.StringVar(&params.prefix, "s3-prefix", "", "key prefix of the snapshots in the S3 bucket")
+		fs.StringVar(&params.snapshot, "snapshot", "", "time of the snapshot to restore, as listed by --list (defaults to the latest snapshot)")
+		fs.BoolVar(&params.list, "list", false, "list the snapshots of the cluster instead of restoring one")
+		fs.BoolVar(&params.awsAuth, "aws-auth", false, "also replace the aws-auth ConfigMap with that of the snapshot")
+		fs.StringVar(&params.configFile, "write-config", "", "write the ClusterConfig of the snapshot to the given file")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doRestore(cmd *cmdutils.Cmd, params *restoreCmdParams) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	if params.bucket != "" {
+		cfg.Backup = &api.ClusterBackup{
+			S3Bucket: params.bucket,
+			S3Prefix: params.prefix,
+		}
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", cfg.Metadata.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if params.list {
+		times, err := backup.List(ctl.Provider, cfg)
+		if err != nil {
+			return err
+		}
+		if len(times) == 0 {
+			logger.Info("no snapshots found for cluster %q", cfg.Metadata.Name)
+		}
+		for _, t := range times {
+			fmt.Fprintln(os.Stdout, t)
+		}
+		return nil
+	}
+
+	snapshot, err := backup.Load(ctl.Provider, cfg, params.snapshot)
+	if err != nil {
+		return err
+	}
+	if taken, err := time.Parse(backup.TimeFormat, snapshot.Time); err == nil {
+		logger.Info("using snapshot %q of cluster %q, taken %s ago", snapshot.Time, cfg.Metadata.Name, time.Since(taken).Round(time.Minute))
+	}
+
+	if params.configFile != "" {
+		if err := writeConfig(params.configFile, snapshot.Config); err != nil {
+			return err
+		}
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	tasks, err := stackManager.NewTasksToRestoreStacks(snapshot.Stacks, cmd.Plan)
+	if err != nil {
+		return err
+	}
+	if tasks.Len() > 0 {
+		logger.Info(tasks.Describe())
+		if errs := tasks.DoAllSync(); len(errs) > 0 {
+			cmdutils.LogTaskErrors(cmd, stackManager, errs)
+			return fmt.Errorf("failed to restore stacks of cluster %q", cfg.Metadata.Name)
+		}
+	} else {
+		logger.Info("all stacks of snapshot %q exist, no stacks to restore", snapshot.Time)
+	}
+
+	if params.awsAuth {
+		cmdutils.LogIntendedAction(cmd.Plan, "replace the aws-auth ConfigMap of cluster %q with that of snapshot %q", cfg.Metadata.Name, snapshot.Time)
+		if !cmd.Plan {
+			if err := ctl.RefreshClusterStatus(cfg); err != nil {
+				return err
+			}
+			clientSet, err := ctl.NewStdClientSet(cfg)
+			if err != nil {
+				return err
+			}
+			if err := backup.RestoreAWSAuth(clientSet, snapshot); err != nil {
+				return err
+			}
+		}
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan && (tasks.Len() > 0 || params.awsAuth))
+	if !cmd.Plan {
+		logger.Success("restored cluster %q from snapshot %q", cfg.Metadata.Name, snapshot.Time)
+	}
+	return nil
+}
+
+func writeConfig(path string, config []byte) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return errors.Wrapf(err, "creating %q", path)
+	}
+	defer f.Close()
+	if _, err := f.Write(config); err != nil {
+		return errors.Wrapf(err, "writing %q", path)
+	}
+	logger.Info("wrote ClusterConfig of the snapshot to %q", path)
+	return nil
+}
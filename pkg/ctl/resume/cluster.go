@@ -0,0 +1,83 @@
+package resume
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func resumeClusterCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("cluster", "Resume a paused cluster",
+		"Scales the nodegroups and workloads of a cluster that was paused with 'eksctl pause cluster' back to the sizes and replicas that "+
+			"are recorded in the "+eks.PausedNodeGroupsConfigMapName+" ConfigMap in kube-system, then deletes the ConfigMap")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doResumeCluster(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddNotifyURLFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doResumeCluster(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if err := ctl.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	tasks, err := ctl.NewTasksToResumeCluster(cfg, clientSet, stackManager)
+	if err != nil {
+		return err
+	}
+	tasks.PlanMode = cmd.Plan
+
+	logger.Info(tasks.Describe())
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		logger.Info("%d error(s) occurred while resuming the cluster, run the same command again to resume the remaining nodegroups", len(errs))
+		cmdutils.LogTaskErrors(cmd, stackManager, errs)
+		return fmt.Errorf("failed to resume cluster %q", meta.Name)
+	}
+
+	cmdutils.LogCompletedAction(cmd.Plan, "resumed cluster %q", meta.Name)
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
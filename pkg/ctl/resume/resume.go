@@ -0,0 +1,16 @@
+package resume
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+// Command will create the `resume` commands
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	verbCmd := cmdutils.NewVerbCmd("resume", "Resume resource(s)", "")
+
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, resumeClusterCmd)
+
+	return verbCmd
+}
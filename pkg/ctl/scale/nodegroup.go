@@ -2,12 +2,17 @@ package scale
 
 import (
 	"fmt"
+	"os"
+	"strings"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
 func scaleNodeGroupCmd(cmd *cmdutils.Cmd) {
@@ -15,7 +20,8 @@ func scaleNodeGroupCmd(cmd *cmdutils.Cmd) {
 	ng := cfg.NewNodeGroup()
 	cmd.ClusterConfig = cfg
 
-	cmd.SetDescription("nodegroup", "Scale a nodegroup", "", "ng")
+	cmd.SetDescription("nodegroup", "Scale a nodegroup",
+		"Scales a nodegroup to --nodes, or all nodegroups in the config file to their desiredCapacity, minSize and maxSize", "ng")
 
 	cmd.SetRunFuncWithNameArg(func() error {
 		return doScaleNodeGroup(cmd, ng)
@@ -32,51 +38,248 @@ func scaleNodeGroupCmd(cmd *cmdutils.Cmd) {
 			}
 		})
 
+		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude, &cmd.LabelSelector)
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+		cmdutils.AddApproveFlag(fs, cmd)
 	})
 
 	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
 }
 
 func doScaleNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup) error {
+	if err := cmdutils.NewScaleNodeGroupLoader(cmd, ng).Load(); err != nil {
+		return err
+	}
+
 	cfg := cmd.ClusterConfig
 
-	// TODO: move this into a loader when --config-file gets added to this command
-	if cfg.Metadata.Name == "" {
-		return cmdutils.ErrMustBeSet("--cluster")
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
 	}
 
-	if ng.Name != "" && cmd.NameArg != "" {
-		return cmdutils.ErrNameFlagAndArg(ng.Name, cmd.NameArg)
+	if err := ctl.CheckAuth(); err != nil {
+		return err
 	}
 
-	if cmd.NameArg != "" {
-		ng.Name = cmd.NameArg
+	stackManager := ctl.NewStackManager(cfg)
+
+	if cmd.ClusterConfigFile != "" {
+		return scaleNodeGroupsFromConfig(cmd, stackManager)
 	}
 
-	if ng.Name == "" {
-		return cmdutils.ErrMustBeSet("--name")
+	if cmdutils.HasNodeGroupFilters(cmd) {
+		nodeGroups, managedNodeGroups, err := cmdutils.SelectExistingNodeGroups(cmd, stackManager)
+		if err != nil {
+			return err
+		}
+		for _, name := range managedNodeGroups {
+			if err := stackManager.ScaleManagedNodeGroup(name, *ng.DesiredCapacity, cmd.Plan); err != nil {
+				return fmt.Errorf("failed to scale managed nodegroup %q for cluster %q, error %v", name, cfg.Metadata.Name, err)
+			}
+		}
+		for _, name := range nodeGroups {
+			selected := &api.NodeGroup{Name: name, DesiredCapacity: ng.DesiredCapacity}
+			if err := stackManager.ScaleNodeGroup(selected, cmd.Plan); err != nil {
+				return fmt.Errorf("failed to scale nodegroup %q for cluster %q, error %v", name, cfg.Metadata.Name, err)
+			}
+		}
+		cmdutils.LogPlanModeWarning(cmd.Plan)
+		return nil
 	}
 
-	ctl, err := cmd.NewCtl()
+	managedNodeGroup, err := stackManager.DescribeManagedNodeGroup(ng.Name)
 	if err != nil {
 		return err
 	}
+	if managedNodeGroup != nil {
+		if err := stackManager.ScaleManagedNodeGroup(ng.Name, *ng.DesiredCapacity, cmd.Plan); err != nil {
+			return fmt.Errorf("failed to scale managed nodegroup for cluster %q, error %v", cfg.Metadata.Name, err)
+		}
+		cmdutils.LogPlanModeWarning(cmd.Plan)
+		return nil
+	}
 
-	if err := ctl.CheckAuth(); err != nil {
+	err = stackManager.ScaleNodeGroup(ng, cmd.Plan)
+	if err != nil {
+		return fmt.Errorf("failed to scale nodegroup for cluster %q, error %v", cfg.Metadata.Name, err)
+	}
+
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
+
+// nodeGroupScaling is a row of the table of nodegroups that were scaled from the config file
+type nodeGroupScaling struct {
+	name    string
+	managed bool
+	before  *manager.NodeGroupSize
+	after   *manager.NodeGroupSize
+}
+
+// scaleNodeGroupsFromConfig scales the nodegroups in the config file that match the filters to the sizes
+// that are set for them, concurrently, and prints their sizes before and after
+func scaleNodeGroupsFromConfig(cmd *cmdutils.Cmd, stackManager *manager.StackCollection) error {
+	cfg := cmd.ClusterConfig
+
+	names := []string{}
+	for _, ng := range cfg.NodeGroups {
+		names = append(names, ng.Name)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		names = append(names, ng.Name)
+	}
+	ngFilter, err := cmdutils.NewNodeGroupFilterFromFlags(cmd, names)
+	if err != nil {
 		return err
 	}
 
-	if ng.DesiredCapacity == nil || *ng.DesiredCapacity < 0 {
-		return fmt.Errorf("number of nodes must be 0 or greater. Use the --nodes/-N flag")
+	hasSize := func(name string, sizes ...*int) bool {
+		for _, size := range sizes {
+			if size != nil {
+				return true
+			}
+		}
+		logger.Warning("no desiredCapacity, minSize or maxSize set for nodegroup %q, it won't be scaled", name)
+		return false
 	}
 
-	stackManager := ctl.NewStackManager(cfg)
-	err = stackManager.ScaleNodeGroup(ng)
+	var nodeGroups []*api.NodeGroup
+	for _, ng := range ngFilter.FilterMatching(cfg.NodeGroups) {
+		if hasSize(ng.Name, ng.DesiredCapacity, ng.MinSize, ng.MaxSize) {
+			nodeGroups = append(nodeGroups, ng)
+		}
+	}
+	var managedNodeGroups []*api.ManagedNodeGroup
+	for _, ng := range ngFilter.FilterMatchingManaged(cfg.ManagedNodeGroups) {
+		if hasSize(ng.Name, ng.DesiredCapacity, ng.MinSize, ng.MaxSize) {
+			managedNodeGroups = append(managedNodeGroups, ng)
+		}
+	}
+	if len(nodeGroups)+len(managedNodeGroups) == 0 {
+		return fmt.Errorf("no nodegroups in %q match the given filters", cmd.ClusterConfigFile)
+	}
+
+	existing, err := stackManager.ListNodeGroupStacks()
 	if err != nil {
-		return fmt.Errorf("failed to scale nodegroup for cluster %q, error %v", cfg.Metadata.Name, err)
+		return err
+	}
+	existingManaged, err := stackManager.ListManagedNodeGroups()
+	if err != nil {
+		return err
+	}
+	var missing []string
+	for _, ng := range nodeGroups {
+		if !contains(existing, ng.Name) {
+			missing = append(missing, ng.Name)
+		}
+	}
+	for _, ng := range managedNodeGroups {
+		if !contains(existingManaged, ng.Name) {
+			missing = append(missing, ng.Name)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("nodegroup(s) %s don't exist in cluster %q, use --exclude to skip them", strings.Join(missing, ", "), cfg.Metadata.Name)
+	}
+
+	getSizes := func() ([]*manager.NodeGroupSize, error) {
+		sizes := []*manager.NodeGroupSize{}
+		for _, ng := range nodeGroups {
+			size, err := stackManager.GetNodeGroupSize(ng.Name)
+			if err != nil {
+				return nil, err
+			}
+			sizes = append(sizes, size)
+		}
+		for _, ng := range managedNodeGroups {
+			size, err := stackManager.GetManagedNodeGroupSize(ng.Name)
+			if err != nil {
+				return nil, err
+			}
+			sizes = append(sizes, size)
+		}
+		return sizes, nil
+	}
+
+	before, err := getSizes()
+	if err != nil {
+		return err
+	}
+
+	tasks := stackManager.NewTasksToScaleNodeGroups(nodeGroups, managedNodeGroups, cmd.Plan)
+	logger.Info(tasks.Describe())
+	errs := tasks.DoAllSync()
+	if cmd.Plan {
+		// nothing was scaled, so there are no sizes to compare
+		if len(errs) > 0 {
+			cmdutils.LogTaskErrors(cmd, stackManager, errs)
+			return fmt.Errorf("failed to preview scaling of nodegroups of cluster %q", cfg.Metadata.Name)
+		}
+		cmdutils.LogPlanModeWarning(true)
+		return nil
+	}
+
+	after, err := getSizes()
+	if err != nil {
+		return err
 	}
 
+	scalings := []*nodeGroupScaling{}
+	for i, ng := range nodeGroups {
+		scalings = append(scalings, &nodeGroupScaling{name: ng.Name, before: before[i], after: after[i]})
+	}
+	for i, ng := range managedNodeGroups {
+		j := len(nodeGroups) + i
+		scalings = append(scalings, &nodeGroupScaling{name: ng.Name, managed: true, before: before[j], after: after[j]})
+	}
+	if err := printNodeGroupScalings(scalings); err != nil {
+		return err
+	}
+
+	if len(errs) > 0 {
+		logger.Info("%d error(s) occurred while scaling nodegroups, you may wish to check CloudFormation console", len(errs))
+		cmdutils.LogTaskErrors(cmd, stackManager, errs)
+		return fmt.Errorf("failed to scale nodegroups of cluster %q", cfg.Metadata.Name)
+	}
 	return nil
 }
+
+func printNodeGroupScalings(scalings []*nodeGroupScaling) error {
+	printer := printers.NewTablePrinter().(*printers.TablePrinter)
+
+	change := func(before, after int) string {
+		if before == after {
+			return fmt.Sprintf("%d", after)
+		}
+		return fmt.Sprintf("%d -> %d", before, after)
+	}
+	printer.AddColumn("NODEGROUP", func(s *nodeGroupScaling) string {
+		return s.name
+	})
+	printer.AddColumn("MANAGED", func(s *nodeGroupScaling) string {
+		return fmt.Sprintf("%t", s.managed)
+	})
+	printer.AddColumn("DESIRED CAPACITY", func(s *nodeGroupScaling) string {
+		return change(s.before.DesiredCapacity, s.after.DesiredCapacity)
+	})
+	printer.AddColumn("MIN SIZE", func(s *nodeGroupScaling) string {
+		return change(s.before.MinSize, s.after.MinSize)
+	})
+	printer.AddColumn("MAX SIZE", func(s *nodeGroupScaling) string {
+		return change(s.before.MaxSize, s.after.MaxSize)
+	})
+
+	return printer.PrintObjWithKind("nodegroups", scalings, os.Stdout)
+}
+
+func contains(names []string, name string) bool {
+	for _, n := range names {
+		if n == name {
+			return true
+		}
+	}
+	return false
+}
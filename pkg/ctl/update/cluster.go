@@ -2,13 +2,14 @@ package update
 
 import (
 	"fmt"
+	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/spf13/pflag"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
 )
 
@@ -16,16 +17,19 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 	cfg := api.NewClusterConfig()
 	cmd.ClusterConfig = cfg
 
+	var parallel bool
+
 	cmd.SetDescription("cluster", "Update cluster", "")
 
 	cmd.SetRunFuncWithNameArg(func() error {
-		return doUpdateClusterCmd(cmd)
+		return cmdutils.ForEachClusterConfig(cmd, parallel, doUpdateClusterCmd)
 	})
 
 	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
 		cmdutils.AddNameFlag(fs, cfg.Metadata)
 		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
-		cmdutils.AddConfigFileFlag(fs, &cmd.ClusterConfigFile)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddParallelFlag(fs, &parallel)
 
 		// cmdutils.AddVersionFlag(fs, cfg.Metadata, `"next" and "latest" can be used to automatically increment version by one, or force latest`)
 
@@ -35,6 +39,7 @@ func updateClusterCmd(cmd *cmdutils.Cmd) {
 
 		cmd.Wait = true
 		cmdutils.AddWaitFlag(fs, &cmd.Wait, "all update operations to complete")
+		cmdutils.AddNotifyURLFlag(fs, cmd)
 		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
 	})
 
@@ -68,7 +73,7 @@ func doUpdateClusterCmd(cmd *cmdutils.Cmd) error {
 
 	if cmd.ClusterConfigFile != "" {
 		logger.Warning("NOTE: config file is used for finding cluster name and region")
-		logger.Warning("NOTE: cluster VPC (subnets, routing & NAT Gateway) configuration changes are not yet implemented")
+		logger.Warning("NOTE: subnets are added for new entries of availabilityZones, other cluster VPC (subnets, routing & NAT Gateway) configuration changes are not yet implemented")
 	}
 
 	currentVersion := ctl.ControlPlaneVersion()
@@ -94,17 +99,50 @@ func doUpdateClusterCmd(cmd *cmdutils.Cmd) error {
 		return errors.Wrapf(err, "getting VPC configuration for cluster %q", cfg.Metadata.Name)
 	}
 
-	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg); err != nil {
+	if err := printer.LogObj(logger.Debug, "cfg.json = \\\n%s\n", cfg.Redacted()); err != nil {
 		return err
 	}
 
 	stackManager := ctl.NewStackManager(cfg)
 
+	// the version skew is checked before any stack is changed, so that a refused upgrade leaves the cluster as it was
+	if versionUpdateRequired {
+		if ok, err := ctl.CanOperate(cfg); !ok {
+			return errors.Wrap(err, "checking version skew of nodegroups and addons")
+		}
+		clientSet, err := ctl.NewStdClientSet(cfg)
+		if err != nil {
+			return err
+		}
+		if err := ctl.ValidateVersionSkew(cfg, stackManager, clientSet, cfg.Metadata.Version); err != nil {
+			return err
+		}
+	}
+
 	stackUpdateRequired, err := stackManager.AppendNewClusterStackResource(cmd.Plan)
 	if err != nil {
 		return err
 	}
 
+	var newZones []string
+	if cmd.ClusterConfigFile != "" {
+		newZones = cfg.AvailabilityZonesWithoutSubnets()
+	}
+	if len(newZones) > 0 {
+		tasks := stackManager.NewTasksToAddAvailabilityZones(newZones, cmd.Plan)
+		logger.Info(tasks.Describe())
+		if errs := tasks.DoAllSync(); len(errs) > 0 {
+			for _, err := range errs {
+				logger.Critical("%s\n", err.Error())
+			}
+			return fmt.Errorf("failed to add availability zones %v to cluster %q", newZones, cfg.Metadata.Name)
+		}
+		stackUpdateRequired = true
+		if !cmd.Plan {
+			logNodeGroupMigration(cmd.ClusterConfigFile, cfg, newZones)
+		}
+	}
+
 	if err := ctl.ValidateExistingNodeGroupsForCompatibility(cfg, stackManager); err != nil {
 		logger.Critical("failed checking nodegroups", err.Error())
 	}
@@ -133,3 +171,37 @@ func doUpdateClusterCmd(cmd *cmdutils.Cmd) error {
 
 	return nil
 }
+
+// logNodeGroupMigration guides through moving nodes to the availability zones that were added,
+// nodegroups are placed in them by setting their availabilityZones
+func logNodeGroupMigration(configFile string, cfg *api.ClusterConfig, newZones []string) {
+	inNewZones := func(zones []string) bool {
+		for _, az := range zones {
+			for _, newAZ := range newZones {
+				if az == newAZ {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	var nodeGroups []string
+	for _, ng := range cfg.NodeGroups {
+		if inNewZones(ng.AvailabilityZones) {
+			nodeGroups = append(nodeGroups, ng.Name)
+		}
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		if inNewZones(ng.AvailabilityZones) {
+			nodeGroups = append(nodeGroups, ng.Name)
+		}
+	}
+
+	logger.Success("subnets in availability zones %v have been added to cluster %q", newZones, cfg.Metadata.Name)
+	if len(nodeGroups) > 0 {
+		logger.Info("to create nodegroups %v in them, run 'eksctl create nodegroup --config-file=%s --include=%s'", nodeGroups, configFile, strings.Join(nodeGroups, ","))
+	} else {
+		logger.Info("to create nodegroups in them, set availabilityZones of the nodegroups to %v and run 'eksctl create nodegroup --config-file=%s'", newZones, configFile)
+	}
+	logger.Info("to move workloads off other zones, run 'eksctl drain nodegroup --cluster=%s --name=<nodegroup>' once the new nodes are ready, and delete the nodegroups that were drained", cfg.Metadata.Name)
+}
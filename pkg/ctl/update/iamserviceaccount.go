@@ -0,0 +1,158 @@
+package update
+
+import (
+	"fmt"
+
+	"github.com/lithammer/dedent"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func updateIAMServiceAccountCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	serviceAccount := &api.ClusterIAMServiceAccount{}
+
+	cfg.IAM.WithOIDC = api.Enabled()
+	cfg.IAM.ServiceAccounts = append(cfg.IAM.ServiceAccounts, serviceAccount)
+
+	cmd.SetDescription("iamserviceaccount", "Update the IAM role of an iamserviceaccount",
+		dedent.Dedent(`Updates the policies and the permissions boundary of the IAM role bound to
+			a Kubernetes service account, by updating its CloudFormation stack; the
+			service account itself is left as it is.
+
+			Without a config file, the role keeps the policies that are not changed
+			with flags; --attach-policy-arn replaces all of its managed policies.
+		`),
+	)
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doUpdateIAMServiceAccount(cmd, serviceAccount)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "name of the EKS cluster the iamserviceaccount belongs to")
+
+		fs.StringVar(&serviceAccount.Name, "name", "", "name of the iamserviceaccount to update")
+		fs.StringVar(&serviceAccount.Namespace, "namespace", "default", "namespace of the iamserviceaccount")
+		fs.StringSliceVar(&serviceAccount.AttachPolicyARNs, "attach-policy-arn", []string{}, "ARN of the policies to attach to the IAM role, replacing the current ones")
+		fs.StringVar(&serviceAccount.PermissionsBoundary, "permissions-boundary", "", "ARN of the policy that is used as the permissions boundary of the IAM role")
+
+		cmdutils.AddIAMServiceAccountFilterFlags(fs, &cmd.Include, &cmd.Exclude)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doUpdateIAMServiceAccount(cmd *cmdutils.Cmd, serviceAccount *api.ClusterIAMServiceAccount) error {
+	saFilter := cmdutils.NewIAMServiceAccountFilter()
+
+	if err := cmdutils.NewUpdateIAMServiceAccountLoader(cmd, serviceAccount, saFilter).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return err
+	}
+
+	providerExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return err
+	}
+
+	if !providerExists {
+		return fmt.Errorf("no IAM OIDC provider associated with cluster %q", meta.Name)
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	if cmd.ClusterConfigFile == "" {
+		// start from the role as it is, so that only what's given with flags is changed
+		existing, err := stackManager.ExportIAMServiceAccounts()
+		if err != nil {
+			return err
+		}
+		var current *api.ClusterIAMServiceAccount
+		for _, sa := range existing {
+			if sa.NameString() == serviceAccount.NameString() {
+				current = sa
+			}
+		}
+		if current == nil {
+			return fmt.Errorf("iamserviceaccount %q doesn't exist", serviceAccount.NameString())
+		}
+		flags := cmd.CobraCommand.Flags()
+		if flags.Changed("attach-policy-arn") {
+			current.AttachPolicyARNs = serviceAccount.AttachPolicyARNs
+		}
+		if flags.Changed("permissions-boundary") {
+			current.PermissionsBoundary = serviceAccount.PermissionsBoundary
+		}
+		if len(current.AttachPolicyARNs) == 0 && len(current.AttachPolicy) == 0 {
+			return errors.New("the IAM role must keep at least one policy")
+		}
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{current}
+	} else if err := saFilter.SetIncludeOrExcludeMissingFilter(stackManager, false, &cfg.IAM.ServiceAccounts); err != nil {
+		return err
+	}
+
+	filteredServiceAccounts := saFilter.FilterMatching(cfg.IAM.ServiceAccounts)
+	saFilter.LogInfo(cfg.IAM.ServiceAccounts)
+
+	updated := 0
+	for _, sa := range filteredServiceAccounts {
+		changed, err := stackManager.IAMServiceAccountStackChanged(sa, oidc)
+		if err != nil {
+			return err
+		}
+		if !changed {
+			logger.Info("IAM role of iamserviceaccount %q is up to date", sa.NameString())
+			continue
+		}
+
+		changes, err := stackManager.IAMServiceAccountPolicyChanges(sa)
+		if err != nil {
+			return err
+		}
+		for _, change := range changes {
+			logger.Info("%s: %s", sa.NameString(), change)
+		}
+
+		if err := stackManager.UpdateIAMServiceAccountStack(sa, oidc, cmd.Plan); err != nil {
+			return errors.Wrapf(err, "updating iamserviceaccount %q", sa.NameString())
+		}
+		updated++
+	}
+
+	if updated > 0 {
+		cmdutils.LogPlanModeWarning(cmd.Plan)
+	}
+	return nil
+}
@@ -0,0 +1,77 @@
+package update
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func updateNodeGroupCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := api.NewManagedNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("nodegroup", "Update managed nodegroups",
+		"Updates labels, taints and scaling configuration of managed nodegroups to match the config file", "ng")
+
+	cmd.SetRunFunc(func() error {
+		return doUpdateNodeGroup(cmd, ng)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVarP(&ng.Name, "name", "n", "", "name of the managed nodegroup to update (all managed nodegroups in the config file are updated if unspecified)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doUpdateNodeGroup(cmd *cmdutils.Cmd, ng *api.ManagedNodeGroup) error {
+	if err := cmdutils.NewUpdateNodeGroupLoader(cmd, ng).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cfg.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	updated := 0
+	for _, managedNodeGroup := range cfg.ManagedNodeGroups {
+		if ng.Name != "" && managedNodeGroup.Name != ng.Name {
+			continue
+		}
+		ok, err := stackManager.UpdateManagedNodeGroupConfig(managedNodeGroup)
+		if err != nil {
+			return fmt.Errorf("failed to update managed nodegroup %q: %v", managedNodeGroup.Name, err)
+		}
+		if !ok {
+			logger.Info("managed nodegroup %q is already up-to-date", managedNodeGroup.Name)
+			continue
+		}
+		logger.Success("updated managed nodegroup %q", managedNodeGroup.Name)
+		updated++
+	}
+
+	logger.Info("%d managed nodegroup(s) updated in cluster %q", updated, meta.Name)
+	return nil
+}
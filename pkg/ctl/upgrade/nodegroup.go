@@ -0,0 +1,158 @@
+package upgrade
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func upgradeNodeGroupCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	var (
+		rolling           bool
+		kubernetesVersion string
+	)
+	options := eks.RollingUpdateOptions{}
+
+	cmd.SetDescription("nodegroup", "Upgrade a nodegroup",
+		"Replaces instances of a nodegroup, so that they pick up changes to its launch template, e.g. a new AMI; "+
+			"managed nodegroups get upgraded to a Kubernetes version by EKS instead", "ng")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doUpgradeNodeGroup(cmd, ng, rolling, kubernetesVersion, options)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		fs.StringVarP(&ng.Name, "name", "n", "", "Name of the nodegroup to upgrade")
+		cmdutils.AddNodeGroupFilterFlags(fs, &cmd.Include, &cmd.Exclude, &cmd.LabelSelector)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		fs.BoolVar(&rolling, "rolling", false, "Replace instances one batch at a time, draining their nodes first")
+		fs.IntVar(&options.BatchSize, "batch-size", 1, "Number of instances to replace at a time")
+		fs.DurationVar(&options.HealthCheckGracePeriod, "health-check-grace-period", 0, "Time to wait after replacement nodes have become ready, before replacing the next batch")
+		fs.StringVar(&kubernetesVersion, "kubernetes-version", "", "Kubernetes version to upgrade a managed nodegroup to (defaults to the version of the control plane)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doUpgradeNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, rolling bool, kubernetesVersion string, options eks.RollingUpdateOptions) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet("--cluster")
+	}
+
+	if ng.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrNameFlagAndArg(ng.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		ng.Name = cmd.NameArg
+	}
+
+	bulk := cmdutils.HasNodeGroupFilters(cmd)
+	if bulk && ng.Name != "" {
+		return fmt.Errorf("--name cannot be used with --include, --exclude or --label-selector")
+	}
+	if !bulk && ng.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	if options.BatchSize < 1 {
+		return fmt.Errorf("--batch-size must be at least 1")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", cfg.Metadata.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+
+	if !bulk {
+		managedNodeGroup, err := stackManager.DescribeManagedNodeGroup(ng.Name)
+		if err != nil {
+			return err
+		}
+		if managedNodeGroup != nil {
+			return upgradeManagedNodeGroup(ctl, stackManager, ng.Name, kubernetesVersion)
+		}
+		if kubernetesVersion != "" {
+			return fmt.Errorf("--kubernetes-version can only be used with managed nodegroups")
+		}
+		return upgradeNodeGroup(ctl, stackManager, cfg, ng, rolling, options)
+	}
+
+	nodeGroups, managedNodeGroups, err := cmdutils.SelectExistingNodeGroups(cmd, stackManager)
+	if err != nil {
+		return err
+	}
+	if len(nodeGroups) > 0 && !rolling {
+		return fmt.Errorf("only rolling upgrades are supported, use --rolling, or select only managed nodegroups")
+	}
+	if len(nodeGroups) > 0 && kubernetesVersion != "" {
+		return fmt.Errorf("--kubernetes-version can only be used with managed nodegroups, select only managed nodegroups")
+	}
+	for _, name := range managedNodeGroups {
+		if err := upgradeManagedNodeGroup(ctl, stackManager, name, kubernetesVersion); err != nil {
+			return err
+		}
+	}
+	for _, name := range nodeGroups {
+		if err := upgradeNodeGroup(ctl, stackManager, cfg, &api.NodeGroup{Name: name}, rolling, options); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func upgradeManagedNodeGroup(ctl *eks.ClusterProvider, stackManager *manager.StackCollection, name, kubernetesVersion string) error {
+	if kubernetesVersion == "" {
+		if kubernetesVersion = ctl.ControlPlaneVersion(); kubernetesVersion == "" {
+			return fmt.Errorf("unable to get control plane version")
+		}
+	}
+	if err := stackManager.UpgradeManagedNodeGroup(name, kubernetesVersion); err != nil {
+		return err
+	}
+	logger.Success("upgraded managed nodegroup %q to Kubernetes %s", name, kubernetesVersion)
+	return nil
+}
+
+func upgradeNodeGroup(ctl *eks.ClusterProvider, stackManager *manager.StackCollection, cfg *api.ClusterConfig, ng *api.NodeGroup, rolling bool, options eks.RollingUpdateOptions) error {
+	if !rolling {
+		return fmt.Errorf("only rolling upgrades are supported, use --rolling")
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	asgName, err := stackManager.GetNodeGroupAutoScalingGroupName(ng.Name)
+	if err != nil {
+		return err
+	}
+
+	return ctl.RollingUpdateNodeGroup(clientSet, ng, asgName, options)
+}
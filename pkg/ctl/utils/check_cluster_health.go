@@ -0,0 +1,154 @@
+package utils
+
+import (
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/health"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func checkClusterHealthCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var (
+		output string
+		strict bool
+	)
+
+	cmd.SetDescription("check-cluster-health", "Check health of a cluster",
+		"Checks the control plane, the readiness of the nodes of each nodegroup, aws-node, kube-proxy and coredns, the IAM OIDC provider and the aws-auth ConfigMap, and exits with an error when any check fails, e.g. to gate CI pipelines after creating or upgrading a cluster")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doCheckClusterHealth(cmd, output, strict)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		fs.StringVarP(&output, "output", "o", "table", "specifies the output format (valid option: table, json, yaml)")
+		fs.BoolVar(&strict, "strict", false, "exit with an error when any check warns, not only when it fails")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doCheckClusterHealth(cmd *cmdutils.Cmd, output string, strict bool) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrNameFlagAndArg(cfg.Metadata.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		cfg.Metadata.Name = cmd.NameArg
+	}
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	report, err := checkClusterHealth(ctl, cfg)
+	if err != nil {
+		return err
+	}
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	if output == "table" {
+		addCheckTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	if err := printer.PrintObjWithKind("checks", report.Checks, os.Stdout); err != nil {
+		return err
+	}
+
+	if err := report.Err(strict); err != nil {
+		return errors.Wrapf(err, "cluster %q is unhealthy", cfg.Metadata.Name)
+	}
+	logger.Success("cluster %q is healthy", cfg.Metadata.Name)
+	return nil
+}
+
+func checkClusterHealth(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) (*health.Report, error) {
+	report := &health.Report{}
+
+	cluster, err := ctl.DescribeControlPlane(cfg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+	report.CheckControlPlane(cluster)
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		// the remaining checks need the Kubernetes API
+		logger.Warning("skipping checks of nodes and addons: %s", err.Error())
+		return report, nil
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	summaries, err := stackManager.GetNodeGroupSummaries("")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stack summaries")
+	}
+	unmanagedNodeGroups := len(summaries)
+	managedSummaries, err := stackManager.GetManagedNodeGroupSummaries("")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting managed nodegroup summaries")
+	}
+	summaries = append(summaries, managedSummaries...)
+	if err := ctl.SetNodeGroupLiveStatus(stackManager, summaries, clientSet); err != nil {
+		return nil, err
+	}
+	report.CheckNodeGroups(summaries)
+
+	report.CheckDefaultAddons(clientSet)
+
+	oidc, err := ctl.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		if _, ok := err.(*eks.UnsupportedOIDCError); !ok {
+			return nil, err
+		}
+		oidc = nil
+	}
+	report.CheckOIDCProvider(oidc)
+
+	report.CheckAWSAuth(clientSet, unmanagedNodeGroups)
+
+	return report, nil
+}
+
+func addCheckTableColumns(printer *printers.TablePrinter) {
+	printer.AddColumn("CHECK", func(c *health.Check) string {
+		return c.Name
+	})
+	printer.AddColumn("STATUS", func(c *health.Check) string {
+		return string(c.Status)
+	})
+	printer.AddColumn("MESSAGE", func(c *health.Check) string {
+		return c.Message
+	})
+}
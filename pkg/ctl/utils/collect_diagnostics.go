@@ -0,0 +1,319 @@
+package utils
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/audit"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/diagnostics"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/version"
+)
+
+// scalingActivities is the number of recent scaling activities of each ASG that are collected
+const scalingActivities = 10
+
+func collectDiagnosticsCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var (
+		outputFile  string
+		stackEvents int
+	)
+
+	cmd.SetDescription("collect-diagnostics", "Collect diagnostics of a cluster into a tarball for support",
+		"Collects the description of the cluster, the status and recent events of its stacks, the state of its nodegroups and their ASGs, "+
+			"the status of the DaemonSets in kube-system, and the entries of the audit log and the error reports of eksctl for the cluster "+
+			"into a gzipped tarball; account IDs, access key IDs and credentials are redacted")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doCollectDiagnostics(cmd, outputFile, stackEvents)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		fs.StringVar(&outputFile, "output-file", "", "path of the tarball (defaults to eksctl-diagnostics-<clusterName>-<timestamp>.tar.gz in the current directory)")
+		fs.IntVar(&stackEvents, "stack-events", 20, "number of recent events of each stack to collect")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doCollectDiagnostics(cmd *cmdutils.Cmd, outputFile string, stackEvents int) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrNameFlagAndArg(cfg.Metadata.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		cfg.Metadata.Name = cmd.NameArg
+	}
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	if stackEvents < 0 {
+		return fmt.Errorf("--stack-events cannot be negative")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", cfg.Metadata.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	dir := fmt.Sprintf("eksctl-diagnostics-%s-%d", cfg.Metadata.Name, now.Unix())
+	if outputFile == "" {
+		outputFile = dir + ".tar.gz"
+	}
+
+	bundle := collectDiagnostics(ctl, cfg, stackEvents, now)
+
+	f, err := os.OpenFile(outputFile, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "creating %q", outputFile)
+	}
+	defer f.Close()
+	if err := bundle.WriteTarball(f, dir, now); err != nil {
+		return errors.Wrapf(err, "writing %q", outputFile)
+	}
+
+	for _, collectErr := range bundle.Errors() {
+		logger.Warning("cannot collect %s", collectErr)
+	}
+	logger.Success("saved diagnostics of cluster %q to %q: %s", cfg.Metadata.Name, outputFile, strings.Join(bundle.Names(), ", "))
+	return nil
+}
+
+// bundleInfo describes how a bundle of diagnostics was collected
+type bundleInfo struct {
+	Cluster       string
+	Region        string
+	Time          time.Time
+	EksctlVersion version.Info
+}
+
+// stackDiagnostics is the status of a stack of the cluster along with its recent events
+type stackDiagnostics struct {
+	StackName         string
+	StackStatus       string
+	StackStatusReason string `json:",omitempty"`
+	CreationTime      *time.Time
+	LastUpdatedTime   *time.Time `json:",omitempty"`
+	Events            []*cloudformation.StackEvent
+}
+
+// autoScalingGroupDiagnostics is the state of an ASG of a nodegroup along with its recent scaling activities
+type autoScalingGroupDiagnostics struct {
+	NodeGroup  string
+	Group      *autoscaling.Group
+	Activities []*autoscaling.Activity
+}
+
+// daemonSetDiagnostics is the status of a DaemonSet in kube-system, e.g. aws-node and kube-proxy
+type daemonSetDiagnostics struct {
+	Name      string
+	Images    []string
+	Desired   int32
+	Current   int32
+	Ready     int32
+	Available int32
+	Updated   int32
+}
+
+// collectDiagnostics collects what it can of the diagnostics of the cluster, parts that can't be collected,
+// e.g. the Kubernetes objects of a cluster whose control plane failed to be created, are recorded as errors
+func collectDiagnostics(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, stackEvents int, now time.Time) *diagnostics.Bundle {
+	bundle := &diagnostics.Bundle{}
+	bundle.AddJSON("bundle.json", bundleInfo{
+		Cluster:       cfg.Metadata.Name,
+		Region:        cfg.Metadata.Region,
+		Time:          now.UTC(),
+		EksctlVersion: version.Get(),
+	})
+
+	if cluster, err := ctl.DescribeControlPlane(cfg.Metadata); err != nil {
+		bundle.AddError("cluster.json", err)
+	} else {
+		// the CA of the cluster isn't needed to diagnose it
+		described := *cluster
+		described.CertificateAuthority = nil
+		bundle.AddJSON("cluster.json", described)
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	if stacks, err := collectStacks(stackManager, stackEvents); err != nil {
+		bundle.AddError("stacks.json", err)
+	} else {
+		bundle.AddJSON("stacks.json", stacks)
+	}
+
+	// the live status of nodegroups is collected without nodes when the cluster can't be reached
+	var clientSet kubernetes.Interface
+	if stdClientSet, err := ctl.NewStdClientSet(cfg); err != nil {
+		bundle.AddError("daemonsets.json", err)
+	} else if daemonSets, err := collectDaemonSets(stdClientSet); err != nil {
+		bundle.AddError("daemonsets.json", err)
+	} else {
+		clientSet = stdClientSet
+		bundle.AddJSON("daemonsets.json", daemonSets)
+	}
+
+	summaries, err := collectNodeGroups(ctl, stackManager, clientSet)
+	if err != nil {
+		bundle.AddError("nodegroups.json", err)
+	} else {
+		bundle.AddJSON("nodegroups.json", summaries)
+		if groups, err := collectAutoScalingGroups(ctl, summaries); err != nil {
+			bundle.AddError("autoscaling-groups.json", err)
+		} else {
+			bundle.AddJSON("autoscaling-groups.json", groups)
+		}
+	}
+
+	if path := os.Getenv(audit.FileEnv); path != "" {
+		if entries, err := audit.ReadClusterEntries(path, cfg.Metadata.Name); err != nil {
+			bundle.AddError("journal.jsonl", err)
+		} else {
+			bundle.AddFile("journal.jsonl", entries)
+		}
+	}
+
+	reports, err := cmdutils.ErrorReportFiles(cfg.Metadata.Name)
+	if err != nil {
+		bundle.AddError("error reports", err)
+	}
+	for _, path := range reports {
+		name := "error-reports/" + filepath.Base(path)
+		if data, err := ioutil.ReadFile(path); err != nil {
+			bundle.AddError(name, err)
+		} else {
+			bundle.AddFile(name, data)
+		}
+	}
+	return bundle
+}
+
+func collectStacks(stackManager *manager.StackCollection, stackEvents int) ([]stackDiagnostics, error) {
+	stacks, err := stackManager.DescribeStacks()
+	if err != nil {
+		return nil, err
+	}
+	collected := []stackDiagnostics{}
+	for _, s := range stacks {
+		events, err := stackManager.DescribeStackEvents(s)
+		if err != nil {
+			return nil, err
+		}
+		// events are returned most recent first
+		if len(events) > stackEvents {
+			events = events[:stackEvents]
+		}
+		collected = append(collected, stackDiagnostics{
+			StackName:         aws.StringValue(s.StackName),
+			StackStatus:       aws.StringValue(s.StackStatus),
+			StackStatusReason: aws.StringValue(s.StackStatusReason),
+			CreationTime:      s.CreationTime,
+			LastUpdatedTime:   s.LastUpdatedTime,
+			Events:            events,
+		})
+	}
+	return collected, nil
+}
+
+func collectNodeGroups(ctl *eks.ClusterProvider, stackManager *manager.StackCollection, clientSet kubernetes.Interface) ([]*manager.NodeGroupSummary, error) {
+	summaries, err := stackManager.GetNodeGroupSummaries("")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting nodegroup stack summaries")
+	}
+	managedSummaries, err := stackManager.GetManagedNodeGroupSummaries("")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting managed nodegroup summaries")
+	}
+	summaries = append(summaries, managedSummaries...)
+	if err := ctl.SetNodeGroupLiveStatus(stackManager, summaries, clientSet); err != nil {
+		return nil, err
+	}
+	return summaries, nil
+}
+
+func collectAutoScalingGroups(ctl *eks.ClusterProvider, summaries []*manager.NodeGroupSummary) ([]autoScalingGroupDiagnostics, error) {
+	collected := []autoScalingGroupDiagnostics{}
+	for _, summary := range summaries {
+		if summary.AutoScalingGroupName == "" {
+			continue
+		}
+		groups, err := ctl.Provider.ASG().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{summary.AutoScalingGroupName}),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing ASG %q", summary.AutoScalingGroupName)
+		}
+		if len(groups.AutoScalingGroups) == 0 {
+			continue
+		}
+		activities, err := ctl.Provider.ASG().DescribeScalingActivities(&autoscaling.DescribeScalingActivitiesInput{
+			AutoScalingGroupName: aws.String(summary.AutoScalingGroupName),
+			MaxRecords:           aws.Int64(scalingActivities),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing scaling activities of ASG %q", summary.AutoScalingGroupName)
+		}
+		collected = append(collected, autoScalingGroupDiagnostics{
+			NodeGroup:  summary.Name,
+			Group:      groups.AutoScalingGroups[0],
+			Activities: activities.Activities,
+		})
+	}
+	return collected, nil
+}
+
+func collectDaemonSets(clientSet kubernetes.Interface) ([]daemonSetDiagnostics, error) {
+	daemonSets, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing DaemonSets in %s", metav1.NamespaceSystem)
+	}
+	collected := []daemonSetDiagnostics{}
+	for _, ds := range daemonSets.Items {
+		images := []string{}
+		for _, container := range ds.Spec.Template.Spec.Containers {
+			images = append(images, container.Image)
+		}
+		collected = append(collected, daemonSetDiagnostics{
+			Name:      ds.Name,
+			Images:    images,
+			Desired:   ds.Status.DesiredNumberScheduled,
+			Current:   ds.Status.CurrentNumberScheduled,
+			Ready:     ds.Status.NumberReady,
+			Available: ds.Status.NumberAvailable,
+			Updated:   ds.Status.UpdatedNumberScheduled,
+		})
+	}
+	return collected, nil
+}
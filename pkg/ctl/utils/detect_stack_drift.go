@@ -0,0 +1,104 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func detectStackDriftCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("detect-stack-drift", "Detect drift of CloudFormation stacks for a given cluster",
+		"Finds resources of the cluster, nodegroup and IAM service account stacks that were changed outside of CloudFormation")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doDetectStackDrift(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doDetectStackDrift(cmd *cmdutils.Cmd) error {
+	cfg := cmd.ClusterConfig
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", cfg.Metadata.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if cfg.Metadata.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrNameFlagAndArg(cfg.Metadata.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		cfg.Metadata.Name = cmd.NameArg
+	}
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	drifts, err := ctl.NewStackManager(cfg).DetectStacksDrift()
+	if err != nil {
+		return err
+	}
+
+	drifted := 0
+	for _, drift := range drifts {
+		for _, line := range formatStackDrift(drift) {
+			logger.Info("%s", line)
+		}
+		if drift.DriftStatus == cloudformation.StackDriftStatusDrifted {
+			drifted++
+		}
+	}
+
+	if drifted > 0 {
+		return fmt.Errorf("%d of %d stack(s) of cluster %q have drifted", drifted, len(drifts), cfg.Metadata.Name)
+	}
+	logger.Success("no drift found in %d stack(s) of cluster %q", len(drifts), cfg.Metadata.Name)
+	return nil
+}
+
+// formatStackDrift describes the drift status of a stack, followed by each drifted
+// resource and the differences in its properties
+func formatStackDrift(drift *manager.StackDrift) []string {
+	lines := []string{fmt.Sprintf("stack %q: %s", drift.StackName, drift.DriftStatus)}
+	for _, resource := range drift.DriftedResources {
+		lines = append(lines, fmt.Sprintf("  %s %s (%s): %s",
+			aws.StringValue(resource.ResourceType),
+			aws.StringValue(resource.LogicalResourceId),
+			aws.StringValue(resource.PhysicalResourceId),
+			aws.StringValue(resource.StackResourceDriftStatus),
+		))
+		for _, diff := range resource.PropertyDifferences {
+			lines = append(lines, fmt.Sprintf("    %s %s: expected %s, actual %s",
+				aws.StringValue(diff.PropertyPath),
+				aws.StringValue(diff.DifferenceType),
+				aws.StringValue(diff.ExpectedValue),
+				aws.StringValue(diff.ActualValue),
+			))
+		}
+	}
+	return lines
+}
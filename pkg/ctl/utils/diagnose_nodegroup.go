@@ -0,0 +1,122 @@
+package utils
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/health"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/printers"
+)
+
+func diagnoseNodeGroupCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	var (
+		instanceID string
+		logLines   int
+		output     string
+	)
+
+	cmd.SetDescription("diagnose-nodegroup", "Diagnose nodes of a nodegroup that fail to join the cluster",
+		"Checks the aws-auth mapping or access entry of the instance role, the instances that haven't registered as nodes, and the route to the API server, "+
+			"the security group rules, the instance profile and IMDS of an instance, and shows the last lines of its cloud-init and kubelet logs, read through SSM")
+
+	cmd.SetRunFunc(func() error {
+		return doDiagnoseNodeGroup(cmd, ng, instanceID, logLines, output)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVar(&ng.Name, "nodegroup", "", "name of the nodegroup")
+		fs.StringVar(&instanceID, "instance", "", "ID of the instance to check (defaults to an instance that hasn't registered as a node)")
+		fs.IntVar(&logLines, "log-lines", 50, "number of lines of the cloud-init and kubelet logs to show")
+		fs.StringVarP(&output, "output", "o", "table", "specifies the output format (valid option: table, json, yaml)")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doDiagnoseNodeGroup(cmd *cmdutils.Cmd, ng *api.NodeGroup, instanceID string, logLines int, output string) error {
+	if err := cmdutils.NewSSMSessionLoader(cmd, ng).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	if logLines <= 0 {
+		return fmt.Errorf("--log-lines must be greater than 0")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	if err := ctl.GetNodeGroupIAM(stackManager, cfg, ng); err != nil {
+		return err
+	}
+
+	report, err := ctl.DiagnoseNodeGroupJoin(cfg, stackManager, clientSet, ng, instanceID, logLines)
+	if err != nil {
+		return err
+	}
+
+	printer, err := printers.NewPrinter(output)
+	if err != nil {
+		return err
+	}
+
+	if output == "table" {
+		addCheckTableColumns(printer.(*printers.TablePrinter))
+	}
+
+	if err := printer.PrintObjWithKind("checks", report.Checks, os.Stdout); err != nil {
+		return err
+	}
+
+	if output == "table" {
+		printCheckDetails(report)
+	}
+
+	if err := report.Err(false); err != nil {
+		return errors.Wrapf(err, "nodes of nodegroup %q can't join the cluster", ng.Name)
+	}
+	logger.Success("found no reason for nodes of nodegroup %q not to join the cluster", ng.Name)
+	return nil
+}
+
+// printCheckDetails prints the details of the checks below the table, as they span multiple lines
+func printCheckDetails(report *health.Report) {
+	for _, check := range report.Checks {
+		if check.Details != "" {
+			fmt.Fprintf(os.Stdout, "\n%s: %s\n\n%s\n", check.Name, check.Message, check.Details)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package utils
+
+import (
+	"fmt"
+
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func migrateToAccessEntryCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var targetMode string
+
+	cmd.SetDescription("migrate-to-access-entry", "Convert the mappings of the aws-auth ConfigMap to access entries",
+		"Switches the cluster to the API_AND_CONFIG_MAP authentication mode and creates an access entry for each IAM role "+
+			"or user in aws-auth; with --target-authentication-mode=API, the aws-auth ConfigMap is no longer used afterwards")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doMigrateToAccessEntry(cmd, targetMode)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVar(&targetMode, "target-authentication-mode", api.AuthenticationModeAPIAndConfigMap, "authentication mode to switch to after the migration, API_AND_CONFIG_MAP or API")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doMigrateToAccessEntry(cmd *cmdutils.Cmd, targetMode string) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	if targetMode != api.AuthenticationModeAPIAndConfigMap && targetMode != api.AuthenticationModeAPI {
+		return fmt.Errorf("--target-authentication-mode must be %s or %s", api.AuthenticationModeAPIAndConfigMap, api.AuthenticationModeAPI)
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	acm, err := authconfigmap.NewFromClientSet(clientSet)
+	if err != nil {
+		return err
+	}
+	identities, err := acm.Identities()
+	if err != nil {
+		return err
+	}
+
+	entries, warnings := eks.AccessEntriesFromAuthConfigMap(identities)
+	for _, warning := range warnings {
+		logger.Warning(warning)
+	}
+	if len(warnings) > 0 && targetMode == api.AuthenticationModeAPI {
+		return fmt.Errorf("cannot switch cluster %q to authentication mode %s, as %d mapping(s) of the aws-auth ConfigMap would lose access", meta.Name, api.AuthenticationModeAPI, len(warnings))
+	}
+
+	currentMode, err := ctl.AuthenticationMode(cfg)
+	if err != nil {
+		return err
+	}
+	if currentMode != api.AuthenticationModeConfigMap {
+		existing, err := ctl.ListAccessEntries(cfg)
+		if err != nil {
+			return err
+		}
+		entries = eks.MissingAccessEntries(existing, entries)
+	}
+
+	cfg.AccessConfig = &api.AccessConfig{AuthenticationMode: api.AuthenticationModeAPIAndConfigMap}
+	if currentMode == api.AuthenticationModeConfigMap {
+		cmdutils.LogIntendedAction(cmd.Plan, "set authentication mode of cluster %q to %s", meta.Name, api.AuthenticationModeAPIAndConfigMap)
+	}
+	for _, entry := range entries {
+		cmdutils.LogIntendedAction(cmd.Plan, "create %s access entry for %q", entry.Type, entry.PrincipalARN)
+	}
+	if targetMode == api.AuthenticationModeAPI && currentMode != api.AuthenticationModeAPI {
+		cmdutils.LogIntendedAction(cmd.Plan, "set authentication mode of cluster %q to %s, the aws-auth ConfigMap will no longer be used", meta.Name, api.AuthenticationModeAPI)
+	}
+
+	if !cmd.Plan {
+		// access entries can only be created once the cluster uses them
+		if currentMode == api.AuthenticationModeConfigMap {
+			if err := ctl.UpdateAuthenticationMode(cfg); err != nil {
+				return err
+			}
+		}
+		for _, entry := range entries {
+			if err := ctl.CreateAccessEntry(cfg, entry); err != nil {
+				return err
+			}
+		}
+		if targetMode == api.AuthenticationModeAPI {
+			cfg.AccessConfig.AuthenticationMode = targetMode
+			if err := ctl.UpdateAuthenticationMode(cfg); err != nil {
+				return err
+			}
+		}
+	}
+
+	cmdutils.LogCompletedAction(cmd.Plan, "migrated the aws-auth ConfigMap of cluster %q to access entries", meta.Name)
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
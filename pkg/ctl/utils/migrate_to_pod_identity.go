@@ -0,0 +1,145 @@
+package utils
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func migrateToPodIdentityCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var removeIRSA bool
+
+	cmd.SetDescription("migrate-to-pod-identity", "Convert iamserviceaccounts to pod identity associations",
+		"Installs the eks-pod-identity-agent addon and creates a pod identity association for each iamserviceaccount, "+
+			"with a new IAM role that has the same policies; with --remove-irsa, the roles of the iamserviceaccounts are deleted "+
+			"and the eks.amazonaws.com/role-arn annotation is removed from their service accounts, as IRSA credentials take "+
+			"precedence over pod identity credentials in AWS SDKs")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doMigrateToPodIdentity(cmd, removeIRSA)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.BoolVar(&removeIRSA, "remove-irsa", false, "delete the IAM roles of the iamserviceaccounts and remove the role annotation from their service accounts")
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, true)
+}
+
+func doMigrateToPodIdentity(cmd *cmdutils.Cmd, removeIRSA bool) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	serviceAccounts, err := stackManager.ExportIAMServiceAccounts()
+	if err != nil {
+		return err
+	}
+	if len(serviceAccounts) == 0 {
+		logger.Info("cluster %q has no iamserviceaccounts to migrate", meta.Name)
+		return nil
+	}
+
+	existing, err := ctl.ListPodIdentityAssociations(cfg)
+	if err != nil {
+		return err
+	}
+	associations := eks.MissingPodIdentityAssociations(existing, eks.PodIdentityAssociationsFromIAMServiceAccounts(serviceAccounts))
+
+	cmdutils.LogIntendedAction(cmd.Plan, "install addon %q unless it's installed already", api.PodIdentityAgentAddon)
+	for _, association := range associations {
+		cmdutils.LogIntendedAction(cmd.Plan, "create pod identity association %q", association.NameString())
+	}
+	if removeIRSA {
+		for _, sa := range serviceAccounts {
+			cmdutils.LogIntendedAction(cmd.Plan, "delete IAM role of iamserviceaccount %q and remove its %s annotation", sa.NameString(), api.AnnotationEKSRoleARN)
+		}
+	}
+
+	if !cmd.Plan {
+		if err := ctl.EnsurePodIdentityAgent(cfg); err != nil {
+			return err
+		}
+		for _, association := range associations {
+			if err := ctl.CreatePodIdentityAssociation(cfg, association); err != nil {
+				return err
+			}
+		}
+		if removeIRSA {
+			if err := removeIAMServiceAccountRoles(ctl, cfg, serviceAccounts); err != nil {
+				return err
+			}
+		}
+	}
+
+	cmdutils.LogCompletedAction(cmd.Plan, "migrated iamserviceaccounts of cluster %q to pod identity associations", meta.Name)
+	if !removeIRSA {
+		logger.Info("service accounts keep using their IRSA roles until the %s annotation is removed, re-run with --remove-irsa once workloads were verified", api.AnnotationEKSRoleARN)
+	}
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+
+	return nil
+}
+
+// removeIAMServiceAccountRoles deletes the stacks of the IAM roles of the iamserviceaccounts, but keeps
+// their service accounts, as pods refer to them
+func removeIAMServiceAccountRoles(ctl *eks.ClusterProvider, cfg *api.ClusterConfig, serviceAccounts []*api.ClusterIAMServiceAccount) error {
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	stackManager := ctl.NewStackManager(cfg)
+	stacks, err := stackManager.DescribeIAMServiceAccountStacks()
+	if err != nil {
+		return err
+	}
+
+	for _, sa := range serviceAccounts {
+		if err := kubernetes.MaybeRemoveServiceAccountAnnotation(clientSet, sa.ObjectMeta, api.AnnotationEKSRoleARN); err != nil {
+			return err
+		}
+		for _, s := range stacks {
+			if stackManager.GetIAMServiceAccountName(s) != sa.NameString() {
+				continue
+			}
+			errs := make(chan error)
+			if err := stackManager.DeleteStackBySpecSync(s, errs); err != nil {
+				return err
+			}
+			if err := <-errs; err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
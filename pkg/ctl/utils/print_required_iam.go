@@ -0,0 +1,201 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/audit"
+	"github.com/weaveworks/eksctl/pkg/backup"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/notify"
+	"github.com/weaveworks/eksctl/pkg/permissions"
+)
+
+// maxManagedPolicySize is the most characters that a managed policy may have, not counting whitespace
+const maxManagedPolicySize = 6144
+
+// requiredIAMCommand is the eksctl command that a policy is printed for
+type requiredIAMCommand struct {
+	name       string
+	configFile string
+	cluster    string
+	region     string
+	cfnRoleARN string
+}
+
+func printRequiredIAMCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var command string
+
+	cmd.SetDescription("print-required-iam", "Print the IAM policy that an eksctl command needs",
+		"Prints the least-privilege IAM policy that the given command, e.g. \"create cluster -f cluster.yaml\", needs to run, "+
+			"with resources restricted to those of the cluster, so that it can run with a role that doesn't have administrator access")
+
+	cmd.SetRunFunc(func() error {
+		return doPrintRequiredIAM(cmd, command)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&command, "command", "", fmt.Sprintf("the eksctl command to print the policy for, one of %s", strings.Join(permissions.SupportedCommands(), ", ")))
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doPrintRequiredIAM(cmd *cmdutils.Cmd, command string) error {
+	if command == "" {
+		return cmdutils.ErrMustBeSet("--command")
+	}
+	c, err := parseRequiredIAMCommand(command)
+	if err != nil {
+		return err
+	}
+
+	if c.configFile != "" {
+		cfg, err := eks.LoadConfigFromFile(c.configFile)
+		if err != nil {
+			return err
+		}
+		cmd.ClusterConfig = cfg
+	}
+	cfg := cmd.ClusterConfig
+	meta := cfg.Metadata
+	if c.cluster != "" {
+		meta.Name = c.cluster
+	}
+	if meta.Name == "" {
+		return fmt.Errorf("the command must set the cluster with --name, --cluster or --config-file")
+	}
+	if c.region != "" {
+		meta.Region = c.region
+	}
+	if meta.Region != "" {
+		cmd.ProviderConfig.Region = meta.Region
+	}
+	if c.cfnRoleARN != "" {
+		cmd.ProviderConfig.CloudFormationRoleARN = c.cfnRoleARN
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	region := ctl.Provider.Region()
+
+	// the account ID only narrows the resources, so a policy can still be printed without credentials
+	accountID := "*"
+	if err := ctl.CheckAuth(); err != nil {
+		logger.Warning("the policy applies to all accounts, as the current account couldn't be found: %s", err.Error())
+	} else {
+		accountID = ctl.AccountID()
+	}
+
+	scope := permissions.Scope{
+		Partition:             api.Partition(region),
+		Region:                region,
+		AccountID:             accountID,
+		CloudFormationRoleARN: cmd.ProviderConfig.CloudFormationRoleARN,
+	}
+	// backups, the audit log and notifications may be enabled by the environment the command runs in
+	if location := backup.LocationFor(cfg); location != nil {
+		scope.BackupBucket, scope.BackupPrefix = location.Bucket, location.Prefix
+	}
+	if journal := audit.NewJournalFromEnv(); journal != nil {
+		scope.AuditLogGroup = journal.LogGroup
+	}
+	if notifier := notify.NewNotifierFromEnv("eksctl " + c.name); notifier != nil {
+		scope.NotifyEventBus = notifier.EventBus
+	}
+
+	policy, err := permissions.Required(c.name, cfg, scope)
+	if err != nil {
+		return err
+	}
+
+	compact, err := json.Marshal(policy)
+	if err != nil {
+		return err
+	}
+	if len(compact) > maxManagedPolicySize {
+		logger.Warning("the policy has %d characters, more than the %d that a managed policy may have, it must be split into several policies", len(compact), maxManagedPolicySize)
+	}
+	indented, err := json.MarshalIndent(policy, "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(indented))
+	return nil
+}
+
+// parseRequiredIAMCommand parses the verb, the resource and the flags that the policy depends on,
+// other flags are ignored
+func parseRequiredIAMCommand(command string) (*requiredIAMCommand, error) {
+	args, err := shellquote.Split(strings.TrimPrefix(strings.TrimSpace(command), "eksctl "))
+	if err != nil {
+		return nil, fmt.Errorf("parsing command %q: %s", command, err.Error())
+	}
+
+	c := &requiredIAMCommand{}
+	// --name is the name of the cluster for cluster commands only, e.g. it's the name of the nodegroup
+	// for nodegroup commands, which set the cluster with --cluster
+	var name string
+	words := []string{}
+	for i := 0; i < len(args); i++ {
+		arg := args[i]
+		if !strings.HasPrefix(arg, "-") {
+			words = append(words, arg)
+			continue
+		}
+		flag, value := arg, ""
+		hasValue := false
+		if eq := strings.Index(arg, "="); eq >= 0 {
+			flag, value, hasValue = arg[:eq], arg[eq+1:], true
+		}
+		var dest *string
+		switch flag {
+		case "-f", "--config-file":
+			dest = &c.configFile
+		case "-n", "--name":
+			dest = &name
+		case "--cluster":
+			dest = &c.cluster
+		case "-r", "--region":
+			dest = &c.region
+		case "--cfn-role-arn":
+			dest = &c.cfnRoleARN
+		default:
+			continue
+		}
+		if !hasValue {
+			if i+1 >= len(args) {
+				return nil, fmt.Errorf("flag %s of command %q has no value", flag, command)
+			}
+			i++
+			value = args[i]
+		}
+		*dest = value
+	}
+
+	if len(words) < 2 {
+		return nil, fmt.Errorf("command %q must have a verb and a resource, e.g. \"create cluster\"", command)
+	}
+	c.name = words[0] + " " + words[1]
+	if words[1] == "cluster" && c.cluster == "" {
+		c.cluster = name
+		// the name of a cluster may also be given as an argument, e.g. "delete cluster dev"
+		if c.cluster == "" && len(words) > 2 {
+			c.cluster = words[2]
+		}
+	}
+	return c, nil
+}
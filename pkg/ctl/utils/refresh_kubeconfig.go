@@ -0,0 +1,91 @@
+package utils
+
+import (
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/file"
+	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+)
+
+func refreshKubeconfigCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var paths []string
+
+	cmd.SetDescription("refresh-kubeconfig", "Update the endpoint and certificate authority of a cluster in kubeconfig files",
+		"Re-fetches the endpoint and certificate authority of the cluster, e.g. after its endpoint access was changed, and rewrites them in all entries for the cluster",
+		"rotate-cluster-certificate")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doRefreshKubeconfig(cmd, paths)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		fs.StringSliceVar(&paths, "kubeconfig", []string{kubeconfig.DefaultPath}, "kubeconfig files to update, the auto-generated file of the cluster is updated too if it exists")
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doRefreshKubeconfig(cmd *cmdutils.Cmd, paths []string) error {
+	cfg := cmd.ClusterConfig
+
+	if cfg.Metadata.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrNameFlagAndArg(cfg.Metadata.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		cfg.Metadata.Name = cmd.NameArg
+	}
+
+	if cfg.Metadata.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", cfg.Metadata.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	if autoPath := kubeconfig.AutoPath(cfg.Metadata.Name); file.Exists(autoPath) {
+		paths = append(paths, autoPath)
+	}
+
+	for _, path := range paths {
+		if !file.Exists(path) {
+			logger.Warning("kubeconfig file %q doesn't exist", path)
+			continue
+		}
+		updated, err := kubeconfig.Refresh(path, cfg)
+		if err != nil {
+			return err
+		}
+		if updated == 0 {
+			logger.Info("no stale entries for %s in %q", cfg.Metadata.LogString(), path)
+			continue
+		}
+		logger.Success("updated %d entries for %s in %q", updated, cfg.Metadata.LogString(), path)
+	}
+
+	if !ctl.ControlPlaneHasPublicEndpoint() {
+		logger.Info("the API endpoint of %s can only be reached from within its VPC", cfg.Metadata.LogString())
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package utils
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func repairAWSAuthCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	cmd.SetDescription("repair-aws-auth", "Re-add missing node role mappings to the aws-auth ConfigMap",
+		"Maps the IAM role of each nodegroup stack of the cluster in the aws-auth ConfigMap when it's missing, existing mappings are left as they are; "+
+			"nodegroups in the config file that use a Windows AMI family are mapped with the groups Windows nodes need")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doRepairAWSAuth(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		cmdutils.AddApproveFlag(fs, cmd)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doRepairAWSAuth(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewMetadataLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	stackManager := ctl.NewStackManager(cfg)
+	stacks, err := stackManager.DescribeNodeGroupStacks()
+	if err != nil {
+		return errors.Wrap(err, "describing nodegroup stacks")
+	}
+
+	configNodeGroups := map[string]*api.NodeGroup{}
+	for _, ng := range cfg.NodeGroups {
+		configNodeGroups[ng.Name] = ng
+	}
+
+	nodeGroups := []*api.NodeGroup{}
+	for _, s := range stacks {
+		name := stackManager.GetNodeGroupName(s)
+		ng, ok := configNodeGroups[name]
+		if !ok {
+			ng = api.NewNodeGroup()
+			ng.Name = name
+		}
+		if err := iam.UseFromNodeGroup(ctl.Provider, s, ng); err != nil {
+			return errors.Wrapf(err, "getting IAM role of nodegroup %q", name)
+		}
+		nodeGroups = append(nodeGroups, ng)
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	acm, err := authconfigmap.NewFromClientSet(clientSet)
+	if err != nil {
+		return err
+	}
+	identities, err := acm.Identities()
+	if err != nil {
+		return errors.Wrap(err, "parsing auth ConfigMap")
+	}
+
+	missing := authconfigmap.MissingNodeGroupRoles(identities, nodeGroups)
+	if len(missing) == 0 {
+		logger.Success("the roles of all %d nodegroup(s) of cluster %q are in the aws-auth ConfigMap", len(nodeGroups), meta.Name)
+		return nil
+	}
+	for _, ng := range missing {
+		cmdutils.LogIntendedAction(cmd.Plan, "add role %q of nodegroup %q to the aws-auth ConfigMap", ng.IAM.InstanceRoleARN, ng.Name)
+	}
+
+	if !cmd.Plan {
+		if _, err := authconfigmap.AddNodeGroups(clientSet, missing); err != nil {
+			return err
+		}
+	}
+
+	cmdutils.LogCompletedAction(cmd.Plan, "added %d missing node role(s) to the aws-auth ConfigMap of cluster %q", len(missing), meta.Name)
+	cmdutils.LogPlanModeWarning(cmd.Plan)
+	return nil
+}
@@ -0,0 +1,27 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/alecthomas/jsonschema"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+)
+
+func schemaCmd(cmd *cmdutils.Cmd) {
+	cmd.SetDescription("schema", "Output the JSON Schema of the config file",
+		fmt.Sprintf("Outputs the JSON Schema of %s, which editors can use to validate and complete config files", api.SchemeGroupVersion.String()))
+
+	cmd.SetRunFunc(doSchema)
+}
+
+func doSchema() error {
+	schema, err := json.MarshalIndent(jsonschema.Reflect(&api.ClusterConfig{}), "", "  ")
+	if err != nil {
+		return err
+	}
+	fmt.Println(string(schema))
+	return nil
+}
@@ -0,0 +1,89 @@
+package utils
+
+import (
+	"os"
+	"os/exec"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func ssmSessionCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	ng := cfg.NewNodeGroup()
+	cmd.ClusterConfig = cfg
+
+	var nodeName string
+
+	cmd.SetDescription("ssm-session", "Start an SSM session to a node of a nodegroup",
+		"Requires the AWS CLI with the Session Manager plugin, and the nodegroup to be created with ssh.enableSSM")
+
+	cmd.SetRunFunc(func() error {
+		return doSSMSession(cmd, ng, nodeName)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		fs.StringVar(&cfg.Metadata.Name, "cluster", "", "EKS cluster name")
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVar(&ng.Name, "nodegroup", "", "name of the nodegroup")
+		fs.StringVar(&nodeName, "node", "", "name of the node to start the session to (defaults to any ready node of the nodegroup)")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doSSMSession(cmd *cmdutils.Cmd, ng *api.NodeGroup, nodeName string) error {
+	if err := cmdutils.NewSSMSessionLoader(cmd, ng).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	awsCLI, err := exec.LookPath("aws")
+	if err != nil {
+		return errors.Wrap(err, "the AWS CLI is required to start SSM sessions")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if ok, err := ctl.CanOperate(cfg); !ok {
+		return err
+	}
+
+	clientSet, err := ctl.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	instanceID, err := eks.NodeGroupInstanceID(clientSet, ng, nodeName)
+	if err != nil {
+		return err
+	}
+	logger.Info("starting SSM session to instance %q of nodegroup %q", instanceID, ng.Name)
+
+	args := []string{"ssm", "start-session", "--target", instanceID, "--region", meta.Region}
+	if profile := cmd.ProviderConfig.Profile; profile != "" {
+		args = append(args, "--profile", profile)
+	}
+
+	session := exec.Command(awsCLI, args...)
+	session.Stdin = os.Stdin
+	session.Stdout = os.Stdout
+	session.Stderr = os.Stderr
+	return session.Run()
+}
@@ -3,10 +3,10 @@ package utils
 import (
 	"os"
 
-	"github.com/kris-nova/logger"
 	"github.com/spf13/cobra"
 
 	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func updateClusterStackCmd(cmd *cmdutils.Cmd) {
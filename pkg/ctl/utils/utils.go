@@ -11,13 +11,27 @@ func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
 
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, waitNodesCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, writeKubeconfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, refreshKubeconfigCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, describeStacksCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, writeConfigCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, writeTemplatesCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, printRequiredIAMCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, detectStackDriftCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, checkClusterHealthCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, diagnoseNodeGroupCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, collectDiagnosticsCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateClusterStackCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateKubeProxyCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateAWSNodeCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, updateCoreDNSCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, enableLoggingCmd)
 	cmdutils.AddResourceCmd(flagGrouping, verbCmd, associateIAMOIDCProviderCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, installWindowsVPCControllerCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, ssmSessionCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, schemaCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, migrateToAccessEntryCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, migrateToPodIdentityCmd)
+	cmdutils.AddResourceCmd(flagGrouping, verbCmd, repairAWSAuthCmd)
 
 	return verbCmd
 }
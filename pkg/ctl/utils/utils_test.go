@@ -4,6 +4,11 @@ import (
 	"strconv"
 	"strings"
 	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 )
 
 func TestValidateLoggingFlags(t *testing.T) {
@@ -98,3 +103,75 @@ func TestValidateLoggingFlags(t *testing.T) {
 	}
 
 }
+
+func TestFormatStackDrift(t *testing.T) {
+	drift := &manager.StackDrift{
+		StackName:   "eksctl-test-cluster",
+		DriftStatus: cloudformation.StackDriftStatusDrifted,
+		DriftedResources: []*cloudformation.StackResourceDrift{
+			{
+				ResourceType:             aws.String("AWS::EC2::SecurityGroup"),
+				LogicalResourceId:        aws.String("ControlPlaneSecurityGroup"),
+				PhysicalResourceId:       aws.String("sg-1"),
+				StackResourceDriftStatus: aws.String(cloudformation.StackResourceDriftStatusModified),
+				PropertyDifferences: []*cloudformation.PropertyDifference{{
+					PropertyPath:   aws.String("/SecurityGroupIngress/0"),
+					DifferenceType: aws.String(cloudformation.DifferenceTypeAdd),
+					ExpectedValue:  aws.String("null"),
+					ActualValue:    aws.String(`{"CidrIp":"0.0.0.0/0"}`),
+				}},
+			},
+		},
+	}
+
+	expected := []string{
+		`stack "eksctl-test-cluster": DRIFTED`,
+		`  AWS::EC2::SecurityGroup ControlPlaneSecurityGroup (sg-1): MODIFIED`,
+		`    /SecurityGroupIngress/0 ADD: expected null, actual {"CidrIp":"0.0.0.0/0"}`,
+	}
+	lines := formatStackDrift(drift)
+	if strings.Join(lines, "\n") != strings.Join(expected, "\n") {
+		t.Errorf("unexpected output:\n%s", strings.Join(lines, "\n"))
+	}
+}
+
+func TestParseRequiredIAMCommand(t *testing.T) {
+	tests := []struct {
+		command  string
+		expected requiredIAMCommand
+	}{
+		{
+			command:  "create cluster -f cluster.yaml",
+			expected: requiredIAMCommand{name: "create cluster", configFile: "cluster.yaml"},
+		},
+		{
+			command:  "eksctl delete cluster dev --region=eu-west-1 --wait",
+			expected: requiredIAMCommand{name: "delete cluster", cluster: "dev", region: "eu-west-1"},
+		},
+		{
+			command:  "update cluster --name=dev",
+			expected: requiredIAMCommand{name: "update cluster", cluster: "dev"},
+		},
+		{
+			command:  "scale nodegroup --cluster dev --name ng-1 --nodes 3 --cfn-role-arn arn:aws:iam::123456789012:role/cfn",
+			expected: requiredIAMCommand{name: "scale nodegroup", cluster: "dev", cfnRoleARN: "arn:aws:iam::123456789012:role/cfn"},
+		},
+	}
+
+	for _, test := range tests {
+		c, err := parseRequiredIAMCommand(test.command)
+		if err != nil {
+			t.Errorf("parsing %q: %s", test.command, err.Error())
+			continue
+		}
+		if *c != test.expected {
+			t.Errorf("parsing %q: expected %+v, got %+v", test.command, test.expected, *c)
+		}
+	}
+
+	for _, command := range []string{"create", "create cluster -f"} {
+		if _, err := parseRequiredIAMCommand(command); err == nil {
+			t.Errorf("expected parsing %q to fail", command)
+		}
+	}
+}
@@ -0,0 +1,68 @@
+package utils
+
+import (
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+func writeConfigCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var outputFile string
+
+	cmd.SetDescription("write-config", "Write a ClusterConfig file for an existing cluster",
+		"Reconstructs the config of a live cluster from its stacks and the EKS API, including networking, logging, IAM, nodegroups, Fargate profiles and addons")
+
+	cmd.SetRunFuncWithNameArg(func() error {
+		return doWriteConfig(cmd, outputFile)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddNameFlag(fs, cfg.Metadata)
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddOutputFileFlag(fs, &outputFile)
+		cmdutils.AddTimeoutFlag(fs, &cmd.ProviderConfig.WaitTimeout)
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doWriteConfig(cmd *cmdutils.Cmd, outputFile string) error {
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	if meta.Name != "" && cmd.NameArg != "" {
+		return cmdutils.ErrNameFlagAndArg(meta.Name, cmd.NameArg)
+	}
+
+	if cmd.NameArg != "" {
+		meta.Name = cmd.NameArg
+	}
+
+	if meta.Name == "" {
+		return cmdutils.ErrMustBeSet("--name")
+	}
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if err := ctl.ExportClusterConfig(cfg); err != nil {
+		return errors.Wrapf(err, "exporting configuration of cluster %q", meta.Name)
+	}
+	logger.Info("found %d nodegroup(s), %d managed nodegroup(s), %d Fargate profile(s) and %d addon(s) in cluster %q",
+		len(cfg.NodeGroups), len(cfg.ManagedNodeGroups), len(cfg.FargateProfiles), len(cfg.Addons), meta.Name)
+
+	return cmdutils.WriteClusterConfig(cfg, outputFile)
+}
@@ -0,0 +1,182 @@
+package utils
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/ctl/create"
+	"github.com/weaveworks/eksctl/pkg/eks"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// placeholders for the status of clusters that don't exist yet
+const (
+	placeholderEndpoint                 = "https://CLUSTER-ENDPOINT"
+	placeholderCertificateAuthorityData = "CLUSTER-CERTIFICATE-AUTHORITY-DATA"
+	placeholderOIDCIssuerFmt            = "https://oidc.eks.%s.%s/id/CLUSTER-OIDC-ID"
+)
+
+func writeTemplatesCmd(cmd *cmdutils.Cmd) {
+	cfg := api.NewClusterConfig()
+	cmd.ClusterConfig = cfg
+
+	var outputDir string
+
+	cmd.SetDescription("write-templates", "Write the CloudFormation templates of a cluster to files",
+		"Renders the templates of the stacks that 'eksctl create cluster' creates for a config file, i.e. of the cluster, its nodegroups, "+
+			"managed nodegroups and iamserviceaccounts, without creating any stacks, so that they can be reviewed and compared")
+
+	cmd.SetRunFunc(func() error {
+		return doWriteTemplates(cmd, outputDir)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddRegionFlag(fs, cmd.ProviderConfig)
+		cmdutils.AddConfigFileFlag(fs, cmd)
+		fs.StringVar(&outputDir, "out", "", "directory to write the templates to, one file per stack")
+	})
+
+	cmdutils.AddCommonFlagsForAWS(cmd.FlagSetGroup, cmd.ProviderConfig, false)
+}
+
+func doWriteTemplates(cmd *cmdutils.Cmd, outputDir string) error {
+	if cmd.ClusterConfigFile == "" {
+		return cmdutils.ErrMustBeSet("--config-file")
+	}
+	if outputDir == "" {
+		return cmdutils.ErrMustBeSet("--out")
+	}
+
+	ngFilter := cmdutils.NewNodeGroupFilter()
+	if err := cmdutils.NewCreateClusterLoader(cmd, ngFilter, nil, false).Load(); err != nil {
+		return err
+	}
+
+	cfg := cmd.ClusterConfig
+	meta := cmd.ClusterConfig.Metadata
+
+	ctl, err := cmd.NewCtl()
+	if err != nil {
+		return err
+	}
+	logger.Info("using region %s", meta.Region)
+
+	if err := ctl.CheckAuth(); err != nil {
+		return err
+	}
+
+	if meta.Version == "" {
+		meta.Version = api.DefaultVersion
+	}
+
+	oidc, err := loadClusterStatus(ctl, cfg)
+	if err != nil {
+		return err
+	}
+
+	if cfg.VPC.SubnetDiscovery != nil {
+		if err := vpc.DiscoverSubnets(ctl.Provider, cfg); err != nil {
+			return err
+		}
+	}
+	if cfg.HasAnySubnets() {
+		if err := vpc.ImportAllSubnets(ctl.Provider, cfg); err != nil {
+			return err
+		}
+	} else {
+		if err := ctl.SetAvailabilityZones(cfg, nil); err != nil {
+			return err
+		}
+		if err := vpc.SetSubnets(cfg); err != nil {
+			return err
+		}
+	}
+
+	nodeGroups := ngFilter.FilterMatching(cfg.NodeGroups)
+	for _, ng := range nodeGroups {
+		if err := create.PrepareNodeGroup(ctl, meta, ng); err != nil {
+			return err
+		}
+		// SSH keys are only imported to EC2 when the nodegroup is created
+		if ng.SSH != nil && api.IsEnabled(ng.SSH.Allow) && !api.IsSetAndNonEmptyString(ng.SSH.PublicKeyName) {
+			logger.Warning("the template of nodegroup %q has no EC2 key pair, as its SSH key is only imported when the nodegroup is created", ng.Name)
+		}
+	}
+
+	templates, err := ctl.NewStackManager(cfg).RenderStackTemplates(nodeGroups, oidc)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return errors.Wrapf(err, "creating %q", outputDir)
+	}
+	for _, t := range templates {
+		indented := &bytes.Buffer{}
+		if err := json.Indent(indented, t.Template, "", "  "); err != nil {
+			return errors.Wrapf(err, "formatting template of %q stack", t.StackName)
+		}
+		indented.WriteString("\n")
+		path := filepath.Join(outputDir, t.StackName+".json")
+		if err := ioutil.WriteFile(path, indented.Bytes(), 0644); err != nil {
+			return errors.Wrapf(err, "writing %q", path)
+		}
+		logger.Info("wrote template of stack %q to %q", t.StackName, path)
+	}
+	logger.Success("wrote %d template(s) of cluster %q to %q", len(templates), meta.Name, outputDir)
+	return nil
+}
+
+// loadClusterStatus loads the endpoint and certificate authority of the cluster, which the templates of
+// nodegroups refer to, and returns the OIDC manager for the templates of iamserviceaccounts; placeholders
+// are used when the cluster doesn't exist yet
+func loadClusterStatus(ctl *eks.ClusterProvider, cfg *api.ClusterConfig) (*iamoidc.OpenIDConnectManager, error) {
+	err := ctl.RefreshClusterStatus(cfg)
+	if err == nil {
+		if len(cfg.IAM.ServiceAccounts) == 0 {
+			return nil, nil
+		}
+		oidc, err := ctl.NewOpenIDConnectManager(cfg)
+		if err != nil {
+			return nil, err
+		}
+		providerExists, err := oidc.CheckProviderExists()
+		if err != nil {
+			return nil, err
+		}
+		if !providerExists {
+			logger.Warning("no IAM OIDC provider is associated with cluster %q, the templates of iamserviceaccounts are not written", cfg.Metadata.Name)
+			return nil, nil
+		}
+		return oidc, nil
+	}
+
+	if awsErr, ok := errors.Cause(err).(awserr.Error); !ok || awsErr.Code() != awseks.ErrCodeResourceNotFoundException {
+		return nil, err
+	}
+	logger.Info("cluster %q doesn't exist yet, the templates refer to placeholders for its endpoint, certificate authority and OIDC issuer", cfg.Metadata.Name)
+	cfg.Status = &api.ClusterStatus{
+		Endpoint:                 placeholderEndpoint,
+		CertificateAuthorityData: []byte(placeholderCertificateAuthorityData),
+	}
+	issuer := fmt.Sprintf(placeholderOIDCIssuerFmt, cfg.Metadata.Region, api.PartitionDNSSuffix(cfg.Metadata.Region))
+	oidc, err := iamoidc.NewOpenIDConnectManager(ctl.Provider.IAM(), ctl.AccountID(), issuer)
+	if err != nil {
+		return nil, err
+	}
+	// the provider of the placeholder issuer doesn't exist, so its ARN is derived from the issuer
+	return oidc.WithIssuer(issuer)
+}
@@ -0,0 +1,49 @@
+package validate
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/ctl/cmdutils"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// Command will create the `validate` command
+func Command(flagGrouping *cmdutils.FlagGrouping) *cobra.Command {
+	return cmdutils.NewCmd(flagGrouping, validateCmd)
+}
+
+func validateCmd(cmd *cmdutils.Cmd) {
+	cmd.ClusterConfig = api.NewClusterConfig()
+
+	cmd.SetDescription("validate", "Validate a config file",
+		"Checks field types, supported values and constraints between fields of a config file, without contacting AWS")
+
+	cmd.SetRunFunc(func() error {
+		return doValidate(cmd)
+	})
+
+	cmd.FlagSetGroup.InFlagSet("General", func(fs *pflag.FlagSet) {
+		cmdutils.AddConfigFileFlag(fs, cmd)
+	})
+}
+
+func doValidate(cmd *cmdutils.Cmd) error {
+	if err := cmdutils.NewValidateLoader(cmd).Load(); err != nil {
+		return err
+	}
+
+	errs := api.ValidateClusterConfigFile(cmd.ClusterConfig)
+	if len(errs) > 0 {
+		for _, err := range errs {
+			logger.Critical("%s", err.Error())
+		}
+		return fmt.Errorf("found %d error(s) in config file %q", len(errs), cmd.ClusterConfigFile)
+	}
+
+	logger.Success("config file %q is valid", cmd.ClusterConfigFile)
+	return nil
+}
@@ -0,0 +1,97 @@
+package diagnostics
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"encoding/json"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// errorsFile is the file of a bundle that lists what couldn't be collected
+const errorsFile = "errors.txt"
+
+type bundleFile struct {
+	name string
+	data []byte
+}
+
+// Bundle collects the files of the diagnostics of a cluster, they are redacted as they're added,
+// so that the bundle can be attached to support tickets
+type Bundle struct {
+	files  []bundleFile
+	errors []string
+}
+
+// AddJSON adds a file with the indented JSON of v
+func (b *Bundle) AddJSON(name string, v interface{}) {
+	data, err := json.MarshalIndent(v, "", "  ")
+	if err != nil {
+		b.AddError(name, errors.Wrap(err, "encoding JSON"))
+		return
+	}
+	b.AddFile(name, append(data, '\n'))
+}
+
+// AddFile adds a file with the given data
+func (b *Bundle) AddFile(name string, data []byte) {
+	b.files = append(b.files, bundleFile{name: name, data: Redact(data)})
+}
+
+// AddError records that part of the diagnostics couldn't be collected, the bundle is still worth
+// attaching without it, e.g. the stacks of a cluster whose control plane failed to be created
+func (b *Bundle) AddError(part string, err error) {
+	b.errors = append(b.errors, fmt.Sprintf("%s: %s", part, err.Error()))
+}
+
+// Names returns the names of the files of the bundle in the order they were added
+func (b *Bundle) Names() []string {
+	names := []string{}
+	for _, f := range b.files {
+		names = append(names, f.name)
+	}
+	if len(b.errors) > 0 {
+		names = append(names, errorsFile)
+	}
+	return names
+}
+
+// Errors returns what couldn't be collected
+func (b *Bundle) Errors() []string {
+	return b.errors
+}
+
+// WriteTarball writes the files of the bundle as a gzipped tarball, in a directory named dir
+func (b *Bundle) WriteTarball(w io.Writer, dir string, modTime time.Time) error {
+	gz := gzip.NewWriter(w)
+	tw := tar.NewWriter(gz)
+
+	files := b.files
+	if len(b.errors) > 0 {
+		files = append(files, bundleFile{name: errorsFile, data: Redact([]byte(strings.Join(b.errors, "\n") + "\n"))})
+	}
+	for _, f := range files {
+		header := &tar.Header{
+			Name:    path.Join(dir, f.name),
+			Mode:    0600,
+			Size:    int64(len(f.data)),
+			ModTime: modTime,
+		}
+		if err := tw.WriteHeader(header); err != nil {
+			return errors.Wrapf(err, "writing %q", f.name)
+		}
+		if _, err := tw.Write(f.data); err != nil {
+			return errors.Wrapf(err, "writing %q", f.name)
+		}
+	}
+
+	if err := tw.Close(); err != nil {
+		return err
+	}
+	return gz.Close()
+}
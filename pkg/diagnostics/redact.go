@@ -0,0 +1,69 @@
+package diagnostics
+
+import (
+	"regexp"
+	"strings"
+)
+
+// Redacted replaces what Redact removes
+const Redacted = "REDACTED"
+
+var (
+	// accountIDPattern matches AWS account IDs, e.g. in ARNs, but not longer numbers like timestamps
+	accountIDPattern = regexp.MustCompile(`\b[0-9]{12}\b`)
+	// accessKeyIDPattern matches the IDs of long-term and temporary access keys
+	accessKeyIDPattern = regexp.MustCompile(`\b(AKIA|ASIA)[A-Z0-9]{16}\b`)
+	// secretFieldPattern matches JSON fields whose names suggest they hold credentials
+	secretFieldPattern = regexp.MustCompile(`(?i)("[a-z_-]*(password|secret|token|credentials)[a-z_-]*"\s*:\s*)"(?:[^"\\]|\\.)*"`)
+	// secretFlagPatterns match the values of flags whose names suggest they take credentials, e.g. in the
+	// args of the journal, as --flag=value and as --flag followed by value; URLs of webhooks often embed
+	// a secret, e.g. in the path of Slack incoming webhooks
+	secretFlagPatterns = []*regexp.Regexp{
+		regexp.MustCompile(`(?i)("--[a-z-]*(?:password|secret|token|url|webhook)[a-z-]*=)(?:[^"\\]|\\.)*"`),
+		regexp.MustCompile(`(?i)("--[a-z-]*(?:password|secret|token|url|webhook)[a-z-]*"\s*,\s*)"(?:[^"\\]|\\.)*"`),
+	}
+	// secretFlagNamePattern matches the names of the flags of secretFlagPatterns
+	secretFlagNamePattern = regexp.MustCompile(`(?i)^--?[a-z-]*(password|secret|token|url|webhook)[a-z-]*$`)
+	// secretKeyValuePattern matches key=value args, e.g. the values of --set, whose keys suggest they hold
+	// credentials, e.g. notifications.webhookURL=..., the key is kept
+	secretKeyValuePattern = regexp.MustCompile(`(?i)^((?:--?[a-z-]+=)?[a-z0-9_.\[\]-]*(?:password|secret|token|url|webhook)[a-z0-9_.\[\]-]*=).*$`)
+	// secretKeyValueFieldPattern matches the args of secretKeyValuePattern in JSON
+	secretKeyValueFieldPattern = regexp.MustCompile(`(?i)("(?:--?[a-z-]+=)?[a-z0-9_.\[\]-]*(?:password|secret|token|url|webhook)[a-z0-9_.\[\]-]*=)(?:[^"\\]|\\.)*"`)
+)
+
+// Redact removes account IDs, access key IDs and the values of fields and flags that look like they hold
+// credentials, e.g. tokens in the args of commands; names of resources are kept, as support needs them
+func Redact(data []byte) []byte {
+	data = accountIDPattern.ReplaceAll(data, []byte(Redacted))
+	data = accessKeyIDPattern.ReplaceAll(data, []byte(Redacted))
+	data = secretFieldPattern.ReplaceAll(data, []byte(`${1}"`+Redacted+`"`))
+	data = secretFlagPatterns[0].ReplaceAll(data, []byte(`${1}`+Redacted+`"`))
+	data = secretKeyValueFieldPattern.ReplaceAll(data, []byte(`${1}`+Redacted+`"`))
+	return secretFlagPatterns[1].ReplaceAll(data, []byte(`${1}"`+Redacted+`"`))
+}
+
+// RedactArgs replaces the values of flags in the args of a command that look like they hold credentials,
+// as --flag=value and as --flag followed by value, and of key=value args whose keys look like they do,
+// e.g. --set notifications.webhookURL=..., e.g. before the args are recorded
+func RedactArgs(args []string) []string {
+	if len(args) == 0 {
+		return args
+	}
+	redacted := make([]string, 0, len(args))
+	for i := 0; i < len(args); i++ {
+		name := strings.SplitN(args[i], "=", 2)[0]
+		switch {
+		case !secretFlagNamePattern.MatchString(name):
+			redacted = append(redacted, secretKeyValuePattern.ReplaceAllString(args[i], `${1}`+Redacted))
+		case name != args[i]:
+			redacted = append(redacted, name+"="+Redacted)
+		default:
+			redacted = append(redacted, name)
+			if i+1 < len(args) && !strings.HasPrefix(args[i+1], "-") {
+				redacted = append(redacted, Redacted)
+				i++
+			}
+		}
+	}
+	return redacted
+}
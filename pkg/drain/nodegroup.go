@@ -4,20 +4,25 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // this is our custom addition, it's not part of the package
 // we copied from Kubernetes
 
+// retryDelay is the time to wait before retrying evictions
+// that have been refused, e.g. due to a PodDisruptionBudget
+const retryDelay = 5 * time.Second
+
 func evictPods(drainer *Helper, node *corev1.Node) (int, error) {
 	list, errs := drainer.GetPodsForDeletion(node.Name)
 	if len(errs) > 0 {
@@ -29,16 +34,20 @@ func evictPods(drainer *Helper, node *corev1.Node) (int, error) {
 	pods := list.Pods()
 	pending := len(pods)
 	for _, pod := range pods {
-		// TODO: handle API rate limitter error
 		if err := drainer.EvictOrDeletePod(pod); err != nil {
+			if apierrors.IsTooManyRequests(err) {
+				// eviction would violate a PodDisruptionBudget, so it
+				// has to be retried once pods elsewhere become ready
+				logger.Debug("eviction of pod %s/%s is blocked by a disruption budget, will retry", pod.Namespace, pod.Name)
+				continue
+			}
 			return pending, err
 		}
 	}
 	return pending, nil
 }
 
-// NodeGroup drains a nodegroup
-func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout time.Duration, undo bool) error {
+func newDrainer(clientSet kubernetes.Interface) (*Helper, error) {
 	drainer := &Helper{
 		Client: clientSet,
 
@@ -81,7 +90,85 @@ func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout ti
 	}
 
 	if err := drainer.CanUseEvictions(); err != nil {
-		return errors.Wrapf(err, "checking if cluster implements policy API")
+		return nil, errors.Wrapf(err, "checking if cluster implements policy API")
+	}
+	return drainer, nil
+}
+
+func cordonNode(clientSet kubernetes.Interface, node *corev1.Node, desired DesiredCordonStatus) {
+	c := NewCordonHelper(node, desired)
+	if c.IsUpdateRequired() {
+		err, patchErr := c.PatchOrReplace(clientSet)
+		if patchErr != nil {
+			logger.Warning(patchErr.Error())
+		}
+		if err != nil {
+			logger.Critical(err.Error())
+		}
+		logger.Info("%s node %q", desired, node.Name)
+	} else {
+		logger.Debug("no need to %s node %q", desired, node.Name)
+	}
+}
+
+// Nodes cordons the given nodes and evicts their pods
+func Nodes(clientSet kubernetes.Interface, nodes []corev1.Node, waitTimeout time.Duration) error {
+	drainer, err := newDrainer(clientSet)
+	if err != nil {
+		return err
+	}
+
+	for i := range nodes {
+		cordonNode(clientSet, &nodes[i], CordonNode)
+	}
+
+	pendingNodes := sets.NewString()
+	for _, node := range nodes {
+		pendingNodes.Insert(node.Name)
+	}
+
+	timer := time.After(waitTimeout)
+	for pendingNodes.Len() > 0 {
+		select {
+		case <-timer:
+			return fmt.Errorf("timed out (after %s) waiting for nodes %v to be drained", waitTimeout, pendingNodes.List())
+		default:
+			for i := range nodes {
+				node := &nodes[i]
+				if !pendingNodes.Has(node.Name) {
+					continue
+				}
+				pending, err := evictPods(drainer, node)
+				if err != nil {
+					return err
+				}
+				logger.Debug("%d pods to be evicted from %s", pending, node.Name)
+				if pending == 0 {
+					pendingNodes.Delete(node.Name)
+				}
+			}
+			if pendingNodes.Len() > 0 {
+				time.Sleep(retryDelay)
+			}
+		}
+	}
+	logger.Success("drained nodes: %v", nodeNames(nodes))
+	return nil
+}
+
+func nodeNames(nodes []corev1.Node) []string {
+	names := make([]string, len(nodes))
+	for i, node := range nodes {
+		names[i] = node.Name
+	}
+	return names
+}
+
+// NodeGroup drains a nodegroup
+func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout time.Duration, undo bool) error {
+	drainer, err := newDrainer(clientSet)
+	if err != nil {
+		return err
 	}
 
 	drainedNodes := sets.NewString()
@@ -115,19 +202,7 @@ func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout ti
 				if undo {
 					desired = UncordonNode
 				}
-				c := NewCordonHelper(&node, desired)
-				if c.IsUpdateRequired() {
-					err, patchErr := c.PatchOrReplace(clientSet)
-					if patchErr != nil {
-						logger.Warning(patchErr.Error())
-					}
-					if err != nil {
-						logger.Critical(err.Error())
-					}
-					logger.Info("%s node %q", desired, node.Name)
-				} else {
-					logger.Debug("no need to %s node %q", desired, node.Name)
-				}
+				cordonNode(clientSet, &node, desired)
 			}
 
 			if undo {
@@ -142,6 +217,7 @@ func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout ti
 			logger.Debug("already drained: %v", drainedNodes.List())
 			logger.Debug("will drain: %v", newPendingNodes.List())
 
+			drained := true
 			for _, node := range nodes.Items {
 				if newPendingNodes.Has(node.Name) {
 					pending, err := evictPods(drainer, &node)
@@ -151,9 +227,14 @@ func NodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, waitTimeout ti
 					logger.Debug("%d pods to be evicted from %s", pending, node.Name)
 					if pending == 0 {
 						drainedNodes.Insert(node.Name)
+					} else {
+						drained = false
 					}
 				}
 			}
+			if !drained {
+				time.Sleep(retryDelay)
+			}
 		}
 	}
 	if timeout {
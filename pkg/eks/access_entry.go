@@ -0,0 +1,262 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// clusterAdminPolicy is the access policy that grants the same permissions as the system:masters group
+const clusterAdminPolicy = "cluster-access-policy/AmazonEKSClusterAdminPolicy"
+
+// AuthenticationMode returns the authentication mode of the live cluster, clusters that
+// were created before access entries were introduced only use the aws-auth ConfigMap
+func (c *ClusterProvider) AuthenticationMode(cfg *api.ClusterConfig) (string, error) {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return "", err
+	}
+	if accessConfig := c.Status.clusterInfo.cluster.AccessConfig; accessConfig != nil && accessConfig.AuthenticationMode != nil {
+		return *accessConfig.AuthenticationMode, nil
+	}
+	return api.AuthenticationModeConfigMap, nil
+}
+
+// UpdateAuthenticationMode switches the cluster to the authentication mode of the config,
+// one step at a time, as EKS only allows going from CONFIG_MAP to API_AND_CONFIG_MAP to API
+func (c *ClusterProvider) UpdateAuthenticationMode(cfg *api.ClusterConfig) error {
+	current, err := c.AuthenticationMode(cfg)
+	if err != nil {
+		return err
+	}
+	modes := api.SupportedAuthenticationModes()
+	from, to := indexOf(current, modes), indexOf(cfg.AccessConfig.AuthenticationMode, modes)
+	if to < from {
+		return fmt.Errorf("cannot change authentication mode of cluster %q from %s to %s, EKS only allows switching from %s", cfg.Metadata.Name, current, cfg.AccessConfig.AuthenticationMode, strings.Join(modes, " to "))
+	}
+
+	for _, mode := range modes[from+1 : to+1] {
+		input := &awseks.UpdateClusterConfigInput{
+			Name: &cfg.Metadata.Name,
+			AccessConfig: &awseks.UpdateAccessConfigRequest{
+				AuthenticationMode: aws.String(mode),
+			},
+		}
+		output, err := c.Provider.EKS().UpdateClusterConfig(input)
+		if err != nil {
+			return errors.Wrapf(err, "setting authentication mode of cluster %q to %s", cfg.Metadata.Name, mode)
+		}
+		if err := c.waitForUpdateToSucceed(cfg.Metadata.Name, output.Update); err != nil {
+			return err
+		}
+		logger.Success("set authentication mode of cluster %q to %s", cfg.Metadata.Name, mode)
+	}
+	return nil
+}
+
+// ListAccessEntries returns the principal ARNs of all access entries of the cluster
+func (c *ClusterProvider) ListAccessEntries(cfg *api.ClusterConfig) ([]string, error) {
+	principals := []string{}
+	input := &awseks.ListAccessEntriesInput{
+		ClusterName: &cfg.Metadata.Name,
+	}
+	err := c.Provider.EKS().ListAccessEntriesPages(input, func(output *awseks.ListAccessEntriesOutput, _ bool) bool {
+		principals = append(principals, aws.StringValueSlice(output.AccessEntries)...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing access entries of cluster %q", cfg.Metadata.Name)
+	}
+	return principals, nil
+}
+
+// DescribeAccessEntry returns an access entry along with its access policies
+func (c *ClusterProvider) DescribeAccessEntry(cfg *api.ClusterConfig, principalARN string) (*api.AccessEntry, error) {
+	output, err := c.Provider.EKS().DescribeAccessEntry(&awseks.DescribeAccessEntryInput{
+		ClusterName:  &cfg.Metadata.Name,
+		PrincipalArn: &principalARN,
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing access entry %q", principalARN)
+	}
+	entry := &api.AccessEntry{
+		PrincipalARN:       principalARN,
+		Type:               aws.StringValue(output.AccessEntry.Type),
+		KubernetesUsername: aws.StringValue(output.AccessEntry.Username),
+		KubernetesGroups:   aws.StringValueSlice(output.AccessEntry.KubernetesGroups),
+	}
+
+	input := &awseks.ListAssociatedAccessPoliciesInput{
+		ClusterName:  &cfg.Metadata.Name,
+		PrincipalArn: &principalARN,
+	}
+	err = c.Provider.EKS().ListAssociatedAccessPoliciesPages(input, func(output *awseks.ListAssociatedAccessPoliciesOutput, _ bool) bool {
+		for _, policy := range output.AssociatedAccessPolicies {
+			entry.AccessPolicies = append(entry.AccessPolicies, api.AccessPolicy{
+				PolicyARN: aws.StringValue(policy.PolicyArn),
+				AccessScope: api.AccessScope{
+					Type:       aws.StringValue(policy.AccessScope.Type),
+					Namespaces: aws.StringValueSlice(policy.AccessScope.Namespaces),
+				},
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing access policies of %q", principalARN)
+	}
+	return entry, nil
+}
+
+// CreateAccessEntry creates an access entry and associates its access policies
+func (c *ClusterProvider) CreateAccessEntry(cfg *api.ClusterConfig, entry *api.AccessEntry) error {
+	input := &awseks.CreateAccessEntryInput{
+		ClusterName:  &cfg.Metadata.Name,
+		PrincipalArn: &entry.PrincipalARN,
+	}
+	if entry.Type != "" {
+		input.Type = &entry.Type
+	}
+	if entry.KubernetesUsername != "" {
+		input.Username = &entry.KubernetesUsername
+	}
+	if len(entry.KubernetesGroups) > 0 {
+		input.KubernetesGroups = aws.StringSlice(entry.KubernetesGroups)
+	}
+	if _, err := c.Provider.EKS().CreateAccessEntry(input); err != nil {
+		return errors.Wrapf(err, "creating access entry %q", entry.PrincipalARN)
+	}
+
+	for _, policy := range entry.AccessPolicies {
+		scope := &awseks.AccessScope{
+			Type: aws.String(policy.AccessScope.Type),
+		}
+		if len(policy.AccessScope.Namespaces) > 0 {
+			scope.Namespaces = aws.StringSlice(policy.AccessScope.Namespaces)
+		}
+		_, err := c.Provider.EKS().AssociateAccessPolicy(&awseks.AssociateAccessPolicyInput{
+			ClusterName:  &cfg.Metadata.Name,
+			PrincipalArn: &entry.PrincipalARN,
+			PolicyArn:    &policy.PolicyARN,
+			AccessScope:  scope,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "associating access policy %q with %q", policy.PolicyARN, entry.PrincipalARN)
+		}
+	}
+	logger.Info("created access entry for %q", entry.PrincipalARN)
+	return nil
+}
+
+// CreateAccessEntries creates the access entries of the config that don't exist yet,
+// EKS creates one for the IAM principal that created the cluster, along with the ones
+// for managed nodegroups and Fargate profiles
+func (c *ClusterProvider) CreateAccessEntries(cfg *api.ClusterConfig) error {
+	existing, err := c.ListAccessEntries(cfg)
+	if err != nil {
+		return err
+	}
+	for _, entry := range MissingAccessEntries(existing, cfg.AccessEntries) {
+		if err := c.CreateAccessEntry(cfg, entry); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MissingAccessEntries returns the entries of principals that don't have an access entry
+func MissingAccessEntries(existing []string, entries []*api.AccessEntry) []*api.AccessEntry {
+	principals := sets.NewString(existing...)
+	missing := []*api.AccessEntry{}
+	for _, entry := range entries {
+		if !principals.Has(entry.PrincipalARN) {
+			missing = append(missing, entry)
+		}
+	}
+	return missing
+}
+
+// DeleteAccessEntry deletes the access entry of an IAM principal, along with its access policies
+func (c *ClusterProvider) DeleteAccessEntry(cfg *api.ClusterConfig, principalARN string) error {
+	_, err := c.Provider.EKS().DeleteAccessEntry(&awseks.DeleteAccessEntryInput{
+		ClusterName:  &cfg.Metadata.Name,
+		PrincipalArn: &principalARN,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "deleting access entry %q", principalARN)
+	}
+	logger.Info("deleted access entry for %q", principalARN)
+	return nil
+}
+
+// AccessEntriesFromAuthConfigMap converts the mappings of the aws-auth ConfigMap to access entries;
+// roles of nodegroups and Fargate get access entries of the matching type, and system:masters is
+// replaced by the cluster admin access policy, as groups starting with "system:" are reserved;
+// mappings that cannot be converted are returned as warnings
+func AccessEntriesFromAuthConfigMap(identities []iam.Identity) ([]*api.AccessEntry, []string) {
+	entries := []*api.AccessEntry{}
+	warnings := []string{}
+	// aws-iam-authenticator only considers the last mapping of an ARN
+	seen := map[string]int{}
+
+	for _, identity := range identities {
+		groups := sets.NewString(identity.Groups()...)
+		entry := &api.AccessEntry{PrincipalARN: identity.ARN(), Type: "STANDARD"}
+
+		switch {
+		case groups.Has("system:node-proxier"):
+			entry.Type = "FARGATE_LINUX"
+		case groups.Has("system:nodes") && groups.Has("system:bootstrappers"):
+			entry.Type = "EC2_LINUX"
+			if groups.Has("eks:kube-proxy-windows") {
+				entry.Type = "EC2_WINDOWS"
+			}
+		default:
+			entry.KubernetesUsername = identity.Username()
+			unsupported := []string{}
+			for _, group := range groups.List() {
+				switch {
+				case group == authconfigmap.GroupMasters:
+					partition := strings.Split(identity.ARN(), ":")[1]
+					entry.AccessPolicies = append(entry.AccessPolicies, api.AccessPolicy{
+						PolicyARN:   fmt.Sprintf("arn:%s:eks::aws:%s", partition, clusterAdminPolicy),
+						AccessScope: api.AccessScope{Type: api.AccessScopeCluster},
+					})
+				case strings.HasPrefix(group, "system:"):
+					unsupported = append(unsupported, group)
+				default:
+					entry.KubernetesGroups = append(entry.KubernetesGroups, group)
+				}
+			}
+			if len(unsupported) > 0 {
+				warnings = append(warnings, fmt.Sprintf("%q is mapped to groups %v that cannot be used in access entries, it needs to be migrated manually", identity.ARN(), unsupported))
+				continue
+			}
+		}
+
+		if i, ok := seen[entry.PrincipalARN]; ok {
+			entries[i] = entry
+			continue
+		}
+		seen[entry.PrincipalARN] = len(entries)
+		entries = append(entries, entry)
+	}
+	return entries, warnings
+}
+
+func indexOf(value string, values []string) int {
+	for i, v := range values {
+		if v == value {
+			return i
+		}
+	}
+	return -1
+}
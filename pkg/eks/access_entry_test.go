@@ -0,0 +1,142 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("access entries", func() {
+	newIdentity := func(arn, username string, groups ...string) iam.Identity {
+		identity, err := iam.NewIdentity(arn, username, groups)
+		Expect(err).NotTo(HaveOccurred())
+		return identity
+	}
+
+	It("converts the mappings of the aws-auth ConfigMap", func() {
+		entries, warnings := AccessEntriesFromAuthConfigMap([]iam.Identity{
+			newIdentity("arn:aws:iam::123456789012:role/ng-1", "system:node:{{EC2PrivateDNSName}}", "system:bootstrappers", "system:nodes"),
+			newIdentity("arn:aws:iam::123456789012:role/ng-win", "system:node:{{EC2PrivateDNSName}}", "system:bootstrappers", "system:nodes", "eks:kube-proxy-windows"),
+			newIdentity("arn:aws:iam::123456789012:role/fargate", "system:node:{{SessionName}}", "system:bootstrappers", "system:nodes", "system:node-proxier"),
+			newIdentity("arn:aws-cn:iam::123456789012:user/alice", "alice", "system:masters", "devs"),
+			newIdentity("arn:aws:iam::123456789012:user/bob", "bob", "viewers"),
+			newIdentity("arn:aws:iam::123456789012:user/bob", "bob", "editors"),
+			newIdentity("arn:aws:iam::123456789012:role/monitoring", "monitoring", "system:monitoring"),
+		})
+
+		Expect(warnings).To(HaveLen(1))
+		Expect(warnings[0]).To(ContainSubstring("role/monitoring"))
+
+		Expect(entries).To(Equal([]*api.AccessEntry{
+			{PrincipalARN: "arn:aws:iam::123456789012:role/ng-1", Type: "EC2_LINUX"},
+			{PrincipalARN: "arn:aws:iam::123456789012:role/ng-win", Type: "EC2_WINDOWS"},
+			{PrincipalARN: "arn:aws:iam::123456789012:role/fargate", Type: "FARGATE_LINUX"},
+			{
+				PrincipalARN:       "arn:aws-cn:iam::123456789012:user/alice",
+				Type:               "STANDARD",
+				KubernetesUsername: "alice",
+				KubernetesGroups:   []string{"devs"},
+				AccessPolicies: []api.AccessPolicy{{
+					PolicyARN:   "arn:aws-cn:eks::aws:cluster-access-policy/AmazonEKSClusterAdminPolicy",
+					AccessScope: api.AccessScope{Type: api.AccessScopeCluster},
+				}},
+			},
+			{
+				PrincipalARN:       "arn:aws:iam::123456789012:user/bob",
+				Type:               "STANDARD",
+				KubernetesUsername: "bob",
+				KubernetesGroups:   []string{"editors"},
+			},
+		}))
+	})
+
+	It("finds the entries that are missing", func() {
+		entries := []*api.AccessEntry{{PrincipalARN: "arn:a"}, {PrincipalARN: "arn:b"}}
+		Expect(MissingAccessEntries([]string{"arn:a", "arn:c"}, entries)).To(Equal(entries[1:]))
+	})
+
+	Context("with a cluster", func() {
+		var (
+			p   *mockprovider.MockProvider
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{Provider: p, Status: &ProviderStatus{}}
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			cluster := testutils.NewFakeCluster("test-cluster", awseks.ClusterStatusActive)
+			cluster.AccessConfig = &awseks.AccessConfigResponse{AuthenticationMode: aws.String(api.AuthenticationModeAPI)}
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+		})
+
+		It("doesn't switch back to a previous authentication mode", func() {
+			mode, err := ctl.AuthenticationMode(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(mode).To(Equal(api.AuthenticationModeAPI))
+
+			cfg.AccessConfig = &api.AccessConfig{AuthenticationMode: api.AuthenticationModeAPI}
+			Expect(ctl.UpdateAuthenticationMode(cfg)).To(Succeed())
+
+			cfg.AccessConfig.AuthenticationMode = api.AuthenticationModeAPIAndConfigMap
+			Expect(ctl.UpdateAuthenticationMode(cfg)).To(MatchError(ContainSubstring("cannot change authentication mode")))
+			p.MockEKS().AssertNotCalled(GinkgoT(), "UpdateClusterConfig", mock.Anything)
+		})
+
+		It("creates access entries along with their policies", func() {
+			p.MockEKS().On("ListAccessEntriesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *awseks.ListAccessEntriesOutput, last bool) bool)
+				consume(&awseks.ListAccessEntriesOutput{AccessEntries: aws.StringSlice([]string{"arn:aws:iam::123456789012:role/creator"})}, true)
+			}).Return(nil)
+			p.MockEKS().On("CreateAccessEntry", mock.Anything).Return(&awseks.CreateAccessEntryOutput{}, nil)
+			p.MockEKS().On("AssociateAccessPolicy", mock.Anything).Return(&awseks.AssociateAccessPolicyOutput{}, nil)
+
+			cfg.AccessEntries = []*api.AccessEntry{
+				{PrincipalARN: "arn:aws:iam::123456789012:role/creator"},
+				{
+					PrincipalARN:     "arn:aws:iam::123456789012:role/dev",
+					KubernetesGroups: []string{"devs"},
+					AccessPolicies: []api.AccessPolicy{{
+						PolicyARN:   "arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy",
+						AccessScope: api.AccessScope{Type: api.AccessScopeNamespace, Namespaces: []string{"dev"}},
+					}},
+				},
+			}
+			Expect(ctl.CreateAccessEntries(cfg)).To(Succeed())
+
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "CreateAccessEntry", 1)
+			var (
+				createInput    *awseks.CreateAccessEntryInput
+				associateInput *awseks.AssociateAccessPolicyInput
+			)
+			for _, call := range p.MockEKS().Calls {
+				switch input := call.Arguments[0].(type) {
+				case *awseks.CreateAccessEntryInput:
+					createInput = input
+				case *awseks.AssociateAccessPolicyInput:
+					associateInput = input
+				}
+			}
+			Expect(createInput).NotTo(BeNil())
+			Expect(*createInput.PrincipalArn).To(Equal("arn:aws:iam::123456789012:role/dev"))
+			Expect(aws.StringValueSlice(createInput.KubernetesGroups)).To(Equal([]string{"devs"}))
+
+			Expect(associateInput).NotTo(BeNil())
+			Expect(*associateInput.PolicyArn).To(Equal("arn:aws:eks::aws:cluster-access-policy/AmazonEKSEditPolicy"))
+			Expect(*associateInput.AccessScope.Type).To(Equal("namespace"))
+			Expect(aws.StringValueSlice(associateInput.AccessScope.Namespaces)).To(Equal([]string{"dev"}))
+		})
+	})
+})
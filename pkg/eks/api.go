@@ -1,21 +1,30 @@
 package eks
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"regexp"
+	"strings"
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/credentials"
 	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/request"
 	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	"github.com/aws/aws-sdk-go/service/ec2"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
@@ -24,11 +33,20 @@ import (
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/pricing"
+	"github.com/aws/aws-sdk-go/service/pricing/pricingiface"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -38,16 +56,55 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/az"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	eksctlcredentials "github.com/weaveworks/eksctl/pkg/credentials"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/metrics"
 	"github.com/weaveworks/eksctl/pkg/utils"
+	"github.com/weaveworks/eksctl/pkg/utils/httpclient"
 	"github.com/weaveworks/eksctl/pkg/version"
 )
 
+// pricingRegion is the region of the Price List API endpoint that is used for all regions
+const pricingRegion = "us-east-1"
+
 // ClusterProvider stores information about the cluster
 type ClusterProvider struct {
 	// core fields used for config and AWS APIs
 	Provider api.ClusterProvider
 	// informative fields, i.e. used as outputs
 	Status *ProviderStatus
+
+	ctx context.Context
+	// stackCache is shared by all stack managers of the provider, it's nil when caching is disabled
+	stackCache *manager.StackCache
+	// stackPoller batches the polls of the stacks the stack managers of the provider wait for
+	stackPoller *manager.StackPoller
+	// amiResolver is the resolver of the AMIs of nodegroups whose AMI is static or auto, see WithAMIResolver
+	amiResolver string
+}
+
+// WithContext returns a copy of the cluster provider whose waits, including those of the
+// stack managers it creates, stop once ctx is done
+func (c *ClusterProvider) WithContext(ctx context.Context) *ClusterProvider {
+	copy := *c
+	copy.ctx = ctx
+	return &copy
+}
+
+// WithAMIResolver returns a copy of the cluster provider that resolves the AMIs of all nodegroups whose AMI
+// is static or auto with the given resolver, the static resolver then never falls back to the auto resolver
+func (c *ClusterProvider) WithAMIResolver(resolver string) *ClusterProvider {
+	copy := *c
+	copy.amiResolver = resolver
+	return &copy
+}
+
+// Context returns the context of the cluster provider, which is never done unless one was set
+func (c *ClusterProvider) Context() context.Context {
+	if c.ctx == nil {
+		return context.Background()
+	}
+	return c.ctx
 }
 
 // ProviderServices stores the used APIs
@@ -60,8 +117,17 @@ type ProviderServices struct {
 	elbv2 elbv2iface.ELBV2API
 	sts   stsiface.STSAPI
 	iam   iamiface.IAMAPI
+	asg   autoscalingiface.AutoScalingAPI
 
 	cloudtrail cloudtrailiface.CloudTrailAPI
+	ssm        ssmiface.SSMAPI
+
+	servicequotas servicequotasiface.ServiceQuotasAPI
+	pricing       pricingiface.PricingAPI
+	s3            s3iface.S3API
+
+	cloudwatchlogs cloudwatchlogsiface.CloudWatchLogsAPI
+	eventbridge    eventbridgeiface.EventBridgeAPI
 }
 
 // CloudFormation returns a representation of the CloudFormation API
@@ -82,6 +148,9 @@ func (p ProviderServices) ELB() elbiface.ELBAPI { return p.elb }
 // ELBV2 returns a representation of the ELBV2 API
 func (p ProviderServices) ELBV2() elbv2iface.ELBV2API { return p.elbv2 }
 
+// ASG returns a representation of the AutoScaling API
+func (p ProviderServices) ASG() autoscalingiface.AutoScalingAPI { return p.asg }
+
 // STS returns a representation of the STS API
 func (p ProviderServices) STS() stsiface.STSAPI { return p.sts }
 
@@ -91,6 +160,26 @@ func (p ProviderServices) IAM() iamiface.IAMAPI { return p.iam }
 // CloudTrail returns a representation of the CloudTrail API
 func (p ProviderServices) CloudTrail() cloudtrailiface.CloudTrailAPI { return p.cloudtrail }
 
+// SSM returns a representation of the SSM API
+func (p ProviderServices) SSM() ssmiface.SSMAPI { return p.ssm }
+
+// ServiceQuotas returns a representation of the Service Quotas API
+func (p ProviderServices) ServiceQuotas() servicequotasiface.ServiceQuotasAPI { return p.servicequotas }
+
+// Pricing returns a representation of the Price List API
+func (p ProviderServices) Pricing() pricingiface.PricingAPI { return p.pricing }
+
+// S3 returns a representation of the S3 API
+func (p ProviderServices) S3() s3iface.S3API { return p.s3 }
+
+// CloudWatchLogs returns a representation of the CloudWatch Logs API
+func (p ProviderServices) CloudWatchLogs() cloudwatchlogsiface.CloudWatchLogsAPI {
+	return p.cloudwatchlogs
+}
+
+// EventBridge returns a representation of the EventBridge API
+func (p ProviderServices) EventBridge() eventbridgeiface.EventBridgeAPI { return p.eventbridge }
+
 // Region returns provider-level region setting
 func (p ProviderServices) Region() string { return p.spec.Region }
 
@@ -115,80 +204,111 @@ func New(spec *api.ProviderConfig, clusterSpec *api.ClusterConfig) *ClusterProvi
 	c := &ClusterProvider{
 		Provider: provider,
 	}
+	if !spec.NoCache {
+		c.stackCache = manager.NewStackCache()
+	}
+	c.stackPoller = manager.NewStackPoller(provider)
 	// Create a new session and save credentials for possible
 	// later re-use if overriding sessions due to custom URL
 	s := c.newSession(spec)
 
-	provider.cfn = cloudformation.New(s)
-	provider.eks = awseks.New(s)
-	provider.ec2 = ec2.New(s)
-	provider.elb = elb.New(s)
-	provider.elbv2 = elbv2.New(s)
+	provider.cfn = cloudformation.New(s, endpointConfig(s, spec, "cloudformation"))
+	provider.eks = awseks.New(s, endpointConfig(s, spec, "eks"))
+	provider.ec2 = ec2.New(s, endpointConfig(s, spec, "ec2"))
+	provider.elb = elb.New(s, endpointConfig(s, spec, "elb"))
+	provider.elbv2 = elbv2.New(s, endpointConfig(s, spec, "elbv2"))
 	provider.sts = sts.New(s,
 		// STS retrier has to be disabled, as it's not very helpful
 		// (see https://github.com/weaveworks/eksctl/issues/705)
-		request.WithRetryer(s.Config.Copy(),
+		request.WithRetryer(endpointConfig(s, spec, "sts"),
 			&client.DefaultRetryer{
 				NumMaxRetries: 1,
 			},
 		),
 	)
-	provider.iam = iam.New(s)
-	provider.cloudtrail = cloudtrail.New(s)
+	provider.iam = iam.New(s, endpointConfig(s, spec, "iam"))
+	provider.asg = autoscaling.New(s, endpointConfig(s, spec, "autoscaling"))
+	provider.cloudtrail = cloudtrail.New(s, endpointConfig(s, spec, "cloudtrail"))
+	provider.ssm = ssm.New(s, endpointConfig(s, spec, "ssm"))
+	provider.servicequotas = servicequotas.New(s, endpointConfig(s, spec, "servicequotas"))
+	// the Price List API is only served in a few regions, prices of all regions are available in us-east-1
+	provider.pricing = pricing.New(s, endpointConfig(s, spec, "pricing").WithRegion(pricingRegion))
+	s3Config := endpointConfig(s, spec, "s3")
+	if s3Config.Endpoint != nil {
+		s3Config = s3Config.WithS3ForcePathStyle(true)
+	}
+	provider.s3 = s3.New(s, s3Config)
+	provider.cloudwatchlogs = cloudwatchlogs.New(s, endpointConfig(s, spec, "cloudwatchlogs"))
+	provider.eventbridge = eventbridge.New(s, endpointConfig(s, spec, "eventbridge"))
 
 	c.Status = &ProviderStatus{
 		sessionCreds: s.Config.Credentials,
 	}
 
-	// override sessions if any custom endpoints specified
-	if endpoint, ok := os.LookupEnv("AWS_CLOUDFORMATION_ENDPOINT"); ok {
-		logger.Debug("Setting CloudFormation endpoint to %s", endpoint)
-		provider.cfn = cloudformation.New(s, s.Config.Copy().WithEndpoint(endpoint))
-	}
-	if endpoint, ok := os.LookupEnv("AWS_EKS_ENDPOINT"); ok {
-		logger.Debug("Setting EKS endpoint to %s", endpoint)
-		provider.eks = awseks.New(s, s.Config.Copy().WithEndpoint(endpoint))
+	if clusterSpec != nil {
+		clusterSpec.Metadata.Region = c.Provider.Region()
 	}
-	if endpoint, ok := os.LookupEnv("AWS_EC2_ENDPOINT"); ok {
-		logger.Debug("Setting EC2 endpoint to %s", endpoint)
-		provider.ec2 = ec2.New(s, s.Config.Copy().WithEndpoint(endpoint))
 
-	}
-	if endpoint, ok := os.LookupEnv("AWS_ELB_ENDPOINT"); ok {
-		logger.Debug("Setting ELB endpoint to %s", endpoint)
-		provider.elb = elb.New(s, s.Config.Copy().WithEndpoint(endpoint))
+	return c
+}
 
-	}
-	if endpoint, ok := os.LookupEnv("AWS_ELBV2_ENDPOINT"); ok {
-		logger.Debug("Setting ELBV2 endpoint to %s", endpoint)
-		provider.elbv2 = elbv2.New(s, s.Config.Copy().WithEndpoint(endpoint))
+// LoadConfigFromFile loads ClusterConfig from configFile, placeholders are resolved strictly
+func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
+	return LoadInterpolatedConfigFromFile(configFile, &ConfigInterpolation{})
+}
 
+// LoadInterpolatedConfigFromFile loads ClusterConfig from configFile, placeholders are resolved
+// as set by the given interpolation
+func LoadInterpolatedConfigFromFile(configFile string, interpolation *ConfigInterpolation) (*api.ClusterConfig, error) {
+	documents, err := ReadConfigDocuments(configFile)
+	if err != nil {
+		return nil, err
 	}
-	if endpoint, ok := os.LookupEnv("AWS_STS_ENDPOINT"); ok {
-		logger.Debug("Setting STS endpoint to %s", endpoint)
-		provider.sts = sts.New(s, s.Config.Copy().WithEndpoint(endpoint))
+	if len(documents) > 1 {
+		return nil, fmt.Errorf("config file %q defines %d clusters, but this command only works with one cluster", configFile, len(documents))
 	}
-	if endpoint, ok := os.LookupEnv("AWS_IAM_ENDPOINT"); ok {
-		logger.Debug("Setting IAM endpoint to %s", endpoint)
-		provider.iam = iam.New(s, s.Config.Copy().WithEndpoint(endpoint))
+	data := []byte{}
+	if len(documents) == 1 {
+		data = documents[0]
 	}
-	if endpoint, ok := os.LookupEnv("AWS_CLOUDTRAIL_ENDPOINT"); ok {
-		logger.Debug("Setting CloudTrail endpoint to %s", endpoint)
-		provider.cloudtrail = cloudtrail.New(s, s.Config.Copy().WithEndpoint(endpoint))
+	return LoadConfigFromDocument(configFile, data, interpolation)
+}
+
+// ReadConfigDocuments reads configFile and splits it into YAML documents, each of which
+// defines a cluster
+func ReadConfigDocuments(configFile string) ([][]byte, error) {
+	data, err := readConfig(configFile)
+	if err != nil {
+		return nil, errors.Wrapf(err, "reading config file %q", configFile)
 	}
 
-	if clusterSpec != nil {
-		clusterSpec.Metadata.Region = c.Provider.Region()
+	documents := [][]byte{}
+	for _, document := range documentSeparator.Split(string(data), -1) {
+		if !isEmptyDocument(document) {
+			documents = append(documents, []byte(document))
+		}
 	}
+	return documents, nil
+}
 
-	return c
+// isEmptyDocument checks if a YAML document has nothing but comments,
+// e.g. before the first separator
+func isEmptyDocument(document string) bool {
+	for _, line := range strings.Split(document, "\n") {
+		if line = strings.TrimSpace(line); line != "" && !strings.HasPrefix(line, "#") {
+			return false
+		}
+	}
+	return true
 }
 
-// LoadConfigFromFile loads ClusterConfig from configFile
-func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
-	data, err := readConfig(configFile)
+var documentSeparator = regexp.MustCompile(`(?m)^---[ \t]*$`)
+
+// LoadConfigFromDocument loads ClusterConfig from one document of configFile
+func LoadConfigFromDocument(configFile string, data []byte, interpolation *ConfigInterpolation) (*api.ClusterConfig, error) {
+	data, err := interpolation.Interpolate(data)
 	if err != nil {
-		return nil, errors.Wrapf(err, "reading config file %q", configFile)
+		return nil, errors.Wrapf(err, "loading config file %q", configFile)
 	}
 
 	// strict mode is not available in runtime.Decode, so we use the parser
@@ -209,6 +329,7 @@ func LoadConfigFromFile(configFile string) (*api.ClusterConfig, error) {
 	if !ok {
 		return nil, fmt.Errorf("expected to decode object of type %T; got %T", &api.ClusterConfig{}, cfg)
 	}
+	cfg.SecretValues = interpolation.SecretValues
 	return cfg, nil
 }
 
@@ -258,24 +379,62 @@ func (c *ClusterProvider) CheckAuth() error {
 	return nil
 }
 
+// AccountID returns the ID of the account of the current session, as found by CheckAuth
+func (c *ClusterProvider) AccountID() string {
+	parts := strings.Split(c.Status.iamRoleARN, ":")
+	if len(parts) < 5 {
+		return ""
+	}
+	return parts[4]
+}
+
 // EnsureAMI ensures that the node AMI is set and is available
 func (c *ClusterProvider) EnsureAMI(version string, ng *api.NodeGroup) error {
-	if ng.AMI == ami.ResolverAuto {
+	if api.HasMixedInstances(ng) && utils.HasARMInstanceType(ng.InstancesDistribution.InstanceTypes) {
+		for _, instanceType := range ng.InstancesDistribution.InstanceTypes {
+			if !utils.IsARMInstanceType(instanceType) {
+				return fmt.Errorf("cannot mix arm64 and x86_64 instance types in nodegroup %q, as they require different AMIs", ng.Name)
+			}
+		}
+	}
+
+	custom := ng.AMI != ami.ResolverStatic && ng.AMI != ami.ResolverAuto
+	if c.amiResolver != "" && !custom {
+		ng.AMI = c.amiResolver
+	}
+	region, instanceType := c.Provider.Region(), selectInstanceType(ng)
+	if ng.AMI == ami.ResolverStatic && !ami.HasStaticImage(region, version, instanceType, ng.AMIFamily) {
+		if c.amiResolver == ami.ResolverStatic {
+			// the static resolver is used offline, so it never falls back to the auto resolver
+			return fmt.Errorf("%s, there is no static AMI for it; add one to the AMI manifest of --ami-manifest", ami.NewErrFailedResolution(region, version, instanceType, ng.AMIFamily))
+		}
+		if api.IsWindowsImage(ng.AMIFamily) || utils.IsARMInstanceType(instanceType) || api.Partition(region) != api.PartitionAWS {
+			// there are no static Windows or arm64 AMIs compiled into eksctl, nor any of other partitions
+			logger.Debug("using auto resolver for nodegroup %q with image family %s in region %s", ng.Name, ng.AMIFamily, region)
+			ng.AMI = ami.ResolverAuto
+		}
+	}
+	switch ng.AMI {
+	case ami.ResolverAuto:
 		ami.DefaultResolvers = []ami.Resolver{ami.NewAutoResolver(c.Provider.EC2())}
+	case ami.ResolverStatic:
+		ami.DefaultResolvers = []ami.Resolver{&ami.StaticGPUResolver{}, &ami.StaticDefaultResolver{}}
 	}
 	if ng.AMI == ami.ResolverStatic || ng.AMI == ami.ResolverAuto {
-		instanceType := selectInstanceType(ng)
-		id, err := ami.Resolve(c.Provider.Region(), version, instanceType, ng.AMIFamily)
+		id, err := ami.Resolve(region, version, instanceType, ng.AMIFamily)
 		if err != nil {
 			return errors.Wrap(err, "unable to determine AMI to use")
 		}
 		if id == "" {
-			return ami.NewErrFailedResolution(c.Provider.Region(), version, instanceType, ng.AMIFamily)
+			return ami.NewErrFailedResolution(region, version, instanceType, ng.AMIFamily)
 		}
 		ng.AMI = id
 	}
 
 	// Check the AMI is available and populate RootDevice information
+	if custom {
+		return ami.UseCustom(c.Provider.EC2(), ng)
+	}
 	return ami.Use(c.Provider.EC2(), ng)
 
 }
@@ -355,7 +514,16 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 	}
 
 	config = config.WithCredentialsChainVerboseErrors(true)
-	config = request.WithRetryer(config, newLoggingRetryer())
+	// go through the proxy of HTTPS_PROXY like all other clients
+	config = config.WithHTTPClient(httpclient.NewClient(0))
+	if spec.STSRegionalEndpoint {
+		config = config.WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint)
+	}
+	maxRetries := api.DefaultMaxRetries
+	if spec.MaxRetries != nil {
+		maxRetries = *spec.MaxRetries
+	}
+	config = request.WithRetryer(config, newLoggingRetryer(maxRetries))
 	if logger.Level >= api.AWSDebugLevel {
 		config = config.WithLogLevel(aws.LogDebug |
 			aws.LogDebugWithHTTPBody |
@@ -374,6 +542,10 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		Profile:                 spec.Profile,
 		AssumeRoleTokenProvider: stscreds.StdinTokenProvider,
 	}
+	if bundle := httpclient.CABundle(); bundle != nil {
+		// takes precedence over AWS_CA_BUNDLE, the SDK then only trusts the CAs of the bundle, like the AWS CLI
+		opts.CustomCABundle = bytes.NewReader(bundle)
+	}
 
 	stscreds.DefaultDuration = 30 * time.Minute
 
@@ -384,6 +556,23 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		Fn: request.MakeAddToUserAgentHandler(
 			"eksctl", version.String()),
 	})
+	s.Handlers.AfterRetry.PushFrontNamed(request.NamedHandler{
+		Name: "eksctlThrottleMetrics",
+		Fn: func(r *request.Request) {
+			if r.Error != nil && request.IsErrorThrottle(r.Error) {
+				metrics.AWSThrottle(r.ClientInfo.ServiceName, operationName(r))
+			}
+		},
+	})
+	if spec.RetryMode == api.RetryModeAdaptive {
+		newAdaptiveRateLimiter().addHandlers(&s.Handlers)
+	}
+	s.Handlers.Complete.PushBackNamed(request.NamedHandler{
+		Name: "eksctlCallMetrics",
+		Fn: func(r *request.Request) {
+			metrics.AWSCall(r.ClientInfo.ServiceName, operationName(r))
+		},
+	})
 
 	if spec.Region == "" {
 		if api.IsSetAndNonEmptyString(s.Config.Region) {
@@ -397,10 +586,14 @@ func (c *ClusterProvider) newSession(spec *api.ProviderConfig) *session.Session
 		}
 	}
 
+	s = assumeRoles(s, spec)
+	if eksctlcredentials.IsCacheEnabled() {
+		s = cacheCredentials(s, spec)
+	}
 	return s
 }
 
 // NewStackManager returns a new stack manager
 func (c *ClusterProvider) NewStackManager(spec *api.ClusterConfig) *manager.StackCollection {
-	return manager.NewStackCollection(c.Provider, spec)
+	return manager.NewStackCollection(c.Provider, spec).WithContext(c.Context()).WithCache(c.stackCache).WithPoller(c.stackPoller)
 }
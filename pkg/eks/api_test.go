@@ -149,6 +149,47 @@ var _ = Describe("eksctl API", func() {
 			Expect(err).ToNot(HaveOccurred())
 			Expect(ng.AMI).To(Equal("ami-0c9156d7fcd3c2948"))
 		})
+		It("should resolve auto AMIs statically with the static AMI resolver", func() {
+			ng.AMI = "auto"
+			ng.InstanceType = "m5.xlarge"
+
+			err := ctl.WithAMIResolver("static").EnsureAMI("1.12", ng)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ng.AMI).To(Equal("ami-0b520e822d42998c1"))
+		})
+		It("should not fall back to the auto resolver with the static AMI resolver", func() {
+			ng.AMI = "static"
+			ng.AMIFamily = api.NodeImageFamilyWindowsServer2019FullContainer
+			ng.InstanceType = "m5.xlarge"
+
+			err := ctl.WithAMIResolver("static").EnsureAMI("1.12", ng)
+
+			Expect(err).To(MatchError(ContainSubstring("there is no static AMI for it; add one to the AMI manifest of --ami-manifest")))
+			Expect(ng.AMI).To(Equal("static"))
+		})
+		It("should use the AMIs of the manifest with the static AMI resolver", func() {
+			Expect(ami.LoadStaticManifest("../ami/testdata/manifest.yaml")).To(Succeed())
+			defer func() {
+				Expect(ami.LoadStaticManifest("")).To(Succeed())
+			}()
+			ng.AMI = "static"
+			ng.InstanceType = "a1.large"
+
+			err := ctl.WithAMIResolver("static").EnsureAMI("1.12", ng)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ng.AMI).To(Equal("ami-0a1b2c3d4e5f60718"))
+		})
+		It("should resolve static AMIs from EC2 with the auto AMI resolver", func() {
+			ng.AMI = "static"
+			ng.InstanceType = "m5.xlarge"
+
+			err := ctl.WithAMIResolver("auto").EnsureAMI("1.12", ng)
+
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ng.AMI).To(Equal("abc123"))
+		})
 	})
 })
 
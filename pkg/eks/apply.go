@@ -0,0 +1,566 @@
+package eks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+// ClusterConfigDiff holds the changes needed to bring a live cluster in line with its config,
+// resources that only exist in the cluster, and log types that are only enabled in the cluster,
+// are only deleted or disabled when pruning
+type ClusterConfigDiff struct {
+	NodeGroupsToCreate        []*api.NodeGroup
+	NodeGroupsToDelete        []string
+	ManagedNodeGroupsToCreate []*api.ManagedNodeGroup
+	ManagedNodeGroupsToDelete []string
+	// managed nodegroups that aren't in the config, but weren't created by eksctl either,
+	// so they're never deleted
+	UnownedManagedNodeGroups []string
+
+	AssociateOIDCProvider   bool
+	ServiceAccountsToCreate []*api.ClusterIAMServiceAccount
+	ServiceAccountsToUpdate []*api.ClusterIAMServiceAccount
+	ServiceAccountsToDelete []string
+
+	LogTypesToEnable  []string
+	LogTypesToDisable []string
+
+	TagsToSet map[string]string
+
+	DeletionProtectionToSet *bool
+
+	IAMIdentityMappingsToAdd []*api.IAMIdentityMapping
+
+	AuthenticationModeToSet string
+	AccessEntriesToCreate   []*api.AccessEntry
+
+	PodIdentityAssociationsToCreate []*api.PodIdentityAssociation
+}
+
+// liveClusterState holds the parts of a live cluster that are compared with its config
+type liveClusterState struct {
+	nodeGroups        sets.String
+	managedNodeGroups sets.String
+	// managedNodeGroups that have an eksctl stack
+	ownedManagedNodeGroups sets.String
+	serviceAccounts        sets.String
+	// serviceAccounts whose stack template differs from the one built from the config
+	changedServiceAccounts  sets.String
+	oidcProvider            bool
+	enabledLogTypes         sets.String
+	tags                    map[string]string
+	deletionProtection      bool
+	identities              []iam.Identity
+	authenticationMode      string
+	accessEntries           []string
+	podIdentityAssociations []*PodIdentityAssociationSummary
+}
+
+// DiffClusterConfig compares the config with the live cluster, which must have been created
+// or registered by eksctl
+func (c *ClusterProvider) DiffClusterConfig(cfg *api.ClusterConfig) (*ClusterConfigDiff, error) {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return nil, err
+	}
+	stackManager := c.NewStackManager(cfg)
+
+	live := &liveClusterState{
+		tags:               aws.StringValueMap(c.Status.clusterInfo.cluster.Tags),
+		authenticationMode: api.AuthenticationModeConfigMap,
+	}
+	if accessConfig := c.Status.clusterInfo.cluster.AccessConfig; accessConfig != nil && accessConfig.AuthenticationMode != nil {
+		live.authenticationMode = *accessConfig.AuthenticationMode
+	}
+
+	if cfg.Metadata.DeletionProtection != nil {
+		clusterStack, err := stackManager.DescribeClusterStack()
+		if err != nil {
+			return nil, err
+		}
+		live.deletionProtection = manager.IsDeletionProtected(clusterStack)
+	}
+
+	nodeGroups, err := stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	live.nodeGroups = sets.NewString(nodeGroups...)
+
+	managedNodeGroups, err := stackManager.ListManagedNodeGroups()
+	if err != nil {
+		return nil, err
+	}
+	live.managedNodeGroups = sets.NewString(managedNodeGroups...)
+
+	managedNodeGroupStacks, err := stackManager.DescribeManagedNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	live.ownedManagedNodeGroups = sets.NewString()
+	for _, s := range managedNodeGroupStacks {
+		live.ownedManagedNodeGroups.Insert(stackManager.GetManagedNodeGroupName(s))
+	}
+
+	serviceAccounts, err := stackManager.ListIAMServiceAccountStacks()
+	if err != nil {
+		return nil, err
+	}
+	live.serviceAccounts = sets.NewString(serviceAccounts...)
+
+	if live.enabledLogTypes, _, err = c.GetCurrentClusterConfigForLogging(cfg); err != nil {
+		return nil, err
+	}
+
+	oidc, err := c.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		if _, ok := err.(*UnsupportedOIDCError); !ok {
+			return nil, err
+		}
+	} else if live.oidcProvider, err = oidc.CheckProviderExists(); err != nil {
+		return nil, err
+	}
+
+	live.changedServiceAccounts = sets.NewString()
+	if live.oidcProvider && api.IsEnabled(cfg.IAM.WithOIDC) {
+		for _, sa := range DesiredIAMServiceAccounts(cfg) {
+			if !live.serviceAccounts.Has(sa.NameString()) {
+				continue
+			}
+			changed, err := stackManager.IAMServiceAccountStackChanged(sa, oidc)
+			if err != nil {
+				return nil, err
+			}
+			if changed {
+				live.changedServiceAccounts.Insert(sa.NameString())
+			}
+		}
+	}
+
+	if len(cfg.AccessEntries) > 0 && live.authenticationMode != api.AuthenticationModeConfigMap {
+		if live.accessEntries, err = c.ListAccessEntries(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(cfg.IAM.PodIdentityAssociations) > 0 {
+		if live.podIdentityAssociations, err = c.ListPodIdentityAssociations(cfg); err != nil {
+			return nil, err
+		}
+	}
+
+	if len(cfg.IAMIdentityMappings) > 0 {
+		clientSet, err := c.NewStdClientSet(cfg)
+		if err != nil {
+			return nil, err
+		}
+		acm, err := authconfigmap.NewFromClientSet(clientSet)
+		if err != nil {
+			return nil, err
+		}
+		if live.identities, err = acm.Identities(); err != nil {
+			return nil, err
+		}
+	}
+
+	return diffClusterConfig(cfg, live), nil
+}
+
+func diffClusterConfig(cfg *api.ClusterConfig, live *liveClusterState) *ClusterConfigDiff {
+	diff := &ClusterConfigDiff{
+		TagsToSet: map[string]string{},
+	}
+
+	desiredNodeGroups := sets.NewString()
+	for _, ng := range cfg.NodeGroups {
+		desiredNodeGroups.Insert(ng.Name)
+		if !live.nodeGroups.Has(ng.Name) {
+			diff.NodeGroupsToCreate = append(diff.NodeGroupsToCreate, ng)
+		}
+	}
+	diff.NodeGroupsToDelete = live.nodeGroups.Difference(desiredNodeGroups).List()
+
+	desiredManagedNodeGroups := sets.NewString()
+	for _, ng := range cfg.ManagedNodeGroups {
+		desiredManagedNodeGroups.Insert(ng.Name)
+		if !live.managedNodeGroups.Has(ng.Name) {
+			diff.ManagedNodeGroupsToCreate = append(diff.ManagedNodeGroupsToCreate, ng)
+		}
+	}
+	for _, name := range live.managedNodeGroups.Difference(desiredManagedNodeGroups).List() {
+		if live.ownedManagedNodeGroups.Has(name) {
+			diff.ManagedNodeGroupsToDelete = append(diff.ManagedNodeGroupsToDelete, name)
+		} else {
+			diff.UnownedManagedNodeGroups = append(diff.UnownedManagedNodeGroups, name)
+		}
+	}
+
+	desiredServiceAccounts := sets.NewString()
+	if api.IsEnabled(cfg.IAM.WithOIDC) {
+		diff.AssociateOIDCProvider = !live.oidcProvider
+		for _, sa := range DesiredIAMServiceAccounts(cfg) {
+			desiredServiceAccounts.Insert(sa.NameString())
+			if !live.serviceAccounts.Has(sa.NameString()) {
+				diff.ServiceAccountsToCreate = append(diff.ServiceAccountsToCreate, sa)
+			} else if live.changedServiceAccounts.Has(sa.NameString()) {
+				diff.ServiceAccountsToUpdate = append(diff.ServiceAccountsToUpdate, sa)
+			}
+		}
+	}
+	diff.ServiceAccountsToDelete = live.serviceAccounts.Difference(desiredServiceAccounts).List()
+
+	desiredLogTypes := sets.NewString()
+	if cfg.HasClusterCloudWatchLogging() {
+		desiredLogTypes.Insert(cfg.CloudWatch.ClusterLogging.EnableTypes...)
+	}
+	diff.LogTypesToEnable = desiredLogTypes.Difference(live.enabledLogTypes).List()
+	diff.LogTypesToDisable = live.enabledLogTypes.Difference(desiredLogTypes).List()
+
+	for key, value := range cfg.Metadata.Tags {
+		if current, ok := live.tags[key]; !ok || current != value {
+			diff.TagsToSet[key] = value
+		}
+	}
+
+	if cfg.Metadata.DeletionProtection != nil && *cfg.Metadata.DeletionProtection != live.deletionProtection {
+		diff.DeletionProtectionToSet = cfg.Metadata.DeletionProtection
+	}
+
+	diff.IAMIdentityMappingsToAdd = authconfigmap.MissingIdentityMappings(live.identities, cfg.IAMIdentityMappings)
+
+	if cfg.AccessConfig != nil && cfg.AccessConfig.AuthenticationMode != "" && cfg.AccessConfig.AuthenticationMode != live.authenticationMode {
+		diff.AuthenticationModeToSet = cfg.AccessConfig.AuthenticationMode
+	}
+	diff.AccessEntriesToCreate = MissingAccessEntries(live.accessEntries, cfg.AccessEntries)
+	diff.PodIdentityAssociationsToCreate = MissingPodIdentityAssociations(live.podIdentityAssociations, cfg.IAM.PodIdentityAssociations)
+
+	return diff
+}
+
+// FilterNodeGroups keeps only the creations and deletions of nodegroups that are matched, match is called
+// with the labels of nodegroups to create, and matchExisting with the names of nodegroups to delete, as these
+// aren't in the config, so their labels have to be read from the cluster
+func (d *ClusterConfigDiff) FilterNodeGroups(match func(name string, labels map[string]string) bool, matchExisting func(name string) bool) {
+	var nodeGroupsToCreate []*api.NodeGroup
+	for _, ng := range d.NodeGroupsToCreate {
+		if match(ng.Name, ng.Labels) {
+			nodeGroupsToCreate = append(nodeGroupsToCreate, ng)
+		}
+	}
+	d.NodeGroupsToCreate = nodeGroupsToCreate
+
+	var managedNodeGroupsToCreate []*api.ManagedNodeGroup
+	for _, ng := range d.ManagedNodeGroupsToCreate {
+		if match(ng.Name, ng.Labels) {
+			managedNodeGroupsToCreate = append(managedNodeGroupsToCreate, ng)
+		}
+	}
+	d.ManagedNodeGroupsToCreate = managedNodeGroupsToCreate
+
+	filterNames := func(names []string) []string {
+		var matching []string
+		for _, name := range names {
+			if matchExisting(name) {
+				matching = append(matching, name)
+			}
+		}
+		return matching
+	}
+	d.NodeGroupsToDelete = filterNames(d.NodeGroupsToDelete)
+	d.ManagedNodeGroupsToDelete = filterNames(d.ManagedNodeGroupsToDelete)
+	d.UnownedManagedNodeGroups = filterNames(d.UnownedManagedNodeGroups)
+}
+
+// HasChanges checks if anything needs to be done to apply the config
+func (d *ClusterConfigDiff) HasChanges(prune bool) bool {
+	hasDeletions := len(d.NodeGroupsToDelete) > 0 || len(d.ManagedNodeGroupsToDelete) > 0 || len(d.ServiceAccountsToDelete) > 0 ||
+		len(d.LogTypesToDisable) > 0
+	return len(d.NodeGroupsToCreate) > 0 || len(d.ManagedNodeGroupsToCreate) > 0 ||
+		d.AssociateOIDCProvider || len(d.ServiceAccountsToCreate) > 0 || len(d.ServiceAccountsToUpdate) > 0 ||
+		len(d.LogTypesToEnable) > 0 ||
+		len(d.TagsToSet) > 0 || d.DeletionProtectionToSet != nil || len(d.IAMIdentityMappingsToAdd) > 0 ||
+		d.AuthenticationModeToSet != "" || len(d.AccessEntriesToCreate) > 0 ||
+		len(d.PodIdentityAssociationsToCreate) > 0 || (prune && hasDeletions)
+}
+
+// Describe returns one line for each change, deletions are marked as skipped
+// unless pruning
+func (d *ClusterConfigDiff) Describe(prune bool) []string {
+	lines := []string{}
+	deletion := func(what, name string) {
+		if prune {
+			lines = append(lines, fmt.Sprintf("- delete %s %q", what, name))
+		} else {
+			lines = append(lines, fmt.Sprintf("- delete %s %q (skipped, use --prune)", what, name))
+		}
+	}
+
+	for _, ng := range d.NodeGroupsToCreate {
+		lines = append(lines, fmt.Sprintf("+ create nodegroup %q", ng.Name))
+	}
+	for _, name := range d.NodeGroupsToDelete {
+		deletion("nodegroup", name)
+	}
+	for _, ng := range d.ManagedNodeGroupsToCreate {
+		lines = append(lines, fmt.Sprintf("+ create managed nodegroup %q", ng.Name))
+	}
+	for _, name := range d.ManagedNodeGroupsToDelete {
+		deletion("managed nodegroup", name)
+	}
+	for _, name := range d.UnownedManagedNodeGroups {
+		lines = append(lines, fmt.Sprintf("- delete managed nodegroup %q (skipped, not created by eksctl)", name))
+	}
+	if d.AssociateOIDCProvider {
+		lines = append(lines, "+ associate IAM OIDC provider")
+	}
+	for _, sa := range d.ServiceAccountsToCreate {
+		lines = append(lines, fmt.Sprintf("+ create IAM service account %q", sa.NameString()))
+	}
+	for _, sa := range d.ServiceAccountsToUpdate {
+		lines = append(lines, fmt.Sprintf("~ update IAM role of IAM service account %q", sa.NameString()))
+	}
+	for _, name := range d.ServiceAccountsToDelete {
+		deletion("IAM service account", name)
+	}
+	if len(d.LogTypesToEnable) > 0 {
+		lines = append(lines, fmt.Sprintf("~ enable CloudWatch logging types: %s", strings.Join(d.LogTypesToEnable, ", ")))
+	}
+	if len(d.LogTypesToDisable) > 0 {
+		line := fmt.Sprintf("~ disable CloudWatch logging types: %s", strings.Join(d.LogTypesToDisable, ", "))
+		if !prune {
+			line += " (skipped, use --prune)"
+		}
+		lines = append(lines, line)
+	}
+	keys := []string{}
+	for key := range d.TagsToSet {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		lines = append(lines, fmt.Sprintf("~ set cluster tag %s=%s", key, d.TagsToSet[key]))
+	}
+	if d.DeletionProtectionToSet != nil {
+		if *d.DeletionProtectionToSet {
+			lines = append(lines, "~ enable deletion protection")
+		} else {
+			lines = append(lines, "~ disable deletion protection")
+		}
+	}
+	if d.AuthenticationModeToSet != "" {
+		lines = append(lines, fmt.Sprintf("~ set authentication mode to %s", d.AuthenticationModeToSet))
+	}
+	for _, entry := range d.AccessEntriesToCreate {
+		lines = append(lines, fmt.Sprintf("+ create access entry %q", entry.PrincipalARN))
+	}
+	for _, association := range d.PodIdentityAssociationsToCreate {
+		lines = append(lines, fmt.Sprintf("+ create pod identity association %q", association.NameString()))
+	}
+	for _, mapping := range d.IAMIdentityMappingsToAdd {
+		lines = append(lines, fmt.Sprintf("~ map IAM identity %q to username %q and groups %v", mapping.ARN, mapping.Username, mapping.Groups))
+	}
+	return lines
+}
+
+// NewTasksToApplyClusterConfig defines the tasks that apply the changes in the diff, new resources are
+// created first and deletions, when pruning, come last; nodegroups need to be prepared as for
+// `eksctl create nodegroup`, i.e. have their AMI and SSH key resolved
+func (c *ClusterProvider) NewTasksToApplyClusterConfig(cfg *api.ClusterConfig, diff *ClusterConfigDiff, prune bool) (*manager.TaskTree, error) {
+	stackManager := c.NewStackManager(cfg)
+	tasks := &manager.TaskTree{Parallel: false}
+
+	nodeGroupTasks := stackManager.NewTasksToCreateNodeGroups(diff.NodeGroupsToCreate)
+	if managedNodeGroupTasks := stackManager.NewTasksToCreateManagedNodeGroups(diff.ManagedNodeGroupsToCreate); managedNodeGroupTasks.Len() > 0 {
+		managedNodeGroupTasks.IsSubTask = true
+		nodeGroupTasks.Append(managedNodeGroupTasks)
+	}
+	if nodeGroupTasks.Len() > 0 {
+		nodeGroupTasks.IsSubTask = true
+		tasks.Append(nodeGroupTasks)
+	}
+
+	configTasks := &manager.TaskTree{Parallel: false, IsSubTask: true}
+	if diff.AuthenticationModeToSet != "" {
+		configTasks.Append(&clusterConfigTask{
+			info: "set authentication mode",
+			spec: cfg,
+			call: c.UpdateAuthenticationMode,
+		})
+	}
+	if len(diff.AccessEntriesToCreate) > 0 {
+		configTasks.Append(&clusterConfigTask{
+			info: "create access entries",
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				for _, entry := range diff.AccessEntriesToCreate {
+					if err := c.CreateAccessEntry(cfg, entry); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		})
+	}
+	if len(diff.PodIdentityAssociationsToCreate) > 0 {
+		configTasks.Append(&clusterConfigTask{
+			info: "create pod identity associations",
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				if err := c.EnsurePodIdentityAgent(cfg); err != nil {
+					return err
+				}
+				for _, association := range diff.PodIdentityAssociationsToCreate {
+					if err := c.CreatePodIdentityAssociation(cfg, association); err != nil {
+						return err
+					}
+				}
+				return nil
+			},
+		})
+	}
+	if diff.AssociateOIDCProvider {
+		c.appendCreateTasksForIAMServiceAccounts(cfg, diff.ServiceAccountsToCreate, configTasks)
+	} else if len(diff.ServiceAccountsToCreate) > 0 || len(diff.ServiceAccountsToUpdate) > 0 {
+		oidc, err := c.NewOpenIDConnectManager(cfg)
+		if err != nil {
+			return nil, err
+		}
+		// sets the provider ARN that trust policies of the roles refer to
+		if _, err := oidc.CheckProviderExists(); err != nil {
+			return nil, err
+		}
+		if len(diff.ServiceAccountsToCreate) > 0 {
+			serviceAccountTasks := stackManager.NewTasksToCreateIAMServiceAccounts(diff.ServiceAccountsToCreate, oidc, c.newCallbackClientSet(cfg))
+			serviceAccountTasks.IsSubTask = true
+			configTasks.Append(serviceAccountTasks)
+		}
+		if len(diff.ServiceAccountsToUpdate) > 0 {
+			serviceAccountTasks := stackManager.NewTasksToUpdateIAMServiceAccounts(diff.ServiceAccountsToUpdate, oidc)
+			serviceAccountTasks.IsSubTask = true
+			configTasks.Append(serviceAccountTasks)
+		}
+	}
+	if len(diff.LogTypesToEnable) > 0 || (prune && len(diff.LogTypesToDisable) > 0) {
+		configTasks.Append(&clusterConfigTask{
+			info: "update CloudWatch logging configuration",
+			spec: loggingConfig(cfg, diff, prune),
+			call: c.UpdateClusterConfigForLogging,
+		})
+	}
+	if len(diff.TagsToSet) > 0 {
+		configTasks.Append(&clusterConfigTask{
+			info: "update cluster tags",
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				return c.tagCluster(cfg, diff.TagsToSet)
+			},
+		})
+	}
+	if diff.DeletionProtectionToSet != nil {
+		configTasks.Append(&clusterConfigTask{
+			info: "update deletion protection",
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				return stackManager.SetDeletionProtection(*diff.DeletionProtectionToSet)
+			},
+		})
+	}
+	if len(diff.IAMIdentityMappingsToAdd) > 0 {
+		configTasks.Append(&clusterConfigTask{
+			info: "update IAM identity mappings in auth ConfigMap",
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				clientSet, err := c.NewStdClientSet(cfg)
+				if err != nil {
+					return err
+				}
+				return authconfigmap.AddIdentityMappings(clientSet, diff.IAMIdentityMappingsToAdd)
+			},
+		})
+	}
+	if configTasks.Len() > 0 {
+		tasks.Append(configTasks)
+	}
+
+	if !prune {
+		return tasks, nil
+	}
+
+	deleteTasks := &manager.TaskTree{Parallel: true, IsSubTask: true}
+	if len(diff.ServiceAccountsToDelete) > 0 {
+		serviceAccountTasks, err := stackManager.NewTasksToDeleteIAMServiceAccounts(sets.NewString(diff.ServiceAccountsToDelete...).Has, nil, c.newCallbackClientSet(cfg), true)
+		if err != nil {
+			return nil, err
+		}
+		serviceAccountTasks.IsSubTask = true
+		deleteTasks.Append(serviceAccountTasks)
+	}
+	if len(diff.NodeGroupsToDelete) > 0 {
+		nodeGroupTasks, err := stackManager.NewTasksToDeleteNodeGroups(sets.NewString(diff.NodeGroupsToDelete...).Has, true, nil)
+		if err != nil {
+			return nil, err
+		}
+		nodeGroupTasks.IsSubTask = true
+		deleteTasks.Append(nodeGroupTasks)
+	}
+	if len(diff.ManagedNodeGroupsToDelete) > 0 {
+		managedNodeGroupTasks, err := stackManager.NewTasksToDeleteManagedNodeGroups(sets.NewString(diff.ManagedNodeGroupsToDelete...).Has)
+		if err != nil {
+			return nil, err
+		}
+		managedNodeGroupTasks.IsSubTask = true
+		deleteTasks.Append(managedNodeGroupTasks)
+	}
+	if deleteTasks.Len() > 0 {
+		tasks.Append(deleteTasks)
+	}
+	return tasks, nil
+}
+
+// loggingConfig returns the config that logging is updated with, which, unless pruning, keeps the log types
+// enabled that are only enabled in the cluster
+func loggingConfig(cfg *api.ClusterConfig, diff *ClusterConfigDiff, prune bool) *api.ClusterConfig {
+	if prune || len(diff.LogTypesToDisable) == 0 {
+		return cfg
+	}
+	out := cfg.DeepCopy()
+	if out.CloudWatch == nil {
+		out.CloudWatch = &api.ClusterCloudWatch{}
+	}
+	if out.CloudWatch.ClusterLogging == nil {
+		out.CloudWatch.ClusterLogging = &api.ClusterCloudWatchLogging{}
+	}
+	out.CloudWatch.ClusterLogging.EnableTypes = sets.NewString(out.CloudWatch.ClusterLogging.EnableTypes...).Insert(diff.LogTypesToDisable...).List()
+	return out
+}
+
+func (c *ClusterProvider) newCallbackClientSet(cfg *api.ClusterConfig) kubernetes.ClientSetGetter {
+	return &kubernetes.CallbackClientSet{
+		Callback: func() (kubernetes.Interface, error) {
+			return c.NewStdClientSet(cfg)
+		},
+	}
+}
+
+// tagCluster adds or updates the given tags of the EKS cluster
+func (c *ClusterProvider) tagCluster(cfg *api.ClusterConfig, tags map[string]string) error {
+	input := &awseks.TagResourceInput{
+		ResourceArn: aws.String(cfg.Status.ARN),
+		Tags:        aws.StringMap(tags),
+	}
+	if _, err := c.Provider.EKS().TagResource(input); err != nil {
+		return errors.Wrapf(err, "tagging cluster %q", cfg.Metadata.Name)
+	}
+	return nil
+}
@@ -0,0 +1,197 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("EKS API wrapper", func() {
+	Describe("can diff cluster config against the live cluster", func() {
+		var (
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p := mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{
+				Provider: p,
+				Status:   &ProviderStatus{},
+			}
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			cluster := testutils.NewFakeCluster("test-cluster", awseks.ClusterStatusActive)
+			cluster.Tags = aws.StringMap(map[string]string{"team": "a", "env": "dev"})
+			cluster.Logging = &awseks.Logging{
+				ClusterLogging: []*awseks.LogSetup{
+					{
+						Enabled: api.Enabled(),
+						Types:   aws.StringSlice([]string{"api", "audit"}),
+					},
+				},
+			}
+			p.MockEKS().On("DescribeCluster", mock.Anything).Return(&awseks.DescribeClusterOutput{Cluster: cluster}, nil)
+
+			p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *awseks.ListNodegroupsOutput, last bool) bool)
+				consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"mng-1", "mng-unowned"})}, true)
+			}).Return(nil)
+
+			stackTags := map[string]string{
+				"eksctl-test-cluster-nodegroup-ng-1":                          api.NodeGroupNameTag,
+				"eksctl-test-cluster-nodegroup-ng-old":                        api.NodeGroupNameTag,
+				"eksctl-test-cluster-nodegroup-mng-1":                         api.ManagedNodeGroupNameTag,
+				"eksctl-test-cluster-addon-iamserviceaccount-kube-system-old": api.IAMServiceAccountNameTag,
+			}
+			stackNames := map[string]string{
+				"eksctl-test-cluster-nodegroup-ng-1":                          "ng-1",
+				"eksctl-test-cluster-nodegroup-ng-old":                        "ng-old",
+				"eksctl-test-cluster-nodegroup-mng-1":                         "mng-1",
+				"eksctl-test-cluster-addon-iamserviceaccount-kube-system-old": "kube-system/old",
+			}
+			p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) bool)
+				stacks := []*cfn.Stack{}
+				for name, tag := range stackTags {
+					stacks = append(stacks, &cfn.Stack{
+						StackName:   aws.String(name),
+						StackStatus: aws.String(cfn.StackStatusCreateComplete),
+						Tags: []*cfn.Tag{{
+							Key:   aws.String(tag),
+							Value: aws.String(stackNames[name]),
+						}},
+					})
+				}
+				consume(&cfn.DescribeStacksOutput{Stacks: stacks}, true)
+			}).Return(nil)
+		})
+
+		It("finds the resources to create and the ones to delete", func() {
+			cfg.NodeGroups = []*api.NodeGroup{{Name: "ng-1"}, {Name: "ng-2"}}
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{Name: "mng-2"}}
+			cfg.IAM.WithOIDC = api.Enabled()
+			cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{}}
+			cfg.IAM.ServiceAccounts[0].Name = "new"
+			cfg.IAM.ServiceAccounts[0].Namespace = "default"
+			cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"api", "scheduler"}
+			cfg.Metadata.Tags = map[string]string{"team": "a", "env": "prod"}
+
+			diff, err := ctl.DiffClusterConfig(cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(diff.NodeGroupsToCreate).To(HaveLen(1))
+			Expect(diff.NodeGroupsToCreate[0].Name).To(Equal("ng-2"))
+			Expect(diff.NodeGroupsToDelete).To(ConsistOf("ng-old"))
+
+			Expect(diff.ManagedNodeGroupsToCreate).To(HaveLen(1))
+			Expect(diff.ManagedNodeGroupsToCreate[0].Name).To(Equal("mng-2"))
+			Expect(diff.ManagedNodeGroupsToDelete).To(ConsistOf("mng-1"))
+			Expect(diff.UnownedManagedNodeGroups).To(ConsistOf("mng-unowned"))
+
+			Expect(diff.AssociateOIDCProvider).To(BeTrue())
+			Expect(diff.ServiceAccountsToCreate).To(HaveLen(1))
+			Expect(diff.ServiceAccountsToCreate[0].NameString()).To(Equal("default/new"))
+			Expect(diff.ServiceAccountsToDelete).To(ConsistOf("kube-system/old"))
+
+			Expect(diff.LogTypesToEnable).To(ConsistOf("scheduler"))
+			Expect(diff.LogTypesToDisable).To(ConsistOf("audit"))
+
+			Expect(diff.TagsToSet).To(Equal(map[string]string{"env": "prod"}))
+		})
+
+		It("only reports deletions as changes when pruning", func() {
+			cfg.NodeGroups = []*api.NodeGroup{{Name: "ng-1"}}
+			cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"api", "audit"}
+			cfg.Metadata.Tags = map[string]string{"team": "a"}
+
+			diff, err := ctl.DiffClusterConfig(cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(diff.NodeGroupsToCreate).To(BeEmpty())
+			Expect(diff.LogTypesToEnable).To(BeEmpty())
+			Expect(diff.LogTypesToDisable).To(BeEmpty())
+			Expect(diff.TagsToSet).To(BeEmpty())
+
+			Expect(diff.HasChanges(false)).To(BeFalse())
+			Expect(diff.HasChanges(true)).To(BeTrue())
+			Expect(diff.Describe(true)).To(ConsistOf(
+				`- delete nodegroup "ng-old"`,
+				`- delete managed nodegroup "mng-1"`,
+				`- delete managed nodegroup "mng-unowned" (skipped, not created by eksctl)`,
+				`- delete IAM service account "kube-system/old"`,
+			))
+			Expect(diff.Describe(false)).To(ContainElement(`- delete nodegroup "ng-old" (skipped, use --prune)`))
+		})
+
+		It("never deletes managed nodegroups that weren't created by eksctl", func() {
+			diff, err := ctl.DiffClusterConfig(cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			tasks, err := ctl.NewTasksToApplyClusterConfig(cfg, diff, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks.Describe()).To(ContainSubstring(`delete managed nodegroup "mng-1"`))
+			Expect(tasks.Describe()).NotTo(ContainSubstring("mng-unowned"))
+		})
+
+		It("only disables log types when pruning", func() {
+			cfg.NodeGroups = []*api.NodeGroup{{Name: "ng-1"}}
+			cfg.CloudWatch.ClusterLogging.EnableTypes = []string{"api"}
+			cfg.Metadata.Tags = map[string]string{"team": "a"}
+
+			diff, err := ctl.DiffClusterConfig(cfg)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(diff.LogTypesToEnable).To(BeEmpty())
+			Expect(diff.LogTypesToDisable).To(ConsistOf("audit"))
+			Expect(diff.Describe(false)).To(ContainElement("~ disable CloudWatch logging types: audit (skipped, use --prune)"))
+
+			diff = &ClusterConfigDiff{LogTypesToDisable: []string{"audit"}}
+			Expect(diff.HasChanges(false)).To(BeFalse())
+			Expect(diff.HasChanges(true)).To(BeTrue())
+
+			tasks, err := ctl.NewTasksToApplyClusterConfig(cfg, diff, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks.Len()).To(Equal(0))
+
+			tasks, err = ctl.NewTasksToApplyClusterConfig(cfg, diff, true)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(tasks.Describe()).To(ContainSubstring("update CloudWatch logging configuration"))
+		})
+
+		It("keeps only the nodegroups that are matched", func() {
+			cfg.NodeGroups = []*api.NodeGroup{
+				{Name: "ng-1"},
+				{Name: "ng-spot-1", Labels: map[string]string{"lifecycle": "spot"}},
+				{Name: "ng-spot-2"},
+			}
+			cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{Name: "mng-2", Labels: map[string]string{"lifecycle": "spot"}}}
+
+			diff, err := ctl.DiffClusterConfig(cfg)
+			Expect(err).NotTo(HaveOccurred())
+
+			diff.FilterNodeGroups(func(name string, labels map[string]string) bool {
+				return labels["lifecycle"] == "spot"
+			}, func(name string) bool {
+				return name == "ng-old"
+			})
+
+			Expect(diff.NodeGroupsToCreate).To(HaveLen(1))
+			Expect(diff.NodeGroupsToCreate[0].Name).To(Equal("ng-spot-1"))
+			Expect(diff.NodeGroupsToDelete).To(ConsistOf("ng-old"))
+			Expect(diff.ManagedNodeGroupsToCreate).To(HaveLen(1))
+			Expect(diff.ManagedNodeGroupsToCreate[0].Name).To(Equal("mng-2"))
+			Expect(diff.ManagedNodeGroupsToDelete).To(BeEmpty())
+		})
+	})
+})
@@ -0,0 +1,85 @@
+package eks
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/credentials/stscreds"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	eksctlcredentials "github.com/weaveworks/eksctl/pkg/credentials"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// assumeRoles makes the session use the credentials of the last of the roles given with
+// --assume-role-arn, each role is assumed with the credentials of the previous one
+func assumeRoles(s *session.Session, spec *api.ProviderConfig) *session.Session {
+	for i, roleARN := range spec.AssumeRoleARNs {
+		logger.Debug("assuming role %q", roleARN)
+		// roles are assumed at the STS endpoint that the other clients use
+		stsClient := sts.New(s, endpointConfig(s, spec, "sts"))
+		creds := stscreds.NewCredentialsWithClient(stsClient, roleARN, assumeRoleOptions(spec, i == 0))
+		s = s.Copy(&aws.Config{Credentials: creds})
+	}
+	return s
+}
+
+func assumeRoleOptions(spec *api.ProviderConfig, first bool) func(*stscreds.AssumeRoleProvider) {
+	return func(p *stscreds.AssumeRoleProvider) {
+		p.Duration = stscreds.DefaultDuration
+		if spec.AssumeRoleExternalID != "" {
+			p.ExternalID = aws.String(spec.AssumeRoleExternalID)
+		}
+		if !first {
+			// session tags are transitive, and MFA can only be used with long-term credentials
+			return
+		}
+		keys := make([]string, 0, len(spec.AssumeRoleSessionTags))
+		for k := range spec.AssumeRoleSessionTags {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			p.Tags = append(p.Tags, &sts.Tag{Key: aws.String(k), Value: aws.String(spec.AssumeRoleSessionTags[k])})
+			p.TransitiveTagKeys = append(p.TransitiveTagKeys, aws.String(k))
+		}
+		if spec.MFASerial != "" {
+			p.SerialNumber = aws.String(spec.MFASerial)
+			p.TokenProvider = stscreds.StdinTokenProvider
+		}
+	}
+}
+
+// cacheCredentials makes the session use credentials that are cached on disk across commands,
+// under a key that identifies the profile and the roles that are assumed
+func cacheCredentials(s *session.Session, spec *api.ProviderConfig) *session.Session {
+	profile := spec.Profile
+	if profile == "" {
+		profile = os.Getenv("AWS_PROFILE")
+	}
+	cacheKey := strings.Join([]string{
+		profile,
+		strings.Join(spec.AssumeRoleARNs, ","),
+		spec.AssumeRoleExternalID,
+		spec.MFASerial,
+		fmt.Sprintf("%v", spec.AssumeRoleSessionTags),
+	}, "\n")
+	dir, err := eksctlcredentials.DefaultCacheDir()
+	if err != nil {
+		logger.Warning("not caching credentials: %s", err.Error())
+		return s
+	}
+	keyFile, err := eksctlcredentials.DefaultKeyFile()
+	if err != nil {
+		logger.Warning("not caching credentials: %s", err.Error())
+		return s
+	}
+	provider := eksctlcredentials.NewFileCacheProvider(dir, keyFile, cacheKey, s.Config.Credentials)
+	return s.Copy(&aws.Config{Credentials: credentials.NewCredentials(provider)})
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -57,6 +58,9 @@ func (c *Client) new(spec *api.ClusterConfig, stsClient stsiface.STSAPI) (*Clien
 	if err != nil {
 		return nil, errors.Wrap(err, "failed to create API client configuration from client config")
 	}
+	if err := kubewrapper.UseCABundle(rawConfig); err != nil {
+		return nil, err
+	}
 	c.rawConfig = rawConfig
 
 	return c, nil
@@ -119,3 +123,17 @@ func (c *ClusterProvider) NewRawClient(spec *api.ClusterConfig) (*kubewrapper.Ra
 
 	return kubewrapper.NewRawClient(clientSet, client.rawConfig)
 }
+
+// NewDynamicClient creates a new dynamic client with an embedded STS token, it's used for custom resources
+func (c *ClusterProvider) NewDynamicClient(spec *api.ClusterConfig) (dynamic.Interface, error) {
+	client, err := c.NewClient(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating Kubernetes client config with embedded token")
+	}
+
+	dynamicClient, err := dynamic.NewForConfig(client.rawConfig)
+	if err != nil {
+		return nil, errors.Wrap(err, "creating dynamic Kubernetes client")
+	}
+	return dynamicClient, nil
+}
@@ -2,6 +2,7 @@ package eks_test
 
 import (
 	"fmt"
+	"os"
 	"strings"
 
 	. "github.com/onsi/ginkgo"
@@ -10,6 +11,7 @@ import (
 	. "github.com/weaveworks/eksctl/pkg/eks"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 	"github.com/weaveworks/eksctl/pkg/utils/kubeconfig"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 var _ = Describe("eks auth helpers", func() {
@@ -43,7 +45,8 @@ var _ = Describe("eks auth helpers", func() {
 				}
 
 				testAuthenticatorConfig := func(roleARN string) {
-					clientConfig := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), roleARN, ctl.Provider.Profile())
+					clientConfig, err := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), kubeconfig.ExecOptions{RoleARN: roleARN, Profile: ctl.Provider.Profile()})
+					Expect(err).ToNot(HaveOccurred())
 					Expect(clientConfig).To(Not(BeNil()))
 					ctx := clientConfig.CurrentContext
 					cluster := strings.Split(ctx, "@")[1]
@@ -103,6 +106,47 @@ var _ = Describe("eks auth helpers", func() {
 					testAuthenticatorConfig("arn:aws:iam::111111111111:role/eksctl")
 				})
 
+				It("should create config with the given authenticator, its options and context name", func() {
+					os.Setenv("EKSCTL_TEST_AUTHENTICATOR_ENV", "passed")
+					defer os.Unsetenv("EKSCTL_TEST_AUTHENTICATOR_ENV")
+
+					k, err := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), kubeconfig.ExecOptions{
+						Authenticator: kubeconfig.AWSIAMAuthenticator,
+						RoleARN:       "arn:aws:iam::111111111111:role/eksctl",
+						SessionName:   "ops",
+						Env:           []string{"AWS_STS_REGIONAL_ENDPOINTS=regional", "EKSCTL_TEST_AUTHENTICATOR_ENV"},
+						ContextName:   "{{.ClusterName}}-{{.Region}}",
+					})
+					Expect(err).ToNot(HaveOccurred())
+
+					ctx := "auth-test-cluster-eu-west-3"
+					Expect(k.CurrentContext).To(Equal(ctx))
+					Expect(k.Contexts).To(HaveLen(1))
+					Expect(k.Contexts[ctx].AuthInfo).To(Equal(ctx))
+					Expect(k.Contexts[ctx].Cluster).To(Equal("auth-test-cluster.eu-west-3.eksctl.io"))
+					Expect(k.AuthInfos).To(HaveLen(1))
+
+					exec := k.AuthInfos[ctx].Exec
+					Expect(exec.Command).To(Equal("aws-iam-authenticator"))
+					Expect(strings.Join(exec.Args, " ")).To(Equal("token -i auth-test-cluster -r arn:aws:iam::111111111111:role/eksctl --session-name ops"))
+					Expect(exec.Env).To(Equal([]clientcmdapi.ExecEnvVar{
+						{Name: "AWS_STS_REGIONAL_ENDPOINTS", Value: "regional"},
+						{Name: "EKSCTL_TEST_AUTHENTICATOR_ENV", Value: "passed"},
+					}))
+				})
+
+				It("should reject options the authenticator doesn't support", func() {
+					_, err := kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), kubeconfig.ExecOptions{
+						Authenticator: kubeconfig.AWSEKSAuthenticator,
+						RoleARN:       "arn:aws:iam::111111111111:role/eksctl",
+						SessionName:   "ops",
+					})
+					Expect(err).To(MatchError("session name of the assumed role is only supported by aws-iam-authenticator"))
+
+					_, err = kubeconfig.NewForKubectl(cfg, ctl.GetUsername(), kubeconfig.ExecOptions{ContextName: "{{.Cluster}}"})
+					Expect(err).To(HaveOccurred())
+				})
+
 				It("should create config with embedded token", func() {
 					// TODO: cannot test this, as token generator uses STS directly, we cannot pass the interface
 					// we can probably fix the package itself
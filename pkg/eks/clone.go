@@ -0,0 +1,80 @@
+package eks
+
+import (
+	"math"
+	"sort"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// CloneClusterConfig returns the config of a new cluster named name from the exported config
+// of a live cluster; the new cluster gets a VPC of its own with the same CIDR, NAT mode and
+// availability zones, and everything that belongs to the source cluster, i.e. its VPC, security
+// groups, IAM roles and deletion protection, is left out so that it's created again
+func CloneClusterConfig(source *api.ClusterConfig, name string) *api.ClusterConfig {
+	cfg := source.DeepCopy()
+	cfg.Status = nil
+	cfg.Metadata.Name = name
+	cfg.Metadata.DeletionProtection = nil
+
+	if cfg.VPC != nil {
+		zones := map[string]bool{}
+		if cfg.VPC.Subnets != nil {
+			for az := range cfg.VPC.Subnets.Private {
+				zones[az] = true
+			}
+			for az := range cfg.VPC.Subnets.Public {
+				zones[az] = true
+			}
+		}
+		if len(zones) > 0 {
+			cfg.AvailabilityZones = nil
+			for az := range zones {
+				cfg.AvailabilityZones = append(cfg.AvailabilityZones, az)
+			}
+			sort.Strings(cfg.AvailabilityZones)
+		}
+		cfg.VPC.ID = ""
+		cfg.VPC.Subnets = nil
+		cfg.VPC.SubnetDiscovery = nil
+		cfg.VPC.SecurityGroup = ""
+		cfg.VPC.SharedNodeSecurityGroup = ""
+	}
+
+	if cfg.IAM != nil {
+		cfg.IAM.ServiceRoleARN = nil
+		cfg.IAM.FargatePodExecutionRoleARN = nil
+		for _, sa := range cfg.IAM.ServiceAccounts {
+			// role names are unique in an account
+			sa.RoleName = ""
+			sa.Status = nil
+		}
+	}
+	for _, addon := range cfg.Addons {
+		// the role trusts the IAM OIDC provider of the source cluster
+		addon.ServiceAccountRoleARN = ""
+	}
+	for _, profile := range cfg.FargateProfiles {
+		profile.PodExecutionRoleARN = ""
+		profile.Subnets = nil
+	}
+	return cfg
+}
+
+// ScaleNodeGroups multiplies the minimum, maximum and desired sizes of all nodegroups by factor,
+// rounding up so that nodegroups with nodes keep at least one
+func ScaleNodeGroups(cfg *api.ClusterConfig, factor float64) {
+	scale := func(size *int) *int {
+		if size == nil {
+			return nil
+		}
+		scaled := int(math.Ceil(float64(*size) * factor))
+		return &scaled
+	}
+	for _, ng := range cfg.NodeGroups {
+		ng.MinSize, ng.MaxSize, ng.DesiredCapacity = scale(ng.MinSize), scale(ng.MaxSize), scale(ng.DesiredCapacity)
+	}
+	for _, ng := range cfg.ManagedNodeGroups {
+		ng.MinSize, ng.MaxSize, ng.DesiredCapacity = scale(ng.MinSize), scale(ng.MaxSize), scale(ng.DesiredCapacity)
+	}
+}
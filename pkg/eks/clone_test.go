@@ -0,0 +1,90 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("cloning cluster configs", func() {
+	var source *api.ClusterConfig
+
+	BeforeEach(func() {
+		source = api.NewClusterConfig()
+		source.Metadata.Name = "prod"
+		source.Metadata.Version = "1.29"
+		source.Metadata.DeletionProtection = api.Enabled()
+		source.Status = &api.ClusterStatus{Endpoint: "https://prod.example.com"}
+		source.VPC.ID = "vpc-1"
+		source.VPC.SecurityGroup = "sg-1"
+		source.VPC.SharedNodeSecurityGroup = "sg-2"
+		source.VPC.Subnets = &api.ClusterSubnets{
+			Private: map[string]api.Network{
+				"us-west-2b": {ID: "subnet-2"},
+				"us-west-2a": {ID: "subnet-1"},
+			},
+			Public: map[string]api.Network{
+				"us-west-2a": {ID: "subnet-3"},
+			},
+		}
+		source.IAM.ServiceRoleARN = aws.String("arn:aws:iam::123456789012:role/prod-service-role")
+		source.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{
+			RoleName:         "prod-s3-reader",
+			AttachPolicyARNs: []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+		}}
+		source.Addons = []*api.Addon{{Name: "vpc-cni", ServiceAccountRoleARN: "arn:aws:iam::123456789012:role/prod-vpc-cni"}}
+		source.FargateProfiles = []*api.FargateProfile{{
+			Name:                "fp-default",
+			PodExecutionRoleARN: "arn:aws:iam::123456789012:role/prod-fargate",
+			Subnets:             []string{"subnet-1"},
+		}}
+
+		ng := source.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.AMI = "ami-123"
+		ng.MinSize, ng.MaxSize, ng.DesiredCapacity = aws.Int(2), aws.Int(10), aws.Int(5)
+	})
+
+	It("leaves out resources of the source cluster", func() {
+		cfg := CloneClusterConfig(source, "staging")
+
+		Expect(cfg.Metadata.Name).To(Equal("staging"))
+		Expect(cfg.Metadata.Version).To(Equal("1.29"))
+		Expect(cfg.Metadata.DeletionProtection).To(BeNil())
+		Expect(cfg.Status).To(BeNil())
+		Expect(cfg.AvailabilityZones).To(Equal([]string{"us-west-2a", "us-west-2b"}))
+		Expect(cfg.VPC.ID).To(BeEmpty())
+		Expect(cfg.VPC.Subnets).To(BeNil())
+		Expect(cfg.VPC.SecurityGroup).To(BeEmpty())
+		Expect(cfg.VPC.SharedNodeSecurityGroup).To(BeEmpty())
+		Expect(cfg.VPC.CIDR).To(Equal(source.VPC.CIDR))
+		Expect(cfg.IAM.ServiceRoleARN).To(BeNil())
+		Expect(cfg.IAM.ServiceAccounts[0].RoleName).To(BeEmpty())
+		Expect(cfg.IAM.ServiceAccounts[0].AttachPolicyARNs).To(Equal(source.IAM.ServiceAccounts[0].AttachPolicyARNs))
+		Expect(cfg.Addons[0].ServiceAccountRoleARN).To(BeEmpty())
+		Expect(cfg.FargateProfiles[0].PodExecutionRoleARN).To(BeEmpty())
+		Expect(cfg.FargateProfiles[0].Subnets).To(BeNil())
+		Expect(cfg.NodeGroups[0].AMI).To(Equal("ami-123"))
+
+		By("not changing the source config")
+		Expect(source.Metadata.Name).To(Equal("prod"))
+		Expect(source.VPC.ID).To(Equal("vpc-1"))
+		Expect(source.IAM.ServiceAccounts[0].RoleName).To(Equal("prod-s3-reader"))
+	})
+
+	It("scales nodegroups, keeping at least one node", func() {
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng-1"
+		mng.MinSize, mng.MaxSize, mng.DesiredCapacity = aws.Int(0), aws.Int(3), aws.Int(1)
+		source.ManagedNodeGroups = []*api.ManagedNodeGroup{mng}
+
+		ScaleNodeGroups(source, 0.25)
+
+		ng := source.NodeGroups[0]
+		Expect([]int{*ng.MinSize, *ng.MaxSize, *ng.DesiredCapacity}).To(Equal([]int{1, 3, 2}))
+		Expect([]int{*mng.MinSize, *mng.MaxSize, *mng.DesiredCapacity}).To(Equal([]int{0, 1, 1}))
+	})
+})
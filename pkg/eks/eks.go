@@ -5,9 +5,9 @@ import (
 	"fmt"
 	"os"
 	"strings"
+	"sync"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	awseks "github.com/aws/aws-sdk-go/service/eks"
@@ -17,6 +17,7 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/printers"
 	"github.com/weaveworks/eksctl/pkg/vpc"
 )
@@ -123,6 +124,19 @@ func (c *ClusterProvider) ControlPlaneVersion() string {
 	return *c.Status.clusterInfo.cluster.Version
 }
 
+// ControlPlaneHasPublicEndpoint checks if the Kubernetes API endpoint of the cluster can be reached
+// from outside of its VPC, it assumes so when it's not known
+func (c *ClusterProvider) ControlPlaneHasPublicEndpoint() bool {
+	if c.Status.clusterInfo == nil || c.Status.clusterInfo.cluster == nil {
+		return true
+	}
+	vpcConfig := c.Status.clusterInfo.cluster.ResourcesVpcConfig
+	if vpcConfig == nil || vpcConfig.EndpointPublicAccess == nil {
+		return true
+	}
+	return *vpcConfig.EndpointPublicAccess
+}
+
 // UnsupportedOIDCError represents an unsupported OIDC error
 type UnsupportedOIDCError struct {
 	msg string
@@ -145,10 +159,11 @@ func (c *ClusterProvider) NewOpenIDConnectManager(spec *api.ClusterConfig) (*iam
 	if c.Status.clusterInfo.cluster == nil || c.Status.clusterInfo.cluster.Identity == nil || c.Status.clusterInfo.cluster.Identity.Oidc == nil || c.Status.clusterInfo.cluster.Identity.Oidc.Issuer == nil {
 		return nil, &UnsupportedOIDCError{"unknown OIDC issuer URL"}
 	}
-	if !strings.HasPrefix(spec.Status.ARN, "arn:aws:eks:") {
+	parts := strings.Split(spec.Status.ARN, ":")
+	if api.ARNPartition(spec.Status.ARN) == "" || len(parts) < 6 || parts[2] != "eks" {
 		return nil, fmt.Errorf("unknown EKS ARN: %q", spec.Status.ARN)
 	}
-	accountID := strings.Split(spec.Status.ARN, ":")[4]
+	accountID := parts[4]
 	return iamoidc.NewOpenIDConnectManager(c.Provider.IAM(), accountID, *c.Status.clusterInfo.cluster.Identity.Oidc.Issuer)
 }
 
@@ -203,17 +218,21 @@ func (c *ClusterProvider) getClustersRequest(chunkSize int64, nextToken string)
 
 func (c *ClusterProvider) doListClusters(chunkSize int64, printer printers.OutputPrinter, allClusters *[]*api.ClusterMeta, eachRegion bool) error {
 	if eachRegion {
-		// reset region and re-create the client, then make a recursive call
-		for _, region := range api.SupportedRegions() {
-			spec := &api.ProviderConfig{
-				Region:      region,
-				Profile:     c.Provider.Profile(),
-				WaitTimeout: c.Provider.WaitTimeout(),
-			}
-			if err := New(spec, nil).doListClusters(chunkSize, printer, allClusters, false); err != nil {
-				logger.Critical("error listing clusters in %q region: %s", region, err.Error())
+		var mutex sync.Mutex
+		errs := c.ForEachRegion(c.EnabledRegions(), func(ctl *ClusterProvider) error {
+			clusters := []*api.ClusterMeta{}
+			if err := ctl.doListClusters(chunkSize, printer, &clusters, false); err != nil {
+				return err
 			}
+			mutex.Lock()
+			defer mutex.Unlock()
+			*allClusters = append(*allClusters, clusters...)
+			return nil
+		})
+		for region, err := range errs {
+			logger.Critical("error listing clusters in %q region: %s", region, err.Error())
 		}
+		sortClusters(*allClusters)
 		return nil
 	}
 
@@ -293,6 +312,8 @@ func (c *ClusterProvider) WaitForControlPlane(meta *api.ClusterMeta, clientSet *
 			logger.Debug("control plane not ready yet – %s", err.Error())
 		case <-timer.C:
 			return fmt.Errorf("timed out waiting for control plane %q after %s", meta.Name, c.Provider.WaitTimeout())
+		case <-c.Context().Done():
+			return errors.Wrapf(c.Context().Err(), "waiting for control plane %q", meta.Name)
 		}
 	}
 }
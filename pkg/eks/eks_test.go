@@ -9,14 +9,13 @@ import (
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	awseks "github.com/aws/aws-sdk-go/service/eks"
 
-	"github.com/kris-nova/logger"
-
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"github.com/stretchr/testify/mock"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/testutils"
 	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
 )
@@ -72,43 +71,18 @@ var _ = Describe("EKS API wrapper", func() {
 					Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeCluster", 1)).To(BeTrue())
 				})
 
-				It("should not call AWS CFN ListStacksPages", func() {
-					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ListStacksPages", 0)).To(BeTrue())
+				It("should not call AWS CFN DescribeStacksPages", func() {
+					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacksPages", 0)).To(BeTrue())
 				})
 			})
 
 			Context("and debug log level", func() {
 
 				BeforeEach(func() {
-					expectedStatusFilter := []string{
-						"CREATE_IN_PROGRESS",
-						"CREATE_FAILED",
-						"CREATE_COMPLETE",
-						"ROLLBACK_IN_PROGRESS",
-						"ROLLBACK_FAILED",
-						"ROLLBACK_COMPLETE",
-						"DELETE_IN_PROGRESS",
-						"DELETE_FAILED",
-						"UPDATE_IN_PROGRESS",
-						"UPDATE_COMPLETE_CLEANUP_IN_PROGRESS",
-						"UPDATE_COMPLETE",
-						"UPDATE_ROLLBACK_IN_PROGRESS",
-						"UPDATE_ROLLBACK_FAILED",
-						"UPDATE_ROLLBACK_COMPLETE_CLEANUP_IN_PROGRESS",
-						"UPDATE_ROLLBACK_COMPLETE",
-						"REVIEW_IN_PROGRESS",
-					}
-
 					logger.Level = 4
 
-					p.MockCloudFormation().On("ListStacksPages", mock.MatchedBy(func(input *cfn.ListStacksInput) bool {
-						matches := 0
-						for i := range input.StackStatusFilter {
-							if *input.StackStatusFilter[i] == expectedStatusFilter[i] {
-								matches++
-							}
-						}
-						return matches == len(expectedStatusFilter)
+					p.MockCloudFormation().On("DescribeStacksPages", mock.MatchedBy(func(input *cfn.DescribeStacksInput) bool {
+						return input.StackName == nil
 					}), mock.Anything).Return(nil)
 				})
 
@@ -124,8 +98,8 @@ var _ = Describe("EKS API wrapper", func() {
 					Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeCluster", 1)).To(BeTrue())
 				})
 
-				It("should have called AWS CFN ListStacksPages", func() {
-					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ListStacksPages", 1)).To(BeTrue())
+				It("should have called AWS CFN DescribeStacksPages", func() {
+					Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacksPages", 1)).To(BeTrue())
 				})
 			})
 		})
@@ -176,8 +150,8 @@ var _ = Describe("EKS API wrapper", func() {
 				Expect(p.MockEKS().AssertNumberOfCalls(GinkgoT(), "DescribeCluster", 1)).To(BeTrue())
 			})
 
-			It("should not call AWS CFN ListStacksPages", func() {
-				Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "ListStacksPages", 0)).To(BeTrue())
+			It("should not call AWS CFN DescribeStacksPages", func() {
+				Expect(p.MockCloudFormation().AssertNumberOfCalls(GinkgoT(), "DescribeStacksPages", 0)).To(BeTrue())
 			})
 
 			It("the output should equal the golden file singlecluster_deleting.golden", func() {
@@ -0,0 +1,84 @@
+package eks
+
+import (
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// endpointEnvVars are the environment variables that override the endpoints of services
+var endpointEnvVars = map[string]string{
+	"autoscaling":    "AWS_AUTOSCALING_ENDPOINT",
+	"cloudformation": "AWS_CLOUDFORMATION_ENDPOINT",
+	"cloudtrail":     "AWS_CLOUDTRAIL_ENDPOINT",
+	"cloudwatchlogs": "AWS_CLOUDWATCH_LOGS_ENDPOINT",
+	"ec2":            "AWS_EC2_ENDPOINT",
+	"eks":            "AWS_EKS_ENDPOINT",
+	"elb":            "AWS_ELB_ENDPOINT",
+	"elbv2":          "AWS_ELBV2_ENDPOINT",
+	"eventbridge":    "AWS_EVENTBRIDGE_ENDPOINT",
+	"iam":            "AWS_IAM_ENDPOINT",
+	"pricing":        "AWS_PRICING_ENDPOINT",
+	"s3":             "AWS_S3_ENDPOINT",
+	"servicequotas":  "AWS_SERVICEQUOTAS_ENDPOINT",
+	"ssm":            "AWS_SSM_ENDPOINT",
+	"sts":            "AWS_STS_ENDPOINT",
+}
+
+// endpointIDs are the IDs of the services in the endpoints model of the SDK
+var endpointIDs = map[string]string{
+	"autoscaling":    "autoscaling",
+	"cloudformation": "cloudformation",
+	"cloudtrail":     "cloudtrail",
+	"cloudwatchlogs": "logs",
+	"ec2":            "ec2",
+	"eks":            "eks",
+	"elb":            "elasticloadbalancing",
+	"elbv2":          "elasticloadbalancing",
+	"eventbridge":    "events",
+	"iam":            "iam",
+	"pricing":        "api.pricing",
+	"s3":             "s3",
+	"servicequotas":  "servicequotas",
+	"ssm":            "ssm",
+	"sts":            "sts",
+}
+
+// endpointConfig returns the config of the client of a service, with the endpoint that is set by its
+// environment variable or, failing that, by the provider config; otherwise the FIPS endpoint is used
+// when it's enabled and the service has one in the region
+func endpointConfig(s *session.Session, spec *api.ProviderConfig, service string) *aws.Config {
+	config := s.Config.Copy()
+	endpoint, ok := os.LookupEnv(endpointEnvVars[service])
+	if !ok {
+		endpoint, ok = spec.Endpoints[service]
+	}
+	if ok {
+		logger.Debug("setting %s endpoint to %s", service, endpoint)
+		return config.WithEndpoint(endpoint)
+	}
+	if spec.UseFIPSEndpoints {
+		region := aws.StringValue(config.Region)
+		if hasFIPSEndpoint(service, region) {
+			config.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+		} else {
+			logger.Debug("%s has no FIPS endpoint in region %s, using its standard endpoint", service, region)
+		}
+	}
+	return config
+}
+
+// hasFIPSEndpoint returns true if the endpoints model of the SDK has a FIPS endpoint of the service in region,
+// the SDK would otherwise make up a hostname that may not exist
+func hasFIPSEndpoint(service, region string) bool {
+	_, err := endpoints.DefaultResolver().EndpointFor(endpointIDs[service], region, func(o *endpoints.Options) {
+		o.UseFIPSEndpoint = endpoints.FIPSEndpointStateEnabled
+		o.StrictMatching = true
+	})
+	return err == nil
+}
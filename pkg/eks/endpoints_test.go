@@ -0,0 +1,63 @@
+package eks
+
+import (
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+var _ = Describe("endpoint overrides", func() {
+	var (
+		s    *session.Session
+		spec *api.ProviderConfig
+	)
+
+	BeforeEach(func() {
+		s = session.Must(session.NewSession(aws.NewConfig().WithRegion("us-west-2")))
+		spec = &api.ProviderConfig{
+			Endpoints: map[string]string{
+				"eks": "https://eks.vpce.example.com",
+				"sts": "https://sts.vpce.example.com",
+			},
+		}
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("AWS_STS_ENDPOINT")
+	})
+
+	It("uses the default endpoints of services that aren't overridden", func() {
+		Expect(endpointConfig(s, spec, "ec2").Endpoint).To(BeNil())
+	})
+
+	It("uses the endpoints of the provider config", func() {
+		Expect(*endpointConfig(s, spec, "eks").Endpoint).To(Equal("https://eks.vpce.example.com"))
+	})
+
+	It("gives precedence to the environment variables", func() {
+		os.Setenv("AWS_STS_ENDPOINT", "http://localhost:4566")
+		Expect(*endpointConfig(s, spec, "sts").Endpoint).To(Equal("http://localhost:4566"))
+	})
+
+	It("has an environment variable for every service that can be overridden", func() {
+		for _, service := range api.SupportedServiceEndpoints() {
+			Expect(endpointEnvVars).To(HaveKey(service))
+			Expect(endpointIDs).To(HaveKey(service))
+		}
+	})
+
+	It("uses the FIPS endpoints of services that have one", func() {
+		spec.UseFIPSEndpoints = true
+		Expect(endpointConfig(s, spec, "ec2").UseFIPSEndpoint).To(Equal(endpoints.FIPSEndpointStateEnabled))
+		Expect(endpointConfig(s, spec, "pricing").UseFIPSEndpoint).To(Equal(endpoints.FIPSEndpointStateUnset))
+		eks := endpointConfig(s, spec, "eks")
+		Expect(*eks.Endpoint).To(Equal("https://eks.vpce.example.com"))
+		Expect(eks.UseFIPSEndpoint).To(Equal(endpoints.FIPSEndpointStateUnset))
+	})
+})
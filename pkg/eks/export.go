@@ -0,0 +1,74 @@
+package eks
+
+import (
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// ExportClusterConfig reconstructs the config of a live cluster, including networking,
+// logging, IAM, nodegroups, Fargate profiles and addons, from its stacks and the EKS API;
+// clusters that weren't created by eksctl are loaded with LoadConfigFromControlPlane
+func (c *ClusterProvider) ExportClusterConfig(cfg *api.ClusterConfig) error {
+	stackManager := c.NewStackManager(cfg)
+
+	if clusterStack, err := stackManager.DescribeClusterStack(); err != nil {
+		logger.Info("cluster %q has no eksctl stack, loading its configuration from the EKS API", cfg.Metadata.Name)
+		if err := c.LoadConfigFromControlPlane(cfg); err != nil {
+			return err
+		}
+	} else {
+		if _, err := c.loadControlPlaneConfig(cfg); err != nil {
+			return err
+		}
+		if err := c.LoadClusterVPC(cfg); err != nil {
+			return errors.Wrapf(err, "getting VPC configuration for cluster %q", cfg.Metadata.Name)
+		}
+		if err := c.loadOIDCConfig(cfg); err != nil {
+			return err
+		}
+		if manager.IsDeletionProtected(clusterStack) {
+			cfg.Metadata.DeletionProtection = api.Enabled()
+		}
+	}
+
+	if _, err := stackManager.DescribeStacks(); err == nil {
+		if cfg.NodeGroups, err = stackManager.ExportNodeGroups(); err != nil {
+			return err
+		}
+		if api.IsEnabled(cfg.IAM.WithOIDC) {
+			if cfg.IAM.ServiceAccounts, err = stackManager.ExportIAMServiceAccounts(); err != nil {
+				return err
+			}
+		}
+	}
+
+	managedNodeGroups, err := stackManager.ExportManagedNodeGroups()
+	if err != nil {
+		return err
+	}
+	cfg.ManagedNodeGroups = managedNodeGroups
+
+	// Fargate and EKS Addons are not available on all platform versions,
+	// so the config is still useful without them
+	if cfg.FargateProfiles, err = c.NewFargateClient(cfg).ReadProfiles(); err != nil {
+		logger.Warning("not exporting Fargate profiles: %s", err.Error())
+	}
+
+	summaries, err := addons.NewEKSAddonManager(c.Provider, stackManager, nil, cfg.Metadata.Name).Get("")
+	if err != nil {
+		logger.Warning("not exporting addons: %s", err.Error())
+		return nil
+	}
+	for _, summary := range summaries {
+		cfg.Addons = append(cfg.Addons, &api.Addon{
+			Name:                  summary.Name,
+			Version:               summary.Version,
+			ServiceAccountRoleARN: summary.ServiceAccountRoleARN,
+		})
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// NewFargateClient returns a client for Fargate profiles of the cluster
+func (c *ClusterProvider) NewFargateClient(cfg *api.ClusterConfig) *fargate.Client {
+	return fargate.NewClient(cfg.Metadata.Name, c.Provider.EKS(), c.Provider.WaitTimeout())
+}
+
+// CreateFargateProfiles creates all Fargate profiles of the cluster, profiles without
+// a pod execution role use the one that is created along with the cluster; CoreDNS
+// gets scheduled onto Fargate when the cluster has no nodegroups for it to run on
+func (c *ClusterProvider) CreateFargateProfiles(cfg *api.ClusterConfig) error {
+	client := c.NewFargateClient(cfg)
+	for _, profile := range cfg.FargateProfiles {
+		if profile.PodExecutionRoleARN == "" && api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) {
+			profile.PodExecutionRoleARN = *cfg.IAM.FargatePodExecutionRoleARN
+		}
+		if err := client.CreateProfile(profile); err != nil {
+			return err
+		}
+	}
+
+	if cfg.HasNodeGroups() {
+		return nil
+	}
+	clientSet, err := c.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	scheduled, err := fargate.ScheduleCoreDNSOnFargate(cfg, clientSet)
+	if err != nil {
+		return err
+	}
+	if scheduled {
+		logger.Info("CoreDNS of cluster %q will be scheduled onto Fargate", cfg.Metadata.Name)
+	} else {
+		logger.Warning("cluster %q has no nodegroups and none of its Fargate profiles selects CoreDNS pods, they won't be scheduled", cfg.Metadata.Name)
+	}
+	return nil
+}
+
+// EnsureFargatePodExecutionRole sets the ARN of the pod execution role that Fargate profiles
+// of the cluster use by default, the role gets added to the cluster stack when it's not there yet
+func (c *ClusterProvider) EnsureFargatePodExecutionRole(cfg *api.ClusterConfig) error {
+	stackManager := c.NewStackManager(cfg)
+
+	collectRoles := func() error {
+		stack, err := stackManager.DescribeClusterStack()
+		if err != nil {
+			return err
+		}
+		return outputs.Collect(*stack, nil, map[string]outputs.Collector{
+			outputs.ClusterServiceRoleARN: func(v string) error {
+				cfg.IAM.ServiceRoleARN = &v
+				return nil
+			},
+			outputs.ClusterFargatePodExecutionRoleARN: func(v string) error {
+				cfg.IAM.FargatePodExecutionRoleARN = &v
+				return nil
+			},
+		})
+	}
+
+	if err := collectRoles(); err != nil {
+		return err
+	}
+	if api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) {
+		return nil
+	}
+
+	if err := c.LoadClusterVPC(cfg); err != nil {
+		return errors.Wrapf(err, "getting VPC configuration for cluster %q", cfg.Metadata.Name)
+	}
+	logger.Info("adding Fargate pod execution role to the stack of cluster %q", cfg.Metadata.Name)
+	if _, err := stackManager.AppendNewClusterStackResource(false); err != nil {
+		return err
+	}
+
+	if err := collectRoles(); err != nil {
+		return err
+	}
+	if !api.IsSetAndNonEmptyString(cfg.IAM.FargatePodExecutionRoleARN) {
+		return fmt.Errorf("stack of cluster %q doesn't have Fargate pod execution role", cfg.Metadata.Name)
+	}
+	return nil
+}
+
+// DeleteFargateProfiles deletes all Fargate profiles of the cluster,
+// which is needed before the cluster itself can be deleted
+func (c *ClusterProvider) DeleteFargateProfiles(cfg *api.ClusterConfig) error {
+	client := c.NewFargateClient(cfg)
+	profiles, err := client.ReadProfiles()
+	if err != nil {
+		return err
+	}
+	for _, profile := range profiles {
+		if err := client.DeleteProfile(profile.Name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
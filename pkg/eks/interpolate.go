@@ -0,0 +1,276 @@
+package eks
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+var (
+	placeholderPattern = regexp.MustCompile(`\$\{(env|ssm):([^}]+)\}`)
+	// numberPattern matches JSON numbers
+	numberPattern = regexp.MustCompile(`^-?(0|[1-9][0-9]*)(\.[0-9]+)?([eE][+-]?[0-9]+)?$`)
+
+	clusterConfigType = reflect.TypeOf(api.ClusterConfig{})
+	unmarshalerType   = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// ConfigInterpolation resolves ${env:VAR} and ${ssm:/path/param} placeholders in config files,
+// the file is parsed first and placeholders are only resolved in string values, so that resolved
+// values can't change the structure of the config
+type ConfigInterpolation struct {
+	// Lenient leaves placeholders that cannot be resolved in place with a warning,
+	// instead of failing
+	Lenient bool
+	// ProviderConfig sets the profile and region used to look up SSM parameters,
+	// when no region is set, metadata.region of the config file is used
+	ProviderConfig *api.ProviderConfig
+	// Provider is used to look up SSM parameters, if set
+	Provider api.ClusterProvider
+
+	// SecretValues are the values of SecureString SSM parameters that placeholders resolved to,
+	// they're set by Interpolate
+	SecretValues []string
+}
+
+// Interpolate replaces all placeholders in the string values of the given config file, and returns
+// the config as JSON when it had any
+func (i *ConfigInterpolation) Interpolate(data []byte) ([]byte, error) {
+	if !placeholderPattern.Match(data) {
+		return data, nil
+	}
+	jsonData, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, errors.Wrap(err, "parsing config file")
+	}
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(jsonData))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, errors.Wrap(err, "parsing config file")
+	}
+
+	unresolved := []string{}
+	resolve := func(kind string, get func(string) (string, error)) func(string, string, string) (string, bool) {
+		return func(placeholder, placeholderKind, name string) (string, bool) {
+			if placeholderKind != kind {
+				return "", false
+			}
+			value, err := get(name)
+			if err != nil {
+				unresolved = append(unresolved, fmt.Sprintf("%s: %s", placeholder, err.Error()))
+				return "", false
+			}
+			return value, true
+		}
+	}
+
+	// environment variables are resolved first, so that they can be used in metadata.region,
+	// which is needed to look up SSM parameters
+	document = interpolateValues(document, clusterConfigType, resolve("env", func(name string) (string, error) {
+		value, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf("environment variable %q is not set", name)
+		}
+		return value, nil
+	}))
+
+	if hasPlaceholders(document, "ssm") {
+		provider, err := i.ssmProvider(document)
+		if err != nil {
+			return nil, err
+		}
+		document = interpolateValues(document, clusterConfigType, resolve("ssm", func(name string) (string, error) {
+			output, err := provider.SSM().GetParameter(&ssm.GetParameterInput{
+				Name:           aws.String(name),
+				WithDecryption: aws.Bool(true),
+			})
+			if err != nil {
+				return "", errors.Wrapf(err, "getting SSM parameter %q", name)
+			}
+			value := aws.StringValue(output.Parameter.Value)
+			if aws.StringValue(output.Parameter.Type) == ssm.ParameterTypeSecureString && value != "" {
+				i.SecretValues = append(i.SecretValues, value)
+			}
+			return value, nil
+		}))
+	}
+
+	if len(unresolved) > 0 {
+		if !i.Lenient {
+			return nil, fmt.Errorf("cannot resolve placeholders in config file:\n%s", strings.Join(unresolved, "\n"))
+		}
+		for _, msg := range unresolved {
+			logger.Warning("leaving placeholder unresolved, %s", msg)
+		}
+	}
+	return json.Marshal(document)
+}
+
+// IsPlaceholder returns true when s is a single placeholder, e.g. ${ssm:/path/param}
+func IsPlaceholder(s string) bool {
+	match := placeholderPattern.FindString(s)
+	return match != "" && match == s
+}
+
+// interpolateValues resolves the placeholders in the string values of a document that was decoded
+// from JSON, keys are left alone; t is the type the document is decoded into, or nil when unknown
+func interpolateValues(document interface{}, t reflect.Type, resolve func(placeholder, kind, name string) (string, bool)) interface{} {
+	switch v := document.(type) {
+	case map[string]interface{}:
+		for key, value := range v {
+			v[key] = interpolateValues(value, fieldType(t, key), resolve)
+		}
+	case []interface{}:
+		elem := indirectType(t)
+		if elem != nil && (elem.Kind() == reflect.Slice || elem.Kind() == reflect.Array) {
+			elem = elem.Elem()
+		} else {
+			elem = nil
+		}
+		for i, value := range v {
+			v[i] = interpolateValues(value, elem, resolve)
+		}
+	case string:
+		return interpolateString(v, t, resolve)
+	}
+	return document
+}
+
+// interpolateString resolves the placeholders in a string value, the resolved values are always substituted
+// as text; only when a placeholder makes up the whole value of a field that takes a number or a boolean, and
+// resolves to one, it's used as such, so that e.g. `version: ${env:K8S_VERSION}` stays a string
+func interpolateString(s string, t reflect.Type, resolve func(placeholder, kind, name string) (string, bool)) interface{} {
+	if match := placeholderPattern.FindStringSubmatch(s); match != nil && match[0] == s {
+		value, ok := resolve(s, match[1], match[2])
+		if !ok {
+			return s
+		}
+		if t = indirectType(t); t == nil {
+			return value
+		}
+		switch t.Kind() {
+		case reflect.Bool:
+			if value == "true" || value == "false" {
+				return value == "true"
+			}
+		case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+			reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64,
+			reflect.Float32, reflect.Float64:
+			if numberPattern.MatchString(value) {
+				return json.Number(value)
+			}
+		}
+		return value
+	}
+	return placeholderPattern.ReplaceAllStringFunc(s, func(placeholder string) string {
+		match := placeholderPattern.FindStringSubmatch(placeholder)
+		if value, ok := resolve(placeholder, match[1], match[2]); ok {
+			return value
+		}
+		return placeholder
+	})
+}
+
+// fieldType returns the type of the value that key sets in a value of type t, or nil when unknown,
+// e.g. for types that decode themselves
+func fieldType(t reflect.Type, key string) reflect.Type {
+	if t = indirectType(t); t == nil {
+		return nil
+	}
+	switch t.Kind() {
+	case reflect.Map:
+		return t.Elem()
+	case reflect.Struct:
+		for i := 0; i < t.NumField(); i++ {
+			field := t.Field(i)
+			name := strings.Split(field.Tag.Get("json"), ",")[0]
+			switch {
+			case name == "-":
+				continue
+			case field.Anonymous && name == "":
+				if embedded := fieldType(field.Type, key); embedded != nil {
+					return embedded
+				}
+				continue
+			case name == "":
+				name = field.Name
+			}
+			if name == key && field.PkgPath == "" {
+				return field.Type
+			}
+		}
+	}
+	return nil
+}
+
+// indirectType returns the type that t points to, or nil when t is nil or decodes itself
+func indirectType(t reflect.Type) reflect.Type {
+	for t != nil && t.Kind() == reflect.Ptr {
+		t = t.Elem()
+	}
+	if t == nil || t.Kind() == reflect.Interface || reflect.PtrTo(t).Implements(unmarshalerType) {
+		return nil
+	}
+	return t
+}
+
+func hasPlaceholders(document interface{}, kind string) bool {
+	switch v := document.(type) {
+	case map[string]interface{}:
+		for _, value := range v {
+			if hasPlaceholders(value, kind) {
+				return true
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if hasPlaceholders(value, kind) {
+				return true
+			}
+		}
+	case string:
+		for _, match := range placeholderPattern.FindAllStringSubmatch(v, -1) {
+			if match[1] == kind {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (i *ConfigInterpolation) ssmProvider(document interface{}) (api.ClusterProvider, error) {
+	if i.Provider != nil {
+		return i.Provider, nil
+	}
+
+	spec := &api.ProviderConfig{}
+	if i.ProviderConfig != nil {
+		*spec = *i.ProviderConfig
+	}
+	if spec.Region == "" {
+		region := ""
+		if config, ok := document.(map[string]interface{}); ok {
+			if metadata, ok := config["metadata"].(map[string]interface{}); ok {
+				region, _ = metadata["region"].(string)
+			}
+		}
+		if placeholderPattern.MatchString(region) {
+			return nil, fmt.Errorf("metadata.region cannot be set from an SSM parameter")
+		}
+		spec.Region = region
+	}
+	return New(spec, nil).Provider, nil
+}
@@ -0,0 +1,122 @@
+package eks_test
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("config file interpolation", func() {
+	var (
+		p             *mockprovider.MockProvider
+		interpolation *ConfigInterpolation
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		interpolation = &ConfigInterpolation{Provider: p}
+
+		Expect(os.Setenv("EKSCTL_TEST_ENV", "prod")).To(Succeed())
+		Expect(os.Unsetenv("EKSCTL_TEST_UNSET")).To(Succeed())
+
+		p.MockSSM().On("GetParameter", mock.MatchedBy(func(input *ssm.GetParameterInput) bool {
+			return *input.Name == "/eks/ami" && *input.WithDecryption
+		})).Return(&ssm.GetParameterOutput{
+			Parameter: &ssm.Parameter{Value: aws.String("ami-123"), Type: aws.String(ssm.ParameterTypeString)},
+		}, nil)
+		p.MockSSM().On("GetParameter", mock.MatchedBy(func(input *ssm.GetParameterInput) bool {
+			return *input.Name == "/eks/token"
+		})).Return(&ssm.GetParameterOutput{
+			Parameter: &ssm.Parameter{Value: aws.String("s3cr3t"), Type: aws.String(ssm.ParameterTypeSecureString)},
+		}, nil)
+		p.MockSSM().On("GetParameter", mock.Anything).Return(nil, fmt.Errorf("ParameterNotFound"))
+	})
+
+	AfterEach(func() {
+		Expect(os.Unsetenv("EKSCTL_TEST_ENV")).To(Succeed())
+	})
+
+	It("resolves environment variables and SSM parameters", func() {
+		data, err := interpolation.Interpolate([]byte("name: cluster-${env:EKSCTL_TEST_ENV}\nami: ${ssm:/eks/ami}\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"name":"cluster-prod","ami":"ami-123"}`))
+		Expect(interpolation.SecretValues).To(BeEmpty())
+	})
+
+	It("leaves config files without placeholders as they are", func() {
+		data, err := interpolation.Interpolate([]byte("name: test # comment\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(data)).To(Equal("name: test # comment\n"))
+	})
+
+	It("leaves other text alone", func() {
+		data, err := interpolation.Interpolate([]byte("name: ${foo} $bar ${vault:x}\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"name":"${foo} $bar ${vault:x}"}`))
+		p.MockSSM().AssertNotCalled(GinkgoT(), "GetParameter", mock.Anything)
+	})
+
+	It("doesn't let resolved values change the structure of the config", func() {
+		Expect(os.Setenv("EKSCTL_TEST_ENV", "prod\n  privileged: true")).To(Succeed())
+		data, err := interpolation.Interpolate([]byte("metadata:\n  name: ${env:EKSCTL_TEST_ENV}\n  tags: {env: \"${env:EKSCTL_TEST_ENV}\"}\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"metadata":{"name":"prod\n  privileged: true","tags":{"env":"prod\n  privileged: true"}}}`))
+	})
+
+	It("uses numbers and booleans that make up the whole value of fields that take them as such", func() {
+		Expect(os.Setenv("EKSCTL_TEST_ENV", "3")).To(Succeed())
+		Expect(os.Setenv("EKSCTL_TEST_BOOL", "true")).To(Succeed())
+		defer os.Unsetenv("EKSCTL_TEST_BOOL")
+
+		data, err := interpolation.Interpolate([]byte("nodeGroups:\n- desiredCapacity: ${env:EKSCTL_TEST_ENV}\n  name: ng-${env:EKSCTL_TEST_ENV}\n  privateNetworking: \"${env:EKSCTL_TEST_BOOL}\"\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"nodeGroups":[{"desiredCapacity":3,"name":"ng-3","privateNetworking":true}]}`))
+	})
+
+	It("substitutes numbers and booleans as text in fields that take strings", func() {
+		Expect(os.Setenv("EKSCTL_TEST_ENV", "1.27")).To(Succeed())
+		Expect(os.Setenv("EKSCTL_TEST_BOOL", "true")).To(Succeed())
+		defer os.Unsetenv("EKSCTL_TEST_BOOL")
+
+		data, err := interpolation.Interpolate([]byte("metadata:\n  name: test\n  version: ${env:EKSCTL_TEST_ENV}\n  tags:\n    enabled: ${env:EKSCTL_TEST_BOOL}\nunknown: ${env:EKSCTL_TEST_ENV}\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"metadata":{"name":"test","version":"1.27","tags":{"enabled":"true"}},"unknown":"1.27"}`))
+	})
+
+	It("loads a config file with a numeric value in a string field", func() {
+		Expect(os.Setenv("EKSCTL_TEST_ENV", "1.27")).To(Succeed())
+
+		cfg, err := LoadConfigFromDocument("test.yaml", []byte("apiVersion: eksctl.io/v1alpha5\nkind: ClusterConfig\nmetadata:\n  name: test\n  region: us-west-2\n  version: ${env:EKSCTL_TEST_ENV}\n"), interpolation)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(cfg.Metadata.Version).To(Equal("1.27"))
+	})
+
+	It("records the values of SecureString parameters as secret", func() {
+		data, err := interpolation.Interpolate([]byte("token: ${ssm:/eks/token}\nami: ${ssm:/eks/ami}\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"token":"s3cr3t","ami":"ami-123"}`))
+		Expect(interpolation.SecretValues).To(Equal([]string{"s3cr3t"}))
+	})
+
+	It("fails on placeholders that cannot be resolved", func() {
+		_, err := interpolation.Interpolate([]byte("a: ${env:EKSCTL_TEST_UNSET}\nb: ${ssm:/missing}\n"))
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`${env:EKSCTL_TEST_UNSET}: environment variable "EKSCTL_TEST_UNSET" is not set`))
+		Expect(err.Error()).To(ContainSubstring(`${ssm:/missing}: getting SSM parameter "/missing"`))
+	})
+
+	It("leaves placeholders that cannot be resolved in place when lenient", func() {
+		interpolation.Lenient = true
+		data, err := interpolation.Interpolate([]byte("a: ${env:EKSCTL_TEST_UNSET}\nb: ${ssm:/eks/ami}\n"))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(data).To(MatchJSON(`{"a":"${env:EKSCTL_TEST_UNSET}","b":"ami-123"}`))
+	})
+})
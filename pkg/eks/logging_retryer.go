@@ -20,13 +20,11 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/kris-nova/logger"
-
 	"github.com/aws/aws-sdk-go/aws/client"
 	"github.com/aws/aws-sdk-go/aws/request"
-)
 
-const maxRetries = 13
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
 
 // LoggingRetryer adds some logging when we are retrying, so we have some idea what is happening
 // Right now it is very basic - e.g. it only logs when we retry (so doesn't log when we fail due to too many retries)
@@ -38,7 +36,7 @@ type LoggingRetryer struct {
 
 var _ request.Retryer = &LoggingRetryer{}
 
-func newLoggingRetryer() *LoggingRetryer {
+func newLoggingRetryer(maxRetries int) *LoggingRetryer {
 	return &LoggingRetryer{
 		client.DefaultRetryer{NumMaxRetries: maxRetries},
 	}
@@ -48,12 +46,7 @@ func newLoggingRetryer() *LoggingRetryer {
 func (l LoggingRetryer) RetryRules(r *request.Request) time.Duration {
 	duration := l.DefaultRetryer.RetryRules(r)
 
-	service := r.ClientInfo.ServiceName
-	name := "?"
-	if r.Operation != nil {
-		name = r.Operation.Name
-	}
-	methodDescription := service + "/" + name
+	methodDescription := r.ClientInfo.ServiceName + "/" + operationName(r)
 
 	var errorDescription string
 	if r.Error != nil {
@@ -67,3 +60,10 @@ func (l LoggingRetryer) RetryRules(r *request.Request) time.Duration {
 
 	return duration
 }
+
+func operationName(r *request.Request) string {
+	if r.Operation == nil {
+		return "?"
+	}
+	return r.Operation.Name
+}
@@ -3,7 +3,6 @@
 package mocks
 
 import cloudformation "github.com/aws/aws-sdk-go/service/cloudformation"
-
 import context "context"
 import mock "github.com/stretchr/testify/mock"
 import request "github.com/aws/aws-sdk-go/aws/request"
@@ -13,6 +12,240 @@ type CloudFormationAPI struct {
 	mock.Mock
 }
 
+// ActivateOrganizationsAccess provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ActivateOrganizationsAccess(_a0 *cloudformation.ActivateOrganizationsAccessInput) (*cloudformation.ActivateOrganizationsAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ActivateOrganizationsAccessOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ActivateOrganizationsAccessInput) *cloudformation.ActivateOrganizationsAccessOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ActivateOrganizationsAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ActivateOrganizationsAccessInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivateOrganizationsAccessRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ActivateOrganizationsAccessRequest(_a0 *cloudformation.ActivateOrganizationsAccessInput) (*request.Request, *cloudformation.ActivateOrganizationsAccessOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ActivateOrganizationsAccessInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ActivateOrganizationsAccessOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ActivateOrganizationsAccessInput) *cloudformation.ActivateOrganizationsAccessOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ActivateOrganizationsAccessOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ActivateOrganizationsAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ActivateOrganizationsAccessWithContext(_a0 context.Context, _a1 *cloudformation.ActivateOrganizationsAccessInput, _a2 ...request.Option) (*cloudformation.ActivateOrganizationsAccessOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ActivateOrganizationsAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ActivateOrganizationsAccessInput, ...request.Option) *cloudformation.ActivateOrganizationsAccessOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ActivateOrganizationsAccessOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ActivateOrganizationsAccessInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivateType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ActivateType(_a0 *cloudformation.ActivateTypeInput) (*cloudformation.ActivateTypeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ActivateTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ActivateTypeInput) *cloudformation.ActivateTypeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ActivateTypeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ActivateTypeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ActivateTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ActivateTypeRequest(_a0 *cloudformation.ActivateTypeInput) (*request.Request, *cloudformation.ActivateTypeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ActivateTypeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ActivateTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ActivateTypeInput) *cloudformation.ActivateTypeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ActivateTypeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ActivateTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ActivateTypeWithContext(_a0 context.Context, _a1 *cloudformation.ActivateTypeInput, _a2 ...request.Option) (*cloudformation.ActivateTypeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ActivateTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ActivateTypeInput, ...request.Option) *cloudformation.ActivateTypeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ActivateTypeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ActivateTypeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchDescribeTypeConfigurations provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) BatchDescribeTypeConfigurations(_a0 *cloudformation.BatchDescribeTypeConfigurationsInput) (*cloudformation.BatchDescribeTypeConfigurationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.BatchDescribeTypeConfigurationsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.BatchDescribeTypeConfigurationsInput) *cloudformation.BatchDescribeTypeConfigurationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.BatchDescribeTypeConfigurationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.BatchDescribeTypeConfigurationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BatchDescribeTypeConfigurationsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) BatchDescribeTypeConfigurationsRequest(_a0 *cloudformation.BatchDescribeTypeConfigurationsInput) (*request.Request, *cloudformation.BatchDescribeTypeConfigurationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.BatchDescribeTypeConfigurationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.BatchDescribeTypeConfigurationsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.BatchDescribeTypeConfigurationsInput) *cloudformation.BatchDescribeTypeConfigurationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.BatchDescribeTypeConfigurationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// BatchDescribeTypeConfigurationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) BatchDescribeTypeConfigurationsWithContext(_a0 context.Context, _a1 *cloudformation.BatchDescribeTypeConfigurationsInput, _a2 ...request.Option) (*cloudformation.BatchDescribeTypeConfigurationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.BatchDescribeTypeConfigurationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.BatchDescribeTypeConfigurationsInput, ...request.Option) *cloudformation.BatchDescribeTypeConfigurationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.BatchDescribeTypeConfigurationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.BatchDescribeTypeConfigurationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CancelUpdateStack provides a mock function with given fields: _a0
 func (_m *CloudFormationAPI) CancelUpdateStack(_a0 *cloudformation.CancelUpdateStackInput) (*cloudformation.CancelUpdateStackOutput, error) {
 	ret := _m.Called(_a0)
@@ -481,21 +714,21 @@ func (_m *CloudFormationAPI) CreateStackWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
-// DeleteChangeSet provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DeleteChangeSet(_a0 *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error) {
+// DeactivateOrganizationsAccess provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeactivateOrganizationsAccess(_a0 *cloudformation.DeactivateOrganizationsAccessInput) (*cloudformation.DeactivateOrganizationsAccessOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DeleteChangeSetOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteChangeSetInput) *cloudformation.DeleteChangeSetOutput); ok {
+	var r0 *cloudformation.DeactivateOrganizationsAccessOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeactivateOrganizationsAccessInput) *cloudformation.DeactivateOrganizationsAccessOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DeleteChangeSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DeactivateOrganizationsAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteChangeSetInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeactivateOrganizationsAccessInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -504,12 +737,12 @@ func (_m *CloudFormationAPI) DeleteChangeSet(_a0 *cloudformation.DeleteChangeSet
 	return r0, r1
 }
 
-// DeleteChangeSetRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DeleteChangeSetRequest(_a0 *cloudformation.DeleteChangeSetInput) (*request.Request, *cloudformation.DeleteChangeSetOutput) {
+// DeactivateOrganizationsAccessRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeactivateOrganizationsAccessRequest(_a0 *cloudformation.DeactivateOrganizationsAccessInput) (*request.Request, *cloudformation.DeactivateOrganizationsAccessOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteChangeSetInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeactivateOrganizationsAccessInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -517,20 +750,20 @@ func (_m *CloudFormationAPI) DeleteChangeSetRequest(_a0 *cloudformation.DeleteCh
 		}
 	}
 
-	var r1 *cloudformation.DeleteChangeSetOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteChangeSetInput) *cloudformation.DeleteChangeSetOutput); ok {
+	var r1 *cloudformation.DeactivateOrganizationsAccessOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeactivateOrganizationsAccessInput) *cloudformation.DeactivateOrganizationsAccessOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DeleteChangeSetOutput)
+			r1 = ret.Get(1).(*cloudformation.DeactivateOrganizationsAccessOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteChangeSetWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DeleteChangeSetWithContext(_a0 context.Context, _a1 *cloudformation.DeleteChangeSetInput, _a2 ...request.Option) (*cloudformation.DeleteChangeSetOutput, error) {
+// DeactivateOrganizationsAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DeactivateOrganizationsAccessWithContext(_a0 context.Context, _a1 *cloudformation.DeactivateOrganizationsAccessInput, _a2 ...request.Option) (*cloudformation.DeactivateOrganizationsAccessOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -540,17 +773,17 @@ func (_m *CloudFormationAPI) DeleteChangeSetWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DeleteChangeSetOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeleteChangeSetInput, ...request.Option) *cloudformation.DeleteChangeSetOutput); ok {
+	var r0 *cloudformation.DeactivateOrganizationsAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeactivateOrganizationsAccessInput, ...request.Option) *cloudformation.DeactivateOrganizationsAccessOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DeleteChangeSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DeactivateOrganizationsAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeleteChangeSetInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeactivateOrganizationsAccessInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -559,44 +792,21 @@ func (_m *CloudFormationAPI) DeleteChangeSetWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DeleteStack provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DeleteStack(_a0 *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *cloudformation.DeleteStackOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackInput) *cloudformation.DeleteStackOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DeleteStackOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DeleteStackInstances provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DeleteStackInstances(_a0 *cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error) {
+// DeactivateType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeactivateType(_a0 *cloudformation.DeactivateTypeInput) (*cloudformation.DeactivateTypeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DeleteStackInstancesOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackInstancesInput) *cloudformation.DeleteStackInstancesOutput); ok {
+	var r0 *cloudformation.DeactivateTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeactivateTypeInput) *cloudformation.DeactivateTypeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DeleteStackInstancesOutput)
+			r0 = ret.Get(0).(*cloudformation.DeactivateTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackInstancesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeactivateTypeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -605,12 +815,12 @@ func (_m *CloudFormationAPI) DeleteStackInstances(_a0 *cloudformation.DeleteStac
 	return r0, r1
 }
 
-// DeleteStackInstancesRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DeleteStackInstancesRequest(_a0 *cloudformation.DeleteStackInstancesInput) (*request.Request, *cloudformation.DeleteStackInstancesOutput) {
+// DeactivateTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeactivateTypeRequest(_a0 *cloudformation.DeactivateTypeInput) (*request.Request, *cloudformation.DeactivateTypeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackInstancesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeactivateTypeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -618,20 +828,20 @@ func (_m *CloudFormationAPI) DeleteStackInstancesRequest(_a0 *cloudformation.Del
 		}
 	}
 
-	var r1 *cloudformation.DeleteStackInstancesOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackInstancesInput) *cloudformation.DeleteStackInstancesOutput); ok {
+	var r1 *cloudformation.DeactivateTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeactivateTypeInput) *cloudformation.DeactivateTypeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DeleteStackInstancesOutput)
+			r1 = ret.Get(1).(*cloudformation.DeactivateTypeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteStackInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DeleteStackInstancesWithContext(_a0 context.Context, _a1 *cloudformation.DeleteStackInstancesInput, _a2 ...request.Option) (*cloudformation.DeleteStackInstancesOutput, error) {
+// DeactivateTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DeactivateTypeWithContext(_a0 context.Context, _a1 *cloudformation.DeactivateTypeInput, _a2 ...request.Option) (*cloudformation.DeactivateTypeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -641,17 +851,17 @@ func (_m *CloudFormationAPI) DeleteStackInstancesWithContext(_a0 context.Context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DeleteStackInstancesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeleteStackInstancesInput, ...request.Option) *cloudformation.DeleteStackInstancesOutput); ok {
+	var r0 *cloudformation.DeactivateTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeactivateTypeInput, ...request.Option) *cloudformation.DeactivateTypeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DeleteStackInstancesOutput)
+			r0 = ret.Get(0).(*cloudformation.DeactivateTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeleteStackInstancesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeactivateTypeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -660,46 +870,21 @@ func (_m *CloudFormationAPI) DeleteStackInstancesWithContext(_a0 context.Context
 	return r0, r1
 }
 
-// DeleteStackRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DeleteStackRequest(_a0 *cloudformation.DeleteStackInput) (*request.Request, *cloudformation.DeleteStackOutput) {
+// DeleteChangeSet provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeleteChangeSet(_a0 *cloudformation.DeleteChangeSetInput) (*cloudformation.DeleteChangeSetOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackInput) *request.Request); ok {
+	var r0 *cloudformation.DeleteChangeSetOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteChangeSetInput) *cloudformation.DeleteChangeSetOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
+			r0 = ret.Get(0).(*cloudformation.DeleteChangeSetOutput)
 		}
 	}
 
-	var r1 *cloudformation.DeleteStackOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackInput) *cloudformation.DeleteStackOutput); ok {
-		r1 = rf(_a0)
-	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DeleteStackOutput)
-		}
-	}
-
-	return r0, r1
-}
-
-// DeleteStackSet provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DeleteStackSet(_a0 *cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *cloudformation.DeleteStackSetOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackSetInput) *cloudformation.DeleteStackSetOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DeleteStackSetOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackSetInput) error); ok {
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteChangeSetInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -708,12 +893,12 @@ func (_m *CloudFormationAPI) DeleteStackSet(_a0 *cloudformation.DeleteStackSetIn
 	return r0, r1
 }
 
-// DeleteStackSetRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DeleteStackSetRequest(_a0 *cloudformation.DeleteStackSetInput) (*request.Request, *cloudformation.DeleteStackSetOutput) {
+// DeleteChangeSetRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeleteChangeSetRequest(_a0 *cloudformation.DeleteChangeSetInput) (*request.Request, *cloudformation.DeleteChangeSetOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackSetInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteChangeSetInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -721,20 +906,20 @@ func (_m *CloudFormationAPI) DeleteStackSetRequest(_a0 *cloudformation.DeleteSta
 		}
 	}
 
-	var r1 *cloudformation.DeleteStackSetOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackSetInput) *cloudformation.DeleteStackSetOutput); ok {
+	var r1 *cloudformation.DeleteChangeSetOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteChangeSetInput) *cloudformation.DeleteChangeSetOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DeleteStackSetOutput)
+			r1 = ret.Get(1).(*cloudformation.DeleteChangeSetOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteStackSetWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DeleteStackSetWithContext(_a0 context.Context, _a1 *cloudformation.DeleteStackSetInput, _a2 ...request.Option) (*cloudformation.DeleteStackSetOutput, error) {
+// DeleteChangeSetWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DeleteChangeSetWithContext(_a0 context.Context, _a1 *cloudformation.DeleteChangeSetInput, _a2 ...request.Option) (*cloudformation.DeleteChangeSetOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -744,17 +929,17 @@ func (_m *CloudFormationAPI) DeleteStackSetWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DeleteStackSetOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeleteStackSetInput, ...request.Option) *cloudformation.DeleteStackSetOutput); ok {
+	var r0 *cloudformation.DeleteChangeSetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeleteChangeSetInput, ...request.Option) *cloudformation.DeleteChangeSetOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DeleteStackSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DeleteChangeSetOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeleteStackSetInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeleteChangeSetInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -763,20 +948,13 @@ func (_m *CloudFormationAPI) DeleteStackSetWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DeleteStackWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DeleteStackWithContext(_a0 context.Context, _a1 *cloudformation.DeleteStackInput, _a2 ...request.Option) (*cloudformation.DeleteStackOutput, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
+// DeleteStack provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeleteStack(_a0 *cloudformation.DeleteStackInput) (*cloudformation.DeleteStackOutput, error) {
+	ret := _m.Called(_a0)
 
 	var r0 *cloudformation.DeleteStackOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeleteStackInput, ...request.Option) *cloudformation.DeleteStackOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackInput) *cloudformation.DeleteStackOutput); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*cloudformation.DeleteStackOutput)
@@ -784,8 +962,8 @@ func (_m *CloudFormationAPI) DeleteStackWithContext(_a0 context.Context, _a1 *cl
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeleteStackInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackInput) error); ok {
+		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -793,21 +971,21 @@ func (_m *CloudFormationAPI) DeleteStackWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
-// DescribeAccountLimits provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeAccountLimits(_a0 *cloudformation.DescribeAccountLimitsInput) (*cloudformation.DescribeAccountLimitsOutput, error) {
+// DeleteStackInstances provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeleteStackInstances(_a0 *cloudformation.DeleteStackInstancesInput) (*cloudformation.DeleteStackInstancesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeAccountLimitsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeAccountLimitsInput) *cloudformation.DescribeAccountLimitsOutput); ok {
+	var r0 *cloudformation.DeleteStackInstancesOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackInstancesInput) *cloudformation.DeleteStackInstancesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeAccountLimitsOutput)
+			r0 = ret.Get(0).(*cloudformation.DeleteStackInstancesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeAccountLimitsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackInstancesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -816,12 +994,12 @@ func (_m *CloudFormationAPI) DescribeAccountLimits(_a0 *cloudformation.DescribeA
 	return r0, r1
 }
 
-// DescribeAccountLimitsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeAccountLimitsRequest(_a0 *cloudformation.DescribeAccountLimitsInput) (*request.Request, *cloudformation.DescribeAccountLimitsOutput) {
+// DeleteStackInstancesRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeleteStackInstancesRequest(_a0 *cloudformation.DeleteStackInstancesInput) (*request.Request, *cloudformation.DeleteStackInstancesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeAccountLimitsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackInstancesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -829,20 +1007,20 @@ func (_m *CloudFormationAPI) DescribeAccountLimitsRequest(_a0 *cloudformation.De
 		}
 	}
 
-	var r1 *cloudformation.DescribeAccountLimitsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeAccountLimitsInput) *cloudformation.DescribeAccountLimitsOutput); ok {
+	var r1 *cloudformation.DeleteStackInstancesOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackInstancesInput) *cloudformation.DeleteStackInstancesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeAccountLimitsOutput)
+			r1 = ret.Get(1).(*cloudformation.DeleteStackInstancesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeAccountLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeAccountLimitsWithContext(_a0 context.Context, _a1 *cloudformation.DescribeAccountLimitsInput, _a2 ...request.Option) (*cloudformation.DescribeAccountLimitsOutput, error) {
+// DeleteStackInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DeleteStackInstancesWithContext(_a0 context.Context, _a1 *cloudformation.DeleteStackInstancesInput, _a2 ...request.Option) (*cloudformation.DeleteStackInstancesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -852,17 +1030,17 @@ func (_m *CloudFormationAPI) DescribeAccountLimitsWithContext(_a0 context.Contex
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeAccountLimitsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeAccountLimitsInput, ...request.Option) *cloudformation.DescribeAccountLimitsOutput); ok {
+	var r0 *cloudformation.DeleteStackInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeleteStackInstancesInput, ...request.Option) *cloudformation.DeleteStackInstancesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeAccountLimitsOutput)
+			r0 = ret.Get(0).(*cloudformation.DeleteStackInstancesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeAccountLimitsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeleteStackInstancesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -871,21 +1049,46 @@ func (_m *CloudFormationAPI) DescribeAccountLimitsWithContext(_a0 context.Contex
 	return r0, r1
 }
 
-// DescribeChangeSet provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeChangeSet(_a0 *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
+// DeleteStackRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeleteStackRequest(_a0 *cloudformation.DeleteStackInput) (*request.Request, *cloudformation.DeleteStackOutput) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeChangeSetOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeChangeSetInput) *cloudformation.DescribeChangeSetOutput); ok {
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeChangeSetOutput)
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.DeleteStackOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackInput) *cloudformation.DeleteStackOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.DeleteStackOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DeleteStackSet provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeleteStackSet(_a0 *cloudformation.DeleteStackSetInput) (*cloudformation.DeleteStackSetOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DeleteStackSetOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackSetInput) *cloudformation.DeleteStackSetOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DeleteStackSetOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeChangeSetInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackSetInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -894,12 +1097,12 @@ func (_m *CloudFormationAPI) DescribeChangeSet(_a0 *cloudformation.DescribeChang
 	return r0, r1
 }
 
-// DescribeChangeSetRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeChangeSetRequest(_a0 *cloudformation.DescribeChangeSetInput) (*request.Request, *cloudformation.DescribeChangeSetOutput) {
+// DeleteStackSetRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeleteStackSetRequest(_a0 *cloudformation.DeleteStackSetInput) (*request.Request, *cloudformation.DeleteStackSetOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeChangeSetInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeleteStackSetInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -907,20 +1110,20 @@ func (_m *CloudFormationAPI) DescribeChangeSetRequest(_a0 *cloudformation.Descri
 		}
 	}
 
-	var r1 *cloudformation.DescribeChangeSetOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeChangeSetInput) *cloudformation.DescribeChangeSetOutput); ok {
+	var r1 *cloudformation.DeleteStackSetOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeleteStackSetInput) *cloudformation.DeleteStackSetOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeChangeSetOutput)
+			r1 = ret.Get(1).(*cloudformation.DeleteStackSetOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeChangeSetWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeChangeSetWithContext(_a0 context.Context, _a1 *cloudformation.DescribeChangeSetInput, _a2 ...request.Option) (*cloudformation.DescribeChangeSetOutput, error) {
+// DeleteStackSetWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DeleteStackSetWithContext(_a0 context.Context, _a1 *cloudformation.DeleteStackSetInput, _a2 ...request.Option) (*cloudformation.DeleteStackSetOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -930,17 +1133,17 @@ func (_m *CloudFormationAPI) DescribeChangeSetWithContext(_a0 context.Context, _
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeChangeSetOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeChangeSetInput, ...request.Option) *cloudformation.DescribeChangeSetOutput); ok {
+	var r0 *cloudformation.DeleteStackSetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeleteStackSetInput, ...request.Option) *cloudformation.DeleteStackSetOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeChangeSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DeleteStackSetOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeChangeSetInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeleteStackSetInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -949,21 +1152,51 @@ func (_m *CloudFormationAPI) DescribeChangeSetWithContext(_a0 context.Context, _
 	return r0, r1
 }
 
-// DescribeStackDriftDetectionStatus provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatus(_a0 *cloudformation.DescribeStackDriftDetectionStatusInput) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+// DeleteStackWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DeleteStackWithContext(_a0 context.Context, _a1 *cloudformation.DeleteStackInput, _a2 ...request.Option) (*cloudformation.DeleteStackOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.DeleteStackOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeleteStackInput, ...request.Option) *cloudformation.DeleteStackOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DeleteStackOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeleteStackInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeregisterType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeregisterType(_a0 *cloudformation.DeregisterTypeInput) (*cloudformation.DeregisterTypeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeStackDriftDetectionStatusOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackDriftDetectionStatusInput) *cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+	var r0 *cloudformation.DeregisterTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeregisterTypeInput) *cloudformation.DeregisterTypeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackDriftDetectionStatusOutput)
+			r0 = ret.Get(0).(*cloudformation.DeregisterTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackDriftDetectionStatusInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeregisterTypeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -972,12 +1205,12 @@ func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatus(_a0 *cloudformati
 	return r0, r1
 }
 
-// DescribeStackDriftDetectionStatusRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatusRequest(_a0 *cloudformation.DescribeStackDriftDetectionStatusInput) (*request.Request, *cloudformation.DescribeStackDriftDetectionStatusOutput) {
+// DeregisterTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DeregisterTypeRequest(_a0 *cloudformation.DeregisterTypeInput) (*request.Request, *cloudformation.DeregisterTypeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackDriftDetectionStatusInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DeregisterTypeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -985,20 +1218,20 @@ func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatusRequest(_a0 *cloud
 		}
 	}
 
-	var r1 *cloudformation.DescribeStackDriftDetectionStatusOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackDriftDetectionStatusInput) *cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+	var r1 *cloudformation.DeregisterTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DeregisterTypeInput) *cloudformation.DeregisterTypeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStackDriftDetectionStatusOutput)
+			r1 = ret.Get(1).(*cloudformation.DeregisterTypeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackDriftDetectionStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatusWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackDriftDetectionStatusInput, _a2 ...request.Option) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
+// DeregisterTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DeregisterTypeWithContext(_a0 context.Context, _a1 *cloudformation.DeregisterTypeInput, _a2 ...request.Option) (*cloudformation.DeregisterTypeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1008,17 +1241,17 @@ func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatusWithContext(_a0 co
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackDriftDetectionStatusOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackDriftDetectionStatusInput, ...request.Option) *cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
+	var r0 *cloudformation.DeregisterTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DeregisterTypeInput, ...request.Option) *cloudformation.DeregisterTypeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackDriftDetectionStatusOutput)
+			r0 = ret.Get(0).(*cloudformation.DeregisterTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackDriftDetectionStatusInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DeregisterTypeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1027,21 +1260,21 @@ func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatusWithContext(_a0 co
 	return r0, r1
 }
 
-// DescribeStackEvents provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackEvents(_a0 *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error) {
+// DescribeAccountLimits provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeAccountLimits(_a0 *cloudformation.DescribeAccountLimitsInput) (*cloudformation.DescribeAccountLimitsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeStackEventsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackEventsInput) *cloudformation.DescribeStackEventsOutput); ok {
+	var r0 *cloudformation.DescribeAccountLimitsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeAccountLimitsInput) *cloudformation.DescribeAccountLimitsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackEventsOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeAccountLimitsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackEventsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeAccountLimitsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1050,12 +1283,12 @@ func (_m *CloudFormationAPI) DescribeStackEvents(_a0 *cloudformation.DescribeSta
 	return r0, r1
 }
 
-// DescribeStackEventsPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) DescribeStackEventsPages(_a0 *cloudformation.DescribeStackEventsInput, _a1 func(*cloudformation.DescribeStackEventsOutput, bool) bool) error {
+// DescribeAccountLimitsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) DescribeAccountLimitsPages(_a0 *cloudformation.DescribeAccountLimitsInput, _a1 func(*cloudformation.DescribeAccountLimitsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackEventsInput, func(*cloudformation.DescribeStackEventsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeAccountLimitsInput, func(*cloudformation.DescribeAccountLimitsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -1064,8 +1297,8 @@ func (_m *CloudFormationAPI) DescribeStackEventsPages(_a0 *cloudformation.Descri
 	return r0
 }
 
-// DescribeStackEventsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) DescribeStackEventsPagesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackEventsInput, _a2 func(*cloudformation.DescribeStackEventsOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeAccountLimitsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) DescribeAccountLimitsPagesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeAccountLimitsInput, _a2 func(*cloudformation.DescribeAccountLimitsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -1076,7 +1309,7 @@ func (_m *CloudFormationAPI) DescribeStackEventsPagesWithContext(_a0 context.Con
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackEventsInput, func(*cloudformation.DescribeStackEventsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeAccountLimitsInput, func(*cloudformation.DescribeAccountLimitsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -1085,12 +1318,12 @@ func (_m *CloudFormationAPI) DescribeStackEventsPagesWithContext(_a0 context.Con
 	return r0
 }
 
-// DescribeStackEventsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackEventsRequest(_a0 *cloudformation.DescribeStackEventsInput) (*request.Request, *cloudformation.DescribeStackEventsOutput) {
+// DescribeAccountLimitsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeAccountLimitsRequest(_a0 *cloudformation.DescribeAccountLimitsInput) (*request.Request, *cloudformation.DescribeAccountLimitsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackEventsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeAccountLimitsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1098,20 +1331,20 @@ func (_m *CloudFormationAPI) DescribeStackEventsRequest(_a0 *cloudformation.Desc
 		}
 	}
 
-	var r1 *cloudformation.DescribeStackEventsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackEventsInput) *cloudformation.DescribeStackEventsOutput); ok {
+	var r1 *cloudformation.DescribeAccountLimitsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeAccountLimitsInput) *cloudformation.DescribeAccountLimitsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStackEventsOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeAccountLimitsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackEventsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStackEventsWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackEventsInput, _a2 ...request.Option) (*cloudformation.DescribeStackEventsOutput, error) {
+// DescribeAccountLimitsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeAccountLimitsWithContext(_a0 context.Context, _a1 *cloudformation.DescribeAccountLimitsInput, _a2 ...request.Option) (*cloudformation.DescribeAccountLimitsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1121,17 +1354,17 @@ func (_m *CloudFormationAPI) DescribeStackEventsWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackEventsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackEventsInput, ...request.Option) *cloudformation.DescribeStackEventsOutput); ok {
+	var r0 *cloudformation.DescribeAccountLimitsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeAccountLimitsInput, ...request.Option) *cloudformation.DescribeAccountLimitsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackEventsOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeAccountLimitsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackEventsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeAccountLimitsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1140,21 +1373,21 @@ func (_m *CloudFormationAPI) DescribeStackEventsWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// DescribeStackInstance provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackInstance(_a0 *cloudformation.DescribeStackInstanceInput) (*cloudformation.DescribeStackInstanceOutput, error) {
+// DescribeChangeSet provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeChangeSet(_a0 *cloudformation.DescribeChangeSetInput) (*cloudformation.DescribeChangeSetOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeStackInstanceOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackInstanceInput) *cloudformation.DescribeStackInstanceOutput); ok {
+	var r0 *cloudformation.DescribeChangeSetOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeChangeSetInput) *cloudformation.DescribeChangeSetOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackInstanceOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeChangeSetOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackInstanceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeChangeSetInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1163,12 +1396,35 @@ func (_m *CloudFormationAPI) DescribeStackInstance(_a0 *cloudformation.DescribeS
 	return r0, r1
 }
 
-// DescribeStackInstanceRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackInstanceRequest(_a0 *cloudformation.DescribeStackInstanceInput) (*request.Request, *cloudformation.DescribeStackInstanceOutput) {
+// DescribeChangeSetHooks provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeChangeSetHooks(_a0 *cloudformation.DescribeChangeSetHooksInput) (*cloudformation.DescribeChangeSetHooksOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DescribeChangeSetHooksOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeChangeSetHooksInput) *cloudformation.DescribeChangeSetHooksOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DescribeChangeSetHooksOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeChangeSetHooksInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeChangeSetHooksRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeChangeSetHooksRequest(_a0 *cloudformation.DescribeChangeSetHooksInput) (*request.Request, *cloudformation.DescribeChangeSetHooksOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackInstanceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeChangeSetHooksInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1176,20 +1432,20 @@ func (_m *CloudFormationAPI) DescribeStackInstanceRequest(_a0 *cloudformation.De
 		}
 	}
 
-	var r1 *cloudformation.DescribeStackInstanceOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackInstanceInput) *cloudformation.DescribeStackInstanceOutput); ok {
+	var r1 *cloudformation.DescribeChangeSetHooksOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeChangeSetHooksInput) *cloudformation.DescribeChangeSetHooksOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStackInstanceOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeChangeSetHooksOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStackInstanceWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackInstanceInput, _a2 ...request.Option) (*cloudformation.DescribeStackInstanceOutput, error) {
+// DescribeChangeSetHooksWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeChangeSetHooksWithContext(_a0 context.Context, _a1 *cloudformation.DescribeChangeSetHooksInput, _a2 ...request.Option) (*cloudformation.DescribeChangeSetHooksOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1199,17 +1455,17 @@ func (_m *CloudFormationAPI) DescribeStackInstanceWithContext(_a0 context.Contex
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackInstanceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackInstanceInput, ...request.Option) *cloudformation.DescribeStackInstanceOutput); ok {
+	var r0 *cloudformation.DescribeChangeSetHooksOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeChangeSetHooksInput, ...request.Option) *cloudformation.DescribeChangeSetHooksOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackInstanceOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeChangeSetHooksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackInstanceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeChangeSetHooksInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1218,45 +1474,54 @@ func (_m *CloudFormationAPI) DescribeStackInstanceWithContext(_a0 context.Contex
 	return r0, r1
 }
 
-// DescribeStackResource provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackResource(_a0 *cloudformation.DescribeStackResourceInput) (*cloudformation.DescribeStackResourceOutput, error) {
+// DescribeChangeSetRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeChangeSetRequest(_a0 *cloudformation.DescribeChangeSetInput) (*request.Request, *cloudformation.DescribeChangeSetOutput) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeStackResourceOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceInput) *cloudformation.DescribeStackResourceOutput); ok {
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeChangeSetInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackResourceOutput)
+			r0 = ret.Get(0).(*request.Request)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourceInput) error); ok {
+	var r1 *cloudformation.DescribeChangeSetOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeChangeSetInput) *cloudformation.DescribeChangeSetOutput); ok {
 		r1 = rf(_a0)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.DescribeChangeSetOutput)
+		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackResourceDrifts provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackResourceDrifts(_a0 *cloudformation.DescribeStackResourceDriftsInput) (*cloudformation.DescribeStackResourceDriftsOutput, error) {
-	ret := _m.Called(_a0)
+// DescribeChangeSetWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeChangeSetWithContext(_a0 context.Context, _a1 *cloudformation.DescribeChangeSetInput, _a2 ...request.Option) (*cloudformation.DescribeChangeSetOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackResourceDriftsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceDriftsInput) *cloudformation.DescribeStackResourceDriftsOutput); ok {
-		r0 = rf(_a0)
+	var r0 *cloudformation.DescribeChangeSetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeChangeSetInput, ...request.Option) *cloudformation.DescribeChangeSetOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackResourceDriftsOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeChangeSetOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourceDriftsInput) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeChangeSetInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -1264,47 +1529,35 @@ func (_m *CloudFormationAPI) DescribeStackResourceDrifts(_a0 *cloudformation.Des
 	return r0, r1
 }
 
-// DescribeStackResourceDriftsPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) DescribeStackResourceDriftsPages(_a0 *cloudformation.DescribeStackResourceDriftsInput, _a1 func(*cloudformation.DescribeStackResourceDriftsOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// DescribeOrganizationsAccess provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeOrganizationsAccess(_a0 *cloudformation.DescribeOrganizationsAccessInput) (*cloudformation.DescribeOrganizationsAccessOutput, error) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceDriftsInput, func(*cloudformation.DescribeStackResourceDriftsOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *cloudformation.DescribeOrganizationsAccessOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeOrganizationsAccessInput) *cloudformation.DescribeOrganizationsAccessOutput); ok {
+		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// DescribeStackResourceDriftsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) DescribeStackResourceDriftsPagesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackResourceDriftsInput, _a2 func(*cloudformation.DescribeStackResourceDriftsOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DescribeOrganizationsAccessOutput)
+		}
 	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackResourceDriftsInput, func(*cloudformation.DescribeStackResourceDriftsOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeOrganizationsAccessInput) error); ok {
+		r1 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		r1 = ret.Error(1)
 	}
 
-	return r0
+	return r0, r1
 }
 
-// DescribeStackResourceDriftsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackResourceDriftsRequest(_a0 *cloudformation.DescribeStackResourceDriftsInput) (*request.Request, *cloudformation.DescribeStackResourceDriftsOutput) {
+// DescribeOrganizationsAccessRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeOrganizationsAccessRequest(_a0 *cloudformation.DescribeOrganizationsAccessInput) (*request.Request, *cloudformation.DescribeOrganizationsAccessOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceDriftsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeOrganizationsAccessInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1312,20 +1565,20 @@ func (_m *CloudFormationAPI) DescribeStackResourceDriftsRequest(_a0 *cloudformat
 		}
 	}
 
-	var r1 *cloudformation.DescribeStackResourceDriftsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourceDriftsInput) *cloudformation.DescribeStackResourceDriftsOutput); ok {
+	var r1 *cloudformation.DescribeOrganizationsAccessOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeOrganizationsAccessInput) *cloudformation.DescribeOrganizationsAccessOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStackResourceDriftsOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeOrganizationsAccessOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackResourceDriftsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStackResourceDriftsWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackResourceDriftsInput, _a2 ...request.Option) (*cloudformation.DescribeStackResourceDriftsOutput, error) {
+// DescribeOrganizationsAccessWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeOrganizationsAccessWithContext(_a0 context.Context, _a1 *cloudformation.DescribeOrganizationsAccessInput, _a2 ...request.Option) (*cloudformation.DescribeOrganizationsAccessOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1335,17 +1588,17 @@ func (_m *CloudFormationAPI) DescribeStackResourceDriftsWithContext(_a0 context.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackResourceDriftsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackResourceDriftsInput, ...request.Option) *cloudformation.DescribeStackResourceDriftsOutput); ok {
+	var r0 *cloudformation.DescribeOrganizationsAccessOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeOrganizationsAccessInput, ...request.Option) *cloudformation.DescribeOrganizationsAccessOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackResourceDriftsOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeOrganizationsAccessOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackResourceDriftsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeOrganizationsAccessInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1354,12 +1607,35 @@ func (_m *CloudFormationAPI) DescribeStackResourceDriftsWithContext(_a0 context.
 	return r0, r1
 }
 
-// DescribeStackResourceRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackResourceRequest(_a0 *cloudformation.DescribeStackResourceInput) (*request.Request, *cloudformation.DescribeStackResourceOutput) {
+// DescribePublisher provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribePublisher(_a0 *cloudformation.DescribePublisherInput) (*cloudformation.DescribePublisherOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DescribePublisherOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribePublisherInput) *cloudformation.DescribePublisherOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DescribePublisherOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribePublisherInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribePublisherRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribePublisherRequest(_a0 *cloudformation.DescribePublisherInput) (*request.Request, *cloudformation.DescribePublisherOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribePublisherInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1367,20 +1643,20 @@ func (_m *CloudFormationAPI) DescribeStackResourceRequest(_a0 *cloudformation.De
 		}
 	}
 
-	var r1 *cloudformation.DescribeStackResourceOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourceInput) *cloudformation.DescribeStackResourceOutput); ok {
+	var r1 *cloudformation.DescribePublisherOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribePublisherInput) *cloudformation.DescribePublisherOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStackResourceOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribePublisherOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStackResourceWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackResourceInput, _a2 ...request.Option) (*cloudformation.DescribeStackResourceOutput, error) {
+// DescribePublisherWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribePublisherWithContext(_a0 context.Context, _a1 *cloudformation.DescribePublisherInput, _a2 ...request.Option) (*cloudformation.DescribePublisherOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1390,17 +1666,17 @@ func (_m *CloudFormationAPI) DescribeStackResourceWithContext(_a0 context.Contex
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackResourceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackResourceInput, ...request.Option) *cloudformation.DescribeStackResourceOutput); ok {
+	var r0 *cloudformation.DescribePublisherOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribePublisherInput, ...request.Option) *cloudformation.DescribePublisherOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackResourceOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribePublisherOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackResourceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribePublisherInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1409,21 +1685,21 @@ func (_m *CloudFormationAPI) DescribeStackResourceWithContext(_a0 context.Contex
 	return r0, r1
 }
 
-// DescribeStackResources provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackResources(_a0 *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error) {
+// DescribeStackDriftDetectionStatus provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatus(_a0 *cloudformation.DescribeStackDriftDetectionStatusInput) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeStackResourcesOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourcesInput) *cloudformation.DescribeStackResourcesOutput); ok {
+	var r0 *cloudformation.DescribeStackDriftDetectionStatusOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackDriftDetectionStatusInput) *cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackResourcesOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackDriftDetectionStatusOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourcesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackDriftDetectionStatusInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1432,12 +1708,12 @@ func (_m *CloudFormationAPI) DescribeStackResources(_a0 *cloudformation.Describe
 	return r0, r1
 }
 
-// DescribeStackResourcesRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackResourcesRequest(_a0 *cloudformation.DescribeStackResourcesInput) (*request.Request, *cloudformation.DescribeStackResourcesOutput) {
+// DescribeStackDriftDetectionStatusRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatusRequest(_a0 *cloudformation.DescribeStackDriftDetectionStatusInput) (*request.Request, *cloudformation.DescribeStackDriftDetectionStatusOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourcesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackDriftDetectionStatusInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1445,20 +1721,20 @@ func (_m *CloudFormationAPI) DescribeStackResourcesRequest(_a0 *cloudformation.D
 		}
 	}
 
-	var r1 *cloudformation.DescribeStackResourcesOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourcesInput) *cloudformation.DescribeStackResourcesOutput); ok {
+	var r1 *cloudformation.DescribeStackDriftDetectionStatusOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackDriftDetectionStatusInput) *cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStackResourcesOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeStackDriftDetectionStatusOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStackResourcesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackResourcesInput, _a2 ...request.Option) (*cloudformation.DescribeStackResourcesOutput, error) {
+// DescribeStackDriftDetectionStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStackDriftDetectionStatusWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackDriftDetectionStatusInput, _a2 ...request.Option) (*cloudformation.DescribeStackDriftDetectionStatusOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1468,17 +1744,17 @@ func (_m *CloudFormationAPI) DescribeStackResourcesWithContext(_a0 context.Conte
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackResourcesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackResourcesInput, ...request.Option) *cloudformation.DescribeStackResourcesOutput); ok {
+	var r0 *cloudformation.DescribeStackDriftDetectionStatusOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackDriftDetectionStatusInput, ...request.Option) *cloudformation.DescribeStackDriftDetectionStatusOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackResourcesOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackDriftDetectionStatusOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackResourcesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackDriftDetectionStatusInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1487,21 +1763,21 @@ func (_m *CloudFormationAPI) DescribeStackResourcesWithContext(_a0 context.Conte
 	return r0, r1
 }
 
-// DescribeStackSet provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackSet(_a0 *cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error) {
+// DescribeStackEvents provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackEvents(_a0 *cloudformation.DescribeStackEventsInput) (*cloudformation.DescribeStackEventsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeStackSetOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackSetInput) *cloudformation.DescribeStackSetOutput); ok {
+	var r0 *cloudformation.DescribeStackEventsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackEventsInput) *cloudformation.DescribeStackEventsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackEventsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackSetInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackEventsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1510,35 +1786,47 @@ func (_m *CloudFormationAPI) DescribeStackSet(_a0 *cloudformation.DescribeStackS
 	return r0, r1
 }
 
-// DescribeStackSetOperation provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackSetOperation(_a0 *cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error) {
-	ret := _m.Called(_a0)
+// DescribeStackEventsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) DescribeStackEventsPages(_a0 *cloudformation.DescribeStackEventsInput, _a1 func(*cloudformation.DescribeStackEventsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *cloudformation.DescribeStackSetOperationOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackSetOperationInput) *cloudformation.DescribeStackSetOperationOutput); ok {
-		r0 = rf(_a0)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackEventsInput, func(*cloudformation.DescribeStackEventsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackSetOperationOutput)
-		}
+		r0 = ret.Error(0)
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackSetOperationInput) error); ok {
-		r1 = rf(_a0)
+	return r0
+}
+
+// DescribeStackEventsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) DescribeStackEventsPagesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackEventsInput, _a2 func(*cloudformation.DescribeStackEventsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackEventsInput, func(*cloudformation.DescribeStackEventsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// DescribeStackSetOperationRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackSetOperationRequest(_a0 *cloudformation.DescribeStackSetOperationInput) (*request.Request, *cloudformation.DescribeStackSetOperationOutput) {
+// DescribeStackEventsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackEventsRequest(_a0 *cloudformation.DescribeStackEventsInput) (*request.Request, *cloudformation.DescribeStackEventsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackSetOperationInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackEventsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1546,20 +1834,20 @@ func (_m *CloudFormationAPI) DescribeStackSetOperationRequest(_a0 *cloudformatio
 		}
 	}
 
-	var r1 *cloudformation.DescribeStackSetOperationOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackSetOperationInput) *cloudformation.DescribeStackSetOperationOutput); ok {
+	var r1 *cloudformation.DescribeStackEventsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackEventsInput) *cloudformation.DescribeStackEventsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStackSetOperationOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeStackEventsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackSetOperationWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStackSetOperationWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackSetOperationInput, _a2 ...request.Option) (*cloudformation.DescribeStackSetOperationOutput, error) {
+// DescribeStackEventsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStackEventsWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackEventsInput, _a2 ...request.Option) (*cloudformation.DescribeStackEventsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1569,17 +1857,17 @@ func (_m *CloudFormationAPI) DescribeStackSetOperationWithContext(_a0 context.Co
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackSetOperationOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackSetOperationInput, ...request.Option) *cloudformation.DescribeStackSetOperationOutput); ok {
+	var r0 *cloudformation.DescribeStackEventsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackEventsInput, ...request.Option) *cloudformation.DescribeStackEventsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackSetOperationOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackEventsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackSetOperationInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackEventsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1588,12 +1876,35 @@ func (_m *CloudFormationAPI) DescribeStackSetOperationWithContext(_a0 context.Co
 	return r0, r1
 }
 
-// DescribeStackSetRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStackSetRequest(_a0 *cloudformation.DescribeStackSetInput) (*request.Request, *cloudformation.DescribeStackSetOutput) {
+// DescribeStackInstance provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackInstance(_a0 *cloudformation.DescribeStackInstanceInput) (*cloudformation.DescribeStackInstanceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DescribeStackInstanceOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackInstanceInput) *cloudformation.DescribeStackInstanceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DescribeStackInstanceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackInstanceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeStackInstanceRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackInstanceRequest(_a0 *cloudformation.DescribeStackInstanceInput) (*request.Request, *cloudformation.DescribeStackInstanceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackSetInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackInstanceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1601,20 +1912,20 @@ func (_m *CloudFormationAPI) DescribeStackSetRequest(_a0 *cloudformation.Describ
 		}
 	}
 
-	var r1 *cloudformation.DescribeStackSetOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackSetInput) *cloudformation.DescribeStackSetOutput); ok {
+	var r1 *cloudformation.DescribeStackInstanceOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackInstanceInput) *cloudformation.DescribeStackInstanceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStackSetOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeStackInstanceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStackSetWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStackSetWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackSetInput, _a2 ...request.Option) (*cloudformation.DescribeStackSetOutput, error) {
+// DescribeStackInstanceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStackInstanceWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackInstanceInput, _a2 ...request.Option) (*cloudformation.DescribeStackInstanceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1624,17 +1935,17 @@ func (_m *CloudFormationAPI) DescribeStackSetWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStackSetOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackSetInput, ...request.Option) *cloudformation.DescribeStackSetOutput); ok {
+	var r0 *cloudformation.DescribeStackInstanceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackInstanceInput, ...request.Option) *cloudformation.DescribeStackInstanceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStackSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackInstanceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackSetInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackInstanceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1643,21 +1954,21 @@ func (_m *CloudFormationAPI) DescribeStackSetWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// DescribeStacks provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStacks(_a0 *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
+// DescribeStackResource provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackResource(_a0 *cloudformation.DescribeStackResourceInput) (*cloudformation.DescribeStackResourceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DescribeStacksOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput) *cloudformation.DescribeStacksOutput); ok {
+	var r0 *cloudformation.DescribeStackResourceOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceInput) *cloudformation.DescribeStackResourceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStacksOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStacksInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1666,12 +1977,35 @@ func (_m *CloudFormationAPI) DescribeStacks(_a0 *cloudformation.DescribeStacksIn
 	return r0, r1
 }
 
-// DescribeStacksPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) DescribeStacksPages(_a0 *cloudformation.DescribeStacksInput, _a1 func(*cloudformation.DescribeStacksOutput, bool) bool) error {
+// DescribeStackResourceDrifts provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackResourceDrifts(_a0 *cloudformation.DescribeStackResourceDriftsInput) (*cloudformation.DescribeStackResourceDriftsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DescribeStackResourceDriftsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceDriftsInput) *cloudformation.DescribeStackResourceDriftsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DescribeStackResourceDriftsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourceDriftsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeStackResourceDriftsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) DescribeStackResourceDriftsPages(_a0 *cloudformation.DescribeStackResourceDriftsInput, _a1 func(*cloudformation.DescribeStackResourceDriftsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput, func(*cloudformation.DescribeStacksOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceDriftsInput, func(*cloudformation.DescribeStackResourceDriftsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -1680,8 +2014,8 @@ func (_m *CloudFormationAPI) DescribeStacksPages(_a0 *cloudformation.DescribeSta
 	return r0
 }
 
-// DescribeStacksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) DescribeStacksPagesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStacksInput, _a2 func(*cloudformation.DescribeStacksOutput, bool) bool, _a3 ...request.Option) error {
+// DescribeStackResourceDriftsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) DescribeStackResourceDriftsPagesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackResourceDriftsInput, _a2 func(*cloudformation.DescribeStackResourceDriftsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -1692,7 +2026,7 @@ func (_m *CloudFormationAPI) DescribeStacksPagesWithContext(_a0 context.Context,
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStacksInput, func(*cloudformation.DescribeStacksOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackResourceDriftsInput, func(*cloudformation.DescribeStackResourceDriftsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -1701,12 +2035,12 @@ func (_m *CloudFormationAPI) DescribeStacksPagesWithContext(_a0 context.Context,
 	return r0
 }
 
-// DescribeStacksRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DescribeStacksRequest(_a0 *cloudformation.DescribeStacksInput) (*request.Request, *cloudformation.DescribeStacksOutput) {
+// DescribeStackResourceDriftsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackResourceDriftsRequest(_a0 *cloudformation.DescribeStackResourceDriftsInput) (*request.Request, *cloudformation.DescribeStackResourceDriftsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceDriftsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1714,20 +2048,20 @@ func (_m *CloudFormationAPI) DescribeStacksRequest(_a0 *cloudformation.DescribeS
 		}
 	}
 
-	var r1 *cloudformation.DescribeStacksOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStacksInput) *cloudformation.DescribeStacksOutput); ok {
+	var r1 *cloudformation.DescribeStackResourceDriftsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourceDriftsInput) *cloudformation.DescribeStackResourceDriftsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DescribeStacksOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeStackResourceDriftsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeStacksWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DescribeStacksWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStacksInput, _a2 ...request.Option) (*cloudformation.DescribeStacksOutput, error) {
+// DescribeStackResourceDriftsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStackResourceDriftsWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackResourceDriftsInput, _a2 ...request.Option) (*cloudformation.DescribeStackResourceDriftsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1737,17 +2071,17 @@ func (_m *CloudFormationAPI) DescribeStacksWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DescribeStacksOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStacksInput, ...request.Option) *cloudformation.DescribeStacksOutput); ok {
+	var r0 *cloudformation.DescribeStackResourceDriftsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackResourceDriftsInput, ...request.Option) *cloudformation.DescribeStackResourceDriftsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DescribeStacksOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackResourceDriftsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStacksInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackResourceDriftsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1756,35 +2090,12 @@ func (_m *CloudFormationAPI) DescribeStacksWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// DetectStackDrift provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DetectStackDrift(_a0 *cloudformation.DetectStackDriftInput) (*cloudformation.DetectStackDriftOutput, error) {
-	ret := _m.Called(_a0)
-
-	var r0 *cloudformation.DetectStackDriftOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackDriftInput) *cloudformation.DetectStackDriftOutput); ok {
-		r0 = rf(_a0)
-	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DetectStackDriftOutput)
-		}
-	}
-
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackDriftInput) error); ok {
-		r1 = rf(_a0)
-	} else {
-		r1 = ret.Error(1)
-	}
-
-	return r0, r1
-}
-
-// DetectStackDriftRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DetectStackDriftRequest(_a0 *cloudformation.DetectStackDriftInput) (*request.Request, *cloudformation.DetectStackDriftOutput) {
+// DescribeStackResourceRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackResourceRequest(_a0 *cloudformation.DescribeStackResourceInput) (*request.Request, *cloudformation.DescribeStackResourceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackDriftInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1792,20 +2103,20 @@ func (_m *CloudFormationAPI) DetectStackDriftRequest(_a0 *cloudformation.DetectS
 		}
 	}
 
-	var r1 *cloudformation.DetectStackDriftOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackDriftInput) *cloudformation.DetectStackDriftOutput); ok {
+	var r1 *cloudformation.DescribeStackResourceOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourceInput) *cloudformation.DescribeStackResourceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DetectStackDriftOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeStackResourceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DetectStackDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DetectStackDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackDriftOutput, error) {
+// DescribeStackResourceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStackResourceWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackResourceInput, _a2 ...request.Option) (*cloudformation.DescribeStackResourceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1815,17 +2126,17 @@ func (_m *CloudFormationAPI) DetectStackDriftWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DetectStackDriftOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackDriftInput, ...request.Option) *cloudformation.DetectStackDriftOutput); ok {
+	var r0 *cloudformation.DescribeStackResourceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackResourceInput, ...request.Option) *cloudformation.DescribeStackResourceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DetectStackDriftOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackResourceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackDriftInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackResourceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1834,21 +2145,21 @@ func (_m *CloudFormationAPI) DetectStackDriftWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// DetectStackResourceDrift provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DetectStackResourceDrift(_a0 *cloudformation.DetectStackResourceDriftInput) (*cloudformation.DetectStackResourceDriftOutput, error) {
+// DescribeStackResources provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackResources(_a0 *cloudformation.DescribeStackResourcesInput) (*cloudformation.DescribeStackResourcesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.DetectStackResourceDriftOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackResourceDriftInput) *cloudformation.DetectStackResourceDriftOutput); ok {
+	var r0 *cloudformation.DescribeStackResourcesOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourcesInput) *cloudformation.DescribeStackResourcesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DetectStackResourceDriftOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackResourcesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackResourceDriftInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourcesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1857,12 +2168,12 @@ func (_m *CloudFormationAPI) DetectStackResourceDrift(_a0 *cloudformation.Detect
 	return r0, r1
 }
 
-// DetectStackResourceDriftRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) DetectStackResourceDriftRequest(_a0 *cloudformation.DetectStackResourceDriftInput) (*request.Request, *cloudformation.DetectStackResourceDriftOutput) {
+// DescribeStackResourcesRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackResourcesRequest(_a0 *cloudformation.DescribeStackResourcesInput) (*request.Request, *cloudformation.DescribeStackResourcesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackResourceDriftInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackResourcesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -1870,20 +2181,20 @@ func (_m *CloudFormationAPI) DetectStackResourceDriftRequest(_a0 *cloudformation
 		}
 	}
 
-	var r1 *cloudformation.DetectStackResourceDriftOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackResourceDriftInput) *cloudformation.DetectStackResourceDriftOutput); ok {
+	var r1 *cloudformation.DescribeStackResourcesOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackResourcesInput) *cloudformation.DescribeStackResourcesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.DetectStackResourceDriftOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeStackResourcesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DetectStackResourceDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) DetectStackResourceDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackResourceDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackResourceDriftOutput, error) {
+// DescribeStackResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStackResourcesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackResourcesInput, _a2 ...request.Option) (*cloudformation.DescribeStackResourcesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -1893,17 +2204,17 @@ func (_m *CloudFormationAPI) DetectStackResourceDriftWithContext(_a0 context.Con
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.DetectStackResourceDriftOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...request.Option) *cloudformation.DetectStackResourceDriftOutput); ok {
+	var r0 *cloudformation.DescribeStackResourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackResourcesInput, ...request.Option) *cloudformation.DescribeStackResourcesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.DetectStackResourceDriftOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackResourcesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackResourcesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1912,21 +2223,21 @@ func (_m *CloudFormationAPI) DetectStackResourceDriftWithContext(_a0 context.Con
 	return r0, r1
 }
 
-// EstimateTemplateCost provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) EstimateTemplateCost(_a0 *cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error) {
+// DescribeStackSet provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackSet(_a0 *cloudformation.DescribeStackSetInput) (*cloudformation.DescribeStackSetOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.EstimateTemplateCostOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.EstimateTemplateCostInput) *cloudformation.EstimateTemplateCostOutput); ok {
+	var r0 *cloudformation.DescribeStackSetOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackSetInput) *cloudformation.DescribeStackSetOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.EstimateTemplateCostOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackSetOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.EstimateTemplateCostInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackSetInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -1935,77 +2246,77 @@ func (_m *CloudFormationAPI) EstimateTemplateCost(_a0 *cloudformation.EstimateTe
 	return r0, r1
 }
 
-// EstimateTemplateCostRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) EstimateTemplateCostRequest(_a0 *cloudformation.EstimateTemplateCostInput) (*request.Request, *cloudformation.EstimateTemplateCostOutput) {
+// DescribeStackSetOperation provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackSetOperation(_a0 *cloudformation.DescribeStackSetOperationInput) (*cloudformation.DescribeStackSetOperationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.EstimateTemplateCostInput) *request.Request); ok {
+	var r0 *cloudformation.DescribeStackSetOperationOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackSetOperationInput) *cloudformation.DescribeStackSetOperationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*request.Request)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackSetOperationOutput)
 		}
 	}
 
-	var r1 *cloudformation.EstimateTemplateCostOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.EstimateTemplateCostInput) *cloudformation.EstimateTemplateCostOutput); ok {
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackSetOperationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
-		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.EstimateTemplateCostOutput)
-		}
+		r1 = ret.Error(1)
 	}
 
 	return r0, r1
 }
 
-// EstimateTemplateCostWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) EstimateTemplateCostWithContext(_a0 context.Context, _a1 *cloudformation.EstimateTemplateCostInput, _a2 ...request.Option) (*cloudformation.EstimateTemplateCostOutput, error) {
-	_va := make([]interface{}, len(_a2))
-	for _i := range _a2 {
-		_va[_i] = _a2[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 *cloudformation.EstimateTemplateCostOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.EstimateTemplateCostInput, ...request.Option) *cloudformation.EstimateTemplateCostOutput); ok {
-		r0 = rf(_a0, _a1, _a2...)
+// DescribeStackSetOperationRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackSetOperationRequest(_a0 *cloudformation.DescribeStackSetOperationInput) (*request.Request, *cloudformation.DescribeStackSetOperationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackSetOperationInput) *request.Request); ok {
+		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.EstimateTemplateCostOutput)
+			r0 = ret.Get(0).(*request.Request)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.EstimateTemplateCostInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	var r1 *cloudformation.DescribeStackSetOperationOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackSetOperationInput) *cloudformation.DescribeStackSetOperationOutput); ok {
+		r1 = rf(_a0)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.DescribeStackSetOperationOutput)
+		}
 	}
 
 	return r0, r1
 }
 
-// ExecuteChangeSet provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ExecuteChangeSet(_a0 *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
-	ret := _m.Called(_a0)
+// DescribeStackSetOperationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStackSetOperationWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackSetOperationInput, _a2 ...request.Option) (*cloudformation.DescribeStackSetOperationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ExecuteChangeSetOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ExecuteChangeSetInput) *cloudformation.ExecuteChangeSetOutput); ok {
-		r0 = rf(_a0)
+	var r0 *cloudformation.DescribeStackSetOperationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackSetOperationInput, ...request.Option) *cloudformation.DescribeStackSetOperationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ExecuteChangeSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackSetOperationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ExecuteChangeSetInput) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackSetOperationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2013,12 +2324,12 @@ func (_m *CloudFormationAPI) ExecuteChangeSet(_a0 *cloudformation.ExecuteChangeS
 	return r0, r1
 }
 
-// ExecuteChangeSetRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ExecuteChangeSetRequest(_a0 *cloudformation.ExecuteChangeSetInput) (*request.Request, *cloudformation.ExecuteChangeSetOutput) {
+// DescribeStackSetRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStackSetRequest(_a0 *cloudformation.DescribeStackSetInput) (*request.Request, *cloudformation.DescribeStackSetOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ExecuteChangeSetInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStackSetInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2026,20 +2337,20 @@ func (_m *CloudFormationAPI) ExecuteChangeSetRequest(_a0 *cloudformation.Execute
 		}
 	}
 
-	var r1 *cloudformation.ExecuteChangeSetOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ExecuteChangeSetInput) *cloudformation.ExecuteChangeSetOutput); ok {
+	var r1 *cloudformation.DescribeStackSetOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStackSetInput) *cloudformation.DescribeStackSetOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ExecuteChangeSetOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeStackSetOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ExecuteChangeSetWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ExecuteChangeSetWithContext(_a0 context.Context, _a1 *cloudformation.ExecuteChangeSetInput, _a2 ...request.Option) (*cloudformation.ExecuteChangeSetOutput, error) {
+// DescribeStackSetWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStackSetWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStackSetInput, _a2 ...request.Option) (*cloudformation.DescribeStackSetOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2049,17 +2360,17 @@ func (_m *CloudFormationAPI) ExecuteChangeSetWithContext(_a0 context.Context, _a
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ExecuteChangeSetOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ExecuteChangeSetInput, ...request.Option) *cloudformation.ExecuteChangeSetOutput); ok {
+	var r0 *cloudformation.DescribeStackSetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStackSetInput, ...request.Option) *cloudformation.DescribeStackSetOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ExecuteChangeSetOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStackSetOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ExecuteChangeSetInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStackSetInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2068,21 +2379,21 @@ func (_m *CloudFormationAPI) ExecuteChangeSetWithContext(_a0 context.Context, _a
 	return r0, r1
 }
 
-// GetStackPolicy provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetStackPolicy(_a0 *cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error) {
+// DescribeStacks provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStacks(_a0 *cloudformation.DescribeStacksInput) (*cloudformation.DescribeStacksOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.GetStackPolicyOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetStackPolicyInput) *cloudformation.GetStackPolicyOutput); ok {
+	var r0 *cloudformation.DescribeStacksOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput) *cloudformation.DescribeStacksOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetStackPolicyOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStacksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.GetStackPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStacksInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2091,12 +2402,47 @@ func (_m *CloudFormationAPI) GetStackPolicy(_a0 *cloudformation.GetStackPolicyIn
 	return r0, r1
 }
 
-// GetStackPolicyRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetStackPolicyRequest(_a0 *cloudformation.GetStackPolicyInput) (*request.Request, *cloudformation.GetStackPolicyOutput) {
+// DescribeStacksPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) DescribeStacksPages(_a0 *cloudformation.DescribeStacksInput, _a1 func(*cloudformation.DescribeStacksOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput, func(*cloudformation.DescribeStacksOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeStacksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) DescribeStacksPagesWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStacksInput, _a2 func(*cloudformation.DescribeStacksOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStacksInput, func(*cloudformation.DescribeStacksOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DescribeStacksRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeStacksRequest(_a0 *cloudformation.DescribeStacksInput) (*request.Request, *cloudformation.DescribeStacksOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetStackPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2104,20 +2450,20 @@ func (_m *CloudFormationAPI) GetStackPolicyRequest(_a0 *cloudformation.GetStackP
 		}
 	}
 
-	var r1 *cloudformation.GetStackPolicyOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.GetStackPolicyInput) *cloudformation.GetStackPolicyOutput); ok {
+	var r1 *cloudformation.DescribeStacksOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeStacksInput) *cloudformation.DescribeStacksOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.GetStackPolicyOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeStacksOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetStackPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) GetStackPolicyWithContext(_a0 context.Context, _a1 *cloudformation.GetStackPolicyInput, _a2 ...request.Option) (*cloudformation.GetStackPolicyOutput, error) {
+// DescribeStacksWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeStacksWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStacksInput, _a2 ...request.Option) (*cloudformation.DescribeStacksOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2127,17 +2473,17 @@ func (_m *CloudFormationAPI) GetStackPolicyWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.GetStackPolicyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.GetStackPolicyInput, ...request.Option) *cloudformation.GetStackPolicyOutput); ok {
+	var r0 *cloudformation.DescribeStacksOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStacksInput, ...request.Option) *cloudformation.DescribeStacksOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetStackPolicyOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeStacksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetStackPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeStacksInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2146,21 +2492,21 @@ func (_m *CloudFormationAPI) GetStackPolicyWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// GetTemplate provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetTemplate(_a0 *cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error) {
+// DescribeType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeType(_a0 *cloudformation.DescribeTypeInput) (*cloudformation.DescribeTypeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.GetTemplateOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateInput) *cloudformation.GetTemplateOutput); ok {
+	var r0 *cloudformation.DescribeTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeInput) *cloudformation.DescribeTypeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetTemplateOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.GetTemplateInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeTypeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2169,12 +2515,35 @@ func (_m *CloudFormationAPI) GetTemplate(_a0 *cloudformation.GetTemplateInput) (
 	return r0, r1
 }
 
-// GetTemplateRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetTemplateRequest(_a0 *cloudformation.GetTemplateInput) (*request.Request, *cloudformation.GetTemplateOutput) {
+// DescribeTypeRegistration provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeTypeRegistration(_a0 *cloudformation.DescribeTypeRegistrationInput) (*cloudformation.DescribeTypeRegistrationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DescribeTypeRegistrationOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeRegistrationInput) *cloudformation.DescribeTypeRegistrationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DescribeTypeRegistrationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeTypeRegistrationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTypeRegistrationRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeTypeRegistrationRequest(_a0 *cloudformation.DescribeTypeRegistrationInput) (*request.Request, *cloudformation.DescribeTypeRegistrationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeRegistrationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2182,34 +2551,41 @@ func (_m *CloudFormationAPI) GetTemplateRequest(_a0 *cloudformation.GetTemplateI
 		}
 	}
 
-	var r1 *cloudformation.GetTemplateOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.GetTemplateInput) *cloudformation.GetTemplateOutput); ok {
+	var r1 *cloudformation.DescribeTypeRegistrationOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeTypeRegistrationInput) *cloudformation.DescribeTypeRegistrationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.GetTemplateOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeTypeRegistrationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetTemplateSummary provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetTemplateSummary(_a0 *cloudformation.GetTemplateSummaryInput) (*cloudformation.GetTemplateSummaryOutput, error) {
-	ret := _m.Called(_a0)
+// DescribeTypeRegistrationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeTypeRegistrationWithContext(_a0 context.Context, _a1 *cloudformation.DescribeTypeRegistrationInput, _a2 ...request.Option) (*cloudformation.DescribeTypeRegistrationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.GetTemplateSummaryOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateSummaryInput) *cloudformation.GetTemplateSummaryOutput); ok {
-		r0 = rf(_a0)
+	var r0 *cloudformation.DescribeTypeRegistrationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeTypeRegistrationInput, ...request.Option) *cloudformation.DescribeTypeRegistrationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetTemplateSummaryOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeTypeRegistrationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.GetTemplateSummaryInput) error); ok {
-		r1 = rf(_a0)
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeTypeRegistrationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -2217,12 +2593,12 @@ func (_m *CloudFormationAPI) GetTemplateSummary(_a0 *cloudformation.GetTemplateS
 	return r0, r1
 }
 
-// GetTemplateSummaryRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) GetTemplateSummaryRequest(_a0 *cloudformation.GetTemplateSummaryInput) (*request.Request, *cloudformation.GetTemplateSummaryOutput) {
+// DescribeTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DescribeTypeRequest(_a0 *cloudformation.DescribeTypeInput) (*request.Request, *cloudformation.DescribeTypeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateSummaryInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2230,20 +2606,20 @@ func (_m *CloudFormationAPI) GetTemplateSummaryRequest(_a0 *cloudformation.GetTe
 		}
 	}
 
-	var r1 *cloudformation.GetTemplateSummaryOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.GetTemplateSummaryInput) *cloudformation.GetTemplateSummaryOutput); ok {
+	var r1 *cloudformation.DescribeTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DescribeTypeInput) *cloudformation.DescribeTypeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.GetTemplateSummaryOutput)
+			r1 = ret.Get(1).(*cloudformation.DescribeTypeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetTemplateSummaryWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) GetTemplateSummaryWithContext(_a0 context.Context, _a1 *cloudformation.GetTemplateSummaryInput, _a2 ...request.Option) (*cloudformation.GetTemplateSummaryOutput, error) {
+// DescribeTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DescribeTypeWithContext(_a0 context.Context, _a1 *cloudformation.DescribeTypeInput, _a2 ...request.Option) (*cloudformation.DescribeTypeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2253,27 +2629,1794 @@ func (_m *CloudFormationAPI) GetTemplateSummaryWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.GetTemplateSummaryOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.GetTemplateSummaryInput, ...request.Option) *cloudformation.GetTemplateSummaryOutput); ok {
+	var r0 *cloudformation.DescribeTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeTypeInput, ...request.Option) *cloudformation.DescribeTypeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetTemplateSummaryOutput)
+			r0 = ret.Get(0).(*cloudformation.DescribeTypeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DescribeTypeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetectStackDrift provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackDrift(_a0 *cloudformation.DetectStackDriftInput) (*cloudformation.DetectStackDriftOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DetectStackDriftOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackDriftInput) *cloudformation.DetectStackDriftOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DetectStackDriftOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackDriftInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetectStackDriftRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackDriftRequest(_a0 *cloudformation.DetectStackDriftInput) (*request.Request, *cloudformation.DetectStackDriftOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackDriftInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.DetectStackDriftOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackDriftInput) *cloudformation.DetectStackDriftOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.DetectStackDriftOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DetectStackDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DetectStackDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackDriftOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.DetectStackDriftOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackDriftInput, ...request.Option) *cloudformation.DetectStackDriftOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DetectStackDriftOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackDriftInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetectStackResourceDrift provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackResourceDrift(_a0 *cloudformation.DetectStackResourceDriftInput) (*cloudformation.DetectStackResourceDriftOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DetectStackResourceDriftOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackResourceDriftInput) *cloudformation.DetectStackResourceDriftOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DetectStackResourceDriftOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackResourceDriftInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetectStackResourceDriftRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackResourceDriftRequest(_a0 *cloudformation.DetectStackResourceDriftInput) (*request.Request, *cloudformation.DetectStackResourceDriftOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackResourceDriftInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.DetectStackResourceDriftOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackResourceDriftInput) *cloudformation.DetectStackResourceDriftOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.DetectStackResourceDriftOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DetectStackResourceDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DetectStackResourceDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackResourceDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackResourceDriftOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.DetectStackResourceDriftOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...request.Option) *cloudformation.DetectStackResourceDriftOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DetectStackResourceDriftOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackResourceDriftInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetectStackSetDrift provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackSetDrift(_a0 *cloudformation.DetectStackSetDriftInput) (*cloudformation.DetectStackSetDriftOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.DetectStackSetDriftOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackSetDriftInput) *cloudformation.DetectStackSetDriftOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DetectStackSetDriftOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackSetDriftInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DetectStackSetDriftRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) DetectStackSetDriftRequest(_a0 *cloudformation.DetectStackSetDriftInput) (*request.Request, *cloudformation.DetectStackSetDriftOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.DetectStackSetDriftInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.DetectStackSetDriftOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.DetectStackSetDriftInput) *cloudformation.DetectStackSetDriftOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.DetectStackSetDriftOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DetectStackSetDriftWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) DetectStackSetDriftWithContext(_a0 context.Context, _a1 *cloudformation.DetectStackSetDriftInput, _a2 ...request.Option) (*cloudformation.DetectStackSetDriftOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.DetectStackSetDriftOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DetectStackSetDriftInput, ...request.Option) *cloudformation.DetectStackSetDriftOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.DetectStackSetDriftOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.DetectStackSetDriftInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EstimateTemplateCost provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) EstimateTemplateCost(_a0 *cloudformation.EstimateTemplateCostInput) (*cloudformation.EstimateTemplateCostOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.EstimateTemplateCostOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.EstimateTemplateCostInput) *cloudformation.EstimateTemplateCostOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.EstimateTemplateCostOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.EstimateTemplateCostInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EstimateTemplateCostRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) EstimateTemplateCostRequest(_a0 *cloudformation.EstimateTemplateCostInput) (*request.Request, *cloudformation.EstimateTemplateCostOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.EstimateTemplateCostInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.EstimateTemplateCostOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.EstimateTemplateCostInput) *cloudformation.EstimateTemplateCostOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.EstimateTemplateCostOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// EstimateTemplateCostWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) EstimateTemplateCostWithContext(_a0 context.Context, _a1 *cloudformation.EstimateTemplateCostInput, _a2 ...request.Option) (*cloudformation.EstimateTemplateCostOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.EstimateTemplateCostOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.EstimateTemplateCostInput, ...request.Option) *cloudformation.EstimateTemplateCostOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.EstimateTemplateCostOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.EstimateTemplateCostInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteChangeSet provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ExecuteChangeSet(_a0 *cloudformation.ExecuteChangeSetInput) (*cloudformation.ExecuteChangeSetOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ExecuteChangeSetOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ExecuteChangeSetInput) *cloudformation.ExecuteChangeSetOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ExecuteChangeSetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ExecuteChangeSetInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExecuteChangeSetRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ExecuteChangeSetRequest(_a0 *cloudformation.ExecuteChangeSetInput) (*request.Request, *cloudformation.ExecuteChangeSetOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ExecuteChangeSetInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ExecuteChangeSetOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ExecuteChangeSetInput) *cloudformation.ExecuteChangeSetOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ExecuteChangeSetOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ExecuteChangeSetWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ExecuteChangeSetWithContext(_a0 context.Context, _a1 *cloudformation.ExecuteChangeSetInput, _a2 ...request.Option) (*cloudformation.ExecuteChangeSetOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ExecuteChangeSetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ExecuteChangeSetInput, ...request.Option) *cloudformation.ExecuteChangeSetOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ExecuteChangeSetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ExecuteChangeSetInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStackPolicy provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetStackPolicy(_a0 *cloudformation.GetStackPolicyInput) (*cloudformation.GetStackPolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.GetStackPolicyOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetStackPolicyInput) *cloudformation.GetStackPolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.GetStackPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.GetStackPolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetStackPolicyRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetStackPolicyRequest(_a0 *cloudformation.GetStackPolicyInput) (*request.Request, *cloudformation.GetStackPolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetStackPolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.GetStackPolicyOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.GetStackPolicyInput) *cloudformation.GetStackPolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.GetStackPolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetStackPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) GetStackPolicyWithContext(_a0 context.Context, _a1 *cloudformation.GetStackPolicyInput, _a2 ...request.Option) (*cloudformation.GetStackPolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.GetStackPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.GetStackPolicyInput, ...request.Option) *cloudformation.GetStackPolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.GetStackPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetStackPolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplate provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetTemplate(_a0 *cloudformation.GetTemplateInput) (*cloudformation.GetTemplateOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.GetTemplateOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateInput) *cloudformation.GetTemplateOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.GetTemplateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.GetTemplateInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplateRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetTemplateRequest(_a0 *cloudformation.GetTemplateInput) (*request.Request, *cloudformation.GetTemplateOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.GetTemplateOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.GetTemplateInput) *cloudformation.GetTemplateOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.GetTemplateOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTemplateSummary provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetTemplateSummary(_a0 *cloudformation.GetTemplateSummaryInput) (*cloudformation.GetTemplateSummaryOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.GetTemplateSummaryOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateSummaryInput) *cloudformation.GetTemplateSummaryOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.GetTemplateSummaryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.GetTemplateSummaryInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplateSummaryRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) GetTemplateSummaryRequest(_a0 *cloudformation.GetTemplateSummaryInput) (*request.Request, *cloudformation.GetTemplateSummaryOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.GetTemplateSummaryInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.GetTemplateSummaryOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.GetTemplateSummaryInput) *cloudformation.GetTemplateSummaryOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.GetTemplateSummaryOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTemplateSummaryWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) GetTemplateSummaryWithContext(_a0 context.Context, _a1 *cloudformation.GetTemplateSummaryInput, _a2 ...request.Option) (*cloudformation.GetTemplateSummaryOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.GetTemplateSummaryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.GetTemplateSummaryInput, ...request.Option) *cloudformation.GetTemplateSummaryOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.GetTemplateSummaryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetTemplateSummaryInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTemplateWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) GetTemplateWithContext(_a0 context.Context, _a1 *cloudformation.GetTemplateInput, _a2 ...request.Option) (*cloudformation.GetTemplateOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.GetTemplateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.GetTemplateInput, ...request.Option) *cloudformation.GetTemplateOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.GetTemplateOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetTemplateInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportStacksToStackSet provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ImportStacksToStackSet(_a0 *cloudformation.ImportStacksToStackSetInput) (*cloudformation.ImportStacksToStackSetOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ImportStacksToStackSetOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ImportStacksToStackSetInput) *cloudformation.ImportStacksToStackSetOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ImportStacksToStackSetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ImportStacksToStackSetInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportStacksToStackSetRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ImportStacksToStackSetRequest(_a0 *cloudformation.ImportStacksToStackSetInput) (*request.Request, *cloudformation.ImportStacksToStackSetOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ImportStacksToStackSetInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ImportStacksToStackSetOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ImportStacksToStackSetInput) *cloudformation.ImportStacksToStackSetOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ImportStacksToStackSetOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ImportStacksToStackSetWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ImportStacksToStackSetWithContext(_a0 context.Context, _a1 *cloudformation.ImportStacksToStackSetInput, _a2 ...request.Option) (*cloudformation.ImportStacksToStackSetOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ImportStacksToStackSetOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ImportStacksToStackSetInput, ...request.Option) *cloudformation.ImportStacksToStackSetOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ImportStacksToStackSetOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ImportStacksToStackSetInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChangeSets provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListChangeSets(_a0 *cloudformation.ListChangeSetsInput) (*cloudformation.ListChangeSetsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListChangeSetsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput) *cloudformation.ListChangeSetsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListChangeSetsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListChangeSetsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChangeSetsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListChangeSetsPages(_a0 *cloudformation.ListChangeSetsInput, _a1 func(*cloudformation.ListChangeSetsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput, func(*cloudformation.ListChangeSetsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListChangeSetsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListChangeSetsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListChangeSetsInput, _a2 func(*cloudformation.ListChangeSetsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListChangeSetsInput, func(*cloudformation.ListChangeSetsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListChangeSetsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListChangeSetsRequest(_a0 *cloudformation.ListChangeSetsInput) (*request.Request, *cloudformation.ListChangeSetsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListChangeSetsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListChangeSetsInput) *cloudformation.ListChangeSetsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListChangeSetsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListChangeSetsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListChangeSetsWithContext(_a0 context.Context, _a1 *cloudformation.ListChangeSetsInput, _a2 ...request.Option) (*cloudformation.ListChangeSetsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListChangeSetsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListChangeSetsInput, ...request.Option) *cloudformation.ListChangeSetsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListChangeSetsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListChangeSetsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExports provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListExports(_a0 *cloudformation.ListExportsInput) (*cloudformation.ListExportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput) *cloudformation.ListExportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListExportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListExportsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListExportsPages(_a0 *cloudformation.ListExportsInput, _a1 func(*cloudformation.ListExportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput, func(*cloudformation.ListExportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListExportsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListExportsInput, _a2 func(*cloudformation.ListExportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListExportsInput, func(*cloudformation.ListExportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListExportsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListExportsRequest(_a0 *cloudformation.ListExportsInput) (*request.Request, *cloudformation.ListExportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListExportsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListExportsInput) *cloudformation.ListExportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListExportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListExportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListExportsWithContext(_a0 context.Context, _a1 *cloudformation.ListExportsInput, _a2 ...request.Option) (*cloudformation.ListExportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListExportsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListExportsInput, ...request.Option) *cloudformation.ListExportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListExportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListExportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImports provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListImports(_a0 *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput) *cloudformation.ListImportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListImportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImportsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListImportsPages(_a0 *cloudformation.ListImportsInput, _a1 func(*cloudformation.ListImportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput, func(*cloudformation.ListImportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListImportsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListImportsInput, _a2 func(*cloudformation.ListImportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListImportsInput, func(*cloudformation.ListImportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListImportsRequest(_a0 *cloudformation.ListImportsInput) (*request.Request, *cloudformation.ListImportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListImportsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListImportsInput) *cloudformation.ListImportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListImportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListImportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListImportsWithContext(_a0 context.Context, _a1 *cloudformation.ListImportsInput, _a2 ...request.Option) (*cloudformation.ListImportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListImportsInput, ...request.Option) *cloudformation.ListImportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListImportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackInstanceResourceDrifts provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackInstanceResourceDrifts(_a0 *cloudformation.ListStackInstanceResourceDriftsInput) (*cloudformation.ListStackInstanceResourceDriftsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackInstanceResourceDriftsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstanceResourceDriftsInput) *cloudformation.ListStackInstanceResourceDriftsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackInstanceResourceDriftsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstanceResourceDriftsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackInstanceResourceDriftsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackInstanceResourceDriftsRequest(_a0 *cloudformation.ListStackInstanceResourceDriftsInput) (*request.Request, *cloudformation.ListStackInstanceResourceDriftsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstanceResourceDriftsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackInstanceResourceDriftsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstanceResourceDriftsInput) *cloudformation.ListStackInstanceResourceDriftsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackInstanceResourceDriftsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackInstanceResourceDriftsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackInstanceResourceDriftsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackInstanceResourceDriftsInput, _a2 ...request.Option) (*cloudformation.ListStackInstanceResourceDriftsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackInstanceResourceDriftsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackInstanceResourceDriftsInput, ...request.Option) *cloudformation.ListStackInstanceResourceDriftsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackInstanceResourceDriftsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackInstanceResourceDriftsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackInstances provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackInstances(_a0 *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackInstancesOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput) *cloudformation.ListStackInstancesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstancesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackInstancesPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackInstancesPages(_a0 *cloudformation.ListStackInstancesInput, _a1 func(*cloudformation.ListStackInstancesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput, func(*cloudformation.ListStackInstancesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackInstancesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackInstancesPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackInstancesInput, _a2 func(*cloudformation.ListStackInstancesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackInstancesInput, func(*cloudformation.ListStackInstancesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackInstancesRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackInstancesRequest(_a0 *cloudformation.ListStackInstancesInput) (*request.Request, *cloudformation.ListStackInstancesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackInstancesOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstancesInput) *cloudformation.ListStackInstancesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackInstancesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackInstancesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackInstancesInput, _a2 ...request.Option) (*cloudformation.ListStackInstancesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackInstancesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackInstancesInput, ...request.Option) *cloudformation.ListStackInstancesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackInstancesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackInstancesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackResources provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackResources(_a0 *cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackResourcesOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput) *cloudformation.ListStackResourcesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackResourcesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackResourcesPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackResourcesPages(_a0 *cloudformation.ListStackResourcesInput, _a1 func(*cloudformation.ListStackResourcesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackResourcesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackResourcesPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackResourcesInput, _a2 func(*cloudformation.ListStackResourcesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackResourcesRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackResourcesRequest(_a0 *cloudformation.ListStackResourcesInput) (*request.Request, *cloudformation.ListStackResourcesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackResourcesOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackResourcesInput) *cloudformation.ListStackResourcesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackResourcesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackResourcesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackResourcesInput, _a2 ...request.Option) (*cloudformation.ListStackResourcesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackResourcesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackResourcesInput, ...request.Option) *cloudformation.ListStackResourcesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackResourcesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackResourcesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperationResults provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetOperationResults(_a0 *cloudformation.ListStackSetOperationResultsInput) (*cloudformation.ListStackSetOperationResultsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackSetOperationResultsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput) *cloudformation.ListStackSetOperationResultsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationResultsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationResultsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperationResultsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackSetOperationResultsPages(_a0 *cloudformation.ListStackSetOperationResultsInput, _a1 func(*cloudformation.ListStackSetOperationResultsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput, func(*cloudformation.ListStackSetOperationResultsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetOperationResultsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackSetOperationResultsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationResultsInput, _a2 func(*cloudformation.ListStackSetOperationResultsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, func(*cloudformation.ListStackSetOperationResultsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetOperationResultsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetOperationResultsRequest(_a0 *cloudformation.ListStackSetOperationResultsInput) (*request.Request, *cloudformation.ListStackSetOperationResultsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackSetOperationResultsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationResultsInput) *cloudformation.ListStackSetOperationResultsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackSetOperationResultsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperationResultsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackSetOperationResultsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationResultsInput, _a2 ...request.Option) (*cloudformation.ListStackSetOperationResultsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackSetOperationResultsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, ...request.Option) *cloudformation.ListStackSetOperationResultsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationResultsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperations provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetOperations(_a0 *cloudformation.ListStackSetOperationsInput) (*cloudformation.ListStackSetOperationsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackSetOperationsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput) *cloudformation.ListStackSetOperationsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperationsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackSetOperationsPages(_a0 *cloudformation.ListStackSetOperationsInput, _a1 func(*cloudformation.ListStackSetOperationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput, func(*cloudformation.ListStackSetOperationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetOperationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackSetOperationsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationsInput, _a2 func(*cloudformation.ListStackSetOperationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationsInput, func(*cloudformation.ListStackSetOperationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetOperationsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetOperationsRequest(_a0 *cloudformation.ListStackSetOperationsInput) (*request.Request, *cloudformation.ListStackSetOperationsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackSetOperationsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationsInput) *cloudformation.ListStackSetOperationsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackSetOperationsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackSetOperationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackSetOperationsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationsInput, _a2 ...request.Option) (*cloudformation.ListStackSetOperationsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackSetOperationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationsInput, ...request.Option) *cloudformation.ListStackSetOperationsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetOperationsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackSets provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSets(_a0 *cloudformation.ListStackSetsInput) (*cloudformation.ListStackSetsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStackSetsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput) *cloudformation.ListStackSetsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackSetsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStackSetsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStackSetsPages(_a0 *cloudformation.ListStackSetsInput, _a1 func(*cloudformation.ListStackSetsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput, func(*cloudformation.ListStackSetsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStackSetsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetsInput, _a2 func(*cloudformation.ListStackSetsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetsInput, func(*cloudformation.ListStackSetsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStackSetsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStackSetsRequest(_a0 *cloudformation.ListStackSetsInput) (*request.Request, *cloudformation.ListStackSetsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.ListStackSetsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetsInput) *cloudformation.ListStackSetsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStackSetsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListStackSetsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStackSetsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetsInput, _a2 ...request.Option) (*cloudformation.ListStackSetsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.ListStackSetsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetsInput, ...request.Option) *cloudformation.ListStackSetsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStackSetsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStacks provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStacks(_a0 *cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.ListStacksOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput) *cloudformation.ListStacksOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.ListStacksOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStacksInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListStacksPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListStacksPages(_a0 *cloudformation.ListStacksInput, _a1 func(*cloudformation.ListStacksOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStacksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListStacksPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStacksInput, _a2 func(*cloudformation.ListStacksOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListStacksRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListStacksRequest(_a0 *cloudformation.ListStacksInput) (*request.Request, *cloudformation.ListStacksOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetTemplateSummaryInput, ...request.Option) error); ok {
-		r1 = rf(_a0, _a1, _a2...)
+	var r1 *cloudformation.ListStacksOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListStacksInput) *cloudformation.ListStacksOutput); ok {
+		r1 = rf(_a0)
 	} else {
-		r1 = ret.Error(1)
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.ListStacksOutput)
+		}
 	}
 
 	return r0, r1
 }
 
-// GetTemplateWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) GetTemplateWithContext(_a0 context.Context, _a1 *cloudformation.GetTemplateInput, _a2 ...request.Option) (*cloudformation.GetTemplateOutput, error) {
+// ListStacksWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListStacksWithContext(_a0 context.Context, _a1 *cloudformation.ListStacksInput, _a2 ...request.Option) (*cloudformation.ListStacksOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2283,17 +4426,17 @@ func (_m *CloudFormationAPI) GetTemplateWithContext(_a0 context.Context, _a1 *cl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.GetTemplateOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.GetTemplateInput, ...request.Option) *cloudformation.GetTemplateOutput); ok {
+	var r0 *cloudformation.ListStacksOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStacksInput, ...request.Option) *cloudformation.ListStacksOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.GetTemplateOutput)
+			r0 = ret.Get(0).(*cloudformation.ListStacksOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.GetTemplateInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStacksInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2302,21 +4445,21 @@ func (_m *CloudFormationAPI) GetTemplateWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
-// ListChangeSets provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListChangeSets(_a0 *cloudformation.ListChangeSetsInput) (*cloudformation.ListChangeSetsOutput, error) {
+// ListTypeRegistrations provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypeRegistrations(_a0 *cloudformation.ListTypeRegistrationsInput) (*cloudformation.ListTypeRegistrationsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListChangeSetsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput) *cloudformation.ListChangeSetsOutput); ok {
+	var r0 *cloudformation.ListTypeRegistrationsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeRegistrationsInput) *cloudformation.ListTypeRegistrationsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListChangeSetsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypeRegistrationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListChangeSetsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypeRegistrationsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2325,12 +4468,47 @@ func (_m *CloudFormationAPI) ListChangeSets(_a0 *cloudformation.ListChangeSetsIn
 	return r0, r1
 }
 
-// ListChangeSetsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListChangeSetsRequest(_a0 *cloudformation.ListChangeSetsInput) (*request.Request, *cloudformation.ListChangeSetsOutput) {
+// ListTypeRegistrationsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListTypeRegistrationsPages(_a0 *cloudformation.ListTypeRegistrationsInput, _a1 func(*cloudformation.ListTypeRegistrationsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeRegistrationsInput, func(*cloudformation.ListTypeRegistrationsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTypeRegistrationsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListTypeRegistrationsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListTypeRegistrationsInput, _a2 func(*cloudformation.ListTypeRegistrationsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypeRegistrationsInput, func(*cloudformation.ListTypeRegistrationsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTypeRegistrationsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypeRegistrationsRequest(_a0 *cloudformation.ListTypeRegistrationsInput) (*request.Request, *cloudformation.ListTypeRegistrationsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListChangeSetsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeRegistrationsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2338,20 +4516,20 @@ func (_m *CloudFormationAPI) ListChangeSetsRequest(_a0 *cloudformation.ListChang
 		}
 	}
 
-	var r1 *cloudformation.ListChangeSetsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListChangeSetsInput) *cloudformation.ListChangeSetsOutput); ok {
+	var r1 *cloudformation.ListTypeRegistrationsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypeRegistrationsInput) *cloudformation.ListTypeRegistrationsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListChangeSetsOutput)
+			r1 = ret.Get(1).(*cloudformation.ListTypeRegistrationsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListChangeSetsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListChangeSetsWithContext(_a0 context.Context, _a1 *cloudformation.ListChangeSetsInput, _a2 ...request.Option) (*cloudformation.ListChangeSetsOutput, error) {
+// ListTypeRegistrationsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListTypeRegistrationsWithContext(_a0 context.Context, _a1 *cloudformation.ListTypeRegistrationsInput, _a2 ...request.Option) (*cloudformation.ListTypeRegistrationsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2361,17 +4539,17 @@ func (_m *CloudFormationAPI) ListChangeSetsWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListChangeSetsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListChangeSetsInput, ...request.Option) *cloudformation.ListChangeSetsOutput); ok {
+	var r0 *cloudformation.ListTypeRegistrationsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypeRegistrationsInput, ...request.Option) *cloudformation.ListTypeRegistrationsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListChangeSetsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypeRegistrationsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListChangeSetsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListTypeRegistrationsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2380,21 +4558,21 @@ func (_m *CloudFormationAPI) ListChangeSetsWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// ListExports provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListExports(_a0 *cloudformation.ListExportsInput) (*cloudformation.ListExportsOutput, error) {
+// ListTypeVersions provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypeVersions(_a0 *cloudformation.ListTypeVersionsInput) (*cloudformation.ListTypeVersionsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListExportsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput) *cloudformation.ListExportsOutput); ok {
+	var r0 *cloudformation.ListTypeVersionsOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeVersionsInput) *cloudformation.ListTypeVersionsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListExportsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypeVersionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListExportsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypeVersionsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2403,12 +4581,12 @@ func (_m *CloudFormationAPI) ListExports(_a0 *cloudformation.ListExportsInput) (
 	return r0, r1
 }
 
-// ListExportsPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) ListExportsPages(_a0 *cloudformation.ListExportsInput, _a1 func(*cloudformation.ListExportsOutput, bool) bool) error {
+// ListTypeVersionsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListTypeVersionsPages(_a0 *cloudformation.ListTypeVersionsInput, _a1 func(*cloudformation.ListTypeVersionsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput, func(*cloudformation.ListExportsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeVersionsInput, func(*cloudformation.ListTypeVersionsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -2417,8 +4595,8 @@ func (_m *CloudFormationAPI) ListExportsPages(_a0 *cloudformation.ListExportsInp
 	return r0
 }
 
-// ListExportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) ListExportsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListExportsInput, _a2 func(*cloudformation.ListExportsOutput, bool) bool, _a3 ...request.Option) error {
+// ListTypeVersionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListTypeVersionsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListTypeVersionsInput, _a2 func(*cloudformation.ListTypeVersionsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -2429,7 +4607,7 @@ func (_m *CloudFormationAPI) ListExportsPagesWithContext(_a0 context.Context, _a
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListExportsInput, func(*cloudformation.ListExportsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypeVersionsInput, func(*cloudformation.ListTypeVersionsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -2438,12 +4616,12 @@ func (_m *CloudFormationAPI) ListExportsPagesWithContext(_a0 context.Context, _a
 	return r0
 }
 
-// ListExportsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListExportsRequest(_a0 *cloudformation.ListExportsInput) (*request.Request, *cloudformation.ListExportsOutput) {
+// ListTypeVersionsRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypeVersionsRequest(_a0 *cloudformation.ListTypeVersionsInput) (*request.Request, *cloudformation.ListTypeVersionsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListExportsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypeVersionsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2451,20 +4629,20 @@ func (_m *CloudFormationAPI) ListExportsRequest(_a0 *cloudformation.ListExportsI
 		}
 	}
 
-	var r1 *cloudformation.ListExportsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListExportsInput) *cloudformation.ListExportsOutput); ok {
+	var r1 *cloudformation.ListTypeVersionsOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypeVersionsInput) *cloudformation.ListTypeVersionsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListExportsOutput)
+			r1 = ret.Get(1).(*cloudformation.ListTypeVersionsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListExportsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListExportsWithContext(_a0 context.Context, _a1 *cloudformation.ListExportsInput, _a2 ...request.Option) (*cloudformation.ListExportsOutput, error) {
+// ListTypeVersionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListTypeVersionsWithContext(_a0 context.Context, _a1 *cloudformation.ListTypeVersionsInput, _a2 ...request.Option) (*cloudformation.ListTypeVersionsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2474,17 +4652,17 @@ func (_m *CloudFormationAPI) ListExportsWithContext(_a0 context.Context, _a1 *cl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListExportsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListExportsInput, ...request.Option) *cloudformation.ListExportsOutput); ok {
+	var r0 *cloudformation.ListTypeVersionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypeVersionsInput, ...request.Option) *cloudformation.ListTypeVersionsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListExportsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypeVersionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListExportsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListTypeVersionsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2493,21 +4671,21 @@ func (_m *CloudFormationAPI) ListExportsWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
-// ListImports provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListImports(_a0 *cloudformation.ListImportsInput) (*cloudformation.ListImportsOutput, error) {
+// ListTypes provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypes(_a0 *cloudformation.ListTypesInput) (*cloudformation.ListTypesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListImportsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput) *cloudformation.ListImportsOutput); ok {
+	var r0 *cloudformation.ListTypesOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypesInput) *cloudformation.ListTypesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListImportsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListImportsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2516,12 +4694,12 @@ func (_m *CloudFormationAPI) ListImports(_a0 *cloudformation.ListImportsInput) (
 	return r0, r1
 }
 
-// ListImportsPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) ListImportsPages(_a0 *cloudformation.ListImportsInput, _a1 func(*cloudformation.ListImportsOutput, bool) bool) error {
+// ListTypesPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudFormationAPI) ListTypesPages(_a0 *cloudformation.ListTypesInput, _a1 func(*cloudformation.ListTypesOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput, func(*cloudformation.ListImportsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypesInput, func(*cloudformation.ListTypesOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -2530,8 +4708,8 @@ func (_m *CloudFormationAPI) ListImportsPages(_a0 *cloudformation.ListImportsInp
 	return r0
 }
 
-// ListImportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) ListImportsPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListImportsInput, _a2 func(*cloudformation.ListImportsOutput, bool) bool, _a3 ...request.Option) error {
+// ListTypesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudFormationAPI) ListTypesPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListTypesInput, _a2 func(*cloudformation.ListTypesOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -2542,7 +4720,7 @@ func (_m *CloudFormationAPI) ListImportsPagesWithContext(_a0 context.Context, _a
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListImportsInput, func(*cloudformation.ListImportsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypesInput, func(*cloudformation.ListTypesOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -2551,12 +4729,12 @@ func (_m *CloudFormationAPI) ListImportsPagesWithContext(_a0 context.Context, _a
 	return r0
 }
 
-// ListImportsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListImportsRequest(_a0 *cloudformation.ListImportsInput) (*request.Request, *cloudformation.ListImportsOutput) {
+// ListTypesRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) ListTypesRequest(_a0 *cloudformation.ListTypesInput) (*request.Request, *cloudformation.ListTypesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListImportsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.ListTypesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2564,20 +4742,20 @@ func (_m *CloudFormationAPI) ListImportsRequest(_a0 *cloudformation.ListImportsI
 		}
 	}
 
-	var r1 *cloudformation.ListImportsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListImportsInput) *cloudformation.ListImportsOutput); ok {
+	var r1 *cloudformation.ListTypesOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.ListTypesInput) *cloudformation.ListTypesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListImportsOutput)
+			r1 = ret.Get(1).(*cloudformation.ListTypesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListImportsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListImportsWithContext(_a0 context.Context, _a1 *cloudformation.ListImportsInput, _a2 ...request.Option) (*cloudformation.ListImportsOutput, error) {
+// ListTypesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) ListTypesWithContext(_a0 context.Context, _a1 *cloudformation.ListTypesInput, _a2 ...request.Option) (*cloudformation.ListTypesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2587,17 +4765,17 @@ func (_m *CloudFormationAPI) ListImportsWithContext(_a0 context.Context, _a1 *cl
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListImportsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListImportsInput, ...request.Option) *cloudformation.ListImportsOutput); ok {
+	var r0 *cloudformation.ListTypesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListTypesInput, ...request.Option) *cloudformation.ListTypesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListImportsOutput)
+			r0 = ret.Get(0).(*cloudformation.ListTypesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListImportsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListTypesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2606,21 +4784,21 @@ func (_m *CloudFormationAPI) ListImportsWithContext(_a0 context.Context, _a1 *cl
 	return r0, r1
 }
 
-// ListStackInstances provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackInstances(_a0 *cloudformation.ListStackInstancesInput) (*cloudformation.ListStackInstancesOutput, error) {
+// PublishType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) PublishType(_a0 *cloudformation.PublishTypeInput) (*cloudformation.PublishTypeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackInstancesOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput) *cloudformation.ListStackInstancesOutput); ok {
+	var r0 *cloudformation.PublishTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.PublishTypeInput) *cloudformation.PublishTypeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackInstancesOutput)
+			r0 = ret.Get(0).(*cloudformation.PublishTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstancesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.PublishTypeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2629,12 +4807,12 @@ func (_m *CloudFormationAPI) ListStackInstances(_a0 *cloudformation.ListStackIns
 	return r0, r1
 }
 
-// ListStackInstancesRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackInstancesRequest(_a0 *cloudformation.ListStackInstancesInput) (*request.Request, *cloudformation.ListStackInstancesOutput) {
+// PublishTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) PublishTypeRequest(_a0 *cloudformation.PublishTypeInput) (*request.Request, *cloudformation.PublishTypeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackInstancesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.PublishTypeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2642,20 +4820,20 @@ func (_m *CloudFormationAPI) ListStackInstancesRequest(_a0 *cloudformation.ListS
 		}
 	}
 
-	var r1 *cloudformation.ListStackInstancesOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackInstancesInput) *cloudformation.ListStackInstancesOutput); ok {
+	var r1 *cloudformation.PublishTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.PublishTypeInput) *cloudformation.PublishTypeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackInstancesOutput)
+			r1 = ret.Get(1).(*cloudformation.PublishTypeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackInstancesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackInstancesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackInstancesInput, _a2 ...request.Option) (*cloudformation.ListStackInstancesOutput, error) {
+// PublishTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) PublishTypeWithContext(_a0 context.Context, _a1 *cloudformation.PublishTypeInput, _a2 ...request.Option) (*cloudformation.PublishTypeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2665,17 +4843,17 @@ func (_m *CloudFormationAPI) ListStackInstancesWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackInstancesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackInstancesInput, ...request.Option) *cloudformation.ListStackInstancesOutput); ok {
+	var r0 *cloudformation.PublishTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.PublishTypeInput, ...request.Option) *cloudformation.PublishTypeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackInstancesOutput)
+			r0 = ret.Get(0).(*cloudformation.PublishTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackInstancesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.PublishTypeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2684,21 +4862,21 @@ func (_m *CloudFormationAPI) ListStackInstancesWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// ListStackResources provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackResources(_a0 *cloudformation.ListStackResourcesInput) (*cloudformation.ListStackResourcesOutput, error) {
+// RecordHandlerProgress provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RecordHandlerProgress(_a0 *cloudformation.RecordHandlerProgressInput) (*cloudformation.RecordHandlerProgressOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackResourcesOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput) *cloudformation.ListStackResourcesOutput); ok {
+	var r0 *cloudformation.RecordHandlerProgressOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.RecordHandlerProgressInput) *cloudformation.RecordHandlerProgressOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackResourcesOutput)
+			r0 = ret.Get(0).(*cloudformation.RecordHandlerProgressOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackResourcesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.RecordHandlerProgressInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2707,47 +4885,90 @@ func (_m *CloudFormationAPI) ListStackResources(_a0 *cloudformation.ListStackRes
 	return r0, r1
 }
 
-// ListStackResourcesPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) ListStackResourcesPages(_a0 *cloudformation.ListStackResourcesInput, _a1 func(*cloudformation.ListStackResourcesOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
+// RecordHandlerProgressRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RecordHandlerProgressRequest(_a0 *cloudformation.RecordHandlerProgressInput) (*request.Request, *cloudformation.RecordHandlerProgressOutput) {
+	ret := _m.Called(_a0)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.RecordHandlerProgressInput) *request.Request); ok {
+		r0 = rf(_a0)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
 	}
 
-	return r0
+	var r1 *cloudformation.RecordHandlerProgressOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.RecordHandlerProgressInput) *cloudformation.RecordHandlerProgressOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.RecordHandlerProgressOutput)
+		}
+	}
+
+	return r0, r1
 }
 
-// ListStackResourcesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) ListStackResourcesPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackResourcesInput, _a2 func(*cloudformation.ListStackResourcesOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
+// RecordHandlerProgressWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) RecordHandlerProgressWithContext(_a0 context.Context, _a1 *cloudformation.RecordHandlerProgressInput, _a2 ...request.Option) (*cloudformation.RecordHandlerProgressOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
 	}
 	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _a0, _a1)
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackResourcesInput, func(*cloudformation.ListStackResourcesOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
+	var r0 *cloudformation.RecordHandlerProgressOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.RecordHandlerProgressInput, ...request.Option) *cloudformation.RecordHandlerProgressOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
 	} else {
-		r0 = ret.Error(0)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.RecordHandlerProgressOutput)
+		}
 	}
 
-	return r0
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.RecordHandlerProgressInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// ListStackResourcesRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackResourcesRequest(_a0 *cloudformation.ListStackResourcesInput) (*request.Request, *cloudformation.ListStackResourcesOutput) {
+// RegisterPublisher provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RegisterPublisher(_a0 *cloudformation.RegisterPublisherInput) (*cloudformation.RegisterPublisherOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.RegisterPublisherOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.RegisterPublisherInput) *cloudformation.RegisterPublisherOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.RegisterPublisherOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.RegisterPublisherInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterPublisherRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RegisterPublisherRequest(_a0 *cloudformation.RegisterPublisherInput) (*request.Request, *cloudformation.RegisterPublisherOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackResourcesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.RegisterPublisherInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2755,20 +4976,20 @@ func (_m *CloudFormationAPI) ListStackResourcesRequest(_a0 *cloudformation.ListS
 		}
 	}
 
-	var r1 *cloudformation.ListStackResourcesOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackResourcesInput) *cloudformation.ListStackResourcesOutput); ok {
+	var r1 *cloudformation.RegisterPublisherOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.RegisterPublisherInput) *cloudformation.RegisterPublisherOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackResourcesOutput)
+			r1 = ret.Get(1).(*cloudformation.RegisterPublisherOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackResourcesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackResourcesWithContext(_a0 context.Context, _a1 *cloudformation.ListStackResourcesInput, _a2 ...request.Option) (*cloudformation.ListStackResourcesOutput, error) {
+// RegisterPublisherWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) RegisterPublisherWithContext(_a0 context.Context, _a1 *cloudformation.RegisterPublisherInput, _a2 ...request.Option) (*cloudformation.RegisterPublisherOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2778,17 +4999,17 @@ func (_m *CloudFormationAPI) ListStackResourcesWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackResourcesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackResourcesInput, ...request.Option) *cloudformation.ListStackResourcesOutput); ok {
+	var r0 *cloudformation.RegisterPublisherOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.RegisterPublisherInput, ...request.Option) *cloudformation.RegisterPublisherOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackResourcesOutput)
+			r0 = ret.Get(0).(*cloudformation.RegisterPublisherOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackResourcesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.RegisterPublisherInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2797,21 +5018,21 @@ func (_m *CloudFormationAPI) ListStackResourcesWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// ListStackSetOperationResults provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetOperationResults(_a0 *cloudformation.ListStackSetOperationResultsInput) (*cloudformation.ListStackSetOperationResultsOutput, error) {
+// RegisterType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RegisterType(_a0 *cloudformation.RegisterTypeInput) (*cloudformation.RegisterTypeOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackSetOperationResultsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput) *cloudformation.ListStackSetOperationResultsOutput); ok {
+	var r0 *cloudformation.RegisterTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.RegisterTypeInput) *cloudformation.RegisterTypeOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationResultsOutput)
+			r0 = ret.Get(0).(*cloudformation.RegisterTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationResultsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.RegisterTypeInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2820,12 +5041,12 @@ func (_m *CloudFormationAPI) ListStackSetOperationResults(_a0 *cloudformation.Li
 	return r0, r1
 }
 
-// ListStackSetOperationResultsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetOperationResultsRequest(_a0 *cloudformation.ListStackSetOperationResultsInput) (*request.Request, *cloudformation.ListStackSetOperationResultsOutput) {
+// RegisterTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RegisterTypeRequest(_a0 *cloudformation.RegisterTypeInput) (*request.Request, *cloudformation.RegisterTypeOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationResultsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.RegisterTypeInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2833,20 +5054,20 @@ func (_m *CloudFormationAPI) ListStackSetOperationResultsRequest(_a0 *cloudforma
 		}
 	}
 
-	var r1 *cloudformation.ListStackSetOperationResultsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationResultsInput) *cloudformation.ListStackSetOperationResultsOutput); ok {
+	var r1 *cloudformation.RegisterTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.RegisterTypeInput) *cloudformation.RegisterTypeOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackSetOperationResultsOutput)
+			r1 = ret.Get(1).(*cloudformation.RegisterTypeOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackSetOperationResultsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackSetOperationResultsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationResultsInput, _a2 ...request.Option) (*cloudformation.ListStackSetOperationResultsOutput, error) {
+// RegisterTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) RegisterTypeWithContext(_a0 context.Context, _a1 *cloudformation.RegisterTypeInput, _a2 ...request.Option) (*cloudformation.RegisterTypeOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2856,17 +5077,17 @@ func (_m *CloudFormationAPI) ListStackSetOperationResultsWithContext(_a0 context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackSetOperationResultsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, ...request.Option) *cloudformation.ListStackSetOperationResultsOutput); ok {
+	var r0 *cloudformation.RegisterTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.RegisterTypeInput, ...request.Option) *cloudformation.RegisterTypeOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationResultsOutput)
+			r0 = ret.Get(0).(*cloudformation.RegisterTypeOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetOperationResultsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.RegisterTypeInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2875,21 +5096,21 @@ func (_m *CloudFormationAPI) ListStackSetOperationResultsWithContext(_a0 context
 	return r0, r1
 }
 
-// ListStackSetOperations provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetOperations(_a0 *cloudformation.ListStackSetOperationsInput) (*cloudformation.ListStackSetOperationsOutput, error) {
+// RollbackStack provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RollbackStack(_a0 *cloudformation.RollbackStackInput) (*cloudformation.RollbackStackOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackSetOperationsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput) *cloudformation.ListStackSetOperationsOutput); ok {
+	var r0 *cloudformation.RollbackStackOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.RollbackStackInput) *cloudformation.RollbackStackOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationsOutput)
+			r0 = ret.Get(0).(*cloudformation.RollbackStackOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.RollbackStackInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2898,12 +5119,12 @@ func (_m *CloudFormationAPI) ListStackSetOperations(_a0 *cloudformation.ListStac
 	return r0, r1
 }
 
-// ListStackSetOperationsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetOperationsRequest(_a0 *cloudformation.ListStackSetOperationsInput) (*request.Request, *cloudformation.ListStackSetOperationsOutput) {
+// RollbackStackRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) RollbackStackRequest(_a0 *cloudformation.RollbackStackInput) (*request.Request, *cloudformation.RollbackStackOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetOperationsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.RollbackStackInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2911,20 +5132,20 @@ func (_m *CloudFormationAPI) ListStackSetOperationsRequest(_a0 *cloudformation.L
 		}
 	}
 
-	var r1 *cloudformation.ListStackSetOperationsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetOperationsInput) *cloudformation.ListStackSetOperationsOutput); ok {
+	var r1 *cloudformation.RollbackStackOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.RollbackStackInput) *cloudformation.RollbackStackOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackSetOperationsOutput)
+			r1 = ret.Get(1).(*cloudformation.RollbackStackOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackSetOperationsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackSetOperationsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetOperationsInput, _a2 ...request.Option) (*cloudformation.ListStackSetOperationsOutput, error) {
+// RollbackStackWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) RollbackStackWithContext(_a0 context.Context, _a1 *cloudformation.RollbackStackInput, _a2 ...request.Option) (*cloudformation.RollbackStackOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -2934,17 +5155,17 @@ func (_m *CloudFormationAPI) ListStackSetOperationsWithContext(_a0 context.Conte
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackSetOperationsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetOperationsInput, ...request.Option) *cloudformation.ListStackSetOperationsOutput); ok {
+	var r0 *cloudformation.RollbackStackOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.RollbackStackInput, ...request.Option) *cloudformation.RollbackStackOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetOperationsOutput)
+			r0 = ret.Get(0).(*cloudformation.RollbackStackOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetOperationsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.RollbackStackInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -2953,21 +5174,21 @@ func (_m *CloudFormationAPI) ListStackSetOperationsWithContext(_a0 context.Conte
 	return r0, r1
 }
 
-// ListStackSets provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSets(_a0 *cloudformation.ListStackSetsInput) (*cloudformation.ListStackSetsOutput, error) {
+// SetStackPolicy provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetStackPolicy(_a0 *cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStackSetsOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput) *cloudformation.ListStackSetsOutput); ok {
+	var r0 *cloudformation.SetStackPolicyOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetStackPolicyInput) *cloudformation.SetStackPolicyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetsOutput)
+			r0 = ret.Get(0).(*cloudformation.SetStackPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetStackPolicyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -2976,12 +5197,12 @@ func (_m *CloudFormationAPI) ListStackSets(_a0 *cloudformation.ListStackSetsInpu
 	return r0, r1
 }
 
-// ListStackSetsRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStackSetsRequest(_a0 *cloudformation.ListStackSetsInput) (*request.Request, *cloudformation.ListStackSetsOutput) {
+// SetStackPolicyRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetStackPolicyRequest(_a0 *cloudformation.SetStackPolicyInput) (*request.Request, *cloudformation.SetStackPolicyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStackSetsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetStackPolicyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -2989,20 +5210,20 @@ func (_m *CloudFormationAPI) ListStackSetsRequest(_a0 *cloudformation.ListStackS
 		}
 	}
 
-	var r1 *cloudformation.ListStackSetsOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStackSetsInput) *cloudformation.ListStackSetsOutput); ok {
+	var r1 *cloudformation.SetStackPolicyOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetStackPolicyInput) *cloudformation.SetStackPolicyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStackSetsOutput)
+			r1 = ret.Get(1).(*cloudformation.SetStackPolicyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStackSetsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStackSetsWithContext(_a0 context.Context, _a1 *cloudformation.ListStackSetsInput, _a2 ...request.Option) (*cloudformation.ListStackSetsOutput, error) {
+// SetStackPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) SetStackPolicyWithContext(_a0 context.Context, _a1 *cloudformation.SetStackPolicyInput, _a2 ...request.Option) (*cloudformation.SetStackPolicyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3012,17 +5233,17 @@ func (_m *CloudFormationAPI) ListStackSetsWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStackSetsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStackSetsInput, ...request.Option) *cloudformation.ListStackSetsOutput); ok {
+	var r0 *cloudformation.SetStackPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.SetStackPolicyInput, ...request.Option) *cloudformation.SetStackPolicyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStackSetsOutput)
+			r0 = ret.Get(0).(*cloudformation.SetStackPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStackSetsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.SetStackPolicyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3031,21 +5252,21 @@ func (_m *CloudFormationAPI) ListStackSetsWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// ListStacks provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStacks(_a0 *cloudformation.ListStacksInput) (*cloudformation.ListStacksOutput, error) {
+// SetTypeConfiguration provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetTypeConfiguration(_a0 *cloudformation.SetTypeConfigurationInput) (*cloudformation.SetTypeConfigurationOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.ListStacksOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput) *cloudformation.ListStacksOutput); ok {
+	var r0 *cloudformation.SetTypeConfigurationOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetTypeConfigurationInput) *cloudformation.SetTypeConfigurationOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStacksOutput)
+			r0 = ret.Get(0).(*cloudformation.SetTypeConfigurationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStacksInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetTypeConfigurationInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3054,47 +5275,12 @@ func (_m *CloudFormationAPI) ListStacks(_a0 *cloudformation.ListStacksInput) (*c
 	return r0, r1
 }
 
-// ListStacksPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudFormationAPI) ListStacksPages(_a0 *cloudformation.ListStacksInput, _a1 func(*cloudformation.ListStacksOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListStacksPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudFormationAPI) ListStacksPagesWithContext(_a0 context.Context, _a1 *cloudformation.ListStacksInput, _a2 func(*cloudformation.ListStacksOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStacksInput, func(*cloudformation.ListStacksOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// ListStacksRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) ListStacksRequest(_a0 *cloudformation.ListStacksInput) (*request.Request, *cloudformation.ListStacksOutput) {
+// SetTypeConfigurationRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetTypeConfigurationRequest(_a0 *cloudformation.SetTypeConfigurationInput) (*request.Request, *cloudformation.SetTypeConfigurationOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.ListStacksInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetTypeConfigurationInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3102,20 +5288,20 @@ func (_m *CloudFormationAPI) ListStacksRequest(_a0 *cloudformation.ListStacksInp
 		}
 	}
 
-	var r1 *cloudformation.ListStacksOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.ListStacksInput) *cloudformation.ListStacksOutput); ok {
+	var r1 *cloudformation.SetTypeConfigurationOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetTypeConfigurationInput) *cloudformation.SetTypeConfigurationOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.ListStacksOutput)
+			r1 = ret.Get(1).(*cloudformation.SetTypeConfigurationOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListStacksWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) ListStacksWithContext(_a0 context.Context, _a1 *cloudformation.ListStacksInput, _a2 ...request.Option) (*cloudformation.ListStacksOutput, error) {
+// SetTypeConfigurationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) SetTypeConfigurationWithContext(_a0 context.Context, _a1 *cloudformation.SetTypeConfigurationInput, _a2 ...request.Option) (*cloudformation.SetTypeConfigurationOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3125,17 +5311,17 @@ func (_m *CloudFormationAPI) ListStacksWithContext(_a0 context.Context, _a1 *clo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.ListStacksOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.ListStacksInput, ...request.Option) *cloudformation.ListStacksOutput); ok {
+	var r0 *cloudformation.SetTypeConfigurationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.SetTypeConfigurationInput, ...request.Option) *cloudformation.SetTypeConfigurationOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.ListStacksOutput)
+			r0 = ret.Get(0).(*cloudformation.SetTypeConfigurationOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.ListStacksInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.SetTypeConfigurationInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3144,21 +5330,21 @@ func (_m *CloudFormationAPI) ListStacksWithContext(_a0 context.Context, _a1 *clo
 	return r0, r1
 }
 
-// SetStackPolicy provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) SetStackPolicy(_a0 *cloudformation.SetStackPolicyInput) (*cloudformation.SetStackPolicyOutput, error) {
+// SetTypeDefaultVersion provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetTypeDefaultVersion(_a0 *cloudformation.SetTypeDefaultVersionInput) (*cloudformation.SetTypeDefaultVersionOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudformation.SetStackPolicyOutput
-	if rf, ok := ret.Get(0).(func(*cloudformation.SetStackPolicyInput) *cloudformation.SetStackPolicyOutput); ok {
+	var r0 *cloudformation.SetTypeDefaultVersionOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetTypeDefaultVersionInput) *cloudformation.SetTypeDefaultVersionOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.SetStackPolicyOutput)
+			r0 = ret.Get(0).(*cloudformation.SetTypeDefaultVersionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudformation.SetStackPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetTypeDefaultVersionInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -3167,12 +5353,12 @@ func (_m *CloudFormationAPI) SetStackPolicy(_a0 *cloudformation.SetStackPolicyIn
 	return r0, r1
 }
 
-// SetStackPolicyRequest provides a mock function with given fields: _a0
-func (_m *CloudFormationAPI) SetStackPolicyRequest(_a0 *cloudformation.SetStackPolicyInput) (*request.Request, *cloudformation.SetStackPolicyOutput) {
+// SetTypeDefaultVersionRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) SetTypeDefaultVersionRequest(_a0 *cloudformation.SetTypeDefaultVersionInput) (*request.Request, *cloudformation.SetTypeDefaultVersionOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudformation.SetStackPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudformation.SetTypeDefaultVersionInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -3180,20 +5366,20 @@ func (_m *CloudFormationAPI) SetStackPolicyRequest(_a0 *cloudformation.SetStackP
 		}
 	}
 
-	var r1 *cloudformation.SetStackPolicyOutput
-	if rf, ok := ret.Get(1).(func(*cloudformation.SetStackPolicyInput) *cloudformation.SetStackPolicyOutput); ok {
+	var r1 *cloudformation.SetTypeDefaultVersionOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.SetTypeDefaultVersionInput) *cloudformation.SetTypeDefaultVersionOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudformation.SetStackPolicyOutput)
+			r1 = ret.Get(1).(*cloudformation.SetTypeDefaultVersionOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// SetStackPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudFormationAPI) SetStackPolicyWithContext(_a0 context.Context, _a1 *cloudformation.SetStackPolicyInput, _a2 ...request.Option) (*cloudformation.SetStackPolicyOutput, error) {
+// SetTypeDefaultVersionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) SetTypeDefaultVersionWithContext(_a0 context.Context, _a1 *cloudformation.SetTypeDefaultVersionInput, _a2 ...request.Option) (*cloudformation.SetTypeDefaultVersionOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -3203,17 +5389,17 @@ func (_m *CloudFormationAPI) SetStackPolicyWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudformation.SetStackPolicyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.SetStackPolicyInput, ...request.Option) *cloudformation.SetStackPolicyOutput); ok {
+	var r0 *cloudformation.SetTypeDefaultVersionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.SetTypeDefaultVersionInput, ...request.Option) *cloudformation.SetTypeDefaultVersionOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudformation.SetStackPolicyOutput)
+			r0 = ret.Get(0).(*cloudformation.SetTypeDefaultVersionOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.SetStackPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.SetTypeDefaultVersionInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -3378,6 +5564,84 @@ func (_m *CloudFormationAPI) StopStackSetOperationWithContext(_a0 context.Contex
 	return r0, r1
 }
 
+// TestType provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) TestType(_a0 *cloudformation.TestTypeInput) (*cloudformation.TestTypeOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudformation.TestTypeOutput
+	if rf, ok := ret.Get(0).(func(*cloudformation.TestTypeInput) *cloudformation.TestTypeOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.TestTypeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudformation.TestTypeInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TestTypeRequest provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) TestTypeRequest(_a0 *cloudformation.TestTypeInput) (*request.Request, *cloudformation.TestTypeOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudformation.TestTypeInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudformation.TestTypeOutput
+	if rf, ok := ret.Get(1).(func(*cloudformation.TestTypeInput) *cloudformation.TestTypeOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudformation.TestTypeOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// TestTypeWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) TestTypeWithContext(_a0 context.Context, _a1 *cloudformation.TestTypeInput, _a2 ...request.Option) (*cloudformation.TestTypeOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudformation.TestTypeOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.TestTypeInput, ...request.Option) *cloudformation.TestTypeOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudformation.TestTypeOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudformation.TestTypeInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateStack provides a mock function with given fields: _a0
 func (_m *CloudFormationAPI) UpdateStack(_a0 *cloudformation.UpdateStackInput) (*cloudformation.UpdateStackOutput, error) {
 	ret := _m.Called(_a0)
@@ -3908,6 +6172,76 @@ func (_m *CloudFormationAPI) WaitUntilStackExistsWithContext(_a0 context.Context
 	return r0
 }
 
+// WaitUntilStackImportComplete provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) WaitUntilStackImportComplete(_a0 *cloudformation.DescribeStacksInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilStackImportCompleteWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) WaitUntilStackImportCompleteWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStacksInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStacksInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilStackRollbackComplete provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) WaitUntilStackRollbackComplete(_a0 *cloudformation.DescribeStacksInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeStacksInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilStackRollbackCompleteWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) WaitUntilStackRollbackCompleteWithContext(_a0 context.Context, _a1 *cloudformation.DescribeStacksInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeStacksInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // WaitUntilStackUpdateComplete provides a mock function with given fields: _a0
 func (_m *CloudFormationAPI) WaitUntilStackUpdateComplete(_a0 *cloudformation.DescribeStacksInput) error {
 	ret := _m.Called(_a0)
@@ -3942,3 +6276,38 @@ func (_m *CloudFormationAPI) WaitUntilStackUpdateCompleteWithContext(_a0 context
 
 	return r0
 }
+
+// WaitUntilTypeRegistrationComplete provides a mock function with given fields: _a0
+func (_m *CloudFormationAPI) WaitUntilTypeRegistrationComplete(_a0 *cloudformation.DescribeTypeRegistrationInput) error {
+	ret := _m.Called(_a0)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudformation.DescribeTypeRegistrationInput) error); ok {
+		r0 = rf(_a0)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// WaitUntilTypeRegistrationCompleteWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudFormationAPI) WaitUntilTypeRegistrationCompleteWithContext(_a0 context.Context, _a1 *cloudformation.DescribeTypeRegistrationInput, _a2 ...request.WaiterOption) error {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudformation.DescribeTypeRegistrationInput, ...request.WaiterOption) error); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
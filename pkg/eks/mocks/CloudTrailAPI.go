@@ -3,7 +3,6 @@
 package mocks
 
 import cloudtrail "github.com/aws/aws-sdk-go/service/cloudtrail"
-
 import context "context"
 import mock "github.com/stretchr/testify/mock"
 import request "github.com/aws/aws-sdk-go/aws/request"
@@ -91,21 +90,21 @@ func (_m *CloudTrailAPI) AddTagsWithContext(_a0 context.Context, _a1 *cloudtrail
 	return r0, r1
 }
 
-// CreateTrail provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) CreateTrail(_a0 *cloudtrail.CreateTrailInput) (*cloudtrail.CreateTrailOutput, error) {
+// CancelQuery provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) CancelQuery(_a0 *cloudtrail.CancelQueryInput) (*cloudtrail.CancelQueryOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.CreateTrailOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.CreateTrailInput) *cloudtrail.CreateTrailOutput); ok {
+	var r0 *cloudtrail.CancelQueryOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.CancelQueryInput) *cloudtrail.CancelQueryOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.CreateTrailOutput)
+			r0 = ret.Get(0).(*cloudtrail.CancelQueryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.CreateTrailInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.CancelQueryInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -114,12 +113,12 @@ func (_m *CloudTrailAPI) CreateTrail(_a0 *cloudtrail.CreateTrailInput) (*cloudtr
 	return r0, r1
 }
 
-// CreateTrailRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) CreateTrailRequest(_a0 *cloudtrail.CreateTrailInput) (*request.Request, *cloudtrail.CreateTrailOutput) {
+// CancelQueryRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) CancelQueryRequest(_a0 *cloudtrail.CancelQueryInput) (*request.Request, *cloudtrail.CancelQueryOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.CreateTrailInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.CancelQueryInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -127,20 +126,20 @@ func (_m *CloudTrailAPI) CreateTrailRequest(_a0 *cloudtrail.CreateTrailInput) (*
 		}
 	}
 
-	var r1 *cloudtrail.CreateTrailOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.CreateTrailInput) *cloudtrail.CreateTrailOutput); ok {
+	var r1 *cloudtrail.CancelQueryOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.CancelQueryInput) *cloudtrail.CancelQueryOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.CreateTrailOutput)
+			r1 = ret.Get(1).(*cloudtrail.CancelQueryOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// CreateTrailWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) CreateTrailWithContext(_a0 context.Context, _a1 *cloudtrail.CreateTrailInput, _a2 ...request.Option) (*cloudtrail.CreateTrailOutput, error) {
+// CancelQueryWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) CancelQueryWithContext(_a0 context.Context, _a1 *cloudtrail.CancelQueryInput, _a2 ...request.Option) (*cloudtrail.CancelQueryOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -150,17 +149,17 @@ func (_m *CloudTrailAPI) CreateTrailWithContext(_a0 context.Context, _a1 *cloudt
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.CreateTrailOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.CreateTrailInput, ...request.Option) *cloudtrail.CreateTrailOutput); ok {
+	var r0 *cloudtrail.CancelQueryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.CancelQueryInput, ...request.Option) *cloudtrail.CancelQueryOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.CreateTrailOutput)
+			r0 = ret.Get(0).(*cloudtrail.CancelQueryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.CreateTrailInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.CancelQueryInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -169,21 +168,21 @@ func (_m *CloudTrailAPI) CreateTrailWithContext(_a0 context.Context, _a1 *cloudt
 	return r0, r1
 }
 
-// DeleteTrail provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) DeleteTrail(_a0 *cloudtrail.DeleteTrailInput) (*cloudtrail.DeleteTrailOutput, error) {
+// CreateChannel provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) CreateChannel(_a0 *cloudtrail.CreateChannelInput) (*cloudtrail.CreateChannelOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.DeleteTrailOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteTrailInput) *cloudtrail.DeleteTrailOutput); ok {
+	var r0 *cloudtrail.CreateChannelOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.CreateChannelInput) *cloudtrail.CreateChannelOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.DeleteTrailOutput)
+			r0 = ret.Get(0).(*cloudtrail.CreateChannelOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteTrailInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.CreateChannelInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -192,12 +191,12 @@ func (_m *CloudTrailAPI) DeleteTrail(_a0 *cloudtrail.DeleteTrailInput) (*cloudtr
 	return r0, r1
 }
 
-// DeleteTrailRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) DeleteTrailRequest(_a0 *cloudtrail.DeleteTrailInput) (*request.Request, *cloudtrail.DeleteTrailOutput) {
+// CreateChannelRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) CreateChannelRequest(_a0 *cloudtrail.CreateChannelInput) (*request.Request, *cloudtrail.CreateChannelOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteTrailInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.CreateChannelInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -205,20 +204,20 @@ func (_m *CloudTrailAPI) DeleteTrailRequest(_a0 *cloudtrail.DeleteTrailInput) (*
 		}
 	}
 
-	var r1 *cloudtrail.DeleteTrailOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteTrailInput) *cloudtrail.DeleteTrailOutput); ok {
+	var r1 *cloudtrail.CreateChannelOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.CreateChannelInput) *cloudtrail.CreateChannelOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.DeleteTrailOutput)
+			r1 = ret.Get(1).(*cloudtrail.CreateChannelOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DeleteTrailWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) DeleteTrailWithContext(_a0 context.Context, _a1 *cloudtrail.DeleteTrailInput, _a2 ...request.Option) (*cloudtrail.DeleteTrailOutput, error) {
+// CreateChannelWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) CreateChannelWithContext(_a0 context.Context, _a1 *cloudtrail.CreateChannelInput, _a2 ...request.Option) (*cloudtrail.CreateChannelOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -228,17 +227,17 @@ func (_m *CloudTrailAPI) DeleteTrailWithContext(_a0 context.Context, _a1 *cloudt
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.DeleteTrailOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DeleteTrailInput, ...request.Option) *cloudtrail.DeleteTrailOutput); ok {
+	var r0 *cloudtrail.CreateChannelOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.CreateChannelInput, ...request.Option) *cloudtrail.CreateChannelOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.DeleteTrailOutput)
+			r0 = ret.Get(0).(*cloudtrail.CreateChannelOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DeleteTrailInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.CreateChannelInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -247,21 +246,21 @@ func (_m *CloudTrailAPI) DeleteTrailWithContext(_a0 context.Context, _a1 *cloudt
 	return r0, r1
 }
 
-// DescribeTrails provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) DescribeTrails(_a0 *cloudtrail.DescribeTrailsInput) (*cloudtrail.DescribeTrailsOutput, error) {
+// CreateEventDataStore provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) CreateEventDataStore(_a0 *cloudtrail.CreateEventDataStoreInput) (*cloudtrail.CreateEventDataStoreOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.DescribeTrailsOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.DescribeTrailsInput) *cloudtrail.DescribeTrailsOutput); ok {
+	var r0 *cloudtrail.CreateEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.CreateEventDataStoreInput) *cloudtrail.CreateEventDataStoreOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.DescribeTrailsOutput)
+			r0 = ret.Get(0).(*cloudtrail.CreateEventDataStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.DescribeTrailsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.CreateEventDataStoreInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -270,12 +269,12 @@ func (_m *CloudTrailAPI) DescribeTrails(_a0 *cloudtrail.DescribeTrailsInput) (*c
 	return r0, r1
 }
 
-// DescribeTrailsRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) DescribeTrailsRequest(_a0 *cloudtrail.DescribeTrailsInput) (*request.Request, *cloudtrail.DescribeTrailsOutput) {
+// CreateEventDataStoreRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) CreateEventDataStoreRequest(_a0 *cloudtrail.CreateEventDataStoreInput) (*request.Request, *cloudtrail.CreateEventDataStoreOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.DescribeTrailsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.CreateEventDataStoreInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -283,20 +282,20 @@ func (_m *CloudTrailAPI) DescribeTrailsRequest(_a0 *cloudtrail.DescribeTrailsInp
 		}
 	}
 
-	var r1 *cloudtrail.DescribeTrailsOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.DescribeTrailsInput) *cloudtrail.DescribeTrailsOutput); ok {
+	var r1 *cloudtrail.CreateEventDataStoreOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.CreateEventDataStoreInput) *cloudtrail.CreateEventDataStoreOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.DescribeTrailsOutput)
+			r1 = ret.Get(1).(*cloudtrail.CreateEventDataStoreOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// DescribeTrailsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) DescribeTrailsWithContext(_a0 context.Context, _a1 *cloudtrail.DescribeTrailsInput, _a2 ...request.Option) (*cloudtrail.DescribeTrailsOutput, error) {
+// CreateEventDataStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) CreateEventDataStoreWithContext(_a0 context.Context, _a1 *cloudtrail.CreateEventDataStoreInput, _a2 ...request.Option) (*cloudtrail.CreateEventDataStoreOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -306,17 +305,17 @@ func (_m *CloudTrailAPI) DescribeTrailsWithContext(_a0 context.Context, _a1 *clo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.DescribeTrailsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DescribeTrailsInput, ...request.Option) *cloudtrail.DescribeTrailsOutput); ok {
+	var r0 *cloudtrail.CreateEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.CreateEventDataStoreInput, ...request.Option) *cloudtrail.CreateEventDataStoreOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.DescribeTrailsOutput)
+			r0 = ret.Get(0).(*cloudtrail.CreateEventDataStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DescribeTrailsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.CreateEventDataStoreInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -325,21 +324,21 @@ func (_m *CloudTrailAPI) DescribeTrailsWithContext(_a0 context.Context, _a1 *clo
 	return r0, r1
 }
 
-// GetEventSelectors provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) GetEventSelectors(_a0 *cloudtrail.GetEventSelectorsInput) (*cloudtrail.GetEventSelectorsOutput, error) {
+// CreateTrail provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) CreateTrail(_a0 *cloudtrail.CreateTrailInput) (*cloudtrail.CreateTrailOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.GetEventSelectorsOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.GetEventSelectorsInput) *cloudtrail.GetEventSelectorsOutput); ok {
+	var r0 *cloudtrail.CreateTrailOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.CreateTrailInput) *cloudtrail.CreateTrailOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.GetEventSelectorsOutput)
+			r0 = ret.Get(0).(*cloudtrail.CreateTrailOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.GetEventSelectorsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.CreateTrailInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -348,12 +347,12 @@ func (_m *CloudTrailAPI) GetEventSelectors(_a0 *cloudtrail.GetEventSelectorsInpu
 	return r0, r1
 }
 
-// GetEventSelectorsRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) GetEventSelectorsRequest(_a0 *cloudtrail.GetEventSelectorsInput) (*request.Request, *cloudtrail.GetEventSelectorsOutput) {
+// CreateTrailRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) CreateTrailRequest(_a0 *cloudtrail.CreateTrailInput) (*request.Request, *cloudtrail.CreateTrailOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.GetEventSelectorsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.CreateTrailInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -361,20 +360,20 @@ func (_m *CloudTrailAPI) GetEventSelectorsRequest(_a0 *cloudtrail.GetEventSelect
 		}
 	}
 
-	var r1 *cloudtrail.GetEventSelectorsOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.GetEventSelectorsInput) *cloudtrail.GetEventSelectorsOutput); ok {
+	var r1 *cloudtrail.CreateTrailOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.CreateTrailInput) *cloudtrail.CreateTrailOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.GetEventSelectorsOutput)
+			r1 = ret.Get(1).(*cloudtrail.CreateTrailOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetEventSelectorsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) GetEventSelectorsWithContext(_a0 context.Context, _a1 *cloudtrail.GetEventSelectorsInput, _a2 ...request.Option) (*cloudtrail.GetEventSelectorsOutput, error) {
+// CreateTrailWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) CreateTrailWithContext(_a0 context.Context, _a1 *cloudtrail.CreateTrailInput, _a2 ...request.Option) (*cloudtrail.CreateTrailOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -384,17 +383,17 @@ func (_m *CloudTrailAPI) GetEventSelectorsWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.GetEventSelectorsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetEventSelectorsInput, ...request.Option) *cloudtrail.GetEventSelectorsOutput); ok {
+	var r0 *cloudtrail.CreateTrailOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.CreateTrailInput, ...request.Option) *cloudtrail.CreateTrailOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.GetEventSelectorsOutput)
+			r0 = ret.Get(0).(*cloudtrail.CreateTrailOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetEventSelectorsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.CreateTrailInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -403,21 +402,21 @@ func (_m *CloudTrailAPI) GetEventSelectorsWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// GetTrailStatus provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) GetTrailStatus(_a0 *cloudtrail.GetTrailStatusInput) (*cloudtrail.GetTrailStatusOutput, error) {
+// DeleteChannel provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeleteChannel(_a0 *cloudtrail.DeleteChannelInput) (*cloudtrail.DeleteChannelOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.GetTrailStatusOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.GetTrailStatusInput) *cloudtrail.GetTrailStatusOutput); ok {
+	var r0 *cloudtrail.DeleteChannelOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteChannelInput) *cloudtrail.DeleteChannelOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.GetTrailStatusOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeleteChannelOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.GetTrailStatusInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteChannelInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -426,12 +425,12 @@ func (_m *CloudTrailAPI) GetTrailStatus(_a0 *cloudtrail.GetTrailStatusInput) (*c
 	return r0, r1
 }
 
-// GetTrailStatusRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) GetTrailStatusRequest(_a0 *cloudtrail.GetTrailStatusInput) (*request.Request, *cloudtrail.GetTrailStatusOutput) {
+// DeleteChannelRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeleteChannelRequest(_a0 *cloudtrail.DeleteChannelInput) (*request.Request, *cloudtrail.DeleteChannelOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.GetTrailStatusInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteChannelInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -439,20 +438,20 @@ func (_m *CloudTrailAPI) GetTrailStatusRequest(_a0 *cloudtrail.GetTrailStatusInp
 		}
 	}
 
-	var r1 *cloudtrail.GetTrailStatusOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.GetTrailStatusInput) *cloudtrail.GetTrailStatusOutput); ok {
+	var r1 *cloudtrail.DeleteChannelOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteChannelInput) *cloudtrail.DeleteChannelOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.GetTrailStatusOutput)
+			r1 = ret.Get(1).(*cloudtrail.DeleteChannelOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// GetTrailStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) GetTrailStatusWithContext(_a0 context.Context, _a1 *cloudtrail.GetTrailStatusInput, _a2 ...request.Option) (*cloudtrail.GetTrailStatusOutput, error) {
+// DeleteChannelWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) DeleteChannelWithContext(_a0 context.Context, _a1 *cloudtrail.DeleteChannelInput, _a2 ...request.Option) (*cloudtrail.DeleteChannelOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -462,17 +461,17 @@ func (_m *CloudTrailAPI) GetTrailStatusWithContext(_a0 context.Context, _a1 *clo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.GetTrailStatusOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetTrailStatusInput, ...request.Option) *cloudtrail.GetTrailStatusOutput); ok {
+	var r0 *cloudtrail.DeleteChannelOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DeleteChannelInput, ...request.Option) *cloudtrail.DeleteChannelOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.GetTrailStatusOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeleteChannelOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetTrailStatusInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DeleteChannelInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -481,21 +480,21 @@ func (_m *CloudTrailAPI) GetTrailStatusWithContext(_a0 context.Context, _a1 *clo
 	return r0, r1
 }
 
-// ListPublicKeys provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) ListPublicKeys(_a0 *cloudtrail.ListPublicKeysInput) (*cloudtrail.ListPublicKeysOutput, error) {
+// DeleteEventDataStore provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeleteEventDataStore(_a0 *cloudtrail.DeleteEventDataStoreInput) (*cloudtrail.DeleteEventDataStoreOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.ListPublicKeysOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.ListPublicKeysInput) *cloudtrail.ListPublicKeysOutput); ok {
+	var r0 *cloudtrail.DeleteEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteEventDataStoreInput) *cloudtrail.DeleteEventDataStoreOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.ListPublicKeysOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeleteEventDataStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.ListPublicKeysInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteEventDataStoreInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -504,12 +503,12 @@ func (_m *CloudTrailAPI) ListPublicKeys(_a0 *cloudtrail.ListPublicKeysInput) (*c
 	return r0, r1
 }
 
-// ListPublicKeysRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) ListPublicKeysRequest(_a0 *cloudtrail.ListPublicKeysInput) (*request.Request, *cloudtrail.ListPublicKeysOutput) {
+// DeleteEventDataStoreRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeleteEventDataStoreRequest(_a0 *cloudtrail.DeleteEventDataStoreInput) (*request.Request, *cloudtrail.DeleteEventDataStoreOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.ListPublicKeysInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteEventDataStoreInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -517,20 +516,20 @@ func (_m *CloudTrailAPI) ListPublicKeysRequest(_a0 *cloudtrail.ListPublicKeysInp
 		}
 	}
 
-	var r1 *cloudtrail.ListPublicKeysOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.ListPublicKeysInput) *cloudtrail.ListPublicKeysOutput); ok {
+	var r1 *cloudtrail.DeleteEventDataStoreOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteEventDataStoreInput) *cloudtrail.DeleteEventDataStoreOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.ListPublicKeysOutput)
+			r1 = ret.Get(1).(*cloudtrail.DeleteEventDataStoreOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListPublicKeysWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) ListPublicKeysWithContext(_a0 context.Context, _a1 *cloudtrail.ListPublicKeysInput, _a2 ...request.Option) (*cloudtrail.ListPublicKeysOutput, error) {
+// DeleteEventDataStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) DeleteEventDataStoreWithContext(_a0 context.Context, _a1 *cloudtrail.DeleteEventDataStoreInput, _a2 ...request.Option) (*cloudtrail.DeleteEventDataStoreOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -540,17 +539,17 @@ func (_m *CloudTrailAPI) ListPublicKeysWithContext(_a0 context.Context, _a1 *clo
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.ListPublicKeysOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListPublicKeysInput, ...request.Option) *cloudtrail.ListPublicKeysOutput); ok {
+	var r0 *cloudtrail.DeleteEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DeleteEventDataStoreInput, ...request.Option) *cloudtrail.DeleteEventDataStoreOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.ListPublicKeysOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeleteEventDataStoreOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListPublicKeysInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DeleteEventDataStoreInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -559,21 +558,21 @@ func (_m *CloudTrailAPI) ListPublicKeysWithContext(_a0 context.Context, _a1 *clo
 	return r0, r1
 }
 
-// ListTags provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) ListTags(_a0 *cloudtrail.ListTagsInput) (*cloudtrail.ListTagsOutput, error) {
+// DeleteResourcePolicy provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeleteResourcePolicy(_a0 *cloudtrail.DeleteResourcePolicyInput) (*cloudtrail.DeleteResourcePolicyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.ListTagsOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.ListTagsInput) *cloudtrail.ListTagsOutput); ok {
+	var r0 *cloudtrail.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteResourcePolicyInput) *cloudtrail.DeleteResourcePolicyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.ListTagsOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeleteResourcePolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.ListTagsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteResourcePolicyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -582,12 +581,12 @@ func (_m *CloudTrailAPI) ListTags(_a0 *cloudtrail.ListTagsInput) (*cloudtrail.Li
 	return r0, r1
 }
 
-// ListTagsRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) ListTagsRequest(_a0 *cloudtrail.ListTagsInput) (*request.Request, *cloudtrail.ListTagsOutput) {
+// DeleteResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeleteResourcePolicyRequest(_a0 *cloudtrail.DeleteResourcePolicyInput) (*request.Request, *cloudtrail.DeleteResourcePolicyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.ListTagsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteResourcePolicyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -595,20 +594,20 @@ func (_m *CloudTrailAPI) ListTagsRequest(_a0 *cloudtrail.ListTagsInput) (*reques
 		}
 	}
 
-	var r1 *cloudtrail.ListTagsOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.ListTagsInput) *cloudtrail.ListTagsOutput); ok {
+	var r1 *cloudtrail.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteResourcePolicyInput) *cloudtrail.DeleteResourcePolicyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.ListTagsOutput)
+			r1 = ret.Get(1).(*cloudtrail.DeleteResourcePolicyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) ListTagsWithContext(_a0 context.Context, _a1 *cloudtrail.ListTagsInput, _a2 ...request.Option) (*cloudtrail.ListTagsOutput, error) {
+// DeleteResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) DeleteResourcePolicyWithContext(_a0 context.Context, _a1 *cloudtrail.DeleteResourcePolicyInput, _a2 ...request.Option) (*cloudtrail.DeleteResourcePolicyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -618,17 +617,17 @@ func (_m *CloudTrailAPI) ListTagsWithContext(_a0 context.Context, _a1 *cloudtrai
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.ListTagsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListTagsInput, ...request.Option) *cloudtrail.ListTagsOutput); ok {
+	var r0 *cloudtrail.DeleteResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DeleteResourcePolicyInput, ...request.Option) *cloudtrail.DeleteResourcePolicyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.ListTagsOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeleteResourcePolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListTagsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DeleteResourcePolicyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -637,21 +636,21 @@ func (_m *CloudTrailAPI) ListTagsWithContext(_a0 context.Context, _a1 *cloudtrai
 	return r0, r1
 }
 
-// LookupEvents provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) LookupEvents(_a0 *cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error) {
+// DeleteTrail provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeleteTrail(_a0 *cloudtrail.DeleteTrailInput) (*cloudtrail.DeleteTrailOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.LookupEventsOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.LookupEventsInput) *cloudtrail.LookupEventsOutput); ok {
+	var r0 *cloudtrail.DeleteTrailOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteTrailInput) *cloudtrail.DeleteTrailOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.LookupEventsOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeleteTrailOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.LookupEventsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteTrailInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -660,47 +659,12 @@ func (_m *CloudTrailAPI) LookupEvents(_a0 *cloudtrail.LookupEventsInput) (*cloud
 	return r0, r1
 }
 
-// LookupEventsPages provides a mock function with given fields: _a0, _a1
-func (_m *CloudTrailAPI) LookupEventsPages(_a0 *cloudtrail.LookupEventsInput, _a1 func(*cloudtrail.LookupEventsOutput, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*cloudtrail.LookupEventsInput, func(*cloudtrail.LookupEventsOutput, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// LookupEventsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *CloudTrailAPI) LookupEventsPagesWithContext(_a0 context.Context, _a1 *cloudtrail.LookupEventsInput, _a2 func(*cloudtrail.LookupEventsOutput, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.LookupEventsInput, func(*cloudtrail.LookupEventsOutput, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// LookupEventsRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) LookupEventsRequest(_a0 *cloudtrail.LookupEventsInput) (*request.Request, *cloudtrail.LookupEventsOutput) {
+// DeleteTrailRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeleteTrailRequest(_a0 *cloudtrail.DeleteTrailInput) (*request.Request, *cloudtrail.DeleteTrailOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.LookupEventsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeleteTrailInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -708,20 +672,20 @@ func (_m *CloudTrailAPI) LookupEventsRequest(_a0 *cloudtrail.LookupEventsInput)
 		}
 	}
 
-	var r1 *cloudtrail.LookupEventsOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.LookupEventsInput) *cloudtrail.LookupEventsOutput); ok {
+	var r1 *cloudtrail.DeleteTrailOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeleteTrailInput) *cloudtrail.DeleteTrailOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.LookupEventsOutput)
+			r1 = ret.Get(1).(*cloudtrail.DeleteTrailOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// LookupEventsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) LookupEventsWithContext(_a0 context.Context, _a1 *cloudtrail.LookupEventsInput, _a2 ...request.Option) (*cloudtrail.LookupEventsOutput, error) {
+// DeleteTrailWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) DeleteTrailWithContext(_a0 context.Context, _a1 *cloudtrail.DeleteTrailInput, _a2 ...request.Option) (*cloudtrail.DeleteTrailOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -731,17 +695,17 @@ func (_m *CloudTrailAPI) LookupEventsWithContext(_a0 context.Context, _a1 *cloud
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.LookupEventsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.LookupEventsInput, ...request.Option) *cloudtrail.LookupEventsOutput); ok {
+	var r0 *cloudtrail.DeleteTrailOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DeleteTrailInput, ...request.Option) *cloudtrail.DeleteTrailOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.LookupEventsOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeleteTrailOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.LookupEventsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DeleteTrailInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -750,21 +714,21 @@ func (_m *CloudTrailAPI) LookupEventsWithContext(_a0 context.Context, _a1 *cloud
 	return r0, r1
 }
 
-// PutEventSelectors provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) PutEventSelectors(_a0 *cloudtrail.PutEventSelectorsInput) (*cloudtrail.PutEventSelectorsOutput, error) {
+// DeregisterOrganizationDelegatedAdmin provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeregisterOrganizationDelegatedAdmin(_a0 *cloudtrail.DeregisterOrganizationDelegatedAdminInput) (*cloudtrail.DeregisterOrganizationDelegatedAdminOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.PutEventSelectorsOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.PutEventSelectorsInput) *cloudtrail.PutEventSelectorsOutput); ok {
+	var r0 *cloudtrail.DeregisterOrganizationDelegatedAdminOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeregisterOrganizationDelegatedAdminInput) *cloudtrail.DeregisterOrganizationDelegatedAdminOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.PutEventSelectorsOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeregisterOrganizationDelegatedAdminOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.PutEventSelectorsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeregisterOrganizationDelegatedAdminInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -773,12 +737,12 @@ func (_m *CloudTrailAPI) PutEventSelectors(_a0 *cloudtrail.PutEventSelectorsInpu
 	return r0, r1
 }
 
-// PutEventSelectorsRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) PutEventSelectorsRequest(_a0 *cloudtrail.PutEventSelectorsInput) (*request.Request, *cloudtrail.PutEventSelectorsOutput) {
+// DeregisterOrganizationDelegatedAdminRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DeregisterOrganizationDelegatedAdminRequest(_a0 *cloudtrail.DeregisterOrganizationDelegatedAdminInput) (*request.Request, *cloudtrail.DeregisterOrganizationDelegatedAdminOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.PutEventSelectorsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DeregisterOrganizationDelegatedAdminInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -786,20 +750,20 @@ func (_m *CloudTrailAPI) PutEventSelectorsRequest(_a0 *cloudtrail.PutEventSelect
 		}
 	}
 
-	var r1 *cloudtrail.PutEventSelectorsOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.PutEventSelectorsInput) *cloudtrail.PutEventSelectorsOutput); ok {
+	var r1 *cloudtrail.DeregisterOrganizationDelegatedAdminOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DeregisterOrganizationDelegatedAdminInput) *cloudtrail.DeregisterOrganizationDelegatedAdminOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.PutEventSelectorsOutput)
+			r1 = ret.Get(1).(*cloudtrail.DeregisterOrganizationDelegatedAdminOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PutEventSelectorsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) PutEventSelectorsWithContext(_a0 context.Context, _a1 *cloudtrail.PutEventSelectorsInput, _a2 ...request.Option) (*cloudtrail.PutEventSelectorsOutput, error) {
+// DeregisterOrganizationDelegatedAdminWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) DeregisterOrganizationDelegatedAdminWithContext(_a0 context.Context, _a1 *cloudtrail.DeregisterOrganizationDelegatedAdminInput, _a2 ...request.Option) (*cloudtrail.DeregisterOrganizationDelegatedAdminOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -809,17 +773,17 @@ func (_m *CloudTrailAPI) PutEventSelectorsWithContext(_a0 context.Context, _a1 *
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.PutEventSelectorsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.PutEventSelectorsInput, ...request.Option) *cloudtrail.PutEventSelectorsOutput); ok {
+	var r0 *cloudtrail.DeregisterOrganizationDelegatedAdminOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DeregisterOrganizationDelegatedAdminInput, ...request.Option) *cloudtrail.DeregisterOrganizationDelegatedAdminOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.PutEventSelectorsOutput)
+			r0 = ret.Get(0).(*cloudtrail.DeregisterOrganizationDelegatedAdminOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.PutEventSelectorsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DeregisterOrganizationDelegatedAdminInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -828,21 +792,21 @@ func (_m *CloudTrailAPI) PutEventSelectorsWithContext(_a0 context.Context, _a1 *
 	return r0, r1
 }
 
-// RemoveTags provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) RemoveTags(_a0 *cloudtrail.RemoveTagsInput) (*cloudtrail.RemoveTagsOutput, error) {
+// DescribeQuery provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DescribeQuery(_a0 *cloudtrail.DescribeQueryInput) (*cloudtrail.DescribeQueryOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *cloudtrail.RemoveTagsOutput
-	if rf, ok := ret.Get(0).(func(*cloudtrail.RemoveTagsInput) *cloudtrail.RemoveTagsOutput); ok {
+	var r0 *cloudtrail.DescribeQueryOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DescribeQueryInput) *cloudtrail.DescribeQueryOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.RemoveTagsOutput)
+			r0 = ret.Get(0).(*cloudtrail.DescribeQueryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.RemoveTagsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DescribeQueryInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -851,12 +815,12 @@ func (_m *CloudTrailAPI) RemoveTags(_a0 *cloudtrail.RemoveTagsInput) (*cloudtrai
 	return r0, r1
 }
 
-// RemoveTagsRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) RemoveTagsRequest(_a0 *cloudtrail.RemoveTagsInput) (*request.Request, *cloudtrail.RemoveTagsOutput) {
+// DescribeQueryRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DescribeQueryRequest(_a0 *cloudtrail.DescribeQueryInput) (*request.Request, *cloudtrail.DescribeQueryOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.RemoveTagsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DescribeQueryInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -864,20 +828,20 @@ func (_m *CloudTrailAPI) RemoveTagsRequest(_a0 *cloudtrail.RemoveTagsInput) (*re
 		}
 	}
 
-	var r1 *cloudtrail.RemoveTagsOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.RemoveTagsInput) *cloudtrail.RemoveTagsOutput); ok {
+	var r1 *cloudtrail.DescribeQueryOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DescribeQueryInput) *cloudtrail.DescribeQueryOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.RemoveTagsOutput)
+			r1 = ret.Get(1).(*cloudtrail.DescribeQueryOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) RemoveTagsWithContext(_a0 context.Context, _a1 *cloudtrail.RemoveTagsInput, _a2 ...request.Option) (*cloudtrail.RemoveTagsOutput, error) {
+// DescribeQueryWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) DescribeQueryWithContext(_a0 context.Context, _a1 *cloudtrail.DescribeQueryInput, _a2 ...request.Option) (*cloudtrail.DescribeQueryOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -887,17 +851,17 @@ func (_m *CloudTrailAPI) RemoveTagsWithContext(_a0 context.Context, _a1 *cloudtr
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.RemoveTagsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.RemoveTagsInput, ...request.Option) *cloudtrail.RemoveTagsOutput); ok {
+	var r0 *cloudtrail.DescribeQueryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DescribeQueryInput, ...request.Option) *cloudtrail.DescribeQueryOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.RemoveTagsOutput)
+			r0 = ret.Get(0).(*cloudtrail.DescribeQueryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.RemoveTagsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DescribeQueryInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -906,8 +870,2620 @@ func (_m *CloudTrailAPI) RemoveTagsWithContext(_a0 context.Context, _a1 *cloudtr
 	return r0, r1
 }
 
-// StartLogging provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) StartLogging(_a0 *cloudtrail.StartLoggingInput) (*cloudtrail.StartLoggingOutput, error) {
+// DescribeTrails provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DescribeTrails(_a0 *cloudtrail.DescribeTrailsInput) (*cloudtrail.DescribeTrailsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.DescribeTrailsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DescribeTrailsInput) *cloudtrail.DescribeTrailsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.DescribeTrailsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DescribeTrailsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DescribeTrailsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DescribeTrailsRequest(_a0 *cloudtrail.DescribeTrailsInput) (*request.Request, *cloudtrail.DescribeTrailsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DescribeTrailsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.DescribeTrailsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DescribeTrailsInput) *cloudtrail.DescribeTrailsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.DescribeTrailsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DescribeTrailsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) DescribeTrailsWithContext(_a0 context.Context, _a1 *cloudtrail.DescribeTrailsInput, _a2 ...request.Option) (*cloudtrail.DescribeTrailsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.DescribeTrailsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DescribeTrailsInput, ...request.Option) *cloudtrail.DescribeTrailsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.DescribeTrailsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DescribeTrailsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableFederation provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DisableFederation(_a0 *cloudtrail.DisableFederationInput) (*cloudtrail.DisableFederationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.DisableFederationOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DisableFederationInput) *cloudtrail.DisableFederationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.DisableFederationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DisableFederationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DisableFederationRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) DisableFederationRequest(_a0 *cloudtrail.DisableFederationInput) (*request.Request, *cloudtrail.DisableFederationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.DisableFederationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.DisableFederationOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.DisableFederationInput) *cloudtrail.DisableFederationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.DisableFederationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// DisableFederationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) DisableFederationWithContext(_a0 context.Context, _a1 *cloudtrail.DisableFederationInput, _a2 ...request.Option) (*cloudtrail.DisableFederationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.DisableFederationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.DisableFederationInput, ...request.Option) *cloudtrail.DisableFederationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.DisableFederationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.DisableFederationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableFederation provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) EnableFederation(_a0 *cloudtrail.EnableFederationInput) (*cloudtrail.EnableFederationOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.EnableFederationOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.EnableFederationInput) *cloudtrail.EnableFederationOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.EnableFederationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.EnableFederationInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// EnableFederationRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) EnableFederationRequest(_a0 *cloudtrail.EnableFederationInput) (*request.Request, *cloudtrail.EnableFederationOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.EnableFederationInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.EnableFederationOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.EnableFederationInput) *cloudtrail.EnableFederationOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.EnableFederationOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// EnableFederationWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) EnableFederationWithContext(_a0 context.Context, _a1 *cloudtrail.EnableFederationInput, _a2 ...request.Option) (*cloudtrail.EnableFederationOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.EnableFederationOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.EnableFederationInput, ...request.Option) *cloudtrail.EnableFederationOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.EnableFederationOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.EnableFederationInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChannel provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetChannel(_a0 *cloudtrail.GetChannelInput) (*cloudtrail.GetChannelOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetChannelOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetChannelInput) *cloudtrail.GetChannelOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetChannelOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetChannelInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetChannelRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetChannelRequest(_a0 *cloudtrail.GetChannelInput) (*request.Request, *cloudtrail.GetChannelOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetChannelInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetChannelOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetChannelInput) *cloudtrail.GetChannelOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetChannelOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetChannelWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetChannelWithContext(_a0 context.Context, _a1 *cloudtrail.GetChannelInput, _a2 ...request.Option) (*cloudtrail.GetChannelOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetChannelOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetChannelInput, ...request.Option) *cloudtrail.GetChannelOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetChannelOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetChannelInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventDataStore provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetEventDataStore(_a0 *cloudtrail.GetEventDataStoreInput) (*cloudtrail.GetEventDataStoreOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetEventDataStoreInput) *cloudtrail.GetEventDataStoreOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetEventDataStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetEventDataStoreInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventDataStoreRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetEventDataStoreRequest(_a0 *cloudtrail.GetEventDataStoreInput) (*request.Request, *cloudtrail.GetEventDataStoreOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetEventDataStoreInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetEventDataStoreOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetEventDataStoreInput) *cloudtrail.GetEventDataStoreOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetEventDataStoreOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetEventDataStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetEventDataStoreWithContext(_a0 context.Context, _a1 *cloudtrail.GetEventDataStoreInput, _a2 ...request.Option) (*cloudtrail.GetEventDataStoreOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetEventDataStoreInput, ...request.Option) *cloudtrail.GetEventDataStoreOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetEventDataStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetEventDataStoreInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventSelectors provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetEventSelectors(_a0 *cloudtrail.GetEventSelectorsInput) (*cloudtrail.GetEventSelectorsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetEventSelectorsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetEventSelectorsInput) *cloudtrail.GetEventSelectorsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetEventSelectorsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetEventSelectorsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEventSelectorsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetEventSelectorsRequest(_a0 *cloudtrail.GetEventSelectorsInput) (*request.Request, *cloudtrail.GetEventSelectorsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetEventSelectorsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetEventSelectorsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetEventSelectorsInput) *cloudtrail.GetEventSelectorsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetEventSelectorsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetEventSelectorsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetEventSelectorsWithContext(_a0 context.Context, _a1 *cloudtrail.GetEventSelectorsInput, _a2 ...request.Option) (*cloudtrail.GetEventSelectorsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetEventSelectorsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetEventSelectorsInput, ...request.Option) *cloudtrail.GetEventSelectorsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetEventSelectorsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetEventSelectorsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetImport provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetImport(_a0 *cloudtrail.GetImportInput) (*cloudtrail.GetImportOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetImportOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetImportInput) *cloudtrail.GetImportOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetImportInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetImportRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetImportRequest(_a0 *cloudtrail.GetImportInput) (*request.Request, *cloudtrail.GetImportOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetImportInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetImportOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetImportInput) *cloudtrail.GetImportOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetImportOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetImportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetImportWithContext(_a0 context.Context, _a1 *cloudtrail.GetImportInput, _a2 ...request.Option) (*cloudtrail.GetImportOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetImportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetImportInput, ...request.Option) *cloudtrail.GetImportOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetImportInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInsightSelectors provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetInsightSelectors(_a0 *cloudtrail.GetInsightSelectorsInput) (*cloudtrail.GetInsightSelectorsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetInsightSelectorsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetInsightSelectorsInput) *cloudtrail.GetInsightSelectorsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetInsightSelectorsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetInsightSelectorsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInsightSelectorsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetInsightSelectorsRequest(_a0 *cloudtrail.GetInsightSelectorsInput) (*request.Request, *cloudtrail.GetInsightSelectorsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetInsightSelectorsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetInsightSelectorsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetInsightSelectorsInput) *cloudtrail.GetInsightSelectorsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetInsightSelectorsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetInsightSelectorsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetInsightSelectorsWithContext(_a0 context.Context, _a1 *cloudtrail.GetInsightSelectorsInput, _a2 ...request.Option) (*cloudtrail.GetInsightSelectorsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetInsightSelectorsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetInsightSelectorsInput, ...request.Option) *cloudtrail.GetInsightSelectorsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetInsightSelectorsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetInsightSelectorsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQueryResults provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetQueryResults(_a0 *cloudtrail.GetQueryResultsInput) (*cloudtrail.GetQueryResultsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetQueryResultsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetQueryResultsInput) *cloudtrail.GetQueryResultsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetQueryResultsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetQueryResultsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetQueryResultsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) GetQueryResultsPages(_a0 *cloudtrail.GetQueryResultsInput, _a1 func(*cloudtrail.GetQueryResultsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetQueryResultsInput, func(*cloudtrail.GetQueryResultsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetQueryResultsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) GetQueryResultsPagesWithContext(_a0 context.Context, _a1 *cloudtrail.GetQueryResultsInput, _a2 func(*cloudtrail.GetQueryResultsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetQueryResultsInput, func(*cloudtrail.GetQueryResultsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetQueryResultsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetQueryResultsRequest(_a0 *cloudtrail.GetQueryResultsInput) (*request.Request, *cloudtrail.GetQueryResultsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetQueryResultsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetQueryResultsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetQueryResultsInput) *cloudtrail.GetQueryResultsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetQueryResultsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetQueryResultsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetQueryResultsWithContext(_a0 context.Context, _a1 *cloudtrail.GetQueryResultsInput, _a2 ...request.Option) (*cloudtrail.GetQueryResultsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetQueryResultsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetQueryResultsInput, ...request.Option) *cloudtrail.GetQueryResultsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetQueryResultsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetQueryResultsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicy provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetResourcePolicy(_a0 *cloudtrail.GetResourcePolicyInput) (*cloudtrail.GetResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetResourcePolicyInput) *cloudtrail.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetResourcePolicyRequest(_a0 *cloudtrail.GetResourcePolicyInput) (*request.Request, *cloudtrail.GetResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetResourcePolicyInput) *cloudtrail.GetResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetResourcePolicyWithContext(_a0 context.Context, _a1 *cloudtrail.GetResourcePolicyInput, _a2 ...request.Option) (*cloudtrail.GetResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetResourcePolicyInput, ...request.Option) *cloudtrail.GetResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrail provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetTrail(_a0 *cloudtrail.GetTrailInput) (*cloudtrail.GetTrailOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetTrailOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetTrailInput) *cloudtrail.GetTrailOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetTrailOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetTrailInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrailRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetTrailRequest(_a0 *cloudtrail.GetTrailInput) (*request.Request, *cloudtrail.GetTrailOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetTrailInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetTrailOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetTrailInput) *cloudtrail.GetTrailOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetTrailOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTrailStatus provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetTrailStatus(_a0 *cloudtrail.GetTrailStatusInput) (*cloudtrail.GetTrailStatusOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.GetTrailStatusOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetTrailStatusInput) *cloudtrail.GetTrailStatusOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetTrailStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetTrailStatusInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrailStatusRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) GetTrailStatusRequest(_a0 *cloudtrail.GetTrailStatusInput) (*request.Request, *cloudtrail.GetTrailStatusOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.GetTrailStatusInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.GetTrailStatusOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.GetTrailStatusInput) *cloudtrail.GetTrailStatusOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.GetTrailStatusOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetTrailStatusWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetTrailStatusWithContext(_a0 context.Context, _a1 *cloudtrail.GetTrailStatusInput, _a2 ...request.Option) (*cloudtrail.GetTrailStatusOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetTrailStatusOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetTrailStatusInput, ...request.Option) *cloudtrail.GetTrailStatusOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetTrailStatusOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetTrailStatusInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTrailWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) GetTrailWithContext(_a0 context.Context, _a1 *cloudtrail.GetTrailInput, _a2 ...request.Option) (*cloudtrail.GetTrailOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.GetTrailOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.GetTrailInput, ...request.Option) *cloudtrail.GetTrailOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.GetTrailOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.GetTrailInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChannels provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListChannels(_a0 *cloudtrail.ListChannelsInput) (*cloudtrail.ListChannelsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.ListChannelsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListChannelsInput) *cloudtrail.ListChannelsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListChannelsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListChannelsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListChannelsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) ListChannelsPages(_a0 *cloudtrail.ListChannelsInput, _a1 func(*cloudtrail.ListChannelsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListChannelsInput, func(*cloudtrail.ListChannelsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListChannelsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) ListChannelsPagesWithContext(_a0 context.Context, _a1 *cloudtrail.ListChannelsInput, _a2 func(*cloudtrail.ListChannelsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListChannelsInput, func(*cloudtrail.ListChannelsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListChannelsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListChannelsRequest(_a0 *cloudtrail.ListChannelsInput) (*request.Request, *cloudtrail.ListChannelsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListChannelsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.ListChannelsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListChannelsInput) *cloudtrail.ListChannelsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.ListChannelsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListChannelsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) ListChannelsWithContext(_a0 context.Context, _a1 *cloudtrail.ListChannelsInput, _a2 ...request.Option) (*cloudtrail.ListChannelsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.ListChannelsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListChannelsInput, ...request.Option) *cloudtrail.ListChannelsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListChannelsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListChannelsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEventDataStores provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListEventDataStores(_a0 *cloudtrail.ListEventDataStoresInput) (*cloudtrail.ListEventDataStoresOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.ListEventDataStoresOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListEventDataStoresInput) *cloudtrail.ListEventDataStoresOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListEventDataStoresOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListEventDataStoresInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListEventDataStoresPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) ListEventDataStoresPages(_a0 *cloudtrail.ListEventDataStoresInput, _a1 func(*cloudtrail.ListEventDataStoresOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListEventDataStoresInput, func(*cloudtrail.ListEventDataStoresOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListEventDataStoresPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) ListEventDataStoresPagesWithContext(_a0 context.Context, _a1 *cloudtrail.ListEventDataStoresInput, _a2 func(*cloudtrail.ListEventDataStoresOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListEventDataStoresInput, func(*cloudtrail.ListEventDataStoresOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListEventDataStoresRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListEventDataStoresRequest(_a0 *cloudtrail.ListEventDataStoresInput) (*request.Request, *cloudtrail.ListEventDataStoresOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListEventDataStoresInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.ListEventDataStoresOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListEventDataStoresInput) *cloudtrail.ListEventDataStoresOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.ListEventDataStoresOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListEventDataStoresWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) ListEventDataStoresWithContext(_a0 context.Context, _a1 *cloudtrail.ListEventDataStoresInput, _a2 ...request.Option) (*cloudtrail.ListEventDataStoresOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.ListEventDataStoresOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListEventDataStoresInput, ...request.Option) *cloudtrail.ListEventDataStoresOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListEventDataStoresOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListEventDataStoresInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImportFailures provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListImportFailures(_a0 *cloudtrail.ListImportFailuresInput) (*cloudtrail.ListImportFailuresOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.ListImportFailuresOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListImportFailuresInput) *cloudtrail.ListImportFailuresOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListImportFailuresOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListImportFailuresInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImportFailuresPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) ListImportFailuresPages(_a0 *cloudtrail.ListImportFailuresInput, _a1 func(*cloudtrail.ListImportFailuresOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListImportFailuresInput, func(*cloudtrail.ListImportFailuresOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportFailuresPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) ListImportFailuresPagesWithContext(_a0 context.Context, _a1 *cloudtrail.ListImportFailuresInput, _a2 func(*cloudtrail.ListImportFailuresOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListImportFailuresInput, func(*cloudtrail.ListImportFailuresOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportFailuresRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListImportFailuresRequest(_a0 *cloudtrail.ListImportFailuresInput) (*request.Request, *cloudtrail.ListImportFailuresOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListImportFailuresInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.ListImportFailuresOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListImportFailuresInput) *cloudtrail.ListImportFailuresOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.ListImportFailuresOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListImportFailuresWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) ListImportFailuresWithContext(_a0 context.Context, _a1 *cloudtrail.ListImportFailuresInput, _a2 ...request.Option) (*cloudtrail.ListImportFailuresOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.ListImportFailuresOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListImportFailuresInput, ...request.Option) *cloudtrail.ListImportFailuresOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListImportFailuresOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListImportFailuresInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImports provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListImports(_a0 *cloudtrail.ListImportsInput) (*cloudtrail.ListImportsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListImportsInput) *cloudtrail.ListImportsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListImportsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListImportsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) ListImportsPages(_a0 *cloudtrail.ListImportsInput, _a1 func(*cloudtrail.ListImportsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListImportsInput, func(*cloudtrail.ListImportsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) ListImportsPagesWithContext(_a0 context.Context, _a1 *cloudtrail.ListImportsInput, _a2 func(*cloudtrail.ListImportsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListImportsInput, func(*cloudtrail.ListImportsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListImportsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListImportsRequest(_a0 *cloudtrail.ListImportsInput) (*request.Request, *cloudtrail.ListImportsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListImportsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.ListImportsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListImportsInput) *cloudtrail.ListImportsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.ListImportsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListImportsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) ListImportsWithContext(_a0 context.Context, _a1 *cloudtrail.ListImportsInput, _a2 ...request.Option) (*cloudtrail.ListImportsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.ListImportsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListImportsInput, ...request.Option) *cloudtrail.ListImportsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListImportsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListImportsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPublicKeys provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListPublicKeys(_a0 *cloudtrail.ListPublicKeysInput) (*cloudtrail.ListPublicKeysOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.ListPublicKeysOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListPublicKeysInput) *cloudtrail.ListPublicKeysOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListPublicKeysOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListPublicKeysInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPublicKeysPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) ListPublicKeysPages(_a0 *cloudtrail.ListPublicKeysInput, _a1 func(*cloudtrail.ListPublicKeysOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListPublicKeysInput, func(*cloudtrail.ListPublicKeysOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListPublicKeysPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) ListPublicKeysPagesWithContext(_a0 context.Context, _a1 *cloudtrail.ListPublicKeysInput, _a2 func(*cloudtrail.ListPublicKeysOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListPublicKeysInput, func(*cloudtrail.ListPublicKeysOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListPublicKeysRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListPublicKeysRequest(_a0 *cloudtrail.ListPublicKeysInput) (*request.Request, *cloudtrail.ListPublicKeysOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListPublicKeysInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.ListPublicKeysOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListPublicKeysInput) *cloudtrail.ListPublicKeysOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.ListPublicKeysOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListPublicKeysWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) ListPublicKeysWithContext(_a0 context.Context, _a1 *cloudtrail.ListPublicKeysInput, _a2 ...request.Option) (*cloudtrail.ListPublicKeysOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.ListPublicKeysOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListPublicKeysInput, ...request.Option) *cloudtrail.ListPublicKeysOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListPublicKeysOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListPublicKeysInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListQueries provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListQueries(_a0 *cloudtrail.ListQueriesInput) (*cloudtrail.ListQueriesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.ListQueriesOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListQueriesInput) *cloudtrail.ListQueriesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListQueriesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListQueriesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListQueriesPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) ListQueriesPages(_a0 *cloudtrail.ListQueriesInput, _a1 func(*cloudtrail.ListQueriesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListQueriesInput, func(*cloudtrail.ListQueriesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListQueriesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) ListQueriesPagesWithContext(_a0 context.Context, _a1 *cloudtrail.ListQueriesInput, _a2 func(*cloudtrail.ListQueriesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListQueriesInput, func(*cloudtrail.ListQueriesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListQueriesRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListQueriesRequest(_a0 *cloudtrail.ListQueriesInput) (*request.Request, *cloudtrail.ListQueriesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListQueriesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.ListQueriesOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListQueriesInput) *cloudtrail.ListQueriesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.ListQueriesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListQueriesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) ListQueriesWithContext(_a0 context.Context, _a1 *cloudtrail.ListQueriesInput, _a2 ...request.Option) (*cloudtrail.ListQueriesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.ListQueriesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListQueriesInput, ...request.Option) *cloudtrail.ListQueriesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListQueriesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListQueriesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTags provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListTags(_a0 *cloudtrail.ListTagsInput) (*cloudtrail.ListTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.ListTagsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListTagsInput) *cloudtrail.ListTagsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListTagsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) ListTagsPages(_a0 *cloudtrail.ListTagsInput, _a1 func(*cloudtrail.ListTagsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListTagsInput, func(*cloudtrail.ListTagsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) ListTagsPagesWithContext(_a0 context.Context, _a1 *cloudtrail.ListTagsInput, _a2 func(*cloudtrail.ListTagsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListTagsInput, func(*cloudtrail.ListTagsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTagsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListTagsRequest(_a0 *cloudtrail.ListTagsInput) (*request.Request, *cloudtrail.ListTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.ListTagsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListTagsInput) *cloudtrail.ListTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.ListTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) ListTagsWithContext(_a0 context.Context, _a1 *cloudtrail.ListTagsInput, _a2 ...request.Option) (*cloudtrail.ListTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.ListTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListTagsInput, ...request.Option) *cloudtrail.ListTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTrails provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListTrails(_a0 *cloudtrail.ListTrailsInput) (*cloudtrail.ListTrailsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.ListTrailsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListTrailsInput) *cloudtrail.ListTrailsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListTrailsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListTrailsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListTrailsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) ListTrailsPages(_a0 *cloudtrail.ListTrailsInput, _a1 func(*cloudtrail.ListTrailsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListTrailsInput, func(*cloudtrail.ListTrailsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTrailsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) ListTrailsPagesWithContext(_a0 context.Context, _a1 *cloudtrail.ListTrailsInput, _a2 func(*cloudtrail.ListTrailsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListTrailsInput, func(*cloudtrail.ListTrailsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListTrailsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) ListTrailsRequest(_a0 *cloudtrail.ListTrailsInput) (*request.Request, *cloudtrail.ListTrailsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.ListTrailsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.ListTrailsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.ListTrailsInput) *cloudtrail.ListTrailsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.ListTrailsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListTrailsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) ListTrailsWithContext(_a0 context.Context, _a1 *cloudtrail.ListTrailsInput, _a2 ...request.Option) (*cloudtrail.ListTrailsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.ListTrailsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.ListTrailsInput, ...request.Option) *cloudtrail.ListTrailsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.ListTrailsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.ListTrailsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupEvents provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) LookupEvents(_a0 *cloudtrail.LookupEventsInput) (*cloudtrail.LookupEventsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.LookupEventsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.LookupEventsInput) *cloudtrail.LookupEventsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.LookupEventsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.LookupEventsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LookupEventsPages provides a mock function with given fields: _a0, _a1
+func (_m *CloudTrailAPI) LookupEventsPages(_a0 *cloudtrail.LookupEventsInput, _a1 func(*cloudtrail.LookupEventsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*cloudtrail.LookupEventsInput, func(*cloudtrail.LookupEventsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LookupEventsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *CloudTrailAPI) LookupEventsPagesWithContext(_a0 context.Context, _a1 *cloudtrail.LookupEventsInput, _a2 func(*cloudtrail.LookupEventsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.LookupEventsInput, func(*cloudtrail.LookupEventsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LookupEventsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) LookupEventsRequest(_a0 *cloudtrail.LookupEventsInput) (*request.Request, *cloudtrail.LookupEventsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.LookupEventsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.LookupEventsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.LookupEventsInput) *cloudtrail.LookupEventsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.LookupEventsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// LookupEventsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) LookupEventsWithContext(_a0 context.Context, _a1 *cloudtrail.LookupEventsInput, _a2 ...request.Option) (*cloudtrail.LookupEventsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.LookupEventsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.LookupEventsInput, ...request.Option) *cloudtrail.LookupEventsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.LookupEventsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.LookupEventsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutEventSelectors provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) PutEventSelectors(_a0 *cloudtrail.PutEventSelectorsInput) (*cloudtrail.PutEventSelectorsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.PutEventSelectorsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.PutEventSelectorsInput) *cloudtrail.PutEventSelectorsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.PutEventSelectorsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.PutEventSelectorsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutEventSelectorsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) PutEventSelectorsRequest(_a0 *cloudtrail.PutEventSelectorsInput) (*request.Request, *cloudtrail.PutEventSelectorsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.PutEventSelectorsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.PutEventSelectorsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.PutEventSelectorsInput) *cloudtrail.PutEventSelectorsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.PutEventSelectorsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutEventSelectorsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) PutEventSelectorsWithContext(_a0 context.Context, _a1 *cloudtrail.PutEventSelectorsInput, _a2 ...request.Option) (*cloudtrail.PutEventSelectorsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.PutEventSelectorsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.PutEventSelectorsInput, ...request.Option) *cloudtrail.PutEventSelectorsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.PutEventSelectorsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.PutEventSelectorsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutInsightSelectors provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) PutInsightSelectors(_a0 *cloudtrail.PutInsightSelectorsInput) (*cloudtrail.PutInsightSelectorsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.PutInsightSelectorsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.PutInsightSelectorsInput) *cloudtrail.PutInsightSelectorsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.PutInsightSelectorsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.PutInsightSelectorsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutInsightSelectorsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) PutInsightSelectorsRequest(_a0 *cloudtrail.PutInsightSelectorsInput) (*request.Request, *cloudtrail.PutInsightSelectorsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.PutInsightSelectorsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.PutInsightSelectorsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.PutInsightSelectorsInput) *cloudtrail.PutInsightSelectorsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.PutInsightSelectorsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutInsightSelectorsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) PutInsightSelectorsWithContext(_a0 context.Context, _a1 *cloudtrail.PutInsightSelectorsInput, _a2 ...request.Option) (*cloudtrail.PutInsightSelectorsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.PutInsightSelectorsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.PutInsightSelectorsInput, ...request.Option) *cloudtrail.PutInsightSelectorsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.PutInsightSelectorsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.PutInsightSelectorsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicy provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) PutResourcePolicy(_a0 *cloudtrail.PutResourcePolicyInput) (*cloudtrail.PutResourcePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.PutResourcePolicyInput) *cloudtrail.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.PutResourcePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) PutResourcePolicyRequest(_a0 *cloudtrail.PutResourcePolicyInput) (*request.Request, *cloudtrail.PutResourcePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.PutResourcePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.PutResourcePolicyOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.PutResourcePolicyInput) *cloudtrail.PutResourcePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.PutResourcePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutResourcePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) PutResourcePolicyWithContext(_a0 context.Context, _a1 *cloudtrail.PutResourcePolicyInput, _a2 ...request.Option) (*cloudtrail.PutResourcePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.PutResourcePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.PutResourcePolicyInput, ...request.Option) *cloudtrail.PutResourcePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.PutResourcePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.PutResourcePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterOrganizationDelegatedAdmin provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) RegisterOrganizationDelegatedAdmin(_a0 *cloudtrail.RegisterOrganizationDelegatedAdminInput) (*cloudtrail.RegisterOrganizationDelegatedAdminOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.RegisterOrganizationDelegatedAdminOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.RegisterOrganizationDelegatedAdminInput) *cloudtrail.RegisterOrganizationDelegatedAdminOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.RegisterOrganizationDelegatedAdminOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.RegisterOrganizationDelegatedAdminInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RegisterOrganizationDelegatedAdminRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) RegisterOrganizationDelegatedAdminRequest(_a0 *cloudtrail.RegisterOrganizationDelegatedAdminInput) (*request.Request, *cloudtrail.RegisterOrganizationDelegatedAdminOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.RegisterOrganizationDelegatedAdminInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.RegisterOrganizationDelegatedAdminOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.RegisterOrganizationDelegatedAdminInput) *cloudtrail.RegisterOrganizationDelegatedAdminOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.RegisterOrganizationDelegatedAdminOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RegisterOrganizationDelegatedAdminWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) RegisterOrganizationDelegatedAdminWithContext(_a0 context.Context, _a1 *cloudtrail.RegisterOrganizationDelegatedAdminInput, _a2 ...request.Option) (*cloudtrail.RegisterOrganizationDelegatedAdminOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.RegisterOrganizationDelegatedAdminOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.RegisterOrganizationDelegatedAdminInput, ...request.Option) *cloudtrail.RegisterOrganizationDelegatedAdminOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.RegisterOrganizationDelegatedAdminOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.RegisterOrganizationDelegatedAdminInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTags provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) RemoveTags(_a0 *cloudtrail.RemoveTagsInput) (*cloudtrail.RemoveTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.RemoveTagsOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.RemoveTagsInput) *cloudtrail.RemoveTagsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.RemoveTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.RemoveTagsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTagsRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) RemoveTagsRequest(_a0 *cloudtrail.RemoveTagsInput) (*request.Request, *cloudtrail.RemoveTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.RemoveTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.RemoveTagsOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.RemoveTagsInput) *cloudtrail.RemoveTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.RemoveTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) RemoveTagsWithContext(_a0 context.Context, _a1 *cloudtrail.RemoveTagsInput, _a2 ...request.Option) (*cloudtrail.RemoveTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.RemoveTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.RemoveTagsInput, ...request.Option) *cloudtrail.RemoveTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.RemoveTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.RemoveTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreEventDataStore provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) RestoreEventDataStore(_a0 *cloudtrail.RestoreEventDataStoreInput) (*cloudtrail.RestoreEventDataStoreOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.RestoreEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.RestoreEventDataStoreInput) *cloudtrail.RestoreEventDataStoreOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.RestoreEventDataStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.RestoreEventDataStoreInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RestoreEventDataStoreRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) RestoreEventDataStoreRequest(_a0 *cloudtrail.RestoreEventDataStoreInput) (*request.Request, *cloudtrail.RestoreEventDataStoreOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.RestoreEventDataStoreInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.RestoreEventDataStoreOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.RestoreEventDataStoreInput) *cloudtrail.RestoreEventDataStoreOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.RestoreEventDataStoreOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RestoreEventDataStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) RestoreEventDataStoreWithContext(_a0 context.Context, _a1 *cloudtrail.RestoreEventDataStoreInput, _a2 ...request.Option) (*cloudtrail.RestoreEventDataStoreOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.RestoreEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.RestoreEventDataStoreInput, ...request.Option) *cloudtrail.RestoreEventDataStoreOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.RestoreEventDataStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.RestoreEventDataStoreInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartEventDataStoreIngestion provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StartEventDataStoreIngestion(_a0 *cloudtrail.StartEventDataStoreIngestionInput) (*cloudtrail.StartEventDataStoreIngestionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.StartEventDataStoreIngestionOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StartEventDataStoreIngestionInput) *cloudtrail.StartEventDataStoreIngestionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StartEventDataStoreIngestionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StartEventDataStoreIngestionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartEventDataStoreIngestionRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StartEventDataStoreIngestionRequest(_a0 *cloudtrail.StartEventDataStoreIngestionInput) (*request.Request, *cloudtrail.StartEventDataStoreIngestionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StartEventDataStoreIngestionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.StartEventDataStoreIngestionOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StartEventDataStoreIngestionInput) *cloudtrail.StartEventDataStoreIngestionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.StartEventDataStoreIngestionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// StartEventDataStoreIngestionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) StartEventDataStoreIngestionWithContext(_a0 context.Context, _a1 *cloudtrail.StartEventDataStoreIngestionInput, _a2 ...request.Option) (*cloudtrail.StartEventDataStoreIngestionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.StartEventDataStoreIngestionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.StartEventDataStoreIngestionInput, ...request.Option) *cloudtrail.StartEventDataStoreIngestionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StartEventDataStoreIngestionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.StartEventDataStoreIngestionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartImport provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StartImport(_a0 *cloudtrail.StartImportInput) (*cloudtrail.StartImportOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.StartImportOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StartImportInput) *cloudtrail.StartImportOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StartImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StartImportInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartImportRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StartImportRequest(_a0 *cloudtrail.StartImportInput) (*request.Request, *cloudtrail.StartImportOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StartImportInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.StartImportOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StartImportInput) *cloudtrail.StartImportOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.StartImportOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// StartImportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) StartImportWithContext(_a0 context.Context, _a1 *cloudtrail.StartImportInput, _a2 ...request.Option) (*cloudtrail.StartImportOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.StartImportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.StartImportInput, ...request.Option) *cloudtrail.StartImportOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StartImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.StartImportInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartLogging provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StartLogging(_a0 *cloudtrail.StartLoggingInput) (*cloudtrail.StartLoggingOutput, error) {
 	ret := _m.Called(_a0)
 
 	var r0 *cloudtrail.StartLoggingOutput
@@ -915,12 +3491,90 @@ func (_m *CloudTrailAPI) StartLogging(_a0 *cloudtrail.StartLoggingInput) (*cloud
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.StartLoggingOutput)
+			r0 = ret.Get(0).(*cloudtrail.StartLoggingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StartLoggingInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartLoggingRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StartLoggingRequest(_a0 *cloudtrail.StartLoggingInput) (*request.Request, *cloudtrail.StartLoggingOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StartLoggingInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.StartLoggingOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StartLoggingInput) *cloudtrail.StartLoggingOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.StartLoggingOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// StartLoggingWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) StartLoggingWithContext(_a0 context.Context, _a1 *cloudtrail.StartLoggingInput, _a2 ...request.Option) (*cloudtrail.StartLoggingOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.StartLoggingOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.StartLoggingInput, ...request.Option) *cloudtrail.StartLoggingOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StartLoggingOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.StartLoggingInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StartQuery provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StartQuery(_a0 *cloudtrail.StartQueryInput) (*cloudtrail.StartQueryOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.StartQueryOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StartQueryInput) *cloudtrail.StartQueryOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StartQueryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*cloudtrail.StartLoggingInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StartQueryInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -929,12 +3583,12 @@ func (_m *CloudTrailAPI) StartLogging(_a0 *cloudtrail.StartLoggingInput) (*cloud
 	return r0, r1
 }
 
-// StartLoggingRequest provides a mock function with given fields: _a0
-func (_m *CloudTrailAPI) StartLoggingRequest(_a0 *cloudtrail.StartLoggingInput) (*request.Request, *cloudtrail.StartLoggingOutput) {
+// StartQueryRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StartQueryRequest(_a0 *cloudtrail.StartQueryInput) (*request.Request, *cloudtrail.StartQueryOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*cloudtrail.StartLoggingInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StartQueryInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -942,20 +3596,20 @@ func (_m *CloudTrailAPI) StartLoggingRequest(_a0 *cloudtrail.StartLoggingInput)
 		}
 	}
 
-	var r1 *cloudtrail.StartLoggingOutput
-	if rf, ok := ret.Get(1).(func(*cloudtrail.StartLoggingInput) *cloudtrail.StartLoggingOutput); ok {
+	var r1 *cloudtrail.StartQueryOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StartQueryInput) *cloudtrail.StartQueryOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*cloudtrail.StartLoggingOutput)
+			r1 = ret.Get(1).(*cloudtrail.StartQueryOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// StartLoggingWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *CloudTrailAPI) StartLoggingWithContext(_a0 context.Context, _a1 *cloudtrail.StartLoggingInput, _a2 ...request.Option) (*cloudtrail.StartLoggingOutput, error) {
+// StartQueryWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) StartQueryWithContext(_a0 context.Context, _a1 *cloudtrail.StartQueryInput, _a2 ...request.Option) (*cloudtrail.StartQueryOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -965,17 +3619,173 @@ func (_m *CloudTrailAPI) StartLoggingWithContext(_a0 context.Context, _a1 *cloud
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *cloudtrail.StartLoggingOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.StartLoggingInput, ...request.Option) *cloudtrail.StartLoggingOutput); ok {
+	var r0 *cloudtrail.StartQueryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.StartQueryInput, ...request.Option) *cloudtrail.StartQueryOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*cloudtrail.StartLoggingOutput)
+			r0 = ret.Get(0).(*cloudtrail.StartQueryOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.StartLoggingInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.StartQueryInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopEventDataStoreIngestion provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StopEventDataStoreIngestion(_a0 *cloudtrail.StopEventDataStoreIngestionInput) (*cloudtrail.StopEventDataStoreIngestionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.StopEventDataStoreIngestionOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StopEventDataStoreIngestionInput) *cloudtrail.StopEventDataStoreIngestionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StopEventDataStoreIngestionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StopEventDataStoreIngestionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopEventDataStoreIngestionRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StopEventDataStoreIngestionRequest(_a0 *cloudtrail.StopEventDataStoreIngestionInput) (*request.Request, *cloudtrail.StopEventDataStoreIngestionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StopEventDataStoreIngestionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.StopEventDataStoreIngestionOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StopEventDataStoreIngestionInput) *cloudtrail.StopEventDataStoreIngestionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.StopEventDataStoreIngestionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// StopEventDataStoreIngestionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) StopEventDataStoreIngestionWithContext(_a0 context.Context, _a1 *cloudtrail.StopEventDataStoreIngestionInput, _a2 ...request.Option) (*cloudtrail.StopEventDataStoreIngestionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.StopEventDataStoreIngestionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.StopEventDataStoreIngestionInput, ...request.Option) *cloudtrail.StopEventDataStoreIngestionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StopEventDataStoreIngestionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.StopEventDataStoreIngestionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopImport provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StopImport(_a0 *cloudtrail.StopImportInput) (*cloudtrail.StopImportOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.StopImportOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StopImportInput) *cloudtrail.StopImportOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StopImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StopImportInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// StopImportRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) StopImportRequest(_a0 *cloudtrail.StopImportInput) (*request.Request, *cloudtrail.StopImportOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.StopImportInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.StopImportOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.StopImportInput) *cloudtrail.StopImportOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.StopImportOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// StopImportWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) StopImportWithContext(_a0 context.Context, _a1 *cloudtrail.StopImportInput, _a2 ...request.Option) (*cloudtrail.StopImportOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.StopImportOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.StopImportInput, ...request.Option) *cloudtrail.StopImportOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.StopImportOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.StopImportInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1062,6 +3872,162 @@ func (_m *CloudTrailAPI) StopLoggingWithContext(_a0 context.Context, _a1 *cloudt
 	return r0, r1
 }
 
+// UpdateChannel provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) UpdateChannel(_a0 *cloudtrail.UpdateChannelInput) (*cloudtrail.UpdateChannelOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.UpdateChannelOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.UpdateChannelInput) *cloudtrail.UpdateChannelOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.UpdateChannelOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.UpdateChannelInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateChannelRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) UpdateChannelRequest(_a0 *cloudtrail.UpdateChannelInput) (*request.Request, *cloudtrail.UpdateChannelOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.UpdateChannelInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.UpdateChannelOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.UpdateChannelInput) *cloudtrail.UpdateChannelOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.UpdateChannelOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateChannelWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) UpdateChannelWithContext(_a0 context.Context, _a1 *cloudtrail.UpdateChannelInput, _a2 ...request.Option) (*cloudtrail.UpdateChannelOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.UpdateChannelOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.UpdateChannelInput, ...request.Option) *cloudtrail.UpdateChannelOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.UpdateChannelOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.UpdateChannelInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEventDataStore provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) UpdateEventDataStore(_a0 *cloudtrail.UpdateEventDataStoreInput) (*cloudtrail.UpdateEventDataStoreOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *cloudtrail.UpdateEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(*cloudtrail.UpdateEventDataStoreInput) *cloudtrail.UpdateEventDataStoreOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.UpdateEventDataStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*cloudtrail.UpdateEventDataStoreInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEventDataStoreRequest provides a mock function with given fields: _a0
+func (_m *CloudTrailAPI) UpdateEventDataStoreRequest(_a0 *cloudtrail.UpdateEventDataStoreInput) (*request.Request, *cloudtrail.UpdateEventDataStoreOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*cloudtrail.UpdateEventDataStoreInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *cloudtrail.UpdateEventDataStoreOutput
+	if rf, ok := ret.Get(1).(func(*cloudtrail.UpdateEventDataStoreInput) *cloudtrail.UpdateEventDataStoreOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*cloudtrail.UpdateEventDataStoreOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// UpdateEventDataStoreWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *CloudTrailAPI) UpdateEventDataStoreWithContext(_a0 context.Context, _a1 *cloudtrail.UpdateEventDataStoreInput, _a2 ...request.Option) (*cloudtrail.UpdateEventDataStoreOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *cloudtrail.UpdateEventDataStoreOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *cloudtrail.UpdateEventDataStoreInput, ...request.Option) *cloudtrail.UpdateEventDataStoreOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*cloudtrail.UpdateEventDataStoreOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *cloudtrail.UpdateEventDataStoreInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateTrail provides a mock function with given fields: _a0
 func (_m *CloudTrailAPI) UpdateTrail(_a0 *cloudtrail.UpdateTrailInput) (*cloudtrail.UpdateTrailOutput, error) {
 	ret := _m.Called(_a0)
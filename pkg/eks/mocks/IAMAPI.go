@@ -4,7 +4,6 @@ package mocks
 
 import context "context"
 import iam "github.com/aws/aws-sdk-go/service/iam"
-
 import mock "github.com/stretchr/testify/mock"
 import request "github.com/aws/aws-sdk-go/aws/request"
 
@@ -4997,6 +4996,84 @@ func (_m *IAMAPI) GetLoginProfileWithContext(_a0 context.Context, _a1 *iam.GetLo
 	return r0, r1
 }
 
+// GetMFADevice provides a mock function with given fields: _a0
+func (_m *IAMAPI) GetMFADevice(_a0 *iam.GetMFADeviceInput) (*iam.GetMFADeviceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.GetMFADeviceOutput
+	if rf, ok := ret.Get(0).(func(*iam.GetMFADeviceInput) *iam.GetMFADeviceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.GetMFADeviceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.GetMFADeviceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetMFADeviceRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) GetMFADeviceRequest(_a0 *iam.GetMFADeviceInput) (*request.Request, *iam.GetMFADeviceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.GetMFADeviceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.GetMFADeviceOutput
+	if rf, ok := ret.Get(1).(func(*iam.GetMFADeviceInput) *iam.GetMFADeviceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.GetMFADeviceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// GetMFADeviceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) GetMFADeviceWithContext(_a0 context.Context, _a1 *iam.GetMFADeviceInput, _a2 ...request.Option) (*iam.GetMFADeviceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.GetMFADeviceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.GetMFADeviceInput, ...request.Option) *iam.GetMFADeviceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.GetMFADeviceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.GetMFADeviceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetOpenIDConnectProvider provides a mock function with given fields: _a0
 func (_m *IAMAPI) GetOpenIDConnectProvider(_a0 *iam.GetOpenIDConnectProviderInput) (*iam.GetOpenIDConnectProviderOutput, error) {
 	ret := _m.Called(_a0)
@@ -7106,6 +7183,119 @@ func (_m *IAMAPI) ListGroupsWithContext(_a0 context.Context, _a1 *iam.ListGroups
 	return r0, r1
 }
 
+// ListInstanceProfileTags provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListInstanceProfileTags(_a0 *iam.ListInstanceProfileTagsInput) (*iam.ListInstanceProfileTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ListInstanceProfileTagsOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListInstanceProfileTagsInput) *iam.ListInstanceProfileTagsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListInstanceProfileTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.ListInstanceProfileTagsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInstanceProfileTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListInstanceProfileTagsPages(_a0 *iam.ListInstanceProfileTagsInput, _a1 func(*iam.ListInstanceProfileTagsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.ListInstanceProfileTagsInput, func(*iam.ListInstanceProfileTagsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListInstanceProfileTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListInstanceProfileTagsPagesWithContext(_a0 context.Context, _a1 *iam.ListInstanceProfileTagsInput, _a2 func(*iam.ListInstanceProfileTagsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListInstanceProfileTagsInput, func(*iam.ListInstanceProfileTagsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListInstanceProfileTagsRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListInstanceProfileTagsRequest(_a0 *iam.ListInstanceProfileTagsInput) (*request.Request, *iam.ListInstanceProfileTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.ListInstanceProfileTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.ListInstanceProfileTagsOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListInstanceProfileTagsInput) *iam.ListInstanceProfileTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.ListInstanceProfileTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListInstanceProfileTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListInstanceProfileTagsWithContext(_a0 context.Context, _a1 *iam.ListInstanceProfileTagsInput, _a2 ...request.Option) (*iam.ListInstanceProfileTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.ListInstanceProfileTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListInstanceProfileTagsInput, ...request.Option) *iam.ListInstanceProfileTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListInstanceProfileTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListInstanceProfileTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListInstanceProfiles provides a mock function with given fields: _a0
 func (_m *IAMAPI) ListInstanceProfiles(_a0 *iam.ListInstanceProfilesInput) (*iam.ListInstanceProfilesOutput, error) {
 	ret := _m.Called(_a0)
@@ -7332,21 +7522,21 @@ func (_m *IAMAPI) ListInstanceProfilesWithContext(_a0 context.Context, _a1 *iam.
 	return r0, r1
 }
 
-// ListMFADevices provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListMFADevices(_a0 *iam.ListMFADevicesInput) (*iam.ListMFADevicesOutput, error) {
+// ListMFADeviceTags provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListMFADeviceTags(_a0 *iam.ListMFADeviceTagsInput) (*iam.ListMFADeviceTagsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ListMFADevicesOutput
-	if rf, ok := ret.Get(0).(func(*iam.ListMFADevicesInput) *iam.ListMFADevicesOutput); ok {
+	var r0 *iam.ListMFADeviceTagsOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListMFADeviceTagsInput) *iam.ListMFADeviceTagsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListMFADevicesOutput)
+			r0 = ret.Get(0).(*iam.ListMFADeviceTagsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.ListMFADevicesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.ListMFADeviceTagsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7355,12 +7545,12 @@ func (_m *IAMAPI) ListMFADevices(_a0 *iam.ListMFADevicesInput) (*iam.ListMFADevi
 	return r0, r1
 }
 
-// ListMFADevicesPages provides a mock function with given fields: _a0, _a1
-func (_m *IAMAPI) ListMFADevicesPages(_a0 *iam.ListMFADevicesInput, _a1 func(*iam.ListMFADevicesOutput, bool) bool) error {
+// ListMFADeviceTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListMFADeviceTagsPages(_a0 *iam.ListMFADeviceTagsInput, _a1 func(*iam.ListMFADeviceTagsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*iam.ListMFADevicesInput, func(*iam.ListMFADevicesOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListMFADeviceTagsInput, func(*iam.ListMFADeviceTagsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7369,8 +7559,8 @@ func (_m *IAMAPI) ListMFADevicesPages(_a0 *iam.ListMFADevicesInput, _a1 func(*ia
 	return r0
 }
 
-// ListMFADevicesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *IAMAPI) ListMFADevicesPagesWithContext(_a0 context.Context, _a1 *iam.ListMFADevicesInput, _a2 func(*iam.ListMFADevicesOutput, bool) bool, _a3 ...request.Option) error {
+// ListMFADeviceTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListMFADeviceTagsPagesWithContext(_a0 context.Context, _a1 *iam.ListMFADeviceTagsInput, _a2 func(*iam.ListMFADeviceTagsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7381,7 +7571,7 @@ func (_m *IAMAPI) ListMFADevicesPagesWithContext(_a0 context.Context, _a1 *iam.L
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListMFADevicesInput, func(*iam.ListMFADevicesOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListMFADeviceTagsInput, func(*iam.ListMFADeviceTagsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7390,12 +7580,12 @@ func (_m *IAMAPI) ListMFADevicesPagesWithContext(_a0 context.Context, _a1 *iam.L
 	return r0
 }
 
-// ListMFADevicesRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListMFADevicesRequest(_a0 *iam.ListMFADevicesInput) (*request.Request, *iam.ListMFADevicesOutput) {
+// ListMFADeviceTagsRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListMFADeviceTagsRequest(_a0 *iam.ListMFADeviceTagsInput) (*request.Request, *iam.ListMFADeviceTagsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.ListMFADevicesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListMFADeviceTagsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7403,20 +7593,20 @@ func (_m *IAMAPI) ListMFADevicesRequest(_a0 *iam.ListMFADevicesInput) (*request.
 		}
 	}
 
-	var r1 *iam.ListMFADevicesOutput
-	if rf, ok := ret.Get(1).(func(*iam.ListMFADevicesInput) *iam.ListMFADevicesOutput); ok {
+	var r1 *iam.ListMFADeviceTagsOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListMFADeviceTagsInput) *iam.ListMFADeviceTagsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.ListMFADevicesOutput)
+			r1 = ret.Get(1).(*iam.ListMFADeviceTagsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListMFADevicesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) ListMFADevicesWithContext(_a0 context.Context, _a1 *iam.ListMFADevicesInput, _a2 ...request.Option) (*iam.ListMFADevicesOutput, error) {
+// ListMFADeviceTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListMFADeviceTagsWithContext(_a0 context.Context, _a1 *iam.ListMFADeviceTagsInput, _a2 ...request.Option) (*iam.ListMFADeviceTagsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7426,17 +7616,17 @@ func (_m *IAMAPI) ListMFADevicesWithContext(_a0 context.Context, _a1 *iam.ListMF
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.ListMFADevicesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListMFADevicesInput, ...request.Option) *iam.ListMFADevicesOutput); ok {
+	var r0 *iam.ListMFADeviceTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListMFADeviceTagsInput, ...request.Option) *iam.ListMFADeviceTagsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListMFADevicesOutput)
+			r0 = ret.Get(0).(*iam.ListMFADeviceTagsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListMFADevicesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListMFADeviceTagsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7445,21 +7635,21 @@ func (_m *IAMAPI) ListMFADevicesWithContext(_a0 context.Context, _a1 *iam.ListMF
 	return r0, r1
 }
 
-// ListOpenIDConnectProviders provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListOpenIDConnectProviders(_a0 *iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error) {
+// ListMFADevices provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListMFADevices(_a0 *iam.ListMFADevicesInput) (*iam.ListMFADevicesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ListOpenIDConnectProvidersOutput
-	if rf, ok := ret.Get(0).(func(*iam.ListOpenIDConnectProvidersInput) *iam.ListOpenIDConnectProvidersOutput); ok {
+	var r0 *iam.ListMFADevicesOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListMFADevicesInput) *iam.ListMFADevicesOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListOpenIDConnectProvidersOutput)
+			r0 = ret.Get(0).(*iam.ListMFADevicesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.ListOpenIDConnectProvidersInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.ListMFADevicesInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7468,12 +7658,47 @@ func (_m *IAMAPI) ListOpenIDConnectProviders(_a0 *iam.ListOpenIDConnectProviders
 	return r0, r1
 }
 
-// ListOpenIDConnectProvidersRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListOpenIDConnectProvidersRequest(_a0 *iam.ListOpenIDConnectProvidersInput) (*request.Request, *iam.ListOpenIDConnectProvidersOutput) {
+// ListMFADevicesPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListMFADevicesPages(_a0 *iam.ListMFADevicesInput, _a1 func(*iam.ListMFADevicesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.ListMFADevicesInput, func(*iam.ListMFADevicesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListMFADevicesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListMFADevicesPagesWithContext(_a0 context.Context, _a1 *iam.ListMFADevicesInput, _a2 func(*iam.ListMFADevicesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListMFADevicesInput, func(*iam.ListMFADevicesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListMFADevicesRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListMFADevicesRequest(_a0 *iam.ListMFADevicesInput) (*request.Request, *iam.ListMFADevicesOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.ListOpenIDConnectProvidersInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListMFADevicesInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7481,20 +7706,20 @@ func (_m *IAMAPI) ListOpenIDConnectProvidersRequest(_a0 *iam.ListOpenIDConnectPr
 		}
 	}
 
-	var r1 *iam.ListOpenIDConnectProvidersOutput
-	if rf, ok := ret.Get(1).(func(*iam.ListOpenIDConnectProvidersInput) *iam.ListOpenIDConnectProvidersOutput); ok {
+	var r1 *iam.ListMFADevicesOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListMFADevicesInput) *iam.ListMFADevicesOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.ListOpenIDConnectProvidersOutput)
+			r1 = ret.Get(1).(*iam.ListMFADevicesOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListOpenIDConnectProvidersWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) ListOpenIDConnectProvidersWithContext(_a0 context.Context, _a1 *iam.ListOpenIDConnectProvidersInput, _a2 ...request.Option) (*iam.ListOpenIDConnectProvidersOutput, error) {
+// ListMFADevicesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListMFADevicesWithContext(_a0 context.Context, _a1 *iam.ListMFADevicesInput, _a2 ...request.Option) (*iam.ListMFADevicesOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7504,17 +7729,17 @@ func (_m *IAMAPI) ListOpenIDConnectProvidersWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.ListOpenIDConnectProvidersOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListOpenIDConnectProvidersInput, ...request.Option) *iam.ListOpenIDConnectProvidersOutput); ok {
+	var r0 *iam.ListMFADevicesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListMFADevicesInput, ...request.Option) *iam.ListMFADevicesOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListOpenIDConnectProvidersOutput)
+			r0 = ret.Get(0).(*iam.ListMFADevicesOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListOpenIDConnectProvidersInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListMFADevicesInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7523,21 +7748,21 @@ func (_m *IAMAPI) ListOpenIDConnectProvidersWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// ListPolicies provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListPolicies(_a0 *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error) {
+// ListOpenIDConnectProviderTags provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListOpenIDConnectProviderTags(_a0 *iam.ListOpenIDConnectProviderTagsInput) (*iam.ListOpenIDConnectProviderTagsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ListPoliciesOutput
-	if rf, ok := ret.Get(0).(func(*iam.ListPoliciesInput) *iam.ListPoliciesOutput); ok {
+	var r0 *iam.ListOpenIDConnectProviderTagsOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListOpenIDConnectProviderTagsInput) *iam.ListOpenIDConnectProviderTagsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListPoliciesOutput)
+			r0 = ret.Get(0).(*iam.ListOpenIDConnectProviderTagsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.ListPoliciesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.ListOpenIDConnectProviderTagsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7546,11 +7771,202 @@ func (_m *IAMAPI) ListPolicies(_a0 *iam.ListPoliciesInput) (*iam.ListPoliciesOut
 	return r0, r1
 }
 
-// ListPoliciesGrantingServiceAccess provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListPoliciesGrantingServiceAccess(_a0 *iam.ListPoliciesGrantingServiceAccessInput) (*iam.ListPoliciesGrantingServiceAccessOutput, error) {
-	ret := _m.Called(_a0)
+// ListOpenIDConnectProviderTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListOpenIDConnectProviderTagsPages(_a0 *iam.ListOpenIDConnectProviderTagsInput, _a1 func(*iam.ListOpenIDConnectProviderTagsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
 
-	var r0 *iam.ListPoliciesGrantingServiceAccessOutput
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.ListOpenIDConnectProviderTagsInput, func(*iam.ListOpenIDConnectProviderTagsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListOpenIDConnectProviderTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListOpenIDConnectProviderTagsPagesWithContext(_a0 context.Context, _a1 *iam.ListOpenIDConnectProviderTagsInput, _a2 func(*iam.ListOpenIDConnectProviderTagsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListOpenIDConnectProviderTagsInput, func(*iam.ListOpenIDConnectProviderTagsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListOpenIDConnectProviderTagsRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListOpenIDConnectProviderTagsRequest(_a0 *iam.ListOpenIDConnectProviderTagsInput) (*request.Request, *iam.ListOpenIDConnectProviderTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.ListOpenIDConnectProviderTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.ListOpenIDConnectProviderTagsOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListOpenIDConnectProviderTagsInput) *iam.ListOpenIDConnectProviderTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.ListOpenIDConnectProviderTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListOpenIDConnectProviderTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListOpenIDConnectProviderTagsWithContext(_a0 context.Context, _a1 *iam.ListOpenIDConnectProviderTagsInput, _a2 ...request.Option) (*iam.ListOpenIDConnectProviderTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.ListOpenIDConnectProviderTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListOpenIDConnectProviderTagsInput, ...request.Option) *iam.ListOpenIDConnectProviderTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListOpenIDConnectProviderTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListOpenIDConnectProviderTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOpenIDConnectProviders provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListOpenIDConnectProviders(_a0 *iam.ListOpenIDConnectProvidersInput) (*iam.ListOpenIDConnectProvidersOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ListOpenIDConnectProvidersOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListOpenIDConnectProvidersInput) *iam.ListOpenIDConnectProvidersOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListOpenIDConnectProvidersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.ListOpenIDConnectProvidersInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListOpenIDConnectProvidersRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListOpenIDConnectProvidersRequest(_a0 *iam.ListOpenIDConnectProvidersInput) (*request.Request, *iam.ListOpenIDConnectProvidersOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.ListOpenIDConnectProvidersInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.ListOpenIDConnectProvidersOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListOpenIDConnectProvidersInput) *iam.ListOpenIDConnectProvidersOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.ListOpenIDConnectProvidersOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListOpenIDConnectProvidersWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListOpenIDConnectProvidersWithContext(_a0 context.Context, _a1 *iam.ListOpenIDConnectProvidersInput, _a2 ...request.Option) (*iam.ListOpenIDConnectProvidersOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.ListOpenIDConnectProvidersOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListOpenIDConnectProvidersInput, ...request.Option) *iam.ListOpenIDConnectProvidersOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListOpenIDConnectProvidersOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListOpenIDConnectProvidersInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPolicies provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListPolicies(_a0 *iam.ListPoliciesInput) (*iam.ListPoliciesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ListPoliciesOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListPoliciesInput) *iam.ListPoliciesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListPoliciesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.ListPoliciesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPoliciesGrantingServiceAccess provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListPoliciesGrantingServiceAccess(_a0 *iam.ListPoliciesGrantingServiceAccessInput) (*iam.ListPoliciesGrantingServiceAccessOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ListPoliciesGrantingServiceAccessOutput
 	if rf, ok := ret.Get(0).(func(*iam.ListPoliciesGrantingServiceAccessInput) *iam.ListPoliciesGrantingServiceAccessOutput); ok {
 		r0 = rf(_a0)
 	} else {
@@ -7714,21 +8130,21 @@ func (_m *IAMAPI) ListPoliciesWithContext(_a0 context.Context, _a1 *iam.ListPoli
 	return r0, r1
 }
 
-// ListPolicyVersions provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListPolicyVersions(_a0 *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
+// ListPolicyTags provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListPolicyTags(_a0 *iam.ListPolicyTagsInput) (*iam.ListPolicyTagsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ListPolicyVersionsOutput
-	if rf, ok := ret.Get(0).(func(*iam.ListPolicyVersionsInput) *iam.ListPolicyVersionsOutput); ok {
+	var r0 *iam.ListPolicyTagsOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListPolicyTagsInput) *iam.ListPolicyTagsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListPolicyVersionsOutput)
+			r0 = ret.Get(0).(*iam.ListPolicyTagsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.ListPolicyVersionsInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.ListPolicyTagsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7737,12 +8153,12 @@ func (_m *IAMAPI) ListPolicyVersions(_a0 *iam.ListPolicyVersionsInput) (*iam.Lis
 	return r0, r1
 }
 
-// ListPolicyVersionsPages provides a mock function with given fields: _a0, _a1
-func (_m *IAMAPI) ListPolicyVersionsPages(_a0 *iam.ListPolicyVersionsInput, _a1 func(*iam.ListPolicyVersionsOutput, bool) bool) error {
+// ListPolicyTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListPolicyTagsPages(_a0 *iam.ListPolicyTagsInput, _a1 func(*iam.ListPolicyTagsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*iam.ListPolicyVersionsInput, func(*iam.ListPolicyVersionsOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListPolicyTagsInput, func(*iam.ListPolicyTagsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7751,8 +8167,8 @@ func (_m *IAMAPI) ListPolicyVersionsPages(_a0 *iam.ListPolicyVersionsInput, _a1
 	return r0
 }
 
-// ListPolicyVersionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *IAMAPI) ListPolicyVersionsPagesWithContext(_a0 context.Context, _a1 *iam.ListPolicyVersionsInput, _a2 func(*iam.ListPolicyVersionsOutput, bool) bool, _a3 ...request.Option) error {
+// ListPolicyTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListPolicyTagsPagesWithContext(_a0 context.Context, _a1 *iam.ListPolicyTagsInput, _a2 func(*iam.ListPolicyTagsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7763,7 +8179,7 @@ func (_m *IAMAPI) ListPolicyVersionsPagesWithContext(_a0 context.Context, _a1 *i
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListPolicyVersionsInput, func(*iam.ListPolicyVersionsOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListPolicyTagsInput, func(*iam.ListPolicyTagsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7772,12 +8188,12 @@ func (_m *IAMAPI) ListPolicyVersionsPagesWithContext(_a0 context.Context, _a1 *i
 	return r0
 }
 
-// ListPolicyVersionsRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListPolicyVersionsRequest(_a0 *iam.ListPolicyVersionsInput) (*request.Request, *iam.ListPolicyVersionsOutput) {
+// ListPolicyTagsRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListPolicyTagsRequest(_a0 *iam.ListPolicyTagsInput) (*request.Request, *iam.ListPolicyTagsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.ListPolicyVersionsInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListPolicyTagsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7785,20 +8201,20 @@ func (_m *IAMAPI) ListPolicyVersionsRequest(_a0 *iam.ListPolicyVersionsInput) (*
 		}
 	}
 
-	var r1 *iam.ListPolicyVersionsOutput
-	if rf, ok := ret.Get(1).(func(*iam.ListPolicyVersionsInput) *iam.ListPolicyVersionsOutput); ok {
+	var r1 *iam.ListPolicyTagsOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListPolicyTagsInput) *iam.ListPolicyTagsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.ListPolicyVersionsOutput)
+			r1 = ret.Get(1).(*iam.ListPolicyTagsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListPolicyVersionsWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) ListPolicyVersionsWithContext(_a0 context.Context, _a1 *iam.ListPolicyVersionsInput, _a2 ...request.Option) (*iam.ListPolicyVersionsOutput, error) {
+// ListPolicyTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListPolicyTagsWithContext(_a0 context.Context, _a1 *iam.ListPolicyTagsInput, _a2 ...request.Option) (*iam.ListPolicyTagsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7808,17 +8224,17 @@ func (_m *IAMAPI) ListPolicyVersionsWithContext(_a0 context.Context, _a1 *iam.Li
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.ListPolicyVersionsOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListPolicyVersionsInput, ...request.Option) *iam.ListPolicyVersionsOutput); ok {
+	var r0 *iam.ListPolicyTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListPolicyTagsInput, ...request.Option) *iam.ListPolicyTagsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListPolicyVersionsOutput)
+			r0 = ret.Get(0).(*iam.ListPolicyTagsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListPolicyVersionsInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListPolicyTagsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7827,21 +8243,21 @@ func (_m *IAMAPI) ListPolicyVersionsWithContext(_a0 context.Context, _a1 *iam.Li
 	return r0, r1
 }
 
-// ListRolePolicies provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListRolePolicies(_a0 *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error) {
+// ListPolicyVersions provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListPolicyVersions(_a0 *iam.ListPolicyVersionsInput) (*iam.ListPolicyVersionsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ListRolePoliciesOutput
-	if rf, ok := ret.Get(0).(func(*iam.ListRolePoliciesInput) *iam.ListRolePoliciesOutput); ok {
+	var r0 *iam.ListPolicyVersionsOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListPolicyVersionsInput) *iam.ListPolicyVersionsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListRolePoliciesOutput)
+			r0 = ret.Get(0).(*iam.ListPolicyVersionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.ListRolePoliciesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.ListPolicyVersionsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -7850,12 +8266,12 @@ func (_m *IAMAPI) ListRolePolicies(_a0 *iam.ListRolePoliciesInput) (*iam.ListRol
 	return r0, r1
 }
 
-// ListRolePoliciesPages provides a mock function with given fields: _a0, _a1
-func (_m *IAMAPI) ListRolePoliciesPages(_a0 *iam.ListRolePoliciesInput, _a1 func(*iam.ListRolePoliciesOutput, bool) bool) error {
+// ListPolicyVersionsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListPolicyVersionsPages(_a0 *iam.ListPolicyVersionsInput, _a1 func(*iam.ListPolicyVersionsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*iam.ListRolePoliciesInput, func(*iam.ListRolePoliciesOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListPolicyVersionsInput, func(*iam.ListPolicyVersionsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -7864,8 +8280,8 @@ func (_m *IAMAPI) ListRolePoliciesPages(_a0 *iam.ListRolePoliciesInput, _a1 func
 	return r0
 }
 
-// ListRolePoliciesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *IAMAPI) ListRolePoliciesPagesWithContext(_a0 context.Context, _a1 *iam.ListRolePoliciesInput, _a2 func(*iam.ListRolePoliciesOutput, bool) bool, _a3 ...request.Option) error {
+// ListPolicyVersionsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListPolicyVersionsPagesWithContext(_a0 context.Context, _a1 *iam.ListPolicyVersionsInput, _a2 func(*iam.ListPolicyVersionsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -7876,7 +8292,7 @@ func (_m *IAMAPI) ListRolePoliciesPagesWithContext(_a0 context.Context, _a1 *iam
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListRolePoliciesInput, func(*iam.ListRolePoliciesOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListPolicyVersionsInput, func(*iam.ListPolicyVersionsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -7885,12 +8301,12 @@ func (_m *IAMAPI) ListRolePoliciesPagesWithContext(_a0 context.Context, _a1 *iam
 	return r0
 }
 
-// ListRolePoliciesRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListRolePoliciesRequest(_a0 *iam.ListRolePoliciesInput) (*request.Request, *iam.ListRolePoliciesOutput) {
+// ListPolicyVersionsRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListPolicyVersionsRequest(_a0 *iam.ListPolicyVersionsInput) (*request.Request, *iam.ListPolicyVersionsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.ListRolePoliciesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListPolicyVersionsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7898,20 +8314,20 @@ func (_m *IAMAPI) ListRolePoliciesRequest(_a0 *iam.ListRolePoliciesInput) (*requ
 		}
 	}
 
-	var r1 *iam.ListRolePoliciesOutput
-	if rf, ok := ret.Get(1).(func(*iam.ListRolePoliciesInput) *iam.ListRolePoliciesOutput); ok {
+	var r1 *iam.ListPolicyVersionsOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListPolicyVersionsInput) *iam.ListPolicyVersionsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.ListRolePoliciesOutput)
+			r1 = ret.Get(1).(*iam.ListPolicyVersionsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListRolePoliciesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) ListRolePoliciesWithContext(_a0 context.Context, _a1 *iam.ListRolePoliciesInput, _a2 ...request.Option) (*iam.ListRolePoliciesOutput, error) {
+// ListPolicyVersionsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListPolicyVersionsWithContext(_a0 context.Context, _a1 *iam.ListPolicyVersionsInput, _a2 ...request.Option) (*iam.ListPolicyVersionsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -7921,17 +8337,17 @@ func (_m *IAMAPI) ListRolePoliciesWithContext(_a0 context.Context, _a1 *iam.List
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.ListRolePoliciesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListRolePoliciesInput, ...request.Option) *iam.ListRolePoliciesOutput); ok {
+	var r0 *iam.ListPolicyVersionsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListPolicyVersionsInput, ...request.Option) *iam.ListPolicyVersionsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListRolePoliciesOutput)
+			r0 = ret.Get(0).(*iam.ListPolicyVersionsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListRolePoliciesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListPolicyVersionsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -7940,12 +8356,125 @@ func (_m *IAMAPI) ListRolePoliciesWithContext(_a0 context.Context, _a1 *iam.List
 	return r0, r1
 }
 
-// ListRoleTags provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListRoleTags(_a0 *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error) {
+// ListRolePolicies provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListRolePolicies(_a0 *iam.ListRolePoliciesInput) (*iam.ListRolePoliciesOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ListRoleTagsOutput
-	if rf, ok := ret.Get(0).(func(*iam.ListRoleTagsInput) *iam.ListRoleTagsOutput); ok {
+	var r0 *iam.ListRolePoliciesOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListRolePoliciesInput) *iam.ListRolePoliciesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListRolePoliciesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.ListRolePoliciesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRolePoliciesPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListRolePoliciesPages(_a0 *iam.ListRolePoliciesInput, _a1 func(*iam.ListRolePoliciesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.ListRolePoliciesInput, func(*iam.ListRolePoliciesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRolePoliciesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListRolePoliciesPagesWithContext(_a0 context.Context, _a1 *iam.ListRolePoliciesInput, _a2 func(*iam.ListRolePoliciesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListRolePoliciesInput, func(*iam.ListRolePoliciesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRolePoliciesRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListRolePoliciesRequest(_a0 *iam.ListRolePoliciesInput) (*request.Request, *iam.ListRolePoliciesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.ListRolePoliciesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.ListRolePoliciesOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListRolePoliciesInput) *iam.ListRolePoliciesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.ListRolePoliciesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListRolePoliciesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListRolePoliciesWithContext(_a0 context.Context, _a1 *iam.ListRolePoliciesInput, _a2 ...request.Option) (*iam.ListRolePoliciesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.ListRolePoliciesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListRolePoliciesInput, ...request.Option) *iam.ListRolePoliciesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListRolePoliciesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListRolePoliciesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRoleTags provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListRoleTags(_a0 *iam.ListRoleTagsInput) (*iam.ListRoleTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ListRoleTagsOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListRoleTagsInput) *iam.ListRoleTagsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -7963,6 +8492,41 @@ func (_m *IAMAPI) ListRoleTags(_a0 *iam.ListRoleTagsInput) (*iam.ListRoleTagsOut
 	return r0, r1
 }
 
+// ListRoleTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListRoleTagsPages(_a0 *iam.ListRoleTagsInput, _a1 func(*iam.ListRoleTagsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.ListRoleTagsInput, func(*iam.ListRoleTagsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRoleTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListRoleTagsPagesWithContext(_a0 context.Context, _a1 *iam.ListRoleTagsInput, _a2 func(*iam.ListRoleTagsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListRoleTagsInput, func(*iam.ListRoleTagsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ListRoleTagsRequest provides a mock function with given fields: _a0
 func (_m *IAMAPI) ListRoleTagsRequest(_a0 *iam.ListRoleTagsInput) (*request.Request, *iam.ListRoleTagsOutput) {
 	ret := _m.Called(_a0)
@@ -8131,6 +8695,119 @@ func (_m *IAMAPI) ListRolesWithContext(_a0 context.Context, _a1 *iam.ListRolesIn
 	return r0, r1
 }
 
+// ListSAMLProviderTags provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListSAMLProviderTags(_a0 *iam.ListSAMLProviderTagsInput) (*iam.ListSAMLProviderTagsOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ListSAMLProviderTagsOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListSAMLProviderTagsInput) *iam.ListSAMLProviderTagsOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListSAMLProviderTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.ListSAMLProviderTagsInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListSAMLProviderTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListSAMLProviderTagsPages(_a0 *iam.ListSAMLProviderTagsInput, _a1 func(*iam.ListSAMLProviderTagsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.ListSAMLProviderTagsInput, func(*iam.ListSAMLProviderTagsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListSAMLProviderTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListSAMLProviderTagsPagesWithContext(_a0 context.Context, _a1 *iam.ListSAMLProviderTagsInput, _a2 func(*iam.ListSAMLProviderTagsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListSAMLProviderTagsInput, func(*iam.ListSAMLProviderTagsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListSAMLProviderTagsRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListSAMLProviderTagsRequest(_a0 *iam.ListSAMLProviderTagsInput) (*request.Request, *iam.ListSAMLProviderTagsOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.ListSAMLProviderTagsInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.ListSAMLProviderTagsOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListSAMLProviderTagsInput) *iam.ListSAMLProviderTagsOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.ListSAMLProviderTagsOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListSAMLProviderTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListSAMLProviderTagsWithContext(_a0 context.Context, _a1 *iam.ListSAMLProviderTagsInput, _a2 ...request.Option) (*iam.ListSAMLProviderTagsOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.ListSAMLProviderTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListSAMLProviderTagsInput, ...request.Option) *iam.ListSAMLProviderTagsOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListSAMLProviderTagsOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListSAMLProviderTagsInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListSAMLProviders provides a mock function with given fields: _a0
 func (_m *IAMAPI) ListSAMLProviders(_a0 *iam.ListSAMLProvidersInput) (*iam.ListSAMLProvidersOutput, error) {
 	ret := _m.Called(_a0)
@@ -8322,21 +8999,21 @@ func (_m *IAMAPI) ListSSHPublicKeysWithContext(_a0 context.Context, _a1 *iam.Lis
 	return r0, r1
 }
 
-// ListServerCertificates provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListServerCertificates(_a0 *iam.ListServerCertificatesInput) (*iam.ListServerCertificatesOutput, error) {
+// ListServerCertificateTags provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListServerCertificateTags(_a0 *iam.ListServerCertificateTagsInput) (*iam.ListServerCertificateTagsOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ListServerCertificatesOutput
-	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificatesInput) *iam.ListServerCertificatesOutput); ok {
+	var r0 *iam.ListServerCertificateTagsOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificateTagsInput) *iam.ListServerCertificateTagsOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListServerCertificatesOutput)
+			r0 = ret.Get(0).(*iam.ListServerCertificateTagsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.ListServerCertificatesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.ListServerCertificateTagsInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -8345,12 +9022,12 @@ func (_m *IAMAPI) ListServerCertificates(_a0 *iam.ListServerCertificatesInput) (
 	return r0, r1
 }
 
-// ListServerCertificatesPages provides a mock function with given fields: _a0, _a1
-func (_m *IAMAPI) ListServerCertificatesPages(_a0 *iam.ListServerCertificatesInput, _a1 func(*iam.ListServerCertificatesOutput, bool) bool) error {
+// ListServerCertificateTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListServerCertificateTagsPages(_a0 *iam.ListServerCertificateTagsInput, _a1 func(*iam.ListServerCertificateTagsOutput, bool) bool) error {
 	ret := _m.Called(_a0, _a1)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificatesInput, func(*iam.ListServerCertificatesOutput, bool) bool) error); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificateTagsInput, func(*iam.ListServerCertificateTagsOutput, bool) bool) error); ok {
 		r0 = rf(_a0, _a1)
 	} else {
 		r0 = ret.Error(0)
@@ -8359,8 +9036,8 @@ func (_m *IAMAPI) ListServerCertificatesPages(_a0 *iam.ListServerCertificatesInp
 	return r0
 }
 
-// ListServerCertificatesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *IAMAPI) ListServerCertificatesPagesWithContext(_a0 context.Context, _a1 *iam.ListServerCertificatesInput, _a2 func(*iam.ListServerCertificatesOutput, bool) bool, _a3 ...request.Option) error {
+// ListServerCertificateTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListServerCertificateTagsPagesWithContext(_a0 context.Context, _a1 *iam.ListServerCertificateTagsInput, _a2 func(*iam.ListServerCertificateTagsOutput, bool) bool, _a3 ...request.Option) error {
 	_va := make([]interface{}, len(_a3))
 	for _i := range _a3 {
 		_va[_i] = _a3[_i]
@@ -8371,7 +9048,7 @@ func (_m *IAMAPI) ListServerCertificatesPagesWithContext(_a0 context.Context, _a
 	ret := _m.Called(_ca...)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListServerCertificatesInput, func(*iam.ListServerCertificatesOutput, bool) bool, ...request.Option) error); ok {
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListServerCertificateTagsInput, func(*iam.ListServerCertificateTagsOutput, bool) bool, ...request.Option) error); ok {
 		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
 		r0 = ret.Error(0)
@@ -8380,12 +9057,12 @@ func (_m *IAMAPI) ListServerCertificatesPagesWithContext(_a0 context.Context, _a
 	return r0
 }
 
-// ListServerCertificatesRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) ListServerCertificatesRequest(_a0 *iam.ListServerCertificatesInput) (*request.Request, *iam.ListServerCertificatesOutput) {
+// ListServerCertificateTagsRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListServerCertificateTagsRequest(_a0 *iam.ListServerCertificateTagsInput) (*request.Request, *iam.ListServerCertificateTagsOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificatesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificateTagsInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -8393,20 +9070,20 @@ func (_m *IAMAPI) ListServerCertificatesRequest(_a0 *iam.ListServerCertificatesI
 		}
 	}
 
-	var r1 *iam.ListServerCertificatesOutput
-	if rf, ok := ret.Get(1).(func(*iam.ListServerCertificatesInput) *iam.ListServerCertificatesOutput); ok {
+	var r1 *iam.ListServerCertificateTagsOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListServerCertificateTagsInput) *iam.ListServerCertificateTagsOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.ListServerCertificatesOutput)
+			r1 = ret.Get(1).(*iam.ListServerCertificateTagsOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ListServerCertificatesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) ListServerCertificatesWithContext(_a0 context.Context, _a1 *iam.ListServerCertificatesInput, _a2 ...request.Option) (*iam.ListServerCertificatesOutput, error) {
+// ListServerCertificateTagsWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListServerCertificateTagsWithContext(_a0 context.Context, _a1 *iam.ListServerCertificateTagsInput, _a2 ...request.Option) (*iam.ListServerCertificateTagsOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -8416,17 +9093,17 @@ func (_m *IAMAPI) ListServerCertificatesWithContext(_a0 context.Context, _a1 *ia
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.ListServerCertificatesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListServerCertificatesInput, ...request.Option) *iam.ListServerCertificatesOutput); ok {
+	var r0 *iam.ListServerCertificateTagsOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListServerCertificateTagsInput, ...request.Option) *iam.ListServerCertificateTagsOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ListServerCertificatesOutput)
+			r0 = ret.Get(0).(*iam.ListServerCertificateTagsOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListServerCertificatesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListServerCertificateTagsInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -8435,7 +9112,120 @@ func (_m *IAMAPI) ListServerCertificatesWithContext(_a0 context.Context, _a1 *ia
 	return r0, r1
 }
 
-// ListServiceSpecificCredentials provides a mock function with given fields: _a0
+// ListServerCertificates provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListServerCertificates(_a0 *iam.ListServerCertificatesInput) (*iam.ListServerCertificatesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ListServerCertificatesOutput
+	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificatesInput) *iam.ListServerCertificatesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListServerCertificatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.ListServerCertificatesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListServerCertificatesPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListServerCertificatesPages(_a0 *iam.ListServerCertificatesInput, _a1 func(*iam.ListServerCertificatesOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificatesInput, func(*iam.ListServerCertificatesOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListServerCertificatesPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListServerCertificatesPagesWithContext(_a0 context.Context, _a1 *iam.ListServerCertificatesInput, _a2 func(*iam.ListServerCertificatesOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListServerCertificatesInput, func(*iam.ListServerCertificatesOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListServerCertificatesRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ListServerCertificatesRequest(_a0 *iam.ListServerCertificatesInput) (*request.Request, *iam.ListServerCertificatesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.ListServerCertificatesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.ListServerCertificatesOutput
+	if rf, ok := ret.Get(1).(func(*iam.ListServerCertificatesInput) *iam.ListServerCertificatesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.ListServerCertificatesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ListServerCertificatesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ListServerCertificatesWithContext(_a0 context.Context, _a1 *iam.ListServerCertificatesInput, _a2 ...request.Option) (*iam.ListServerCertificatesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.ListServerCertificatesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListServerCertificatesInput, ...request.Option) *iam.ListServerCertificatesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ListServerCertificatesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ListServerCertificatesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListServiceSpecificCredentials provides a mock function with given fields: _a0
 func (_m *IAMAPI) ListServiceSpecificCredentials(_a0 *iam.ListServiceSpecificCredentialsInput) (*iam.ListServiceSpecificCredentialsOutput, error) {
 	ret := _m.Called(_a0)
 
@@ -8762,6 +9552,41 @@ func (_m *IAMAPI) ListUserTags(_a0 *iam.ListUserTagsInput) (*iam.ListUserTagsOut
 	return r0, r1
 }
 
+// ListUserTagsPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) ListUserTagsPages(_a0 *iam.ListUserTagsInput, _a1 func(*iam.ListUserTagsOutput, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.ListUserTagsInput, func(*iam.ListUserTagsOutput, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListUserTagsPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) ListUserTagsPagesWithContext(_a0 context.Context, _a1 *iam.ListUserTagsInput, _a2 func(*iam.ListUserTagsOutput, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ListUserTagsInput, func(*iam.ListUserTagsOutput, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // ListUserTagsRequest provides a mock function with given fields: _a0
 func (_m *IAMAPI) ListUserTagsRequest(_a0 *iam.ListUserTagsInput) (*request.Request, *iam.ListUserTagsOutput) {
 	ret := _m.Called(_a0)
@@ -9056,22 +9881,993 @@ func (_m *IAMAPI) PutGroupPolicy(_a0 *iam.PutGroupPolicyInput) (*iam.PutGroupPol
 		}
 	}
 
-	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.PutGroupPolicyInput) error); ok {
-		r1 = rf(_a0)
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.PutGroupPolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutGroupPolicyRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutGroupPolicyRequest(_a0 *iam.PutGroupPolicyInput) (*request.Request, *iam.PutGroupPolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.PutGroupPolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.PutGroupPolicyOutput
+	if rf, ok := ret.Get(1).(func(*iam.PutGroupPolicyInput) *iam.PutGroupPolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.PutGroupPolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutGroupPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) PutGroupPolicyWithContext(_a0 context.Context, _a1 *iam.PutGroupPolicyInput, _a2 ...request.Option) (*iam.PutGroupPolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.PutGroupPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutGroupPolicyInput, ...request.Option) *iam.PutGroupPolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutGroupPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutGroupPolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutRolePermissionsBoundary provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutRolePermissionsBoundary(_a0 *iam.PutRolePermissionsBoundaryInput) (*iam.PutRolePermissionsBoundaryOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.PutRolePermissionsBoundaryOutput
+	if rf, ok := ret.Get(0).(func(*iam.PutRolePermissionsBoundaryInput) *iam.PutRolePermissionsBoundaryOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutRolePermissionsBoundaryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.PutRolePermissionsBoundaryInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutRolePermissionsBoundaryRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutRolePermissionsBoundaryRequest(_a0 *iam.PutRolePermissionsBoundaryInput) (*request.Request, *iam.PutRolePermissionsBoundaryOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.PutRolePermissionsBoundaryInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.PutRolePermissionsBoundaryOutput
+	if rf, ok := ret.Get(1).(func(*iam.PutRolePermissionsBoundaryInput) *iam.PutRolePermissionsBoundaryOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.PutRolePermissionsBoundaryOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutRolePermissionsBoundaryWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) PutRolePermissionsBoundaryWithContext(_a0 context.Context, _a1 *iam.PutRolePermissionsBoundaryInput, _a2 ...request.Option) (*iam.PutRolePermissionsBoundaryOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.PutRolePermissionsBoundaryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutRolePermissionsBoundaryInput, ...request.Option) *iam.PutRolePermissionsBoundaryOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutRolePermissionsBoundaryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutRolePermissionsBoundaryInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutRolePolicy provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutRolePolicy(_a0 *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.PutRolePolicyOutput
+	if rf, ok := ret.Get(0).(func(*iam.PutRolePolicyInput) *iam.PutRolePolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutRolePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.PutRolePolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutRolePolicyRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutRolePolicyRequest(_a0 *iam.PutRolePolicyInput) (*request.Request, *iam.PutRolePolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.PutRolePolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.PutRolePolicyOutput
+	if rf, ok := ret.Get(1).(func(*iam.PutRolePolicyInput) *iam.PutRolePolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.PutRolePolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutRolePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) PutRolePolicyWithContext(_a0 context.Context, _a1 *iam.PutRolePolicyInput, _a2 ...request.Option) (*iam.PutRolePolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.PutRolePolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutRolePolicyInput, ...request.Option) *iam.PutRolePolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutRolePolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutRolePolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutUserPermissionsBoundary provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutUserPermissionsBoundary(_a0 *iam.PutUserPermissionsBoundaryInput) (*iam.PutUserPermissionsBoundaryOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.PutUserPermissionsBoundaryOutput
+	if rf, ok := ret.Get(0).(func(*iam.PutUserPermissionsBoundaryInput) *iam.PutUserPermissionsBoundaryOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutUserPermissionsBoundaryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.PutUserPermissionsBoundaryInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutUserPermissionsBoundaryRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutUserPermissionsBoundaryRequest(_a0 *iam.PutUserPermissionsBoundaryInput) (*request.Request, *iam.PutUserPermissionsBoundaryOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.PutUserPermissionsBoundaryInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.PutUserPermissionsBoundaryOutput
+	if rf, ok := ret.Get(1).(func(*iam.PutUserPermissionsBoundaryInput) *iam.PutUserPermissionsBoundaryOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.PutUserPermissionsBoundaryOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutUserPermissionsBoundaryWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) PutUserPermissionsBoundaryWithContext(_a0 context.Context, _a1 *iam.PutUserPermissionsBoundaryInput, _a2 ...request.Option) (*iam.PutUserPermissionsBoundaryOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.PutUserPermissionsBoundaryOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutUserPermissionsBoundaryInput, ...request.Option) *iam.PutUserPermissionsBoundaryOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutUserPermissionsBoundaryOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutUserPermissionsBoundaryInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutUserPolicy provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutUserPolicy(_a0 *iam.PutUserPolicyInput) (*iam.PutUserPolicyOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.PutUserPolicyOutput
+	if rf, ok := ret.Get(0).(func(*iam.PutUserPolicyInput) *iam.PutUserPolicyOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutUserPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.PutUserPolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PutUserPolicyRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) PutUserPolicyRequest(_a0 *iam.PutUserPolicyInput) (*request.Request, *iam.PutUserPolicyOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.PutUserPolicyInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.PutUserPolicyOutput
+	if rf, ok := ret.Get(1).(func(*iam.PutUserPolicyInput) *iam.PutUserPolicyOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.PutUserPolicyOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// PutUserPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) PutUserPolicyWithContext(_a0 context.Context, _a1 *iam.PutUserPolicyInput, _a2 ...request.Option) (*iam.PutUserPolicyOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.PutUserPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutUserPolicyInput, ...request.Option) *iam.PutUserPolicyOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.PutUserPolicyOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutUserPolicyInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveClientIDFromOpenIDConnectProvider provides a mock function with given fields: _a0
+func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProvider(_a0 *iam.RemoveClientIDFromOpenIDConnectProviderInput) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.RemoveClientIDFromOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(0).(func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) *iam.RemoveClientIDFromOpenIDConnectProviderOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.RemoveClientIDFromOpenIDConnectProviderOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveClientIDFromOpenIDConnectProviderRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProviderRequest(_a0 *iam.RemoveClientIDFromOpenIDConnectProviderInput) (*request.Request, *iam.RemoveClientIDFromOpenIDConnectProviderOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.RemoveClientIDFromOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(1).(func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) *iam.RemoveClientIDFromOpenIDConnectProviderOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.RemoveClientIDFromOpenIDConnectProviderOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveClientIDFromOpenIDConnectProviderWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProviderWithContext(_a0 context.Context, _a1 *iam.RemoveClientIDFromOpenIDConnectProviderInput, _a2 ...request.Option) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.RemoveClientIDFromOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.RemoveClientIDFromOpenIDConnectProviderInput, ...request.Option) *iam.RemoveClientIDFromOpenIDConnectProviderOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.RemoveClientIDFromOpenIDConnectProviderOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.RemoveClientIDFromOpenIDConnectProviderInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveRoleFromInstanceProfile provides a mock function with given fields: _a0
+func (_m *IAMAPI) RemoveRoleFromInstanceProfile(_a0 *iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.RemoveRoleFromInstanceProfileOutput
+	if rf, ok := ret.Get(0).(func(*iam.RemoveRoleFromInstanceProfileInput) *iam.RemoveRoleFromInstanceProfileOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.RemoveRoleFromInstanceProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.RemoveRoleFromInstanceProfileInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveRoleFromInstanceProfileRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) RemoveRoleFromInstanceProfileRequest(_a0 *iam.RemoveRoleFromInstanceProfileInput) (*request.Request, *iam.RemoveRoleFromInstanceProfileOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.RemoveRoleFromInstanceProfileInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.RemoveRoleFromInstanceProfileOutput
+	if rf, ok := ret.Get(1).(func(*iam.RemoveRoleFromInstanceProfileInput) *iam.RemoveRoleFromInstanceProfileOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.RemoveRoleFromInstanceProfileOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveRoleFromInstanceProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) RemoveRoleFromInstanceProfileWithContext(_a0 context.Context, _a1 *iam.RemoveRoleFromInstanceProfileInput, _a2 ...request.Option) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.RemoveRoleFromInstanceProfileOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.RemoveRoleFromInstanceProfileInput, ...request.Option) *iam.RemoveRoleFromInstanceProfileOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.RemoveRoleFromInstanceProfileOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.RemoveRoleFromInstanceProfileInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveUserFromGroup provides a mock function with given fields: _a0
+func (_m *IAMAPI) RemoveUserFromGroup(_a0 *iam.RemoveUserFromGroupInput) (*iam.RemoveUserFromGroupOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.RemoveUserFromGroupOutput
+	if rf, ok := ret.Get(0).(func(*iam.RemoveUserFromGroupInput) *iam.RemoveUserFromGroupOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.RemoveUserFromGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.RemoveUserFromGroupInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveUserFromGroupRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) RemoveUserFromGroupRequest(_a0 *iam.RemoveUserFromGroupInput) (*request.Request, *iam.RemoveUserFromGroupOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.RemoveUserFromGroupInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.RemoveUserFromGroupOutput
+	if rf, ok := ret.Get(1).(func(*iam.RemoveUserFromGroupInput) *iam.RemoveUserFromGroupOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.RemoveUserFromGroupOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// RemoveUserFromGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) RemoveUserFromGroupWithContext(_a0 context.Context, _a1 *iam.RemoveUserFromGroupInput, _a2 ...request.Option) (*iam.RemoveUserFromGroupOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.RemoveUserFromGroupOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.RemoveUserFromGroupInput, ...request.Option) *iam.RemoveUserFromGroupOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.RemoveUserFromGroupOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.RemoveUserFromGroupInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetServiceSpecificCredential provides a mock function with given fields: _a0
+func (_m *IAMAPI) ResetServiceSpecificCredential(_a0 *iam.ResetServiceSpecificCredentialInput) (*iam.ResetServiceSpecificCredentialOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ResetServiceSpecificCredentialOutput
+	if rf, ok := ret.Get(0).(func(*iam.ResetServiceSpecificCredentialInput) *iam.ResetServiceSpecificCredentialOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ResetServiceSpecificCredentialOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.ResetServiceSpecificCredentialInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetServiceSpecificCredentialRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ResetServiceSpecificCredentialRequest(_a0 *iam.ResetServiceSpecificCredentialInput) (*request.Request, *iam.ResetServiceSpecificCredentialOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.ResetServiceSpecificCredentialInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.ResetServiceSpecificCredentialOutput
+	if rf, ok := ret.Get(1).(func(*iam.ResetServiceSpecificCredentialInput) *iam.ResetServiceSpecificCredentialOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.ResetServiceSpecificCredentialOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResetServiceSpecificCredentialWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ResetServiceSpecificCredentialWithContext(_a0 context.Context, _a1 *iam.ResetServiceSpecificCredentialInput, _a2 ...request.Option) (*iam.ResetServiceSpecificCredentialOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.ResetServiceSpecificCredentialOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ResetServiceSpecificCredentialInput, ...request.Option) *iam.ResetServiceSpecificCredentialOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ResetServiceSpecificCredentialOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ResetServiceSpecificCredentialInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResyncMFADevice provides a mock function with given fields: _a0
+func (_m *IAMAPI) ResyncMFADevice(_a0 *iam.ResyncMFADeviceInput) (*iam.ResyncMFADeviceOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.ResyncMFADeviceOutput
+	if rf, ok := ret.Get(0).(func(*iam.ResyncMFADeviceInput) *iam.ResyncMFADeviceOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ResyncMFADeviceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.ResyncMFADeviceInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResyncMFADeviceRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) ResyncMFADeviceRequest(_a0 *iam.ResyncMFADeviceInput) (*request.Request, *iam.ResyncMFADeviceOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.ResyncMFADeviceInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.ResyncMFADeviceOutput
+	if rf, ok := ret.Get(1).(func(*iam.ResyncMFADeviceInput) *iam.ResyncMFADeviceOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.ResyncMFADeviceOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// ResyncMFADeviceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) ResyncMFADeviceWithContext(_a0 context.Context, _a1 *iam.ResyncMFADeviceInput, _a2 ...request.Option) (*iam.ResyncMFADeviceOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.ResyncMFADeviceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.ResyncMFADeviceInput, ...request.Option) *iam.ResyncMFADeviceOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.ResyncMFADeviceOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.ResyncMFADeviceInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDefaultPolicyVersion provides a mock function with given fields: _a0
+func (_m *IAMAPI) SetDefaultPolicyVersion(_a0 *iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.SetDefaultPolicyVersionOutput
+	if rf, ok := ret.Get(0).(func(*iam.SetDefaultPolicyVersionInput) *iam.SetDefaultPolicyVersionOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.SetDefaultPolicyVersionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.SetDefaultPolicyVersionInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetDefaultPolicyVersionRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) SetDefaultPolicyVersionRequest(_a0 *iam.SetDefaultPolicyVersionInput) (*request.Request, *iam.SetDefaultPolicyVersionOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.SetDefaultPolicyVersionInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.SetDefaultPolicyVersionOutput
+	if rf, ok := ret.Get(1).(func(*iam.SetDefaultPolicyVersionInput) *iam.SetDefaultPolicyVersionOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.SetDefaultPolicyVersionOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetDefaultPolicyVersionWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) SetDefaultPolicyVersionWithContext(_a0 context.Context, _a1 *iam.SetDefaultPolicyVersionInput, _a2 ...request.Option) (*iam.SetDefaultPolicyVersionOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.SetDefaultPolicyVersionOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.SetDefaultPolicyVersionInput, ...request.Option) *iam.SetDefaultPolicyVersionOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.SetDefaultPolicyVersionOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.SetDefaultPolicyVersionInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetSecurityTokenServicePreferences provides a mock function with given fields: _a0
+func (_m *IAMAPI) SetSecurityTokenServicePreferences(_a0 *iam.SetSecurityTokenServicePreferencesInput) (*iam.SetSecurityTokenServicePreferencesOutput, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.SetSecurityTokenServicePreferencesOutput
+	if rf, ok := ret.Get(0).(func(*iam.SetSecurityTokenServicePreferencesInput) *iam.SetSecurityTokenServicePreferencesOutput); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.SetSecurityTokenServicePreferencesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.SetSecurityTokenServicePreferencesInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SetSecurityTokenServicePreferencesRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) SetSecurityTokenServicePreferencesRequest(_a0 *iam.SetSecurityTokenServicePreferencesInput) (*request.Request, *iam.SetSecurityTokenServicePreferencesOutput) {
+	ret := _m.Called(_a0)
+
+	var r0 *request.Request
+	if rf, ok := ret.Get(0).(func(*iam.SetSecurityTokenServicePreferencesInput) *request.Request); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*request.Request)
+		}
+	}
+
+	var r1 *iam.SetSecurityTokenServicePreferencesOutput
+	if rf, ok := ret.Get(1).(func(*iam.SetSecurityTokenServicePreferencesInput) *iam.SetSecurityTokenServicePreferencesOutput); ok {
+		r1 = rf(_a0)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(*iam.SetSecurityTokenServicePreferencesOutput)
+		}
+	}
+
+	return r0, r1
+}
+
+// SetSecurityTokenServicePreferencesWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) SetSecurityTokenServicePreferencesWithContext(_a0 context.Context, _a1 *iam.SetSecurityTokenServicePreferencesInput, _a2 ...request.Option) (*iam.SetSecurityTokenServicePreferencesOutput, error) {
+	_va := make([]interface{}, len(_a2))
+	for _i := range _a2 {
+		_va[_i] = _a2[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 *iam.SetSecurityTokenServicePreferencesOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.SetSecurityTokenServicePreferencesInput, ...request.Option) *iam.SetSecurityTokenServicePreferencesOutput); ok {
+		r0 = rf(_a0, _a1, _a2...)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.SetSecurityTokenServicePreferencesOutput)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.SetSecurityTokenServicePreferencesInput, ...request.Option) error); ok {
+		r1 = rf(_a0, _a1, _a2...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimulateCustomPolicy provides a mock function with given fields: _a0
+func (_m *IAMAPI) SimulateCustomPolicy(_a0 *iam.SimulateCustomPolicyInput) (*iam.SimulatePolicyResponse, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *iam.SimulatePolicyResponse
+	if rf, ok := ret.Get(0).(func(*iam.SimulateCustomPolicyInput) *iam.SimulatePolicyResponse); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*iam.SimulatePolicyResponse)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*iam.SimulateCustomPolicyInput) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SimulateCustomPolicyPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) SimulateCustomPolicyPages(_a0 *iam.SimulateCustomPolicyInput, _a1 func(*iam.SimulatePolicyResponse, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.SimulateCustomPolicyInput, func(*iam.SimulatePolicyResponse, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SimulateCustomPolicyPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) SimulateCustomPolicyPagesWithContext(_a0 context.Context, _a1 *iam.SimulateCustomPolicyInput, _a2 func(*iam.SimulatePolicyResponse, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.SimulateCustomPolicyInput, func(*iam.SimulatePolicyResponse, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// PutGroupPolicyRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutGroupPolicyRequest(_a0 *iam.PutGroupPolicyInput) (*request.Request, *iam.PutGroupPolicyOutput) {
+// SimulateCustomPolicyRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) SimulateCustomPolicyRequest(_a0 *iam.SimulateCustomPolicyInput) (*request.Request, *iam.SimulatePolicyResponse) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.PutGroupPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.SimulateCustomPolicyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9079,20 +10875,20 @@ func (_m *IAMAPI) PutGroupPolicyRequest(_a0 *iam.PutGroupPolicyInput) (*request.
 		}
 	}
 
-	var r1 *iam.PutGroupPolicyOutput
-	if rf, ok := ret.Get(1).(func(*iam.PutGroupPolicyInput) *iam.PutGroupPolicyOutput); ok {
+	var r1 *iam.SimulatePolicyResponse
+	if rf, ok := ret.Get(1).(func(*iam.SimulateCustomPolicyInput) *iam.SimulatePolicyResponse); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.PutGroupPolicyOutput)
+			r1 = ret.Get(1).(*iam.SimulatePolicyResponse)
 		}
 	}
 
 	return r0, r1
 }
 
-// PutGroupPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) PutGroupPolicyWithContext(_a0 context.Context, _a1 *iam.PutGroupPolicyInput, _a2 ...request.Option) (*iam.PutGroupPolicyOutput, error) {
+// SimulateCustomPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) SimulateCustomPolicyWithContext(_a0 context.Context, _a1 *iam.SimulateCustomPolicyInput, _a2 ...request.Option) (*iam.SimulatePolicyResponse, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9102,17 +10898,17 @@ func (_m *IAMAPI) PutGroupPolicyWithContext(_a0 context.Context, _a1 *iam.PutGro
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.PutGroupPolicyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutGroupPolicyInput, ...request.Option) *iam.PutGroupPolicyOutput); ok {
+	var r0 *iam.SimulatePolicyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.SimulateCustomPolicyInput, ...request.Option) *iam.SimulatePolicyResponse); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutGroupPolicyOutput)
+			r0 = ret.Get(0).(*iam.SimulatePolicyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutGroupPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.SimulateCustomPolicyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9121,21 +10917,21 @@ func (_m *IAMAPI) PutGroupPolicyWithContext(_a0 context.Context, _a1 *iam.PutGro
 	return r0, r1
 }
 
-// PutRolePermissionsBoundary provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutRolePermissionsBoundary(_a0 *iam.PutRolePermissionsBoundaryInput) (*iam.PutRolePermissionsBoundaryOutput, error) {
+// SimulatePrincipalPolicy provides a mock function with given fields: _a0
+func (_m *IAMAPI) SimulatePrincipalPolicy(_a0 *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.PutRolePermissionsBoundaryOutput
-	if rf, ok := ret.Get(0).(func(*iam.PutRolePermissionsBoundaryInput) *iam.PutRolePermissionsBoundaryOutput); ok {
+	var r0 *iam.SimulatePolicyResponse
+	if rf, ok := ret.Get(0).(func(*iam.SimulatePrincipalPolicyInput) *iam.SimulatePolicyResponse); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutRolePermissionsBoundaryOutput)
+			r0 = ret.Get(0).(*iam.SimulatePolicyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.PutRolePermissionsBoundaryInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.SimulatePrincipalPolicyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9144,12 +10940,47 @@ func (_m *IAMAPI) PutRolePermissionsBoundary(_a0 *iam.PutRolePermissionsBoundary
 	return r0, r1
 }
 
-// PutRolePermissionsBoundaryRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutRolePermissionsBoundaryRequest(_a0 *iam.PutRolePermissionsBoundaryInput) (*request.Request, *iam.PutRolePermissionsBoundaryOutput) {
+// SimulatePrincipalPolicyPages provides a mock function with given fields: _a0, _a1
+func (_m *IAMAPI) SimulatePrincipalPolicyPages(_a0 *iam.SimulatePrincipalPolicyInput, _a1 func(*iam.SimulatePolicyResponse, bool) bool) error {
+	ret := _m.Called(_a0, _a1)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*iam.SimulatePrincipalPolicyInput, func(*iam.SimulatePolicyResponse, bool) bool) error); ok {
+		r0 = rf(_a0, _a1)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SimulatePrincipalPolicyPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
+func (_m *IAMAPI) SimulatePrincipalPolicyPagesWithContext(_a0 context.Context, _a1 *iam.SimulatePrincipalPolicyInput, _a2 func(*iam.SimulatePolicyResponse, bool) bool, _a3 ...request.Option) error {
+	_va := make([]interface{}, len(_a3))
+	for _i := range _a3 {
+		_va[_i] = _a3[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _a0, _a1, _a2)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.SimulatePrincipalPolicyInput, func(*iam.SimulatePolicyResponse, bool) bool, ...request.Option) error); ok {
+		r0 = rf(_a0, _a1, _a2, _a3...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SimulatePrincipalPolicyRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) SimulatePrincipalPolicyRequest(_a0 *iam.SimulatePrincipalPolicyInput) (*request.Request, *iam.SimulatePolicyResponse) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.PutRolePermissionsBoundaryInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.SimulatePrincipalPolicyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9157,20 +10988,20 @@ func (_m *IAMAPI) PutRolePermissionsBoundaryRequest(_a0 *iam.PutRolePermissionsB
 		}
 	}
 
-	var r1 *iam.PutRolePermissionsBoundaryOutput
-	if rf, ok := ret.Get(1).(func(*iam.PutRolePermissionsBoundaryInput) *iam.PutRolePermissionsBoundaryOutput); ok {
+	var r1 *iam.SimulatePolicyResponse
+	if rf, ok := ret.Get(1).(func(*iam.SimulatePrincipalPolicyInput) *iam.SimulatePolicyResponse); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.PutRolePermissionsBoundaryOutput)
+			r1 = ret.Get(1).(*iam.SimulatePolicyResponse)
 		}
 	}
 
 	return r0, r1
 }
 
-// PutRolePermissionsBoundaryWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) PutRolePermissionsBoundaryWithContext(_a0 context.Context, _a1 *iam.PutRolePermissionsBoundaryInput, _a2 ...request.Option) (*iam.PutRolePermissionsBoundaryOutput, error) {
+// SimulatePrincipalPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) SimulatePrincipalPolicyWithContext(_a0 context.Context, _a1 *iam.SimulatePrincipalPolicyInput, _a2 ...request.Option) (*iam.SimulatePolicyResponse, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9180,17 +11011,17 @@ func (_m *IAMAPI) PutRolePermissionsBoundaryWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.PutRolePermissionsBoundaryOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutRolePermissionsBoundaryInput, ...request.Option) *iam.PutRolePermissionsBoundaryOutput); ok {
+	var r0 *iam.SimulatePolicyResponse
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.SimulatePrincipalPolicyInput, ...request.Option) *iam.SimulatePolicyResponse); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutRolePermissionsBoundaryOutput)
+			r0 = ret.Get(0).(*iam.SimulatePolicyResponse)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutRolePermissionsBoundaryInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.SimulatePrincipalPolicyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9199,21 +11030,21 @@ func (_m *IAMAPI) PutRolePermissionsBoundaryWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// PutRolePolicy provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutRolePolicy(_a0 *iam.PutRolePolicyInput) (*iam.PutRolePolicyOutput, error) {
+// TagInstanceProfile provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagInstanceProfile(_a0 *iam.TagInstanceProfileInput) (*iam.TagInstanceProfileOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.PutRolePolicyOutput
-	if rf, ok := ret.Get(0).(func(*iam.PutRolePolicyInput) *iam.PutRolePolicyOutput); ok {
+	var r0 *iam.TagInstanceProfileOutput
+	if rf, ok := ret.Get(0).(func(*iam.TagInstanceProfileInput) *iam.TagInstanceProfileOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutRolePolicyOutput)
+			r0 = ret.Get(0).(*iam.TagInstanceProfileOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.PutRolePolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.TagInstanceProfileInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9222,12 +11053,12 @@ func (_m *IAMAPI) PutRolePolicy(_a0 *iam.PutRolePolicyInput) (*iam.PutRolePolicy
 	return r0, r1
 }
 
-// PutRolePolicyRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutRolePolicyRequest(_a0 *iam.PutRolePolicyInput) (*request.Request, *iam.PutRolePolicyOutput) {
+// TagInstanceProfileRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagInstanceProfileRequest(_a0 *iam.TagInstanceProfileInput) (*request.Request, *iam.TagInstanceProfileOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.PutRolePolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.TagInstanceProfileInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9235,20 +11066,20 @@ func (_m *IAMAPI) PutRolePolicyRequest(_a0 *iam.PutRolePolicyInput) (*request.Re
 		}
 	}
 
-	var r1 *iam.PutRolePolicyOutput
-	if rf, ok := ret.Get(1).(func(*iam.PutRolePolicyInput) *iam.PutRolePolicyOutput); ok {
+	var r1 *iam.TagInstanceProfileOutput
+	if rf, ok := ret.Get(1).(func(*iam.TagInstanceProfileInput) *iam.TagInstanceProfileOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.PutRolePolicyOutput)
+			r1 = ret.Get(1).(*iam.TagInstanceProfileOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PutRolePolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) PutRolePolicyWithContext(_a0 context.Context, _a1 *iam.PutRolePolicyInput, _a2 ...request.Option) (*iam.PutRolePolicyOutput, error) {
+// TagInstanceProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) TagInstanceProfileWithContext(_a0 context.Context, _a1 *iam.TagInstanceProfileInput, _a2 ...request.Option) (*iam.TagInstanceProfileOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9258,17 +11089,17 @@ func (_m *IAMAPI) PutRolePolicyWithContext(_a0 context.Context, _a1 *iam.PutRole
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.PutRolePolicyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutRolePolicyInput, ...request.Option) *iam.PutRolePolicyOutput); ok {
+	var r0 *iam.TagInstanceProfileOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagInstanceProfileInput, ...request.Option) *iam.TagInstanceProfileOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutRolePolicyOutput)
+			r0 = ret.Get(0).(*iam.TagInstanceProfileOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutRolePolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagInstanceProfileInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9277,21 +11108,21 @@ func (_m *IAMAPI) PutRolePolicyWithContext(_a0 context.Context, _a1 *iam.PutRole
 	return r0, r1
 }
 
-// PutUserPermissionsBoundary provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutUserPermissionsBoundary(_a0 *iam.PutUserPermissionsBoundaryInput) (*iam.PutUserPermissionsBoundaryOutput, error) {
+// TagMFADevice provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagMFADevice(_a0 *iam.TagMFADeviceInput) (*iam.TagMFADeviceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.PutUserPermissionsBoundaryOutput
-	if rf, ok := ret.Get(0).(func(*iam.PutUserPermissionsBoundaryInput) *iam.PutUserPermissionsBoundaryOutput); ok {
+	var r0 *iam.TagMFADeviceOutput
+	if rf, ok := ret.Get(0).(func(*iam.TagMFADeviceInput) *iam.TagMFADeviceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutUserPermissionsBoundaryOutput)
+			r0 = ret.Get(0).(*iam.TagMFADeviceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.PutUserPermissionsBoundaryInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.TagMFADeviceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9300,12 +11131,12 @@ func (_m *IAMAPI) PutUserPermissionsBoundary(_a0 *iam.PutUserPermissionsBoundary
 	return r0, r1
 }
 
-// PutUserPermissionsBoundaryRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutUserPermissionsBoundaryRequest(_a0 *iam.PutUserPermissionsBoundaryInput) (*request.Request, *iam.PutUserPermissionsBoundaryOutput) {
+// TagMFADeviceRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagMFADeviceRequest(_a0 *iam.TagMFADeviceInput) (*request.Request, *iam.TagMFADeviceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.PutUserPermissionsBoundaryInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.TagMFADeviceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9313,20 +11144,20 @@ func (_m *IAMAPI) PutUserPermissionsBoundaryRequest(_a0 *iam.PutUserPermissionsB
 		}
 	}
 
-	var r1 *iam.PutUserPermissionsBoundaryOutput
-	if rf, ok := ret.Get(1).(func(*iam.PutUserPermissionsBoundaryInput) *iam.PutUserPermissionsBoundaryOutput); ok {
+	var r1 *iam.TagMFADeviceOutput
+	if rf, ok := ret.Get(1).(func(*iam.TagMFADeviceInput) *iam.TagMFADeviceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.PutUserPermissionsBoundaryOutput)
+			r1 = ret.Get(1).(*iam.TagMFADeviceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PutUserPermissionsBoundaryWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) PutUserPermissionsBoundaryWithContext(_a0 context.Context, _a1 *iam.PutUserPermissionsBoundaryInput, _a2 ...request.Option) (*iam.PutUserPermissionsBoundaryOutput, error) {
+// TagMFADeviceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) TagMFADeviceWithContext(_a0 context.Context, _a1 *iam.TagMFADeviceInput, _a2 ...request.Option) (*iam.TagMFADeviceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9336,17 +11167,17 @@ func (_m *IAMAPI) PutUserPermissionsBoundaryWithContext(_a0 context.Context, _a1
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.PutUserPermissionsBoundaryOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutUserPermissionsBoundaryInput, ...request.Option) *iam.PutUserPermissionsBoundaryOutput); ok {
+	var r0 *iam.TagMFADeviceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagMFADeviceInput, ...request.Option) *iam.TagMFADeviceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutUserPermissionsBoundaryOutput)
+			r0 = ret.Get(0).(*iam.TagMFADeviceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutUserPermissionsBoundaryInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagMFADeviceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9355,21 +11186,21 @@ func (_m *IAMAPI) PutUserPermissionsBoundaryWithContext(_a0 context.Context, _a1
 	return r0, r1
 }
 
-// PutUserPolicy provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutUserPolicy(_a0 *iam.PutUserPolicyInput) (*iam.PutUserPolicyOutput, error) {
+// TagOpenIDConnectProvider provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagOpenIDConnectProvider(_a0 *iam.TagOpenIDConnectProviderInput) (*iam.TagOpenIDConnectProviderOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.PutUserPolicyOutput
-	if rf, ok := ret.Get(0).(func(*iam.PutUserPolicyInput) *iam.PutUserPolicyOutput); ok {
+	var r0 *iam.TagOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(0).(func(*iam.TagOpenIDConnectProviderInput) *iam.TagOpenIDConnectProviderOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutUserPolicyOutput)
+			r0 = ret.Get(0).(*iam.TagOpenIDConnectProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.PutUserPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.TagOpenIDConnectProviderInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9378,12 +11209,12 @@ func (_m *IAMAPI) PutUserPolicy(_a0 *iam.PutUserPolicyInput) (*iam.PutUserPolicy
 	return r0, r1
 }
 
-// PutUserPolicyRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) PutUserPolicyRequest(_a0 *iam.PutUserPolicyInput) (*request.Request, *iam.PutUserPolicyOutput) {
+// TagOpenIDConnectProviderRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagOpenIDConnectProviderRequest(_a0 *iam.TagOpenIDConnectProviderInput) (*request.Request, *iam.TagOpenIDConnectProviderOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.PutUserPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.TagOpenIDConnectProviderInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9391,20 +11222,20 @@ func (_m *IAMAPI) PutUserPolicyRequest(_a0 *iam.PutUserPolicyInput) (*request.Re
 		}
 	}
 
-	var r1 *iam.PutUserPolicyOutput
-	if rf, ok := ret.Get(1).(func(*iam.PutUserPolicyInput) *iam.PutUserPolicyOutput); ok {
+	var r1 *iam.TagOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(1).(func(*iam.TagOpenIDConnectProviderInput) *iam.TagOpenIDConnectProviderOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.PutUserPolicyOutput)
+			r1 = ret.Get(1).(*iam.TagOpenIDConnectProviderOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// PutUserPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) PutUserPolicyWithContext(_a0 context.Context, _a1 *iam.PutUserPolicyInput, _a2 ...request.Option) (*iam.PutUserPolicyOutput, error) {
+// TagOpenIDConnectProviderWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) TagOpenIDConnectProviderWithContext(_a0 context.Context, _a1 *iam.TagOpenIDConnectProviderInput, _a2 ...request.Option) (*iam.TagOpenIDConnectProviderOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9414,17 +11245,17 @@ func (_m *IAMAPI) PutUserPolicyWithContext(_a0 context.Context, _a1 *iam.PutUser
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.PutUserPolicyOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.PutUserPolicyInput, ...request.Option) *iam.PutUserPolicyOutput); ok {
+	var r0 *iam.TagOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagOpenIDConnectProviderInput, ...request.Option) *iam.TagOpenIDConnectProviderOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.PutUserPolicyOutput)
+			r0 = ret.Get(0).(*iam.TagOpenIDConnectProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.PutUserPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagOpenIDConnectProviderInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9433,21 +11264,21 @@ func (_m *IAMAPI) PutUserPolicyWithContext(_a0 context.Context, _a1 *iam.PutUser
 	return r0, r1
 }
 
-// RemoveClientIDFromOpenIDConnectProvider provides a mock function with given fields: _a0
-func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProvider(_a0 *iam.RemoveClientIDFromOpenIDConnectProviderInput) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error) {
+// TagPolicy provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagPolicy(_a0 *iam.TagPolicyInput) (*iam.TagPolicyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.RemoveClientIDFromOpenIDConnectProviderOutput
-	if rf, ok := ret.Get(0).(func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) *iam.RemoveClientIDFromOpenIDConnectProviderOutput); ok {
+	var r0 *iam.TagPolicyOutput
+	if rf, ok := ret.Get(0).(func(*iam.TagPolicyInput) *iam.TagPolicyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.RemoveClientIDFromOpenIDConnectProviderOutput)
+			r0 = ret.Get(0).(*iam.TagPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.TagPolicyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9456,12 +11287,12 @@ func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProvider(_a0 *iam.RemoveClientI
 	return r0, r1
 }
 
-// RemoveClientIDFromOpenIDConnectProviderRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProviderRequest(_a0 *iam.RemoveClientIDFromOpenIDConnectProviderInput) (*request.Request, *iam.RemoveClientIDFromOpenIDConnectProviderOutput) {
+// TagPolicyRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagPolicyRequest(_a0 *iam.TagPolicyInput) (*request.Request, *iam.TagPolicyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.TagPolicyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9469,20 +11300,20 @@ func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProviderRequest(_a0 *iam.Remove
 		}
 	}
 
-	var r1 *iam.RemoveClientIDFromOpenIDConnectProviderOutput
-	if rf, ok := ret.Get(1).(func(*iam.RemoveClientIDFromOpenIDConnectProviderInput) *iam.RemoveClientIDFromOpenIDConnectProviderOutput); ok {
+	var r1 *iam.TagPolicyOutput
+	if rf, ok := ret.Get(1).(func(*iam.TagPolicyInput) *iam.TagPolicyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.RemoveClientIDFromOpenIDConnectProviderOutput)
+			r1 = ret.Get(1).(*iam.TagPolicyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveClientIDFromOpenIDConnectProviderWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProviderWithContext(_a0 context.Context, _a1 *iam.RemoveClientIDFromOpenIDConnectProviderInput, _a2 ...request.Option) (*iam.RemoveClientIDFromOpenIDConnectProviderOutput, error) {
+// TagPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) TagPolicyWithContext(_a0 context.Context, _a1 *iam.TagPolicyInput, _a2 ...request.Option) (*iam.TagPolicyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9492,17 +11323,17 @@ func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProviderWithContext(_a0 context
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.RemoveClientIDFromOpenIDConnectProviderOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.RemoveClientIDFromOpenIDConnectProviderInput, ...request.Option) *iam.RemoveClientIDFromOpenIDConnectProviderOutput); ok {
+	var r0 *iam.TagPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagPolicyInput, ...request.Option) *iam.TagPolicyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.RemoveClientIDFromOpenIDConnectProviderOutput)
+			r0 = ret.Get(0).(*iam.TagPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.RemoveClientIDFromOpenIDConnectProviderInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagPolicyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9511,21 +11342,21 @@ func (_m *IAMAPI) RemoveClientIDFromOpenIDConnectProviderWithContext(_a0 context
 	return r0, r1
 }
 
-// RemoveRoleFromInstanceProfile provides a mock function with given fields: _a0
-func (_m *IAMAPI) RemoveRoleFromInstanceProfile(_a0 *iam.RemoveRoleFromInstanceProfileInput) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+// TagRole provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagRole(_a0 *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.RemoveRoleFromInstanceProfileOutput
-	if rf, ok := ret.Get(0).(func(*iam.RemoveRoleFromInstanceProfileInput) *iam.RemoveRoleFromInstanceProfileOutput); ok {
+	var r0 *iam.TagRoleOutput
+	if rf, ok := ret.Get(0).(func(*iam.TagRoleInput) *iam.TagRoleOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.RemoveRoleFromInstanceProfileOutput)
+			r0 = ret.Get(0).(*iam.TagRoleOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.RemoveRoleFromInstanceProfileInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.TagRoleInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9534,12 +11365,12 @@ func (_m *IAMAPI) RemoveRoleFromInstanceProfile(_a0 *iam.RemoveRoleFromInstanceP
 	return r0, r1
 }
 
-// RemoveRoleFromInstanceProfileRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) RemoveRoleFromInstanceProfileRequest(_a0 *iam.RemoveRoleFromInstanceProfileInput) (*request.Request, *iam.RemoveRoleFromInstanceProfileOutput) {
+// TagRoleRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagRoleRequest(_a0 *iam.TagRoleInput) (*request.Request, *iam.TagRoleOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.RemoveRoleFromInstanceProfileInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.TagRoleInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9547,20 +11378,20 @@ func (_m *IAMAPI) RemoveRoleFromInstanceProfileRequest(_a0 *iam.RemoveRoleFromIn
 		}
 	}
 
-	var r1 *iam.RemoveRoleFromInstanceProfileOutput
-	if rf, ok := ret.Get(1).(func(*iam.RemoveRoleFromInstanceProfileInput) *iam.RemoveRoleFromInstanceProfileOutput); ok {
+	var r1 *iam.TagRoleOutput
+	if rf, ok := ret.Get(1).(func(*iam.TagRoleInput) *iam.TagRoleOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.RemoveRoleFromInstanceProfileOutput)
+			r1 = ret.Get(1).(*iam.TagRoleOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveRoleFromInstanceProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) RemoveRoleFromInstanceProfileWithContext(_a0 context.Context, _a1 *iam.RemoveRoleFromInstanceProfileInput, _a2 ...request.Option) (*iam.RemoveRoleFromInstanceProfileOutput, error) {
+// TagRoleWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) TagRoleWithContext(_a0 context.Context, _a1 *iam.TagRoleInput, _a2 ...request.Option) (*iam.TagRoleOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9570,17 +11401,17 @@ func (_m *IAMAPI) RemoveRoleFromInstanceProfileWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.RemoveRoleFromInstanceProfileOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.RemoveRoleFromInstanceProfileInput, ...request.Option) *iam.RemoveRoleFromInstanceProfileOutput); ok {
+	var r0 *iam.TagRoleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagRoleInput, ...request.Option) *iam.TagRoleOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.RemoveRoleFromInstanceProfileOutput)
+			r0 = ret.Get(0).(*iam.TagRoleOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.RemoveRoleFromInstanceProfileInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagRoleInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9589,21 +11420,21 @@ func (_m *IAMAPI) RemoveRoleFromInstanceProfileWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// RemoveUserFromGroup provides a mock function with given fields: _a0
-func (_m *IAMAPI) RemoveUserFromGroup(_a0 *iam.RemoveUserFromGroupInput) (*iam.RemoveUserFromGroupOutput, error) {
+// TagSAMLProvider provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagSAMLProvider(_a0 *iam.TagSAMLProviderInput) (*iam.TagSAMLProviderOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.RemoveUserFromGroupOutput
-	if rf, ok := ret.Get(0).(func(*iam.RemoveUserFromGroupInput) *iam.RemoveUserFromGroupOutput); ok {
+	var r0 *iam.TagSAMLProviderOutput
+	if rf, ok := ret.Get(0).(func(*iam.TagSAMLProviderInput) *iam.TagSAMLProviderOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.RemoveUserFromGroupOutput)
+			r0 = ret.Get(0).(*iam.TagSAMLProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.RemoveUserFromGroupInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.TagSAMLProviderInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9612,12 +11443,12 @@ func (_m *IAMAPI) RemoveUserFromGroup(_a0 *iam.RemoveUserFromGroupInput) (*iam.R
 	return r0, r1
 }
 
-// RemoveUserFromGroupRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) RemoveUserFromGroupRequest(_a0 *iam.RemoveUserFromGroupInput) (*request.Request, *iam.RemoveUserFromGroupOutput) {
+// TagSAMLProviderRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagSAMLProviderRequest(_a0 *iam.TagSAMLProviderInput) (*request.Request, *iam.TagSAMLProviderOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.RemoveUserFromGroupInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.TagSAMLProviderInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9625,20 +11456,20 @@ func (_m *IAMAPI) RemoveUserFromGroupRequest(_a0 *iam.RemoveUserFromGroupInput)
 		}
 	}
 
-	var r1 *iam.RemoveUserFromGroupOutput
-	if rf, ok := ret.Get(1).(func(*iam.RemoveUserFromGroupInput) *iam.RemoveUserFromGroupOutput); ok {
+	var r1 *iam.TagSAMLProviderOutput
+	if rf, ok := ret.Get(1).(func(*iam.TagSAMLProviderInput) *iam.TagSAMLProviderOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.RemoveUserFromGroupOutput)
+			r1 = ret.Get(1).(*iam.TagSAMLProviderOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// RemoveUserFromGroupWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) RemoveUserFromGroupWithContext(_a0 context.Context, _a1 *iam.RemoveUserFromGroupInput, _a2 ...request.Option) (*iam.RemoveUserFromGroupOutput, error) {
+// TagSAMLProviderWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) TagSAMLProviderWithContext(_a0 context.Context, _a1 *iam.TagSAMLProviderInput, _a2 ...request.Option) (*iam.TagSAMLProviderOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9648,17 +11479,17 @@ func (_m *IAMAPI) RemoveUserFromGroupWithContext(_a0 context.Context, _a1 *iam.R
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.RemoveUserFromGroupOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.RemoveUserFromGroupInput, ...request.Option) *iam.RemoveUserFromGroupOutput); ok {
+	var r0 *iam.TagSAMLProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagSAMLProviderInput, ...request.Option) *iam.TagSAMLProviderOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.RemoveUserFromGroupOutput)
+			r0 = ret.Get(0).(*iam.TagSAMLProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.RemoveUserFromGroupInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagSAMLProviderInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9667,21 +11498,21 @@ func (_m *IAMAPI) RemoveUserFromGroupWithContext(_a0 context.Context, _a1 *iam.R
 	return r0, r1
 }
 
-// ResetServiceSpecificCredential provides a mock function with given fields: _a0
-func (_m *IAMAPI) ResetServiceSpecificCredential(_a0 *iam.ResetServiceSpecificCredentialInput) (*iam.ResetServiceSpecificCredentialOutput, error) {
+// TagServerCertificate provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagServerCertificate(_a0 *iam.TagServerCertificateInput) (*iam.TagServerCertificateOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ResetServiceSpecificCredentialOutput
-	if rf, ok := ret.Get(0).(func(*iam.ResetServiceSpecificCredentialInput) *iam.ResetServiceSpecificCredentialOutput); ok {
+	var r0 *iam.TagServerCertificateOutput
+	if rf, ok := ret.Get(0).(func(*iam.TagServerCertificateInput) *iam.TagServerCertificateOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ResetServiceSpecificCredentialOutput)
+			r0 = ret.Get(0).(*iam.TagServerCertificateOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.ResetServiceSpecificCredentialInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.TagServerCertificateInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9690,12 +11521,12 @@ func (_m *IAMAPI) ResetServiceSpecificCredential(_a0 *iam.ResetServiceSpecificCr
 	return r0, r1
 }
 
-// ResetServiceSpecificCredentialRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) ResetServiceSpecificCredentialRequest(_a0 *iam.ResetServiceSpecificCredentialInput) (*request.Request, *iam.ResetServiceSpecificCredentialOutput) {
+// TagServerCertificateRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagServerCertificateRequest(_a0 *iam.TagServerCertificateInput) (*request.Request, *iam.TagServerCertificateOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.ResetServiceSpecificCredentialInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.TagServerCertificateInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9703,20 +11534,20 @@ func (_m *IAMAPI) ResetServiceSpecificCredentialRequest(_a0 *iam.ResetServiceSpe
 		}
 	}
 
-	var r1 *iam.ResetServiceSpecificCredentialOutput
-	if rf, ok := ret.Get(1).(func(*iam.ResetServiceSpecificCredentialInput) *iam.ResetServiceSpecificCredentialOutput); ok {
+	var r1 *iam.TagServerCertificateOutput
+	if rf, ok := ret.Get(1).(func(*iam.TagServerCertificateInput) *iam.TagServerCertificateOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.ResetServiceSpecificCredentialOutput)
+			r1 = ret.Get(1).(*iam.TagServerCertificateOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ResetServiceSpecificCredentialWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) ResetServiceSpecificCredentialWithContext(_a0 context.Context, _a1 *iam.ResetServiceSpecificCredentialInput, _a2 ...request.Option) (*iam.ResetServiceSpecificCredentialOutput, error) {
+// TagServerCertificateWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) TagServerCertificateWithContext(_a0 context.Context, _a1 *iam.TagServerCertificateInput, _a2 ...request.Option) (*iam.TagServerCertificateOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9726,17 +11557,17 @@ func (_m *IAMAPI) ResetServiceSpecificCredentialWithContext(_a0 context.Context,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.ResetServiceSpecificCredentialOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ResetServiceSpecificCredentialInput, ...request.Option) *iam.ResetServiceSpecificCredentialOutput); ok {
+	var r0 *iam.TagServerCertificateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagServerCertificateInput, ...request.Option) *iam.TagServerCertificateOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ResetServiceSpecificCredentialOutput)
+			r0 = ret.Get(0).(*iam.TagServerCertificateOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.ResetServiceSpecificCredentialInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagServerCertificateInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9745,21 +11576,21 @@ func (_m *IAMAPI) ResetServiceSpecificCredentialWithContext(_a0 context.Context,
 	return r0, r1
 }
 
-// ResyncMFADevice provides a mock function with given fields: _a0
-func (_m *IAMAPI) ResyncMFADevice(_a0 *iam.ResyncMFADeviceInput) (*iam.ResyncMFADeviceOutput, error) {
+// TagUser provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagUser(_a0 *iam.TagUserInput) (*iam.TagUserOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.ResyncMFADeviceOutput
-	if rf, ok := ret.Get(0).(func(*iam.ResyncMFADeviceInput) *iam.ResyncMFADeviceOutput); ok {
+	var r0 *iam.TagUserOutput
+	if rf, ok := ret.Get(0).(func(*iam.TagUserInput) *iam.TagUserOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ResyncMFADeviceOutput)
+			r0 = ret.Get(0).(*iam.TagUserOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.ResyncMFADeviceInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.TagUserInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9768,12 +11599,12 @@ func (_m *IAMAPI) ResyncMFADevice(_a0 *iam.ResyncMFADeviceInput) (*iam.ResyncMFA
 	return r0, r1
 }
 
-// ResyncMFADeviceRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) ResyncMFADeviceRequest(_a0 *iam.ResyncMFADeviceInput) (*request.Request, *iam.ResyncMFADeviceOutput) {
+// TagUserRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) TagUserRequest(_a0 *iam.TagUserInput) (*request.Request, *iam.TagUserOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.ResyncMFADeviceInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.TagUserInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9781,20 +11612,20 @@ func (_m *IAMAPI) ResyncMFADeviceRequest(_a0 *iam.ResyncMFADeviceInput) (*reques
 		}
 	}
 
-	var r1 *iam.ResyncMFADeviceOutput
-	if rf, ok := ret.Get(1).(func(*iam.ResyncMFADeviceInput) *iam.ResyncMFADeviceOutput); ok {
+	var r1 *iam.TagUserOutput
+	if rf, ok := ret.Get(1).(func(*iam.TagUserInput) *iam.TagUserOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.ResyncMFADeviceOutput)
+			r1 = ret.Get(1).(*iam.TagUserOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// ResyncMFADeviceWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) ResyncMFADeviceWithContext(_a0 context.Context, _a1 *iam.ResyncMFADeviceInput, _a2 ...request.Option) (*iam.ResyncMFADeviceOutput, error) {
+// TagUserWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) TagUserWithContext(_a0 context.Context, _a1 *iam.TagUserInput, _a2 ...request.Option) (*iam.TagUserOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9804,17 +11635,17 @@ func (_m *IAMAPI) ResyncMFADeviceWithContext(_a0 context.Context, _a1 *iam.Resyn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.ResyncMFADeviceOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.ResyncMFADeviceInput, ...request.Option) *iam.ResyncMFADeviceOutput); ok {
+	var r0 *iam.TagUserOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagUserInput, ...request.Option) *iam.TagUserOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.ResyncMFADeviceOutput)
+			r0 = ret.Get(0).(*iam.TagUserOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.ResyncMFADeviceInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagUserInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9823,21 +11654,21 @@ func (_m *IAMAPI) ResyncMFADeviceWithContext(_a0 context.Context, _a1 *iam.Resyn
 	return r0, r1
 }
 
-// SetDefaultPolicyVersion provides a mock function with given fields: _a0
-func (_m *IAMAPI) SetDefaultPolicyVersion(_a0 *iam.SetDefaultPolicyVersionInput) (*iam.SetDefaultPolicyVersionOutput, error) {
+// UntagInstanceProfile provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagInstanceProfile(_a0 *iam.UntagInstanceProfileInput) (*iam.UntagInstanceProfileOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.SetDefaultPolicyVersionOutput
-	if rf, ok := ret.Get(0).(func(*iam.SetDefaultPolicyVersionInput) *iam.SetDefaultPolicyVersionOutput); ok {
+	var r0 *iam.UntagInstanceProfileOutput
+	if rf, ok := ret.Get(0).(func(*iam.UntagInstanceProfileInput) *iam.UntagInstanceProfileOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.SetDefaultPolicyVersionOutput)
+			r0 = ret.Get(0).(*iam.UntagInstanceProfileOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.SetDefaultPolicyVersionInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.UntagInstanceProfileInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9846,12 +11677,12 @@ func (_m *IAMAPI) SetDefaultPolicyVersion(_a0 *iam.SetDefaultPolicyVersionInput)
 	return r0, r1
 }
 
-// SetDefaultPolicyVersionRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) SetDefaultPolicyVersionRequest(_a0 *iam.SetDefaultPolicyVersionInput) (*request.Request, *iam.SetDefaultPolicyVersionOutput) {
+// UntagInstanceProfileRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagInstanceProfileRequest(_a0 *iam.UntagInstanceProfileInput) (*request.Request, *iam.UntagInstanceProfileOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.SetDefaultPolicyVersionInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.UntagInstanceProfileInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9859,20 +11690,20 @@ func (_m *IAMAPI) SetDefaultPolicyVersionRequest(_a0 *iam.SetDefaultPolicyVersio
 		}
 	}
 
-	var r1 *iam.SetDefaultPolicyVersionOutput
-	if rf, ok := ret.Get(1).(func(*iam.SetDefaultPolicyVersionInput) *iam.SetDefaultPolicyVersionOutput); ok {
+	var r1 *iam.UntagInstanceProfileOutput
+	if rf, ok := ret.Get(1).(func(*iam.UntagInstanceProfileInput) *iam.UntagInstanceProfileOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.SetDefaultPolicyVersionOutput)
+			r1 = ret.Get(1).(*iam.UntagInstanceProfileOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// SetDefaultPolicyVersionWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) SetDefaultPolicyVersionWithContext(_a0 context.Context, _a1 *iam.SetDefaultPolicyVersionInput, _a2 ...request.Option) (*iam.SetDefaultPolicyVersionOutput, error) {
+// UntagInstanceProfileWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) UntagInstanceProfileWithContext(_a0 context.Context, _a1 *iam.UntagInstanceProfileInput, _a2 ...request.Option) (*iam.UntagInstanceProfileOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9882,17 +11713,17 @@ func (_m *IAMAPI) SetDefaultPolicyVersionWithContext(_a0 context.Context, _a1 *i
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.SetDefaultPolicyVersionOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.SetDefaultPolicyVersionInput, ...request.Option) *iam.SetDefaultPolicyVersionOutput); ok {
+	var r0 *iam.UntagInstanceProfileOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.UntagInstanceProfileInput, ...request.Option) *iam.UntagInstanceProfileOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.SetDefaultPolicyVersionOutput)
+			r0 = ret.Get(0).(*iam.UntagInstanceProfileOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.SetDefaultPolicyVersionInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.UntagInstanceProfileInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9901,21 +11732,21 @@ func (_m *IAMAPI) SetDefaultPolicyVersionWithContext(_a0 context.Context, _a1 *i
 	return r0, r1
 }
 
-// SetSecurityTokenServicePreferences provides a mock function with given fields: _a0
-func (_m *IAMAPI) SetSecurityTokenServicePreferences(_a0 *iam.SetSecurityTokenServicePreferencesInput) (*iam.SetSecurityTokenServicePreferencesOutput, error) {
+// UntagMFADevice provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagMFADevice(_a0 *iam.UntagMFADeviceInput) (*iam.UntagMFADeviceOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.SetSecurityTokenServicePreferencesOutput
-	if rf, ok := ret.Get(0).(func(*iam.SetSecurityTokenServicePreferencesInput) *iam.SetSecurityTokenServicePreferencesOutput); ok {
+	var r0 *iam.UntagMFADeviceOutput
+	if rf, ok := ret.Get(0).(func(*iam.UntagMFADeviceInput) *iam.UntagMFADeviceOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.SetSecurityTokenServicePreferencesOutput)
+			r0 = ret.Get(0).(*iam.UntagMFADeviceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.SetSecurityTokenServicePreferencesInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.UntagMFADeviceInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -9924,12 +11755,12 @@ func (_m *IAMAPI) SetSecurityTokenServicePreferences(_a0 *iam.SetSecurityTokenSe
 	return r0, r1
 }
 
-// SetSecurityTokenServicePreferencesRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) SetSecurityTokenServicePreferencesRequest(_a0 *iam.SetSecurityTokenServicePreferencesInput) (*request.Request, *iam.SetSecurityTokenServicePreferencesOutput) {
+// UntagMFADeviceRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagMFADeviceRequest(_a0 *iam.UntagMFADeviceInput) (*request.Request, *iam.UntagMFADeviceOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.SetSecurityTokenServicePreferencesInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.UntagMFADeviceInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -9937,20 +11768,20 @@ func (_m *IAMAPI) SetSecurityTokenServicePreferencesRequest(_a0 *iam.SetSecurity
 		}
 	}
 
-	var r1 *iam.SetSecurityTokenServicePreferencesOutput
-	if rf, ok := ret.Get(1).(func(*iam.SetSecurityTokenServicePreferencesInput) *iam.SetSecurityTokenServicePreferencesOutput); ok {
+	var r1 *iam.UntagMFADeviceOutput
+	if rf, ok := ret.Get(1).(func(*iam.UntagMFADeviceInput) *iam.UntagMFADeviceOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.SetSecurityTokenServicePreferencesOutput)
+			r1 = ret.Get(1).(*iam.UntagMFADeviceOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// SetSecurityTokenServicePreferencesWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) SetSecurityTokenServicePreferencesWithContext(_a0 context.Context, _a1 *iam.SetSecurityTokenServicePreferencesInput, _a2 ...request.Option) (*iam.SetSecurityTokenServicePreferencesOutput, error) {
+// UntagMFADeviceWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) UntagMFADeviceWithContext(_a0 context.Context, _a1 *iam.UntagMFADeviceInput, _a2 ...request.Option) (*iam.UntagMFADeviceOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -9960,17 +11791,17 @@ func (_m *IAMAPI) SetSecurityTokenServicePreferencesWithContext(_a0 context.Cont
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.SetSecurityTokenServicePreferencesOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.SetSecurityTokenServicePreferencesInput, ...request.Option) *iam.SetSecurityTokenServicePreferencesOutput); ok {
+	var r0 *iam.UntagMFADeviceOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.UntagMFADeviceInput, ...request.Option) *iam.UntagMFADeviceOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.SetSecurityTokenServicePreferencesOutput)
+			r0 = ret.Get(0).(*iam.UntagMFADeviceOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.SetSecurityTokenServicePreferencesInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.UntagMFADeviceInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -9979,21 +11810,21 @@ func (_m *IAMAPI) SetSecurityTokenServicePreferencesWithContext(_a0 context.Cont
 	return r0, r1
 }
 
-// SimulateCustomPolicy provides a mock function with given fields: _a0
-func (_m *IAMAPI) SimulateCustomPolicy(_a0 *iam.SimulateCustomPolicyInput) (*iam.SimulatePolicyResponse, error) {
+// UntagOpenIDConnectProvider provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagOpenIDConnectProvider(_a0 *iam.UntagOpenIDConnectProviderInput) (*iam.UntagOpenIDConnectProviderOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.SimulatePolicyResponse
-	if rf, ok := ret.Get(0).(func(*iam.SimulateCustomPolicyInput) *iam.SimulatePolicyResponse); ok {
+	var r0 *iam.UntagOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(0).(func(*iam.UntagOpenIDConnectProviderInput) *iam.UntagOpenIDConnectProviderOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.SimulatePolicyResponse)
+			r0 = ret.Get(0).(*iam.UntagOpenIDConnectProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.SimulateCustomPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.UntagOpenIDConnectProviderInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10002,47 +11833,12 @@ func (_m *IAMAPI) SimulateCustomPolicy(_a0 *iam.SimulateCustomPolicyInput) (*iam
 	return r0, r1
 }
 
-// SimulateCustomPolicyPages provides a mock function with given fields: _a0, _a1
-func (_m *IAMAPI) SimulateCustomPolicyPages(_a0 *iam.SimulateCustomPolicyInput, _a1 func(*iam.SimulatePolicyResponse, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*iam.SimulateCustomPolicyInput, func(*iam.SimulatePolicyResponse, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// SimulateCustomPolicyPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *IAMAPI) SimulateCustomPolicyPagesWithContext(_a0 context.Context, _a1 *iam.SimulateCustomPolicyInput, _a2 func(*iam.SimulatePolicyResponse, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.SimulateCustomPolicyInput, func(*iam.SimulatePolicyResponse, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// SimulateCustomPolicyRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) SimulateCustomPolicyRequest(_a0 *iam.SimulateCustomPolicyInput) (*request.Request, *iam.SimulatePolicyResponse) {
+// UntagOpenIDConnectProviderRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagOpenIDConnectProviderRequest(_a0 *iam.UntagOpenIDConnectProviderInput) (*request.Request, *iam.UntagOpenIDConnectProviderOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.SimulateCustomPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.UntagOpenIDConnectProviderInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10050,20 +11846,20 @@ func (_m *IAMAPI) SimulateCustomPolicyRequest(_a0 *iam.SimulateCustomPolicyInput
 		}
 	}
 
-	var r1 *iam.SimulatePolicyResponse
-	if rf, ok := ret.Get(1).(func(*iam.SimulateCustomPolicyInput) *iam.SimulatePolicyResponse); ok {
+	var r1 *iam.UntagOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(1).(func(*iam.UntagOpenIDConnectProviderInput) *iam.UntagOpenIDConnectProviderOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.SimulatePolicyResponse)
+			r1 = ret.Get(1).(*iam.UntagOpenIDConnectProviderOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// SimulateCustomPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) SimulateCustomPolicyWithContext(_a0 context.Context, _a1 *iam.SimulateCustomPolicyInput, _a2 ...request.Option) (*iam.SimulatePolicyResponse, error) {
+// UntagOpenIDConnectProviderWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) UntagOpenIDConnectProviderWithContext(_a0 context.Context, _a1 *iam.UntagOpenIDConnectProviderInput, _a2 ...request.Option) (*iam.UntagOpenIDConnectProviderOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10073,17 +11869,17 @@ func (_m *IAMAPI) SimulateCustomPolicyWithContext(_a0 context.Context, _a1 *iam.
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.SimulatePolicyResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.SimulateCustomPolicyInput, ...request.Option) *iam.SimulatePolicyResponse); ok {
+	var r0 *iam.UntagOpenIDConnectProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.UntagOpenIDConnectProviderInput, ...request.Option) *iam.UntagOpenIDConnectProviderOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.SimulatePolicyResponse)
+			r0 = ret.Get(0).(*iam.UntagOpenIDConnectProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.SimulateCustomPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.UntagOpenIDConnectProviderInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10092,21 +11888,21 @@ func (_m *IAMAPI) SimulateCustomPolicyWithContext(_a0 context.Context, _a1 *iam.
 	return r0, r1
 }
 
-// SimulatePrincipalPolicy provides a mock function with given fields: _a0
-func (_m *IAMAPI) SimulatePrincipalPolicy(_a0 *iam.SimulatePrincipalPolicyInput) (*iam.SimulatePolicyResponse, error) {
+// UntagPolicy provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagPolicy(_a0 *iam.UntagPolicyInput) (*iam.UntagPolicyOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.SimulatePolicyResponse
-	if rf, ok := ret.Get(0).(func(*iam.SimulatePrincipalPolicyInput) *iam.SimulatePolicyResponse); ok {
+	var r0 *iam.UntagPolicyOutput
+	if rf, ok := ret.Get(0).(func(*iam.UntagPolicyInput) *iam.UntagPolicyOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.SimulatePolicyResponse)
+			r0 = ret.Get(0).(*iam.UntagPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.SimulatePrincipalPolicyInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.UntagPolicyInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10115,47 +11911,12 @@ func (_m *IAMAPI) SimulatePrincipalPolicy(_a0 *iam.SimulatePrincipalPolicyInput)
 	return r0, r1
 }
 
-// SimulatePrincipalPolicyPages provides a mock function with given fields: _a0, _a1
-func (_m *IAMAPI) SimulatePrincipalPolicyPages(_a0 *iam.SimulatePrincipalPolicyInput, _a1 func(*iam.SimulatePolicyResponse, bool) bool) error {
-	ret := _m.Called(_a0, _a1)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(*iam.SimulatePrincipalPolicyInput, func(*iam.SimulatePolicyResponse, bool) bool) error); ok {
-		r0 = rf(_a0, _a1)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// SimulatePrincipalPolicyPagesWithContext provides a mock function with given fields: _a0, _a1, _a2, _a3
-func (_m *IAMAPI) SimulatePrincipalPolicyPagesWithContext(_a0 context.Context, _a1 *iam.SimulatePrincipalPolicyInput, _a2 func(*iam.SimulatePolicyResponse, bool) bool, _a3 ...request.Option) error {
-	_va := make([]interface{}, len(_a3))
-	for _i := range _a3 {
-		_va[_i] = _a3[_i]
-	}
-	var _ca []interface{}
-	_ca = append(_ca, _a0, _a1, _a2)
-	_ca = append(_ca, _va...)
-	ret := _m.Called(_ca...)
-
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.SimulatePrincipalPolicyInput, func(*iam.SimulatePolicyResponse, bool) bool, ...request.Option) error); ok {
-		r0 = rf(_a0, _a1, _a2, _a3...)
-	} else {
-		r0 = ret.Error(0)
-	}
-
-	return r0
-}
-
-// SimulatePrincipalPolicyRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) SimulatePrincipalPolicyRequest(_a0 *iam.SimulatePrincipalPolicyInput) (*request.Request, *iam.SimulatePolicyResponse) {
+// UntagPolicyRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagPolicyRequest(_a0 *iam.UntagPolicyInput) (*request.Request, *iam.UntagPolicyOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.SimulatePrincipalPolicyInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.UntagPolicyInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10163,20 +11924,20 @@ func (_m *IAMAPI) SimulatePrincipalPolicyRequest(_a0 *iam.SimulatePrincipalPolic
 		}
 	}
 
-	var r1 *iam.SimulatePolicyResponse
-	if rf, ok := ret.Get(1).(func(*iam.SimulatePrincipalPolicyInput) *iam.SimulatePolicyResponse); ok {
+	var r1 *iam.UntagPolicyOutput
+	if rf, ok := ret.Get(1).(func(*iam.UntagPolicyInput) *iam.UntagPolicyOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.SimulatePolicyResponse)
+			r1 = ret.Get(1).(*iam.UntagPolicyOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// SimulatePrincipalPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) SimulatePrincipalPolicyWithContext(_a0 context.Context, _a1 *iam.SimulatePrincipalPolicyInput, _a2 ...request.Option) (*iam.SimulatePolicyResponse, error) {
+// UntagPolicyWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) UntagPolicyWithContext(_a0 context.Context, _a1 *iam.UntagPolicyInput, _a2 ...request.Option) (*iam.UntagPolicyOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10186,17 +11947,17 @@ func (_m *IAMAPI) SimulatePrincipalPolicyWithContext(_a0 context.Context, _a1 *i
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.SimulatePolicyResponse
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.SimulatePrincipalPolicyInput, ...request.Option) *iam.SimulatePolicyResponse); ok {
+	var r0 *iam.UntagPolicyOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.UntagPolicyInput, ...request.Option) *iam.UntagPolicyOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.SimulatePolicyResponse)
+			r0 = ret.Get(0).(*iam.UntagPolicyOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.SimulatePrincipalPolicyInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.UntagPolicyInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10205,21 +11966,21 @@ func (_m *IAMAPI) SimulatePrincipalPolicyWithContext(_a0 context.Context, _a1 *i
 	return r0, r1
 }
 
-// TagRole provides a mock function with given fields: _a0
-func (_m *IAMAPI) TagRole(_a0 *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
+// UntagRole provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagRole(_a0 *iam.UntagRoleInput) (*iam.UntagRoleOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.TagRoleOutput
-	if rf, ok := ret.Get(0).(func(*iam.TagRoleInput) *iam.TagRoleOutput); ok {
+	var r0 *iam.UntagRoleOutput
+	if rf, ok := ret.Get(0).(func(*iam.UntagRoleInput) *iam.UntagRoleOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.TagRoleOutput)
+			r0 = ret.Get(0).(*iam.UntagRoleOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.TagRoleInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.UntagRoleInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10228,12 +11989,12 @@ func (_m *IAMAPI) TagRole(_a0 *iam.TagRoleInput) (*iam.TagRoleOutput, error) {
 	return r0, r1
 }
 
-// TagRoleRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) TagRoleRequest(_a0 *iam.TagRoleInput) (*request.Request, *iam.TagRoleOutput) {
+// UntagRoleRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagRoleRequest(_a0 *iam.UntagRoleInput) (*request.Request, *iam.UntagRoleOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.TagRoleInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.UntagRoleInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10241,20 +12002,20 @@ func (_m *IAMAPI) TagRoleRequest(_a0 *iam.TagRoleInput) (*request.Request, *iam.
 		}
 	}
 
-	var r1 *iam.TagRoleOutput
-	if rf, ok := ret.Get(1).(func(*iam.TagRoleInput) *iam.TagRoleOutput); ok {
+	var r1 *iam.UntagRoleOutput
+	if rf, ok := ret.Get(1).(func(*iam.UntagRoleInput) *iam.UntagRoleOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.TagRoleOutput)
+			r1 = ret.Get(1).(*iam.UntagRoleOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// TagRoleWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) TagRoleWithContext(_a0 context.Context, _a1 *iam.TagRoleInput, _a2 ...request.Option) (*iam.TagRoleOutput, error) {
+// UntagRoleWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) UntagRoleWithContext(_a0 context.Context, _a1 *iam.UntagRoleInput, _a2 ...request.Option) (*iam.UntagRoleOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10264,17 +12025,17 @@ func (_m *IAMAPI) TagRoleWithContext(_a0 context.Context, _a1 *iam.TagRoleInput,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.TagRoleOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagRoleInput, ...request.Option) *iam.TagRoleOutput); ok {
+	var r0 *iam.UntagRoleOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.UntagRoleInput, ...request.Option) *iam.UntagRoleOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.TagRoleOutput)
+			r0 = ret.Get(0).(*iam.UntagRoleOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagRoleInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.UntagRoleInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10283,21 +12044,21 @@ func (_m *IAMAPI) TagRoleWithContext(_a0 context.Context, _a1 *iam.TagRoleInput,
 	return r0, r1
 }
 
-// TagUser provides a mock function with given fields: _a0
-func (_m *IAMAPI) TagUser(_a0 *iam.TagUserInput) (*iam.TagUserOutput, error) {
+// UntagSAMLProvider provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagSAMLProvider(_a0 *iam.UntagSAMLProviderInput) (*iam.UntagSAMLProviderOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.TagUserOutput
-	if rf, ok := ret.Get(0).(func(*iam.TagUserInput) *iam.TagUserOutput); ok {
+	var r0 *iam.UntagSAMLProviderOutput
+	if rf, ok := ret.Get(0).(func(*iam.UntagSAMLProviderInput) *iam.UntagSAMLProviderOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.TagUserOutput)
+			r0 = ret.Get(0).(*iam.UntagSAMLProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.TagUserInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.UntagSAMLProviderInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10306,12 +12067,12 @@ func (_m *IAMAPI) TagUser(_a0 *iam.TagUserInput) (*iam.TagUserOutput, error) {
 	return r0, r1
 }
 
-// TagUserRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) TagUserRequest(_a0 *iam.TagUserInput) (*request.Request, *iam.TagUserOutput) {
+// UntagSAMLProviderRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagSAMLProviderRequest(_a0 *iam.UntagSAMLProviderInput) (*request.Request, *iam.UntagSAMLProviderOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.TagUserInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.UntagSAMLProviderInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10319,20 +12080,20 @@ func (_m *IAMAPI) TagUserRequest(_a0 *iam.TagUserInput) (*request.Request, *iam.
 		}
 	}
 
-	var r1 *iam.TagUserOutput
-	if rf, ok := ret.Get(1).(func(*iam.TagUserInput) *iam.TagUserOutput); ok {
+	var r1 *iam.UntagSAMLProviderOutput
+	if rf, ok := ret.Get(1).(func(*iam.UntagSAMLProviderInput) *iam.UntagSAMLProviderOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.TagUserOutput)
+			r1 = ret.Get(1).(*iam.UntagSAMLProviderOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// TagUserWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) TagUserWithContext(_a0 context.Context, _a1 *iam.TagUserInput, _a2 ...request.Option) (*iam.TagUserOutput, error) {
+// UntagSAMLProviderWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) UntagSAMLProviderWithContext(_a0 context.Context, _a1 *iam.UntagSAMLProviderInput, _a2 ...request.Option) (*iam.UntagSAMLProviderOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10342,17 +12103,17 @@ func (_m *IAMAPI) TagUserWithContext(_a0 context.Context, _a1 *iam.TagUserInput,
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.TagUserOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.TagUserInput, ...request.Option) *iam.TagUserOutput); ok {
+	var r0 *iam.UntagSAMLProviderOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.UntagSAMLProviderInput, ...request.Option) *iam.UntagSAMLProviderOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.TagUserOutput)
+			r0 = ret.Get(0).(*iam.UntagSAMLProviderOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.TagUserInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.UntagSAMLProviderInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -10361,21 +12122,21 @@ func (_m *IAMAPI) TagUserWithContext(_a0 context.Context, _a1 *iam.TagUserInput,
 	return r0, r1
 }
 
-// UntagRole provides a mock function with given fields: _a0
-func (_m *IAMAPI) UntagRole(_a0 *iam.UntagRoleInput) (*iam.UntagRoleOutput, error) {
+// UntagServerCertificate provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagServerCertificate(_a0 *iam.UntagServerCertificateInput) (*iam.UntagServerCertificateOutput, error) {
 	ret := _m.Called(_a0)
 
-	var r0 *iam.UntagRoleOutput
-	if rf, ok := ret.Get(0).(func(*iam.UntagRoleInput) *iam.UntagRoleOutput); ok {
+	var r0 *iam.UntagServerCertificateOutput
+	if rf, ok := ret.Get(0).(func(*iam.UntagServerCertificateInput) *iam.UntagServerCertificateOutput); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.UntagRoleOutput)
+			r0 = ret.Get(0).(*iam.UntagServerCertificateOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(*iam.UntagRoleInput) error); ok {
+	if rf, ok := ret.Get(1).(func(*iam.UntagServerCertificateInput) error); ok {
 		r1 = rf(_a0)
 	} else {
 		r1 = ret.Error(1)
@@ -10384,12 +12145,12 @@ func (_m *IAMAPI) UntagRole(_a0 *iam.UntagRoleInput) (*iam.UntagRoleOutput, erro
 	return r0, r1
 }
 
-// UntagRoleRequest provides a mock function with given fields: _a0
-func (_m *IAMAPI) UntagRoleRequest(_a0 *iam.UntagRoleInput) (*request.Request, *iam.UntagRoleOutput) {
+// UntagServerCertificateRequest provides a mock function with given fields: _a0
+func (_m *IAMAPI) UntagServerCertificateRequest(_a0 *iam.UntagServerCertificateInput) (*request.Request, *iam.UntagServerCertificateOutput) {
 	ret := _m.Called(_a0)
 
 	var r0 *request.Request
-	if rf, ok := ret.Get(0).(func(*iam.UntagRoleInput) *request.Request); ok {
+	if rf, ok := ret.Get(0).(func(*iam.UntagServerCertificateInput) *request.Request); ok {
 		r0 = rf(_a0)
 	} else {
 		if ret.Get(0) != nil {
@@ -10397,20 +12158,20 @@ func (_m *IAMAPI) UntagRoleRequest(_a0 *iam.UntagRoleInput) (*request.Request, *
 		}
 	}
 
-	var r1 *iam.UntagRoleOutput
-	if rf, ok := ret.Get(1).(func(*iam.UntagRoleInput) *iam.UntagRoleOutput); ok {
+	var r1 *iam.UntagServerCertificateOutput
+	if rf, ok := ret.Get(1).(func(*iam.UntagServerCertificateInput) *iam.UntagServerCertificateOutput); ok {
 		r1 = rf(_a0)
 	} else {
 		if ret.Get(1) != nil {
-			r1 = ret.Get(1).(*iam.UntagRoleOutput)
+			r1 = ret.Get(1).(*iam.UntagServerCertificateOutput)
 		}
 	}
 
 	return r0, r1
 }
 
-// UntagRoleWithContext provides a mock function with given fields: _a0, _a1, _a2
-func (_m *IAMAPI) UntagRoleWithContext(_a0 context.Context, _a1 *iam.UntagRoleInput, _a2 ...request.Option) (*iam.UntagRoleOutput, error) {
+// UntagServerCertificateWithContext provides a mock function with given fields: _a0, _a1, _a2
+func (_m *IAMAPI) UntagServerCertificateWithContext(_a0 context.Context, _a1 *iam.UntagServerCertificateInput, _a2 ...request.Option) (*iam.UntagServerCertificateOutput, error) {
 	_va := make([]interface{}, len(_a2))
 	for _i := range _a2 {
 		_va[_i] = _a2[_i]
@@ -10420,17 +12181,17 @@ func (_m *IAMAPI) UntagRoleWithContext(_a0 context.Context, _a1 *iam.UntagRoleIn
 	_ca = append(_ca, _va...)
 	ret := _m.Called(_ca...)
 
-	var r0 *iam.UntagRoleOutput
-	if rf, ok := ret.Get(0).(func(context.Context, *iam.UntagRoleInput, ...request.Option) *iam.UntagRoleOutput); ok {
+	var r0 *iam.UntagServerCertificateOutput
+	if rf, ok := ret.Get(0).(func(context.Context, *iam.UntagServerCertificateInput, ...request.Option) *iam.UntagServerCertificateOutput); ok {
 		r0 = rf(_a0, _a1, _a2...)
 	} else {
 		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*iam.UntagRoleOutput)
+			r0 = ret.Get(0).(*iam.UntagServerCertificateOutput)
 		}
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, *iam.UntagRoleInput, ...request.Option) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, *iam.UntagServerCertificateInput, ...request.Option) error); ok {
 		r1 = rf(_a0, _a1, _a2...)
 	} else {
 		r1 = ret.Error(1)
@@ -1,13 +1,20 @@
 package mocks
 
 import (
+	_ "github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	_ "github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface" // used for testing
 	_ "github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	_ "github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	_ "github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	_ "github.com/aws/aws-sdk-go/service/eks/eksiface"
 	_ "github.com/aws/aws-sdk-go/service/elb/elbiface"
 	_ "github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	_ "github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
 	_ "github.com/aws/aws-sdk-go/service/iam/iamiface"
+	_ "github.com/aws/aws-sdk-go/service/pricing/pricingiface"
+	_ "github.com/aws/aws-sdk-go/service/s3/s3iface"
+	_ "github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
+	_ "github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	_ "github.com/aws/aws-sdk-go/service/sts/stsiface"
 	_ "github.com/vektra/mockery"
 )
@@ -21,3 +28,10 @@ import (
 //go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/sts/stsiface -name=STSAPI -output=./
 //go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/iam/iamiface -name=IAMAPI -output=./
 //go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface -name=CloudTrailAPI -output=./
+//go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface -name=AutoScalingAPI -output=./
+//go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/ssm/ssmiface -name=SSMAPI -output=./
+//go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface -name=ServiceQuotasAPI -output=./
+//go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/pricing/pricingiface -name=PricingAPI -output=./
+//go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/s3/s3iface -name=S3API -output=./
+//go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface -name=CloudWatchLogsAPI -output=./
+//go:generate "${GOBIN}/mockery" -tags netgo -dir=../../../vendor/github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface -name=EventBridgeAPI -output=./
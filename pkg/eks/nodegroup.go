@@ -4,10 +4,11 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
 	"github.com/weaveworks/eksctl/pkg/iam"
+	"github.com/weaveworks/eksctl/pkg/logger"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 
@@ -82,6 +83,9 @@ func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng *api.N
 			}
 		case <-timer:
 			timeout = true
+		case <-c.Context().Done():
+			watcher.Stop()
+			return errors.Wrapf(c.Context().Err(), "waiting for nodes to become ready in %q", ng.Name)
 		}
 	}
 	watcher.Stop()
@@ -96,6 +100,110 @@ func (c *ClusterProvider) WaitForNodes(clientSet kubernetes.Interface, ng *api.N
 	return nil
 }
 
+// NewTasksToWaitForNodes defines tasks to wait for the nodes of each of the given nodegroups
+// to join the cluster and become ready, in parallel; the nodes are expected to be authorised
+// to join beforehand, and when a task times out, the reasons the nodes of its nodegroup may
+// not have joined are logged
+func (c *ClusterProvider) NewTasksToWaitForNodes(cfg *api.ClusterConfig, clientSet kubernetes.Interface, nodeGroups []*api.NodeGroup) *manager.TaskTree {
+	tasks := &manager.TaskTree{Parallel: true}
+	for _, n := range nodeGroups {
+		ng := n
+		if ng.MinSize == nil || *ng.MinSize == 0 {
+			continue
+		}
+		tasks.Append(&clusterConfigTask{
+			info: fmt.Sprintf("wait for nodes of nodegroup %q to become ready", ng.Name),
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				if err := c.WaitForNodes(clientSet, ng); err != nil {
+					c.logNodeGroupDiagnostics(c.NewStackManager(cfg), clientSet, ng)
+					return err
+				}
+				return nil
+			},
+		})
+	}
+	return tasks
+}
+
+func (c *ClusterProvider) logNodeGroupDiagnostics(stackManager *manager.StackCollection, clientSet kubernetes.Interface, ng *api.NodeGroup) {
+	diagnostics, err := c.DiagnoseNodeGroup(stackManager, clientSet, ng)
+	if err != nil {
+		logger.Warning("cannot diagnose nodes of nodegroup %q: %s", ng.Name, err.Error())
+	}
+	for _, diagnostic := range diagnostics {
+		logger.Warning("nodegroup %q: %s", ng.Name, diagnostic)
+	}
+}
+
+// DiagnoseNodeGroup returns the reasons the nodes of the nodegroup may not have joined the cluster
+// or become ready: nodes that aren't ready along with the reason reported by their kubelet, a missing
+// auth ConfigMap entry for the instance role, and instances of the ASG that haven't registered as
+// nodes, which usually means they failed to bootstrap
+func (c *ClusterProvider) DiagnoseNodeGroup(stackManager *manager.StackCollection, clientSet kubernetes.Interface, ng *api.NodeGroup) ([]string, error) {
+	var diagnostics []string
+
+	nodes, err := clientSet.CoreV1().Nodes().List(ng.ListOptions())
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	registered := sets.NewString()
+	for i := range nodes.Items {
+		node := &nodes.Items[i]
+		registered.Insert(instanceIDFromProviderID(node.Spec.ProviderID))
+		if isNodeReady(node) {
+			continue
+		}
+		reason := "no Ready condition reported by kubelet"
+		for _, condition := range node.Status.Conditions {
+			if condition.Type == corev1.NodeReady {
+				reason = fmt.Sprintf("%s: %s", condition.Reason, condition.Message)
+			}
+		}
+		diagnostics = append(diagnostics, fmt.Sprintf("node %q is not ready (%s)", node.Name, reason))
+	}
+
+	if roleARN := ng.IAM.InstanceRoleARN; roleARN != "" {
+		mapped, err := isRoleMapped(clientSet, roleARN)
+		if err != nil {
+			return diagnostics, err
+		}
+		if !mapped {
+			diagnostics = append(diagnostics, fmt.Sprintf("instance role %q is missing from auth ConfigMap, nodes can't join the cluster without it", roleARN))
+		}
+	}
+
+	asgName, err := stackManager.GetNodeGroupAutoScalingGroupName(ng.Name)
+	if err != nil {
+		return diagnostics, err
+	}
+	group, err := c.describeAutoScalingGroup(asgName)
+	if err != nil {
+		return diagnostics, err
+	}
+	for _, id := range inServiceInstanceIDs(group, registered) {
+		diagnostics = append(diagnostics, fmt.Sprintf("instance %q hasn't registered as a node, it may have failed to bootstrap, check its console output with 'aws ec2 get-console-output --instance-id=%s'", id, id))
+	}
+	return diagnostics, nil
+}
+
+func isRoleMapped(clientSet kubernetes.Interface, roleARN string) (bool, error) {
+	acm, err := authconfigmap.NewFromClientSet(clientSet)
+	if err != nil {
+		return false, err
+	}
+	identities, err := acm.Identities()
+	if err != nil {
+		return false, errors.Wrap(err, "parsing auth ConfigMap")
+	}
+	for _, identity := range identities {
+		if identity.ARN() == roleARN {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // GetNodeGroupIAM retrieves the IAM configuration of the given nodegroup
 func (c *ClusterProvider) GetNodeGroupIAM(stackManager *manager.StackCollection, spec *api.ClusterConfig, ng *api.NodeGroup) error {
 	stacks, err := stackManager.DescribeNodeGroupStacks()
@@ -114,3 +222,25 @@ func (c *ClusterProvider) GetNodeGroupIAM(stackManager *manager.StackCollection,
 
 	return fmt.Errorf("stack not found for nodegroup %q", ng.Name)
 }
+
+// NodeGroupInstanceID returns the ID of the instance running the given node of the nodegroup,
+// or the first ready node when no node name is given
+func NodeGroupInstanceID(clientSet kubernetes.Interface, ng *api.NodeGroup, nodeName string) (string, error) {
+	nodes, err := clientSet.CoreV1().Nodes().List(ng.ListOptions())
+	if err != nil {
+		return "", errors.Wrap(err, "listing nodes")
+	}
+	for _, node := range nodes.Items {
+		if nodeName != "" && node.Name != nodeName {
+			continue
+		}
+		if nodeName == "" && !isNodeReady(&node) {
+			continue
+		}
+		return instanceIDFromProviderID(node.Spec.ProviderID), nil
+	}
+	if nodeName != "" {
+		return "", fmt.Errorf("node %q not found in nodegroup %q", nodeName, ng.Name)
+	}
+	return "", fmt.Errorf("nodegroup %q has no ready nodes", ng.Name)
+}
@@ -0,0 +1,245 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/health"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// nodeLogsPollInterval is how often the output of the command that reads the logs of a node is polled
+const nodeLogsPollInterval = 5 * time.Second
+
+// pendingCommandInvocationStatuses are the statuses of SSM commands that haven't finished on an instance
+var pendingCommandInvocationStatuses = sets.NewString(
+	ssm.CommandInvocationStatusPending,
+	ssm.CommandInvocationStatusInProgress,
+	ssm.CommandInvocationStatusDelayed,
+)
+
+// DiagnoseNodeGroupJoin checks the usual reasons the nodes of a nodegroup fail to join the cluster: the
+// authorization of the instance role, instances that haven't registered as nodes, and the route, security
+// groups, instance profile and IMDS of an instance; the last logLines lines of its cloud-init and kubelet
+// logs are read through SSM. The instance defaults to one that hasn't registered as a node, and the instance
+// role of the nodegroup is expected to be set, e.g. by GetNodeGroupIAM
+func (c *ClusterProvider) DiagnoseNodeGroupJoin(cfg *api.ClusterConfig, stackManager *manager.StackCollection, clientSet kubernetes.Interface, ng *api.NodeGroup, instanceID string, logLines int) (*health.Report, error) {
+	report := &health.Report{}
+
+	cluster, err := c.DescribeControlPlane(cfg.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	authenticationMode := api.AuthenticationModeConfigMap
+	if cluster.AccessConfig != nil && cluster.AccessConfig.AuthenticationMode != nil {
+		authenticationMode = *cluster.AccessConfig.AuthenticationMode
+	}
+	hasAccessEntry := false
+	if authenticationMode != api.AuthenticationModeConfigMap {
+		if hasAccessEntry, err = c.hasAccessEntry(cfg, ng.IAM.InstanceRoleARN); err != nil {
+			return nil, err
+		}
+	}
+	report.CheckNodeRole(clientSet, authenticationMode, ng.IAM.InstanceRoleARN, hasAccessEntry)
+
+	nodes, err := clientSet.CoreV1().Nodes().List(ng.ListOptions())
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	registered := sets.NewString()
+	for _, node := range nodes.Items {
+		registered.Insert(instanceIDFromProviderID(node.Spec.ProviderID))
+	}
+	asgName, err := stackManager.GetNodeGroupAutoScalingGroupName(ng.Name)
+	if err != nil {
+		return nil, err
+	}
+	group, err := c.describeAutoScalingGroup(asgName)
+	if err != nil {
+		return nil, err
+	}
+	report.CheckInstances(group, registered)
+
+	if instanceID == "" {
+		if instanceID = sampleInstanceID(group, registered); instanceID == "" {
+			logger.Warning("skipping checks of instances, as nodegroup %q has none in service", ng.Name)
+			return report, nil
+		}
+	}
+	instance, err := c.describeNodeGroupInstance(cluster, instanceID)
+	if err != nil {
+		return nil, err
+	}
+	report.CheckEndpointRoute(cluster, instance)
+	report.CheckSecurityGroups(cluster, instance)
+	report.CheckInstanceMetadata(instance)
+
+	logs, err := c.nodeLogs(ng, instanceID, logLines)
+	report.AddNodeLogs(instanceID, logs, err)
+	return report, nil
+}
+
+// sampleInstanceID returns an in-service instance of the ASG that hasn't registered as a node, or any
+// in-service instance when all of them did
+func sampleInstanceID(group *autoscaling.Group, registered sets.String) string {
+	if ids := inServiceInstanceIDs(group, registered); len(ids) > 0 {
+		return ids[0]
+	}
+	if ids := inServiceInstanceIDs(group, sets.NewString()); len(ids) > 0 {
+		return ids[0]
+	}
+	return ""
+}
+
+func (c *ClusterProvider) hasAccessEntry(cfg *api.ClusterConfig, principalARN string) (bool, error) {
+	_, err := c.Provider.EKS().DescribeAccessEntry(&awseks.DescribeAccessEntryInput{
+		ClusterName:  &cfg.Metadata.Name,
+		PrincipalArn: &principalARN,
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awseks.ErrCodeResourceNotFoundException {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "describing access entry %q", principalARN)
+	}
+	return true, nil
+}
+
+// describeNodeGroupInstance describes the instance along with the route table of its subnet, its security
+// groups and those of the control plane
+func (c *ClusterProvider) describeNodeGroupInstance(cluster *awseks.Cluster, instanceID string) (*health.NodeGroupInstance, error) {
+	output, err := c.Provider.EC2().DescribeInstances(&ec2.DescribeInstancesInput{
+		InstanceIds: aws.StringSlice([]string{instanceID}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing instance %q", instanceID)
+	}
+	if len(output.Reservations) != 1 || len(output.Reservations[0].Instances) != 1 {
+		return nil, fmt.Errorf("instance %q not found", instanceID)
+	}
+	instance := &health.NodeGroupInstance{Instance: output.Reservations[0].Instances[0]}
+
+	if instance.RouteTable, err = c.subnetRouteTable(instance.Instance); err != nil {
+		return nil, err
+	}
+
+	var groupIDs []*string
+	for _, group := range instance.Instance.SecurityGroups {
+		groupIDs = append(groupIDs, group.GroupId)
+	}
+	if instance.SecurityGroups, err = c.describeSecurityGroups(groupIDs); err != nil {
+		return nil, err
+	}
+	vpcConfig := cluster.ResourcesVpcConfig
+	clusterGroupIDs := append([]*string{}, vpcConfig.SecurityGroupIds...)
+	if vpcConfig.ClusterSecurityGroupId != nil {
+		clusterGroupIDs = append(clusterGroupIDs, vpcConfig.ClusterSecurityGroupId)
+	}
+	if instance.ClusterSecurityGroups, err = c.describeSecurityGroups(clusterGroupIDs); err != nil {
+		return nil, err
+	}
+	return instance, nil
+}
+
+// subnetRouteTable returns the route table associated with the subnet of the instance, subnets without
+// one use the main route table of the VPC
+func (c *ClusterProvider) subnetRouteTable(instance *ec2.Instance) (*ec2.RouteTable, error) {
+	filters := [][]*ec2.Filter{
+		{{Name: aws.String("association.subnet-id"), Values: []*string{instance.SubnetId}}},
+		{{Name: aws.String("vpc-id"), Values: []*string{instance.VpcId}}, {Name: aws.String("association.main"), Values: aws.StringSlice([]string{"true"})}},
+	}
+	for _, filter := range filters {
+		output, err := c.Provider.EC2().DescribeRouteTables(&ec2.DescribeRouteTablesInput{Filters: filter})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing route table of subnet %q", aws.StringValue(instance.SubnetId))
+		}
+		if len(output.RouteTables) > 0 {
+			return output.RouteTables[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *ClusterProvider) describeSecurityGroups(groupIDs []*string) ([]*ec2.SecurityGroup, error) {
+	if len(groupIDs) == 0 {
+		return nil, nil
+	}
+	output, err := c.Provider.EC2().DescribeSecurityGroups(&ec2.DescribeSecurityGroupsInput{GroupIds: groupIDs})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing security groups %v", aws.StringValueSlice(groupIDs))
+	}
+	return output.SecurityGroups, nil
+}
+
+// nodeLogs reads the last lines of the cloud-init and kubelet logs of a Linux instance by running a
+// command through SSM, which needs the SSM agent to be running on the instance
+func (c *ClusterProvider) nodeLogs(ng *api.NodeGroup, instanceID string, lines int) (string, error) {
+	if api.IsWindowsImage(ng.AMIFamily) {
+		return "", errors.New("reading the logs of Windows nodes isn't supported")
+	}
+	info, err := c.Provider.SSM().DescribeInstanceInformation(&ssm.DescribeInstanceInformationInput{
+		Filters: []*ssm.InstanceInformationStringFilter{{
+			Key:    aws.String("InstanceIds"),
+			Values: aws.StringSlice([]string{instanceID}),
+		}},
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "describing SSM managed instances")
+	}
+	if len(info.InstanceInformationList) == 0 || aws.StringValue(info.InstanceInformationList[0].PingStatus) != ssm.PingStatusOnline {
+		return "", errors.New("the SSM agent of the instance isn't online, create the nodegroup with ssh.enableSSM to read the logs")
+	}
+
+	command := fmt.Sprintf("tail -n %d /var/log/cloud-init-output.log; journalctl -u kubelet --no-pager -n %d", lines, lines)
+	output, err := c.Provider.SSM().SendCommand(&ssm.SendCommandInput{
+		DocumentName: aws.String("AWS-RunShellScript"),
+		InstanceIds:  aws.StringSlice([]string{instanceID}),
+		Parameters:   map[string][]*string{"commands": aws.StringSlice([]string{command})},
+		Comment:      aws.String("eksctl utils diagnose-nodegroup"),
+	})
+	if err != nil {
+		return "", errors.Wrap(err, "sending SSM command")
+	}
+
+	commandID := output.Command.CommandId
+	timer := time.After(c.Provider.WaitTimeout())
+	for {
+		invocation, err := c.Provider.SSM().GetCommandInvocation(&ssm.GetCommandInvocationInput{
+			CommandId:  commandID,
+			InstanceId: aws.String(instanceID),
+		})
+		switch {
+		case err != nil:
+			// the invocation only exists once the command reached the instance
+			if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != ssm.ErrCodeInvocationDoesNotExist {
+				return "", errors.Wrapf(err, "getting the output of SSM command %q", aws.StringValue(commandID))
+			}
+		case aws.StringValue(invocation.Status) == ssm.CommandInvocationStatusSuccess:
+			return strings.TrimRight(aws.StringValue(invocation.StandardOutputContent), "\n"), nil
+		case !pendingCommandInvocationStatuses.Has(aws.StringValue(invocation.Status)):
+			return "", fmt.Errorf("SSM command %q is %s: %s", aws.StringValue(commandID), aws.StringValue(invocation.Status), aws.StringValue(invocation.StandardErrorContent))
+		}
+
+		select {
+		case <-timer:
+			return "", fmt.Errorf("timed out (after %s) waiting for SSM command %q", c.Provider.WaitTimeout(), aws.StringValue(commandID))
+		case <-c.Context().Done():
+			return "", errors.Wrapf(c.Context().Err(), "waiting for SSM command %q", aws.StringValue(commandID))
+		case <-time.After(nodeLogsPollInterval):
+		}
+	}
+}
@@ -0,0 +1,178 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/ssm"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/health"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("DiagnoseNodeGroupJoin", func() {
+	var (
+		p            *mockprovider.MockProvider
+		c            *ClusterProvider
+		cfg          *api.ClusterConfig
+		stackManager *manager.StackCollection
+		ng           *api.NodeGroup
+		clientSet    *fake.Clientset
+		cluster      *awseks.Cluster
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		c = &ClusterProvider{Provider: p}
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		stackManager = manager.NewStackCollection(p, cfg)
+
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/ng-1-node"
+
+		clientSet = fake.NewSimpleClientset(newReadyNode(ng, "i-1"))
+		Expect(authconfigmap.AddNodeGroup(clientSet, ng)).To(Succeed())
+
+		cluster = &awseks.Cluster{
+			Name: aws.String("test-cluster"),
+			ResourcesVpcConfig: &awseks.VpcConfigResponse{
+				EndpointPublicAccess:   aws.Bool(true),
+				EndpointPrivateAccess:  aws.Bool(false),
+				PublicAccessCidrs:      aws.StringSlice([]string{"0.0.0.0/0"}),
+				ClusterSecurityGroupId: aws.String("sg-cluster"),
+			},
+		}
+		p.MockEKS().On("DescribeCluster", mock.Anything).Return(func(*awseks.DescribeClusterInput) *awseks.DescribeClusterOutput {
+			return &awseks.DescribeClusterOutput{Cluster: cluster}
+		}, nil)
+
+		p.MockCloudFormation().On("DescribeStackResource", mock.Anything).Return(&cfn.DescribeStackResourceOutput{StackResourceDetail: &cfn.StackResourceDetail{
+			PhysicalResourceId: aws.String("asg-ng-1"),
+		}}, nil)
+		group := &autoscaling.Group{AutoScalingGroupName: aws.String("asg-ng-1")}
+		for _, id := range []string{"i-1", "i-2"} {
+			group.Instances = append(group.Instances, &autoscaling.Instance{
+				InstanceId:     aws.String(id),
+				LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+			})
+		}
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{group}}, nil)
+
+		p.MockEC2().On("DescribeInstances", &ec2.DescribeInstancesInput{InstanceIds: aws.StringSlice([]string{"i-2"})}).Return(&ec2.DescribeInstancesOutput{
+			Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{
+				InstanceId:         aws.String("i-2"),
+				SubnetId:           aws.String("subnet-private"),
+				VpcId:              aws.String("vpc-1"),
+				PrivateIpAddress:   aws.String("192.168.100.10"),
+				SecurityGroups:     []*ec2.GroupIdentifier{{GroupId: aws.String("sg-node")}},
+				IamInstanceProfile: &ec2.IamInstanceProfile{Arn: aws.String("arn:aws:iam::123456789012:instance-profile/ng-1")},
+				MetadataOptions:    &ec2.InstanceMetadataOptionsResponse{HttpEndpoint: aws.String(ec2.InstanceMetadataEndpointStateEnabled)},
+			}}}},
+		}, nil)
+		p.MockEC2().On("DescribeRouteTables", mock.Anything).Return(func(input *ec2.DescribeRouteTablesInput) *ec2.DescribeRouteTablesOutput {
+			// the subnet has no route table of its own
+			if aws.StringValue(input.Filters[0].Name) == "association.subnet-id" {
+				return &ec2.DescribeRouteTablesOutput{}
+			}
+			return &ec2.DescribeRouteTablesOutput{RouteTables: []*ec2.RouteTable{{
+				Routes: []*ec2.Route{{DestinationCidrBlock: aws.String("0.0.0.0/0"), NatGatewayId: aws.String("nat-1")}},
+			}}}
+		}, nil)
+		p.MockEC2().On("DescribeSecurityGroups", mock.Anything).Return(func(input *ec2.DescribeSecurityGroupsInput) *ec2.DescribeSecurityGroupsOutput {
+			if aws.StringValue(input.GroupIds[0]) == "sg-node" {
+				return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: []*ec2.SecurityGroup{{
+					GroupId: aws.String("sg-node"),
+					IpPermissions: []*ec2.IpPermission{{
+						IpProtocol:       aws.String("tcp"),
+						FromPort:         aws.Int64(1025),
+						ToPort:           aws.Int64(65535),
+						UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-cluster")}},
+					}},
+					IpPermissionsEgress: []*ec2.IpPermission{{
+						IpProtocol: aws.String("-1"),
+						IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+					}},
+				}}}
+			}
+			return &ec2.DescribeSecurityGroupsOutput{SecurityGroups: []*ec2.SecurityGroup{{GroupId: aws.String("sg-cluster")}}}
+		}, nil)
+
+		p.MockSSM().On("DescribeInstanceInformation", mock.Anything).Return(&ssm.DescribeInstanceInformationOutput{
+			InstanceInformationList: []*ssm.InstanceInformation{{InstanceId: aws.String("i-2"), PingStatus: aws.String(ssm.PingStatusOnline)}},
+		}, nil)
+		p.MockSSM().On("SendCommand", mock.Anything).Return(&ssm.SendCommandOutput{Command: &ssm.Command{CommandId: aws.String("command-1")}}, nil)
+		p.MockSSM().On("GetCommandInvocation", mock.Anything).Return(&ssm.GetCommandInvocationOutput{
+			Status:                aws.String(ssm.CommandInvocationStatusSuccess),
+			StandardOutputContent: aws.String("kubelet: Unauthorized\n"),
+		}, nil)
+	})
+
+	statuses := func(report *health.Report) map[string]health.Status {
+		statuses := map[string]health.Status{}
+		for _, check := range report.Checks {
+			statuses[check.Name] = check.Status
+		}
+		return statuses
+	}
+
+	It("checks an instance that hasn't registered as a node and reads its logs", func() {
+		report, err := c.DiagnoseNodeGroupJoin(cfg, stackManager, clientSet, ng, "", 20)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statuses(report)).To(Equal(map[string]health.Status{
+			"node role":                 health.StatusPass,
+			"instances":                 health.StatusWarn,
+			"route to API server":       health.StatusPass,
+			"security groups":           health.StatusPass,
+			"instance profile and IMDS": health.StatusPass,
+			"node logs":                 health.StatusPass,
+		}))
+		Expect(report.Checks[len(report.Checks)-1].Details).To(Equal("kubelet: Unauthorized"))
+
+		p.MockSSM().AssertCalled(GinkgoT(), "SendCommand", mock.MatchedBy(func(input *ssm.SendCommandInput) bool {
+			return aws.StringValue(input.InstanceIds[0]) == "i-2" &&
+				aws.StringValue(input.Parameters["commands"][0]) == "tail -n 20 /var/log/cloud-init-output.log; journalctl -u kubelet --no-pager -n 20"
+		}))
+	})
+
+	It("fails when the control plane doesn't accept connections from the nodes with private access", func() {
+		cluster.ResourcesVpcConfig.EndpointPrivateAccess = aws.Bool(true)
+
+		report, err := c.DiagnoseNodeGroupJoin(cfg, stackManager, clientSet, ng, "", 20)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statuses(report)["security groups"]).To(Equal(health.StatusFail))
+	})
+
+	It("checks the access entry of the instance role in API authentication mode", func() {
+		cluster.AccessConfig = &awseks.AccessConfigResponse{AuthenticationMode: aws.String(api.AuthenticationModeAPI)}
+		p.MockEKS().On("DescribeAccessEntry", mock.Anything).Return(nil, awserr.New(awseks.ErrCodeResourceNotFoundException, "not found", nil))
+
+		report, err := c.DiagnoseNodeGroupJoin(cfg, stackManager, clientSet, ng, "", 20)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(statuses(report)["node role"]).To(Equal(health.StatusFail))
+	})
+
+	It("warns when the logs can't be read", func() {
+		p.MockSSM().ExpectedCalls = nil
+		p.MockSSM().On("DescribeInstanceInformation", mock.Anything).Return(&ssm.DescribeInstanceInformationOutput{}, nil)
+
+		report, err := c.DiagnoseNodeGroupJoin(cfg, stackManager, clientSet, ng, "", 20)
+		Expect(err).ToNot(HaveOccurred())
+		logs := report.Checks[len(report.Checks)-1]
+		Expect(logs.Status).To(Equal(health.StatusWarn))
+		Expect(logs.Message).To(ContainSubstring("the SSM agent of the instance isn't online"))
+		p.MockSSM().AssertNotCalled(GinkgoT(), "SendCommand", mock.Anything)
+	})
+})
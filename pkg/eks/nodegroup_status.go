@@ -0,0 +1,72 @@
+package eks
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// SetNodeGroupLiveStatus sets the sizes of the summaries to those of the ASGs of the nodegroups,
+// along with their instances in service and, when clientSet isn't nil, their nodes that are
+// ready and the kubelet versions of the nodes; nodegroups whose ASG can't be found are skipped
+// with a warning
+func (c *ClusterProvider) SetNodeGroupLiveStatus(stackManager *manager.StackCollection, summaries []*manager.NodeGroupSummary, clientSet kubernetes.Interface) error {
+	nodesByInstanceID := map[string]*corev1.Node{}
+	if clientSet != nil {
+		nodes, err := clientSet.CoreV1().Nodes().List(metav1.ListOptions{})
+		if err != nil {
+			return errors.Wrap(err, "listing nodes")
+		}
+		for i := range nodes.Items {
+			node := &nodes.Items[i]
+			nodesByInstanceID[instanceIDFromProviderID(node.Spec.ProviderID)] = node
+		}
+	}
+
+	for _, summary := range summaries {
+		if summary.AutoScalingGroupName == "" {
+			asgName, err := stackManager.GetNodeGroupAutoScalingGroupName(summary.Name)
+			if err != nil {
+				logger.Warning("cannot get status of nodegroup %q: %s", summary.Name, err.Error())
+				continue
+			}
+			summary.AutoScalingGroupName = asgName
+		}
+		group, err := c.describeAutoScalingGroup(summary.AutoScalingGroupName)
+		if err != nil {
+			logger.Warning("cannot get status of nodegroup %q: %s", summary.Name, err.Error())
+			continue
+		}
+		summary.MinSize = int(aws.Int64Value(group.MinSize))
+		summary.MaxSize = int(aws.Int64Value(group.MaxSize))
+		summary.DesiredCapacity = int(aws.Int64Value(group.DesiredCapacity))
+
+		instanceIDs := inServiceInstanceIDs(group, sets.NewString())
+		summary.InServiceInstances = len(instanceIDs)
+		if clientSet == nil {
+			continue
+		}
+
+		kubeletVersions := sets.NewString()
+		summary.Nodes, summary.ReadyNodes = 0, 0
+		for _, id := range instanceIDs {
+			node, ok := nodesByInstanceID[id]
+			if !ok {
+				continue
+			}
+			summary.Nodes++
+			if isNodeReady(node) {
+				summary.ReadyNodes++
+			}
+			kubeletVersions.Insert(node.Status.NodeInfo.KubeletVersion)
+		}
+		summary.KubeletVersions = kubeletVersions.List()
+	}
+	return nil
+}
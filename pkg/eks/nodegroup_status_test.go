@@ -0,0 +1,105 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("live status of nodegroups", func() {
+	var (
+		p            *mockprovider.MockProvider
+		c            *ClusterProvider
+		stackManager *manager.StackCollection
+		summaries    []*manager.NodeGroupSummary
+	)
+
+	mockASG := func(name string, instanceIDs ...string) {
+		group := &autoscaling.Group{
+			AutoScalingGroupName: aws.String(name),
+			MinSize:              aws.Int64(1),
+			MaxSize:              aws.Int64(5),
+			DesiredCapacity:      aws.Int64(3),
+		}
+		for _, id := range instanceIDs {
+			group.Instances = append(group.Instances, &autoscaling.Instance{
+				InstanceId:     aws.String(id),
+				LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+			})
+		}
+		group.Instances = append(group.Instances, &autoscaling.Instance{
+			InstanceId:     aws.String("i-pending"),
+			LifecycleState: aws.String(autoscaling.LifecycleStatePending),
+		})
+		p.MockASG().On("DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{name}),
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{group}}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		c = &ClusterProvider{Provider: p}
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		stackManager = manager.NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStackResource", &cfn.DescribeStackResourceInput{
+			StackName:         aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			LogicalResourceId: aws.String("NodeGroup"),
+		}).Return(&cfn.DescribeStackResourceOutput{StackResourceDetail: &cfn.StackResourceDetail{
+			PhysicalResourceId: aws.String("asg-ng-1"),
+		}}, nil)
+		mockASG("asg-ng-1", "i-1", "i-2")
+		mockASG("asg-mng-1", "i-3")
+
+		summaries = []*manager.NodeGroupSummary{
+			{Name: "ng-1", MinSize: 2, MaxSize: 2, DesiredCapacity: 2},
+			{Name: "mng-1", AutoScalingGroupName: "asg-mng-1"},
+		}
+	})
+
+	It("sets the sizes and instances of the ASGs, and the nodes that are ready", func() {
+		ng := api.NewNodeGroup()
+		ng.Name = "ng-1"
+		notReady := newReadyNode(ng, "i-2")
+		notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+		nodes := []*corev1.Node{newReadyNode(ng, "i-1"), notReady, newReadyNode(ng, "i-3")}
+		nodes[0].Status.NodeInfo.KubeletVersion = "v1.14.9-eks-1f0ca9"
+		nodes[1].Status.NodeInfo.KubeletVersion = "v1.13.12-eks-eb1860"
+		nodes[2].Status.NodeInfo.KubeletVersion = "v1.14.9-eks-1f0ca9"
+		clientSet := fake.NewSimpleClientset(nodes[0], nodes[1], nodes[2])
+
+		Expect(c.SetNodeGroupLiveStatus(stackManager, summaries, clientSet)).To(Succeed())
+
+		Expect(*summaries[0]).To(Equal(manager.NodeGroupSummary{
+			Name:                 "ng-1",
+			AutoScalingGroupName: "asg-ng-1",
+			MinSize:              1,
+			MaxSize:              5,
+			DesiredCapacity:      3,
+			InServiceInstances:   2,
+			Nodes:                2,
+			ReadyNodes:           1,
+			KubeletVersions:      []string{"v1.13.12-eks-eb1860", "v1.14.9-eks-1f0ca9"},
+		}))
+		Expect(summaries[1].InServiceInstances).To(Equal(1))
+		Expect(summaries[1].Nodes).To(Equal(1))
+		Expect(summaries[1].ReadyNodes).To(Equal(1))
+	})
+
+	It("sets the status of the ASGs without a client for the cluster", func() {
+		Expect(c.SetNodeGroupLiveStatus(stackManager, summaries, nil)).To(Succeed())
+		Expect(summaries[0].InServiceInstances).To(Equal(2))
+		Expect(summaries[0].Nodes).To(BeZero())
+		Expect(summaries[0].KubeletVersions).To(BeEmpty())
+	})
+})
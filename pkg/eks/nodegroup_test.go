@@ -0,0 +1,110 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("NodeGroupInstanceID", func() {
+	var (
+		ng        *api.NodeGroup
+		clientSet *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		notReady := newReadyNode(ng, "i-1")
+		notReady.Status.Conditions = []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionFalse}}
+
+		other := api.NewNodeGroup()
+		other.Name = "ng-2"
+
+		clientSet = fake.NewSimpleClientset(notReady, newReadyNode(ng, "i-2"), newReadyNode(other, "i-3"))
+	})
+
+	It("should return the instance of the given node", func() {
+		Expect(NodeGroupInstanceID(clientSet, ng, "i-1.ec2.internal")).To(Equal("i-1"))
+	})
+
+	It("should return the first ready node when no node is given", func() {
+		Expect(NodeGroupInstanceID(clientSet, ng, "")).To(Equal("i-2"))
+	})
+
+	It("should only consider nodes of the nodegroup", func() {
+		_, err := NodeGroupInstanceID(clientSet, ng, "i-3.ec2.internal")
+		Expect(err).To(MatchError(`node "i-3.ec2.internal" not found in nodegroup "ng-1"`))
+	})
+})
+
+var _ = Describe("DiagnoseNodeGroup", func() {
+	var (
+		p            *mockprovider.MockProvider
+		c            *ClusterProvider
+		stackManager *manager.StackCollection
+		ng           *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		c = &ClusterProvider{Provider: p}
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		stackManager = manager.NewStackCollection(p, cfg)
+
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/ng-1-node"
+
+		p.MockCloudFormation().On("DescribeStackResource", &cfn.DescribeStackResourceInput{
+			StackName:         aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+			LogicalResourceId: aws.String("NodeGroup"),
+		}).Return(&cfn.DescribeStackResourceOutput{StackResourceDetail: &cfn.StackResourceDetail{
+			PhysicalResourceId: aws.String("asg-ng-1"),
+		}}, nil)
+		group := &autoscaling.Group{AutoScalingGroupName: aws.String("asg-ng-1")}
+		for _, id := range []string{"i-1", "i-2", "i-3"} {
+			group.Instances = append(group.Instances, &autoscaling.Instance{
+				InstanceId:     aws.String(id),
+				LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+			})
+		}
+		p.MockASG().On("DescribeAutoScalingGroups", &autoscaling.DescribeAutoScalingGroupsInput{
+			AutoScalingGroupNames: aws.StringSlice([]string{"asg-ng-1"}),
+		}).Return(&autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{group}}, nil)
+	})
+
+	It("reports nodes that aren't ready, a missing auth ConfigMap entry and instances that haven't registered", func() {
+		notReady := newReadyNode(ng, "i-2")
+		notReady.Status.Conditions[0].Status = corev1.ConditionFalse
+		notReady.Status.Conditions[0].Reason = "KubeletNotReady"
+		notReady.Status.Conditions[0].Message = "cni plugin not initialized"
+		clientSet := fake.NewSimpleClientset(newReadyNode(ng, "i-1"), notReady)
+
+		Expect(c.DiagnoseNodeGroup(stackManager, clientSet, ng)).To(Equal([]string{
+			`node "i-2.ec2.internal" is not ready (KubeletNotReady: cni plugin not initialized)`,
+			`instance role "arn:aws:iam::123456789012:role/ng-1-node" is missing from auth ConfigMap, nodes can't join the cluster without it`,
+			`instance "i-3" hasn't registered as a node, it may have failed to bootstrap, check its console output with 'aws ec2 get-console-output --instance-id=i-3'`,
+		}))
+	})
+
+	It("doesn't report the instance role when it's in auth ConfigMap", func() {
+		clientSet := fake.NewSimpleClientset(newReadyNode(ng, "i-1"), newReadyNode(ng, "i-2"), newReadyNode(ng, "i-3"))
+		Expect(authconfigmap.AddNodeGroup(clientSet, ng)).To(Succeed())
+
+		Expect(c.DiagnoseNodeGroup(stackManager, clientSet, ng)).To(BeEmpty())
+	})
+})
@@ -0,0 +1,238 @@
+package eks
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils"
+)
+
+// maxSuggestedInstanceTypes limits how many alternative instance types are suggested
+const maxSuggestedInstanceTypes = 3
+
+// instanceTypeOfferings holds the instance types that are offered in each availability zone
+type instanceTypeOfferings map[string]map[string]bool
+
+// CheckInstanceTypeOfferings checks that the instance types of nodegroups are offered in the
+// availability zones of their subnets, so that it's reported before any stacks are created
+// rather than when the autoscaling group fails to launch instances; zones and instance types
+// that could be used instead are suggested
+func (c *ClusterProvider) CheckInstanceTypeOfferings(spec *api.ClusterConfig, nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup) error {
+	type placement struct {
+		description   string
+		instanceTypes []string
+		zones         []string
+		candidates    []string
+	}
+
+	placements := []placement{}
+	for _, ng := range nodeGroups {
+		if ng.Edge != nil {
+			// instance types of edge zones are validated along with the zone type
+			continue
+		}
+		instanceTypes := []string{ng.InstanceType}
+		if api.HasMixedInstances(ng) {
+			instanceTypes = ng.InstancesDistribution.InstanceTypes
+		}
+		placements = append(placements, placement{
+			description:   fmt.Sprintf("nodegroup %q", ng.Name),
+			instanceTypes: instanceTypes,
+			zones:         nodeGroupZones(spec, ng.AvailabilityZones, ng.PrivateNetworking),
+			candidates:    nodeGroupZones(spec, nil, ng.PrivateNetworking),
+		})
+	}
+	for _, ng := range managedNodeGroups {
+		placements = append(placements, placement{
+			description:   fmt.Sprintf("managed nodegroup %q", ng.Name),
+			instanceTypes: []string{ng.InstanceType},
+			zones:         nodeGroupZones(spec, ng.AvailabilityZones, ng.PrivateNetworking),
+			candidates:    nodeGroupZones(spec, nil, ng.PrivateNetworking),
+		})
+	}
+
+	allZones := []string{}
+	seen := map[string]bool{}
+	for _, p := range placements {
+		for _, az := range append(append([]string{}, p.zones...), p.candidates...) {
+			if !seen[az] {
+				seen[az] = true
+				allZones = append(allZones, az)
+			}
+		}
+	}
+	if len(allZones) == 0 {
+		return nil
+	}
+
+	offerings, err := c.describeInstanceTypeOfferings(allZones)
+	if err != nil {
+		logger.Warning("cannot check whether instance types are offered in availability zones %v: %s", allZones, err.Error())
+		return nil
+	}
+
+	problems := []string{}
+	for _, p := range placements {
+		if len(p.instanceTypes) > 1 {
+			// an autoscaling group with mixed instances launches any of the types that are offered
+			for _, az := range p.zones {
+				if offerings.offeredAny(az, p.instanceTypes) {
+					continue
+				}
+				problems = append(problems, fmt.Sprintf("none of instance types %v of %s are offered in %s", p.instanceTypes, p.description, az))
+			}
+			for _, instanceType := range p.instanceTypes {
+				if missing := offerings.missingZones(instanceType, p.zones); len(missing) > 0 {
+					logger.Warning("instance type %q of %s is not offered in %v, other instance types will be launched there", instanceType, p.description, missing)
+				}
+			}
+			continue
+		}
+
+		instanceType := p.instanceTypes[0]
+		if instanceType == "" {
+			continue
+		}
+		missing := offerings.missingZones(instanceType, p.zones)
+		if len(missing) == 0 {
+			continue
+		}
+		problem := fmt.Sprintf("instance type %q of %s is not offered in %v", instanceType, p.description, missing)
+		suggestions := []string{}
+		if zones := offerings.offeringZones(instanceType, p.candidates); len(zones) > 0 {
+			suggestions = append(suggestions, fmt.Sprintf("set availabilityZones to some of %v", zones))
+		}
+		if instanceTypes := offerings.similarInstanceTypes(instanceType, p.zones); len(instanceTypes) > 0 {
+			suggestions = append(suggestions, fmt.Sprintf("use one of instance types %v", instanceTypes))
+		}
+		if len(suggestions) > 0 {
+			problem += ", " + strings.Join(suggestions, " or ")
+		}
+		problems = append(problems, problem)
+	}
+
+	if len(problems) > 0 {
+		return fmt.Errorf("instance types are not offered in availability zones of nodegroups:\n- %s", strings.Join(problems, "\n- "))
+	}
+	return nil
+}
+
+// nodeGroupZones returns the given availability zones of a nodegroup, or the zones of
+// the subnets that the nodegroup is placed in otherwise
+func nodeGroupZones(spec *api.ClusterConfig, availabilityZones []string, privateNetworking bool) []string {
+	if len(availabilityZones) > 0 {
+		return availabilityZones
+	}
+	if spec.VPC == nil || spec.VPC.Subnets == nil {
+		return nil
+	}
+	subnets := spec.VPC.Subnets.Public
+	if privateNetworking {
+		subnets = spec.VPC.Subnets.Private
+	}
+	zones := []string{}
+	for az := range subnets {
+		zones = append(zones, az)
+	}
+	sort.Strings(zones)
+	return zones
+}
+
+func (c *ClusterProvider) describeInstanceTypeOfferings(zones []string) (instanceTypeOfferings, error) {
+	offerings := instanceTypeOfferings{}
+	for _, az := range zones {
+		offerings[az] = map[string]bool{}
+	}
+	input := &ec2.DescribeInstanceTypeOfferingsInput{
+		LocationType: aws.String(ec2.LocationTypeAvailabilityZone),
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("location"),
+			Values: aws.StringSlice(zones),
+		}},
+	}
+	err := c.Provider.EC2().DescribeInstanceTypeOfferingsPages(input, func(output *ec2.DescribeInstanceTypeOfferingsOutput, _ bool) bool {
+		for _, offering := range output.InstanceTypeOfferings {
+			az := aws.StringValue(offering.Location)
+			if offerings[az] == nil {
+				offerings[az] = map[string]bool{}
+			}
+			offerings[az][aws.StringValue(offering.InstanceType)] = true
+		}
+		return true
+	})
+	return offerings, err
+}
+
+func (o instanceTypeOfferings) missingZones(instanceType string, zones []string) []string {
+	missing := []string{}
+	for _, az := range zones {
+		if !o[az][instanceType] {
+			missing = append(missing, az)
+		}
+	}
+	return missing
+}
+
+func (o instanceTypeOfferings) offeringZones(instanceType string, zones []string) []string {
+	offering := []string{}
+	for _, az := range zones {
+		if o[az][instanceType] {
+			offering = append(offering, az)
+		}
+	}
+	return offering
+}
+
+func (o instanceTypeOfferings) offeredAny(az string, instanceTypes []string) bool {
+	for _, instanceType := range instanceTypes {
+		if o[az][instanceType] {
+			return true
+		}
+	}
+	return false
+}
+
+// similarInstanceTypes returns instance types of the same class, size and architecture
+// that are offered in all of the zones, e.g. m5a.xlarge or m5n.xlarge for m5.xlarge
+func (o instanceTypeOfferings) similarInstanceTypes(instanceType string, zones []string) []string {
+	if len(zones) == 0 {
+		return nil
+	}
+	class, size := instanceTypeClassAndSize(instanceType)
+	similar := []string{}
+	for candidate := range o[zones[0]] {
+		if candidate == instanceType || len(o.missingZones(candidate, zones)) > 0 {
+			continue
+		}
+		if c, s := instanceTypeClassAndSize(candidate); c != class || s != size {
+			continue
+		}
+		if utils.IsARMInstanceType(candidate) != utils.IsARMInstanceType(instanceType) ||
+			utils.IsGPUInstanceType(candidate) != utils.IsGPUInstanceType(instanceType) {
+			continue
+		}
+		similar = append(similar, candidate)
+	}
+	sort.Strings(similar)
+	if len(similar) > maxSuggestedInstanceTypes {
+		similar = similar[:maxSuggestedInstanceTypes]
+	}
+	return similar
+}
+
+// instanceTypeClassAndSize splits an instance type like m5.xlarge into its class m and size xlarge
+func instanceTypeClassAndSize(instanceType string) (string, string) {
+	parts := strings.SplitN(instanceType, ".", 2)
+	if len(parts) != 2 {
+		return instanceType, ""
+	}
+	class := strings.TrimRightFunc(parts[0], func(r rune) bool { return r < '0' || r > '9' })
+	class = strings.TrimRight(class, "0123456789")
+	return class, parts[1]
+}
@@ -0,0 +1,97 @@
+package eks_test
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("instance type offerings", func() {
+	var (
+		p   *mockprovider.MockProvider
+		ctl *ClusterProvider
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+	)
+
+	mockOfferings := func(offerings map[string][]string) {
+		p.MockEC2().On("DescribeInstanceTypeOfferingsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			output := &ec2.DescribeInstanceTypeOfferingsOutput{}
+			for az, instanceTypes := range offerings {
+				for _, instanceType := range instanceTypes {
+					output.InstanceTypeOfferings = append(output.InstanceTypeOfferings, &ec2.InstanceTypeOffering{
+						Location:     aws.String(az),
+						InstanceType: aws.String(instanceType),
+					})
+				}
+			}
+			consume := args[1].(func(*ec2.DescribeInstanceTypeOfferingsOutput, bool) bool)
+			consume(output, true)
+		}).Return(nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{Provider: p, Status: &ProviderStatus{}}
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Public: map[string]api.Network{
+				"us-east-1a": {ID: "subnet-a"},
+				"us-east-1b": {ID: "subnet-b"},
+				"us-east-1e": {ID: "subnet-e"},
+			},
+		}
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.xlarge"
+	})
+
+	It("succeeds when the instance type is offered in all zones", func() {
+		mockOfferings(map[string][]string{
+			"us-east-1a": {"m5.xlarge"},
+			"us-east-1b": {"m5.xlarge"},
+			"us-east-1e": {"m5.xlarge"},
+		})
+		Expect(ctl.CheckInstanceTypeOfferings(cfg, cfg.NodeGroups, nil)).To(Succeed())
+	})
+
+	It("suggests zones and instance types when the instance type isn't offered", func() {
+		mockOfferings(map[string][]string{
+			"us-east-1a": {"m5.xlarge", "m5a.xlarge", "m6g.xlarge", "c5.xlarge"},
+			"us-east-1b": {"m5.xlarge", "m5a.xlarge", "m6g.xlarge"},
+			"us-east-1e": {"m5a.xlarge", "m6g.xlarge"},
+		})
+		err := ctl.CheckInstanceTypeOfferings(cfg, cfg.NodeGroups, nil)
+		Expect(err).To(MatchError(`instance types are not offered in availability zones of nodegroups:
+- instance type "m5.xlarge" of nodegroup "ng-1" is not offered in [us-east-1e], set availabilityZones to some of [us-east-1a us-east-1b] or use one of instance types [m5a.xlarge]`))
+	})
+
+	It("only fails for mixed instances when none of the types are offered in a zone", func() {
+		ng.AvailabilityZones = []string{"us-east-1a", "us-east-1e"}
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes: []string{"m5.xlarge", "m5a.xlarge"},
+		}
+		mockOfferings(map[string][]string{
+			"us-east-1a": {"m5.xlarge"},
+			"us-east-1e": {"c5.xlarge"},
+		})
+		err := ctl.CheckInstanceTypeOfferings(cfg, cfg.NodeGroups, nil)
+		Expect(err).To(MatchError(`instance types are not offered in availability zones of nodegroups:
+- none of instance types [m5.xlarge m5a.xlarge] of nodegroup "ng-1" are offered in us-east-1e`))
+	})
+
+	It("doesn't block creation when the offerings cannot be described", func() {
+		p.MockEC2().On("DescribeInstanceTypeOfferingsPages", mock.Anything, mock.Anything).Return(fmt.Errorf("access denied"))
+		Expect(ctl.CheckInstanceTypeOfferings(cfg, cfg.NodeGroups, nil)).To(Succeed())
+	})
+})
@@ -0,0 +1,168 @@
+package eks
+
+import (
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// ApplyConfigOverrides sets fields of a config that has been loaded from a file, each override
+// has the form path=value, where path uses the field names of the config file, elements of lists
+// are selected by index, name or '*', e.g. nodeGroups[ng-1].desiredCapacity=5, and values are
+// parsed as YAML, e.g. nodeGroups[*].availabilityZones=[us-west-2a,us-west-2b]
+func ApplyConfigOverrides(cfg *api.ClusterConfig, overrides []string) error {
+	for _, override := range overrides {
+		parts := strings.SplitN(override, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return fmt.Errorf("invalid override %q, must be in the form path=value", override)
+		}
+		path, err := parseOverridePath(parts[0])
+		if err != nil {
+			return errors.Wrapf(err, "invalid override %q", override)
+		}
+		if err := setOverride(reflect.ValueOf(cfg).Elem(), path, parts[1]); err != nil {
+			return errors.Wrapf(err, "applying override %q", override)
+		}
+	}
+	return nil
+}
+
+// parseOverridePath splits a path like nodeGroups[ng-1].labels[example.com/role] into its steps,
+// brackets are used to select list elements and for map keys that contain dots
+func parseOverridePath(path string) ([]string, error) {
+	steps := []string{}
+	for len(path) > 0 {
+		switch path[0] {
+		case '.':
+			if len(steps) == 0 || len(path) == 1 {
+				return nil, fmt.Errorf("unexpected '.'")
+			}
+			path = path[1:]
+		case '[':
+			end := strings.IndexByte(path, ']')
+			if end < 2 {
+				return nil, fmt.Errorf("unterminated or empty '['")
+			}
+			steps = append(steps, path[1:end])
+			path = path[end+1:]
+		default:
+			end := strings.IndexAny(path, ".[")
+			if end == -1 {
+				end = len(path)
+			}
+			steps = append(steps, path[:end])
+			path = path[end:]
+		}
+	}
+	return steps, nil
+}
+
+func setOverride(v reflect.Value, path []string, value string) error {
+	if len(path) == 0 {
+		target := reflect.New(v.Type())
+		if err := yaml.Unmarshal([]byte(value), target.Interface()); err != nil {
+			return errors.Wrapf(err, "invalid value %q", value)
+		}
+		v.Set(target.Elem())
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if v.IsNil() {
+			v.Set(reflect.New(v.Type().Elem()))
+		}
+		return setOverride(v.Elem(), path, value)
+	case reflect.Struct:
+		field, ok := fieldByJSONName(v, path[0])
+		if !ok {
+			return fmt.Errorf("unknown field %q", path[0])
+		}
+		return setOverride(field, path[1:], value)
+	case reflect.Map:
+		if v.IsNil() {
+			v.Set(reflect.MakeMap(v.Type()))
+		}
+		key := reflect.ValueOf(path[0]).Convert(v.Type().Key())
+		elem := reflect.New(v.Type().Elem()).Elem()
+		if existing := v.MapIndex(key); existing.IsValid() {
+			elem.Set(existing)
+		}
+		if err := setOverride(elem, path[1:], value); err != nil {
+			return err
+		}
+		v.SetMapIndex(key, elem)
+		return nil
+	case reflect.Slice:
+		matched := false
+		for i := 0; i < v.Len(); i++ {
+			if !elementMatches(v.Index(i), i, path[0]) {
+				continue
+			}
+			matched = true
+			if err := setOverride(v.Index(i), path[1:], value); err != nil {
+				return err
+			}
+		}
+		if !matched {
+			return fmt.Errorf("no element matches %q, elements can only be selected by index, name or '*'", path[0])
+		}
+		return nil
+	default:
+		return fmt.Errorf("%q cannot be set on a field of type %s", path[0], v.Type())
+	}
+}
+
+func fieldByJSONName(v reflect.Value, name string) (reflect.Value, bool) {
+	for i := 0; i < v.NumField(); i++ {
+		field := v.Type().Field(i)
+		tagName := strings.Split(field.Tag.Get("json"), ",")[0]
+		if tagName == "-" {
+			continue
+		}
+		if tagName == "" && field.Anonymous && field.Type.Kind() == reflect.Struct {
+			if embedded, ok := fieldByJSONName(v.Field(i), name); ok {
+				return embedded, true
+			}
+			continue
+		}
+		if tagName == "" {
+			tagName = field.Name
+		}
+		if tagName == name {
+			return v.Field(i), true
+		}
+	}
+	return reflect.Value{}, false
+}
+
+// elementMatches selects elements of lists by index, by name, i.e. the name
+// or metadata.name field, or all of them with '*'
+func elementMatches(elem reflect.Value, index int, selector string) bool {
+	if selector == "*" {
+		return true
+	}
+	if i, err := strconv.Atoi(selector); err == nil {
+		return i == index
+	}
+	for elem.Kind() == reflect.Ptr {
+		if elem.IsNil() {
+			return false
+		}
+		elem = elem.Elem()
+	}
+	if elem.Kind() != reflect.Struct {
+		return false
+	}
+	if metadata, ok := fieldByJSONName(elem, "metadata"); ok && metadata.Kind() == reflect.Struct {
+		elem = metadata
+	}
+	name, ok := fieldByJSONName(elem, "name")
+	return ok && name.Kind() == reflect.String && name.String() == selector
+}
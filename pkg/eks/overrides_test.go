@@ -0,0 +1,60 @@
+package eks_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("config file overrides", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+		cfg.NodeGroups = []*api.NodeGroup{
+			{Name: "ng-1", InstanceType: "m5.large"},
+			{Name: "ng-2", InstanceType: "m5.large"},
+		}
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{}}
+		cfg.IAM.ServiceAccounts[0].Name = "s3-reader"
+	})
+
+	It("sets fields by path", func() {
+		err := ApplyConfigOverrides(cfg, []string{
+			"metadata.region=eu-north-1",
+			"metadata.version=1.14",
+			"metadata.tags.env=prod",
+			"nodeGroups[ng-1].desiredCapacity=5",
+			"nodeGroups[1].instanceType=m5.xlarge",
+			"nodeGroups[*].availabilityZones=[eu-north-1a, eu-north-1b]",
+			"nodeGroups[ng-2].labels[example.com/role]=builders",
+			"iam.serviceAccounts[s3-reader].attachPolicyARNs=[arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess]",
+		})
+		Expect(err).NotTo(HaveOccurred())
+
+		Expect(cfg.Metadata.Region).To(Equal("eu-north-1"))
+		Expect(cfg.Metadata.Version).To(Equal("1.14"))
+		Expect(cfg.Metadata.Tags).To(Equal(map[string]string{"env": "prod"}))
+		Expect(*cfg.NodeGroups[0].DesiredCapacity).To(Equal(5))
+		Expect(cfg.NodeGroups[1].DesiredCapacity).To(BeNil())
+		Expect(cfg.NodeGroups[0].InstanceType).To(Equal("m5.large"))
+		Expect(cfg.NodeGroups[1].InstanceType).To(Equal("m5.xlarge"))
+		for _, ng := range cfg.NodeGroups {
+			Expect(ng.AvailabilityZones).To(Equal([]string{"eu-north-1a", "eu-north-1b"}))
+		}
+		Expect(cfg.NodeGroups[1].Labels).To(HaveKeyWithValue("example.com/role", "builders"))
+		Expect(cfg.IAM.ServiceAccounts[0].AttachPolicyARNs).To(ConsistOf("arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"))
+	})
+
+	It("rejects invalid overrides", func() {
+		Expect(ApplyConfigOverrides(cfg, []string{"metadata.region"})).To(MatchError(ContainSubstring("must be in the form path=value")))
+		Expect(ApplyConfigOverrides(cfg, []string{"metadata.zone=a"})).To(MatchError(ContainSubstring(`unknown field "zone"`)))
+		Expect(ApplyConfigOverrides(cfg, []string{"nodeGroups[ng-3].desiredCapacity=1"})).To(MatchError(ContainSubstring(`no element matches "ng-3"`)))
+		Expect(ApplyConfigOverrides(cfg, []string{"nodeGroups[ng-1].desiredCapacity=many"})).To(MatchError(ContainSubstring(`invalid value "many"`)))
+		Expect(ApplyConfigOverrides(cfg, []string{"nodeGroups[ng-1.desiredCapacity=1"})).To(MatchError(ContainSubstring("unterminated")))
+	})
+})
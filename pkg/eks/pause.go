@@ -0,0 +1,371 @@
+package eks
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/pkg/errors"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	kerr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	// PausedNodeGroupsConfigMapName is the name of the ConfigMap in kube-system that records the sizes
+	// of the nodegroups and the replicas of the workloads of a paused cluster, so that they can be restored
+	// when it's resumed
+	PausedNodeGroupsConfigMapName = "eksctl-paused-nodegroups"
+
+	pausedNodeGroupsKey = "nodegroups"
+	pausedWorkloadsKey  = "workloads"
+
+	kindDeployment  = "Deployment"
+	kindStatefulSet = "StatefulSet"
+)
+
+// PausedNodeGroup is the size of a nodegroup before its cluster was paused
+type PausedNodeGroup struct {
+	Name            string `json:"name"`
+	Managed         bool   `json:"managed,omitempty"`
+	DesiredCapacity int    `json:"desiredCapacity"`
+	MinSize         int    `json:"minSize"`
+	MaxSize         int    `json:"maxSize"`
+}
+
+// PausedWorkload is the number of replicas of a Deployment or StatefulSet before its cluster was paused
+type PausedWorkload struct {
+	Kind      string `json:"kind"`
+	Namespace string `json:"namespace"`
+	Name      string `json:"name"`
+	Replicas  int32  `json:"replicas"`
+}
+
+func (w *PausedWorkload) key() string {
+	return w.Kind + "/" + w.Namespace + "/" + w.Name
+}
+
+// NewTasksToPauseCluster defines tasks that record the sizes of all nodegroups and managed nodegroups of the
+// cluster and the replicas of the Deployments and StatefulSets that don't run on Fargate, then scale the workloads
+// and the nodegroups to zero; when the cluster is paused already, e.g. after a failure, the sizes that were
+// recorded first are kept, and only nodegroups and workloads that were created or scaled up since are added
+func (c *ClusterProvider) NewTasksToPauseCluster(cfg *api.ClusterConfig, clientSet kubernetes.Interface, stackManager *manager.StackCollection) (*manager.TaskTree, error) {
+	paused, _, err := LoadPausedNodeGroups(clientSet)
+	if err != nil {
+		return nil, err
+	}
+	pausedWorkloads, err := LoadPausedWorkloads(clientSet)
+	if err != nil {
+		return nil, err
+	}
+	recorded := map[string]bool{}
+	for _, ng := range paused {
+		recorded[ng.Name] = true
+	}
+
+	nodeGroups, err := stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	managedNodeGroups, err := stackManager.ListManagedNodeGroups()
+	if err != nil {
+		return nil, err
+	}
+	if len(nodeGroups)+len(managedNodeGroups) == 0 {
+		return nil, fmt.Errorf("cluster %q has no nodegroups to pause", cfg.Metadata.Name)
+	}
+
+	record := func(name string, managed bool, getSize func(string) (*manager.NodeGroupSize, error)) error {
+		if recorded[name] {
+			return nil
+		}
+		size, err := getSize(name)
+		if err != nil {
+			return err
+		}
+		paused = append(paused, &PausedNodeGroup{
+			Name:            name,
+			Managed:         managed,
+			DesiredCapacity: size.DesiredCapacity,
+			MinSize:         size.MinSize,
+			MaxSize:         size.MaxSize,
+		})
+		return nil
+	}
+	for _, name := range nodeGroups {
+		if err := record(name, false, stackManager.GetNodeGroupSize); err != nil {
+			return nil, err
+		}
+	}
+	for _, name := range managedNodeGroups {
+		if err := record(name, true, stackManager.GetManagedNodeGroupSize); err != nil {
+			return nil, err
+		}
+	}
+
+	fargateProfiles, err := c.NewFargateClient(cfg).ReadProfiles()
+	if err != nil {
+		return nil, err
+	}
+	workloads, err := listWorkloadsToPause(clientSet, &api.ClusterConfig{FargateProfiles: fargateProfiles})
+	if err != nil {
+		return nil, err
+	}
+	recordedWorkloads := map[string]bool{}
+	for _, w := range pausedWorkloads {
+		recordedWorkloads[w.key()] = true
+	}
+	for _, w := range workloads {
+		if !recordedWorkloads[w.key()] {
+			pausedWorkloads = append(pausedWorkloads, w)
+		}
+	}
+
+	// the max size is kept, as it can't be zero for managed nodegroups
+	zero := 0
+	var toScale []*api.NodeGroup
+	for _, name := range nodeGroups {
+		toScale = append(toScale, &api.NodeGroup{Name: name, DesiredCapacity: &zero, MinSize: &zero})
+	}
+	var managedToScale []*api.ManagedNodeGroup
+	for _, name := range managedNodeGroups {
+		managedToScale = append(managedToScale, &api.ManagedNodeGroup{Name: name, DesiredCapacity: &zero, MinSize: &zero})
+	}
+
+	// sizes are recorded before anything is scaled, so that they aren't lost when scaling fails, and the workloads
+	// are scaled down before the nodes they run on go away
+	tasks := &manager.TaskTree{Parallel: false}
+	tasks.Append(&clusterConfigTask{
+		info: fmt.Sprintf("record sizes of %d nodegroup(s) and %d workload(s) in ConfigMap %q", len(paused), len(pausedWorkloads), PausedNodeGroupsConfigMapName),
+		spec: cfg,
+		call: func(_ *api.ClusterConfig) error {
+			return savePausedCluster(clientSet, paused, pausedWorkloads)
+		},
+	})
+	if len(workloads) > 0 {
+		tasks.Append(&clusterConfigTask{
+			info: fmt.Sprintf("scale %d workload(s) to zero", len(workloads)),
+			spec: cfg,
+			call: func(_ *api.ClusterConfig) error {
+				return scaleWorkloads(clientSet, workloads, func(*PausedWorkload) int32 { return 0 })
+			},
+		})
+	}
+	scaleTasks := stackManager.NewTasksToScaleNodeGroups(toScale, managedToScale, false)
+	scaleTasks.IsSubTask = true
+	tasks.Append(scaleTasks)
+	return tasks, nil
+}
+
+// NewTasksToResumeCluster defines tasks that scale the nodegroups and the workloads of a paused cluster back to the
+// sizes they had before it was paused, then delete the record of these; nodegroups and workloads that were deleted
+// in the meantime are skipped
+func (c *ClusterProvider) NewTasksToResumeCluster(cfg *api.ClusterConfig, clientSet kubernetes.Interface, stackManager *manager.StackCollection) (*manager.TaskTree, error) {
+	paused, found, err := LoadPausedNodeGroups(clientSet)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, fmt.Errorf("cluster %q isn't paused, ConfigMap %q not found", cfg.Metadata.Name, PausedNodeGroupsConfigMapName)
+	}
+	pausedWorkloads, err := LoadPausedWorkloads(clientSet)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeGroups, err := stackManager.ListNodeGroupStacks()
+	if err != nil {
+		return nil, err
+	}
+	managedNodeGroups, err := stackManager.ListManagedNodeGroups()
+	if err != nil {
+		return nil, err
+	}
+	existing := sets.NewString(nodeGroups...)
+	existingManaged := sets.NewString(managedNodeGroups...)
+
+	var toScale []*api.NodeGroup
+	var managedToScale []*api.ManagedNodeGroup
+	for _, ng := range paused {
+		desiredCapacity, minSize, maxSize := ng.DesiredCapacity, ng.MinSize, ng.MaxSize
+		switch {
+		case ng.Managed && existingManaged.Has(ng.Name):
+			managedToScale = append(managedToScale, &api.ManagedNodeGroup{Name: ng.Name, DesiredCapacity: &desiredCapacity, MinSize: &minSize, MaxSize: &maxSize})
+		case !ng.Managed && existing.Has(ng.Name):
+			toScale = append(toScale, &api.NodeGroup{Name: ng.Name, DesiredCapacity: &desiredCapacity, MinSize: &minSize, MaxSize: &maxSize})
+		default:
+			logger.Warning("nodegroup %q doesn't exist anymore, it won't be resumed", ng.Name)
+		}
+	}
+
+	tasks := &manager.TaskTree{Parallel: false}
+	if scaleTasks := stackManager.NewTasksToScaleNodeGroups(toScale, managedToScale, false); scaleTasks.Len() > 0 {
+		scaleTasks.IsSubTask = true
+		tasks.Append(scaleTasks)
+	}
+	if len(pausedWorkloads) > 0 {
+		tasks.Append(&clusterConfigTask{
+			info: fmt.Sprintf("restore replicas of %d workload(s)", len(pausedWorkloads)),
+			spec: cfg,
+			call: func(_ *api.ClusterConfig) error {
+				return scaleWorkloads(clientSet, pausedWorkloads, func(w *PausedWorkload) int32 { return w.Replicas })
+			},
+		})
+	}
+	tasks.Append(&clusterConfigTask{
+		info: fmt.Sprintf("delete ConfigMap %q", PausedNodeGroupsConfigMapName),
+		spec: cfg,
+		call: func(_ *api.ClusterConfig) error {
+			err := clientSet.CoreV1().ConfigMaps(metav1.NamespaceSystem).Delete(PausedNodeGroupsConfigMapName, &metav1.DeleteOptions{})
+			if err != nil && !kerr.IsNotFound(err) {
+				return errors.Wrapf(err, "deleting ConfigMap %q", PausedNodeGroupsConfigMapName)
+			}
+			return nil
+		},
+	})
+	return tasks, nil
+}
+
+// LoadPausedNodeGroups returns the recorded sizes of the nodegroups of a paused cluster, and whether the cluster
+// is paused
+func LoadPausedNodeGroups(clientSet kubernetes.Interface) ([]*PausedNodeGroup, bool, error) {
+	paused := []*PausedNodeGroup{}
+	found, err := loadPaused(clientSet, pausedNodeGroupsKey, &paused)
+	if err != nil || !found {
+		return nil, found, err
+	}
+	return paused, true, nil
+}
+
+// LoadPausedWorkloads returns the recorded replicas of the workloads of a paused cluster, none if the cluster isn't
+// paused or was paused before workloads were recorded
+func LoadPausedWorkloads(clientSet kubernetes.Interface) ([]*PausedWorkload, error) {
+	paused := []*PausedWorkload{}
+	if _, err := loadPaused(clientSet, pausedWorkloadsKey, &paused); err != nil {
+		return nil, err
+	}
+	return paused, nil
+}
+
+func loadPaused(clientSet kubernetes.Interface, key string, into interface{}) (bool, error) {
+	cm, err := clientSet.CoreV1().ConfigMaps(metav1.NamespaceSystem).Get(PausedNodeGroupsConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if kerr.IsNotFound(err) {
+			return false, nil
+		}
+		return false, errors.Wrapf(err, "getting ConfigMap %q", PausedNodeGroupsConfigMapName)
+	}
+	data, ok := cm.Data[key]
+	if !ok {
+		return true, nil
+	}
+	if err := json.Unmarshal([]byte(data), into); err != nil {
+		return false, errors.Wrapf(err, "reading %s of ConfigMap %q", key, PausedNodeGroupsConfigMapName)
+	}
+	return true, nil
+}
+
+// listWorkloadsToPause returns the Deployments and StatefulSets outside kube-system that have replicas and that
+// none of the Fargate profiles of the cluster selects
+func listWorkloadsToPause(clientSet kubernetes.Interface, cfg *api.ClusterConfig) ([]*PausedWorkload, error) {
+	var workloads []*PausedWorkload
+	add := func(kind string, meta metav1.ObjectMeta, replicas *int32, podLabels map[string]string) {
+		if meta.Namespace == metav1.NamespaceSystem || replicas == nil || *replicas == 0 {
+			return
+		}
+		if cfg.IsSchedulableOnFargate(meta.Namespace, podLabels) {
+			logger.Debug("%s %s/%s runs on Fargate, it won't be paused", kind, meta.Namespace, meta.Name)
+			return
+		}
+		workloads = append(workloads, &PausedWorkload{Kind: kind, Namespace: meta.Namespace, Name: meta.Name, Replicas: *replicas})
+	}
+
+	deployments, err := clientSet.AppsV1().Deployments(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing Deployments")
+	}
+	for _, d := range deployments.Items {
+		add(kindDeployment, d.ObjectMeta, d.Spec.Replicas, d.Spec.Template.Labels)
+	}
+	statefulSets, err := clientSet.AppsV1().StatefulSets(metav1.NamespaceAll).List(metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "listing StatefulSets")
+	}
+	for _, s := range statefulSets.Items {
+		add(kindStatefulSet, s.ObjectMeta, s.Spec.Replicas, s.Spec.Template.Labels)
+	}
+	return workloads, nil
+}
+
+// scaleWorkloads sets the replicas of the workloads, skipping those that were deleted
+func scaleWorkloads(clientSet kubernetes.Interface, workloads []*PausedWorkload, replicas func(*PausedWorkload) int32) error {
+	apps := clientSet.AppsV1()
+	for _, w := range workloads {
+		n := replicas(w)
+		var err error
+		switch w.Kind {
+		case kindDeployment:
+			var d *appsv1.Deployment
+			if d, err = apps.Deployments(w.Namespace).Get(w.Name, metav1.GetOptions{}); err == nil {
+				d.Spec.Replicas = &n
+				_, err = apps.Deployments(w.Namespace).Update(d)
+			}
+		case kindStatefulSet:
+			var s *appsv1.StatefulSet
+			if s, err = apps.StatefulSets(w.Namespace).Get(w.Name, metav1.GetOptions{}); err == nil {
+				s.Spec.Replicas = &n
+				_, err = apps.StatefulSets(w.Namespace).Update(s)
+			}
+		default:
+			err = fmt.Errorf("unknown kind %q", w.Kind)
+		}
+		if kerr.IsNotFound(err) {
+			logger.Warning("%s %s/%s doesn't exist anymore, it won't be scaled", w.Kind, w.Namespace, w.Name)
+			continue
+		}
+		if err != nil {
+			return errors.Wrapf(err, "scaling %s %s/%s to %d replica(s)", w.Kind, w.Namespace, w.Name, n)
+		}
+		logger.Info("scaled %s %s/%s to %d replica(s)", w.Kind, w.Namespace, w.Name, n)
+	}
+	return nil
+}
+
+func savePausedCluster(clientSet kubernetes.Interface, paused []*PausedNodeGroup, pausedWorkloads []*PausedWorkload) error {
+	nodeGroupsData, err := json.Marshal(paused)
+	if err != nil {
+		return err
+	}
+	workloadsData, err := json.Marshal(pausedWorkloads)
+	if err != nil {
+		return err
+	}
+	data := map[string]string{
+		pausedNodeGroupsKey: string(nodeGroupsData),
+		pausedWorkloadsKey:  string(workloadsData),
+	}
+	client := clientSet.CoreV1().ConfigMaps(metav1.NamespaceSystem)
+	cm, err := client.Get(PausedNodeGroupsConfigMapName, metav1.GetOptions{})
+	if err != nil {
+		if !kerr.IsNotFound(err) {
+			return errors.Wrapf(err, "getting ConfigMap %q", PausedNodeGroupsConfigMapName)
+		}
+		_, err = client.Create(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      PausedNodeGroupsConfigMapName,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: data,
+		})
+		return errors.Wrapf(err, "creating ConfigMap %q", PausedNodeGroupsConfigMapName)
+	}
+	cm.Data = data
+	_, err = client.Update(cm)
+	return errors.Wrapf(err, "updating ConfigMap %q", PausedNodeGroupsConfigMapName)
+}
@@ -0,0 +1,194 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Pausing a cluster", func() {
+	var (
+		c            *ClusterProvider
+		cfg          *api.ClusterConfig
+		stackManager *manager.StackCollection
+	)
+
+	BeforeEach(func() {
+		p := mockprovider.NewMockProvider()
+		c = &ClusterProvider{Provider: p, Status: &ProviderStatus{}}
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		stackManager = manager.NewStackCollection(p, cfg)
+
+		p.MockCloudFormation().On("DescribeStacksPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *cfn.DescribeStacksOutput, last bool) bool)
+			consume(&cfn.DescribeStacksOutput{Stacks: []*cfn.Stack{{
+				StackName:   aws.String("eksctl-test-cluster-nodegroup-ng-1"),
+				StackStatus: aws.String(cfn.StackStatusCreateComplete),
+				Tags: []*cfn.Tag{{
+					Key:   aws.String(api.NodeGroupNameTag),
+					Value: aws.String("ng-1"),
+				}},
+			}}}, true)
+		}).Return(nil)
+		p.MockCloudFormation().On("GetTemplate", mock.Anything).Return(&cfn.GetTemplateOutput{
+			TemplateBody: aws.String(`{"Resources": {"NodeGroup": {"Properties": {"DesiredCapacity": "2", "MinSize": "1", "MaxSize": "4"}}}}`),
+		}, nil)
+
+		p.MockEKS().On("ListNodegroupsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(p *awseks.ListNodegroupsOutput, last bool) bool)
+			consume(&awseks.ListNodegroupsOutput{Nodegroups: aws.StringSlice([]string{"mng-1"})}, true)
+		}).Return(nil)
+		p.MockEKS().On("DescribeNodegroup", mock.Anything).Return(&awseks.DescribeNodegroupOutput{
+			Nodegroup: &awseks.Nodegroup{
+				ScalingConfig: &awseks.NodegroupScalingConfig{
+					DesiredSize: aws.Int64(3),
+					MinSize:     aws.Int64(3),
+					MaxSize:     aws.Int64(6),
+				},
+			},
+		}, nil)
+
+		p.MockEKS().On("ListFargateProfilesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*awseks.ListFargateProfilesOutput, bool) bool)
+			consume(&awseks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"fp-serverless"})}, true)
+		}).Return(nil)
+		p.MockEKS().On("DescribeFargateProfile", mock.Anything).Return(&awseks.DescribeFargateProfileOutput{
+			FargateProfile: &awseks.FargateProfile{
+				FargateProfileName: aws.String("fp-serverless"),
+				Selectors:          []*awseks.FargateProfileSelector{{Namespace: aws.String("serverless")}},
+			},
+		}, nil)
+	})
+
+	It("records the sizes of all nodegroups before scaling them to zero", func() {
+		tasks, err := c.NewTasksToPauseCluster(cfg, fake.NewSimpleClientset(), stackManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { record sizes of 2 nodegroup(s) and 0 workload(s) in ConfigMap "eksctl-paused-nodegroups", ` +
+			`2 parallel sub-tasks: { scale nodegroup "ng-1", scale managed nodegroup "mng-1" } }`))
+	})
+
+	It("scales the workloads that don't run on Fargate to zero before the nodegroups", func() {
+		clientSet := fake.NewSimpleClientset(
+			deployment("default", "web", 3),
+			deployment("default", "idle", 0),
+			deployment("serverless", "api", 2),
+			deployment(metav1.NamespaceSystem, "coredns", 2),
+			statefulSet("default", "db", 1),
+		)
+
+		tasks, err := c.NewTasksToPauseCluster(cfg, clientSet, stackManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`3 sequential tasks: { record sizes of 2 nodegroup(s) and 2 workload(s) in ConfigMap "eksctl-paused-nodegroups", ` +
+			`scale 2 workload(s) to zero, 2 parallel sub-tasks: { scale nodegroup "ng-1", scale managed nodegroup "mng-1" } }`))
+	})
+
+	It("keeps the recorded replicas of workloads when pausing a paused cluster again", func() {
+		clientSet := fake.NewSimpleClientset(
+			pausedClusterConfigMap(`[{"name": "ng-1", "desiredCapacity": 2, "minSize": 1, "maxSize": 4}]`,
+				`[{"kind": "Deployment", "namespace": "default", "name": "web", "replicas": 3}]`),
+			deployment("default", "web", 1),
+			deployment("default", "new", 2),
+		)
+
+		tasks, err := c.NewTasksToPauseCluster(cfg, clientSet, stackManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(HavePrefix(`3 sequential tasks: { record sizes of 2 nodegroup(s) and 2 workload(s) in ConfigMap "eksctl-paused-nodegroups", ` +
+			`scale 2 workload(s) to zero, `))
+	})
+
+	It("restores the replicas of the workloads that still exist", func() {
+		clientSet := fake.NewSimpleClientset(
+			pausedClusterConfigMap(`[{"name": "ng-old", "desiredCapacity": 1, "minSize": 1, "maxSize": 1}]`,
+				`[{"kind": "Deployment", "namespace": "default", "name": "web", "replicas": 3}, `+
+					`{"kind": "StatefulSet", "namespace": "default", "name": "db", "replicas": 1}, `+
+					`{"kind": "Deployment", "namespace": "default", "name": "gone", "replicas": 2}]`),
+			deployment("default", "web", 0),
+			statefulSet("default", "db", 0),
+		)
+
+		tasks, err := c.NewTasksToResumeCluster(cfg, clientSet, stackManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { restore replicas of 3 workload(s), delete ConfigMap "eksctl-paused-nodegroups" }`))
+		Expect(tasks.DoAllSync()).To(BeEmpty())
+
+		web, err := clientSet.AppsV1().Deployments("default").Get("web", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*web.Spec.Replicas).To(BeEquivalentTo(3))
+		db, err := clientSet.AppsV1().StatefulSets("default").Get("db", metav1.GetOptions{})
+		Expect(err).NotTo(HaveOccurred())
+		Expect(*db.Spec.Replicas).To(BeEquivalentTo(1))
+
+		_, found, err := LoadPausedNodeGroups(clientSet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeFalse())
+	})
+
+	It("resumes the nodegroups that still exist", func() {
+		clientSet := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      PausedNodeGroupsConfigMapName,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: map[string]string{
+				"nodegroups": `[{"name": "ng-1", "desiredCapacity": 2, "minSize": 1, "maxSize": 4}, {"name": "ng-old", "desiredCapacity": 1, "minSize": 1, "maxSize": 1}]`,
+			},
+		})
+
+		paused, found, err := LoadPausedNodeGroups(clientSet)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(found).To(BeTrue())
+		Expect(paused).To(HaveLen(2))
+		Expect(*paused[0]).To(Equal(PausedNodeGroup{Name: "ng-1", DesiredCapacity: 2, MinSize: 1, MaxSize: 4}))
+
+		tasks, err := c.NewTasksToResumeCluster(cfg, clientSet, stackManager)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(tasks.Describe()).To(Equal(`2 sequential tasks: { scale nodegroup "ng-1", delete ConfigMap "eksctl-paused-nodegroups" }`))
+	})
+
+	It("doesn't resume a cluster that isn't paused", func() {
+		_, err := c.NewTasksToResumeCluster(cfg, fake.NewSimpleClientset(), stackManager)
+		Expect(err).To(MatchError(`cluster "test-cluster" isn't paused, ConfigMap "eksctl-paused-nodegroups" not found`))
+	})
+})
+
+func pausedClusterConfigMap(nodeGroups, workloads string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      PausedNodeGroupsConfigMapName,
+			Namespace: metav1.NamespaceSystem,
+		},
+		Data: map[string]string{
+			"nodegroups": nodeGroups,
+			"workloads":  workloads,
+		},
+	}
+}
+
+func deployment(namespace, name string, replicas int32) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	}
+}
+
+func statefulSet(namespace, name string, replicas int32) *appsv1.StatefulSet {
+	return &appsv1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+		Spec:       appsv1.StatefulSetSpec{Replicas: &replicas},
+	}
+}
@@ -0,0 +1,176 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// PodIdentityAssociationSummary holds the fields of a live pod identity association
+type PodIdentityAssociationSummary struct {
+	AssociationID      string
+	Namespace          string
+	ServiceAccountName string
+	RoleARN            string
+}
+
+// NameString returns the <namespace>/<serviceAccountName> of the association
+func (s *PodIdentityAssociationSummary) NameString() string {
+	return s.Namespace + "/" + s.ServiceAccountName
+}
+
+// ListPodIdentityAssociations returns all pod identity associations of the cluster, without their roles
+func (c *ClusterProvider) ListPodIdentityAssociations(cfg *api.ClusterConfig) ([]*PodIdentityAssociationSummary, error) {
+	associations := []*PodIdentityAssociationSummary{}
+	input := &awseks.ListPodIdentityAssociationsInput{
+		ClusterName: &cfg.Metadata.Name,
+	}
+	err := c.Provider.EKS().ListPodIdentityAssociationsPages(input, func(output *awseks.ListPodIdentityAssociationsOutput, _ bool) bool {
+		for _, association := range output.Associations {
+			associations = append(associations, &PodIdentityAssociationSummary{
+				AssociationID:      aws.StringValue(association.AssociationId),
+				Namespace:          aws.StringValue(association.Namespace),
+				ServiceAccountName: aws.StringValue(association.ServiceAccount),
+			})
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing pod identity associations of cluster %q", cfg.Metadata.Name)
+	}
+	return associations, nil
+}
+
+// DescribePodIdentityAssociations returns the pod identity associations of the cluster along with their roles
+func (c *ClusterProvider) DescribePodIdentityAssociations(cfg *api.ClusterConfig) ([]*PodIdentityAssociationSummary, error) {
+	associations, err := c.ListPodIdentityAssociations(cfg)
+	if err != nil {
+		return nil, err
+	}
+	for _, association := range associations {
+		output, err := c.Provider.EKS().DescribePodIdentityAssociation(&awseks.DescribePodIdentityAssociationInput{
+			ClusterName:   &cfg.Metadata.Name,
+			AssociationId: &association.AssociationID,
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing pod identity association %q", association.NameString())
+		}
+		association.RoleARN = aws.StringValue(output.Association.RoleArn)
+	}
+	return associations, nil
+}
+
+// EnsurePodIdentityAgent installs the EKS Pod Identity Agent addon unless it's installed already,
+// without it pods don't get the credentials of their associations
+func (c *ClusterProvider) EnsurePodIdentityAgent(cfg *api.ClusterConfig) error {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	addonManager, err := c.NewEKSAddonManager(cfg)
+	if err != nil {
+		return err
+	}
+	exists, err := addonManager.Exists(api.PodIdentityAgentAddon)
+	if err != nil || exists {
+		return err
+	}
+	return addonManager.Create(&api.Addon{Name: api.PodIdentityAgentAddon})
+}
+
+// CreatePodIdentityAssociation creates a pod identity association, along with the stack
+// of its IAM role unless the ARN of an existing role is given
+func (c *ClusterProvider) CreatePodIdentityAssociation(cfg *api.ClusterConfig, association *api.PodIdentityAssociation) error {
+	if association.RoleARN == "" {
+		if err := c.NewStackManager(cfg).CreatePodIdentityRole(association); err != nil {
+			return errors.Wrapf(err, "creating IAM role for pod identity association %q", association.NameString())
+		}
+	}
+	_, err := c.Provider.EKS().CreatePodIdentityAssociation(&awseks.CreatePodIdentityAssociationInput{
+		ClusterName:    &cfg.Metadata.Name,
+		Namespace:      &association.Namespace,
+		ServiceAccount: &association.ServiceAccountName,
+		RoleArn:        &association.RoleARN,
+	})
+	if err != nil {
+		return errors.Wrapf(err, "creating pod identity association %q", association.NameString())
+	}
+	logger.Info("created pod identity association %q", association.NameString())
+	return nil
+}
+
+// CreatePodIdentityAssociations creates the pod identity associations of the config that don't exist yet
+func (c *ClusterProvider) CreatePodIdentityAssociations(cfg *api.ClusterConfig) error {
+	existing, err := c.ListPodIdentityAssociations(cfg)
+	if err != nil {
+		return err
+	}
+	for _, association := range MissingPodIdentityAssociations(existing, cfg.IAM.PodIdentityAssociations) {
+		if err := c.CreatePodIdentityAssociation(cfg, association); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// MissingPodIdentityAssociations returns the associations of service accounts that don't have one yet
+func MissingPodIdentityAssociations(existing []*PodIdentityAssociationSummary, associations []*api.PodIdentityAssociation) []*api.PodIdentityAssociation {
+	names := sets.NewString()
+	for _, association := range existing {
+		names.Insert(association.NameString())
+	}
+	missing := []*api.PodIdentityAssociation{}
+	for _, association := range associations {
+		if !names.Has(association.NameString()) {
+			missing = append(missing, association)
+		}
+	}
+	return missing
+}
+
+// DeletePodIdentityAssociation deletes the pod identity association of a service account, along with
+// the stack of its IAM role if eksctl created one
+func (c *ClusterProvider) DeletePodIdentityAssociation(cfg *api.ClusterConfig, namespace, serviceAccountName string) error {
+	existing, err := c.ListPodIdentityAssociations(cfg)
+	if err != nil {
+		return err
+	}
+	name := namespace + "/" + serviceAccountName
+	for _, association := range existing {
+		if association.NameString() != name {
+			continue
+		}
+		_, err := c.Provider.EKS().DeletePodIdentityAssociation(&awseks.DeletePodIdentityAssociationInput{
+			ClusterName:   &cfg.Metadata.Name,
+			AssociationId: &association.AssociationID,
+		})
+		if err != nil {
+			return errors.Wrapf(err, "deleting pod identity association %q", name)
+		}
+		logger.Info("deleted pod identity association %q", name)
+		return c.NewStackManager(cfg).DeletePodIdentityRole(namespace, serviceAccountName)
+	}
+	return fmt.Errorf("pod identity association %q not found", name)
+}
+
+// PodIdentityAssociationsFromIAMServiceAccounts converts iamserviceaccounts to pod identity associations
+// whose roles have the same policies; role names are not copied, as the roles of the iamserviceaccounts
+// still exist until they are deleted
+func PodIdentityAssociationsFromIAMServiceAccounts(serviceAccounts []*api.ClusterIAMServiceAccount) []*api.PodIdentityAssociation {
+	associations := []*api.PodIdentityAssociation{}
+	for _, sa := range serviceAccounts {
+		associations = append(associations, &api.PodIdentityAssociation{
+			Namespace:           sa.Namespace,
+			ServiceAccountName:  sa.Name,
+			PermissionsBoundary: sa.PermissionsBoundary,
+			AttachPolicyARNs:    sa.AttachPolicyARNs,
+			AttachPolicy:        sa.AttachPolicy,
+		})
+	}
+	return associations
+}
@@ -0,0 +1,94 @@
+package eks_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("pod identity associations", func() {
+	It("converts iamserviceaccounts", func() {
+		sa := &api.ClusterIAMServiceAccount{
+			RoleName:            "s3-reader",
+			PermissionsBoundary: "arn:aws:iam::123456789012:policy/boundary",
+			AttachPolicyARNs:    []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+		}
+		sa.Name = "sa-1"
+		sa.Namespace = "ns-1"
+
+		Expect(PodIdentityAssociationsFromIAMServiceAccounts([]*api.ClusterIAMServiceAccount{sa})).To(Equal([]*api.PodIdentityAssociation{{
+			Namespace:           "ns-1",
+			ServiceAccountName:  "sa-1",
+			PermissionsBoundary: "arn:aws:iam::123456789012:policy/boundary",
+			AttachPolicyARNs:    []string{"arn:aws:iam::aws:policy/AmazonS3ReadOnlyAccess"},
+		}}))
+	})
+
+	It("finds the associations that are missing", func() {
+		associations := []*api.PodIdentityAssociation{
+			{Namespace: "default", ServiceAccountName: "a"},
+			{Namespace: "kube-system", ServiceAccountName: "a"},
+		}
+		existing := []*PodIdentityAssociationSummary{{Namespace: "default", ServiceAccountName: "a"}}
+		Expect(MissingPodIdentityAssociations(existing, associations)).To(Equal(associations[1:]))
+	})
+
+	Context("with a cluster", func() {
+		var (
+			p   *mockprovider.MockProvider
+			ctl *ClusterProvider
+			cfg *api.ClusterConfig
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+			ctl = &ClusterProvider{Provider: p, Status: &ProviderStatus{}}
+
+			cfg = api.NewClusterConfig()
+			cfg.Metadata.Name = "test-cluster"
+
+			p.MockEKS().On("ListPodIdentityAssociationsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+				consume := args[1].(func(p *awseks.ListPodIdentityAssociationsOutput, last bool) bool)
+				consume(&awseks.ListPodIdentityAssociationsOutput{Associations: []*awseks.PodIdentityAssociationSummary{{
+					AssociationId:  aws.String("a-1"),
+					Namespace:      aws.String("default"),
+					ServiceAccount: aws.String("existing"),
+				}}}, true)
+			}).Return(nil)
+		})
+
+		It("creates associations with existing roles without creating stacks", func() {
+			p.MockEKS().On("CreatePodIdentityAssociation", mock.Anything).Return(&awseks.CreatePodIdentityAssociationOutput{}, nil)
+
+			cfg.IAM.PodIdentityAssociations = []*api.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "existing", RoleARN: "arn:aws:iam::123456789012:role/existing"},
+				{Namespace: "default", ServiceAccountName: "new", RoleARN: "arn:aws:iam::123456789012:role/new"},
+			}
+			Expect(ctl.CreatePodIdentityAssociations(cfg)).To(Succeed())
+
+			p.MockEKS().AssertNumberOfCalls(GinkgoT(), "CreatePodIdentityAssociation", 1)
+			p.MockCloudFormation().AssertNotCalled(GinkgoT(), "CreateStack", mock.Anything)
+			var input *awseks.CreatePodIdentityAssociationInput
+			for _, call := range p.MockEKS().Calls {
+				if i, ok := call.Arguments[0].(*awseks.CreatePodIdentityAssociationInput); ok {
+					input = i
+				}
+			}
+			Expect(input).NotTo(BeNil())
+			Expect(*input.ServiceAccount).To(Equal("new"))
+			Expect(*input.RoleArn).To(Equal("arn:aws:iam::123456789012:role/new"))
+		})
+
+		It("fails to delete an association that doesn't exist", func() {
+			err := ctl.DeletePodIdentityAssociation(cfg, "default", "missing")
+			Expect(err).To(MatchError(`pod identity association "default/missing" not found`))
+			p.MockEKS().AssertNotCalled(GinkgoT(), "DeletePodIdentityAssociation", mock.Anything)
+		})
+	})
+})
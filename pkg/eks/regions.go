@@ -0,0 +1,121 @@
+package eks
+
+import (
+	"sort"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/service/ec2"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// maxConcurrentRegions limits how many regions are queried at once, so that
+// requests of an account don't get throttled
+const maxConcurrentRegions = 4
+
+// EnabledRegions returns the supported regions that are enabled in the account,
+// or all supported regions if the enabled ones can't be described; only the regions
+// of the partition of the current region are returned, as the credentials of one
+// partition aren't valid in another
+func (c *ClusterProvider) EnabledRegions() []string {
+	regions := []string{}
+	partition := api.Partition(c.Provider.Region())
+	for _, region := range api.SupportedRegions() {
+		if api.Partition(region) == partition {
+			regions = append(regions, region)
+		}
+	}
+
+	output, err := c.Provider.EC2().DescribeRegions(&ec2.DescribeRegionsInput{})
+	if err != nil {
+		logger.Debug("cannot describe enabled regions, using all supported regions: %s", err.Error())
+		return regions
+	}
+	enabled := map[string]bool{}
+	for _, r := range output.Regions {
+		enabled[*r.RegionName] = true
+	}
+	enabledRegions := []string{}
+	for _, region := range regions {
+		if enabled[region] {
+			enabledRegions = append(enabledRegions, region)
+		}
+	}
+	return enabledRegions
+}
+
+// ForEachRegion calls fn with a provider for each of the regions, for at most maxConcurrentRegions
+// regions at once; the errors are returned by region
+func (c *ClusterProvider) ForEachRegion(regions []string, fn func(*ClusterProvider) error) map[string]error {
+	return forEachRegion(regions, c.forRegion, fn)
+}
+
+func (c *ClusterProvider) forRegion(region string) *ClusterProvider {
+	spec := &api.ProviderConfig{
+		Profile:     c.Provider.Profile(),
+		WaitTimeout: c.Provider.WaitTimeout(),
+	}
+	if p, ok := c.Provider.(*ProviderServices); ok {
+		// keep the roles to assume
+		spec = p.spec.DeepCopy()
+	}
+	spec.Region = region
+	return New(spec, nil)
+}
+
+func forEachRegion(regions []string, newProvider func(string) *ClusterProvider, fn func(*ClusterProvider) error) map[string]error {
+	var (
+		wg    sync.WaitGroup
+		mutex sync.Mutex
+		errs  = map[string]error{}
+	)
+	limit := make(chan struct{}, maxConcurrentRegions)
+	for _, region := range regions {
+		wg.Add(1)
+		go func(region string) {
+			defer wg.Done()
+			limit <- struct{}{}
+			defer func() { <-limit }()
+			if err := fn(newProvider(region)); err != nil {
+				mutex.Lock()
+				errs[region] = err
+				mutex.Unlock()
+			}
+		}(region)
+	}
+	wg.Wait()
+	return errs
+}
+
+// ListEksctlClusters lists the clusters that have eksctl-managed CloudFormation stacks in the regions,
+// including the ones with deleted control planes, so that their stacks can be deleted as well
+func (c *ClusterProvider) ListEksctlClusters(regions []string) ([]*api.ClusterMeta, map[string]error) {
+	var (
+		mutex    sync.Mutex
+		clusters = []*api.ClusterMeta{}
+	)
+	errs := c.ForEachRegion(regions, func(ctl *ClusterProvider) error {
+		names, err := ctl.NewStackManager(api.NewClusterConfig()).ListClusterNames()
+		if err != nil {
+			return err
+		}
+		mutex.Lock()
+		defer mutex.Unlock()
+		for _, name := range names {
+			clusters = append(clusters, &api.ClusterMeta{Name: name, Region: ctl.Provider.Region()})
+		}
+		return nil
+	})
+	sortClusters(clusters)
+	return clusters, errs
+}
+
+func sortClusters(clusters []*api.ClusterMeta) {
+	sort.Slice(clusters, func(i, j int) bool {
+		if clusters[i].Region != clusters[j].Region {
+			return clusters[i].Region < clusters[j].Region
+		}
+		return clusters[i].Name < clusters[j].Name
+	})
+}
@@ -0,0 +1,43 @@
+package eks_test
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("regions", func() {
+	var (
+		p   *mockprovider.MockProvider
+		ctl *ClusterProvider
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		ctl = &ClusterProvider{Provider: p, Status: &ProviderStatus{}}
+	})
+
+	It("lists the supported regions that are enabled", func() {
+		p.MockEC2().On("DescribeRegions", mock.Anything).Return(&ec2.DescribeRegionsOutput{Regions: []*ec2.Region{
+			{RegionName: aws.String(api.RegionEUWest1)},
+			{RegionName: aws.String("us-gov-west-1")},
+			{RegionName: aws.String(api.RegionUSWest2)},
+		}}, nil)
+		Expect(ctl.EnabledRegions()).To(Equal([]string{api.RegionUSWest2, api.RegionEUWest1}))
+	})
+
+	It("falls back to all supported regions", func() {
+		p.MockEC2().On("DescribeRegions", mock.Anything).Return(nil, fmt.Errorf("access denied"))
+		Expect(ctl.EnabledRegions()).To(ContainElement(api.RegionUSWest2))
+		Expect(ctl.EnabledRegions()).NotTo(ContainElement(api.RegionCNNorth1))
+		Expect(ctl.EnabledRegions()).NotTo(ContainElement(api.RegionUSGovWest1))
+	})
+})
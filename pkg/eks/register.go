@@ -0,0 +1,91 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+// LoadConfigFromControlPlane sets the config of a cluster that wasn't created by eksctl,
+// e.g. with the console or Terraform, from the EKS API and introspection of its VPC
+func (c *ClusterProvider) LoadConfigFromControlPlane(cfg *api.ClusterConfig) error {
+	cluster, err := c.loadControlPlaneConfig(cfg)
+	if err != nil {
+		return err
+	}
+	cfg.IAM.ServiceRoleARN = cluster.RoleArn
+
+	if err := vpc.UseFromControlPlane(c.Provider, cluster, cfg); err != nil {
+		return errors.Wrapf(err, "getting VPC configuration of cluster %q", cfg.Metadata.Name)
+	}
+
+	return c.loadOIDCConfig(cfg)
+}
+
+// loadControlPlaneConfig sets version, tags and logging of an active cluster
+func (c *ClusterProvider) loadControlPlaneConfig(cfg *api.ClusterConfig) (*awseks.Cluster, error) {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return nil, err
+	}
+	cluster := c.Status.clusterInfo.cluster
+
+	if status := aws.StringValue(cluster.Status); status != awseks.ClusterStatusActive {
+		return nil, fmt.Errorf("cluster %q is in %s state, only the config of active clusters can be loaded", cfg.Metadata.Name, status)
+	}
+
+	cfg.Metadata.Version = aws.StringValue(cluster.Version)
+	for key, value := range cluster.Tags {
+		// tags with the reserved prefix cannot be set on stacks,
+		// and the cluster name tags are always set by eksctl
+		if strings.HasPrefix(key, "aws:") || key == api.ClusterNameTag || key == api.OldClusterNameTag {
+			continue
+		}
+		if cfg.Metadata.Tags == nil {
+			cfg.Metadata.Tags = map[string]string{}
+		}
+		cfg.Metadata.Tags[key] = aws.StringValue(value)
+	}
+
+	if cluster.Logging != nil {
+		for _, logSetup := range cluster.Logging.ClusterLogging {
+			if api.IsEnabled(logSetup.Enabled) {
+				cfg.CloudWatch.ClusterLogging.EnableTypes = append(cfg.CloudWatch.ClusterLogging.EnableTypes, aws.StringValueSlice(logSetup.Types)...)
+			}
+		}
+	}
+
+	// a key that eksctl created is exported by its ARN, as it exists already
+	for _, encryption := range cluster.EncryptionConfig {
+		if encryption.Provider != nil && aws.StringValue(encryption.Provider.KeyArn) != "" {
+			cfg.SecretsEncryption = &api.SecretsEncryption{KeyARN: aws.StringValue(encryption.Provider.KeyArn)}
+		}
+	}
+	return cluster, nil
+}
+
+// loadOIDCConfig enables iam.withOIDC when the IAM OIDC provider of the cluster exists
+func (c *ClusterProvider) loadOIDCConfig(cfg *api.ClusterConfig) error {
+	oidc, err := c.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		if _, ok := err.(*UnsupportedOIDCError); !ok {
+			return err
+		}
+		logger.Debug("not checking IAM OIDC provider: %s", err.Error())
+		return nil
+	}
+	providerExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return err
+	}
+	if providerExists {
+		cfg.IAM.WithOIDC = api.Enabled()
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+package eks
+
+import (
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/request"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	minThrottleDelay = 100 * time.Millisecond
+	maxThrottleDelay = 5 * time.Second
+)
+
+// adaptiveRateLimiter spaces out calls to each AWS service after it throttled any of them,
+// so that heavy parallel operations, e.g. creating many nodegroups at once, slow down
+// instead of running out of retries; the delay between calls doubles on every throttling
+// error, and halves on every call that succeeds; it stands in for the adaptive retry mode of
+// aws-sdk-go-v2, which eksctl doesn't use yet, see docs/proposal-006-aws-sdk-go-v2.md
+type adaptiveRateLimiter struct {
+	mutex    sync.Mutex
+	services map[string]*serviceRate
+	now      func() time.Time
+	sleep    func(time.Duration)
+}
+
+type serviceRate struct {
+	delay time.Duration
+	next  time.Time
+}
+
+func newAdaptiveRateLimiter() *adaptiveRateLimiter {
+	return &adaptiveRateLimiter{
+		services: map[string]*serviceRate{},
+		now:      time.Now,
+		sleep:    time.Sleep,
+	}
+}
+
+func (l *adaptiveRateLimiter) addHandlers(handlers *request.Handlers) {
+	handlers.Sign.PushFrontNamed(request.NamedHandler{
+		Name: "eksctlAdaptiveRateLimit",
+		Fn:   l.wait,
+	})
+	handlers.AfterRetry.PushFrontNamed(request.NamedHandler{
+		Name: "eksctlAdaptiveRateSlowDown",
+		Fn: func(r *request.Request) {
+			if r.Error != nil && request.IsErrorThrottle(r.Error) {
+				l.adjust(r.ClientInfo.ServiceName, true)
+			}
+		},
+	})
+	handlers.Complete.PushFrontNamed(request.NamedHandler{
+		Name: "eksctlAdaptiveRateSpeedUp",
+		Fn: func(r *request.Request) {
+			if r.Error == nil {
+				l.adjust(r.ClientInfo.ServiceName, false)
+			}
+		},
+	})
+}
+
+// wait reserves the next slot for a call to the service of the request, and waits for it
+func (l *adaptiveRateLimiter) wait(r *request.Request) {
+	l.mutex.Lock()
+	rate, ok := l.services[r.ClientInfo.ServiceName]
+	if !ok || rate.delay == 0 {
+		l.mutex.Unlock()
+		return
+	}
+	now := l.now()
+	at := rate.next
+	if at.Before(now) {
+		at = now
+	}
+	rate.next = at.Add(rate.delay)
+	l.mutex.Unlock()
+
+	if wait := at.Sub(now); wait > 0 {
+		logger.Debug("waiting %v before calling %s/%s, as it throttled recent calls", wait, r.ClientInfo.ServiceName, operationName(r))
+		l.sleep(wait)
+	}
+}
+
+// adjust increases the delay between calls to the service when a call was throttled,
+// and decreases it when a call succeeded
+func (l *adaptiveRateLimiter) adjust(service string, throttled bool) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+	rate, ok := l.services[service]
+	if !ok {
+		if !throttled {
+			return
+		}
+		rate = &serviceRate{}
+		l.services[service] = rate
+	}
+	switch {
+	case throttled:
+		rate.delay *= 2
+		if rate.delay < minThrottleDelay {
+			rate.delay = minThrottleDelay
+		}
+		if rate.delay > maxThrottleDelay {
+			rate.delay = maxThrottleDelay
+		}
+	case rate.delay > 0:
+		rate.delay /= 2
+		if rate.delay < minThrottleDelay {
+			rate.delay = 0
+		}
+	}
+}
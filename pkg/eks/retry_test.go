@@ -0,0 +1,65 @@
+package eks
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/client/metadata"
+	"github.com/aws/aws-sdk-go/aws/request"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("adaptive rate limiter", func() {
+	var (
+		limiter *adaptiveRateLimiter
+		now     time.Time
+		slept   []time.Duration
+	)
+
+	call := func(service string, err error) {
+		r := &request.Request{ClientInfo: metadata.ClientInfo{ServiceName: service}, Operation: &request.Operation{Name: "DescribeStacks"}}
+		handlers := request.Handlers{}
+		limiter.addHandlers(&handlers)
+		handlers.Sign.Run(r)
+		r.Error = err
+		if err != nil {
+			handlers.AfterRetry.Run(r)
+		} else {
+			handlers.Complete.Run(r)
+		}
+	}
+	throttled := awserr.New("Throttling", "Rate exceeded", nil)
+
+	BeforeEach(func() {
+		limiter = newAdaptiveRateLimiter()
+		now = time.Now()
+		slept = nil
+		limiter.now = func() time.Time { return now }
+		limiter.sleep = func(d time.Duration) { slept = append(slept, d) }
+	})
+
+	It("doesn't delay calls until a service throttles them", func() {
+		call("cloudformation", nil)
+		call("cloudformation", nil)
+		Expect(slept).To(BeEmpty())
+	})
+
+	It("spaces out calls to a service that throttled, and speeds up again once they succeed", func() {
+		call("cloudformation", throttled)
+		call("cloudformation", throttled)
+		Expect(slept).To(BeEmpty())
+
+		call("cloudformation", nil)
+		call("cloudformation", nil)
+		Expect(slept).To(Equal([]time.Duration{100 * time.Millisecond, 300 * time.Millisecond}))
+
+		call("ec2", nil)
+		Expect(slept).To(HaveLen(2))
+
+		now = now.Add(time.Second)
+		call("cloudformation", nil)
+		call("cloudformation", nil)
+		Expect(slept).To(HaveLen(2))
+	})
+})
@@ -0,0 +1,187 @@
+package eks
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/pkg/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/drain"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const rollingUpdatePollInterval = 15 * time.Second
+
+// RollingUpdateOptions controls how instances of a nodegroup get replaced
+type RollingUpdateOptions struct {
+	// BatchSize is the number of instances replaced at a time
+	BatchSize int
+	// HealthCheckGracePeriod is the time to wait after the replacement
+	// nodes of a batch have become ready, before moving on to the next one
+	HealthCheckGracePeriod time.Duration
+}
+
+// RollingUpdateNodeGroup replaces all instances of the nodegroup in batches; nodes of each batch
+// get drained and their instances terminated, then the ASG launches replacements from the current
+// launch template, and these have to become ready before the next batch gets replaced
+func (c *ClusterProvider) RollingUpdateNodeGroup(clientSet kubernetes.Interface, ng *api.NodeGroup, asgName string, options RollingUpdateOptions) error {
+	if options.BatchSize < 1 {
+		return fmt.Errorf("batch size must be at least 1, got %d", options.BatchSize)
+	}
+
+	group, err := c.describeAutoScalingGroup(asgName)
+	if err != nil {
+		return err
+	}
+	instanceIDs := inServiceInstanceIDs(group, nil)
+	if len(instanceIDs) == 0 {
+		logger.Info("nodegroup %q has no instances to replace", ng.Name)
+		return nil
+	}
+	desiredCapacity := int(aws.Int64Value(group.DesiredCapacity))
+
+	logger.Info("will replace %d instance(s) of nodegroup %q, %d at a time", len(instanceIDs), ng.Name, options.BatchSize)
+
+	replaced := sets.NewString()
+	for start := 0; start < len(instanceIDs); start += options.BatchSize {
+		end := start + options.BatchSize
+		if end > len(instanceIDs) {
+			end = len(instanceIDs)
+		}
+		batch := instanceIDs[start:end]
+
+		nodes, err := nodesOfInstances(clientSet, ng, batch)
+		if err != nil {
+			return err
+		}
+		if len(nodes) > 0 {
+			if err := drain.Nodes(clientSet, nodes, c.Provider.WaitTimeout()); err != nil {
+				return errors.Wrapf(err, "draining nodes of instances %v", batch)
+			}
+		}
+
+		for _, id := range batch {
+			logger.Info("terminating instance %q", id)
+			_, err := c.Provider.ASG().TerminateInstanceInAutoScalingGroup(&autoscaling.TerminateInstanceInAutoScalingGroupInput{
+				InstanceId:                     aws.String(id),
+				ShouldDecrementDesiredCapacity: aws.Bool(false),
+			})
+			if err != nil {
+				return errors.Wrapf(err, "terminating instance %q", id)
+			}
+			replaced.Insert(id)
+		}
+
+		if err := c.waitForReplacementNodes(clientSet, ng, asgName, replaced, desiredCapacity); err != nil {
+			return err
+		}
+
+		if options.HealthCheckGracePeriod > 0 && end < len(instanceIDs) {
+			logger.Info("waiting %s before replacing the next batch of instances", options.HealthCheckGracePeriod)
+			select {
+			case <-time.After(options.HealthCheckGracePeriod):
+			case <-c.Context().Done():
+				return errors.Wrapf(c.Context().Err(), "rolling update of nodegroup %q", ng.Name)
+			}
+		}
+	}
+
+	logger.Success("replaced %d instance(s) of nodegroup %q", replaced.Len(), ng.Name)
+	return nil
+}
+
+func (c *ClusterProvider) describeAutoScalingGroup(name string) (*autoscaling.Group, error) {
+	output, err := c.Provider.ASG().DescribeAutoScalingGroups(&autoscaling.DescribeAutoScalingGroupsInput{
+		AutoScalingGroupNames: aws.StringSlice([]string{name}),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing ASG %q", name)
+	}
+	if len(output.AutoScalingGroups) != 1 {
+		return nil, fmt.Errorf("ASG %q not found", name)
+	}
+	return output.AutoScalingGroups[0], nil
+}
+
+// waitForReplacementNodes waits until the ASG has as many instances in service as desired,
+// excluding the ones that have been replaced, and nodes for all of them are ready
+func (c *ClusterProvider) waitForReplacementNodes(clientSet kubernetes.Interface, ng *api.NodeGroup, asgName string, replaced sets.String, desiredCapacity int) error {
+	logger.Info("waiting for %d node(s) to be ready in %q", desiredCapacity, ng.Name)
+	timer := time.After(c.Provider.WaitTimeout())
+	for {
+		group, err := c.describeAutoScalingGroup(asgName)
+		if err != nil {
+			return err
+		}
+		instanceIDs := inServiceInstanceIDs(group, replaced)
+		if len(instanceIDs) >= desiredCapacity {
+			nodes, err := nodesOfInstances(clientSet, ng, instanceIDs)
+			if err != nil {
+				return err
+			}
+			ready := 0
+			for i := range nodes {
+				if isNodeReady(&nodes[i]) {
+					ready++
+				}
+			}
+			if ready >= desiredCapacity {
+				return nil
+			}
+			logger.Debug("%d of %d node(s) are ready in %q", ready, desiredCapacity, ng.Name)
+		} else {
+			logger.Debug("%d of %d instance(s) are in service in ASG %q", len(instanceIDs), desiredCapacity, asgName)
+		}
+
+		select {
+		case <-timer:
+			return fmt.Errorf("timed out (after %s) waiting for %d replacement node(s) to become ready in %q", c.Provider.WaitTimeout(), desiredCapacity, ng.Name)
+		case <-c.Context().Done():
+			return errors.Wrapf(c.Context().Err(), "waiting for replacement nodes to become ready in %q", ng.Name)
+		case <-time.After(rollingUpdatePollInterval):
+		}
+	}
+}
+
+func inServiceInstanceIDs(group *autoscaling.Group, exclude sets.String) []string {
+	var ids []string
+	for _, instance := range group.Instances {
+		if aws.StringValue(instance.LifecycleState) != autoscaling.LifecycleStateInService {
+			continue
+		}
+		if id := aws.StringValue(instance.InstanceId); !exclude.Has(id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+// nodesOfInstances returns nodes of the nodegroup that run on the given instances
+func nodesOfInstances(clientSet kubernetes.Interface, ng *api.NodeGroup, instanceIDs []string) ([]corev1.Node, error) {
+	nodeList, err := clientSet.CoreV1().Nodes().List(ng.ListOptions())
+	if err != nil {
+		return nil, errors.Wrap(err, "listing nodes")
+	}
+	ids := sets.NewString(instanceIDs...)
+	var nodes []corev1.Node
+	for _, node := range nodeList.Items {
+		if ids.Has(instanceIDFromProviderID(node.Spec.ProviderID)) {
+			nodes = append(nodes, node)
+		}
+	}
+	return nodes, nil
+}
+
+// instanceIDFromProviderID extracts the instance ID from provider ID
+// of a node, which has the form of aws:///<availability-zone>/<instance-id>
+func instanceIDFromProviderID(providerID string) string {
+	return providerID[strings.LastIndex(providerID, "/")+1:]
+}
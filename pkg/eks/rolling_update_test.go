@@ -0,0 +1,97 @@
+package eks_test
+
+import (
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+func newReadyNode(ng *api.NodeGroup, instanceID string) *corev1.Node {
+	return &corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   instanceID + ".ec2.internal",
+			Labels: map[string]string{api.NodeGroupNameLabel: ng.Name},
+		},
+		Spec: corev1.NodeSpec{
+			ProviderID: "aws:///us-west-2a/" + instanceID,
+		},
+		Status: corev1.NodeStatus{
+			Conditions: []corev1.NodeCondition{{Type: corev1.NodeReady, Status: corev1.ConditionTrue}},
+		},
+	}
+}
+
+var _ = Describe("rolling update of nodegroups", func() {
+	var (
+		p         *mockprovider.MockProvider
+		c         *ClusterProvider
+		ng        *api.NodeGroup
+		clientSet *fake.Clientset
+		instances []string
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		c = &ClusterProvider{Provider: p}
+		ng = api.NewNodeGroup()
+		ng.Name = "ng-1"
+
+		instances = []string{"i-1", "i-2", "i-3"}
+		clientSet = fake.NewSimpleClientset(newReadyNode(ng, "i-1"), newReadyNode(ng, "i-2"), newReadyNode(ng, "i-3"))
+
+		p.MockASG().On("DescribeAutoScalingGroups", mock.Anything).Return(func(*autoscaling.DescribeAutoScalingGroupsInput) *autoscaling.DescribeAutoScalingGroupsOutput {
+			group := &autoscaling.Group{DesiredCapacity: aws.Int64(3)}
+			for _, id := range instances {
+				group.Instances = append(group.Instances, &autoscaling.Instance{
+					InstanceId:     aws.String(id),
+					LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+				})
+			}
+			return &autoscaling.DescribeAutoScalingGroupsOutput{AutoScalingGroups: []*autoscaling.Group{group}}
+		}, nil)
+
+		// the ASG replaces terminated instances straight away, and new nodes join the cluster
+		replacements := 0
+		p.MockASG().On("TerminateInstanceInAutoScalingGroup", mock.Anything).Run(func(args mock.Arguments) {
+			input := args.Get(0).(*autoscaling.TerminateInstanceInAutoScalingGroupInput)
+			Expect(*input.ShouldDecrementDesiredCapacity).To(BeFalse())
+			for i, id := range instances {
+				if id == *input.InstanceId {
+					replacements++
+					instances[i] = fmt.Sprintf("i-new-%d", replacements)
+					_, err := clientSet.CoreV1().Nodes().Create(newReadyNode(ng, instances[i]))
+					Expect(err).ToNot(HaveOccurred())
+				}
+			}
+		}).Return(&autoscaling.TerminateInstanceInAutoScalingGroupOutput{}, nil)
+	})
+
+	It("replaces all instances in batches", func() {
+		Expect(c.RollingUpdateNodeGroup(clientSet, ng, "asg-1", RollingUpdateOptions{BatchSize: 2})).To(Succeed())
+
+		Expect(instances).To(Equal([]string{"i-new-1", "i-new-2", "i-new-3"}))
+		p.MockASG().AssertNumberOfCalls(GinkgoT(), "TerminateInstanceInAutoScalingGroup", 3)
+
+		for _, id := range []string{"i-1", "i-2", "i-3"} {
+			node, err := clientSet.CoreV1().Nodes().Get(id+".ec2.internal", metav1.GetOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(node.Spec.Unschedulable).To(BeTrue())
+		}
+	})
+
+	It("rejects invalid batch size", func() {
+		Expect(c.RollingUpdateNodeGroup(clientSet, ng, "asg-1", RollingUpdateOptions{})).To(MatchError("batch size must be at least 1, got 0"))
+	})
+})
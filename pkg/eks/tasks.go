@@ -1,12 +1,19 @@
 package eks
 
 import (
-	"github.com/kris-nova/logger"
+	"fmt"
 
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
 	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/helm"
 	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/vpc"
 )
 
 type clusterConfigTask struct {
@@ -29,6 +36,20 @@ func (c *ClusterProvider) AppendExtraClusterConfigTasks(cfg *api.ClusterConfig,
 		Parallel:  false,
 		IsSubTask: true,
 	}
+	if cfg.UsesAccessEntries() {
+		newTasks.Append(&clusterConfigTask{
+			info: "set authentication mode",
+			spec: cfg,
+			call: c.UpdateAuthenticationMode,
+		})
+	}
+	if len(cfg.AccessEntries) > 0 {
+		newTasks.Append(&clusterConfigTask{
+			info: "create access entries",
+			spec: cfg,
+			call: c.CreateAccessEntries,
+		})
+	}
 	if !cfg.HasClusterCloudWatchLogging() {
 		logger.Info("CloudWatch logging will not be enabled for cluster %q in %q", cfg.Metadata.Name, cfg.Metadata.Region)
 		logger.Info("you can enable it with 'eksctl utils update-cluster-logging --region=%s --name=%s'", cfg.Metadata.Region, cfg.Metadata.Name)
@@ -40,15 +61,82 @@ func (c *ClusterProvider) AppendExtraClusterConfigTasks(cfg *api.ClusterConfig,
 			call: c.UpdateClusterConfigForLogging,
 		})
 	}
+	if cfg.HasFargateProfiles() {
+		newTasks.Append(&clusterConfigTask{
+			info: "create Fargate profiles",
+			spec: cfg,
+			call: c.CreateFargateProfiles,
+		})
+	}
 	if api.IsEnabled(cfg.IAM.WithOIDC) {
-		c.appendCreateTasksForIAMServiceAccounts(cfg, newTasks)
+		c.appendCreateTasksForIAMServiceAccounts(cfg, DesiredIAMServiceAccounts(cfg), newTasks)
+	}
+	if len(cfg.EKSAddons()) > 0 {
+		newTasks.Append(&clusterConfigTask{
+			info: "create EKS addons",
+			spec: cfg,
+			call: c.CreateEKSAddons,
+		})
+	}
+	if len(cfg.IAM.PodIdentityAssociations) > 0 {
+		// the pod identity agent is installed as an EKS addon, so this comes after the addons
+		newTasks.Append(&clusterConfigTask{
+			info: "create pod identity associations",
+			spec: cfg,
+			call: c.CreatePodIdentityAssociations,
+		})
+	}
+	if cfg.HasAddon(api.ClusterAutoscalerAddon) {
+		newTasks.Append(&clusterConfigTask{
+			info: "install cluster-autoscaler",
+			spec: cfg,
+			call: c.InstallClusterAutoscaler,
+		})
+	}
+	if cfg.HasAddon(api.ALBIngressAddon) {
+		newTasks.Append(&clusterConfigTask{
+			info: "install AWS Load Balancer Controller",
+			spec: cfg,
+			call: c.InstallALBIngressController,
+		})
+	}
+	if cfg.HasKarpenter() {
+		newTasks.Append(&clusterConfigTask{
+			info: "install Karpenter",
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				return c.InstallKarpenter(cfg, false)
+			},
+		})
+	}
+	if cfg.HasWindowsNodeGroup() {
+		newTasks.Append(&clusterConfigTask{
+			info: "install Windows VPC controller",
+			spec: cfg,
+			call: c.InstallVPCControllers,
+		})
 	}
 	if newTasks.Len() > 0 {
 		tasks.Append(newTasks)
 	}
 }
 
-func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(cfg *api.ClusterConfig, tasks *manager.TaskTree) {
+// DesiredIAMServiceAccounts returns the IAM service accounts of the config,
+// along with the ones that the addons of the cluster need
+func DesiredIAMServiceAccounts(cfg *api.ClusterConfig) []*api.ClusterIAMServiceAccount {
+	serviceAccounts := []*api.ClusterIAMServiceAccount{}
+	if cfg.HasAddon(api.ClusterAutoscalerAddon) {
+		serviceAccounts = append(serviceAccounts, addons.ClusterAutoscalerServiceAccount())
+	}
+	if cfg.HasAddon(api.ALBIngressAddon) {
+		serviceAccounts = append(serviceAccounts, addons.ALBIngressControllerServiceAccount())
+	}
+	return append(serviceAccounts, cfg.IAM.ServiceAccounts...)
+}
+
+// appendCreateTasksForIAMServiceAccounts adds tasks to associate the IAM OIDC provider
+// and then to create the given service accounts
+func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(cfg *api.ClusterConfig, serviceAccounts []*api.ClusterIAMServiceAccount, tasks *manager.TaskTree) {
 	// we don't have all the information to construct full iamoidc.OpenIDConnectManager now,
 	// instead we just create a reference that gets updated when first task runs, and gets
 	// used by this would be more elegant if it was all done via CloudFormation and we didn't
@@ -83,7 +171,208 @@ func (c *ClusterProvider) appendCreateTasksForIAMServiceAccounts(cfg *api.Cluste
 	// as this is non-CloudFormation context, we need to construct a new stackManager,
 	// given a clientSet getter and OpenIDConnectManager reference we can build out
 	// the list of tasks for each of the service accounts that need to be created
-	newTasks := c.NewStackManager(cfg).NewTasksToCreateIAMServiceAccounts(cfg.IAM.ServiceAccounts, eatlyOIDC, clientSet)
+	newTasks := c.NewStackManager(cfg).NewTasksToCreateIAMServiceAccounts(serviceAccounts, eatlyOIDC, clientSet)
 	newTasks.IsSubTask = true
 	tasks.Append(newTasks)
 }
+
+// NewTaskToConfigureCustomNetworking returns a task that configures VPC CNI to use the pod subnets,
+// it needs to run before any nodes join the cluster, so that pods on all nodes get IPs from pod subnets
+func (c *ClusterProvider) NewTaskToConfigureCustomNetworking(cfg *api.ClusterConfig) manager.Task {
+	return &clusterConfigTask{
+		info: "configure custom networking for pods",
+		spec: cfg,
+		call: c.ConfigureCustomNetworking,
+	}
+}
+
+// ConfigureCustomNetworking creates ENIConfigs for the pod subnets and enables custom networking on aws-node
+func (c *ClusterProvider) ConfigureCustomNetworking(cfg *api.ClusterConfig) error {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	clientSet, err := c.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+	dynamicClient, err := c.NewDynamicClient(cfg)
+	if err != nil {
+		return err
+	}
+	return addons.NewCustomNetworking(clientSet, dynamicClient).Deploy(cfg)
+}
+
+// InstallVPCControllers installs the VPC resource controller and admission webhook,
+// these are needed for pods on Windows nodes to get IP addresses
+func (c *ClusterProvider) InstallVPCControllers(cfg *api.ClusterConfig) error {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	rawClient, err := c.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	return addons.NewVPCController(rawClient, cfg.Metadata.Region, false).Deploy()
+}
+
+// InstallClusterAutoscaler deploys cluster-autoscaler matching the version of the control plane,
+// its IAM service account is expected to be created beforehand
+func (c *ClusterProvider) InstallClusterAutoscaler(cfg *api.ClusterConfig) error {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	rawClient, err := c.NewRawClient(cfg)
+	if err != nil {
+		return err
+	}
+	return addons.NewClusterAutoscaler(rawClient, cfg, c.ControlPlaneVersion(), false).Deploy()
+}
+
+// InstallALBIngressController tags subnets of the cluster for load balancers and installs the Helm chart
+// of the AWS Load Balancer Controller, its IAM service account is expected to be created beforehand
+func (c *ClusterProvider) InstallALBIngressController(cfg *api.ClusterConfig) error {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	if err := vpc.TagSubnetsForLoadBalancers(c.Provider, cfg); err != nil {
+		return err
+	}
+	client, err := c.NewClient(cfg)
+	if err != nil {
+		return err
+	}
+	installer := helm.NewInstaller(client.Config, c.Provider.WaitTimeout())
+	return addons.NewALBIngressController(installer, cfg, c.ControlPlaneVersion(), false).Deploy()
+}
+
+// InstallKarpenter creates the stack of the resources that Karpenter needs, the IAM service account
+// of its controller, tags the subnets and security group of the cluster for discovery, maps the role
+// of its nodes in the auth ConfigMap and installs the Helm chart of Karpenter; the IAM OIDC provider is expected to exist
+func (c *ClusterProvider) InstallKarpenter(cfg *api.ClusterConfig, plan bool) error {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	if version := c.ControlPlaneVersion(); !api.IsVersionAtLeast(version, api.MinimumVersionForKarpenter) {
+		return fmt.Errorf("karpenter is only supported by Kubernetes %s or later, cluster %q runs %s", api.MinimumVersionForKarpenter, cfg.Metadata.Name, version)
+	}
+
+	stackManager := c.NewStackManager(cfg)
+	resources, err := stackManager.EnsureKarpenterStack(plan)
+	if err != nil {
+		return errors.Wrap(err, "creating Karpenter stack")
+	}
+	if resources == nil {
+		logger.Info("(plan) would create IAM service account %q, tag subnets and security group for discovery and deploy Karpenter", "karpenter/karpenter")
+		return nil
+	}
+
+	clientSet, err := c.NewStdClientSet(cfg)
+	if err != nil {
+		return err
+	}
+
+	serviceAccount := addons.KarpenterServiceAccount(resources.ControllerPolicyARN)
+	existingServiceAccounts, err := stackManager.ListIAMServiceAccountStacks()
+	if err != nil {
+		return err
+	}
+	serviceAccounts := []*api.ClusterIAMServiceAccount{serviceAccount}
+	for _, name := range existingServiceAccounts {
+		if name == serviceAccount.NameString() {
+			logger.Info("IAM role for serviceaccount %q already exists", name)
+			serviceAccounts = nil
+		}
+	}
+
+	oidc, err := c.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return err
+	}
+	tasks := stackManager.NewTasksToCreateIAMServiceAccounts(serviceAccounts, oidc, kubernetes.NewCachedClientSet(clientSet))
+	tasks.PlanMode = plan
+	if errs := tasks.DoAllSync(); len(errs) > 0 {
+		for _, err := range errs {
+			logger.Critical("%s\n", err.Error())
+		}
+		return fmt.Errorf("failed to create IAM role for Karpenter")
+	}
+
+	if !plan {
+		if err := vpc.TagForKarpenterDiscovery(c.Provider, cfg); err != nil {
+			return err
+		}
+		if err := authconfigmap.AddNodeRole(clientSet, resources.NodeRoleARN); err != nil {
+			return err
+		}
+	}
+
+	client, err := c.NewClient(cfg)
+	if err != nil {
+		return err
+	}
+	installer := helm.NewInstaller(client.Config, c.Provider.WaitTimeout())
+	return addons.NewKarpenter(installer, cfg, resources, plan).Deploy()
+}
+
+// NewTasksToInstallHelmReleases defines tasks to install the Helm charts of postCreate.helmReleases in order,
+// they are expected to run once the nodes of the cluster are ready
+func (c *ClusterProvider) NewTasksToInstallHelmReleases(cfg *api.ClusterConfig) *manager.TaskTree {
+	tasks := &manager.TaskTree{Parallel: false}
+	if !cfg.HasHelmReleases() {
+		return tasks
+	}
+	for _, r := range cfg.PostCreate.HelmReleases {
+		release := r
+		tasks.Append(&clusterConfigTask{
+			info: fmt.Sprintf("install Helm release %q", release.Name),
+			spec: cfg,
+			call: func(cfg *api.ClusterConfig) error {
+				return c.InstallHelmRelease(cfg, release)
+			},
+		})
+	}
+	return tasks
+}
+
+// InstallHelmRelease installs the Helm chart of the release to the cluster
+func (c *ClusterProvider) InstallHelmRelease(cfg *api.ClusterConfig, release *api.HelmRelease) error {
+	client, err := c.NewClient(cfg)
+	if err != nil {
+		return err
+	}
+	return helm.NewInstaller(client.Config, c.Provider.WaitTimeout()).Install(release)
+}
+
+// NewEKSAddonManager returns a manager of addons of the cluster that are managed via EKS Addons API,
+// it's able to create IAM roles for the addons when the cluster has IAM OIDC provider
+func (c *ClusterProvider) NewEKSAddonManager(cfg *api.ClusterConfig) (*addons.EKSAddonManager, error) {
+	oidc, err := c.NewOpenIDConnectManager(cfg)
+	if err != nil {
+		return nil, err
+	}
+	providerExists, err := oidc.CheckProviderExists()
+	if err != nil {
+		return nil, err
+	}
+	if !providerExists {
+		oidc = nil
+	}
+	return addons.NewEKSAddonManager(c.Provider, c.NewStackManager(cfg), oidc, cfg.Metadata.Name), nil
+}
+
+// CreateEKSAddons creates all addons of the cluster that are managed via EKS Addons API
+func (c *ClusterProvider) CreateEKSAddons(cfg *api.ClusterConfig) error {
+	if err := c.RefreshClusterStatus(cfg); err != nil {
+		return err
+	}
+	addonManager, err := c.NewEKSAddonManager(cfg)
+	if err != nil {
+		return err
+	}
+	for _, addon := range cfg.EKSAddons() {
+		if err := addonManager.Create(addon); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,205 @@
+package eks
+
+import (
+	"fmt"
+
+	"github.com/blang/semver"
+	"github.com/pkg/errors"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/weaveworks/eksctl/pkg/addons"
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// maxKubeletSkew is how many minor versions kubelet and kube-proxy may be behind the control plane
+const maxKubeletSkew = 2
+
+// VersionSkew holds what would be out of the Kubernetes version skew policy after upgrading the control plane
+type VersionSkew struct {
+	// Offenders describes each nodegroup and addon that would be out of the policy
+	Offenders []string
+	// Remediation lists the commands that bring the offenders within the policy, in the order they should be run
+	Remediation []string
+}
+
+// CheckVersionSkew checks that the kubelets of the nodegroups and kube-proxy would be at most maxKubeletSkew minor
+// versions behind targetVersion, and that coredns runs at least the version that eksctl deploys for currentVersion,
+// as the versions of coredns that eksctl deploys for later Kubernetes versions assume it; nodegroups without nodes
+// and aws-node, whose manifest is the same for all versions, aren't checked; eksAddons maps the names of the addons
+// that are managed via EKS Addons API to their default version for currentVersion, which may be empty if unknown,
+// they are updated with `eksctl update addon` rather than `eksctl utils update-*`; versions are compared on their
+// major, minor and patch numbers only, so that e.g. v1.8.7-eksbuild.2 isn't taken for a prerelease of v1.8.7
+func CheckVersionSkew(clusterName, currentVersion, targetVersion string, nodeGroups, managedNodeGroups []*manager.NodeGroupSummary, defaultAddons []*defaultaddons.Version, eksAddons map[string]string) (*VersionSkew, error) {
+	target, err := parseVersion(targetVersion)
+	if err != nil {
+		return nil, errors.Wrapf(err, "parsing target version %q", targetVersion)
+	}
+	skew := &VersionSkew{}
+
+	var replace, upgrade []string
+	checkNodeGroups := func(summaries []*manager.NodeGroupSummary, offenders *[]string) error {
+		for _, summary := range summaries {
+			oldest, oldestParsed, err := oldestVersion(summary.KubeletVersions)
+			if err != nil {
+				return errors.Wrapf(err, "parsing kubelet versions of nodegroup %q", summary.Name)
+			}
+			if oldest == "" || minorsBehind(oldestParsed, target) <= maxKubeletSkew {
+				continue
+			}
+			skew.Offenders = append(skew.Offenders, fmt.Sprintf("nodegroup %q runs kubelet %s, which would be %d minor versions behind %s, at most %d are supported",
+				summary.Name, oldest, minorsBehind(oldestParsed, target), targetVersion, maxKubeletSkew))
+			*offenders = append(*offenders, summary.Name)
+		}
+		return nil
+	}
+	if err := checkNodeGroups(nodeGroups, &replace); err != nil {
+		return nil, err
+	}
+	if err := checkNodeGroups(managedNodeGroups, &upgrade); err != nil {
+		return nil, err
+	}
+
+	var updateAddons []string
+	for _, addon := range defaultAddons {
+		current, err := parseVersion(addon.Current)
+		if err != nil {
+			return nil, errors.Wrapf(err, "parsing version %q of %q", addon.Current, addon.Name)
+		}
+		switch addon.Name {
+		case defaultaddons.KubeProxy:
+			if minorsBehind(current, target) > maxKubeletSkew {
+				skew.Offenders = append(skew.Offenders, fmt.Sprintf("%s runs %s, which would be %d minor versions behind %s, at most %d are supported",
+					addon.Name, addon.Current, minorsBehind(current, target), targetVersion, maxKubeletSkew))
+				updateAddons = append(updateAddons, addon.Name)
+			}
+		case defaultaddons.CoreDNS:
+			defaultVersion, err := parseVersion(addon.Default)
+			if err != nil {
+				return nil, errors.Wrapf(err, "parsing version %q of %q", addon.Default, addon.Name)
+			}
+			if current.LT(defaultVersion) {
+				skew.Offenders = append(skew.Offenders, fmt.Sprintf("%s runs %s, which is older than %s, the version for Kubernetes %s",
+					addon.Name, addon.Current, addon.Default, currentVersion))
+				updateAddons = append(updateAddons, addon.Name)
+			}
+		}
+	}
+
+	// nodes go first, as kube-proxy must not be newer than the kubelets it runs along
+	for _, ng := range upgrade {
+		skew.Remediation = append(skew.Remediation, fmt.Sprintf("eksctl upgrade nodegroup --cluster=%s --name=%s", clusterName, ng))
+	}
+	for _, ng := range replace {
+		skew.Remediation = append(skew.Remediation, fmt.Sprintf("eksctl create nodegroup --cluster=%s, to replace nodegroup %q, then eksctl delete nodegroup --cluster=%s --name=%s", clusterName, ng, clusterName, ng))
+	}
+	for _, addon := range updateAddons {
+		defaultVersion, ok := eksAddons[addon]
+		switch {
+		case !ok:
+			skew.Remediation = append(skew.Remediation, fmt.Sprintf("eksctl utils update-%s --name=%s --approve", addon, clusterName))
+		case defaultVersion != "":
+			skew.Remediation = append(skew.Remediation, fmt.Sprintf("eksctl update addon --cluster=%s --name=%s --version=%s", clusterName, addon, defaultVersion))
+		default:
+			skew.Remediation = append(skew.Remediation, fmt.Sprintf("eksctl update addon --cluster=%s --name=%s --version=<version>, see 'eksctl get addon --cluster=%s' for the versions", clusterName, addon, clusterName))
+		}
+	}
+	return skew, nil
+}
+
+// ValidateVersionSkew refuses to upgrade the control plane to targetVersion when nodegroups or default addons would
+// be out of the Kubernetes version skew policy, it logs the offenders and the commands that fix them
+func (c *ClusterProvider) ValidateVersionSkew(cfg *api.ClusterConfig, stackManager *manager.StackCollection, clientSet kubernetes.Interface, targetVersion string) error {
+	currentVersion := c.ControlPlaneVersion()
+
+	nodeGroups, err := stackManager.GetNodeGroupSummaries("")
+	if err != nil {
+		return errors.Wrap(err, "getting nodegroup stack summaries")
+	}
+	managedNodeGroups, err := stackManager.GetManagedNodeGroupSummaries("")
+	if err != nil {
+		return errors.Wrap(err, "getting managed nodegroup summaries")
+	}
+	if err := c.SetNodeGroupLiveStatus(stackManager, append(nodeGroups, managedNodeGroups...), clientSet); err != nil {
+		return err
+	}
+	defaultAddons, err := defaultaddons.GetVersions(clientSet, currentVersion)
+	if err != nil {
+		return errors.Wrap(err, "getting versions of default addons")
+	}
+	eksAddons, err := c.eksAddonDefaultVersions(cfg, stackManager, currentVersion)
+	if err != nil {
+		return err
+	}
+
+	skew, err := CheckVersionSkew(cfg.Metadata.Name, currentVersion, targetVersion, nodeGroups, managedNodeGroups, defaultAddons, eksAddons)
+	if err != nil {
+		return err
+	}
+	if len(skew.Offenders) == 0 {
+		logger.Info("all nodegroups and default addons are within the version skew policy for Kubernetes %s", targetVersion)
+		return nil
+	}
+
+	for _, offender := range skew.Offenders {
+		logger.Critical("%s", offender)
+	}
+	logger.Info("to bring them within the policy, run in order:")
+	for i, command := range skew.Remediation {
+		logger.Info("%d. %s", i+1, command)
+	}
+	return fmt.Errorf("refusing to upgrade cluster %q to %s, %d nodegroup(s) and addon(s) would be out of the version skew policy", cfg.Metadata.Name, targetVersion, len(skew.Offenders))
+}
+
+// eksAddonDefaultVersions maps the names of the addons that are managed via EKS Addons API to their default version
+// for kubernetesVersion
+func (c *ClusterProvider) eksAddonDefaultVersions(cfg *api.ClusterConfig, stackManager *manager.StackCollection, kubernetesVersion string) (map[string]string, error) {
+	addonManager := addons.NewEKSAddonManager(c.Provider, stackManager, nil, cfg.Metadata.Name)
+	summaries, err := addonManager.Get("")
+	if err != nil {
+		return nil, errors.Wrap(err, "getting addons managed via EKS Addons API")
+	}
+	updates, err := addonManager.GetUpdates(summaries, kubernetesVersion)
+	if err != nil {
+		return nil, err
+	}
+	defaultVersions := map[string]string{}
+	for _, update := range updates {
+		defaultVersions[update.Name] = update.DefaultVersion
+	}
+	return defaultVersions, nil
+}
+
+// parseVersion parses the major, minor and patch numbers of v, leaving out any prerelease and build metadata
+func parseVersion(v string) (semver.Version, error) {
+	parsed, err := semver.ParseTolerant(v)
+	if err != nil {
+		return semver.Version{}, err
+	}
+	return semver.Version{Major: parsed.Major, Minor: parsed.Minor, Patch: parsed.Patch}, nil
+}
+
+// oldestVersion returns the oldest of the versions as it was given and parsed, or an empty string when there are none
+func oldestVersion(versions []string) (string, semver.Version, error) {
+	var (
+		oldest       string
+		oldestParsed semver.Version
+	)
+	for _, v := range versions {
+		parsed, err := parseVersion(v)
+		if err != nil {
+			return "", semver.Version{}, errors.Wrapf(err, "parsing version %q", v)
+		}
+		if oldest == "" || parsed.LT(oldestParsed) {
+			oldest, oldestParsed = v, parsed
+		}
+	}
+	return oldest, oldestParsed, nil
+}
+
+// minorsBehind returns how many minor versions v is behind target
+func minorsBehind(v, target semver.Version) int {
+	return int(target.Minor) - int(v.Minor)
+}
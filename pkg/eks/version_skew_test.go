@@ -0,0 +1,85 @@
+package eks_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	. "github.com/weaveworks/eksctl/pkg/eks"
+)
+
+var _ = Describe("version skew", func() {
+	addons := func(kubeProxy, coreDNS string) []*defaultaddons.Version {
+		return []*defaultaddons.Version{
+			{Name: defaultaddons.AWSNode, Current: "v1.4.1", Default: "v1.5.0"},
+			{Name: defaultaddons.KubeProxy, Current: kubeProxy, Default: "v1.13.7"},
+			{Name: defaultaddons.CoreDNS, Current: coreDNS, Default: "v1.2.6"},
+		}
+	}
+
+	It("passes when nodegroups and addons would be within the policy", func() {
+		nodeGroups := []*manager.NodeGroupSummary{
+			{Name: "ng-1", KubeletVersions: []string{"v1.12.10-eks-aae39f", "v1.13.8-eks-cd3eb0"}},
+			{Name: "ng-empty"},
+		}
+		skew, err := CheckVersionSkew("test", "1.13", "1.14", nodeGroups, nil, addons("v1.12.6", "v1.2.6"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(skew.Offenders).To(BeEmpty())
+		Expect(skew.Remediation).To(BeEmpty())
+	})
+
+	It("lists the offenders and how to fix them, nodegroups first", func() {
+		nodeGroups := []*manager.NodeGroupSummary{
+			{Name: "ng-1", KubeletVersions: []string{"v1.13.8-eks-cd3eb0", "v1.11.10-eks-17cd81"}},
+			{Name: "ng-2", KubeletVersions: []string{"v1.13.8-eks-cd3eb0"}},
+		}
+		managedNodeGroups := []*manager.NodeGroupSummary{
+			{Name: "mng-1", KubeletVersions: []string{"v1.11.9"}},
+		}
+		skew, err := CheckVersionSkew("test", "1.13", "1.14", nodeGroups, managedNodeGroups, addons("v1.11.8", "v1.2.2"), nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(skew.Offenders).To(Equal([]string{
+			`nodegroup "ng-1" runs kubelet v1.11.10-eks-17cd81, which would be 3 minor versions behind 1.14, at most 2 are supported`,
+			`nodegroup "mng-1" runs kubelet v1.11.9, which would be 3 minor versions behind 1.14, at most 2 are supported`,
+			`kube-proxy runs v1.11.8, which would be 3 minor versions behind 1.14, at most 2 are supported`,
+			`coredns runs v1.2.2, which is older than v1.2.6, the version for Kubernetes 1.13`,
+		}))
+		Expect(skew.Remediation).To(Equal([]string{
+			"eksctl upgrade nodegroup --cluster=test --name=mng-1",
+			`eksctl create nodegroup --cluster=test, to replace nodegroup "ng-1", then eksctl delete nodegroup --cluster=test --name=ng-1`,
+			"eksctl utils update-kube-proxy --name=test --approve",
+			"eksctl utils update-coredns --name=test --approve",
+		}))
+	})
+
+	It("ignores EKS build suffixes when comparing versions", func() {
+		skew, err := CheckVersionSkew("test", "1.13", "1.14", nil, nil, []*defaultaddons.Version{
+			{Name: defaultaddons.KubeProxy, Current: "v1.13.7-eksbuild.1", Default: "v1.13.7"},
+			{Name: defaultaddons.CoreDNS, Current: "v1.2.6-eksbuild.1", Default: "v1.2.6"},
+		}, nil)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(skew.Offenders).To(BeEmpty())
+	})
+
+	It("suggests updating addons managed via EKS Addons API with eksctl update addon", func() {
+		skew, err := CheckVersionSkew("test", "1.13", "1.14", nil, nil, addons("v1.11.8-eksbuild.1", "v1.2.2-eksbuild.1"), map[string]string{
+			defaultaddons.KubeProxy: "v1.13.7-eksbuild.1",
+			defaultaddons.CoreDNS:   "",
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(skew.Offenders).To(HaveLen(2))
+		Expect(skew.Remediation).To(Equal([]string{
+			"eksctl update addon --cluster=test --name=kube-proxy --version=v1.13.7-eksbuild.1",
+			"eksctl update addon --cluster=test --name=coredns --version=<version>, see 'eksctl get addon --cluster=test' for the versions",
+		}))
+	})
+
+	It("fails on versions it can't parse", func() {
+		nodeGroups := []*manager.NodeGroupSummary{
+			{Name: "ng-1", KubeletVersions: []string{"unknown"}},
+		}
+		_, err := CheckVersionSkew("test", "1.13", "1.14", nodeGroups, nil, nil, nil)
+		Expect(err).To(MatchError(ContainSubstring(`parsing kubelet versions of nodegroup "ng-1"`)))
+	})
+})
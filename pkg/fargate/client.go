@@ -0,0 +1,177 @@
+package fargate
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/eks/eksiface"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const pollInterval = 10 * time.Second
+
+// Client manages Fargate profiles of a cluster via EKS API
+type Client struct {
+	clusterName string
+	eks         eksiface.EKSAPI
+	waitTimeout time.Duration
+}
+
+// NewClient creates a new Client for the Fargate profiles of the given cluster
+func NewClient(clusterName string, eksAPI eksiface.EKSAPI, waitTimeout time.Duration) *Client {
+	return &Client{
+		clusterName: clusterName,
+		eks:         eksAPI,
+		waitTimeout: waitTimeout,
+	}
+}
+
+// CreateProfile creates the Fargate profile and waits for it to become active,
+// as EKS doesn't create or delete more than one profile of a cluster at a time
+func (c *Client) CreateProfile(profile *api.FargateProfile) error {
+	if profile.PodExecutionRoleARN == "" {
+		return fmt.Errorf("pod execution role of Fargate profile %q must be set", profile.Name)
+	}
+
+	input := &eks.CreateFargateProfileInput{
+		ClusterName:         aws.String(c.clusterName),
+		FargateProfileName:  aws.String(profile.Name),
+		PodExecutionRoleArn: aws.String(profile.PodExecutionRoleARN),
+		Selectors:           toSelectors(profile.Selectors),
+	}
+	if len(profile.Subnets) > 0 {
+		input.Subnets = aws.StringSlice(profile.Subnets)
+	}
+	if len(profile.Tags) > 0 {
+		input.Tags = aws.StringMap(profile.Tags)
+	}
+
+	logger.Info("creating Fargate profile %q on cluster %q", profile.Name, c.clusterName)
+	if _, err := c.eks.CreateFargateProfile(input); err != nil {
+		return errors.Wrapf(err, "creating Fargate profile %q", profile.Name)
+	}
+	if err := c.eks.WaitUntilFargateProfileActiveWithContext(aws.BackgroundContext(), c.describeInput(profile.Name), c.waiterOptions()...); err != nil {
+		return errors.Wrapf(err, "waiting for Fargate profile %q to become active", profile.Name)
+	}
+	logger.Success("created Fargate profile %q on cluster %q", profile.Name, c.clusterName)
+	return nil
+}
+
+// ReadProfiles returns all Fargate profiles of the cluster
+func (c *Client) ReadProfiles() ([]*api.FargateProfile, error) {
+	names := []string{}
+	input := &eks.ListFargateProfilesInput{
+		ClusterName: aws.String(c.clusterName),
+	}
+	err := c.eks.ListFargateProfilesPages(input, func(output *eks.ListFargateProfilesOutput, _ bool) bool {
+		names = append(names, aws.StringValueSlice(output.FargateProfileNames)...)
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "listing Fargate profiles of cluster %q", c.clusterName)
+	}
+
+	profiles := []*api.FargateProfile{}
+	for _, name := range names {
+		profile, err := c.ReadProfile(name)
+		if err != nil {
+			return nil, err
+		}
+		profiles = append(profiles, profile)
+	}
+	return profiles, nil
+}
+
+// ReadProfile returns the Fargate profile with the given name
+func (c *Client) ReadProfile(name string) (*api.FargateProfile, error) {
+	output, err := c.eks.DescribeFargateProfile(c.describeInput(name))
+	if err != nil {
+		if isNotFound(err) {
+			return nil, fmt.Errorf("Fargate profile %q not found", name)
+		}
+		return nil, errors.Wrapf(err, "describing Fargate profile %q", name)
+	}
+	return toProfile(output.FargateProfile), nil
+}
+
+// DeleteProfile deletes the Fargate profile with the given name and waits for it to be deleted
+func (c *Client) DeleteProfile(name string) error {
+	logger.Info("deleting Fargate profile %q from cluster %q", name, c.clusterName)
+	_, err := c.eks.DeleteFargateProfile(&eks.DeleteFargateProfileInput{
+		ClusterName:        aws.String(c.clusterName),
+		FargateProfileName: aws.String(name),
+	})
+	if err != nil {
+		if isNotFound(err) {
+			return fmt.Errorf("Fargate profile %q not found", name)
+		}
+		return errors.Wrapf(err, "deleting Fargate profile %q", name)
+	}
+	if err := c.eks.WaitUntilFargateProfileDeletedWithContext(aws.BackgroundContext(), c.describeInput(name), c.waiterOptions()...); err != nil {
+		return errors.Wrapf(err, "waiting for Fargate profile %q to be deleted", name)
+	}
+	logger.Success("deleted Fargate profile %q from cluster %q", name, c.clusterName)
+	return nil
+}
+
+func (c *Client) describeInput(name string) *eks.DescribeFargateProfileInput {
+	return &eks.DescribeFargateProfileInput{
+		ClusterName:        aws.String(c.clusterName),
+		FargateProfileName: aws.String(name),
+	}
+}
+
+func (c *Client) waiterOptions() []request.WaiterOption {
+	return []request.WaiterOption{
+		request.WithWaiterDelay(request.ConstantWaiterDelay(pollInterval)),
+		request.WithWaiterMaxAttempts(int(c.waitTimeout/pollInterval) + 1),
+	}
+}
+
+func isNotFound(err error) bool {
+	awsErr, ok := err.(awserr.Error)
+	return ok && awsErr.Code() == eks.ErrCodeResourceNotFoundException
+}
+
+func toSelectors(selectors []api.FargateProfileSelector) []*eks.FargateProfileSelector {
+	result := []*eks.FargateProfileSelector{}
+	for _, selector := range selectors {
+		s := &eks.FargateProfileSelector{
+			Namespace: aws.String(selector.Namespace),
+		}
+		if len(selector.Labels) > 0 {
+			s.Labels = aws.StringMap(selector.Labels)
+		}
+		result = append(result, s)
+	}
+	return result
+}
+
+func toProfile(profile *eks.FargateProfile) *api.FargateProfile {
+	result := &api.FargateProfile{
+		Name:                aws.StringValue(profile.FargateProfileName),
+		PodExecutionRoleARN: aws.StringValue(profile.PodExecutionRoleArn),
+		Selectors:           []api.FargateProfileSelector{},
+		Subnets:             aws.StringValueSlice(profile.Subnets),
+	}
+	for _, selector := range profile.Selectors {
+		s := api.FargateProfileSelector{
+			Namespace: aws.StringValue(selector.Namespace),
+		}
+		if len(selector.Labels) > 0 {
+			s.Labels = aws.StringValueMap(selector.Labels)
+		}
+		result.Selectors = append(result.Selectors, s)
+	}
+	if len(profile.Tags) > 0 {
+		result.Tags = aws.StringValueMap(profile.Tags)
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package fargate_test
+
+import (
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/fargate"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("Fargate profiles", func() {
+	var (
+		p      *mockprovider.MockProvider
+		client *Client
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		client = NewClient("test-cluster", p.EKS(), 20*time.Minute)
+	})
+
+	It("creates a profile and waits for it to become active", func() {
+		p.MockEKS().On("CreateFargateProfile", mock.Anything).Return(&eks.CreateFargateProfileOutput{}, nil)
+		p.MockEKS().On("WaitUntilFargateProfileActiveWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		err := client.CreateProfile(&api.FargateProfile{
+			Name:                "default",
+			PodExecutionRoleARN: "arn:aws:iam::123:role/fargate",
+			Selectors: []api.FargateProfileSelector{
+				{Namespace: "default"},
+				{Namespace: "dev", Labels: map[string]string{"env": "dev"}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		input := p.MockEKS().Calls[0].Arguments[0].(*eks.CreateFargateProfileInput)
+		Expect(*input.ClusterName).To(Equal("test-cluster"))
+		Expect(*input.FargateProfileName).To(Equal("default"))
+		Expect(*input.PodExecutionRoleArn).To(Equal("arn:aws:iam::123:role/fargate"))
+		Expect(input.Selectors).To(HaveLen(2))
+		Expect(*input.Selectors[0].Namespace).To(Equal("default"))
+		Expect(input.Selectors[0].Labels).To(BeNil())
+		Expect(aws.StringValueMap(input.Selectors[1].Labels)).To(Equal(map[string]string{"env": "dev"}))
+		Expect(input.Subnets).To(BeNil())
+		Expect(p.MockEKS().AssertCalled(GinkgoT(), "WaitUntilFargateProfileActiveWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything)).To(BeTrue())
+	})
+
+	It("requires a pod execution role to create a profile", func() {
+		err := client.CreateProfile(&api.FargateProfile{Name: "default"})
+		Expect(err).To(MatchError(`pod execution role of Fargate profile "default" must be set`))
+	})
+
+	It("reads all profiles of the cluster", func() {
+		p.MockEKS().On("ListFargateProfilesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*eks.ListFargateProfilesOutput, bool) bool)
+			consume(&eks.ListFargateProfilesOutput{FargateProfileNames: aws.StringSlice([]string{"default", "dev"})}, true)
+		}).Return(nil)
+		p.MockEKS().On("DescribeFargateProfile", mock.Anything).Return(func(input *eks.DescribeFargateProfileInput) *eks.DescribeFargateProfileOutput {
+			return &eks.DescribeFargateProfileOutput{
+				FargateProfile: &eks.FargateProfile{
+					FargateProfileName:  input.FargateProfileName,
+					PodExecutionRoleArn: aws.String("arn:aws:iam::123:role/fargate"),
+					Selectors:           []*eks.FargateProfileSelector{{Namespace: input.FargateProfileName}},
+					Subnets:             aws.StringSlice([]string{"subnet-1", "subnet-2"}),
+				},
+			}
+		}, nil)
+
+		profiles, err := client.ReadProfiles()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(profiles).To(Equal([]*api.FargateProfile{
+			{
+				Name:                "default",
+				PodExecutionRoleARN: "arn:aws:iam::123:role/fargate",
+				Selectors:           []api.FargateProfileSelector{{Namespace: "default"}},
+				Subnets:             []string{"subnet-1", "subnet-2"},
+			},
+			{
+				Name:                "dev",
+				PodExecutionRoleARN: "arn:aws:iam::123:role/fargate",
+				Selectors:           []api.FargateProfileSelector{{Namespace: "dev"}},
+				Subnets:             []string{"subnet-1", "subnet-2"},
+			},
+		}))
+	})
+
+	It("fails to read or delete a profile that doesn't exist", func() {
+		notFound := awserr.New(eks.ErrCodeResourceNotFoundException, "not found", nil)
+		p.MockEKS().On("DescribeFargateProfile", mock.Anything).Return(nil, notFound)
+		p.MockEKS().On("DeleteFargateProfile", mock.Anything).Return(nil, notFound)
+
+		_, err := client.ReadProfile("default")
+		Expect(err).To(MatchError(`Fargate profile "default" not found`))
+		Expect(client.DeleteProfile("dev")).To(MatchError(`Fargate profile "dev" not found`))
+	})
+
+	It("deletes a profile and waits for it to be deleted", func() {
+		p.MockEKS().On("DeleteFargateProfile", mock.Anything).Return(&eks.DeleteFargateProfileOutput{}, nil)
+		p.MockEKS().On("WaitUntilFargateProfileDeletedWithContext", mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		Expect(client.DeleteProfile("default")).To(Succeed())
+
+		input := p.MockEKS().Calls[0].Arguments[0].(*eks.DeleteFargateProfileInput)
+		Expect(*input.FargateProfileName).To(Equal("default"))
+	})
+})
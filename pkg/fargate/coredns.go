@@ -0,0 +1,38 @@
+package fargate
+
+import (
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/kubernetes"
+)
+
+const (
+	coreDNSName = "coredns"
+
+	// computeTypeAnnotation is set by EKS on pods of CoreDNS to keep them on EC2 nodes
+	computeTypeAnnotation = "eks.amazonaws.com/compute-type"
+)
+
+// ScheduleCoreDNSOnFargate removes the annotation that keeps pods of CoreDNS on EC2 nodes,
+// so that they get scheduled onto Fargate; it returns false and leaves CoreDNS as is
+// when none of the Fargate profiles of the cluster selects its pods
+func ScheduleCoreDNSOnFargate(cfg *api.ClusterConfig, clientSet kubernetes.Interface) (bool, error) {
+	deployments := clientSet.AppsV1().Deployments(metav1.NamespaceSystem)
+	deployment, err := deployments.Get(coreDNSName, metav1.GetOptions{})
+	if err != nil {
+		return false, errors.Wrapf(err, "getting %q deployment", coreDNSName)
+	}
+	if !cfg.IsSchedulableOnFargate(metav1.NamespaceSystem, deployment.Spec.Template.Labels) {
+		return false, nil
+	}
+	if _, ok := deployment.Spec.Template.Annotations[computeTypeAnnotation]; !ok {
+		return true, nil
+	}
+	delete(deployment.Spec.Template.Annotations, computeTypeAnnotation)
+	if _, err := deployments.Update(deployment); err != nil {
+		return false, errors.Wrapf(err, "updating %q deployment", coreDNSName)
+	}
+	return true, nil
+}
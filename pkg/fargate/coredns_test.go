@@ -0,0 +1,64 @@
+package fargate_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/fargate"
+)
+
+var _ = Describe("CoreDNS on Fargate", func() {
+	var (
+		cfg       *api.ClusterConfig
+		clientSet *fake.Clientset
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		clientSet = fake.NewSimpleClientset(&appsv1.Deployment{
+			ObjectMeta: metav1.ObjectMeta{Namespace: "kube-system", Name: "coredns"},
+			Spec: appsv1.DeploymentSpec{
+				Template: corev1.PodTemplateSpec{
+					ObjectMeta: metav1.ObjectMeta{
+						Labels:      map[string]string{"k8s-app": "kube-dns", "eks.amazonaws.com/component": "coredns"},
+						Annotations: map[string]string{"eks.amazonaws.com/compute-type": "ec2"},
+					},
+				},
+			},
+		})
+	})
+
+	getAnnotations := func() map[string]string {
+		deployment, err := clientSet.AppsV1().Deployments("kube-system").Get("coredns", metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		return deployment.Spec.Template.Annotations
+	}
+
+	It("schedules CoreDNS onto Fargate when a profile selects its pods", func() {
+		cfg.FargateProfiles = []*api.FargateProfile{
+			{Name: "default", Selectors: []api.FargateProfileSelector{{Namespace: "default"}, {Namespace: "kube-system"}}},
+		}
+
+		scheduled, err := ScheduleCoreDNSOnFargate(cfg, clientSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(scheduled).To(BeTrue())
+		Expect(getAnnotations()).ToNot(HaveKey("eks.amazonaws.com/compute-type"))
+	})
+
+	It("leaves CoreDNS as is when no profile selects its pods", func() {
+		cfg.FargateProfiles = []*api.FargateProfile{
+			{Name: "system", Selectors: []api.FargateProfileSelector{{Namespace: "kube-system", Labels: map[string]string{"app": "other"}}}},
+		}
+
+		scheduled, err := ScheduleCoreDNSOnFargate(cfg, clientSet)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(scheduled).To(BeFalse())
+		Expect(getAnnotations()).To(HaveKeyWithValue("eks.amazonaws.com/compute-type", "ec2"))
+	})
+})
@@ -8,11 +8,11 @@ import (
 	"path/filepath"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	giturls "github.com/whilp/git-urls"
 
 	"github.com/weaveworks/eksctl/pkg/git/executor"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // TmpCloner can clone git repositories in temporary directories
@@ -86,6 +86,8 @@ type CloneOptions struct {
 	URL       string
 	Branch    string
 	Bootstrap bool // create the branch if the repository is empty
+	// CredentialHelper is configured in the clone, to authenticate over HTTPS
+	CredentialHelper string
 }
 
 // CloneRepoInTmpDir clones a repo specified in the gitURL in a temporary directory and checks out the specified branch
@@ -107,7 +109,11 @@ func (git *Client) CloneRepoInPath(clonePath string, options CloneOptions) error
 }
 
 func (git *Client) cloneRepoInPath(clonePath string, options CloneOptions) error {
-	args := []string{"clone", options.URL, clonePath}
+	args := []string{"clone"}
+	if options.CredentialHelper != "" {
+		args = append(args, "--config", "credential.helper="+options.CredentialHelper)
+	}
+	args = append(args, options.URL, clonePath)
 	if err := git.runGitCmd(args...); err != nil {
 		return err
 	}
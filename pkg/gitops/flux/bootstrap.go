@@ -0,0 +1,133 @@
+package flux
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/git"
+	"github.com/weaveworks/eksctl/pkg/git/executor"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// ConfigFileDir is the directory of the repository that the config files of clusters are committed to,
+// it's kept apart from the paths that Flux syncs, as ClusterConfig is not a Kubernetes resource
+const ConfigFileDir = "eksctl"
+
+// Bootstrapper bootstraps Flux v2 in a cluster by running 'flux bootstrap', which commits the manifests
+// of Flux to the repository and adds a deploy key to it, storing the private key in the cluster;
+// the config file of the cluster is committed to the repository afterwards
+type Bootstrapper struct {
+	executor   executor.Executor
+	gitClient  *git.Client
+	spec       *api.ClusterConfig
+	kubeconfig *clientcmdapi.Config
+	configFile []byte
+}
+
+// NewBootstrapper creates a new Bootstrapper, the kubeconfig is expected to have credentials embedded
+func NewBootstrapper(spec *api.ClusterConfig, kubeconfig *clientcmdapi.Config, configFile []byte) *Bootstrapper {
+	return NewBootstrapperFromExecutor(executor.NewShellExecutor(nil), spec, kubeconfig, configFile)
+}
+
+// NewBootstrapperFromExecutor returns a Bootstrapper that can have an executor injected. Useful for testing
+func NewBootstrapperFromExecutor(executor executor.Executor, spec *api.ClusterConfig, kubeconfig *clientcmdapi.Config, configFile []byte) *Bootstrapper {
+	return &Bootstrapper{
+		executor:   executor,
+		gitClient:  git.NewGitClientFromExecutor(executor),
+		spec:       spec,
+		kubeconfig: kubeconfig,
+		configFile: configFile,
+	}
+}
+
+// Run bootstraps Flux and commits the config file of the cluster
+func (b *Bootstrapper) Run() error {
+	flux := b.spec.GitOps.Flux
+	if os.Getenv(flux.TokenEnvVar()) == "" {
+		return fmt.Errorf("%s must be set to bootstrap Flux with %s", flux.TokenEnvVar(), flux.GitProvider)
+	}
+
+	dir, err := ioutil.TempDir("", "eksctl-flux-")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	kubeconfigPath := filepath.Join(dir, "kubeconfig")
+	if err := clientcmd.WriteToFile(*b.kubeconfig, kubeconfigPath); err != nil {
+		return errors.Wrap(err, "writing kubeconfig for Flux")
+	}
+
+	logger.Info("checking prerequisites of Flux")
+	if err := b.executor.Exec("flux", dir, "check", "--pre", "--kubeconfig", kubeconfigPath); err != nil {
+		return errors.Wrap(err, "running 'flux check --pre', make sure the flux CLI is installed")
+	}
+
+	logger.Info("bootstrapping Flux in %s", flux.RepositoryURL())
+	if err := b.executor.Exec("flux", dir, bootstrapArgs(flux, kubeconfigPath)...); err != nil {
+		return errors.Wrap(err, "running 'flux bootstrap'")
+	}
+
+	return b.commitConfigFile(filepath.Join(dir, "repo"))
+}
+
+func bootstrapArgs(flux *api.Flux, kubeconfigPath string) []string {
+	return []string{
+		"bootstrap", flux.GitProvider,
+		"--owner", flux.Owner,
+		"--repository", flux.Repository,
+		"--branch", flux.Branch,
+		"--path", flux.Path,
+		"--personal=" + strconv.FormatBool(api.IsEnabled(flux.Personal)),
+		"--author-name", flux.AuthorName,
+		"--author-email", flux.AuthorEmail,
+		"--kubeconfig", kubeconfigPath,
+	}
+}
+
+// credentialHelper returns a Git credential helper that answers with the token of the Git provider, the
+// token is read from the environment when git runs, so it's neither in the arguments of git nor in the clone
+func credentialHelper(flux *api.Flux) string {
+	return fmt.Sprintf(`!f() { echo username=%s; echo "password=$%s"; }; f`, flux.TokenUsername(), flux.TokenEnvVar())
+}
+
+// commitConfigFile clones the repository over HTTPS with the token, so that no SSH key is needed
+func (b *Bootstrapper) commitConfigFile(cloneDir string) error {
+	flux := b.spec.GitOps.Flux
+	options := git.CloneOptions{
+		URL:              flux.RepositoryURL(),
+		Branch:           flux.Branch,
+		CredentialHelper: credentialHelper(flux),
+	}
+	if err := b.gitClient.CloneRepoInPath(cloneDir, options); err != nil {
+		return errors.Wrapf(err, "cloning %s", options.URL)
+	}
+
+	path := filepath.Join(ConfigFileDir, b.spec.Metadata.Name+".yaml")
+	if err := os.MkdirAll(filepath.Join(cloneDir, ConfigFileDir), 0755); err != nil {
+		return err
+	}
+	if err := ioutil.WriteFile(filepath.Join(cloneDir, path), b.configFile, 0644); err != nil {
+		return errors.Wrapf(err, "writing %s", path)
+	}
+
+	if err := b.gitClient.Add(path); err != nil {
+		return err
+	}
+	if err := b.gitClient.Commit(fmt.Sprintf("Add config of cluster %s", b.spec.Metadata.Name), flux.AuthorName, flux.AuthorEmail); err != nil {
+		return err
+	}
+	if err := b.gitClient.Push(); err != nil {
+		return errors.Wrapf(err, "pushing to %s", options.URL)
+	}
+	logger.Success("committed the config file of the cluster to %s in %s", path, options.URL)
+	return nil
+}
@@ -0,0 +1,95 @@
+package flux
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/git/executor"
+)
+
+var _ = Describe("Bootstrapper", func() {
+	var (
+		fakeExecutor *executor.FakeExecutor
+		cfg          *api.ClusterConfig
+	)
+
+	BeforeEach(func() {
+		fakeExecutor = new(executor.FakeExecutor)
+		fakeExecutor.On("Exec", mock.Anything, mock.Anything, mock.Anything).Return(nil)
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.GitOps = &api.GitOps{
+			Flux: &api.Flux{
+				GitProvider: api.FluxGitProviderGitHub,
+				Owner:       "org",
+				Repository:  "fleet",
+				AuthorEmail: "flux@example.com",
+			},
+		}
+		api.SetClusterConfigDefaults(cfg)
+
+		os.Setenv("GITHUB_TOKEN", "gh-secret")
+	})
+
+	AfterEach(func() {
+		os.Unsetenv("GITHUB_TOKEN")
+	})
+
+	It("bootstraps Flux and commits the config file outside of the synced path", func() {
+		err := NewBootstrapperFromExecutor(fakeExecutor, cfg, clientcmdapi.NewConfig(), []byte("kind: ClusterConfig")).Run()
+		Expect(err).ToNot(HaveOccurred())
+
+		var commands [][]string
+		for _, call := range fakeExecutor.Calls {
+			commands = append(commands, append([]string{call.Arguments[0].(string)}, call.Arguments[2].([]string)...))
+		}
+		Expect(commands[0][:3]).To(Equal([]string{"flux", "check", "--pre"}))
+		Expect(commands[1][:15]).To(Equal([]string{
+			"flux", "bootstrap", "github",
+			"--owner", "org",
+			"--repository", "fleet",
+			"--branch", "main",
+			"--path", "clusters/cluster-1",
+			"--personal=false",
+			"--author-name", "Flux",
+			"--author-email",
+		}))
+		Expect(commands[2][:5]).To(Equal([]string{
+			"git", "clone",
+			"--config", `credential.helper=!f() { echo username=x-access-token; echo "password=$GITHUB_TOKEN"; }; f`,
+			"https://github.com/org/fleet.git",
+		}))
+		for _, command := range commands {
+			Expect(command).NotTo(ContainElement(ContainSubstring("gh-secret")))
+		}
+		Expect(commands[3]).To(Equal([]string{"git", "checkout", "main"}))
+		Expect(commands[4]).To(Equal([]string{"git", "add", "--", "eksctl/cluster-1.yaml"}))
+		Expect(commands[len(commands)-1]).To(Equal([]string{"git", "push"}))
+	})
+
+	It("clones GitLab repositories with the GitLab token", func() {
+		cfg.GitOps.Flux.GitProvider = api.FluxGitProviderGitLab
+		os.Setenv("GITLAB_TOKEN", "token")
+		defer os.Unsetenv("GITLAB_TOKEN")
+
+		err := NewBootstrapperFromExecutor(fakeExecutor, cfg, clientcmdapi.NewConfig(), []byte("kind: ClusterConfig")).Run()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(fakeExecutor.Calls[2].Arguments[2]).To(ContainElements(
+			`credential.helper=!f() { echo username=oauth2; echo "password=$GITLAB_TOKEN"; }; f`,
+			"https://gitlab.com/org/fleet.git",
+		))
+	})
+
+	It("requires the token of the Git provider", func() {
+		os.Unsetenv("GITHUB_TOKEN")
+		err := NewBootstrapperFromExecutor(fakeExecutor, cfg, clientcmdapi.NewConfig(), nil).Run()
+		Expect(err).To(MatchError("GITHUB_TOKEN must be set to bootstrap Flux with github"))
+		Expect(fakeExecutor.Calls).To(BeEmpty())
+	})
+})
@@ -10,7 +10,6 @@ import (
 	"time"
 
 	helmopinstall "github.com/fluxcd/helm-operator/pkg/install"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"github.com/riywo/loginshell"
 	fluxinstall "github.com/weaveworks/flux/install"
@@ -22,6 +21,7 @@ import (
 
 	"github.com/weaveworks/eksctl/pkg/git"
 	"github.com/weaveworks/eksctl/pkg/kubernetes"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 const (
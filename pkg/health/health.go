@@ -0,0 +1,210 @@
+package health
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	defaultaddons "github.com/weaveworks/eksctl/pkg/addons/default"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	iamoidc "github.com/weaveworks/eksctl/pkg/iam/oidc"
+)
+
+// Status is the outcome of a check
+type Status string
+
+const (
+	// StatusPass is the status of checks that found nothing wrong
+	StatusPass Status = "pass"
+	// StatusWarn is the status of checks that found something that may need attention
+	StatusWarn Status = "warn"
+	// StatusFail is the status of checks that found the cluster unhealthy
+	StatusFail Status = "fail"
+)
+
+// Check is the outcome of checking one part of a cluster
+type Check struct {
+	Name    string
+	Status  Status
+	Message string
+	// Details is output that backs the message, e.g. the logs of a node
+	Details string `json:",omitempty"`
+}
+
+// Report holds the checks of a cluster in the order they were made
+type Report struct {
+	Checks []*Check
+}
+
+func (r *Report) add(name string, status Status, format string, args ...interface{}) {
+	r.Checks = append(r.Checks, &Check{Name: name, Status: status, Message: fmt.Sprintf(format, args...)})
+}
+
+// Count returns the number of checks with the given status
+func (r *Report) Count(status Status) int {
+	count := 0
+	for _, check := range r.Checks {
+		if check.Status == status {
+			count++
+		}
+	}
+	return count
+}
+
+// Err returns an error when any of the checks failed, or, when strict is set, warned
+func (r *Report) Err(strict bool) error {
+	failed, warned := r.Count(StatusFail), r.Count(StatusWarn)
+	if failed > 0 || (strict && warned > 0) {
+		return fmt.Errorf("%d of %d check(s) failed, %d warned", failed, len(r.Checks), warned)
+	}
+	return nil
+}
+
+// CheckControlPlane checks the status of the cluster and the health issues reported by EKS
+func (r *Report) CheckControlPlane(cluster *awseks.Cluster) {
+	const name = "control plane"
+	if status := aws.StringValue(cluster.Status); status != awseks.ClusterStatusActive {
+		r.add(name, StatusFail, "cluster status is %s", status)
+		return
+	}
+	if cluster.Health == nil || len(cluster.Health.Issues) == 0 {
+		r.add(name, StatusPass, "cluster is active, Kubernetes version %s", aws.StringValue(cluster.Version))
+		return
+	}
+	issues := []string{}
+	for _, issue := range cluster.Health.Issues {
+		issues = append(issues, fmt.Sprintf("%s: %s", aws.StringValue(issue.Code), aws.StringValue(issue.Message)))
+	}
+	r.add(name, StatusFail, "%d health issue(s): %s", len(issues), strings.Join(issues, "; "))
+}
+
+// CheckNodeGroups checks that the nodes of each nodegroup are ready, given summaries with
+// their live status set
+func (r *Report) CheckNodeGroups(summaries []*manager.NodeGroupSummary) {
+	if len(summaries) == 0 {
+		r.add("nodegroups", StatusWarn, "cluster has no nodegroups")
+		return
+	}
+	for _, summary := range summaries {
+		name := fmt.Sprintf("nodegroup %q", summary.Name)
+		message := fmt.Sprintf("%d of %d desired node(s) ready", summary.ReadyNodes, summary.DesiredCapacity)
+		switch {
+		case summary.ReadyNodes >= summary.DesiredCapacity:
+			r.add(name, StatusPass, "%s", message)
+		case summary.ReadyNodes == 0:
+			r.add(name, StatusFail, "%s", message)
+		default:
+			r.add(name, StatusWarn, "%s", message)
+		}
+	}
+}
+
+// CheckDefaultAddons checks that the pods of aws-node, kube-proxy and coredns are available
+func (r *Report) CheckDefaultAddons(clientSet kubernetes.Interface) {
+	for _, name := range []string{defaultaddons.AWSNode, defaultaddons.KubeProxy} {
+		daemonSet, err := clientSet.AppsV1().DaemonSets(metav1.NamespaceSystem).Get(name, metav1.GetOptions{})
+		if err != nil {
+			r.addGetError(name, err)
+			continue
+		}
+		r.addAvailability(name, daemonSet.Status.NumberAvailable, daemonSet.Status.DesiredNumberScheduled)
+	}
+
+	deployment, err := clientSet.AppsV1().Deployments(metav1.NamespaceSystem).Get(defaultaddons.CoreDNS, metav1.GetOptions{})
+	if err != nil {
+		r.addGetError(defaultaddons.CoreDNS, err)
+		return
+	}
+	desired := int32(1)
+	if deployment.Spec.Replicas != nil {
+		desired = *deployment.Spec.Replicas
+	}
+	r.addAvailability(defaultaddons.CoreDNS, deployment.Status.AvailableReplicas, desired)
+}
+
+func (r *Report) addGetError(name string, err error) {
+	if apierrs.IsNotFound(err) {
+		r.add(name, StatusWarn, "not found in %s", metav1.NamespaceSystem)
+		return
+	}
+	r.add(name, StatusFail, "getting %s: %s", name, err.Error())
+}
+
+func (r *Report) addAvailability(name string, available, desired int32) {
+	message := fmt.Sprintf("%d of %d pod(s) available", available, desired)
+	switch {
+	case available >= desired:
+		r.add(name, StatusPass, "%s", message)
+	case available == 0:
+		r.add(name, StatusFail, "%s", message)
+	default:
+		r.add(name, StatusWarn, "%s", message)
+	}
+}
+
+// CheckOIDCProvider checks that the IAM OIDC provider of the cluster exists, oidc is nil for
+// clusters that don't support it
+func (r *Report) CheckOIDCProvider(oidc *iamoidc.OpenIDConnectManager) {
+	const name = "IAM OIDC provider"
+	if oidc == nil {
+		r.add(name, StatusWarn, "cluster doesn't support IAM OIDC provider")
+		return
+	}
+	exists, err := oidc.CheckProviderExists()
+	if err != nil {
+		r.add(name, StatusFail, "checking provider: %s", err.Error())
+		return
+	}
+	if !exists {
+		r.add(name, StatusWarn, "not associated, IAM roles for service accounts can't be used")
+		return
+	}
+	r.add(name, StatusPass, "%s", oidc.ProviderARN)
+}
+
+// CheckAWSAuth checks that the aws-auth ConfigMap can be parsed, that the identities it maps are
+// complete, and that it maps node roles when there are nodegroups that aren't managed by EKS
+func (r *Report) CheckAWSAuth(clientSet kubernetes.Interface, unmanagedNodeGroups int) {
+	const name = "aws-auth"
+	acm, err := authconfigmap.NewFromClientSet(clientSet)
+	if err != nil {
+		r.add(name, StatusFail, "%s", err.Error())
+		return
+	}
+	identities, err := acm.Identities()
+	if err != nil {
+		r.add(name, StatusFail, "%s", errors.Wrap(err, "parsing ConfigMap").Error())
+		return
+	}
+
+	nodeRoles := 0
+	invalid := []string{}
+	for _, identity := range identities {
+		if identity.ARN() == "" || identity.Username() == "" {
+			invalid = append(invalid, fmt.Sprintf("%q", identity.ARN()))
+			continue
+		}
+		for _, group := range identity.Groups() {
+			if group == "system:nodes" {
+				nodeRoles++
+				break
+			}
+		}
+	}
+
+	switch {
+	case len(invalid) > 0:
+		r.add(name, StatusFail, "identities without an ARN or a username: %s", strings.Join(invalid, ", "))
+	case unmanagedNodeGroups > 0 && nodeRoles == 0:
+		r.add(name, StatusFail, "no node roles are mapped, nodes of %d nodegroup(s) can't join the cluster", unmanagedNodeGroups)
+	default:
+		r.add(name, StatusPass, "%d identities mapped, %d of them node roles", len(identities), nodeRoles)
+	}
+}
@@ -0,0 +1,111 @@
+package health_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
+
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	. "github.com/weaveworks/eksctl/pkg/health"
+)
+
+var _ = Describe("cluster health", func() {
+	var report *Report
+
+	BeforeEach(func() {
+		report = &Report{}
+	})
+
+	statuses := func() []Status {
+		statuses := []Status{}
+		for _, check := range report.Checks {
+			statuses = append(statuses, check.Status)
+		}
+		return statuses
+	}
+
+	It("fails on health issues of the control plane", func() {
+		report.CheckControlPlane(&awseks.Cluster{Status: aws.String(awseks.ClusterStatusActive), Version: aws.String("1.18")})
+		report.CheckControlPlane(&awseks.Cluster{
+			Status: aws.String(awseks.ClusterStatusActive),
+			Health: &awseks.ClusterHealth{Issues: []*awseks.ClusterIssue{{
+				Code:    aws.String(awseks.ClusterIssueCodeClusterUnreachable),
+				Message: aws.String("API server unreachable"),
+			}}},
+		})
+		report.CheckControlPlane(&awseks.Cluster{Status: aws.String(awseks.ClusterStatusFailed)})
+
+		Expect(statuses()).To(Equal([]Status{StatusPass, StatusFail, StatusFail}))
+		Expect(report.Checks[1].Message).To(Equal("1 health issue(s): ClusterUnreachable: API server unreachable"))
+	})
+
+	It("checks that the nodes of nodegroups are ready", func() {
+		report.CheckNodeGroups([]*manager.NodeGroupSummary{
+			{Name: "ng-1", DesiredCapacity: 2, ReadyNodes: 2},
+			{Name: "ng-2", DesiredCapacity: 2, ReadyNodes: 1},
+			{Name: "ng-3", DesiredCapacity: 2},
+		})
+		Expect(statuses()).To(Equal([]Status{StatusPass, StatusWarn, StatusFail}))
+		Expect(report.Checks[1].Name).To(Equal(`nodegroup "ng-2"`))
+		Expect(report.Checks[1].Message).To(Equal("1 of 2 desired node(s) ready"))
+	})
+
+	It("checks that the pods of the default addons are available", func() {
+		replicas := int32(2)
+		clientSet := fake.NewSimpleClientset(
+			&appsv1.DaemonSet{
+				ObjectMeta: metav1.ObjectMeta{Name: "aws-node", Namespace: metav1.NamespaceSystem},
+				Status:     appsv1.DaemonSetStatus{DesiredNumberScheduled: 3, NumberAvailable: 3},
+			},
+			&appsv1.Deployment{
+				ObjectMeta: metav1.ObjectMeta{Name: "coredns", Namespace: metav1.NamespaceSystem},
+				Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+				Status:     appsv1.DeploymentStatus{AvailableReplicas: 1},
+			},
+		)
+		report.CheckDefaultAddons(clientSet)
+		Expect(statuses()).To(Equal([]Status{StatusPass, StatusWarn, StatusWarn}))
+		Expect(report.Checks[1].Message).To(Equal("not found in kube-system"))
+		Expect(report.Checks[2].Message).To(Equal("1 of 2 pod(s) available"))
+	})
+
+	It("fails when aws-auth doesn't map node roles of unmanaged nodegroups", func() {
+		clientSet := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: authconfigmap.ObjectMeta(),
+			Data: map[string]string{
+				"mapRoles": `- rolearn: arn:aws:iam::123456789012:role/admin
+  username: admin
+  groups: [system:masters]
+`,
+			},
+		})
+		report.CheckAWSAuth(clientSet, 0)
+		report.CheckAWSAuth(clientSet, 2)
+		Expect(statuses()).To(Equal([]Status{StatusPass, StatusFail}))
+		Expect(report.Checks[0].Message).To(Equal("1 identities mapped, 0 of them node roles"))
+	})
+
+	It("fails when aws-auth can't be parsed", func() {
+		clientSet := fake.NewSimpleClientset(&corev1.ConfigMap{
+			ObjectMeta: authconfigmap.ObjectMeta(),
+			Data:       map[string]string{"mapRoles": "rolearn: ["},
+		})
+		report.CheckAWSAuth(clientSet, 0)
+		Expect(statuses()).To(Equal([]Status{StatusFail}))
+	})
+
+	It("returns an error on failures, and on warnings when strict", func() {
+		report.CheckNodeGroups([]*manager.NodeGroupSummary{{Name: "ng-1", DesiredCapacity: 2, ReadyNodes: 1}})
+		Expect(report.Err(false)).ToNot(HaveOccurred())
+		Expect(report.Err(true)).To(MatchError("0 of 1 check(s) failed, 1 warned"))
+
+		report.CheckNodeGroups([]*manager.NodeGroupSummary{{Name: "ng-2", DesiredCapacity: 2}})
+		Expect(report.Err(false)).To(MatchError("1 of 2 check(s) failed, 1 warned"))
+	})
+})
@@ -0,0 +1,290 @@
+package health
+
+import (
+	"net"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+)
+
+const (
+	// apiServerPort is the port the API server of EKS clusters listens on
+	apiServerPort = 443
+	// kubeletPort is the port the control plane connects to kubelet on, for logs, exec and port forwarding
+	kubeletPort = 10250
+	// anyIPv4 is the CIDR of all IPv4 addresses
+	anyIPv4 = "0.0.0.0/0"
+)
+
+// NodeGroupInstance is an instance of a nodegroup along with what decides whether it can reach the API server
+type NodeGroupInstance struct {
+	Instance *ec2.Instance
+	// RouteTable is the route table of the subnet of the instance, or the main route table of the VPC
+	RouteTable *ec2.RouteTable
+	// SecurityGroups are the security groups of the instance
+	SecurityGroups []*ec2.SecurityGroup
+	// ClusterSecurityGroups are the security groups of the network interfaces of the control plane
+	ClusterSecurityGroups []*ec2.SecurityGroup
+}
+
+// ID returns the ID of the instance
+func (i *NodeGroupInstance) ID() string {
+	return aws.StringValue(i.Instance.InstanceId)
+}
+
+// CheckNodeRole checks that the instance role of the nodegroup is authorized to join the cluster, by the
+// aws-auth ConfigMap or by an access entry, depending on the authentication mode of the cluster
+func (r *Report) CheckNodeRole(clientSet kubernetes.Interface, authenticationMode, roleARN string, hasAccessEntry bool) {
+	const name = "node role"
+	if roleARN == "" {
+		r.add(name, StatusWarn, "instance role of the nodegroup is unknown")
+		return
+	}
+	if hasAccessEntry && authenticationMode != api.AuthenticationModeConfigMap {
+		r.add(name, StatusPass, "%q has an access entry", roleARN)
+		return
+	}
+	if authenticationMode == api.AuthenticationModeAPI {
+		r.add(name, StatusFail, "%q has no access entry, nodes can't join the cluster; create one of type EC2_LINUX (or EC2_WINDOWS) with 'eksctl create accessentry'", roleARN)
+		return
+	}
+
+	acm, err := authconfigmap.NewFromClientSet(clientSet)
+	if err != nil {
+		r.add(name, StatusFail, "%s", err.Error())
+		return
+	}
+	identities, err := acm.Identities()
+	if err != nil {
+		r.add(name, StatusFail, "%s", errors.Wrap(err, "parsing aws-auth ConfigMap").Error())
+		return
+	}
+	var groups []string
+	mapped := false
+	// aws-iam-authenticator only considers the last mapping of an ARN
+	for _, identity := range identities {
+		if identity.ARN() == roleARN {
+			groups, mapped = identity.Groups(), true
+		}
+	}
+	switch {
+	case !mapped:
+		r.add(name, StatusFail, "%q is missing from aws-auth ConfigMap, nodes can't join the cluster; map it with 'eksctl utils repair-aws-auth'", roleARN)
+	case !sets.NewString(groups...).Has("system:nodes"):
+		r.add(name, StatusFail, "%q is mapped in aws-auth ConfigMap to groups %v, which lack system:nodes; map it to system:bootstrappers and system:nodes", roleARN, groups)
+	default:
+		r.add(name, StatusPass, "%q is mapped in aws-auth ConfigMap", roleARN)
+	}
+}
+
+// CheckInstances checks that the in-service instances of the ASG of a nodegroup registered as nodes
+func (r *Report) CheckInstances(group *autoscaling.Group, registered sets.String) {
+	const name = "instances"
+	inService, unregistered := 0, []string{}
+	for _, instance := range group.Instances {
+		if aws.StringValue(instance.LifecycleState) != autoscaling.LifecycleStateInService {
+			continue
+		}
+		inService++
+		if id := aws.StringValue(instance.InstanceId); !registered.Has(id) {
+			unregistered = append(unregistered, id)
+		}
+	}
+	switch {
+	case inService == 0:
+		r.add(name, StatusFail, "ASG %q has no in-service instances, check its scaling activities with 'aws autoscaling describe-scaling-activities --auto-scaling-group-name=%s'", aws.StringValue(group.AutoScalingGroupName), aws.StringValue(group.AutoScalingGroupName))
+	case len(unregistered) == inService:
+		r.add(name, StatusFail, "none of %d in-service instance(s) registered as nodes", inService)
+	case len(unregistered) > 0:
+		r.add(name, StatusWarn, "%d of %d in-service instance(s) haven't registered as nodes: %s", len(unregistered), inService, strings.Join(unregistered, ", "))
+	default:
+		r.add(name, StatusPass, "%d in-service instance(s) registered as nodes", inService)
+	}
+}
+
+// CheckEndpointRoute checks that the subnet of the instance routes to the API server: endpoints with private
+// access are reached within the VPC, public ones through an internet gateway, with a public IP, or a NAT
+func (r *Report) CheckEndpointRoute(cluster *awseks.Cluster, i *NodeGroupInstance) {
+	const name = "route to API server"
+	vpcConfig := cluster.ResourcesVpcConfig
+	if aws.BoolValue(vpcConfig.EndpointPrivateAccess) {
+		r.add(name, StatusPass, "cluster endpoint has private access, instance %s reaches it within the VPC", i.ID())
+		return
+	}
+
+	subnetID := aws.StringValue(i.Instance.SubnetId)
+	var route *ec2.Route
+	if i.RouteTable != nil {
+		for _, rt := range i.RouteTable.Routes {
+			if aws.StringValue(rt.DestinationCidrBlock) == anyIPv4 && aws.StringValue(rt.State) != ec2.RouteStateBlackhole {
+				route = rt
+			}
+		}
+	}
+	if route == nil {
+		r.add(name, StatusFail, "cluster endpoint only has public access, but subnet %s has no route to 0.0.0.0/0; add one to a NAT gateway, or enable private access of the cluster endpoint", subnetID)
+		return
+	}
+
+	publicAccessCIDRs := aws.StringValueSlice(vpcConfig.PublicAccessCidrs)
+	gatewayID := aws.StringValue(route.GatewayId)
+	if !strings.HasPrefix(gatewayID, "igw-") {
+		if !openToAll(publicAccessCIDRs) {
+			r.add(name, StatusWarn, "subnet %s routes to the public cluster endpoint through %s, whose public IP needs to be in the public access CIDRs %v", subnetID, routeTarget(route), publicAccessCIDRs)
+			return
+		}
+		r.add(name, StatusPass, "subnet %s routes to the public cluster endpoint through %s", subnetID, routeTarget(route))
+		return
+	}
+
+	publicIP := aws.StringValue(i.Instance.PublicIpAddress)
+	switch {
+	case publicIP == "":
+		r.add(name, StatusFail, "subnet %s routes through internet gateway %s, but instance %s has no public IP to reach the public cluster endpoint; enable mapPublicIpOnLaunch of the subnet, or use private subnets with a NAT gateway", subnetID, gatewayID, i.ID())
+	case !containsIP(publicAccessCIDRs, publicIP):
+		r.add(name, StatusFail, "public IP %s of instance %s isn't in the public access CIDRs %v of the cluster endpoint", publicIP, i.ID(), publicAccessCIDRs)
+	default:
+		r.add(name, StatusPass, "instance %s reaches the public cluster endpoint through internet gateway %s", i.ID(), gatewayID)
+	}
+}
+
+func routeTarget(route *ec2.Route) string {
+	for _, id := range []*string{route.NatGatewayId, route.TransitGatewayId, route.InstanceId, route.GatewayId, route.NetworkInterfaceId} {
+		if aws.StringValue(id) != "" {
+			return aws.StringValue(id)
+		}
+	}
+	return "an unknown target"
+}
+
+// openToAll returns true when the public access CIDRs of the cluster endpoint let any IP in, EKS defaults them to 0.0.0.0/0
+func openToAll(cidrs []string) bool {
+	return len(cidrs) == 0 || sets.NewString(cidrs...).Has(anyIPv4)
+}
+
+// containsIP returns true when one of the CIDRs contains the IP, an empty list of CIDRs means any IP
+func containsIP(cidrs []string, ip string) bool {
+	if len(cidrs) == 0 {
+		return true
+	}
+	parsed := net.ParseIP(ip)
+	for _, cidr := range cidrs {
+		if _, network, err := net.ParseCIDR(cidr); err == nil && network.Contains(parsed) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckSecurityGroups checks that the security groups of the instance allow it to connect to the API server,
+// that, for private access, those of the control plane accept it, and that the control plane can reach kubelet
+func (r *Report) CheckSecurityGroups(cluster *awseks.Cluster, i *NodeGroupInstance) {
+	const name = "security groups"
+	nodeGroupIDs := securityGroupIDs(i.SecurityGroups)
+	clusterGroupIDs := securityGroupIDs(i.ClusterSecurityGroups)
+	privateIP := aws.StringValue(i.Instance.PrivateIpAddress)
+
+	allowsEgress := false
+	for _, sg := range i.SecurityGroups {
+		if permitsPort(sg.IpPermissionsEgress, apiServerPort, clusterGroupIDs, "") {
+			allowsEgress = true
+		}
+	}
+	if !allowsEgress {
+		r.add(name, StatusFail, "security groups %v of instance %s don't allow egress on port %d to the API server", nodeGroupIDs.List(), i.ID(), apiServerPort)
+		return
+	}
+
+	if aws.BoolValue(cluster.ResourcesVpcConfig.EndpointPrivateAccess) {
+		allowsIngress := false
+		for _, sg := range i.ClusterSecurityGroups {
+			if permitsPort(sg.IpPermissions, apiServerPort, nodeGroupIDs, privateIP) {
+				allowsIngress = true
+			}
+		}
+		if !allowsIngress {
+			r.add(name, StatusFail, "security groups %v of the control plane don't allow ingress on port %d from instance %s or its security groups %v", clusterGroupIDs.List(), apiServerPort, i.ID(), nodeGroupIDs.List())
+			return
+		}
+	}
+
+	for _, sg := range i.SecurityGroups {
+		if permitsPort(sg.IpPermissions, kubeletPort, clusterGroupIDs, "") {
+			r.add(name, StatusPass, "security groups %v of instance %s allow traffic to and from the control plane", nodeGroupIDs.List(), i.ID())
+			return
+		}
+	}
+	r.add(name, StatusWarn, "security groups %v of instance %s don't allow ingress on port %d from the control plane, 'kubectl logs' and 'kubectl exec' won't work", nodeGroupIDs.List(), i.ID(), kubeletPort)
+}
+
+func securityGroupIDs(securityGroups []*ec2.SecurityGroup) sets.String {
+	ids := sets.NewString()
+	for _, sg := range securityGroups {
+		ids.Insert(aws.StringValue(sg.GroupId))
+	}
+	return ids
+}
+
+// permitsPort returns true when one of the TCP permissions covers the port for one of the security groups,
+// for a CIDR that contains the IP, or for any IP
+func permitsPort(permissions []*ec2.IpPermission, port int64, groupIDs sets.String, ip string) bool {
+	for _, p := range permissions {
+		protocol := aws.StringValue(p.IpProtocol)
+		if protocol != "-1" && protocol != "tcp" && protocol != "6" {
+			continue
+		}
+		if protocol != "-1" && (aws.Int64Value(p.FromPort) > port || aws.Int64Value(p.ToPort) < port) {
+			continue
+		}
+		for _, pair := range p.UserIdGroupPairs {
+			if groupIDs.Has(aws.StringValue(pair.GroupId)) {
+				return true
+			}
+		}
+		for _, ipRange := range p.IpRanges {
+			cidr := aws.StringValue(ipRange.CidrIp)
+			if cidr == anyIPv4 || (ip != "" && containsIP([]string{cidr}, ip)) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// CheckInstanceMetadata checks that the instance has an instance profile and that IMDS is enabled,
+// the bootstrap script and kubelet get the credentials of the instance role from it
+func (r *Report) CheckInstanceMetadata(i *NodeGroupInstance) {
+	const name = "instance profile and IMDS"
+	if i.Instance.IamInstanceProfile == nil {
+		r.add(name, StatusFail, "instance %s has no instance profile, it can't authenticate to the cluster", i.ID())
+		return
+	}
+	profileARN := aws.StringValue(i.Instance.IamInstanceProfile.Arn)
+	options := i.Instance.MetadataOptions
+	if options != nil && aws.StringValue(options.HttpEndpoint) == ec2.InstanceMetadataEndpointStateDisabled {
+		r.add(name, StatusFail, "IMDS is disabled on instance %s, it can't get the credentials of instance profile %q", i.ID(), profileARN)
+		return
+	}
+	r.add(name, StatusPass, "instance %s has instance profile %q and IMDS enabled", i.ID(), profileARN)
+}
+
+// AddNodeLogs adds the last lines of the cloud-init and kubelet logs of the instance, read through SSM,
+// as the details of a check, err is the reason they couldn't be read
+func (r *Report) AddNodeLogs(instanceID, logs string, err error) {
+	const name = "node logs"
+	if err != nil {
+		r.add(name, StatusWarn, "cannot read logs of instance %s: %s", instanceID, err.Error())
+		return
+	}
+	r.add(name, StatusPass, "last lines of the cloud-init and kubelet logs of instance %s", instanceID)
+	r.Checks[len(r.Checks)-1].Details = logs
+}
@@ -0,0 +1,218 @@
+package health_test
+
+import (
+	"errors"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes/fake"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/authconfigmap"
+	. "github.com/weaveworks/eksctl/pkg/health"
+)
+
+var _ = Describe("nodegroup join diagnostics", func() {
+	const roleARN = "arn:aws:iam::123456789012:role/ng-1-node"
+
+	var (
+		report   *Report
+		cluster  *awseks.Cluster
+		instance *NodeGroupInstance
+	)
+
+	BeforeEach(func() {
+		report = &Report{}
+		cluster = &awseks.Cluster{ResourcesVpcConfig: &awseks.VpcConfigResponse{
+			EndpointPublicAccess:  aws.Bool(true),
+			EndpointPrivateAccess: aws.Bool(false),
+			PublicAccessCidrs:     aws.StringSlice([]string{"0.0.0.0/0"}),
+		}}
+		instance = &NodeGroupInstance{
+			Instance: &ec2.Instance{
+				InstanceId:         aws.String("i-1"),
+				SubnetId:           aws.String("subnet-1"),
+				PrivateIpAddress:   aws.String("192.168.0.10"),
+				IamInstanceProfile: &ec2.IamInstanceProfile{Arn: aws.String("arn:aws:iam::123456789012:instance-profile/ng-1")},
+			},
+			RouteTable: &ec2.RouteTable{Routes: []*ec2.Route{
+				{DestinationCidrBlock: aws.String("192.168.0.0/16"), GatewayId: aws.String("local")},
+				{DestinationCidrBlock: aws.String("0.0.0.0/0"), GatewayId: aws.String("igw-1")},
+			}},
+			SecurityGroups: []*ec2.SecurityGroup{{
+				GroupId: aws.String("sg-node"),
+				IpPermissions: []*ec2.IpPermission{{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(1025),
+					ToPort:           aws.Int64(65535),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-cluster")}},
+				}},
+				IpPermissionsEgress: []*ec2.IpPermission{{
+					IpProtocol: aws.String("-1"),
+					IpRanges:   []*ec2.IpRange{{CidrIp: aws.String("0.0.0.0/0")}},
+				}},
+			}},
+			ClusterSecurityGroups: []*ec2.SecurityGroup{{
+				GroupId: aws.String("sg-cluster"),
+				IpPermissions: []*ec2.IpPermission{{
+					IpProtocol:       aws.String("tcp"),
+					FromPort:         aws.Int64(443),
+					ToPort:           aws.Int64(443),
+					UserIdGroupPairs: []*ec2.UserIdGroupPair{{GroupId: aws.String("sg-node")}},
+				}},
+			}},
+		}
+	})
+
+	lastCheck := func() *Check {
+		return report.Checks[len(report.Checks)-1]
+	}
+
+	Context("node role", func() {
+		It("fails when aws-auth maps the role without system:nodes", func() {
+			clientSet := fake.NewSimpleClientset(&corev1.ConfigMap{
+				ObjectMeta: authconfigmap.ObjectMeta(),
+				Data: map[string]string{
+					"mapRoles": `- rolearn: ` + roleARN + `
+  username: system:node:{{EC2PrivateDNSName}}
+  groups: [system:bootstrappers]
+`,
+				},
+			})
+			report.CheckNodeRole(clientSet, api.AuthenticationModeConfigMap, roleARN, false)
+			Expect(lastCheck().Status).To(Equal(StatusFail))
+			Expect(lastCheck().Message).To(ContainSubstring("which lack system:nodes"))
+		})
+
+		It("passes with an access entry, without reading aws-auth", func() {
+			report.CheckNodeRole(fake.NewSimpleClientset(), api.AuthenticationModeAPIAndConfigMap, roleARN, true)
+			Expect(lastCheck().Status).To(Equal(StatusPass))
+		})
+
+		It("fails when the role is neither mapped nor has an access entry", func() {
+			clientSet := fake.NewSimpleClientset(&corev1.ConfigMap{ObjectMeta: authconfigmap.ObjectMeta()})
+			report.CheckNodeRole(clientSet, api.AuthenticationModeAPIAndConfigMap, roleARN, false)
+			Expect(lastCheck().Status).To(Equal(StatusFail))
+			Expect(lastCheck().Message).To(ContainSubstring("eksctl utils repair-aws-auth"))
+		})
+	})
+
+	It("checks that in-service instances registered as nodes", func() {
+		group := &autoscaling.Group{AutoScalingGroupName: aws.String("asg-1")}
+		for _, id := range []string{"i-1", "i-2", "i-3"} {
+			group.Instances = append(group.Instances, &autoscaling.Instance{
+				InstanceId:     aws.String(id),
+				LifecycleState: aws.String(autoscaling.LifecycleStateInService),
+			})
+		}
+		report.CheckInstances(group, sets.NewString("i-1", "i-2", "i-3"))
+		report.CheckInstances(group, sets.NewString("i-1"))
+		report.CheckInstances(group, sets.NewString())
+		report.CheckInstances(&autoscaling.Group{AutoScalingGroupName: aws.String("asg-1")}, sets.NewString())
+		Expect(statuses(report)).To(Equal([]Status{StatusPass, StatusWarn, StatusFail, StatusFail}))
+		Expect(report.Checks[1].Message).To(Equal("2 of 3 in-service instance(s) haven't registered as nodes: i-2, i-3"))
+	})
+
+	Context("route to API server", func() {
+		It("passes with a public IP and an internet gateway", func() {
+			instance.Instance.PublicIpAddress = aws.String("203.0.113.10")
+			report.CheckEndpointRoute(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusPass))
+		})
+
+		It("fails without a public IP in a public subnet", func() {
+			report.CheckEndpointRoute(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusFail))
+			Expect(lastCheck().Message).To(ContainSubstring("instance i-1 has no public IP"))
+		})
+
+		It("fails when the public IP isn't in the public access CIDRs", func() {
+			instance.Instance.PublicIpAddress = aws.String("203.0.113.10")
+			cluster.ResourcesVpcConfig.PublicAccessCidrs = aws.StringSlice([]string{"198.51.100.0/24"})
+			report.CheckEndpointRoute(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusFail))
+			Expect(lastCheck().Message).To(Equal("public IP 203.0.113.10 of instance i-1 isn't in the public access CIDRs [198.51.100.0/24] of the cluster endpoint"))
+		})
+
+		It("fails without a default route", func() {
+			instance.RouteTable.Routes = instance.RouteTable.Routes[:1]
+			report.CheckEndpointRoute(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusFail))
+		})
+
+		It("passes without a default route when the endpoint has private access", func() {
+			instance.RouteTable.Routes = instance.RouteTable.Routes[:1]
+			cluster.ResourcesVpcConfig.EndpointPrivateAccess = aws.Bool(true)
+			report.CheckEndpointRoute(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusPass))
+		})
+	})
+
+	Context("security groups", func() {
+		BeforeEach(func() {
+			cluster.ResourcesVpcConfig.EndpointPrivateAccess = aws.Bool(true)
+		})
+
+		It("passes when nodes and the control plane can reach each other", func() {
+			report.CheckSecurityGroups(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusPass))
+		})
+
+		It("fails without egress to the API server", func() {
+			instance.SecurityGroups[0].IpPermissionsEgress = nil
+			report.CheckSecurityGroups(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusFail))
+		})
+
+		It("fails when the control plane doesn't accept the nodes", func() {
+			instance.ClusterSecurityGroups[0].IpPermissions[0].FromPort = aws.Int64(80)
+			instance.ClusterSecurityGroups[0].IpPermissions[0].ToPort = aws.Int64(80)
+			report.CheckSecurityGroups(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusFail))
+		})
+
+		It("accepts ingress from a CIDR containing the IP of the instance", func() {
+			instance.ClusterSecurityGroups[0].IpPermissions[0].UserIdGroupPairs = nil
+			instance.ClusterSecurityGroups[0].IpPermissions[0].IpRanges = []*ec2.IpRange{{CidrIp: aws.String("192.168.0.0/16")}}
+			report.CheckSecurityGroups(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusPass))
+		})
+
+		It("warns when the control plane can't reach kubelet", func() {
+			instance.SecurityGroups[0].IpPermissions = nil
+			report.CheckSecurityGroups(cluster, instance)
+			Expect(lastCheck().Status).To(Equal(StatusWarn))
+		})
+	})
+
+	It("fails without an instance profile or with IMDS disabled", func() {
+		report.CheckInstanceMetadata(instance)
+		instance.Instance.MetadataOptions = &ec2.InstanceMetadataOptionsResponse{HttpEndpoint: aws.String(ec2.InstanceMetadataEndpointStateDisabled)}
+		report.CheckInstanceMetadata(instance)
+		instance.Instance.IamInstanceProfile = nil
+		report.CheckInstanceMetadata(instance)
+		Expect(statuses(report)).To(Equal([]Status{StatusPass, StatusFail, StatusFail}))
+	})
+
+	It("adds the logs of the node as details", func() {
+		report.AddNodeLogs("i-1", "kubelet: Unauthorized", nil)
+		report.AddNodeLogs("i-1", "", errors.New("the SSM agent of the instance isn't online"))
+		Expect(statuses(report)).To(Equal([]Status{StatusPass, StatusWarn}))
+		Expect(report.Checks[0].Details).To(Equal("kubelet: Unauthorized"))
+		Expect(report.Checks[1].Details).To(BeEmpty())
+	})
+})
+
+func statuses(report *Report) []Status {
+	statuses := []Status{}
+	for _, check := range report.Checks {
+		statuses = append(statuses, check.Status)
+	}
+	return statuses
+}
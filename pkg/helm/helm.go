@@ -0,0 +1,84 @@
+package helm
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"sigs.k8s.io/yaml"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/git/executor"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// Installer installs Helm charts to a cluster by running the helm CLI
+type Installer struct {
+	executor   executor.Executor
+	kubeconfig *clientcmdapi.Config
+	timeout    time.Duration
+}
+
+// NewInstaller creates a new Installer, the kubeconfig is expected to have credentials embedded
+func NewInstaller(kubeconfig *clientcmdapi.Config, timeout time.Duration) *Installer {
+	return NewInstallerFromExecutor(executor.NewShellExecutor(nil), kubeconfig, timeout)
+}
+
+// NewInstallerFromExecutor returns an Installer that can have an executor injected. Useful for testing
+func NewInstallerFromExecutor(executor executor.Executor, kubeconfig *clientcmdapi.Config, timeout time.Duration) *Installer {
+	return &Installer{
+		executor:   executor,
+		kubeconfig: kubeconfig,
+		timeout:    timeout,
+	}
+}
+
+// Install installs the release, or upgrades it if it exists already, and waits for its resources to be ready
+func (i *Installer) Install(release *api.HelmRelease) error {
+	dir, err := ioutil.TempDir("", "eksctl-helm-")
+	if err != nil {
+		return errors.Wrap(err, "creating temporary directory")
+	}
+	defer os.RemoveAll(dir)
+
+	kubeconfigPath := filepath.Join(dir, "kubeconfig")
+	if err := clientcmd.WriteToFile(*i.kubeconfig, kubeconfigPath); err != nil {
+		return errors.Wrap(err, "writing kubeconfig for helm")
+	}
+
+	args := []string{
+		"upgrade", "--install", release.Name, release.ChartReference(),
+		"--namespace", release.Namespace,
+		"--create-namespace",
+		"--kubeconfig", kubeconfigPath,
+		"--wait",
+		"--timeout", i.timeout.String(),
+	}
+	if !release.IsOCI() {
+		args = append(args, "--repo", release.Repo)
+	}
+	if release.Version != "" {
+		args = append(args, "--version", release.Version)
+	}
+	if len(release.Values) > 0 {
+		values, err := yaml.Marshal(release.Values)
+		if err != nil {
+			return errors.Wrapf(err, "serialising values of %q", release.Name)
+		}
+		valuesPath := filepath.Join(dir, "values.yaml")
+		if err := ioutil.WriteFile(valuesPath, values, 0600); err != nil {
+			return errors.Wrapf(err, "writing values of %q", release.Name)
+		}
+		args = append(args, "--values", valuesPath)
+	}
+
+	logger.Info("installing Helm chart %q as release %q in namespace %q", release.Chart, release.Name, release.Namespace)
+	if err := i.executor.Exec("helm", dir, args...); err != nil {
+		return errors.Wrapf(err, "installing release %q, make sure helm v3 is installed", release.Name)
+	}
+	return nil
+}
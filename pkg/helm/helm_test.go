@@ -0,0 +1,77 @@
+package helm
+
+import (
+	"io/ioutil"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/git/executor"
+)
+
+var _ = Describe("Installer", func() {
+	var (
+		fakeExecutor *executor.FakeExecutor
+		installer    *Installer
+	)
+
+	BeforeEach(func() {
+		fakeExecutor = new(executor.FakeExecutor)
+		installer = NewInstallerFromExecutor(fakeExecutor, clientcmdapi.NewConfig(), 5*time.Minute)
+	})
+
+	It("installs a chart from a repository with the given values", func() {
+		var values string
+		fakeExecutor.On("Exec", "helm", mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+			helmArgs := args.Get(2).([]string)
+			data, err := ioutil.ReadFile(helmArgs[len(helmArgs)-1])
+			Expect(err).ToNot(HaveOccurred())
+			values = string(data)
+		})
+
+		err := installer.Install(&api.HelmRelease{
+			Name:      "metrics-server",
+			Namespace: "kube-system",
+			Repo:      "https://kubernetes-sigs.github.io/metrics-server",
+			Chart:     "metrics-server",
+			Version:   "3.8.2",
+			Values:    api.InlineDocument{"replicas": 2},
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		helmArgs := fakeExecutor.Calls[0].Arguments[2].([]string)
+		Expect(helmArgs[:7]).To(Equal([]string{
+			"upgrade", "--install", "metrics-server", "metrics-server",
+			"--namespace", "kube-system",
+			"--create-namespace",
+		}))
+		Expect(helmArgs).To(ContainElement("--wait"))
+		Expect(helmArgs[11:16]).To(Equal([]string{
+			"5m0s",
+			"--repo", "https://kubernetes-sigs.github.io/metrics-server",
+			"--version", "3.8.2",
+		}))
+		Expect(values).To(Equal("replicas: 2\n"))
+	})
+
+	It("installs a chart from an OCI registry by its reference", func() {
+		fakeExecutor.On("Exec", "helm", mock.Anything, mock.Anything).Return(nil)
+
+		err := installer.Install(&api.HelmRelease{
+			Name:      "karpenter",
+			Namespace: "karpenter",
+			Repo:      "oci://public.ecr.aws/karpenter/",
+			Chart:     "karpenter",
+		})
+		Expect(err).ToNot(HaveOccurred())
+
+		helmArgs := fakeExecutor.Calls[0].Arguments[2].([]string)
+		Expect(helmArgs[3]).To(Equal("oci://public.ecr.aws/karpenter/karpenter"))
+		Expect(helmArgs).ToNot(ContainElement("--repo"))
+		Expect(helmArgs).ToNot(ContainElement("--values"))
+	})
+})
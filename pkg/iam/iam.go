@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
@@ -12,16 +11,15 @@ import (
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // ImportInstanceRoleFromProfileARN fetches first role ARN from instance profile
 func ImportInstanceRoleFromProfileARN(provider api.ClusterProvider, ng *api.NodeGroup, profileARN string) error {
-	partsOfProfileARN := strings.Split(profileARN, "/")
-
-	if len(partsOfProfileARN) != 2 {
+	if !strings.Contains(profileARN, ":instance-profile/") {
 		return fmt.Errorf("unexpected format of instance profile ARN: %q", profileARN)
 	}
-	profileName := partsOfProfileARN[1]
+	profileName := resourceName(profileARN)
 	input := &awsiam.GetInstanceProfileInput{
 		InstanceProfileName: &profileName,
 	}
@@ -0,0 +1,152 @@
+package iam
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go/service/iam"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+const (
+	policyAmazonEKSWorkerNodePolicy          = "AmazonEKSWorkerNodePolicy"
+	policyAmazonEC2ContainerRegistryReadOnly = "AmazonEC2ContainerRegistryReadOnly"
+	policyAmazonEKSCNIPolicy                 = "AmazonEKS_CNI_Policy"
+
+	// nodeGroupServiceLinkedRoleName is the role EKS uses to manage the instances of managed nodegroups,
+	// EKS creates it along with the first managed nodegroup of an account
+	nodeGroupServiceLinkedRoleName = "AWSServiceRoleForAmazonEKSNodegroup"
+)
+
+// requiredNodePolicies are the managed policies that an existing node role must have attached,
+// the CNI policy isn't required as aws-node may use an iamserviceaccount instead
+var requiredNodePolicies = []string{
+	policyAmazonEKSWorkerNodePolicy,
+	policyAmazonEC2ContainerRegistryReadOnly,
+}
+
+// CheckNodeGroupRoles checks the existing instance profiles and roles that nodegroups use instead of
+// creating their own, so that a role that nodes can't join the cluster with is found before any stack
+// is created; checks that IAM doesn't allow, e.g. in accounts where IAM is managed by a central team,
+// are skipped with a warning
+func CheckNodeGroupRoles(provider api.ClusterProvider, nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup) error {
+	for _, ng := range nodeGroups {
+		if ng.IAM == nil || (ng.IAM.InstanceProfileARN == "" && ng.IAM.InstanceRoleARN == "") {
+			continue
+		}
+		roleARN := ng.IAM.InstanceRoleARN
+		if ng.IAM.InstanceProfileARN != "" {
+			profileRoleARN, err := getInstanceProfileRoleARN(provider, ng.IAM.InstanceProfileARN)
+			if err != nil {
+				if isAccessDenied(err) {
+					logger.Warning("not allowed to check instance profile %q of nodegroup %q: %s", ng.IAM.InstanceProfileARN, ng.Name, err.Error())
+					continue
+				}
+				return errors.Wrapf(err, "checking instance profile of nodegroup %q", ng.Name)
+			}
+			if roleARN != "" && roleARN != profileRoleARN {
+				return fmt.Errorf("iam.instanceRoleARN of nodegroup %q is %q, but its instance profile %q has role %q", ng.Name, roleARN, ng.IAM.InstanceProfileARN, profileRoleARN)
+			}
+			roleARN = profileRoleARN
+		}
+		if err := checkNodeRolePolicies(provider, "nodegroup", ng.Name, roleARN); err != nil {
+			return err
+		}
+	}
+
+	usesServiceLinkedRole := false
+	for _, ng := range managedNodeGroups {
+		usesServiceLinkedRole = true
+		if ng.IAM == nil || ng.IAM.InstanceRoleARN == "" {
+			continue
+		}
+		if err := checkNodeRolePolicies(provider, "managed nodegroup", ng.Name, ng.IAM.InstanceRoleARN); err != nil {
+			return err
+		}
+	}
+	if usesServiceLinkedRole {
+		checkNodeGroupServiceLinkedRole(provider)
+	}
+	return nil
+}
+
+func getInstanceProfileRoleARN(provider api.ClusterProvider, profileARN string) (string, error) {
+	output, err := provider.IAM().GetInstanceProfile(&awsiam.GetInstanceProfileInput{
+		InstanceProfileName: aws.String(resourceName(profileARN)),
+	})
+	if err != nil {
+		return "", err
+	}
+	roles := output.InstanceProfile.Roles
+	if len(roles) == 0 {
+		return "", fmt.Errorf("instance profile %q has no roles", profileARN)
+	}
+	return *roles[0].Arn, nil
+}
+
+// checkNodeRolePolicies checks that the required managed policies are attached to an existing role
+func checkNodeRolePolicies(provider api.ClusterProvider, kind, name, roleARN string) error {
+	attached := map[string]bool{}
+	input := &awsiam.ListAttachedRolePoliciesInput{
+		RoleName: aws.String(resourceName(roleARN)),
+	}
+	err := provider.IAM().ListAttachedRolePoliciesPages(input, func(p *awsiam.ListAttachedRolePoliciesOutput, _ bool) bool {
+		for _, policy := range p.AttachedPolicies {
+			attached[*policy.PolicyName] = true
+		}
+		return true
+	})
+	if err != nil {
+		if isAccessDenied(err) {
+			logger.Warning("not allowed to check the policies of role %q of %s %q: %s", roleARN, kind, name, err.Error())
+			return nil
+		}
+		return errors.Wrapf(err, "listing policies of role %q of %s %q", roleARN, kind, name)
+	}
+
+	missing := []string{}
+	for _, policy := range requiredNodePolicies {
+		if !attached[policy] {
+			missing = append(missing, policy)
+		}
+	}
+	if len(missing) > 0 {
+		return fmt.Errorf("role %q of %s %q must have the %s managed policies attached, nodes can't join the cluster without them", roleARN, kind, name, strings.Join(missing, ", "))
+	}
+	if !attached[policyAmazonEKSCNIPolicy] {
+		logger.Warning("role %q of %s %q doesn't have the %s managed policy attached, the aws-node daemonset must use an iamserviceaccount with it", roleARN, kind, name, policyAmazonEKSCNIPolicy)
+	}
+	logger.Info("%s %q will use existing role %q", kind, name, roleARN)
+	return nil
+}
+
+// checkNodeGroupServiceLinkedRole warns when EKS will have to create the service-linked role of managed
+// nodegroups, which needs iam:CreateServiceLinkedRole
+func checkNodeGroupServiceLinkedRole(provider api.ClusterProvider) {
+	_, err := provider.IAM().GetRole(&awsiam.GetRoleInput{
+		RoleName: aws.String(nodeGroupServiceLinkedRoleName),
+	})
+	if err == nil {
+		return
+	}
+	if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == awsiam.ErrCodeNoSuchEntityException {
+		logger.Warning("service-linked role %q doesn't exist yet, EKS creates it along with the first managed nodegroup, which requires iam:CreateServiceLinkedRole", nodeGroupServiceLinkedRoleName)
+		return
+	}
+	logger.Debug("couldn't check service-linked role %q: %s", nodeGroupServiceLinkedRoleName, err.Error())
+}
+
+// resourceName returns the name of an IAM role or instance profile given its ARN, which may have a path
+func resourceName(arn string) string {
+	return arn[strings.LastIndex(arn, "/")+1:]
+}
+
+func isAccessDenied(err error) bool {
+	awsErr, ok := errors.Cause(err).(awserr.Error)
+	return ok && (awsErr.Code() == "AccessDenied" || awsErr.Code() == "AccessDeniedException")
+}
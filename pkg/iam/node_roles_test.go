@@ -0,0 +1,84 @@
+package iam
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	awsiam "github.com/aws/aws-sdk-go/service/iam"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("CheckNodeGroupRoles", func() {
+	const (
+		profileARN = "arn:aws:iam::123456789012:instance-profile/central/eks-nodes"
+		roleARN    = "arn:aws:iam::123456789012:role/central/eks-nodes"
+	)
+
+	var (
+		p  *mockprovider.MockProvider
+		ng *api.NodeGroup
+	)
+
+	mockAttachedPolicies := func(names ...string) {
+		p.MockIAM().On("ListAttachedRolePoliciesPages", mock.MatchedBy(func(input *awsiam.ListAttachedRolePoliciesInput) bool {
+			return *input.RoleName == "eks-nodes"
+		}), mock.Anything).Run(func(args mock.Arguments) {
+			policies := []*awsiam.AttachedPolicy{}
+			for _, name := range names {
+				policies = append(policies, &awsiam.AttachedPolicy{PolicyName: aws.String(name)})
+			}
+			consume := args[1].(func(*awsiam.ListAttachedRolePoliciesOutput, bool) bool)
+			consume(&awsiam.ListAttachedRolePoliciesOutput{AttachedPolicies: policies}, true)
+		}).Return(nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		ng = api.NewClusterConfig().NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.IAM.InstanceProfileARN = profileARN
+
+		p.MockIAM().On("GetInstanceProfile", mock.MatchedBy(func(input *awsiam.GetInstanceProfileInput) bool {
+			return *input.InstanceProfileName == "eks-nodes"
+		})).Return(&awsiam.GetInstanceProfileOutput{
+			InstanceProfile: &awsiam.InstanceProfile{
+				Roles: []*awsiam.Role{{Arn: aws.String(roleARN)}},
+			},
+		}, nil)
+	})
+
+	It("accepts an instance profile whose role has the node policies", func() {
+		mockAttachedPolicies("AmazonEKSWorkerNodePolicy", "AmazonEC2ContainerRegistryReadOnly", "AmazonEKS_CNI_Policy")
+		Expect(CheckNodeGroupRoles(p, []*api.NodeGroup{ng}, nil)).To(Succeed())
+	})
+
+	It("fails when the role of the instance profile is missing node policies", func() {
+		mockAttachedPolicies("AmazonEKS_CNI_Policy")
+		Expect(CheckNodeGroupRoles(p, []*api.NodeGroup{ng}, nil)).To(MatchError(`role "arn:aws:iam::123456789012:role/central/eks-nodes" of nodegroup "ng-1" must have the AmazonEKSWorkerNodePolicy, AmazonEC2ContainerRegistryReadOnly managed policies attached, nodes can't join the cluster without them`))
+	})
+
+	It("fails when the role doesn't belong to the instance profile", func() {
+		ng.IAM.InstanceRoleARN = "arn:aws:iam::123456789012:role/other"
+		Expect(CheckNodeGroupRoles(p, []*api.NodeGroup{ng}, nil)).To(MatchError(ContainSubstring(`but its instance profile "arn:aws:iam::123456789012:instance-profile/central/eks-nodes" has role`)))
+	})
+
+	It("skips the check when IAM doesn't allow it", func() {
+		p.MockIAM().On("ListAttachedRolePoliciesPages", mock.Anything, mock.Anything).Return(awserr.New("AccessDenied", "not authorized", nil))
+		Expect(CheckNodeGroupRoles(p, []*api.NodeGroup{ng}, nil)).To(Succeed())
+	})
+
+	It("checks the roles of managed nodegroups and their service-linked role", func() {
+		mockAttachedPolicies("AmazonEKSWorkerNodePolicy", "AmazonEC2ContainerRegistryReadOnly")
+		p.MockIAM().On("GetRole", mock.Anything).Return(nil, awserr.New(awsiam.ErrCodeNoSuchEntityException, "not found", nil))
+
+		mng := api.NewManagedNodeGroup()
+		mng.Name = "mng-1"
+		mng.IAM.InstanceRoleARN = roleARN
+		Expect(CheckNodeGroupRoles(p, nil, []*api.ManagedNodeGroup{mng})).To(Succeed())
+		p.MockIAM().AssertCalled(GinkgoT(), "GetRole", &awsiam.GetRoleInput{RoleName: aws.String("AWSServiceRoleForAmazonEKSNodegroup")})
+	})
+})
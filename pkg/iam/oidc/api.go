@@ -2,9 +2,11 @@ package iamoidc
 
 import (
 	"crypto/sha1"
-	"crypto/tls"
 	"fmt"
+	"net/http"
 	"net/url"
+	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
@@ -12,10 +14,18 @@ import (
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
 	"github.com/pkg/errors"
 
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	cft "github.com/weaveworks/eksctl/pkg/cfn/template"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/httpclient"
 )
 
-const defaultAudience = "sts.amazonaws.com"
+const (
+	defaultAudience = "sts.amazonaws.com"
+
+	// issuerTimeout is how long connecting to the OIDC issuer may take
+	issuerTimeout = 30 * time.Second
+)
 
 // OpenIDConnectManager hold information about IAM OIDC integration
 type OpenIDConnectManager struct {
@@ -58,10 +68,7 @@ func NewOpenIDConnectManager(iamapi iamiface.IAMAPI, accountID, issuer string) (
 // if it was unable to call IAM API
 func (m *OpenIDConnectManager) CheckProviderExists() (bool, error) {
 	input := &awsiam.GetOpenIDConnectProviderInput{
-		OpenIDConnectProviderArn: aws.String(
-			fmt.Sprintf("arn:aws:iam::%s:oidc-provider/%s",
-				m.accountID, m.hostnameAndPath()),
-		),
+		OpenIDConnectProviderArn: aws.String(m.providerARN(m.accountID)),
 	}
 	_, err := m.iam.GetOpenIDConnectProvider(input)
 	if err != nil {
@@ -113,21 +120,32 @@ func (m *OpenIDConnectManager) DeleteProvider() error {
 }
 
 // getIssuerCAThumbprint obtains thumbprint of root CA by connecting to the
-// OIDC issuer and parsing certificates
+// OIDC issuer and parsing certificates, through the proxy of HTTPS_PROXY
 func (m *OpenIDConnectManager) getIssuerCAThumbprint() error {
-	config := &tls.Config{InsecureSkipVerify: m.insecureSkipVerify}
+	transport := httpclient.NewTransport()
+	transport.TLSClientConfig.InsecureSkipVerify = m.insecureSkipVerify
+	client := &http.Client{Transport: transport, Timeout: issuerTimeout}
 
-	conn, err := tls.Dial("tcp", m.issuerURL.Host, config)
+	resp, err := client.Get(m.issuerURL.String())
 	if err != nil {
+		// the error of the request already names the URL
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
 		return errors.Wrapf(err, "connecting to issuer OIDC (%s)", m.issuerURL)
 	}
-	defer conn.Close()
-
-	cs := conn.ConnectionState()
-	if numCerts := len(cs.PeerCertificates); numCerts >= 1 {
-		root := cs.PeerCertificates[numCerts-1]
-		m.issuerCAThumbprint = fmt.Sprintf("%x", sha1.Sum(root.Raw))
-		return nil
+	defer resp.Body.Close()
+
+	if resp.TLS != nil {
+		if numCerts := len(resp.TLS.PeerCertificates); numCerts >= 1 {
+			root := resp.TLS.PeerCertificates[numCerts-1]
+			// the thumbprint would be that of the proxy rather than of the issuer
+			if httpclient.SignedByCABundle(root) {
+				return fmt.Errorf("the certificate of OIDC issuer %s was issued by a CA of the CA bundle, e.g. by a TLS-intercepting proxy; add %s to NO_PROXY", m.issuerURL, m.issuerURL.Hostname())
+			}
+			m.issuerCAThumbprint = fmt.Sprintf("%x", sha1.Sum(root.Raw))
+			return nil
+		}
 	}
 	return fmt.Errorf("unable to get OIDC issuer's certificate")
 }
@@ -135,13 +153,91 @@ func (m *OpenIDConnectManager) getIssuerCAThumbprint() error {
 // MakeAssumeRolePolicyDocument constructs a trust policy document for the given
 // provider
 func (m *OpenIDConnectManager) MakeAssumeRolePolicyDocument(serviceAccountNamespace, serviceAccountName string) cft.MapOfInterfaces {
+	return cft.MakeAssumeRoleWithWebIdentityPolicyDocument(m.ProviderARN, m.makeServiceAccountCondition(serviceAccountNamespace, serviceAccountName))
+}
+
+// WithIssuer returns a manager for another issuer, e.g. the one of a cluster in another account,
+// whose provider ARN refers to the provider of that issuer in the same account
+func (m *OpenIDConnectManager) WithIssuer(issuer string) (*OpenIDConnectManager, error) {
+	other, err := NewOpenIDConnectManager(m.iam, m.accountID, issuer)
+	if err != nil {
+		return nil, err
+	}
+	other.ProviderARN = other.providerARN(other.accountID)
+	return other, nil
+}
+
+// MakeCrossAccountAssumeRolePolicyDocument constructs a trust policy document with a statement for the provider
+// of the issuer in each of the given accounts, which default to the account of the cluster
+func (m *OpenIDConnectManager) MakeCrossAccountAssumeRolePolicyDocument(accountIDs []string, serviceAccountNamespace, serviceAccountName string) cft.MapOfInterfaces {
+	if len(accountIDs) == 0 {
+		accountIDs = []string{m.accountID}
+	}
+	statements := []cft.MapOfInterfaces{}
+	for _, accountID := range accountIDs {
+		statements = append(statements, cft.MapOfInterfaces{
+			"Effect": "Allow",
+			"Action": []string{"sts:AssumeRoleWithWebIdentity"},
+			"Principal": map[string]string{
+				"Federated": m.providerARN(accountID),
+			},
+			"Condition": m.makeServiceAccountCondition(serviceAccountNamespace, serviceAccountName),
+		})
+	}
+	return cft.MakePolicyDocument(statements...)
+}
+
+// CheckCrossAccountProvidersExist returns an error if the provider of the issuer doesn't exist in one of the
+// given accounts, which default to the account of the cluster; providers of other accounts usually can't be
+// read with the credentials of this one, that is only warned about
+func (m *OpenIDConnectManager) CheckCrossAccountProvidersExist(accountIDs []string) error {
+	if len(accountIDs) == 0 {
+		accountIDs = []string{m.accountID}
+	}
+	for _, accountID := range accountIDs {
+		input := &awsiam.GetOpenIDConnectProviderInput{
+			OpenIDConnectProviderArn: aws.String(m.providerARN(accountID)),
+		}
+		_, err := m.iam.GetOpenIDConnectProvider(input)
+		if err == nil {
+			continue
+		}
+		if awsError, ok := err.(awserr.Error); ok {
+			switch {
+			case awsError.Code() == awsiam.ErrCodeNoSuchEntityException:
+				return fmt.Errorf("IAM OIDC provider %q does not exist", *input.OpenIDConnectProviderArn)
+			case awsError.Code() == "AccessDenied" && accountID != m.accountID:
+				logger.Warning("unable to check whether IAM OIDC provider %q exists, as it belongs to another account", *input.OpenIDConnectProviderArn)
+				continue
+			}
+		}
+		return errors.Wrapf(err, "checking IAM OIDC provider %q", *input.OpenIDConnectProviderArn)
+	}
+	return nil
+}
+
+func (m *OpenIDConnectManager) makeServiceAccountCondition(serviceAccountNamespace, serviceAccountName string) cft.MapOfInterfaces {
 	subject := fmt.Sprintf("system:serviceaccount:%s:%s", serviceAccountNamespace, serviceAccountName)
-	return cft.MakeAssumeRoleWithWebIdentityPolicyDocument(m.ProviderARN, cft.MapOfInterfaces{
+	return cft.MapOfInterfaces{
 		"StringEquals": map[string]string{
 			m.hostnameAndPath() + ":sub": subject,
 			m.hostnameAndPath() + ":aud": defaultAudience,
 		},
-	})
+	}
+}
+
+func (m *OpenIDConnectManager) providerARN(accountID string) string {
+	return fmt.Sprintf("arn:%s:iam::%s:oidc-provider/%s", m.partition(), accountID, m.hostnameAndPath())
+}
+
+// partition returns the partition of the issuer, whose hostname is oidc.eks.<region>.amazonaws.com
+// or oidc.eks.<region>.amazonaws.com.cn
+func (m *OpenIDConnectManager) partition() string {
+	parts := strings.Split(m.issuerURL.Hostname(), ".")
+	if len(parts) < 3 || parts[0] != "oidc" {
+		return api.PartitionAWS
+	}
+	return api.Partition(parts[2])
 }
 
 func (m *OpenIDConnectManager) hostnameAndPath() string {
@@ -217,6 +217,95 @@ var _ = Describe("EKS/IAM API wrapper", func() {
 		})
 
 	})
+
+	Describe("partitions", func() {
+		It("should refer to the provider in the partition of the issuer", func() {
+			for issuer, expected := range map[string]string{
+				"https://oidc.eks.us-west-2.amazonaws.com/id/A":     "arn:aws:iam::12345:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/A",
+				"https://oidc.eks.cn-north-1.amazonaws.com.cn/id/A": "arn:aws-cn:iam::12345:oidc-provider/oidc.eks.cn-north-1.amazonaws.com.cn/id/A",
+				"https://oidc.eks.us-gov-west-1.amazonaws.com/id/A": "arn:aws-us-gov:iam::12345:oidc-provider/oidc.eks.us-gov-west-1.amazonaws.com/id/A",
+			} {
+				oidc, err := NewOpenIDConnectManager(nil, "12345", issuer)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(oidc.providerARN("12345")).To(Equal(expected))
+			}
+		})
+	})
+
+	Describe("cross-account trust policies", func() {
+		const (
+			hubProviderARN   = "arn:aws:iam::111122223333:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/HUB"
+			spokeProviderARN = "arn:aws:iam::444455556666:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/HUB"
+			otherProviderARN = "arn:aws:iam::777788889999:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/HUB"
+		)
+
+		var (
+			p    *mockprovider.MockProvider
+			oidc *OpenIDConnectManager
+		)
+
+		BeforeEach(func() {
+			p = mockprovider.NewMockProvider()
+
+			p.MockIAM().On("GetOpenIDConnectProvider", mock.MatchedBy(func(input *awsiam.GetOpenIDConnectProviderInput) bool {
+				return *input.OpenIDConnectProviderArn == hubProviderARN
+			})).Return(&awsiam.GetOpenIDConnectProviderOutput{}, nil)
+			p.MockIAM().On("GetOpenIDConnectProvider", mock.MatchedBy(func(input *awsiam.GetOpenIDConnectProviderInput) bool {
+				return *input.OpenIDConnectProviderArn == spokeProviderARN
+			})).Return(nil, awserr.New("AccessDenied", "not authorized", nil))
+			p.MockIAM().On("GetOpenIDConnectProvider", mock.MatchedBy(func(input *awsiam.GetOpenIDConnectProviderInput) bool {
+				return *input.OpenIDConnectProviderArn == otherProviderARN
+			})).Return(nil, awserr.New(awsiam.ErrCodeNoSuchEntityException, "provider is not there", nil))
+
+			cluster, err := NewOpenIDConnectManager(p.IAM(), "111122223333", exampleIssuer)
+			Expect(err).NotTo(HaveOccurred())
+			oidc, err = cluster.WithIssuer("https://oidc.eks.us-west-2.amazonaws.com/id/HUB")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("should refer to the provider of another issuer", func() {
+			Expect(oidc.ProviderARN).To(Equal(hubProviderARN))
+		})
+
+		It("should construct a statement for the provider in each account", func() {
+			document := oidc.MakeCrossAccountAssumeRolePolicyDocument([]string{"111122223333", "444455556666"}, "test-ns1", "test-sa1")
+
+			statement := func(providerARN string) string {
+				return `{
+					"Effect": "Allow",
+					"Principal": {
+						"Federated": "` + providerARN + `"
+					},
+					"Action": ["sts:AssumeRoleWithWebIdentity"],
+					"Condition": {
+						"StringEquals": {
+							"oidc.eks.us-west-2.amazonaws.com/id/HUB:sub": "system:serviceaccount:test-ns1:test-sa1",
+							"oidc.eks.us-west-2.amazonaws.com/id/HUB:aud": "sts.amazonaws.com"
+						}
+					}
+				}`
+			}
+			expected := `{
+				"Version": "2012-10-17",
+				"Statement": [` + statement(hubProviderARN) + `,` + statement(spokeProviderARN) + `]
+			}`
+
+			js, err := json.Marshal(document)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(js).To(MatchJSON(expected))
+
+			js, err = json.Marshal(oidc.MakeCrossAccountAssumeRolePolicyDocument(nil, "test-ns1", "test-sa1"))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(js).To(MatchJSON(`{"Version": "2012-10-17", "Statement": [` + statement(hubProviderARN) + `]}`))
+		})
+
+		It("should check that the providers exist", func() {
+			Expect(oidc.CheckCrossAccountProvidersExist(nil)).To(Succeed())
+			// providers of other accounts that can't be read are not treated as missing
+			Expect(oidc.CheckCrossAccountProvidersExist([]string{"111122223333", "444455556666"})).To(Succeed())
+			Expect(oidc.CheckCrossAccountProvidersExist([]string{"777788889999"})).To(MatchError(`IAM OIDC provider "` + otherProviderARN + `" does not exist`))
+		})
+	})
 })
 
 type testServer struct {
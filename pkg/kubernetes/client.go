@@ -2,11 +2,11 @@ package kubernetes
 
 import (
 	"fmt"
+	"io/ioutil"
 	"time"
 
 	"github.com/blang/semver"
 	jsonpatch "github.com/evanphx/json-patch"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
@@ -24,6 +24,9 @@ import (
 	"k8s.io/client-go/kubernetes/scheme"
 	restclient "k8s.io/client-go/rest"
 	"k8s.io/client-go/restmapper"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/httpclient"
 )
 
 // Interface is an alias to avoid having to import k8s.io/client-go/kubernetes
@@ -88,6 +91,28 @@ type RawResource struct {
 	GVK    *schema.GroupVersionKind
 }
 
+// UseCABundle makes clients of config trust the CAs of the bundle of --ca-bundle along with the CA
+// of the cluster, e.g. that of a TLS-intercepting proxy; client-go already connects through the proxy
+// of HTTPS_PROXY, unless NO_PROXY excludes the endpoint of the cluster
+func UseCABundle(config *restclient.Config) error {
+	bundle := httpclient.CABundle()
+	if bundle == nil {
+		return nil
+	}
+	caData := config.CAData
+	if len(caData) == 0 && config.CAFile != "" {
+		data, err := ioutil.ReadFile(config.CAFile)
+		if err != nil {
+			return errors.Wrapf(err, "reading CA of cluster %q", config.CAFile)
+		}
+		caData = data
+	}
+	// CAData takes precedence over CAFile
+	config.CAData = append(append(append([]byte{}, caData...), '\n'), bundle...)
+	config.CAFile = ""
+	return nil
+}
+
 // NewRawClient creates a new raw REST client
 func NewRawClient(clientSet Interface, config *restclient.Config) (*RawClient, error) {
 	c := &RawClient{
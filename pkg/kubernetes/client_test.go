@@ -11,7 +11,11 @@ import (
 
 	"k8s.io/apimachinery/pkg/runtime"
 
+	restclient "k8s.io/client-go/rest"
+
+	. "github.com/weaveworks/eksctl/pkg/kubernetes"
 	"github.com/weaveworks/eksctl/pkg/testutils"
+	"github.com/weaveworks/eksctl/pkg/utils/httpclient"
 )
 
 var _ = Describe("Kubernetes client wrappers", func() {
@@ -235,4 +239,20 @@ var _ = Describe("Kubernetes client wrappers", func() {
 			Expect(ct.DeletedItems()).To(HaveLen(10))
 		})
 	})
+
+	Describe("CA bundle", func() {
+		AfterEach(func() {
+			Expect(httpclient.LoadCABundle("")).To(Succeed())
+		})
+
+		It("trusts the CAs of the bundle along with the CA of the cluster", func() {
+			config := &restclient.Config{TLSClientConfig: restclient.TLSClientConfig{CAData: []byte("CLUSTER-CA")}}
+			Expect(UseCABundle(config)).To(Succeed())
+			Expect(config.CAData).To(Equal([]byte("CLUSTER-CA")))
+
+			Expect(httpclient.LoadCABundle("../iam/oidc/testdata/ca.pem")).To(Succeed())
+			Expect(UseCABundle(config)).To(Succeed())
+			Expect(string(config.CAData)).To(HavePrefix("CLUSTER-CA\n-----BEGIN CERTIFICATE-----"))
+		})
+	})
 })
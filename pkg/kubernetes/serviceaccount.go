@@ -1,11 +1,12 @@
 package kubernetes
 
 import (
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // NewServiceAccount creates a corev1.ServiceAccount object using the provided meta.
@@ -113,3 +114,24 @@ func MaybeDeleteServiceAccount(clientSet Interface, meta metav1.ObjectMeta) erro
 	logger.Info("deleted serviceaccount %q", name)
 	return nil
 }
+
+// MaybeRemoveServiceAccountAnnotation removes an annotation from the serviceaccount, if both exist
+func MaybeRemoveServiceAccountAnnotation(clientSet Interface, meta metav1.ObjectMeta, key string) error {
+	name := meta.Namespace + "/" + meta.Name
+	current, err := clientSet.CoreV1().ServiceAccounts(meta.Namespace).Get(meta.Name, metav1.GetOptions{})
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil
+		}
+		return errors.Wrapf(err, "getting serviceaccount %q", name)
+	}
+	if _, ok := current.Annotations[key]; !ok {
+		return nil
+	}
+	delete(current.Annotations, key)
+	if _, err := clientSet.CoreV1().ServiceAccounts(meta.Namespace).Update(current); err != nil {
+		return err
+	}
+	logger.Info("removed annotation %q from serviceaccount %q", key, name)
+	return nil
+}
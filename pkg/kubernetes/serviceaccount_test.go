@@ -128,4 +128,27 @@ var _ = Describe("Kubernetes serviceaccount object helpers", func() {
 		Expect(err).ToNot(HaveOccurred())
 		Expect(ok).To(BeFalse())
 	})
+	It("can remove an annotation from a serviceaccount, and doesn't fail if either doesn't exist", func() {
+		sa := metav1.ObjectMeta{
+			Name:        "sa-3",
+			Namespace:   "ns-3",
+			Annotations: map[string]string{"eks.amazonaws.com/role-arn": "arn:aws:iam::123456789012:role/sa-3", "test": "1"},
+		}
+
+		err = MaybeCreateServiceAccountOrUpdateMetadata(clientSet, sa)
+		Expect(err).ToNot(HaveOccurred())
+
+		err = MaybeRemoveServiceAccountAnnotation(clientSet, sa, "eks.amazonaws.com/role-arn")
+		Expect(err).ToNot(HaveOccurred())
+
+		resp, err := clientSet.CoreV1().ServiceAccounts(sa.Namespace).Get(sa.Name, metav1.GetOptions{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(resp.Annotations).To(Equal(map[string]string{"test": "1"}))
+
+		err = MaybeRemoveServiceAccountAnnotation(clientSet, sa, "eks.amazonaws.com/role-arn")
+		Expect(err).ToNot(HaveOccurred())
+
+		err = MaybeRemoveServiceAccountAnnotation(clientSet, metav1.ObjectMeta{Name: "sa-4", Namespace: "ns-4"}, "test")
+		Expect(err).ToNot(HaveOccurred())
+	})
 })
@@ -0,0 +1,196 @@
+package logger
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"runtime"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	krisnova "github.com/kris-nova/logger"
+)
+
+// Logger is the signature of the logging functions, so that they can be passed around
+type Logger func(format string, args ...interface{})
+
+// Log levels, an entry is logged when its level is at most the level of its subsystem
+const (
+	LevelCritical = 1
+	LevelWarning  = 2
+	LevelInfo     = 3
+	LevelDebug    = 4
+)
+
+// Log formats
+const (
+	FormatText = "text"
+	FormatJSON = "json"
+)
+
+var (
+	// Level is the verbosity of subsystems that don't have their own, use 0 to silence
+	Level = LevelInfo
+	// Color enables colorized text logs
+	Color = true
+	// Fabulous enables rainbow colored text logs
+	Fabulous = false
+	// Timestamps enables timestamps in text logs
+	Timestamps = false
+
+	mutex           sync.Mutex
+	sink            Sink = &TextSink{}
+	subsystemLevels      = map[string]int{}
+)
+
+// Entry is a log entry passed to a sink
+type Entry struct {
+	Time      time.Time `json:"time"`
+	Level     string    `json:"level"`
+	Subsystem string    `json:"subsystem,omitempty"`
+	Message   string    `json:"msg"`
+}
+
+// Sink writes the entries that passed the level of their subsystem
+type Sink interface {
+	Write(entry *Entry)
+}
+
+// TextSink writes entries in the human readable format of kris-nova/logger
+type TextSink struct{}
+
+// Write writes the entry
+func (*TextSink) Write(entry *Entry) {
+	krisnova.Color = Color
+	krisnova.Fabulous = Fabulous
+	krisnova.Timestamps = Timestamps
+	// levels are checked before entries get here
+	krisnova.Level = LevelDebug
+
+	switch entry.Level {
+	case "critical":
+		krisnova.Critical("%s", entry.Message)
+	case "warning":
+		krisnova.Warning("%s", entry.Message)
+	case "success":
+		krisnova.Success("%s", entry.Message)
+	case "debug":
+		krisnova.Debug("%s", entry.Message)
+	default:
+		krisnova.Info("%s", entry.Message)
+	}
+}
+
+// JSONSink writes each entry as a JSON object on its own line, so that it can be ingested by log pipelines
+type JSONSink struct {
+	encoder *json.Encoder
+}
+
+// NewJSONSink creates a sink writing to w
+func NewJSONSink(w io.Writer) *JSONSink {
+	return &JSONSink{encoder: json.NewEncoder(w)}
+}
+
+// Write writes the entry
+func (s *JSONSink) Write(entry *Entry) {
+	trimmed := *entry
+	trimmed.Message = strings.TrimRight(entry.Message, "\n")
+	// errors writing logs can't be logged
+	_ = s.encoder.Encode(&trimmed)
+}
+
+// SetSink replaces the sink of all entries
+func SetSink(s Sink) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	sink = s
+}
+
+// SetSubsystemLevels sets the verbosity of subsystems, which are the packages under pkg/, e.g. cfn,
+// eks or kubernetes; subsystems without a level use Level
+func SetSubsystemLevels(levels map[string]int) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	subsystemLevels = levels
+}
+
+// Configure sets up logging with the given format and subsystem levels given as strings, e.g. from flags
+func Configure(format string, levels map[string]string) error {
+	switch format {
+	case FormatText:
+		SetSink(&TextSink{})
+	case FormatJSON:
+		SetSink(NewJSONSink(os.Stdout))
+	default:
+		return fmt.Errorf("unknown log format %q (valid options: %s, %s)", format, FormatText, FormatJSON)
+	}
+
+	subsystemLevels := map[string]int{}
+	for subsystem, value := range levels {
+		level, err := strconv.Atoi(value)
+		if err != nil {
+			return fmt.Errorf("invalid log level %q for subsystem %q", value, subsystem)
+		}
+		subsystemLevels[subsystem] = level
+	}
+	SetSubsystemLevels(subsystemLevels)
+	return nil
+}
+
+// Critical logs a critical error
+func Critical(format string, args ...interface{}) { log(LevelCritical, "critical", format, args) }
+
+// Warning logs a warning
+func Warning(format string, args ...interface{}) { log(LevelWarning, "warning", format, args) }
+
+// Info logs an informational message
+func Info(format string, args ...interface{}) { log(LevelInfo, "info", format, args) }
+
+// Success logs a successful outcome
+func Success(format string, args ...interface{}) { log(LevelInfo, "success", format, args) }
+
+// Debug logs a message for debugging
+func Debug(format string, args ...interface{}) { log(LevelDebug, "debug", format, args) }
+
+func log(level int, name, format string, args []interface{}) {
+	var subsystem string
+	// skip log and the logging function to get to its caller
+	if pc, _, _, ok := runtime.Caller(2); ok {
+		subsystem = subsystemOf(runtime.FuncForPC(pc).Name())
+	}
+
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	maxLevel, ok := subsystemLevels[subsystem]
+	if !ok {
+		maxLevel = Level
+	}
+	if level > maxLevel {
+		return
+	}
+
+	sink.Write(&Entry{
+		Time:      time.Now(),
+		Level:     name,
+		Subsystem: subsystem,
+		Message:   fmt.Sprintf(format, args...),
+	})
+}
+
+// subsystemOf returns the package under pkg/ of the given function, tests of a
+// package belong to its subsystem
+func subsystemOf(funcName string) string {
+	const prefix = "github.com/weaveworks/eksctl/pkg/"
+	if !strings.HasPrefix(funcName, prefix) {
+		return ""
+	}
+	name := strings.TrimPrefix(funcName, prefix)
+	if i := strings.IndexAny(name, "/."); i >= 0 {
+		name = name[:i]
+	}
+	return strings.TrimSuffix(name, "_test")
+}
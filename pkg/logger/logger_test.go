@@ -0,0 +1,76 @@
+package logger_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/logger"
+)
+
+var _ = Describe("logger", func() {
+	var (
+		output *bytes.Buffer
+		level  int
+	)
+
+	entries := func() []Entry {
+		entries := []Entry{}
+		decoder := json.NewDecoder(output)
+		for decoder.More() {
+			var entry Entry
+			Expect(decoder.Decode(&entry)).To(Succeed())
+			entries = append(entries, entry)
+		}
+		return entries
+	}
+
+	BeforeEach(func() {
+		output = &bytes.Buffer{}
+		level = Level
+		SetSink(NewJSONSink(output))
+	})
+
+	AfterEach(func() {
+		Level = level
+		SetSink(&TextSink{})
+		SetSubsystemLevels(map[string]int{})
+	})
+
+	It("writes entries as JSON with the subsystem of the caller", func() {
+		Level = LevelInfo
+		Info("creating %d nodegroup(s)\n", 2)
+		Debug("not logged")
+		Warning("nodegroup %q has no nodes", "ng-1")
+
+		logged := entries()
+		Expect(logged).To(HaveLen(2))
+		Expect(logged[0].Level).To(Equal("info"))
+		Expect(logged[0].Subsystem).To(Equal("logger"))
+		Expect(logged[0].Message).To(Equal("creating 2 nodegroup(s)"))
+		Expect(logged[0].Time).ToNot(BeZero())
+		Expect(logged[1].Level).To(Equal("warning"))
+		Expect(logged[1].Message).To(Equal(`nodegroup "ng-1" has no nodes`))
+	})
+
+	It("uses the level of the subsystem over the global one", func() {
+		Level = LevelInfo
+		SetSubsystemLevels(map[string]int{"logger": LevelCritical})
+		Info("not logged")
+		Warning("not logged")
+		Critical("logged")
+		Expect(entries()).To(HaveLen(1))
+
+		SetSubsystemLevels(map[string]int{"cfn": LevelCritical, "logger": LevelDebug})
+		Debug("logged")
+		Expect(entries()).To(HaveLen(1))
+	})
+
+	It("configures the format and the levels of subsystems", func() {
+		Expect(Configure(FormatJSON, map[string]string{"cfn": "4"})).To(Succeed())
+		Expect(Configure("xml", nil)).To(MatchError(`unknown log format "xml" (valid options: text, json)`))
+		Expect(Configure(FormatText, map[string]string{"cfn": "debug"})).To(MatchError(`invalid log level "debug" for subsystem "cfn"`))
+	})
+})
@@ -0,0 +1,171 @@
+package metrics
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// AddressEnv is the environment variable that sets the address to serve metrics on, e.g. localhost:9090
+const AddressEnv = "EKSCTL_METRICS_ADDRESS"
+
+var (
+	mutex sync.Mutex
+
+	tasksStarted, tasksCompleted, tasksFailed int
+	awsCalls                                  = map[operation]int{}
+	awsThrottles                              = map[operation]int{}
+)
+
+type operation struct {
+	service, name string
+}
+
+// TaskStarted counts a task that started
+func TaskStarted() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	tasksStarted++
+}
+
+// TaskFinished counts a task that completed, or failed when err isn't nil
+func TaskFinished(err error) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	if err != nil {
+		tasksFailed++
+	} else {
+		tasksCompleted++
+	}
+}
+
+// AWSCall counts a call to the AWS API, including its retries
+func AWSCall(service, name string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	awsCalls[operation{service, name}]++
+}
+
+// AWSThrottle counts an attempt of a call to the AWS API that was throttled
+func AWSThrottle(service, name string) {
+	mutex.Lock()
+	defer mutex.Unlock()
+	awsThrottles[operation{service, name}]++
+}
+
+// Reset sets all metrics back to zero
+func Reset() {
+	mutex.Lock()
+	defer mutex.Unlock()
+	tasksStarted, tasksCompleted, tasksFailed = 0, 0, 0
+	awsCalls = map[operation]int{}
+	awsThrottles = map[operation]int{}
+}
+
+// Write writes all metrics in the Prometheus text format
+func Write(w io.Writer) error {
+	mutex.Lock()
+	defer mutex.Unlock()
+
+	out := &errWriter{w: w}
+	writeCounter(out, "eksctl_tasks_started_total", "Tasks that started.", tasksStarted)
+	writeCounter(out, "eksctl_tasks_completed_total", "Tasks that completed successfully.", tasksCompleted)
+	writeCounter(out, "eksctl_tasks_failed_total", "Tasks that failed.", tasksFailed)
+	out.printf("# HELP eksctl_tasks_running Tasks that are running.\n# TYPE eksctl_tasks_running gauge\neksctl_tasks_running %d\n", tasksStarted-tasksCompleted-tasksFailed)
+	writeOperationCounter(out, "eksctl_aws_api_calls_total", "Calls to the AWS API.", awsCalls)
+	writeOperationCounter(out, "eksctl_aws_api_throttles_total", "Attempts of calls to the AWS API that were throttled.", awsThrottles)
+	return out.err
+}
+
+func writeCounter(out *errWriter, name, help string, value int) {
+	out.printf("# HELP %s %s\n# TYPE %s counter\n%s %d\n", name, help, name, name, value)
+}
+
+func writeOperationCounter(out *errWriter, name, help string, values map[operation]int) {
+	out.printf("# HELP %s %s\n# TYPE %s counter\n", name, help, name)
+	operations := make([]operation, 0, len(values))
+	for op := range values {
+		operations = append(operations, op)
+	}
+	sort.Slice(operations, func(i, j int) bool {
+		if operations[i].service != operations[j].service {
+			return operations[i].service < operations[j].service
+		}
+		return operations[i].name < operations[j].name
+	})
+	for _, op := range operations {
+		out.printf("%s{service=%q,operation=%q} %d\n", name, op.service, op.name, values[op])
+	}
+}
+
+type errWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (e *errWriter) printf(format string, args ...interface{}) {
+	if e.err == nil {
+		_, e.err = fmt.Fprintf(e.w, format, args...)
+	}
+}
+
+// Server serves metrics over HTTP at /metrics
+type Server struct {
+	Address string
+
+	server *http.Server
+}
+
+// NewServerFromEnv returns the server that the environment configures, which is nil when no address is set
+func NewServerFromEnv() *Server {
+	address := os.Getenv(AddressEnv)
+	if address == "" {
+		return nil
+	}
+	return &Server{Address: address}
+}
+
+// Start starts serving metrics in the background, Address is set to the address
+// that is listened on, e.g. when the given one has port 0
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", s.Address)
+	if err != nil {
+		return err
+	}
+	s.Address = listener.Addr().String()
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+		if err := Write(w); err != nil {
+			logger.Debug("writing metrics: %s", err.Error())
+		}
+	})
+	s.server = &http.Server{Handler: mux}
+	go func() {
+		if err := s.server.Serve(listener); err != nil && err != http.ErrServerClosed {
+			logger.Warning("serving metrics: %s", err.Error())
+		}
+	}()
+	logger.Info("serving metrics at http://%s/metrics", s.Address)
+	return nil
+}
+
+// Stop stops serving metrics, waiting briefly for requests in flight
+func (s *Server) Stop() {
+	if s.server == nil {
+		return
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := s.server.Shutdown(ctx); err != nil {
+		logger.Debug("stopping metrics server: %s", err.Error())
+	}
+}
@@ -0,0 +1,68 @@
+package metrics_test
+
+import (
+	"bytes"
+	"errors"
+	"io/ioutil"
+	"net/http"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/metrics"
+)
+
+var _ = Describe("metrics", func() {
+	BeforeEach(func() {
+		Reset()
+	})
+
+	It("writes counters of tasks and AWS API calls in the Prometheus text format", func() {
+		TaskStarted()
+		TaskStarted()
+		TaskStarted()
+		TaskFinished(nil)
+		TaskFinished(errors.New("failed"))
+		AWSCall("cloudformation", "DescribeStacks")
+		AWSCall("cloudformation", "DescribeStacks")
+		AWSCall("autoscaling", "DescribeAutoScalingGroups")
+		AWSThrottle("cloudformation", "DescribeStacks")
+
+		out := &bytes.Buffer{}
+		Expect(Write(out)).To(Succeed())
+		Expect(out.String()).To(Equal(`# HELP eksctl_tasks_started_total Tasks that started.
+# TYPE eksctl_tasks_started_total counter
+eksctl_tasks_started_total 3
+# HELP eksctl_tasks_completed_total Tasks that completed successfully.
+# TYPE eksctl_tasks_completed_total counter
+eksctl_tasks_completed_total 1
+# HELP eksctl_tasks_failed_total Tasks that failed.
+# TYPE eksctl_tasks_failed_total counter
+eksctl_tasks_failed_total 1
+# HELP eksctl_tasks_running Tasks that are running.
+# TYPE eksctl_tasks_running gauge
+eksctl_tasks_running 1
+# HELP eksctl_aws_api_calls_total Calls to the AWS API.
+# TYPE eksctl_aws_api_calls_total counter
+eksctl_aws_api_calls_total{service="autoscaling",operation="DescribeAutoScalingGroups"} 1
+eksctl_aws_api_calls_total{service="cloudformation",operation="DescribeStacks"} 2
+# HELP eksctl_aws_api_throttles_total Attempts of calls to the AWS API that were throttled.
+# TYPE eksctl_aws_api_throttles_total counter
+eksctl_aws_api_throttles_total{service="cloudformation",operation="DescribeStacks"} 1
+`))
+	})
+
+	It("serves metrics over HTTP", func() {
+		TaskStarted()
+		server := &Server{Address: "127.0.0.1:0"}
+		Expect(server.Start()).To(Succeed())
+		defer server.Stop()
+
+		resp, err := http.Get("http://" + server.Address + "/metrics")
+		Expect(err).NotTo(HaveOccurred())
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(string(body)).To(ContainSubstring("eksctl_tasks_started_total 1\n"))
+	})
+})
@@ -71,7 +71,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var __10EkscltAl2Conf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\xd1\x6a\xdb\x4a\x10\x7d\xd7\x57\x2c\x24\x0f\xf7\x82\x57\xe2\xe6\x5e\xee\x43\x40\x0f\xaa\xad\x04\x53\x55\x0e\x91\x43\x0b\x6d\x11\xe3\xdd\xb1\x33\x78\x35\x2b\x76\x57\x76\xd2\xe0\x7f\x2f\xb2\xac\xd6\xa1\xa1\xf4\x4d\xbb\x67\xe6\x9c\x33\xb3\x47\x17\x02\xb7\x5e\x05\x23\x7d\x8b\x8a\xd6\xa4\x84\x7f\xf6\x01\x1b\x2d\xb4\xb3\xad\x24\x16\x1d\x53\x10\x6b\xeb\xc4\xb6\x5b\xa1\xc1\x30\x39\x1e\xb2\x06\xbe\x59\x16\x05\x71\xf7\x24\xae\xc4\x5f\x59\x71\xf5\x77\x14\x7d\xae\xd0\xed\x48\xe1\xd7\xe8\x42\x14\x56\x81\x11\x0d\x06\xd0\x10\x40\xb4\xe0\xa0\xc1\x80\xce\x5f\x8b\xfb\xfc\x76\xbe\x28\x27\x22\xfb\x58\xd5\xb3\xfc\x26\x7b\x28\x96\xf5\x70\x17\xe5\xbc\x23\x67\xb9\x41\x0e\x37\x64\x30\x4d\x30\xa8\x64\xb0\x98\x8c\x5c\x31\xf2\x2e\xba\x10\xb7\xc6\xae\xc0\x08\x60\x2d\x7c\x80\x40\xea\x95\xc6\xb4\x78\xa8\x96\xf9\x7d\x3d\x2b\xab\x89\x28\x17\xb3\xbc\x2e\xb2\x77\x79\x31\x1e\x96\xd9\xbc\x5c\x56\xbf\x95\x3b\xcd\x7b\x52\x1b\xc6\x61\xcb\xf2\x0d\xb1\x23\xe5\xfc\x6e\x22\xe6\x65\xb5\xcc\xca\x69\x5e\xcf\x67\x7f\xc4\x6d\x7a\xd6\xa3\x42\x94\x3f\xa1\xaa\x02\xb8\x90\x9e\x7d\x26\x9d\x77\xc9\x8a\x78\x6c\x10\x5f\x22\x21\xa4\x64\xab\x51\x52\x9b\x5e\xbe\x9c\x94\x0f\xe7\x80\x81\x15\x1a\x3f\x82\xc3\xd8\x87\x09\x98\xf6\x11\xe2\x41\x3f\x26\x9b\x10\xfb\x00\xac\x50\x92\x4e\x2f\x5f\xce\x8c\x8f\x5c\x0d\x3c\xc9\xd6\xea\x9e\xe8\x43\xf6\xa9\xbe\x5b\xcc\xaa\x11\x72\xb8\x21\x1f\xd0\x1d\xf5\xd2\xe0\x3a\x3c\xbf\xdc\x53\x78\x94\x01\x88\xc3\x0f\x13\xc3\xba\xc7\x76\x30\xc6\xee\x65\xeb\x68\x47\x06\x37\xa8\x07\x86\x01\x53\xc6\x76\x5a\xb6\xce\xee\x48\xa3\x4b\x61\xef\x47\xc0\x72\xcf\x89\x4e\xba\x8e\x03\x35\x98\x6a\xab\xb6\xe8\xc6\xc9\x31\xec\xad\xdb\xca\xd6\x74\x1b\xe2\x54\x31\x8d\x7d\x4c\x72\x45\x2c\x35\xb9\x34\xb1\x6d\x48\x14\x53\xbf\xd2\x33\x58\x59\x5e\x0f\x78\xff\x44\x3d\xce\x18\x62\x7d\xaa\x68\xad\x96\xc4\x6b\x07\x67\x16\xa8\x81\x0d\xa6\x97\x2f\x7d\x82\xf3\xf7\x55\x9d\x4f\xef\xeb\x6c\x3a\x5d\x3c\x94\xcb\x43\xac\xb7\x2e\x46\xe5\xe2\x01\x7e\x1d\xf0\x43\x0c\xc7\x3f\x07\xf6\x3e\x56\xb6\xe9\xf3\x90\xb4\xd0\x79\x94\xd0\xe8\xff\xff\xbb\xfe\x37\xfe\xe7\x24\xdb\xbf\x78\x6f\x8c\x36\xbf\x24\x67\xb8\x8e\x9f\xa1\x31\x3f\x97\xf3\x56\x61\x1f\xb1\xbe\x2a\xfa\x1e\x00\x00\xff\xff\x5c\x3b\x51\x59\xe7\x03\x00\x00")
+var __10EkscltAl2Conf = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x93\x51\x6b\xdb\x48\x10\xc7\xdf\xf5\x29\x16\xe2\x87\x3b\xf0\x4a\x5c\xee\x2d\xa0\x07\x9d\xad\xe4\x4c\x55\x39\x58\x0e\x2d\xb4\x45\x8c\x77\xc7\xce\xe0\xd5\xac\x58\xad\xec\xa4\xc6\xdf\xbd\xc8\x92\x8a\x4b\xda\xd2\x37\xed\xfc\x67\xfe\xbf\x99\xdd\xd1\x8d\xc0\x7d\xa3\xbc\x91\x4d\x8d\x8a\xb6\xa4\x44\xf3\xda\x78\xac\xb4\xd0\xce\xd6\x92\x58\xb4\x4c\x5e\x6c\xad\x13\xfb\x76\x83\x06\xfd\xf4\x72\x48\x2a\xf8\x6a\x59\x64\xc4\xed\x8b\xb8\x15\x7f\x25\xd9\xed\xdf\x41\xf0\xa9\x40\x77\x20\x85\x5f\x82\x1b\x91\x59\x05\x46\x54\xe8\x41\x83\x07\x51\x83\x83\x0a\x3d\xba\xe6\x4e\xac\xd2\x87\xc5\x32\x9f\x8a\xe4\x43\x51\xce\xd3\xfb\xe4\x29\x5b\x97\x7d\x2c\x48\xf9\x40\xce\x72\x85\xec\xef\xc9\x60\x1c\xa1\x57\x51\xdf\x62\x34\x7a\x85\xc8\x87\xe0\x46\x3c\x18\xbb\x01\x23\x80\xb5\x68\x3c\x78\x52\x3f\x30\x66\xd9\x53\xb1\x4e\x57\xe5\x3c\x2f\xa6\x22\x5f\xce\xd3\x32\x4b\xfe\x4b\xb3\xf1\xb0\x4e\x16\xf9\xba\xf8\x2d\x6e\x98\x77\xa0\xf5\xe3\xb0\x65\xf9\x13\xd8\xc5\x7f\xf1\x38\x15\x8b\xbc\x58\x27\xf9\x2c\x2d\x17\xf3\xa9\x48\x56\xb3\xff\xff\x88\x60\x3a\xef\x0b\x27\x48\x5f\x50\x15\x1e\x9c\x8f\xaf\x3e\xa3\xb6\x71\xd1\x86\x78\x2c\x10\x9f\x03\x21\xa4\x64\xab\x51\x52\x1d\x4f\x4e\x03\xff\x7c\x2d\x18\xd8\xa0\x69\x46\xb1\x1f\xfe\x3c\x05\x53\x3f\x43\xd8\xf3\x43\xb2\x11\x71\xe3\x81\x15\x4a\xd2\xf1\xe4\x74\xd5\xfe\xe8\x55\xc1\x8b\xac\xad\xee\x8c\xde\x27\x1f\xcb\xc7\xe5\xbc\x18\x25\x87\x3b\x6a\x3c\xba\x0b\x2f\xf6\xae\xc5\xeb\xe0\x91\xfc\xb3\xf4\x40\xec\xbf\x37\xd1\x5f\xfa\x58\x0e\xc6\xd8\xa3\xac\x1d\x1d\xc8\xe0\x0e\x75\xef\xd0\x6b\xca\xd8\x56\xcb\xda\xd9\x03\x69\x74\x31\x1c\x9b\x51\xb0\xdc\x79\xa2\x93\xae\x65\x4f\x15\xc6\xda\xaa\x3d\xba\x41\x66\xf4\x47\xeb\xf6\xb2\x36\xed\x8e\x38\x56\x4c\x63\x1d\x93\xdc\x10\x4b\x4d\x2e\x8e\x6c\xed\x23\xc5\xd4\x5d\xe9\x95\xac\x2c\x6f\x7b\xbd\x7b\xa2\x4e\x67\xf4\xa1\x1e\x32\x6a\xab\x25\xf1\xd6\xc1\x55\x0b\x54\xc1\x0e\xe3\xc9\xa9\xdb\xe3\xf4\x5d\x51\xa6\xb3\x55\x99\xcc\x66\xcb\xa7\x7c\x7d\x0e\xf5\xde\x85\xa8\x5c\x38\x39\xbd\x5d\xf3\x73\x08\x97\xff\x07\x8e\x4d\xa8\x6c\xd5\x2d\x78\x54\x43\xdb\xa0\x9c\x9c\xba\xad\x39\xdf\xfd\x1b\xfe\x33\x80\xbb\x37\xef\x5a\xa3\xdd\x9b\xdd\xe9\xc3\xe1\x2b\x54\x66\x48\xfe\x45\xa2\x41\x1f\xbe\x42\x65\x82\x6f\x03\x00\x0e\x8d\xca\x6b\xef\x03\x00\x00")
 
 func _10EkscltAl2ConfBytes() ([]byte, error) {
 	return bindataRead(
@@ -91,7 +91,7 @@ func _10EkscltAl2Conf() (*asset, error) {
 	return a, nil
 }
 
-var _bootstrapAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\x5d\x6b\xdb\x30\x14\x7d\xd7\xaf\x38\x73\x4d\x69\x18\x8e\xd7\xd1\x0d\xda\x26\x83\xb2\x64\x90\x87\x25\x81\xf6\x61\x23\x64\x46\x91\x6f\x66\x51\x45\x32\xd2\x75\xd6\x12\xbc\xdf\x3e\x9c\xd9\xc5\x19\x7b\x1a\x7b\x92\xce\xc7\x3d\x5c\x1d\x74\xf6\x2a\xdd\x68\x9b\x6e\x64\x28\x84\x08\xc4\x48\x1c\xc8\x7b\x7a\xd2\xdc\xc1\x52\x97\xb4\x95\xda\x74\xd8\xba\xca\x06\x62\x21\xb6\x95\x55\xac\x9d\xc5\x77\xe2\x6c\x27\x9f\xb2\xd2\xe5\xe1\x62\x80\x83\x00\x7e\x14\xda\x10\x3c\xc9\x1c\xda\x06\x96\x56\x51\xc6\xcf\x25\xa1\xf1\xdc\x22\x77\x02\x00\xf4\x16\x58\xad\x10\xc5\x87\x13\x53\x1d\x61\x3c\x6e\xd8\xcb\x3a\xc2\x7a\x8d\xf3\xf3\xd6\xd5\x0c\x37\xe2\x4f\x7c\x5b\xbd\x49\xae\xd7\xaf\xe3\x46\xbe\x05\x17\x64\x8f\x81\x00\xa9\xc2\xa1\x75\xb6\x94\x27\xae\xfc\x6f\x7d\xab\x05\x90\x3b\x4b\x18\x21\x25\x56\x29\x3d\x06\xc5\x26\xed\xb6\x1f\xee\x64\x29\x6a\x21\xe6\x8b\xc9\x34\x9b\x2d\xc7\x51\x7c\xa1\x2a\x6f\x90\x24\x41\x1b\xb2\x8c\x82\xb9\xbc\x49\xd3\xcb\xf7\xd7\xc3\xb7\xef\xae\x86\xed\x99\x1a\xc9\x14\x38\xdd\x11\xcb\x24\x97\x2c\x53\xe3\x94\x34\x89\x2e\xf7\x57\x83\x48\xcc\xe6\xf7\x0f\x77\xf3\x8f\xd3\x6c\x36\xf9\xf7\xc4\xae\xa1\x44\xe7\xfd\xc8\x87\xaf\xcb\xe9\x7f\x08\x6d\x6a\x1f\x44\x42\x04\x57\x79\x45\x27\xdd\x3c\x56\x1b\x32\xc4\x43\xb2\x7b\x9c\x81\x0b\x1d\xa0\xa4\x85\xdb\x93\xf7\x3a\x27\x7c\xbe\xfb\x92\x2d\x17\x93\x7b\x21\x94\x64\x7c\xf8\xeb\xec\xb1\x8e\x63\xc2\x68\x34\x5d\x7c\x7a\xe9\x37\x3e\xb4\xb7\xfa\xa4\xa4\xf8\xd0\x43\xf5\x1f\x8f\xed\x89\x0d\xae\x45\xb7\xc0\x38\x3e\x74\xd7\x9b\x24\xbe\xe8\xff\xca\xe6\xf3\x9c\x4e\x45\x83\x5a\x34\x9b\x88\xf0\x1c\x98\x76\x8a\x0d\x72\x49\x3b\x67\x13\x4f\xc6\xc9\xbc\xc7\x93\x95\x1b\x43\x68\xdf\xd2\x13\x02\x4b\xcf\x2f\xfc\xaf\x00\x00\x00\xff\xff\x07\x7d\xb2\xb5\x4b\x03\x00\x00")
+var _bootstrapAl2Sh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x52\xd1\x4f\xdb\x3e\x18\x7c\xf7\x5f\x71\x3f\x13\xa1\xf6\x37\xa5\x19\x53\x87\x04\xa5\x93\x10\x74\x1a\x0f\x03\x34\x78\xd8\x84\x58\xe5\x3a\x5f\x17\x0b\xc7\x8e\xec\x2f\x0c\x54\x65\x7f\xfb\x94\x2e\x41\xe9\xb4\xa7\x69\x4f\xf6\x77\x77\x3e\x9d\xcf\xde\xfb\x2f\x5b\x19\x97\xad\x54\x2c\x84\x88\xc4\x48\x3d\x28\x04\x7a\x32\xdc\x8f\x95\xa9\x68\xad\x8c\xed\x67\xe7\x6b\x17\x89\x85\x58\xd7\x4e\xb3\xf1\x0e\xdf\x88\x97\xa5\x7a\x5a\x56\x3e\x8f\xa3\x31\x36\x02\xf8\x5e\x18\x4b\x08\xa4\x72\x18\x17\x59\x39\x4d\x4b\x7e\xae\x08\xad\x66\x86\xdc\x0b\x00\x30\x6b\xe0\xee\x0e\x32\xd9\xec\x88\x1a\x89\xf9\xbc\x45\x0f\x1a\x89\xfb\x7b\xec\xef\x77\xaa\xf6\x70\x4b\xfe\xc0\xd7\xbb\xd7\xe9\xd1\xfd\xab\xa4\xa5\x67\xe0\x82\xdc\xd6\x10\x20\x5d\x78\x74\xca\x0e\x0a\xc4\x75\xf8\xc5\xaf\x8d\x00\x72\xef\x08\x27\xc8\x88\x75\x46\x0f\x51\xb3\xcd\xfa\xf4\x93\x52\x55\xa2\x11\xe2\xf2\xea\x7c\xb1\xbc\xb8\x9e\xcb\x64\xa4\xeb\x60\x91\xa6\xd1\x58\x72\x8c\x82\xb9\x3a\xce\xb2\x83\xc3\xa3\xc9\x9b\xb7\xd3\x49\xb7\x66\x56\x31\x45\xce\x4a\x62\x95\xe6\x8a\x55\x66\xbd\x56\x36\x35\xd5\xe3\x74\x2c\xc5\xc5\xe5\xcd\xed\xe9\xe5\xd9\x62\x79\x71\xfe\xf7\x8e\x7d\x43\xa9\xc9\x87\x96\xb7\x5f\xae\x17\xff\xc0\xb4\x7d\x9b\xb1\x14\x42\xab\x48\x90\xc9\xa8\x76\xaa\x24\xa4\xe5\x58\xc2\xb4\xd5\x29\x15\x74\x71\x38\x1d\xe3\xf4\xd3\xd9\x87\xb9\x54\xa1\x3c\x9c\x4a\xcc\x66\x02\xf8\xff\x05\x2c\xf3\x0e\xa4\xa8\xb4\x10\xd1\xd7\x41\xd3\x4e\xcf\x0f\xf5\x8a\x2c\xf1\x84\xdc\x23\xf6\xc0\x85\x89\xd0\xca\xc1\x3f\x52\x08\x26\x27\x7c\x3c\xfd\xbc\xbc\xbe\x3a\xbf\x69\x83\x30\xde\xfd\xf1\xec\xb6\xda\xad\xc3\xc9\xc9\xe2\xea\xfd\xcb\x5b\x25\x9b\x6e\xd7\xec\x14\x9e\x6c\x06\xd3\x80\xda\x16\x37\x20\xdb\xb9\x11\xdb\x9b\x24\x9b\x76\x69\x44\x9f\x66\x9e\x6c\xfa\xed\x71\x9a\x8c\x86\xdf\x1d\xf2\x77\x0b\x39\x6e\x44\x1b\x4b\xc4\xe7\xc8\x54\x6a\xb6\xc8\x15\x95\xde\xa5\x81\xac\x57\xf9\x00\x27\xa7\x56\x96\xd0\x5d\x6c\x40\x44\x56\x81\xf1\x50\xaf\xc8\x12\x8b\x9f\x03\x00\x83\x23\xff\xee\xa4\x03\x00\x00")
 
 func bootstrapAl2ShBytes() ([]byte, error) {
 	return bindataRead(
@@ -111,7 +111,7 @@ func bootstrapAl2Sh() (*asset, error) {
 	return a, nil
 }
 
-var _bootstrapUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x61\x6f\x22\x37\x10\xfd\xee\x5f\x31\x25\xe8\x0a\x6a\xbc\xdb\x5c\xd3\x93\x2e\xc9\x56\xa5\x09\x57\x45\x4d\x21\xba\x70\x6a\xab\x28\x45\xc6\x1e\x58\x0b\xaf\xbd\xb2\x67\x21\x29\xa2\xbf\xbd\x32\xbb\x4b\x48\x74\xbd\x0f\x55\x3f\xb1\xf6\x7b\xf3\x76\xf6\xf9\x79\x38\xfa\x2a\x9d\x69\x9b\xce\x44\xc8\x19\x0b\x48\xc0\x1d\xa0\xf7\xf8\xa8\xa9\x5d\x96\xba\xc4\xb9\xd0\xa6\x5d\x5b\x57\xd9\x80\xc4\xd8\xbc\xb2\x92\xb4\xb3\xb0\x40\x9a\x16\xe2\x71\x5a\x3a\x15\x7a\x7d\xd8\x30\x80\x75\xae\x0d\x82\x47\xa1\x40\xdb\x40\xc2\x4a\x9c\xd2\x53\x89\x10\x39\xe7\xa0\x1c\x03\x00\xd0\x73\x80\xfb\x7b\xe8\x74\x37\x2f\x48\xdb\x0e\x64\x59\xdc\x3d\xd9\x76\xe0\xe1\x01\xde\xbc\x69\x58\xb1\x38\x82\x7f\xc3\x9f\xf7\xdf\xf2\xf7\x0f\xdf\x74\x23\x7c\x0e\x94\xa3\xdd\x09\x02\xa0\xcc\x1d\x34\xcc\xf3\x66\xcf\x23\x55\xbe\x26\xcc\x35\x03\x50\xce\x22\x5c\x40\x8a\x24\x53\x5c\x06\x49\x26\x6d\xdb\x4f\x0a\x51\xb2\x2d\x63\xa3\xf1\xd5\x70\x7a\x7d\x9b\x75\xba\x3d\x59\x79\x03\x9c\x07\x6d\xd0\x12\xe4\x44\xe5\x59\x9a\x9e\xbc\x7b\x9f\xbc\xfd\xfe\x34\x69\x7e\x53\x23\x08\x03\xa5\x05\x92\xe0\x4a\x90\x48\x8d\x93\xc2\x70\x5d\xae\x4e\xfb\x1d\x76\x3d\xba\x9b\x0c\x46\x97\xc3\xe9\xf5\xd5\x7f\x57\x6c\x2d\xe2\x5a\x1d\x4a\x4e\xfe\xb8\x1d\xfe\x0f\xa2\xd1\xf7\x7e\x87\xb1\xe0\x2a\x2f\xf1\x85\x37\xcb\x6a\x86\x06\x29\x41\xbb\x82\x23\xa0\x5c\x07\x90\xc2\x82\x5b\xa1\xf7\x5a\x21\xfc\x3a\xf8\x7d\x7a\x3b\xbe\xba\x63\x4c\x0a\x82\x1f\x3e\x5b\xbb\xb3\x63\xa7\x70\x71\x31\x1c\x7f\xd8\xfb\xdb\xdd\x34\x4f\xdb\x17\x26\x75\x37\x07\xab\xed\xab\x8f\x3d\x00\xe3\x7a\xcb\xda\x06\xb2\xee\xa6\x7d\x3c\xe3\xdd\xde\x61\x2c\x63\x7a\x5e\x56\x75\xfa\x5b\x16\x3b\x61\xc1\x8a\x12\x84\xd1\x22\x40\xd3\x2d\xc7\x65\x48\x9a\xe7\x76\xef\x35\x4d\x92\xd9\xd3\x24\x99\x76\xaf\xa6\x05\x72\xe5\xa1\x18\x0b\x4f\x81\xb0\x88\x3c\x8f\x01\x89\xc7\xfb\x84\x8a\xb1\x1e\x03\x38\x82\xc9\xf8\x6a\x7c\x16\x43\x1c\x10\x42\xee\x2a\xa3\x60\x86\x60\x9c\x5b\xa2\x02\x41\x80\x2b\xf4\x4f\x40\xba\xc0\x56\x14\x02\x09\x4f\x01\xaa\xf2\x78\xa7\xb0\xce\xb5\xcc\x41\x07\x58\xe7\x82\x60\x8d\xa0\x1c\x68\x0b\x83\x9b\xb7\xd0\xdb\x63\x33\x11\x50\x81\xb3\x50\x1a\xa1\x2d\xd4\x3d\xa9\x5a\x40\x58\x05\x05\x0a\x4b\x40\x2e\xbe\xbc\x74\x9e\xc4\xcc\x60\x5c\x16\x2e\x50\xcb\x06\xa5\x03\x79\x17\xfa\xc7\x30\xab\x08\x34\x7d\x1d\x76\xf5\xd6\x11\x48\x83\xc2\x43\xee\xd6\xb1\xc8\x38\xa1\x9a\x4f\x9a\x7b\x57\x3c\x37\x1e\xfd\x59\x6b\xca\x5d\x45\x90\x8b\x95\xb6\x8b\x9d\x00\x39\x90\x55\x20\x57\xe8\x80\xb1\xae\x26\x6a\x0a\x68\xe6\x0c\xe0\x0b\xb1\xdc\x47\xeb\xcb\xb4\x7f\x25\xc4\xcb\x10\xef\xc2\x8e\xc1\x00\xe6\x46\x2c\x42\xd6\xdb\x8d\x8b\x8e\x75\x0a\xb9\x2e\x0f\x72\xda\xa9\x81\x42\x3c\xf2\x18\xac\x83\xcc\xb5\xd0\xae\xc6\x88\x19\x9a\xd0\xd6\xdd\x0c\x7e\x1a\xde\xdc\x6d\x8f\x85\x29\x73\x91\xd4\x2f\x4e\xb4\x3b\xbc\xd3\xaf\x32\xdf\x68\x09\x63\xdc\x9a\x97\x5e\xaf\xb4\xc1\x05\xaa\x8c\x7c\x85\x0d\x56\x3a\xc5\xb5\x9d\x7b\xc1\xa5\xb3\x24\xb4\x45\xcf\x75\x21\x16\x98\x75\x37\x83\xdf\xee\xa6\xc3\x5f\xee\xa6\xc3\xcb\x8f\xd3\xc1\xe5\xe5\xf8\xd3\x68\xb2\x4d\xd4\xd2\x27\x28\x7d\x52\xc3\x57\xc3\x0f\x83\x4f\x37\x93\xe9\xc7\xe1\xcf\xd7\xe3\xd1\x36\x11\x85\xf8\xcb\x59\xb1\x0e\x89\x74\x45\x34\x27\x2d\x45\x15\x90\x8b\x42\xbd\x3b\x3d\xfb\x2e\x39\x69\x5e\x2b\x8d\xab\x14\x2f\xbd\x5b\x69\x85\x3e\x13\xeb\xd0\x02\x56\xf3\x99\xb6\x5c\x69\x9f\xa5\xae\xa4\x54\x5a\x1d\xff\x5d\x0e\x60\xe9\xec\xbc\xc6\xe3\x01\x44\xdc\x22\x25\xaa\x65\xec\x3f\xc3\x57\x36\xc6\x3d\x53\x4e\x2e\xd1\xb7\xbe\x22\xad\x9d\x5f\xf2\xd2\x54\x0b\x6d\x33\x69\x75\x03\x78\x5c\xe8\x40\xe8\x79\x74\xfe\xd0\xa1\x3d\x10\x03\xc7\xa3\x36\xed\x8f\x64\x32\xb8\x1e\x4d\xf6\x67\xb6\xbb\xbe\xce\xce\xf5\x22\x7b\x1d\x9e\x7a\x3b\x79\x12\x85\x79\xee\xf3\x73\xc4\x98\xb2\x96\xd5\x8f\x49\xaa\x67\xc1\xf3\x0c\x89\xa3\x20\x0e\xa2\x5d\xc2\xee\x7f\x7c\xd8\x76\x58\x9f\xb5\x13\x43\xf8\x17\x3c\xf6\x4f\x00\x00\x00\xff\xff\x8c\x16\xcc\x40\x9a\x07\x00\x00")
+var _bootstrapUbuntuSh = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x55\x61\x6f\x22\x37\x10\xfd\xee\x5f\x31\xe5\xd0\x35\xa8\xe7\xdd\xde\x35\x3d\xe9\x92\x6c\x55\x1a\xb8\x0a\x35\x85\xe8\xe0\xd4\x56\x51\x8a\x8c\x3d\xb0\x16\x5e\x7b\x65\xcf\x42\x52\x44\x7f\x7b\x65\xd8\xe5\x36\x51\xef\x3e\x54\xfd\xc4\xda\xef\xcd\xf3\xf8\xf9\xd9\xbc\xf8\x2a\x5d\x68\x9b\x2e\x44\xc8\x19\x0b\x48\xc0\x1d\xa0\xf7\xf8\xa0\xa9\x19\x96\xba\xc4\xa5\xd0\xa6\x19\x5b\x57\xd9\x80\xc4\xd8\xb2\xb2\x92\xb4\xb3\xb0\x42\x9a\x17\xe2\x61\x5e\x3a\x15\xce\x7a\xb0\x63\x00\xdb\x5c\x1b\x04\x8f\x42\x81\xb6\x81\x84\x95\x38\xa7\xc7\x12\x21\x72\x2e\x41\x39\x06\x00\xa0\x97\x00\x77\x77\xd0\xe9\xee\x9e\x90\xf6\x1d\xc8\xb2\x38\xfb\x7a\xdf\x81\xfb\x7b\x78\xf9\xb2\x66\xc5\xe2\x08\xfe\x0d\x7f\xde\x7d\xcb\xdf\xdd\x7f\xd3\x8d\xf0\x25\x50\x8e\xf6\x20\x08\x80\x32\x77\x50\x33\x2f\xeb\x39\x8f\x54\xf9\x23\x61\xa9\x19\x80\x72\x16\xe1\x0a\x52\x24\x99\xe2\x3a\x48\x32\x69\xd3\x7e\x52\x88\x92\xed\x19\x1b\x4f\x06\xc3\xf9\xe8\x36\xeb\x74\xcf\x64\xe5\x0d\x70\x1e\xb4\x41\x4b\x90\x13\x95\x17\x69\xfa\xfa\xed\xbb\xe4\xcd\xf7\xe7\x49\xfd\x9b\x1a\x41\x18\x28\x2d\x90\x04\x57\x82\x44\x6a\x9c\x14\x86\xeb\x72\x73\xde\xeb\xb0\xd1\x78\x3a\xeb\x8f\xaf\x87\xf3\xd1\xe0\xbf\x2b\x36\x16\x71\xad\xda\x92\xb3\x3f\x6e\x87\xff\x83\x68\x3c\x9c\x5e\x87\xb1\xe0\x2a\x2f\xf1\x89\x37\xeb\x6a\x81\x06\x29\x41\xbb\x81\x17\x40\xb9\x0e\x20\x85\x05\xb7\x41\xef\xb5\x42\xf8\xb5\xff\xfb\xfc\x76\x32\x98\x32\x26\x05\xc1\x0f\xff\x5a\x7b\xb0\xe3\xa0\x70\x75\x35\x9c\xbc\x3f\xf9\xdb\xdd\xd5\x5f\xfb\x27\x26\x75\x77\xad\x51\x0b\x3a\x6c\xb6\x05\xc6\xf1\x9e\x35\x0d\x64\xdd\x5d\xf3\x79\xc1\xbb\x67\xed\x58\x42\xe7\x79\x55\xa7\xb7\x67\xb1\x13\x16\xac\x28\x41\x18\x2d\x02\xd4\xdd\x72\x5c\x87\xa4\xfe\x6e\xe6\x9e\xd3\x24\x99\x13\x4d\x92\x69\xe6\x8e\xb4\x40\xae\x6c\x8b\xb1\xf0\x18\x08\x8b\xc8\xf3\x18\x90\x78\xbc\x4f\xa8\x18\x3b\x63\x00\x2f\x60\x36\x19\x4c\x2e\x62\x88\x03\x42\xc8\x5d\x65\x14\x2c\x10\x8c\x73\x6b\x54\x20\x08\x70\x83\xfe\x11\x48\x17\xd8\x88\x42\x20\xe1\x29\x40\x55\xbe\x3a\x28\x6c\x73\x2d\x73\xd0\x01\xb6\xb9\x20\xd8\x22\x28\x07\xda\x42\xff\xe6\x0d\x9c\x9d\xb0\x85\x08\xa8\xc0\x59\x28\x8d\xd0\x16\x8e\x3d\xa9\xa3\x80\xb0\x0a\x0a\x14\x96\x80\x5c\x5c\xbc\x74\x9e\xc4\xc2\x60\x1c\x16\x2e\x50\xc3\x06\xa5\x03\x79\x17\x7a\xaf\x60\x51\x11\x68\xfa\x3a\x1c\xea\xad\x23\x90\x06\x85\x87\xdc\x6d\x63\x91\x71\x42\xd5\x5b\x5a\x7a\x57\x7c\x6a\x3c\xfa\xb3\xd5\x94\xbb\x8a\x20\x17\x1b\x6d\x57\x07\x01\x72\x20\xab\x40\xae\xd0\x01\x63\xdd\x91\xa8\x29\xa0\x59\x32\x80\x2f\xc4\xf2\x14\xad\x2f\xd3\x3e\x4b\x88\x97\x21\x5e\xd9\x98\x4e\xc6\x00\x96\x46\xac\x42\x16\x4f\x06\xa0\x63\x9d\x42\xae\xcb\x56\x4e\x3b\x47\xa0\x10\x0f\x3c\x06\xab\x95\xb9\x06\x3a\xd4\x18\xb1\x40\x13\x9a\xba\x9b\xfe\x4f\xc3\x9b\xe9\xfe\x95\x30\x65\x2e\x92\xe3\xc2\x89\x76\xed\x3b\x9d\xb5\x02\x3a\x1a\x34\x5a\xc2\x18\xb7\xe5\xa5\xd7\x1b\x6d\x70\x85\x2a\x23\x5f\x61\x8d\x95\x4e\x71\x6d\x97\x5e\x70\xe9\x2c\x09\x6d\xd1\x73\x5d\x88\x15\x66\xdd\x5d\xff\xb7\xe9\x7c\xf8\xcb\x74\x3e\xbc\xfe\x30\xef\x5f\x5f\x4f\x3e\x8e\x67\xfb\x44\xad\x7d\x82\xd2\x27\x47\x78\x30\x7c\xdf\xff\x78\x33\x9b\x7f\x18\xfe\x3c\x9a\x8c\xf7\x89\x28\xc4\x5f\xce\x8a\x6d\x48\xa4\x2b\xa2\x7b\x69\x29\xaa\x80\x5c\x14\xea\xed\xf9\xc5\x77\xc9\xeb\x7a\x59\x69\x5c\xa5\x78\xe9\xdd\x46\x2b\xf4\x99\xd8\x86\x06\xb0\x9a\x2f\xb4\xe5\x4a\xfb\x2c\x75\x25\xa5\xd2\xea\xf8\xef\xd2\x82\xa5\xb3\xcb\x23\x1e\x0f\x20\xe2\x16\x29\x51\x0d\xe3\xb4\x0d\x5f\xd9\x18\xf7\x4c\x39\xb9\x46\x5f\xc3\x16\x69\xeb\xfc\x9a\x97\xa6\x5a\x69\x9b\x49\xab\x6b\xc0\xe3\x4a\x07\x42\xcf\xa3\xf3\x6d\x87\x4e\x40\x0c\x1c\x8f\xda\x74\x3a\x92\x59\x7f\x34\x9e\x4d\x1b\x9f\x63\x4a\x62\x73\x7a\x95\x3d\x0f\xcf\x71\x3a\x79\x14\x85\xa9\xc9\x9f\x21\xc6\x94\x35\xac\x5e\x4c\xd2\x21\xc2\xe1\xd3\x1b\x12\x9f\x82\xf8\x10\x1d\x12\x76\xf7\xe3\xfd\xbe\xc3\x7a\xf5\xfb\x73\xb8\xcf\x6d\x1e\xfb\x67\x00\x8c\x16\xcc\x40\x9a\x07\x00\x00")
 
 func bootstrapUbuntuShBytes() ([]byte, error) {
 	return bindataRead(
@@ -131,7 +131,7 @@ func bootstrapUbuntuSh() (*asset, error) {
 	return a, nil
 }
 
-var _kubeletYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x4f\x4f\xc3\x30\x0c\xc5\xef\xf9\x14\xfe\x04\x6d\x07\x9a\x04\xb9\x8d\x4d\x70\x60\x27\x36\xe0\xec\xa6\xee\x16\x35\x8d\x27\xc7\x19\x7f\x3e\x3d\x5a\x5a\x90\x26\xa1\x9c\x9e\xde\xb3\xdf\x4f\xce\xe0\x63\x67\xe1\x39\xb7\x14\x48\xd7\x1c\x7b\x7f\xc8\x82\xea\x39\x1a\x3c\xf9\x37\x92\xe4\x39\x5a\x18\xa6\x40\xe5\x4a\xa2\x1a\xee\x52\xe5\xb9\x3e\x2f\x5a\x52\x5c\x18\x83\x5d\x27\x94\x92\x85\xa6\x2a\xcf\xb8\x90\x93\x92\x6c\x78\x44\x1f\x2d\xcc\xb2\x0a\xec\x30\x18\x83\x59\x8f\x14\xd5\xbb\x52\x64\x0d\x00\x46\x8e\x5f\x23\xe7\x74\x11\x00\x14\xb1\x0d\xd4\x59\xe8\x31\x24\x32\x00\x1f\xd4\x1e\x99\x87\xc9\x75\xe8\x8e\xb4\xdf\x6f\x2d\xdc\x8c\x4d\xba\x1e\x50\xc9\x97\xfc\xe7\xb2\xb9\x9f\xc3\xc1\x53\xd4\xf5\xea\xd1\x07\xb2\x50\x93\xba\x9a\x86\xe4\x34\xd4\x0e\x2b\x27\x3a\xd1\xb0\xf8\xef\x3f\x98\x91\x3b\xb2\xf0\x3e\x55\xfe\x5b\xbe\x9a\x47\xa8\x2b\x18\xcb\x5f\x8c\x62\xbe\x46\xbc\xb6\x6f\x9b\x64\x4c\x22\x39\x93\xec\xb7\xbb\x07\x66\x4d\x2a\x78\x9a\x61\x8d\x3b\x08\xe7\xd3\x46\xfc\x99\xc4\xc2\xa4\xfa\x64\x4c\x4f\xa8\x59\xe8\x09\x95\xca\x59\x5e\x58\x51\x69\xfe\xaa\x5d\x59\xb7\x26\x51\xdf\x5f\xee\x48\xf3\xb6\x9f\x00\x00\x00\xff\xff\x1f\x2f\xa9\x0f\xd0\x01\x00\x00")
+var _kubeletYaml = []byte("\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x91\x4f\x4f\xc3\x30\x0c\xc5\xef\xf9\x14\xfe\x04\x6d\x07\x9a\x04\xb9\x8d\x4d\x70\x60\x27\x36\xe0\xec\xa6\xee\x16\x35\x8d\x27\xc7\x19\x7f\x3e\x3d\x5a\x5a\x90\x26\xa1\x9c\x9e\xde\x73\xde\x4f\xf6\xe0\x63\x67\xe1\x39\xb7\x14\x48\xd7\x1c\x7b\x7f\xc8\x82\xea\x39\x1a\x3c\xf9\x37\x92\xe4\x39\x5a\x18\xa6\x40\xe5\x4a\xa2\x1a\xee\x52\xe5\xb9\x3e\x2f\x5a\x52\x5c\x18\x83\x5d\x27\x94\x92\x85\xa6\x2a\xcf\xb8\x90\x93\x92\x6c\x78\x44\x1f\x2d\xcc\xb2\x0a\xec\x30\x18\x83\x59\x8f\x14\xd5\xbb\x52\x64\x0d\x00\x46\x8e\x5f\x23\xe7\x74\x11\x00\x14\xb1\x0d\xd4\x59\xe8\x31\x24\x32\x00\x1f\xd4\x1e\x99\x87\xc9\x75\xe8\x8e\xb4\xdf\x6f\x2d\xdc\x8c\x4d\xba\x1e\x50\xc9\x97\xfc\xe7\xb2\xb9\x9f\xc3\xc1\x53\xd4\xf5\xea\xd1\x07\xb2\x50\x93\xba\x9a\x86\xe4\x34\xd4\x0e\x2b\x27\x3a\xd1\xb0\xf8\xef\x3f\x98\x91\x3b\xb2\xf0\x3e\x55\xfe\x5b\xbe\x9a\x47\xa8\x2b\x18\xcb\x5f\x8c\x62\xbe\x46\xbc\xb6\x6f\x9b\x64\x4c\x22\x39\x93\xec\xb7\xbb\x07\x66\x4d\x2a\x78\x9a\x61\x8d\x3b\x08\xe7\xd3\x46\xfc\x99\xc4\xc2\xa4\xfa\x64\x4c\x4f\xa8\x59\xe8\x09\x95\xca\x5a\x5e\x58\x51\x69\x3e\xd5\xae\x7c\xb7\x26\x51\xdf\x5f\xf6\x48\x16\x54\x32\x99\x9f\x01\x00\x1f\x2f\xa9\x0f\xd0\x01\x00\x00")
 
 func kubeletYamlBytes() ([]byte, error) {
 	return bindataRead(
@@ -17,12 +17,18 @@ NODE_IP="$(curl --silent http://169.254.169.254/latest/meta-data/local-ipv4)"
 INSTANCE_ID="$(curl --silent http://169.254.169.254/latest/meta-data/instance-id)"
 INSTANCE_TYPE="$(curl --silent http://169.254.169.254/latest/meta-data/instance-type)"
 
+case "$(uname -m)" in
+  aarch64) ARCH="arm64" ;;
+  *) ARCH="amd64" ;;
+esac
+
 source /etc/eksctl/kubelet.env # this can override MAX_PODS
 
 cat > /etc/eksctl/kubelet.local.env <<EOF
 NODE_IP=${NODE_IP}
 INSTANCE_ID=${INSTANCE_ID}
 INSTANCE_TYPE=${INSTANCE_TYPE}
+ARCH=${ARCH}
 MAX_PODS=${MAX_PODS:-$(get_max_pods "${INSTANCE_TYPE}")}
 EOF
 
@@ -0,0 +1,57 @@
+package nodebootstrap
+
+import (
+	"encoding/base64"
+	"strings"
+	"text/template"
+
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// BootstrapScriptVariables are the variables that bootstrap script templates are rendered with,
+// they are those that the bootstrap scripts of the EKS-optimized AMIs take
+type BootstrapScriptVariables struct {
+	ClusterName string
+	Region      string
+	// APIServerEndpoint is the URL of the API server of the cluster
+	APIServerEndpoint string
+	// Base64ClusterCA is the base64-encoded PEM certificate of the CA of the cluster
+	Base64ClusterCA string
+	// ClusterDNS is the IP address of the DNS service of the cluster
+	ClusterDNS string
+	// NodeLabels are the labels of the nodes as kubelet's --node-labels takes them
+	NodeLabels string
+	// NodeTaints are the taints of the nodes as kubelet's --register-with-taints takes them
+	NodeTaints string
+	// MaxPods is the maximum number of pods per node, or 0 when it's not set
+	MaxPods int
+}
+
+// renderBootstrapScriptTemplate renders bootstrapScriptTemplate of the nodegroup, variables that
+// don't exist fail the rendering rather than being left empty
+func renderBootstrapScriptTemplate(spec *api.ClusterConfig, ng *api.NodeGroup) (string, error) {
+	if len(spec.Status.CertificateAuthorityData) == 0 {
+		return "", errors.New("invalid cluster config: missing CertificateAuthorityData")
+	}
+	tmpl, err := template.New("bootstrapScriptTemplate").Option("missingkey=error").Parse(*ng.BootstrapScriptTemplate)
+	if err != nil {
+		return "", errors.Wrapf(err, "parsing bootstrap script template of nodegroup %q", ng.Name)
+	}
+	variables := BootstrapScriptVariables{
+		ClusterName:       spec.Metadata.Name,
+		Region:            spec.Metadata.Region,
+		APIServerEndpoint: spec.Status.Endpoint,
+		Base64ClusterCA:   base64.StdEncoding.EncodeToString(spec.Status.CertificateAuthorityData),
+		ClusterDNS:        clusterDNS(spec, ng),
+		NodeLabels:        joinSortedKeyValues(ng.Labels),
+		NodeTaints:        registerWithTaints(ng.Taints),
+		MaxPods:           ng.MaxPodsPerNode,
+	}
+	var script strings.Builder
+	if err := tmpl.Execute(&script, variables); err != nil {
+		return "", errors.Wrapf(err, "rendering bootstrap script template of nodegroup %q", ng.Name)
+	}
+	return script.String(), nil
+}
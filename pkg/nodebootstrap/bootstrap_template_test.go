@@ -0,0 +1,82 @@
+package nodebootstrap
+
+import (
+	"encoding/base64"
+
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+var _ = Describe("Bootstrap script templates", func() {
+	var (
+		clusterConfig *api.ClusterConfig
+		ng            *api.NodeGroup
+	)
+
+	BeforeEach(func() {
+		clusterConfig = api.NewClusterConfig()
+		clusterConfig.Metadata.Name = "golden"
+		clusterConfig.Metadata.Region = "us-west-2"
+		clusterConfig.Status = &api.ClusterStatus{
+			Endpoint:                 "https://test.eks.amazonaws.com",
+			CertificateAuthorityData: []byte("CA"),
+		}
+		ng = clusterConfig.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.AMIFamily = api.NodeImageFamilyAmazonLinux2
+		ng.Labels = map[string]string{"role": "app", "os": "linux"}
+		ng.Taints = map[string]string{"dedicated": "app:NoSchedule"}
+		ng.MaxPodsPerNode = 20
+	})
+
+	It("renders the cluster variables", func() {
+		ng.BootstrapScriptTemplate = aws.String(`/etc/eks/bootstrap.sh {{.ClusterName}} --apiserver-endpoint {{.APIServerEndpoint}} --b64-cluster-ca {{.Base64ClusterCA}} --dns-cluster-ip {{.ClusterDNS}} --kubelet-extra-args '--node-labels={{.NodeLabels}} --register-with-taints={{.NodeTaints}}{{if .MaxPods}} --max-pods={{.MaxPods}}{{end}}' # {{.Region}}`)
+
+		script, err := renderBootstrapScriptTemplate(clusterConfig, ng)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(script).To(Equal("/etc/eks/bootstrap.sh golden --apiserver-endpoint https://test.eks.amazonaws.com --b64-cluster-ca " +
+			base64.StdEncoding.EncodeToString([]byte("CA")) + " --dns-cluster-ip 10.100.0.10 --kubelet-extra-args '--node-labels=os=linux,role=app --register-with-taints=dedicated=app:NoSchedule --max-pods=20' # us-west-2"))
+	})
+
+	It("fails on variables that don't exist", func() {
+		ng.BootstrapScriptTemplate = aws.String(`/etc/eks/bootstrap.sh {{.Cluster}}`)
+
+		_, err := renderBootstrapScriptTemplate(clusterConfig, ng)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(HavePrefix(`rendering bootstrap script template of nodegroup "ng-1"`))
+	})
+
+	It("runs the rendered script instead of the bootstrap script of eksctl", func() {
+		ng.BootstrapScriptTemplate = aws.String("#!/bin/bash\n/etc/eks/bootstrap.sh {{.ClusterName}}\n")
+
+		userData, err := NewUserData(clusterConfig, ng)
+		Expect(err).ToNot(HaveOccurred())
+
+		config, err := cloudconfig.DecodeCloudConfig(userData)
+		Expect(err).ToNot(HaveOccurred())
+
+		scripts := map[string]string{}
+		for _, file := range config.WriteFiles {
+			scripts[file.Path] = file.Content
+		}
+		Expect(scripts).To(HaveKeyWithValue("/var/lib/cloud/scripts/per-instance/bootstrap.custom.sh", "#!/bin/bash\n/etc/eks/bootstrap.sh golden\n"))
+		Expect(scripts).ToNot(HaveKey("/var/lib/cloud/scripts/per-instance/bootstrap.al2.sh"))
+		Expect(config.Commands).To(ContainElement(ConsistOf("/var/lib/cloud/scripts/per-instance/bootstrap.custom.sh")))
+	})
+
+	It("adds the rendered script to the PowerShell script of Windows nodes", func() {
+		ng.AMIFamily = api.NodeImageFamilyWindowsServer2019CoreContainer
+		ng.BootstrapScriptTemplate = aws.String("& C:\\bootstrap.ps1 -EKSClusterName {{.ClusterName}}\n")
+
+		userData, err := NewUserData(clusterConfig, ng)
+		Expect(err).ToNot(HaveOccurred())
+
+		data, err := base64.StdEncoding.DecodeString(userData)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(string(data)).To(Equal("<powershell>\n& C:\\bootstrap.ps1 -EKSClusterName golden\n</powershell>\n"))
+	})
+})
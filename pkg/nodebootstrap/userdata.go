@@ -2,6 +2,7 @@ package nodebootstrap
 
 import (
 	"fmt"
+	"sort"
 	"strings"
 
 	"github.com/pkg/errors"
@@ -24,6 +25,9 @@ const (
 	kubeletDropInUnitDir = "/etc/systemd/system/kubelet.service.d/"
 )
 
+// installEFADriverCommand installs the EFA kernel module, the libraries that workloads need are part of their images
+const installEFADriverCommand = "curl --silent --fail --location https://efa-installer.amazonaws.com/aws-efa-installer-latest.tar.gz | tar -xz -C /tmp && cd /tmp/aws-efa-installer && ./efa_installer.sh --yes --minimal"
+
 type configFile struct {
 	content string
 	isAsset bool
@@ -141,7 +145,7 @@ func makeCommonKubeletEnvParams(spec *api.ClusterConfig, ng *api.NodeGroup) []st
 
 	variables := []string{
 		fmt.Sprintf("NODE_LABELS=%s", kvs(ng.Labels)),
-		fmt.Sprintf("NODE_TAINTS=%s", kvs(ng.Taints)),
+		fmt.Sprintf("NODE_TAINTS=%s", registerWithTaints(ng.Taints)),
 	}
 
 	if ng.MaxPodsPerNode != 0 {
@@ -150,6 +154,26 @@ func makeCommonKubeletEnvParams(spec *api.ClusterConfig, ng *api.NodeGroup) []st
 	return variables
 }
 
+// registerWithTaints formats taints as expected by kubelet's --register-with-taints,
+// i.e. "<key>=<value>:<effect>", or "<key>:<effect>" for taints without a value
+func registerWithTaints(taints map[string]string) string {
+	var params []string
+	for key, taint := range taints {
+		value, effect, err := api.ParseTaint(taint)
+		switch {
+		case err != nil:
+			// taints are validated beforehand, keep it as it is
+			params = append(params, fmt.Sprintf("%s=%s", key, taint))
+		case value == "":
+			params = append(params, fmt.Sprintf("%s:%s", key, effect))
+		default:
+			params = append(params, fmt.Sprintf("%s=%s:%s", key, value, effect))
+		}
+	}
+	sort.Strings(params)
+	return strings.Join(params, ",")
+}
+
 func makeMetadata(spec *api.ClusterConfig) []string {
 	return []string{
 		fmt.Sprintf("AWS_DEFAULT_REGION=%s", spec.Metadata.Region),
@@ -174,6 +198,8 @@ func NewUserData(spec *api.ClusterConfig, ng *api.NodeGroup) (string, error) {
 		return NewUserDataForAmazonLinux2(spec, ng)
 	case ami.ImageFamilyUbuntu1804:
 		return NewUserDataForUbuntu1804(spec, ng)
+	case ami.ImageFamilyWindowsServer2019CoreContainer, ami.ImageFamilyWindowsServer2019FullContainer:
+		return NewUserDataForWindows(spec, ng)
 	default:
 		return "", nil
 	}
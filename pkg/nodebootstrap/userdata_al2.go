@@ -3,12 +3,14 @@ package nodebootstrap
 import (
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
+const installSSMAgentCommand = "yum install -y amazon-ssm-agent && systemctl enable --now amazon-ssm-agent"
+
 func makeAmazonLinux2Config(spec *api.ClusterConfig, ng *api.NodeGroup) (configFiles, error) {
 	clientConfigData, err := makeClientConfigData(spec, ng)
 	if err != nil {
@@ -53,13 +55,31 @@ func NewUserDataForAmazonLinux2(spec *api.ClusterConfig, ng *api.NodeGroup) (str
 
 	scripts := []string{}
 
+	if ng.SSH != nil && api.IsEnabled(ng.SSH.EnableSSM) {
+		// SSM agent isn't part of the EKS-optimised AMI
+		config.AddShellCommand(installSSMAgentCommand)
+	}
+
+	addSetupVolumesScript(config, ng)
+
+	if api.IsEnabled(ng.EFAEnabled) {
+		config.AddShellCommand(installEFADriverCommand)
+	}
+
 	for _, command := range ng.PreBootstrapCommands {
 		config.AddShellCommand(command)
 	}
 
-	if ng.OverrideBootstrapCommand != nil {
+	switch {
+	case ng.OverrideBootstrapCommand != nil:
 		config.AddShellCommand(*ng.OverrideBootstrapCommand)
-	} else {
+	case ng.BootstrapScriptTemplate != nil:
+		script, err := renderBootstrapScriptTemplate(spec, ng)
+		if err != nil {
+			return "", err
+		}
+		config.RunScript("bootstrap.custom.sh", script)
+	default:
 		scripts = append(scripts, "bootstrap.al2.sh")
 	}
 
@@ -67,6 +87,10 @@ func NewUserDataForAmazonLinux2(spec *api.ClusterConfig, ng *api.NodeGroup) (str
 		return "", err
 	}
 
+	for _, command := range ng.PostBootstrapCommands {
+		config.AddShellCommand(command)
+	}
+
 	body, err := config.Encode()
 	if err != nil {
 		return "", errors.Wrap(err, "encoding user data")
@@ -0,0 +1,132 @@
+package nodebootstrap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+	"net/textproto"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+type userDataPart struct {
+	header  textproto.MIMEHeader
+	content []byte
+}
+
+func newUserDataPart(contentType string, content []byte) userDataPart {
+	header := textproto.MIMEHeader{}
+	header.Set("Content-Type", contentType)
+	return userDataPart{header: header, content: content}
+}
+
+// MergeUserData combines user data of a launch template with user data generated by eksctl,
+// both base64-encoded, into a single MIME multi-part document that cloud-init understands;
+// parts from the launch template come first, so that they run before the node is bootstrapped
+func MergeUserData(templateUserData, userData string) (string, error) {
+	templateParts, err := decodeUserDataParts(templateUserData)
+	if err != nil {
+		return "", errors.Wrap(err, "decoding user data of launch template")
+	}
+	parts, err := decodeUserDataParts(userData)
+	if err != nil {
+		return "", errors.Wrap(err, "decoding user data of nodegroup")
+	}
+
+	body := &bytes.Buffer{}
+	mw := multipart.NewWriter(body)
+	for _, part := range append(templateParts, parts...) {
+		w, err := mw.CreatePart(part.header)
+		if err != nil {
+			return "", err
+		}
+		if _, err := w.Write(part.content); err != nil {
+			return "", err
+		}
+	}
+	if err := mw.Close(); err != nil {
+		return "", err
+	}
+
+	buf := &bytes.Buffer{}
+	gw := gzip.NewWriter(buf)
+	fmt.Fprintf(gw, "MIME-Version: 1.0\nContent-Type: multipart/mixed; boundary=%q\n\n", mw.Boundary())
+	if _, err := gw.Write(body.Bytes()); err != nil {
+		return "", err
+	}
+	if err := gw.Close(); err != nil {
+		return "", err
+	}
+	return base64.StdEncoding.EncodeToString(buf.Bytes()), nil
+}
+
+func decodeUserDataParts(userData string) ([]userDataPart, error) {
+	if userData == "" {
+		return nil, nil
+	}
+	data, err := base64.StdEncoding.DecodeString(userData)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) > 2 && data[0] == 0x1f && data[1] == 0x8b {
+		gr, err := gzip.NewReader(bytes.NewReader(data))
+		if err != nil {
+			return nil, err
+		}
+		if data, err = ioutil.ReadAll(gr); err != nil {
+			return nil, err
+		}
+	}
+
+	switch {
+	case bytes.HasPrefix(data, []byte("#cloud-config")):
+		return []userDataPart{newUserDataPart("text/cloud-config", data)}, nil
+	case bytes.HasPrefix(data, []byte("#cloud-boothook")):
+		return []userDataPart{newUserDataPart("text/cloud-boothook", data)}, nil
+	case bytes.HasPrefix(data, []byte("#!")):
+		return []userDataPart{newUserDataPart("text/x-shellscript", data)}, nil
+	case bytes.HasPrefix(data, []byte("Content-Type:")), bytes.HasPrefix(data, []byte("MIME-Version:")):
+		return decodeMultipartUserData(data)
+	default:
+		return nil, fmt.Errorf("unsupported user data format, only shell scripts, cloud-config and MIME multi-part documents can be merged")
+	}
+}
+
+func decodeMultipartUserData(data []byte) ([]userDataPart, error) {
+	msg, err := mail.ReadMessage(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+	if !strings.HasPrefix(mediaType, "multipart/") {
+		return nil, fmt.Errorf("unexpected content type %q", mediaType)
+	}
+
+	var parts []userDataPart
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for {
+		p, err := mr.NextPart()
+		if err == io.EOF {
+			return parts, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		content, err := ioutil.ReadAll(p)
+		if err != nil {
+			return nil, err
+		}
+		parts = append(parts, userDataPart{header: p.Header, content: content})
+	}
+}
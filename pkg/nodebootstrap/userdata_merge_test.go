@@ -0,0 +1,80 @@
+package nodebootstrap
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/base64"
+	"io/ioutil"
+	"mime"
+	"mime/multipart"
+	"net/mail"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+func decodeMergedUserData(userData string) map[string]string {
+	data, err := base64.StdEncoding.DecodeString(userData)
+	Expect(err).ToNot(HaveOccurred())
+	gr, err := gzip.NewReader(bytes.NewReader(data))
+	Expect(err).ToNot(HaveOccurred())
+
+	msg, err := mail.ReadMessage(gr)
+	Expect(err).ToNot(HaveOccurred())
+	mediaType, params, err := mime.ParseMediaType(msg.Header.Get("Content-Type"))
+	Expect(err).ToNot(HaveOccurred())
+	Expect(mediaType).To(Equal("multipart/mixed"))
+
+	parts := map[string]string{}
+	mr := multipart.NewReader(msg.Body, params["boundary"])
+	for p, err := mr.NextPart(); err == nil; p, err = mr.NextPart() {
+		content, err := ioutil.ReadAll(p)
+		Expect(err).ToNot(HaveOccurred())
+		parts[p.Header.Get("Content-Type")] = string(content)
+	}
+	return parts
+}
+
+var _ = Describe("merging user data", func() {
+	var userData string
+
+	BeforeEach(func() {
+		config := cloudconfig.New()
+		config.AddShellCommand("/var/lib/cloud/scripts/per-instance/bootstrap.al2.sh")
+		var err error
+		userData, err = config.Encode()
+		Expect(err).ToNot(HaveOccurred())
+	})
+
+	It("merges a shell script with generated cloud-config", func() {
+		script := base64.StdEncoding.EncodeToString([]byte("#!/bin/bash\necho hardening\n"))
+
+		merged, err := MergeUserData(script, userData)
+		Expect(err).ToNot(HaveOccurred())
+
+		parts := decodeMergedUserData(merged)
+		Expect(parts).To(HaveLen(2))
+		Expect(parts["text/x-shellscript"]).To(Equal("#!/bin/bash\necho hardening\n"))
+		Expect(parts["text/cloud-config"]).To(ContainSubstring("bootstrap.al2.sh"))
+	})
+
+	It("merges parts of a multi-part document", func() {
+		doc := "MIME-Version: 1.0\nContent-Type: multipart/mixed; boundary=\"==BOUNDARY==\"\n\n" +
+			"--==BOUNDARY==\nContent-Type: text/cloud-boothook\n\n#cloud-boothook\necho boothook\n" +
+			"--==BOUNDARY==--\n"
+
+		merged, err := MergeUserData(base64.StdEncoding.EncodeToString([]byte(doc)), userData)
+		Expect(err).ToNot(HaveOccurred())
+
+		parts := decodeMergedUserData(merged)
+		Expect(parts).To(HaveLen(2))
+		Expect(parts["text/cloud-boothook"]).To(Equal("#cloud-boothook\necho boothook"))
+	})
+
+	It("rejects unsupported formats", func() {
+		_, err := MergeUserData(base64.StdEncoding.EncodeToString([]byte("<powershell></powershell>")), userData)
+		Expect(err).To(MatchError(ContainSubstring("unsupported user data format")))
+	})
+})
@@ -1,6 +1,7 @@
 package nodebootstrap
 
 import (
+	"encoding/base64"
 	"strconv"
 	"strings"
 
@@ -76,4 +77,100 @@ var _ = Describe("User data", func() {
 			Expect(kubelet.FeatureGates["RotateKubeletServerCertificate"]).To(Equal(false))
 		})
 	})
+
+	Describe("registering nodes with taints", func() {
+		It("formats taints with and without values", func() {
+			taints := map[string]string{
+				"dedicated":            "ci:NoSchedule",
+				"example.com/draining": "PreferNoSchedule",
+			}
+			Expect(registerWithTaints(taints)).To(Equal("dedicated=ci:NoSchedule,example.com/draining:PreferNoSchedule"))
+		})
+
+		It("passes taints to kubelet via the environment", func() {
+			ng := &api.NodeGroup{Taints: map[string]string{"dedicated": "NoExecute"}}
+			Expect(makeCommonKubeletEnvParams(api.NewClusterConfig(), ng)).To(ContainElement("NODE_TAINTS=dedicated:NoExecute"))
+		})
+	})
+
+	Describe("creating Windows user data", func() {
+		var (
+			clusterConfig *api.ClusterConfig
+			ng            *api.NodeGroup
+		)
+		BeforeEach(func() {
+			clusterConfig = api.NewClusterConfig()
+			clusterConfig.Metadata.Name = "windows-test"
+			clusterConfig.Status = &api.ClusterStatus{
+				Endpoint:                 "https://test.eks.amazonaws.com",
+				CertificateAuthorityData: []byte("CA"),
+			}
+			ng = &api.NodeGroup{
+				AMIFamily: api.NodeImageFamilyWindowsServer2019FullContainer,
+				Labels:    map[string]string{"os": "windows", "role": "app"},
+				Taints:    map[string]string{"os": "windows:NoSchedule"},
+			}
+		})
+
+		It("produces a PowerShell script that calls the EKS bootstrap script", func() {
+			userData, err := NewUserData(clusterConfig, ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			data, err := base64.StdEncoding.DecodeString(userData)
+			Expect(err).ToNot(HaveOccurred())
+
+			script := string(data)
+			Expect(script).To(HavePrefix("<powershell>\n"))
+			Expect(script).To(HaveSuffix("</powershell>\n"))
+			Expect(script).To(ContainSubstring(`-EKSClusterName "windows-test"`))
+			Expect(script).To(ContainSubstring(`-APIServerEndpoint "https://test.eks.amazonaws.com"`))
+			Expect(script).To(ContainSubstring(`-KubeletExtraArgs "--node-labels=os=windows,role=app --register-with-taints=os=windows:NoSchedule"`))
+		})
+
+		It("runs post-bootstrap commands after the bootstrap script", func() {
+			ng.PostBootstrapCommands = []string{"Write-Output post"}
+
+			userData, err := NewUserData(clusterConfig, ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			data, err := base64.StdEncoding.DecodeString(userData)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(data)).To(HaveSuffix("3>&1 4>&1 5>&1 6>&1\nWrite-Output post\n</powershell>\n"))
+		})
+
+		It("uses the override bootstrap command", func() {
+			override := "Write-Output custom"
+			ng.PreBootstrapCommands = []string{"Write-Output pre"}
+			ng.OverrideBootstrapCommand = &override
+
+			userData, err := NewUserData(clusterConfig, ng)
+			Expect(err).ToNot(HaveOccurred())
+
+			data, err := base64.StdEncoding.DecodeString(userData)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(string(data)).To(Equal("<powershell>\nWrite-Output pre\nWrite-Output custom\n</powershell>\n"))
+		})
+	})
+
+	Describe("setting up volumes", func() {
+		It("doesn't add a script when nothing has to be mounted", func() {
+			ng := &api.NodeGroup{
+				AdditionalVolumes: []*api.VolumeMapping{{VolumeName: "/dev/xvdb"}},
+			}
+			Expect(makeSetupVolumesScript(ng)).To(BeEmpty())
+		})
+
+		It("mounts additional volumes and the instance store", func() {
+			ng := &api.NodeGroup{
+				AdditionalVolumes: []*api.VolumeMapping{
+					{VolumeName: "/dev/xvdb", MountPath: "/var/lib/docker"},
+					{VolumeName: "/dev/xvdc"},
+				},
+				InstanceStore: &api.NodeGroupInstanceStore{MountPath: "/mnt/scratch"},
+			}
+			Expect(makeSetupVolumesScript(ng)).To(HaveSuffix("\nsetup_ebs_volume '/dev/xvdb' '/var/lib/docker'\nsetup_instance_store '/mnt/scratch'\n"))
+		})
+	})
 })
@@ -4,10 +4,10 @@ import (
 	"fmt"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 func makeUbuntu1804Config(spec *api.ClusterConfig, ng *api.NodeGroup) (configFiles, error) {
@@ -55,13 +55,26 @@ func NewUserDataForUbuntu1804(spec *api.ClusterConfig, ng *api.NodeGroup) (strin
 
 	scripts := []string{}
 
+	addSetupVolumesScript(config, ng)
+
+	if api.IsEnabled(ng.EFAEnabled) {
+		config.AddShellCommand(installEFADriverCommand)
+	}
+
 	for _, command := range ng.PreBootstrapCommands {
 		config.AddShellCommand(command)
 	}
 
-	if ng.OverrideBootstrapCommand != nil {
+	switch {
+	case ng.OverrideBootstrapCommand != nil:
 		config.AddShellCommand(*ng.OverrideBootstrapCommand)
-	} else {
+	case ng.BootstrapScriptTemplate != nil:
+		script, err := renderBootstrapScriptTemplate(spec, ng)
+		if err != nil {
+			return "", err
+		}
+		config.RunScript("bootstrap.custom.sh", script)
+	default:
 		scripts = append(scripts, "bootstrap.ubuntu.sh")
 	}
 
@@ -69,6 +82,10 @@ func NewUserDataForUbuntu1804(spec *api.ClusterConfig, ng *api.NodeGroup) (strin
 		return "", err
 	}
 
+	for _, command := range ng.PostBootstrapCommands {
+		config.AddShellCommand(command)
+	}
+
 	body, err := config.Encode()
 	if err != nil {
 		return "", errors.Wrap(err, "encoding user data")
@@ -0,0 +1,82 @@
+package nodebootstrap
+
+import (
+	"encoding/base64"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// windowsBootstrapScript is shipped with the EKS-optimized Windows AMIs
+const windowsBootstrapScript = `$env:ProgramFiles\Amazon\EKS\Start-EKSBootstrap.ps1`
+
+func joinSortedKeyValues(kv map[string]string) string {
+	var params []string
+	for k, v := range kv {
+		params = append(params, fmt.Sprintf("%s=%s", k, v))
+	}
+	sort.Strings(params)
+	return strings.Join(params, ",")
+}
+
+func makeWindowsKubeletExtraArgs(ng *api.NodeGroup) string {
+	var args []string
+	if len(ng.Labels) > 0 {
+		args = append(args, "--node-labels="+joinSortedKeyValues(ng.Labels))
+	}
+	if len(ng.Taints) > 0 {
+		args = append(args, "--register-with-taints="+registerWithTaints(ng.Taints))
+	}
+	if ng.MaxPodsPerNode != 0 {
+		args = append(args, fmt.Sprintf("--max-pods=%d", ng.MaxPodsPerNode))
+	}
+	return strings.Join(args, " ")
+}
+
+// NewUserDataForWindows creates new user data for Windows Server nodes,
+// unlike other image families it is a PowerShell script and not cloud-config
+func NewUserDataForWindows(spec *api.ClusterConfig, ng *api.NodeGroup) (string, error) {
+	if len(spec.Status.CertificateAuthorityData) == 0 {
+		return "", errors.New("invalid cluster config: missing CertificateAuthorityData")
+	}
+
+	var script strings.Builder
+	script.WriteString("<powershell>\n")
+
+	for _, command := range ng.PreBootstrapCommands {
+		script.WriteString(command + "\n")
+	}
+
+	switch {
+	case ng.OverrideBootstrapCommand != nil:
+		script.WriteString(*ng.OverrideBootstrapCommand + "\n")
+	case ng.BootstrapScriptTemplate != nil:
+		bootstrapScript, err := renderBootstrapScriptTemplate(spec, ng)
+		if err != nil {
+			return "", err
+		}
+		script.WriteString(strings.TrimSuffix(bootstrapScript, "\n") + "\n")
+	default:
+		fmt.Fprintf(&script, "[string]$EKSBootstrapScriptFile = \"%s\"\n", windowsBootstrapScript)
+		fmt.Fprintf(&script, "& $EKSBootstrapScriptFile -EKSClusterName %q -APIServerEndpoint %q -Base64ClusterCA %q -DNSClusterIP %q -KubeletExtraArgs %q 3>&1 4>&1 5>&1 6>&1\n",
+			spec.Metadata.Name,
+			spec.Status.Endpoint,
+			base64.StdEncoding.EncodeToString(spec.Status.CertificateAuthorityData),
+			clusterDNS(spec, ng),
+			makeWindowsKubeletExtraArgs(ng),
+		)
+	}
+
+	for _, command := range ng.PostBootstrapCommands {
+		script.WriteString(command + "\n")
+	}
+
+	script.WriteString("</powershell>\n")
+
+	logger.Debug("user-data = %s", script.String())
+	return base64.StdEncoding.EncodeToString([]byte(script.String())), nil
+}
@@ -0,0 +1,106 @@
+package nodebootstrap
+
+import (
+	"fmt"
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cloudconfig"
+)
+
+const setupVolumesScriptName = "setup-volumes.sh"
+
+// setupVolumesFunctions are used by the script that formats and mounts volumes of the nodes,
+// before kubelet gets started
+const setupVolumesFunctions = `#!/bin/bash
+
+set -o errexit
+set -o pipefail
+set -o nounset
+
+# on Nitro instances EBS volumes are NVMe devices, which have the device name
+# of the block device mapping in the vendor specific data of the controller
+function find_ebs_volume() {
+  local name="${1#/dev/}"
+  for _ in $(seq 60); do
+    for device in "/dev/${name}" "/dev/xvd${name#sd}"; do
+      if [[ -b "${device}" ]]; then
+        echo "${device}"
+        return
+      fi
+    done
+    for device in $(lsblk --nodeps --noheadings --output NAME,MODEL | awk '/Amazon Elastic Block Store/ {print "/dev/"$1}'); do
+      if [[ "$(nvme id-ctrl --raw-binary "${device}" | cut -c3073-3104 | tr -d ' \0')" =~ ^(/dev/)?${name}$ ]]; then
+        echo "${device}"
+        return
+      fi
+    done
+    sleep 5
+  done
+  echo "volume ${1} not found" >&2
+  return 1
+}
+
+function mount_volume() {
+  local device="${1}" mount_path="${2}"
+  mkdir -p "${mount_path}"
+  echo "UUID=$(blkid --match-tag UUID --output value "${device}") ${mount_path} ext4 defaults,nofail 0 2" >> /etc/fstab
+  mount "${mount_path}"
+}
+
+function setup_ebs_volume() {
+  local device
+  device="$(find_ebs_volume "${1}")"
+  # keep the data of volumes that are already formatted, e.g. when they're created from snapshots
+  if ! blkid "${device}" > /dev/null; then
+    mkfs.ext4 -q "${device}"
+  fi
+  mount_volume "${device}" "${2}"
+}
+
+function setup_instance_store() {
+  local devices
+  devices=($(lsblk --nodeps --noheadings --output NAME,MODEL | awk '/Amazon EC2 NVMe Instance Storage/ {print "/dev/"$1}'))
+  if [[ "${#devices[@]}" -eq 0 ]]; then
+    echo "instance type has no NVMe instance store volumes" >&2
+    return 1
+  fi
+  local device="${devices[0]}"
+  if [[ "${#devices[@]}" -gt 1 ]]; then
+    device="/dev/md/eksctl-instance-store"
+    mdadm --create --force --run "${device}" --level=0 --name=eksctl-instance-store --raid-devices="${#devices[@]}" "${devices[@]}"
+  fi
+  mkfs.ext4 -q "${device}"
+  mount_volume "${device}" "${1}"
+}
+`
+
+// makeSetupVolumesScript returns the script that formats and mounts the additional volumes
+// and the instance store of the nodegroup, or an empty string if there is nothing to mount
+func makeSetupVolumesScript(ng *api.NodeGroup) string {
+	commands := []string{}
+	for _, v := range ng.AdditionalVolumes {
+		if v.MountPath != "" {
+			commands = append(commands, fmt.Sprintf("setup_ebs_volume %s %s", shellQuote(v.VolumeName), shellQuote(v.MountPath)))
+		}
+	}
+	if ng.InstanceStore != nil {
+		commands = append(commands, fmt.Sprintf("setup_instance_store %s", shellQuote(ng.InstanceStore.MountPath)))
+	}
+	if len(commands) == 0 {
+		return ""
+	}
+	return setupVolumesFunctions + "\n" + strings.Join(commands, "\n") + "\n"
+}
+
+// addSetupVolumesScript adds the script that mounts volumes, it has to run before any commands
+// that might use the volumes
+func addSetupVolumesScript(config *cloudconfig.CloudConfig, ng *api.NodeGroup) {
+	if script := makeSetupVolumesScript(ng); script != "" {
+		config.RunScript(setupVolumesScriptName, script)
+	}
+}
+
+func shellQuote(s string) string {
+	return "'" + strings.Replace(s, "'", `'\''`, -1) + "'"
+}
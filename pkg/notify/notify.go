@@ -0,0 +1,121 @@
+package notify
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/audit"
+)
+
+const (
+	// EventBusEnv is the environment variable that sets the name or ARN of the EventBridge event bus
+	// that events are sent to
+	EventBusEnv = "EKSCTL_NOTIFY_EVENT_BUS"
+
+	// Source is the source of the events
+	Source = "eksctl"
+	// DetailType is the detail type of the events
+	DetailType = "eksctl Operation Completed"
+
+	// OutcomeSucceeded is the outcome of an operation that returned no error
+	OutcomeSucceeded = "Succeeded"
+	// OutcomeFailed is the outcome of an operation that returned an error
+	OutcomeFailed = "Failed"
+)
+
+// operations are the commands that events are sent for, by their command path
+var operations = map[string]string{
+	"eksctl create cluster":    "CreateCluster",
+	"eksctl delete cluster":    "DeleteCluster",
+	"eksctl update cluster":    "UpgradeCluster",
+	"eksctl replace nodegroup": "ReplaceNodeGroup",
+}
+
+// Event is the detail of the event that is sent when an operation finishes
+type Event struct {
+	Operation string `json:"operation"`
+	Command   string `json:"command"`
+	Cluster   string `json:"cluster,omitempty"`
+	Region    string `json:"region,omitempty"`
+	// ConfigHash is the SHA-256 of the resolved ClusterConfig, as in the audit log
+	ConfigHash      string  `json:"configHash,omitempty"`
+	Outcome         string  `json:"outcome"`
+	Error           string  `json:"error,omitempty"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	Version         string  `json:"version"`
+}
+
+// Notifier sends an event to an EventBridge event bus when an operation finishes
+type Notifier struct {
+	EventBus  string
+	Operation string
+}
+
+// NewNotifierFromEnv returns the notifier that the environment configures for the given command,
+// which is nil when no event bus is set, or when the command isn't one of the operations events are sent for
+func NewNotifierFromEnv(command string) *Notifier {
+	eventBus := os.Getenv(EventBusEnv)
+	operation, ok := operations[command]
+	if eventBus == "" || !ok {
+		return nil
+	}
+	return &Notifier{
+		EventBus:  eventBus,
+		Operation: operation,
+	}
+}
+
+// NewEvent returns the event for the finished command that e records
+func (n *Notifier) NewEvent(e *audit.Entry) *Event {
+	event := &Event{
+		Operation:       n.Operation,
+		Command:         e.Command,
+		Cluster:         e.Cluster,
+		Region:          e.Region,
+		ConfigHash:      e.ConfigHash,
+		Outcome:         OutcomeSucceeded,
+		DurationSeconds: e.DurationSeconds,
+		Version:         e.Version,
+	}
+	if e.Error != "" {
+		event.Outcome = OutcomeFailed
+		event.Error = e.Error
+	}
+	return event
+}
+
+// Send puts the event for the finished command that e records on the event bus; provider may
+// be nil when the command didn't get to use AWS, in which case no event can be sent
+func (n *Notifier) Send(provider api.ClusterProvider, e *audit.Entry) error {
+	if provider == nil {
+		return fmt.Errorf("cannot send event to event bus %q without AWS credentials", n.EventBus)
+	}
+
+	data, err := json.Marshal(n.NewEvent(e))
+	if err != nil {
+		return err
+	}
+
+	output, err := provider.EventBridge().PutEvents(&eventbridge.PutEventsInput{
+		Entries: []*eventbridge.PutEventsRequestEntry{{
+			EventBusName: aws.String(n.EventBus),
+			Source:       aws.String(Source),
+			DetailType:   aws.String(DetailType),
+			Detail:       aws.String(string(data)),
+			Time:         aws.Time(e.Time),
+		}},
+	})
+	if err != nil {
+		return errors.Wrapf(err, "sending event to event bus %q", n.EventBus)
+	}
+	if aws.Int64Value(output.FailedEntryCount) > 0 && len(output.Entries) > 0 {
+		return fmt.Errorf("sending event to event bus %q: %s", n.EventBus, aws.StringValue(output.Entries[0].ErrorMessage))
+	}
+	return nil
+}
@@ -0,0 +1,100 @@
+package notify_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/eventbridge"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/audit"
+	. "github.com/weaveworks/eksctl/pkg/notify"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("notifications", func() {
+	var (
+		p     *mockprovider.MockProvider
+		entry *audit.Entry
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg := api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		started := time.Date(2020, 3, 4, 5, 6, 7, 0, time.UTC)
+		entry = audit.NewEntry("eksctl create cluster", []string{"create", "cluster", "-f", "cluster.yaml"}, started)
+		Expect(entry.Finish(cfg, nil, started.Add(20*time.Minute))).To(Succeed())
+	})
+
+	AfterEach(func() {
+		os.Unsetenv(EventBusEnv)
+	})
+
+	It("is only configured for major operations when an event bus is set", func() {
+		Expect(NewNotifierFromEnv("eksctl create cluster")).To(BeNil())
+
+		os.Setenv(EventBusEnv, "automation")
+		Expect(NewNotifierFromEnv("eksctl create cluster")).To(Equal(&Notifier{EventBus: "automation", Operation: "CreateCluster"}))
+		Expect(NewNotifierFromEnv("eksctl update cluster")).To(Equal(&Notifier{EventBus: "automation", Operation: "UpgradeCluster"}))
+		Expect(NewNotifierFromEnv("eksctl get cluster")).To(BeNil())
+	})
+
+	It("records the outcome of the operation", func() {
+		notifier := &Notifier{EventBus: "automation", Operation: "CreateCluster"}
+
+		event := notifier.NewEvent(entry)
+		Expect(event.Outcome).To(Equal(OutcomeSucceeded))
+		Expect(event.Cluster).To(Equal("test-cluster"))
+		Expect(event.ConfigHash).To(Equal(entry.ConfigHash))
+		Expect(event.DurationSeconds).To(Equal(1200.0))
+
+		entry.Error = "failed to create cluster"
+		event = notifier.NewEvent(entry)
+		Expect(event.Outcome).To(Equal(OutcomeFailed))
+		Expect(event.Error).To(Equal("failed to create cluster"))
+	})
+
+	It("puts the event on the event bus", func() {
+		var input *eventbridge.PutEventsInput
+		p.MockEventBridge().On("PutEvents", mock.Anything).Run(func(args mock.Arguments) {
+			input = args[0].(*eventbridge.PutEventsInput)
+		}).Return(&eventbridge.PutEventsOutput{FailedEntryCount: aws.Int64(0)}, nil)
+
+		notifier := &Notifier{EventBus: "automation", Operation: "CreateCluster"}
+		Expect(notifier.Send(p, entry)).To(Succeed())
+
+		Expect(input.Entries).To(HaveLen(1))
+		Expect(*input.Entries[0].EventBusName).To(Equal("automation"))
+		Expect(*input.Entries[0].Source).To(Equal(Source))
+		Expect(*input.Entries[0].DetailType).To(Equal(DetailType))
+
+		var detail map[string]interface{}
+		Expect(json.Unmarshal([]byte(*input.Entries[0].Detail), &detail)).To(Succeed())
+		Expect(detail).To(HaveKeyWithValue("operation", "CreateCluster"))
+		Expect(detail).To(HaveKeyWithValue("cluster", "test-cluster"))
+		Expect(detail).To(HaveKeyWithValue("region", "us-west-2"))
+		Expect(detail).To(HaveKeyWithValue("outcome", "Succeeded"))
+		Expect(detail["configHash"]).To(HaveLen(64))
+	})
+
+	It("fails when the event is rejected", func() {
+		p.MockEventBridge().On("PutEvents", mock.Anything).Return(&eventbridge.PutEventsOutput{
+			FailedEntryCount: aws.Int64(1),
+			Entries:          []*eventbridge.PutEventsResultEntry{{ErrorMessage: aws.String("access denied")}},
+		}, nil)
+
+		notifier := &Notifier{EventBus: "automation", Operation: "CreateCluster"}
+		Expect(notifier.Send(p, entry)).To(MatchError(`sending event to event bus "automation": access denied`))
+		Expect(notifier.Send(nil, entry)).To(MatchError(fmt.Sprintf("cannot send event to event bus %q without AWS credentials", "automation")))
+	})
+})
@@ -0,0 +1,162 @@
+package notify
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	"github.com/weaveworks/eksctl/pkg/logger"
+	"github.com/weaveworks/eksctl/pkg/utils/httpclient"
+)
+
+const (
+	// StageStarted is the stage of an operation that started running its tasks
+	StageStarted = "started"
+	// StageSucceeded is the stage of an operation that finished without an error
+	StageSucceeded = "succeeded"
+	// StageFailed is the stage of an operation that finished with an error
+	StageFailed = "failed"
+
+	webhookTimeout = 10 * time.Second
+)
+
+// WebhookPayload is posted to the webhook as JSON, Text makes it a valid Slack message
+type WebhookPayload struct {
+	Text    string `json:"text"`
+	Stage   string `json:"stage"`
+	Command string `json:"command"`
+	Cluster string `json:"cluster,omitempty"`
+	Region  string `json:"region,omitempty"`
+	// Tasks describes the tasks that the operation started with
+	Tasks           string   `json:"tasks,omitempty"`
+	TaskErrors      []string `json:"taskErrors,omitempty"`
+	Error           string   `json:"error,omitempty"`
+	DurationSeconds float64  `json:"durationSeconds,omitempty"`
+}
+
+// Webhook posts to a URL when an operation starts running its tasks, and when it finishes or fails,
+// e.g. to ping a Slack channel; it observes the trees of tasks that the operation runs, so operations
+// that don't run any tasks aren't reported
+type Webhook struct {
+	Command string
+	// Target returns the URL to post to and the config of the cluster the operation runs on, it's
+	// called when the first tree of tasks starts, once the config was loaded; nothing is posted when
+	// it returns an empty URL
+	Target func() (string, *api.ClusterConfig)
+
+	// mutex guards the state below, trees of tasks may run in parallel, e.g. one per cluster
+	mutex sync.Mutex
+	// client is created once the CA bundle of --ca-bundle was loaded
+	client          *http.Client
+	url             string
+	cluster, region string
+	started         time.Time
+	taskErrors      []string
+}
+
+// NewWebhook returns the webhook of the given command
+func NewWebhook(command string, target func() (string, *api.ClusterConfig)) *Webhook {
+	return &Webhook{
+		Command: command,
+		Target:  target,
+	}
+}
+
+// TaskTreeStarted posts that the operation started when the first tree of tasks starts
+func (w *Webhook) TaskTreeStarted(tree *manager.TaskTree) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if !w.started.IsZero() {
+		return
+	}
+	w.started = time.Now()
+
+	webhookURL, cfg := w.Target()
+	w.url = webhookURL
+	if cfg != nil && cfg.Metadata != nil {
+		w.cluster = cfg.Metadata.Name
+		w.region = cfg.Metadata.Region
+	}
+	if w.url == "" {
+		return
+	}
+	w.client = httpclient.NewClient(webhookTimeout)
+
+	payload := w.newPayload(StageStarted)
+	payload.Tasks = tree.Describe()
+	if err := w.post(payload); err != nil {
+		logger.Warning("posting to webhook: %s", err.Error())
+	}
+}
+
+// TaskTreeFinished records the errors of the tasks, they're posted when the operation finishes
+func (w *Webhook) TaskTreeFinished(_ *manager.TaskTree, errs []error) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	for _, err := range errs {
+		w.taskErrors = append(w.taskErrors, err.Error())
+	}
+}
+
+// Finish posts the outcome of the operation, unless it didn't start any tasks
+func (w *Webhook) Finish(err error) error {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+	if w.started.IsZero() || w.url == "" {
+		return nil
+	}
+	stage := StageSucceeded
+	if err != nil {
+		stage = StageFailed
+	}
+	duration := time.Since(w.started)
+	payload := w.newPayload(stage)
+	payload.Text += fmt.Sprintf(" after %s", duration.Round(time.Second))
+	payload.TaskErrors = w.taskErrors
+	payload.DurationSeconds = duration.Seconds()
+	if err != nil {
+		payload.Error = err.Error()
+		payload.Text += ": " + payload.Error
+	}
+	return w.post(payload)
+}
+
+func (w *Webhook) newPayload(stage string) *WebhookPayload {
+	text := fmt.Sprintf("`%s` %s", w.Command, stage)
+	if w.cluster != "" {
+		text += fmt.Sprintf(" for cluster %q in %s", w.cluster, w.region)
+	}
+	return &WebhookPayload{
+		Text:    text,
+		Stage:   stage,
+		Command: w.Command,
+		Cluster: w.cluster,
+		Region:  w.region,
+	}
+}
+
+func (w *Webhook) post(payload *WebhookPayload) error {
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+	resp, err := w.client.Post(w.url, "application/json", bytes.NewReader(data))
+	if err != nil {
+		// the URL isn't part of the error, as webhook URLs often embed a secret
+		if urlErr, ok := err.(*url.Error); ok {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("posting %s payload: %s", payload.Stage, err.Error())
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("posting %s payload: webhook responded with %s", payload.Stage, resp.Status)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package notify_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	. "github.com/weaveworks/eksctl/pkg/notify"
+)
+
+var _ = Describe("webhook", func() {
+	var (
+		server   *httptest.Server
+		status   int
+		payloads []*WebhookPayload
+		cfg      *api.ClusterConfig
+		tasks    *manager.TaskTree
+	)
+
+	BeforeEach(func() {
+		status = http.StatusOK
+		payloads = nil
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			defer GinkgoRecover()
+			Expect(r.Header.Get("Content-Type")).To(Equal("application/json"))
+			payload := &WebhookPayload{}
+			Expect(json.NewDecoder(r.Body).Decode(payload)).To(Succeed())
+			payloads = append(payloads, payload)
+			w.WriteHeader(status)
+		}))
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "test-cluster"
+		cfg.Metadata.Region = "us-west-2"
+
+		tasks = &manager.TaskTree{}
+	})
+
+	AfterEach(func() {
+		server.Close()
+	})
+
+	newWebhook := func(url string) *Webhook {
+		return NewWebhook("eksctl create cluster", func() (string, *api.ClusterConfig) {
+			return url, cfg
+		})
+	}
+
+	It("posts when the first tree of tasks starts and when the operation finishes", func() {
+		webhook := newWebhook(server.URL)
+		webhook.TaskTreeStarted(tasks)
+		webhook.TaskTreeFinished(tasks, nil)
+		webhook.TaskTreeStarted(tasks)
+		webhook.TaskTreeFinished(tasks, nil)
+		Expect(webhook.Finish(nil)).To(Succeed())
+
+		Expect(payloads).To(HaveLen(2))
+		Expect(payloads[0].Stage).To(Equal(StageStarted))
+		Expect(payloads[0].Text).To(Equal("`eksctl create cluster` started for cluster \"test-cluster\" in us-west-2"))
+		Expect(payloads[0].Tasks).To(Equal("no tasks"))
+		Expect(payloads[1].Stage).To(Equal(StageSucceeded))
+		Expect(payloads[1].Cluster).To(Equal("test-cluster"))
+		Expect(payloads[1].Error).To(BeEmpty())
+	})
+
+	It("posts the errors of a failed operation", func() {
+		webhook := newWebhook(server.URL)
+		webhook.TaskTreeStarted(tasks)
+		webhook.TaskTreeFinished(tasks, []error{fmt.Errorf("creating CloudFormation stack failed")})
+		Expect(webhook.Finish(fmt.Errorf("failed to create cluster \"test-cluster\""))).To(Succeed())
+
+		Expect(payloads).To(HaveLen(2))
+		Expect(payloads[1].Stage).To(Equal(StageFailed))
+		Expect(payloads[1].TaskErrors).To(Equal([]string{"creating CloudFormation stack failed"}))
+		Expect(payloads[1].Error).To(Equal("failed to create cluster \"test-cluster\""))
+		Expect(payloads[1].Text).To(HaveSuffix(": failed to create cluster \"test-cluster\""))
+	})
+
+	It("posts once for trees of tasks that run in parallel, with the errors of all of them", func() {
+		webhook := newWebhook(server.URL)
+		var wg sync.WaitGroup
+		for i := 0; i < 5; i++ {
+			wg.Add(1)
+			go func(i int) {
+				defer wg.Done()
+				webhook.TaskTreeStarted(tasks)
+				webhook.TaskTreeFinished(tasks, []error{fmt.Errorf("task %d failed", i)})
+			}(i)
+		}
+		wg.Wait()
+		Expect(webhook.Finish(nil)).To(Succeed())
+
+		Expect(payloads).To(HaveLen(2))
+		Expect(payloads[0].Stage).To(Equal(StageStarted))
+		Expect(payloads[1].TaskErrors).To(HaveLen(5))
+	})
+
+	It("doesn't post for operations that run no tasks, or without a URL", func() {
+		Expect(newWebhook(server.URL).Finish(fmt.Errorf("invalid config"))).To(Succeed())
+
+		webhook := newWebhook("")
+		webhook.TaskTreeStarted(tasks)
+		Expect(webhook.Finish(nil)).To(Succeed())
+
+		Expect(payloads).To(BeEmpty())
+	})
+
+	It("fails when the webhook doesn't accept the payload", func() {
+		status = http.StatusNotFound
+		webhook := newWebhook(server.URL)
+		webhook.TaskTreeStarted(tasks)
+		Expect(webhook.Finish(nil)).To(MatchError("posting succeeded payload: webhook responded with 404 Not Found"))
+	})
+})
@@ -0,0 +1,818 @@
+package permissions
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+)
+
+// PolicyVersion is the version of the policy language of the documents
+const PolicyVersion = "2012-10-17"
+
+// Statement is a statement of an IAM policy document
+type Statement struct {
+	Sid       string                       `json:"Sid"`
+	Effect    string                       `json:"Effect"`
+	Action    []string                     `json:"Action"`
+	Resource  []string                     `json:"Resource"`
+	Condition map[string]map[string]string `json:"Condition,omitempty"`
+}
+
+// Policy is an IAM policy document
+type Policy struct {
+	Version   string       `json:"Version"`
+	Statement []*Statement `json:"Statement"`
+}
+
+// Scope is what the resources of the policy are restricted to
+type Scope struct {
+	Partition string
+	Region    string
+	// AccountID may be "*" when the account isn't known
+	AccountID string
+	// CloudFormationRoleARN is the role CloudFormation uses to create the resources of stacks,
+	// the caller then doesn't need the permissions to create them
+	CloudFormationRoleARN string
+	// BackupBucket and BackupPrefix are where snapshots of the cluster are written to before
+	// commands change it, BackupPrefix being the key prefix of the snapshots of the cluster
+	BackupBucket, BackupPrefix string
+	// AuditLogGroup is the CloudWatch Logs group that commands are recorded in
+	AuditLogGroup string
+	// NotifyEventBus is the name or ARN of the EventBridge event bus that an event is sent to
+	// when the command finishes, it's only set for the commands that events are sent for
+	NotifyEventBus string
+}
+
+// command returns the statements that a command needs, given its config
+type command func(b *builder, spec *api.ClusterConfig)
+
+var commands = map[string]command{
+	"create cluster":           createCluster,
+	"create nodegroup":         createNodeGroups,
+	"create iamserviceaccount": createIAMServiceAccounts,
+	"create fargateprofile":    createFargateProfiles,
+	"delete cluster":           deleteCluster,
+	"delete nodegroup":         deleteNodeGroups,
+	"delete iamserviceaccount": deleteIAMServiceAccounts,
+	"delete fargateprofile":    deleteFargateProfiles,
+	"scale nodegroup":          scaleNodeGroups,
+	"update cluster":           updateCluster,
+	"get cluster":              getCluster,
+	"get nodegroup":            getNodeGroups,
+	"utils write-kubeconfig":   getCluster,
+}
+
+// mutatingVerbs are the verbs of commands that change the cluster, a snapshot
+// of the cluster is taken before they run when backups are enabled
+var mutatingVerbs = map[string]bool{
+	"create": true,
+	"delete": true,
+	"scale":  true,
+	"update": true,
+}
+
+// SupportedCommands returns the commands that policies can be made for
+func SupportedCommands() []string {
+	names := []string{}
+	for name := range commands {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Required returns the least-privilege policy that the given command, e.g. "create cluster", needs to run
+// with the given config; resources are restricted to those of the cluster where the API allows it
+func Required(commandName string, spec *api.ClusterConfig, scope Scope) (*Policy, error) {
+	cmd, ok := commands[commandName]
+	if !ok {
+		return nil, fmt.Errorf("command %q is not supported, must be one of %s", commandName, strings.Join(SupportedCommands(), ", "))
+	}
+	b := &builder{
+		scope:       scope,
+		clusterName: spec.Metadata.Name,
+		statements:  map[string]*Statement{},
+	}
+	if spec.IAM != nil && api.IsSetAndNonEmptyString(spec.IAM.ServiceRolePermissionsBoundary) {
+		b.permissionsBoundary = *spec.IAM.ServiceRolePermissionsBoundary
+	}
+	b.allow("CallerIdentity", []string{"*"}, "sts:GetCallerIdentity")
+	cmd(b, spec)
+	if mutatingVerbs[strings.Fields(commandName)[0]] {
+		b.backup()
+	}
+	b.auditLog()
+	b.notify()
+	return b.policy(), nil
+}
+
+// builder merges the actions and resources of statements with the same Sid
+type builder struct {
+	scope       Scope
+	clusterName string
+	// permissionsBoundary is the boundary that the roles eksctl creates must have
+	permissionsBoundary string
+	statements          map[string]*Statement
+	order               []string
+}
+
+func (b *builder) allow(sid string, resources []string, actions ...string) *Statement {
+	s, ok := b.statements[sid]
+	if !ok {
+		s = &Statement{Sid: sid, Effect: "Allow"}
+		b.statements[sid] = s
+		b.order = append(b.order, sid)
+	}
+	s.Action = appendUnique(s.Action, actions...)
+	s.Resource = appendUnique(s.Resource, resources...)
+	return s
+}
+
+func (b *builder) policy() *Policy {
+	p := &Policy{Version: PolicyVersion}
+	for _, sid := range b.order {
+		s := b.statements[sid]
+		sort.Strings(s.Action)
+		if len(s.Resource) > 1 && contains(s.Resource, "*") {
+			s.Resource = []string{"*"}
+		}
+		p.Statement = append(p.Statement, s)
+	}
+	return p
+}
+
+func (b *builder) arn(service, region, resource string) string {
+	return fmt.Sprintf("arn:%s:%s:%s:%s:%s", b.scope.Partition, service, region, b.scope.AccountID, resource)
+}
+
+func (b *builder) stackARN() string {
+	return b.arn("cloudformation", b.scope.Region, fmt.Sprintf("stack/eksctl-%s-*", b.clusterName))
+}
+
+func (b *builder) clusterARN() string {
+	return b.arn("eks", b.scope.Region, "cluster/"+b.clusterName)
+}
+
+func (b *builder) eksSubresourceARN(kind string) string {
+	return b.arn("eks", b.scope.Region, fmt.Sprintf("%s/%s/*", kind, b.clusterName))
+}
+
+// roleARNs are the roles that CloudFormation creates in the stacks of the cluster, whose names start with
+// the name of the stack, and roles with the given names
+func (b *builder) roleARNs(names ...string) []string {
+	arns := []string{b.arn("iam", "", fmt.Sprintf("role/eksctl-%s-*", b.clusterName))}
+	for _, name := range names {
+		arns = append(arns, b.arn("iam", "", "role/"+name))
+	}
+	return arns
+}
+
+func (b *builder) instanceProfileARN() string {
+	return b.arn("iam", "", fmt.Sprintf("instance-profile/eksctl-%s-*", b.clusterName))
+}
+
+func (b *builder) oidcProviderARN() string {
+	return b.arn("iam", "", fmt.Sprintf("oidc-provider/oidc.eks.%s.%s/id/*", b.scope.Region, api.PartitionDNSSuffix(b.scope.Region)))
+}
+
+// viaCloudFormation is whether the resources of stacks are created with the permissions of the caller
+func (b *builder) viaCloudFormation() bool {
+	return b.scope.CloudFormationRoleARN == ""
+}
+
+func (b *builder) describe() {
+	b.allow("Describe", []string{"*"},
+		"cloudformation:DescribeStacks",
+		"cloudformation:ListStacks",
+		"ec2:DescribeAvailabilityZones",
+		"ec2:DescribeSubnets",
+		"ec2:DescribeVpcs",
+		"ec2:DescribeSecurityGroups",
+		"eks:ListClusters",
+	)
+}
+
+func (b *builder) stacks(actions ...string) {
+	b.describe()
+	b.allow("Stacks", []string{b.stackARN()}, append([]string{
+		"cloudformation:DescribeStackEvents",
+		"cloudformation:DescribeStackResource",
+		"cloudformation:DescribeStackResources",
+		"cloudformation:GetTemplate",
+	}, actions...)...)
+	if !b.viaCloudFormation() {
+		b.allow("PassCloudFormationRole", []string{b.scope.CloudFormationRoleARN}, "iam:PassRole")
+	}
+}
+
+func (b *builder) createStacks() {
+	b.stacks("cloudformation:CreateStack", "cloudformation:UpdateTerminationProtection")
+}
+
+func (b *builder) updateStacks() {
+	b.stacks(
+		"cloudformation:CreateChangeSet",
+		"cloudformation:DescribeChangeSet",
+		"cloudformation:ExecuteChangeSet",
+		"cloudformation:DeleteChangeSet",
+	)
+}
+
+func (b *builder) deleteStacks() {
+	b.stacks("cloudformation:DeleteStack", "cloudformation:UpdateTerminationProtection")
+}
+
+// createRoles allows the roles of stacks to be created, with the permissions boundary if one is set
+func (b *builder) createRoles(names ...string) {
+	if !b.viaCloudFormation() {
+		return
+	}
+	resources := b.roleARNs(names...)
+	create := b.allow("CreateRoles", resources, "iam:CreateRole", "iam:PutRolePermissionsBoundary")
+	if b.permissionsBoundary != "" {
+		create.Condition = map[string]map[string]string{
+			"StringEquals": {"iam:PermissionsBoundary": b.permissionsBoundary},
+		}
+	}
+	b.allow("ManageRoles", resources,
+		"iam:GetRole",
+		"iam:TagRole",
+		"iam:AttachRolePolicy",
+		"iam:DetachRolePolicy",
+		"iam:PutRolePolicy",
+		"iam:DeleteRolePolicy",
+		"iam:GetRolePolicy",
+		"iam:PassRole",
+	)
+}
+
+func (b *builder) deleteRoles(names ...string) {
+	if !b.viaCloudFormation() {
+		return
+	}
+	b.allow("DeleteRoles", b.roleARNs(names...),
+		"iam:GetRole",
+		"iam:DeleteRole",
+		"iam:DetachRolePolicy",
+		"iam:DeleteRolePolicy",
+		"iam:ListAttachedRolePolicies",
+		"iam:ListRolePolicies",
+		"iam:DeleteRolePermissionsBoundary",
+	)
+}
+
+// passRoles allows existing roles to be passed to EKS and EC2
+func (b *builder) passRoles(arns ...string) {
+	if len(arns) > 0 {
+		b.allow("PassExistingRoles", arns, "iam:PassRole", "iam:GetRole")
+	}
+}
+
+func (b *builder) vpc(create bool) {
+	if !b.viaCloudFormation() {
+		return
+	}
+	actions := []string{
+		"ec2:CreateSecurityGroup",
+		"ec2:AuthorizeSecurityGroupIngress",
+		"ec2:AuthorizeSecurityGroupEgress",
+		"ec2:RevokeSecurityGroupEgress",
+		"ec2:CreateTags",
+	}
+	if create {
+		actions = append(actions,
+			"ec2:CreateVpc",
+			"ec2:ModifyVpcAttribute",
+			"ec2:CreateSubnet",
+			"ec2:ModifySubnetAttribute",
+			"ec2:CreateInternetGateway",
+			"ec2:AttachInternetGateway",
+			"ec2:AllocateAddress",
+			"ec2:CreateNatGateway",
+			"ec2:DescribeNatGateways",
+			"ec2:CreateRouteTable",
+			"ec2:CreateRoute",
+			"ec2:AssociateRouteTable",
+			"ec2:DescribeInternetGateways",
+			"ec2:DescribeRouteTables",
+			"ec2:DescribeAddresses",
+		)
+	}
+	b.allow("Network", []string{"*"}, actions...)
+}
+
+func (b *builder) deleteVPC() {
+	if !b.viaCloudFormation() {
+		return
+	}
+	b.allow("Network", []string{"*"},
+		"ec2:DeleteVpc",
+		"ec2:DeleteSubnet",
+		"ec2:DetachInternetGateway",
+		"ec2:DeleteInternetGateway",
+		"ec2:DeleteNatGateway",
+		"ec2:DescribeNatGateways",
+		"ec2:ReleaseAddress",
+		"ec2:DisassociateAddress",
+		"ec2:DeleteRouteTable",
+		"ec2:DeleteRoute",
+		"ec2:DisassociateRouteTable",
+		"ec2:DeleteSecurityGroup",
+		"ec2:RevokeSecurityGroupIngress",
+		"ec2:RevokeSecurityGroupEgress",
+		"ec2:DescribeInternetGateways",
+		"ec2:DescribeRouteTables",
+		"ec2:DescribeAddresses",
+		"ec2:DescribeNetworkInterfaces",
+		"ec2:DeleteNetworkInterface",
+		"ec2:DeleteTags",
+	)
+}
+
+func (b *builder) createCluster(spec *api.ClusterConfig) {
+	b.allow("Cluster", []string{b.clusterARN()},
+		"eks:CreateCluster",
+		"eks:DescribeCluster",
+		"eks:TagResource",
+		"eks:UpdateClusterConfig",
+		"eks:DescribeUpdate",
+	)
+	if spec.SecretsEncryption != nil && spec.SecretsEncryption.KeyARN != "" {
+		b.allow("SecretsEncryptionKey", []string{spec.SecretsEncryption.KeyARN}, "kms:DescribeKey", "kms:CreateGrant")
+	}
+	if api.IsSetAndNonEmptyString(spec.IAM.ServiceRoleARN) {
+		b.passRoles(*spec.IAM.ServiceRoleARN)
+	}
+}
+
+func (b *builder) nodeGroups(nodeGroups []*api.NodeGroup) {
+	if len(nodeGroups) == 0 {
+		return
+	}
+	b.allow("Describe", []string{"*"},
+		"ec2:DescribeImages",
+		"ec2:DescribeInstanceTypeOfferings",
+		"ec2:DescribeKeyPairs",
+		"ec2:DescribeLaunchTemplateVersions",
+		"autoscaling:DescribeAutoScalingGroups",
+		"servicequotas:GetServiceQuota",
+	)
+	// the parameters of the public AMIs have no account in their ARNs
+	amiParameters := fmt.Sprintf("arn:%s:ssm:%s::parameter/aws/service/*", b.scope.Partition, b.scope.Region)
+	b.allow("AMIs", []string{amiParameters}, "ssm:GetParameter")
+	roleNames, existingRoles := []string{}, []string{}
+	for _, ng := range nodeGroups {
+		if ng.SSH != nil && api.IsEnabled(ng.SSH.Allow) && !api.IsSetAndNonEmptyString(ng.SSH.PublicKeyName) {
+			b.allow("Describe", []string{"*"}, "ec2:ImportKeyPair")
+		}
+		if ng.IAM == nil {
+			continue
+		}
+		if ng.IAM.InstanceRoleName != "" {
+			roleNames = append(roleNames, ng.IAM.InstanceRoleName)
+		}
+		if ng.IAM.InstanceRoleARN != "" {
+			existingRoles = append(existingRoles, ng.IAM.InstanceRoleARN)
+		}
+		if ng.IAM.InstanceProfileARN != "" {
+			b.allow("ExistingInstanceProfiles", []string{ng.IAM.InstanceProfileARN}, "iam:GetInstanceProfile")
+		}
+	}
+	b.passRoles(existingRoles...)
+	if !b.viaCloudFormation() {
+		return
+	}
+	b.allow("NodeGroups", []string{"*"},
+		"ec2:CreateLaunchTemplate",
+		"ec2:RunInstances",
+		"autoscaling:CreateAutoScalingGroup",
+		"autoscaling:UpdateAutoScalingGroup",
+		"autoscaling:CreateOrUpdateTags",
+		"autoscaling:DescribeScalingActivities",
+	)
+	b.allow("InstanceProfiles", []string{b.instanceProfileARN()},
+		"iam:CreateInstanceProfile",
+		"iam:GetInstanceProfile",
+		"iam:AddRoleToInstanceProfile",
+	)
+	b.createRoles(roleNames...)
+}
+
+func (b *builder) managedNodeGroups(managedNodeGroups []*api.ManagedNodeGroup) {
+	if len(managedNodeGroups) == 0 {
+		return
+	}
+	b.allow("ManagedNodeGroups", []string{b.clusterARN(), b.eksSubresourceARN("nodegroup")},
+		"eks:CreateNodegroup",
+		"eks:DescribeNodegroup",
+		"eks:ListNodegroups",
+		"eks:TagResource",
+	)
+	b.allow("Describe", []string{"*"}, "ec2:DescribeInstanceTypeOfferings", "servicequotas:GetServiceQuota")
+	b.allow("NodeGroupServiceLinkedRole", []string{b.arn("iam", "", "role/aws-service-role/eks-nodegroup.amazonaws.com/AWSServiceRoleForAmazonEKSNodegroup")},
+		"iam:GetRole",
+		"iam:CreateServiceLinkedRole",
+	)
+	existingRoles := []string{}
+	for _, ng := range managedNodeGroups {
+		if ng.IAM != nil && ng.IAM.InstanceRoleARN != "" {
+			existingRoles = append(existingRoles, ng.IAM.InstanceRoleARN)
+		}
+		if ng.SSH != nil && api.IsEnabled(ng.SSH.Allow) && !api.IsSetAndNonEmptyString(ng.SSH.PublicKeyName) {
+			b.allow("Describe", []string{"*"}, "ec2:DescribeKeyPairs", "ec2:ImportKeyPair")
+		}
+	}
+	b.passRoles(existingRoles...)
+	// the roles of managed nodegroups are created in stacks, but the nodegroups themselves are created by
+	// the caller, which passes the roles to EKS
+	b.allow("ManagedNodeGroupRoles", b.roleARNs(), "iam:PassRole")
+}
+
+func (b *builder) fargateProfiles() {
+	b.allow("FargateProfiles", []string{b.clusterARN(), b.eksSubresourceARN("fargateprofile")},
+		"eks:CreateFargateProfile",
+		"eks:DescribeFargateProfile",
+		"eks:ListFargateProfiles",
+		"eks:TagResource",
+	)
+	b.allow("FargatePodExecutionRole", b.roleARNs(), "iam:PassRole")
+}
+
+func (b *builder) oidcProvider(create bool) {
+	actions := []string{"iam:GetOpenIDConnectProvider"}
+	if create {
+		actions = append(actions, "iam:CreateOpenIDConnectProvider", "iam:TagOpenIDConnectProvider")
+	}
+	b.allow("OIDCProvider", []string{b.oidcProviderARN()}, actions...)
+}
+
+func (b *builder) addons(spec *api.ClusterConfig) {
+	if len(spec.Addons) == 0 {
+		return
+	}
+	b.allow("Addons", []string{b.clusterARN(), b.eksSubresourceARN("addon")},
+		"eks:CreateAddon",
+		"eks:DescribeAddon",
+		"eks:DescribeAddonVersions",
+		"eks:ListAddons",
+		"eks:TagResource",
+	)
+}
+
+// secretsEncryptionKey allows the KMS key for secrets encryption to be created in the cluster stack
+func (b *builder) secretsEncryptionKey(spec *api.ClusterConfig) {
+	if !spec.CreatesSecretsEncryptionKey() {
+		return
+	}
+	keys := b.arn("kms", b.scope.Region, "key/*")
+	b.allow("SecretsEncryptionKey", []string{keys}, "kms:DescribeKey", "kms:CreateGrant")
+	if !b.viaCloudFormation() {
+		return
+	}
+	b.allow("CreateSecretsEncryptionKey", []string{"*"}, "kms:CreateKey")
+	b.allow("SecretsEncryptionKey", []string{keys},
+		"kms:PutKeyPolicy",
+		"kms:EnableKeyRotation",
+		"kms:TagResource",
+	)
+	b.allow("SecretsEncryptionKeyAlias", []string{keys, b.arn("kms", b.scope.Region, spec.SecretsEncryptionKeyAlias())}, "kms:CreateAlias")
+}
+
+func (b *builder) deleteSecretsEncryptionKey(spec *api.ClusterConfig) {
+	if !spec.CreatesSecretsEncryptionKey() || !b.viaCloudFormation() {
+		return
+	}
+	keys := b.arn("kms", b.scope.Region, "key/*")
+	b.allow("SecretsEncryptionKey", []string{keys}, "kms:DescribeKey", "kms:ScheduleKeyDeletion")
+	b.allow("SecretsEncryptionKeyAlias", []string{keys, b.arn("kms", b.scope.Region, spec.SecretsEncryptionKeyAlias())}, "kms:DeleteAlias")
+}
+
+func (b *builder) accessEntries(spec *api.ClusterConfig) {
+	if len(spec.AccessEntries) == 0 {
+		return
+	}
+	b.allow("AccessEntries", []string{b.clusterARN(), b.eksSubresourceARN("access-entry")},
+		"eks:CreateAccessEntry",
+		"eks:DescribeAccessEntry",
+		"eks:ListAccessEntries",
+		"eks:AssociateAccessPolicy",
+		"eks:ListAssociatedAccessPolicies",
+	)
+}
+
+// podIdentityAssociations allows the associations to be created, along with the stacks of their roles,
+// roles that are given by ARN can only be passed
+func (b *builder) podIdentityAssociations(spec *api.ClusterConfig) {
+	if len(spec.IAM.PodIdentityAssociations) == 0 {
+		return
+	}
+	b.allow("PodIdentityAssociations", []string{b.clusterARN(), b.eksSubresourceARN("podidentityassociation")},
+		"eks:CreatePodIdentityAssociation",
+		"eks:DescribePodIdentityAssociation",
+		"eks:ListPodIdentityAssociations",
+		"eks:TagResource",
+	)
+	roleNames, existingRoles := []string{}, []string{}
+	for _, association := range spec.IAM.PodIdentityAssociations {
+		switch {
+		case association.RoleARN != "":
+			existingRoles = append(existingRoles, association.RoleARN)
+		case association.RoleName != "":
+			roleNames = append(roleNames, association.RoleName)
+		}
+	}
+	b.passRoles(existingRoles...)
+	b.allow("PodIdentityRoles", b.roleARNs(roleNames...), "iam:PassRole")
+	b.createRoles(roleNames...)
+}
+
+func (b *builder) deletePodIdentityAssociations(spec *api.ClusterConfig) {
+	if len(spec.IAM.PodIdentityAssociations) == 0 {
+		return
+	}
+	b.allow("PodIdentityAssociations", []string{b.clusterARN(), b.eksSubresourceARN("podidentityassociation")},
+		"eks:ListPodIdentityAssociations",
+		"eks:DescribePodIdentityAssociation",
+		"eks:DeletePodIdentityAssociation",
+	)
+}
+
+// karpenter allows the Karpenter stack to be created, i.e. the role and instance profile of its nodes,
+// the policy of its controller, and the queue and rules of interruption events, and the subnets and
+// security group of the cluster to be tagged for discovery
+func (b *builder) karpenter(spec *api.ClusterConfig) {
+	if !spec.HasKarpenter() {
+		return
+	}
+	b.allow("KarpenterDiscovery", []string{"*"}, "ec2:CreateTags")
+	if !b.viaCloudFormation() {
+		return
+	}
+	b.allow("KarpenterInterruptionQueue", []string{b.arn("sqs", b.scope.Region, fmt.Sprintf("eksctl-%s-*", b.clusterName))},
+		"sqs:CreateQueue",
+		"sqs:GetQueueAttributes",
+		"sqs:SetQueueAttributes",
+		"sqs:TagQueue",
+	)
+	b.allow("KarpenterInterruptionRules", []string{b.arn("events", b.scope.Region, fmt.Sprintf("rule/eksctl-%s-*", b.clusterName))},
+		"events:PutRule",
+		"events:PutTargets",
+		"events:DescribeRule",
+	)
+	b.allow("KarpenterControllerPolicy", []string{b.arn("iam", "", fmt.Sprintf("policy/eksctl-%s-*", b.clusterName))},
+		"iam:CreatePolicy",
+		"iam:GetPolicy",
+		"iam:GetPolicyVersion",
+		"iam:ListPolicyVersions",
+	)
+	b.allow("InstanceProfiles", []string{b.instanceProfileARN()},
+		"iam:CreateInstanceProfile",
+		"iam:GetInstanceProfile",
+		"iam:AddRoleToInstanceProfile",
+	)
+	b.createRoles()
+}
+
+func (b *builder) deleteKarpenter(spec *api.ClusterConfig) {
+	if !spec.HasKarpenter() || !b.viaCloudFormation() {
+		return
+	}
+	b.allow("KarpenterInterruptionQueue", []string{b.arn("sqs", b.scope.Region, fmt.Sprintf("eksctl-%s-*", b.clusterName))},
+		"sqs:GetQueueAttributes",
+		"sqs:DeleteQueue",
+	)
+	b.allow("KarpenterInterruptionRules", []string{b.arn("events", b.scope.Region, fmt.Sprintf("rule/eksctl-%s-*", b.clusterName))},
+		"events:DescribeRule",
+		"events:RemoveTargets",
+		"events:DeleteRule",
+	)
+	b.allow("KarpenterControllerPolicy", []string{b.arn("iam", "", fmt.Sprintf("policy/eksctl-%s-*", b.clusterName))},
+		"iam:GetPolicy",
+		"iam:ListPolicyVersions",
+		"iam:DeletePolicyVersion",
+		"iam:DeletePolicy",
+	)
+}
+
+// backup allows snapshots of the cluster to be written to S3
+func (b *builder) backup() {
+	if b.scope.BackupBucket == "" {
+		return
+	}
+	bucket := fmt.Sprintf("arn:%s:s3:::%s", b.scope.Partition, b.scope.BackupBucket)
+	b.allow("BackupObjects", []string{fmt.Sprintf("%s/%s/*", bucket, b.scope.BackupPrefix)}, "s3:PutObject")
+	b.allow("BackupBucket", []string{bucket}, "s3:ListBucket").Condition = map[string]map[string]string{
+		"StringLike": {"s3:prefix": b.scope.BackupPrefix + "/*"},
+	}
+}
+
+func (b *builder) auditLog() {
+	if b.scope.AuditLogGroup == "" {
+		return
+	}
+	b.allow("AuditLog", []string{b.arn("logs", b.scope.Region, fmt.Sprintf("log-group:%s:*", b.scope.AuditLogGroup))},
+		"logs:CreateLogStream",
+		"logs:PutLogEvents",
+	)
+}
+
+func (b *builder) notify() {
+	if b.scope.NotifyEventBus == "" {
+		return
+	}
+	eventBus := b.scope.NotifyEventBus
+	if !strings.HasPrefix(eventBus, "arn:") {
+		eventBus = b.arn("events", b.scope.Region, "event-bus/"+eventBus)
+	}
+	b.allow("Notify", []string{eventBus}, "events:PutEvents")
+}
+
+func serviceAccountRoleNames(spec *api.ClusterConfig) []string {
+	names := []string{}
+	for _, sa := range spec.IAM.ServiceAccounts {
+		if sa.RoleName != "" {
+			names = append(names, sa.RoleName)
+		}
+	}
+	return names
+}
+
+func createCluster(b *builder, spec *api.ClusterConfig) {
+	b.createStacks()
+	b.createCluster(spec)
+	b.vpc(spec.VPC == nil || spec.VPC.ID == "")
+	roleNames := serviceAccountRoleNames(spec)
+	for _, ng := range spec.NodeGroups {
+		if ng.IAM != nil && ng.IAM.InstanceRoleName != "" {
+			roleNames = append(roleNames, ng.IAM.InstanceRoleName)
+		}
+	}
+	b.createRoles(roleNames...)
+	b.nodeGroups(spec.NodeGroups)
+	b.managedNodeGroups(spec.ManagedNodeGroups)
+	if spec.HasFargateProfiles() {
+		b.fargateProfiles()
+	}
+	if api.IsEnabled(spec.IAM.WithOIDC) {
+		b.oidcProvider(true)
+	}
+	b.addons(spec)
+	b.secretsEncryptionKey(spec)
+	b.accessEntries(spec)
+	b.podIdentityAssociations(spec)
+	b.karpenter(spec)
+}
+
+func createNodeGroups(b *builder, spec *api.ClusterConfig) {
+	b.createStacks()
+	b.allow("Cluster", []string{b.clusterARN()}, "eks:DescribeCluster")
+	b.vpc(false)
+	b.nodeGroups(spec.NodeGroups)
+	b.managedNodeGroups(spec.ManagedNodeGroups)
+	b.createRoles()
+}
+
+func createIAMServiceAccounts(b *builder, spec *api.ClusterConfig) {
+	b.createStacks()
+	b.allow("Cluster", []string{b.clusterARN()}, "eks:DescribeCluster")
+	b.oidcProvider(false)
+	b.createRoles(serviceAccountRoleNames(spec)...)
+}
+
+func createFargateProfiles(b *builder, spec *api.ClusterConfig) {
+	b.allow("Cluster", []string{b.clusterARN()}, "eks:DescribeCluster")
+	b.updateStacks()
+	b.fargateProfiles()
+	b.createRoles()
+}
+
+func deleteCluster(b *builder, spec *api.ClusterConfig) {
+	b.deleteStacks()
+	b.allow("Cluster", []string{b.clusterARN(), b.eksSubresourceARN("nodegroup"), b.eksSubresourceARN("fargateprofile"), b.eksSubresourceARN("addon")},
+		"eks:DescribeCluster",
+		"eks:DeleteCluster",
+		"eks:ListNodegroups",
+		"eks:DescribeNodegroup",
+		"eks:DeleteNodegroup",
+		"eks:ListFargateProfiles",
+		"eks:DeleteFargateProfile",
+		"eks:DescribeFargateProfile",
+		"eks:ListAddons",
+		"eks:DeleteAddon",
+	)
+	b.oidcProvider(false)
+	b.allow("OIDCProvider", []string{b.oidcProviderARN()}, "iam:DeleteOpenIDConnectProvider")
+	b.deleteVPC()
+	b.deleteNodeGroupResources()
+	b.deleteRoles(serviceAccountRoleNames(spec)...)
+	b.deleteSecretsEncryptionKey(spec)
+	b.deletePodIdentityAssociations(spec)
+	b.deleteKarpenter(spec)
+}
+
+func (b *builder) deleteNodeGroupResources() {
+	b.allow("Describe", []string{"*"}, "autoscaling:DescribeAutoScalingGroups", "ec2:DescribeLaunchTemplateVersions")
+	if !b.viaCloudFormation() {
+		return
+	}
+	b.allow("NodeGroups", []string{"*"},
+		"ec2:DeleteLaunchTemplate",
+		"ec2:TerminateInstances",
+		"autoscaling:UpdateAutoScalingGroup",
+		"autoscaling:DeleteAutoScalingGroup",
+		"autoscaling:DeleteTags",
+		"autoscaling:DescribeScalingActivities",
+		"ec2:DeleteSecurityGroup",
+		"ec2:RevokeSecurityGroupIngress",
+		"ec2:RevokeSecurityGroupEgress",
+	)
+	b.allow("InstanceProfiles", []string{b.instanceProfileARN()},
+		"iam:GetInstanceProfile",
+		"iam:RemoveRoleFromInstanceProfile",
+		"iam:DeleteInstanceProfile",
+	)
+	b.deleteRoles()
+}
+
+func deleteNodeGroups(b *builder, spec *api.ClusterConfig) {
+	b.deleteStacks()
+	b.allow("Cluster", []string{b.clusterARN(), b.eksSubresourceARN("nodegroup")},
+		"eks:DescribeCluster",
+		"eks:DescribeNodegroup",
+		"eks:DeleteNodegroup",
+	)
+	b.deleteNodeGroupResources()
+}
+
+func deleteIAMServiceAccounts(b *builder, spec *api.ClusterConfig) {
+	b.deleteStacks()
+	b.allow("Cluster", []string{b.clusterARN()}, "eks:DescribeCluster")
+	b.deleteRoles(serviceAccountRoleNames(spec)...)
+}
+
+func deleteFargateProfiles(b *builder, spec *api.ClusterConfig) {
+	b.allow("FargateProfiles", []string{b.clusterARN(), b.eksSubresourceARN("fargateprofile")},
+		"eks:DescribeCluster",
+		"eks:DescribeFargateProfile",
+		"eks:ListFargateProfiles",
+		"eks:DeleteFargateProfile",
+	)
+}
+
+func scaleNodeGroups(b *builder, spec *api.ClusterConfig) {
+	b.updateStacks()
+	b.allow("Cluster", []string{b.clusterARN(), b.eksSubresourceARN("nodegroup")},
+		"eks:DescribeCluster",
+		"eks:DescribeNodegroup",
+		"eks:UpdateNodegroupConfig",
+	)
+	b.allow("Describe", []string{"*"}, "autoscaling:DescribeAutoScalingGroups")
+	if b.viaCloudFormation() {
+		b.allow("NodeGroups", []string{"*"}, "autoscaling:UpdateAutoScalingGroup")
+	}
+}
+
+func updateCluster(b *builder, spec *api.ClusterConfig) {
+	b.updateStacks()
+	b.allow("Cluster", []string{b.clusterARN()},
+		"eks:DescribeCluster",
+		"eks:UpdateClusterVersion",
+		"eks:DescribeUpdate",
+	)
+}
+
+func getCluster(b *builder, spec *api.ClusterConfig) {
+	b.allow("Cluster", []string{b.clusterARN()}, "eks:DescribeCluster")
+	b.allow("Describe", []string{"*"}, "eks:ListClusters")
+}
+
+func getNodeGroups(b *builder, spec *api.ClusterConfig) {
+	b.describe()
+	b.allow("Stacks", []string{b.stackARN()}, "cloudformation:DescribeStackResources", "cloudformation:GetTemplate")
+	b.allow("Cluster", []string{b.clusterARN(), b.eksSubresourceARN("nodegroup")},
+		"eks:DescribeCluster",
+		"eks:ListNodegroups",
+		"eks:DescribeNodegroup",
+	)
+	b.allow("Describe", []string{"*"}, "autoscaling:DescribeAutoScalingGroups")
+}
+
+func appendUnique(values []string, more ...string) []string {
+	for _, v := range more {
+		if !contains(values, v) {
+			values = append(values, v)
+		}
+	}
+	return values
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,260 @@
+package permissions_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/permissions"
+)
+
+func findStatement(policy *Policy, sid string) *Statement {
+	for _, s := range policy.Statement {
+		if s.Sid == sid {
+			return s
+		}
+	}
+	return nil
+}
+
+var _ = Describe("required IAM policies", func() {
+	var (
+		cfg   *api.ClusterConfig
+		scope Scope
+	)
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "dev"
+		cfg.Metadata.Region = "us-west-2"
+		scope = Scope{
+			Partition: "aws",
+			Region:    "us-west-2",
+			AccountID: "123456789012",
+		}
+	})
+
+	It("rejects unsupported commands", func() {
+		_, err := Required("create addon", cfg, scope)
+		Expect(err).To(MatchError(ContainSubstring(`command "create addon" is not supported, must be one of create cluster`)))
+	})
+
+	It("restricts the resources of create cluster to those of the cluster", func() {
+		cfg.NodeGroups = []*api.NodeGroup{{Name: "ng-1"}}
+
+		policy, err := Required("create cluster", cfg, scope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.Version).To(Equal("2012-10-17"))
+
+		Expect(findStatement(policy, "CallerIdentity").Action).To(Equal([]string{"sts:GetCallerIdentity"}))
+
+		stacks := findStatement(policy, "Stacks")
+		Expect(stacks.Resource).To(Equal([]string{"arn:aws:cloudformation:us-west-2:123456789012:stack/eksctl-dev-*"}))
+		Expect(stacks.Action).To(ContainElement("cloudformation:CreateStack"))
+
+		cluster := findStatement(policy, "Cluster")
+		Expect(cluster.Resource).To(Equal([]string{"arn:aws:eks:us-west-2:123456789012:cluster/dev"}))
+		Expect(cluster.Action).To(ContainElement("eks:CreateCluster"))
+
+		Expect(findStatement(policy, "Network").Action).To(ContainElement("ec2:CreateVpc"))
+		Expect(findStatement(policy, "NodeGroups").Action).To(ContainElement("ec2:RunInstances"))
+		Expect(findStatement(policy, "InstanceProfiles").Resource).To(Equal([]string{"arn:aws:iam::123456789012:instance-profile/eksctl-dev-*"}))
+
+		roles := findStatement(policy, "CreateRoles")
+		Expect(roles.Resource).To(Equal([]string{"arn:aws:iam::123456789012:role/eksctl-dev-*"}))
+		Expect(roles.Condition).To(BeNil())
+
+		Expect(findStatement(policy, "ManagedNodeGroups")).To(BeNil())
+		Expect(findStatement(policy, "OIDCProvider")).To(BeNil())
+	})
+
+	It("doesn't allow creating a VPC when the cluster uses an existing one", func() {
+		cfg.VPC.ID = "vpc-1"
+
+		policy, err := Required("create cluster", cfg, scope)
+		Expect(err).NotTo(HaveOccurred())
+		network := findStatement(policy, "Network")
+		Expect(network.Action).To(ContainElement("ec2:CreateSecurityGroup"))
+		Expect(network.Action).NotTo(ContainElement("ec2:CreateVpc"))
+	})
+
+	It("requires roles to be created with the permissions boundary", func() {
+		cfg.IAM.ServiceRolePermissionsBoundary = aws.String("arn:aws:iam::123456789012:policy/boundary")
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{RoleName: "s3-reader"}}
+
+		policy, err := Required("create iamserviceaccount", cfg, scope)
+		Expect(err).NotTo(HaveOccurred())
+		roles := findStatement(policy, "CreateRoles")
+		Expect(roles.Resource).To(ConsistOf(
+			"arn:aws:iam::123456789012:role/eksctl-dev-*",
+			"arn:aws:iam::123456789012:role/s3-reader",
+		))
+		Expect(roles.Condition).To(Equal(map[string]map[string]string{
+			"StringEquals": {"iam:PermissionsBoundary": "arn:aws:iam::123456789012:policy/boundary"},
+		}))
+		Expect(findStatement(policy, "OIDCProvider").Resource).To(Equal([]string{"arn:aws:iam::123456789012:oidc-provider/oidc.eks.us-west-2.amazonaws.com/id/*"}))
+	})
+
+	It("only allows existing roles to be passed", func() {
+		cfg.ManagedNodeGroups = []*api.ManagedNodeGroup{{
+			Name: "mng-1",
+			IAM:  &api.ManagedNodeGroupIAM{InstanceRoleARN: "arn:aws:iam::123456789012:role/nodes"},
+		}}
+
+		policy, err := Required("create nodegroup", cfg, scope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findStatement(policy, "PassExistingRoles").Resource).To(Equal([]string{"arn:aws:iam::123456789012:role/nodes"}))
+		Expect(findStatement(policy, "ManagedNodeGroups").Resource).To(ConsistOf(
+			"arn:aws:eks:us-west-2:123456789012:cluster/dev",
+			"arn:aws:eks:us-west-2:123456789012:nodegroup/dev/*",
+		))
+		Expect(findStatement(policy, "NodeGroupServiceLinkedRole").Action).To(ContainElement("iam:CreateServiceLinkedRole"))
+	})
+
+	It("leaves the resources of stacks to the CloudFormation role", func() {
+		scope.CloudFormationRoleARN = "arn:aws:iam::123456789012:role/cfn"
+
+		policy, err := Required("delete cluster", cfg, scope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findStatement(policy, "PassCloudFormationRole").Resource).To(Equal([]string{"arn:aws:iam::123456789012:role/cfn"}))
+		Expect(findStatement(policy, "Stacks").Action).To(ContainElement("cloudformation:DeleteStack"))
+		Expect(findStatement(policy, "Network")).To(BeNil())
+		Expect(findStatement(policy, "DeleteRoles")).To(BeNil())
+	})
+
+	It("uses the partition of the region", func() {
+		cfg.Metadata.Region = "cn-north-1"
+		cfg.IAM.ServiceAccounts = []*api.ClusterIAMServiceAccount{{RoleName: "s3-reader"}}
+		scope.Partition = api.Partition("cn-north-1")
+		scope.Region = "cn-north-1"
+
+		policy, err := Required("create iamserviceaccount", cfg, scope)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(findStatement(policy, "OIDCProvider").Resource).To(Equal([]string{"arn:aws-cn:iam::123456789012:oidc-provider/oidc.eks.cn-north-1.amazonaws.com.cn/id/*"}))
+	})
+
+	Describe("features of the config", func() {
+		It("allows the KMS key for secrets encryption to be created and deleted", func() {
+			cfg.SecretsEncryption = &api.SecretsEncryption{CreateKey: api.Enabled()}
+
+			policy, err := Required("create cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findStatement(policy, "CreateSecretsEncryptionKey").Action).To(Equal([]string{"kms:CreateKey"}))
+			Expect(findStatement(policy, "SecretsEncryptionKey").Action).To(ContainElement("kms:PutKeyPolicy"))
+			alias := findStatement(policy, "SecretsEncryptionKeyAlias")
+			Expect(alias.Action).To(Equal([]string{"kms:CreateAlias"}))
+			Expect(alias.Resource).To(ContainElement("arn:aws:kms:us-west-2:123456789012:alias/eksctl/dev"))
+
+			policy, err = Required("delete cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findStatement(policy, "SecretsEncryptionKey").Action).To(ContainElement("kms:ScheduleKeyDeletion"))
+			Expect(findStatement(policy, "SecretsEncryptionKeyAlias").Action).To(Equal([]string{"kms:DeleteAlias"}))
+
+			cfg.SecretsEncryption = nil
+			policy, err = Required("create cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findStatement(policy, "CreateSecretsEncryptionKey")).To(BeNil())
+		})
+
+		It("allows access entries to be created and access policies to be associated", func() {
+			cfg.AccessEntries = []*api.AccessEntry{{PrincipalARN: "arn:aws:iam::123456789012:role/admins"}}
+
+			policy, err := Required("create cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			entries := findStatement(policy, "AccessEntries")
+			Expect(entries.Action).To(ContainElement("eks:CreateAccessEntry"))
+			Expect(entries.Action).To(ContainElement("eks:AssociateAccessPolicy"))
+			Expect(entries.Resource).To(ConsistOf(
+				"arn:aws:eks:us-west-2:123456789012:cluster/dev",
+				"arn:aws:eks:us-west-2:123456789012:access-entry/dev/*",
+			))
+		})
+
+		It("allows pod identity associations to be created with their roles", func() {
+			cfg.IAM.PodIdentityAssociations = []*api.PodIdentityAssociation{
+				{Namespace: "default", ServiceAccountName: "s3-reader", RoleName: "s3-reader"},
+				{Namespace: "default", ServiceAccountName: "existing", RoleARN: "arn:aws:iam::123456789012:role/existing"},
+			}
+
+			policy, err := Required("create cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findStatement(policy, "PodIdentityAssociations").Action).To(ContainElement("eks:CreatePodIdentityAssociation"))
+			Expect(findStatement(policy, "PodIdentityRoles").Resource).To(ConsistOf(
+				"arn:aws:iam::123456789012:role/eksctl-dev-*",
+				"arn:aws:iam::123456789012:role/s3-reader",
+			))
+			Expect(findStatement(policy, "PassExistingRoles").Resource).To(Equal([]string{"arn:aws:iam::123456789012:role/existing"}))
+			Expect(findStatement(policy, "CreateRoles").Resource).To(ContainElement("arn:aws:iam::123456789012:role/s3-reader"))
+
+			policy, err = Required("delete cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findStatement(policy, "PodIdentityAssociations").Action).To(ContainElement("eks:DeletePodIdentityAssociation"))
+		})
+
+		It("allows the Karpenter stack to be created and deleted", func() {
+			cfg.Karpenter = &api.Karpenter{}
+
+			policy, err := Required("create cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			queue := findStatement(policy, "KarpenterInterruptionQueue")
+			Expect(queue.Resource).To(Equal([]string{"arn:aws:sqs:us-west-2:123456789012:eksctl-dev-*"}))
+			Expect(queue.Action).To(ContainElement("sqs:CreateQueue"))
+			rules := findStatement(policy, "KarpenterInterruptionRules")
+			Expect(rules.Resource).To(Equal([]string{"arn:aws:events:us-west-2:123456789012:rule/eksctl-dev-*"}))
+			Expect(rules.Action).To(ContainElement("events:PutRule"))
+			Expect(findStatement(policy, "KarpenterControllerPolicy").Action).To(ContainElement("iam:CreatePolicy"))
+			Expect(findStatement(policy, "KarpenterDiscovery").Action).To(Equal([]string{"ec2:CreateTags"}))
+
+			policy, err = Required("delete cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findStatement(policy, "KarpenterInterruptionQueue").Action).To(ContainElement("sqs:DeleteQueue"))
+			Expect(findStatement(policy, "KarpenterInterruptionRules").Action).To(ContainElement("events:DeleteRule"))
+		})
+
+		It("allows snapshots to be written before commands that change the cluster", func() {
+			scope.BackupBucket = "backups"
+			scope.BackupPrefix = "eksctl/us-west-2/dev"
+
+			policy, err := Required("scale nodegroup", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			objects := findStatement(policy, "BackupObjects")
+			Expect(objects.Action).To(Equal([]string{"s3:PutObject"}))
+			Expect(objects.Resource).To(Equal([]string{"arn:aws:s3:::backups/eksctl/us-west-2/dev/*"}))
+			bucket := findStatement(policy, "BackupBucket")
+			Expect(bucket.Action).To(Equal([]string{"s3:ListBucket"}))
+			Expect(bucket.Condition).To(Equal(map[string]map[string]string{
+				"StringLike": {"s3:prefix": "eksctl/us-west-2/dev/*"},
+			}))
+
+			policy, err = Required("get nodegroup", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findStatement(policy, "BackupObjects")).To(BeNil())
+		})
+
+		It("allows commands to be recorded in the audit log group", func() {
+			scope.AuditLogGroup = "eksctl-audit"
+
+			policy, err := Required("get cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			auditLog := findStatement(policy, "AuditLog")
+			Expect(auditLog.Action).To(Equal([]string{"logs:CreateLogStream", "logs:PutLogEvents"}))
+			Expect(auditLog.Resource).To(Equal([]string{"arn:aws:logs:us-west-2:123456789012:log-group:eksctl-audit:*"}))
+		})
+
+		It("allows events to be sent to the event bus", func() {
+			scope.NotifyEventBus = "ops"
+
+			policy, err := Required("delete cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			notify := findStatement(policy, "Notify")
+			Expect(notify.Action).To(Equal([]string{"events:PutEvents"}))
+			Expect(notify.Resource).To(Equal([]string{"arn:aws:events:us-west-2:123456789012:event-bus/ops"}))
+
+			scope.NotifyEventBus = "arn:aws:events:us-east-1:210987654321:event-bus/central"
+			policy, err = Required("delete cluster", cfg, scope)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(findStatement(policy, "Notify").Resource).To(Equal([]string{"arn:aws:events:us-east-1:210987654321:event-bus/central"}))
+		})
+	})
+})
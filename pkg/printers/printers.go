@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"io"
 
-	"github.com/kris-nova/logger"
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // OutputPrinter is the interface that printer must implement. This allows
@@ -8,9 +8,10 @@ import (
 	"reflect"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	"k8s.io/kops/util/pkg/tables"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // TablePrinter is a printer that outputs an object formatted
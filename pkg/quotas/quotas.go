@@ -0,0 +1,408 @@
+package quotas
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// Quota is a service quota that creating a cluster or nodegroups consumes
+type Quota struct {
+	ServiceCode string
+	QuotaCode   string
+	Name        string
+}
+
+func (q Quota) String() string {
+	return fmt.Sprintf("%q (%s/%s)", q.Name, q.ServiceCode, q.QuotaCode)
+}
+
+// Quotas that are checked, instances are counted in vCPUs by family
+var (
+	ClustersPerRegion           = Quota{"eks", "L-1194D53C", "Clusters"}
+	ManagedNodeGroupsPerCluster = Quota{"eks", "L-6D54EA21", "Managed node groups per cluster"}
+	VPCsPerRegion               = Quota{"vpc", "L-F678F1CE", "VPCs per Region"}
+	InternetGatewaysPerRegion   = Quota{"vpc", "L-A4707A72", "Internet gateways per Region"}
+	NetworkInterfacesPerRegion  = Quota{"vpc", "L-DF5E4CA3", "Network interfaces per Region"}
+	ElasticIPs                  = Quota{"ec2", "L-0263D0A3", "EC2-VPC Elastic IPs"}
+)
+
+// instanceQuotas holds the on-demand and spot vCPU quotas of instance families,
+// families that aren't listed count towards the standard ones
+var instanceQuotas = map[string][2]Quota{
+	"standard": {
+		{"ec2", "L-1216C47A", "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances"},
+		{"ec2", "L-34B43A08", "All Standard (A, C, D, H, I, M, R, T, Z) Spot Instance Requests"},
+	},
+	"g": {
+		{"ec2", "L-DB2E81BA", "Running On-Demand G and VT instances"},
+		{"ec2", "L-3819A6DF", "All G and VT Spot Instance Requests"},
+	},
+	"p": {
+		{"ec2", "L-417A185B", "Running On-Demand P instances"},
+		{"ec2", "L-7212CCBC", "All P Spot Instance Requests"},
+	},
+	"x": {
+		{"ec2", "L-7295265B", "Running On-Demand X instances"},
+		{"ec2", "L-E3A00192", "All X Spot Instance Requests"},
+	},
+	"f": {
+		{"ec2", "L-74FC7D96", "Running On-Demand F instances"},
+		{"ec2", "L-88CF9481", "All F Spot Instance Requests"},
+	},
+	"inf": {
+		{"ec2", "L-1945791B", "Running On-Demand Inf instances"},
+		{"ec2", "L-B5D1601B", "All Inf Spot Instance Requests"},
+	},
+}
+
+// networkInterfacesPerNode estimates the network interfaces of a node, i.e. the primary
+// one and the one that the VPC CNI keeps attached for pods
+const networkInterfacesPerNode = 2
+
+// networkInterfacesPerControlPlane is the number of network interfaces that EKS creates
+// in the subnets of a cluster for the control plane
+const networkInterfacesPerControlPlane = 2
+
+// Shortfall describes a quota that is too low for the resources that are created
+type Shortfall struct {
+	Quota    Quota
+	Value    float64
+	Usage    float64
+	Required float64
+}
+
+func (s Shortfall) String() string {
+	return fmt.Sprintf("quota %s is %g, %g are in use and %g more are required", s.Quota, s.Value, s.Usage, s.Required)
+}
+
+// Checker compares the quotas of an account with what creating resources will consume
+type Checker struct {
+	provider     api.ClusterProvider
+	requirements map[Quota]float64
+	// usage is counted by quota, as the instances of several quotas are described at once
+	usage map[Quota]float64
+}
+
+// NewChecker creates a new Checker
+func NewChecker(provider api.ClusterProvider) *Checker {
+	return &Checker{
+		provider:     provider,
+		requirements: map[Quota]float64{},
+	}
+}
+
+// Require adds an amount of a quota that will be consumed
+func (c *Checker) Require(quota Quota, amount float64) {
+	if amount > 0 {
+		c.requirements[quota] += amount
+	}
+}
+
+// AddCluster adds the quotas that creating the cluster of spec consumes, along with its VPC
+// when it's not an existing one
+func (c *Checker) AddCluster(spec *api.ClusterConfig) {
+	c.Require(ClustersPerRegion, 1)
+	c.Require(NetworkInterfacesPerRegion, networkInterfacesPerControlPlane)
+	if spec.VPC.ID != "" {
+		return
+	}
+	c.Require(VPCsPerRegion, 1)
+	if spec.IsPrivateCluster() {
+		return
+	}
+	c.Require(InternetGatewaysPerRegion, 1)
+	switch aws.StringValue(spec.VPC.NAT.Gateway) {
+	case api.ClusterSingleNAT:
+		c.Require(ElasticIPs, 1)
+	case api.ClusterHighlyAvailableNAT:
+		c.Require(ElasticIPs, float64(len(spec.AvailabilityZones)))
+	}
+}
+
+// AddNodeGroups adds the quotas that the desired capacity of the nodegroups consumes
+func (c *Checker) AddNodeGroups(nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup) error {
+	instanceTypes := []string{}
+	for _, ng := range nodeGroups {
+		instanceTypes = append(instanceTypes, nodeGroupInstanceType(ng))
+	}
+	for _, ng := range managedNodeGroups {
+		instanceTypes = append(instanceTypes, ng.InstanceType)
+	}
+	vCPUs, err := c.describeVCPUs(instanceTypes)
+	if err != nil {
+		return err
+	}
+
+	for _, ng := range nodeGroups {
+		onDemand, spot := api.InstanceCapacity(ng)
+		instanceType := nodeGroupInstanceType(ng)
+		quotas := instanceQuotas[instanceFamilyQuota(instanceType)]
+		c.Require(quotas[0], float64(onDemand*vCPUs[instanceType]))
+		c.Require(quotas[1], float64(spot*vCPUs[instanceType]))
+		c.Require(NetworkInterfacesPerRegion, float64((onDemand+spot)*networkInterfacesPerNode))
+	}
+	for _, ng := range managedNodeGroups {
+		capacity := api.DesiredCapacityOrDefault(ng.DesiredCapacity, ng.MinSize)
+		quotas := instanceQuotas[instanceFamilyQuota(ng.InstanceType)]
+		c.Require(quotas[0], float64(capacity*vCPUs[ng.InstanceType]))
+		c.Require(NetworkInterfacesPerRegion, float64(capacity*networkInterfacesPerNode))
+		c.Require(ManagedNodeGroupsPerCluster, 1)
+	}
+	return nil
+}
+
+// Check returns the quotas that are too low for the requirements; quotas whose value or
+// usage cannot be described are only warned about, e.g. when the caller isn't allowed to
+func (c *Checker) Check(clusterName string, newCluster bool) []Shortfall {
+	if err := c.describeInstanceUsage(); err != nil {
+		logger.Warning("cannot check vCPU quotas of instances: %s", err.Error())
+	}
+
+	quotas := []Quota{}
+	for quota := range c.requirements {
+		quotas = append(quotas, quota)
+	}
+	sort.Slice(quotas, func(i, j int) bool { return quotas[i].QuotaCode < quotas[j].QuotaCode })
+
+	shortfalls := []Shortfall{}
+	for _, quota := range quotas {
+		usage, ok := c.usage[quota]
+		if !ok {
+			var err error
+			if usage, err = c.describeUsage(quota, clusterName, newCluster); err != nil {
+				logger.Warning("cannot check quota %s: %s", quota, err.Error())
+				continue
+			}
+		}
+		value, err := c.quotaValue(quota)
+		if err != nil {
+			logger.Warning("cannot check quota %s: %s", quota, err.Error())
+			continue
+		}
+		required := c.requirements[quota]
+		logger.Debug("quota %s is %g, %g are in use and %g are required", quota, value, usage, required)
+		if usage+required > value {
+			shortfalls = append(shortfalls, Shortfall{Quota: quota, Value: value, Usage: usage, Required: required})
+		}
+	}
+	return shortfalls
+}
+
+// RequestIncreases files requests to increase the quotas by what they fall short
+func (c *Checker) RequestIncreases(shortfalls []Shortfall) {
+	for _, s := range shortfalls {
+		desired := math.Ceil(s.Usage + s.Required)
+		output, err := c.provider.ServiceQuotas().RequestServiceQuotaIncrease(&servicequotas.RequestServiceQuotaIncreaseInput{
+			ServiceCode:  aws.String(s.Quota.ServiceCode),
+			QuotaCode:    aws.String(s.Quota.QuotaCode),
+			DesiredValue: aws.Float64(desired),
+		})
+		if err != nil {
+			logger.Warning("cannot request increase of quota %s: %s", s.Quota, err.Error())
+			continue
+		}
+		logger.Info("requested increase of quota %s to %g, request %s is %s", s.Quota, desired,
+			aws.StringValue(output.RequestedQuota.Id), aws.StringValue(output.RequestedQuota.Status))
+	}
+}
+
+// Preflight checks that the quotas of the account suffice to create the nodegroups, and the
+// cluster of spec when newCluster is set, and optionally requests increases of the ones that don't
+func Preflight(provider api.ClusterProvider, spec *api.ClusterConfig, nodeGroups []*api.NodeGroup, managedNodeGroups []*api.ManagedNodeGroup, newCluster, requestIncreases bool) error {
+	checker := NewChecker(provider)
+	if newCluster {
+		checker.AddCluster(spec)
+	}
+	if err := checker.AddNodeGroups(nodeGroups, managedNodeGroups); err != nil {
+		logger.Warning("cannot check quotas of nodegroups: %s", err.Error())
+	}
+
+	shortfalls := checker.Check(spec.Metadata.Name, newCluster)
+	if len(shortfalls) == 0 {
+		return nil
+	}
+
+	problems := []string{}
+	for _, s := range shortfalls {
+		problems = append(problems, s.String())
+	}
+	msg := fmt.Sprintf("insufficient service quotas in %s:\n- %s", provider.Region(), strings.Join(problems, "\n- "))
+	if requestIncreases {
+		checker.RequestIncreases(shortfalls)
+		return fmt.Errorf("%s\nincreases of the quotas were requested, retry once they are approved", msg)
+	}
+	return fmt.Errorf("%s\nuse --request-quota-increases to request increases of the quotas", msg)
+}
+
+func (c *Checker) quotaValue(quota Quota) (float64, error) {
+	output, err := c.provider.ServiceQuotas().GetServiceQuota(&servicequotas.GetServiceQuotaInput{
+		ServiceCode: aws.String(quota.ServiceCode),
+		QuotaCode:   aws.String(quota.QuotaCode),
+	})
+	if err == nil {
+		return aws.Float64Value(output.Quota.Value), nil
+	}
+	if awsErr, ok := err.(awserr.Error); !ok || awsErr.Code() != servicequotas.ErrCodeNoSuchResourceException {
+		return 0, err
+	}
+	// quotas that were never changed in the account only have their default value
+	defaultOutput, err := c.provider.ServiceQuotas().GetAWSDefaultServiceQuota(&servicequotas.GetAWSDefaultServiceQuotaInput{
+		ServiceCode: aws.String(quota.ServiceCode),
+		QuotaCode:   aws.String(quota.QuotaCode),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return aws.Float64Value(defaultOutput.Quota.Value), nil
+}
+
+func (c *Checker) describeUsage(quota Quota, clusterName string, newCluster bool) (float64, error) {
+	count := 0
+	var err error
+	switch quota {
+	case ClustersPerRegion:
+		err = c.provider.EKS().ListClustersPages(&awseks.ListClustersInput{}, func(output *awseks.ListClustersOutput, _ bool) bool {
+			count += len(output.Clusters)
+			return true
+		})
+	case ManagedNodeGroupsPerCluster:
+		if newCluster {
+			return 0, nil
+		}
+		err = c.provider.EKS().ListNodegroupsPages(&awseks.ListNodegroupsInput{ClusterName: aws.String(clusterName)}, func(output *awseks.ListNodegroupsOutput, _ bool) bool {
+			count += len(output.Nodegroups)
+			return true
+		})
+	case VPCsPerRegion:
+		err = c.provider.EC2().DescribeVpcsPages(&ec2.DescribeVpcsInput{}, func(output *ec2.DescribeVpcsOutput, _ bool) bool {
+			count += len(output.Vpcs)
+			return true
+		})
+	case InternetGatewaysPerRegion:
+		err = c.provider.EC2().DescribeInternetGatewaysPages(&ec2.DescribeInternetGatewaysInput{}, func(output *ec2.DescribeInternetGatewaysOutput, _ bool) bool {
+			count += len(output.InternetGateways)
+			return true
+		})
+	case NetworkInterfacesPerRegion:
+		err = c.provider.EC2().DescribeNetworkInterfacesPages(&ec2.DescribeNetworkInterfacesInput{}, func(output *ec2.DescribeNetworkInterfacesOutput, _ bool) bool {
+			count += len(output.NetworkInterfaces)
+			return true
+		})
+	case ElasticIPs:
+		var output *ec2.DescribeAddressesOutput
+		output, err = c.provider.EC2().DescribeAddresses(&ec2.DescribeAddressesInput{
+			Filters: []*ec2.Filter{{Name: aws.String("domain"), Values: aws.StringSlice([]string{"vpc"})}},
+		})
+		if err == nil {
+			count = len(output.Addresses)
+		}
+	default:
+		return 0, fmt.Errorf("usage of quota %s is unknown", quota)
+	}
+	return float64(count), err
+}
+
+// describeInstanceUsage counts the vCPUs of running instances towards the quotas of their families
+func (c *Checker) describeInstanceUsage() error {
+	c.usage = map[Quota]float64{}
+	input := &ec2.DescribeInstancesInput{
+		Filters: []*ec2.Filter{{
+			Name:   aws.String("instance-state-name"),
+			Values: aws.StringSlice([]string{ec2.InstanceStateNamePending, ec2.InstanceStateNameRunning}),
+		}},
+	}
+	err := c.provider.EC2().DescribeInstancesPages(input, func(output *ec2.DescribeInstancesOutput, _ bool) bool {
+		for _, reservation := range output.Reservations {
+			for _, instance := range reservation.Instances {
+				if instance.CpuOptions == nil {
+					continue
+				}
+				vCPUs := aws.Int64Value(instance.CpuOptions.CoreCount) * aws.Int64Value(instance.CpuOptions.ThreadsPerCore)
+				quotas := instanceQuotas[instanceFamilyQuota(aws.StringValue(instance.InstanceType))]
+				quota := quotas[0]
+				if aws.StringValue(instance.InstanceLifecycle) == ec2.InstanceLifecycleTypeSpot {
+					quota = quotas[1]
+				}
+				c.usage[quota] += float64(vCPUs)
+			}
+		}
+		return true
+	})
+	if err != nil {
+		c.usage = map[Quota]float64{}
+		return errors.Wrap(err, "describing instances")
+	}
+	// quotas of families without instances are not in use at all
+	for _, quotas := range instanceQuotas {
+		for _, quota := range quotas {
+			if _, ok := c.usage[quota]; !ok {
+				c.usage[quota] = 0
+			}
+		}
+	}
+	return nil
+}
+
+func (c *Checker) describeVCPUs(instanceTypes []string) (map[string]int, error) {
+	vCPUs := map[string]int{}
+	unique := []string{}
+	for _, instanceType := range instanceTypes {
+		if _, ok := vCPUs[instanceType]; !ok && instanceType != "" {
+			vCPUs[instanceType] = 0
+			unique = append(unique, instanceType)
+		}
+	}
+	if len(unique) == 0 {
+		return vCPUs, nil
+	}
+	input := &ec2.DescribeInstanceTypesInput{
+		InstanceTypes: aws.StringSlice(unique),
+	}
+	err := c.provider.EC2().DescribeInstanceTypesPages(input, func(output *ec2.DescribeInstanceTypesOutput, _ bool) bool {
+		for _, info := range output.InstanceTypes {
+			if info.VCpuInfo != nil {
+				vCPUs[aws.StringValue(info.InstanceType)] = int(aws.Int64Value(info.VCpuInfo.DefaultVCpus))
+			}
+		}
+		return true
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing instance types %v", unique)
+	}
+	return vCPUs, nil
+}
+
+// instanceFamilyQuota returns the key of the quotas that instances of the type count towards
+func instanceFamilyQuota(instanceType string) string {
+	family := strings.SplitN(instanceType, ".", 2)[0]
+	if i := strings.IndexFunc(family, unicode.IsDigit); i > 0 {
+		family = family[:i]
+	}
+	switch family {
+	case "g", "vt":
+		return "g"
+	case "p", "x", "f", "inf":
+		return family
+	}
+	return "standard"
+}
+
+func nodeGroupInstanceType(ng *api.NodeGroup) string {
+	if api.HasMixedInstances(ng) {
+		return ng.InstancesDistribution.InstanceTypes[0]
+	}
+	return ng.InstanceType
+}
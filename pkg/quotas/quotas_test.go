@@ -0,0 +1,140 @@
+package quotas_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/aws/aws-sdk-go/service/servicequotas"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	. "github.com/weaveworks/eksctl/pkg/quotas"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+)
+
+var _ = Describe("service quotas", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+		ng  *api.NodeGroup
+	)
+
+	const standardQuotaCode = "L-1216C47A"
+
+	mockQuota := func(quotaCode string, value float64) {
+		p.MockServiceQuotas().On("GetServiceQuota", mock.MatchedBy(func(input *servicequotas.GetServiceQuotaInput) bool {
+			return *input.QuotaCode == quotaCode
+		})).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{Value: aws.Float64(value)},
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		p.MockEC2().On("DescribeInstanceTypesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeInstanceTypesOutput, bool) bool)
+			consume(&ec2.DescribeInstanceTypesOutput{InstanceTypes: []*ec2.InstanceTypeInfo{{
+				InstanceType: aws.String("m5.xlarge"),
+				VCpuInfo:     &ec2.VCpuInfo{DefaultVCpus: aws.Int64(4)},
+			}}}, true)
+		}).Return(nil)
+		p.MockEC2().On("DescribeInstancesPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeInstancesOutput, bool) bool)
+			consume(&ec2.DescribeInstancesOutput{Reservations: []*ec2.Reservation{{Instances: []*ec2.Instance{{
+				InstanceType: aws.String("c5.2xlarge"),
+				CpuOptions:   &ec2.CpuOptions{CoreCount: aws.Int64(4), ThreadsPerCore: aws.Int64(2)},
+			}}}}}, true)
+		}).Return(nil)
+
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.VPC.ID = "vpc-1"
+		ng = cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.InstanceType = "m5.xlarge"
+		ng.DesiredCapacity = aws.Int(3)
+	})
+
+	It("succeeds when the quotas suffice", func() {
+		mockQuota(standardQuotaCode, 20)
+		p.MockEC2().On("DescribeNetworkInterfacesPages", mock.Anything, mock.Anything).Return(nil)
+		mockQuota("L-DF5E4CA3", 5000)
+
+		Expect(Preflight(p, cfg, cfg.NodeGroups, nil, false, false)).To(Succeed())
+	})
+
+	It("reports the quotas that fall short, counting the vCPUs of running instances", func() {
+		mockQuota(standardQuotaCode, 16)
+		p.MockEC2().On("DescribeNetworkInterfacesPages", mock.Anything, mock.Anything).Return(nil)
+		mockQuota("L-DF5E4CA3", 5000)
+
+		err := Preflight(p, cfg, cfg.NodeGroups, nil, false, false)
+		Expect(err).To(MatchError(`insufficient service quotas in us-west-2:
+- quota "Running On-Demand Standard (A, C, D, H, I, M, R, T, Z) instances" (ec2/L-1216C47A) is 16, 8 are in use and 12 more are required
+use --request-quota-increases to request increases of the quotas`))
+		p.MockServiceQuotas().AssertNotCalled(GinkgoT(), "RequestServiceQuotaIncrease", mock.Anything)
+	})
+
+	It("requests increases of the quotas that fall short", func() {
+		mockQuota(standardQuotaCode, 16)
+		p.MockEC2().On("DescribeNetworkInterfacesPages", mock.Anything, mock.Anything).Return(nil)
+		mockQuota("L-DF5E4CA3", 5000)
+		p.MockServiceQuotas().On("RequestServiceQuotaIncrease", mock.Anything).Return(&servicequotas.RequestServiceQuotaIncreaseOutput{
+			RequestedQuota: &servicequotas.RequestedServiceQuotaChange{Id: aws.String("request-1"), Status: aws.String("PENDING")},
+		}, nil)
+
+		Expect(Preflight(p, cfg, cfg.NodeGroups, nil, false, true)).ToNot(Succeed())
+		input := p.MockServiceQuotas().Calls[len(p.MockServiceQuotas().Calls)-1].Arguments[0].(*servicequotas.RequestServiceQuotaIncreaseInput)
+		Expect(*input.QuotaCode).To(Equal(standardQuotaCode))
+		Expect(*input.DesiredValue).To(Equal(20.0))
+	})
+
+	It("uses the default value of quotas that were never changed, and counts spot instances apart", func() {
+		ng.InstancesDistribution = &api.NodeGroupInstancesDistribution{
+			InstanceTypes:                       []string{"m5.xlarge"},
+			OnDemandBaseCapacity:                aws.Int(1),
+			OnDemandPercentageAboveBaseCapacity: aws.Int(0),
+		}
+		p.MockServiceQuotas().On("GetServiceQuota", mock.Anything).Return(nil, awserr.New(servicequotas.ErrCodeNoSuchResourceException, "no quota", nil))
+		p.MockServiceQuotas().On("GetAWSDefaultServiceQuota", mock.MatchedBy(func(input *servicequotas.GetAWSDefaultServiceQuotaInput) bool {
+			return *input.QuotaCode == "L-34B43A08"
+		})).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{Value: aws.Float64(5)},
+		}, nil)
+		p.MockServiceQuotas().On("GetAWSDefaultServiceQuota", mock.Anything).Return(&servicequotas.GetAWSDefaultServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{Value: aws.Float64(5000)},
+		}, nil)
+		p.MockEC2().On("DescribeNetworkInterfacesPages", mock.Anything, mock.Anything).Return(nil)
+
+		err := Preflight(p, cfg, cfg.NodeGroups, nil, false, false)
+		Expect(err).To(MatchError(ContainSubstring(`quota "All Standard (A, C, D, H, I, M, R, T, Z) Spot Instance Requests" (ec2/L-34B43A08) is 5, 0 are in use and 8 more are required`)))
+	})
+
+	It("checks the quotas of a new cluster and its VPC", func() {
+		cfg.VPC.ID = ""
+		single := api.ClusterSingleNAT
+		cfg.VPC.NAT = &api.ClusterNAT{Gateway: &single}
+		checker := NewChecker(p)
+		checker.AddCluster(cfg)
+
+		p.MockEKS().On("ListClustersPages", mock.Anything, mock.Anything).Return(nil)
+		p.MockEC2().On("DescribeVpcsPages", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+			consume := args[1].(func(*ec2.DescribeVpcsOutput, bool) bool)
+			consume(&ec2.DescribeVpcsOutput{Vpcs: make([]*ec2.Vpc, 5)}, true)
+		}).Return(nil)
+		p.MockEC2().On("DescribeInternetGatewaysPages", mock.Anything, mock.Anything).Return(nil)
+		p.MockEC2().On("DescribeNetworkInterfacesPages", mock.Anything, mock.Anything).Return(nil)
+		p.MockEC2().On("DescribeAddresses", mock.Anything).Return(&ec2.DescribeAddressesOutput{}, nil)
+		mockQuota("L-F678F1CE", 5)
+		p.MockServiceQuotas().On("GetServiceQuota", mock.Anything).Return(&servicequotas.GetServiceQuotaOutput{
+			Quota: &servicequotas.ServiceQuota{Value: aws.Float64(100)},
+		}, nil)
+
+		shortfalls := checker.Check(cfg.Metadata.Name, true)
+		Expect(shortfalls).To(HaveLen(1))
+		Expect(shortfalls[0].Quota).To(Equal(VPCsPerRegion))
+		Expect(shortfalls[0].String()).To(Equal(`quota "VPCs per Region" (vpc/L-F678F1CE) is 5, 5 are in use and 1 more are required`))
+	})
+})
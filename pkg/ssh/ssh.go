@@ -3,11 +3,11 @@ package ssh
 import (
 	"fmt"
 	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/file"
 	"io/ioutil"
 	"strings"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 
 	"github.com/aws/aws-sdk-go/aws"
@@ -18,6 +18,54 @@ import (
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 )
 
+// LoadKey loads the ssh public key specified in the SSH config of a nodegroup. The key should be specified
+// in only one way: by name (for a key existing in EC2), by path (for a key in a local file)
+// or by its contents (in the config-file). It also assumes that if ssh is enabled (SSH.Allow
+// == true) then one key was specified
+func LoadKey(sshConfig *api.NodeGroupSSH, clusterName, ngName string, provider api.ClusterProvider) error {
+	if sshConfig.Allow == nil || *sshConfig.Allow == false {
+		return nil
+	}
+
+	switch {
+
+	// Load Key by content
+	case sshConfig.PublicKey != nil:
+		keyName, err := LoadKeyByContent(sshConfig.PublicKey, clusterName, ngName, provider)
+		if err != nil {
+			return err
+		}
+		sshConfig.PublicKeyName = &keyName
+
+	// Use key by name in EC2
+	case sshConfig.PublicKeyName != nil && *sshConfig.PublicKeyName != "":
+		if err := CheckKeyExistsInEC2(*sshConfig.PublicKeyName, provider); err != nil {
+			return err
+		}
+		logger.Info("using EC2 key pair %q", *sshConfig.PublicKeyName)
+
+	// Local ssh key file
+	case file.Exists(*sshConfig.PublicKeyPath):
+		keyName, err := LoadKeyFromFile(*sshConfig.PublicKeyPath, clusterName, ngName, provider)
+		if err != nil {
+			return err
+		}
+		sshConfig.PublicKeyName = &keyName
+
+	// A keyPath, when specified as a flag, can mean a local key (checked above) or a key name in EC2
+	default:
+		err := CheckKeyExistsInEC2(*sshConfig.PublicKeyPath, provider)
+		if err != nil {
+			return err
+		}
+		sshConfig.PublicKeyName = sshConfig.PublicKeyPath
+		sshConfig.PublicKeyPath = nil
+		logger.Info("using EC2 key pair %q", *sshConfig.PublicKeyName)
+	}
+
+	return nil
+}
+
 // LoadKeyFromFile loads and imports a public SSH key from a file provided a path to that file.
 // returns the name of the key
 func LoadKeyFromFile(filePath, clusterName, ngName string, provider api.ClusterProvider) (string, error) {
@@ -0,0 +1,154 @@
+package terraform
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+var identifierPattern = regexp.MustCompile(`^[a-zA-Z_][a-zA-Z0-9_-]*$`)
+
+// writer writes HCL, indented by two spaces per level
+type writer struct {
+	b strings.Builder
+}
+
+func (w *writer) line(indent int, format string, args ...interface{}) {
+	if format == "" {
+		w.b.WriteString("\n")
+		return
+	}
+	w.b.WriteString(strings.Repeat("  ", indent))
+	fmt.Fprintf(&w.b, format, args...)
+	w.b.WriteString("\n")
+}
+
+func (w *writer) String() string {
+	return w.b.String()
+}
+
+// snakeCase translates a CloudFormation name to the name of a Terraform attribute or resource,
+// e.g. VPCZoneIdentifier to vpc_zone_identifier and Ec2SshKey to ec2_ssh_key
+func snakeCase(name string) string {
+	runes := []rune(name)
+	var b strings.Builder
+	for i, r := range runes {
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) {
+			if b.Len() > 0 && !strings.HasSuffix(b.String(), "_") {
+				b.WriteRune('_')
+			}
+			continue
+		}
+		if unicode.IsUpper(r) && i > 0 {
+			prev := runes[i-1]
+			nextIsLower := i+1 < len(runes) && unicode.IsLower(runes[i+1])
+			if unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextIsLower) {
+				if !strings.HasSuffix(b.String(), "_") {
+					b.WriteRune('_')
+				}
+			}
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return strings.Trim(b.String(), "_")
+}
+
+// quote returns an HCL string literal, template sequences are escaped
+func quote(s string) string {
+	return `"` + escape(s) + `"`
+}
+
+func escape(s string) string {
+	replacer := strings.NewReplacer(
+		`\`, `\\`,
+		`"`, `\"`,
+		"\n", `\n`,
+		"\r", `\r`,
+		"\t", `\t`,
+		"${", "$${",
+		"%{", "%%{",
+	)
+	return replacer.Replace(s)
+}
+
+// unquote returns the string of an HCL string literal, it's false for other expressions
+func unquote(expr string) (string, bool) {
+	if len(expr) < 2 || !strings.HasPrefix(expr, `"`) || !strings.HasSuffix(expr, `"`) {
+		return "", false
+	}
+	inner := expr[1 : len(expr)-1]
+	for i := 0; i < len(inner); i++ {
+		switch inner[i] {
+		case '\\':
+			i++
+		case '"':
+			return "", false
+		case '$', '%':
+			if i+1 < len(inner) && inner[i+1] == '{' {
+				if i == 0 || inner[i-1] != inner[i] {
+					return "", false
+				}
+			}
+		}
+	}
+	replacer := strings.NewReplacer(`\\`, `\`, `\"`, `"`, `\n`, "\n", `\r`, "\r", `\t`, "\t", "$${", "${", "%%{", "%{")
+	return replacer.Replace(inner), true
+}
+
+// objectKey returns the key of an HCL object, keys that aren't identifiers are quoted
+func objectKey(key string) string {
+	if identifierPattern.MatchString(key) {
+		return key
+	}
+	return quote(key)
+}
+
+func number(f float64) string {
+	return strconv.FormatFloat(f, 'f', -1, 64)
+}
+
+func sortedKeys(m map[string]interface{}) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// list renders the elements of a list, on a single line unless one of them spans several lines
+func list(elements []string, indent int) string {
+	multiline := false
+	for _, e := range elements {
+		if strings.Contains(e, "\n") {
+			multiline = true
+		}
+	}
+	if !multiline {
+		return "[" + strings.Join(elements, ", ") + "]"
+	}
+	w := &writer{}
+	w.b.WriteString("[\n")
+	for _, e := range elements {
+		w.line(indent+1, "%s,", e)
+	}
+	w.b.WriteString(strings.Repeat("  ", indent) + "]")
+	return w.String()
+}
+
+// object renders the keys and values of an object, one per line
+func object(keys, values []string, indent int) string {
+	if len(keys) == 0 {
+		return "{}"
+	}
+	w := &writer{}
+	w.b.WriteString("{\n")
+	for i := range keys {
+		w.line(indent+1, "%s = %s", keys[i], values[i])
+	}
+	w.b.WriteString(strings.Repeat("  ", indent) + "}")
+	return w.String()
+}
@@ -0,0 +1,79 @@
+package terraform
+
+import (
+	"strings"
+)
+
+// Imports returns the import blocks of the resources, so that Terraform adopts the existing resources
+// instead of creating new ones; the addresses are those of the resources returned by HCL
+func (t *Translator) Imports() string {
+	w := &writer{}
+	w.line(0, "# Import blocks of the resources of cluster %q, for use with the configuration of `eksctl export terraform`", t.ClusterName)
+	w.line(0, "")
+	for _, s := range t.stacks {
+		w.line(0, "# stack %s", s.name)
+		w.line(0, "")
+		for _, logicalID := range s.logicalIDs() {
+			address, ok := s.address(logicalID)
+			if !ok {
+				continue
+			}
+			id, ok := t.importID(s, logicalID)
+			if !ok {
+				w.line(0, "# %s can't be imported by its ID, import it manually", address)
+				w.line(0, "")
+				continue
+			}
+			w.line(0, "import {")
+			w.line(1, "to = %s", address)
+			w.line(1, "id = %s", quote(id))
+			w.line(0, "}")
+			w.line(0, "")
+		}
+	}
+	return strings.TrimRight(w.String(), "\n") + "\n"
+}
+
+// importID returns the ID that Terraform imports the resource by, most resources are imported by
+// their physical ID
+func (t *Translator) importID(s *stack, logicalID string) (string, bool) {
+	r := s.template.Resources[logicalID]
+	physicalID := s.physicalIDs[logicalID]
+	property := func(name string) (string, bool) {
+		value := r.Properties[name]
+		if l, ok := value.([]interface{}); ok && len(l) > 0 {
+			value = l[0]
+		}
+		return t.literal(s, value)
+	}
+	join := func(separator string, names ...string) (string, bool) {
+		values := []string{}
+		for _, name := range names {
+			value, ok := property(name)
+			if !ok {
+				return "", false
+			}
+			values = append(values, value)
+		}
+		return strings.Join(values, separator), true
+	}
+
+	switch r.Type {
+	case "AWS::EC2::Route":
+		return join("_", "RouteTableId", "DestinationCidrBlock")
+	case "AWS::EC2::SubnetRouteTableAssociation":
+		return join("/", "SubnetId", "RouteTableId")
+	case "AWS::EC2::VPCGatewayAttachment":
+		return join(":", "InternetGatewayId", "VpcId")
+	case "AWS::IAM::Policy":
+		return join(":", "Roles", "PolicyName")
+	case "AWS::EKS::Nodegroup":
+		return strings.Replace(physicalID, "/", ":", 1), physicalID != ""
+	case "AWS::EC2::EIP":
+		// older EIPs have the public IP as their physical ID, Terraform imports them by allocation ID
+		return physicalID, strings.HasPrefix(physicalID, "eipalloc-")
+	case "AWS::EC2::SecurityGroupIngress", "AWS::EC2::SecurityGroupEgress":
+		return "", false
+	}
+	return physicalID, physicalID != ""
+}
@@ -0,0 +1,635 @@
+package terraform
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+)
+
+type parameter struct {
+	Description string
+}
+
+type resource struct {
+	Type       string
+	Properties map[string]interface{}
+	DependsOn  interface{}
+}
+
+type output struct {
+	Value  interface{}
+	Export *struct {
+		Name interface{}
+	}
+}
+
+type template struct {
+	Parameters map[string]parameter
+	Mappings   map[string]interface{}
+	Resources  map[string]resource
+	Outputs    map[string]output
+}
+
+type stack struct {
+	name string
+	// component prefixes the names of the Terraform resources of the stack, e.g. nodegroup_ng_1
+	component   string
+	template    template
+	parameters  map[string]string
+	physicalIDs map[string]string
+}
+
+type export struct {
+	stack *stack
+	value interface{}
+}
+
+// pseudoParameters are the pseudo parameters that translate to data sources
+var pseudoParameters = map[string]struct{ expr, data string }{
+	"AWS::Region":    {"data.aws_region.current.name", `data "aws_region" "current" {}`},
+	"AWS::AccountId": {"data.aws_caller_identity.current.account_id", `data "aws_caller_identity" "current" {}`},
+	"AWS::Partition": {"data.aws_partition.current.partition", `data "aws_partition" "current" {}`},
+	"AWS::URLSuffix": {"data.aws_partition.current.dns_suffix", `data "aws_partition" "current" {}`},
+}
+
+// Translator translates the resources of the stacks of a cluster to Terraform; the translation is best-effort,
+// intrinsic functions without an equivalent, e.g. Fn::If, translate to null with a comment
+type Translator struct {
+	ClusterName string
+
+	stacks  []*stack
+	exports map[string]export
+
+	// data sources, variables and locals that the translated resources refer to
+	data      map[string]string
+	variables map[string]string
+	locals    map[string]string
+}
+
+// NewTranslator returns a translator of the given stacks, only the resources that exist in the stacks are translated
+func NewTranslator(clusterName string, stacks []manager.StackInfo) (*Translator, error) {
+	t := &Translator{
+		ClusterName: clusterName,
+		exports:     map[string]export{},
+	}
+	for _, info := range stacks {
+		s := &stack{
+			name:        *info.Stack.StackName,
+			parameters:  map[string]string{},
+			physicalIDs: map[string]string{},
+		}
+		s.component = snakeCase(strings.TrimPrefix(s.name, fmt.Sprintf("eksctl-%s-", clusterName)))
+		if err := json.Unmarshal([]byte(aws.StringValue(info.Template)), &s.template); err != nil {
+			return nil, errors.Wrapf(err, "parsing template of stack %q", s.name)
+		}
+		for _, p := range info.Stack.Parameters {
+			s.parameters[*p.ParameterKey] = aws.StringValue(p.ParameterValue)
+		}
+		for _, r := range info.Resources {
+			s.physicalIDs[*r.LogicalResourceId] = aws.StringValue(r.PhysicalResourceId)
+		}
+		t.stacks = append(t.stacks, s)
+	}
+	sort.Slice(t.stacks, func(i, j int) bool {
+		return t.stacks[i].name < t.stacks[j].name
+	})
+
+	for _, s := range t.stacks {
+		for _, o := range s.template.Outputs {
+			if o.Export == nil {
+				continue
+			}
+			if name, ok := t.literal(s, o.Export.Name); ok {
+				t.exports[name] = export{stack: s, value: o.Value}
+			}
+		}
+	}
+	return t, nil
+}
+
+// logicalIDs returns the logical IDs of the resources that exist in the stack
+func (s *stack) logicalIDs() []string {
+	ids := []string{}
+	for id := range s.template.Resources {
+		if _, ok := s.physicalIDs[id]; ok {
+			ids = append(ids, id)
+		}
+	}
+	sort.Strings(ids)
+	return ids
+}
+
+func (s *stack) resourceName(logicalID string) string {
+	return s.component + "_" + snakeCase(logicalID)
+}
+
+// address returns the address of the Terraform resource of a resource, it's false for resource types
+// that aren't translated
+func (s *stack) address(logicalID string) (string, bool) {
+	rt, ok := resourceTypes[s.template.Resources[logicalID].Type]
+	if !ok {
+		return "", false
+	}
+	return rt.name + "." + s.resourceName(logicalID), true
+}
+
+// HCL returns the Terraform configuration of the resources, along with the data sources, variables and
+// locals that they refer to
+func (t *Translator) HCL() string {
+	t.data = map[string]string{}
+	t.variables = map[string]string{}
+	t.locals = map[string]string{}
+
+	resources := &writer{}
+	for _, s := range t.stacks {
+		resources.line(0, "# stack %s", s.name)
+		resources.line(0, "")
+		for _, id := range s.logicalIDs() {
+			t.resource(resources, s, id)
+			resources.line(0, "")
+		}
+	}
+
+	w := &writer{}
+	w.line(0, "# Terraform configuration of the resources of cluster %q, translated from its CloudFormation stacks;", t.ClusterName)
+	w.line(0, "# review it before use, not all properties and intrinsic functions have an equivalent")
+	w.line(0, "")
+	for _, name := range sortedStringKeys(t.data) {
+		w.line(0, "%s", t.data[name])
+		w.line(0, "")
+	}
+	for _, name := range sortedStringKeys(t.variables) {
+		w.line(0, "%s", t.variables[name])
+		w.line(0, "")
+	}
+	if len(t.locals) > 0 {
+		w.line(0, "locals {")
+		for _, name := range sortedStringKeys(t.locals) {
+			w.line(1, "%s = %s", name, t.locals[name])
+		}
+		w.line(0, "}")
+		w.line(0, "")
+	}
+	return strings.TrimRight(w.String()+resources.String(), "\n") + "\n"
+}
+
+func (t *Translator) resource(w *writer, s *stack, logicalID string) {
+	r := s.template.Resources[logicalID]
+	rt, ok := resourceTypes[r.Type]
+	if !ok {
+		w.line(0, "# %s (%s) has no Terraform equivalent", logicalID, r.Type)
+		return
+	}
+
+	w.line(0, "resource %q %q {", rt.name, s.resourceName(logicalID))
+	for _, name := range sortedStringKeys(rt.static) {
+		w.line(1, "%s = %s", name, rt.static[name])
+	}
+
+	properties := map[string]interface{}{}
+	for key, value := range r.Properties {
+		if nested, ok := value.(map[string]interface{}); ok && key == rt.flatten {
+			for k, v := range nested {
+				properties[k] = v
+			}
+			continue
+		}
+		properties[key] = value
+	}
+	t.block(w, 1, s, rt, "", properties)
+
+	var dependsOn []string
+	switch d := r.DependsOn.(type) {
+	case string:
+		dependsOn = []string{d}
+	case []interface{}:
+		for _, id := range d {
+			if id, ok := id.(string); ok {
+				dependsOn = append(dependsOn, id)
+			}
+		}
+	}
+	addresses := []string{}
+	for _, id := range dependsOn {
+		if address, ok := s.address(id); ok {
+			addresses = append(addresses, address)
+		}
+	}
+	if len(addresses) > 0 {
+		w.line(1, "depends_on = [%s]", strings.Join(addresses, ", "))
+	}
+	w.line(0, "}")
+}
+
+// block writes the arguments of the properties, objects become nested blocks unless the property is an attribute
+func (t *Translator) block(w *writer, indent int, s *stack, rt *resourceType, parent string, properties map[string]interface{}) {
+	for _, key := range sortedKeys(properties) {
+		value := properties[key]
+		p, translated := rt.property(parent, key)
+		if !translated && key == "Tags" {
+			p.attribute = true
+			value = tagMap(value)
+		}
+		if l, ok := value.([]interface{}); ok && p.first && len(l) > 0 {
+			value = l[0]
+		}
+		if _, ok := value.([]interface{}); !ok && p.list {
+			value = []interface{}{value}
+		}
+
+		if m, ok := plainMap(value); ok && p.json {
+			x, _ := t.expr(s, m, indent)
+			w.line(indent, "%s = jsonencode(%s)", p.name, x)
+			continue
+		}
+		if !p.attribute && !p.json {
+			if m, ok := plainMap(value); ok {
+				t.nestedBlock(w, indent, s, rt, key, p.name, m)
+				continue
+			}
+			if l, ok := value.([]interface{}); ok && len(l) > 0 && allPlainMaps(l) {
+				for _, m := range l {
+					t.nestedBlock(w, indent, s, rt, key, p.name, m.(map[string]interface{}))
+				}
+				continue
+			}
+		}
+		if x, ok := t.expr(s, value, indent); ok {
+			w.line(indent, "%s = %s", p.name, x)
+		}
+	}
+}
+
+func (t *Translator) nestedBlock(w *writer, indent int, s *stack, rt *resourceType, key, name string, properties map[string]interface{}) {
+	w.line(indent, "%s {", name)
+	t.block(w, indent+1, s, rt, key, properties)
+	w.line(indent, "}")
+}
+
+// tagMap translates a list of tags to a map, as Terraform expects them
+func tagMap(value interface{}) interface{} {
+	tags, ok := value.([]interface{})
+	if !ok {
+		return value
+	}
+	m := map[string]interface{}{}
+	for _, tag := range tags {
+		tag, ok := tag.(map[string]interface{})
+		if !ok {
+			return value
+		}
+		key, ok := tag["Key"].(string)
+		if !ok {
+			return value
+		}
+		m[key] = tag["Value"]
+	}
+	return m
+}
+
+// intrinsic returns the function and argument of an intrinsic function, e.g. Ref or Fn::Sub
+func intrinsic(value interface{}) (string, interface{}, bool) {
+	m, ok := value.(map[string]interface{})
+	if !ok || len(m) != 1 {
+		return "", nil, false
+	}
+	for fn, arg := range m {
+		if fn == "Ref" || strings.HasPrefix(fn, "Fn::") {
+			return fn, arg, true
+		}
+	}
+	return "", nil, false
+}
+
+func plainMap(value interface{}) (map[string]interface{}, bool) {
+	if _, _, ok := intrinsic(value); ok {
+		return nil, false
+	}
+	m, ok := value.(map[string]interface{})
+	return m, ok
+}
+
+func allPlainMaps(l []interface{}) bool {
+	for _, e := range l {
+		if _, ok := plainMap(e); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// expr translates a value to an HCL expression, it's false when the value is AWS::NoValue
+func (t *Translator) expr(s *stack, value interface{}, indent int) (string, bool) {
+	if fn, arg, ok := intrinsic(value); ok {
+		return t.intrinsic(s, fn, arg, indent)
+	}
+	switch v := value.(type) {
+	case string:
+		return quote(v), true
+	case float64:
+		return number(v), true
+	case bool:
+		return strconv.FormatBool(v), true
+	case []interface{}:
+		elements := []string{}
+		for _, e := range v {
+			if x, ok := t.expr(s, e, indent+1); ok {
+				elements = append(elements, x)
+			}
+		}
+		return list(elements, indent), true
+	case map[string]interface{}:
+		keys, values := []string{}, []string{}
+		for _, k := range sortedKeys(v) {
+			if x, ok := t.expr(s, v[k], indent+1); ok {
+				keys = append(keys, objectKey(k))
+				values = append(values, x)
+			}
+		}
+		return object(keys, values, indent), true
+	}
+	return "null", true
+}
+
+func notTranslated(fn string) (string, bool) {
+	return fmt.Sprintf("null /* %s is not translated */", fn), true
+}
+
+func (t *Translator) intrinsic(s *stack, fn string, arg interface{}, indent int) (string, bool) {
+	args, _ := arg.([]interface{})
+	switch fn {
+	case "Ref":
+		name, _ := arg.(string)
+		return t.ref(s, name)
+	case "Fn::GetAtt":
+		var name, attribute string
+		if a, ok := arg.(string); ok {
+			if parts := strings.SplitN(a, ".", 2); len(parts) == 2 {
+				name, attribute = parts[0], parts[1]
+			}
+		} else if len(args) == 2 {
+			name, _ = args[0].(string)
+			attribute, _ = args[1].(string)
+		}
+		if x, ok := t.getAtt(s, name, attribute); ok {
+			return x, true
+		}
+	case "Fn::Sub":
+		return t.sub(s, arg, indent)
+	case "Fn::Join":
+		if len(args) == 2 {
+			delimiter, _ := t.expr(s, args[0], indent)
+			values, _ := t.expr(s, args[1], indent)
+			return fmt.Sprintf("join(%s, %s)", delimiter, values), true
+		}
+	case "Fn::Select":
+		if len(args) == 2 {
+			index, _ := t.expr(s, args[0], indent)
+			values, _ := t.expr(s, args[1], indent)
+			return fmt.Sprintf("element(%s, %s)", values, index), true
+		}
+	case "Fn::Split":
+		if len(args) == 2 {
+			delimiter, _ := t.expr(s, args[0], indent)
+			value, _ := t.expr(s, args[1], indent)
+			return fmt.Sprintf("split(%s, %s)", delimiter, value), true
+		}
+	case "Fn::Base64":
+		value, _ := t.expr(s, arg, indent)
+		return fmt.Sprintf("base64encode(%s)", value), true
+	case "Fn::GetAZs":
+		t.data["data.aws_availability_zones.available"] = `data "aws_availability_zones" "available" {}`
+		return "data.aws_availability_zones.available.names", true
+	case "Fn::FindInMap":
+		if len(args) == 3 {
+			name, _ := args[0].(string)
+			if mapping, ok := s.template.Mappings[name]; ok {
+				local := s.component + "_" + snakeCase(name)
+				t.locals[local], _ = t.expr(s, mapping, 1)
+				first, _ := t.expr(s, args[1], indent)
+				second, _ := t.expr(s, args[2], indent)
+				return fmt.Sprintf("local.%s[%s][%s]", local, first, second), true
+			}
+		}
+	case "Fn::ImportValue":
+		name, ok := t.literal(s, arg)
+		if !ok {
+			break
+		}
+		if e, ok := t.exports[name]; ok {
+			return t.expr(e.stack, e.value, indent)
+		}
+		dataName := snakeCase(name)
+		t.data["data.aws_cloudformation_export."+dataName] = fmt.Sprintf("data \"aws_cloudformation_export\" %q {\n  name = %s\n}", dataName, quote(name))
+		return "data.aws_cloudformation_export." + dataName + ".value", true
+	}
+	return notTranslated(fn)
+}
+
+func (t *Translator) ref(s *stack, name string) (string, bool) {
+	switch name {
+	case "AWS::NoValue":
+		return "", false
+	case "AWS::StackName":
+		return quote(s.name), true
+	}
+	if p, ok := pseudoParameters[name]; ok {
+		t.data[strings.Join(strings.Split(p.expr, ".")[:3], ".")] = p.data
+		return p.expr, true
+	}
+	if p, ok := s.template.Parameters[name]; ok {
+		variable := s.component + "_" + snakeCase(name)
+		w := &writer{}
+		w.line(0, "variable %q {", variable)
+		if p.Description != "" {
+			w.line(1, "description = %s", quote(p.Description))
+		}
+		w.line(1, "type = string")
+		w.line(1, "default = %s", quote(s.parameters[name]))
+		w.b.WriteString("}")
+		t.variables[variable] = w.String()
+		return "var." + variable, true
+	}
+	if r, ok := s.template.Resources[name]; ok {
+		if rt, ok := resourceTypes[r.Type]; ok {
+			return rt.name + "." + s.resourceName(name) + "." + rt.refAttribute(), true
+		}
+	}
+	return notTranslated("Ref to " + name)
+}
+
+func (t *Translator) getAtt(s *stack, name, attribute string) (string, bool) {
+	r, ok := s.template.Resources[name]
+	if !ok || attribute == "" {
+		return "", false
+	}
+	rt, ok := resourceTypes[r.Type]
+	if !ok {
+		return "", false
+	}
+	return rt.name + "." + s.resourceName(name) + "." + rt.attribute(attribute), true
+}
+
+// sub translates Fn::Sub to a template string
+func (t *Translator) sub(s *stack, arg interface{}, indent int) (string, bool) {
+	str, variables, ok := subArgs(arg)
+	if !ok {
+		return notTranslated("Fn::Sub")
+	}
+	var b strings.Builder
+	for _, part := range splitSub(str) {
+		if !part.variable {
+			b.WriteString(escape(part.text))
+			continue
+		}
+		var x string
+		if value, ok := variables[part.text]; ok {
+			x, _ = t.expr(s, value, indent)
+		} else if parts := strings.SplitN(part.text, ".", 2); len(parts) == 2 {
+			if x, ok = t.getAtt(s, parts[0], parts[1]); !ok {
+				x, _ = notTranslated("Fn::GetAtt of " + part.text)
+			}
+		} else {
+			x, _ = t.ref(s, part.text)
+		}
+		if literal, ok := unquote(x); ok {
+			b.WriteString(escape(literal))
+		} else {
+			b.WriteString("${" + x + "}")
+		}
+	}
+	return `"` + b.String() + `"`, true
+}
+
+func subArgs(arg interface{}) (string, map[string]interface{}, bool) {
+	switch a := arg.(type) {
+	case string:
+		return a, nil, true
+	case []interface{}:
+		if len(a) == 2 {
+			str, ok := a[0].(string)
+			variables, _ := a[1].(map[string]interface{})
+			return str, variables, ok
+		}
+	}
+	return "", nil, false
+}
+
+type subPart struct {
+	text     string
+	variable bool
+}
+
+// splitSub splits the string of Fn::Sub into text and variables, ${!Literal} is text
+func splitSub(str string) []subPart {
+	parts := []subPart{}
+	for {
+		start := strings.Index(str, "${")
+		if start < 0 {
+			break
+		}
+		end := strings.Index(str[start:], "}")
+		if end < 0 {
+			break
+		}
+		name := str[start+2 : start+end]
+		if strings.HasPrefix(name, "!") {
+			parts = append(parts, subPart{text: str[:start] + "${" + name[1:] + "}"})
+		} else {
+			parts = append(parts, subPart{text: str[:start]}, subPart{text: name, variable: true})
+		}
+		str = str[start+end+1:]
+	}
+	return append(parts, subPart{text: str})
+}
+
+// literal evaluates a value to a string, resolving references to resources to their physical IDs, it's false
+// when the value can't be evaluated without deploying the stack
+func (t *Translator) literal(s *stack, value interface{}) (string, bool) {
+	if str, ok := value.(string); ok {
+		return str, true
+	}
+	fn, arg, ok := intrinsic(value)
+	if !ok {
+		return "", false
+	}
+	switch fn {
+	case "Ref":
+		name, _ := arg.(string)
+		if name == "AWS::StackName" {
+			return s.name, true
+		}
+		if v, ok := s.parameters[name]; ok {
+			return v, true
+		}
+		if id, ok := s.physicalIDs[name]; ok && id != "" {
+			return id, true
+		}
+	case "Fn::Sub":
+		str, variables, ok := subArgs(arg)
+		if !ok {
+			return "", false
+		}
+		var b strings.Builder
+		for _, part := range splitSub(str) {
+			if !part.variable {
+				b.WriteString(part.text)
+				continue
+			}
+			v, ok := variables[part.text]
+			if !ok {
+				v = map[string]interface{}{"Ref": part.text}
+			}
+			literal, ok := t.literal(s, v)
+			if !ok {
+				return "", false
+			}
+			b.WriteString(literal)
+		}
+		return b.String(), true
+	case "Fn::Join":
+		args, _ := arg.([]interface{})
+		if len(args) != 2 {
+			return "", false
+		}
+		delimiter, ok := args[0].(string)
+		values, isList := args[1].([]interface{})
+		if !ok || !isList {
+			return "", false
+		}
+		literals := []string{}
+		for _, v := range values {
+			literal, ok := t.literal(s, v)
+			if !ok {
+				return "", false
+			}
+			literals = append(literals, literal)
+		}
+		return strings.Join(literals, delimiter), true
+	case "Fn::ImportValue":
+		name, ok := t.literal(s, arg)
+		if !ok {
+			return "", false
+		}
+		if e, ok := t.exports[name]; ok {
+			return t.literal(e.stack, e.value)
+		}
+	}
+	return "", false
+}
+
+func sortedStringKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
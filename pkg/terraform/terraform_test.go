@@ -0,0 +1,265 @@
+package terraform_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/cloudformation"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/weaveworks/eksctl/pkg/cfn/manager"
+	. "github.com/weaveworks/eksctl/pkg/terraform"
+)
+
+const clusterTemplate = `{
+  "Resources": {
+    "VPC": {
+      "Type": "AWS::EC2::VPC",
+      "Properties": {
+        "CidrBlock": "192.168.0.0/16",
+        "EnableDnsSupport": true,
+        "Tags": [{"Key": "Name", "Value": {"Fn::Sub": "${AWS::StackName}/VPC"}}]
+      }
+    },
+    "SubnetPublicUSWEST2A": {
+      "Type": "AWS::EC2::Subnet",
+      "Properties": {
+        "AvailabilityZone": "us-west-2a",
+        "CidrBlock": "192.168.0.0/19",
+        "VpcId": {"Ref": "VPC"},
+        "Tags": [{"Key": "kubernetes.io/role/elb", "Value": "1"}]
+      }
+    },
+    "InternetGateway": {"Type": "AWS::EC2::InternetGateway"},
+    "VPCGatewayAttachment": {
+      "Type": "AWS::EC2::VPCGatewayAttachment",
+      "Properties": {"InternetGatewayId": {"Ref": "InternetGateway"}, "VpcId": {"Ref": "VPC"}}
+    },
+    "PublicRouteTable": {"Type": "AWS::EC2::RouteTable", "Properties": {"VpcId": {"Ref": "VPC"}}},
+    "PublicSubnetRoute": {
+      "Type": "AWS::EC2::Route",
+      "Properties": {
+        "RouteTableId": {"Ref": "PublicRouteTable"},
+        "DestinationCidrBlock": "0.0.0.0/0",
+        "GatewayId": {"Ref": "InternetGateway"}
+      },
+      "DependsOn": ["VPCGatewayAttachment"]
+    },
+    "NATGateway": {"Type": "AWS::EC2::NatGateway", "Properties": {"SubnetId": {"Ref": "SubnetPublicUSWEST2A"}}},
+    "ControlPlaneSecurityGroup": {
+      "Type": "AWS::EC2::SecurityGroup",
+      "Properties": {
+        "GroupDescription": "Communication between the control plane and worker nodegroups",
+        "VpcId": {"Ref": "VPC"},
+        "SecurityGroupIngress": [{"IpProtocol": "tcp", "FromPort": 443, "ToPort": 443, "CidrIp": "10.0.0.0/8"}]
+      }
+    },
+    "ControlPlane": {
+      "Type": "AWS::EKS::Cluster",
+      "Properties": {
+        "Name": "test",
+        "RoleArn": {"Fn::GetAtt": ["ServiceRole", "Arn"]},
+        "Version": "1.30",
+        "ResourcesVpcConfig": {
+          "SecurityGroupIds": [{"Ref": "ControlPlaneSecurityGroup"}],
+          "SubnetIds": [{"Ref": "SubnetPublicUSWEST2A"}]
+        }
+      }
+    },
+    "ServiceRole": {
+      "Type": "AWS::IAM::Role",
+      "Properties": {
+        "AssumeRolePolicyDocument": {
+          "Version": "2012-10-17",
+          "Statement": [{"Action": ["sts:AssumeRole"], "Effect": "Allow", "Principal": {"Service": ["eks.amazonaws.com"]}}]
+        },
+        "ManagedPolicyArns": [{"Fn::Sub": "arn:${AWS::Partition}:iam::aws:policy/AmazonEKSClusterPolicy"}],
+        "PermissionsBoundary": {"Fn::If": ["HasBoundary", "arn:aws:iam::111122223333:policy/boundary", {"Ref": "AWS::NoValue"}]}
+      }
+    },
+    "PolicyCloudWatchMetrics": {
+      "Type": "AWS::IAM::Policy",
+      "Properties": {
+        "PolicyName": {"Fn::Sub": "${AWS::StackName}-PolicyCloudWatchMetrics"},
+        "PolicyDocument": {"Version": "2012-10-17", "Statement": [{"Action": ["cloudwatch:PutMetricData"], "Effect": "Allow", "Resource": "*"}]},
+        "Roles": [{"Ref": "ServiceRole"}]
+      }
+    }
+  },
+  "Outputs": {
+    "VPC": {"Value": {"Ref": "VPC"}, "Export": {"Name": {"Fn::Sub": "${AWS::StackName}::VPC"}}},
+    "SecurityGroup": {
+      "Value": {"Fn::GetAtt": "ControlPlaneSecurityGroup.GroupId"},
+      "Export": {"Name": {"Fn::Sub": "${AWS::StackName}::SecurityGroup"}}
+    }
+  }
+}`
+
+const nodeGroupTemplate = `{
+  "Mappings": {
+    "ServicePrincipalPartitionMap": {"aws": {"EC2": "ec2.amazonaws.com"}}
+  },
+  "Resources": {
+    "NodeGroup": {
+      "Type": "AWS::AutoScaling::AutoScalingGroup",
+      "Properties": {
+        "LaunchTemplate": {
+          "LaunchTemplateName": {"Fn::Sub": "${AWS::StackName}"},
+          "Version": {"Fn::GetAtt": ["NodeGroupLaunchTemplate", "LatestVersionNumber"]}
+        },
+        "MinSize": "1",
+        "MaxSize": "2",
+        "VPCZoneIdentifier": {"Fn::Split": [",", {"Fn::ImportValue": "eksctl-test-cluster::SubnetsPublic"}]},
+        "Tags": [{"Key": "Name", "Value": "test-ng-1-Node", "PropagateAtLaunch": "true"}]
+      }
+    },
+    "NodeGroupLaunchTemplate": {
+      "Type": "AWS::EC2::LaunchTemplate",
+      "Properties": {
+        "LaunchTemplateName": {"Fn::Sub": "${AWS::StackName}"},
+        "LaunchTemplateData": {
+          "ImageId": "ami-0123456789",
+          "NetworkInterfaces": [{"DeviceIndex": 0, "Groups": [{"Fn::ImportValue": "eksctl-test-cluster::SecurityGroup"}]}],
+          "UserData": {"Fn::Base64": {"Fn::Sub": "#!/bin/bash\necho ${!HOME} ${AWS::Region}"}}
+        }
+      }
+    },
+    "NodeInstanceRole": {
+      "Type": "AWS::IAM::Role",
+      "Properties": {
+        "AssumeRolePolicyDocument": {
+          "Statement": [{
+            "Action": ["sts:AssumeRole"],
+            "Effect": "Allow",
+            "Principal": {"Service": [{"Fn::FindInMap": ["ServicePrincipalPartitionMap", {"Ref": "AWS::Partition"}, "EC2"]}]}
+          }]
+        }
+      }
+    },
+    "NodeInstanceProfile": {"Type": "AWS::IAM::InstanceProfile", "Properties": {"Roles": [{"Ref": "NodeInstanceRole"}]}},
+    "IngressInterSG": {
+      "Type": "AWS::EC2::SecurityGroupIngress",
+      "Properties": {
+        "GroupId": {"Fn::ImportValue": "eksctl-test-cluster::SecurityGroup"},
+        "IpProtocol": "tcp",
+        "FromPort": 443,
+        "ToPort": 443,
+        "SourceSecurityGroupId": "sg-0abc"
+      }
+    },
+    "BootstrapParameter": {"Type": "AWS::SSM::Parameter", "Properties": {"Type": "String", "Value": "bootstrap"}}
+  }
+}`
+
+func newStackInfo(name, template string, physicalIDs map[string]string) manager.StackInfo {
+	info := manager.StackInfo{
+		Stack:    &manager.Stack{StackName: aws.String(name)},
+		Template: aws.String(template),
+	}
+	for logicalID, physicalID := range physicalIDs {
+		info.Resources = append(info.Resources, &cloudformation.StackResource{
+			LogicalResourceId:  aws.String(logicalID),
+			PhysicalResourceId: aws.String(physicalID),
+		})
+	}
+	return info
+}
+
+var _ = Describe("Terraform export", func() {
+	var translator *Translator
+
+	BeforeEach(func() {
+		var err error
+		translator, err = NewTranslator("test", []manager.StackInfo{
+			newStackInfo("eksctl-test-nodegroup-ng-1", nodeGroupTemplate, map[string]string{
+				"NodeGroup":               "eksctl-test-nodegroup-ng-1-NodeGroup-XYZ",
+				"NodeGroupLaunchTemplate": "lt-0123",
+				"NodeInstanceRole":        "eksctl-test-nodegroup-ng-1-NodeInstanceRole-XYZ",
+				"NodeInstanceProfile":     "eksctl-test-nodegroup-ng-1-NodeInstanceProfile-XYZ",
+				"IngressInterSG":          "IngressInterSG",
+				"BootstrapParameter":      "bootstrap",
+			}),
+			newStackInfo("eksctl-test-cluster", clusterTemplate, map[string]string{
+				"VPC":                       "vpc-0123",
+				"SubnetPublicUSWEST2A":      "subnet-0123",
+				"InternetGateway":           "igw-0123",
+				"VPCGatewayAttachment":      "eksct-VPCGa-XYZ",
+				"PublicRouteTable":          "rtb-0123",
+				"PublicSubnetRoute":         "eksct-Publi-XYZ",
+				"ControlPlaneSecurityGroup": "sg-0123",
+				"ControlPlane":              "test",
+				"ServiceRole":               "eksctl-test-cluster-ServiceRole-XYZ",
+				"PolicyCloudWatchMetrics":   "eksct-Poli-XYZ",
+			}),
+		})
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("fails on templates that aren't JSON", func() {
+		_, err := NewTranslator("test", []manager.StackInfo{newStackInfo("eksctl-test-cluster", "Resources: {}", nil)})
+		Expect(err).To(MatchError(ContainSubstring(`parsing template of stack "eksctl-test-cluster"`)))
+	})
+
+	It("translates the resources of the stacks to HCL", func() {
+		hcl := translator.HCL()
+
+		Expect(hcl).To(ContainSubstring(`resource "aws_vpc" "cluster_vpc" {`))
+		Expect(hcl).To(ContainSubstring(`Name = "eksctl-test-cluster/VPC"`))
+		Expect(hcl).To(ContainSubstring(`resource "aws_subnet" "cluster_subnet_public_uswest2_a" {`))
+		Expect(hcl).To(ContainSubstring(`vpc_id = aws_vpc.cluster_vpc.id`))
+		Expect(hcl).To(ContainSubstring(`"kubernetes.io/role/elb" = "1"`))
+		Expect(hcl).To(ContainSubstring(`gateway_id = aws_internet_gateway.cluster_internet_gateway.id`))
+		Expect(hcl).To(ContainSubstring(`depends_on = [aws_internet_gateway_attachment.cluster_vpc_gateway_attachment]`))
+		Expect(hcl).NotTo(ContainSubstring("aws_nat_gateway"))
+
+		Expect(hcl).To(ContainSubstring(`description = "Communication between the control plane and worker nodegroups"`))
+		Expect(hcl).To(ContainSubstring("  ingress {\n    cidr_blocks = [\"10.0.0.0/8\"]\n    from_port = 443\n    protocol = \"tcp\"\n    to_port = 443\n  }\n"))
+
+		Expect(hcl).To(ContainSubstring(`role_arn = aws_iam_role.cluster_service_role.arn`))
+		Expect(hcl).To(ContainSubstring("  vpc_config {\n    security_group_ids = [aws_security_group.cluster_control_plane_security_group.id]\n"))
+		Expect(hcl).To(ContainSubstring("assume_role_policy = jsonencode({\n"))
+		Expect(hcl).To(ContainSubstring(`Service = ["eks.amazonaws.com"]`))
+		Expect(hcl).To(ContainSubstring(`managed_policy_arns = ["arn:${data.aws_partition.current.partition}:iam::aws:policy/AmazonEKSClusterPolicy"]`))
+		Expect(hcl).To(ContainSubstring(`permissions_boundary = null /* Fn::If is not translated */`))
+		Expect(hcl).To(ContainSubstring(`name = "eksctl-test-cluster-PolicyCloudWatchMetrics"`))
+		Expect(hcl).To(ContainSubstring(`role = aws_iam_role.cluster_service_role.name`))
+		Expect(hcl).To(ContainSubstring(`data "aws_partition" "current" {}`))
+	})
+
+	It("translates imports, mappings and intrinsic functions of nodegroups", func() {
+		hcl := translator.HCL()
+
+		Expect(hcl).To(ContainSubstring(`resource "aws_autoscaling_group" "nodegroup_ng_1_node_group" {`))
+		Expect(hcl).To(ContainSubstring("  launch_template {\n    name = \"eksctl-test-nodegroup-ng-1\"\n    version = aws_launch_template.nodegroup_ng_1_node_group_launch_template.latest_version\n  }\n"))
+		Expect(hcl).To(ContainSubstring("  tag {\n    key = \"Name\"\n    propagate_at_launch = \"true\"\n    value = \"test-ng-1-Node\"\n  }\n"))
+		Expect(hcl).To(ContainSubstring(`vpc_zone_identifier = split(",", data.aws_cloudformation_export.eksctl_test_cluster_subnets_public.value)`))
+		Expect(hcl).To(ContainSubstring("data \"aws_cloudformation_export\" \"eksctl_test_cluster_subnets_public\" {\n  name = \"eksctl-test-cluster::SubnetsPublic\"\n}"))
+
+		Expect(hcl).To(ContainSubstring(`image_id = "ami-0123456789"`))
+		Expect(hcl).To(ContainSubstring(`security_groups = [aws_security_group.cluster_control_plane_security_group.id]`))
+		Expect(hcl).To(ContainSubstring(`user_data = base64encode("#!/bin/bash\necho $${HOME} ${data.aws_region.current.name}")`))
+		Expect(hcl).To(ContainSubstring(`data "aws_region" "current" {}`))
+
+		Expect(hcl).To(ContainSubstring(`Service = [local.nodegroup_ng_1_service_principal_partition_map[data.aws_partition.current.partition]["EC2"]]`))
+		Expect(hcl).To(ContainSubstring("locals {\n  nodegroup_ng_1_service_principal_partition_map = {\n    aws = {\n      EC2 = \"ec2.amazonaws.com\"\n    }\n  }\n}"))
+		Expect(hcl).To(ContainSubstring(`role = aws_iam_role.nodegroup_ng_1_node_instance_role.name`))
+
+		Expect(hcl).To(ContainSubstring(`resource "aws_security_group_rule" "nodegroup_ng_1_ingress_inter_sg" {`))
+		Expect(hcl).To(ContainSubstring(`type = "ingress"`))
+		Expect(hcl).To(ContainSubstring(`security_group_id = aws_security_group.cluster_control_plane_security_group.id`))
+		Expect(hcl).To(ContainSubstring(`source_security_group_id = "sg-0abc"`))
+		Expect(hcl).To(ContainSubstring(`# BootstrapParameter (AWS::SSM::Parameter) has no Terraform equivalent`))
+	})
+
+	It("writes import blocks with the IDs that Terraform imports the resources by", func() {
+		imports := translator.Imports()
+
+		Expect(imports).To(ContainSubstring("import {\n  to = aws_vpc.cluster_vpc\n  id = \"vpc-0123\"\n}\n"))
+		Expect(imports).To(ContainSubstring("import {\n  to = aws_route.cluster_public_subnet_route\n  id = \"rtb-0123_0.0.0.0/0\"\n}\n"))
+		Expect(imports).To(ContainSubstring("import {\n  to = aws_internet_gateway_attachment.cluster_vpc_gateway_attachment\n  id = \"igw-0123:vpc-0123\"\n}\n"))
+		Expect(imports).To(ContainSubstring("import {\n  to = aws_iam_role_policy.cluster_policy_cloud_watch_metrics\n  id = \"eksctl-test-cluster-ServiceRole-XYZ:eksctl-test-cluster-PolicyCloudWatchMetrics\"\n}\n"))
+		Expect(imports).To(ContainSubstring("import {\n  to = aws_autoscaling_group.nodegroup_ng_1_node_group\n  id = \"eksctl-test-nodegroup-ng-1-NodeGroup-XYZ\"\n}\n"))
+		Expect(imports).To(ContainSubstring("# aws_security_group_rule.nodegroup_ng_1_ingress_inter_sg can't be imported by its ID, import it manually"))
+		Expect(imports).NotTo(ContainSubstring("aws_nat_gateway"))
+		Expect(imports).NotTo(ContainSubstring("bootstrap"))
+	})
+})
@@ -0,0 +1,176 @@
+package terraform
+
+// property describes how a CloudFormation property translates to Terraform
+type property struct {
+	// name of the Terraform argument
+	name string
+	// attribute renders objects as a map attribute rather than a nested block
+	attribute bool
+	// json encodes the value with jsonencode, e.g. for IAM policy documents
+	json bool
+	// first takes the first element of a list, e.g. the role of an instance profile
+	first bool
+	// list wraps a single value in a list, e.g. the CIDR of a security group rule
+	list bool
+}
+
+// resourceType describes how a CloudFormation resource type translates to a Terraform resource
+type resourceType struct {
+	name string
+	// ref is the attribute that a Ref resolves to, defaults to id
+	ref string
+	// attributes are the Terraform attributes of Fn::GetAtt, defaults to the attribute in snake case
+	attributes map[string]string
+	// properties are looked up by "Parent.Property", then "Property", defaults to the property in snake case,
+	// objects and lists of objects become nested blocks
+	properties map[string]property
+	// flatten is a property whose properties are those of the Terraform resource
+	flatten string
+	// static are arguments that don't have a property, e.g. the type of a security group rule
+	static map[string]string
+}
+
+var securityGroupRuleProperties = map[string]property{
+	"GroupId":                    {name: "security_group_id"},
+	"IpProtocol":                 {name: "protocol"},
+	"CidrIp":                     {name: "cidr_blocks", list: true},
+	"CidrIpv6":                   {name: "ipv6_cidr_blocks", list: true},
+	"SourceSecurityGroupId":      {name: "source_security_group_id"},
+	"DestinationSecurityGroupId": {name: "source_security_group_id"},
+}
+
+// resourceTypes are the CloudFormation resource types that eksctl creates and their Terraform resources,
+// resources of other types are only listed as comments
+var resourceTypes = map[string]*resourceType{
+	"AWS::EC2::VPC":                         {name: "aws_vpc"},
+	"AWS::EC2::Subnet":                      {name: "aws_subnet"},
+	"AWS::EC2::InternetGateway":             {name: "aws_internet_gateway"},
+	"AWS::EC2::EgressOnlyInternetGateway":   {name: "aws_egress_only_internet_gateway"},
+	"AWS::EC2::VPCGatewayAttachment":        {name: "aws_internet_gateway_attachment"},
+	"AWS::EC2::RouteTable":                  {name: "aws_route_table"},
+	"AWS::EC2::Route":                       {name: "aws_route"},
+	"AWS::EC2::SubnetRouteTableAssociation": {name: "aws_route_table_association"},
+	"AWS::EC2::EIP":                         {name: "aws_eip"},
+	"AWS::EC2::NatGateway":                  {name: "aws_nat_gateway"},
+	"AWS::EC2::SecurityGroup": {
+		name:       "aws_security_group",
+		attributes: map[string]string{"GroupId": "id"},
+		properties: map[string]property{
+			"GroupName":             {name: "name"},
+			"GroupDescription":      {name: "description"},
+			"SecurityGroupIngress":  {name: "ingress"},
+			"SecurityGroupEgress":   {name: "egress"},
+			"IpProtocol":            {name: "protocol"},
+			"CidrIp":                {name: "cidr_blocks", list: true},
+			"CidrIpv6":              {name: "ipv6_cidr_blocks", list: true},
+			"SourceSecurityGroupId": {name: "security_groups", list: true},
+		},
+	},
+	"AWS::EC2::SecurityGroupIngress": {
+		name:       "aws_security_group_rule",
+		properties: securityGroupRuleProperties,
+		static:     map[string]string{"type": `"ingress"`},
+	},
+	"AWS::EC2::SecurityGroupEgress": {
+		name:       "aws_security_group_rule",
+		properties: securityGroupRuleProperties,
+		static:     map[string]string{"type": `"egress"`},
+	},
+	"AWS::EC2::LaunchTemplate": {
+		name:       "aws_launch_template",
+		attributes: map[string]string{"LatestVersionNumber": "latest_version", "DefaultVersionNumber": "default_version"},
+		flatten:    "LaunchTemplateData",
+		properties: map[string]property{
+			"LaunchTemplateName":       {name: "name"},
+			"SecurityGroupIds":         {name: "vpc_security_group_ids"},
+			"NetworkInterfaces.Groups": {name: "security_groups"},
+		},
+	},
+	"AWS::AutoScaling::AutoScalingGroup": {
+		name: "aws_autoscaling_group",
+		ref:  "name",
+		properties: map[string]property{
+			"AutoScalingGroupName":              {name: "name"},
+			"LaunchTemplate.LaunchTemplateName": {name: "name"},
+			"LaunchTemplate.LaunchTemplateId":   {name: "id"},
+			"Overrides":                         {name: "override"},
+			"Tags":                              {name: "tag"},
+		},
+	},
+	"AWS::EKS::Cluster": {
+		name: "aws_eks_cluster",
+		ref:  "name",
+		attributes: map[string]string{
+			"CertificateAuthorityData": "certificate_authority[0].data",
+			"ClusterSecurityGroupId":   "vpc_config[0].cluster_security_group_id",
+			"OpenIdConnectIssuerUrl":   "identity[0].oidc[0].issuer",
+		},
+		properties: map[string]property{
+			"ResourcesVpcConfig": {name: "vpc_config"},
+		},
+	},
+	"AWS::EKS::Nodegroup": {
+		name: "aws_eks_node_group",
+		properties: map[string]property{
+			"NodegroupName":        {name: "node_group_name"},
+			"NodeRole":             {name: "node_role_arn"},
+			"Subnets":              {name: "subnet_ids"},
+			"Labels":               {name: "labels", attribute: true},
+			"Taints":               {name: "taint"},
+			"SourceSecurityGroups": {name: "source_security_group_ids"},
+		},
+	},
+	"AWS::IAM::Role": {
+		name:       "aws_iam_role",
+		ref:        "name",
+		attributes: map[string]string{"RoleId": "unique_id"},
+		properties: map[string]property{
+			"RoleName":                 {name: "name"},
+			"AssumeRolePolicyDocument": {name: "assume_role_policy", json: true},
+			"Policies":                 {name: "inline_policy"},
+			"PolicyName":               {name: "name"},
+			"PolicyDocument":           {name: "policy", json: true},
+		},
+	},
+	"AWS::IAM::Policy": {
+		name: "aws_iam_role_policy",
+		properties: map[string]property{
+			"PolicyName":     {name: "name"},
+			"PolicyDocument": {name: "policy", json: true},
+			"Roles":          {name: "role", first: true},
+		},
+	},
+	"AWS::IAM::InstanceProfile": {
+		name: "aws_iam_instance_profile",
+		ref:  "name",
+		properties: map[string]property{
+			"InstanceProfileName": {name: "name"},
+			"Roles":               {name: "role", first: true},
+		},
+	},
+}
+
+// property returns how the property translates, and whether it's translated differently from the default
+func (rt *resourceType) property(parent, key string) (property, bool) {
+	if p, ok := rt.properties[parent+"."+key]; ok {
+		return p, true
+	}
+	if p, ok := rt.properties[key]; ok {
+		return p, true
+	}
+	return property{name: snakeCase(key)}, false
+}
+
+func (rt *resourceType) refAttribute() string {
+	if rt.ref != "" {
+		return rt.ref
+	}
+	return "id"
+}
+
+func (rt *resourceType) attribute(name string) string {
+	if attribute, ok := rt.attributes[name]; ok {
+		return attribute
+	}
+	return snakeCase(name)
+}
@@ -4,13 +4,20 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/autoscaling/autoscalingiface"
 	"github.com/aws/aws-sdk-go/service/cloudformation/cloudformationiface"
 	"github.com/aws/aws-sdk-go/service/cloudtrail/cloudtrailiface"
+	"github.com/aws/aws-sdk-go/service/cloudwatchlogs/cloudwatchlogsiface"
 	"github.com/aws/aws-sdk-go/service/ec2/ec2iface"
 	"github.com/aws/aws-sdk-go/service/eks/eksiface"
 	"github.com/aws/aws-sdk-go/service/elb/elbiface"
 	"github.com/aws/aws-sdk-go/service/elbv2/elbv2iface"
+	"github.com/aws/aws-sdk-go/service/eventbridge/eventbridgeiface"
 	"github.com/aws/aws-sdk-go/service/iam/iamiface"
+	"github.com/aws/aws-sdk-go/service/pricing/pricingiface"
+	"github.com/aws/aws-sdk-go/service/s3/s3iface"
+	"github.com/aws/aws-sdk-go/service/servicequotas/servicequotasiface"
+	"github.com/aws/aws-sdk-go/service/ssm/ssmiface"
 	"github.com/aws/aws-sdk-go/service/sts/stsiface"
 
 	//"github.com/aws/aws-sdk-go/aws/awserr"
@@ -55,7 +62,16 @@ type MockProvider struct {
 	elbv2      *mocks.ELBV2API
 	sts        *mocks.STSAPI
 	iam        *mocks.IAMAPI
+	asg        *mocks.AutoScalingAPI
 	cloudtrail *mocks.CloudTrailAPI
+	ssm        *mocks.SSMAPI
+
+	servicequotas *mocks.ServiceQuotasAPI
+	pricing       *mocks.PricingAPI
+	s3            *mocks.S3API
+
+	cloudwatchlogs *mocks.CloudWatchLogsAPI
+	eventbridge    *mocks.EventBridgeAPI
 }
 
 // NewMockProvider returns a new MockProvider
@@ -70,7 +86,16 @@ func NewMockProvider() *MockProvider {
 		elbv2:      &mocks.ELBV2API{},
 		sts:        &mocks.STSAPI{},
 		iam:        &mocks.IAMAPI{},
+		asg:        &mocks.AutoScalingAPI{},
 		cloudtrail: &mocks.CloudTrailAPI{},
+		ssm:        &mocks.SSMAPI{},
+
+		servicequotas: &mocks.ServiceQuotasAPI{},
+		pricing:       &mocks.PricingAPI{},
+		s3:            &mocks.S3API{},
+
+		cloudwatchlogs: &mocks.CloudWatchLogsAPI{},
+		eventbridge:    &mocks.EventBridgeAPI{},
 	}
 }
 
@@ -115,6 +140,12 @@ func (m MockProvider) IAM() iamiface.IAMAPI { return m.iam }
 // MockIAM returns a mocked IAM API
 func (m MockProvider) MockIAM() *mocks.IAMAPI { return m.IAM().(*mocks.IAMAPI) }
 
+// ASG returns a representation of the AutoScaling API
+func (m MockProvider) ASG() autoscalingiface.AutoScalingAPI { return m.asg }
+
+// MockASG returns a mocked AutoScaling API
+func (m MockProvider) MockASG() *mocks.AutoScalingAPI { return m.ASG().(*mocks.AutoScalingAPI) }
+
 // CloudTrail returns a representation of the CloudTrail API
 func (m MockProvider) CloudTrail() cloudtrailiface.CloudTrailAPI { return m.cloudtrail }
 
@@ -123,6 +154,52 @@ func (m MockProvider) MockCloudTrail() *mocks.CloudTrailAPI {
 	return m.CloudTrail().(*mocks.CloudTrailAPI)
 }
 
+// SSM returns a representation of the SSM API
+func (m MockProvider) SSM() ssmiface.SSMAPI { return m.ssm }
+
+// MockSSM returns a mocked SSM API
+func (m MockProvider) MockSSM() *mocks.SSMAPI { return m.SSM().(*mocks.SSMAPI) }
+
+// ServiceQuotas returns a representation of the Service Quotas API
+func (m MockProvider) ServiceQuotas() servicequotasiface.ServiceQuotasAPI { return m.servicequotas }
+
+// MockServiceQuotas returns a mocked Service Quotas API
+func (m MockProvider) MockServiceQuotas() *mocks.ServiceQuotasAPI {
+	return m.ServiceQuotas().(*mocks.ServiceQuotasAPI)
+}
+
+// Pricing returns a representation of the Price List API
+func (m MockProvider) Pricing() pricingiface.PricingAPI { return m.pricing }
+
+// MockPricing returns a mocked Price List API
+func (m MockProvider) MockPricing() *mocks.PricingAPI {
+	return m.Pricing().(*mocks.PricingAPI)
+}
+
+// S3 returns a representation of the S3 API
+func (m MockProvider) S3() s3iface.S3API { return m.s3 }
+
+// MockS3 returns a mocked S3 API
+func (m MockProvider) MockS3() *mocks.S3API {
+	return m.S3().(*mocks.S3API)
+}
+
+// CloudWatchLogs returns a representation of the CloudWatch Logs API
+func (m MockProvider) CloudWatchLogs() cloudwatchlogsiface.CloudWatchLogsAPI { return m.cloudwatchlogs }
+
+// MockCloudWatchLogs returns a mocked CloudWatch Logs API
+func (m MockProvider) MockCloudWatchLogs() *mocks.CloudWatchLogsAPI {
+	return m.CloudWatchLogs().(*mocks.CloudWatchLogsAPI)
+}
+
+// EventBridge returns a representation of the EventBridge API
+func (m MockProvider) EventBridge() eventbridgeiface.EventBridgeAPI { return m.eventbridge }
+
+// MockEventBridge returns a mocked EventBridge API
+func (m MockProvider) MockEventBridge() *mocks.EventBridgeAPI {
+	return m.EventBridge().(*mocks.EventBridgeAPI)
+}
+
 // Profile returns current profile setting
 func (m MockProvider) Profile() string { return ProviderConfig.Profile }
 
@@ -0,0 +1,124 @@
+package httpclient
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/utils/file"
+)
+
+// caBundleEnvVar is the environment variable of the AWS CLI and SDKs that sets the CA bundle,
+// it's used when --ca-bundle isn't set
+const caBundleEnvVar = "AWS_CA_BUNDLE"
+
+var (
+	caBundle      []byte
+	caBundleCerts []*x509.Certificate
+)
+
+// LoadCABundle loads a PEM bundle of CAs that are trusted along with those of the system, e.g. the CA of
+// a TLS-intercepting proxy; the bundle is read from path or, when it's empty, from AWS_CA_BUNDLE
+func LoadCABundle(path string) error {
+	caBundle, caBundleCerts = nil, nil
+	if path == "" {
+		path = os.Getenv(caBundleEnvVar)
+	}
+	if path == "" {
+		return nil
+	}
+	data, err := ioutil.ReadFile(file.ExpandPath(path))
+	if err != nil {
+		return errors.Wrapf(err, "reading CA bundle %q", path)
+	}
+	certs, err := parseCertificates(data)
+	if err != nil {
+		return errors.Wrapf(err, "parsing CA bundle %q", path)
+	}
+	caBundle, caBundleCerts = data, certs
+	return nil
+}
+
+// CABundle returns the loaded CA bundle, or nil when none was loaded
+func CABundle() []byte {
+	return caBundle
+}
+
+// SignedByCABundle returns true if cert is one of the CAs of the bundle or is signed by one of them, e.g.
+// when a TLS-intercepting proxy presented it instead of the certificate of the server
+func SignedByCABundle(cert *x509.Certificate) bool {
+	for _, ca := range caBundleCerts {
+		if cert.Equal(ca) || cert.CheckSignatureFrom(ca) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// NewTransport returns a transport that connects through the proxy of HTTPS_PROXY and HTTP_PROXY, unless
+// NO_PROXY excludes the host, and that trusts the CAs of the system and of the bundle
+func NewTransport() *http.Transport {
+	// the same settings as http.DefaultTransport
+	transport := &http.Transport{
+		Proxy: http.ProxyFromEnvironment,
+		DialContext: (&net.Dialer{
+			Timeout:   30 * time.Second,
+			KeepAlive: 30 * time.Second,
+		}).DialContext,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ExpectContinueTimeout: 1 * time.Second,
+		TLSClientConfig:       &tls.Config{},
+	}
+	if len(caBundleCerts) > 0 {
+		pool, err := x509.SystemCertPool()
+		if err != nil || pool == nil {
+			pool = x509.NewCertPool()
+		}
+		for _, cert := range caBundleCerts {
+			pool.AddCert(cert)
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+	return transport
+}
+
+// NewClient returns a client with the transport of NewTransport
+func NewClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport: NewTransport(),
+		Timeout:   timeout,
+	}
+}
+
+func parseCertificates(data []byte) ([]*x509.Certificate, error) {
+	certs := []*x509.Certificate{}
+	for {
+		var block *pem.Block
+		block, data = pem.Decode(data)
+		if block == nil {
+			break
+		}
+		if block.Type != "CERTIFICATE" {
+			continue
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	if len(certs) == 0 {
+		return nil, fmt.Errorf("no PEM certificates found")
+	}
+	return certs, nil
+}
@@ -0,0 +1,99 @@
+package httpclient
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func newCertificate(name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  parent == nil,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageDigitalSignature,
+	}
+	if parent == nil {
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	Expect(err).NotTo(HaveOccurred())
+	cert, err := x509.ParseCertificate(der)
+	Expect(err).NotTo(HaveOccurred())
+	return cert, key
+}
+
+var _ = Describe("HTTP clients", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "httpclient")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		caBundle, caBundleCerts = nil, nil
+		os.Unsetenv(caBundleEnvVar)
+		Expect(os.RemoveAll(dir)).To(Succeed())
+	})
+
+	writeBundle := func(certs ...*x509.Certificate) string {
+		data := []byte{}
+		for _, cert := range certs {
+			data = append(data, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: cert.Raw})...)
+		}
+		path := filepath.Join(dir, "ca.pem")
+		Expect(ioutil.WriteFile(path, data, 0600)).To(Succeed())
+		return path
+	}
+
+	It("only trusts the CAs of the system without a bundle", func() {
+		Expect(LoadCABundle("")).To(Succeed())
+		Expect(CABundle()).To(BeNil())
+		Expect(NewTransport().TLSClientConfig.RootCAs).To(BeNil())
+		Expect(NewTransport().Proxy).NotTo(BeNil())
+	})
+
+	It("trusts the CAs of the bundle and finds certificates they signed", func() {
+		proxyCA, proxyKey := newCertificate("proxy", nil, nil)
+		otherCA, _ := newCertificate("other", nil, nil)
+		intercepted, _ := newCertificate("oidc.eks.us-west-2.amazonaws.com", proxyCA, proxyKey)
+
+		Expect(LoadCABundle(writeBundle(proxyCA))).To(Succeed())
+		Expect(CABundle()).NotTo(BeEmpty())
+		Expect(NewTransport().TLSClientConfig.RootCAs).NotTo(BeNil())
+		Expect(SignedByCABundle(proxyCA)).To(BeTrue())
+		Expect(SignedByCABundle(intercepted)).To(BeTrue())
+		Expect(SignedByCABundle(otherCA)).To(BeFalse())
+	})
+
+	It("reads the bundle of AWS_CA_BUNDLE when no path is given", func() {
+		ca, _ := newCertificate("proxy", nil, nil)
+		os.Setenv(caBundleEnvVar, writeBundle(ca))
+		Expect(LoadCABundle("")).To(Succeed())
+		Expect(SignedByCABundle(ca)).To(BeTrue())
+	})
+
+	It("fails when the bundle has no certificates", func() {
+		path := writeBundle()
+		Expect(LoadCABundle(path)).To(MatchError(ContainSubstring("no PEM certificates found")))
+		Expect(LoadCABundle(filepath.Join(dir, "missing.pem"))).To(MatchError(HavePrefix("reading CA bundle")))
+	})
+})
@@ -1,16 +1,19 @@
 package kubeconfig
 
 import (
+	"bytes"
 	"fmt"
 	"os"
 	"path"
 	"strings"
+	"sync"
+	"text/template"
 
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/file"
 
 	"os/exec"
 
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"k8s.io/client-go/tools/clientcmd"
@@ -20,6 +23,8 @@ import (
 // DefaultPath defines the default path
 var DefaultPath = clientcmd.RecommendedHomeFile
 
+var writeMutex sync.Mutex
+
 const (
 	// AWSIAMAuthenticator defines the name of the AWS IAM authenticator
 	AWSIAMAuthenticator = "aws-iam-authenticator"
@@ -72,28 +77,134 @@ func New(spec *api.ClusterConfig, username, certificateAuthorityPath string) (*c
 	return c, clusterName, contextName
 }
 
-// NewForKubectl creates configuration for kubectl using a suitable authenticator
-func NewForKubectl(spec *api.ClusterConfig, username, roleARN, profile string) *clientcmdapi.Config {
+// ExecOptions holds the options of the exec plugin that kubectl obtains tokens with,
+// and of the context that is written
+type ExecOptions struct {
+	// Authenticator is the command of the exec plugin, a suitable one is looked up when it's empty
+	Authenticator string
+	// RoleARN is the IAM role that the authenticator assumes
+	RoleARN string
+	// SessionName is the name of the session of the assumed role, only aws-iam-authenticator supports it
+	SessionName string
+	// Profile sets AWS_PROFILE for the authenticator
+	Profile string
+	// Env are set for the authenticator, either as NAME=VALUE or as NAME to pass the current value through
+	Env []string
+	// ContextName is a template of the name of the context, e.g. "{{.ClusterName}}-{{.Region}}",
+	// the default is "<username>@<cluster>.<region>.eksctl.io"
+	ContextName string
+}
+
+// contextNameParams are the fields that can be used in the template of the context name
+type contextNameParams struct {
+	ClusterName string
+	Region      string
+	Username    string
+}
+
+// Validate checks that the authenticator is known and supports the given options
+func (o ExecOptions) Validate() error {
+	switch o.Authenticator {
+	case "", AWSIAMAuthenticator, HeptioAuthenticatorAWS:
+	case AWSEKSAuthenticator:
+		if o.SessionName != "" {
+			return fmt.Errorf("session name of the assumed role is only supported by %s", AWSIAMAuthenticator)
+		}
+	default:
+		return fmt.Errorf("authenticator must be one of %v, got %q", AuthenticatorCommands(), o.Authenticator)
+	}
+	if o.SessionName != "" && o.RoleARN == "" {
+		return fmt.Errorf("session name can only be set along with the role to assume")
+	}
+	for _, env := range o.Env {
+		if strings.HasPrefix(env, "=") || env == "" {
+			return fmt.Errorf("invalid environment variable %q for authenticator, must be NAME=VALUE or NAME", env)
+		}
+	}
+	if _, err := template.New("context").Parse(o.ContextName); err != nil {
+		return errors.Wrap(err, "parsing template of context name")
+	}
+	return nil
+}
+
+// NewForKubectl creates configuration for kubectl using a suitable authenticator,
+// unless one is given in the options
+func NewForKubectl(spec *api.ClusterConfig, username string, opts ExecOptions) (*clientcmdapi.Config, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
 	config, _, _ := New(spec, username, "")
-	authenticator, found := LookupAuthenticator()
-	if !found {
-		// fall back to aws-iam-authenticator
-		authenticator = AWSIAMAuthenticator
+	if opts.ContextName != "" {
+		contextName, err := renderContextName(opts.ContextName, contextNameParams{
+			ClusterName: spec.Metadata.Name,
+			Region:      spec.Metadata.Region,
+			Username:    username,
+		})
+		if err != nil {
+			return nil, err
+		}
+		renameContext(config, contextName)
+	}
+	if opts.Authenticator == "" {
+		authenticator, found := LookupAuthenticator()
+		if !found {
+			// fall back to aws-iam-authenticator
+			authenticator = AWSIAMAuthenticator
+		}
+		opts.Authenticator = authenticator
+		if err := opts.Validate(); err != nil {
+			return nil, errors.Wrapf(err, "using %s found in PATH", authenticator)
+		}
+	}
+	appendExecConfig(config, spec, opts)
+	return config, nil
+}
+
+func renderContextName(nameTemplate string, params contextNameParams) (string, error) {
+	tmpl, err := template.New("context").Option("missingkey=error").Parse(nameTemplate)
+	if err != nil {
+		return "", errors.Wrap(err, "parsing template of context name")
 	}
-	AppendAuthenticator(config, spec, authenticator, roleARN, profile)
-	return config
+	name := &strings.Builder{}
+	if err := tmpl.Execute(name, params); err != nil {
+		return "", errors.Wrap(err, "rendering context name")
+	}
+	if name.Len() == 0 {
+		return "", fmt.Errorf("context name %q is empty once rendered", nameTemplate)
+	}
+	return name.String(), nil
+}
+
+// renameContext renames the current context of the config, along with its user
+func renameContext(config *clientcmdapi.Config, name string) {
+	context := config.Contexts[config.CurrentContext]
+	authInfo := config.AuthInfos[config.CurrentContext]
+	delete(config.Contexts, config.CurrentContext)
+	delete(config.AuthInfos, config.CurrentContext)
+	context.AuthInfo = name
+	config.Contexts[name] = context
+	config.AuthInfos[name] = authInfo
+	config.CurrentContext = name
 }
 
 // AppendAuthenticator appends the AWS IAM  authenticator, and
 // if profile is non-empty string it sets AWS_PROFILE environment
 // variable also
 func AppendAuthenticator(config *clientcmdapi.Config, spec *api.ClusterConfig, authenticatorCMD, roleARN, profile string) {
+	appendExecConfig(config, spec, ExecOptions{
+		Authenticator: authenticatorCMD,
+		RoleARN:       roleARN,
+		Profile:       profile,
+	})
+}
+
+func appendExecConfig(config *clientcmdapi.Config, spec *api.ClusterConfig, opts ExecOptions) {
 	var (
 		args        []string
 		roleARNFlag string
 	)
 
-	switch authenticatorCMD {
+	switch opts.Authenticator {
 	case AWSIAMAuthenticator, HeptioAuthenticatorAWS:
 		args = []string{"token", "-i", spec.Metadata.Name}
 		roleARNFlag = "-r"
@@ -104,24 +215,36 @@ func AppendAuthenticator(config *clientcmdapi.Config, spec *api.ClusterConfig, a
 			args = append(args, "--region", spec.Metadata.Region)
 		}
 	}
-	if roleARN != "" {
-		args = append(args, roleARNFlag, roleARN)
+	if opts.RoleARN != "" {
+		args = append(args, roleARNFlag, opts.RoleARN)
+	}
+	if opts.SessionName != "" {
+		args = append(args, "--session-name", opts.SessionName)
 	}
 
 	execConfig := &clientcmdapi.ExecConfig{
 		APIVersion: "client.authentication.k8s.io/v1alpha1",
-		Command:    authenticatorCMD,
+		Command:    opts.Authenticator,
 		Args:       args,
 	}
 
-	if profile != "" {
+	if opts.Profile != "" {
 		execConfig.Env = []clientcmdapi.ExecEnvVar{
 			{
 				Name:  "AWS_PROFILE",
-				Value: profile,
+				Value: opts.Profile,
 			},
 		}
 	}
+	for _, env := range opts.Env {
+		if parts := strings.SplitN(env, "=", 2); len(parts) == 2 {
+			execConfig.Env = append(execConfig.Env, clientcmdapi.ExecEnvVar{Name: parts[0], Value: parts[1]})
+		} else if value, ok := os.LookupEnv(env); ok {
+			execConfig.Env = append(execConfig.Env, clientcmdapi.ExecEnvVar{Name: env, Value: value})
+		} else {
+			logger.Warning("environment variable %q is not set, it will not be passed to the authenticator", env)
+		}
+	}
 
 	config.AuthInfos[config.CurrentContext] = &clientcmdapi.AuthInfo{
 		Exec: execConfig,
@@ -133,6 +256,10 @@ func AppendAuthenticator(config *clientcmdapi.Config, spec *api.ClusterConfig, a
 // If file pointed to by path doesn't exist it will be created.
 // If the file already exists then the configuration will be merged with the existing file.
 func Write(path string, newConfig clientcmdapi.Config, setContext bool) (string, error) {
+	// clusters may be created in parallel, and each of them reads, merges and writes the file
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+
 	configAccess := getConfigAccess(path)
 
 	config, err := configAccess.GetStartingConfig()
@@ -207,6 +334,48 @@ func isValidConfig(p, name string) error {
 	return ctxFmtErr
 }
 
+// Refresh updates the endpoint and certificate authority of the cluster in the kubeconfig file at the given path,
+// in the entries written by eksctl as well as the ones named after the ARN of the cluster, e.g. by
+// 'aws eks update-kubeconfig'; it returns the number of entries that were updated
+func Refresh(path string, spec *api.ClusterConfig) (int, error) {
+	writeMutex.Lock()
+	defer writeMutex.Unlock()
+
+	config, err := clientcmd.LoadFromFile(path)
+	if err != nil {
+		return 0, errors.Wrapf(err, "reading kubeconfig file %q", path)
+	}
+
+	updated := refreshClusterInfo(config, spec)
+	if updated == 0 {
+		return 0, nil
+	}
+	if err := clientcmd.WriteToFile(*config, path); err != nil {
+		return 0, errors.Wrapf(err, "writing kubeconfig file %q", path)
+	}
+	return updated, nil
+}
+
+func refreshClusterInfo(existing *clientcmdapi.Config, spec *api.ClusterConfig) int {
+	updated := 0
+	for name, cluster := range existing.Clusters {
+		if name != spec.Metadata.String() && (spec.Status.ARN == "" || name != spec.Status.ARN) {
+			continue
+		}
+		if cluster.Server == spec.Status.Endpoint && bytes.Equal(cluster.CertificateAuthorityData, spec.Status.CertificateAuthorityData) {
+			logger.Debug("cluster %q is up to date in kubeconfig", name)
+			continue
+		}
+		cluster.Server = spec.Status.Endpoint
+		cluster.CertificateAuthorityData = spec.Status.CertificateAuthorityData
+		// the data is embedded from now on, a file would keep the stale certificate authority
+		cluster.CertificateAuthority = ""
+		logger.Debug("refreshed cluster %q in kubeconfig", name)
+		updated++
+	}
+	return updated
+}
+
 // MaybeDeleteConfig will delete the auto-generated kubeconfig, if it exists
 func MaybeDeleteConfig(meta *api.ClusterMeta) {
 	p := AutoPath(meta.Name)
@@ -291,4 +291,41 @@ var _ = Describe("Kubeconfig", func() {
 			Expect(configFileAsBytes).To(MatchYAML(twoClustersAsBytes), "Should not change")
 		})
 	})
+
+	Context("refresh", func() {
+		var twoClustersAsBytes []byte
+
+		BeforeEach(func() {
+			var err error
+			if twoClustersAsBytes, err = ioutil.ReadFile("testdata/two_clusters.golden"); err != nil {
+				GinkgoT().Fatalf("failed reading .golden: %v", err)
+			}
+			_, err = configFile.Write(twoClustersAsBytes)
+			Expect(err).To(BeNil())
+		})
+
+		It("updates the endpoint and certificate authority of the cluster only", func() {
+			cfg := eksctlapi.NewClusterConfig()
+			cfg.Metadata.Name = "cluster-one"
+			cfg.Metadata.Region = "us-west-2"
+			cfg.Status = &eksctlapi.ClusterStatus{
+				Endpoint:                 "https://84.sk1.us-west-2.eks.amazonaws.com",
+				CertificateAuthorityData: []byte("new-ca"),
+			}
+
+			updated, err := kubeconfig.Refresh(configFile.Name(), cfg)
+			Expect(err).To(BeNil())
+			Expect(updated).To(Equal(1))
+
+			config, err := clientcmd.LoadFromFile(configFile.Name())
+			Expect(err).To(BeNil())
+			Expect(config.Clusters["cluster-one.us-west-2.eksctl.io"].Server).To(Equal("https://84.sk1.us-west-2.eks.amazonaws.com"))
+			Expect(config.Clusters["cluster-one.us-west-2.eksctl.io"].CertificateAuthorityData).To(Equal([]byte("new-ca")))
+			Expect(config.Clusters["cluster-two.us-west-2.eksctl.io"].Server).To(Equal("https://21.sk1.us-west-2.eks.amazonaws.com"))
+
+			updated, err = kubeconfig.Refresh(configFile.Name(), cfg)
+			Expect(err).To(BeNil())
+			Expect(updated).To(Equal(0))
+		})
+	})
 })
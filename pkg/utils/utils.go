@@ -1,13 +1,17 @@
 package utils
 
 import (
+	"regexp"
 	"strings"
 )
 
+// gravitonInstanceFamily matches families such as m6g, c6gn or r6gd
+var gravitonInstanceFamily = regexp.MustCompile(`^[a-z]+[0-9]+g[a-z]*$`)
+
 // IsGPUInstanceType returns tru of the instance type is GPU
 // optimised.
 func IsGPUInstanceType(instanceType string) bool {
-	return strings.HasPrefix(instanceType, "p2") || strings.HasPrefix(instanceType, "p3") || strings.HasPrefix(instanceType, "g3") || strings.HasPrefix(instanceType, "g4")
+	return strings.HasPrefix(instanceType, "p2") || strings.HasPrefix(instanceType, "p3") || strings.HasPrefix(instanceType, "g3") || strings.HasPrefix(instanceType, "g4") || strings.HasPrefix(instanceType, "g5")
 }
 
 // HasGPUInstanceType returns true if it finds a gpu instance among the mixed instances
@@ -19,3 +23,49 @@ func HasGPUInstanceType(instanceTypes []string) bool {
 	}
 	return false
 }
+
+// efaInstanceTypes are the instance types that support Elastic Fabric Adapters
+var efaInstanceTypes = map[string]bool{
+	"c5n.9xlarge":   true,
+	"c5n.18xlarge":  true,
+	"c5n.metal":     true,
+	"c6gn.16xlarge": true,
+	"g4dn.8xlarge":  true,
+	"g4dn.12xlarge": true,
+	"g4dn.16xlarge": true,
+	"g4dn.metal":    true,
+	"i3en.12xlarge": true,
+	"i3en.24xlarge": true,
+	"i3en.metal":    true,
+	"inf1.24xlarge": true,
+	"m5dn.24xlarge": true,
+	"m5n.24xlarge":  true,
+	"m5zn.12xlarge": true,
+	"m5zn.metal":    true,
+	"p3dn.24xlarge": true,
+	"p4d.24xlarge":  true,
+	"r5dn.24xlarge": true,
+	"r5n.24xlarge":  true,
+}
+
+// IsEFAInstanceType returns true if the instance type supports Elastic Fabric Adapters
+func IsEFAInstanceType(instanceType string) bool {
+	return efaInstanceTypes[instanceType]
+}
+
+// IsARMInstanceType returns true if the instance type uses an
+// arm64 (AWS Graviton) processor
+func IsARMInstanceType(instanceType string) bool {
+	family := strings.Split(instanceType, ".")[0]
+	return family == "a1" || gravitonInstanceFamily.MatchString(family)
+}
+
+// HasARMInstanceType returns true if it finds an arm64 instance among the mixed instances
+func HasARMInstanceType(instanceTypes []string) bool {
+	for _, instanceType := range instanceTypes {
+		if IsARMInstanceType(instanceType) {
+			return true
+		}
+	}
+	return false
+}
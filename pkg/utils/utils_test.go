@@ -0,0 +1,37 @@
+package utils_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	. "github.com/weaveworks/eksctl/pkg/utils"
+)
+
+var _ = Describe("instance types", func() {
+	It("detects arm64 instance types", func() {
+		for _, instanceType := range []string{"a1.large", "m6g.medium", "c6gn.xlarge", "r6gd.2xlarge", "t4g.micro"} {
+			Expect(IsARMInstanceType(instanceType)).To(BeTrue(), instanceType)
+		}
+		for _, instanceType := range []string{"m5.large", "g4dn.xlarge", "p3.2xlarge", "t3a.small", "mixed"} {
+			Expect(IsARMInstanceType(instanceType)).To(BeFalse(), instanceType)
+		}
+	})
+
+	It("detects Graviton GPU instance types as both arm64 and GPU", func() {
+		Expect(IsARMInstanceType("g5g.xlarge")).To(BeTrue())
+		Expect(IsGPUInstanceType("g5g.xlarge")).To(BeTrue())
+		Expect(IsARMInstanceType("g5.xlarge")).To(BeFalse())
+		Expect(IsGPUInstanceType("g5.xlarge")).To(BeTrue())
+	})
+
+	It("detects arm64 instance types among mixed instances", func() {
+		Expect(HasARMInstanceType([]string{"m5.large", "m6g.large"})).To(BeTrue())
+		Expect(HasARMInstanceType([]string{"m5.large", "m5a.large"})).To(BeFalse())
+	})
+
+	It("detects instance types that support EFA", func() {
+		Expect(IsEFAInstanceType("p3dn.24xlarge")).To(BeTrue())
+		Expect(IsEFAInstanceType("c5n.18xlarge")).To(BeTrue())
+		Expect(IsEFAInstanceType("c5n.large")).To(BeFalse())
+	})
+})
@@ -8,24 +8,29 @@ import (
 	"time"
 
 	"github.com/aws/aws-sdk-go/aws/request"
-	"github.com/kris-nova/logger"
 	"github.com/pkg/errors"
+
+	"github.com/weaveworks/eksctl/pkg/logger"
 )
 
 // Wait for something with a name to reach status that is expressed by acceptors using newRequest
-// until we hit waitTimeout, on unexpected status troubleshoot will be called with the desired
-// status as an argument, so that it can find what migth have gone wrong
-func Wait(name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string)) error {
+// until we hit waitTimeout or ctx is cancelled, on unexpected status troubleshoot will be called
+// with the desired status as an argument, so that it can find what migth have gone wrong
+func Wait(ctx context.Context, name, msg string, acceptors []request.WaiterAcceptor, newRequest func() *request.Request, waitTimeout time.Duration, troubleshoot func(string)) error {
 	desiredStatus := fmt.Sprintf("%v", acceptors[0].Expected)
 	msg = fmt.Sprintf("%s to reach %q status", msg, desiredStatus)
 	name = strings.Join([]string{"wait", name, desiredStatus}, "_")
 
-	ctx, cancel := context.WithTimeout(context.Background(), waitTimeout)
+	ctx, cancel := context.WithTimeout(ctx, waitTimeout)
 	defer cancel()
 	startTime := time.Now()
 	w := makeWaiter(ctx, name, msg, acceptors, newRequest)
 	logger.Debug("start %s", msg)
 	if waitErr := w.WaitWithContext(ctx); waitErr != nil {
+		if ctx.Err() == context.Canceled {
+			// troubleshooting is pointless when the caller gave up
+			return errors.Wrap(waitErr, msg)
+		}
 		if troubleshoot != nil {
 			troubleshoot(desiredStatus)
 		}
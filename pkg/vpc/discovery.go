@@ -0,0 +1,159 @@
+package vpc
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// DiscoverSubnets imports subnets of the existing VPC that match the selectors of
+// vpc.subnetDiscovery, one subnet is used per AZ; it checks that enough AZs are covered
+// and that public subnets are routed to an internet gateway while private ones are not
+func DiscoverSubnets(provider api.ClusterProvider, spec *api.ClusterConfig) error {
+	selectors := spec.VPC.SubnetDiscovery.SubnetSelectors()
+	zones := map[api.SubnetTopology][]string{}
+
+	for _, topology := range api.SubnetTopologies() {
+		selector, ok := selectors[topology]
+		if !ok {
+			continue
+		}
+		subnets, err := findSubnets(provider, spec.VPC.ID, selector)
+		if err != nil {
+			return errors.Wrapf(err, "discovering %s subnets", strings.ToLower(string(topology)))
+		}
+		subnets = onePerAvailabilityZone(subnets)
+		if len(subnets) < api.MinRequiredSubnets {
+			return fmt.Errorf("found %s subnets in %d availability zone(s) of %s with tags %v, at least %d are required",
+				strings.ToLower(string(topology)), len(subnets), spec.VPC.ID, selector.Tags, api.MinRequiredSubnets)
+		}
+		for _, subnet := range subnets {
+			if err := checkRouting(provider, spec.VPC.ID, topology, subnet); err != nil {
+				return err
+			}
+			zones[topology] = append(zones[topology], *subnet.AvailabilityZone)
+		}
+		if err := ImportSubnets(provider, spec, topology, subnets); err != nil {
+			return err
+		}
+		logger.Info("discovered %s subnets %v", strings.ToLower(string(topology)), subnetIDs(subnets))
+	}
+
+	private, public := zones[api.SubnetTopologyPrivate], zones[api.SubnetTopologyPublic]
+	if len(private) > 0 && len(public) > 0 && strings.Join(private, ",") != strings.Join(public, ",") {
+		logger.Warning("private subnets are in %v and public subnets are in %v, nodes of some availability zones may not be reachable by load balancers", private, public)
+	}
+	return nil
+}
+
+func findSubnets(provider api.ClusterProvider, vpcID string, selector *api.SubnetSelector) ([]*ec2.Subnet, error) {
+	filters := []*ec2.Filter{{
+		Name:   aws.String("vpc-id"),
+		Values: aws.StringSlice([]string{vpcID}),
+	}}
+	for key, value := range selector.Tags {
+		if value == "" {
+			filters = append(filters, &ec2.Filter{
+				Name:   aws.String("tag-key"),
+				Values: aws.StringSlice([]string{key}),
+			})
+			continue
+		}
+		filters = append(filters, &ec2.Filter{
+			Name:   aws.String("tag:" + key),
+			Values: aws.StringSlice([]string{value}),
+		})
+	}
+
+	output, err := provider.EC2().DescribeSubnets(&ec2.DescribeSubnetsInput{Filters: filters})
+	if err != nil {
+		return nil, err
+	}
+	return output.Subnets, nil
+}
+
+// onePerAvailabilityZone picks a subnet with the lowest ID in each AZ, so that
+// the same subnets are picked every time, the result is sorted by AZ
+func onePerAvailabilityZone(subnets []*ec2.Subnet) []*ec2.Subnet {
+	byZone := map[string]*ec2.Subnet{}
+	for _, subnet := range subnets {
+		zone := *subnet.AvailabilityZone
+		if picked, ok := byZone[zone]; ok {
+			if *subnet.SubnetId > *picked.SubnetId {
+				logger.Warning("ignoring subnet %q, subnet %q is used in %s", *subnet.SubnetId, *picked.SubnetId, zone)
+				continue
+			}
+			logger.Warning("ignoring subnet %q, subnet %q is used in %s", *picked.SubnetId, *subnet.SubnetId, zone)
+		}
+		byZone[zone] = subnet
+	}
+
+	result := []*ec2.Subnet{}
+	for _, subnet := range byZone {
+		result = append(result, subnet)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		return *result[i].AvailabilityZone < *result[j].AvailabilityZone
+	})
+	return result
+}
+
+// checkRouting checks the default route of the route table of the subnet, or the main route
+// table of the VPC when the subnet has no explicit association
+func checkRouting(provider api.ClusterProvider, vpcID string, topology api.SubnetTopology, subnet *ec2.Subnet) error {
+	routeTable, err := findRouteTable(provider, vpcID, *subnet.SubnetId)
+	if err != nil {
+		return errors.Wrapf(err, "describing route table of subnet %q", *subnet.SubnetId)
+	}
+
+	viaInternetGateway := isInternetGatewayRoute(defaultRoute(routeTable))
+
+	switch topology {
+	case api.SubnetTopologyPublic:
+		if !viaInternetGateway {
+			return fmt.Errorf("public subnet %q has no default route to an internet gateway", *subnet.SubnetId)
+		}
+	case api.SubnetTopologyPrivate:
+		if viaInternetGateway {
+			return fmt.Errorf("private subnet %q has a default route to an internet gateway", *subnet.SubnetId)
+		}
+	}
+	return nil
+}
+
+func findRouteTable(provider api.ClusterProvider, vpcID, subnetID string) (*ec2.RouteTable, error) {
+	filterSets := [][]*ec2.Filter{
+		{
+			{Name: aws.String("association.subnet-id"), Values: aws.StringSlice([]string{subnetID})},
+		},
+		{
+			{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{vpcID})},
+			{Name: aws.String("association.main"), Values: aws.StringSlice([]string{"true"})},
+		},
+	}
+	for _, filters := range filterSets {
+		output, err := provider.EC2().DescribeRouteTables(&ec2.DescribeRouteTablesInput{Filters: filters})
+		if err != nil {
+			return nil, err
+		}
+		if len(output.RouteTables) > 0 {
+			return output.RouteTables[0], nil
+		}
+	}
+	return nil, nil
+}
+
+func subnetIDs(subnets []*ec2.Subnet) []string {
+	ids := []string{}
+	for _, subnet := range subnets {
+		ids = append(ids, *subnet.SubnetId)
+	}
+	return ids
+}
@@ -0,0 +1,125 @@
+package vpc_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	. "github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("Subnet discovery", func() {
+	var (
+		p   *mockprovider.MockProvider
+		cfg *api.ClusterConfig
+	)
+
+	newSubnet := func(id, az, cidr string) *ec2.Subnet {
+		return &ec2.Subnet{
+			SubnetId:         aws.String(id),
+			AvailabilityZone: aws.String(az),
+			CidrBlock:        aws.String(cidr),
+			VpcId:            aws.String("vpc-1"),
+		}
+	}
+
+	hasFilter := func(name, value string) func(*ec2.DescribeSubnetsInput) bool {
+		return func(input *ec2.DescribeSubnetsInput) bool {
+			for _, f := range input.Filters {
+				if *f.Name == name && *f.Values[0] == value {
+					return true
+				}
+			}
+			return false
+		}
+	}
+
+	mockRouteTables := func(gatewayIDs map[string]string) {
+		p.MockEC2().On("DescribeRouteTables", mock.Anything).Return(func(input *ec2.DescribeRouteTablesInput) *ec2.DescribeRouteTablesOutput {
+			subnetID := *input.Filters[0].Values[0]
+			return &ec2.DescribeRouteTablesOutput{
+				RouteTables: []*ec2.RouteTable{{
+					Routes: []*ec2.Route{{
+						DestinationCidrBlock: aws.String("0.0.0.0/0"),
+						GatewayId:            aws.String(gatewayIDs[subnetID]),
+					}},
+				}},
+			}
+		}, nil)
+	}
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+
+		cfg = api.NewClusterConfig()
+		cfg.VPC.ID = "vpc-1"
+		cfg.VPC.CIDR = nil
+		cfg.VPC.SubnetDiscovery = &api.ClusterSubnetDiscovery{}
+		api.SetClusterConfigDefaults(cfg)
+
+		p.MockEC2().On("DescribeVpcs", mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("10.0.0.0/16")}},
+		}, nil)
+	})
+
+	It("imports one subnet per AZ matching the role tags", func() {
+		p.MockEC2().On("DescribeSubnets", mock.MatchedBy(hasFilter("tag:kubernetes.io/role/internal-elb", "1"))).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				newSubnet("subnet-p2", "us-west-2a", "10.0.3.0/24"),
+				newSubnet("subnet-p1", "us-west-2a", "10.0.1.0/24"),
+				newSubnet("subnet-p3", "us-west-2b", "10.0.2.0/24"),
+			},
+		}, nil)
+		p.MockEC2().On("DescribeSubnets", mock.MatchedBy(hasFilter("tag:kubernetes.io/role/elb", "1"))).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				newSubnet("subnet-a", "us-west-2a", "10.0.101.0/24"),
+				newSubnet("subnet-b", "us-west-2b", "10.0.102.0/24"),
+			},
+		}, nil)
+		mockRouteTables(map[string]string{
+			"subnet-p1": "local",
+			"subnet-p3": "local",
+			"subnet-a":  "igw-1",
+			"subnet-b":  "igw-1",
+		})
+
+		Expect(DiscoverSubnets(p, cfg)).To(Succeed())
+		Expect(cfg.PrivateSubnetIDs()).To(ConsistOf("subnet-p1", "subnet-p3"))
+		Expect(cfg.PublicSubnetIDs()).To(ConsistOf("subnet-a", "subnet-b"))
+		Expect(cfg.VPC.CIDR.String()).To(Equal("10.0.0.0/16"))
+		Expect(cfg.AvailabilityZones).To(ConsistOf("us-west-2a", "us-west-2b"))
+	})
+
+	It("requires subnets in enough AZs", func() {
+		cfg.VPC.SubnetDiscovery = &api.ClusterSubnetDiscovery{
+			Private: &api.SubnetSelector{Tags: map[string]string{"tier": "private"}},
+		}
+		p.MockEC2().On("DescribeSubnets", mock.MatchedBy(hasFilter("tag:tier", "private"))).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{newSubnet("subnet-p1", "us-west-2a", "10.0.1.0/24")},
+		}, nil)
+
+		Expect(DiscoverSubnets(p, cfg)).To(MatchError("found private subnets in 1 availability zone(s) of vpc-1 with tags map[tier:private], at least 2 are required"))
+	})
+
+	It("rejects public subnets without a route to an internet gateway", func() {
+		cfg.VPC.SubnetDiscovery = &api.ClusterSubnetDiscovery{
+			Public: &api.SubnetSelector{Tags: map[string]string{"tier": ""}},
+		}
+		p.MockEC2().On("DescribeSubnets", mock.MatchedBy(hasFilter("tag-key", "tier"))).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				newSubnet("subnet-a", "us-west-2a", "10.0.101.0/24"),
+				newSubnet("subnet-b", "us-west-2b", "10.0.102.0/24"),
+			},
+		}, nil)
+		mockRouteTables(map[string]string{
+			"subnet-a": "igw-1",
+			"subnet-b": "local",
+		})
+
+		Expect(DiscoverSubnets(p, cfg)).To(MatchError(`public subnet "subnet-b" has no default route to an internet gateway`))
+	})
+})
@@ -0,0 +1,196 @@
+package vpc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	"github.com/pkg/errors"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/logger"
+)
+
+// Preflight checks that a cluster can be created in the existing VPC and subnets of spec,
+// including subnets shared with accountID by other accounts via RAM, so that problems are
+// reported before any CloudFormation stacks are created, rather than as a rollback;
+// nodes must reach the public endpoint, or VPC endpoints in case of a fully-private cluster
+func Preflight(provider api.ClusterProvider, spec *api.ClusterConfig, accountID string) error {
+	problems := []string{}
+
+	dnsProblems, err := checkDNSAttributes(provider, spec.VPC.ID)
+	if err != nil {
+		return err
+	}
+	problems = append(problems, dnsProblems...)
+
+	subnets := map[api.SubnetTopology][]*ec2.Subnet{}
+	for topology, ids := range map[api.SubnetTopology][]string{
+		api.SubnetTopologyPrivate: spec.PrivateSubnetIDs(),
+		api.SubnetTopologyPublic:  spec.PublicSubnetIDs(),
+	} {
+		if len(ids) == 0 {
+			continue
+		}
+		if subnets[topology], err = describeSubnets(provider, ids...); err != nil {
+			return errors.Wrap(err, "describing subnets")
+		}
+		logSharedSubnets(subnets[topology], accountID)
+	}
+
+	if spec.HasAddon(api.ALBIngressAddon) {
+		problems = append(problems, checkTagsOfSharedSubnets(subnets, accountID)...)
+	}
+
+	checkReachability := checkInternetReachability
+	if spec.IsPrivateCluster() {
+		checkReachability = checkEndpointReachability
+	}
+	reachabilityProblems, err := checkReachability(provider, spec, subnets)
+	if err != nil {
+		return err
+	}
+	problems = append(problems, reachabilityProblems...)
+
+	if len(problems) > 0 {
+		return fmt.Errorf("cannot create cluster in %s:\n- %s", spec.VPC.ID, strings.Join(problems, "\n- "))
+	}
+	return nil
+}
+
+func checkDNSAttributes(provider api.ClusterProvider, vpcID string) ([]string, error) {
+	problems := []string{}
+	for _, attribute := range []string{ec2.VpcAttributeNameEnableDnsSupport, ec2.VpcAttributeNameEnableDnsHostnames} {
+		output, err := provider.EC2().DescribeVpcAttribute(&ec2.DescribeVpcAttributeInput{
+			VpcId:     aws.String(vpcID),
+			Attribute: aws.String(attribute),
+		})
+		if err != nil {
+			return nil, errors.Wrapf(err, "describing attribute %q of %s", attribute, vpcID)
+		}
+		enabled := output.EnableDnsSupport
+		if attribute == ec2.VpcAttributeNameEnableDnsHostnames {
+			enabled = output.EnableDnsHostnames
+		}
+		if enabled == nil || !aws.BoolValue(enabled.Value) {
+			problems = append(problems, fmt.Sprintf("%s of %s must be enabled, otherwise nodes cannot resolve the endpoint of the cluster; if the VPC is shared, only its owner can change this", attribute, vpcID))
+		}
+	}
+	return problems, nil
+}
+
+// checkTagsOfSharedSubnets makes sure that subnets which load balancers are discovered by
+// are tagged already when they are owned by another account, as only the owner can tag them
+func checkTagsOfSharedSubnets(subnets map[api.SubnetTopology][]*ec2.Subnet, accountID string) []string {
+	roleTags := map[api.SubnetTopology]string{
+		api.SubnetTopologyPrivate: api.PrivateSubnetRoleTag,
+		api.SubnetTopologyPublic:  api.PublicSubnetRoleTag,
+	}
+	problems := []string{}
+	for topology, topologySubnets := range subnets {
+		for _, subnet := range topologySubnets {
+			owner := aws.StringValue(subnet.OwnerId)
+			if owner == "" || owner == accountID || hasTag(subnet.Tags, roleTags[topology]) {
+				continue
+			}
+			problems = append(problems, fmt.Sprintf("subnet %q is owned by account %s and cannot be tagged by this account, ask the owner to add the tag %q with value \"1\" for load balancers to use it", *subnet.SubnetId, owner, roleTags[topology]))
+		}
+	}
+	return problems
+}
+
+// checkInternetReachability makes sure that nodes can reach the public endpoint of the cluster,
+// so the subnets of nodes need a default route to the internet
+func checkInternetReachability(provider api.ClusterProvider, spec *api.ClusterConfig, subnets map[api.SubnetTopology][]*ec2.Subnet) ([]string, error) {
+	usedTopologies := map[api.SubnetTopology]bool{}
+	for _, ng := range spec.NodeGroups {
+		usedTopologies[nodeSubnetTopology(ng.PrivateNetworking)] = true
+	}
+	for _, ng := range spec.ManagedNodeGroups {
+		usedTopologies[nodeSubnetTopology(ng.PrivateNetworking)] = true
+	}
+
+	problems := []string{}
+	for topology := range usedTopologies {
+		for _, subnet := range subnets[topology] {
+			routeTable, err := findRouteTable(provider, spec.VPC.ID, *subnet.SubnetId)
+			if err != nil {
+				return nil, errors.Wrapf(err, "describing route table of subnet %q", *subnet.SubnetId)
+			}
+			route := defaultRoute(routeTable)
+
+			switch {
+			case route == nil && topology == api.SubnetTopologyPrivate:
+				problems = append(problems, fmt.Sprintf("private subnet %q has no default route, nodes in it cannot reach the public endpoint of the cluster; add a route through a NAT gateway", *subnet.SubnetId))
+			case topology == api.SubnetTopologyPublic && !isInternetGatewayRoute(route):
+				problems = append(problems, fmt.Sprintf("public subnet %q has no default route to an internet gateway, nodes in it cannot reach the public endpoint of the cluster", *subnet.SubnetId))
+			}
+		}
+	}
+	return problems, nil
+}
+
+// checkEndpointReachability makes sure that nodes of a fully-private cluster can reach the AWS
+// services they need, which is only possible through VPC endpoints
+func checkEndpointReachability(provider api.ClusterProvider, spec *api.ClusterConfig, _ map[api.SubnetTopology][]*ec2.Subnet) ([]string, error) {
+	output, err := provider.EC2().DescribeVpcEndpoints(&ec2.DescribeVpcEndpointsInput{
+		Filters: []*ec2.Filter{{Name: aws.String("vpc-id"), Values: aws.StringSlice([]string{spec.VPC.ID})}},
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "describing VPC endpoints of %s", spec.VPC.ID)
+	}
+	existing := map[string]bool{}
+	for _, endpoint := range output.VpcEndpoints {
+		existing[aws.StringValue(endpoint.ServiceName)] = true
+	}
+
+	problems := []string{}
+	for _, service := range spec.EndpointServices() {
+		if name := spec.EndpointServiceName(service); !existing[name] {
+			problems = append(problems, fmt.Sprintf("%s has no VPC endpoint for %q, nodes of a fully-private cluster cannot reach it", spec.VPC.ID, name))
+		}
+	}
+	return problems, nil
+}
+
+func nodeSubnetTopology(privateNetworking bool) api.SubnetTopology {
+	if privateNetworking {
+		return api.SubnetTopologyPrivate
+	}
+	return api.SubnetTopologyPublic
+}
+
+func defaultRoute(routeTable *ec2.RouteTable) *ec2.Route {
+	if routeTable == nil {
+		return nil
+	}
+	for _, route := range routeTable.Routes {
+		if aws.StringValue(route.DestinationCidrBlock) == "0.0.0.0/0" && aws.StringValue(route.State) != ec2.RouteStateBlackhole {
+			return route
+		}
+	}
+	return nil
+}
+
+func isInternetGatewayRoute(route *ec2.Route) bool {
+	return route != nil && strings.HasPrefix(aws.StringValue(route.GatewayId), "igw-")
+}
+
+func hasTag(tags []*ec2.Tag, key string) bool {
+	for _, tag := range tags {
+		if aws.StringValue(tag.Key) == key {
+			return true
+		}
+	}
+	return false
+}
+
+// logSharedSubnets informs about subnets that are owned by other accounts
+func logSharedSubnets(subnets []*ec2.Subnet, accountID string) {
+	for _, subnet := range subnets {
+		if owner := aws.StringValue(subnet.OwnerId); owner != "" && owner != accountID {
+			logger.Info("subnet %q is shared by account %s", *subnet.SubnetId, owner)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package vpc_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	. "github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("VPC preflight", func() {
+	var (
+		p             *mockprovider.MockProvider
+		cfg           *api.ClusterConfig
+		subnets       map[string]*ec2.Subnet
+		dnsHostnames  bool
+		defaultRoutes map[string]*ec2.Route
+	)
+
+	const accountID = "111122223333"
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		dnsHostnames = true
+
+		cfg = api.NewClusterConfig()
+		cfg.VPC.ID = "vpc-1"
+		ng := cfg.NewNodeGroup()
+		ng.Name = "ng-1"
+		ng.PrivateNetworking = true
+
+		subnets = map[string]*ec2.Subnet{}
+		for id, az := range map[string]string{"subnet-p1": "us-west-2a", "subnet-p2": "us-west-2b"} {
+			Expect(cfg.ImportSubnet(api.SubnetTopologyPrivate, az, id, "")).To(Succeed())
+			subnets[id] = &ec2.Subnet{SubnetId: aws.String(id), OwnerId: aws.String(accountID)}
+		}
+		for id, az := range map[string]string{"subnet-a": "us-west-2a", "subnet-b": "us-west-2b"} {
+			Expect(cfg.ImportSubnet(api.SubnetTopologyPublic, az, id, "")).To(Succeed())
+			subnets[id] = &ec2.Subnet{SubnetId: aws.String(id), OwnerId: aws.String(accountID)}
+		}
+
+		defaultRoutes = map[string]*ec2.Route{
+			"subnet-p1": {NatGatewayId: aws.String("nat-1")},
+			"subnet-p2": {NatGatewayId: aws.String("nat-2")},
+			"subnet-a":  {GatewayId: aws.String("igw-1")},
+			"subnet-b":  {GatewayId: aws.String("igw-1")},
+		}
+
+		p.MockEC2().On("DescribeVpcAttribute", mock.Anything).Return(func(input *ec2.DescribeVpcAttributeInput) *ec2.DescribeVpcAttributeOutput {
+			if *input.Attribute == ec2.VpcAttributeNameEnableDnsHostnames {
+				return &ec2.DescribeVpcAttributeOutput{EnableDnsHostnames: &ec2.AttributeBooleanValue{Value: aws.Bool(dnsHostnames)}}
+			}
+			return &ec2.DescribeVpcAttributeOutput{EnableDnsSupport: &ec2.AttributeBooleanValue{Value: aws.Bool(true)}}
+		}, nil)
+
+		p.MockEC2().On("DescribeSubnets", mock.Anything).Return(func(input *ec2.DescribeSubnetsInput) *ec2.DescribeSubnetsOutput {
+			output := &ec2.DescribeSubnetsOutput{}
+			for _, id := range input.SubnetIds {
+				output.Subnets = append(output.Subnets, subnets[*id])
+			}
+			return output
+		}, nil)
+
+		p.MockEC2().On("DescribeRouteTables", mock.Anything).Return(func(input *ec2.DescribeRouteTablesInput) *ec2.DescribeRouteTablesOutput {
+			routeTable := &ec2.RouteTable{}
+			if route, ok := defaultRoutes[*input.Filters[0].Values[0]]; ok {
+				route.DestinationCidrBlock = aws.String("0.0.0.0/0")
+				routeTable.Routes = []*ec2.Route{route}
+			}
+			return &ec2.DescribeRouteTablesOutput{RouteTables: []*ec2.RouteTable{routeTable}}
+		}, nil)
+	})
+
+	It("accepts a VPC that is set up correctly", func() {
+		Expect(Preflight(p, cfg, accountID)).To(Succeed())
+	})
+
+	It("requires DNS hostnames to be enabled", func() {
+		dnsHostnames = false
+
+		err := Preflight(p, cfg, accountID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring("enableDnsHostnames of vpc-1 must be enabled"))
+	})
+
+	It("requires private subnets of nodes to have a default route", func() {
+		delete(defaultRoutes, "subnet-p2")
+
+		err := Preflight(p, cfg, accountID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`private subnet "subnet-p2" has no default route`))
+		Expect(err.Error()).ToNot(ContainSubstring("subnet-p1"))
+	})
+
+	It("requires public subnets of nodes to have a route to an internet gateway", func() {
+		cfg.NodeGroups[0].PrivateNetworking = false
+		defaultRoutes["subnet-a"] = &ec2.Route{NatGatewayId: aws.String("nat-1")}
+
+		err := Preflight(p, cfg, accountID)
+		Expect(err).To(HaveOccurred())
+		Expect(err.Error()).To(ContainSubstring(`public subnet "subnet-a" has no default route to an internet gateway`))
+	})
+
+	Context("with a fully-private cluster", func() {
+		var endpointServices []string
+
+		BeforeEach(func() {
+			cfg.Metadata.Region = "us-west-2"
+			cfg.PrivateCluster = &api.PrivateCluster{Enabled: api.Enabled()}
+			defaultRoutes = map[string]*ec2.Route{}
+			endpointServices = []string{"s3", "ec2", "ecr.api", "ecr.dkr", "sts", "logs"}
+
+			p.MockEC2().On("DescribeVpcEndpoints", mock.Anything).Return(func(*ec2.DescribeVpcEndpointsInput) *ec2.DescribeVpcEndpointsOutput {
+				output := &ec2.DescribeVpcEndpointsOutput{}
+				for _, service := range endpointServices {
+					output.VpcEndpoints = append(output.VpcEndpoints, &ec2.VpcEndpoint{ServiceName: aws.String("com.amazonaws.us-west-2." + service)})
+				}
+				return output
+			}, nil)
+		})
+
+		It("accepts subnets without routes to the internet when all endpoints exist", func() {
+			Expect(Preflight(p, cfg, accountID)).To(Succeed())
+		})
+
+		It("requires VPC endpoints for the services nodes need", func() {
+			endpointServices = []string{"s3", "ec2", "sts", "logs"}
+
+			err := Preflight(p, cfg, accountID)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`vpc-1 has no VPC endpoint for "com.amazonaws.us-west-2.ecr.api"`))
+			Expect(err.Error()).To(ContainSubstring(`vpc-1 has no VPC endpoint for "com.amazonaws.us-west-2.ecr.dkr"`))
+			Expect(err.Error()).ToNot(ContainSubstring("default route"))
+		})
+	})
+
+	Context("with subnets shared by another account", func() {
+		BeforeEach(func() {
+			for _, subnet := range subnets {
+				subnet.OwnerId = aws.String("444455556666")
+			}
+			cfg.Addons = []*api.Addon{{Name: api.ALBIngressAddon}}
+		})
+
+		It("requires the role tags to be set by the owner when alb-ingress is enabled", func() {
+			subnets["subnet-p1"].Tags = []*ec2.Tag{{Key: aws.String(api.PrivateSubnetRoleTag), Value: aws.String("1")}}
+			subnets["subnet-p2"].Tags = subnets["subnet-p1"].Tags
+
+			err := Preflight(p, cfg, accountID)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring(`subnet "subnet-a" is owned by account 444455556666`))
+			Expect(err.Error()).To(ContainSubstring(`subnet "subnet-b" is owned by account 444455556666`))
+			Expect(err.Error()).ToNot(ContainSubstring("subnet-p"))
+		})
+
+		It("does not check tags without alb-ingress", func() {
+			cfg.Addons = nil
+			Expect(Preflight(p, cfg, accountID)).To(Succeed())
+		})
+	})
+})
@@ -2,16 +2,19 @@ package vpc
 
 import (
 	"fmt"
+	"net"
 	"strings"
 
-	"github.com/kris-nova/logger"
+	"github.com/pkg/errors"
 
 	"github.com/aws/aws-sdk-go/aws"
 	cfn "github.com/aws/aws-sdk-go/service/cloudformation"
 	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
 
 	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
 	"github.com/weaveworks/eksctl/pkg/cfn/outputs"
+	"github.com/weaveworks/eksctl/pkg/logger"
 	"github.com/weaveworks/eksctl/pkg/utils/ipnet"
 
 	"k8s.io/kops/pkg/util/subnet"
@@ -53,12 +56,131 @@ func SetSubnets(spec *api.ClusterConfig) error {
 		vpc.Subnets.Private[zone] = api.Network{
 			CIDR: &ipnet.IPNet{IPNet: *private},
 		}
+		if spec.IsPrivateCluster() {
+			// nothing in a fully-private cluster is reachable from the internet
+			logger.Info("subnets for %s - private:%s", zone, private.String())
+			continue
+		}
 		vpc.Subnets.Public[zone] = api.Network{
 			CIDR: &ipnet.IPNet{IPNet: *public},
 		}
 		logger.Info("subnets for %s - public:%s private:%s", zone, public.String(), private.String())
 	}
 
+	return setPodSubnets(spec)
+}
+
+// SetSubnetsForZones defines CIDRs of the subnets in availability zones that are added to
+// an existing cluster, they are taken from the blocks of the VPC CIDR that SetSubnets divides
+// it into and that none of the existing subnets overlap with
+func SetSubnetsForZones(spec *api.ClusterConfig, zones []string) error {
+	vpc := spec.VPC
+	if vpc.CIDR == nil {
+		return fmt.Errorf("CIDR of the VPC of cluster %q is unknown", spec.Metadata.Name)
+	}
+	if vpc.Subnets == nil {
+		vpc.Subnets = &api.ClusterSubnets{}
+	}
+	if vpc.Subnets.Private == nil {
+		vpc.Subnets.Private = map[string]api.Network{}
+	}
+	if vpc.Subnets.Public == nil {
+		vpc.Subnets.Public = map[string]api.Network{}
+	}
+	zoneCIDRs, err := subnet.SplitInto8(&vpc.CIDR.IPNet)
+	if err != nil {
+		return err
+	}
+
+	var existing []*net.IPNet
+	for _, subnets := range []map[string]api.Network{vpc.Subnets.Private, vpc.Subnets.Public} {
+		for _, s := range subnets {
+			if s.CIDR != nil {
+				existing = append(existing, &s.CIDR.IPNet)
+			}
+		}
+	}
+	var free []*net.IPNet
+	for _, cidr := range zoneCIDRs {
+		overlaps := false
+		for _, e := range existing {
+			if e.Contains(cidr.IP) || cidr.Contains(e.IP) {
+				overlaps = true
+				break
+			}
+		}
+		if !overlaps {
+			free = append(free, cidr)
+		}
+	}
+
+	required := len(zones)
+	if !spec.IsPrivateCluster() {
+		required *= 2
+	}
+	if required > len(free) {
+		return fmt.Errorf("insufficient number of free subnets (have %d, but need %d) in VPC CIDR %s for %d availability zones", len(free), required, vpc.CIDR.String(), len(zones))
+	}
+
+	for _, zone := range zones {
+		private := free[0]
+		free = free[1:]
+		vpc.Subnets.Private[zone] = api.Network{
+			CIDR: &ipnet.IPNet{IPNet: *private},
+		}
+		spec.AppendAvailabilityZone(zone)
+		if spec.IsPrivateCluster() {
+			logger.Info("subnets for %s - private:%s", zone, private.String())
+			continue
+		}
+		public := free[0]
+		free = free[1:]
+		vpc.Subnets.Public[zone] = api.Network{
+			CIDR: &ipnet.IPNet{IPNet: *public},
+		}
+		logger.Info("subnets for %s - public:%s private:%s", zone, public.String(), private.String())
+	}
+	return nil
+}
+
+// setPodSubnets defines CIDRs of pod subnets that are not given,
+// splitting the secondary CIDR in the same way as the VPC CIDR
+func setPodSubnets(spec *api.ClusterConfig) error {
+	if !spec.HasPodSubnets() || spec.VPC.PodSubnets.CIDR == nil {
+		return nil
+	}
+	podSubnets := spec.VPC.PodSubnets
+	if podSubnets.Subnets == nil {
+		podSubnets.Subnets = map[string]api.Network{}
+	}
+
+	zoneCIDRs, err := subnet.SplitInto8(&podSubnets.CIDR.IPNet)
+	if err != nil {
+		return err
+	}
+	if len(spec.AvailabilityZones) > len(zoneCIDRs) {
+		return fmt.Errorf("insufficient number of pod subnets (have %d, but need %d)", len(zoneCIDRs), len(spec.AvailabilityZones))
+	}
+
+	zones := map[string]bool{}
+	for _, zone := range spec.AvailabilityZones {
+		zones[zone] = true
+	}
+	for zone := range podSubnets.Subnets {
+		if !zones[zone] {
+			return fmt.Errorf("pod subnet is given for %s, which is not one of the availability zones %v", zone, spec.AvailabilityZones)
+		}
+	}
+
+	for i, zone := range spec.AvailabilityZones {
+		if _, ok := podSubnets.Subnets[zone]; ok {
+			continue
+		}
+		podSubnets.Subnets[zone] = api.Network{
+			CIDR: &ipnet.IPNet{IPNet: *zoneCIDRs[i]},
+		}
+		logger.Info("pod subnet for %s - %s", zone, zoneCIDRs[i].String())
+	}
 	return nil
 }
 
@@ -118,6 +240,9 @@ func UseFromCluster(provider api.ClusterProvider, stack *cfn.Stack, spec *api.Cl
 		outputs.ClusterSubnetsPublic: func(v string) error {
 			return ImportSubnetsFromList(provider, spec, api.SubnetTopologyPublic, strings.Split(v, ","))
 		},
+		outputs.ClusterSubnetsPod: func(v string) error {
+			return ImportPodSubnetsFromList(provider, spec, strings.Split(v, ","))
+		},
 	}
 
 	if !outputs.Exists(*stack, outputs.ClusterSubnetsPublic) &&
@@ -127,7 +252,155 @@ func UseFromCluster(provider api.ClusterProvider, stack *cfn.Stack, spec *api.Cl
 		}
 	}
 
-	return outputs.Collect(*stack, requiredCollectors, optionalCollectors)
+	if err := outputs.Collect(*stack, requiredCollectors, optionalCollectors); err != nil {
+		return err
+	}
+
+	for _, topology := range api.SubnetTopologies() {
+		if err := ImportSubnetsFromList(provider, spec, topology, outputs.SubnetsInZones(*stack, topology)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UseFromControlPlane retrieves the VPC configuration from the EKS API description of
+// a cluster that wasn't created by eksctl; subnets are classified as public when their
+// default route goes to an internet gateway, the cluster security group created by EKS
+// is used as the shared node security group
+func UseFromControlPlane(provider api.ClusterProvider, cluster *awseks.Cluster, spec *api.ClusterConfig) error {
+	if spec.VPC == nil {
+		spec.VPC = api.NewClusterVPC()
+	}
+	spec.VPC.CIDR = nil
+
+	vpcConfig := cluster.ResourcesVpcConfig
+	if vpcConfig == nil || vpcConfig.VpcId == nil {
+		return fmt.Errorf("cluster %q has no VPC configuration", spec.Metadata.Name)
+	}
+	spec.VPC.ID = *vpcConfig.VpcId
+
+	if len(vpcConfig.SecurityGroupIds) > 0 {
+		spec.VPC.SecurityGroup = *vpcConfig.SecurityGroupIds[0]
+	} else if vpcConfig.ClusterSecurityGroupId != nil {
+		spec.VPC.SecurityGroup = *vpcConfig.ClusterSecurityGroupId
+	}
+	if vpcConfig.ClusterSecurityGroupId != nil {
+		spec.VPC.SharedNodeSecurityGroup = *vpcConfig.ClusterSecurityGroupId
+	}
+	if spec.VPC.SecurityGroup == "" || spec.VPC.SharedNodeSecurityGroup == "" {
+		return fmt.Errorf("cluster %q has no cluster security group, it must be on EKS platform version eks.3 or later", spec.Metadata.Name)
+	}
+
+	subnets, err := describeSubnets(provider, aws.StringValueSlice(vpcConfig.SubnetIds)...)
+	if err != nil {
+		return err
+	}
+	subnetsByTopology := map[api.SubnetTopology][]*ec2.Subnet{}
+	for _, subnet := range subnets {
+		routeTable, err := findRouteTable(provider, spec.VPC.ID, *subnet.SubnetId)
+		if err != nil {
+			return errors.Wrapf(err, "describing route table of subnet %q", *subnet.SubnetId)
+		}
+		topology := api.SubnetTopologyPrivate
+		if isInternetGatewayRoute(defaultRoute(routeTable)) {
+			topology = api.SubnetTopologyPublic
+		}
+		subnetsByTopology[topology] = append(subnetsByTopology[topology], subnet)
+	}
+	for _, topology := range api.SubnetTopologies() {
+		if err := ImportSubnets(provider, spec, topology, subnetsByTopology[topology]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// TagSubnetsForLoadBalancers adds the tags by which load balancer controllers discover
+// subnets of the cluster, i.e. public subnets for internet-facing load balancers and
+// private subnets for internal ones; subnets created by eksctl have the role tags already,
+// but imported subnets may not; the cluster tag is only added to subnets that don't have it,
+// so that a subnet owned by the cluster isn't marked as shared
+func TagSubnetsForLoadBalancers(provider api.ClusterProvider, spec *api.ClusterConfig) error {
+	clusterTagKey := "kubernetes.io/cluster/" + spec.Metadata.Name
+	subnets := map[string][]string{
+		api.PublicSubnetRoleTag:  spec.PublicSubnetIDs(),
+		api.PrivateSubnetRoleTag: spec.PrivateSubnetIDs(),
+	}
+	for roleTag, subnetIDs := range subnets {
+		if len(subnetIDs) == 0 {
+			continue
+		}
+		input := &ec2.CreateTagsInput{
+			Resources: aws.StringSlice(subnetIDs),
+			Tags: []*ec2.Tag{{
+				Key:   aws.String(roleTag),
+				Value: aws.String("1"),
+			}},
+		}
+		if _, err := provider.EC2().CreateTags(input); err != nil {
+			return errors.Wrapf(err, "tagging subnets %v", subnetIDs)
+		}
+		logger.Info("tagged subnets %v with %q", subnetIDs, roleTag)
+	}
+
+	subnetIDs := append(spec.PublicSubnetIDs(), spec.PrivateSubnetIDs()...)
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	existing, err := describeSubnets(provider, subnetIDs...)
+	if err != nil {
+		return errors.Wrapf(err, "describing subnets %v", subnetIDs)
+	}
+	var untagged []string
+	for _, subnet := range existing {
+		if !hasTag(subnet.Tags, clusterTagKey) {
+			untagged = append(untagged, *subnet.SubnetId)
+		}
+	}
+	if len(untagged) == 0 {
+		return nil
+	}
+	input := &ec2.CreateTagsInput{
+		Resources: aws.StringSlice(untagged),
+		Tags: []*ec2.Tag{{
+			Key:   aws.String(clusterTagKey),
+			Value: aws.String("shared"),
+		}},
+	}
+	if _, err := provider.EC2().CreateTags(input); err != nil {
+		return errors.Wrapf(err, "tagging subnets %v", untagged)
+	}
+	logger.Info("tagged subnets %v with %q", untagged, clusterTagKey)
+	return nil
+}
+
+// TagForKarpenterDiscovery adds the tag by which Karpenter discovers the subnets and security
+// groups of nodes it launches, i.e. the private subnets of the cluster, or its public subnets
+// when there are no private ones, and the shared node security group
+func TagForKarpenterDiscovery(provider api.ClusterProvider, spec *api.ClusterConfig) error {
+	resources := spec.PrivateSubnetIDs()
+	if len(resources) == 0 {
+		resources = spec.PublicSubnetIDs()
+	}
+	if spec.VPC.SharedNodeSecurityGroup != "" {
+		resources = append(resources, spec.VPC.SharedNodeSecurityGroup)
+	}
+	if len(resources) == 0 {
+		return fmt.Errorf("no subnets or security groups of cluster %q to tag for Karpenter", spec.Metadata.Name)
+	}
+	input := &ec2.CreateTagsInput{
+		Resources: aws.StringSlice(resources),
+		Tags: []*ec2.Tag{{
+			Key:   aws.String(api.KarpenterDiscoveryTag),
+			Value: aws.String(spec.Metadata.Name),
+		}},
+	}
+	if _, err := provider.EC2().CreateTags(input); err != nil {
+		return errors.Wrapf(err, "tagging %v", resources)
+	}
+	logger.Info("tagged %v with %q", resources, api.KarpenterDiscoveryTag)
+	return nil
 }
 
 // Import will update spec with VPC ID/CIDR
@@ -212,6 +485,27 @@ func ImportSubnetsFromList(provider api.ClusterProvider, spec *api.ClusterConfig
 	return ImportSubnets(provider, spec, topology, subnets)
 }
 
+// ImportPodSubnetsFromList will update spec with pod subnets keyed by their AZ,
+// it will call describeSubnets first
+func ImportPodSubnetsFromList(provider api.ClusterProvider, spec *api.ClusterConfig, subnetIDs []string) error {
+	if len(subnetIDs) == 0 {
+		return nil
+	}
+	subnets, err := describeSubnets(provider, subnetIDs...)
+	if err != nil {
+		return err
+	}
+	for _, subnet := range subnets {
+		if spec.VPC.ID != "" && spec.VPC.ID != *subnet.VpcId {
+			return fmt.Errorf("given %s is in %s, not in %s", *subnet.SubnetId, *subnet.VpcId, spec.VPC.ID)
+		}
+		if err := spec.ImportPodSubnet(*subnet.AvailabilityZone, *subnet.SubnetId, *subnet.CidrBlock); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // ImportAllSubnets will update spec with subnets, it will call describeSubnets first,
 // then pass resulting subnets to ImportSubnets
 // NOTE: it does respect all fields set in spec.VPC, and will error if
@@ -229,6 +523,11 @@ func ImportAllSubnets(provider api.ClusterProvider, spec *api.ClusterConfig) err
 	if err := ImportSubnetsFromList(provider, spec, api.SubnetTopologyPublic, spec.PublicSubnetIDs()); err != nil {
 		return err
 	}
+	if spec.HasPodSubnets() && spec.VPC.ID != "" {
+		if err := ImportPodSubnetsFromList(provider, spec, spec.PodSubnetIDs()); err != nil {
+			return err
+		}
+	}
 
 	return nil
 }
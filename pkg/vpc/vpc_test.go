@@ -0,0 +1,160 @@
+package vpc_test
+
+import (
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/ec2"
+	awseks "github.com/aws/aws-sdk-go/service/eks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/stretchr/testify/mock"
+
+	api "github.com/weaveworks/eksctl/pkg/apis/eksctl.io/v1alpha5"
+	"github.com/weaveworks/eksctl/pkg/testutils/mockprovider"
+	. "github.com/weaveworks/eksctl/pkg/vpc"
+)
+
+var _ = Describe("VPC of a cluster that wasn't created by eksctl", func() {
+	var (
+		p       *mockprovider.MockProvider
+		cfg     *api.ClusterConfig
+		cluster *awseks.Cluster
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "external"
+
+		cluster = &awseks.Cluster{
+			ResourcesVpcConfig: &awseks.VpcConfigResponse{
+				VpcId:                  aws.String("vpc-1"),
+				SubnetIds:              aws.StringSlice([]string{"subnet-a", "subnet-p1"}),
+				SecurityGroupIds:       aws.StringSlice([]string{"sg-control-plane"}),
+				ClusterSecurityGroupId: aws.String("sg-cluster"),
+			},
+		}
+
+		subnets := map[string]*ec2.Subnet{
+			"subnet-a":  {SubnetId: aws.String("subnet-a"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2a"), CidrBlock: aws.String("10.0.0.0/24")},
+			"subnet-p1": {SubnetId: aws.String("subnet-p1"), VpcId: aws.String("vpc-1"), AvailabilityZone: aws.String("us-west-2b"), CidrBlock: aws.String("10.0.1.0/24")},
+		}
+		defaultRoutes := map[string]*ec2.Route{
+			"subnet-a":  {GatewayId: aws.String("igw-1")},
+			"subnet-p1": {NatGatewayId: aws.String("nat-1")},
+		}
+
+		p.MockEC2().On("DescribeVpcs", mock.Anything).Return(&ec2.DescribeVpcsOutput{
+			Vpcs: []*ec2.Vpc{{VpcId: aws.String("vpc-1"), CidrBlock: aws.String("10.0.0.0/16")}},
+		}, nil)
+
+		p.MockEC2().On("DescribeSubnets", mock.Anything).Return(func(input *ec2.DescribeSubnetsInput) *ec2.DescribeSubnetsOutput {
+			output := &ec2.DescribeSubnetsOutput{}
+			for _, id := range input.SubnetIds {
+				output.Subnets = append(output.Subnets, subnets[*id])
+			}
+			return output
+		}, nil)
+
+		p.MockEC2().On("DescribeRouteTables", mock.Anything).Return(func(input *ec2.DescribeRouteTablesInput) *ec2.DescribeRouteTablesOutput {
+			route := defaultRoutes[*input.Filters[0].Values[0]]
+			route.DestinationCidrBlock = aws.String("0.0.0.0/0")
+			return &ec2.DescribeRouteTablesOutput{RouteTables: []*ec2.RouteTable{{Routes: []*ec2.Route{route}}}}
+		}, nil)
+	})
+
+	It("imports the VPC and classifies subnets by their default route", func() {
+		Expect(UseFromControlPlane(p, cluster, cfg)).To(Succeed())
+
+		Expect(cfg.VPC.ID).To(Equal("vpc-1"))
+		Expect(cfg.VPC.CIDR.String()).To(Equal("10.0.0.0/16"))
+		Expect(cfg.VPC.SecurityGroup).To(Equal("sg-control-plane"))
+		Expect(cfg.VPC.SharedNodeSecurityGroup).To(Equal("sg-cluster"))
+		Expect(cfg.PublicSubnetIDs()).To(ConsistOf("subnet-a"))
+		Expect(cfg.PrivateSubnetIDs()).To(ConsistOf("subnet-p1"))
+		Expect(*cfg.VPC.NAT.Gateway).To(Equal(api.ClusterDisableNAT))
+	})
+
+	It("uses the cluster security group for the control plane when there are no additional ones", func() {
+		cluster.ResourcesVpcConfig.SecurityGroupIds = nil
+
+		Expect(UseFromControlPlane(p, cluster, cfg)).To(Succeed())
+		Expect(cfg.VPC.SecurityGroup).To(Equal("sg-cluster"))
+	})
+
+	It("rejects clusters without a cluster security group", func() {
+		cluster.ResourcesVpcConfig.ClusterSecurityGroupId = nil
+
+		err := UseFromControlPlane(p, cluster, cfg)
+		Expect(err).To(MatchError(ContainSubstring("has no cluster security group")))
+	})
+})
+
+var _ = Describe("Subnets of availability zones added to a cluster", func() {
+	var cfg *api.ClusterConfig
+
+	BeforeEach(func() {
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.AvailabilityZones = []string{"us-west-2a", "us-west-2b", "us-west-2c"}
+		Expect(SetSubnets(cfg)).To(Succeed())
+	})
+
+	It("takes the blocks of the VPC CIDR that the existing subnets don't use", func() {
+		Expect(SetSubnetsForZones(cfg, []string{"us-west-2d"})).To(Succeed())
+
+		Expect(cfg.VPC.Subnets.Private["us-west-2d"].CIDR.String()).To(Equal("192.168.192.0/19"))
+		Expect(cfg.VPC.Subnets.Public["us-west-2d"].CIDR.String()).To(Equal("192.168.224.0/19"))
+		Expect(cfg.VPC.Subnets.Private["us-west-2a"].CIDR.String()).To(Equal("192.168.96.0/19"))
+		Expect(cfg.AvailabilityZones).To(ContainElement("us-west-2d"))
+	})
+
+	It("fails when the VPC CIDR has no blocks left", func() {
+		err := SetSubnetsForZones(cfg, []string{"us-west-2d", "us-west-2e"})
+		Expect(err).To(MatchError("insufficient number of free subnets (have 2, but need 4) in VPC CIDR 192.168.0.0/16 for 2 availability zones"))
+	})
+})
+
+var _ = Describe("Tagging subnets for load balancers", func() {
+	var (
+		p       *mockprovider.MockProvider
+		cfg     *api.ClusterConfig
+		created []*ec2.CreateTagsInput
+	)
+
+	BeforeEach(func() {
+		p = mockprovider.NewMockProvider()
+		cfg = api.NewClusterConfig()
+		cfg.Metadata.Name = "cluster-1"
+		cfg.VPC.Subnets = &api.ClusterSubnets{
+			Public:  map[string]api.Network{"us-west-2a": {ID: "subnet-a"}},
+			Private: map[string]api.Network{"us-west-2b": {ID: "subnet-p1"}},
+		}
+		created = nil
+
+		p.MockEC2().On("DescribeSubnets", mock.Anything).Return(&ec2.DescribeSubnetsOutput{
+			Subnets: []*ec2.Subnet{
+				{SubnetId: aws.String("subnet-a"), Tags: []*ec2.Tag{{Key: aws.String("kubernetes.io/cluster/cluster-1"), Value: aws.String("owned")}}},
+				{SubnetId: aws.String("subnet-p1")},
+			},
+		}, nil)
+		p.MockEC2().On("CreateTags", mock.Anything).Run(func(args mock.Arguments) {
+			created = append(created, args.Get(0).(*ec2.CreateTagsInput))
+		}).Return(&ec2.CreateTagsOutput{}, nil)
+	})
+
+	It("adds the role tags, and the cluster tag only to subnets that don't have it", func() {
+		Expect(TagSubnetsForLoadBalancers(p, cfg)).To(Succeed())
+
+		tagged := map[string][]string{}
+		for _, input := range created {
+			for _, tag := range input.Tags {
+				tagged[*tag.Key+"="+*tag.Value] = append(tagged[*tag.Key+"="+*tag.Value], aws.StringValueSlice(input.Resources)...)
+			}
+		}
+		Expect(tagged).To(Equal(map[string][]string{
+			"kubernetes.io/role/elb=1":               {"subnet-a"},
+			"kubernetes.io/role/internal-elb=1":      {"subnet-p1"},
+			"kubernetes.io/cluster/cluster-1=shared": {"subnet-p1"},
+		}))
+	})
+})